@@ -0,0 +1,852 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/internal/config"
+	common "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/pagination"
+	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	auth "github.com/NVIDIA/ncx-infra-controller-rest/auth/pkg/authorization"
+	cutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbp "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/queue"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+	tp "go.temporal.io/sdk/temporal"
+)
+
+// ~~~~~ Create Handler ~~~~~ //
+
+// CreateAddressGroupHandler is the API Handler for creating a new AddressGroup
+type CreateAddressGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateAddressGroupHandler initializes and returns a new handler for creating AddressGroup
+func NewCreateAddressGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) CreateAddressGroupHandler {
+	return CreateAddressGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Create an AddressGroup
+// @Description Create a reusable set of prefixes that NetworkSecurityGroup rules can reference
+// @Tags AddressGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APIAddressGroupCreateRequest true "AddressGroup creation request"
+// @Success 201 {object} model.APIAddressGroup
+// @Router /v2/org/{org}/carbide/address-group [post]
+func (cagh CreateAddressGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressGroup", "Create", c, cagh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to create AddressGroups
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIAddressGroupCreateRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating AddressGroup creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Address Group creation request data", verr)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, cagh.dbSession, org)
+	if err != nil {
+		if err == common.ErrOrgTenantNotFound {
+			logger.Error().Err(err).Msg("Tenant not found for org in request")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Tenant not found for org in request", nil)
+		}
+		logger.Error().Err(err).Msg("unable to retrieve tenant for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	site, err := common.GetSiteFromIDString(ctx, nil, apiRequest.SiteID, cagh.dbSession)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "The Site where this Address Group is being created could not be found", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Site from DB by ID")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "SiteID in request is not valid", nil)
+	}
+
+	// Ensure that Tenant has access to Site
+	tsDAO := cdbm.NewTenantSiteDAO(cagh.dbSession)
+	_, err = tsDAO.GetByTenantIDAndSiteID(ctx, nil, tenant.ID, site.ID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "Tenant does not have access to Site, Address Group cannot be created", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Tenant Site association")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Tenant Site association", nil)
+	}
+
+	agDAO := cdbm.NewAddressGroupDAO(cagh.dbSession)
+
+	// Check if an AddressGroup already exists for the given name and Site
+	ags, tot, err := agDAO.GetAll(ctx, nil, cdbm.AddressGroupFilterInput{Name: &apiRequest.Name, TenantIDs: []uuid.UUID{tenant.ID}, SiteIDs: []uuid.UUID{site.ID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(1)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error checking for existing AddressGroup")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to check for existing Address Group", nil)
+	}
+	if tot > 0 {
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, fmt.Sprintf("Address Group with name: %s for Site: %s already exists", apiRequest.Name, apiRequest.SiteID), validation.Errors{
+			"id": errors.New(ags[0].ID.String()),
+		})
+	}
+
+	ag, err := agDAO.Create(ctx, nil, cdbm.AddressGroupCreateInput{
+		Name:        apiRequest.Name,
+		Description: apiRequest.Description,
+		SiteID:      site.ID,
+		TenantID:    tenant.ID,
+		TenantOrg:   tenant.Org,
+		Prefixes:    apiRequest.Prefixes,
+		Labels:      apiRequest.Labels,
+		Status:      cdbm.AddressGroupStatusReady,
+		CreatedByID: dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create AddressGroup record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed creating Address Group record, DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusCreated, model.NewAPIAddressGroup(ag))
+}
+
+// ~~~~~ GetAll Handler ~~~~~ //
+
+// GetAllAddressGroupHandler is the API Handler for getting all AddressGroups
+type GetAllAddressGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllAddressGroupHandler initializes and returns a new handler for getting all AddressGroups
+func NewGetAllAddressGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAllAddressGroupHandler {
+	return GetAllAddressGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all AddressGroups
+// @Description Get all AddressGroups of the Tenant, optionally filtered by Site
+// @Tags AddressGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteId query string false "ID of Site"
+// @Param status query string false "Query input for status"
+// @Param query query string false "Query input for full text search"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Tenant', 'Site'"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {object} []model.APIAddressGroup
+// @Router /v2/org/{org}/carbide/address-group [get]
+func (gaagh GetAllAddressGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressGroup", "GetAll", c, gaagh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err = c.Bind(&pageRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	err = pageRequest.Validate(cdbm.AddressGroupOrderByFields)
+	if err != nil {
+		logger.Error().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate pagination request data", err)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with AddressGroup endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	filter := cdbm.AddressGroupFilterInput{TenantOrgs: []string{org}}
+
+	qstID := c.QueryParam("siteId")
+	if qstID != "" {
+		stID, err := uuid.Parse(qstID)
+		if err != nil {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Site ID in query", nil)
+		}
+		filter.SiteIDs = []uuid.UUID{stID}
+	}
+
+	searchQueryStr := c.QueryParam("query")
+	if searchQueryStr != "" {
+		filter.SearchQuery = &searchQueryStr
+		gaagh.tracerSpan.SetAttribute(handlerSpan, attribute.String("query", searchQueryStr), logger)
+	}
+
+	statusQuery := c.QueryParam("status")
+	if statusQuery != "" {
+		if !cdbm.AddressGroupStatusMap[statusQuery] {
+			logger.Warn().Msg(fmt.Sprintf("invalid value in status query: %v", statusQuery))
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Status value in query", nil)
+		}
+		filter.Statuses = []string{statusQuery}
+	}
+
+	// Get and validate includeRelation params
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.AddressGroupRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	agDAO := cdbm.NewAddressGroupDAO(gaagh.dbSession)
+
+	ags, total, err := agDAO.GetAll(ctx, nil, filter, cdbp.PageInput{Offset: pageRequest.Offset, Limit: pageRequest.Limit, OrderBy: pageRequest.OrderBy}, qIncludeRelations)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving AddressGroups from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Address Groups", nil)
+	}
+
+	// Create response
+	apiAddressGroups := make([]*model.APIAddressGroup, 0, len(ags))
+	for i := range ags {
+		apiAddressGroups = append(apiAddressGroups, model.NewAPIAddressGroup(&ags[i]))
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, apiAddressGroups)
+}
+
+// ~~~~~ Get Handler ~~~~~ //
+
+// GetAddressGroupHandler is the API Handler for getting an AddressGroup
+type GetAddressGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAddressGroupHandler initializes and returns a new handler for getting an AddressGroup
+func NewGetAddressGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAddressGroupHandler {
+	return GetAddressGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get an AddressGroup
+// @Description Get an AddressGroup along with the propagation status to each NetworkSecurityGroup referencing it
+// @Tags AddressGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of AddressGroup"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Tenant', 'Site'"
+// @Success 200 {object} model.APIAddressGroup
+// @Router /v2/org/{org}/carbide/address-group/{id} [get]
+func (gagh GetAddressGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressGroup", "Get", c, gagh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with AddressGroup endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.AddressGroupRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	ag, apiErr := getAddressGroupForOrg(ctx, logger, gagh.dbSession, c.Param("id"), org, qIncludeRelations)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPIAddressGroup(ag))
+}
+
+// ~~~~~ Update Handler ~~~~~ //
+
+// UpdateAddressGroupHandler is the API Handler for updating an AddressGroup
+type UpdateAddressGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewUpdateAddressGroupHandler initializes and returns a new handler for updating an AddressGroup
+func NewUpdateAddressGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, scp *sc.ClientPool, cfg *config.Config) UpdateAddressGroupHandler {
+	return UpdateAddressGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Update an AddressGroup
+// @Description Update an AddressGroup. If the prefixes change, every NetworkSecurityGroup referencing the AddressGroup is updated on Site in parallel and the outcome for each is reported in the response. Sending the same prefixes again retries the propagation.
+// @Tags AddressGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of AddressGroup"
+// @Param message body model.APIAddressGroupUpdateRequest true "AddressGroup update request"
+// @Success 200 {object} model.APIAddressGroup
+// @Router /v2/org/{org}/carbide/address-group/{id} [patch]
+func (uagh UpdateAddressGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressGroup", "Update", c, uagh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with AddressGroup endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIAddressGroupUpdateRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating AddressGroup update request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Address Group update request data", verr)
+	}
+
+	ag, apiErr := getAddressGroupForOrg(ctx, logger, uagh.dbSession, c.Param("id"), org, nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	uagh.tracerSpan.SetAttribute(handlerSpan, attribute.String("address_group_id", ag.ID.String()), logger)
+
+	agDAO := cdbm.NewAddressGroupDAO(uagh.dbSession)
+
+	// If a name change is happening, check for name conflicts
+	if apiRequest.Name != nil && *apiRequest.Name != ag.Name {
+		ags, tot, err := agDAO.GetAll(ctx, nil, cdbm.AddressGroupFilterInput{Name: apiRequest.Name, TenantIDs: []uuid.UUID{ag.TenantID}, SiteIDs: []uuid.UUID{ag.SiteID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(1)}, nil)
+		if err != nil {
+			logger.Error().Err(err).Msg("error checking for existing AddressGroup")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to check for existing Address Group", nil)
+		}
+		if tot > 0 {
+			return cutil.NewAPIErrorResponse(c, http.StatusConflict, fmt.Sprintf("Address Group with name: %s for Site: %s already exists", *apiRequest.Name, ag.SiteID), validation.Errors{
+				"id": errors.New(ags[0].ID.String()),
+			})
+		}
+	}
+
+	// Resolve the Site rules of every NetworkSecurityGroup referencing the AddressGroup
+	// before anything is changed, so an update that would break one of them is rejected
+	var referencingNSGs []cdbm.NetworkSecurityGroup
+	siteRules := map[string][]*cwssaws.NetworkSecurityGroupRuleAttributes{}
+
+	if apiRequest.IsPrefixChange() {
+		referencingNSGs, err = getNetworkSecurityGroupsReferencingAddressGroup(ctx, nil, uagh.dbSession, ag)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving NetworkSecurityGroups referencing AddressGroup")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Network Security Groups referencing Address Group", nil)
+		}
+	}
+
+	if len(referencingNSGs) > 0 {
+		stDAO := cdbm.NewSiteDAO(uagh.dbSession)
+		site, err := stDAO.GetByID(ctx, nil, ag.SiteID, nil, false)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving Site from DB by ID")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "The Site of this Address Group could not be retrieved", nil)
+		}
+
+		updatedAG := *ag
+		updatedAG.Prefixes = apiRequest.Prefixes
+
+		for i := range referencingNSGs {
+			dbnsg := &referencingNSGs[i]
+
+			addressGroups, err := getAddressGroupsForRules(ctx, nil, uagh.dbSession, dbnsg.TenantID, &dbnsg.SiteID, dbnsg.Rules)
+			if err == nil {
+				addressGroups[ag.ID.String()] = &updatedAG
+				siteRules[dbnsg.ID], err = expandNetworkSecurityGroupRules(dbnsg.Rules, addressGroups, site.Config)
+			}
+			if err != nil {
+				if errors.Is(err, errInvalidNetworkSecurityGroupRules) {
+					logger.Warn().Err(err).Str("Network Security Group ID", dbnsg.ID).Msg("AddressGroup update would invalidate NetworkSecurityGroup rules")
+					return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Address Group update would invalidate rules of Network Security Group: %s", dbnsg.Name), validation.Errors{
+						"prefixes": errors.New(err.Error()),
+					})
+				}
+				logger.Error().Err(err).Msg("error resolving Site rules for NetworkSecurityGroup")
+				return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to resolve rules of Network Security Groups referencing Address Group", nil)
+			}
+		}
+	}
+
+	// Apply the update, recording every referencing NetworkSecurityGroup as pending so
+	// that a propagation cut short is visible and can be retried
+	var status *string
+	var pending []cdbm.AddressGroupPropagation
+	if len(referencingNSGs) > 0 {
+		status = cdb.GetStrPtr(cdbm.AddressGroupStatusPropagating)
+		pending = make([]cdbm.AddressGroupPropagation, 0, len(referencingNSGs))
+		for i := range referencingNSGs {
+			pending = append(pending, cdbm.AddressGroupPropagation{
+				NetworkSecurityGroupID:   referencingNSGs[i].ID,
+				NetworkSecurityGroupName: referencingNSGs[i].Name,
+				Status:                   cdbm.AddressGroupPropagationStatusPending,
+				Updated:                  time.Now().UTC(),
+			})
+		}
+	}
+
+	ag, err = agDAO.Update(ctx, nil, cdbm.AddressGroupUpdateInput{
+		AddressGroupID: ag.ID,
+		Name:           apiRequest.Name,
+		Description:    apiRequest.Description,
+		Prefixes:       apiRequest.Prefixes,
+		Labels:         apiRequest.Labels,
+		Status:         status,
+		Propagation:    pending,
+		UpdatedByID:    dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating AddressGroup in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Address Group, DB error", nil)
+	}
+
+	if len(referencingNSGs) > 0 {
+		propagation := propagateAddressGroup(ctx, logger, uagh.scp, ag.SiteID, referencingNSGs, siteRules)
+
+		status = cdb.GetStrPtr(cdbm.AddressGroupStatusReady)
+		for _, p := range propagation {
+			if p.Status != cdbm.AddressGroupPropagationStatusSynced {
+				status = cdb.GetStrPtr(cdbm.AddressGroupStatusError)
+			}
+		}
+
+		ag, err = agDAO.Update(ctx, nil, cdbm.AddressGroupUpdateInput{
+			AddressGroupID: ag.ID,
+			Status:         status,
+			Propagation:    propagation,
+			UpdatedByID:    dbUser.ID,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("error updating AddressGroup propagation status in DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Address Group propagation status, DB error", nil)
+		}
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPIAddressGroup(ag))
+}
+
+// ~~~~~ Delete Handler ~~~~~ //
+
+// DeleteAddressGroupHandler is the API Handler for deleting an AddressGroup
+type DeleteAddressGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDeleteAddressGroupHandler initializes and returns a new handler for deleting an AddressGroup
+func NewDeleteAddressGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) DeleteAddressGroupHandler {
+	return DeleteAddressGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Delete an AddressGroup
+// @Description Delete an AddressGroup. AddressGroups still referenced by NetworkSecurityGroup rules cannot be deleted.
+// @Tags AddressGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of AddressGroup"
+// @Success 202
+// @Router /v2/org/{org}/carbide/address-group/{id} [delete]
+func (dagh DeleteAddressGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressGroup", "Delete", c, dagh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with AddressGroup endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	ag, apiErr := getAddressGroupForOrg(ctx, logger, dagh.dbSession, c.Param("id"), org, nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	dagh.tracerSpan.SetAttribute(handlerSpan, attribute.String("address_group_id", ag.ID.String()), logger)
+
+	// Start a db tx so the reference check and the delete see the same state
+	tx, err := cdb.BeginTx(ctx, dagh.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Address Group, DB transaction error", nil)
+	}
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	nsgs, err := getNetworkSecurityGroupsReferencingAddressGroup(ctx, tx, dagh.dbSession, ag)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving NetworkSecurityGroups referencing AddressGroup")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Network Security Groups referencing Address Group", nil)
+	}
+
+	if len(nsgs) > 0 {
+		logger.Warn().Int("Count", len(nsgs)).Msg("AddressGroup is referenced by NetworkSecurityGroups, cannot delete")
+		return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, fmt.Sprintf("Address Group is referenced by %d Network Security Group(s) and cannot be deleted", len(nsgs)), validation.Errors{
+			"networkSecurityGroupId": errors.New(nsgs[0].ID),
+		})
+	}
+
+	agDAO := cdbm.NewAddressGroupDAO(dagh.dbSession)
+	err = agDAO.Delete(ctx, tx, cdbm.AddressGroupDeleteInput{AddressGroupID: ag.ID, UpdatedByID: dbUser.ID})
+	if err != nil {
+		logger.Error().Err(err).Msg("error deleting AddressGroup from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Address Group, DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing AddressGroup delete transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Address Group, DB transaction error", nil)
+	}
+	txCommitted = true
+
+	logger.Info().Msg("finishing API handler")
+	return c.String(http.StatusAccepted, "Deletion request was accepted")
+}
+
+// addressGroupPropagationConcurrency is the maximum number of NetworkSecurityGroups updated on Site at the same time
+const addressGroupPropagationConcurrency = 8
+
+// getAddressGroupForOrg retrieves an AddressGroup by ID and ensures it belongs to the org
+func getAddressGroupForOrg(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, agIDStr string, org string, includeRelations []string) (*cdbm.AddressGroup, *cutil.APIError) {
+	agID, err := uuid.Parse(agIDStr)
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Address Group ID in URL", nil)
+	}
+
+	agDAO := cdbm.NewAddressGroupDAO(dbSession)
+	ag, err := agDAO.GetByID(ctx, nil, agID, includeRelations)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Address Group with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving AddressGroup from DB by ID")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Address Group with specified ID", nil)
+	}
+
+	if ag.TenantOrg != org {
+		logger.Warn().Msg("org specified in request does not match org of Tenant associated with AddressGroup")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Org specified in request does not match org of Tenant associated with Address Group", nil)
+	}
+
+	return ag, nil
+}
+
+// getNetworkSecurityGroupsReferencingAddressGroup returns the NetworkSecurityGroups with at least one rule referencing the AddressGroup
+func getNetworkSecurityGroupsReferencingAddressGroup(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, ag *cdbm.AddressGroup) ([]cdbm.NetworkSecurityGroup, error) {
+	nsgDAO := cdbm.NewNetworkSecurityGroupDAO(dbSession)
+	nsgs, _, err := nsgDAO.GetAll(ctx, tx, cdbm.NetworkSecurityGroupFilterInput{TenantIDs: []uuid.UUID{ag.TenantID}, SiteIDs: []uuid.UUID{ag.SiteID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	referencing := []cdbm.NetworkSecurityGroup{}
+	for _, dbnsg := range nsgs {
+		if dbnsg.ReferencesAddressGroup(ag.ID.String()) {
+			referencing = append(referencing, dbnsg)
+		}
+	}
+
+	return referencing, nil
+}
+
+// propagateAddressGroup updates the NetworkSecurityGroups on Site in parallel with their resolved rules and reports the
+// outcome for each. All updates share a single deadline so a widely referenced AddressGroup cannot hold the request past
+// its timeout; NetworkSecurityGroups not updated in time are reported as failed.
+func propagateAddressGroup(ctx context.Context, logger zerolog.Logger, scp *sc.ClientPool, siteID uuid.UUID, nsgs []cdbm.NetworkSecurityGroup, siteRules map[string][]*cwssaws.NetworkSecurityGroupRuleAttributes) []cdbm.AddressGroupPropagation {
+	propagation := make([]cdbm.AddressGroupPropagation, len(nsgs))
+
+	stc, stcErr := scp.GetClientByID(siteID)
+	if stcErr != nil {
+		logger.Error().Err(stcErr).Msg("failed to retrieve Temporal client for Site")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cutil.WorkflowContextTimeout)
+	defer cancel()
+
+	sem := make(chan struct{}, addressGroupPropagationConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range nsgs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			dbnsg := &nsgs[i]
+
+			p := cdbm.AddressGroupPropagation{
+				NetworkSecurityGroupID:   dbnsg.ID,
+				NetworkSecurityGroupName: dbnsg.Name,
+				Status:                   cdbm.AddressGroupPropagationStatusSynced,
+			}
+
+			err := stcErr
+			if err == nil {
+				select {
+				case sem <- struct{}{}:
+					err = updateNetworkSecurityGroupOnSite(ctx, logger, stc, newUpdateNetworkSecurityGroupRequest(dbnsg, siteRules[dbnsg.ID]))
+					<-sem
+				case <-ctx.Done():
+					err = ctx.Err()
+				}
+			}
+			if err != nil {
+				p.Status = cdbm.AddressGroupPropagationStatusError
+				p.Message = cdb.GetStrPtr(fmt.Sprintf("Failed to update Network Security Group on Site: %s", err))
+			}
+
+			p.Updated = time.Now().UTC()
+			propagation[i] = p
+		}(i)
+	}
+
+	wg.Wait()
+
+	return propagation
+}
+
+// updateNetworkSecurityGroupOnSite synchronously executes the Site workflow to update a NetworkSecurityGroup
+func updateNetworkSecurityGroupOnSite(ctx context.Context, logger zerolog.Logger, stc temporalClient.Client, request *cwssaws.UpdateNetworkSecurityGroupRequest) error {
+	workflowOptions := temporalClient.StartWorkflowOptions{
+		ID:                       "network-security-group-update-" + request.Id,
+		TaskQueue:                queue.SiteTaskQueue,
+		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
+	}
+
+	// Add context deadlines
+	ctx, cancel := context.WithTimeout(ctx, cutil.WorkflowContextTimeout)
+	defer cancel()
+
+	we, err := stc.ExecuteWorkflow(ctx, workflowOptions, "UpdateNetworkSecurityGroup", request)
+	if err != nil {
+		return err
+	}
+
+	wid := we.GetID()
+	logger.Info().Str("Workflow ID", wid).Msg("executed synchronous update NetworkSecurityGroup workflow")
+
+	err = we.Get(ctx, nil)
+	if err != nil {
+		var timeoutErr *tp.TimeoutError
+		if errors.As(err, &timeoutErr) || err == context.DeadlineExceeded || ctx.Err() != nil {
+			// Terminate the workflow so it does not apply stale rules later
+			newctx, newcancel := context.WithTimeout(context.Background(), cutil.WorkflowContextNewAfterTimeout)
+			defer newcancel()
+
+			serr := stc.TerminateWorkflow(newctx, wid, "", "timeout occurred executing UpdateNetworkSecurityGroup workflow for AddressGroup")
+			if serr != nil {
+				logger.Error().Err(serr).Msg("failed to terminate UpdateNetworkSecurityGroup workflow after timeout")
+			}
+			return fmt.Errorf("timeout occurred executing workflow on Site: %w", err)
+		}
+
+		_, err = common.UnwrapWorkflowError(err)
+		logger.Error().Err(err).Str("Workflow ID", wid).Msg("failed to synchronously execute Temporal workflow to update NetworkSecurityGroup")
+		return err
+	}
+
+	logger.Info().Str("Workflow ID", wid).Msg("completed synchronous update NetworkSecurityGroup workflow")
+
+	return nil
+}
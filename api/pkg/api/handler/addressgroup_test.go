@@ -0,0 +1,760 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	tmocks "go.temporal.io/sdk/mocks"
+	tp "go.temporal.io/sdk/temporal"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/otelecho"
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func testAddressGroupSetupSchema(t *testing.T, dbSession *cdb.Session) {
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+	// create Address Group table
+	err := dbSession.DB.ResetModel(context.Background(), (*cdbm.AddressGroup)(nil))
+	assert.Nil(t, err)
+}
+
+func testBuildAddressGroup(t *testing.T, dbSession *cdb.Session, name string, tenant *cdbm.Tenant, site *cdbm.Site, prefixes []string) *cdbm.AddressGroup {
+	ag := &cdbm.AddressGroup{
+		ID:        uuid.New(),
+		Name:      name,
+		SiteID:    site.ID,
+		TenantID:  tenant.ID,
+		TenantOrg: tenant.Org,
+		Prefixes:  prefixes,
+		Status:    cdbm.AddressGroupStatusReady,
+	}
+	_, err := dbSession.DB.NewInsert().Model(ag).Exec(context.Background())
+	assert.Nil(t, err)
+	return ag
+}
+
+// testBuildNetworkSecurityGroupReferencingAddressGroup builds a NetworkSecurityGroup with a single rule sourcing traffic from the AddressGroup
+func testBuildNetworkSecurityGroupReferencingAddressGroup(t *testing.T, dbSession *cdb.Session, name string, tenant *cdbm.Tenant, site *cdbm.Site, ag *cdbm.AddressGroup) *cdbm.NetworkSecurityGroup {
+	nsg := testBuildNetworkSecurityGroup(t, dbSession, name, tenant, site, cdbm.NetworkSecurityGroupStatusReady)
+	nsg.Rules = []*cdbm.NetworkSecurityGroupRule{
+		{
+			NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+				Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+				Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+				Action:         cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT,
+				Priority:       10,
+				DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+			},
+			SourceAddressGroupID: cdb.GetStrPtr(ag.ID.String()),
+		},
+	}
+	return testUpdateNetworkSecurityGroup(t, dbSession, nsg)
+}
+
+func TestAddressGroupHandler_Create(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testAddressGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+	st2 := testInstanceBuildSite(t, dbSession, ip, "test-site-2", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant", tnOrg, tnu1)
+
+	ts1 := testBuildTenantSiteAssociation(t, dbSession, tnOrg, tn1.ID, st1.ID, tnu1.ID)
+	assert.NotNil(t, ts1)
+
+	ag1 := testBuildAddressGroup(t, dbSession, "existing", tn1, st1, []string{"10.0.0.0/24"})
+	assert.NotNil(t, ag1)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		user             *cdbm.User
+		requestPayload   *model.APIAddressGroupCreateRequest
+		wantResponseCode int
+	}{
+		{
+			name: "Create - success",
+			user: tnu1,
+			requestPayload: &model.APIAddressGroupCreateRequest{
+				Name:        "web-servers",
+				Description: cdb.GetStrPtr("Web servers"),
+				SiteID:      st1.ID.String(),
+				Prefixes:    []string{"10.1.0.0/24", "10.1.1.0/24"},
+				Labels:      map[string]string{"tier": "web"},
+			},
+			wantResponseCode: http.StatusCreated,
+		},
+		{
+			name: "Create with duplicate name - fail",
+			user: tnu1,
+			requestPayload: &model.APIAddressGroupCreateRequest{
+				Name:     ag1.Name,
+				SiteID:   st1.ID.String(),
+				Prefixes: []string{"10.1.0.0/24"},
+			},
+			wantResponseCode: http.StatusConflict,
+		},
+		{
+			name: "Create with invalid prefix - fail",
+			user: tnu1,
+			requestPayload: &model.APIAddressGroupCreateRequest{
+				Name:     "bad-prefix",
+				SiteID:   st1.ID.String(),
+				Prefixes: []string{"10.1.0.0/33"},
+			},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name: "Create for Site without Tenant access - fail",
+			user: tnu1,
+			requestPayload: &model.APIAddressGroupCreateRequest{
+				Name:     "no-access",
+				SiteID:   st2.ID.String(),
+				Prefixes: []string{"10.1.0.0/24"},
+			},
+			wantResponseCode: http.StatusForbidden,
+		},
+		{
+			name: "Create by Provider - fail",
+			user: ipu,
+			requestPayload: &model.APIAddressGroupCreateRequest{
+				Name:     "provider",
+				SiteID:   st1.ID.String(),
+				Prefixes: []string{"10.1.0.0/24"},
+			},
+			wantResponseCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cagh := NewCreateAddressGroupHandler(dbSession, tc, cfg)
+
+			jsonData, _ := json.Marshal(test.requestPayload)
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			org := tnOrg
+			if test.user == ipu {
+				org = ipOrg
+			}
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/address-group", org))
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(org)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := cagh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("CreateAddressGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusCreated {
+				return
+			}
+
+			rst := &model.APIAddressGroup{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.requestPayload.Name, rst.Name)
+			assert.Equal(t, test.requestPayload.Prefixes, rst.Prefixes)
+			assert.Equal(t, test.requestPayload.Labels, rst.Labels)
+			assert.Equal(t, tn1.ID.String(), rst.TenantID)
+			assert.Equal(t, cdbm.AddressGroupStatusReady, rst.Status)
+		})
+	}
+}
+
+func TestAddressGroupHandler_GetAll(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testAddressGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+	st2 := testInstanceBuildSite(t, dbSession, ip, "test-site-2", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-3", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	for i := 0; i < 3; i++ {
+		testBuildAddressGroup(t, dbSession, fmt.Sprintf("site1-group-%d", i), tn1, st1, []string{fmt.Sprintf("10.%d.0.0/16", i)})
+	}
+	testBuildAddressGroup(t, dbSession, "site2-group", tn1, st2, []string{"192.168.0.0/16"})
+	testBuildAddressGroup(t, dbSession, "other-tenant-group", tn2, st1, []string{"172.16.0.0/12"})
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		user             *cdbm.User
+		org              string
+		query            string
+		wantResponseCode int
+		wantCount        int
+	}{
+		{
+			name:             "GetAll - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			wantResponseCode: http.StatusOK,
+			wantCount:        4,
+		},
+		{
+			name:             "GetAll filtered by Site - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			query:            "siteId=" + st1.ID.String(),
+			wantResponseCode: http.StatusOK,
+			wantCount:        3,
+		},
+		{
+			name:             "GetAll with search query - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			query:            "query=192.168",
+			wantResponseCode: http.StatusOK,
+			wantCount:        1,
+		},
+		{
+			name:             "GetAll with invalid status - fail",
+			user:             tnu1,
+			org:              tnOrg1,
+			query:            "status=Bogus",
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "GetAll for other Tenant - success",
+			user:             tnu2,
+			org:              tnOrg2,
+			wantResponseCode: http.StatusOK,
+			wantCount:        1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gaagh := NewGetAllAddressGroupHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodGet, "/?"+test.query, nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/address-group", test.org))
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(test.org)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := gaagh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetAllAddressGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := []model.APIAddressGroup{}
+			err = json.Unmarshal(rec.Body.Bytes(), &rst)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.wantCount, len(rst))
+		})
+	}
+}
+
+func TestAddressGroupHandler_Get(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testAddressGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-3", tnOrg2, tnOrgRoles)
+	testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	ag1 := testBuildAddressGroup(t, dbSession, "group-1", tn1, st1, []string{"10.0.0.0/16"})
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		user             *cdbm.User
+		org              string
+		id               string
+		query            string
+		wantResponseCode int
+	}{
+		{
+			name:             "Get - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			id:               ag1.ID.String(),
+			wantResponseCode: http.StatusOK,
+		},
+		{
+			name:             "Get with Site relation - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			id:               ag1.ID.String(),
+			query:            "includeRelation=Site",
+			wantResponseCode: http.StatusOK,
+		},
+		{
+			name:             "Get non-existent - fail",
+			user:             tnu1,
+			org:              tnOrg1,
+			id:               uuid.NewString(),
+			wantResponseCode: http.StatusNotFound,
+		},
+		{
+			name:             "Get invalid ID - fail",
+			user:             tnu1,
+			org:              tnOrg1,
+			id:               "not-a-uuid",
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Get by other Tenant - fail",
+			user:             tnu2,
+			org:              tnOrg2,
+			id:               ag1.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gagh := NewGetAddressGroupHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodGet, "/?"+test.query, nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/address-group/%s", test.org, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(test.org, test.id)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := gagh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetAddressGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIAddressGroup{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+
+			assert.Equal(t, ag1.ID.String(), rst.ID)
+			assert.Equal(t, ag1.Prefixes, rst.Prefixes)
+			assert.Equal(t, test.query != "", rst.Site != nil)
+		})
+	}
+}
+
+func TestAddressGroupHandler_Update(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testAddressGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+	st1.Config = &cdbm.SiteConfig{NetworkSecurityGroup: true, MaxNetworkSecurityGroupRuleCount: cdb.GetIntPtr(4)}
+	_ = testUpdateSite(t, dbSession, st1)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant", tnOrg, tnu1)
+
+	ts1 := testBuildTenantSiteAssociation(t, dbSession, tnOrg, tn1.ID, st1.ID, tnu1.ID)
+	assert.NotNil(t, ts1)
+
+	ag1 := testBuildAddressGroup(t, dbSession, "referenced", tn1, st1, []string{"10.0.0.0/24"})
+	ag2 := testBuildAddressGroup(t, dbSession, "unreferenced", tn1, st1, []string{"10.1.0.0/24"})
+	ag3 := testBuildAddressGroup(t, dbSession, "referenced-failing", tn1, st1, []string{"10.2.0.0/24"})
+
+	nsg1 := testBuildNetworkSecurityGroupReferencingAddressGroup(t, dbSession, "nsg-1", tn1, st1, ag1)
+	nsg2 := testBuildNetworkSecurityGroupReferencingAddressGroup(t, dbSession, "nsg-2", tn1, st1, ag1)
+	nsg3 := testBuildNetworkSecurityGroupReferencingAddressGroup(t, dbSession, "nsg-3", tn1, st1, ag3)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tcfg, _ := cfg.GetTemporalConfig()
+
+	// Site client where every NetworkSecurityGroup update succeeds
+	scp := sc.NewClientPool(tcfg)
+	tsc := &tmocks.Client{}
+	scp.IDClientMap[st1.ID.String()] = tsc
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	wrun.Mock.On("Get", mock.Anything, mock.Anything).Return(nil)
+
+	tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"UpdateNetworkSecurityGroup", mock.Anything).Return(wrun, nil)
+
+	// Site client where every NetworkSecurityGroup update times out
+	scpWithTimeout := sc.NewClientPool(tcfg)
+	tscWithTimeout := &tmocks.Client{}
+	scpWithTimeout.IDClientMap[st1.ID.String()] = tscWithTimeout
+
+	wrunTimeout := &tmocks.WorkflowRun{}
+	wrunTimeout.On("GetID").Return("workflow-with-timeout")
+	wrunTimeout.Mock.On("Get", mock.Anything, mock.Anything).Return(tp.NewTimeoutError(enums.TIMEOUT_TYPE_UNSPECIFIED, nil, nil))
+
+	tscWithTimeout.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"UpdateNetworkSecurityGroup", mock.Anything).Return(wrunTimeout, nil)
+	tscWithTimeout.Mock.On("TerminateWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	tests := []struct {
+		name              string
+		id                string
+		clientPool        *sc.ClientPool
+		requestPayload    *model.APIAddressGroupUpdateRequest
+		wantResponseCode  int
+		wantStatus        string
+		wantPropagation   map[string]string
+		wantWorkflowCalls int
+	}{
+		{
+			name:       "Update name of unreferenced group - success",
+			id:         ag2.ID.String(),
+			clientPool: scp,
+			requestPayload: &model.APIAddressGroupUpdateRequest{
+				Name: cdb.GetStrPtr("renamed"),
+			},
+			wantResponseCode: http.StatusOK,
+			wantStatus:       cdbm.AddressGroupStatusReady,
+			wantPropagation:  map[string]string{},
+		},
+		{
+			name:       "Update with duplicate name - fail",
+			id:         ag2.ID.String(),
+			clientPool: scp,
+			requestPayload: &model.APIAddressGroupUpdateRequest{
+				Name: cdb.GetStrPtr(ag1.Name),
+			},
+			wantResponseCode: http.StatusConflict,
+		},
+		{
+			name:       "Update with empty prefixes - fail",
+			id:         ag1.ID.String(),
+			clientPool: scp,
+			requestPayload: &model.APIAddressGroupUpdateRequest{
+				Prefixes: []string{},
+			},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:       "Update prefixes beyond Site rule limit of referencing group - fail",
+			id:         ag1.ID.String(),
+			clientPool: scp,
+			requestPayload: &model.APIAddressGroupUpdateRequest{
+				Prefixes: []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24", "10.0.3.0/24", "10.0.4.0/24"},
+			},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:       "Update prefixes of referenced group - success",
+			id:         ag1.ID.String(),
+			clientPool: scp,
+			requestPayload: &model.APIAddressGroupUpdateRequest{
+				Prefixes: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			},
+			wantResponseCode: http.StatusOK,
+			wantStatus:       cdbm.AddressGroupStatusReady,
+			wantPropagation: map[string]string{
+				nsg1.ID: cdbm.AddressGroupPropagationStatusSynced,
+				nsg2.ID: cdbm.AddressGroupPropagationStatusSynced,
+			},
+			wantWorkflowCalls: 2,
+		},
+		{
+			name:       "Update prefixes of referenced group with Site timeout - reported",
+			id:         ag3.ID.String(),
+			clientPool: scpWithTimeout,
+			requestPayload: &model.APIAddressGroupUpdateRequest{
+				Prefixes: []string{"10.2.1.0/24"},
+			},
+			wantResponseCode: http.StatusOK,
+			wantStatus:       cdbm.AddressGroupStatusError,
+			wantPropagation: map[string]string{
+				nsg3.ID: cdbm.AddressGroupPropagationStatusError,
+			},
+			wantWorkflowCalls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uagh := NewUpdateAddressGroupHandler(dbSession, tc, test.clientPool, cfg)
+
+			jsonData, _ := json.Marshal(test.requestPayload)
+
+			req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/address-group/%s", tnOrg, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			tsc.Calls = nil
+			tscWithTimeout.Calls = nil
+
+			err := uagh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("UpdateAddressGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIAddressGroup{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+
+			if test.requestPayload.Name != nil {
+				assert.Equal(t, *test.requestPayload.Name, rst.Name)
+			}
+			if test.requestPayload.Prefixes != nil {
+				assert.Equal(t, test.requestPayload.Prefixes, rst.Prefixes)
+			}
+
+			assert.Equal(t, test.wantStatus, rst.Status)
+			assert.Equal(t, len(test.wantPropagation), len(rst.Propagation))
+			for _, p := range rst.Propagation {
+				assert.Equal(t, test.wantPropagation[p.NetworkSecurityGroupID], p.Status)
+				assert.Equal(t, p.Status == cdbm.AddressGroupPropagationStatusError, p.Message != nil)
+			}
+
+			test.clientPool.IDClientMap[st1.ID.String()].(*tmocks.Client).AssertNumberOfCalls(t, "ExecuteWorkflow", test.wantWorkflowCalls)
+		})
+	}
+}
+
+func TestAddressGroupHandler_Delete(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testAddressGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant", tnOrg, tnu1)
+
+	ag1 := testBuildAddressGroup(t, dbSession, "referenced", tn1, st1, []string{"10.0.0.0/24"})
+	ag2 := testBuildAddressGroup(t, dbSession, "unreferenced", tn1, st1, []string{"10.1.0.0/24"})
+
+	testBuildNetworkSecurityGroupReferencingAddressGroup(t, dbSession, "nsg-1", tn1, st1, ag1)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		id               string
+		wantResponseCode int
+	}{
+		{
+			name:             "Delete referenced group - fail",
+			id:               ag1.ID.String(),
+			wantResponseCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:             "Delete unreferenced group - success",
+			id:               ag2.ID.String(),
+			wantResponseCode: http.StatusAccepted,
+		},
+		{
+			name:             "Delete non-existent group - fail",
+			id:               uuid.NewString(),
+			wantResponseCode: http.StatusNotFound,
+		},
+	}
+
+	agDAO := cdbm.NewAddressGroupDAO(dbSession)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dagh := NewDeleteAddressGroupHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/address-group/%s", tnOrg, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := dagh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("DeleteAddressGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if test.wantResponseCode == http.StatusNotFound {
+				return
+			}
+
+			_, err = agDAO.GetByID(ctx, nil, uuid.MustParse(test.id), nil)
+			if test.wantResponseCode == http.StatusAccepted {
+				assert.Equal(t, cdb.ErrDoesNotExist, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,1105 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/internal/config"
+	common "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/pagination"
+	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	auth "github.com/NVIDIA/ncx-infra-controller-rest/auth/pkg/authorization"
+	cutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/ipam"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbp "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/queue"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+	tp "go.temporal.io/sdk/temporal"
+)
+
+// ~~~~~ Create Handler ~~~~~ //
+
+// CreateFloatingIPHandler is the API Handler for allocating a new FloatingIP
+type CreateFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateFloatingIPHandler initializes and returns a new handler for allocating a FloatingIP
+func NewCreateFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) CreateFloatingIPHandler {
+	return CreateFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Create a FloatingIP
+// @Description Allocate a FloatingIP address from an IPv4 IP Block the Tenant has been allocated
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APIFloatingIPCreateRequest true "FloatingIP creation request"
+// @Success 201 {object} model.APIFloatingIP
+// @Router /v2/org/{org}/carbide/floating-ip [post]
+func (cfih CreateFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "Create", c, cfih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIFloatingIPCreateRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating FloatingIP creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Floating IP creation request data", verr)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, cfih.dbSession, org)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error getting tenant from org")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error retrieving Tenant from org", nil)
+	}
+
+	// Verify IP Block in request, it must have been derived for the Tenant via an allocation
+	ipBlock, err := common.GetIPBlockFromIDString(ctx, nil, apiRequest.IPBlockID, cfih.dbSession)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error getting IP Block in request")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Could not find IP Block specified in request", nil)
+	}
+	if ipBlock.TenantID == nil || *ipBlock.TenantID != tenant.ID {
+		logger.Warn().Msg("IP Block in request does not belong to tenant")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "IP Block specified in request does not belong to Tenant", nil)
+	}
+	if ipBlock.ProtocolVersion != cdbm.IPBlockProtocolVersionV4 {
+		logger.Warn().Msg("IP Block in request is not an IPv4 block")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "IP Block specified in request must be an IPv4 block", nil)
+	}
+
+	// Verify Site is ready
+	stDAO := cdbm.NewSiteDAO(cfih.dbSession)
+	site, err := stDAO.GetByID(ctx, nil, ipBlock.SiteID, nil, false)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Could not find Site associated with IP Block", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Site from DB by ID")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Site of IP Block", nil)
+	}
+	if site.Status != cdbm.SiteStatusRegistered {
+		logger.Warn().Msg("Site of IP Block is not in Registered state")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Site of IP Block specified in request must be in Registered state in order to allocate Floating IP", nil)
+	}
+
+	cfih.tracerSpan.SetAttribute(handlerSpan, attribute.String("site_id", site.ID.String()), logger)
+
+	// Check for name uniqueness for the Tenant at the Site
+	fipDAO := cdbm.NewFloatingIPDAO(cfih.dbSession)
+	fips, tot, err := fipDAO.GetAll(ctx, nil, cdbm.FloatingIPFilterInput{Name: &apiRequest.Name, SiteIDs: []uuid.UUID{site.ID}, TenantIDs: []uuid.UUID{tenant.ID}}, cdbp.PageInput{}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("db error checking for name uniqueness of FloatingIP")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Floating IP due to DB error", nil)
+	}
+	if tot > 0 {
+		logger.Warn().Str("tenantId", tenant.ID.String()).Str("name", apiRequest.Name).Msg("FloatingIP with same name already exists for tenant at Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "A Floating IP with specified name already exists for Tenant at this Site", validation.Errors{
+			"id": errors.New(fips[0].ID.String()),
+		})
+	}
+
+	// Start a db tx
+	tx, err := cdb.BeginTx(ctx, cfih.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Floating IP, DB transaction error", nil)
+	}
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	// acquire an advisory lock on the IP block on which there could be contention
+	// this lock is released when the transaction commits or rollsback
+	err = tx.TryAcquireAdvisoryLock(ctx, cdb.GetAdvisoryLockIDFromString(fmt.Sprintf("%s-%s", tenant.ID.String(), ipBlock.ID.String())), nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to acquire advisory lock on IP Block")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Floating IP, detected multiple parallel request on IP Block by Tenant", nil)
+	}
+
+	// allocate a host prefix for the address in ipam
+	ipamStorage := ipam.NewIpamStorage(cfih.dbSession.DB, tx.GetBunTx())
+	hostPrefix, err := ipam.CreateChildIpamEntryForIPBlock(ctx, tx, cfih.dbSession, ipamStorage, ipBlock, 32)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to create IPAM entry for FloatingIP")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Could not allocate address for Floating IP from IP Block. Details: %s", err.Error()), nil)
+	}
+
+	ipAddress, _, err := net.ParseCIDR(hostPrefix.Cidr)
+	if err != nil {
+		logger.Error().Err(err).Str("cidr", hostPrefix.Cidr).Msg("IPAM returned an invalid CIDR for FloatingIP")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to allocate address for Floating IP", nil)
+	}
+
+	fip, err := fipDAO.Create(ctx, tx, cdbm.FloatingIPCreateInput{
+		Name:        apiRequest.Name,
+		Description: apiRequest.Description,
+		SiteID:      site.ID,
+		TenantID:    tenant.ID,
+		TenantOrg:   org,
+		IPBlockID:   ipBlock.ID,
+		IPAddress:   ipAddress.String(),
+		Status:      cdbm.FloatingIPStatusAvailable,
+		CreatedByID: dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error creating FloatingIP record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Floating IP, DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing FloatingIP transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Floating IP, DB transaction error", nil)
+	}
+	txCommitted = true
+
+	logger.Info().Str("ipAddress", fip.IPAddress).Msg("finishing API handler")
+	return c.JSON(http.StatusCreated, model.NewAPIFloatingIP(fip))
+}
+
+// ~~~~~ GetAll Handler ~~~~~ //
+
+// GetAllFloatingIPHandler is the API Handler for getting all FloatingIPs
+type GetAllFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllFloatingIPHandler initializes and returns a new handler for getting all FloatingIPs
+func NewGetAllFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAllFloatingIPHandler {
+	return GetAllFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all FloatingIPs
+// @Description Get all FloatingIPs of the Tenant, optionally filtered by Site, IP Block or Instance
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteId query string false "ID of Site"
+// @Param ipBlockId query string false "ID of IP Block"
+// @Param instanceId query string false "ID of Instance"
+// @Param status query string false "Query input for status"
+// @Param query query string false "Query input for full text search"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Site', 'IPBlock', 'Instance'"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {object} []model.APIFloatingIP
+// @Router /v2/org/{org}/carbide/floating-ip [get]
+func (gafih GetAllFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "GetAll", c, gafih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err = c.Bind(&pageRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	err = pageRequest.Validate(cdbm.FloatingIPOrderByFields)
+	if err != nil {
+		logger.Error().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate pagination request data", err)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	filter := cdbm.FloatingIPFilterInput{TenantOrgs: []string{org}}
+
+	qstID := c.QueryParam("siteId")
+	if qstID != "" {
+		stID, err := uuid.Parse(qstID)
+		if err != nil {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Site ID in query", nil)
+		}
+		filter.SiteIDs = []uuid.UUID{stID}
+	}
+
+	qipbID := c.QueryParam("ipBlockId")
+	if qipbID != "" {
+		ipbID, err := uuid.Parse(qipbID)
+		if err != nil {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid IP Block ID in query", nil)
+		}
+		filter.IPBlockIDs = []uuid.UUID{ipbID}
+	}
+
+	qinstID := c.QueryParam("instanceId")
+	if qinstID != "" {
+		instID, err := uuid.Parse(qinstID)
+		if err != nil {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Instance ID in query", nil)
+		}
+		filter.InstanceIDs = []uuid.UUID{instID}
+	}
+
+	searchQueryStr := c.QueryParam("query")
+	if searchQueryStr != "" {
+		filter.SearchQuery = &searchQueryStr
+		gafih.tracerSpan.SetAttribute(handlerSpan, attribute.String("query", searchQueryStr), logger)
+	}
+
+	statusQuery := c.QueryParam("status")
+	if statusQuery != "" {
+		if !cdbm.FloatingIPStatusMap[statusQuery] {
+			logger.Warn().Msg(fmt.Sprintf("invalid value in status query: %v", statusQuery))
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Status value in query", nil)
+		}
+		filter.Statuses = []string{statusQuery}
+	}
+
+	// Get and validate includeRelation params
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.FloatingIPRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	fipDAO := cdbm.NewFloatingIPDAO(gafih.dbSession)
+
+	fips, total, err := fipDAO.GetAll(ctx, nil, filter, cdbp.PageInput{Offset: pageRequest.Offset, Limit: pageRequest.Limit, OrderBy: pageRequest.OrderBy}, qIncludeRelations)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving FloatingIPs from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Floating IPs", nil)
+	}
+
+	// Create response
+	apiFloatingIPs := make([]*model.APIFloatingIP, 0, len(fips))
+	for i := range fips {
+		apiFloatingIPs = append(apiFloatingIPs, model.NewAPIFloatingIP(&fips[i]))
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, apiFloatingIPs)
+}
+
+// ~~~~~ Get Handler ~~~~~ //
+
+// GetFloatingIPHandler is the API Handler for getting a FloatingIP
+type GetFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetFloatingIPHandler initializes and returns a new handler for getting a FloatingIP
+func NewGetFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetFloatingIPHandler {
+	return GetFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get a FloatingIP
+// @Description Get a FloatingIP by ID
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of FloatingIP"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Site', 'IPBlock', 'Instance'"
+// @Success 200 {object} model.APIFloatingIP
+// @Router /v2/org/{org}/carbide/floating-ip/{id} [get]
+func (gfih GetFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "Get", c, gfih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.FloatingIPRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	fip, apiErr := getFloatingIPForOrg(ctx, logger, gfih.dbSession, c.Param("id"), org, qIncludeRelations)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPIFloatingIP(fip))
+}
+
+// ~~~~~ Update Handler ~~~~~ //
+
+// UpdateFloatingIPHandler is the API Handler for updating a FloatingIP
+type UpdateFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewUpdateFloatingIPHandler initializes and returns a new handler for updating a FloatingIP
+func NewUpdateFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) UpdateFloatingIPHandler {
+	return UpdateFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Update a FloatingIP
+// @Description Update the name or description of a FloatingIP
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of FloatingIP"
+// @Param message body model.APIFloatingIPUpdateRequest true "FloatingIP update request"
+// @Success 200 {object} model.APIFloatingIP
+// @Router /v2/org/{org}/carbide/floating-ip/{id} [patch]
+func (ufih UpdateFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "Update", c, ufih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIFloatingIPUpdateRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating FloatingIP update request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Floating IP update request data", verr)
+	}
+
+	fip, apiErr := getFloatingIPForOrg(ctx, logger, ufih.dbSession, c.Param("id"), org, nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	ufih.tracerSpan.SetAttribute(handlerSpan, attribute.String("floating_ip_id", fip.ID.String()), logger)
+
+	fipDAO := cdbm.NewFloatingIPDAO(ufih.dbSession)
+
+	// Check for name uniqueness for the Tenant at the Site
+	if apiRequest.Name != nil && *apiRequest.Name != fip.Name {
+		fips, tot, err := fipDAO.GetAll(ctx, nil, cdbm.FloatingIPFilterInput{Name: apiRequest.Name, SiteIDs: []uuid.UUID{fip.SiteID}, TenantIDs: []uuid.UUID{fip.TenantID}}, cdbp.PageInput{}, nil)
+		if err != nil {
+			logger.Error().Err(err).Msg("db error checking for name uniqueness of FloatingIP")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Floating IP due to DB error", nil)
+		}
+		if tot > 0 {
+			return cutil.NewAPIErrorResponse(c, http.StatusConflict, "A Floating IP with specified name already exists for Tenant at this Site", validation.Errors{
+				"id": errors.New(fips[0].ID.String()),
+			})
+		}
+	}
+
+	fip, err = fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+		FloatingIPID: fip.ID,
+		Name:         apiRequest.Name,
+		Description:  apiRequest.Description,
+		UpdatedByID:  dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating FloatingIP in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Floating IP, DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPIFloatingIP(fip))
+}
+
+// ~~~~~ Delete Handler ~~~~~ //
+
+// DeleteFloatingIPHandler is the API Handler for releasing a FloatingIP
+type DeleteFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDeleteFloatingIPHandler initializes and returns a new handler for releasing a FloatingIP
+func NewDeleteFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) DeleteFloatingIPHandler {
+	return DeleteFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Delete a FloatingIP
+// @Description Release a FloatingIP address back to its IP Block. FloatingIP must be disassociated first.
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of FloatingIP"
+// @Success 202
+// @Router /v2/org/{org}/carbide/floating-ip/{id} [delete]
+func (dfih DeleteFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "Delete", c, dfih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	fip, apiErr := getFloatingIPForOrg(ctx, logger, dfih.dbSession, c.Param("id"), org, []string{cdbm.IPBlockRelationName})
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	dfih.tracerSpan.SetAttribute(handlerSpan, attribute.String("floating_ip_id", fip.ID.String()), logger)
+
+	if fip.IsAssociated() {
+		logger.Warn().Msg("FloatingIP is associated with an Instance Interface, cannot delete")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Floating IP is associated with an Instance Interface, it must be disassociated before it can be deleted", nil)
+	}
+
+	if fip.IPBlock == nil {
+		logger.Error().Msg("IP Block of FloatingIP could not be retrieved")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve IP Block of Floating IP", nil)
+	}
+
+	// Start a db tx so the address is only released if the FloatingIP is deleted
+	tx, err := cdb.BeginTx(ctx, dfih.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Floating IP, DB transaction error", nil)
+	}
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	err = tx.TryAcquireAdvisoryLock(ctx, cdb.GetAdvisoryLockIDFromString(fmt.Sprintf("%s-%s", fip.TenantID.String(), fip.IPBlockID.String())), nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to acquire advisory lock on IP Block")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Floating IP, detected multiple parallel request on IP Block by Tenant", nil)
+	}
+
+	fipDAO := cdbm.NewFloatingIPDAO(dfih.dbSession)
+	err = fipDAO.Delete(ctx, tx, cdbm.FloatingIPDeleteInput{FloatingIPID: fip.ID, UpdatedByID: dbUser.ID})
+	if err != nil {
+		logger.Error().Err(err).Msg("error deleting FloatingIP from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Floating IP, DB error", nil)
+	}
+
+	ipamStorage := ipam.NewIpamStorage(dfih.dbSession.DB, tx.GetBunTx())
+	err = ipam.DeleteChildIpamEntryFromCidr(ctx, tx, dfih.dbSession, ipamStorage, fip.IPBlock, fip.GetCidr())
+	if err != nil {
+		if !errors.Is(err, ipam.ErrPrefixDoesNotExistForIPBlock) {
+			logger.Error().Err(err).Msg("error releasing IPAM entry of FloatingIP")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to release address of Floating IP", nil)
+		}
+		logger.Warn().Str("cidr", fip.GetCidr()).Msg("IPAM entry for FloatingIP was not found, ignoring")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing FloatingIP delete transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Floating IP, DB transaction error", nil)
+	}
+	txCommitted = true
+
+	logger.Info().Msg("finishing API handler")
+	return c.String(http.StatusAccepted, "Deletion request was accepted")
+}
+
+// ~~~~~ Associate Handler ~~~~~ //
+
+// AssociateFloatingIPHandler is the API Handler for associating a FloatingIP with an Instance Interface
+type AssociateFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewAssociateFloatingIPHandler initializes and returns a new handler for associating a FloatingIP
+func NewAssociateFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, scp *sc.ClientPool, cfg *config.Config) AssociateFloatingIPHandler {
+	return AssociateFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Associate a FloatingIP
+// @Description Associate a FloatingIP with an Instance Interface. If the FloatingIP is associated with another Interface, it is moved.
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of FloatingIP"
+// @Param message body model.APIFloatingIPAssociateRequest true "FloatingIP association request"
+// @Success 200 {object} model.APIFloatingIP
+// @Router /v2/org/{org}/carbide/floating-ip/{id}/associate [post]
+func (afih AssociateFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "Associate", c, afih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIFloatingIPAssociateRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating FloatingIP association request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Floating IP association request data", verr)
+	}
+
+	fip, apiErr := getFloatingIPForOrg(ctx, logger, afih.dbSession, c.Param("id"), org, nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	afih.tracerSpan.SetAttribute(handlerSpan, attribute.String("floating_ip_id", fip.ID.String()), logger)
+
+	// Verify the Interface belongs to an Instance of the Tenant at the same Site
+	ifcDAO := cdbm.NewInterfaceDAO(afih.dbSession)
+	ifc, err := ifcDAO.GetByID(ctx, nil, uuid.MustParse(apiRequest.InterfaceID), []string{cdbm.InstanceRelationName})
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Could not find Interface specified in request", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Interface from DB by ID")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Interface specified in request", nil)
+	}
+	if ifc.Instance == nil || ifc.Instance.TenantID != fip.TenantID {
+		logger.Warn().Msg("Interface in request does not belong to an Instance of Tenant")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Interface specified in request does not belong to an Instance of Tenant", nil)
+	}
+	if ifc.Instance.SiteID != fip.SiteID {
+		logger.Warn().Msg("Interface in request and FloatingIP do not belong to the same Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Interface specified in request and Floating IP do not belong to the same Site", nil)
+	}
+	if ifc.Instance.Status == cdbm.InstanceStatusTerminating || ifc.Instance.Status == cdbm.InstanceStatusTerminated {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Instance of Interface specified in request is being terminated", nil)
+	}
+
+	if fip.InterfaceID != nil && *fip.InterfaceID == ifc.ID && fip.Status == cdbm.FloatingIPStatusAssociated {
+		logger.Info().Msg("FloatingIP is already associated with Interface, finishing API handler")
+		return c.JSON(http.StatusOK, model.NewAPIFloatingIP(fip))
+	}
+
+	controllerInterfaceID, apiErr := getControllerInterfaceIDForInterface(ctx, logger, afih.dbSession, ifc)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	// Start a db tx and hold a lock on the FloatingIP while the Site is programmed
+	tx, err := cdb.BeginTx(ctx, afih.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to associate Floating IP, DB transaction error", nil)
+	}
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	err = tx.TryAcquireAdvisoryLock(ctx, cdb.GetAdvisoryLockIDFromString(fip.ID.String()), nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to acquire advisory lock on FloatingIP")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "Floating IP is being modified by another request, please try again", nil)
+	}
+
+	// Move the address off the Interface it is currently associated with
+	if fip.ControllerInterfaceID != nil {
+		apiErr = executeFloatingIPWorkflow(ctx, logger, afih.scp, fip.SiteID, "floating-ip-disassociate-"+fip.ID.String(), "DisassociateFloatingIP", &cwssaws.RemoveStaticAddressRequest{
+			InterfaceId: &cwssaws.MachineInterfaceId{Value: fip.ControllerInterfaceID.String()},
+			IpAddress:   fip.IPAddress,
+		})
+		if apiErr != nil {
+			return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+		}
+	}
+
+	fipDAO := cdbm.NewFloatingIPDAO(afih.dbSession)
+
+	apiErr = executeFloatingIPWorkflow(ctx, logger, afih.scp, fip.SiteID, "floating-ip-associate-"+fip.ID.String(), "AssociateFloatingIP", &cwssaws.AssignStaticAddressRequest{
+		InterfaceId: &cwssaws.MachineInterfaceId{Value: controllerInterfaceID.String()},
+		IpAddress:   fip.IPAddress,
+	})
+	if apiErr != nil {
+		if fip.ControllerInterfaceID != nil {
+			// The address has already been removed from the previous Interface
+			_, serr := fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{FloatingIPID: fip.ID, Status: cdb.GetStrPtr(cdbm.FloatingIPStatusError), UpdatedByID: dbUser.ID})
+			if serr != nil {
+				logger.Error().Err(serr).Msg("error updating FloatingIP status in DB")
+			}
+		}
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	fip, err = fipDAO.Update(ctx, tx, cdbm.FloatingIPUpdateInput{
+		FloatingIPID:          fip.ID,
+		InstanceID:            &ifc.InstanceID,
+		InterfaceID:           &ifc.ID,
+		ControllerInterfaceID: controllerInterfaceID,
+		Status:                cdb.GetStrPtr(cdbm.FloatingIPStatusAssociated),
+		UpdatedByID:           dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating FloatingIP association in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to associate Floating IP, DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing FloatingIP association transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to associate Floating IP, DB transaction error", nil)
+	}
+	txCommitted = true
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPIFloatingIP(fip))
+}
+
+// ~~~~~ Disassociate Handler ~~~~~ //
+
+// DisassociateFloatingIPHandler is the API Handler for disassociating a FloatingIP from its Instance Interface
+type DisassociateFloatingIPHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDisassociateFloatingIPHandler initializes and returns a new handler for disassociating a FloatingIP
+func NewDisassociateFloatingIPHandler(dbSession *cdb.Session, tc temporalClient.Client, scp *sc.ClientPool, cfg *config.Config) DisassociateFloatingIPHandler {
+	return DisassociateFloatingIPHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Disassociate a FloatingIP
+// @Description Disassociate a FloatingIP from its Instance Interface. The address remains allocated to the Tenant.
+// @Tags FloatingIP
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of FloatingIP"
+// @Success 200 {object} model.APIFloatingIP
+// @Router /v2/org/{org}/carbide/floating-ip/{id}/disassociate [post]
+func (dfih DisassociateFloatingIPHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("FloatingIP", "Disassociate", c, dfih.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with FloatingIP endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	fip, apiErr := getFloatingIPForOrg(ctx, logger, dfih.dbSession, c.Param("id"), org, nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+	}
+
+	dfih.tracerSpan.SetAttribute(handlerSpan, attribute.String("floating_ip_id", fip.ID.String()), logger)
+
+	if !fip.IsAssociated() && fip.Status == cdbm.FloatingIPStatusAvailable {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Floating IP is not associated with an Instance Interface", nil)
+	}
+
+	// Start a db tx and hold a lock on the FloatingIP while the Site is programmed
+	tx, err := cdb.BeginTx(ctx, dfih.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to disassociate Floating IP, DB transaction error", nil)
+	}
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	err = tx.TryAcquireAdvisoryLock(ctx, cdb.GetAdvisoryLockIDFromString(fip.ID.String()), nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to acquire advisory lock on FloatingIP")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "Floating IP is being modified by another request, please try again", nil)
+	}
+
+	if fip.ControllerInterfaceID != nil {
+		apiErr = executeFloatingIPWorkflow(ctx, logger, dfih.scp, fip.SiteID, "floating-ip-disassociate-"+fip.ID.String(), "DisassociateFloatingIP", &cwssaws.RemoveStaticAddressRequest{
+			InterfaceId: &cwssaws.MachineInterfaceId{Value: fip.ControllerInterfaceID.String()},
+			IpAddress:   fip.IPAddress,
+		})
+		if apiErr != nil {
+			return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, nil)
+		}
+	}
+
+	fipDAO := cdbm.NewFloatingIPDAO(dfih.dbSession)
+	_, err = fipDAO.Clear(ctx, tx, cdbm.FloatingIPClearInput{
+		FloatingIPID:          fip.ID,
+		InstanceID:            true,
+		InterfaceID:           true,
+		ControllerInterfaceID: true,
+		UpdatedByID:           dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error clearing FloatingIP association in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to disassociate Floating IP, DB error", nil)
+	}
+
+	fip, err = fipDAO.Update(ctx, tx, cdbm.FloatingIPUpdateInput{
+		FloatingIPID: fip.ID,
+		Status:       cdb.GetStrPtr(cdbm.FloatingIPStatusAvailable),
+		UpdatedByID:  dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating FloatingIP status in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to disassociate Floating IP, DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing FloatingIP disassociation transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to disassociate Floating IP, DB transaction error", nil)
+	}
+	txCommitted = true
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPIFloatingIP(fip))
+}
+
+// getFloatingIPForOrg retrieves a FloatingIP by ID and ensures it belongs to the org
+func getFloatingIPForOrg(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, fipIDStr string, org string, includeRelations []string) (*cdbm.FloatingIP, *cutil.APIError) {
+	fipID, err := uuid.Parse(fipIDStr)
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Floating IP ID in URL", nil)
+	}
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	fip, err := fipDAO.GetByID(ctx, nil, fipID, includeRelations)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Floating IP with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving FloatingIP from DB by ID")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Floating IP with specified ID", nil)
+	}
+
+	if fip.TenantOrg != org {
+		logger.Warn().Msg("org specified in request does not match org of Tenant associated with FloatingIP")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Org specified in request does not match org of Tenant associated with Floating IP", nil)
+	}
+
+	return fip, nil
+}
+
+// getControllerInterfaceIDForInterface resolves the Site Controller machine interface backing an Instance Interface
+func getControllerInterfaceIDForInterface(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, ifc *cdbm.Interface) (*uuid.UUID, *cutil.APIError) {
+	if ifc.Instance.MachineID == nil || ifc.MacAddress == nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Interface specified in request has not been provisioned on Site yet", nil)
+	}
+
+	miDAO := cdbm.NewMachineInterfaceDAO(dbSession)
+	mis, _, err := miDAO.GetAll(ctx, nil, cdbm.MachineInterfaceFilterInput{
+		MachineIDs:   []string{*ifc.Instance.MachineID},
+		MacAddresses: []string{*ifc.MacAddress},
+	}, cdbp.PageInput{}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Machine Interface for Interface from DB")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Machine Interface for Interface specified in request", nil)
+	}
+
+	if len(mis) == 0 || mis[0].ControllerInterfaceID == nil {
+		logger.Warn().Str("macAddress", *ifc.MacAddress).Msg("could not find Machine Interface for Interface")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find Machine Interface on Site for Interface specified in request", nil)
+	}
+
+	return mis[0].ControllerInterfaceID, nil
+}
+
+// executeFloatingIPWorkflow synchronously executes a FloatingIP workflow on Site
+func executeFloatingIPWorkflow(ctx context.Context, logger zerolog.Logger, scp *sc.ClientPool, siteID uuid.UUID, workflowID string, workflowName string, request interface{}) *cutil.APIError {
+	stc, err := scp.GetClientByID(siteID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	workflowOptions := temporalClient.StartWorkflowOptions{
+		ID:                       workflowID,
+		TaskQueue:                queue.SiteTaskQueue,
+		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
+	}
+
+	// Add context deadlines
+	ctx, cancel := context.WithTimeout(ctx, cutil.WorkflowContextTimeout)
+	defer cancel()
+
+	we, err := stc.ExecuteWorkflow(ctx, workflowOptions, workflowName, request)
+	if err != nil {
+		logger.Error().Err(err).Str("Workflow", workflowName).Msg("failed to start Temporal workflow for FloatingIP on Site")
+		return cutil.NewAPIError(http.StatusInternalServerError, "Failed to start sync workflow to update Floating IP on Site", nil)
+	}
+
+	wid := we.GetID()
+	logger.Info().Str("Workflow ID", wid).Msg("executed synchronous FloatingIP workflow")
+
+	err = we.Get(ctx, nil)
+	if err != nil {
+		var timeoutErr *tp.TimeoutError
+		if errors.As(err, &timeoutErr) || err == context.DeadlineExceeded || ctx.Err() != nil {
+			// Terminate the workflow so it does not program the Site after the request has failed
+			newctx, newcancel := context.WithTimeout(context.Background(), cutil.WorkflowContextNewAfterTimeout)
+			defer newcancel()
+
+			serr := stc.TerminateWorkflow(newctx, wid, "", "timeout occurred executing "+workflowName+" workflow")
+			if serr != nil {
+				logger.Error().Err(serr).Str("Workflow", workflowName).Msg("failed to terminate FloatingIP workflow after timeout")
+			}
+			return cutil.NewAPIError(http.StatusInternalServerError, fmt.Sprintf("Failed to update Floating IP on Site, timeout occurred executing workflow on Site: %s", err), nil)
+		}
+
+		code, uerr := common.UnwrapWorkflowError(err)
+		logger.Error().Err(uerr).Str("Workflow ID", wid).Msg("failed to synchronously execute Temporal workflow for FloatingIP")
+		return cutil.NewAPIError(code, fmt.Sprintf("Failed to update Floating IP on Site: %s", uerr), nil)
+	}
+
+	logger.Info().Str("Workflow ID", wid).Msg("completed synchronous FloatingIP workflow")
+
+	return nil
+}
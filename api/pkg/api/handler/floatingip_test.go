@@ -0,0 +1,1037 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	tmocks "go.temporal.io/sdk/mocks"
+	tp "go.temporal.io/sdk/temporal"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/otelecho"
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/ipam"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cipam "github.com/NVIDIA/ncx-infra-controller-rest/ipam"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func testFloatingIPSetupSchema(t *testing.T, dbSession *cdb.Session) {
+	common.TestSetupSchema(t, dbSession)
+	// create Floating IP table
+	err := dbSession.DB.ResetModel(context.Background(), (*cdbm.FloatingIP)(nil))
+	assert.Nil(t, err)
+	// unique index is created by migration
+	_, err = dbSession.DB.Exec("CREATE UNIQUE INDEX floating_ip_site_id_ip_address_idx ON floating_ip(site_id, ip_address) WHERE deleted IS NULL")
+	assert.Nil(t, err)
+}
+
+// testFloatingIPBuildIPBlock creates a Tenant IP Block along with its IPAM entry
+func testFloatingIPBuildIPBlock(t *testing.T, dbSession *cdb.Session, name string, site *cdbm.Site, ip *cdbm.InfrastructureProvider, tenant *cdbm.Tenant, prefix string, prefixLength int, user *cdbm.User) *cdbm.IPBlock {
+	ipb := testInstanceBuildIPBlock(t, dbSession, name, site, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, prefix, prefixLength, cdbm.IPBlockProtocolVersionV4, cdbm.IPBlockStatusReady, user)
+
+	ipamStorage := ipam.NewIpamStorage(dbSession.DB, nil)
+	_, err := ipam.CreateIpamEntryForIPBlock(context.Background(), ipamStorage, ipb.Prefix, ipb.PrefixLength, ipb.RoutingType, ipb.InfrastructureProviderID.String(), ipb.SiteID.String())
+	require.NoError(t, err)
+
+	return ipb
+}
+
+func testBuildFloatingIP(t *testing.T, dbSession *cdb.Session, name string, tenant *cdbm.Tenant, ipb *cdbm.IPBlock, ipAddress string, user *cdbm.User) *cdbm.FloatingIP {
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	fip, err := fipDAO.Create(context.Background(), nil, cdbm.FloatingIPCreateInput{
+		Name:        name,
+		SiteID:      ipb.SiteID,
+		TenantID:    tenant.ID,
+		TenantOrg:   tenant.Org,
+		IPBlockID:   ipb.ID,
+		IPAddress:   ipAddress,
+		Status:      cdbm.FloatingIPStatusAvailable,
+		CreatedByID: user.ID,
+	})
+	require.NoError(t, err)
+	return fip
+}
+
+// testFloatingIPBuildInterface creates an Instance Interface along with the Machine Interface backing it on Site
+func testFloatingIPBuildInterface(t *testing.T, dbSession *cdb.Session, ins *cdbm.Instance, macAddress string) (*cdbm.Interface, *cdbm.MachineInterface) {
+	mi := &cdbm.MachineInterface{
+		ID:                    uuid.New(),
+		MachineID:             *ins.MachineID,
+		ControllerInterfaceID: cdb.GetUUIDPtr(uuid.New()),
+		IsPrimary:             true,
+		MacAddress:            cdb.GetStrPtr(macAddress),
+		Created:               cdb.GetCurTime(),
+		Updated:               cdb.GetCurTime(),
+	}
+	_, err := dbSession.DB.NewInsert().Model(mi).Exec(context.Background())
+	require.NoError(t, err)
+
+	ifc := testInstanceBuildInstanceInterface(t, dbSession, ins.ID, nil, nil, nil, cdbm.InterfaceStatusReady)
+	ifc.MacAddress = cdb.GetStrPtr(macAddress)
+	_, err = dbSession.DB.NewUpdate().Where("id = ?", ifc.ID).Model(ifc).Exec(context.Background())
+	require.NoError(t, err)
+
+	return ifc, mi
+}
+
+// testFloatingIPSiteClientPool returns a Site client pool whose FloatingIP workflows either succeed or time out
+func testFloatingIPSiteClientPool(t *testing.T, site *cdbm.Site, timeout bool) (*sc.ClientPool, *tmocks.Client) {
+	tcfg, _ := common.GetTestConfig().GetTemporalConfig()
+
+	scp := sc.NewClientPool(tcfg)
+	tsc := &tmocks.Client{}
+	scp.IDClientMap[site.ID.String()] = tsc
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	if timeout {
+		wrun.Mock.On("Get", mock.Anything, mock.Anything).Return(tp.NewTimeoutError(enums.TIMEOUT_TYPE_UNSPECIFIED, nil, nil))
+		tsc.Mock.On("TerminateWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	} else {
+		wrun.Mock.On("Get", mock.Anything, mock.Anything).Return(nil)
+	}
+
+	tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"AssociateFloatingIP", mock.Anything).Return(wrun, nil)
+	tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"DisassociateFloatingIP", mock.Anything).Return(wrun, nil)
+
+	return scp, tsc
+}
+
+func TestFloatingIPHandler_Create(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 30, ipu)
+	ipb2 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-2", st1, ip, tn2, "192.168.20.0", 24, ipu)
+
+	testBuildFloatingIP(t, dbSession, "existing", tn1, ipb2, "192.168.20.200", tnu1)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		user             *cdbm.User
+		requestPayload   *model.APIFloatingIPCreateRequest
+		wantResponseCode int
+	}{
+		{
+			name:             "Create from Tenant IP Block - success",
+			user:             tnu1,
+			requestPayload:   &model.APIFloatingIPCreateRequest{Name: "vip-1", Description: cdb.GetStrPtr("web frontend"), IPBlockID: ipb1.ID.String()},
+			wantResponseCode: http.StatusCreated,
+		},
+		{
+			name:             "Create second from Tenant IP Block - success",
+			user:             tnu1,
+			requestPayload:   &model.APIFloatingIPCreateRequest{Name: "vip-2", IPBlockID: ipb1.ID.String()},
+			wantResponseCode: http.StatusCreated,
+		},
+		{
+			name:             "Create with existing name - fail",
+			user:             tnu1,
+			requestPayload:   &model.APIFloatingIPCreateRequest{Name: "vip-1", IPBlockID: ipb1.ID.String()},
+			wantResponseCode: http.StatusConflict,
+		},
+		{
+			name:             "Create from IP Block of another Tenant - fail",
+			user:             tnu1,
+			requestPayload:   &model.APIFloatingIPCreateRequest{Name: "other-tenant", IPBlockID: ipb2.ID.String()},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Create from non-existent IP Block - fail",
+			user:             tnu1,
+			requestPayload:   &model.APIFloatingIPCreateRequest{Name: "missing-block", IPBlockID: uuid.NewString()},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Create by Provider - fail",
+			user:             ipu,
+			requestPayload:   &model.APIFloatingIPCreateRequest{Name: "provider", IPBlockID: ipb1.ID.String()},
+			wantResponseCode: http.StatusForbidden,
+		},
+	}
+
+	allocated := map[string]bool{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfih := NewCreateFloatingIPHandler(dbSession, tc, cfg)
+
+			jsonData, _ := json.Marshal(test.requestPayload)
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			org := tnOrg1
+			if test.user == ipu {
+				org = ipOrg
+			}
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip", org))
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(org)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := cfih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("CreateFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusCreated {
+				return
+			}
+
+			rst := &model.APIFloatingIP{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.requestPayload.Name, rst.Name)
+			assert.Equal(t, st1.ID.String(), rst.SiteID)
+			assert.Equal(t, cdbm.FloatingIPStatusAvailable, rst.Status)
+			assert.True(t, strings.HasPrefix(rst.IPAddress, "192.168.10."))
+			assert.False(t, allocated[rst.IPAddress], "address allocated more than once")
+			allocated[rst.IPAddress] = true
+
+			// Validate address is tracked in IPAM
+			ipamer := cipam.NewWithStorage(ipam.NewIpamStorage(dbSession.DB, nil))
+			ipamer.SetNamespace(ipam.GetIpamNamespaceForIPBlock(ctx, ipb1.RoutingType, ipb1.InfrastructureProviderID.String(), ipb1.SiteID.String()))
+			assert.NotNil(t, ipamer.PrefixFrom(ctx, rst.IPAddress+"/32"))
+		})
+	}
+}
+
+func TestFloatingIPHandler_GetAll(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+	st2 := testInstanceBuildSite(t, dbSession, ip, "test-site-2", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+	ipb2 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-2", st2, ip, tn1, "192.168.20.0", 24, ipu)
+	ipb3 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-3", st1, ip, tn2, "192.168.30.0", 24, ipu)
+
+	testBuildFloatingIP(t, dbSession, "vip-1", tn1, ipb1, "192.168.10.1", tnu1)
+	testBuildFloatingIP(t, dbSession, "vip-2", tn1, ipb1, "192.168.10.2", tnu1)
+	testBuildFloatingIP(t, dbSession, "vip-3", tn1, ipb2, "192.168.20.1", tnu1)
+	testBuildFloatingIP(t, dbSession, "vip-4", tn2, ipb3, "192.168.30.1", tnu2)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		user             *cdbm.User
+		org              string
+		queryParams      map[string]string
+		wantResponseCode int
+		wantCount        int
+	}{
+		{
+			name:             "GetAll for Tenant - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			wantResponseCode: http.StatusOK,
+			wantCount:        3,
+		},
+		{
+			name:             "GetAll filtered by Site - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			queryParams:      map[string]string{"siteId": st2.ID.String()},
+			wantResponseCode: http.StatusOK,
+			wantCount:        1,
+		},
+		{
+			name:             "GetAll filtered by IP Block with relation - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			queryParams:      map[string]string{"ipBlockId": ipb1.ID.String(), "includeRelation": cdbm.IPBlockRelationName},
+			wantResponseCode: http.StatusOK,
+			wantCount:        2,
+		},
+		{
+			name:             "GetAll filtered by status - success",
+			user:             tnu1,
+			org:              tnOrg1,
+			queryParams:      map[string]string{"status": cdbm.FloatingIPStatusAssociated},
+			wantResponseCode: http.StatusOK,
+			wantCount:        0,
+		},
+		{
+			name:             "GetAll with invalid status - fail",
+			user:             tnu1,
+			org:              tnOrg1,
+			queryParams:      map[string]string{"status": "Bogus"},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "GetAll by Provider - fail",
+			user:             ipu,
+			org:              ipOrg,
+			wantResponseCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gafih := NewGetAllFloatingIPHandler(dbSession, tc, cfg)
+
+			q := make([]string, 0, len(test.queryParams))
+			for k, v := range test.queryParams {
+				q = append(q, fmt.Sprintf("%s=%s", k, v))
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/?"+strings.Join(q, "&"), nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip", test.org))
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(test.org)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := gafih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetAllFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := []model.APIFloatingIP{}
+			err = json.Unmarshal(rec.Body.Bytes(), &rst)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantCount, len(rst))
+
+			if test.queryParams["includeRelation"] != "" {
+				for _, fip := range rst {
+					assert.NotNil(t, fip.IPBlock)
+				}
+			}
+		})
+	}
+}
+
+func TestFloatingIPHandler_Get(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+	ipb2 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-2", st1, ip, tn2, "192.168.20.0", 24, ipu)
+
+	fip1 := testBuildFloatingIP(t, dbSession, "vip-1", tn1, ipb1, "192.168.10.1", tnu1)
+	fip2 := testBuildFloatingIP(t, dbSession, "vip-2", tn2, ipb2, "192.168.20.1", tnu2)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		id               string
+		wantResponseCode int
+	}{
+		{
+			name:             "Get Floating IP - success",
+			id:               fip1.ID.String(),
+			wantResponseCode: http.StatusOK,
+		},
+		{
+			name:             "Get Floating IP of another Tenant - fail",
+			id:               fip2.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Get non-existent Floating IP - fail",
+			id:               uuid.NewString(),
+			wantResponseCode: http.StatusNotFound,
+		},
+		{
+			name:             "Get with invalid ID - fail",
+			id:               "vip-1",
+			wantResponseCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gfih := NewGetFloatingIPHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodGet, "/?includeRelation="+cdbm.SiteRelationName, nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip/%s", tnOrg1, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg1, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := gfih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIFloatingIP{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, test.id, rst.ID)
+			assert.Equal(t, fip1.IPAddress, rst.IPAddress)
+			assert.NotNil(t, rst.Site)
+		})
+	}
+}
+
+func TestFloatingIPHandler_Update(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg, tnu1)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+
+	fip1 := testBuildFloatingIP(t, dbSession, "vip-1", tn1, ipb1, "192.168.10.1", tnu1)
+	testBuildFloatingIP(t, dbSession, "vip-2", tn1, ipb1, "192.168.10.2", tnu1)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		requestPayload   *model.APIFloatingIPUpdateRequest
+		wantResponseCode int
+	}{
+		{
+			name:             "Update name and description - success",
+			requestPayload:   &model.APIFloatingIPUpdateRequest{Name: cdb.GetStrPtr("vip-1-renamed"), Description: cdb.GetStrPtr("renamed")},
+			wantResponseCode: http.StatusOK,
+		},
+		{
+			name:             "Update name to existing name - fail",
+			requestPayload:   &model.APIFloatingIPUpdateRequest{Name: cdb.GetStrPtr("vip-2")},
+			wantResponseCode: http.StatusConflict,
+		},
+		{
+			name:             "Update with invalid name - fail",
+			requestPayload:   &model.APIFloatingIPUpdateRequest{Name: cdb.GetStrPtr("")},
+			wantResponseCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ufih := NewUpdateFloatingIPHandler(dbSession, tc, cfg)
+
+			jsonData, _ := json.Marshal(test.requestPayload)
+
+			req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip/%s", tnOrg, fip1.ID.String()))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg, fip1.ID.String())
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := ufih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("UpdateFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIFloatingIP{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, *test.requestPayload.Name, rst.Name)
+			assert.Equal(t, test.requestPayload.Description, rst.Description)
+			assert.Equal(t, fip1.IPAddress, rst.IPAddress)
+		})
+	}
+}
+
+func TestFloatingIPHandler_Delete(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg, tnu1)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+
+	// Allocate addresses through IPAM so release can be verified
+	ipamStorage := ipam.NewIpamStorage(dbSession.DB, nil)
+	ipamer := cipam.NewWithStorage(ipamStorage)
+	ipamer.SetNamespace(ipam.GetIpamNamespaceForIPBlock(ctx, ipb1.RoutingType, ipb1.InfrastructureProviderID.String(), ipb1.SiteID.String()))
+
+	prefix1, err := ipamer.AcquireSpecificChildPrefix(ctx, "192.168.10.0/24", "192.168.10.1/32")
+	require.NoError(t, err)
+	fip1 := testBuildFloatingIP(t, dbSession, "vip-1", tn1, ipb1, "192.168.10.1", tnu1)
+
+	_, err = ipamer.AcquireSpecificChildPrefix(ctx, "192.168.10.0/24", "192.168.10.2/32")
+	require.NoError(t, err)
+	fip2 := testBuildFloatingIP(t, dbSession, "vip-2", tn1, ipb1, "192.168.10.2", tnu1)
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	_, err = fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+		FloatingIPID: fip2.ID,
+		InterfaceID:  cdb.GetUUIDPtr(uuid.New()),
+		Status:       cdb.GetStrPtr(cdbm.FloatingIPStatusAssociated),
+		UpdatedByID:  tnu1.ID,
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		id               string
+		wantResponseCode int
+	}{
+		{
+			name:             "Delete associated Floating IP - fail",
+			id:               fip2.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Delete Floating IP - success",
+			id:               fip1.ID.String(),
+			wantResponseCode: http.StatusAccepted,
+		},
+		{
+			name:             "Delete non-existent Floating IP - fail",
+			id:               uuid.NewString(),
+			wantResponseCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dfih := NewDeleteFloatingIPHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip/%s", tnOrg, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := dfih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("DeleteFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusAccepted {
+				return
+			}
+
+			_, err = fipDAO.GetByID(ctx, nil, fip1.ID, nil)
+			assert.ErrorIs(t, err, cdb.ErrDoesNotExist)
+
+			// Address has been released back to the IP Block
+			assert.Nil(t, ipamer.PrefixFrom(ctx, prefix1.Cidr))
+		})
+	}
+}
+
+func TestFloatingIPHandler_Associate(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+	st2 := testInstanceBuildSite(t, dbSession, ip, "test-site-2", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	vpc1 := testInstanceBuildVPC(t, dbSession, "test-vpc-1", ip, tn1, st1, nil, nil, cdb.GetStrPtr(cdbm.VpcFNN), nil, cdbm.VpcStatusReady, tnu1)
+	vpc2 := testInstanceBuildVPC(t, dbSession, "test-vpc-2", ip, tn1, st2, nil, nil, cdb.GetStrPtr(cdbm.VpcFNN), nil, cdbm.VpcStatusReady, tnu1)
+	vpc3 := testInstanceBuildVPC(t, dbSession, "test-vpc-3", ip, tn2, st1, nil, nil, cdb.GetStrPtr(cdbm.VpcFNN), nil, cdbm.VpcStatusReady, tnu2)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+	fip1 := testBuildFloatingIP(t, dbSession, "vip-1", tn1, ipb1, "192.168.10.1", tnu1)
+	fip2 := testBuildFloatingIP(t, dbSession, "vip-2", tn1, ipb1, "192.168.10.2", tnu1)
+
+	m1 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(true), nil)
+	ins1 := testInstanceBuildInstance(t, dbSession, "test-instance-1", tn1.ID, ip.ID, st1.ID, nil, vpc1.ID, &m1.ID, nil, nil, cdbm.InstanceStatusReady)
+	ifc1, mi1 := testFloatingIPBuildInterface(t, dbSession, ins1, "00:1B:44:11:3A:01")
+
+	m2 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(true), nil)
+	ins2 := testInstanceBuildInstance(t, dbSession, "test-instance-2", tn1.ID, ip.ID, st1.ID, nil, vpc1.ID, &m2.ID, nil, nil, cdbm.InstanceStatusReady)
+	ifc2, mi2 := testFloatingIPBuildInterface(t, dbSession, ins2, "00:1B:44:11:3A:02")
+
+	// Interface not yet provisioned on Site
+	ins3 := testInstanceBuildInstance(t, dbSession, "test-instance-3", tn1.ID, ip.ID, st1.ID, nil, vpc1.ID, nil, nil, nil, cdbm.InstanceStatusProvisioning)
+	ifc3 := testInstanceBuildInstanceInterface(t, dbSession, ins3.ID, nil, nil, nil, cdbm.InterfaceStatusPending)
+
+	// Interface at another Site
+	m4 := testInstanceBuildMachine(t, dbSession, ip.ID, st2.ID, cdb.GetBoolPtr(true), nil)
+	ins4 := testInstanceBuildInstance(t, dbSession, "test-instance-4", tn1.ID, ip.ID, st2.ID, nil, vpc2.ID, &m4.ID, nil, nil, cdbm.InstanceStatusReady)
+	ifc4, _ := testFloatingIPBuildInterface(t, dbSession, ins4, "00:1B:44:11:3A:04")
+
+	// Interface of another Tenant
+	m5 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(true), nil)
+	ins5 := testInstanceBuildInstance(t, dbSession, "test-instance-5", tn2.ID, ip.ID, st1.ID, nil, vpc3.ID, &m5.ID, nil, nil, cdbm.InstanceStatusReady)
+	ifc5, _ := testFloatingIPBuildInterface(t, dbSession, ins5, "00:1B:44:11:3A:05")
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	scp, tsc := testFloatingIPSiteClientPool(t, st1, false)
+	scpWithTimeout, tscWithTimeout := testFloatingIPSiteClientPool(t, st1, true)
+
+	tests := []struct {
+		name                     string
+		id                       string
+		clientPool               *sc.ClientPool
+		interfaceID              string
+		wantResponseCode         int
+		wantControllerInterface  *uuid.UUID
+		wantWorkflows            []string
+		wantRemovedFromInterface *uuid.UUID
+	}{
+		{
+			name:             "Associate with Interface of another Tenant - fail",
+			id:               fip1.ID.String(),
+			clientPool:       scp,
+			interfaceID:      ifc5.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Associate with Interface at another Site - fail",
+			id:               fip1.ID.String(),
+			clientPool:       scp,
+			interfaceID:      ifc4.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Associate with Interface not provisioned on Site - fail",
+			id:               fip1.ID.String(),
+			clientPool:       scp,
+			interfaceID:      ifc3.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Associate with non-existent Interface - fail",
+			id:               fip1.ID.String(),
+			clientPool:       scp,
+			interfaceID:      uuid.NewString(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Associate with Site timeout - fail",
+			id:               fip2.ID.String(),
+			clientPool:       scpWithTimeout,
+			interfaceID:      ifc2.ID.String(),
+			wantResponseCode: http.StatusInternalServerError,
+			wantWorkflows:    []string{"AssociateFloatingIP"},
+		},
+		{
+			name:                    "Associate with Interface - success",
+			id:                      fip1.ID.String(),
+			clientPool:              scp,
+			interfaceID:             ifc1.ID.String(),
+			wantResponseCode:        http.StatusOK,
+			wantControllerInterface: mi1.ControllerInterfaceID,
+			wantWorkflows:           []string{"AssociateFloatingIP"},
+		},
+		{
+			name:                    "Associate with same Interface again - no-op",
+			id:                      fip1.ID.String(),
+			clientPool:              scp,
+			interfaceID:             ifc1.ID.String(),
+			wantResponseCode:        http.StatusOK,
+			wantControllerInterface: mi1.ControllerInterfaceID,
+		},
+		{
+			name:                     "Move to Interface of replacement Instance - success",
+			id:                       fip1.ID.String(),
+			clientPool:               scp,
+			interfaceID:              ifc2.ID.String(),
+			wantResponseCode:         http.StatusOK,
+			wantControllerInterface:  mi2.ControllerInterfaceID,
+			wantWorkflows:            []string{"DisassociateFloatingIP", "AssociateFloatingIP"},
+			wantRemovedFromInterface: mi1.ControllerInterfaceID,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			afih := NewAssociateFloatingIPHandler(dbSession, tc, test.clientPool, cfg)
+
+			jsonData, _ := json.Marshal(model.APIFloatingIPAssociateRequest{InterfaceID: test.interfaceID})
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip/%s/associate", tnOrg1, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg1, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			tsc.Calls = nil
+			tscWithTimeout.Calls = nil
+
+			err := afih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("AssociateFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			client := test.clientPool.IDClientMap[st1.ID.String()].(*tmocks.Client)
+			client.AssertNumberOfCalls(t, "ExecuteWorkflow", len(test.wantWorkflows))
+			for i, workflowName := range test.wantWorkflows {
+				assert.Equal(t, workflowName, client.Calls[i].Arguments.Get(2))
+			}
+
+			if test.wantRemovedFromInterface != nil {
+				request := client.Calls[0].Arguments.Get(3).(*cwssaws.RemoveStaticAddressRequest)
+				assert.Equal(t, test.wantRemovedFromInterface.String(), request.InterfaceId.Value)
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIFloatingIP{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, cdbm.FloatingIPStatusAssociated, rst.Status)
+			require.NotNil(t, rst.InterfaceID)
+			assert.Equal(t, test.interfaceID, *rst.InterfaceID)
+
+			if len(test.wantWorkflows) > 0 {
+				request := client.Calls[len(test.wantWorkflows)-1].Arguments.Get(3).(*cwssaws.AssignStaticAddressRequest)
+				assert.Equal(t, test.wantControllerInterface.String(), request.InterfaceId.Value)
+				assert.Equal(t, fip1.IPAddress, request.IpAddress)
+			}
+		})
+	}
+}
+
+func TestFloatingIPHandler_Disassociate(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testFloatingIPSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg, tnu1)
+
+	vpc1 := testInstanceBuildVPC(t, dbSession, "test-vpc-1", ip, tn1, st1, nil, nil, cdb.GetStrPtr(cdbm.VpcFNN), nil, cdbm.VpcStatusReady, tnu1)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+	fip1 := testBuildFloatingIP(t, dbSession, "vip-1", tn1, ipb1, "192.168.10.1", tnu1)
+	fip2 := testBuildFloatingIP(t, dbSession, "vip-2", tn1, ipb1, "192.168.10.2", tnu1)
+
+	m1 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(true), nil)
+	ins1 := testInstanceBuildInstance(t, dbSession, "test-instance-1", tn1.ID, ip.ID, st1.ID, nil, vpc1.ID, &m1.ID, nil, nil, cdbm.InstanceStatusReady)
+	ifc1, mi1 := testFloatingIPBuildInterface(t, dbSession, ins1, "00:1B:44:11:3A:01")
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	_, err := fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+		FloatingIPID:          fip1.ID,
+		InstanceID:            &ins1.ID,
+		InterfaceID:           &ifc1.ID,
+		ControllerInterfaceID: mi1.ControllerInterfaceID,
+		Status:                cdb.GetStrPtr(cdbm.FloatingIPStatusAssociated),
+		UpdatedByID:           tnu1.ID,
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	scp, tsc := testFloatingIPSiteClientPool(t, st1, false)
+	scpWithTimeout, tscWithTimeout := testFloatingIPSiteClientPool(t, st1, true)
+
+	tests := []struct {
+		name              string
+		id                string
+		clientPool        *sc.ClientPool
+		wantResponseCode  int
+		wantWorkflowCalls int
+	}{
+		{
+			name:             "Disassociate Floating IP that is not associated - fail",
+			id:               fip2.ID.String(),
+			clientPool:       scp,
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:              "Disassociate with Site timeout - fail",
+			id:                fip1.ID.String(),
+			clientPool:        scpWithTimeout,
+			wantResponseCode:  http.StatusInternalServerError,
+			wantWorkflowCalls: 1,
+		},
+		{
+			name:              "Disassociate Floating IP - success",
+			id:                fip1.ID.String(),
+			clientPool:        scp,
+			wantResponseCode:  http.StatusOK,
+			wantWorkflowCalls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dfih := NewDisassociateFloatingIPHandler(dbSession, tc, test.clientPool, cfg)
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/floating-ip/%s/disassociate", tnOrg, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			tsc.Calls = nil
+			tscWithTimeout.Calls = nil
+
+			err := dfih.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("DisassociateFloatingIPHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			test.clientPool.IDClientMap[st1.ID.String()].(*tmocks.Client).AssertNumberOfCalls(t, "ExecuteWorkflow", test.wantWorkflowCalls)
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			request := tsc.Calls[0].Arguments.Get(3).(*cwssaws.RemoveStaticAddressRequest)
+			assert.Equal(t, mi1.ControllerInterfaceID.String(), request.InterfaceId.Value)
+			assert.Equal(t, fip1.IPAddress, request.IpAddress)
+
+			rst := &model.APIFloatingIP{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, cdbm.FloatingIPStatusAvailable, rst.Status)
+			assert.Nil(t, rst.InterfaceID)
+			assert.Nil(t, rst.InstanceID)
+		})
+	}
+}
@@ -790,6 +790,31 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 		})
 	}
 
+	// Validate the Instance being replaced, its Floating IPs move to the new Instance
+	var replacesInstanceID *uuid.UUID
+	if apiRequest.ReplacesInstanceID != nil {
+		replacedInstances, _, serr := instanceDAO.GetAll(ctx, nil, cdbm.InstanceFilterInput{
+			InstanceIDs:    []uuid.UUID{uuid.MustParse(*apiRequest.ReplacesInstanceID)},
+			TenantIDs:      []uuid.UUID{tenant.ID},
+			IncludeDeleted: true,
+		}, cdbp.PageInput{}, nil)
+		if serr != nil {
+			logger.Error().Err(serr).Msg("db error retrieving Instance to replace")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Instance to replace due to DB error", nil)
+		}
+		if len(replacedInstances) == 0 {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Could not find Instance to replace specified in request data", nil)
+		}
+		replaced := replacedInstances[0]
+		if replaced.VpcID != vpc.ID {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Instance to replace specified in request data must belong to the same VPC", nil)
+		}
+		if replaced.Deleted == nil && replaced.Status != cdbm.InstanceStatusTerminating && replaced.Status != cdbm.InstanceStatusTerminated {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Instance to replace specified in request data must be deleted or terminating", nil)
+		}
+		replacesInstanceID = &replaced.ID
+	}
+
 	// ==================== Step 3: Database Transaction ====================
 
 	// Start a db tx
@@ -1257,6 +1282,7 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 		IsUpdatePending:          false,
 		Status:                   cdbm.InstanceStatusPending,
 		PowerStatus:              cdb.GetStrPtr(cdbm.InstancePowerStatusRebooting),
+		ReplacesInstanceID:       replacesInstanceID,
 		CreatedBy:                dbUser.ID,
 	}
 
@@ -2531,6 +2531,67 @@ func TestCreateInstanceHandler_Handle(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "test Instance create API endpoint failed, Instance to replace not found",
+			fields: fields{
+				dbSession: dbSession,
+				tc:        tc,
+				cfg:       cfg,
+			},
+			args: args{
+				reqData: &model.APIInstanceCreateRequest{
+					Name:               "Test Instance Replacement",
+					TenantID:           tn1.ID.String(),
+					InstanceTypeID:     cdb.GetStrPtr(ist1.ID.String()),
+					VpcID:              vpc1.ID.String(),
+					OperatingSystemID:  cdb.GetStrPtr(os1.ID.String()),
+					ReplacesInstanceID: cdb.GetStrPtr(uuid.NewString()),
+					Interfaces: []model.APIInterfaceCreateOrUpdateRequest{
+						{
+							SubnetID: cdb.GetStrPtr(subnet1.ID.String()),
+						},
+					},
+				},
+				reqOrg:      tnOrg,
+				reqUser:     tnu1,
+				respCode:    http.StatusBadRequest,
+				respMessage: "Could not find Instance to replace specified in request data",
+			},
+			wantErr: false,
+		},
+		{
+			name: "test Instance create API endpoint failed, Instance to replace is not being deleted",
+			fields: fields{
+				dbSession: dbSession,
+				tc:        tc,
+				cfg:       cfg,
+			},
+			args: args{
+				reqData: &model.APIInstanceCreateRequest{
+					Name:              "Test Instance Replacement",
+					TenantID:          tn1.ID.String(),
+					InstanceTypeID:    cdb.GetStrPtr(ist1.ID.String()),
+					VpcID:             vpc1.ID.String(),
+					OperatingSystemID: cdb.GetStrPtr(os1.ID.String()),
+					Interfaces: []model.APIInterfaceCreateOrUpdateRequest{
+						{
+							SubnetID: cdb.GetStrPtr(subnet1.ID.String()),
+						},
+					},
+				},
+				prepareReq: func(t *testing.T, req *model.APIInstanceCreateRequest) {
+					instances, _, err := cdbm.NewInstanceDAO(dbSession).GetAll(ctx, nil, cdbm.InstanceFilterInput{Names: []string{"Test Instance"}, TenantIDs: []uuid.UUID{tn1.ID}}, cdbp.PageInput{}, nil)
+					require.NoError(t, err)
+					require.Len(t, instances, 1)
+					req.ReplacesInstanceID = cdb.GetStrPtr(instances[0].ID.String())
+				},
+				reqOrg:      tnOrg,
+				reqUser:     tnu1,
+				respCode:    http.StatusBadRequest,
+				respMessage: "must be deleted or terminating",
+			},
+			wantErr: false,
+		},
 		{
 			name: "error creating Instance due to invalid Subnet",
 			fields: fields{
@@ -0,0 +1,429 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/ipam"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbp "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	auth "github.com/NVIDIA/ncx-infra-controller-rest/auth/pkg/authorization"
+	cutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
+)
+
+// getIPUsageHistoryDays parses and validates the `days` query param
+func getIPUsageHistoryDays(c echo.Context) (int, error) {
+	qDays := c.QueryParam("days")
+	if qDays == "" {
+		return model.IPUsageHistoryDaysDefault, nil
+	}
+
+	days, err := strconv.Atoi(qDays)
+	if err != nil || days < 1 || days > model.IPUsageHistoryDaysMax {
+		return 0, fmt.Errorf("`days` query param must be an integer between 1 and %d", model.IPUsageHistoryDaysMax)
+	}
+
+	return days, nil
+}
+
+// getAPIIPUsage combines the current usage of a resource with its recorded history to build the API response
+func getAPIIPUsage(ctx context.Context, dbSession *cdb.Session, resourceType string, resourceID uuid.UUID, siteID uuid.UUID, usage *ipam.AddressUsage, days int) (*model.APIIPUsage, error) {
+	iusDAO := cdbm.NewIPUsageSampleDAO(dbSession)
+
+	history, _, err := iusDAO.GetAll(ctx, nil, cdbm.IPUsageSampleFilterInput{
+		ResourceIDs:  []uuid.UUID{resourceID},
+		CreatedAfter: cdb.GetTimePtr(time.Now().AddDate(0, 0, -days)),
+	}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)})
+	if err != nil {
+		return nil, err
+	}
+
+	current := cdbm.IPUsageSample{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		SiteID:       siteID,
+		Prefix:       usage.Prefix,
+		TotalIPs:     usage.TotalIPs,
+		UsedIPs:      usage.UsedIPs,
+		Created:      time.Now(),
+	}
+
+	forecast := ipam.ForecastUsage(append(history, current))
+
+	return model.NewAPIIPUsage(&current, history, forecast.GrowthPerDay, forecast.ProjectedExhaustion), nil
+}
+
+// ~~~~~ Get IPBlock Usage Handler ~~~~~ //
+
+// GetIPBlockUsageHandler is the API Handler for retrieving usage trend of an IPBlock
+type GetIPBlockUsageHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetIPBlockUsageHandler initializes and returns a new handler for retrieving usage trend of an IPBlock
+func NewGetIPBlockUsageHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetIPBlockUsageHandler {
+	return GetIPBlockUsageHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get IPBlock usage
+// @Description Get current utilization, growth trend and projected exhaustion date of an IPBlock
+// @Tags IPBlock
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of IPBlock"
+// @Param days query integer false "Number of days of usage history to return, defaults to 30, max 90"
+// @Success 200 {object} model.APIIPUsage
+// @Router /v2/org/{org}/carbide/ipblock/{id}/usage [get]
+func (gipbuh GetIPBlockUsageHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("IPBlock", "GetUsage", c, gipbuh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Get ipBlock ID from URL param
+	ipbStrID := c.Param("id")
+
+	gipbuh.tracerSpan.SetAttribute(handlerSpan, attribute.String("ipblock_id", ipbStrID), logger)
+
+	ipbID, err := uuid.Parse(ipbStrID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error parsing id in url into uuid")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid IP Block ID in URL", nil)
+	}
+
+	days, err := getIPUsageHistoryDays(c)
+	if err != nil {
+		logger.Warn().Err(err).Msg("invalid value specified for days query param")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+
+	provider, tenant, apiError := common.IsProviderOrTenant(ctx, logger, gipbuh.dbSession, org, dbUser, true, false)
+	if apiError != nil {
+		return cutil.NewAPIErrorResponse(c, apiError.Code, apiError.Message, apiError.Data)
+	}
+
+	ipbDAO := cdbm.NewIPBlockDAO(gipbuh.dbSession)
+
+	// Get IP Block from DB
+	ipb, err := ipbDAO.GetByID(ctx, nil, ipbID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			logger.Warn().Err(err).Msg("IP Block not found")
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Could not find IP Block with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving IP Block from DB by ID")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve IP Block, DB error", nil)
+	}
+
+	// Check if IP Block is associated with Provider or Tenant
+	isAssociated := false
+	if provider != nil {
+		isAssociated = provider.ID == ipb.InfrastructureProviderID
+	}
+
+	if !isAssociated && tenant != nil {
+		isAssociated = ipb.TenantID != nil && tenant.ID == *ipb.TenantID
+	}
+
+	if !isAssociated {
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "IP Block is not associated with org", nil)
+	}
+
+	// Get current usage from IPAM
+	ipamStorage := ipam.NewIpamStorage(gipbuh.dbSession.DB, nil)
+	usage, err := ipam.GetAddressUsageForIPBlock(ctx, ipamStorage, ipb)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving ipam usage for IPBlock")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage for IP Block", nil)
+	}
+
+	apiIPUsage, err := getAPIIPUsage(ctx, gipbuh.dbSession, cdbm.IPUsageResourceTypeIPBlock, ipb.ID, ipb.SiteID, usage, days)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving usage history for IPBlock from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage history for IP Block, DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiIPUsage)
+}
+
+// ~~~~~ Get Subnet Usage Handler ~~~~~ //
+
+// GetSubnetUsageHandler is the API Handler for retrieving usage trend of a Subnet
+type GetSubnetUsageHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetSubnetUsageHandler initializes and returns a new handler for retrieving usage trend of a Subnet
+func NewGetSubnetUsageHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetSubnetUsageHandler {
+	return GetSubnetUsageHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get Subnet usage
+// @Description Get current utilization, growth trend and projected exhaustion date of a Subnet
+// @Tags Subnet
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Subnet"
+// @Param days query integer false "Number of days of usage history to return, defaults to 30, max 90"
+// @Success 200 {object} model.APIIPUsage
+// @Router /v2/org/{org}/carbide/subnet/{id}/usage [get]
+func (gsuh GetSubnetUsageHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("Subnet", "GetUsage", c, gsuh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with Subnet endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role with org, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Get subnet ID from URL param
+	sStrID := c.Param("id")
+
+	gsuh.tracerSpan.SetAttribute(handlerSpan, attribute.String("subnet_id", sStrID), logger)
+
+	sID, err := uuid.Parse(sStrID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error parsing id in url into uuid")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Subnet ID in URL", nil)
+	}
+
+	days, err := getIPUsageHistoryDays(c)
+	if err != nil {
+		logger.Warn().Err(err).Msg("invalid value specified for days query param")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, gsuh.dbSession, org)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error getting tenant from org")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error retrieving Tenant from org", nil)
+	}
+
+	// Check that subnet exists
+	sDAO := cdbm.NewSubnetDAO(gsuh.dbSession)
+	subnet, err := sDAO.GetByID(ctx, nil, sID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Could not find Subnet with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Subnet DB entity")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Subnet, DB error", nil)
+	}
+
+	// verify tenant matches
+	if tenant.ID != subnet.TenantID {
+		logger.Warn().Msg("tenant in subnet does not belong to tenant in org")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Tenant for subnet in request does not match tenant in org", nil)
+	}
+
+	usage, err := ipam.GetAddressUsageForSubnet(ctx, nil, gsuh.dbSession, subnet)
+	if err != nil {
+		if errors.Is(err, ipam.ErrSubnetWithoutIPv4Prefix) {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Subnet does not have an IPv4 prefix assigned yet", nil)
+		}
+		logger.Error().Err(err).Msg("error computing usage for Subnet")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage for Subnet", nil)
+	}
+
+	apiIPUsage, err := getAPIIPUsage(ctx, gsuh.dbSession, cdbm.IPUsageResourceTypeSubnet, subnet.ID, subnet.SiteID, usage, days)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving usage history for Subnet from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage history for Subnet, DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiIPUsage)
+}
+
+// ~~~~~ Get VpcPrefix Usage Handler ~~~~~ //
+
+// GetVpcPrefixUsageHandler is the API Handler for retrieving usage trend of a VPC prefix
+type GetVpcPrefixUsageHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetVpcPrefixUsageHandler initializes and returns a new handler for retrieving usage trend of a VPC prefix
+func NewGetVpcPrefixUsageHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetVpcPrefixUsageHandler {
+	return GetVpcPrefixUsageHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get VPC prefix usage
+// @Description Get current utilization, growth trend and projected exhaustion date of a VPC prefix
+// @Tags VpcPrefix
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of VPC prefix"
+// @Param days query integer false "Number of days of usage history to return, defaults to 30, max 90"
+// @Success 200 {object} model.APIIPUsage
+// @Router /v2/org/{org}/carbide/vpc-prefix/{id}/usage [get]
+func (gvpuh GetVpcPrefixUsageHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("VpcPrefix", "GetUsage", c, gvpuh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with VPC prefix endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role with org, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Get VPC prefix ID from URL param
+	vpStrID := c.Param("id")
+
+	gvpuh.tracerSpan.SetAttribute(handlerSpan, attribute.String("VpcPrefixId", vpStrID), logger)
+
+	vpID, err := uuid.Parse(vpStrID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error parsing id in url into uuid")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid VPC prefix ID in URL", nil)
+	}
+
+	days, err := getIPUsageHistoryDays(c)
+	if err != nil {
+		logger.Warn().Err(err).Msg("invalid value specified for days query param")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, gvpuh.dbSession, org)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error getting tenant from org")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error retrieving Tenant from org", nil)
+	}
+
+	// Check that VPC prefix exists
+	vpDAO := cdbm.NewVpcPrefixDAO(gvpuh.dbSession)
+	vpcPrefix, err := vpDAO.GetByID(ctx, nil, vpID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Could not find VPC prefix with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving VPC prefix DB entity")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve VPC prefix, DB error", nil)
+	}
+
+	// verify tenant matches
+	if tenant.ID != vpcPrefix.TenantID {
+		logger.Warn().Msg("tenant in VPC prefix does not belong to tenant in org")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Tenant for VPC prefix in request does not match tenant in org", nil)
+	}
+
+	usage, err := ipam.GetAddressUsageForVpcPrefix(ctx, nil, gvpuh.dbSession, vpcPrefix)
+	if err != nil {
+		logger.Error().Err(err).Msg("error computing usage for VPC prefix")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage for VPC prefix", nil)
+	}
+
+	apiIPUsage, err := getAPIIPUsage(ctx, gvpuh.dbSession, cdbm.IPUsageResourceTypeVpcPrefix, vpcPrefix.ID, vpcPrefix.SiteID, usage, days)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving usage history for VPC prefix from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage history for VPC prefix, DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiIPUsage)
+}
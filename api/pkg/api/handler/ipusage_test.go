@@ -0,0 +1,393 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/otelecho"
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+)
+
+func testIPUsageSetupSchema(t *testing.T, dbSession *cdb.Session) {
+	common.TestSetupSchema(t, dbSession)
+	// create IP Usage Sample table
+	err := dbSession.DB.ResetModel(context.Background(), (*cdbm.IPUsageSample)(nil))
+	assert.Nil(t, err)
+}
+
+func TestIPUsageHandler_GetIPBlockUsage(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testIPUsageSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg2, tnOrgRoles)
+	testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	ipb1 := testFloatingIPBuildIPBlock(t, dbSession, "test-ipblock-1", st1, ip, tn1, "192.168.10.0", 24, ipu)
+
+	// Sample older than the requested period is excluded from history
+	cdbm.TestBuildIPUsageSample(t, dbSession, cdbm.IPUsageResourceTypeIPBlock, ipb1.ID, st1, "192.168.10.0/24", 256, 0, time.Now().AddDate(0, 0, -20))
+	cdbm.TestBuildIPUsageSample(t, dbSession, cdbm.IPUsageResourceTypeIPBlock, ipb1.ID, st1, "192.168.10.0/24", 256, 0, time.Now().AddDate(0, 0, -2))
+	cdbm.TestBuildIPUsageSample(t, dbSession, cdbm.IPUsageResourceTypeIPBlock, ipb1.ID, st1, "192.168.10.0/24", 256, 0, time.Now().AddDate(0, 0, -1))
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		org              string
+		user             *cdbm.User
+		id               string
+		query            string
+		wantResponseCode int
+		wantHistoryCount int
+	}{
+		{
+			name:             "Get IP Block usage as Tenant - success",
+			org:              tnOrg1,
+			user:             tnu1,
+			id:               ipb1.ID.String(),
+			wantResponseCode: http.StatusOK,
+			wantHistoryCount: 3,
+		},
+		{
+			name:             "Get IP Block usage as Provider for limited period - success",
+			org:              ipOrg,
+			user:             ipu,
+			id:               ipb1.ID.String(),
+			query:            "?days=7",
+			wantResponseCode: http.StatusOK,
+			wantHistoryCount: 2,
+		},
+		{
+			name:             "Get IP Block usage as another Tenant - fail",
+			org:              tnOrg2,
+			user:             tnu2,
+			id:               ipb1.ID.String(),
+			wantResponseCode: http.StatusForbidden,
+		},
+		{
+			name:             "Get IP Block usage with invalid days - fail",
+			org:              tnOrg1,
+			user:             tnu1,
+			id:               ipb1.ID.String(),
+			query:            "?days=365",
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Get usage of non-existent IP Block - fail",
+			org:              tnOrg1,
+			user:             tnu1,
+			id:               uuid.NewString(),
+			wantResponseCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := NewGetIPBlockUsageHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodGet, "/"+test.query, nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/ipblock/%s/usage", test.org, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(test.org, test.id)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := h.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetIPBlockUsageHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIIPUsage{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, cdbm.IPUsageResourceTypeIPBlock, rst.ResourceType)
+			assert.Equal(t, test.id, rst.ResourceID)
+			assert.Equal(t, "192.168.10.0/24", rst.Prefix)
+			assert.Equal(t, int64(256), rst.TotalIPs)
+			assert.Equal(t, int64(0), rst.UsedIPs)
+			assert.Equal(t, int64(256), rst.AvailableIPs)
+			assert.Nil(t, rst.ProjectedExhaustion)
+			assert.Equal(t, test.wantHistoryCount, len(rst.History))
+		})
+	}
+}
+
+func TestIPUsageHandler_GetSubnetUsage(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testIPUsageSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg2, tnOrgRoles)
+	testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	vpc1 := testInstanceBuildVPC(t, dbSession, "test-vpc-1", ip, tn1, st1, nil, nil, nil, nil, cdbm.VpcStatusReady, tnu1)
+
+	// Subnet with IPv4 prefix and gateway
+	subnet1 := testInstanceBuildSubnet(t, dbSession, "test-subnet-1", tn1, vpc1, nil, cdbm.SubnetStatusReady, tnu1)
+	subnet1.IPv4Prefix = cdb.GetStrPtr("10.10.0.0")
+	subnet1.PrefixLength = 28
+	subnet1.IPv4Gateway = cdb.GetStrPtr("10.10.0.1")
+	_, err := dbSession.DB.NewUpdate().Where("id = ?", subnet1.ID).Model(subnet1).Exec(ctx)
+	require.NoError(t, err)
+
+	// Subnet that has not been assigned a prefix yet
+	subnet2 := testInstanceBuildSubnet(t, dbSession, "test-subnet-2", tn1, vpc1, nil, cdbm.SubnetStatusPending, tnu1)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		org              string
+		user             *cdbm.User
+		id               string
+		wantResponseCode int
+	}{
+		{
+			name:             "Get Subnet usage - success",
+			org:              tnOrg1,
+			user:             tnu1,
+			id:               subnet1.ID.String(),
+			wantResponseCode: http.StatusOK,
+		},
+		{
+			name:             "Get usage of Subnet without prefix - fail",
+			org:              tnOrg1,
+			user:             tnu1,
+			id:               subnet2.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Get Subnet usage as another Tenant - fail",
+			org:              tnOrg2,
+			user:             tnu2,
+			id:               subnet1.ID.String(),
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Get Subnet usage as Provider - fail",
+			org:              ipOrg,
+			user:             ipu,
+			id:               subnet1.ID.String(),
+			wantResponseCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := NewGetSubnetUsageHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/subnet/%s/usage", test.org, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(test.org, test.id)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := h.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetSubnetUsageHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIIPUsage{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, cdbm.IPUsageResourceTypeSubnet, rst.ResourceType)
+			assert.Equal(t, "10.10.0.0/28", rst.Prefix)
+			assert.Equal(t, int64(16), rst.TotalIPs)
+			assert.Equal(t, int64(1), rst.UsedIPs)
+			assert.Equal(t, 0, len(rst.History))
+		})
+	}
+}
+
+func TestIPUsageHandler_GetVpcPrefixUsage(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testIPUsageSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-1", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	vpc1 := testInstanceBuildVPC(t, dbSession, "test-vpc-1", ip, tn1, st1, nil, nil, nil, nil, cdbm.VpcStatusReady, tnu1)
+	vp1 := testInstanceBuildVPCPrefix(t, dbSession, "test-vpc-prefix-1", tn1, vpc1, nil, "10.20.0.0/24", 24, cdbm.VpcPrefixStatusReady, tnu1)
+
+	cdbm.TestBuildIPUsageSample(t, dbSession, cdbm.IPUsageResourceTypeVpcPrefix, vp1.ID, st1, "10.20.0.0/24", 256, 0, time.Now().AddDate(0, 0, -1))
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		id               string
+		query            string
+		wantResponseCode int
+	}{
+		{
+			name:             "Get VPC Prefix usage - success",
+			id:               vp1.ID.String(),
+			wantResponseCode: http.StatusOK,
+		},
+		{
+			name:             "Get VPC Prefix usage with non-numeric days - fail",
+			id:               vp1.ID.String(),
+			query:            "?days=week",
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "Get usage of non-existent VPC Prefix - fail",
+			id:               uuid.NewString(),
+			wantResponseCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := NewGetVpcPrefixUsageHandler(dbSession, tc, cfg)
+
+			req := httptest.NewRequest(http.MethodGet, "/"+test.query, nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(fmt.Sprintf("/v2/org/%s/carbide/vpc-prefix/%s/usage", tnOrg1, test.id))
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg1, test.id)
+			ec.Set("user", tnu1)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := h.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("GetVpcPrefixUsageHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APIIPUsage{}
+			err = json.Unmarshal(rec.Body.Bytes(), rst)
+			require.NoError(t, err)
+			assert.Equal(t, cdbm.IPUsageResourceTypeVpcPrefix, rst.ResourceType)
+			assert.Equal(t, "10.20.0.0/24", rst.Prefix)
+			assert.Equal(t, int64(256), rst.TotalIPs)
+			assert.Equal(t, int64(0), rst.UsedIPs)
+			assert.Equal(t, float64(0), rst.GrowthPerDay)
+			assert.Equal(t, 1, len(rst.History))
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
 	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/pagination"
 	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/nsg"
 	auth "github.com/NVIDIA/ncx-infra-controller-rest/auth/pkg/authorization"
 	cutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
@@ -215,6 +216,18 @@ func (cnsgh CreateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 		rules[i] = newRule
 	}
 
+	// Resolve any address groups referenced by the rules
+	// and expand the rules into what will be sent to Carbide.
+	carbideRules, err := getNetworkSecurityGroupSiteRules(ctx, nil, cnsgh.dbSession, tenant.ID, site.ID, siteConfig, rules)
+	if err != nil {
+		if errors.Is(err, errInvalidNetworkSecurityGroupRules) {
+			logger.Warn().Err(err).Msg("unable to expand rules in request")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Unable to process rules in request: %s", err), nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Address Groups referenced by rules in request")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Address Groups referenced by rules in request", nil)
+	}
+
 	// Start a db tx
 	tx, err := cdb.BeginTx(ctx, cnsgh.dbSession, &sql.TxOptions{})
 	if err != nil {
@@ -285,14 +298,6 @@ func (cnsgh CreateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 		description = *networkSecurityGroup.Description
 	}
 
-	// Convert the DB rule wrappers into rules
-	// we can send to Carbide.
-	carbideRules := make([]*cwssaws.NetworkSecurityGroupRuleAttributes, len(rules))
-
-	for i, rule := range rules {
-		carbideRules[i] = rule.NetworkSecurityGroupRuleAttributes
-	}
-
 	// Prepare the create request workflow object
 	createNetworkSecurityGroupRequest := &cwssaws.CreateNetworkSecurityGroupRequest{
 		Id:                   &networkSecurityGroupID,
@@ -1210,6 +1215,24 @@ func (dnsgh UpdateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 		}
 	}
 
+	// Resolve any address groups referenced by the rules
+	// that will be in effect after the update and expand
+	// them into what will be sent to Carbide.
+	effectiveRules := nsg.Rules
+	if rules != nil {
+		effectiveRules = rules
+	}
+
+	carbideRules, err := getNetworkSecurityGroupSiteRules(ctx, nil, dnsgh.dbSession, nsg.TenantID, nsg.SiteID, siteConfig, effectiveRules)
+	if err != nil {
+		if errors.Is(err, errInvalidNetworkSecurityGroupRules) {
+			logger.Warn().Err(err).Msg("unable to expand rules in request")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Unable to process rules in request: %s", err), nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Address Groups referenced by rules in request")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Address Groups referenced by rules in request", nil)
+	}
+
 	// Start a DB transaction
 	tx, err := cdb.BeginTx(ctx, dnsgh.dbSession, &sql.TxOptions{})
 	if err != nil {
@@ -1253,42 +1276,8 @@ func (dnsgh UpdateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
 	}
 
-	// Prepare the labels for the metadata of the carbide call.
-	labels := []*cwssaws.Label{}
-	for k, v := range nsg.Labels {
-		labels = append(labels, &cwssaws.Label{
-			Key:   k,
-			Value: &v,
-		})
-	}
-
-	description := ""
-	if nsg.Description != nil {
-		description = *nsg.Description
-	}
-
-	// Convert the DB rule wrappers into rules
-	// we can send to Carbide.
-	carbideRules := make([]*cwssaws.NetworkSecurityGroupRuleAttributes, len(nsg.Rules))
-
-	for i, rule := range nsg.Rules {
-		carbideRules[i] = rule.NetworkSecurityGroupRuleAttributes
-	}
-
-	// Prepare the create request workflow object
-	updateNetworkSecurityGroupRequest := &cwssaws.UpdateNetworkSecurityGroupRequest{
-		Id:                   nsg.ID,
-		TenantOrganizationId: nsg.TenantOrg,
-		Metadata: &cwssaws.Metadata{
-			Name:        nsg.Name,
-			Description: description,
-			Labels:      labels,
-		},
-		NetworkSecurityGroupAttributes: &cwssaws.NetworkSecurityGroupAttributes{
-			StatefulEgress: nsg.StatefulEgress,
-			Rules:          carbideRules,
-		},
-	}
+	// Prepare the update request workflow object
+	updateNetworkSecurityGroupRequest := newUpdateNetworkSecurityGroupRequest(nsg, carbideRules)
 
 	workflowOptions := temporalClient.StartWorkflowOptions{
 		ID:                       "network-security-group-update-" + nsg.ID,
@@ -1355,3 +1344,400 @@ func (dnsgh UpdateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 	logger.Info().Msg("finishing API handler")
 	return c.JSON(http.StatusOK, apiNetworkSecurityGroup)
 }
+
+// ~~~~~ Evaluate Handler ~~~~~ //
+
+// EvaluateNetworkSecurityGroupHandler is the API Handler for evaluating a flow against the NetworkSecurityGroups of an Instance
+type EvaluateNetworkSecurityGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewEvaluateNetworkSecurityGroupHandler initializes and returns a new handler for evaluating NetworkSecurityGroup rules
+func NewEvaluateNetworkSecurityGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) EvaluateNetworkSecurityGroupHandler {
+	return EvaluateNetworkSecurityGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Evaluate a flow against NetworkSecurityGroups
+// @Description Determine whether a flow to or from an Instance would be allowed by the NetworkSecurityGroups attached to the Instance or its VPC, and which rule decides it
+// @Tags NetworkSecurityGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APINetworkSecurityGroupEvaluationRequest true "NetworkSecurityGroup evaluation request"
+// @Success 200 {object} model.APINetworkSecurityGroupEvaluation
+// @Router /v2/org/{org}/carbide/network-security-group/evaluate [post]
+func (ensgh EvaluateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("NetworkSecurityGroup", "Evaluate", c, ensgh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with NetworkSecurityGroup endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Validate request
+	// Bind request data to API model
+	apiRequest := model.APINetworkSecurityGroupEvaluationRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating NetworkSecurityGroup evaluation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Network Security Group evaluation request data", verr)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, ensgh.dbSession, org)
+	if err != nil {
+		if err == common.ErrOrgTenantNotFound {
+			logger.Warn().Err(err).Msg("Tenant not found for org in request")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Tenant not found for org in request", nil)
+		}
+		logger.Error().Err(err).Msg("unable to retrieve tenant for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	// Retrieve the Instance and its VPC
+	instanceDAO := cdbm.NewInstanceDAO(ensgh.dbSession)
+	instance, err := instanceDAO.GetByID(ctx, nil, uuid.MustParse(apiRequest.InstanceID), []string{cdbm.VpcRelationName})
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Could not find Instance with ID specified in request", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Instance from DB by ID")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Instance specified in request", nil)
+	}
+
+	if instance.TenantID != tenant.ID {
+		logger.Warn().Msg("Instance in request does not belong to Tenant of org")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "Instance specified in request does not belong to current Tenant", nil)
+	}
+
+	if instance.Vpc == nil {
+		logger.Error().Msg("error retrieving VPC as included relation for Instance")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve VPC details for Instance", nil)
+	}
+
+	// Build attachments for each level that has a NetworkSecurityGroup
+	nsgDAO := cdbm.NewNetworkSecurityGroupDAO(ensgh.dbSession)
+
+	getAttachment := func(level string, nsgID *string) (*nsg.Attachment, *cdbm.NetworkSecurityGroup, error) {
+		if nsgID == nil {
+			return nil, nil, nil
+		}
+
+		dbnsg, err := nsgDAO.GetByID(ctx, nil, *nsgID, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		addressGroups, err := getAddressGroupsForRules(ctx, nil, ensgh.dbSession, dbnsg.TenantID, &dbnsg.SiteID, dbnsg.Rules)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rules, err := nsg.NewRules(dbnsg.Rules, addressGroups)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &nsg.Attachment{Level: level, NetworkSecurityGroupID: dbnsg.ID, Rules: rules}, dbnsg, nil
+	}
+
+	instanceAttachment, instanceNSG, err := getAttachment(nsg.AttachmentLevelInstance, instance.NetworkSecurityGroupID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error preparing Instance NetworkSecurityGroup for evaluation")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Network Security Group attached to Instance", nil)
+	}
+
+	vpcAttachment, vpcNSG, err := getAttachment(nsg.AttachmentLevelVpc, instance.Vpc.NetworkSecurityGroupID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error preparing VPC NetworkSecurityGroup for evaluation")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Network Security Group attached to VPC of Instance", nil)
+	}
+
+	decision := nsg.Evaluate(apiRequest.ToFlow(), instanceAttachment, vpcAttachment)
+
+	var decidingRules []*cdbm.NetworkSecurityGroupRule
+	if decision.Attachment == instanceAttachment && instanceNSG != nil {
+		decidingRules = instanceNSG.Rules
+	} else if vpcNSG != nil {
+		decidingRules = vpcNSG.Rules
+	}
+
+	// Create response
+	apiEvaluation, err := model.NewAPINetworkSecurityGroupEvaluation(decision, decidingRules)
+	if err != nil {
+		logger.Error().Err(err).Msg("error converting NetworkSecurityGroup evaluation to API response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to prepare Network Security Group evaluation for response", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, apiEvaluation)
+}
+
+// ~~~~~ Lint Handler ~~~~~ //
+
+// LintNetworkSecurityGroupHandler is the API Handler for linting a set of NetworkSecurityGroup rules
+type LintNetworkSecurityGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewLintNetworkSecurityGroupHandler initializes and returns a new handler for linting NetworkSecurityGroup rules
+func NewLintNetworkSecurityGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) LintNetworkSecurityGroupHandler {
+	return LintNetworkSecurityGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Lint NetworkSecurityGroup rules
+// @Description Report shadowed, redundant and conflicting rules in a set of NetworkSecurityGroup rules before they are submitted
+// @Tags NetworkSecurityGroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APINetworkSecurityGroupLintRequest true "NetworkSecurityGroup lint request"
+// @Success 200 {object} model.APINetworkSecurityGroupLintResult
+// @Router /v2/org/{org}/carbide/network-security-group/lint [post]
+func (lnsgh LintNetworkSecurityGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("NetworkSecurityGroup", "Lint", c, lnsgh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to interact with NetworkSecurityGroup endpoints
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	// Validate request
+	// Bind request data to API model
+	apiRequest := model.APINetworkSecurityGroupLintRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating NetworkSecurityGroup lint request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Network Security Group lint request data", verr)
+	}
+
+	// Convert the request rules the same way create and update do,
+	// so lint rejects exactly what those endpoints would reject.
+	rules := make([]*cdbm.NetworkSecurityGroupRule, len(apiRequest.Rules))
+
+	for i, rule := range apiRequest.Rules {
+		newRule, err := model.ProtobufRuleFromAPINetworkSecurityGroupRule(&rule)
+		if err != nil {
+			logger.Warn().Err(err).Msg("unable to convert rules in request to internal rules")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Unable to process rules in request", err)
+		}
+
+		rules[i] = newRule
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, lnsgh.dbSession, org)
+	if err != nil {
+		if err == common.ErrOrgTenantNotFound {
+			logger.Warn().Err(err).Msg("Tenant not found for org in request")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Tenant not found for org in request", nil)
+		}
+		logger.Error().Err(err).Msg("unable to retrieve tenant for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	// Resolve address groups referenced by the rules within the Tenant of the org
+	addressGroups, err := getAddressGroupsForRules(ctx, nil, lnsgh.dbSession, tenant.ID, nil, rules)
+	if err != nil {
+		if errors.Is(err, errInvalidNetworkSecurityGroupRules) {
+			logger.Warn().Err(err).Msg("unable to resolve Address Groups referenced by rules in request")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Unable to process rules in request: %s", err), nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Address Groups referenced by rules in request")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Address Groups referenced by rules in request", nil)
+	}
+
+	evalRules, err := nsg.NewRules(rules, addressGroups)
+	if err != nil {
+		logger.Warn().Err(err).Msg("unable to prepare rules in request for linting")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Unable to process rules in request", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, model.NewAPINetworkSecurityGroupLintResult(nsg.Lint(evalRules)))
+}
+
+// ~~~~~ Address Group Helpers ~~~~~ //
+
+// errInvalidNetworkSecurityGroupRules is returned when rules cannot be converted into the rules sent to Site
+var errInvalidNetworkSecurityGroupRules = errors.New("invalid Network Security Group rules")
+
+// getAddressGroupsForRules retrieves the AddressGroups referenced by rules, keyed by ID.
+// Every referenced AddressGroup must belong to the Tenant and, if specified, the Site.
+func getAddressGroupsForRules(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, tenantID uuid.UUID, siteID *uuid.UUID, rules []*cdbm.NetworkSecurityGroupRule) (map[string]*cdbm.AddressGroup, error) {
+	addressGroups := map[string]*cdbm.AddressGroup{}
+
+	referenced := map[string]bool{}
+	ids := []uuid.UUID{}
+
+	for _, rule := range rules {
+		for _, id := range rule.AddressGroupIDs() {
+			if referenced[id] {
+				continue
+			}
+			referenced[id] = true
+
+			agID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("%w: Address Group ID `%s` is not valid", errInvalidNetworkSecurityGroupRules, id)
+			}
+			ids = append(ids, agID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return addressGroups, nil
+	}
+
+	agDAO := cdbm.NewAddressGroupDAO(dbSession)
+	ags, _, err := agDAO.GetAll(ctx, tx, cdbm.AddressGroupFilterInput{AddressGroupIDs: ids, TenantIDs: []uuid.UUID{tenantID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range ags {
+		ag := &ags[i]
+		if siteID != nil && ag.SiteID != *siteID {
+			return nil, fmt.Errorf("%w: Address Group `%s` belongs to a different Site", errInvalidNetworkSecurityGroupRules, ag.Name)
+		}
+		addressGroups[ag.ID.String()] = ag
+	}
+
+	for id := range referenced {
+		if addressGroups[id] == nil {
+			return nil, fmt.Errorf("%w: Address Group `%s` could not be found", errInvalidNetworkSecurityGroupRules, id)
+		}
+	}
+
+	return addressGroups, nil
+}
+
+// expandNetworkSecurityGroupRules converts stored rules into the literal-prefix rules sent to Site,
+// ensuring the expanded rules do not exceed the rule limit of the Site
+func expandNetworkSecurityGroupRules(rules []*cdbm.NetworkSecurityGroupRule, addressGroups map[string]*cdbm.AddressGroup, siteConfig *cdbm.SiteConfig) ([]*cwssaws.NetworkSecurityGroupRuleAttributes, error) {
+	carbideRules, err := nsg.ExpandRules(rules, addressGroups)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidNetworkSecurityGroupRules, err)
+	}
+
+	maxRules := model.MaxNetworkSecurityGroupRules
+	if siteConfig != nil && siteConfig.MaxNetworkSecurityGroupRuleCount != nil {
+		maxRules = *siteConfig.MaxNetworkSecurityGroupRuleCount
+	}
+
+	if len(carbideRules) > maxRules {
+		return nil, fmt.Errorf("%w: rules expand to %d Site rules after resolving Address Groups, which exceeds the maximum of %d", errInvalidNetworkSecurityGroupRules, len(carbideRules), maxRules)
+	}
+
+	return carbideRules, nil
+}
+
+// getNetworkSecurityGroupSiteRules resolves the AddressGroups referenced by rules and returns the rules to send to Site
+func getNetworkSecurityGroupSiteRules(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, tenantID uuid.UUID, siteID uuid.UUID, siteConfig *cdbm.SiteConfig, rules []*cdbm.NetworkSecurityGroupRule) ([]*cwssaws.NetworkSecurityGroupRuleAttributes, error) {
+	addressGroups, err := getAddressGroupsForRules(ctx, tx, dbSession, tenantID, &siteID, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return expandNetworkSecurityGroupRules(rules, addressGroups, siteConfig)
+}
+
+// newUpdateNetworkSecurityGroupRequest prepares the Site workflow request to update a NetworkSecurityGroup
+func newUpdateNetworkSecurityGroupRequest(dbnsg *cdbm.NetworkSecurityGroup, carbideRules []*cwssaws.NetworkSecurityGroupRuleAttributes) *cwssaws.UpdateNetworkSecurityGroupRequest {
+	// Prepare the labels for the metadata of the carbide call.
+	labels := []*cwssaws.Label{}
+	for k, v := range dbnsg.Labels {
+		labels = append(labels, &cwssaws.Label{
+			Key:   k,
+			Value: &v,
+		})
+	}
+
+	description := ""
+	if dbnsg.Description != nil {
+		description = *dbnsg.Description
+	}
+
+	return &cwssaws.UpdateNetworkSecurityGroupRequest{
+		Id:                   dbnsg.ID,
+		TenantOrganizationId: dbnsg.TenantOrg,
+		Metadata: &cwssaws.Metadata{
+			Name:        dbnsg.Name,
+			Description: description,
+			Labels:      labels,
+		},
+		NetworkSecurityGroupAttributes: &cwssaws.NetworkSecurityGroupAttributes{
+			StatefulEgress: dbnsg.StatefulEgress,
+			Rules:          carbideRules,
+		},
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/api/internal/config"
 	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
 	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/nsg"
 	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/otelecho"
 	sutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
 	swe "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/error"
@@ -2300,3 +2301,365 @@ func TestNetworkSecurityGroupHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkSecurityGroupHandler_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-3", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+	assert.NotNil(t, tn2)
+
+	ts1 := testBuildTenantSiteAssociation(t, dbSession, tnOrg1, tn1.ID, st1.ID, tnu1.ID)
+	assert.NotNil(t, ts1)
+
+	instanceRules := []*cdbm.NetworkSecurityGroupRule{
+		{
+			NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+				Id:             cdb.GetStrPtr("deny-bad-host"),
+				Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+				Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+				Action:         cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY,
+				Priority:       50,
+				SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: "10.1.2.3/32"},
+				DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+			},
+		},
+		{
+			NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+				Id:             cdb.GetStrPtr("allow-postgres"),
+				Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+				Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+				Action:         cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT,
+				Priority:       100,
+				DstPortStart:   getIntPtrToUint32Ptr(cdb.GetIntPtr(5432)),
+				DstPortEnd:     getIntPtrToUint32Ptr(cdb.GetIntPtr(5432)),
+				SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: "10.1.0.0/16"},
+				DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+			},
+		},
+	}
+
+	vpcRules := []*cdbm.NetworkSecurityGroupRule{
+		{
+			NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+				Id:             cdb.GetStrPtr("allow-all"),
+				Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+				Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_ANY,
+				Action:         cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT,
+				Priority:       0,
+				SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: "0.0.0.0/0"},
+				DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+			},
+		},
+	}
+
+	instanceNSG := testBuildNetworkSecurityGroup(t, dbSession, "instance-nsg", tn1, st1, cdbm.NetworkSecurityGroupStatusReady)
+	instanceNSG.Rules = instanceRules
+	testUpdateNetworkSecurityGroup(t, dbSession, instanceNSG)
+
+	vpcNSG := testBuildNetworkSecurityGroup(t, dbSession, "vpc-nsg", tn1, st1, cdbm.NetworkSecurityGroupStatusReady)
+	vpcNSG.Rules = vpcRules
+	testUpdateNetworkSecurityGroup(t, dbSession, vpcNSG)
+
+	vpc1 := testVPCBuildVPC(t, dbSession, "vpc1", ip, tn1, st1, nil, nil, nil, cdbm.VpcStatusReady, tnu1)
+	vpc1.NetworkSecurityGroupID = cdb.GetStrPtr(vpcNSG.ID)
+	testUpdateVPC(t, dbSession, vpc1)
+
+	ist1 := testInstanceBuildInstanceType(t, dbSession, ip, "test-instance-type-1", st1, cdbm.InstanceStatusReady)
+	mc1 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(false), nil)
+	mc2 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(false), nil)
+
+	inst1 := testInstanceBuildInstance(t, dbSession, "test-instance-1", tn1.ID, ip.ID, st1.ID, &ist1.ID, vpc1.ID, cdb.GetStrPtr(mc1.ID), nil, nil, cdbm.InstanceStatusReady)
+	inst1.NetworkSecurityGroupID = cdb.GetStrPtr(instanceNSG.ID)
+	testUpdateInstance(t, dbSession, inst1)
+
+	inst2 := testInstanceBuildInstance(t, dbSession, "test-instance-2", tn1.ID, ip.ID, st1.ID, &ist1.ID, vpc1.ID, cdb.GetStrPtr(mc2.ID), nil, nil, cdbm.InstanceStatusReady)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	// OTEL Spanner configuration
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name             string
+		org              string
+		user             *cdbm.User
+		request          model.APINetworkSecurityGroupEvaluationRequest
+		wantResponseCode int
+		wantAllowed      bool
+		wantNSGID        *string
+		wantRuleName     *string
+		wantOverridden   *string
+	}{
+		{
+			name: "instance level rule permits flow",
+			org:  tnOrg1,
+			user: tnu1,
+			request: model.APINetworkSecurityGroupEvaluationRequest{
+				InstanceID: inst1.ID.String(), Direction: "INGRESS", Protocol: "TCP",
+				SourceAddress: "10.1.9.9", DestinationAddress: "192.168.0.10", DestinationPort: cdb.GetIntPtr(5432),
+			},
+			wantResponseCode: http.StatusOK,
+			wantAllowed:      true,
+			wantNSGID:        cdb.GetStrPtr(instanceNSG.ID),
+			wantRuleName:     cdb.GetStrPtr("allow-postgres"),
+			wantOverridden:   cdb.GetStrPtr(vpcNSG.ID),
+		},
+		{
+			name: "instance level rule denies flow despite permissive VPC rules",
+			org:  tnOrg1,
+			user: tnu1,
+			request: model.APINetworkSecurityGroupEvaluationRequest{
+				InstanceID: inst1.ID.String(), Direction: "INGRESS", Protocol: "TCP",
+				SourceAddress: "10.1.2.3", DestinationAddress: "192.168.0.10", DestinationPort: cdb.GetIntPtr(5432),
+			},
+			wantResponseCode: http.StatusOK,
+			wantAllowed:      false,
+			wantNSGID:        cdb.GetStrPtr(instanceNSG.ID),
+			wantRuleName:     cdb.GetStrPtr("deny-bad-host"),
+			wantOverridden:   cdb.GetStrPtr(vpcNSG.ID),
+		},
+		{
+			name: "VPC level rule applies to instance without its own NSG",
+			org:  tnOrg1,
+			user: tnu1,
+			request: model.APINetworkSecurityGroupEvaluationRequest{
+				InstanceID: inst2.ID.String(), Direction: "INGRESS", Protocol: "TCP",
+				SourceAddress: "10.1.2.3", DestinationAddress: "192.168.0.11", DestinationPort: cdb.GetIntPtr(5432),
+			},
+			wantResponseCode: http.StatusOK,
+			wantAllowed:      true,
+			wantNSGID:        cdb.GetStrPtr(vpcNSG.ID),
+			wantRuleName:     cdb.GetStrPtr("allow-all"),
+		},
+		{
+			name: "invalid request is rejected",
+			org:  tnOrg1,
+			user: tnu1,
+			request: model.APINetworkSecurityGroupEvaluationRequest{
+				InstanceID: inst1.ID.String(), Direction: "INGRESS", Protocol: "ANY",
+				SourceAddress: "10.1.2.3", DestinationAddress: "192.168.0.10",
+			},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown instance is not found",
+			org:  tnOrg1,
+			user: tnu1,
+			request: model.APINetworkSecurityGroupEvaluationRequest{
+				InstanceID: uuid.NewString(), Direction: "INGRESS", Protocol: "TCP",
+				SourceAddress: "10.1.2.3", DestinationAddress: "192.168.0.10",
+			},
+			wantResponseCode: http.StatusNotFound,
+		},
+		{
+			name: "instance of another tenant is forbidden",
+			org:  tnOrg2,
+			user: tnu2,
+			request: model.APINetworkSecurityGroupEvaluationRequest{
+				InstanceID: inst1.ID.String(), Direction: "INGRESS", Protocol: "TCP",
+				SourceAddress: "10.1.2.3", DestinationAddress: "192.168.0.10",
+			},
+			wantResponseCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ensgh := NewEvaluateNetworkSecurityGroupHandler(dbSession, tc, cfg)
+
+			body, err := json.Marshal(test.request)
+			require.NoError(t, err)
+
+			path := fmt.Sprintf("/v2/org/%s/carbide/network-security-group/evaluate", test.org)
+
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(string(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(test.org)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err = ensgh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("EvaluateNetworkSecurityGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APINetworkSecurityGroupEvaluation{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), rst))
+
+			assert.Equal(t, test.wantAllowed, rst.Allowed)
+			assert.Equal(t, test.wantNSGID, rst.NetworkSecurityGroupID)
+			assert.Equal(t, test.wantOverridden, rst.OverriddenNetworkSecurityGroupID)
+
+			if test.wantRuleName != nil {
+				require.NotNil(t, rst.MatchedRule)
+				assert.Equal(t, *test.wantRuleName, *rst.MatchedRule.Name)
+			}
+		})
+	}
+}
+
+func TestNetworkSecurityGroupHandler_Lint(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+
+	tnOrg := "test-tenant-org-1"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg, tnOrgRoles)
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-3", tnOrg, []string{"FORGE_TENANT_USER"})
+
+	testInstanceBuildTenant(t, dbSession, "test-tenant", tnOrg, tnu1)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	// OTEL Spanner configuration
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	denyAll := model.APINetworkSecurityGroupRule{
+		Name:              cdb.GetStrPtr("deny-all"),
+		Direction:         model.APINetworkSecurityGroupRuleDirectionIngress,
+		Protocol:          model.APINetworkSecurityGroupRuleProtocolAny,
+		Action:            model.APINetworkSecurityGroupRuleActionDeny,
+		Priority:          10,
+		SourcePrefix:      cdb.GetStrPtr("0.0.0.0/0"),
+		DestinationPrefix: cdb.GetStrPtr("0.0.0.0/0"),
+	}
+
+	allowSSH := model.APINetworkSecurityGroupRule{
+		Name:                 cdb.GetStrPtr("allow-ssh"),
+		Direction:            model.APINetworkSecurityGroupRuleDirectionIngress,
+		Protocol:             model.APINetworkSecurityGroupRuleProtocolTcp,
+		Action:               model.APINetworkSecurityGroupRuleActionPermit,
+		Priority:             20,
+		DestinationPortRange: cdb.GetStrPtr("22"),
+		SourcePrefix:         cdb.GetStrPtr("10.0.0.0/8"),
+		DestinationPrefix:    cdb.GetStrPtr("0.0.0.0/0"),
+	}
+
+	badPrefix := allowSSH
+	badPrefix.SourcePrefix = cdb.GetStrPtr("10.0.0.0/33")
+
+	tests := []struct {
+		name             string
+		user             *cdbm.User
+		request          model.APINetworkSecurityGroupLintRequest
+		wantResponseCode int
+		wantValid        bool
+		wantTypes        []string
+	}{
+		{
+			name:             "shadowed rule is reported",
+			user:             tnu1,
+			request:          model.APINetworkSecurityGroupLintRequest{Rules: []model.APINetworkSecurityGroupRule{denyAll, allowSSH}},
+			wantResponseCode: http.StatusOK,
+			wantValid:        false,
+			wantTypes:        []string{nsg.FindingTypeShadowed},
+		},
+		{
+			name:             "clean rules are valid",
+			user:             tnu1,
+			request:          model.APINetworkSecurityGroupLintRequest{Rules: []model.APINetworkSecurityGroupRule{allowSSH}},
+			wantResponseCode: http.StatusOK,
+			wantValid:        true,
+			wantTypes:        []string{},
+		},
+		{
+			name:             "invalid rule is rejected",
+			user:             tnu1,
+			request:          model.APINetworkSecurityGroupLintRequest{Rules: []model.APINetworkSecurityGroupRule{badPrefix}},
+			wantResponseCode: http.StatusBadRequest,
+		},
+		{
+			name:             "user without Tenant Admin role is forbidden",
+			user:             tnu2,
+			request:          model.APINetworkSecurityGroupLintRequest{Rules: []model.APINetworkSecurityGroupRule{allowSSH}},
+			wantResponseCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lnsgh := NewLintNetworkSecurityGroupHandler(dbSession, tc, cfg)
+
+			body, err := json.Marshal(test.request)
+			require.NoError(t, err)
+
+			path := fmt.Sprintf("/v2/org/%s/carbide/network-security-group/lint", tnOrg)
+
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(string(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(tnOrg)
+			ec.Set("user", test.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err = lnsgh.Handle(ec)
+			require.NoError(t, err)
+
+			if !assert.Equal(t, test.wantResponseCode, rec.Code) {
+				t.Errorf("LintNetworkSecurityGroupHandler.Handle() response = %s", rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			rst := &model.APINetworkSecurityGroupLintResult{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), rst))
+
+			assert.Equal(t, test.wantValid, rst.Valid)
+
+			gotTypes := []string{}
+			for _, f := range rst.Findings {
+				gotTypes = append(gotTypes, f.Type)
+			}
+			assert.Equal(t, test.wantTypes, gotTypes)
+		})
+	}
+}
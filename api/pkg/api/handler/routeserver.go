@@ -0,0 +1,459 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/internal/config"
+	common "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	auth "github.com/NVIDIA/ncx-infra-controller-rest/auth/pkg/authorization"
+	cutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/queue"
+	tclient "go.temporal.io/sdk/client"
+	tp "go.temporal.io/sdk/temporal"
+)
+
+// GetAllRouteServerHandler is the API Handler for getting all route servers of a Site
+type GetAllRouteServerHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllRouteServerHandler initializes and returns a new handler for getting all route servers of a Site
+func NewGetAllRouteServerHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) GetAllRouteServerHandler {
+	return GetAllRouteServerHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all route servers of a Site
+// @Description Get all route servers configured on a Site, either in the Site config file or through the API
+// @Tags RouteServer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteID path string true "ID of Site"
+// @Success 200 {object} []model.APIRouteServer
+// @Router /v2/org/{org}/carbide/site/{siteID}/route-server [get]
+func (garsh GetAllRouteServerHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("RouteServer", "GetAll", c, garsh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	site, apiErr := getRouteServerSiteForOrg(ctx, logger, garsh.dbSession, dbUser, org, c.Param("siteID"))
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	stc, err := garsh.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	apiRouteServers, apiErr := getRouteServersOnSite(ctx, logger, stc, site)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiRouteServers)
+}
+
+// CreateRouteServerHandler is the API Handler for adding route servers to a Site
+type CreateRouteServerHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateRouteServerHandler initializes and returns a new handler for adding route servers to a Site
+func NewCreateRouteServerHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) CreateRouteServerHandler {
+	return CreateRouteServerHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Add route servers to a Site
+// @Description Add route servers to a Site, the route servers are used by all VPCs of the Site
+// @Tags RouteServer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteID path string true "ID of Site"
+// @Param message body model.APIRouteServerCreateRequest true "Route server add request"
+// @Success 201 {object} []model.APIRouteServer
+// @Router /v2/org/{org}/carbide/site/{siteID}/route-server [post]
+func (crsh CreateRouteServerHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("RouteServer", "Create", c, crsh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	site, apiErr := getRouteServerSiteForOrg(ctx, logger, crsh.dbSession, dbUser, org, c.Param("siteID"))
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	apiRequest := model.APIRouteServerCreateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating route server add request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating route server add request data", verr)
+	}
+
+	stc, err := crsh.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	apiErr = executeRouteServerWorkflow(ctx, logger, stc, "AddRouteServers", "route-server-add-"+site.ID.String(), apiRequest.ToProto(), nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	apiRouteServers, apiErr := getRouteServersOnSite(ctx, logger, stc, site)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, apiRouteServers)
+}
+
+// UpdateRouteServerHandler is the API Handler for replacing the route servers of a Site set through the API
+type UpdateRouteServerHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewUpdateRouteServerHandler initializes and returns a new handler for replacing the route servers of a Site
+func NewUpdateRouteServerHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) UpdateRouteServerHandler {
+	return UpdateRouteServerHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Replace the route servers of a Site
+// @Description Replace the route servers of a Site set through the API, route servers from the Site config file are left untouched
+// @Tags RouteServer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteID path string true "ID of Site"
+// @Param message body model.APIRouteServerUpdateRequest true "Route server update request"
+// @Success 200 {object} []model.APIRouteServer
+// @Router /v2/org/{org}/carbide/site/{siteID}/route-server [patch]
+func (ursh UpdateRouteServerHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("RouteServer", "Update", c, ursh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	site, apiErr := getRouteServerSiteForOrg(ctx, logger, ursh.dbSession, dbUser, org, c.Param("siteID"))
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	apiRequest := model.APIRouteServerUpdateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating route server update request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating route server update request data", verr)
+	}
+
+	stc, err := ursh.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	apiErr = executeRouteServerWorkflow(ctx, logger, stc, "ReplaceRouteServers", "route-server-replace-"+site.ID.String(), apiRequest.ToProto(), nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	apiRouteServers, apiErr := getRouteServersOnSite(ctx, logger, stc, site)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiRouteServers)
+}
+
+// DeleteRouteServerHandler is the API Handler for removing a route server from a Site
+type DeleteRouteServerHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDeleteRouteServerHandler initializes and returns a new handler for removing a route server from a Site
+func NewDeleteRouteServerHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) DeleteRouteServerHandler {
+	return DeleteRouteServerHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Remove a route server from a Site
+// @Description Remove a route server set through the API from a Site
+// @Tags RouteServer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteID path string true "ID of Site"
+// @Param address path string true "IP address of route server"
+// @Success 202
+// @Router /v2/org/{org}/carbide/site/{siteID}/route-server/{address} [delete]
+func (drsh DeleteRouteServerHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("RouteServer", "Delete", c, drsh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	site, apiErr := getRouteServerSiteForOrg(ctx, logger, drsh.dbSession, dbUser, org, c.Param("siteID"))
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	ip := net.ParseIP(c.Param("address"))
+	if ip == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Route server address specified in request is not a valid IP address", nil)
+	}
+	address := ip.String()
+
+	stc, err := drsh.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	apiRouteServers, apiErr := getRouteServersOnSite(ctx, logger, stc, site)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	var routeServer *model.APIRouteServer
+	for _, apiRouteServer := range apiRouteServers {
+		if rsIP := net.ParseIP(apiRouteServer.Address); rsIP != nil && rsIP.Equal(ip) {
+			routeServer = apiRouteServer
+			break
+		}
+	}
+	if routeServer == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusNotFound, fmt.Sprintf("Could not find route server with address: %s on Site", address), nil)
+	}
+	if routeServer.SourceType != model.RouteServerSourceTypeAdminAPI {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Route server is configured in the Site config file and cannot be removed through the API", nil)
+	}
+
+	apiErr = executeRouteServerWorkflow(ctx, logger, stc, "RemoveRouteServers", "route-server-remove-"+site.ID.String(), &cwssaws.RouteServers{
+		RouteServers: []string{routeServer.Address},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}, nil)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusAccepted, "Deletion request was accepted")
+}
+
+// getRouteServerSiteForOrg returns the Site specified in the request if the User is a Provider Admin of the Provider owning it.
+// Route servers are shared by all VPCs of a Site, so only the Provider can change them.
+func getRouteServerSiteForOrg(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, dbUser *cdbm.User, org string, siteIDStr string) (*cdbm.Site, *cutil.APIError) {
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return nil, cutil.NewAPIError(http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Provider Admins are allowed to manage route servers
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.ProviderAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Provider Admin role, access denied")
+		return nil, cutil.NewAPIError(http.StatusForbidden, "User does not have Provider Admin role with org", nil)
+	}
+
+	ip, err := common.GetInfrastructureProviderForOrg(ctx, nil, dbSession, org)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error getting infrastructure provider for org")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to retrieve Infrastructure Provider for org", nil)
+	}
+
+	site, err := common.GetSiteFromIDString(ctx, nil, siteIDStr, dbSession)
+	if err != nil {
+		logger.Warn().Err(err).Str("Site ID", siteIDStr).Msg("error getting site from request")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Error retrieving Site in request", nil)
+	}
+
+	if site.InfrastructureProviderID != ip.ID {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Site specified in request doesn't belong to current org's Provider", nil)
+	}
+
+	return site, nil
+}
+
+// getRouteServersOnSite retrieves the route servers configured on Site
+func getRouteServersOnSite(ctx context.Context, logger zerolog.Logger, stc tclient.Client, site *cdbm.Site) ([]*model.APIRouteServer, *cutil.APIError) {
+	entries := &cwssaws.RouteServerEntries{}
+	apiErr := executeRouteServerWorkflow(ctx, logger, stc, "GetRouteServers", "route-server-get-all-"+site.ID.String(), nil, entries)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	apiRouteServers := []*model.APIRouteServer{}
+	for _, entry := range entries.GetRouteServers() {
+		apiRouteServers = append(apiRouteServers, model.NewAPIRouteServer(entry))
+	}
+
+	return apiRouteServers, nil
+}
+
+// executeRouteServerWorkflow synchronously executes a route server workflow on Site. request is omitted when nil and response is
+// only populated when not nil.
+func executeRouteServerWorkflow(ctx context.Context, logger zerolog.Logger, stc tclient.Client, workflowName string, workflowID string, request *cwssaws.RouteServers, response interface{}) *cutil.APIError {
+	workflowOptions := tclient.StartWorkflowOptions{
+		ID:                       workflowID,
+		TaskQueue:                queue.SiteTaskQueue,
+		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
+	}
+
+	args := []interface{}{}
+	if request != nil {
+		args = append(args, request)
+	}
+
+	// Add context deadlines
+	ctx, cancel := context.WithTimeout(ctx, cutil.WorkflowContextTimeout)
+	defer cancel()
+
+	we, err := stc.ExecuteWorkflow(ctx, workflowOptions, workflowName, args...)
+	if err != nil {
+		logger.Error().Err(err).Str("Workflow", workflowName).Msg("failed to start Temporal workflow for route servers on Site")
+		return cutil.NewAPIError(http.StatusInternalServerError, "Failed to start sync workflow for route servers on Site", nil)
+	}
+
+	wid := we.GetID()
+	logger.Info().Str("Workflow ID", wid).Str("Workflow", workflowName).Msg("executed synchronous route servers workflow")
+
+	err = we.Get(ctx, response)
+	if err != nil {
+		var timeoutErr *tp.TimeoutError
+		if errors.As(err, &timeoutErr) || errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil {
+			// Terminate the workflow so it does not change route servers later
+			newctx, newcancel := context.WithTimeout(context.Background(), cutil.WorkflowContextNewAfterTimeout)
+			defer newcancel()
+
+			serr := stc.TerminateWorkflow(newctx, wid, "", fmt.Sprintf("timeout occurred executing %s workflow", workflowName))
+			if serr != nil {
+				logger.Error().Err(serr).Str("Workflow", workflowName).Msg("failed to terminate route servers workflow after timeout")
+			}
+			return cutil.NewAPIError(http.StatusInternalServerError, fmt.Sprintf("Failed to execute %s, timeout occurred executing workflow on Site: %s", workflowName, err), nil)
+		}
+
+		code, uerr := common.UnwrapWorkflowError(err)
+		logger.Error().Err(uerr).Str("Workflow ID", wid).Str("Workflow", workflowName).Msg("failed to synchronously execute Temporal workflow for route servers")
+		return cutil.NewAPIError(code, fmt.Sprintf("Failed to execute %s on Site: %s", workflowName, uerr), nil)
+	}
+
+	logger.Info().Str("Workflow ID", wid).Str("Workflow", workflowName).Msg("completed synchronous route servers workflow")
+
+	return nil
+}
@@ -0,0 +1,477 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	tmocks "go.temporal.io/sdk/mocks"
+	tp "go.temporal.io/sdk/temporal"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model"
+	sc "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/client/site"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/otelecho"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+// testRouteServerSiteClient returns a Site client whose route server workflows either succeed or time out.
+// GetRouteServers returns the present route servers.
+func testRouteServerSiteClient(timeout bool, present []*cwssaws.RouteServer) *tmocks.Client {
+	tsc := &tmocks.Client{}
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	if timeout {
+		wrun.Mock.On("Get", mock.Anything, mock.Anything).Return(tp.NewTimeoutError(enums.TIMEOUT_TYPE_UNSPECIFIED, nil, nil))
+		tsc.Mock.On("TerminateWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	} else {
+		wrun.Mock.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			if entries, ok := args.Get(1).(*cwssaws.RouteServerEntries); ok {
+				entries.RouteServers = present
+			}
+		}).Return(nil)
+	}
+
+	tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"GetRouteServers").Return(wrun, nil)
+	for _, workflowName := range []string{"AddRouteServers", "RemoveRouteServers", "ReplaceRouteServers"} {
+		tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+			workflowName, mock.Anything).Return(wrun, nil)
+	}
+
+	return tsc
+}
+
+// testRouteServerWorkflowRequest returns the route servers request of the call to ExecuteWorkflow for workflowName
+func testRouteServerWorkflowRequest(t *testing.T, tsc *tmocks.Client, workflowName string) *cwssaws.RouteServers {
+	for _, call := range tsc.Calls {
+		if call.Method != "ExecuteWorkflow" || call.Arguments.Get(2) != workflowName {
+			continue
+		}
+		request, ok := call.Arguments.Get(3).(*cwssaws.RouteServers)
+		require.True(t, ok)
+		return request
+	}
+	require.Failf(t, "workflow not executed", "%s was not executed", workflowName)
+	return nil
+}
+
+func TestGetAllRouteServerHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testMachineInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-ip-org-1"
+	pvu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_PROVIDER_ADMIN"})
+	tnu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_TENANT_ADMIN"})
+
+	ip := testMachineBuildInfrastructureProvider(t, dbSession, ipOrg, "infra-provider-1")
+	site := testMachineBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered)
+
+	ip2 := testMachineBuildInfrastructureProvider(t, dbSession, "test-ip-org-2", "infra-provider-2")
+	site2 := testMachineBuildSite(t, dbSession, ip2, "test-site-2", cdbm.SiteStatusRegistered)
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	present := []*cwssaws.RouteServer{
+		{Address: "10.0.0.1", SourceType: cwssaws.RouteServerSourceType_ConfigFile},
+		{Address: "10.0.0.2", SourceType: cwssaws.RouteServerSourceType_AdminApi},
+	}
+
+	tests := []struct {
+		name           string
+		user           *cdbm.User
+		siteID         string
+		tsc            *tmocks.Client
+		expectedStatus int
+	}{
+		{
+			name:           "error when user is not a Provider Admin",
+			user:           tnu,
+			siteID:         site.ID.String(),
+			tsc:            testRouteServerSiteClient(false, present),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "error when Site belongs to another Provider",
+			user:           pvu,
+			siteID:         site2.ID.String(),
+			tsc:            testRouteServerSiteClient(false, present),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "error when workflow times out",
+			user:           pvu,
+			siteID:         site.ID.String(),
+			tsc:            testRouteServerSiteClient(true, nil),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "success",
+			user:           pvu,
+			siteID:         site.ID.String(),
+			tsc:            testRouteServerSiteClient(false, present),
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scp := sc.NewClientPool(tcfg)
+			scp.IDClientMap[site.ID.String()] = tc.tsc
+			scp.IDClientMap[site2.ID.String()] = tc.tsc
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "siteID")
+			ec.SetParamValues(ipOrg, tc.siteID)
+			ec.Set("user", tc.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			garsh := NewGetAllRouteServerHandler(dbSession, &tmocks.Client{}, scp, cfg)
+			err := garsh.Handle(ec)
+			assert.Nil(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var apiRouteServers []model.APIRouteServer
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiRouteServers))
+			require.Len(t, apiRouteServers, 2)
+			assert.Equal(t, model.APIRouteServer{Address: "10.0.0.1", SourceType: model.RouteServerSourceTypeConfigFile}, apiRouteServers[0])
+			assert.Equal(t, model.APIRouteServer{Address: "10.0.0.2", SourceType: model.RouteServerSourceTypeAdminAPI}, apiRouteServers[1])
+		})
+	}
+}
+
+func TestCreateRouteServerHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testMachineInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-ip-org-1"
+	pvu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_PROVIDER_ADMIN"})
+	tnu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_TENANT_ADMIN"})
+
+	ip := testMachineBuildInfrastructureProvider(t, dbSession, ipOrg, "infra-provider-1")
+	site := testMachineBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered)
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name           string
+		user           *cdbm.User
+		reqBody        string
+		timeout        bool
+		expectedStatus int
+	}{
+		{
+			name:           "error when user is not a Provider Admin",
+			user:           tnu,
+			reqBody:        `{"routeServers": ["10.0.0.2"]}`,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "error when no route server is specified",
+			user:           pvu,
+			reqBody:        `{"routeServers": []}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "error when route server address is invalid",
+			user:           pvu,
+			reqBody:        `{"routeServers": ["10.0.0.300"]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "error when workflow times out",
+			user:           pvu,
+			reqBody:        `{"routeServers": ["10.0.0.2"]}`,
+			timeout:        true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "success",
+			user:           pvu,
+			reqBody:        `{"routeServers": ["10.0.0.2", "fd00::2"]}`,
+			expectedStatus: http.StatusCreated,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tsc := testRouteServerSiteClient(tc.timeout, []*cwssaws.RouteServer{
+				{Address: "10.0.0.2", SourceType: cwssaws.RouteServerSourceType_AdminApi},
+				{Address: "fd00::2", SourceType: cwssaws.RouteServerSourceType_AdminApi},
+			})
+			scp := sc.NewClientPool(tcfg)
+			scp.IDClientMap[site.ID.String()] = tsc
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "siteID")
+			ec.SetParamValues(ipOrg, site.ID.String())
+			ec.Set("user", tc.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			crsh := NewCreateRouteServerHandler(dbSession, &tmocks.Client{}, scp, cfg)
+			err := crsh.Handle(ec)
+			assert.Nil(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+			if tc.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			request := testRouteServerWorkflowRequest(t, tsc, "AddRouteServers")
+			assert.Equal(t, []string{"10.0.0.2", "fd00::2"}, request.RouteServers)
+			assert.Equal(t, cwssaws.RouteServerSourceType_AdminApi, request.SourceType)
+
+			var apiRouteServers []model.APIRouteServer
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiRouteServers))
+			assert.Len(t, apiRouteServers, 2)
+		})
+	}
+}
+
+func TestUpdateRouteServerHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testMachineInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-ip-org-1"
+	pvu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_PROVIDER_ADMIN"})
+	tnu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_TENANT_ADMIN"})
+
+	ip := testMachineBuildInfrastructureProvider(t, dbSession, ipOrg, "infra-provider-1")
+	site := testMachineBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered)
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name                 string
+		user                 *cdbm.User
+		reqBody              string
+		expectedStatus       int
+		expectedRouteServers []string
+	}{
+		{
+			name:           "error when user is not a Provider Admin",
+			user:           tnu,
+			reqBody:        `{"routeServers": ["10.0.0.3"]}`,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "error when route servers are not specified",
+			user:           pvu,
+			reqBody:        `{}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "error when route server address is invalid",
+			user:           pvu,
+			reqBody:        `{"routeServers": ["not-an-ip"]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:                 "success replacing route servers",
+			user:                 pvu,
+			reqBody:              `{"routeServers": ["10.0.0.3"]}`,
+			expectedStatus:       http.StatusOK,
+			expectedRouteServers: []string{"10.0.0.3"},
+		},
+		{
+			name:                 "success removing all route servers",
+			user:                 pvu,
+			reqBody:              `{"routeServers": []}`,
+			expectedStatus:       http.StatusOK,
+			expectedRouteServers: []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tsc := testRouteServerSiteClient(false, []*cwssaws.RouteServer{
+				{Address: "10.0.0.1", SourceType: cwssaws.RouteServerSourceType_ConfigFile},
+			})
+			scp := sc.NewClientPool(tcfg)
+			scp.IDClientMap[site.ID.String()] = tsc
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(tc.reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "siteID")
+			ec.SetParamValues(ipOrg, site.ID.String())
+			ec.Set("user", tc.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			ursh := NewUpdateRouteServerHandler(dbSession, &tmocks.Client{}, scp, cfg)
+			err := ursh.Handle(ec)
+			assert.Nil(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			// Only the route servers set through the API are replaced
+			request := testRouteServerWorkflowRequest(t, tsc, "ReplaceRouteServers")
+			assert.Equal(t, tc.expectedRouteServers, request.RouteServers)
+			assert.Equal(t, cwssaws.RouteServerSourceType_AdminApi, request.SourceType)
+		})
+	}
+}
+
+func TestDeleteRouteServerHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testMachineInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-ip-org-1"
+	pvu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_PROVIDER_ADMIN"})
+	tnu := testMachineBuildUser(t, dbSession, uuid.NewString(), []string{ipOrg}, []string{"FORGE_TENANT_ADMIN"})
+
+	ip := testMachineBuildInfrastructureProvider(t, dbSession, ipOrg, "infra-provider-1")
+	site := testMachineBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered)
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	present := []*cwssaws.RouteServer{
+		{Address: "10.0.0.1", SourceType: cwssaws.RouteServerSourceType_ConfigFile},
+		{Address: "fd00::2", SourceType: cwssaws.RouteServerSourceType_AdminApi},
+	}
+
+	tests := []struct {
+		name           string
+		user           *cdbm.User
+		address        string
+		expectedStatus int
+	}{
+		{
+			name:           "error when user is not a Provider Admin",
+			user:           tnu,
+			address:        "fd00::2",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "error when address is invalid",
+			user:           pvu,
+			address:        "not-an-ip",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "error when route server is not configured on Site",
+			user:           pvu,
+			address:        "10.0.0.9",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "error when route server is configured in Site config file",
+			user:           pvu,
+			address:        "10.0.0.1",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "success",
+			user:           pvu,
+			address:        "fd00:0::2",
+			expectedStatus: http.StatusAccepted,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tsc := testRouteServerSiteClient(false, present)
+			scp := sc.NewClientPool(tcfg)
+			scp.IDClientMap[site.ID.String()] = tsc
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "siteID", "address")
+			ec.SetParamValues(ipOrg, site.ID.String(), tc.address)
+			ec.Set("user", tc.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			drsh := NewDeleteRouteServerHandler(dbSession, &tmocks.Client{}, scp, cfg)
+			err := drsh.Handle(ec)
+			assert.Nil(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+			if tc.expectedStatus != http.StatusAccepted {
+				tsc.AssertNotCalled(t, "ExecuteWorkflow", mock.Anything, mock.Anything, "RemoveRouteServers", mock.Anything)
+				return
+			}
+
+			request := testRouteServerWorkflowRequest(t, tsc, "RemoveRouteServers")
+			assert.Equal(t, []string{"fd00::2"}, request.RouteServers)
+			assert.Equal(t, cwssaws.RouteServerSourceType_AdminApi, request.SourceType)
+		})
+	}
+}
@@ -200,3 +200,176 @@ func (gth GetTaskHandler) Handle(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, apiTask)
 }
+
+// ~~~~~ Get Task Report Handler ~~~~~ //
+
+// GetTaskReportHandler is the API Handler for getting the report of a Task
+type GetTaskReportHandler struct {
+	dbSession  *cdb.Session
+	tc         tClient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetTaskReportHandler initializes and returns a new handler for getting the report of a Task
+func NewGetTaskReportHandler(dbSession *cdb.Session, tc tClient.Client, scp *sc.ClientPool, cfg *config.Config) GetTaskReportHandler {
+	return GetTaskReportHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get the report of a Task
+// @Description Get what a Task did to each rack component: every action with its stage, step, outcome and message, followed by how the Task finished. With format=markdown the report is returned as a Markdown document for incident tickets.
+// @Tags rack
+// @Accept json
+// @Produce json
+// @Produce text/markdown
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "UUID of the Task"
+// @Param siteId query string true "ID of the Site"
+// @Param format query string false "Export format: json (default) or markdown"
+// @Success 200 {object} model.APIRackTaskReport
+// @Router /v2/org/{org}/carbide/rack/task/{id}/report [get]
+func (gtrh GetTaskReportHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("Task", "GetReport", c, gtrh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+
+	var apiRequest model.APIGetTaskReportRequest
+	if err := common.ValidateKnownQueryParams(c.QueryParams(), apiRequest); err != nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+	if err := c.Bind(&apiRequest); err != nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data", nil)
+	}
+	if err := apiRequest.Validate(); err != nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+
+	if dbUser == nil {
+		logger.Error().Msg("invalid User object found in request context")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.ProviderAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Provider Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Provider Admin role with org", nil)
+	}
+
+	infrastructureProvider, err := common.GetInfrastructureProviderForOrg(ctx, nil, gtrh.dbSession, org)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error getting infrastructure provider for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to retrieve Infrastructure Provider for org", nil)
+	}
+
+	taskID := c.Param("id")
+	if _, err := uuid.Parse(taskID); err != nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Task ID specified in URL", nil)
+	}
+
+	site, err := common.GetSiteFromIDString(ctx, nil, apiRequest.SiteID, gtrh.dbSession)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidID) {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Site specified in request: invalid ID", nil)
+		}
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Site specified in request does not exist", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Site from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Site specified in request due to DB error", nil)
+	}
+
+	if site.InfrastructureProviderID != infrastructureProvider.ID {
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "Site specified in request doesn't belong to current org's Provider", nil)
+	}
+
+	siteConfig := &cdbm.SiteConfig{}
+	if site.Config != nil {
+		siteConfig = site.Config
+	}
+
+	if !siteConfig.RackLevelAdministration {
+		logger.Warn().Msg("site does not have Rack Level Administration enabled")
+		return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, "Site does not have Rack Level Administration enabled", nil)
+	}
+
+	stc, err := gtrh.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	format := apiRequest.Format
+	if format == "" {
+		format = model.RackTaskReportFormatJSON
+	}
+
+	// JSON is built from the structured entries; only Markdown is rendered by RLA.
+	rlaRequest := &rlav1.GetTaskReportRequest{
+		TaskId: &rlav1.UUID{Id: taskID},
+	}
+	if format == model.RackTaskReportFormatMarkdown {
+		rlaRequest.Format = rlav1.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN
+	}
+
+	workflowID := fmt.Sprintf("task-report-%s-%s", taskID, format)
+	workflowOptions := tClient.StartWorkflowOptions{
+		ID:                       workflowID,
+		WorkflowIDReusePolicy:    temporalEnums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		WorkflowIDConflictPolicy: temporalEnums.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
+		TaskQueue:                queue.SiteTaskQueue,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cutil.WorkflowContextTimeout)
+	defer cancel()
+
+	we, err := stc.ExecuteWorkflow(ctx, workflowOptions, "GetRackTaskReport", rlaRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to schedule GetRackTaskReport workflow")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to schedule Task report retrieval workflow", nil)
+	}
+
+	var rlaResponse rlav1.GetTaskReportResponse
+	err = we.Get(ctx, &rlaResponse)
+	if err != nil {
+		var timeoutErr *tp.TimeoutError
+		if errors.As(err, &timeoutErr) || err == context.DeadlineExceeded || ctx.Err() != nil {
+			return common.TerminateWorkflowOnTimeOut(c, logger, stc, workflowID, err, "Task", "GetRackTaskReport")
+		}
+		code, unwrapErr := common.UnwrapWorkflowError(err)
+		logger.Error().Err(unwrapErr).Msg("failed to get result from GetRackTaskReport workflow")
+		return cutil.NewAPIErrorResponse(c, code, fmt.Sprintf("Failed to execute Task report retrieval workflow on Site: %s", unwrapErr), nil)
+	}
+
+	if rlaResponse.GetTask() == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Task not found", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	if format == model.RackTaskReportFormatMarkdown {
+		return c.Blob(http.StatusOK, "text/markdown; charset=UTF-8", []byte(rlaResponse.GetContent()))
+	}
+
+	return c.JSON(http.StatusOK, model.NewAPIRackTaskReport(&rlaResponse))
+}
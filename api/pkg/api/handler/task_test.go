@@ -209,3 +209,206 @@ func TestGetTaskHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTaskReportHandler_Handle(t *testing.T) {
+	e := echo.New()
+	dbSession := testRackInitDB(t)
+	defer dbSession.Close()
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+	scp := sc.NewClientPool(tcfg)
+
+	org := "test-org"
+	_, site, _ := testRackSetupTestData(t, dbSession, org)
+
+	siteNoRLA := &cdbm.Site{
+		ID:                       uuid.New(),
+		Name:                     "test-site-no-rla",
+		Org:                      org,
+		InfrastructureProviderID: site.InfrastructureProviderID,
+		Status:                   cdbm.SiteStatusRegistered,
+		Config:                   &cdbm.SiteConfig{},
+	}
+	_, err := dbSession.DB.NewInsert().Model(siteNoRLA).Exec(context.Background())
+	assert.Nil(t, err)
+
+	providerUser := testRackBuildUser(t, dbSession, "provider-user-task-report", org, []string{"FORGE_PROVIDER_ADMIN"})
+	tenantUser := testRackBuildUser(t, dbSession, "tenant-user-task-report", org, []string{"FORGE_TENANT_ADMIN"})
+
+	handler := NewGetTaskReportHandler(dbSession, nil, scp, cfg)
+
+	taskUUID := uuid.New().String()
+
+	mockReport := &rlav1.GetTaskReportResponse{
+		Task: &rlav1.Task{
+			Id:      &rlav1.UUID{Id: taskUUID},
+			Status:  rlav1.TaskStatus_TASK_STATUS_FAILED,
+			Message: "BMC unreachable",
+		},
+		Entries: []*rlav1.TaskReportEntry{
+			{
+				ComponentType: rlav1.ComponentType_COMPONENT_TYPE_COMPUTE,
+				ComponentId:   "compute-1",
+				Stage:         1,
+				Step:          "main_operation",
+				Action:        "PowerControl",
+				Outcome:       rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED,
+				Message:       "BMC unreachable",
+			},
+			{
+				Action:  "Task",
+				Outcome: rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED,
+				Message: "BMC unreachable",
+			},
+		},
+	}
+	markdown := "# Task " + taskUUID + "\n"
+
+	tracer := oteltrace.NewNoopTracerProvider().Tracer("test")
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		reqOrg         string
+		user           *cdbm.User
+		queryParams    map[string]string
+		mockResponse   *rlav1.GetTaskReportResponse
+		expectedFormat rlav1.TaskReportFormat
+		expectedStatus int
+	}{
+		{
+			name:   "success - get task report as JSON",
+			reqOrg: org,
+			user:   providerUser,
+			queryParams: map[string]string{
+				"siteId": site.ID.String(),
+			},
+			mockResponse:   mockReport,
+			expectedFormat: rlav1.TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "success - get task report as Markdown",
+			reqOrg: org,
+			user:   providerUser,
+			queryParams: map[string]string{
+				"siteId": site.ID.String(),
+				"format": "markdown",
+			},
+			mockResponse: &rlav1.GetTaskReportResponse{
+				Task:    mockReport.Task,
+				Content: markdown,
+			},
+			expectedFormat: rlav1.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "failure - task not found",
+			reqOrg: org,
+			user:   providerUser,
+			queryParams: map[string]string{
+				"siteId": site.ID.String(),
+			},
+			mockResponse:   &rlav1.GetTaskReportResponse{},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:   "failure - invalid format",
+			reqOrg: org,
+			user:   providerUser,
+			queryParams: map[string]string{
+				"siteId": site.ID.String(),
+				"format": "pdf",
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "failure - RLA not enabled on site",
+			reqOrg: org,
+			user:   providerUser,
+			queryParams: map[string]string{
+				"siteId": siteNoRLA.ID.String(),
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:   "failure - tenant access denied",
+			reqOrg: org,
+			user:   tenantUser,
+			queryParams: map[string]string{
+				"siteId": site.ID.String(),
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemporalClient := &tmocks.Client{}
+			mockWorkflowRun := &tmocks.WorkflowRun{}
+			mockWorkflowRun.On("GetID").Return("test-workflow-id")
+			if tt.mockResponse != nil {
+				mockWorkflowRun.Mock.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					resp := args.Get(1).(*rlav1.GetTaskReportResponse)
+					resp.Task = tt.mockResponse.Task
+					resp.Entries = tt.mockResponse.Entries
+					resp.Content = tt.mockResponse.Content
+				}).Return(nil)
+			}
+			mockTemporalClient.Mock.On("ExecuteWorkflow", mock.Anything, mock.Anything, "GetRackTaskReport",
+				mock.MatchedBy(func(req *rlav1.GetTaskReportRequest) bool {
+					return req.GetTaskId().GetId() == taskUUID && req.GetFormat() == tt.expectedFormat
+				})).Return(mockWorkflowRun, nil)
+			scp.IDClientMap[site.ID.String()] = mockTemporalClient
+
+			q := url.Values{}
+			for k, v := range tt.queryParams {
+				q.Set(k, v)
+			}
+			path := fmt.Sprintf("/v2/org/%s/carbide/rack/task/%s/report?%s", tt.reqOrg, taskUUID, q.Encode())
+
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tt.reqOrg, taskUUID)
+			ec.Set("user", tt.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := handler.Handle(ec)
+
+			if tt.expectedStatus != rec.Code {
+				t.Errorf("GetTaskReportHandler.Handle() status = %v, want %v, response: %v, err: %v", rec.Code, tt.expectedStatus, rec.Body.String(), err)
+			}
+
+			require.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if tt.expectedFormat == rlav1.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN {
+				assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/markdown")
+				assert.Equal(t, markdown, rec.Body.String())
+				return
+			}
+
+			var apiReport model.APIRackTaskReport
+			err = json.Unmarshal(rec.Body.Bytes(), &apiReport)
+			assert.NoError(t, err)
+			require.NotNil(t, apiReport.Task)
+			assert.Equal(t, taskUUID, apiReport.Task.ID)
+			assert.Equal(t, "Failed", apiReport.Task.Status)
+			require.Len(t, apiReport.Entries, 2)
+			require.NotNil(t, apiReport.Entries[0].ComponentID)
+			assert.Equal(t, "compute-1", *apiReport.Entries[0].ComponentID)
+			assert.Equal(t, "Failed", apiReport.Entries[0].Outcome)
+			assert.Nil(t, apiReport.Entries[1].ComponentID)
+			assert.Equal(t, "Task", apiReport.Entries[1].Action)
+		})
+	}
+}
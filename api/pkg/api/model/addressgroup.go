@@ -0,0 +1,222 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model/util"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+// MaxAddressGroupPrefixes is the maximum number of prefixes an AddressGroup can hold
+const MaxAddressGroupPrefixes = 100
+
+// validateAddressGroupPrefixes ensures all prefixes are unique IPv4 CIDRs
+func validateAddressGroupPrefixes(value interface{}) error {
+	prefixes, _ := value.([]string)
+
+	if len(prefixes) > MaxAddressGroupPrefixes {
+		return fmt.Errorf("number of prefixes cannot exceed %d", MaxAddressGroupPrefixes)
+	}
+
+	seen := map[string]bool{}
+	for _, prefix := range prefixes {
+		ip, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf("prefix `%s` is not a valid IPv4 CIDR", prefix)
+		}
+		if seen[ipNet.String()] {
+			return fmt.Errorf("prefix `%s` is specified more than once", prefix)
+		}
+		seen[ipNet.String()] = true
+	}
+
+	return nil
+}
+
+// APIAddressGroupCreateRequest is the data structure to capture user request to create a new AddressGroup
+type APIAddressGroupCreateRequest struct {
+	// Name is the name of the AddressGroup
+	Name string `json:"name"`
+	// Description is the description of the AddressGroup
+	Description *string `json:"description"`
+	// SiteID is the ID of the Site
+	SiteID string `json:"siteId"`
+	// Prefixes is the list of IPv4 CIDRs in the AddressGroup
+	Prefixes []string `json:"prefixes"`
+	// Labels to be associated with the AddressGroup
+	Labels map[string]string `json:"labels"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req APIAddressGroupCreateRequest) Validate() error {
+	err := validation.ValidateStruct(&req,
+		validation.Field(&req.Name,
+			validation.Required.Error(validationErrorStringLength),
+			validation.By(util.ValidateNameCharacters),
+			validation.Length(2, 256).Error(validationErrorStringLength)),
+		validation.Field(&req.SiteID,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&req.Description,
+			validation.When(req.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength)),
+		),
+		validation.Field(&req.Prefixes,
+			validation.Required.Error("at least one prefix must be specified"),
+			validation.By(validateAddressGroupPrefixes)),
+	)
+	if err != nil {
+		return err
+	}
+
+	return util.ValidateLabels(req.Labels)
+}
+
+// APIAddressGroupUpdateRequest is the data structure to capture user request to update an AddressGroup
+type APIAddressGroupUpdateRequest struct {
+	// Name is the name of the AddressGroup
+	Name *string `json:"name"`
+	// Description is the description of the AddressGroup
+	Description *string `json:"description"`
+	// Prefixes replaces the list of IPv4 CIDRs in the AddressGroup
+	Prefixes []string `json:"prefixes"`
+	// Labels to be associated with the AddressGroup
+	Labels map[string]string `json:"labels"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req APIAddressGroupUpdateRequest) Validate() error {
+	err := validation.ValidateStruct(&req,
+		validation.Field(&req.Name,
+			validation.When(req.Name != nil, validation.Required.Error(validationErrorStringLength)),
+			validation.When(req.Name != nil, validation.By(util.ValidateNameCharacters)),
+			validation.When(req.Name != nil, validation.Length(2, 256).Error(validationErrorStringLength))),
+		validation.Field(&req.Description,
+			validation.When(req.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength)),
+		),
+		validation.Field(&req.Prefixes,
+			validation.By(validateAddressGroupPrefixes)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if req.Prefixes != nil && len(req.Prefixes) == 0 {
+		return validation.Errors{
+			"prefixes": errors.New("at least one prefix must be specified"),
+		}
+	}
+
+	return util.ValidateLabels(req.Labels)
+}
+
+// IsPrefixChange returns true if the request replaces the prefixes of the AddressGroup
+func (req APIAddressGroupUpdateRequest) IsPrefixChange() bool {
+	return req.Prefixes != nil
+}
+
+// APIAddressGroupPropagation is the data structure to capture the propagation status of an AddressGroup to a NetworkSecurityGroup
+type APIAddressGroupPropagation struct {
+	// NetworkSecurityGroupID is the ID of the NetworkSecurityGroup referencing the AddressGroup
+	NetworkSecurityGroupID string `json:"networkSecurityGroupId"`
+	// NetworkSecurityGroupName is the name of the NetworkSecurityGroup referencing the AddressGroup
+	NetworkSecurityGroupName string `json:"networkSecurityGroupName"`
+	// Status is the outcome of updating the NetworkSecurityGroup on Site
+	Status string `json:"status"`
+	// Message describes the failure if the NetworkSecurityGroup could not be updated
+	Message *string `json:"message"`
+	// Updated indicates the ISO datetime string for when the propagation was attempted
+	Updated time.Time `json:"updated"`
+}
+
+// APIAddressGroup is the data structure to capture API representation of an AddressGroup
+type APIAddressGroup struct {
+	// ID is the unique UUID v4 identifier for the AddressGroup
+	ID string `json:"id"`
+	// Name is the name of the AddressGroup
+	Name string `json:"name"`
+	// Description is the description of the AddressGroup
+	Description *string `json:"description"`
+	// SiteID is the ID of the Site
+	SiteID string `json:"siteId"`
+	// Site is the summary of the Site
+	Site *APISiteSummary `json:"site,omitempty"`
+	// TenantID is the ID of the Tenant
+	TenantID string `json:"tenantId"`
+	// Tenant is the summary of the tenant
+	Tenant *APITenantSummary `json:"tenant,omitempty"`
+	// Prefixes is the list of IPv4 CIDRs in the AddressGroup
+	Prefixes []string `json:"prefixes"`
+	// Labels is the set of labels/tags for the AddressGroup
+	Labels map[string]string `json:"labels"`
+	// Status is the status of the AddressGroup
+	Status string `json:"status"`
+	// Propagation holds the outcome of the last propagation to each referencing NetworkSecurityGroup
+	Propagation []APIAddressGroupPropagation `json:"propagation"`
+	// Created indicates the ISO datetime string for when the AddressGroup was created
+	Created time.Time `json:"created"`
+	// Updated indicates the ISO datetime string for when the AddressGroup was last updated
+	Updated time.Time `json:"updated"`
+}
+
+// NewAPIAddressGroup accepts a DB layer AddressGroup object and returns an API object
+func NewAPIAddressGroup(dag *cdbm.AddressGroup) *APIAddressGroup {
+	apiag := &APIAddressGroup{
+		ID:          dag.ID.String(),
+		Name:        dag.Name,
+		Description: dag.Description,
+		SiteID:      dag.SiteID.String(),
+		TenantID:    dag.TenantID.String(),
+		Prefixes:    dag.Prefixes,
+		Labels:      dag.Labels,
+		Status:      dag.Status,
+		Created:     dag.Created,
+		Updated:     dag.Updated,
+	}
+
+	if apiag.Prefixes == nil {
+		apiag.Prefixes = []string{}
+	}
+
+	if dag.Site != nil {
+		apiag.Site = NewAPISiteSummary(dag.Site)
+	}
+
+	if dag.Tenant != nil {
+		apiag.Tenant = NewAPITenantSummary(dag.Tenant)
+	}
+
+	apiag.Propagation = []APIAddressGroupPropagation{}
+	for _, p := range dag.Propagation {
+		apiag.Propagation = append(apiag.Propagation, APIAddressGroupPropagation{
+			NetworkSecurityGroupID:   p.NetworkSecurityGroupID,
+			NetworkSecurityGroupName: p.NetworkSecurityGroupName,
+			Status:                   p.Status,
+			Message:                  p.Message,
+			Updated:                  p.Updated,
+		})
+	}
+
+	return apiag
+}
@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIAddressGroupCreateRequest_Validate(t *testing.T) {
+	siteID := uuid.NewString()
+
+	tests := []struct {
+		desc      string
+		obj       APIAddressGroupCreateRequest
+		expectErr bool
+	}{
+		{
+			desc: "ok when all fields are provided",
+			obj:  APIAddressGroupCreateRequest{Name: "partners", Description: cdb.GetStrPtr("partner networks"), SiteID: siteID, Prefixes: []string{"10.0.0.0/24", "10.0.1.0/24"}, Labels: map[string]string{"env": "prod"}},
+		},
+		{
+			desc:      "error when site ID is not a UUID",
+			obj:       APIAddressGroupCreateRequest{Name: "partners", SiteID: "site", Prefixes: []string{"10.0.0.0/24"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when no prefixes are provided",
+			obj:       APIAddressGroupCreateRequest{Name: "partners", SiteID: siteID},
+			expectErr: true,
+		},
+		{
+			desc:      "error when prefix is not a CIDR",
+			obj:       APIAddressGroupCreateRequest{Name: "partners", SiteID: siteID, Prefixes: []string{"10.0.0.1"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when prefix is IPv6",
+			obj:       APIAddressGroupCreateRequest{Name: "partners", SiteID: siteID, Prefixes: []string{"2001:db8::/64"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when prefixes are duplicated",
+			obj:       APIAddressGroupCreateRequest{Name: "partners", SiteID: siteID, Prefixes: []string{"10.0.0.0/24", "10.0.0.0/24"}},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIAddressGroupUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIAddressGroupUpdateRequest
+		expectErr bool
+	}{
+		{
+			desc: "ok when nothing is provided",
+			obj:  APIAddressGroupUpdateRequest{},
+		},
+		{
+			desc: "ok when prefixes are replaced",
+			obj:  APIAddressGroupUpdateRequest{Prefixes: []string{"10.0.0.0/24"}},
+		},
+		{
+			desc:      "error when prefixes are cleared",
+			obj:       APIAddressGroupUpdateRequest{Prefixes: []string{}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when name is too short",
+			obj:       APIAddressGroupUpdateRequest{Name: cdb.GetStrPtr("a")},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIAddressGroupNew(t *testing.T) {
+	dbag := &cdbm.AddressGroup{
+		ID:       uuid.New(),
+		Name:     "partners",
+		SiteID:   uuid.New(),
+		TenantID: uuid.New(),
+		Prefixes: []string{"10.0.0.0/24"},
+		Status:   cdbm.AddressGroupStatusError,
+		Propagation: []cdbm.AddressGroupPropagation{
+			{NetworkSecurityGroupID: uuid.NewString(), NetworkSecurityGroupName: "web", Status: cdbm.AddressGroupPropagationStatusError, Message: cdb.GetStrPtr("site unreachable")},
+		},
+	}
+
+	apiag := NewAPIAddressGroup(dbag)
+	assert.Equal(t, dbag.ID.String(), apiag.ID)
+	assert.Equal(t, dbag.Prefixes, apiag.Prefixes)
+	assert.Equal(t, dbag.Status, apiag.Status)
+	require.Len(t, apiag.Propagation, 1)
+	assert.Equal(t, dbag.Propagation[0].NetworkSecurityGroupID, apiag.Propagation[0].NetworkSecurityGroupID)
+	assert.Equal(t, "site unreachable", *apiag.Propagation[0].Message)
+}
+
+func TestAPINetworkSecurityGroupRuleAddressGroupConversion(t *testing.T) {
+	groupID := uuid.NewString()
+
+	rule := &APINetworkSecurityGroupRule{
+		Name:                 cdb.GetStrPtr("allow-partners"),
+		Direction:            APINetworkSecurityGroupRuleDirectionIngress,
+		Protocol:             APINetworkSecurityGroupRuleProtocolTcp,
+		Action:               APINetworkSecurityGroupRuleActionPermit,
+		Priority:             100,
+		SourceAddressGroupID: cdb.GetStrPtr(groupID),
+		DestinationPrefix:    cdb.GetStrPtr("0.0.0.0/0"),
+	}
+
+	dbRule, err := ProtobufRuleFromAPINetworkSecurityGroupRule(rule)
+	require.NoError(t, err)
+	assert.Equal(t, groupID, *dbRule.SourceAddressGroupID)
+	assert.Nil(t, dbRule.SourceNet)
+
+	apiRule, err := APINetworkSecurityGroupRuleFromProtobufRule(dbRule)
+	require.NoError(t, err)
+	assert.Equal(t, rule, apiRule)
+
+	// A prefix and an address group cannot both be used for the same side of a rule
+	rule.SourcePrefix = cdb.GetStrPtr("10.0.0.0/8")
+	_, err = ProtobufRuleFromAPINetworkSecurityGroupRule(rule)
+	assert.Error(t, err)
+
+	// Address group references must be UUIDs
+	rule.SourcePrefix = nil
+	rule.SourceAddressGroupID = cdb.GetStrPtr("partners")
+	_, err = ProtobufRuleFromAPINetworkSecurityGroupRule(rule)
+	assert.Error(t, err)
+}
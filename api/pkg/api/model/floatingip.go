@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package model
+
+import (
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model/util"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+// APIFloatingIPCreateRequest is the data structure to capture user request to allocate a new FloatingIP
+type APIFloatingIPCreateRequest struct {
+	// Name is the name of the FloatingIP
+	Name string `json:"name"`
+	// Description is the description of the FloatingIP
+	Description *string `json:"description"`
+	// IPBlockID is the ID of the Tenant IP Block the address is allocated from
+	IPBlockID string `json:"ipBlockId"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req APIFloatingIPCreateRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Name,
+			validation.Required.Error(validationErrorStringLength),
+			validation.By(util.ValidateNameCharacters),
+			validation.Length(2, 256).Error(validationErrorStringLength)),
+		validation.Field(&req.Description,
+			validation.When(req.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength)),
+		),
+		validation.Field(&req.IPBlockID,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+	)
+}
+
+// APIFloatingIPUpdateRequest is the data structure to capture user request to update a FloatingIP
+type APIFloatingIPUpdateRequest struct {
+	// Name is the name of the FloatingIP
+	Name *string `json:"name"`
+	// Description is the description of the FloatingIP
+	Description *string `json:"description"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req APIFloatingIPUpdateRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Name,
+			validation.When(req.Name != nil, validation.Required.Error(validationErrorStringLength)),
+			validation.When(req.Name != nil, validation.By(util.ValidateNameCharacters)),
+			validation.When(req.Name != nil, validation.Length(2, 256).Error(validationErrorStringLength))),
+		validation.Field(&req.Description,
+			validation.When(req.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength)),
+		),
+	)
+}
+
+// APIFloatingIPAssociateRequest is the data structure to capture user request to associate a FloatingIP with an Instance Interface
+type APIFloatingIPAssociateRequest struct {
+	// InterfaceID is the ID of the Instance Interface the FloatingIP should be associated with
+	InterfaceID string `json:"interfaceId"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req APIFloatingIPAssociateRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.InterfaceID,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+	)
+}
+
+// APIFloatingIP is the data structure to capture API representation of a FloatingIP
+type APIFloatingIP struct {
+	// ID is the unique UUID v4 identifier for the FloatingIP
+	ID string `json:"id"`
+	// Name is the name of the FloatingIP
+	Name string `json:"name"`
+	// Description is the description of the FloatingIP
+	Description *string `json:"description"`
+	// SiteID is the ID of the Site
+	SiteID string `json:"siteId"`
+	// Site is the summary of the Site
+	Site *APISiteSummary `json:"site,omitempty"`
+	// TenantID is the ID of the Tenant
+	TenantID string `json:"tenantId"`
+	// Tenant is the summary of the tenant
+	Tenant *APITenantSummary `json:"tenant,omitempty"`
+	// IPBlockID is the ID of the IP Block the address was allocated from
+	IPBlockID string `json:"ipBlockId"`
+	// IPBlock is the summary of the IP Block
+	IPBlock *APIIPBlockSummary `json:"ipBlock,omitempty"`
+	// IPAddress is the IPv4 address of the FloatingIP
+	IPAddress string `json:"ipAddress"`
+	// InstanceID is the ID of the Instance the FloatingIP is associated with
+	InstanceID *string `json:"instanceId"`
+	// Instance is the summary of the Instance
+	Instance *APIInstanceSummary `json:"instance,omitempty"`
+	// InterfaceID is the ID of the Instance Interface the FloatingIP is associated with
+	InterfaceID *string `json:"interfaceId"`
+	// Status is the status of the FloatingIP
+	Status string `json:"status"`
+	// Created indicates the ISO datetime string for when the FloatingIP was created
+	Created time.Time `json:"created"`
+	// Updated indicates the ISO datetime string for when the FloatingIP was last updated
+	Updated time.Time `json:"updated"`
+}
+
+// NewAPIFloatingIP accepts a DB layer FloatingIP object and returns an API object
+func NewAPIFloatingIP(dfip *cdbm.FloatingIP) *APIFloatingIP {
+	apifip := &APIFloatingIP{
+		ID:          dfip.ID.String(),
+		Name:        dfip.Name,
+		Description: dfip.Description,
+		SiteID:      dfip.SiteID.String(),
+		TenantID:    dfip.TenantID.String(),
+		IPBlockID:   dfip.IPBlockID.String(),
+		IPAddress:   dfip.IPAddress,
+		InstanceID:  util.GetUUIDPtrToStrPtr(dfip.InstanceID),
+		InterfaceID: util.GetUUIDPtrToStrPtr(dfip.InterfaceID),
+		Status:      dfip.Status,
+		Created:     dfip.Created,
+		Updated:     dfip.Updated,
+	}
+
+	if dfip.Site != nil {
+		apifip.Site = NewAPISiteSummary(dfip.Site)
+	}
+
+	if dfip.Tenant != nil {
+		apifip.Tenant = NewAPITenantSummary(dfip.Tenant)
+	}
+
+	if dfip.IPBlock != nil {
+		apifip.IPBlock = NewAPIIPBlockSummary(dfip.IPBlock)
+	}
+
+	if dfip.Instance != nil {
+		apifip.Instance = NewAPIInstanceSummary(dfip.Instance)
+	}
+
+	return apifip
+}
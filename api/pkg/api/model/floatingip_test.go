@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package model
+
+import (
+	"testing"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIFloatingIPCreateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIFloatingIPCreateRequest
+		expectErr bool
+	}{
+		{
+			desc: "ok when all fields are provided",
+			obj:  APIFloatingIPCreateRequest{Name: "web-vip", Description: cdb.GetStrPtr("web frontend"), IPBlockID: uuid.NewString()},
+		},
+		{
+			desc:      "error when name is missing",
+			obj:       APIFloatingIPCreateRequest{IPBlockID: uuid.NewString()},
+			expectErr: true,
+		},
+		{
+			desc:      "error when IP Block ID is missing",
+			obj:       APIFloatingIPCreateRequest{Name: "web-vip"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when IP Block ID is not a UUID",
+			obj:       APIFloatingIPCreateRequest{Name: "web-vip", IPBlockID: "block"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIFloatingIPUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIFloatingIPUpdateRequest
+		expectErr bool
+	}{
+		{
+			desc: "ok when no fields are provided",
+			obj:  APIFloatingIPUpdateRequest{},
+		},
+		{
+			desc: "ok when name and description are provided",
+			obj:  APIFloatingIPUpdateRequest{Name: cdb.GetStrPtr("web-vip"), Description: cdb.GetStrPtr("")},
+		},
+		{
+			desc:      "error when name is empty",
+			obj:       APIFloatingIPUpdateRequest{Name: cdb.GetStrPtr("")},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIFloatingIPAssociateRequest_Validate(t *testing.T) {
+	assert.NoError(t, APIFloatingIPAssociateRequest{InterfaceID: uuid.NewString()}.Validate())
+	assert.Error(t, APIFloatingIPAssociateRequest{}.Validate())
+	assert.Error(t, APIFloatingIPAssociateRequest{InterfaceID: "eth0"}.Validate())
+}
+
+func TestNewAPIFloatingIP(t *testing.T) {
+	dfip := &cdbm.FloatingIP{
+		ID:        uuid.New(),
+		Name:      "web-vip",
+		SiteID:    uuid.New(),
+		TenantID:  uuid.New(),
+		IPBlockID: uuid.New(),
+		IPAddress: "192.168.10.5",
+		Status:    cdbm.FloatingIPStatusAvailable,
+	}
+
+	apifip := NewAPIFloatingIP(dfip)
+	assert.Equal(t, dfip.ID.String(), apifip.ID)
+	assert.Equal(t, dfip.IPAddress, apifip.IPAddress)
+	assert.Nil(t, apifip.InstanceID)
+	assert.Nil(t, apifip.InterfaceID)
+	assert.Nil(t, apifip.Site)
+
+	instanceID := uuid.New()
+	interfaceID := uuid.New()
+	dfip.InstanceID = &instanceID
+	dfip.InterfaceID = &interfaceID
+	dfip.Instance = &cdbm.Instance{ID: instanceID, Name: "web-1"}
+	dfip.Status = cdbm.FloatingIPStatusAssociated
+
+	apifip = NewAPIFloatingIP(dfip)
+	assert.Equal(t, instanceID.String(), *apifip.InstanceID)
+	assert.Equal(t, interfaceID.String(), *apifip.InterfaceID)
+	assert.NotNil(t, apifip.Instance)
+}
@@ -387,6 +387,9 @@ type APIInstanceCreateRequest struct {
 	MachineID *string `json:"machineId"`
 	// AllowUnhealthyMachine is a flag that can be used to target Machines are in maintenance or have health alerts preventing regular provision flow.
 	AllowUnhealthyMachine *bool `json:"allowUnhealthyMachine"`
+	// ReplacesInstanceID is the ID of a deleted or terminating Instance of the Tenant in the same VPC that the new Instance replaces.
+	// Floating IPs of the replaced Instance move to the new Instance once it is Ready
+	ReplacesInstanceID *string `json:"replacesInstanceId"`
 }
 
 // APIBatchInstanceCreateRequest is the data structure to capture request to create multiple instances in a single request
@@ -460,6 +463,8 @@ func (icr APIInstanceCreateRequest) Validate() error {
 			validationis.UUID.Error(validationErrorInvalidUUID)),
 		validation.Field(&icr.OperatingSystemID,
 			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&icr.ReplacesInstanceID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
 		validation.Field(&icr.Interfaces,
 			validation.Required.Error("at least one Interface must be specified"),
 			validation.Length(1, MaxInterfaceCount).Error(fmt.Sprintf("at most %v Interfaces can be specified", MaxInterfaceCount))),
@@ -1595,6 +1600,8 @@ type APIInstance struct {
 	NetworkSecurityGroupInherited bool `json:"networkSecurityGroupInherited"`
 	// TPM EK Cert
 	TpmEkCertificate *string `json:"tpmEkCertificate"`
+	// ReplacesInstanceID is the ID of the Instance this Instance replaces, if any
+	ReplacesInstanceID *string `json:"replacesInstanceId"`
 	// Status is the status of the Instance
 	Status string `json:"status"`
 	// Interfaces are list of the subnet associated with the Instance
@@ -1702,6 +1709,10 @@ func NewAPIInstance(dbinst *cdbm.Instance, dbSite *cdbm.Site, dbiss []cdbm.Inter
 		apiInstance.TpmEkCertificate = dbinst.TpmEkCertificate
 	}
 
+	if dbinst.ReplacesInstanceID != nil {
+		apiInstance.ReplacesInstanceID = cdb.GetStrPtr(dbinst.ReplacesInstanceID.String())
+	}
+
 	apiInstance.Status = getAggregatedInstanceStatus(dbinst.Status, dbinst.PowerStatus)
 
 	secondaryVpcIDs := goset.NewSet[string]()
@@ -392,6 +392,7 @@ func TestAPIInstanceCreateRequest_Validate(t *testing.T) {
 		DpuExtensionServiceDeployments []APIDpuExtensionServiceDeploymentRequest
 		NVLinkInterfaces               []APINVLinkInterfaceCreateOrUpdateRequest
 		Labels                         map[string]string
+		ReplacesInstanceID             *string
 	}
 	tests := []struct {
 		name                 string
@@ -946,6 +947,41 @@ func TestAPIInstanceCreateRequest_Validate(t *testing.T) {
 			wantErr:          true,
 			wantErrorMessage: "deviceInstance: deviceInstance must be between 0 and 3",
 		},
+		{
+			name: "test valid Instance create request, replaced Instance specified",
+			fields: fields{
+				Name:               "test-name",
+				TenantID:           uuid.NewString(),
+				InstanceTypeID:     uuid.NewString(),
+				VpcID:              uuid.NewString(),
+				OperatingSystemID:  cdb.GetStrPtr(uuid.NewString()),
+				ReplacesInstanceID: cdb.GetStrPtr(uuid.NewString()),
+				Interfaces: []APIInterfaceCreateOrUpdateRequest{
+					{
+						SubnetID: cdb.GetStrPtr(uuid.NewString()),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test invalid Instance create request, replaced Instance ID is not a UUID",
+			fields: fields{
+				Name:               "test-name",
+				TenantID:           uuid.NewString(),
+				InstanceTypeID:     uuid.NewString(),
+				VpcID:              uuid.NewString(),
+				OperatingSystemID:  cdb.GetStrPtr(uuid.NewString()),
+				ReplacesInstanceID: cdb.GetStrPtr("test1"),
+				Interfaces: []APIInterfaceCreateOrUpdateRequest{
+					{
+						SubnetID: cdb.GetStrPtr(uuid.NewString()),
+					},
+				},
+			},
+			wantErr:          true,
+			wantErrorMessage: "replacesInstanceId",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -964,6 +1000,7 @@ func TestAPIInstanceCreateRequest_Validate(t *testing.T) {
 				DpuExtensionServiceDeployments: tt.fields.DpuExtensionServiceDeployments,
 				NVLinkInterfaces:               tt.fields.NVLinkInterfaces,
 				Labels:                         tt.fields.Labels,
+				ReplacesInstanceID:             tt.fields.ReplacesInstanceID,
 			}
 
 			err := icr.Validate()
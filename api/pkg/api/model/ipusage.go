@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+)
+
+const (
+	// IPUsageHistoryDaysDefault is the default number of days of usage history returned
+	IPUsageHistoryDaysDefault = 30
+	// IPUsageHistoryDaysMax is the maximum number of days of usage history that can be requested
+	IPUsageHistoryDaysMax = 90
+)
+
+// APIIPUsageSample is a point in time IP usage record
+type APIIPUsageSample struct {
+	// TotalIPs is the number of addresses in the prefix
+	TotalIPs int64 `json:"totalIps"`
+	// UsedIPs is the number of addresses in use
+	UsedIPs int64 `json:"usedIps"`
+	// Utilization is the percentage of addresses in use
+	Utilization float64 `json:"utilization"`
+	// Created is the time the sample was recorded
+	Created time.Time `json:"created"`
+}
+
+// NewAPIIPUsageSample creates and returns a new APIIPUsageSample object
+func NewAPIIPUsageSample(ius *cdbm.IPUsageSample) APIIPUsageSample {
+	return APIIPUsageSample{
+		TotalIPs:    ius.TotalIPs,
+		UsedIPs:     ius.UsedIPs,
+		Utilization: ius.GetUtilization(),
+		Created:     ius.Created,
+	}
+}
+
+// APIIPUsage is the data structure to capture current utilization, growth trend and projected exhaustion
+// of an IP Block, Subnet or VPC Prefix
+type APIIPUsage struct {
+	// ResourceType is the type of the resource, one of IPBlock, Subnet or VpcPrefix
+	ResourceType string `json:"resourceType"`
+	// ResourceID is the ID of the resource
+	ResourceID string `json:"resourceId"`
+	// Prefix is the CIDR of the resource
+	Prefix string `json:"prefix"`
+	// TotalIPs is the number of addresses in the prefix
+	TotalIPs int64 `json:"totalIps"`
+	// UsedIPs is the number of addresses currently in use
+	UsedIPs int64 `json:"usedIps"`
+	// AvailableIPs is the number of addresses currently available
+	AvailableIPs int64 `json:"availableIps"`
+	// Utilization is the percentage of addresses currently in use
+	Utilization float64 `json:"utilization"`
+	// GrowthPerDay is the average number of addresses consumed per day over the history period
+	GrowthPerDay float64 `json:"growthPerDay"`
+	// ProjectedExhaustion is the date all addresses are projected to be in use, nil if usage is not growing
+	ProjectedExhaustion *time.Time `json:"projectedExhaustion"`
+	// History is the list of recorded usage samples, oldest first
+	History []APIIPUsageSample `json:"history"`
+}
+
+// NewAPIIPUsage creates and returns a new APIIPUsage object from the current usage and recorded history
+func NewAPIIPUsage(current *cdbm.IPUsageSample, history []cdbm.IPUsageSample, growthPerDay float64, projectedExhaustion *time.Time) *APIIPUsage {
+	apiIPUsage := &APIIPUsage{
+		ResourceType:        current.ResourceType,
+		ResourceID:          current.ResourceID.String(),
+		Prefix:              current.Prefix,
+		TotalIPs:            current.TotalIPs,
+		UsedIPs:             current.UsedIPs,
+		AvailableIPs:        current.TotalIPs - current.UsedIPs,
+		Utilization:         current.GetUtilization(),
+		GrowthPerDay:        growthPerDay,
+		ProjectedExhaustion: projectedExhaustion,
+		History:             []APIIPUsageSample{},
+	}
+
+	for i := range history {
+		apiIPUsage.History = append(apiIPUsage.History, NewAPIIPUsageSample(&history[i]))
+	}
+
+	return apiIPUsage
+}
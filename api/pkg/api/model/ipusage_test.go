@@ -0,0 +1,88 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+)
+
+func TestNewAPIIPUsage(t *testing.T) {
+	resourceID := uuid.New()
+	now := time.Now()
+
+	current := &cdbm.IPUsageSample{
+		ResourceType: cdbm.IPUsageResourceTypeSubnet,
+		ResourceID:   resourceID,
+		Prefix:       "192.168.0.0/24",
+		TotalIPs:     256,
+		UsedIPs:      192,
+		Created:      now,
+	}
+
+	history := []cdbm.IPUsageSample{
+		{ResourceType: cdbm.IPUsageResourceTypeSubnet, ResourceID: resourceID, Prefix: "192.168.0.0/24", TotalIPs: 256, UsedIPs: 64, Created: now.AddDate(0, 0, -2)},
+		{ResourceType: cdbm.IPUsageResourceTypeSubnet, ResourceID: resourceID, Prefix: "192.168.0.0/24", TotalIPs: 256, UsedIPs: 128, Created: now.AddDate(0, 0, -1)},
+	}
+
+	tests := []struct {
+		name                string
+		history             []cdbm.IPUsageSample
+		growthPerDay        float64
+		projectedExhaustion *time.Time
+	}{
+		{
+			name:                "usage with history and projected exhaustion",
+			history:             history,
+			growthPerDay:        64,
+			projectedExhaustion: cdb.GetTimePtr(now.AddDate(0, 0, 1)),
+		},
+		{
+			name: "usage without history",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewAPIIPUsage(current, tt.history, tt.growthPerDay, tt.projectedExhaustion)
+
+			assert.Equal(t, current.ResourceType, got.ResourceType)
+			assert.Equal(t, resourceID.String(), got.ResourceID)
+			assert.Equal(t, current.Prefix, got.Prefix)
+			assert.Equal(t, int64(256), got.TotalIPs)
+			assert.Equal(t, int64(192), got.UsedIPs)
+			assert.Equal(t, int64(64), got.AvailableIPs)
+			assert.Equal(t, float64(75), got.Utilization)
+			assert.Equal(t, tt.growthPerDay, got.GrowthPerDay)
+			assert.Equal(t, tt.projectedExhaustion, got.ProjectedExhaustion)
+
+			assert.NotNil(t, got.History)
+			assert.Equal(t, len(tt.history), len(got.History))
+			for i, sample := range got.History {
+				assert.Equal(t, tt.history[i].UsedIPs, sample.UsedIPs)
+				assert.Equal(t, tt.history[i].GetUtilization(), sample.Utilization)
+				assert.Equal(t, tt.history[i].Created, sample.Created)
+			}
+		})
+	}
+}
@@ -26,11 +26,13 @@ import (
 
 	hutil "github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/handler/util"
 	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/api/model/util"
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/nsg"
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
 	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
 )
 
 const MaxNetworkSecurityGroupRules = 200
@@ -353,6 +355,16 @@ func ProtobufRuleFromAPINetworkSecurityGroupRule(rule *APINetworkSecurityGroupRu
 		sourceNetOptionCount++
 	}
 
+	if rule.SourceAddressGroupID != nil {
+
+		if _, err := uuid.Parse(*rule.SourceAddressGroupID); err != nil {
+			return nil, validation.Errors{"rules": fmt.Errorf("source address group ID `%s` is not valid", *rule.SourceAddressGroupID)}
+		}
+
+		newRule.SourceAddressGroupID = rule.SourceAddressGroupID
+		sourceNetOptionCount++
+	}
+
 	if sourceNetOptionCount > 1 {
 		return nil, validation.Errors{"rules": fmt.Errorf("too many source network options found in API request")}
 	}
@@ -375,6 +387,16 @@ func ProtobufRuleFromAPINetworkSecurityGroupRule(rule *APINetworkSecurityGroupRu
 		destinationNetOptionCount++
 	}
 
+	if rule.DestinationAddressGroupID != nil {
+
+		if _, err := uuid.Parse(*rule.DestinationAddressGroupID); err != nil {
+			return nil, validation.Errors{"rules": fmt.Errorf("destination address group ID `%s` is not valid", *rule.DestinationAddressGroupID)}
+		}
+
+		newRule.DestinationAddressGroupID = rule.DestinationAddressGroupID
+		destinationNetOptionCount++
+	}
+
 	if destinationNetOptionCount > 1 {
 		return nil, validation.Errors{"rules": fmt.Errorf("too many destination network options found in API request")}
 	}
@@ -426,24 +448,28 @@ func APINetworkSecurityGroupRuleFromProtobufRule(rule *cdbm.NetworkSecurityGroup
 	var srcPrefix *string
 	var dstPrefix *string
 
-	switch srcNet := rule.GetSourceNet().(type) {
-	case *cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix:
-		if _, _, err := net.ParseCIDR(srcNet.SrcPrefix); err != nil {
-			return nil, validation.Errors{"rules": fmt.Errorf("found invalid source prefix `%s` in database record", srcNet.SrcPrefix)}
+	if rule.SourceAddressGroupID == nil {
+		switch srcNet := rule.GetSourceNet().(type) {
+		case *cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix:
+			if _, _, err := net.ParseCIDR(srcNet.SrcPrefix); err != nil {
+				return nil, validation.Errors{"rules": fmt.Errorf("found invalid source prefix `%s` in database record", srcNet.SrcPrefix)}
+			}
+			srcPrefix = &srcNet.SrcPrefix
+		default:
+			return nil, validation.Errors{"rules": fmt.Errorf("encountered unknown source network option in database record")}
 		}
-		srcPrefix = &srcNet.SrcPrefix
-	default:
-		return nil, validation.Errors{"rules": fmt.Errorf("encountered unknown source network option in database record")}
 	}
 
-	switch dstNet := rule.GetDestinationNet().(type) {
-	case *cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix:
-		if _, _, err := net.ParseCIDR(dstNet.DstPrefix); err != nil {
-			return nil, validation.Errors{"rules": fmt.Errorf("found invalid destination prefix `%s` in database record", dstNet.DstPrefix)}
+	if rule.DestinationAddressGroupID == nil {
+		switch dstNet := rule.GetDestinationNet().(type) {
+		case *cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix:
+			if _, _, err := net.ParseCIDR(dstNet.DstPrefix); err != nil {
+				return nil, validation.Errors{"rules": fmt.Errorf("found invalid destination prefix `%s` in database record", dstNet.DstPrefix)}
+			}
+			dstPrefix = &dstNet.DstPrefix
+		default:
+			return nil, fmt.Errorf("encountered unknown source network option in database record")
 		}
-		dstPrefix = &dstNet.DstPrefix
-	default:
-		return nil, fmt.Errorf("encountered unknown source network option in database record")
 	}
 
 	// Process rule port ranges
@@ -471,15 +497,17 @@ func APINetworkSecurityGroupRuleFromProtobufRule(rule *cdbm.NetworkSecurityGroup
 	}
 
 	return &APINetworkSecurityGroupRule{
-		Name:                 rule.Id,
-		Direction:            direction,
-		SourcePortRange:      srcPortRange,
-		DestinationPortRange: dstPortRange,
-		Protocol:             protocol,
-		Action:               action,
-		Priority:             int(rule.Priority),
-		SourcePrefix:         srcPrefix,
-		DestinationPrefix:    dstPrefix,
+		Name:                      rule.Id,
+		Direction:                 direction,
+		SourcePortRange:           srcPortRange,
+		DestinationPortRange:      dstPortRange,
+		Protocol:                  protocol,
+		Action:                    action,
+		Priority:                  int(rule.Priority),
+		SourcePrefix:              srcPrefix,
+		DestinationPrefix:         dstPrefix,
+		SourceAddressGroupID:      rule.SourceAddressGroupID,
+		DestinationAddressGroupID: rule.DestinationAddressGroupID,
 	}, nil
 }
 
@@ -538,6 +566,10 @@ type APINetworkSecurityGroupRule struct {
 	Priority             int     `json:"priority"`
 	SourcePrefix         *string `json:"sourcePrefix"`
 	DestinationPrefix    *string `json:"destinationPrefix"`
+	// SourceAddressGroupID references an AddressGroup to use instead of SourcePrefix
+	SourceAddressGroupID *string `json:"sourceAddressGroupId"`
+	// DestinationAddressGroupID references an AddressGroup to use instead of DestinationPrefix
+	DestinationAddressGroupID *string `json:"destinationAddressGroupId"`
 }
 
 // APINetworkSecurityGroupStats holds detailed usage stats for an NSG
@@ -639,3 +671,206 @@ func NewAPINetworkSecurityGroupPropagationDetails(s *cdbm.NetworkSecurityGroupPr
 
 	return details
 }
+
+// APINetworkSecurityGroupEvaluationRequest is the data structure to capture a request to evaluate a flow against the
+// NetworkSecurityGroups that apply to an Instance
+type APINetworkSecurityGroupEvaluationRequest struct {
+	// InstanceID is the ID of the Instance the flow is evaluated for
+	InstanceID string `json:"instanceId"`
+	// Direction is the direction of the flow relative to the Instance
+	Direction string `json:"direction"`
+	// Protocol is the protocol of the flow
+	Protocol string `json:"protocol"`
+	// SourceAddress is the source IPv4 address of the flow
+	SourceAddress string `json:"sourceAddress"`
+	// SourcePort is the source port of the flow, only applicable to TCP and UDP
+	SourcePort *int `json:"sourcePort"`
+	// DestinationAddress is the destination IPv4 address of the flow
+	DestinationAddress string `json:"destinationAddress"`
+	// DestinationPort is the destination port of the flow, only applicable to TCP and UDP
+	DestinationPort *int `json:"destinationPort"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req *APINetworkSecurityGroupEvaluationRequest) Validate() error {
+	req.Direction = strings.ToUpper(req.Direction)
+	req.Protocol = strings.ToUpper(req.Protocol)
+
+	portsAllowed := req.Protocol == APINetworkSecurityGroupRuleProtocolTcp || req.Protocol == APINetworkSecurityGroupRuleProtocolUdp
+
+	return validation.ValidateStruct(req,
+		validation.Field(&req.InstanceID,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&req.Direction,
+			validation.Required.Error(validationErrorValueRequired),
+			validation.In(APINetworkSecurityGroupRuleDirectionIngress, APINetworkSecurityGroupRuleActionEgress).Error("must be one of INGRESS, EGRESS")),
+		validation.Field(&req.Protocol,
+			validation.Required.Error(validationErrorValueRequired),
+			validation.In(APINetworkSecurityGroupRuleProtocolTcp, APINetworkSecurityGroupRuleProtocolUdp,
+				APINetworkSecurityGroupRuleProtocolIcmp, APINetworkSecurityGroupRuleProtocolIcmp6).Error("must be one of TCP, UDP, ICMP, ICMP6")),
+		validation.Field(&req.SourceAddress,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.IPv4.Error(validationErrorInvalidIPv4Address)),
+		validation.Field(&req.DestinationAddress,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.IPv4.Error(validationErrorInvalidIPv4Address)),
+		validation.Field(&req.SourcePort,
+			validation.When(req.SourcePort != nil && !portsAllowed, validation.Nil.Error("ports can only be specified with protocol TCP or UDP")),
+			validation.When(req.SourcePort != nil, validation.Min(0), validation.Max(65535))),
+		validation.Field(&req.DestinationPort,
+			validation.When(req.DestinationPort != nil && !portsAllowed, validation.Nil.Error("ports can only be specified with protocol TCP or UDP")),
+			validation.When(req.DestinationPort != nil, validation.Min(0), validation.Max(65535))),
+	)
+}
+
+// ToFlow converts a validated request into a flow that can be evaluated
+func (req *APINetworkSecurityGroupEvaluationRequest) ToFlow() nsg.Flow {
+	flow := nsg.Flow{
+		Direction:     NetworkSecurityGroupRuleProtobufDirectionFromAPIDirection[req.Direction],
+		Protocol:      NetworkSecurityGroupRuleProtobufProtocolFromAPIProtocol[req.Protocol],
+		SourceIP:      net.ParseIP(req.SourceAddress),
+		DestinationIP: net.ParseIP(req.DestinationAddress),
+	}
+
+	if req.SourcePort != nil {
+		port := uint32(*req.SourcePort)
+		flow.SourcePort = &port
+	}
+
+	if req.DestinationPort != nil {
+		port := uint32(*req.DestinationPort)
+		flow.DestinationPort = &port
+	}
+
+	return flow
+}
+
+// APINetworkSecurityGroupEvaluation is the data structure to capture API representation of a flow evaluation result
+type APINetworkSecurityGroupEvaluation struct {
+	// Allowed indicates whether the flow would be permitted
+	Allowed bool `json:"allowed"`
+	// Action is the action applied to the flow, either PERMIT or DENY
+	Action string `json:"action"`
+	// NetworkSecurityGroupID is the ID of the NetworkSecurityGroup that decided the flow
+	NetworkSecurityGroupID *string `json:"networkSecurityGroupId"`
+	// AttachmentLevel is the level the deciding NetworkSecurityGroup is attached at, either Instance or VPC
+	AttachmentLevel *string `json:"attachmentLevel"`
+	// OverriddenNetworkSecurityGroupID is the ID of the VPC NetworkSecurityGroup that was not evaluated
+	// because the Instance has its own NetworkSecurityGroup attached
+	OverriddenNetworkSecurityGroupID *string `json:"overriddenNetworkSecurityGroupId"`
+	// MatchedRuleIndex is the position of the matched rule in the NetworkSecurityGroup
+	MatchedRuleIndex *int `json:"matchedRuleIndex"`
+	// MatchedRule is the rule that decided the flow, null if the flow fell through to the default action
+	MatchedRule *APINetworkSecurityGroupRule `json:"matchedRule"`
+	// EvaluatedRuleCount is the number of rules examined before a decision was reached
+	EvaluatedRuleCount int `json:"evaluatedRuleCount"`
+	// Reason is a human readable explanation of the decision
+	Reason string `json:"reason"`
+}
+
+// NewAPINetworkSecurityGroupEvaluation accepts an evaluation decision and the rules of the deciding NetworkSecurityGroup
+// and returns an API object
+func NewAPINetworkSecurityGroupEvaluation(decision nsg.Decision, rules []*cdbm.NetworkSecurityGroupRule) (*APINetworkSecurityGroupEvaluation, error) {
+	apie := &APINetworkSecurityGroupEvaluation{
+		Allowed:            decision.Allowed,
+		Action:             APINetworkSecurityGroupRuleActionDeny,
+		EvaluatedRuleCount: decision.EvaluatedRuleCount,
+		Reason:             decision.Reason,
+	}
+
+	if decision.Allowed {
+		apie.Action = APINetworkSecurityGroupRuleActionPermit
+	}
+
+	if decision.Attachment != nil {
+		apie.NetworkSecurityGroupID = cdb.GetStrPtr(decision.Attachment.NetworkSecurityGroupID)
+		apie.AttachmentLevel = cdb.GetStrPtr(decision.Attachment.Level)
+	}
+
+	if decision.Overridden != nil {
+		apie.OverriddenNetworkSecurityGroupID = cdb.GetStrPtr(decision.Overridden.NetworkSecurityGroupID)
+	}
+
+	if decision.MatchedRule != nil {
+		if decision.MatchedRule.Index >= len(rules) {
+			return nil, fmt.Errorf("matched rule index %d is out of range", decision.MatchedRule.Index)
+		}
+
+		rule, err := APINetworkSecurityGroupRuleFromProtobufRule(rules[decision.MatchedRule.Index])
+		if err != nil {
+			return nil, err
+		}
+
+		apie.MatchedRule = rule
+		apie.MatchedRuleIndex = cdb.GetIntPtr(decision.MatchedRule.Index)
+	}
+
+	return apie, nil
+}
+
+// APINetworkSecurityGroupLintRequest is the data structure to capture a request to lint a set of NetworkSecurityGroup rules
+type APINetworkSecurityGroupLintRequest struct {
+	// Rules is the list of NetworkSecurityGroup rules to lint
+	Rules []APINetworkSecurityGroupRule `json:"rules"`
+}
+
+// Validate ensures the values in the request are acceptable
+func (req APINetworkSecurityGroupLintRequest) Validate() error {
+	if len(req.Rules) > MaxNetworkSecurityGroupRules {
+		return validation.Errors{
+			"rules": fmt.Errorf("number of rules cannot exceed %d", MaxNetworkSecurityGroupRules),
+		}
+	}
+
+	// Individual rule validation happens later during
+	// processing when we convert from request rules to
+	// the protobuf representation.
+
+	return nil
+}
+
+// APINetworkSecurityGroupLintFinding is the data structure to capture API representation of a single lint finding
+type APINetworkSecurityGroupLintFinding struct {
+	// Type is the type of finding, one of Shadowed, Redundant or Conflicting
+	Type string `json:"type"`
+	// RuleIndex is the position of the affected rule in the request
+	RuleIndex int `json:"ruleIndex"`
+	// RuleName is the name of the affected rule
+	RuleName *string `json:"ruleName"`
+	// RelatedRuleIndex is the position of the rule that causes the finding
+	RelatedRuleIndex int `json:"relatedRuleIndex"`
+	// RelatedRuleName is the name of the rule that causes the finding
+	RelatedRuleName *string `json:"relatedRuleName"`
+	// Message is a human readable description of the finding
+	Message string `json:"message"`
+}
+
+// APINetworkSecurityGroupLintResult is the data structure to capture API representation of a lint result
+type APINetworkSecurityGroupLintResult struct {
+	// Valid is true if no findings were reported
+	Valid bool `json:"valid"`
+	// Findings is the list of findings for the rules in the request
+	Findings []APINetworkSecurityGroupLintFinding `json:"findings"`
+}
+
+// NewAPINetworkSecurityGroupLintResult accepts lint findings and returns an API object
+func NewAPINetworkSecurityGroupLintResult(findings []nsg.Finding) *APINetworkSecurityGroupLintResult {
+	apilr := &APINetworkSecurityGroupLintResult{
+		Valid:    len(findings) == 0,
+		Findings: []APINetworkSecurityGroupLintFinding{},
+	}
+
+	for _, f := range findings {
+		apilr.Findings = append(apilr.Findings, APINetworkSecurityGroupLintFinding{
+			Type:             f.Type,
+			RuleIndex:        f.Rule.Index,
+			RuleName:         f.Rule.Name,
+			RelatedRuleIndex: f.RelatedRule.Index,
+			RelatedRuleName:  f.RelatedRule.Name,
+			Message:          f.Message,
+		})
+	}
+
+	return apilr
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/api/pkg/nsg"
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
 	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
@@ -438,3 +439,139 @@ func TestAPINetworkSecurityGroupNewSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestAPINetworkSecurityGroupEvaluationRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APINetworkSecurityGroupEvaluationRequest
+		expectErr bool
+	}{
+		{
+			desc:      "ok when a TCP flow with ports is provided",
+			obj:       APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "ingress", Protocol: "tcp", SourceAddress: "10.1.2.3", DestinationAddress: "10.1.5.6", DestinationPort: cdb.GetIntPtr(5432)},
+			expectErr: false,
+		},
+		{
+			desc:      "ok when an ICMP flow without ports is provided",
+			obj:       APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "EGRESS", Protocol: "ICMP", SourceAddress: "10.1.2.3", DestinationAddress: "8.8.8.8"},
+			expectErr: false,
+		},
+		{
+			desc:      "error when ports are provided with ICMP",
+			obj:       APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "EGRESS", Protocol: "ICMP", SourceAddress: "10.1.2.3", DestinationAddress: "8.8.8.8", DestinationPort: cdb.GetIntPtr(80)},
+			expectErr: true,
+		},
+		{
+			desc:      "error when protocol is ANY",
+			obj:       APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "INGRESS", Protocol: "ANY", SourceAddress: "10.1.2.3", DestinationAddress: "10.1.5.6"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when address is invalid",
+			obj:       APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "INGRESS", Protocol: "TCP", SourceAddress: "10.1.2.0/24", DestinationAddress: "10.1.5.6"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when port is out of range",
+			obj:       APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "INGRESS", Protocol: "UDP", SourceAddress: "10.1.2.3", DestinationAddress: "10.1.5.6", SourcePort: cdb.GetIntPtr(70000)},
+			expectErr: true,
+		},
+		{
+			desc:      "error when instance ID is not provided",
+			obj:       APINetworkSecurityGroupEvaluationRequest{Direction: "INGRESS", Protocol: "TCP", SourceAddress: "10.1.2.3", DestinationAddress: "10.1.5.6"},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+			if err != nil {
+				fmt.Println(err.Error())
+				return
+			}
+
+			flow := tc.obj.ToFlow()
+			assert.Equal(t, NetworkSecurityGroupRuleProtobufProtocolFromAPIProtocol[tc.obj.Protocol], flow.Protocol)
+			assert.Equal(t, tc.obj.SourceAddress, flow.SourceIP.String())
+			assert.Equal(t, tc.obj.DestinationPort != nil, flow.DestinationPort != nil)
+		})
+	}
+}
+
+func TestAPINetworkSecurityGroupEvaluationNew(t *testing.T) {
+	dbRules := []*cdbm.NetworkSecurityGroupRule{
+		{
+			NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+				Id:             cdb.GetStrPtr("allow-postgres"),
+				Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+				Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+				Action:         cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT,
+				Priority:       100,
+				DstPortStart:   getIntPtrToUint32Ptr(cdb.GetIntPtr(5432)),
+				DstPortEnd:     getIntPtrToUint32Ptr(cdb.GetIntPtr(5432)),
+				SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: "10.1.0.0/16"},
+				DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+			},
+		},
+	}
+
+	rules, err := nsg.NewRules(dbRules, nil)
+	assert.NoError(t, err)
+
+	req := APINetworkSecurityGroupEvaluationRequest{InstanceID: uuid.NewString(), Direction: "INGRESS", Protocol: "TCP", SourceAddress: "10.1.2.3", DestinationAddress: "10.2.0.4", DestinationPort: cdb.GetIntPtr(5432)}
+	assert.NoError(t, req.Validate())
+
+	instanceAttachment := &nsg.Attachment{Level: nsg.AttachmentLevelInstance, NetworkSecurityGroupID: "nsg-1", Rules: rules}
+	vpcAttachment := &nsg.Attachment{Level: nsg.AttachmentLevelVpc, NetworkSecurityGroupID: "nsg-2"}
+
+	apie, err := NewAPINetworkSecurityGroupEvaluation(nsg.Evaluate(req.ToFlow(), instanceAttachment, vpcAttachment), dbRules)
+	assert.NoError(t, err)
+	assert.True(t, apie.Allowed)
+	assert.Equal(t, APINetworkSecurityGroupRuleActionPermit, apie.Action)
+	assert.Equal(t, "nsg-1", *apie.NetworkSecurityGroupID)
+	assert.Equal(t, nsg.AttachmentLevelInstance, *apie.AttachmentLevel)
+	assert.Equal(t, "nsg-2", *apie.OverriddenNetworkSecurityGroupID)
+	assert.Equal(t, 0, *apie.MatchedRuleIndex)
+	assert.Equal(t, "allow-postgres", *apie.MatchedRule.Name)
+	assert.Equal(t, "5432-5432", *apie.MatchedRule.DestinationPortRange)
+
+	req.DestinationPort = cdb.GetIntPtr(22)
+	apie, err = NewAPINetworkSecurityGroupEvaluation(nsg.Evaluate(req.ToFlow(), instanceAttachment, vpcAttachment), dbRules)
+	assert.NoError(t, err)
+	assert.False(t, apie.Allowed)
+	assert.Equal(t, APINetworkSecurityGroupRuleActionDeny, apie.Action)
+	assert.Nil(t, apie.MatchedRule)
+	assert.Nil(t, apie.MatchedRuleIndex)
+}
+
+func TestAPINetworkSecurityGroupLintResultNew(t *testing.T) {
+	apiRules := []APINetworkSecurityGroupRule{
+		{Name: cdb.GetStrPtr("deny-all"), Direction: APINetworkSecurityGroupRuleDirectionIngress, Protocol: APINetworkSecurityGroupRuleProtocolAny, Action: APINetworkSecurityGroupRuleActionDeny, Priority: 10, SourcePrefix: cdb.GetStrPtr("0.0.0.0/0"), DestinationPrefix: cdb.GetStrPtr("0.0.0.0/0")},
+		{Name: cdb.GetStrPtr("allow-ssh"), Direction: APINetworkSecurityGroupRuleDirectionIngress, Protocol: APINetworkSecurityGroupRuleProtocolTcp, Action: APINetworkSecurityGroupRuleActionPermit, Priority: 20, DestinationPortRange: cdb.GetStrPtr("22"), SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationPrefix: cdb.GetStrPtr("0.0.0.0/0")},
+	}
+
+	assert.NoError(t, APINetworkSecurityGroupLintRequest{Rules: apiRules}.Validate())
+
+	dbRules := []*cdbm.NetworkSecurityGroupRule{}
+	for _, r := range apiRules {
+		dbRule, err := ProtobufRuleFromAPINetworkSecurityGroupRule(&r)
+		assert.NoError(t, err)
+		dbRules = append(dbRules, dbRule)
+	}
+
+	rules, err := nsg.NewRules(dbRules, nil)
+	assert.NoError(t, err)
+
+	result := NewAPINetworkSecurityGroupLintResult(nsg.Lint(rules))
+	assert.False(t, result.Valid)
+	assert.Equal(t, 1, len(result.Findings))
+	assert.Equal(t, nsg.FindingTypeShadowed, result.Findings[0].Type)
+	assert.Equal(t, 1, result.Findings[0].RuleIndex)
+	assert.Equal(t, "allow-ssh", *result.Findings[0].RuleName)
+	assert.Equal(t, 0, result.Findings[0].RelatedRuleIndex)
+
+	result = NewAPINetworkSecurityGroupLintResult(nsg.Lint(rules[1:]))
+	assert.True(t, result.Valid)
+	assert.Equal(t, 0, len(result.Findings))
+}
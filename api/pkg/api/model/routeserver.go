@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+const (
+	// RouteServerSourceTypeConfigFile indicates the route server is configured in the Site config file and can't be changed through the API
+	RouteServerSourceTypeConfigFile = "ConfigFile"
+	// RouteServerSourceTypeAdminAPI indicates the route server was set through the API
+	RouteServerSourceTypeAdminAPI = "AdminApi"
+
+	// MaxRouteServers is the maximum number of route servers that can be specified in a request
+	MaxRouteServers = 32
+)
+
+// APIRouteServer is the data structure to capture API representation of a route server of a Site
+type APIRouteServer struct {
+	// Address is the IP address of the route server
+	Address string `json:"address"`
+	// SourceType indicates where the route server was configured, either ConfigFile or AdminApi
+	SourceType string `json:"sourceType"`
+}
+
+// NewAPIRouteServer creates and returns a new APIRouteServer object
+func NewAPIRouteServer(proto *cwssaws.RouteServer) *APIRouteServer {
+	sourceType := RouteServerSourceTypeConfigFile
+	if proto.GetSourceType() == cwssaws.RouteServerSourceType_AdminApi {
+		sourceType = RouteServerSourceTypeAdminAPI
+	}
+
+	return &APIRouteServer{
+		Address:    proto.GetAddress(),
+		SourceType: sourceType,
+	}
+}
+
+// APIRouteServerCreateRequest is the data structure to capture request to add route servers to a Site
+type APIRouteServerCreateRequest struct {
+	// RouteServers is the list of route server IP addresses to add
+	RouteServers []string `json:"routeServers"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (rscr APIRouteServerCreateRequest) Validate() error {
+	return validation.ValidateStruct(&rscr,
+		validation.Field(&rscr.RouteServers,
+			validation.Required.Error(validationErrorValueRequired),
+			validation.Length(1, MaxRouteServers),
+			validation.Each(validationis.IP.Error(validationErrorInvalidIPAddress))),
+	)
+}
+
+// ToProto converts the request to the route servers added with the Admin API source type
+func (rscr APIRouteServerCreateRequest) ToProto() *cwssaws.RouteServers {
+	return &cwssaws.RouteServers{
+		RouteServers: rscr.RouteServers,
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+}
+
+// APIRouteServerUpdateRequest is the data structure to capture request to replace the route servers of a Site set through the API
+type APIRouteServerUpdateRequest struct {
+	// RouteServers is the list of route server IP addresses replacing the ones set through the API, an empty list removes them all
+	RouteServers []string `json:"routeServers"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (rsur APIRouteServerUpdateRequest) Validate() error {
+	return validation.ValidateStruct(&rsur,
+		validation.Field(&rsur.RouteServers,
+			validation.NotNil.Error(validationErrorValueRequired),
+			validation.Length(0, MaxRouteServers),
+			validation.Each(validationis.IP.Error(validationErrorInvalidIPAddress))),
+	)
+}
+
+// ToProto converts the request to the route servers replacing the ones with the Admin API source type
+func (rsur APIRouteServerUpdateRequest) ToProto() *cwssaws.RouteServers {
+	return &cwssaws.RouteServers{
+		RouteServers: rsur.RouteServers,
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func TestNewAPIRouteServer(t *testing.T) {
+	assert.Equal(t, &APIRouteServer{Address: "10.0.0.1", SourceType: RouteServerSourceTypeConfigFile},
+		NewAPIRouteServer(&cwssaws.RouteServer{Address: "10.0.0.1", SourceType: cwssaws.RouteServerSourceType_ConfigFile}))
+	assert.Equal(t, &APIRouteServer{Address: "fd00::1", SourceType: RouteServerSourceTypeAdminAPI},
+		NewAPIRouteServer(&cwssaws.RouteServer{Address: "fd00::1", SourceType: cwssaws.RouteServerSourceType_AdminApi}))
+}
+
+func TestAPIRouteServerCreateRequest_Validate(t *testing.T) {
+	tooMany := make([]string, MaxRouteServers+1)
+	for i := range tooMany {
+		tooMany[i] = "10.0.0.1"
+	}
+
+	tests := []struct {
+		name         string
+		routeServers []string
+		wantErr      bool
+	}{
+		{name: "valid IPv4 and IPv6 route servers", routeServers: []string{"10.0.0.1", "fd00::1"}},
+		{name: "missing route servers", routeServers: nil, wantErr: true},
+		{name: "empty route servers", routeServers: []string{}, wantErr: true},
+		{name: "invalid route server address", routeServers: []string{"10.0.0.256"}, wantErr: true},
+		{name: "route server CIDR", routeServers: []string{"10.0.0.0/24"}, wantErr: true},
+		{name: "too many route servers", routeServers: tooMany, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rscr := APIRouteServerCreateRequest{RouteServers: tt.routeServers}
+			err := rscr.Validate()
+			assert.Equal(t, tt.wantErr, err != nil, err)
+			if err != nil {
+				return
+			}
+
+			request := rscr.ToProto()
+			assert.Equal(t, tt.routeServers, request.RouteServers)
+			assert.Equal(t, cwssaws.RouteServerSourceType_AdminApi, request.SourceType)
+		})
+	}
+}
+
+func TestAPIRouteServerUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		routeServers []string
+		wantErr      bool
+	}{
+		{name: "valid route servers", routeServers: []string{"10.0.0.1", "fd00::1"}},
+		{name: "empty route servers remove all route servers", routeServers: []string{}},
+		{name: "missing route servers", routeServers: nil, wantErr: true},
+		{name: "invalid route server address", routeServers: []string{"route-server"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsur := APIRouteServerUpdateRequest{RouteServers: tt.routeServers}
+			err := rsur.Validate()
+			assert.Equal(t, tt.wantErr, err != nil, err)
+			if err != nil {
+				return
+			}
+
+			request := rsur.ToProto()
+			assert.Equal(t, tt.routeServers, request.RouteServers)
+			assert.Equal(t, cwssaws.RouteServerSourceType_AdminApi, request.SourceType)
+		})
+	}
+}
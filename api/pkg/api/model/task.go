@@ -85,3 +85,88 @@ func (r *APIGetTaskRequest) Validate() error {
 	}
 	return nil
 }
+
+// ProtoToAPIRackTaskReportOutcomeName maps protobuf TaskReportOutcome to API-friendly names.
+var ProtoToAPIRackTaskReportOutcomeName = map[rlav1.TaskReportOutcome]string{
+	rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_UNKNOWN:    "Unknown",
+	rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_SUCCEEDED:  "Succeeded",
+	rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED:     "Failed",
+	rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_TERMINATED: "Terminated",
+}
+
+// Export formats of a rack task report.
+const (
+	RackTaskReportFormatJSON     = "json"
+	RackTaskReportFormatMarkdown = "markdown"
+)
+
+// APIRackTaskReportEntry is one line of a rack task report (OpenAPI schema RackTaskReportEntry).
+// Entries about the task as a whole have no component.
+type APIRackTaskReportEntry struct {
+	Time          time.Time `json:"time"`
+	ComponentType *string   `json:"componentType"`
+	ComponentID   *string   `json:"componentId"`
+	Stage         *int      `json:"stage"`
+	Step          *string   `json:"step"`
+	Action        string    `json:"action"`
+	Outcome       string    `json:"outcome"`
+	Message       string    `json:"message"`
+}
+
+// APIRackTaskReport is the API response model for the report of a rack task (OpenAPI schema RackTaskReport).
+type APIRackTaskReport struct {
+	Task    *APIRackTask             `json:"task"`
+	Entries []APIRackTaskReportEntry `json:"entries"`
+}
+
+func (r *APIRackTaskReport) FromProto(rsp *rlav1.GetTaskReportResponse) {
+	if rsp == nil {
+		return
+	}
+	r.Task = NewAPIRackTask(rsp.GetTask())
+	r.Entries = make([]APIRackTaskReportEntry, 0, len(rsp.GetEntries()))
+	for _, e := range rsp.GetEntries() {
+		entry := APIRackTaskReportEntry{
+			Time:    e.GetTime().AsTime().UTC(),
+			Action:  e.GetAction(),
+			Outcome: enumOr(ProtoToAPIRackTaskReportOutcomeName, e.GetOutcome(), "Unknown"),
+			Message: e.GetMessage(),
+		}
+		if id := e.GetComponentId(); id != "" {
+			componentType := enumOr(ProtoToAPIRackComponentTypeName, e.GetComponentType(), "Unknown")
+			entry.ComponentType = &componentType
+			entry.ComponentID = &id
+		}
+		if stage := int(e.GetStage()); stage > 0 {
+			entry.Stage = &stage
+		}
+		if step := e.GetStep(); step != "" {
+			entry.Step = &step
+		}
+		r.Entries = append(r.Entries, entry)
+	}
+}
+
+func NewAPIRackTaskReport(rsp *rlav1.GetTaskReportResponse) *APIRackTaskReport {
+	r := &APIRackTaskReport{}
+	r.FromProto(rsp)
+	return r
+}
+
+// APIGetTaskReportRequest captures query parameters for getting the report of a task.
+type APIGetTaskReportRequest struct {
+	SiteID string `query:"siteId"`
+	Format string `query:"format"`
+}
+
+func (r *APIGetTaskReportRequest) Validate() error {
+	if r.SiteID == "" {
+		return fmt.Errorf("siteId query parameter is required")
+	}
+	switch r.Format {
+	case "", RackTaskReportFormatJSON, RackTaskReportFormatMarkdown:
+	default:
+		return fmt.Errorf("format query parameter must be %q or %q", RackTaskReportFormatJSON, RackTaskReportFormatMarkdown)
+	}
+	return nil
+}
@@ -218,3 +218,95 @@ func TestAPIGetTaskRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAPIRackTaskReport(t *testing.T) {
+	recorded := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	rsp := &rlav1.GetTaskReportResponse{
+		Task: &rlav1.Task{
+			Id:     &rlav1.UUID{Id: "task-123"},
+			Status: rlav1.TaskStatus_TASK_STATUS_FAILED,
+		},
+		Entries: []*rlav1.TaskReportEntry{
+			{
+				Time:          timestamppb.New(recorded),
+				ComponentType: rlav1.ComponentType_COMPONENT_TYPE_POWERSHELF,
+				ComponentId:   "ps-1",
+				Stage:         2,
+				Step:          "post_operation",
+				Action:        "VerifyPowerStatus",
+				Outcome:       rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_SUCCEEDED,
+				Message:       "completed in 5s",
+			},
+			{
+				Time:    timestamppb.New(recorded),
+				Action:  "Task",
+				Outcome: rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_TERMINATED,
+			},
+		},
+	}
+
+	result := NewAPIRackTaskReport(rsp)
+	assert.Equal(t, "task-123", result.Task.ID)
+	assert.Equal(t, "Failed", result.Task.Status)
+	assert.Len(t, result.Entries, 2)
+
+	component := result.Entries[0]
+	assert.True(t, component.Time.Equal(recorded))
+	assert.Equal(t, "PowerShelf", *component.ComponentType)
+	assert.Equal(t, "ps-1", *component.ComponentID)
+	assert.Equal(t, 2, *component.Stage)
+	assert.Equal(t, "post_operation", *component.Step)
+	assert.Equal(t, "VerifyPowerStatus", component.Action)
+	assert.Equal(t, "Succeeded", component.Outcome)
+	assert.Equal(t, "completed in 5s", component.Message)
+
+	task := result.Entries[1]
+	assert.Nil(t, task.ComponentType)
+	assert.Nil(t, task.ComponentID)
+	assert.Nil(t, task.Stage)
+	assert.Nil(t, task.Step)
+	assert.Equal(t, "Terminated", task.Outcome)
+}
+
+func TestAPIGetTaskReportRequest_Validate(t *testing.T) {
+	siteID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name    string
+		request APIGetTaskReportRequest
+		wantErr bool
+	}{
+		{
+			name:    "valid request without format",
+			request: APIGetTaskReportRequest{SiteID: siteID},
+			wantErr: false,
+		},
+		{
+			name:    "valid request with markdown format",
+			request: APIGetTaskReportRequest{SiteID: siteID, Format: RackTaskReportFormatMarkdown},
+			wantErr: false,
+		},
+		{
+			name:    "missing siteId",
+			request: APIGetTaskReportRequest{Format: RackTaskReportFormatJSON},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported format",
+			request: APIGetTaskReportRequest{SiteID: siteID, Format: "pdf"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -159,6 +159,27 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetSiteStatusDetailsHandler(dbSession),
 		},
+		// Route Server endpoints
+		{
+			Path:    apiPathPrefix + "/site/:siteID/route-server",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllRouteServerHandler(dbSession, tc, scp, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/site/:siteID/route-server",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateRouteServerHandler(dbSession, tc, scp, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/site/:siteID/route-server",
+			Method:  http.MethodPatch,
+			Handler: apiHandler.NewUpdateRouteServerHandler(dbSession, tc, scp, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/site/:siteID/route-server/:address",
+			Method:  http.MethodDelete,
+			Handler: apiHandler.NewDeleteRouteServerHandler(dbSession, tc, scp, cfg),
+		},
 		// VPC endpoints
 		{
 			Path:    apiPathPrefix + "/vpc",
@@ -207,6 +228,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetVpcPrefixHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/vpc-prefix/:id/usage",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetVpcPrefixUsageHandler(dbSession, tc, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/vpc-prefix/:id",
 			Method:  http.MethodPatch,
@@ -261,6 +287,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetAllDerivedIPBlockHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/ipblock/:id/usage",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetIPBlockUsageHandler(dbSession, tc, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/ipblock/:id",
 			Method:  http.MethodPatch,
@@ -602,6 +633,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetSubnetHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/subnet/:id/usage",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetSubnetUsageHandler(dbSession, tc, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/subnet/:id",
 			Method:  http.MethodPatch,
@@ -651,6 +687,18 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Handler: apiHandler.NewGetAllNetworkSecurityGroupHandler(dbSession, tc, cfg),
 		},
 
+		{
+			Path:    apiPathPrefix + "/network-security-group/evaluate",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewEvaluateNetworkSecurityGroupHandler(dbSession, tc, cfg),
+		},
+
+		{
+			Path:    apiPathPrefix + "/network-security-group/lint",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewLintNetworkSecurityGroupHandler(dbSession, tc, cfg),
+		},
+
 		{
 			Path:    apiPathPrefix + "/network-security-group/:id",
 			Method:  http.MethodGet,
@@ -668,6 +716,68 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodDelete,
 			Handler: apiHandler.NewDeleteNetworkSecurityGroupHandler(dbSession, tc, scp, cfg),
 		},
+		// AddressGroup endpoints
+		{
+			Path:    apiPathPrefix + "/address-group",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateAddressGroupHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-group",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllAddressGroupHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-group/:id",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAddressGroupHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-group/:id",
+			Method:  http.MethodPatch,
+			Handler: apiHandler.NewUpdateAddressGroupHandler(dbSession, tc, scp, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-group/:id",
+			Method:  http.MethodDelete,
+			Handler: apiHandler.NewDeleteAddressGroupHandler(dbSession, tc, cfg),
+		},
+		// FloatingIP endpoints
+		{
+			Path:    apiPathPrefix + "/floating-ip",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateFloatingIPHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/floating-ip",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllFloatingIPHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/floating-ip/:id",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetFloatingIPHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/floating-ip/:id",
+			Method:  http.MethodPatch,
+			Handler: apiHandler.NewUpdateFloatingIPHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/floating-ip/:id",
+			Method:  http.MethodDelete,
+			Handler: apiHandler.NewDeleteFloatingIPHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/floating-ip/:id/associate",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewAssociateFloatingIPHandler(dbSession, tc, scp, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/floating-ip/:id/disassociate",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewDisassociateFloatingIPHandler(dbSession, tc, scp, cfg),
+		},
 
 		// SSHKey endpoints
 		{
@@ -847,6 +957,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetTaskHandler(dbSession, tc, scp, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/rack/task/:id/report",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetTaskReportHandler(dbSession, tc, scp, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/rack",
 			Method:  http.MethodGet,
@@ -53,10 +53,11 @@ func TestNewAPIRoutes(t *testing.T) {
 		"tenant":                   4,
 		"tenant-account":           5,
 		"site":                     6,
+		"route-server":             4,
 		"vpc":                      6,
 		"vpcpeering":               4,
-		"vpcprefix":                5,
-		"ip-block":                 6,
+		"vpcprefix":                6,
+		"ip-block":                 7,
 		"instance":                 8,
 		"interface":                1,
 		"infiniband-interface":     2,
@@ -69,7 +70,7 @@ func TestNewAPIRoutes(t *testing.T) {
 		"instance-type":            5,
 		"machine":                  5,
 		"allocation":               6,
-		"subnet":                   5,
+		"subnet":                   6,
 		"machine-instance-type":    3,
 		"user":                     1,
 		"operating-system":         5,
@@ -77,11 +78,13 @@ func TestNewAPIRoutes(t *testing.T) {
 		"sshkeygroup":              5,
 		"machine-capability":       1,
 		"audit":                    2,
-		"network-security-group":   5,
+		"network-security-group":   7,
+		"address-group":            5,
+		"floating-ip":              7,
 		"machine-validation":       11,
 		"dpu-extension-service":    7,
 		"sku":                      2,
-		"rack":                     11,
+		"rack":                     12,
 		"tray":                     8,
 		"stats":                    4,
 	}
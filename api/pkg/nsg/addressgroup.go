@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nsg
+
+import (
+	"errors"
+	"fmt"
+
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrAddressGroupNotFound is returned when a rule references an address group that was not supplied
+	ErrAddressGroupNotFound = errors.New("address group not found")
+	// ErrAddressGroupEmpty is returned when a rule references an address group without prefixes
+	ErrAddressGroupEmpty = errors.New("address group has no prefixes")
+)
+
+// SourcePrefixes returns the source prefixes of a rule, resolving an address group reference if present
+func SourcePrefixes(rule *cdbm.NetworkSecurityGroupRule, addressGroups map[string]*cdbm.AddressGroup) ([]string, error) {
+	if rule.SourceAddressGroupID != nil {
+		return addressGroupPrefixes(*rule.SourceAddressGroupID, addressGroups)
+	}
+
+	switch srcNet := rule.GetSourceNet().(type) {
+	case *cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix:
+		return []string{srcNet.SrcPrefix}, nil
+	default:
+		return nil, errors.New("has unknown source network option")
+	}
+}
+
+// DestinationPrefixes returns the destination prefixes of a rule, resolving an address group reference if present
+func DestinationPrefixes(rule *cdbm.NetworkSecurityGroupRule, addressGroups map[string]*cdbm.AddressGroup) ([]string, error) {
+	if rule.DestinationAddressGroupID != nil {
+		return addressGroupPrefixes(*rule.DestinationAddressGroupID, addressGroups)
+	}
+
+	switch dstNet := rule.GetDestinationNet().(type) {
+	case *cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix:
+		return []string{dstNet.DstPrefix}, nil
+	default:
+		return nil, errors.New("has unknown destination network option")
+	}
+}
+
+func addressGroupPrefixes(id string, addressGroups map[string]*cdbm.AddressGroup) ([]string, error) {
+	ag, found := addressGroups[id]
+	if !found || ag == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAddressGroupNotFound, id)
+	}
+	if len(ag.Prefixes) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrAddressGroupEmpty, ag.Name)
+	}
+	return ag.Prefixes, nil
+}
+
+// ExpandRules converts stored rules into the literal-prefix rules understood by a Site.
+//
+// Sites only accept a single source and destination prefix per rule, so a rule
+// that references an address group is expanded into one rule per combination
+// of resolved source and destination prefix. Expanded copies of a named rule
+// are suffixed with their position so that they remain distinguishable on Site.
+// Rules without address group references are passed through unchanged.
+func ExpandRules(rules []*cdbm.NetworkSecurityGroupRule, addressGroups map[string]*cdbm.AddressGroup) ([]*cwssaws.NetworkSecurityGroupRuleAttributes, error) {
+	expanded := []*cwssaws.NetworkSecurityGroupRuleAttributes{}
+
+	for i, rule := range rules {
+		if rule == nil || rule.NetworkSecurityGroupRuleAttributes == nil {
+			return nil, fmt.Errorf("%w: rule %d has no attributes", ErrInvalidRule, i)
+		}
+
+		if rule.SourceAddressGroupID == nil && rule.DestinationAddressGroupID == nil {
+			expanded = append(expanded, rule.NetworkSecurityGroupRuleAttributes)
+			continue
+		}
+
+		srcPrefixes, err := SourcePrefixes(rule, addressGroups)
+		if err != nil {
+			return nil, fmt.Errorf("%w: rule %d %v", ErrInvalidRule, i, err)
+		}
+
+		dstPrefixes, err := DestinationPrefixes(rule, addressGroups)
+		if err != nil {
+			return nil, fmt.Errorf("%w: rule %d %v", ErrInvalidRule, i, err)
+		}
+
+		count := 0
+		for _, src := range srcPrefixes {
+			for _, dst := range dstPrefixes {
+				count++
+
+				attrs := proto.Clone(rule.NetworkSecurityGroupRuleAttributes).(*cwssaws.NetworkSecurityGroupRuleAttributes)
+				attrs.SourceNet = &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: src}
+				attrs.DestinationNet = &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: dst}
+
+				if rule.Id != nil && len(srcPrefixes)*len(dstPrefixes) > 1 {
+					id := fmt.Sprintf("%s-%d", *rule.Id, count)
+					attrs.Id = &id
+				}
+
+				expanded = append(expanded, attrs)
+			}
+		}
+	}
+
+	return expanded, nil
+}
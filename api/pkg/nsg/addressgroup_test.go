@@ -0,0 +1,134 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nsg
+
+import (
+	"net"
+	"testing"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBuildGroupRule(name string, srcGroupID, dstGroupID *string) *cdbm.NetworkSecurityGroupRule {
+	rule := testBuildRule(name, testIngress, testTCP, testPermit, 100, "0.0.0.0/0", "0.0.0.0/0", &PortRange{Start: 443, End: 443})
+	if srcGroupID != nil {
+		rule.SourceNet = nil
+		rule.SourceAddressGroupID = srcGroupID
+	}
+	if dstGroupID != nil {
+		rule.DestinationNet = nil
+		rule.DestinationAddressGroupID = dstGroupID
+	}
+	return rule
+}
+
+func TestExpandRules(t *testing.T) {
+	groups := map[string]*cdbm.AddressGroup{
+		"partners": {Name: "partners", Prefixes: []string{"10.0.0.0/24", "10.0.1.0/24"}},
+		"backends": {Name: "backends", Prefixes: []string{"192.168.0.0/24", "192.168.1.0/24", "192.168.2.0/24"}},
+		"empty":    {Name: "empty", Prefixes: []string{}},
+	}
+
+	tests := []struct {
+		name      string
+		rules     []*cdbm.NetworkSecurityGroupRule
+		wantCount int
+		wantIDs   []string
+		wantErr   error
+	}{
+		{
+			name:      "literal rules pass through",
+			rules:     []*cdbm.NetworkSecurityGroupRule{testBuildRule("literal", testIngress, testTCP, testPermit, 10, "10.0.0.0/8", "0.0.0.0/0", nil)},
+			wantCount: 1,
+			wantIDs:   []string{"literal"},
+		},
+		{
+			name:      "source group expands per prefix",
+			rules:     []*cdbm.NetworkSecurityGroupRule{testBuildGroupRule("web", cdb.GetStrPtr("partners"), nil)},
+			wantCount: 2,
+			wantIDs:   []string{"web-1", "web-2"},
+		},
+		{
+			name:      "source and destination groups expand to every combination",
+			rules:     []*cdbm.NetworkSecurityGroupRule{testBuildGroupRule("web", cdb.GetStrPtr("partners"), cdb.GetStrPtr("backends"))},
+			wantCount: 6,
+		},
+		{
+			name:    "unknown group is rejected",
+			rules:   []*cdbm.NetworkSecurityGroupRule{testBuildGroupRule("web", cdb.GetStrPtr("missing"), nil)},
+			wantErr: ErrAddressGroupNotFound,
+		},
+		{
+			name:    "empty group is rejected",
+			rules:   []*cdbm.NetworkSecurityGroupRule{testBuildGroupRule("web", nil, cdb.GetStrPtr("empty"))},
+			wantErr: ErrAddressGroupEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandRules(tt.rules, groups)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, got, tt.wantCount)
+			for i, id := range tt.wantIDs {
+				assert.Equal(t, id, got[i].GetId())
+			}
+			for _, r := range got {
+				assert.NotEmpty(t, r.GetSrcPrefix())
+				assert.NotEmpty(t, r.GetDstPrefix())
+			}
+		})
+	}
+
+	// The stored rule must not be modified by expansion
+	stored := testBuildGroupRule("web", cdb.GetStrPtr("partners"), nil)
+	_, err := ExpandRules([]*cdbm.NetworkSecurityGroupRule{stored}, groups)
+	require.NoError(t, err)
+	assert.Nil(t, stored.SourceNet)
+	assert.Equal(t, "web", stored.GetId())
+}
+
+func TestNewRuleWithAddressGroup(t *testing.T) {
+	groups := map[string]*cdbm.AddressGroup{
+		"partners": {Name: "partners", Prefixes: []string{"10.0.0.0/24", "10.0.1.0/24"}},
+	}
+
+	r, err := NewRule(0, testBuildGroupRule("web", cdb.GetStrPtr("partners"), nil), groups)
+	require.NoError(t, err)
+	assert.Len(t, r.SourcePrefixes, 2)
+
+	assert.True(t, r.Matches(Flow{
+		Direction:       testIngress,
+		Protocol:        testTCP,
+		SourceIP:        net.ParseIP("10.0.1.20"),
+		DestinationIP:   net.ParseIP("192.168.0.1"),
+		DestinationPort: testUint32Ptr(443),
+	}))
+
+	_, err = NewRule(0, testBuildGroupRule("web", cdb.GetStrPtr("partners"), nil), nil)
+	assert.ErrorIs(t, err, ErrInvalidRule)
+}
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nsg
+
+import (
+	"fmt"
+	"net"
+
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+const (
+	// AttachmentLevelInstance indicates the Network Security Group is attached directly to an Instance
+	AttachmentLevelInstance = "Instance"
+	// AttachmentLevelVpc indicates the Network Security Group is attached to the VPC of an Instance
+	AttachmentLevelVpc = "VPC"
+)
+
+// Flow describes a single connection attempt to be evaluated
+type Flow struct {
+	// Direction is the direction of the flow relative to the Instance
+	Direction cwssaws.NetworkSecurityGroupRuleDirection
+	// Protocol is the L4 protocol of the flow, must not be ANY
+	Protocol cwssaws.NetworkSecurityGroupRuleProtocol
+	// SourceIP is the source address of the flow
+	SourceIP net.IP
+	// SourcePort is the source port of the flow, only used for TCP and UDP
+	SourcePort *uint32
+	// DestinationIP is the destination address of the flow
+	DestinationIP net.IP
+	// DestinationPort is the destination port of the flow, only used for TCP and UDP
+	DestinationPort *uint32
+}
+
+// Attachment is a Network Security Group attached at a given level
+type Attachment struct {
+	// Level is the attachment level, either AttachmentLevelInstance or AttachmentLevelVpc
+	Level string
+	// NetworkSecurityGroupID is the ID of the attached Network Security Group
+	NetworkSecurityGroupID string
+	// Rules are the rules of the attached Network Security Group
+	Rules []*Rule
+}
+
+// Decision is the outcome of evaluating a Flow
+type Decision struct {
+	// Allowed is true if the flow would be permitted
+	Allowed bool
+	// Attachment is the attachment whose rules decided the flow, nil if no Network Security Group applies
+	Attachment *Attachment
+	// Overridden is the attachment that was not evaluated because a more specific one took precedence
+	Overridden *Attachment
+	// MatchedRule is the rule that decided the flow, nil if no rule matched
+	MatchedRule *Rule
+	// EvaluatedRuleCount is the number of rules examined before a decision was reached
+	EvaluatedRuleCount int
+	// Reason is a human readable explanation of the decision
+	Reason string
+}
+
+// Matches returns true if the rule matches the flow
+func (r *Rule) Matches(flow Flow) bool {
+	if r.Direction != flow.Direction {
+		return false
+	}
+
+	if !protocolCovers(r.Protocol, flow.Protocol) {
+		return false
+	}
+
+	if !prefixesContain(r.SourcePrefixes, flow.SourceIP) || !prefixesContain(r.DestinationPrefixes, flow.DestinationIP) {
+		return false
+	}
+
+	if r.SourcePorts != nil && (flow.SourcePort == nil || !r.SourcePorts.Contains(*flow.SourcePort)) {
+		return false
+	}
+
+	if r.DestinationPorts != nil && (flow.DestinationPort == nil || !r.DestinationPorts.Contains(*flow.DestinationPort)) {
+		return false
+	}
+
+	return true
+}
+
+// Evaluate determines whether a flow would be allowed by the Network Security Group
+// attachments of an Instance.
+//
+// A Network Security Group attached directly to an Instance takes precedence over
+// the one attached to its VPC, so only the most specific attachment is evaluated.
+// Within that Network Security Group, the first matching rule in precedence order
+// decides the flow. If no rule matches, the flow is denied. If no Network Security
+// Group is attached at any level, the flow is not filtered and is allowed.
+func Evaluate(flow Flow, instanceAttachment *Attachment, vpcAttachment *Attachment) Decision {
+	effective := instanceAttachment
+	var overridden *Attachment
+
+	if effective == nil {
+		effective = vpcAttachment
+	} else if vpcAttachment != nil {
+		overridden = vpcAttachment
+	}
+
+	if effective == nil {
+		return Decision{
+			Allowed: true,
+			Reason:  "No Network Security Group is attached to the Instance or its VPC, traffic is not filtered",
+		}
+	}
+
+	decision := Decision{
+		Attachment: effective,
+		Overridden: overridden,
+	}
+
+	for _, rule := range SortRules(effective.Rules) {
+		decision.EvaluatedRuleCount++
+
+		if !rule.Matches(flow) {
+			continue
+		}
+
+		decision.MatchedRule = rule
+		decision.Allowed = rule.Action == cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT
+		decision.Reason = fmt.Sprintf("Rule %s with priority %d of %s level Network Security Group %s matched", rule.DisplayName(), rule.Priority, effective.Level, effective.NetworkSecurityGroupID)
+
+		return decision
+	}
+
+	decision.Reason = fmt.Sprintf("No rule of %s level Network Security Group %s matched, traffic is denied by default", effective.Level, effective.NetworkSecurityGroupID)
+
+	return decision
+}
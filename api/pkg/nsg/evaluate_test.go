@@ -0,0 +1,251 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nsg
+
+import (
+	"net"
+	"testing"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUint32Ptr(v uint32) *uint32 {
+	return &v
+}
+
+func testBuildRule(name string, direction cwssaws.NetworkSecurityGroupRuleDirection, protocol cwssaws.NetworkSecurityGroupRuleProtocol, action cwssaws.NetworkSecurityGroupRuleAction, priority uint32, src, dst string, dstPorts *PortRange) *cdbm.NetworkSecurityGroupRule {
+	rule := &cdbm.NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             cdb.GetStrPtr(name),
+			Direction:      direction,
+			Protocol:       protocol,
+			Action:         action,
+			Priority:       priority,
+			SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: src},
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: dst},
+		},
+	}
+	if dstPorts != nil {
+		rule.DstPortStart = testUint32Ptr(dstPorts.Start)
+		rule.DstPortEnd = testUint32Ptr(dstPorts.End)
+	}
+	return rule
+}
+
+const (
+	testIngress = cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS
+	testEgress  = cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_EGRESS
+	testTCP     = cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP
+	testUDP     = cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_UDP
+	testAny     = cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_ANY
+	testPermit  = cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT
+	testDeny    = cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY
+)
+
+func TestNewRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *cdbm.NetworkSecurityGroupRule
+		wantErr bool
+	}{
+		{
+			name: "valid rule is converted",
+			rule: testBuildRule("r1", testIngress, testTCP, testPermit, 10, "10.0.0.0/8", "192.168.1.0/24", &PortRange{Start: 22, End: 22}),
+		},
+		{
+			name:    "nil attributes are rejected",
+			rule:    &cdbm.NetworkSecurityGroupRule{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid prefix is rejected",
+			rule:    testBuildRule("r1", testIngress, testTCP, testPermit, 10, "10.0.0.0/33", "192.168.1.0/24", nil),
+			wantErr: true,
+		},
+		{
+			name: "half-defined port range is rejected",
+			rule: func() *cdbm.NetworkSecurityGroupRule {
+				r := testBuildRule("r1", testIngress, testTCP, testPermit, 10, "10.0.0.0/8", "192.168.1.0/24", nil)
+				r.DstPortStart = testUint32Ptr(80)
+				return r
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRule(0, tt.rule, nil)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.rule.Priority, r.Priority)
+			assert.Len(t, r.SourcePrefixes, 1)
+			assert.Len(t, r.DestinationPrefixes, 1)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	instanceRules, err := NewRules([]*cdbm.NetworkSecurityGroupRule{
+		testBuildRule("allow-postgres", testIngress, testTCP, testPermit, 100, "10.1.0.0/16", "0.0.0.0/0", &PortRange{Start: 5432, End: 5432}),
+		testBuildRule("deny-bad-host", testIngress, testTCP, testDeny, 50, "10.1.2.3/32", "0.0.0.0/0", nil),
+		testBuildRule("allow-any-egress", testEgress, testAny, testPermit, 100, "0.0.0.0/0", "0.0.0.0/0", nil),
+		testBuildRule("tie-permit", testIngress, testUDP, testPermit, 200, "0.0.0.0/0", "0.0.0.0/0", nil),
+		testBuildRule("tie-deny", testIngress, testUDP, testDeny, 200, "0.0.0.0/0", "0.0.0.0/0", nil),
+	}, nil)
+	require.NoError(t, err)
+
+	vpcRules, err := NewRules([]*cdbm.NetworkSecurityGroupRule{
+		testBuildRule("vpc-allow-all", testIngress, testAny, testPermit, 0, "0.0.0.0/0", "0.0.0.0/0", nil),
+	}, nil)
+	require.NoError(t, err)
+
+	instanceAttachment := &Attachment{Level: AttachmentLevelInstance, NetworkSecurityGroupID: "nsg-instance", Rules: instanceRules}
+	vpcAttachment := &Attachment{Level: AttachmentLevelVpc, NetworkSecurityGroupID: "nsg-vpc", Rules: vpcRules}
+
+	tests := []struct {
+		name            string
+		flow            Flow
+		instance        *Attachment
+		vpc             *Attachment
+		wantAllowed     bool
+		wantRule        *string
+		wantLevel       *string
+		wantOverridden  bool
+		wantEvaluations int
+	}{
+		{
+			name: "permit rule matches",
+			flow: Flow{
+				Direction:       testIngress,
+				Protocol:        testTCP,
+				SourceIP:        net.ParseIP("10.1.9.9"),
+				DestinationIP:   net.ParseIP("192.168.1.10"),
+				DestinationPort: testUint32Ptr(5432),
+			},
+			instance:        instanceAttachment,
+			vpc:             vpcAttachment,
+			wantAllowed:     true,
+			wantRule:        cdb.GetStrPtr("allow-postgres"),
+			wantLevel:       cdb.GetStrPtr(AttachmentLevelInstance),
+			wantOverridden:  true,
+			wantEvaluations: 2,
+		},
+		{
+			name: "higher priority deny wins over permit",
+			flow: Flow{
+				Direction:       testIngress,
+				Protocol:        testTCP,
+				SourceIP:        net.ParseIP("10.1.2.3"),
+				DestinationIP:   net.ParseIP("192.168.1.10"),
+				DestinationPort: testUint32Ptr(5432),
+			},
+			instance:        instanceAttachment,
+			vpc:             vpcAttachment,
+			wantAllowed:     false,
+			wantRule:        cdb.GetStrPtr("deny-bad-host"),
+			wantLevel:       cdb.GetStrPtr(AttachmentLevelInstance),
+			wantOverridden:  true,
+			wantEvaluations: 1,
+		},
+		{
+			name: "deny wins ties on equal priority",
+			flow: Flow{
+				Direction:     testIngress,
+				Protocol:      testUDP,
+				SourceIP:      net.ParseIP("172.16.0.1"),
+				DestinationIP: net.ParseIP("192.168.1.10"),
+			},
+			instance:        instanceAttachment,
+			wantAllowed:     false,
+			wantRule:        cdb.GetStrPtr("tie-deny"),
+			wantLevel:       cdb.GetStrPtr(AttachmentLevelInstance),
+			wantEvaluations: 4,
+		},
+		{
+			name: "port outside range falls through to default deny",
+			flow: Flow{
+				Direction:       testIngress,
+				Protocol:        testTCP,
+				SourceIP:        net.ParseIP("10.1.9.9"),
+				DestinationIP:   net.ParseIP("192.168.1.10"),
+				DestinationPort: testUint32Ptr(5433),
+			},
+			instance:        instanceAttachment,
+			wantAllowed:     false,
+			wantLevel:       cdb.GetStrPtr(AttachmentLevelInstance),
+			wantEvaluations: 5,
+		},
+		{
+			name: "VPC level applies when instance has no attachment",
+			flow: Flow{
+				Direction:       testIngress,
+				Protocol:        testTCP,
+				SourceIP:        net.ParseIP("10.1.2.3"),
+				DestinationIP:   net.ParseIP("192.168.1.10"),
+				DestinationPort: testUint32Ptr(5432),
+			},
+			vpc:             vpcAttachment,
+			wantAllowed:     true,
+			wantRule:        cdb.GetStrPtr("vpc-allow-all"),
+			wantLevel:       cdb.GetStrPtr(AttachmentLevelVpc),
+			wantEvaluations: 1,
+		},
+		{
+			name: "no attachment allows traffic",
+			flow: Flow{
+				Direction:     testEgress,
+				Protocol:      testTCP,
+				SourceIP:      net.ParseIP("192.168.1.10"),
+				DestinationIP: net.ParseIP("8.8.8.8"),
+			},
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Evaluate(tt.flow, tt.instance, tt.vpc)
+			assert.Equal(t, tt.wantAllowed, d.Allowed)
+			assert.NotEmpty(t, d.Reason)
+			assert.Equal(t, tt.wantEvaluations, d.EvaluatedRuleCount)
+			assert.Equal(t, tt.wantOverridden, d.Overridden != nil)
+
+			if tt.wantRule == nil {
+				assert.Nil(t, d.MatchedRule)
+			} else {
+				require.NotNil(t, d.MatchedRule)
+				assert.Equal(t, *tt.wantRule, d.MatchedRule.DisplayName())
+			}
+
+			if tt.wantLevel == nil {
+				assert.Nil(t, d.Attachment)
+			} else {
+				require.NotNil(t, d.Attachment)
+				assert.Equal(t, *tt.wantLevel, d.Attachment.Level)
+			}
+		})
+	}
+}
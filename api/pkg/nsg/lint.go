@@ -0,0 +1,117 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nsg
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// FindingTypeShadowed indicates a rule can never match because a preceding rule with a different action covers it
+	FindingTypeShadowed = "Shadowed"
+	// FindingTypeRedundant indicates a rule has no effect because a preceding rule with the same action covers it
+	FindingTypeRedundant = "Redundant"
+	// FindingTypeConflicting indicates two overlapping rules share a priority but take different actions
+	FindingTypeConflicting = "Conflicting"
+)
+
+// Finding describes a problem detected in a set of rules
+type Finding struct {
+	// Type is the type of the finding
+	Type string
+	// Rule is the rule the finding applies to
+	Rule *Rule
+	// RelatedRule is the rule that causes the finding
+	RelatedRule *Rule
+	// Message is a human readable description of the finding
+	Message string
+}
+
+// Lint examines a set of rules for shadowed, redundant and conflicting entries.
+//
+// A rule is shadowed when a rule evaluated before it matches every flow it
+// would match but takes a different action, so the rule is never applied.
+// A rule is redundant when such a preceding rule takes the same action, so
+// removing it would not change the outcome of any flow. Two rules conflict
+// when they have the same priority, overlap and take different actions; the
+// outcome for the overlapping flows then depends on tie-breaking rather than
+// on an explicit priority. A rule covered by a rule of the same priority with
+// a different action is reported once, as conflicting.
+//
+// Findings are returned in rule order.
+func Lint(rules []*Rule) []Finding {
+	findings := []Finding{}
+
+	sorted := SortRules(rules)
+
+	for i, rule := range sorted {
+		for _, prior := range sorted[:i] {
+			if !prior.Covers(rule) {
+				continue
+			}
+
+			if prior.Action != rule.Action && prior.Priority == rule.Priority {
+				// Reported as conflicting below
+				break
+			}
+
+			if prior.Action == rule.Action {
+				findings = append(findings, Finding{
+					Type:        FindingTypeRedundant,
+					Rule:        rule,
+					RelatedRule: prior,
+					Message:     fmt.Sprintf("Rule %s is redundant, rule %s is evaluated first and takes the same action for all matching traffic", rule.DisplayName(), prior.DisplayName()),
+				})
+			} else {
+				findings = append(findings, Finding{
+					Type:        FindingTypeShadowed,
+					Rule:        rule,
+					RelatedRule: prior,
+					Message:     fmt.Sprintf("Rule %s is shadowed, rule %s is evaluated first and matches all of its traffic", rule.DisplayName(), prior.DisplayName()),
+				})
+			}
+			break
+		}
+	}
+
+	for i, rule := range sorted {
+		for _, other := range sorted[i+1:] {
+			if other.Priority != rule.Priority {
+				break
+			}
+
+			if other.Action == rule.Action || !rule.Overlaps(other) {
+				continue
+			}
+
+			findings = append(findings, Finding{
+				Type:        FindingTypeConflicting,
+				Rule:        other,
+				RelatedRule: rule,
+				Message:     fmt.Sprintf("Rule %s conflicts with rule %s, both have priority %d and overlap but take different actions", other.DisplayName(), rule.DisplayName(), rule.Priority),
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Rule.Index < findings[j].Rule.Index
+	})
+
+	return findings
+}
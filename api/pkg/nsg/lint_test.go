@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nsg
+
+import (
+	"testing"
+
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name         string
+		rules        []*cdbm.NetworkSecurityGroupRule
+		wantFindings []string
+		wantRules    []string
+	}{
+		{
+			name: "disjoint rules produce no findings",
+			rules: []*cdbm.NetworkSecurityGroupRule{
+				testBuildRule("a", testIngress, testTCP, testPermit, 10, "10.0.0.0/24", "0.0.0.0/0", &PortRange{Start: 22, End: 22}),
+				testBuildRule("b", testIngress, testTCP, testDeny, 20, "10.0.1.0/24", "0.0.0.0/0", &PortRange{Start: 22, End: 22}),
+				testBuildRule("c", testEgress, testTCP, testDeny, 10, "10.0.0.0/24", "0.0.0.0/0", nil),
+			},
+			wantFindings: []string{},
+			wantRules:    []string{},
+		},
+		{
+			name: "covered rule with different action is shadowed",
+			rules: []*cdbm.NetworkSecurityGroupRule{
+				testBuildRule("deny-all", testIngress, testAny, testDeny, 10, "0.0.0.0/0", "0.0.0.0/0", nil),
+				testBuildRule("allow-ssh", testIngress, testTCP, testPermit, 20, "10.0.0.0/8", "0.0.0.0/0", &PortRange{Start: 22, End: 22}),
+			},
+			wantFindings: []string{FindingTypeShadowed},
+			wantRules:    []string{"allow-ssh"},
+		},
+		{
+			name: "covered rule with same action is redundant",
+			rules: []*cdbm.NetworkSecurityGroupRule{
+				testBuildRule("allow-web", testIngress, testTCP, testPermit, 10, "10.0.0.0/8", "0.0.0.0/0", &PortRange{Start: 80, End: 443}),
+				testBuildRule("allow-https", testIngress, testTCP, testPermit, 20, "10.1.0.0/16", "0.0.0.0/0", &PortRange{Start: 443, End: 443}),
+			},
+			wantFindings: []string{FindingTypeRedundant},
+			wantRules:    []string{"allow-https"},
+		},
+		{
+			name: "lower priority broad rule is not flagged",
+			rules: []*cdbm.NetworkSecurityGroupRule{
+				testBuildRule("allow-ssh", testIngress, testTCP, testPermit, 10, "10.0.0.0/8", "0.0.0.0/0", &PortRange{Start: 22, End: 22}),
+				testBuildRule("deny-all", testIngress, testAny, testDeny, 20, "0.0.0.0/0", "0.0.0.0/0", nil),
+			},
+			wantFindings: []string{},
+			wantRules:    []string{},
+		},
+		{
+			name: "overlapping rules with equal priority and different actions conflict",
+			rules: []*cdbm.NetworkSecurityGroupRule{
+				testBuildRule("allow-a", testIngress, testTCP, testPermit, 10, "10.0.0.0/16", "0.0.0.0/0", &PortRange{Start: 1000, End: 2000}),
+				testBuildRule("deny-b", testIngress, testTCP, testDeny, 10, "10.0.5.0/24", "0.0.0.0/0", &PortRange{Start: 1500, End: 2500}),
+			},
+			wantFindings: []string{FindingTypeConflicting},
+			wantRules:    []string{"allow-a"},
+		},
+		{
+			name: "covered rule with equal priority and different action is only reported as conflicting",
+			rules: []*cdbm.NetworkSecurityGroupRule{
+				testBuildRule("deny-all", testIngress, testAny, testDeny, 10, "0.0.0.0/0", "0.0.0.0/0", nil),
+				testBuildRule("allow-ssh", testIngress, testTCP, testPermit, 10, "10.0.0.0/8", "0.0.0.0/0", &PortRange{Start: 22, End: 22}),
+			},
+			wantFindings: []string{FindingTypeConflicting},
+			wantRules:    []string{"allow-ssh"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := NewRules(tt.rules, nil)
+			require.NoError(t, err)
+
+			findings := Lint(rules)
+
+			gotTypes := []string{}
+			gotRules := []string{}
+			for _, f := range findings {
+				gotTypes = append(gotTypes, f.Type)
+				gotRules = append(gotRules, f.Rule.DisplayName())
+				assert.NotNil(t, f.RelatedRule)
+				assert.NotEmpty(t, f.Message)
+			}
+
+			assert.Equal(t, tt.wantFindings, gotTypes)
+			assert.Equal(t, tt.wantRules, gotRules)
+		})
+	}
+}
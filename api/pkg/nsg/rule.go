@@ -0,0 +1,304 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package nsg provides an offline evaluation engine for Network Security
+// Group rules. It mirrors the ordering semantics used when rules are
+// programmed on a Site so that callers can ask whether a given flow would be
+// permitted, and which rule would decide it, without touching the data path.
+package nsg
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+var (
+	// ErrInvalidRule is returned when a stored rule cannot be converted for evaluation
+	ErrInvalidRule = errors.New("invalid network security group rule")
+)
+
+// PortRange is an inclusive range of L4 ports
+type PortRange struct {
+	Start uint32
+	End   uint32
+}
+
+// Contains returns true if the port falls within the range
+func (pr PortRange) Contains(port uint32) bool {
+	return port >= pr.Start && port <= pr.End
+}
+
+// Covers returns true if the other range is entirely within this range
+func (pr PortRange) Covers(other PortRange) bool {
+	return other.Start >= pr.Start && other.End <= pr.End
+}
+
+// Overlaps returns true if the two ranges share at least one port
+func (pr PortRange) Overlaps(other PortRange) bool {
+	return pr.Start <= other.End && other.Start <= pr.End
+}
+
+// Rule is the evaluation representation of a single Network Security Group rule.
+// Source and destination are held as lists of prefixes so that rules which
+// resolve to more than one network can be evaluated the same way as literal ones.
+type Rule struct {
+	// Index is the position of the rule in the Network Security Group
+	Index int
+	// Name is the optional user supplied identifier of the rule
+	Name *string
+	// Direction is the direction of traffic the rule applies to
+	Direction cwssaws.NetworkSecurityGroupRuleDirection
+	// Protocol is the protocol the rule applies to
+	Protocol cwssaws.NetworkSecurityGroupRuleProtocol
+	// Action is the action taken when the rule matches
+	Action cwssaws.NetworkSecurityGroupRuleAction
+	// Priority determines evaluation order, lower values are evaluated first
+	Priority uint32
+	// SourcePrefixes are the networks matched against the source address
+	SourcePrefixes []*net.IPNet
+	// DestinationPrefixes are the networks matched against the destination address
+	DestinationPrefixes []*net.IPNet
+	// SourcePorts is the source port range, nil matches any port
+	SourcePorts *PortRange
+	// DestinationPorts is the destination port range, nil matches any port
+	DestinationPorts *PortRange
+}
+
+// NewRule converts a stored Network Security Group rule into its evaluation representation.
+// Source and destination address group references are resolved using addressGroups,
+// which is keyed by address group ID.
+func NewRule(index int, rule *cdbm.NetworkSecurityGroupRule, addressGroups map[string]*cdbm.AddressGroup) (*Rule, error) {
+	if rule == nil || rule.NetworkSecurityGroupRuleAttributes == nil {
+		return nil, fmt.Errorf("%w: rule %d has no attributes", ErrInvalidRule, index)
+	}
+
+	r := &Rule{
+		Index:     index,
+		Name:      rule.Id,
+		Direction: rule.Direction,
+		Protocol:  rule.Protocol,
+		Action:    rule.Action,
+		Priority:  rule.Priority,
+	}
+
+	var err error
+
+	r.SourcePorts, err = newPortRange(rule.SrcPortStart, rule.SrcPortEnd)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %d source ports: %v", ErrInvalidRule, index, err)
+	}
+
+	r.DestinationPorts, err = newPortRange(rule.DstPortStart, rule.DstPortEnd)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %d destination ports: %v", ErrInvalidRule, index, err)
+	}
+
+	srcPrefixes, err := SourcePrefixes(rule, addressGroups)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %d %v", ErrInvalidRule, index, err)
+	}
+
+	r.SourcePrefixes, err = parsePrefixes(srcPrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %d source %v", ErrInvalidRule, index, err)
+	}
+
+	dstPrefixes, err := DestinationPrefixes(rule, addressGroups)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %d %v", ErrInvalidRule, index, err)
+	}
+
+	r.DestinationPrefixes, err = parsePrefixes(dstPrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rule %d destination %v", ErrInvalidRule, index, err)
+	}
+
+	return r, nil
+}
+
+// NewRules converts all stored rules of a Network Security Group into their evaluation representation
+func NewRules(rules []*cdbm.NetworkSecurityGroupRule, addressGroups map[string]*cdbm.AddressGroup) ([]*Rule, error) {
+	converted := make([]*Rule, 0, len(rules))
+	for i, rule := range rules {
+		r, err := NewRule(i, rule, addressGroups)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, r)
+	}
+	return converted, nil
+}
+
+// DisplayName returns the rule name if set, otherwise a positional identifier
+func (r *Rule) DisplayName() string {
+	if r.Name != nil && *r.Name != "" {
+		return *r.Name
+	}
+	return fmt.Sprintf("#%d", r.Index)
+}
+
+// precedes returns true if r is evaluated before other.
+// Lower priority values are evaluated first. For equal priorities,
+// DENY rules are evaluated ahead of PERMIT rules, and remaining ties
+// are broken by the position of the rule in the Network Security Group.
+func (r *Rule) precedes(other *Rule) bool {
+	if r.Priority != other.Priority {
+		return r.Priority < other.Priority
+	}
+	if r.Action != other.Action {
+		return r.Action == cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY
+	}
+	return r.Index < other.Index
+}
+
+// SortRules returns a copy of the rules ordered by evaluation precedence
+func SortRules(rules []*Rule) []*Rule {
+	sorted := make([]*Rule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].precedes(sorted[j])
+	})
+	return sorted
+}
+
+// protocolCovers returns true if every packet matched by protocol b is also matched by protocol a
+func protocolCovers(a, b cwssaws.NetworkSecurityGroupRuleProtocol) bool {
+	return a == cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_ANY || a == b
+}
+
+// protocolOverlaps returns true if protocols a and b can match the same packet
+func protocolOverlaps(a, b cwssaws.NetworkSecurityGroupRuleProtocol) bool {
+	return protocolCovers(a, b) || protocolCovers(b, a)
+}
+
+// portsCover returns true if range a includes every port of range b, nil meaning any port
+func portsCover(a, b *PortRange) bool {
+	if a == nil {
+		return true
+	}
+	if b == nil {
+		return a.Start == 0 && a.End >= 65535
+	}
+	return a.Covers(*b)
+}
+
+// portsOverlap returns true if ranges a and b share at least one port, nil meaning any port
+func portsOverlap(a, b *PortRange) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	return a.Overlaps(*b)
+}
+
+// prefixCovers returns true if prefix a contains all of prefix b
+func prefixCovers(a, b *net.IPNet) bool {
+	aOnes, aBits := a.Mask.Size()
+	bOnes, bBits := b.Mask.Size()
+	if aBits != bBits {
+		return false
+	}
+	return aOnes <= bOnes && a.Contains(b.IP)
+}
+
+// prefixesCover returns true if every prefix in b is contained in at least one prefix in a
+func prefixesCover(a, b []*net.IPNet) bool {
+	for _, bp := range b {
+		covered := false
+		for _, ap := range a {
+			if prefixCovers(ap, bp) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// prefixesOverlap returns true if any prefix in a overlaps with any prefix in b
+func prefixesOverlap(a, b []*net.IPNet) bool {
+	for _, ap := range a {
+		for _, bp := range b {
+			if prefixCovers(ap, bp) || prefixCovers(bp, ap) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// prefixesContain returns true if the address falls within any of the prefixes
+func prefixesContain(prefixes []*net.IPNet, ip net.IP) bool {
+	for _, p := range prefixes {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers returns true if every flow matched by other is also matched by r
+func (r *Rule) Covers(other *Rule) bool {
+	return r.Direction == other.Direction &&
+		protocolCovers(r.Protocol, other.Protocol) &&
+		prefixesCover(r.SourcePrefixes, other.SourcePrefixes) &&
+		prefixesCover(r.DestinationPrefixes, other.DestinationPrefixes) &&
+		portsCover(r.SourcePorts, other.SourcePorts) &&
+		portsCover(r.DestinationPorts, other.DestinationPorts)
+}
+
+// Overlaps returns true if at least one flow is matched by both r and other
+func (r *Rule) Overlaps(other *Rule) bool {
+	return r.Direction == other.Direction &&
+		protocolOverlaps(r.Protocol, other.Protocol) &&
+		prefixesOverlap(r.SourcePrefixes, other.SourcePrefixes) &&
+		prefixesOverlap(r.DestinationPrefixes, other.DestinationPrefixes) &&
+		portsOverlap(r.SourcePorts, other.SourcePorts) &&
+		portsOverlap(r.DestinationPorts, other.DestinationPorts)
+}
+
+func parsePrefixes(prefixes []string) ([]*net.IPNet, error) {
+	parsed := make([]*net.IPNet, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("prefix `%s` is not valid", prefix)
+		}
+		parsed = append(parsed, ipNet)
+	}
+	return parsed, nil
+}
+
+func newPortRange(start, end *uint32) (*PortRange, error) {
+	if start == nil && end == nil {
+		return nil, nil
+	}
+	if start == nil || end == nil {
+		return nil, errors.New("half-defined port range")
+	}
+	if *start > *end {
+		return nil, fmt.Errorf("start port %d is greater than end port %d", *start, *end)
+	}
+	return &PortRange{Start: *start, End: *end}, nil
+}
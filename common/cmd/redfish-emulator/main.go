@@ -0,0 +1,129 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+)
+
+// faultFlags collects repeated -fault flags.
+type faultFlags []emulator.Fault
+
+func (f *faultFlags) String() string {
+	return fmt.Sprintf("%d faults", len(*f))
+}
+
+func (f *faultFlags) Set(s string) error {
+	fault, err := emulator.ParseFault(s)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, fault)
+	return nil
+}
+
+// Serve an emulated PMC or NV-Switch BMC over HTTPS
+func main() {
+	var faults faultFlags
+
+	addr := flag.String("addr", ":8443", "HTTPS listen address")
+	profileName := flag.String("profile", emulator.LiteonPowerShelf.Name, "device profile: "+strings.Join(emulator.ProfileNames(), ", "))
+	username := flag.String("username", emulator.DefaultUsername, "Redfish username")
+	password := flag.String("password", emulator.DefaultPassword, "Redfish password")
+	firmwareVersion := flag.String("firmware-version", "", "initial manager firmware version (default from profile)")
+	updatedVersion := flag.String("updated-firmware-version", "", "firmware version reported after an update (default increments the current version)")
+	taskDuration := flag.Duration("task-duration", 30*time.Second, "duration of firmware update tasks")
+	failTasks := flag.Bool("fail-tasks", false, "fail firmware update tasks")
+	resetDowntime := flag.Duration("reset-downtime", 10*time.Second, "time the service is unavailable after a manager reset")
+	rack := flag.String("rack", "", "rack reported in the chassis location")
+	serial := flag.String("serial", "", "chassis serial number (default from profile)")
+	mac := flag.String("mac", "", "MAC address reported by the manager's EthernetInterface (omitted if empty)")
+	hostMAC := flag.String("host-mac", "", "MAC address reported by the system's EthernetInterface, e.g. the NVOS port (omitted if empty)")
+	hostIP := flag.String("host-ip", "", "IPv4 address reported by the system's EthernetInterface")
+	psuFaultInterval := flag.Duration("psu-fault-interval", 0, "raise and clear a fault on PSU 0 at this interval to exercise event consumers (0 disables)")
+	certFile := flag.String("tls-cert", "", "TLS certificate file (default self-signed)")
+	keyFile := flag.String("tls-key", "", "TLS key file (default self-signed)")
+	flag.Var(&faults, "fault", "inject a fault as METHOD:PATH:STATUS[:DELAY], may be repeated")
+	flag.Parse()
+
+	profile, err := emulator.ProfileByName(*profileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *firmwareVersion != "" {
+		profile.FirmwareVersion = *firmwareVersion
+	}
+	if *serial != "" {
+		profile.SerialNumber = *serial
+	}
+
+	emu := emulator.New(emulator.Config{
+		Profile:                profile,
+		Username:               *username,
+		Password:               *password,
+		TaskDuration:           *taskDuration,
+		UpdatedFirmwareVersion: *updatedVersion,
+		FailTasks:              *failTasks,
+		ResetDowntime:          *resetDowntime,
+		Rack:                   *rack,
+		MACAddress:             *mac,
+		HostMACAddress:         *hostMAC,
+		HostIPAddress:          *hostIP,
+	})
+	for _, f := range faults {
+		emu.InjectFault(f)
+	}
+
+	if *psuFaultInterval > 0 {
+		go func() {
+			faulted := false
+			for range time.Tick(*psuFaultInterval) {
+				faulted = !faulted
+				emu.SetPSUFault(0, faulted)
+			}
+		}()
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           emu,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if *certFile == "" || *keyFile == "" {
+		cert, err := emulator.SelfSignedCertificate()
+		if err != nil {
+			log.Fatalf("failed to generate TLS certificate: %v", err)
+		}
+		server.TLSConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	log.Infof("Started Redfish emulator (profile %s, firmware %s) on %s", profile.Name, profile.FirmwareVersion, *addr)
+	if err := server.ListenAndServeTLS(*certFile, *keyFile); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credential
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	testCases := map[string]struct {
+		length  int
+		wantErr bool
+	}{
+		"default length": {
+			length: DefaultPasswordLength,
+		},
+		"minimum length": {
+			length: MinPasswordLength,
+		},
+		"too short": {
+			length:  MinPasswordLength - 1,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			password, err := GeneratePassword(tc.length)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Len(t, password, tc.length)
+			assert.True(t, strings.ContainsAny(password, lowerChars))
+			assert.True(t, strings.ContainsAny(password, upperChars))
+			assert.True(t, strings.ContainsAny(password, digitChars))
+			assert.True(t, strings.ContainsAny(password, symbolChars))
+			for _, c := range password {
+				assert.True(t, strings.ContainsRune(lowerChars+upperChars+digitChars+symbolChars, c))
+			}
+		})
+	}
+}
+
+func TestGeneratePassword_Unique(t *testing.T) {
+	a, err := GeneratePassword(DefaultPasswordLength)
+	require.NoError(t, err)
+	b, err := GeneratePassword(DefaultPasswordLength)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, a, b)
+}
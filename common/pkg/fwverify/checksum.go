@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fwverify verifies firmware artifacts before they are flashed: artifact checksums with a declared
+// algorithm, and detached signatures of the manifests that declare them, checked against a trust bundle of
+// public keys (cosign/minisign-style key pairs) and x509 CA certificates.
+package fwverify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when an artifact does not match its declared checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// hashes maps the supported checksum algorithms to their constructors.
+var hashes = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// Checksum is an artifact digest together with the algorithm that produced it.
+type Checksum struct {
+	Algorithm string
+	Digest    []byte
+}
+
+// ParseChecksum parses a checksum of the form "<algorithm>:<hex digest>", e.g. "sha256:9f86d0...".
+// The algorithm must be one of sha256, sha384 or sha512.
+func ParseChecksum(s string) (Checksum, error) {
+	alg, digest, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok || alg == "" {
+		return Checksum{}, fmt.Errorf("checksum %q must be of the form <algorithm>:<hex digest>", s)
+	}
+
+	alg = strings.ToLower(alg)
+	newHash, ok := hashes[alg]
+	if !ok {
+		return Checksum{}, fmt.Errorf("unsupported checksum algorithm %q (supported: sha256, sha384, sha512)", alg)
+	}
+
+	sum, err := hex.DecodeString(digest)
+	if err != nil {
+		return Checksum{}, fmt.Errorf("checksum %q has an invalid hex digest: %w", s, err)
+	}
+	if len(sum) != newHash().Size() {
+		return Checksum{}, fmt.Errorf("checksum %q has a %d byte digest, %s digests are %d bytes", s, len(sum), alg, newHash().Size())
+	}
+
+	return Checksum{Algorithm: alg, Digest: sum}, nil
+}
+
+// String returns the checksum in "<algorithm>:<hex digest>" form.
+func (c Checksum) String() string {
+	return c.Algorithm + ":" + hex.EncodeToString(c.Digest)
+}
+
+// Verify reads r to the end and returns ErrChecksumMismatch if its digest differs from c.
+func (c Checksum) Verify(r io.Reader) error {
+	newHash, ok := hashes[c.Algorithm]
+	if !ok {
+		return fmt.Errorf("unsupported checksum algorithm %q", c.Algorithm)
+	}
+
+	h := newHash()
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+	if sum := h.Sum(nil); !bytes.Equal(sum, c.Digest) {
+		return fmt.Errorf("%w: expected %s, got %s:%s", ErrChecksumMismatch, c, c.Algorithm, hex.EncodeToString(sum))
+	}
+	return nil
+}
+
+// VerifyFile checks the file name in fsys against the checksum string s.
+func VerifyFile(fsys fs.FS, name, s string) error {
+	c, err := ParseChecksum(s)
+	if err != nil {
+		return err
+	}
+
+	f, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := c.Verify(f); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
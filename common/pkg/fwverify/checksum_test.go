@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fwverify
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChecksum(t *testing.T) {
+	testCases := map[string]struct {
+		in      string
+		wantErr string
+	}{
+		"sha256":            {in: "sha256:" + strings.Repeat("ab", 32)},
+		"sha384":            {in: "SHA384:" + strings.Repeat("ab", 48)},
+		"sha512":            {in: "sha512:" + strings.Repeat("ab", 64)},
+		"missing algorithm": {in: strings.Repeat("ab", 32), wantErr: "must be of the form"},
+		"unsupported":       {in: "md5:" + strings.Repeat("ab", 16), wantErr: "unsupported checksum algorithm"},
+		"invalid hex":       {in: "sha256:xyz", wantErr: "invalid hex digest"},
+		"wrong length":      {in: "sha256:abcd", wantErr: "2 byte digest"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := ParseChecksum(tc.in)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, strings.ToLower(tc.in), c.String())
+		})
+	}
+}
+
+func TestVerifyFile(t *testing.T) {
+	fsys := fstest.MapFS{"fw.bin": {Data: []byte("firmware")}}
+	sum := checksumOf(t, []byte("firmware"))
+
+	require.NoError(t, VerifyFile(fsys, "fw.bin", sum))
+
+	err := VerifyFile(fsys, "fw.bin", "sha256:"+strings.Repeat("00", 32))
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.Contains(t, err.Error(), sum)
+
+	require.Error(t, VerifyFile(fsys, "missing.bin", sum))
+}
@@ -0,0 +1,222 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fwverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrUntrusted is returned when a signature does not verify against any key or CA of the trust bundle.
+var ErrUntrusted = errors.New("signature not trusted")
+
+// trustedKey is a public key of the trust bundle with its fingerprint.
+type trustedKey struct {
+	key         crypto.PublicKey
+	fingerprint string
+}
+
+// TrustBundle holds the public keys and CA certificates firmware manifest signatures are verified against.
+type TrustBundle struct {
+	keys  []trustedKey
+	roots *x509.CertPool
+}
+
+// LoadTrustBundle reads a PEM trust bundle from path. See ParseTrustBundle.
+func LoadTrustBundle(path string) (*TrustBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust bundle: %w", err)
+	}
+
+	bundle, err := ParseTrustBundle(data)
+	if err != nil {
+		return nil, fmt.Errorf("trust bundle %s: %w", path, err)
+	}
+	return bundle, nil
+}
+
+// ParseTrustBundle parses a PEM trust bundle. "PUBLIC KEY" blocks hold Ed25519, ECDSA or RSA signing keys that
+// verify signatures directly; "CERTIFICATE" blocks hold the CAs that signer certificates must chain to.
+func ParseTrustBundle(data []byte) (*TrustBundle, error) {
+	bundle := &TrustBundle{roots: x509.NewCertPool()}
+	certs := 0
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid public key: %w", err)
+			}
+			bundle.keys = append(bundle.keys, trustedKey{key: key, fingerprint: fingerprint(block.Bytes)})
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate: %w", err)
+			}
+			bundle.roots.AddCert(cert)
+			certs++
+		default:
+			return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+		}
+	}
+
+	if len(bundle.keys) == 0 && certs == 0 {
+		return nil, errors.New("no public keys or certificates found")
+	}
+	return bundle, nil
+}
+
+// Verify verifies the detached signature sig over data and returns the identity of the signer. sig may be raw
+// or base64 encoded, as written by cosign sign-blob. With a PEM signer certificate chain in chain, the leaf must
+// chain to a CA of the bundle, be valid for code signing and verify the signature, and the identity is its
+// subject ("x509:<subject>"). Without one, the signature must verify against a public key of the bundle and the
+// identity is the key fingerprint ("key:SHA256:<fingerprint>").
+func (b *TrustBundle) Verify(data, sig, chain []byte) (string, error) {
+	sig = decodeSignature(sig)
+
+	if len(chain) > 0 {
+		return b.verifyCertificate(data, sig, chain)
+	}
+
+	for _, k := range b.keys {
+		if verifySignature(k.key, data, sig) {
+			return "key:" + k.fingerprint, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no trusted public key verifies the signature", ErrUntrusted)
+}
+
+// verifyCertificate verifies sig with the leaf of the PEM certificate chain after validating the chain.
+func (b *TrustBundle) verifyCertificate(data, sig, chain []byte) (string, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, chain = pem.Decode(chain)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return "", fmt.Errorf("invalid signer certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return "", errors.New("signer certificate chain contains no certificates")
+	}
+
+	leaf := certs[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         b.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return "", fmt.Errorf("%w: signer certificate %q: %v", ErrUntrusted, leaf.Subject, err)
+	}
+
+	if !verifySignature(leaf.PublicKey, data, sig) {
+		return "", fmt.Errorf("%w: signature does not match signer certificate %q", ErrUntrusted, leaf.Subject)
+	}
+	return "x509:" + certIdentity(leaf), nil
+}
+
+// verifySignature reports whether sig is a valid signature of data by key. ECDSA signatures are ASN.1 encoded
+// over the digest matching the curve size; RSA signatures use SHA-256 with PKCS #1 v1.5 or PSS padding.
+func verifySignature(key crypto.PublicKey, data, sig []byte) bool {
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, data, sig)
+	case *ecdsa.PublicKey:
+		var digest []byte
+		switch k.Curve {
+		case elliptic.P384():
+			sum := sha512.Sum384(data)
+			digest = sum[:]
+		case elliptic.P521():
+			sum := sha512.Sum512(data)
+			digest = sum[:]
+		default:
+			sum := sha256.Sum256(data)
+			digest = sum[:]
+		}
+		return ecdsa.VerifyASN1(k, digest, sig)
+	case *rsa.PublicKey:
+		sum := sha256.Sum256(data)
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], sig) == nil ||
+			rsa.VerifyPSS(k, crypto.SHA256, sum[:], sig, nil) == nil
+	default:
+		return false
+	}
+}
+
+// decodeSignature returns the base64-decoded signature, or sig itself if it is not base64.
+func decodeSignature(sig []byte) []byte {
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig))); err == nil {
+		return decoded
+	}
+	return sig
+}
+
+// fingerprint returns the SHA-256 fingerprint of a DER encoded public key in OpenSSH notation.
+func fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// certIdentity names the signer of a certificate: its subject, or its first email or URI SAN if the subject
+// is empty (as for keyless signing certificates).
+func certIdentity(cert *x509.Certificate) string {
+	if subject := cert.Subject.String(); subject != "" {
+		return subject
+	}
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return fingerprint(cert.RawSubjectPublicKeyInfo)
+}
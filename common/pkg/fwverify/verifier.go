@@ -0,0 +1,105 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fwverify
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+const (
+	// SignatureSuffix is appended to a manifest name to find its detached signature.
+	SignatureSuffix = ".sig"
+	// CertificateSuffix is appended to a manifest name to find the optional PEM signer certificate chain.
+	CertificateSuffix = ".crt"
+
+	// Unsigned is the identity recorded for manifests accepted without a verified signature.
+	Unsigned = "unsigned"
+)
+
+// ErrUnsigned is returned for a manifest without a signature when unsigned firmware is not allowed.
+var ErrUnsigned = errors.New("manifest is not signed")
+
+// Config configures manifest signature verification.
+type Config struct {
+	// TrustBundle is the path of the PEM trust bundle; see ParseTrustBundle.
+	TrustBundle string
+
+	// AllowUnsigned accepts manifests without a signature, and all manifests when no trust bundle is configured.
+	// Signatures that are present are still verified against the trust bundle, if any.
+	AllowUnsigned bool
+}
+
+// Verifier verifies firmware manifest signatures according to its Config.
+type Verifier struct {
+	bundle        *TrustBundle
+	allowUnsigned bool
+}
+
+// New loads the trust bundle of conf. Without a trust bundle, every manifest is rejected unless
+// conf.AllowUnsigned is set.
+func New(conf Config) (*Verifier, error) {
+	v := &Verifier{allowUnsigned: conf.AllowUnsigned}
+
+	if conf.TrustBundle == "" {
+		return v, nil
+	}
+
+	bundle, err := LoadTrustBundle(conf.TrustBundle)
+	if err != nil {
+		return nil, err
+	}
+	v.bundle = bundle
+	return v, nil
+}
+
+// VerifyManifest verifies the detached signature of the manifest name in fsys, whose content is data, and returns
+// the identity of its signer. The signature is read from name+SignatureSuffix and the optional signer certificate
+// chain from name+CertificateSuffix. Manifests accepted without verification have the identity Unsigned.
+func (v *Verifier) VerifyManifest(fsys fs.FS, name string, data []byte) (string, error) {
+	sig, err := fs.ReadFile(fsys, name+SignatureSuffix)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("failed to read signature of %s: %w", name, err)
+	}
+
+	if v.bundle == nil {
+		if v.allowUnsigned {
+			return Unsigned, nil
+		}
+		return "", fmt.Errorf("cannot verify %s: no firmware trust bundle configured", name)
+	}
+
+	if sig == nil {
+		if v.allowUnsigned {
+			return Unsigned, nil
+		}
+		return "", fmt.Errorf("%w: %s has no %s signature", ErrUnsigned, name, SignatureSuffix)
+	}
+
+	chain, err := fs.ReadFile(fsys, name+CertificateSuffix)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("failed to read signer certificate of %s: %w", name, err)
+	}
+
+	identity, err := v.bundle.Verify(data, sig, chain)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return identity, nil
+}
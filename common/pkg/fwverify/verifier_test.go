@@ -0,0 +1,258 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fwverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var manifest = []byte("version: 1.0.0\n")
+
+func checksumOf(t *testing.T, data []byte) string {
+	t.Helper()
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func publicKeyPEM(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func signECDSA(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	t.Helper()
+	sum := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	require.NoError(t, err)
+	return []byte(base64.StdEncoding.EncodeToString(sig))
+}
+
+// newCertificate issues a certificate for key signed by parent (self-signed if parent is nil).
+func newCertificate(t *testing.T, cn string, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, ca bool) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if ca {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func certPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func TestTrustBundleVerify(t *testing.T) {
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := newCertificate(t, "Firmware CA", caKey, nil, nil, true)
+	signer := newCertificate(t, "Firmware Release", ecKey, ca, caKey, false)
+	rogueCA := newCertificate(t, "Rogue CA", otherKey, nil, nil, true)
+	rogue := newCertificate(t, "Rogue Release", ecKey, rogueCA, otherKey, false)
+
+	bundlePEM := append(publicKeyPEM(t, edPub), certPEM(ca)...)
+	bundle, err := ParseTrustBundle(bundlePEM)
+	require.NoError(t, err)
+
+	edDER, err := x509.MarshalPKIXPublicKey(edPub)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		data         []byte
+		sig          []byte
+		chain        []byte
+		wantIdentity string
+		wantErr      error
+	}{
+		"raw ed25519 signature": {
+			data:         manifest,
+			sig:          ed25519.Sign(edKey, manifest),
+			wantIdentity: "key:" + fingerprint(edDER),
+		},
+		"base64 ed25519 signature": {
+			data:         manifest,
+			sig:          []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(edKey, manifest)) + "\n"),
+			wantIdentity: "key:" + fingerprint(edDER),
+		},
+		"tampered data": {
+			data:    []byte("version: 6.6.6\n"),
+			sig:     ed25519.Sign(edKey, manifest),
+			wantErr: ErrUntrusted,
+		},
+		"untrusted key": {
+			data:    manifest,
+			sig:     signECDSA(t, otherKey, manifest),
+			wantErr: ErrUntrusted,
+		},
+		"certificate chained to trusted CA": {
+			data:         manifest,
+			sig:          signECDSA(t, ecKey, manifest),
+			chain:        certPEM(signer),
+			wantIdentity: "x509:CN=Firmware Release",
+		},
+		"certificate chained to untrusted CA": {
+			data:    manifest,
+			sig:     signECDSA(t, ecKey, manifest),
+			chain:   certPEM(rogue),
+			wantErr: ErrUntrusted,
+		},
+		"signature by other key than certificate": {
+			data:    manifest,
+			sig:     signECDSA(t, otherKey, manifest),
+			chain:   certPEM(signer),
+			wantErr: ErrUntrusted,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			identity, err := bundle.Verify(tc.data, tc.sig, tc.chain)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIdentity, identity)
+		})
+	}
+}
+
+func TestParseTrustBundle(t *testing.T) {
+	_, err := ParseTrustBundle([]byte("not pem"))
+	require.ErrorContains(t, err, "no public keys or certificates")
+
+	_, err = ParseTrustBundle(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1}}))
+	require.ErrorContains(t, err, `unexpected PEM block "PRIVATE KEY"`)
+}
+
+func TestVerifyManifest(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	bundlePath := filepath.Join(t.TempDir(), "trust.pem")
+	require.NoError(t, os.WriteFile(bundlePath, publicKeyPEM(t, pub), 0o600))
+
+	signed := fstest.MapFS{
+		"bundle.yaml":     {Data: manifest},
+		"bundle.yaml.sig": {Data: ed25519.Sign(key, manifest)},
+	}
+	unsigned := fstest.MapFS{"bundle.yaml": {Data: manifest}}
+
+	testCases := map[string]struct {
+		conf         Config
+		fsys         fstest.MapFS
+		wantIdentity string
+		wantErr      string
+	}{
+		"signed": {
+			conf: Config{TrustBundle: bundlePath},
+			fsys: signed,
+		},
+		"unsigned rejected": {
+			conf:    Config{TrustBundle: bundlePath},
+			fsys:    unsigned,
+			wantErr: "bundle.yaml has no .sig signature",
+		},
+		"unsigned allowed": {
+			conf:         Config{TrustBundle: bundlePath, AllowUnsigned: true},
+			fsys:         unsigned,
+			wantIdentity: Unsigned,
+		},
+		"no trust bundle": {
+			fsys:    signed,
+			wantErr: "no firmware trust bundle configured",
+		},
+		"no trust bundle, unsigned allowed": {
+			conf:         Config{AllowUnsigned: true},
+			fsys:         signed,
+			wantIdentity: Unsigned,
+		},
+		"bad signature rejected even if unsigned allowed": {
+			conf: Config{TrustBundle: bundlePath, AllowUnsigned: true},
+			fsys: fstest.MapFS{
+				"bundle.yaml":     {Data: manifest},
+				"bundle.yaml.sig": {Data: ed25519.Sign(key, []byte("other"))},
+			},
+			wantErr: ErrUntrusted.Error(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			v, err := New(tc.conf)
+			require.NoError(t, err)
+
+			identity, err := v.VerifyManifest(tc.fsys, "bundle.yaml", manifest)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantIdentity != "" {
+				assert.Equal(t, tc.wantIdentity, identity)
+			} else {
+				assert.Contains(t, identity, "key:SHA256:")
+			}
+		})
+	}
+}
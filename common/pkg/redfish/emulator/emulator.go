@@ -0,0 +1,1508 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package emulator serves an in-memory Redfish service that mimics power shelf PMCs and NV-Switch tray BMCs
+// closely enough to exercise the powershelf-manager and nvswitch-manager Redfish clients without hardware.
+package emulator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultUsername is the account accepted when Config.Username is empty.
+	DefaultUsername = "root"
+	// DefaultPassword is the password accepted when Config.Password is empty.
+	DefaultPassword = "0penBmc"
+
+	// TaskStateRunning is reported while a firmware update task is in progress.
+	TaskStateRunning = "Running"
+	// TaskStateCompleted is reported once a firmware update task has succeeded.
+	TaskStateCompleted = "Completed"
+	// TaskStateException is reported once a firmware update task has failed.
+	TaskStateException = "Exception"
+
+	// ApplyTimeImmediate applies an uploaded image as soon as its task completes.
+	ApplyTimeImmediate = "Immediate"
+	// ApplyTimeOnReset stages an uploaded image until the next Manager.Reset.
+	ApplyTimeOnReset = "OnReset"
+
+	serviceRootURI    = "/redfish/v1/"
+	sessionsURI       = "/redfish/v1/SessionService/Sessions"
+	accountServiceURI = "/redfish/v1/AccountService"
+	accountsURI       = "/redfish/v1/AccountService/Accounts"
+	chassisURI        = "/redfish/v1/Chassis"
+	managersURI       = "/redfish/v1/Managers"
+	systemsURI        = "/redfish/v1/Systems"
+	updateServiceURI  = "/redfish/v1/UpdateService"
+	firmwareInvURI    = "/redfish/v1/UpdateService/FirmwareInventory"
+	taskServiceURI    = "/redfish/v1/TaskService"
+	tasksURI          = "/redfish/v1/TaskService/Tasks"
+	maxFirmwareUpload = 1 << 30
+
+	// accountID is the ID of the single emulated manager account.
+	accountID = "1"
+	// ethernetInterfaceID is the ID of the single EthernetInterface of the manager and the system.
+	ethernetInterfaceID = "eth0"
+	// minPasswordLength is the AccountService MinPasswordLength enforced on password changes.
+	minPasswordLength = 8
+)
+
+var (
+	chassisResetTypes = map[string]string{
+		"Chassis.ForceOff": "Off",
+		"Chassis.On":       "On",
+		"Chassis.Reset":    "On",
+	}
+	systemResetTypes = map[string]string{
+		"On":               "On",
+		"ForceOn":          "On",
+		"ForceOff":         "Off",
+		"GracefulShutdown": "Off",
+		"PowerCycle":       "On",
+		"GracefulRestart":  "On",
+		"ForceRestart":     "On",
+	}
+	managerResetTypes    = []string{"GracefulRestart", "ForceRestart"}
+	managerDefaultsTypes = []string{"ResetAll", "PreserveNetworkAndUsers", "PreserveNetwork"}
+	applyTimes           = []string{ApplyTimeImmediate, ApplyTimeOnReset, "AtMaintenanceWindowStart", "InMaintenanceWindowOnReset"}
+
+	lastNumber = regexp.MustCompile(`(\d+)(\D*)$`)
+)
+
+// Config specifies the device emulated by an Emulator.
+type Config struct {
+	// Profile selects the vendor specific resources and values.
+	Profile Profile
+	// Username and Password are the credentials accepted via session login or basic auth. The password
+	// can be changed through the AccountService.
+	Username string
+	Password string
+	// TaskDuration is how long a firmware update task runs before completing. Zero completes on the first poll.
+	TaskDuration time.Duration
+	// UpdatedFirmwareVersion is the Manager.FirmwareVersion reported after a successful update. If empty,
+	// the last numeric component of the current version is incremented (e.g. r1.3.7 becomes r1.3.8).
+	UpdatedFirmwareVersion string
+	// FailTasks makes firmware update tasks end in the Exception state half way through.
+	FailTasks bool
+	// ResetDowntime is how long the service answers 503 after a Manager.Reset or Manager.ResetToDefaults.
+	ResetDowntime time.Duration
+	// Rack is reported as the chassis Location.Placement.Rack. Omitted if empty.
+	Rack string
+	// MACAddress is the MAC of the manager's EthernetInterface. The manager has no EthernetInterfaces if empty.
+	MACAddress string
+	// HostMACAddress and HostIPAddress are reported by the EthernetInterface of the computer system, e.g. the
+	// NVOS management port of an NV-Switch tray. The system has no EthernetInterfaces if HostMACAddress is empty.
+	HostMACAddress string
+	HostIPAddress  string
+}
+
+// task is a firmware update task tracked by the TaskService.
+type task struct {
+	id      string
+	started time.Time
+	ended   time.Time
+	state   string
+	percent int
+	fail    bool
+}
+
+// Emulator is an http.Handler serving the Redfish resources of a single emulated device.
+type Emulator struct {
+	cfg Config
+	mux *http.ServeMux
+	now func() time.Time
+
+	mu               sync.Mutex
+	password         string
+	passwordChanges  int
+	powerState       string
+	firmwareVersion  string
+	pendingVersion   string
+	applyTime        string
+	sessions         map[string]string
+	nextSessionID    int
+	tasks            map[string]*task
+	nextTaskID       int
+	faults           []*Fault
+	managerResets    int
+	unavailableUntil time.Time
+	powerLimitWatts  float64
+	psuFaults        map[int]bool
+
+	subscriptions      map[string]*subscription
+	nextSubscriptionID int
+	sseClients         map[chan []byte]struct{}
+	nextEventID        int
+	outbox             []delivery
+	delivering         bool
+}
+
+// New creates an Emulator for the given configuration.
+func New(cfg Config) *Emulator {
+	if cfg.Username == "" {
+		cfg.Username = DefaultUsername
+	}
+	if cfg.Password == "" {
+		cfg.Password = DefaultPassword
+	}
+
+	e := &Emulator{
+		cfg:             cfg,
+		mux:             http.NewServeMux(),
+		now:             time.Now,
+		password:        cfg.Password,
+		powerState:      "On",
+		firmwareVersion: cfg.Profile.FirmwareVersion,
+		applyTime:       ApplyTimeOnReset,
+		sessions:        map[string]string{},
+		tasks:           map[string]*task{},
+		psuFaults:       map[int]bool{},
+		subscriptions:   map[string]*subscription{},
+		sseClients:      map[chan []byte]struct{}{},
+	}
+
+	e.mux.HandleFunc("GET /redfish", e.handleVersions)
+	e.mux.HandleFunc("GET /redfish/v1", e.handleServiceRoot)
+	e.mux.HandleFunc("GET /redfish/v1/{$}", e.handleServiceRoot)
+
+	e.mux.HandleFunc("GET /redfish/v1/SessionService", e.handleSessionService)
+	e.mux.HandleFunc("GET "+sessionsURI, e.handleSessions)
+	e.mux.HandleFunc("POST "+sessionsURI, e.handleCreateSession)
+	e.mux.HandleFunc("GET "+sessionsURI+"/{id}", e.handleSession)
+	e.mux.HandleFunc("DELETE "+sessionsURI+"/{id}", e.handleDeleteSession)
+
+	e.mux.HandleFunc("GET "+accountServiceURI, e.handleAccountService)
+	e.mux.HandleFunc("GET "+accountsURI, e.handleAccounts)
+	e.mux.HandleFunc("GET "+accountsURI+"/{id}", e.handleAccount)
+	e.mux.HandleFunc("PATCH "+accountsURI+"/{id}", e.handlePatchAccount)
+
+	e.mux.HandleFunc("GET "+chassisURI, e.handleChassisCollection)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}", e.handleChassis)
+	e.mux.HandleFunc("POST "+chassisURI+"/{id}/Actions/{action}", e.handleChassisAction)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}/Power", e.handlePower)
+	e.mux.HandleFunc("PATCH "+chassisURI+"/{id}/Power", e.handlePatchPower)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}/PowerSubsystem", e.handlePowerSubsystem)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}/PowerSubsystem/PowerSupplies", e.handlePowerSupplies)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}/PowerSubsystem/PowerSupplies/{psu}", e.handlePowerSupply)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}/Sensors", e.handleSensors)
+	e.mux.HandleFunc("GET "+chassisURI+"/{id}/Sensors/{sensor}", e.handleSensor)
+
+	e.mux.HandleFunc("GET "+managersURI, e.handleManagers)
+	e.mux.HandleFunc("GET "+managersURI+"/{id}", e.handleManager)
+	e.mux.HandleFunc("POST "+managersURI+"/{id}/Actions/{action}", e.handleManagerAction)
+	e.mux.HandleFunc("GET "+managersURI+"/{id}/EthernetInterfaces", e.handleManagerInterfaces)
+	e.mux.HandleFunc("GET "+managersURI+"/{id}/EthernetInterfaces/{iface}", e.handleManagerInterface)
+
+	e.mux.HandleFunc("GET "+systemsURI, e.handleSystems)
+	e.mux.HandleFunc("GET "+systemsURI+"/{id}", e.handleSystem)
+	e.mux.HandleFunc("POST "+systemsURI+"/{id}/Actions/{action}", e.handleSystemAction)
+	e.mux.HandleFunc("GET "+systemsURI+"/{id}/EthernetInterfaces", e.handleSystemInterfaces)
+	e.mux.HandleFunc("GET "+systemsURI+"/{id}/EthernetInterfaces/{iface}", e.handleSystemInterface)
+
+	e.mux.HandleFunc("GET "+updateServiceURI, e.handleUpdateService)
+	e.mux.HandleFunc("PATCH "+updateServiceURI, e.handlePatchUpdateService)
+	e.mux.HandleFunc("POST "+updateServiceURI, e.handleFirmwareUpload)
+	e.mux.HandleFunc("GET "+firmwareInvURI, e.handleFirmwareInventories)
+	e.mux.HandleFunc("GET "+firmwareInvURI+"/{id}", e.handleFirmwareInventory)
+
+	e.mux.HandleFunc("GET "+taskServiceURI, e.handleTaskService)
+	e.mux.HandleFunc("GET "+tasksURI, e.handleTasks)
+	e.mux.HandleFunc("GET "+tasksURI+"/{id}", e.handleTask)
+
+	e.mux.HandleFunc("GET "+eventServiceURI, e.handleEventService)
+	e.mux.HandleFunc("GET "+subscriptionsURI, e.handleSubscriptions)
+	e.mux.HandleFunc("POST "+subscriptionsURI, e.handleCreateSubscription)
+	e.mux.HandleFunc("GET "+subscriptionsURI+"/{id}", e.handleSubscription)
+	e.mux.HandleFunc("DELETE "+subscriptionsURI+"/{id}", e.handleDeleteSubscription)
+	e.mux.HandleFunc("GET "+sseURI, e.handleSSE)
+
+	return e
+}
+
+// ServeHTTP applies injected faults and authentication before dispatching to the Redfish resource handlers.
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if f := e.takeFault(r); f != nil {
+		if f.Delay > 0 {
+			select {
+			case <-time.After(f.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if f.Drop {
+			panic(http.ErrAbortHandler)
+		}
+		if f.StatusCode != 0 {
+			writeError(w, f.StatusCode, "injected fault")
+			return
+		}
+	}
+
+	if e.resetting() {
+		writeError(w, http.StatusServiceUnavailable, "manager is resetting")
+		return
+	}
+
+	if !isPublic(r) && !e.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Redfish"`)
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	e.mux.ServeHTTP(w, r)
+}
+
+// InjectFault adds a fault applied to subsequent matching requests. Faults are checked in the order they were added.
+func (e *Emulator) InjectFault(f Fault) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.faults = append(e.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (e *Emulator) ClearFaults() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.faults = nil
+}
+
+// PowerState returns the current chassis power state ("On" or "Off").
+func (e *Emulator) PowerState() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.powerState
+}
+
+// FirmwareVersion returns the firmware version currently reported by the manager.
+func (e *Emulator) FirmwareVersion() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.firmwareVersion
+}
+
+// SetFirmwareVersion overrides the firmware version reported by the manager.
+func (e *Emulator) SetFirmwareVersion(version string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.firmwareVersion = version
+}
+
+// ApplyTime returns the current UpdateService HttpPushUriApplyTime.
+func (e *Emulator) ApplyTime() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.applyTime
+}
+
+// PowerLimit returns the power limit of the chassis in watts, or zero if power capping is disabled.
+func (e *Emulator) PowerLimit() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.powerLimitWatts
+}
+
+// Password returns the password currently accepted by the emulated account.
+func (e *Emulator) Password() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.password
+}
+
+// PasswordChanges returns the number of successful AccountService password changes.
+func (e *Emulator) PasswordChanges() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.passwordChanges
+}
+
+// ManagerResets returns the number of Manager.Reset and Manager.ResetToDefaults actions received.
+func (e *Emulator) ManagerResets() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.managerResets
+}
+
+func (e *Emulator) takeFault(r *http.Request) *Fault {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i, f := range e.faults {
+		if !f.matches(r) {
+			continue
+		}
+
+		taken := *f
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				e.faults = append(e.faults[:i], e.faults[i+1:]...)
+			}
+		}
+		return &taken
+	}
+
+	return nil
+}
+
+func (e *Emulator) resetting() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.now().Before(e.unavailableUntil)
+}
+
+func isPublic(r *http.Request) bool {
+	switch r.URL.Path {
+	case "/redfish", "/redfish/v1", serviceRootURI:
+		return r.Method == http.MethodGet
+	case sessionsURI:
+		return r.Method == http.MethodPost
+	}
+
+	return false
+}
+
+func (e *Emulator) authorized(r *http.Request) bool {
+	if token := r.Header.Get("X-Auth-Token"); token != "" {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		for _, t := range e.sessions {
+			if t == token {
+				return true
+			}
+		}
+		return false
+	}
+
+	user, password, ok := r.BasicAuth()
+	return ok && e.validCredentials(user, password)
+}
+
+func (e *Emulator) validCredentials(user, password string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return user == e.cfg.Username && password == e.password
+}
+
+func (e *Emulator) handleVersions(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"v1": serviceRootURI})
+}
+
+func (e *Emulator) handleServiceRoot(w http.ResponseWriter, r *http.Request) {
+	p := e.cfg.Profile
+	root := map[string]any{
+		"@odata.id":      serviceRootURI,
+		"@odata.type":    "#ServiceRoot.v1_15_0.ServiceRoot",
+		"Id":             "RootService",
+		"Name":           "Root Service",
+		"RedfishVersion": "1.15.0",
+		"Vendor":         p.Manufacturer,
+		"Product":        p.Model,
+		"Chassis":        link(chassisURI),
+		"Managers":       link(managersURI),
+		"SessionService": link("/redfish/v1/SessionService"),
+		"AccountService": link(accountServiceURI),
+		"UpdateService":  link(updateServiceURI),
+		"TaskService":    link(taskServiceURI),
+		"EventService":   link(eventServiceURI),
+		"Links": map[string]any{
+			"Sessions": link(sessionsURI),
+		},
+	}
+	if p.SystemID != "" {
+		root["Systems"] = link(systemsURI)
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
+
+func (e *Emulator) handleSessionService(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":      "/redfish/v1/SessionService",
+		"@odata.type":    "#SessionService.v1_1_8.SessionService",
+		"Id":             "SessionService",
+		"Name":           "Session Service",
+		"ServiceEnabled": true,
+		"Sessions":       link(sessionsURI),
+	})
+}
+
+func (e *Emulator) handleSessions(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	members := make([]string, 0, len(e.sessions))
+	for id := range e.sessions {
+		members = append(members, sessionsURI+"/"+id)
+	}
+	e.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, collection(sessionsURI, "#SessionCollection.SessionCollection", "Session Collection", members))
+}
+
+func (e *Emulator) handleCreateSession(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserName string
+		Password string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed session request: %v", err))
+		return
+	}
+
+	if !e.validCredentials(req.UserName, req.Password) {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	e.mu.Lock()
+	e.nextSessionID++
+	id := strconv.Itoa(e.nextSessionID)
+	e.sessions[id] = hex.EncodeToString(token)
+	w.Header().Set("X-Auth-Token", e.sessions[id])
+	e.mu.Unlock()
+
+	w.Header().Set("Location", sessionsURI+"/"+id)
+	writeJSON(w, http.StatusCreated, e.session(id))
+}
+
+func (e *Emulator) handleSession(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	e.mu.Lock()
+	_, ok := e.sessions[id]
+	e.mu.Unlock()
+
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, e.session(id))
+}
+
+func (e *Emulator) handleDeleteSession(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	e.mu.Lock()
+	_, ok := e.sessions[id]
+	delete(e.sessions, id)
+	e.mu.Unlock()
+
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) session(id string) map[string]any {
+	return map[string]any{
+		"@odata.id":   sessionsURI + "/" + id,
+		"@odata.type": "#Session.v1_6_0.Session",
+		"Id":          id,
+		"Name":        "User Session",
+		"UserName":    e.cfg.Username,
+	}
+}
+
+func (e *Emulator) handleAccountService(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":         accountServiceURI,
+		"@odata.type":       "#AccountService.v1_12_0.AccountService",
+		"Id":                "AccountService",
+		"Name":              "Account Service",
+		"ServiceEnabled":    true,
+		"MinPasswordLength": minPasswordLength,
+		"Accounts":          link(accountsURI),
+		"Status":            status(),
+	})
+}
+
+func (e *Emulator) handleAccounts(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, collection(accountsURI, "#ManagerAccountCollection.ManagerAccountCollection", "Accounts Collection", []string{accountsURI + "/" + accountID}))
+}
+
+func (e *Emulator) handleAccount(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != accountID {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":   accountsURI + "/" + accountID,
+		"@odata.type": "#ManagerAccount.v1_10_0.ManagerAccount",
+		"Id":          accountID,
+		"Name":        "User Account",
+		"UserName":    e.cfg.Username,
+		"RoleId":      "Administrator",
+		"Enabled":     true,
+		"Locked":      false,
+		// Password is write-only and always reported as null.
+		"Password": nil,
+	})
+}
+
+func (e *Emulator) handlePatchAccount(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != accountID {
+		writeNotFound(w, r)
+		return
+	}
+
+	var req struct {
+		Password *string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return
+	}
+
+	if req.Password == nil {
+		writeError(w, http.StatusBadRequest, "no supported properties in request")
+		return
+	}
+	if len(*req.Password) < minPasswordLength {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("password is shorter than %d characters", minPasswordLength))
+		return
+	}
+
+	e.mu.Lock()
+	e.password = *req.Password
+	e.passwordChanges++
+	e.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handleChassisCollection(w http.ResponseWriter, r *http.Request) {
+	members := []string{e.chassisURI()}
+	writeJSON(w, http.StatusOK, collection(chassisURI, "#ChassisCollection.ChassisCollection", "Chassis Collection", members))
+}
+
+func (e *Emulator) handleChassis(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) {
+		return
+	}
+
+	p := e.cfg.Profile
+	chassisType := "RackMount"
+	if p.PowerSupplies > 0 {
+		chassisType = "Shelf"
+	}
+
+	chassis := map[string]any{
+		"@odata.id":    e.chassisURI(),
+		"@odata.type":  "#Chassis.v1_23_0.Chassis",
+		"Id":           p.ChassisID,
+		"Name":         p.Model,
+		"ChassisType":  chassisType,
+		"Manufacturer": p.Manufacturer,
+		"Model":        p.Model,
+		"SerialNumber": p.SerialNumber,
+		"PowerState":   e.PowerState(),
+		"Status":       status(),
+		"Actions": map[string]any{
+			"#Chassis.Reset": map[string]any{
+				"target":                            e.chassisURI() + "/Actions/Chassis.Reset",
+				"ResetType@Redfish.AllowableValues": []string{"On", "ForceOff", "PowerCycle"},
+			},
+		},
+		"Links": map[string]any{
+			"ManagedBy": []any{link(e.managerURI())},
+		},
+	}
+	if e.cfg.Rack != "" {
+		chassis["Location"] = map[string]any{
+			"Placement": map[string]any{"Rack": e.cfg.Rack},
+		}
+	}
+	if p.PowerSupplies > 0 {
+		chassis["PowerSubsystem"] = link(e.chassisURI() + "/PowerSubsystem")
+		chassis["Sensors"] = link(e.chassisURI() + "/Sensors")
+	}
+	if p.SupportsPowerLimit {
+		chassis["Power"] = link(e.chassisURI() + "/Power")
+	}
+
+	writeJSON(w, http.StatusOK, chassis)
+}
+
+func (e *Emulator) handleChassisAction(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) {
+		return
+	}
+
+	state, ok := chassisResetTypes[r.PathValue("action")]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	if _, ok := decodeAction(w, r); !ok {
+		return
+	}
+
+	e.setPowerState(state, e.chassisURI())
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handlePower(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerLimit(w, r) {
+		return
+	}
+
+	p := e.cfg.Profile
+	var limit any
+	if l := e.PowerLimit(); l > 0 {
+		limit = l
+	}
+
+	uri := e.chassisURI() + "/Power"
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":   uri,
+		"@odata.type": "#Power.v1_7_1.Power",
+		"Id":          "Power",
+		"Name":        "Power",
+		"PowerControl": []any{
+			map[string]any{
+				"@odata.id":          uri + "#/PowerControl/0",
+				"MemberId":           "0",
+				"Name":               "Chassis Power Control",
+				"PowerCapacityWatts": p.PSUCapacityWatts * p.PowerSupplies,
+				"PowerConsumedWatts": e.psuOutputPower() * float64(p.PowerSupplies),
+				"PowerLimit": map[string]any{
+					"LimitInWatts":   limit,
+					"LimitException": "LogEventOnly",
+				},
+				"Status": status(),
+			},
+		},
+	})
+}
+
+func (e *Emulator) handlePatchPower(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerLimit(w, r) {
+		return
+	}
+
+	var req struct {
+		PowerControl []struct {
+			PowerLimit *struct {
+				LimitInWatts *float64
+			}
+		}
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return
+	}
+
+	if len(req.PowerControl) != 1 || req.PowerControl[0].PowerLimit == nil {
+		writeError(w, http.StatusBadRequest, "no supported properties in request")
+		return
+	}
+
+	// A null LimitInWatts disables power capping.
+	var limit float64
+	if l := req.PowerControl[0].PowerLimit.LimitInWatts; l != nil {
+		if *l <= 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid LimitInWatts %v", *l))
+			return
+		}
+		limit = *l
+	}
+
+	e.mu.Lock()
+	e.powerLimitWatts = limit
+	e.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handlePowerSubsystem(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerSupplies(w, r) {
+		return
+	}
+
+	p := e.cfg.Profile
+	uri := e.chassisURI() + "/PowerSubsystem"
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":     uri,
+		"@odata.type":   "#PowerSubsystem.v1_1_0.PowerSubsystem",
+		"Id":            "PowerSubsystem",
+		"Name":          "Power Subsystem",
+		"CapacityWatts": p.PSUCapacityWatts * p.PowerSupplies,
+		"PowerSupplies": link(uri + "/PowerSupplies"),
+		"Status":        status(),
+	})
+}
+
+func (e *Emulator) handlePowerSupplies(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerSupplies(w, r) {
+		return
+	}
+
+	uri := e.chassisURI() + "/PowerSubsystem/PowerSupplies"
+	members := make([]string, 0, e.cfg.Profile.PowerSupplies)
+	for i := 0; i < e.cfg.Profile.PowerSupplies; i++ {
+		members = append(members, fmt.Sprintf("%s/PSU%d", uri, i))
+	}
+
+	writeJSON(w, http.StatusOK, collection(uri, "#PowerSupplyCollection.PowerSupplyCollection", "Power Supply Collection", members))
+}
+
+func (e *Emulator) handlePowerSupply(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerSupplies(w, r) {
+		return
+	}
+
+	i, ok := e.psuIndex(r.PathValue("psu"))
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	p := e.cfg.Profile
+	id := fmt.Sprintf("PSU%d", i)
+	sensors := make([]any, 0, 3)
+	for _, s := range e.psuSensors(i) {
+		sensors = append(sensors, link(s["@odata.id"].(string)))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":       e.chassisURI() + "/PowerSubsystem/PowerSupplies/" + id,
+		"@odata.type":     "#PowerSupply.v1_5_0.PowerSupply",
+		"Id":              id,
+		"Name":            fmt.Sprintf("Power Supply %d", i),
+		"CapacityWatts":   strconv.Itoa(p.PSUCapacityWatts),
+		"FirmwareVersion": p.PSUFirmwareVersion,
+		"HardwareVersion": "A00",
+		"HotPluggable":    true,
+		"InputSourceNum":  1,
+		"Manufacturer":    p.PSUManufacturer,
+		"Model":           p.PSUModel,
+		"PowerState":      e.PowerState() == "On",
+		"SerialNumber":    fmt.Sprintf("%s-PSU%d", p.SerialNumber, i),
+		"Location": map[string]any{
+			"PartLocation": map[string]any{
+				"LocationOrdinalValue": i,
+				"LocationType":         "Bay",
+				"ServiceLabel":         fmt.Sprintf("PSU %d", i),
+			},
+		},
+		"Sensors": sensors,
+		"Status":  e.psuHealth(i),
+	})
+}
+
+func (e *Emulator) handleSensors(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerSupplies(w, r) {
+		return
+	}
+
+	var members []string
+	for i := 0; i < e.cfg.Profile.PowerSupplies; i++ {
+		for _, s := range e.psuSensors(i) {
+			members = append(members, s["@odata.id"].(string))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, collection(e.chassisURI()+"/Sensors", "#SensorCollection.SensorCollection", "Sensor Collection", members))
+}
+
+func (e *Emulator) handleSensor(w http.ResponseWriter, r *http.Request) {
+	if !e.isChassis(w, r) || !e.hasPowerSupplies(w, r) {
+		return
+	}
+
+	for i := 0; i < e.cfg.Profile.PowerSupplies; i++ {
+		for _, s := range e.psuSensors(i) {
+			if s["Id"] == r.PathValue("sensor") {
+				writeJSON(w, http.StatusOK, s)
+				return
+			}
+		}
+	}
+
+	writeNotFound(w, r)
+}
+
+// psuSensors returns the output power, input voltage, input current, temperature and fan speed sensors of PSU i.
+func (e *Emulator) psuSensors(i int) []map[string]any {
+	p := e.cfg.Profile
+	output, fanSpeed := e.psuOutputPower(), p.PSUFanSpeedRPM
+	if e.PowerState() != "On" {
+		fanSpeed = 0
+	}
+	var inputCurrent float64
+	if p.PSUInputVoltage > 0 {
+		inputCurrent = output / p.PSUInputVoltage
+	}
+
+	sensor := func(name, readingType, units string, reading, min, max, caution, critical float64) map[string]any {
+		id := fmt.Sprintf("PSU%d_%s", i, name)
+		return map[string]any{
+			"@odata.id":       e.chassisURI() + "/Sensors/" + id,
+			"@odata.type":     "#Sensor.v1_7_0.Sensor",
+			"Id":              id,
+			"Name":            fmt.Sprintf("PSU %d %s", i, name),
+			"Reading":         reading,
+			"ReadingType":     readingType,
+			"ReadingUnits":    units,
+			"ReadingRangeMin": min,
+			"ReadingRangeMax": max,
+			"Thresholds": map[string]any{
+				"UpperCaution":  map[string]any{"Reading": caution},
+				"UpperCritical": map[string]any{"Reading": critical},
+			},
+			"Status": status(),
+		}
+	}
+
+	capacity := float64(p.PSUCapacityWatts)
+	return []map[string]any{
+		sensor("OutputPower", "Power", "W", output, 0, capacity, capacity*0.9, capacity),
+		sensor("InputVoltage", "Voltage", "V", p.PSUInputVoltage, 180, 264, 255, 264),
+		sensor("InputCurrent", "Current", "A", inputCurrent, 0, 32, 28, 32),
+		sensor("Temperature", "Temperature", "Cel", p.PSUTemperatureCelsius, 0, 100, 70, 85),
+		sensor("FanSpeed", "Rotational", "RPM", fanSpeed, 0, 25000, 20000, 23000),
+	}
+}
+
+// psuOutputPower returns the output power of each PSU: zero while the shelf is off, and an even share of the
+// power limit if that is lower than the profile's output power.
+func (e *Emulator) psuOutputPower() float64 {
+	if e.PowerState() != "On" {
+		return 0
+	}
+
+	output := e.cfg.Profile.PSUOutputPowerWatts
+	if limit := e.PowerLimit(); limit > 0 && limit/float64(e.cfg.Profile.PowerSupplies) < output {
+		output = limit / float64(e.cfg.Profile.PowerSupplies)
+	}
+
+	return output
+}
+
+func (e *Emulator) handleManagers(w http.ResponseWriter, r *http.Request) {
+	members := []string{e.managerURI()}
+	writeJSON(w, http.StatusOK, collection(managersURI, "#ManagerCollection.ManagerCollection", "Manager Collection", members))
+}
+
+func (e *Emulator) handleManager(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != e.cfg.Profile.ManagerID {
+		writeNotFound(w, r)
+		return
+	}
+
+	uri := e.managerURI()
+	manager := map[string]any{
+		"@odata.id":       uri,
+		"@odata.type":     "#Manager.v1_19_0.Manager",
+		"Id":              e.cfg.Profile.ManagerID,
+		"Name":            "Manager",
+		"ManagerType":     "BMC",
+		"Manufacturer":    e.cfg.Profile.Manufacturer,
+		"Model":           e.cfg.Profile.Model,
+		"FirmwareVersion": e.FirmwareVersion(),
+		"PowerState":      "On",
+		"Status":          status(),
+		"Actions": map[string]any{
+			"#Manager.Reset": map[string]any{
+				"target":                            uri + "/Actions/Manager.Reset",
+				"ResetType@Redfish.AllowableValues": managerResetTypes,
+			},
+			"#Manager.ResetToDefaults": map[string]any{
+				"target":                            uri + "/Actions/Manager.ResetToDefaults",
+				"ResetType@Redfish.AllowableValues": managerDefaultsTypes,
+			},
+		},
+	}
+	if e.cfg.MACAddress != "" {
+		manager["EthernetInterfaces"] = link(uri + "/EthernetInterfaces")
+	}
+
+	writeJSON(w, http.StatusOK, manager)
+}
+
+func (e *Emulator) handleManagerInterfaces(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != e.cfg.Profile.ManagerID || e.cfg.MACAddress == "" {
+		writeNotFound(w, r)
+		return
+	}
+
+	uri := e.managerURI() + "/EthernetInterfaces"
+	writeJSON(w, http.StatusOK, collection(uri, "#EthernetInterfaceCollection.EthernetInterfaceCollection",
+		"Ethernet Network Interface Collection", []string{uri + "/" + ethernetInterfaceID}))
+}
+
+func (e *Emulator) handleManagerInterface(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != e.cfg.Profile.ManagerID || e.cfg.MACAddress == "" ||
+		r.PathValue("iface") != ethernetInterfaceID {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ethernetInterface(e.managerURI(), e.cfg.MACAddress, ""))
+}
+
+func (e *Emulator) handleManagerAction(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != e.cfg.Profile.ManagerID {
+		writeNotFound(w, r)
+		return
+	}
+
+	var allowed []string
+	switch r.PathValue("action") {
+	case "Manager.Reset":
+		allowed = managerResetTypes
+	case "Manager.ResetToDefaults":
+		allowed = managerDefaultsTypes
+	default:
+		writeNotFound(w, r)
+		return
+	}
+
+	resetType, ok := decodeAction(w, r)
+	if !ok {
+		return
+	}
+	if !contains(allowed, resetType) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported ResetType %q", resetType))
+		return
+	}
+
+	e.mu.Lock()
+	e.managerResets++
+	if e.pendingVersion != "" {
+		e.firmwareVersion = e.pendingVersion
+		e.pendingVersion = ""
+	}
+	e.unavailableUntil = e.now().Add(e.cfg.ResetDowntime)
+	e.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handleSystems(w http.ResponseWriter, r *http.Request) {
+	var members []string
+	if e.cfg.Profile.SystemID != "" {
+		members = append(members, e.systemURI())
+	}
+
+	writeJSON(w, http.StatusOK, collection(systemsURI, "#ComputerSystemCollection.ComputerSystemCollection", "Computer System Collection", members))
+}
+
+func (e *Emulator) handleSystem(w http.ResponseWriter, r *http.Request) {
+	if !e.isSystem(w, r) {
+		return
+	}
+
+	p := e.cfg.Profile
+	system := map[string]any{
+		"@odata.id":    e.systemURI(),
+		"@odata.type":  "#ComputerSystem.v1_20_0.ComputerSystem",
+		"Id":           p.SystemID,
+		"Name":         p.Model,
+		"SystemType":   "Physical",
+		"Manufacturer": p.Manufacturer,
+		"Model":        p.Model,
+		"SerialNumber": p.SerialNumber,
+		"PowerState":   e.PowerState(),
+		"Status":       status(),
+		"Actions": map[string]any{
+			"#ComputerSystem.Reset": map[string]any{
+				"target":                            e.systemURI() + "/Actions/ComputerSystem.Reset",
+				"ResetType@Redfish.AllowableValues": []string{"On", "ForceOn", "ForceOff", "GracefulShutdown", "PowerCycle", "GracefulRestart", "ForceRestart"},
+			},
+		},
+	}
+	if e.cfg.HostMACAddress != "" {
+		system["EthernetInterfaces"] = link(e.systemURI() + "/EthernetInterfaces")
+	}
+
+	writeJSON(w, http.StatusOK, system)
+}
+
+func (e *Emulator) handleSystemInterfaces(w http.ResponseWriter, r *http.Request) {
+	if !e.isSystem(w, r) {
+		return
+	}
+	if e.cfg.HostMACAddress == "" {
+		writeNotFound(w, r)
+		return
+	}
+
+	uri := e.systemURI() + "/EthernetInterfaces"
+	writeJSON(w, http.StatusOK, collection(uri, "#EthernetInterfaceCollection.EthernetInterfaceCollection",
+		"Ethernet Network Interface Collection", []string{uri + "/" + ethernetInterfaceID}))
+}
+
+func (e *Emulator) handleSystemInterface(w http.ResponseWriter, r *http.Request) {
+	if !e.isSystem(w, r) {
+		return
+	}
+	if e.cfg.HostMACAddress == "" || r.PathValue("iface") != ethernetInterfaceID {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ethernetInterface(e.systemURI(), e.cfg.HostMACAddress, e.cfg.HostIPAddress))
+}
+
+func (e *Emulator) handleSystemAction(w http.ResponseWriter, r *http.Request) {
+	if !e.isSystem(w, r) {
+		return
+	}
+
+	if r.PathValue("action") != "ComputerSystem.Reset" {
+		writeNotFound(w, r)
+		return
+	}
+
+	resetType, ok := decodeAction(w, r)
+	if !ok {
+		return
+	}
+
+	state, ok := systemResetTypes[resetType]
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported ResetType %q", resetType))
+		return
+	}
+
+	e.setPowerState(state, e.systemURI())
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handleUpdateService(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":      updateServiceURI,
+		"@odata.type":    "#UpdateService.v1_11_0.UpdateService",
+		"Id":             "UpdateService",
+		"Name":           "Update Service",
+		"ServiceEnabled": true,
+		"HttpPushUri":    updateServiceURI,
+		"HttpPushUriOptions": map[string]any{
+			"HttpPushUriApplyTime": map[string]any{
+				"ApplyTime":                         e.ApplyTime(),
+				"ApplyTime@Redfish.AllowableValues": applyTimes,
+			},
+		},
+		"FirmwareInventory": link(firmwareInvURI),
+		"Status":            status(),
+	})
+}
+
+func (e *Emulator) handlePatchUpdateService(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		HttpPushUriOptions *struct {
+			HttpPushUriApplyTime *struct {
+				ApplyTime string
+			}
+		}
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return
+	}
+
+	if req.HttpPushUriOptions == nil || req.HttpPushUriOptions.HttpPushUriApplyTime == nil {
+		writeError(w, http.StatusBadRequest, "no supported properties in request")
+		return
+	}
+
+	applyTime := req.HttpPushUriOptions.HttpPushUriApplyTime.ApplyTime
+	if !contains(applyTimes, applyTime) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported ApplyTime %q", applyTime))
+		return
+	}
+
+	e.mu.Lock()
+	e.applyTime = applyTime
+	e.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handleFirmwareUpload(w http.ResponseWriter, r *http.Request) {
+	n, err := io.Copy(io.Discard, io.LimitReader(r.Body, maxFirmwareUpload))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to read firmware image: %v", err))
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusBadRequest, "empty firmware image")
+		return
+	}
+
+	e.mu.Lock()
+	e.nextTaskID++
+	t := &task{
+		id:      strconv.Itoa(e.nextTaskID),
+		started: e.now(),
+		state:   TaskStateRunning,
+		fail:    e.cfg.FailTasks,
+	}
+	e.tasks[t.id] = t
+	e.emitTaskState(t)
+	e.armTaskEvent(t)
+	body := e.taskResource(t)
+	e.mu.Unlock()
+
+	w.Header().Set("Location", tasksURI+"/"+t.id)
+	writeJSON(w, http.StatusAccepted, body)
+}
+
+func (e *Emulator) handleFirmwareInventories(w http.ResponseWriter, r *http.Request) {
+	members := []string{firmwareInvURI + "/BMC"}
+	for i := 0; i < e.cfg.Profile.PowerSupplies; i++ {
+		members = append(members, fmt.Sprintf("%s/PSU%d", firmwareInvURI, i))
+	}
+
+	writeJSON(w, http.StatusOK, collection(firmwareInvURI, "#SoftwareInventoryCollection.SoftwareInventoryCollection", "Firmware Inventory Collection", members))
+}
+
+func (e *Emulator) handleFirmwareInventory(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	var version string
+	if id == "BMC" {
+		version = e.FirmwareVersion()
+	} else if _, ok := e.psuIndex(id); ok {
+		version = e.cfg.Profile.PSUFirmwareVersion
+	} else {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":   firmwareInvURI + "/" + id,
+		"@odata.type": "#SoftwareInventory.v1_10_0.SoftwareInventory",
+		"Id":          id,
+		"Name":        id + " Firmware",
+		"Version":     version,
+		"Updateable":  id == "BMC",
+		"Status":      status(),
+	})
+}
+
+func (e *Emulator) handleTaskService(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":      taskServiceURI,
+		"@odata.type":    "#TaskService.v1_2_0.TaskService",
+		"Id":             "TaskService",
+		"Name":           "Task Service",
+		"ServiceEnabled": true,
+		"Tasks":          link(tasksURI),
+		"Status":         status(),
+	})
+}
+
+func (e *Emulator) handleTasks(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	members := make([]string, 0, len(e.tasks))
+	for i := 1; i <= e.nextTaskID; i++ {
+		if _, ok := e.tasks[strconv.Itoa(i)]; ok {
+			members = append(members, fmt.Sprintf("%s/%d", tasksURI, i))
+		}
+	}
+	e.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, collection(tasksURI, "#TaskCollection.TaskCollection", "Task Collection", members))
+}
+
+func (e *Emulator) handleTask(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	t, ok := e.tasks[r.PathValue("id")]
+	var body map[string]any
+	if ok {
+		e.advanceTask(t)
+		body = e.taskResource(t)
+	}
+	e.mu.Unlock()
+
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// advanceTask moves a running task forward based on the time elapsed since it started,
+// applying or staging the new firmware version and publishing a TaskEvent when it ends. Must be called with e.mu held.
+func (e *Emulator) advanceTask(t *task) {
+	if t.state != TaskStateRunning {
+		return
+	}
+
+	duration := e.cfg.TaskDuration
+	elapsed := e.now().Sub(t.started)
+
+	if t.fail {
+		if elapsed >= duration/2 {
+			t.state = TaskStateException
+			t.percent = 50
+			t.ended = e.now()
+			e.emitTaskState(t)
+		} else {
+			t.percent = int(elapsed * 100 / duration)
+		}
+		return
+	}
+
+	if elapsed < duration {
+		t.percent = int(elapsed * 100 / duration)
+		return
+	}
+
+	t.state = TaskStateCompleted
+	t.percent = 100
+	t.ended = e.now()
+	e.emitTaskState(t)
+
+	version := e.cfg.UpdatedFirmwareVersion
+	if version == "" {
+		version = nextVersion(e.firmwareVersion)
+	}
+	if e.applyTime == ApplyTimeImmediate {
+		e.firmwareVersion = version
+	} else {
+		e.pendingVersion = version
+	}
+}
+
+// taskResource renders a task. Must be called with e.mu held.
+func (e *Emulator) taskResource(t *task) map[string]any {
+	taskStatus := "OK"
+	message := "The task is running."
+	switch t.state {
+	case TaskStateCompleted:
+		message = "The task has completed successfully."
+	case TaskStateException:
+		taskStatus = "Critical"
+		message = "The firmware update failed."
+	}
+
+	res := map[string]any{
+		"@odata.id":       tasksURI + "/" + t.id,
+		"@odata.type":     "#Task.v1_7_1.Task",
+		"Id":              t.id,
+		"Name":            "Firmware Update Task",
+		"TaskState":       t.state,
+		"TaskStatus":      taskStatus,
+		"PercentComplete": t.percent,
+		"StartTime":       t.started.UTC().Format(time.RFC3339),
+		"Messages": []any{
+			map[string]any{"Message": message},
+		},
+	}
+	if !t.ended.IsZero() {
+		res["EndTime"] = t.ended.UTC().Format(time.RFC3339)
+	}
+
+	return res
+}
+
+func (e *Emulator) chassisURI() string {
+	return chassisURI + "/" + e.cfg.Profile.ChassisID
+}
+
+func (e *Emulator) managerURI() string {
+	return managersURI + "/" + e.cfg.Profile.ManagerID
+}
+
+func (e *Emulator) systemURI() string {
+	return systemsURI + "/" + e.cfg.Profile.SystemID
+}
+
+func (e *Emulator) isChassis(w http.ResponseWriter, r *http.Request) bool {
+	if r.PathValue("id") != e.cfg.Profile.ChassisID {
+		writeNotFound(w, r)
+		return false
+	}
+
+	return true
+}
+
+func (e *Emulator) isSystem(w http.ResponseWriter, r *http.Request) bool {
+	if e.cfg.Profile.SystemID == "" || r.PathValue("id") != e.cfg.Profile.SystemID {
+		writeNotFound(w, r)
+		return false
+	}
+
+	return true
+}
+
+func (e *Emulator) hasPowerSupplies(w http.ResponseWriter, r *http.Request) bool {
+	if e.cfg.Profile.PowerSupplies == 0 {
+		writeNotFound(w, r)
+		return false
+	}
+
+	return true
+}
+
+func (e *Emulator) hasPowerLimit(w http.ResponseWriter, r *http.Request) bool {
+	if !e.cfg.Profile.SupportsPowerLimit || e.cfg.Profile.PowerSupplies == 0 {
+		writeNotFound(w, r)
+		return false
+	}
+
+	return true
+}
+
+func (e *Emulator) psuIndex(id string) (int, bool) {
+	var i int
+	if _, err := fmt.Sscanf(id, "PSU%d", &i); err != nil || id != fmt.Sprintf("PSU%d", i) {
+		return 0, false
+	}
+
+	return i, i >= 0 && i < e.cfg.Profile.PowerSupplies
+}
+
+// nextVersion increments the last numeric component of a version string.
+func nextVersion(version string) string {
+	m := lastNumber.FindStringSubmatchIndex(version)
+	if m == nil {
+		return version
+	}
+
+	n, err := strconv.Atoi(version[m[2]:m[3]])
+	if err != nil {
+		return version
+	}
+
+	return fmt.Sprintf("%s%0*d%s", version[:m[2]], m[3]-m[2], n+1, version[m[4]:])
+}
+
+// decodeAction decodes the ResetType of an action request body, writing a 400 response if it is malformed.
+func decodeAction(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req struct {
+		ResetType string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed action body: %v", err))
+		return "", false
+	}
+
+	return req.ResetType, true
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ethernetInterface returns the EthernetInterface resource of the manager or system at parentURI. The
+// IPv4 address is omitted if ip is empty.
+func ethernetInterface(parentURI, mac, ip string) map[string]any {
+	iface := map[string]any{
+		"@odata.id":           parentURI + "/EthernetInterfaces/" + ethernetInterfaceID,
+		"@odata.type":         "#EthernetInterface.v1_9_0.EthernetInterface",
+		"Id":                  ethernetInterfaceID,
+		"Name":                "Ethernet Interface",
+		"MACAddress":          mac,
+		"PermanentMACAddress": mac,
+		"InterfaceEnabled":    true,
+		"Status":              status(),
+	}
+	if ip != "" {
+		iface["IPv4Addresses"] = []any{
+			map[string]any{"Address": ip, "AddressOrigin": "DHCP"},
+		}
+	}
+
+	return iface
+}
+
+func link(uri string) map[string]any {
+	return map[string]any{"@odata.id": uri}
+}
+
+func status() map[string]any {
+	return map[string]any{"State": "Enabled", "Health": "OK"}
+}
+
+func collection(uri, odataType, name string, members []string) map[string]any {
+	links := make([]any, 0, len(members))
+	for _, m := range members {
+		links = append(links, link(m))
+	}
+
+	return map[string]any{
+		"@odata.id":           uri,
+		"@odata.type":         odataType,
+		"Name":                name,
+		"Members":             links,
+		"Members@odata.count": len(links),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("OData-Version", "4.0")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]any{
+		"error": map[string]any{
+			"code":    "Base.1.15.GeneralError",
+			"message": message,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("resource %s not found", r.URL.Path))
+}
@@ -0,0 +1,551 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testServer struct {
+	*httptest.Server
+	emu *Emulator
+	now time.Time
+}
+
+func newTestServer(t *testing.T, cfg Config) *testServer {
+	ts := &testServer{emu: New(cfg), now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ts.emu.now = func() time.Time { return ts.now }
+	ts.Server = httptest.NewTLSServer(ts.emu)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func (ts *testServer) do(t *testing.T, method, path string, body any, auth bool) (*http.Response, map[string]any) {
+	var reader *bytes.Reader
+	switch b := body.(type) {
+	case nil:
+		reader = bytes.NewReader(nil)
+	case []byte:
+		reader = bytes.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, ts.URL+path, reader)
+	require.NoError(t, err)
+	if auth {
+		req.SetBasicAuth(DefaultUsername, DefaultPassword)
+	}
+
+	resp, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var decoded map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&decoded)
+	return resp, decoded
+}
+
+func TestServiceRootAndAuthentication(t *testing.T) {
+	ts := newTestServer(t, Config{Profile: LiteonPowerShelf})
+
+	resp, root := ts.do(t, http.MethodGet, "/redfish/v1/", nil, false)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Liteon", root["Vendor"])
+	assert.Nil(t, root["Systems"])
+
+	resp, _ = ts.do(t, http.MethodGet, "/redfish/v1/Chassis", nil, false)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodPost, sessionsURI, map[string]string{"UserName": "root", "Password": "wrong"}, false)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodPost, sessionsURI, map[string]string{"UserName": DefaultUsername, "Password": DefaultPassword}, false)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	token := resp.Header.Get("X-Auth-Token")
+	location := resp.Header.Get("Location")
+	assert.NotEmpty(t, token)
+	assert.Equal(t, sessionsURI+"/1", location)
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/redfish/v1/Chassis", nil)
+	req.Header.Set("X-Auth-Token", token)
+	tokenResp, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	tokenResp.Body.Close()
+	assert.Equal(t, http.StatusOK, tokenResp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodDelete, location, nil, true)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	tokenResp, err = ts.Client().Do(req)
+	require.NoError(t, err)
+	tokenResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, tokenResp.StatusCode)
+}
+
+func TestEthernetInterfaces(t *testing.T) {
+	ts := newTestServer(t, Config{
+		Profile:        NVSwitchBMC,
+		MACAddress:     "aa:bb:cc:00:00:01",
+		HostMACAddress: "aa:bb:cc:00:00:02",
+		HostIPAddress:  "10.0.0.12",
+	})
+
+	resp, manager := ts.do(t, http.MethodGet, managersURI+"/bmc", nil, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotNil(t, manager["EthernetInterfaces"])
+
+	resp, iface := ts.do(t, http.MethodGet, managersURI+"/bmc/EthernetInterfaces/eth0", nil, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "aa:bb:cc:00:00:01", iface["MACAddress"])
+	assert.Nil(t, iface["IPv4Addresses"])
+
+	resp, iface = ts.do(t, http.MethodGet, systemsURI+"/System_0/EthernetInterfaces/eth0", nil, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "aa:bb:cc:00:00:02", iface["MACAddress"])
+	addrs, _ := iface["IPv4Addresses"].([]any)
+	require.Len(t, addrs, 1)
+	assert.Equal(t, "10.0.0.12", addrs[0].(map[string]any)["Address"])
+
+	// Without MAC addresses neither resource exposes interfaces.
+	plain := newTestServer(t, Config{Profile: NVSwitchBMC})
+	_, manager = plain.do(t, http.MethodGet, managersURI+"/bmc", nil, true)
+	assert.Nil(t, manager["EthernetInterfaces"])
+	resp, _ = plain.do(t, http.MethodGet, systemsURI+"/System_0/EthernetInterfaces", nil, true)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAccountPasswordChange(t *testing.T) {
+	ts := newTestServer(t, Config{Profile: LiteonPowerShelf})
+
+	resp, account := ts.do(t, http.MethodGet, accountsURI+"/1", nil, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, DefaultUsername, account["UserName"])
+
+	resp, _ = ts.do(t, http.MethodPatch, accountsURI+"/1", map[string]string{"Password": "short"}, true)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, DefaultPassword, ts.emu.Password())
+
+	resp, _ = ts.do(t, http.MethodPatch, accountsURI+"/2", map[string]string{"Password": "N3w-Passw0rd"}, true)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodPatch, accountsURI+"/1", map[string]string{"Password": "N3w-Passw0rd"}, true)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "N3w-Passw0rd", ts.emu.Password())
+	assert.Equal(t, 1, ts.emu.PasswordChanges())
+
+	// The old password is no longer accepted, the new one is.
+	resp, _ = ts.do(t, http.MethodGet, accountServiceURI, nil, true)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodPost, sessionsURI, map[string]string{"UserName": DefaultUsername, "Password": "N3w-Passw0rd"}, false)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestPowerActions(t *testing.T) {
+	t.Run("power shelf chassis", func(t *testing.T) {
+		ts := newTestServer(t, Config{Profile: DeltaPowerShelf})
+
+		resp, _ := ts.do(t, http.MethodPost, "/redfish/v1/Chassis/powershelf/Actions/Chassis.ForceOff", map[string]string{"ForceOffType": "ForceOff"}, true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "Off", ts.emu.PowerState())
+
+		_, psu := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU0", nil, true)
+		assert.Equal(t, false, psu["PowerState"])
+
+		resp, _ = ts.do(t, http.MethodPost, "/redfish/v1/Chassis/powershelf/Actions/Chassis.On", map[string]string{"OnType": "On"}, true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "On", ts.emu.PowerState())
+
+		resp, _ = ts.do(t, http.MethodPost, "/redfish/v1/Systems/System_0/Actions/ComputerSystem.Reset", map[string]string{"ResetType": "ForceOff"}, true)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("nvswitch system", func(t *testing.T) {
+		ts := newTestServer(t, Config{Profile: NVSwitchBMC})
+
+		resp, _ := ts.do(t, http.MethodPost, "/redfish/v1/Systems/System_0/Actions/ComputerSystem.Reset", map[string]string{"ResetType": "GracefulShutdown"}, true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "Off", ts.emu.PowerState())
+
+		resp, _ = ts.do(t, http.MethodPost, "/redfish/v1/Systems/System_0/Actions/ComputerSystem.Reset", map[string]string{"ResetType": "Explode"}, true)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "Off", ts.emu.PowerState())
+
+		resp, _ = ts.do(t, http.MethodGet, "/redfish/v1/Chassis/MGX_NVSwitch_0/PowerSubsystem", nil, true)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestPowerLimit(t *testing.T) {
+	t.Run("supported", func(t *testing.T) {
+		ts := newTestServer(t, Config{Profile: LiteonPowerShelf})
+
+		_, chassis := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf", nil, true)
+		assert.Equal(t, map[string]any{"@odata.id": "/redfish/v1/Chassis/powershelf/Power"}, chassis["Power"])
+
+		limit := func(watts any) map[string]any {
+			return map[string]any{"PowerControl": []any{map[string]any{"PowerLimit": map[string]any{"LimitInWatts": watts}}}}
+		}
+
+		resp, _ := ts.do(t, http.MethodPatch, "/redfish/v1/Chassis/powershelf/Power", limit(-1), true)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = ts.do(t, http.MethodPatch, "/redfish/v1/Chassis/powershelf/Power", limit(6000), true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, 6000.0, ts.emu.PowerLimit())
+
+		_, power := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf/Power", nil, true)
+		control := power["PowerControl"].([]any)[0].(map[string]any)
+		assert.Equal(t, 6000.0, control["PowerConsumedWatts"])
+		assert.Equal(t, 6000.0, control["PowerLimit"].(map[string]any)["LimitInWatts"])
+
+		_, sensor := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf/Sensors/PSU0_OutputPower", nil, true)
+		assert.Equal(t, 1000.0, sensor["Reading"])
+
+		resp, _ = ts.do(t, http.MethodPatch, "/redfish/v1/Chassis/powershelf/Power", limit(nil), true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, 0.0, ts.emu.PowerLimit())
+
+		_, power = ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf/Power", nil, true)
+		control = power["PowerControl"].([]any)[0].(map[string]any)
+		assert.Equal(t, LiteonPowerShelf.PSUOutputPowerWatts*6, control["PowerConsumedWatts"])
+		assert.Nil(t, control["PowerLimit"].(map[string]any)["LimitInWatts"])
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		ts := newTestServer(t, Config{Profile: DeltaPowerShelf})
+
+		_, chassis := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf", nil, true)
+		assert.Nil(t, chassis["Power"])
+
+		resp, _ := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf/Power", nil, true)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestManagerReset(t *testing.T) {
+	ts := newTestServer(t, Config{Profile: NVSwitchBMC, ResetDowntime: time.Minute})
+
+	resp, _ := ts.do(t, http.MethodPost, "/redfish/v1/Managers/bmc/Actions/Manager.Reset", map[string]string{"ResetType": "PowerCycle"}, true)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, 0, ts.emu.ManagerResets())
+
+	resp, _ = ts.do(t, http.MethodPost, "/redfish/v1/Managers/bmc/Actions/Manager.Reset", map[string]string{"ResetType": "GracefulRestart"}, true)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, 1, ts.emu.ManagerResets())
+
+	resp, _ = ts.do(t, http.MethodGet, "/redfish/v1/Managers/bmc", nil, true)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	ts.now = ts.now.Add(time.Minute)
+	resp, _ = ts.do(t, http.MethodGet, "/redfish/v1/Managers/bmc", nil, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestFirmwareUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		cfg         Config
+		applyTime   string
+		wantState   string
+		wantVersion string
+		wantStaged  string
+	}{
+		"immediate apply bumps the version": {
+			cfg:         Config{Profile: LiteonPowerShelf, TaskDuration: 10 * time.Second},
+			applyTime:   ApplyTimeImmediate,
+			wantState:   TaskStateCompleted,
+			wantVersion: "r1.3.8",
+		},
+		"configured target version": {
+			cfg:         Config{Profile: NVSwitchBMC, TaskDuration: 10 * time.Second, UpdatedFirmwareVersion: "88.0002.1000"},
+			applyTime:   ApplyTimeImmediate,
+			wantState:   TaskStateCompleted,
+			wantVersion: "88.0002.1000",
+		},
+		"on reset apply stages the version": {
+			cfg:         Config{Profile: LiteonPowerShelf, TaskDuration: 10 * time.Second},
+			applyTime:   ApplyTimeOnReset,
+			wantState:   TaskStateCompleted,
+			wantVersion: "r1.3.7",
+			wantStaged:  "r1.3.8",
+		},
+		"failed task keeps the version": {
+			cfg:         Config{Profile: DeltaPowerShelf, TaskDuration: 10 * time.Second, FailTasks: true},
+			applyTime:   ApplyTimeImmediate,
+			wantState:   TaskStateException,
+			wantVersion: "r2.0.4",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t, tc.cfg)
+
+			resp, _ := ts.do(t, http.MethodPatch, updateServiceURI, map[string]any{
+				"HttpPushUriOptions": map[string]any{"HttpPushUriApplyTime": map[string]any{"ApplyTime": tc.applyTime}},
+			}, true)
+			require.Equal(t, http.StatusNoContent, resp.StatusCode)
+			assert.Equal(t, tc.applyTime, ts.emu.ApplyTime())
+
+			resp, _ = ts.do(t, http.MethodPost, updateServiceURI, []byte{}, true)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			resp, created := ts.do(t, http.MethodPost, updateServiceURI, []byte("firmware image"), true)
+			require.Equal(t, http.StatusAccepted, resp.StatusCode)
+			taskURI := created["@odata.id"].(string)
+			assert.Equal(t, taskURI, resp.Header.Get("Location"))
+			assert.Equal(t, TaskStateRunning, created["TaskState"])
+
+			ts.now = ts.now.Add(2 * time.Second)
+			_, running := ts.do(t, http.MethodGet, taskURI, nil, true)
+			assert.Equal(t, TaskStateRunning, running["TaskState"])
+			assert.Equal(t, float64(20), running["PercentComplete"])
+
+			ts.now = ts.now.Add(10 * time.Second)
+			_, done := ts.do(t, http.MethodGet, taskURI, nil, true)
+			assert.Equal(t, tc.wantState, done["TaskState"])
+			assert.Equal(t, tc.wantVersion, ts.emu.FirmwareVersion())
+
+			if tc.wantStaged != "" {
+				resp, _ = ts.do(t, http.MethodPost, "/redfish/v1/Managers/bmc/Actions/Manager.Reset", map[string]string{"ResetType": "ForceRestart"}, true)
+				require.Equal(t, http.StatusNoContent, resp.StatusCode)
+				assert.Equal(t, tc.wantStaged, ts.emu.FirmwareVersion())
+			}
+		})
+	}
+}
+
+func TestInjectFault(t *testing.T) {
+	ts := newTestServer(t, Config{Profile: LiteonPowerShelf})
+
+	ts.emu.InjectFault(Fault{Method: http.MethodPost, Path: updateServiceURI, StatusCode: http.StatusServiceUnavailable, Count: 1})
+	ts.emu.InjectFault(Fault{Path: "/redfish/v1/Managers", StatusCode: http.StatusInternalServerError})
+
+	resp, _ := ts.do(t, http.MethodGet, updateServiceURI, nil, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodPost, updateServiceURI, []byte("image"), true)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodPost, updateServiceURI, []byte("image"), true)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp, _ = ts.do(t, http.MethodGet, "/redfish/v1/Managers/bmc", nil, true)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	ts.emu.ClearFaults()
+	resp, _ = ts.do(t, http.MethodGet, "/redfish/v1/Managers/bmc", nil, true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	ts.emu.InjectFault(Fault{Path: "/redfish/v1/Chassis", Drop: true})
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/redfish/v1/Chassis", nil)
+	req.SetBasicAuth(DefaultUsername, DefaultPassword)
+	_, err := ts.Client().Do(req)
+	assert.Error(t, err)
+}
+
+func TestParseFault(t *testing.T) {
+	testCases := map[string]struct {
+		in      string
+		want    Fault
+		wantErr bool
+	}{
+		"status":       {in: "post:/redfish/v1/UpdateService:503", want: Fault{Method: "POST", Path: "/redfish/v1/UpdateService", StatusCode: 503}},
+		"delay only":   {in: "*:/redfish/v1/Chassis:0:2s", want: Fault{Method: "*", Path: "/redfish/v1/Chassis", Delay: 2 * time.Second}},
+		"drop":         {in: "GET:/redfish/v1/TaskService:drop", want: Fault{Method: "GET", Path: "/redfish/v1/TaskService", Drop: true}},
+		"missing part": {in: "GET:/redfish/v1", wantErr: true},
+		"bad path":     {in: "GET:redfish:500", wantErr: true},
+		"bad status":   {in: "GET:/redfish:abc", wantErr: true},
+		"bad delay":    {in: "GET:/redfish:500:soon", wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseFault(tc.in)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	assert.Equal(t, "r1.3.8", nextVersion("r1.3.7"))
+	assert.Equal(t, "r1.3.10", nextVersion("r1.3.9"))
+	assert.Equal(t, "88.0002.0951", nextVersion("88.0002.0950"))
+	assert.Equal(t, "1.2.4-rc", nextVersion("1.2.3-rc"))
+	assert.Equal(t, "unknown", nextVersion("unknown"))
+}
+
+func TestProfileByName(t *testing.T) {
+	p, err := ProfileByName("NVSwitch")
+	assert.NoError(t, err)
+	assert.Equal(t, NVSwitchBMC, p)
+
+	_, err = ProfileByName("foo")
+	assert.True(t, err != nil && strings.Contains(err.Error(), "delta, liteon, nvswitch"))
+}
+
+// eventRecorder is a push destination collecting the events posted by subscriptions.
+type eventRecorder struct {
+	*httptest.Server
+	events chan map[string]any
+	header chan http.Header
+}
+
+func newEventRecorder(t *testing.T) *eventRecorder {
+	rec := &eventRecorder{events: make(chan map[string]any, 16), header: make(chan http.Header, 16)}
+	rec.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&ev)
+		rec.header <- r.Header
+		rec.events <- ev
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(rec.Close)
+	return rec
+}
+
+func (rec *eventRecorder) next(t *testing.T) (map[string]any, map[string]any, http.Header) {
+	select {
+	case ev := <-rec.events:
+		records := ev["Events"].([]any)
+		require.Len(t, records, 1)
+		return ev, records[0].(map[string]any), <-rec.header
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event delivered")
+		return nil, nil, nil
+	}
+}
+
+func TestEventSubscriptions(t *testing.T) {
+	ts := newTestServer(t, Config{Profile: LiteonPowerShelf})
+	rec := newEventRecorder(t)
+
+	_, root := ts.do(t, http.MethodGet, "/redfish/v1/", nil, true)
+	assert.Equal(t, map[string]any{"@odata.id": eventServiceURI}, root["EventService"])
+
+	_, es := ts.do(t, http.MethodGet, eventServiceURI, nil, true)
+	assert.Equal(t, true, es["ServiceEnabled"])
+	assert.Equal(t, sseURI, es["ServerSentEventUri"])
+
+	resp, _ := ts.do(t, http.MethodPost, subscriptionsURI, map[string]any{"Destination": "not a url", "Context": "pmc"}, true)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, created := ts.do(t, http.MethodPost, subscriptionsURI, map[string]any{
+		"Destination": rec.URL,
+		"Context":     "pmc-1",
+		"Protocol":    "Redfish",
+		"HttpHeaders": map[string]string{"X-Event-Token": "secret"},
+	}, true)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	subURI := resp.Header.Get("Location")
+	assert.Equal(t, subURI, created["@odata.id"])
+	assert.Equal(t, 1, ts.emu.Subscriptions())
+
+	_, list := ts.do(t, http.MethodGet, subscriptionsURI, nil, true)
+	assert.Equal(t, float64(1), list["Members@odata.count"])
+
+	resp, _ = ts.do(t, http.MethodPost, "/redfish/v1/Chassis/powershelf/Actions/Chassis.ForceOff", map[string]string{"ForceOffType": "ForceOff"}, true)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	ev, record, header := rec.next(t)
+	assert.Equal(t, "pmc-1", ev["Context"])
+	assert.Equal(t, "secret", header.Get("X-Event-Token"))
+	assert.Equal(t, "ResourceEvent.1.3.ResourcePoweredOff", record["MessageId"])
+	assert.Equal(t, map[string]any{"@odata.id": "/redfish/v1/Chassis/powershelf"}, record["OriginOfCondition"])
+
+	ts.emu.SetPSUFault(1, true)
+	_, record, _ = rec.next(t)
+	assert.Equal(t, "ResourceEvent.1.3.ResourceStatusChangedCritical", record["MessageId"])
+	assert.Equal(t, "Critical", record["MessageSeverity"])
+
+	_, psu := ts.do(t, http.MethodGet, "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU1", nil, true)
+	assert.Equal(t, "Critical", psu["Status"].(map[string]any)["Health"])
+
+	resp, _ = ts.do(t, http.MethodDelete, subURI, nil, true)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, 0, ts.emu.Subscriptions())
+
+	resp, _ = ts.do(t, http.MethodDelete, subURI, nil, true)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServerSentEvents(t *testing.T) {
+	ts := newTestServer(t, Config{Profile: NVSwitchBMC})
+	t.Cleanup(ts.emu.Close)
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+sseURI, nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(DefaultUsername, DefaultPassword)
+	resp, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	frames := make(chan map[string]any, 16)
+	go func() {
+		defer close(frames)
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+				var ev map[string]any
+				if json.Unmarshal([]byte(data), &ev) == nil {
+					frames <- ev
+				}
+			}
+		}
+	}()
+	next := func() string {
+		select {
+		case ev := <-frames:
+			return ev["Events"].([]any)[0].(map[string]any)["MessageId"].(string)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no event streamed")
+			return ""
+		}
+	}
+
+	upload, _ := ts.do(t, http.MethodPost, updateServiceURI, []byte("firmware image"), true)
+	require.Equal(t, http.StatusAccepted, upload.StatusCode)
+
+	// With a zero TaskDuration the task completes in the background without being polled.
+	assert.Equal(t, "TaskEvent.1.0.TaskStarted", next())
+	assert.Equal(t, "TaskEvent.1.0.TaskCompletedOK", next())
+
+	ts.emu.Close()
+	for range frames {
+	}
+}
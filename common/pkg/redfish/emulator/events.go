@@ -0,0 +1,405 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	eventServiceURI  = "/redfish/v1/EventService"
+	subscriptionsURI = "/redfish/v1/EventService/Subscriptions"
+	sseURI           = "/redfish/v1/EventService/SSE"
+
+	// sseBuffer is the number of events queued per SSE client before further events are dropped.
+	sseBuffer = 64
+	// deliveryTimeout bounds a single event POST to a subscriber.
+	deliveryTimeout = 5 * time.Second
+)
+
+// subscription is an EventService push subscription.
+type subscription struct {
+	id          string
+	destination string
+	context     string
+	headers     map[string]string
+}
+
+// delivery is an event queued for posting to a subscription.
+type delivery struct {
+	sub  *subscription
+	body []byte
+}
+
+var deliveryClient = &http.Client{
+	Timeout: deliveryTimeout,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // test destinations use self-signed certificates
+	},
+}
+
+// SetPSUFault raises or clears a fault on power supply i. A faulted supply reports Critical health, and every
+// change is published as a ResourceEvent.
+func (e *Emulator) SetPSUFault(i int, faulted bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if i < 0 || i >= e.cfg.Profile.PowerSupplies || e.psuFaults[i] == faulted {
+		return
+	}
+	e.psuFaults[i] = faulted
+
+	origin := fmt.Sprintf("%s/PowerSubsystem/PowerSupplies/PSU%d", e.chassisURI(), i)
+	if faulted {
+		e.emit("ResourceEvent.1.3.ResourceStatusChangedCritical", "Critical",
+			fmt.Sprintf("The health of resource '%s' has changed to Critical.", origin), origin, origin, "Critical")
+	} else {
+		e.emit("ResourceEvent.1.3.ResourceStatusChangedOK", "OK",
+			fmt.Sprintf("The health of resource '%s' has changed to OK.", origin), origin, origin, "OK")
+	}
+}
+
+// Subscriptions returns the number of push subscriptions registered with the EventService.
+func (e *Emulator) Subscriptions() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return len(e.subscriptions)
+}
+
+// StreamClients returns the number of open SSE streams.
+func (e *Emulator) StreamClients() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return len(e.sseClients)
+}
+
+// Close ends open SSE streams. It should be called before shutting down the HTTP server serving the emulator,
+// which otherwise waits for the streams to finish.
+func (e *Emulator) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for c := range e.sseClients {
+		delete(e.sseClients, c)
+		close(c)
+	}
+}
+
+// psuHealth returns the Status of power supply i.
+func (e *Emulator) psuHealth(i int) map[string]any {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.psuFaults[i] {
+		return map[string]any{"State": "Enabled", "Health": "Critical"}
+	}
+	return status()
+}
+
+// setPowerState changes the power state, publishing a ResourceEvent for origin if it changed.
+func (e *Emulator) setPowerState(state, origin string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.powerState == state {
+		return
+	}
+	e.powerState = state
+
+	e.emit("ResourceEvent.1.3.ResourcePowered"+state, "OK",
+		fmt.Sprintf("The resource '%s' has powered %s.", origin, strings.ToLower(state)), origin, origin)
+}
+
+// emitTaskState publishes the TaskEvent matching the current state of t. Must be called with e.mu held.
+func (e *Emulator) emitTaskState(t *task) {
+	origin := tasksURI + "/" + t.id
+	switch t.state {
+	case TaskStateRunning:
+		e.emit("TaskEvent.1.0.TaskStarted", "OK",
+			fmt.Sprintf("The task with Id '%s' has started.", t.id), origin, t.id)
+	case TaskStateCompleted:
+		e.emit("TaskEvent.1.0.TaskCompletedOK", "OK",
+			fmt.Sprintf("The task with Id '%s' has completed.", t.id), origin, t.id)
+	case TaskStateException:
+		e.emit("TaskEvent.1.0.TaskAborted", "Critical",
+			fmt.Sprintf("The task with Id '%s' has been aborted.", t.id), origin, t.id)
+	}
+}
+
+// hasListeners reports whether any push subscription or SSE client would receive an event. Must be called with
+// e.mu held.
+func (e *Emulator) hasListeners() bool {
+	return len(e.subscriptions) > 0 || len(e.sseClients) > 0
+}
+
+// emit publishes an event record to every SSE client and queues it for every push subscription. Must be called
+// with e.mu held.
+func (e *Emulator) emit(messageID, severity, message, origin string, args ...string) {
+	if !e.hasListeners() {
+		return
+	}
+
+	e.nextEventID++
+	record := map[string]any{
+		"EventId":           strconv.Itoa(e.nextEventID),
+		"EventTimestamp":    e.now().UTC().Format(time.RFC3339),
+		"MessageId":         messageID,
+		"Message":           message,
+		"MessageArgs":       args,
+		"MessageSeverity":   severity,
+		"OriginOfCondition": link(origin),
+	}
+	event := func(context string) []byte {
+		data, _ := json.Marshal(map[string]any{
+			"@odata.type": "#Event.v1_7_0.Event",
+			"Id":          strconv.Itoa(e.nextEventID),
+			"Name":        "Event Array",
+			"Context":     context,
+			"Events":      []any{record},
+		})
+		return data
+	}
+
+	if len(e.sseClients) > 0 {
+		data := event("")
+		for c := range e.sseClients {
+			select {
+			case c <- data:
+			default:
+			}
+		}
+	}
+
+	for _, sub := range e.subscriptions {
+		e.outbox = append(e.outbox, delivery{sub: sub, body: event(sub.context)})
+	}
+	if len(e.outbox) > 0 && !e.delivering {
+		e.delivering = true
+		go e.deliver()
+	}
+}
+
+// deliver posts queued events in order until the outbox is empty. Failed deliveries are dropped.
+func (e *Emulator) deliver() {
+	for {
+		e.mu.Lock()
+		if len(e.outbox) == 0 {
+			e.delivering = false
+			e.mu.Unlock()
+			return
+		}
+		d := e.outbox[0]
+		e.outbox = e.outbox[1:]
+		e.mu.Unlock()
+
+		req, err := http.NewRequest(http.MethodPost, d.sub.destination, bytes.NewReader(d.body))
+		if err != nil {
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range d.sub.headers {
+			req.Header.Set(k, v)
+		}
+		if resp, err := deliveryClient.Do(req); err == nil {
+			resp.Body.Close()
+		}
+	}
+}
+
+// armTaskEvent completes t in the background once its duration has elapsed, so that listeners receive the
+// completion event without polling the task. Must be called with e.mu held.
+func (e *Emulator) armTaskEvent(t *task) {
+	if !e.hasListeners() {
+		return
+	}
+
+	after := e.cfg.TaskDuration
+	if t.fail {
+		after /= 2
+	}
+	time.AfterFunc(after, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		e.advanceTask(t)
+	})
+}
+
+func (e *Emulator) handleEventService(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":                    eventServiceURI,
+		"@odata.type":                  "#EventService.v1_10_0.EventService",
+		"Id":                           "EventService",
+		"Name":                         "Event Service",
+		"ServiceEnabled":               true,
+		"DeliveryRetryAttempts":        0,
+		"DeliveryRetryIntervalSeconds": 0,
+		"EventFormatTypes":             []string{"Event"},
+		"RegistryPrefixes":             []string{"ResourceEvent", "TaskEvent"},
+		"ServerSentEventUri":           sseURI,
+		"Subscriptions":                link(subscriptionsURI),
+		"Status":                       status(),
+	})
+}
+
+func (e *Emulator) handleSubscriptions(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	members := make([]string, 0, len(e.subscriptions))
+	for i := 1; i <= e.nextSubscriptionID; i++ {
+		if _, ok := e.subscriptions[strconv.Itoa(i)]; ok {
+			members = append(members, fmt.Sprintf("%s/%d", subscriptionsURI, i))
+		}
+	}
+	e.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, collection(subscriptionsURI, "#EventDestinationCollection.EventDestinationCollection", "Event Subscriptions Collection", members))
+}
+
+func (e *Emulator) handleCreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Destination string
+		Context     string
+		Protocol    string
+		HTTPHeaders map[string]string `json:"HttpHeaders"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return
+	}
+
+	if u, err := url.ParseRequestURI(body.Destination); err != nil || !strings.HasPrefix(u.Scheme, "http") {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid Destination %q", body.Destination))
+		return
+	}
+	if body.Protocol != "" && body.Protocol != "Redfish" {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported Protocol %q", body.Protocol))
+		return
+	}
+
+	e.mu.Lock()
+	e.nextSubscriptionID++
+	sub := &subscription{
+		id:          strconv.Itoa(e.nextSubscriptionID),
+		destination: body.Destination,
+		context:     body.Context,
+		headers:     body.HTTPHeaders,
+	}
+	e.subscriptions[sub.id] = sub
+	res := subscriptionResource(sub)
+	e.mu.Unlock()
+
+	w.Header().Set("Location", subscriptionsURI+"/"+sub.id)
+	writeJSON(w, http.StatusCreated, res)
+}
+
+func (e *Emulator) handleSubscription(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	sub, ok := e.subscriptions[r.PathValue("id")]
+	var res map[string]any
+	if ok {
+		res = subscriptionResource(sub)
+	}
+	e.mu.Unlock()
+
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (e *Emulator) handleDeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	_, ok := e.subscriptions[r.PathValue("id")]
+	delete(e.subscriptions, r.PathValue("id"))
+	e.mu.Unlock()
+
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) handleSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+
+	c := make(chan []byte, sseBuffer)
+	e.mu.Lock()
+	e.sseClients[c] = struct{}{}
+	e.mu.Unlock()
+	defer func() {
+		e.mu.Lock()
+		if _, ok := e.sseClients[c]; ok {
+			delete(e.sseClients, c)
+			close(c)
+		}
+		e.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case data, ok := <-c:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// subscriptionResource renders a subscription. Must be called with e.mu held.
+func subscriptionResource(sub *subscription) map[string]any {
+	return map[string]any{
+		"@odata.id":        subscriptionsURI + "/" + sub.id,
+		"@odata.type":      "#EventDestination.v1_13_0.EventDestination",
+		"Id":               sub.id,
+		"Name":             "Event Subscription " + sub.id,
+		"Destination":      sub.destination,
+		"Context":          sub.context,
+		"Protocol":         "Redfish",
+		"SubscriptionType": "RedfishEvent",
+		"Status":           status(),
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault describes an error injected into requests matching Method and Path.
+type Fault struct {
+	// Method is the HTTP method to match. Empty or "*" matches any method.
+	Method string
+	// Path is matched as a prefix of the request path.
+	Path string
+	// StatusCode is returned instead of the normal response. Zero lets the request through after Delay.
+	StatusCode int
+	// Delay is applied before responding.
+	Delay time.Duration
+	// Drop closes the connection without writing a response.
+	Drop bool
+	// Count limits the number of requests the fault applies to. Zero applies it until cleared.
+	Count int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != "*" && !strings.EqualFold(f.Method, r.Method) {
+		return false
+	}
+
+	return strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// ParseFault parses a fault from the "METHOD:PATH:STATUS[:DELAY]" form used on the command line,
+// e.g. "POST:/redfish/v1/UpdateService:503" or "*:/redfish/v1/Chassis:0:2s". A STATUS of "drop"
+// closes the connection without a response.
+func ParseFault(s string) (Fault, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 3 || len(parts) > 4 {
+		return Fault{}, fmt.Errorf("invalid fault %q: expected METHOD:PATH:STATUS[:DELAY]", s)
+	}
+
+	f := Fault{Method: strings.ToUpper(parts[0]), Path: parts[1]}
+	if !strings.HasPrefix(f.Path, "/") {
+		return Fault{}, fmt.Errorf("invalid fault %q: path must start with /", s)
+	}
+
+	if parts[2] == "drop" {
+		f.Drop = true
+	} else {
+		code, err := strconv.Atoi(parts[2])
+		if err != nil || (code != 0 && (code < 100 || code > 599)) {
+			return Fault{}, fmt.Errorf("invalid fault %q: bad status %q", s, parts[2])
+		}
+		f.StatusCode = code
+	}
+
+	if len(parts) == 4 {
+		delay, err := time.ParseDuration(parts[3])
+		if err != nil {
+			return Fault{}, fmt.Errorf("invalid fault %q: %w", s, err)
+		}
+		f.Delay = delay
+	}
+
+	return f, nil
+}
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Profile describes the Redfish resources exposed by an emulated device and the vendor specific values they report.
+type Profile struct {
+	// Name is the short name used to select the profile from the command line.
+	Name         string
+	Manufacturer string
+	Model        string
+	SerialNumber string
+	// ChassisID is the ID of the single chassis exposed under /redfish/v1/Chassis.
+	ChassisID string
+	// ManagerID is the ID of the single manager exposed under /redfish/v1/Managers.
+	ManagerID string
+	// SystemID is the ID of the computer system exposed under /redfish/v1/Systems. Empty if the device has none.
+	SystemID string
+	// FirmwareVersion is the initial Manager.FirmwareVersion.
+	FirmwareVersion string
+	// PowerSupplies is the number of PSUs exposed under the chassis PowerSubsystem. Zero if the device has none.
+	PowerSupplies         int
+	PSUManufacturer       string
+	PSUModel              string
+	PSUFirmwareVersion    string
+	PSUCapacityWatts      int
+	PSUOutputPowerWatts   float64
+	PSUInputVoltage       float64
+	PSUTemperatureCelsius float64
+	PSUFanSpeedRPM        float64
+	// SupportsPowerLimit exposes the chassis Power resource whose PowerControl PowerLimit caps the PSU output.
+	SupportsPowerLimit bool
+}
+
+var (
+	// LiteonPowerShelf emulates a Liteon power shelf PMC.
+	LiteonPowerShelf = Profile{
+		Name:                  "liteon",
+		Manufacturer:          "Liteon",
+		Model:                 "CM14MP1R",
+		SerialNumber:          "LTN0000000001",
+		ChassisID:             "powershelf",
+		ManagerID:             "bmc",
+		FirmwareVersion:       "r1.3.7",
+		PowerSupplies:         6,
+		PSUManufacturer:       "Liteon",
+		PSUModel:              "PS-2551-9L",
+		PSUFirmwareVersion:    "01.02.03",
+		PSUCapacityWatts:      5500,
+		PSUOutputPowerWatts:   2150,
+		PSUInputVoltage:       230,
+		PSUTemperatureCelsius: 34,
+		PSUFanSpeedRPM:        9800,
+		SupportsPowerLimit:    true,
+	}
+
+	// DeltaPowerShelf emulates a Delta power shelf PMC.
+	DeltaPowerShelf = Profile{
+		Name:                  "delta",
+		Manufacturer:          "Delta",
+		Model:                 "ECD16010096",
+		SerialNumber:          "DLT0000000001",
+		ChassisID:             "powershelf",
+		ManagerID:             "bmc",
+		FirmwareVersion:       "r2.0.4",
+		PowerSupplies:         6,
+		PSUManufacturer:       "Delta",
+		PSUModel:              "ECD15020056",
+		PSUFirmwareVersion:    "0A.01",
+		PSUCapacityWatts:      5500,
+		PSUOutputPowerWatts:   2080,
+		PSUInputVoltage:       230,
+		PSUTemperatureCelsius: 36,
+		PSUFanSpeedRPM:        10200,
+	}
+
+	// NVSwitchBMC emulates the BMC of an NV-Switch tray.
+	NVSwitchBMC = Profile{
+		Name:            "nvswitch",
+		Manufacturer:    "NVIDIA",
+		Model:           "P4978",
+		SerialNumber:    "NVS0000000001",
+		ChassisID:       "MGX_NVSwitch_0",
+		ManagerID:       "bmc",
+		SystemID:        "System_0",
+		FirmwareVersion: "88.0002.0950",
+	}
+)
+
+var profiles = map[string]Profile{
+	LiteonPowerShelf.Name: LiteonPowerShelf,
+	DeltaPowerShelf.Name:  DeltaPowerShelf,
+	NVSwitchBMC.Name:      NVSwitchBMC,
+}
+
+// ProfileByName returns the built-in profile with the given name.
+func ProfileByName(name string) (Profile, error) {
+	p, ok := profiles[strings.ToLower(name)]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile %q (supported: %s)", name, strings.Join(ProfileNames(), ", "))
+	}
+
+	return p, nil
+}
+
+// ProfileNames returns the names of the built-in profiles in sorted order.
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
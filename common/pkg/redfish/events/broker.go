@@ -0,0 +1,123 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultSubscriptionBuffer is the channel capacity used when Subscribe is called with a non-positive buffer.
+const DefaultSubscriptionBuffer = 256
+
+// Broker fans published values out to subscribers. A subscriber that falls behind loses values instead of
+// blocking the publisher; the number lost is reported by Subscription.Dropped.
+type Broker[T any] struct {
+	mu     sync.Mutex
+	subs   map[*Subscription[T]]struct{}
+	closed bool
+}
+
+// Subscription receives the values published to a Broker that pass its filter.
+type Subscription[T any] struct {
+	// C delivers the values. It is closed by Close or when the broker is closed.
+	C <-chan T
+
+	c       chan T
+	filter  func(T) bool
+	broker  *Broker[T]
+	dropped atomic.Uint64
+	once    sync.Once
+}
+
+// NewBroker creates an empty Broker.
+func NewBroker[T any]() *Broker[T] {
+	return &Broker[T]{subs: map[*Subscription[T]]struct{}{}}
+}
+
+// Subscribe registers a subscriber receiving the published values for which filter returns true (all values if
+// filter is nil).
+func (b *Broker[T]) Subscribe(buffer int, filter func(T) bool) *Subscription[T] {
+	if buffer <= 0 {
+		buffer = DefaultSubscriptionBuffer
+	}
+
+	c := make(chan T, buffer)
+	s := &Subscription[T]{C: c, c: c, filter: filter, broker: b}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		s.once.Do(func() { close(c) })
+		return s
+	}
+	b.subs[s] = struct{}{}
+	return s
+}
+
+// Publish delivers v to every matching subscriber without blocking.
+func (b *Broker[T]) Publish(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for s := range b.subs {
+		if s.filter != nil && !s.filter(v) {
+			continue
+		}
+		select {
+		case s.c <- v:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// Subscribers returns the number of active subscribers.
+func (b *Broker[T]) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs)
+}
+
+// Close closes every subscription; later subscriptions are closed immediately.
+func (b *Broker[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for s := range b.subs {
+		delete(b.subs, s)
+		s.once.Do(func() { close(s.c) })
+	}
+}
+
+// Close unregisters the subscription and closes C.
+func (s *Subscription[T]) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	delete(s.broker.subs, s)
+	s.once.Do(func() { close(s.c) })
+}
+
+// Dropped returns the number of values not delivered because C was full.
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
@@ -0,0 +1,62 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	b := NewBroker[int]()
+	all := b.Subscribe(0, nil)
+	even := b.Subscribe(1, func(v int) bool { return v%2 == 0 })
+	assert.Equal(t, 2, b.Subscribers())
+
+	for i := 1; i <= 4; i++ {
+		b.Publish(i)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, drain(all.C, 4))
+	assert.Equal(t, []int{2}, drain(even.C, 1))
+	assert.Equal(t, uint64(1), even.Dropped(), "a full subscriber loses values instead of blocking")
+
+	even.Close()
+	even.Close()
+	assert.Equal(t, 1, b.Subscribers())
+	_, open := <-even.C
+	assert.False(t, open)
+
+	b.Close()
+	_, open = <-all.C
+	assert.False(t, open)
+
+	late := b.Subscribe(0, nil)
+	_, open = <-late.C
+	assert.False(t, open, "subscriptions to a closed broker are closed immediately")
+	b.Publish(5)
+}
+
+func drain(c <-chan int, n int) []int {
+	out := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, <-c)
+	}
+	return out
+}
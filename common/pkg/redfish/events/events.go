@@ -0,0 +1,149 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package events consumes Redfish EventService notifications: it manages push subscriptions, reads Server-Sent
+// Event streams, decodes and classifies event records, and fans them out to in-process subscribers.
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// maxEventBytes bounds the size of a single decoded event payload.
+const maxEventBytes = 1 << 20
+
+// Kind classifies an event record by what it reports.
+type Kind string
+
+const (
+	// KindPSUFault reports a power supply fault being raised or cleared.
+	KindPSUFault Kind = "PSUFault"
+	// KindPowerState reports a chassis or system power state change.
+	KindPowerState Kind = "PowerState"
+	// KindTask reports the progress of a Redfish task, such as a firmware update.
+	KindTask Kind = "Task"
+	// KindOther is any other event.
+	KindOther Kind = "Other"
+)
+
+// Task states reported by TaskUpdate, matching the Redfish TaskState values.
+const (
+	TaskStateRunning   = "Running"
+	TaskStateCompleted = "Completed"
+	TaskStateException = "Exception"
+	TaskStateCancelled = "Cancelled"
+	TaskStateSuspended = "Suspended"
+)
+
+var (
+	powerStateMessages = map[string]bool{
+		"ResourcePoweredOn":         true,
+		"ResourcePoweredOff":        true,
+		"ResourcePoweringOn":        true,
+		"ResourcePoweringOff":       true,
+		"ResourcePowerStateChanged": true,
+		"PowerStateChanged":         true,
+		"PowerOn":                   true,
+		"PowerOff":                  true,
+	}
+	psuFaultMessages = map[string]bool{
+		"PowerSupplyFailed":          true,
+		"PowerSupplyFault":           true,
+		"PowerSupplyInputLost":       true,
+		"PowerSupplyPredictiveFault": true,
+		"PowerSupplyRecovered":       true,
+		"PowerSupplyInserted":        true,
+		"PowerSupplyRemoved":         true,
+	}
+)
+
+// Decode reads a Redfish Event payload.
+func Decode(r io.Reader) (*redfish.Event, error) {
+	var ev redfish.Event
+	if err := json.NewDecoder(io.LimitReader(r, maxEventBytes)).Decode(&ev); err != nil {
+		return nil, fmt.Errorf("malformed Redfish event: %w", err)
+	}
+	return &ev, nil
+}
+
+// Classify returns the kind of an event record based on its MessageId and OriginOfCondition.
+func Classify(rec *redfish.EventRecord) Kind {
+	registry, message := splitMessageID(rec.MessageID)
+
+	switch {
+	case registry == "TaskEvent":
+		return KindTask
+	case powerStateMessages[message]:
+		return KindPowerState
+	case psuFaultMessages[message]:
+		return KindPSUFault
+	case isPowerSupply(rec.OriginOfCondition) && strings.HasPrefix(message, "ResourceStatusChanged"):
+		return KindPSUFault
+	}
+
+	return KindOther
+}
+
+// TaskUpdate extracts the task URI, state and completion percentage from a TaskEvent record. ok is false for
+// records of other registries or unknown task messages.
+func TaskUpdate(rec *redfish.EventRecord) (uri, state string, percent int, ok bool) {
+	registry, message := splitMessageID(rec.MessageID)
+	if registry != "TaskEvent" {
+		return "", "", 0, false
+	}
+
+	switch message {
+	case "TaskStarted", "TaskResumed":
+		state = TaskStateRunning
+	case "TaskProgressChanged":
+		state = TaskStateRunning
+		if len(rec.MessageArgs) > 1 {
+			percent, _ = strconv.Atoi(rec.MessageArgs[1])
+		}
+	case "TaskCompletedOK", "TaskCompletedWarning":
+		state, percent = TaskStateCompleted, 100
+	case "TaskAborted":
+		state = TaskStateException
+	case "TaskCancelled":
+		state = TaskStateCancelled
+	case "TaskPaused":
+		state = TaskStateSuspended
+	default:
+		return "", "", 0, false
+	}
+
+	return rec.OriginOfCondition, state, percent, true
+}
+
+// splitMessageID splits a "Registry.Major.Minor.Message" MessageId into its registry prefix and message key.
+func splitMessageID(id string) (registry, message string) {
+	parts := strings.Split(id, ".")
+	if len(parts) == 1 {
+		return "", parts[0]
+	}
+	return parts[0], parts[len(parts)-1]
+}
+
+func isPowerSupply(origin string) bool {
+	return strings.Contains(origin, "/PowerSupplies/")
+}
@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	ev, err := Decode(strings.NewReader(`{
+		"Context": "aa:bb:cc:dd:ee:01",
+		"Events": [{
+			"MessageId": "ResourceEvent.1.3.ResourcePoweredOff",
+			"MessageSeverity": "OK",
+			"OriginOfCondition": {"@odata.id": "/redfish/v1/Chassis/powershelf"}
+		}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "aa:bb:cc:dd:ee:01", ev.Context)
+	require.Len(t, ev.Events, 1)
+	assert.Equal(t, "ResourceEvent.1.3.ResourcePoweredOff", ev.Events[0].MessageID)
+	assert.Equal(t, "/redfish/v1/Chassis/powershelf", ev.Events[0].OriginOfCondition)
+
+	_, err = Decode(strings.NewReader(`{"Events": `))
+	assert.ErrorContains(t, err, "malformed Redfish event")
+}
+
+func TestClassify(t *testing.T) {
+	testCases := map[string]struct {
+		rec      redfish.EventRecord
+		expected Kind
+	}{
+		"power on": {
+			rec:      redfish.EventRecord{MessageID: "ResourceEvent.1.3.ResourcePoweredOn"},
+			expected: KindPowerState,
+		},
+		"vendor power state": {
+			rec:      redfish.EventRecord{MessageID: "OpenBMC.0.1.PowerStateChanged"},
+			expected: KindPowerState,
+		},
+		"psu status change": {
+			rec: redfish.EventRecord{
+				MessageID:         "ResourceEvent.1.3.ResourceStatusChangedCritical",
+				OriginOfCondition: "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU1",
+			},
+			expected: KindPSUFault,
+		},
+		"psu registry message": {
+			rec:      redfish.EventRecord{MessageID: "Platform.1.0.PowerSupplyFailed"},
+			expected: KindPSUFault,
+		},
+		"task progress": {
+			rec:      redfish.EventRecord{MessageID: "TaskEvent.1.0.TaskProgressChanged"},
+			expected: KindTask,
+		},
+		"chassis status change": {
+			rec: redfish.EventRecord{
+				MessageID:         "ResourceEvent.1.3.ResourceStatusChangedCritical",
+				OriginOfCondition: "/redfish/v1/Chassis/powershelf",
+			},
+			expected: KindOther,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Classify(&tc.rec))
+		})
+	}
+}
+
+func TestTaskUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		rec     redfish.EventRecord
+		state   string
+		percent int
+		ok      bool
+	}{
+		"started": {
+			rec:   redfish.EventRecord{MessageID: "TaskEvent.1.0.TaskStarted", OriginOfCondition: "/redfish/v1/TaskService/Tasks/1"},
+			state: TaskStateRunning,
+			ok:    true,
+		},
+		"progress": {
+			rec: redfish.EventRecord{
+				MessageID:         "TaskEvent.1.0.TaskProgressChanged",
+				MessageArgs:       []string{"1", "40"},
+				OriginOfCondition: "/redfish/v1/TaskService/Tasks/1",
+			},
+			state:   TaskStateRunning,
+			percent: 40,
+			ok:      true,
+		},
+		"completed": {
+			rec:     redfish.EventRecord{MessageID: "TaskEvent.1.0.TaskCompletedWarning", OriginOfCondition: "/redfish/v1/TaskService/Tasks/1"},
+			state:   TaskStateCompleted,
+			percent: 100,
+			ok:      true,
+		},
+		"aborted": {
+			rec:   redfish.EventRecord{MessageID: "TaskEvent.1.0.TaskAborted", OriginOfCondition: "/redfish/v1/TaskService/Tasks/1"},
+			state: TaskStateException,
+			ok:    true,
+		},
+		"other registry": {
+			rec: redfish.EventRecord{MessageID: "ResourceEvent.1.3.ResourcePoweredOn"},
+		},
+		"unknown task message": {
+			rec: redfish.EventRecord{MessageID: "TaskEvent.1.0.TaskRemoved"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			uri, state, percent, ok := TaskUpdate(&tc.rec)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.state, state)
+			assert.Equal(t, tc.percent, percent)
+			if ok {
+				assert.Equal(t, "/redfish/v1/TaskService/Tasks/1", uri)
+			}
+		})
+	}
+}
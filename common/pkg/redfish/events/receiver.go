@@ -0,0 +1,64 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// TokenHeader is the HTTP header carrying the shared token that devices echo back on every event POST. It is
+// registered as a subscription HttpHeader so that the receiver can reject events from unknown senders.
+const TokenHeader = "X-Event-Token"
+
+// Receiver is an http.Handler accepting Redfish events pushed by subscribed devices. Each event is handed to
+// Handler with its Context, which subscribers set to the device identifier.
+type Receiver struct {
+	// Token, when set, must match the TokenHeader of every request.
+	Token string
+	// Handler is called for every accepted event.
+	Handler func(ev *redfish.Event)
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.Token != "" && subtle.ConstantTimeCompare([]byte(req.Header.Get(TokenHeader)), []byte(r.Token)) != 1 {
+		http.Error(w, "invalid event token", http.StatusUnauthorized)
+		return
+	}
+
+	ev, err := Decode(http.MaxBytesReader(w, req.Body, maxEventBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Handler != nil {
+		r.Handler(ev)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
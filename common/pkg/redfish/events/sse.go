@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// ReadSSE reads a text/event-stream body and calls fn with every Redfish Event it carries. It returns when the
+// stream ends or fn returns an error. Frames that are not valid Redfish events (keep-alives, comments) are
+// skipped.
+func ReadSSE(r io.Reader, fn func(*redfish.Event) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxEventBytes)
+
+	var data strings.Builder
+	flush := func() error {
+		if data.Len() == 0 {
+			return nil
+		}
+		defer data.Reset()
+
+		ev, err := Decode(strings.NewReader(data.String()))
+		if err != nil {
+			return nil
+		}
+		return fn(ev)
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if err := flush(); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+// Stream opens the EventService Server-Sent Event stream of the service behind api and calls fn with every event
+// until ctx is cancelled or the stream ends. It returns ErrSSENotSupported when the service has no SSE endpoint,
+// so callers can fall back to push subscriptions or polling.
+func Stream(ctx context.Context, api *gofish.APIClient, fn func(*redfish.Event) error) error {
+	es, err := eventService(api)
+	if err != nil {
+		return err
+	}
+	if es.ServerSentEventURI == "" {
+		return ErrSSENotSupported
+	}
+
+	resp, err := api.GetWithHeaders(es.ServerSentEventURI, map[string]string{"Accept": "text/event-stream"})
+	if err != nil {
+		return fmt.Errorf("failed to open event stream: %w", err)
+	}
+
+	// The request is bound to the client's context, not ctx; closing the body unblocks the reader.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		resp.Body.Close()
+	}()
+
+	err = ReadSSE(resp.Body, fn)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if err == nil {
+		err = errors.New("event stream closed by the service")
+	}
+
+	return err
+}
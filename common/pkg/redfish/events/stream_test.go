@@ -0,0 +1,189 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+)
+
+// connectEmulator starts an emulated device and returns a gofish client connected to it.
+func connectEmulator(t *testing.T, profile emulator.Profile) (*gofish.APIClient, *emulator.Emulator) {
+	emu := emulator.New(emulator.Config{Profile: profile})
+	server := httptest.NewTLSServer(emu)
+	t.Cleanup(server.Close)
+	t.Cleanup(emu.Close)
+
+	api, err := gofish.ConnectContext(context.Background(), gofish.ClientConfig{
+		Endpoint:  server.URL,
+		Username:  emulator.DefaultUsername,
+		Password:  emulator.DefaultPassword,
+		Insecure:  true,
+		BasicAuth: true,
+	})
+	require.NoError(t, err)
+	return api, emu
+}
+
+func TestReceiver(t *testing.T) {
+	received := make(chan *redfish.Event, 1)
+	rcv := &Receiver{Token: "secret", Handler: func(ev *redfish.Event) { received <- ev }}
+
+	testCases := map[string]struct {
+		method   string
+		token    string
+		body     string
+		expected int
+	}{
+		"accepted":      {method: http.MethodPost, token: "secret", body: `{"Context":"pmc-1","Events":[]}`, expected: http.StatusNoContent},
+		"wrong method":  {method: http.MethodGet, token: "secret", expected: http.StatusMethodNotAllowed},
+		"missing token": {method: http.MethodPost, body: `{}`, expected: http.StatusUnauthorized},
+		"malformed":     {method: http.MethodPost, token: "secret", body: `{`, expected: http.StatusBadRequest},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/events", strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set(TokenHeader, tc.token)
+			}
+			w := httptest.NewRecorder()
+			rcv.ServeHTTP(w, req)
+			assert.Equal(t, tc.expected, w.Code)
+
+			if tc.expected == http.StatusNoContent {
+				assert.Equal(t, "pmc-1", (<-received).Context)
+			}
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	api, emu := connectEmulator(t, emulator.LiteonPowerShelf)
+
+	received := make(chan *redfish.Event, 4)
+	receiver := httptest.NewServer(&Receiver{Token: "secret", Handler: func(ev *redfish.Event) { received <- ev }})
+	t.Cleanup(receiver.Close)
+
+	headers := map[string]string{TokenHeader: "secret"}
+	uri, err := Subscribe(api, receiver.URL, "pmc-1", headers)
+	require.NoError(t, err)
+	assert.NotEmpty(t, uri)
+
+	again, err := Subscribe(api, receiver.URL, "pmc-1", headers)
+	require.NoError(t, err)
+	assert.Equal(t, uri, again, "an existing subscription is reused")
+	assert.Equal(t, 1, emu.Subscriptions())
+
+	emu.SetPSUFault(0, true)
+	select {
+	case ev := <-received:
+		assert.Equal(t, "pmc-1", ev.Context)
+		require.Len(t, ev.Events, 1)
+		assert.Equal(t, KindPSUFault, Classify(&ev.Events[0]))
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event delivered")
+	}
+
+	require.NoError(t, Unsubscribe(api, uri))
+	assert.Equal(t, 0, emu.Subscriptions())
+}
+
+func TestStream(t *testing.T) {
+	api, _ := connectEmulator(t, emulator.NVSwitchBMC)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan Kind, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- Stream(ctx, api, func(ev *redfish.Event) error {
+			for i := range ev.Events {
+				received <- Classify(&ev.Events[i])
+			}
+			return nil
+		})
+	}()
+
+	// The stream is registered asynchronously; keep toggling power until an event arrives.
+	deadline := time.After(5 * time.Second)
+	state := "Off"
+	for kind := Kind(""); kind == ""; {
+		require.NoError(t, postReset(api, state))
+		select {
+		case kind = <-received:
+			assert.Equal(t, KindPowerState, kind)
+		case <-time.After(50 * time.Millisecond):
+			if state == "Off" {
+				state = "On"
+			} else {
+				state = "Off"
+			}
+		case <-deadline:
+			require.FailNow(t, "no event streamed")
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "stream did not stop")
+	}
+}
+
+func TestReadSSE(t *testing.T) {
+	stream := ": keep-alive\n\n" +
+		"id: 1\ndata: {\"Context\":\"a\",\n\n" +
+		"data: {\"Context\":\"b\",\n" +
+		"data: \"Events\":[]}\n\n" +
+		"data: {\"Context\":\"c\"}\n"
+
+	var contexts []string
+	err := ReadSSE(strings.NewReader(stream), func(ev *redfish.Event) error {
+		contexts = append(contexts, ev.Context)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c"}, contexts, "malformed frames are skipped")
+}
+
+func postReset(api *gofish.APIClient, state string) error {
+	resetType := "ForceOff"
+	if state == "On" {
+		resetType = "On"
+	}
+	resp, err := api.Post("/redfish/v1/Systems/System_0/Actions/ComputerSystem.Reset", map[string]string{"ResetType": resetType})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
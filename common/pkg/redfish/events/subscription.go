@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	// ErrNotSupported is returned when the service does not expose an enabled EventService.
+	ErrNotSupported = errors.New("redfish EventService is not supported")
+	// ErrSSENotSupported is returned by Stream when the EventService has no ServerSentEventUri.
+	ErrSSENotSupported = errors.New("redfish EventService does not support Server-Sent Events")
+)
+
+// eventService returns the enabled EventService of the service behind api.
+func eventService(api *gofish.APIClient) (*redfish.EventService, error) {
+	if api == nil || api.Service == nil {
+		return nil, errors.New("redfish client is not connected")
+	}
+
+	es, err := api.Service.EventService()
+	if err != nil || es == nil || !es.ServiceEnabled {
+		return nil, ErrNotSupported
+	}
+
+	return es, nil
+}
+
+// Subscribe registers destination as a push target for the service's events and returns the subscription URI.
+// context is echoed back in every event posted to destination and identifies the device; headers are sent with
+// each post. An existing subscription for the same destination and context is reused, so repeated calls after a
+// manager restart do not accumulate subscriptions on the device.
+func Subscribe(api *gofish.APIClient, destination, context string, headers map[string]string) (string, error) {
+	es, err := eventService(api)
+	if err != nil {
+		return "", err
+	}
+
+	existing, err := es.GetEventSubscriptions()
+	if err != nil {
+		return "", fmt.Errorf("failed to list event subscriptions: %w", err)
+	}
+	for _, sub := range existing {
+		if sub.Destination == destination && sub.Context == context {
+			return sub.ODataID, nil
+		}
+	}
+
+	uri, err := es.CreateEventSubscriptionInstance(
+		destination,
+		nil,
+		nil,
+		headers,
+		redfish.RedfishEventDestinationProtocol,
+		context,
+		"",
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create event subscription: %w", err)
+	}
+
+	return uri, nil
+}
+
+// Unsubscribe deletes the subscription at uri.
+func Unsubscribe(api *gofish.APIClient, uri string) error {
+	es, err := eventService(api)
+	if err != nil {
+		return err
+	}
+
+	if err := es.DeleteEventSubscription(uri); err != nil {
+		return fmt.Errorf("failed to delete event subscription %s: %w", uri, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/netip"
+	"sort"
+	"time"
+
+	cipam "github.com/NVIDIA/ncx-infra-controller-rest/ipam"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbp "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+)
+
+const (
+	// MaxUsageForecastHorizon is the furthest in the future an exhaustion date is projected
+	MaxUsageForecastHorizon = 10 * 365 * 24 * time.Hour
+)
+
+// ErrSubnetWithoutIPv4Prefix is the error returned when usage is requested for a Subnet that has no IPv4 prefix
+var ErrSubnetWithoutIPv4Prefix = errors.New("subnet does not have an IPv4 prefix")
+
+// AddressUsage is the number of addresses in a prefix and how many of them are in use
+type AddressUsage struct {
+	Prefix   string
+	TotalIPs int64
+	UsedIPs  int64
+}
+
+// UsageForecast describes the growth trend of an address range and when it is expected to run out of addresses
+type UsageForecast struct {
+	// GrowthPerDay is the average number of addresses acquired per day, negative if usage is shrinking
+	GrowthPerDay float64
+	// ProjectedExhaustion is when all addresses are expected to be in use, nil if usage is not growing
+	// or exhaustion is further away than MaxUsageForecastHorizon
+	ProjectedExhaustion *time.Time
+}
+
+// GetAddressCountForCidr returns the number of addresses in the cidr
+// Counts that do not fit in an int64 (large IPv6 prefixes) are capped at math.MaxInt64
+func GetAddressCountForCidr(cidr string) (int64, error) {
+	pfx, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return 0, err
+	}
+
+	hostBits := pfx.Addr().BitLen() - pfx.Bits()
+	if hostBits >= 63 {
+		return math.MaxInt64, nil
+	}
+
+	return 1 << hostBits, nil
+}
+
+// GetAddressUsageForIPBlock returns the number of addresses of the IPBlock acquired by child prefixes,
+// e.g. Allocations, Subnets, VPC Prefixes or Floating IPs
+func GetAddressUsageForIPBlock(ctx context.Context, ipamDB cipam.Storage, ipBlock *cdbm.IPBlock) (*AddressUsage, error) {
+	if ipBlock == nil {
+		return nil, ErrNilIPBlock
+	}
+
+	cidr := GetCidrForIPBlock(ctx, ipBlock.Prefix, ipBlock.PrefixLength)
+	total, err := GetAddressCountForCidr(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Full grant IPBlocks are acquired as a whole
+	if ipBlock.FullGrant {
+		return &AddressUsage{Prefix: cidr, TotalIPs: total, UsedIPs: total}, nil
+	}
+
+	namespace := GetIpamNamespaceForIPBlock(ctx, ipBlock.RoutingType, ipBlock.InfrastructureProviderID.String(), ipBlock.SiteID.String())
+	prefixes, err := ipamDB.ReadAllPrefixes(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	used := int64(0)
+	for _, prefix := range prefixes {
+		if prefix.Cidr == cidr {
+			found = true
+			continue
+		}
+		if prefix.ParentCidr != cidr {
+			continue
+		}
+		count, serr := GetAddressCountForCidr(prefix.Cidr)
+		if serr != nil {
+			return nil, serr
+		}
+		if used > math.MaxInt64-count {
+			used = math.MaxInt64
+			continue
+		}
+		used += count
+	}
+
+	if !found {
+		return nil, ErrPrefixDoesNotExistForIPBlock
+	}
+
+	if used > total {
+		used = total
+	}
+
+	return &AddressUsage{Prefix: cidr, TotalIPs: total, UsedIPs: used}, nil
+}
+
+// GetAddressUsageForCidr returns the number of distinct addresses from the given list that are within the cidr
+// Used for Subnets and VPC Prefixes, whose addresses are assigned to Instance Interfaces by the Site
+func GetAddressUsageForCidr(cidr string, addresses []string) (*AddressUsage, error) {
+	pfx, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := GetAddressCountForCidr(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[netip.Addr]bool{}
+	for _, address := range addresses {
+		addr, serr := netip.ParseAddr(address)
+		if serr != nil {
+			// Interface addresses may be reported in CIDR notation
+			apfx, perr := netip.ParsePrefix(address)
+			if perr != nil {
+				continue
+			}
+			addr = apfx.Addr()
+		}
+		if pfx.Contains(addr) {
+			used[addr] = true
+		}
+	}
+
+	return &AddressUsage{Prefix: pfx.Masked().String(), TotalIPs: total, UsedIPs: int64(len(used))}, nil
+}
+
+// GetAddressUsageForSubnet returns the number of addresses of the Subnet assigned to Instance Interfaces, including the gateway
+func GetAddressUsageForSubnet(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, subnet *cdbm.Subnet) (*AddressUsage, error) {
+	if subnet.IPv4Prefix == nil {
+		return nil, ErrSubnetWithoutIPv4Prefix
+	}
+
+	ifcDAO := cdbm.NewInterfaceDAO(dbSession)
+	ifcs, _, err := ifcDAO.GetAll(ctx, tx, cdbm.InterfaceFilterInput{SubnetID: &subnet.ID}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	if subnet.IPv4Gateway != nil {
+		addresses = append(addresses, *subnet.IPv4Gateway)
+	}
+	for _, ifc := range ifcs {
+		addresses = append(addresses, ifc.IPAddresses...)
+	}
+
+	return GetAddressUsageForCidr(GetCidrForIPBlock(ctx, *subnet.IPv4Prefix, subnet.PrefixLength), addresses)
+}
+
+// GetAddressUsageForVpcPrefix returns the number of addresses of the VPC Prefix assigned to Instance Interfaces
+func GetAddressUsageForVpcPrefix(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, vpcPrefix *cdbm.VpcPrefix) (*AddressUsage, error) {
+	ifcDAO := cdbm.NewInterfaceDAO(dbSession)
+	ifcs, _, err := ifcDAO.GetAll(ctx, tx, cdbm.InterfaceFilterInput{VpcPrefixID: &vpcPrefix.ID}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	for _, ifc := range ifcs {
+		addresses = append(addresses, ifc.IPAddresses...)
+	}
+
+	return GetAddressUsageForCidr(vpcPrefix.Prefix, addresses)
+}
+
+// ForecastUsage fits a linear trend through the usage samples of an address range and projects when it will be exhausted
+// Samples may be in any order, the most recent sample is taken as the current usage
+func ForecastUsage(samples []cdbm.IPUsageSample) *UsageForecast {
+	forecast := &UsageForecast{}
+	if len(samples) == 0 {
+		return forecast
+	}
+
+	sorted := make([]cdbm.IPUsageSample, len(samples))
+	copy(sorted, samples)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created.Before(sorted[j].Created)
+	})
+
+	latest := sorted[len(sorted)-1]
+	if latest.TotalIPs > 0 && latest.UsedIPs >= latest.TotalIPs {
+		exhausted := latest.Created
+		forecast.ProjectedExhaustion = &exhausted
+	}
+
+	if len(sorted) < 2 || !latest.Created.After(sorted[0].Created) {
+		return forecast
+	}
+
+	// Least squares slope of used addresses over time, in days since the first sample
+	start := sorted[0].Created
+	var sumX, sumY, sumXY, sumXX float64
+	n := float64(len(sorted))
+	for _, sample := range sorted {
+		x := sample.Created.Sub(start).Hours() / 24
+		y := float64(sample.UsedIPs)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return forecast
+	}
+
+	forecast.GrowthPerDay = (n*sumXY - sumX*sumY) / denominator
+
+	if forecast.ProjectedExhaustion != nil || forecast.GrowthPerDay <= 0 {
+		return forecast
+	}
+
+	remaining := float64(latest.TotalIPs-latest.UsedIPs) / forecast.GrowthPerDay * float64(24*time.Hour)
+	if remaining > float64(MaxUsageForecastHorizon) {
+		return forecast
+	}
+
+	exhaustion := latest.Created.Add(time.Duration(remaining))
+	forecast.ProjectedExhaustion = &exhaustion
+
+	return forecast
+}
@@ -0,0 +1,295 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipam
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbutil "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAddressCountForCidr(t *testing.T) {
+	tests := []struct {
+		name        string
+		cidr        string
+		expected    int64
+		expectedErr bool
+	}{
+		{
+			name:     "IPv4 /24",
+			cidr:     "192.168.0.0/24",
+			expected: 256,
+		},
+		{
+			name:     "IPv4 /32",
+			cidr:     "192.168.0.1/32",
+			expected: 1,
+		},
+		{
+			name:     "IPv6 /120",
+			cidr:     "2001:db8::/120",
+			expected: 256,
+		},
+		{
+			name:     "IPv6 /64 is capped",
+			cidr:     "2001:db8::/64",
+			expected: math.MaxInt64,
+		},
+		{
+			name:        "invalid cidr",
+			cidr:        "192.168.0.0",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			count, err := GetAddressCountForCidr(tc.cidr)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expected, count)
+		})
+	}
+}
+
+func TestGetAddressUsageForCidr(t *testing.T) {
+	tests := []struct {
+		name         string
+		cidr         string
+		addresses    []string
+		expectedUsed int64
+		expectedErr  bool
+	}{
+		{
+			name:         "addresses within cidr are counted once",
+			cidr:         "10.0.0.0/28",
+			addresses:    []string{"10.0.0.1", "10.0.0.2", "10.0.0.2", "10.0.0.3/32"},
+			expectedUsed: 3,
+		},
+		{
+			name:         "addresses outside cidr or invalid are ignored",
+			cidr:         "10.0.0.0/28",
+			addresses:    []string{"10.0.1.1", "not-an-ip", "10.0.0.15"},
+			expectedUsed: 1,
+		},
+		{
+			name:         "no addresses",
+			cidr:         "10.0.0.0/28",
+			expectedUsed: 0,
+		},
+		{
+			name:        "invalid cidr",
+			cidr:        "10.0.0.0/33",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			usage, err := GetAddressUsageForCidr(tc.cidr, tc.addresses)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, int64(16), usage.TotalIPs)
+			assert.Equal(t, tc.expectedUsed, usage.UsedIPs)
+		})
+	}
+}
+
+func TestGetAddressUsageForIPBlock(t *testing.T) {
+	dbSession := cdbutil.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	ipamDB := getTestIpamDB(t, dbSession, true)
+	ctx := context.Background()
+
+	testIpamSetupSchema(t, dbSession)
+
+	ip := testIpamBuildInfrastructureProvider(t, dbSession, "testip")
+	site := testIpamBuildSite(t, dbSession, ip, "testsite")
+
+	ipBlock1 := testIpamBuildIPBlock(t, dbSession, &cdbm.IPBlock{
+		ID:                       uuid.New(),
+		RoutingType:              cdbm.IPBlockRoutingTypeDatacenterOnly,
+		InfrastructureProviderID: ip.ID,
+		SiteID:                   site.ID,
+		Prefix:                   "10.10.0.0",
+		PrefixLength:             24,
+		ProtocolVersion:          cdbm.IPBlockProtocolVersionV4,
+	})
+	_, err := CreateIpamEntryForIPBlock(ctx, ipamDB, ipBlock1.Prefix, ipBlock1.PrefixLength, ipBlock1.RoutingType, ip.ID.String(), site.ID.String())
+	require.NoError(t, err)
+
+	// Acquire a /26 and a /32 from the block
+	_, err = CreateChildIpamEntryForIPBlock(ctx, nil, dbSession, ipamDB, ipBlock1, 26)
+	require.NoError(t, err)
+	_, err = CreateChildIpamEntryForIPBlock(ctx, nil, dbSession, ipamDB, ipBlock1, 32)
+	require.NoError(t, err)
+
+	ipBlock2 := testIpamBuildIPBlock(t, dbSession, &cdbm.IPBlock{
+		ID:                       uuid.New(),
+		RoutingType:              cdbm.IPBlockRoutingTypeDatacenterOnly,
+		InfrastructureProviderID: ip.ID,
+		SiteID:                   site.ID,
+		Prefix:                   "10.20.0.0",
+		PrefixLength:             28,
+		ProtocolVersion:          cdbm.IPBlockProtocolVersionV4,
+		FullGrant:                true,
+	})
+
+	ipBlock3 := testIpamBuildIPBlock(t, dbSession, &cdbm.IPBlock{
+		ID:                       uuid.New(),
+		RoutingType:              cdbm.IPBlockRoutingTypeDatacenterOnly,
+		InfrastructureProviderID: ip.ID,
+		SiteID:                   site.ID,
+		Prefix:                   "10.30.0.0",
+		PrefixLength:             24,
+		ProtocolVersion:          cdbm.IPBlockProtocolVersionV4,
+	})
+
+	tests := []struct {
+		name          string
+		ipBlock       *cdbm.IPBlock
+		expectedTotal int64
+		expectedUsed  int64
+		expectedErr   error
+	}{
+		{
+			name:          "child prefixes are counted as used",
+			ipBlock:       ipBlock1,
+			expectedTotal: 256,
+			expectedUsed:  65,
+		},
+		{
+			name:          "full grant IP Block is fully used",
+			ipBlock:       ipBlock2,
+			expectedTotal: 16,
+			expectedUsed:  16,
+		},
+		{
+			name:        "IP Block without ipam entry",
+			ipBlock:     ipBlock3,
+			expectedErr: ErrPrefixDoesNotExistForIPBlock,
+		},
+		{
+			name:        "nil IP Block",
+			expectedErr: ErrNilIPBlock,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			usage, err := GetAddressUsageForIPBlock(ctx, ipamDB, tc.ipBlock)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTotal, usage.TotalIPs)
+			assert.Equal(t, tc.expectedUsed, usage.UsedIPs)
+		})
+	}
+}
+
+func TestForecastUsage(t *testing.T) {
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	buildSamples := func(total int64, used ...int64) []cdbm.IPUsageSample {
+		samples := []cdbm.IPUsageSample{}
+		for i, u := range used {
+			samples = append(samples, cdbm.IPUsageSample{
+				TotalIPs: total,
+				UsedIPs:  u,
+				Created:  now.Add(time.Duration(i-len(used)+1) * day),
+			})
+		}
+		return samples
+	}
+
+	tests := []struct {
+		name               string
+		samples            []cdbm.IPUsageSample
+		expectedGrowth     float64
+		expectedExhaustion *time.Time
+	}{
+		{
+			name:    "no samples",
+			samples: nil,
+		},
+		{
+			name:    "single sample",
+			samples: buildSamples(256, 100),
+		},
+		{
+			name:               "linear growth projects exhaustion",
+			samples:            buildSamples(256, 100, 110, 120, 130, 140, 150, 160),
+			expectedGrowth:     10,
+			expectedExhaustion: cdb.GetTimePtr(now.Add(time.Duration(9.6 * float64(day)))),
+		},
+		{
+			name:           "shrinking usage has no exhaustion",
+			samples:        buildSamples(256, 160, 150, 140),
+			expectedGrowth: -10,
+		},
+		{
+			name:           "flat usage has no exhaustion",
+			samples:        buildSamples(256, 50, 50, 50),
+			expectedGrowth: 0,
+		},
+		{
+			name:               "exhausted range is exhausted at latest sample",
+			samples:            buildSamples(16, 14, 15, 16),
+			expectedGrowth:     1,
+			expectedExhaustion: cdb.GetTimePtr(now),
+		},
+		{
+			name:           "exhaustion beyond horizon is not projected",
+			samples:        buildSamples(math.MaxInt32, 1, 2, 3),
+			expectedGrowth: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			forecast := ForecastUsage(tc.samples)
+			require.NotNil(t, forecast)
+			assert.InDelta(t, tc.expectedGrowth, forecast.GrowthPerDay, 0.0001)
+			if tc.expectedExhaustion == nil {
+				assert.Nil(t, forecast.ProjectedExhaustion)
+				return
+			}
+			require.NotNil(t, forecast.ProjectedExhaustion)
+			assert.WithinDuration(t, *tc.expectedExhaustion, *forecast.ProjectedExhaustion, time.Minute)
+		})
+	}
+
+	// Samples are sorted before fitting the trend
+	samples := buildSamples(256, 100, 110, 120)
+	samples[0], samples[2] = samples[2], samples[0]
+	forecast := ForecastUsage(samples)
+	assert.InDelta(t, 10, forecast.GrowthPerDay, 0.0001)
+}
@@ -0,0 +1,447 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	stracer "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/tracer"
+)
+
+const (
+	// AddressGroupStatusReady indicates that all Network Security Groups referencing the group have the latest prefixes
+	AddressGroupStatusReady = "Ready"
+	// AddressGroupStatusPropagating indicates that the latest prefixes are being propagated to referencing Network Security Groups
+	AddressGroupStatusPropagating = "Propagating"
+	// AddressGroupStatusError indicates that the latest prefixes could not be propagated to one or more Network Security Groups
+	AddressGroupStatusError = "Error"
+
+	// AddressGroupPropagationStatusPending indicates that a Network Security Group is yet to be updated on Site with the latest prefixes
+	AddressGroupPropagationStatusPending = "Pending"
+	// AddressGroupPropagationStatusSynced indicates that a Network Security Group was updated on Site with the latest prefixes
+	AddressGroupPropagationStatusSynced = "Synced"
+	// AddressGroupPropagationStatusError indicates that a Network Security Group could not be updated on Site
+	AddressGroupPropagationStatusError = "Error"
+
+	// AddressGroupRelationName is the relation name for the AddressGroup model
+	AddressGroupRelationName = "AddressGroup"
+
+	// AddressGroupOrderByDefault default field to be used for ordering when none specified
+	AddressGroupOrderByDefault = "created"
+)
+
+var (
+	// AddressGroupOrderByFields is a list of valid order by fields for the AddressGroup model
+	AddressGroupOrderByFields = []string{"name", "status", "created", "updated"}
+	// AddressGroupRelatedEntities is a list of valid relation by fields for the AddressGroup model
+	AddressGroupRelatedEntities = map[string]bool{
+		SiteRelationName:   true,
+		TenantRelationName: true,
+	}
+	// AddressGroupStatusMap is a list of valid status for the AddressGroup model
+	AddressGroupStatusMap = map[string]bool{
+		AddressGroupStatusReady:       true,
+		AddressGroupStatusPropagating: true,
+		AddressGroupStatusError:       true,
+	}
+)
+
+// AddressGroupPropagation records the outcome of pushing the prefixes of an
+// AddressGroup to a Network Security Group that references it
+type AddressGroupPropagation struct {
+	NetworkSecurityGroupID   string    `json:"networkSecurityGroupId"`
+	NetworkSecurityGroupName string    `json:"networkSecurityGroupName"`
+	Status                   string    `json:"status"`
+	Message                  *string   `json:"message,omitempty"`
+	Updated                  time.Time `json:"updated"`
+}
+
+// AddressGroup is a named, reusable set of prefixes that Network Security Group
+// rules can reference in place of a literal source or destination prefix
+type AddressGroup struct {
+	bun.BaseModel `bun:"table:address_group,alias:ag"`
+
+	ID          uuid.UUID                 `bun:"type:uuid,pk"`
+	Name        string                    `bun:"name,notnull"`
+	Description *string                   `bun:"description"`
+	SiteID      uuid.UUID                 `bun:"site_id,type:uuid,notnull"`
+	Site        *Site                     `bun:"rel:belongs-to,join:site_id=id"`
+	TenantOrg   string                    `bun:"tenant_org,notnull"`
+	TenantID    uuid.UUID                 `bun:"tenant_id,type:uuid,notnull"`
+	Tenant      *Tenant                   `bun:"rel:belongs-to,join:tenant_id=id"`
+	Prefixes    []string                  `bun:"prefixes,type:jsonb,notnull"`
+	Labels      map[string]string         `bun:"labels,type:jsonb"`
+	Status      string                    `bun:"status,notnull"`
+	Propagation []AddressGroupPropagation `bun:"propagation,type:jsonb"`
+	Created     time.Time                 `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated     time.Time                 `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted     *time.Time                `bun:"deleted,soft_delete"`
+	CreatedBy   uuid.UUID                 `bun:"type:uuid,notnull"`
+	UpdatedBy   uuid.UUID                 `bun:"type:uuid,notnull"`
+}
+
+// AddressGroupCreateInput input parameters for Create method
+type AddressGroupCreateInput struct {
+	AddressGroupID *uuid.UUID
+	Name           string
+	Description    *string
+	SiteID         uuid.UUID
+	TenantID       uuid.UUID
+	TenantOrg      string
+	Prefixes       []string
+	Labels         map[string]string
+	Status         string
+	CreatedByID    uuid.UUID
+}
+
+// AddressGroupUpdateInput input parameters for Update method
+type AddressGroupUpdateInput struct {
+	AddressGroupID uuid.UUID
+	Name           *string
+	Description    *string
+	Prefixes       []string
+	Labels         map[string]string
+	Status         *string
+	Propagation    []AddressGroupPropagation
+	UpdatedByID    uuid.UUID
+}
+
+// AddressGroupFilterInput input parameters for Filter method
+type AddressGroupFilterInput struct {
+	Name            *string
+	AddressGroupIDs []uuid.UUID
+	TenantOrgs      []string
+	TenantIDs       []uuid.UUID
+	SiteIDs         []uuid.UUID
+	Statuses        []string
+	SearchQuery     *string
+}
+
+// AddressGroupDeleteInput input parameters for Delete method
+type AddressGroupDeleteInput struct {
+	AddressGroupID uuid.UUID
+	UpdatedByID    uuid.UUID
+}
+
+var _ bun.BeforeAppendModelHook = (*AddressGroup)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (ag *AddressGroup) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		ag.Created = db.GetCurTime()
+		ag.Updated = db.GetCurTime()
+	case *bun.UpdateQuery:
+		ag.Updated = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*AddressGroup)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (ag *AddressGroup) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("site_id") REFERENCES "site" ("id")`).
+		ForeignKey(`("tenant_id") REFERENCES "tenant" ("id")`)
+
+	return nil
+}
+
+// AddressGroupDAO is an interface for interacting with the AddressGroup model
+type AddressGroupDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input AddressGroupCreateInput) (*AddressGroup, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*AddressGroup, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter AddressGroupFilterInput, page paginator.PageInput, includeRelations []string) ([]AddressGroup, int, error)
+	//
+	Update(ctx context.Context, tx *db.Tx, input AddressGroupUpdateInput) (*AddressGroup, error)
+	//
+	Delete(ctx context.Context, tx *db.Tx, input AddressGroupDeleteInput) error
+}
+
+// AddressGroupSQLDAO is an implementation of the AddressGroupDAO interface
+type AddressGroupSQLDAO struct {
+	dbSession *db.Session
+	AddressGroupDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new AddressGroup from the given parameters
+// The returned AddressGroup will not have any related structs filled in
+// since there are 2 operations (INSERT, SELECT), in this, it is required that
+// this library call happens within a transaction
+func (agsd AddressGroupSQLDAO) Create(ctx context.Context, tx *db.Tx, input AddressGroupCreateInput) (*AddressGroup, error) {
+	// Create a child span and set the attributes for current request
+	ctx, addressGroupDAOSpan := agsd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressGroupDAO.Create")
+	if addressGroupDAOSpan != nil {
+		defer addressGroupDAOSpan.End()
+
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "name", input.Name)
+	}
+
+	id := uuid.New()
+	if input.AddressGroupID != nil {
+		id = *input.AddressGroupID
+	}
+
+	prefixes := input.Prefixes
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+
+	ag := &AddressGroup{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		SiteID:      input.SiteID,
+		TenantOrg:   input.TenantOrg,
+		TenantID:    input.TenantID,
+		Prefixes:    prefixes,
+		Labels:      input.Labels,
+		Status:      input.Status,
+		CreatedBy:   input.CreatedByID,
+		UpdatedBy:   input.CreatedByID,
+	}
+
+	_, err := db.GetIDB(tx, agsd.dbSession).NewInsert().Model(ag).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nv, err := agsd.GetByID(ctx, tx, ag.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nv, nil
+}
+
+// GetByID returns an AddressGroup by ID
+// Returns db.ErrDoesNotExist error if the record is not found
+func (agsd AddressGroupSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*AddressGroup, error) {
+	// Create a child span and set the attributes for current request
+	ctx, addressGroupDAOSpan := agsd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressGroupDAO.GetByID")
+	if addressGroupDAOSpan != nil {
+		defer addressGroupDAOSpan.End()
+
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "id", id.String())
+	}
+
+	ag := &AddressGroup{}
+
+	query := db.GetIDB(tx, agsd.dbSession).NewSelect().Model(ag).Where("ag.id = ?", id)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return ag, nil
+}
+
+// GetAll returns all AddressGroups with various optional filters
+// If no records found, then error is nil, but length of returned slice is 0
+// If orderBy is nil, then records are ordered by column specified
+// in AddressGroupOrderByDefault in ascending order
+func (agsd AddressGroupSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter AddressGroupFilterInput, page paginator.PageInput, includeRelations []string) ([]AddressGroup, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, addressGroupDAOSpan := agsd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressGroupDAO.GetAll")
+	if addressGroupDAOSpan != nil {
+		defer addressGroupDAOSpan.End()
+	}
+
+	ags := []AddressGroup{}
+
+	query := db.GetIDB(tx, agsd.dbSession).NewSelect().Model(&ags)
+
+	if filter.Name != nil {
+		query = query.Where("ag.name = ?", *filter.Name)
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "name", *filter.Name)
+	}
+
+	if filter.AddressGroupIDs != nil {
+		query = query.Where("ag.id IN (?)", bun.In(filter.AddressGroupIDs))
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "address_group_ids", filter.AddressGroupIDs)
+	}
+
+	if filter.TenantOrgs != nil {
+		query = query.Where("ag.tenant_org IN (?)", bun.In(filter.TenantOrgs))
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "tenant_orgs", filter.TenantOrgs)
+	}
+
+	if filter.TenantIDs != nil {
+		query = query.Where("ag.tenant_id IN (?)", bun.In(filter.TenantIDs))
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "tenant_ids", filter.TenantIDs)
+	}
+
+	if filter.SiteIDs != nil {
+		query = query.Where("ag.site_id IN (?)", bun.In(filter.SiteIDs))
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "site_ids", filter.SiteIDs)
+	}
+
+	if filter.Statuses != nil {
+		query = query.Where("ag.status IN (?)", bun.In(filter.Statuses))
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "statuses", filter.Statuses)
+	}
+
+	if filter.SearchQuery != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("ag.name ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("ag.description ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("ag.prefixes::text ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("ag.labels::text ILIKE ?", "%"+*filter.SearchQuery+"%")
+		})
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "search_query", *filter.SearchQuery)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to ensure consistent ordering for pagination.
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(AddressGroupOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, AddressGroupOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ags, paginator.Total, nil
+}
+
+// Update updates specified fields of an existing AddressGroup
+// The updated fields are assumed to be set to non-null values
+// Since there are 2 operations (UPDATE, SELECT), it is required that
+// this library call happens within a transaction.
+func (agsd AddressGroupSQLDAO) Update(ctx context.Context, tx *db.Tx, input AddressGroupUpdateInput) (*AddressGroup, error) {
+	// Create a child span and set the attributes for current request
+	ctx, addressGroupDAOSpan := agsd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressGroupDAO.Update")
+	if addressGroupDAOSpan != nil {
+		defer addressGroupDAOSpan.End()
+
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "id", input.AddressGroupID.String())
+	}
+
+	updatedFields := []string{}
+
+	ag := &AddressGroup{
+		ID: input.AddressGroupID,
+	}
+
+	if input.Name != nil {
+		ag.Name = *input.Name
+		updatedFields = append(updatedFields, "name")
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "name", *input.Name)
+	}
+	if input.Description != nil {
+		ag.Description = input.Description
+		updatedFields = append(updatedFields, "description")
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "description", *input.Description)
+	}
+	if input.Prefixes != nil {
+		ag.Prefixes = input.Prefixes
+		updatedFields = append(updatedFields, "prefixes")
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "prefixes", input.Prefixes)
+	}
+	if input.Labels != nil {
+		ag.Labels = input.Labels
+		updatedFields = append(updatedFields, "labels")
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "labels", input.Labels)
+	}
+	if input.Status != nil {
+		ag.Status = *input.Status
+		updatedFields = append(updatedFields, "status")
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "status", *input.Status)
+	}
+	if input.Propagation != nil {
+		ag.Propagation = input.Propagation
+		updatedFields = append(updatedFields, "propagation")
+	}
+
+	ag.UpdatedBy = input.UpdatedByID
+	updatedFields = append(updatedFields, "updated_by", "updated")
+
+	_, err := db.GetIDB(tx, agsd.dbSession).NewUpdate().Model(ag).Column(updatedFields...).Where("ag.id = ?", input.AddressGroupID).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nv, err := agsd.GetByID(ctx, tx, ag.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nv, nil
+}
+
+// Delete deletes an AddressGroup
+// If the object being deleted doesnt exist,
+// error is not returned (idempotent delete)
+func (agsd AddressGroupSQLDAO) Delete(ctx context.Context, tx *db.Tx, input AddressGroupDeleteInput) error {
+	// Create a child span and set the attributes for current request
+	ctx, addressGroupDAOSpan := agsd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressGroupDAO.Delete")
+	if addressGroupDAOSpan != nil {
+		defer addressGroupDAOSpan.End()
+
+		agsd.tracerSpan.SetAttribute(addressGroupDAOSpan, "id", input.AddressGroupID.String())
+	}
+
+	ag := &AddressGroup{
+		ID:        input.AddressGroupID,
+		UpdatedBy: input.UpdatedByID,
+	}
+
+	_, err := db.GetIDB(tx, agsd.dbSession).NewDelete().Model(ag).Where("id = ?", input.AddressGroupID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddressGroupDAO returns a new AddressGroupDAO
+func NewAddressGroupDAO(dbSession *db.Session) AddressGroupDAO {
+	return &AddressGroupSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
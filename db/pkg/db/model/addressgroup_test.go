@@ -0,0 +1,363 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	stracer "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/tracer"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+// reset the tables needed for AddressGroup tests
+func testAddressGroupSetupSchema(t *testing.T, dbSession *db.Session) {
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+	// create Address Group table
+	err := dbSession.DB.ResetModel(context.Background(), (*AddressGroup)(nil))
+	assert.Nil(t, err)
+}
+
+func TestAddressGroupSQLDAO_Create(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testAddressGroupSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+
+	agsd := NewAddressGroupDAO(dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx = testCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		desc               string
+		input              AddressGroupCreateInput
+		expectError        bool
+		verifyChildSpanner bool
+	}{
+		{
+			desc: "success - create one with all fields set",
+			input: AddressGroupCreateInput{
+				AddressGroupID: db.GetUUIDPtr(uuid.New()),
+				Name:           "partners",
+				Description:    db.GetStrPtr("partner networks"),
+				SiteID:         site.ID,
+				TenantID:       tenant.ID,
+				TenantOrg:      tenant.Org,
+				Prefixes:       []string{"10.0.0.0/24", "192.168.10.0/24"},
+				Labels:         map[string]string{"env": "prod"},
+				Status:         AddressGroupStatusReady,
+				CreatedByID:    user.ID,
+			},
+			verifyChildSpanner: true,
+		},
+		{
+			desc: "success - with nullable fields not set",
+			input: AddressGroupCreateInput{
+				Name:        "empty",
+				SiteID:      site.ID,
+				TenantID:    tenant.ID,
+				TenantOrg:   tenant.Org,
+				Status:      AddressGroupStatusReady,
+				CreatedByID: user.ID,
+			},
+		},
+		{
+			desc: "error - when foreign key fails on non-null site ID",
+			input: AddressGroupCreateInput{
+				Name:        "bad-site",
+				SiteID:      uuid.New(),
+				TenantID:    tenant.ID,
+				TenantOrg:   tenant.Org,
+				Status:      AddressGroupStatusReady,
+				CreatedByID: user.ID,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := agsd.Create(ctx, nil, tc.input)
+			assert.Equal(t, tc.expectError, err != nil)
+			if err != nil {
+				return
+			}
+
+			if tc.input.AddressGroupID != nil {
+				assert.Equal(t, *tc.input.AddressGroupID, got.ID)
+			}
+			assert.Equal(t, tc.input.Name, got.Name)
+			assert.Equal(t, tc.input.SiteID, got.SiteID)
+			assert.Equal(t, tc.input.TenantID, got.TenantID)
+			assert.Equal(t, tc.input.CreatedByID, got.CreatedBy)
+			assert.Equal(t, tc.input.CreatedByID, got.UpdatedBy)
+			assert.Equal(t, tc.input.Labels, got.Labels)
+			if tc.input.Prefixes == nil {
+				assert.Equal(t, []string{}, got.Prefixes)
+			} else {
+				assert.Equal(t, tc.input.Prefixes, got.Prefixes)
+			}
+
+			if tc.verifyChildSpanner {
+				span := otrace.SpanFromContext(ctx)
+				assert.True(t, span.SpanContext().IsValid())
+				_, ok := ctx.Value(stracer.TracerKey).(otrace.Tracer)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestAddressGroupSQLDAO_GetByID(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testAddressGroupSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+
+	ag := TestBuildAddressGroup(t, dbSession, "partners", tenant, site, []string{"10.0.0.0/24"})
+
+	agsd := NewAddressGroupDAO(dbSession)
+
+	tests := []struct {
+		desc             string
+		id               uuid.UUID
+		includeRelations []string
+		expectError      error
+	}{
+		{
+			desc:             "success - with relations",
+			id:               ag.ID,
+			includeRelations: []string{SiteRelationName, TenantRelationName},
+		},
+		{
+			desc:        "error - not found",
+			id:          uuid.New(),
+			expectError: db.ErrDoesNotExist,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := agsd.GetByID(ctx, nil, tc.id, tc.includeRelations)
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, ag.ID, got.ID)
+			assert.Equal(t, ag.Prefixes, got.Prefixes)
+			assert.NotNil(t, got.Site)
+			assert.NotNil(t, got.Tenant)
+		})
+	}
+}
+
+func TestAddressGroupSQLDAO_GetAll(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testAddressGroupSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site1 := testInstanceBuildSite(t, dbSession, ip, "testSite1")
+	site2 := testInstanceBuildSite(t, dbSession, ip, "testSite2")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+
+	ags := []*AddressGroup{}
+	for i := 0; i < 10; i++ {
+		st := site1
+		if i%2 == 1 {
+			st = site2
+		}
+		ags = append(ags, TestBuildAddressGroup(t, dbSession, fmt.Sprintf("group-%d", i), tenant, st, []string{fmt.Sprintf("10.0.%d.0/24", i)}))
+	}
+
+	agsd := NewAddressGroupDAO(dbSession)
+
+	tests := []struct {
+		desc      string
+		filter    AddressGroupFilterInput
+		page      paginator.PageInput
+		wantCount int
+		wantTotal int
+	}{
+		{
+			desc:      "all",
+			wantCount: 10,
+			wantTotal: 10,
+		},
+		{
+			desc:      "by site",
+			filter:    AddressGroupFilterInput{SiteIDs: []uuid.UUID{site1.ID}},
+			wantCount: 5,
+			wantTotal: 5,
+		},
+		{
+			desc:      "by ids",
+			filter:    AddressGroupFilterInput{AddressGroupIDs: []uuid.UUID{ags[0].ID, ags[3].ID}},
+			wantCount: 2,
+			wantTotal: 2,
+		},
+		{
+			desc:      "by name",
+			filter:    AddressGroupFilterInput{Name: db.GetStrPtr("group-4")},
+			wantCount: 1,
+			wantTotal: 1,
+		},
+		{
+			desc:      "by search query matching prefix",
+			filter:    AddressGroupFilterInput{SearchQuery: db.GetStrPtr("10.0.7.")},
+			wantCount: 1,
+			wantTotal: 1,
+		},
+		{
+			desc:      "with limit",
+			filter:    AddressGroupFilterInput{TenantIDs: []uuid.UUID{tenant.ID}},
+			page:      paginator.PageInput{Limit: db.GetIntPtr(3)},
+			wantCount: 3,
+			wantTotal: 10,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, total, err := agsd.GetAll(ctx, nil, tc.filter, tc.page, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCount, len(got))
+			assert.Equal(t, tc.wantTotal, total)
+		})
+	}
+}
+
+func TestAddressGroupSQLDAO_Update(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testAddressGroupSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+
+	ag := TestBuildAddressGroup(t, dbSession, "partners", tenant, site, []string{"10.0.0.0/24"})
+
+	agsd := NewAddressGroupDAO(dbSession)
+
+	propagation := []AddressGroupPropagation{
+		{NetworkSecurityGroupID: uuid.NewString(), NetworkSecurityGroupName: "web", Status: AddressGroupPropagationStatusSynced, Updated: db.GetCurTime()},
+	}
+
+	got, err := agsd.Update(ctx, nil, AddressGroupUpdateInput{
+		AddressGroupID: ag.ID,
+		Name:           db.GetStrPtr("partners-updated"),
+		Prefixes:       []string{"10.0.0.0/24", "10.0.1.0/24"},
+		Status:         db.GetStrPtr(AddressGroupStatusPropagating),
+		Propagation:    propagation,
+		UpdatedByID:    user.ID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "partners-updated", got.Name)
+	assert.Equal(t, []string{"10.0.0.0/24", "10.0.1.0/24"}, got.Prefixes)
+	assert.Equal(t, AddressGroupStatusPropagating, got.Status)
+	assert.Equal(t, user.ID, got.UpdatedBy)
+	require.Len(t, got.Propagation, 1)
+	assert.Equal(t, propagation[0].NetworkSecurityGroupID, got.Propagation[0].NetworkSecurityGroupID)
+	assert.True(t, got.Updated.After(ag.Updated) || got.Updated.Equal(ag.Updated))
+}
+
+func TestAddressGroupSQLDAO_Delete(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testAddressGroupSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+
+	ag := TestBuildAddressGroup(t, dbSession, "partners", tenant, site, []string{"10.0.0.0/24"})
+
+	agsd := NewAddressGroupDAO(dbSession)
+
+	err := agsd.Delete(ctx, nil, AddressGroupDeleteInput{AddressGroupID: ag.ID, UpdatedByID: user.ID})
+	require.NoError(t, err)
+
+	_, err = agsd.GetByID(ctx, nil, ag.ID, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	// Deleting again is a no-op
+	err = agsd.Delete(ctx, nil, AddressGroupDeleteInput{AddressGroupID: ag.ID, UpdatedByID: user.ID})
+	assert.NoError(t, err)
+}
+
+func TestNetworkSecurityGroupRule_AddressGroupReferences(t *testing.T) {
+	sourceGroupID := uuid.NewString()
+
+	rule := &NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             db.GetStrPtr("allow-partners"),
+			Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+			Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+			Action:         cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT,
+			Priority:       100,
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		},
+		SourceAddressGroupID: &sourceGroupID,
+	}
+
+	b, err := json.Marshal(rule)
+	require.NoError(t, err)
+
+	got := &NetworkSecurityGroupRule{}
+	require.NoError(t, json.Unmarshal(b, got))
+
+	require.NotNil(t, got.SourceAddressGroupID)
+	assert.Equal(t, sourceGroupID, *got.SourceAddressGroupID)
+	assert.Nil(t, got.DestinationAddressGroupID)
+	assert.Equal(t, "allow-partners", got.GetId())
+	assert.Equal(t, "0.0.0.0/0", got.GetDstPrefix())
+	assert.Equal(t, []string{sourceGroupID}, got.AddressGroupIDs())
+
+	nsg := &NetworkSecurityGroup{Rules: []*NetworkSecurityGroupRule{got}}
+	assert.True(t, nsg.HasAddressGroupReferences())
+	assert.True(t, nsg.ReferencesAddressGroup(sourceGroupID))
+	assert.False(t, nsg.ReferencesAddressGroup(uuid.NewString()))
+
+	// Rules without references marshal exactly as the protobuf message does
+	plain := &NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: rule.NetworkSecurityGroupRuleAttributes}
+	b, err = json.Marshal(plain)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "AddressGroupId")
+}
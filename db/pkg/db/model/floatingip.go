@@ -0,0 +1,540 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	stracer "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/tracer"
+)
+
+const (
+	// FloatingIPStatusAvailable indicates that the Floating IP is allocated but not associated with an Instance Interface
+	FloatingIPStatusAvailable = "Available"
+	// FloatingIPStatusAssociating indicates that the Floating IP is being programmed on the Site
+	FloatingIPStatusAssociating = "Associating"
+	// FloatingIPStatusAssociated indicates that the Floating IP is programmed on an Instance Interface
+	FloatingIPStatusAssociated = "Associated"
+	// FloatingIPStatusError indicates that the Floating IP could not be programmed on the Site
+	FloatingIPStatusError = "Error"
+	// FloatingIPStatusDetached indicates that the Instance the Floating IP is associated with was deleted, the Floating IP
+	// moves to the replacement Instance once it is Ready or is released if it isn't replaced in time
+	FloatingIPStatusDetached = "Detached"
+
+	// FloatingIPRelationName is the relation name for the FloatingIP model
+	FloatingIPRelationName = "FloatingIP"
+
+	// FloatingIPOrderByDefault default field to be used for ordering when none specified
+	FloatingIPOrderByDefault = "created"
+)
+
+var (
+	// FloatingIPOrderByFields is a list of valid order by fields for the FloatingIP model
+	FloatingIPOrderByFields = []string{"name", "ip_address", "status", "created", "updated"}
+	// FloatingIPRelatedEntities is a list of valid relation by fields for the FloatingIP model
+	FloatingIPRelatedEntities = map[string]bool{
+		SiteRelationName:      true,
+		TenantRelationName:    true,
+		IPBlockRelationName:   true,
+		InstanceRelationName:  true,
+		InterfaceRelationName: true,
+	}
+	// FloatingIPStatusMap is a list of valid status for the FloatingIP model
+	FloatingIPStatusMap = map[string]bool{
+		FloatingIPStatusAvailable:   true,
+		FloatingIPStatusAssociating: true,
+		FloatingIPStatusAssociated:  true,
+		FloatingIPStatusError:       true,
+		FloatingIPStatusDetached:    true,
+	}
+)
+
+// FloatingIP is a single IPv4 address allocated from a Tenant IP Block that can be moved between Instance Interfaces.
+// The association survives the deletion of its Instance: the Floating IP becomes Detached and moves to the replacement
+// Instance, the Instance created with ReplacesInstanceID set to the deleted Instance.
+type FloatingIP struct {
+	bun.BaseModel `bun:"table:floating_ip,alias:fip"`
+
+	ID                    uuid.UUID  `bun:"type:uuid,pk"`
+	Name                  string     `bun:"name,notnull"`
+	Description           *string    `bun:"description"`
+	SiteID                uuid.UUID  `bun:"site_id,type:uuid,notnull"`
+	Site                  *Site      `bun:"rel:belongs-to,join:site_id=id"`
+	TenantOrg             string     `bun:"tenant_org,notnull"`
+	TenantID              uuid.UUID  `bun:"tenant_id,type:uuid,notnull"`
+	Tenant                *Tenant    `bun:"rel:belongs-to,join:tenant_id=id"`
+	IPBlockID             uuid.UUID  `bun:"ip_block_id,type:uuid,notnull"`
+	IPBlock               *IPBlock   `bun:"rel:belongs-to,join:ip_block_id=id"`
+	IPAddress             string     `bun:"ip_address,notnull"`
+	InstanceID            *uuid.UUID `bun:"instance_id,type:uuid"`
+	Instance              *Instance  `bun:"rel:belongs-to,join:instance_id=id"`
+	InterfaceID           *uuid.UUID `bun:"interface_id,type:uuid"`
+	Interface             *Interface `bun:"rel:belongs-to,join:interface_id=id"`
+	ControllerInterfaceID *uuid.UUID `bun:"controller_interface_id,type:uuid"`
+	Status                string     `bun:"status,notnull"`
+	Created               time.Time  `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated               time.Time  `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted               *time.Time `bun:"deleted,soft_delete"`
+	CreatedBy             uuid.UUID  `bun:"type:uuid,notnull"`
+	UpdatedBy             uuid.UUID  `bun:"type:uuid,notnull"`
+}
+
+// GetCidr returns the Floating IP address as a host CIDR, as tracked in IPAM
+func (fip *FloatingIP) GetCidr() string {
+	return fip.IPAddress + "/32"
+}
+
+// IsAssociated returns true if the Floating IP is associated with an Instance Interface
+func (fip *FloatingIP) IsAssociated() bool {
+	return fip.InterfaceID != nil
+}
+
+// FloatingIPCreateInput input parameters for Create method
+type FloatingIPCreateInput struct {
+	FloatingIPID *uuid.UUID
+	Name         string
+	Description  *string
+	SiteID       uuid.UUID
+	TenantID     uuid.UUID
+	TenantOrg    string
+	IPBlockID    uuid.UUID
+	IPAddress    string
+	Status       string
+	CreatedByID  uuid.UUID
+}
+
+// FloatingIPUpdateInput input parameters for Update method
+type FloatingIPUpdateInput struct {
+	FloatingIPID          uuid.UUID
+	Name                  *string
+	Description           *string
+	InstanceID            *uuid.UUID
+	InterfaceID           *uuid.UUID
+	ControllerInterfaceID *uuid.UUID
+	Status                *string
+	UpdatedByID           uuid.UUID
+}
+
+// FloatingIPClearInput input parameters for Clear method
+type FloatingIPClearInput struct {
+	FloatingIPID          uuid.UUID
+	Description           bool
+	InstanceID            bool
+	InterfaceID           bool
+	ControllerInterfaceID bool
+	UpdatedByID           uuid.UUID
+}
+
+// FloatingIPFilterInput input parameters for Filter method
+type FloatingIPFilterInput struct {
+	Name          *string
+	FloatingIPIDs []uuid.UUID
+	TenantOrgs    []string
+	TenantIDs     []uuid.UUID
+	SiteIDs       []uuid.UUID
+	IPBlockIDs    []uuid.UUID
+	IPAddresses   []string
+	InstanceIDs   []uuid.UUID
+	InterfaceIDs  []uuid.UUID
+	Statuses      []string
+	SearchQuery   *string
+}
+
+// FloatingIPDeleteInput input parameters for Delete method
+type FloatingIPDeleteInput struct {
+	FloatingIPID uuid.UUID
+	UpdatedByID  uuid.UUID
+}
+
+var _ bun.BeforeAppendModelHook = (*FloatingIP)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (fip *FloatingIP) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		fip.Created = db.GetCurTime()
+		fip.Updated = db.GetCurTime()
+	case *bun.UpdateQuery:
+		fip.Updated = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*FloatingIP)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (fip *FloatingIP) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("site_id") REFERENCES "site" ("id")`).
+		ForeignKey(`("tenant_id") REFERENCES "tenant" ("id")`).
+		ForeignKey(`("ip_block_id") REFERENCES "ip_block" ("id")`)
+
+	return nil
+}
+
+// FloatingIPDAO is an interface for interacting with the FloatingIP model
+type FloatingIPDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input FloatingIPCreateInput) (*FloatingIP, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*FloatingIP, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter FloatingIPFilterInput, page paginator.PageInput, includeRelations []string) ([]FloatingIP, int, error)
+	//
+	Update(ctx context.Context, tx *db.Tx, input FloatingIPUpdateInput) (*FloatingIP, error)
+	//
+	Clear(ctx context.Context, tx *db.Tx, input FloatingIPClearInput) (*FloatingIP, error)
+	//
+	Delete(ctx context.Context, tx *db.Tx, input FloatingIPDeleteInput) error
+}
+
+// FloatingIPSQLDAO is an implementation of the FloatingIPDAO interface
+type FloatingIPSQLDAO struct {
+	dbSession *db.Session
+	FloatingIPDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new FloatingIP from the given parameters
+// The returned FloatingIP will not have any related structs filled in
+// since there are 2 operations (INSERT, SELECT), in this, it is required that
+// this library call happens within a transaction
+func (fipsd FloatingIPSQLDAO) Create(ctx context.Context, tx *db.Tx, input FloatingIPCreateInput) (*FloatingIP, error) {
+	// Create a child span and set the attributes for current request
+	ctx, floatingIPDAOSpan := fipsd.tracerSpan.CreateChildInCurrentContext(ctx, "FloatingIPDAO.Create")
+	if floatingIPDAOSpan != nil {
+		defer floatingIPDAOSpan.End()
+
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "name", input.Name)
+	}
+
+	id := uuid.New()
+	if input.FloatingIPID != nil {
+		id = *input.FloatingIPID
+	}
+
+	fip := &FloatingIP{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		SiteID:      input.SiteID,
+		TenantOrg:   input.TenantOrg,
+		TenantID:    input.TenantID,
+		IPBlockID:   input.IPBlockID,
+		IPAddress:   input.IPAddress,
+		Status:      input.Status,
+		CreatedBy:   input.CreatedByID,
+		UpdatedBy:   input.CreatedByID,
+	}
+
+	_, err := db.GetIDB(tx, fipsd.dbSession).NewInsert().Model(fip).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nv, err := fipsd.GetByID(ctx, tx, fip.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nv, nil
+}
+
+// GetByID returns a FloatingIP by ID
+// Returns db.ErrDoesNotExist error if the record is not found
+func (fipsd FloatingIPSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*FloatingIP, error) {
+	// Create a child span and set the attributes for current request
+	ctx, floatingIPDAOSpan := fipsd.tracerSpan.CreateChildInCurrentContext(ctx, "FloatingIPDAO.GetByID")
+	if floatingIPDAOSpan != nil {
+		defer floatingIPDAOSpan.End()
+
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "id", id.String())
+	}
+
+	fip := &FloatingIP{}
+
+	query := db.GetIDB(tx, fipsd.dbSession).NewSelect().Model(fip).Where("fip.id = ?", id)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return fip, nil
+}
+
+// GetAll returns all FloatingIPs with various optional filters
+// If no records found, then error is nil, but length of returned slice is 0
+// If orderBy is nil, then records are ordered by column specified
+// in FloatingIPOrderByDefault in ascending order
+func (fipsd FloatingIPSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter FloatingIPFilterInput, page paginator.PageInput, includeRelations []string) ([]FloatingIP, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, floatingIPDAOSpan := fipsd.tracerSpan.CreateChildInCurrentContext(ctx, "FloatingIPDAO.GetAll")
+	if floatingIPDAOSpan != nil {
+		defer floatingIPDAOSpan.End()
+	}
+
+	fips := []FloatingIP{}
+
+	query := db.GetIDB(tx, fipsd.dbSession).NewSelect().Model(&fips)
+
+	if filter.Name != nil {
+		query = query.Where("fip.name = ?", *filter.Name)
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "name", *filter.Name)
+	}
+
+	if filter.FloatingIPIDs != nil {
+		query = query.Where("fip.id IN (?)", bun.In(filter.FloatingIPIDs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "floating_ip_ids", filter.FloatingIPIDs)
+	}
+
+	if filter.TenantOrgs != nil {
+		query = query.Where("fip.tenant_org IN (?)", bun.In(filter.TenantOrgs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "tenant_orgs", filter.TenantOrgs)
+	}
+
+	if filter.TenantIDs != nil {
+		query = query.Where("fip.tenant_id IN (?)", bun.In(filter.TenantIDs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "tenant_ids", filter.TenantIDs)
+	}
+
+	if filter.SiteIDs != nil {
+		query = query.Where("fip.site_id IN (?)", bun.In(filter.SiteIDs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "site_ids", filter.SiteIDs)
+	}
+
+	if filter.IPBlockIDs != nil {
+		query = query.Where("fip.ip_block_id IN (?)", bun.In(filter.IPBlockIDs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "ip_block_ids", filter.IPBlockIDs)
+	}
+
+	if filter.IPAddresses != nil {
+		query = query.Where("fip.ip_address IN (?)", bun.In(filter.IPAddresses))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "ip_addresses", filter.IPAddresses)
+	}
+
+	if filter.InstanceIDs != nil {
+		query = query.Where("fip.instance_id IN (?)", bun.In(filter.InstanceIDs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "instance_ids", filter.InstanceIDs)
+	}
+
+	if filter.InterfaceIDs != nil {
+		query = query.Where("fip.interface_id IN (?)", bun.In(filter.InterfaceIDs))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "interface_ids", filter.InterfaceIDs)
+	}
+
+	if filter.Statuses != nil {
+		query = query.Where("fip.status IN (?)", bun.In(filter.Statuses))
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "statuses", filter.Statuses)
+	}
+
+	if filter.SearchQuery != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("fip.name ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("fip.description ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("fip.ip_address ILIKE ?", "%"+*filter.SearchQuery+"%")
+		})
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "search_query", *filter.SearchQuery)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to ensure consistent ordering for pagination.
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(FloatingIPOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, FloatingIPOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return fips, paginator.Total, nil
+}
+
+// Update updates specified fields of an existing FloatingIP
+// The updated fields are assumed to be set to non-null values
+// Since there are 2 operations (UPDATE, SELECT), it is required that
+// this library call happens within a transaction.
+func (fipsd FloatingIPSQLDAO) Update(ctx context.Context, tx *db.Tx, input FloatingIPUpdateInput) (*FloatingIP, error) {
+	// Create a child span and set the attributes for current request
+	ctx, floatingIPDAOSpan := fipsd.tracerSpan.CreateChildInCurrentContext(ctx, "FloatingIPDAO.Update")
+	if floatingIPDAOSpan != nil {
+		defer floatingIPDAOSpan.End()
+
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "id", input.FloatingIPID.String())
+	}
+
+	updatedFields := []string{}
+
+	fip := &FloatingIP{
+		ID: input.FloatingIPID,
+	}
+
+	if input.Name != nil {
+		fip.Name = *input.Name
+		updatedFields = append(updatedFields, "name")
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "name", *input.Name)
+	}
+	if input.Description != nil {
+		fip.Description = input.Description
+		updatedFields = append(updatedFields, "description")
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "description", *input.Description)
+	}
+	if input.InstanceID != nil {
+		fip.InstanceID = input.InstanceID
+		updatedFields = append(updatedFields, "instance_id")
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "instance_id", input.InstanceID.String())
+	}
+	if input.InterfaceID != nil {
+		fip.InterfaceID = input.InterfaceID
+		updatedFields = append(updatedFields, "interface_id")
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "interface_id", input.InterfaceID.String())
+	}
+	if input.ControllerInterfaceID != nil {
+		fip.ControllerInterfaceID = input.ControllerInterfaceID
+		updatedFields = append(updatedFields, "controller_interface_id")
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "controller_interface_id", input.ControllerInterfaceID.String())
+	}
+	if input.Status != nil {
+		fip.Status = *input.Status
+		updatedFields = append(updatedFields, "status")
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "status", *input.Status)
+	}
+
+	fip.UpdatedBy = input.UpdatedByID
+	updatedFields = append(updatedFields, "updated_by", "updated")
+
+	_, err := db.GetIDB(tx, fipsd.dbSession).NewUpdate().Model(fip).Column(updatedFields...).Where("fip.id = ?", input.FloatingIPID).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nv, err := fipsd.GetByID(ctx, tx, fip.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nv, nil
+}
+
+// Clear sets parameters of an existing FloatingIP to null values in db
+// Since there are 2 operations (UPDATE, SELECT), it is required that
+// this library call happens within a transaction.
+func (fipsd FloatingIPSQLDAO) Clear(ctx context.Context, tx *db.Tx, input FloatingIPClearInput) (*FloatingIP, error) {
+	// Create a child span and set the attributes for current request
+	ctx, floatingIPDAOSpan := fipsd.tracerSpan.CreateChildInCurrentContext(ctx, "FloatingIPDAO.Clear")
+	if floatingIPDAOSpan != nil {
+		defer floatingIPDAOSpan.End()
+
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "id", input.FloatingIPID.String())
+	}
+
+	fip := &FloatingIP{
+		ID: input.FloatingIPID,
+	}
+
+	updatedFields := []string{}
+	if input.Description {
+		fip.Description = nil
+		updatedFields = append(updatedFields, "description")
+	}
+	if input.InstanceID {
+		fip.InstanceID = nil
+		updatedFields = append(updatedFields, "instance_id")
+	}
+	if input.InterfaceID {
+		fip.InterfaceID = nil
+		updatedFields = append(updatedFields, "interface_id")
+	}
+	if input.ControllerInterfaceID {
+		fip.ControllerInterfaceID = nil
+		updatedFields = append(updatedFields, "controller_interface_id")
+	}
+
+	if len(updatedFields) > 0 {
+		fip.UpdatedBy = input.UpdatedByID
+		updatedFields = append(updatedFields, "updated_by", "updated")
+
+		_, err := db.GetIDB(tx, fipsd.dbSession).NewUpdate().Model(fip).Column(updatedFields...).Where("fip.id = ?", input.FloatingIPID).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nv, err := fipsd.GetByID(ctx, tx, fip.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nv, nil
+}
+
+// Delete deletes a FloatingIP
+// If the object being deleted doesnt exist,
+// error is not returned (idempotent delete)
+func (fipsd FloatingIPSQLDAO) Delete(ctx context.Context, tx *db.Tx, input FloatingIPDeleteInput) error {
+	// Create a child span and set the attributes for current request
+	ctx, floatingIPDAOSpan := fipsd.tracerSpan.CreateChildInCurrentContext(ctx, "FloatingIPDAO.Delete")
+	if floatingIPDAOSpan != nil {
+		defer floatingIPDAOSpan.End()
+
+		fipsd.tracerSpan.SetAttribute(floatingIPDAOSpan, "id", input.FloatingIPID.String())
+	}
+
+	fip := &FloatingIP{
+		ID:        input.FloatingIPID,
+		UpdatedBy: input.UpdatedByID,
+	}
+
+	_, err := db.GetIDB(tx, fipsd.dbSession).NewDelete().Model(fip).Where("id = ?", input.FloatingIPID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewFloatingIPDAO returns a new FloatingIPDAO
+func NewFloatingIPDAO(dbSession *db.Session) FloatingIPDAO {
+	return &FloatingIPSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -0,0 +1,366 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	stracer "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/tracer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+// reset the tables needed for FloatingIP tests
+func testFloatingIPSetupSchema(t *testing.T, dbSession *db.Session) {
+	// interface setup covers Site, Tenant, IPBlock, Instance and Interface tables
+	testInterfaceSetupSchema(t, dbSession)
+	// create Floating IP table
+	err := dbSession.DB.ResetModel(context.Background(), (*FloatingIP)(nil))
+	assert.Nil(t, err)
+	// an address can be allocated once per Site, enforced by a partial unique index created in migrations
+	_, err = dbSession.DB.Exec("CREATE UNIQUE INDEX floating_ip_site_id_ip_address_idx ON floating_ip(site_id, ip_address) WHERE deleted IS NULL")
+	assert.Nil(t, err)
+}
+
+func TestFloatingIPSQLDAO_Create(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testFloatingIPSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+	ipb := TestBuildIPBlock(t, dbSession, "testIPBlock", site, tenant, IPBlockRoutingTypeDatacenterOnly, "192.0.2.0", 24, IPBlockProtocolVersionV4)
+
+	fipsd := NewFloatingIPDAO(dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx = testCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		desc               string
+		input              FloatingIPCreateInput
+		expectError        bool
+		verifyChildSpanner bool
+	}{
+		{
+			desc: "success - create one with all fields set",
+			input: FloatingIPCreateInput{
+				FloatingIPID: db.GetUUIDPtr(uuid.New()),
+				Name:         "web",
+				Description:  db.GetStrPtr("web frontend"),
+				SiteID:       site.ID,
+				TenantID:     tenant.ID,
+				TenantOrg:    tenant.Org,
+				IPBlockID:    ipb.ID,
+				IPAddress:    "192.0.2.10",
+				Status:       FloatingIPStatusAvailable,
+				CreatedByID:  user.ID,
+			},
+			verifyChildSpanner: true,
+		},
+		{
+			desc: "success - with nullable fields not set",
+			input: FloatingIPCreateInput{
+				Name:        "db",
+				SiteID:      site.ID,
+				TenantID:    tenant.ID,
+				TenantOrg:   tenant.Org,
+				IPBlockID:   ipb.ID,
+				IPAddress:   "192.0.2.11",
+				Status:      FloatingIPStatusAvailable,
+				CreatedByID: user.ID,
+			},
+		},
+		{
+			desc: "error - same address allocated twice on the Site",
+			input: FloatingIPCreateInput{
+				Name:        "duplicate",
+				SiteID:      site.ID,
+				TenantID:    tenant.ID,
+				TenantOrg:   tenant.Org,
+				IPBlockID:   ipb.ID,
+				IPAddress:   "192.0.2.10",
+				Status:      FloatingIPStatusAvailable,
+				CreatedByID: user.ID,
+			},
+			expectError: true,
+		},
+		{
+			desc: "error - when foreign key fails on non-null IP Block ID",
+			input: FloatingIPCreateInput{
+				Name:        "bad-ipblock",
+				SiteID:      site.ID,
+				TenantID:    tenant.ID,
+				TenantOrg:   tenant.Org,
+				IPBlockID:   uuid.New(),
+				IPAddress:   "192.0.2.12",
+				Status:      FloatingIPStatusAvailable,
+				CreatedByID: user.ID,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := fipsd.Create(ctx, nil, tc.input)
+			assert.Equal(t, tc.expectError, err != nil)
+			if err != nil {
+				return
+			}
+
+			if tc.input.FloatingIPID != nil {
+				assert.Equal(t, *tc.input.FloatingIPID, got.ID)
+			}
+			assert.Equal(t, tc.input.Name, got.Name)
+			assert.Equal(t, tc.input.IPBlockID, got.IPBlockID)
+			assert.Equal(t, tc.input.IPAddress, got.IPAddress)
+			assert.Equal(t, tc.input.Status, got.Status)
+			assert.Equal(t, tc.input.CreatedByID, got.CreatedBy)
+			assert.False(t, got.IsAssociated())
+
+			if tc.verifyChildSpanner {
+				span := otrace.SpanFromContext(ctx)
+				assert.True(t, span.SpanContext().IsValid())
+				_, ok := ctx.Value(stracer.TracerKey).(otrace.Tracer)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestFloatingIPSQLDAO_GetByID(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testFloatingIPSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	ipb := TestBuildIPBlock(t, dbSession, "testIPBlock", site, tenant, IPBlockRoutingTypeDatacenterOnly, "192.0.2.0", 24, IPBlockProtocolVersionV4)
+
+	fip := TestBuildFloatingIP(t, dbSession, "web", tenant, site, ipb, "192.0.2.10")
+
+	fipsd := NewFloatingIPDAO(dbSession)
+
+	got, err := fipsd.GetByID(ctx, nil, fip.ID, []string{SiteRelationName, TenantRelationName, IPBlockRelationName})
+	require.NoError(t, err)
+	assert.Equal(t, fip.ID, got.ID)
+	assert.NotNil(t, got.Site)
+	assert.NotNil(t, got.Tenant)
+	assert.NotNil(t, got.IPBlock)
+
+	_, err = fipsd.GetByID(ctx, nil, uuid.New(), nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+}
+
+func TestFloatingIPSQLDAO_GetAll(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testFloatingIPSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site1 := testInstanceBuildSite(t, dbSession, ip, "testSite1")
+	site2 := testInstanceBuildSite(t, dbSession, ip, "testSite2")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+	ipb1 := TestBuildIPBlock(t, dbSession, "testIPBlock1", site1, tenant, IPBlockRoutingTypeDatacenterOnly, "192.0.2.0", 24, IPBlockProtocolVersionV4)
+	ipb2 := TestBuildIPBlock(t, dbSession, "testIPBlock2", site2, tenant, IPBlockRoutingTypeDatacenterOnly, "198.51.100.0", 24, IPBlockProtocolVersionV4)
+
+	fipsd := NewFloatingIPDAO(dbSession)
+
+	instanceID := uuid.New()
+	fips := []*FloatingIP{}
+	for i := 0; i < 6; i++ {
+		st, ipb, prefix := site1, ipb1, "192.0.2"
+		if i%2 == 1 {
+			st, ipb, prefix = site2, ipb2, "198.51.100"
+		}
+		fips = append(fips, TestBuildFloatingIP(t, dbSession, fmt.Sprintf("fip-%d", i), tenant, st, ipb, fmt.Sprintf("%s.%d", prefix, i+10)))
+	}
+
+	_, err := fipsd.Update(ctx, nil, FloatingIPUpdateInput{
+		FloatingIPID: fips[0].ID,
+		InstanceID:   &instanceID,
+		InterfaceID:  db.GetUUIDPtr(uuid.New()),
+		Status:       db.GetStrPtr(FloatingIPStatusAssociated),
+		UpdatedByID:  user.ID,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc      string
+		filter    FloatingIPFilterInput
+		page      paginator.PageInput
+		wantCount int
+		wantTotal int
+	}{
+		{
+			desc:      "all",
+			wantCount: 6,
+			wantTotal: 6,
+		},
+		{
+			desc:      "by site",
+			filter:    FloatingIPFilterInput{SiteIDs: []uuid.UUID{site1.ID}},
+			wantCount: 3,
+			wantTotal: 3,
+		},
+		{
+			desc:      "by ip block",
+			filter:    FloatingIPFilterInput{IPBlockIDs: []uuid.UUID{ipb2.ID}},
+			wantCount: 3,
+			wantTotal: 3,
+		},
+		{
+			desc:      "by instance",
+			filter:    FloatingIPFilterInput{InstanceIDs: []uuid.UUID{instanceID}},
+			wantCount: 1,
+			wantTotal: 1,
+		},
+		{
+			desc:      "by status",
+			filter:    FloatingIPFilterInput{Statuses: []string{FloatingIPStatusAvailable}},
+			wantCount: 5,
+			wantTotal: 5,
+		},
+		{
+			desc:      "by search query matching address",
+			filter:    FloatingIPFilterInput{SearchQuery: db.GetStrPtr("198.51.100.11")},
+			wantCount: 1,
+			wantTotal: 1,
+		},
+		{
+			desc:      "with limit",
+			filter:    FloatingIPFilterInput{TenantIDs: []uuid.UUID{tenant.ID}},
+			page:      paginator.PageInput{Limit: db.GetIntPtr(2)},
+			wantCount: 2,
+			wantTotal: 6,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, total, err := fipsd.GetAll(ctx, nil, tc.filter, tc.page, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCount, len(got))
+			assert.Equal(t, tc.wantTotal, total)
+		})
+	}
+}
+
+func TestFloatingIPSQLDAO_UpdateAndClear(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testFloatingIPSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+	ipb := TestBuildIPBlock(t, dbSession, "testIPBlock", site, tenant, IPBlockRoutingTypeDatacenterOnly, "192.0.2.0", 24, IPBlockProtocolVersionV4)
+
+	fip := TestBuildFloatingIP(t, dbSession, "web", tenant, site, ipb, "192.0.2.10")
+
+	fipsd := NewFloatingIPDAO(dbSession)
+
+	instanceID := uuid.New()
+	interfaceID := uuid.New()
+	controllerInterfaceID := uuid.New()
+
+	got, err := fipsd.Update(ctx, nil, FloatingIPUpdateInput{
+		FloatingIPID:          fip.ID,
+		Name:                  db.GetStrPtr("web-updated"),
+		InstanceID:            &instanceID,
+		InterfaceID:           &interfaceID,
+		ControllerInterfaceID: &controllerInterfaceID,
+		Status:                db.GetStrPtr(FloatingIPStatusAssociated),
+		UpdatedByID:           user.ID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "web-updated", got.Name)
+	assert.Equal(t, instanceID, *got.InstanceID)
+	assert.Equal(t, interfaceID, *got.InterfaceID)
+	assert.Equal(t, controllerInterfaceID, *got.ControllerInterfaceID)
+	assert.Equal(t, FloatingIPStatusAssociated, got.Status)
+	assert.True(t, got.IsAssociated())
+
+	got, err = fipsd.Clear(ctx, nil, FloatingIPClearInput{
+		FloatingIPID:          fip.ID,
+		InstanceID:            true,
+		InterfaceID:           true,
+		ControllerInterfaceID: true,
+		UpdatedByID:           user.ID,
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, got.InstanceID)
+	assert.Nil(t, got.InterfaceID)
+	assert.Nil(t, got.ControllerInterfaceID)
+	assert.False(t, got.IsAssociated())
+	assert.Equal(t, "web-updated", got.Name)
+}
+
+func TestFloatingIPSQLDAO_Delete(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testFloatingIPSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+	ipb := TestBuildIPBlock(t, dbSession, "testIPBlock", site, tenant, IPBlockRoutingTypeDatacenterOnly, "192.0.2.0", 24, IPBlockProtocolVersionV4)
+
+	fip := TestBuildFloatingIP(t, dbSession, "web", tenant, site, ipb, "192.0.2.10")
+
+	fipsd := NewFloatingIPDAO(dbSession)
+
+	err := fipsd.Delete(ctx, nil, FloatingIPDeleteInput{FloatingIPID: fip.ID, UpdatedByID: user.ID})
+	require.NoError(t, err)
+
+	_, err = fipsd.GetByID(ctx, nil, fip.ID, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	// The address can be allocated again once the previous Floating IP is deleted
+	_, err = fipsd.Create(ctx, nil, FloatingIPCreateInput{
+		Name:        "replacement",
+		SiteID:      site.ID,
+		TenantID:    tenant.ID,
+		TenantOrg:   tenant.Org,
+		IPBlockID:   ipb.ID,
+		IPAddress:   fip.IPAddress,
+		Status:      FloatingIPStatusAvailable,
+		CreatedByID: user.ID,
+	})
+	assert.NoError(t, err)
+}
+
+func TestFloatingIP_GetCidr(t *testing.T) {
+	fip := &FloatingIP{IPAddress: "192.0.2.10"}
+	assert.Equal(t, "192.0.2.10/32", fip.GetCidr())
+}
@@ -180,6 +180,7 @@ type Instance struct {
 	Status                                 string                                  `bun:"status,notnull"`
 	PowerStatus                            *string                                 `bun:"power_status"`
 	IsMissingOnSite                        bool                                    `bun:"is_missing_on_site,notnull"`
+	ReplacesInstanceID                     *uuid.UUID                              `bun:"replaces_instance_id,type:uuid"`
 	Created                                time.Time                               `bun:"created,nullzero,notnull,default:current_timestamp"`
 	Updated                                time.Time                               `bun:"updated,nullzero,notnull,default:current_timestamp"`
 	Deleted                                *time.Time                              `bun:"deleted,soft_delete"`
@@ -213,7 +214,9 @@ type InstanceCreateInput struct {
 	TpmEkCertificate                       *string
 	Status                                 string
 	PowerStatus                            *string
-	CreatedBy                              uuid.UUID
+	// ReplacesInstanceID is the Instance the new Instance replaces, its Floating IPs move to the new Instance
+	ReplacesInstanceID *uuid.UUID
+	CreatedBy          uuid.UUID
 }
 
 // InstanceUpdateInput input parameters for Update method
@@ -276,6 +279,8 @@ type InstanceFilterInput struct {
 	OperatingSystemIDs        []uuid.UUID
 	Statuses                  []string
 	SearchQuery               *string
+	// IncludeDeleted includes soft-deleted Instances, e.g. to identify the Instance a new Instance replaces
+	IncludeDeleted bool
 }
 
 var _ bun.BeforeAppendModelHook = (*Instance)(nil)
@@ -565,6 +570,10 @@ func (isd InstanceSQLDAO) setQueryWithFilter(filter InstanceFilterInput, query *
 			isd.tracerSpan.SetAttribute(instanceDAOSpan, "search_query", *filter.SearchQuery)
 		}
 	}
+
+	if filter.IncludeDeleted {
+		query = query.WhereAllWithDeleted()
+	}
 	return query, nil
 }
 
@@ -824,6 +833,7 @@ func (isd InstanceSQLDAO) CreateMultiple(ctx context.Context, tx *db.Tx, inputs
 			TpmEkCertificate:                       input.TpmEkCertificate,
 			Status:                                 input.Status,
 			PowerStatus:                            input.PowerStatus,
+			ReplacesInstanceID:                     input.ReplacesInstanceID,
 			CreatedBy:                              input.CreatedBy,
 			Labels:                                 input.Labels,
 		}
@@ -197,7 +197,7 @@ func testInstanceBuildNetworkSecurityGroup(t *testing.T, dbSession *db.Session,
 		Status:    InstanceTypeStatusReady,
 		Rules: []*NetworkSecurityGroupRule{
 			&NetworkSecurityGroupRule{
-				&cwssaws.NetworkSecurityGroupRuleAttributes{
+				NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
 					Id:     db.GetStrPtr(uuid.NewString()),
 					Action: cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY,
 				},
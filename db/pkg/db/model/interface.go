@@ -137,6 +137,8 @@ type InterfaceFilterInput struct {
 	IsPhysical     *bool
 	Statuses       []string
 	IPAddresses    []string
+	// IncludeDeleted includes soft-deleted Interfaces, e.g. to look up the Interfaces of a replaced Instance
+	IncludeDeleted bool
 }
 
 // InterfaceClearInput input parameters for Clear method
@@ -312,6 +314,10 @@ func (ifcd InterfaceSQLDAO) setQueryWithFilter(filter InterfaceFilterInput, quer
 		}
 	}
 
+	if filter.IncludeDeleted {
+		query = query.WhereAllWithDeleted()
+	}
+
 	return query, nil
 }
 
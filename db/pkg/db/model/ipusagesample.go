@@ -0,0 +1,280 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	stracer "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/tracer"
+)
+
+const (
+	// IPUsageResourceTypeIPBlock indicates that the sample was recorded for an IP Block
+	IPUsageResourceTypeIPBlock = "IPBlock"
+	// IPUsageResourceTypeSubnet indicates that the sample was recorded for a Subnet
+	IPUsageResourceTypeSubnet = "Subnet"
+	// IPUsageResourceTypeVpcPrefix indicates that the sample was recorded for a VPC Prefix
+	IPUsageResourceTypeVpcPrefix = "VpcPrefix"
+
+	// IPUsageSampleOrderByDefault default field to be used for ordering when none specified
+	IPUsageSampleOrderByDefault = "created"
+)
+
+var (
+	// IPUsageSampleOrderByFields is a list of valid order by fields for the IPUsageSample model
+	IPUsageSampleOrderByFields = []string{"created", "used_ips"}
+	// IPUsageResourceTypeMap is a list of valid resource types for the IPUsageSample model
+	IPUsageResourceTypeMap = map[string]bool{
+		IPUsageResourceTypeIPBlock:   true,
+		IPUsageResourceTypeSubnet:    true,
+		IPUsageResourceTypeVpcPrefix: true,
+	}
+)
+
+// IPUsageSample is a point-in-time record of how many addresses of an IP Block, Subnet or VPC Prefix are in use
+type IPUsageSample struct {
+	bun.BaseModel `bun:"table:ip_usage_sample,alias:ius"`
+
+	ID           uuid.UUID `bun:"type:uuid,pk"`
+	ResourceType string    `bun:"resource_type,notnull"`
+	ResourceID   uuid.UUID `bun:"resource_id,type:uuid,notnull"`
+	SiteID       uuid.UUID `bun:"site_id,type:uuid,notnull"`
+	Prefix       string    `bun:"prefix,notnull"`
+	TotalIPs     int64     `bun:"total_ips,notnull"`
+	UsedIPs      int64     `bun:"used_ips,notnull"`
+	Created      time.Time `bun:"created,nullzero,notnull,default:current_timestamp"`
+}
+
+// GetUtilization returns the percentage of addresses in use
+func (ius *IPUsageSample) GetUtilization() float64 {
+	if ius.TotalIPs <= 0 {
+		return 0
+	}
+	return float64(ius.UsedIPs) * 100 / float64(ius.TotalIPs)
+}
+
+// IPUsageSampleCreateInput input parameters for Create method
+type IPUsageSampleCreateInput struct {
+	ResourceType string
+	ResourceID   uuid.UUID
+	SiteID       uuid.UUID
+	Prefix       string
+	TotalIPs     int64
+	UsedIPs      int64
+}
+
+// IPUsageSampleFilterInput input parameters for GetAll method
+type IPUsageSampleFilterInput struct {
+	ResourceTypes []string
+	ResourceIDs   []uuid.UUID
+	SiteIDs       []uuid.UUID
+	CreatedAfter  *time.Time
+}
+
+var _ bun.BeforeAppendModelHook = (*IPUsageSample)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (ius *IPUsageSample) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if ius.Created.IsZero() {
+			ius.Created = db.GetCurTime()
+		}
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*IPUsageSample)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (ius *IPUsageSample) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("site_id") REFERENCES "site" ("id")`)
+	return nil
+}
+
+// IPUsageSampleDAO is an interface for interacting with the IPUsageSample model
+type IPUsageSampleDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input IPUsageSampleCreateInput) (*IPUsageSample, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter IPUsageSampleFilterInput, page paginator.PageInput) ([]IPUsageSample, int, error)
+	//
+	GetLatestByResourceIDs(ctx context.Context, tx *db.Tx, resourceIDs []uuid.UUID) (map[uuid.UUID]*IPUsageSample, error)
+	//
+	DeleteCreatedBefore(ctx context.Context, tx *db.Tx, before time.Time) (int, error)
+}
+
+// IPUsageSampleSQLDAO is an implementation of the IPUsageSampleDAO interface
+type IPUsageSampleSQLDAO struct {
+	dbSession *db.Session
+	IPUsageSampleDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create records a new IPUsageSample from the given parameters
+func (iussd IPUsageSampleSQLDAO) Create(ctx context.Context, tx *db.Tx, input IPUsageSampleCreateInput) (*IPUsageSample, error) {
+	// Create a child span and set the attributes for current request
+	ctx, ipUsageSampleDAOSpan := iussd.tracerSpan.CreateChildInCurrentContext(ctx, "IPUsageSampleDAO.Create")
+	if ipUsageSampleDAOSpan != nil {
+		defer ipUsageSampleDAOSpan.End()
+
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "resource_id", input.ResourceID.String())
+	}
+
+	ius := &IPUsageSample{
+		ID:           uuid.New(),
+		ResourceType: input.ResourceType,
+		ResourceID:   input.ResourceID,
+		SiteID:       input.SiteID,
+		Prefix:       input.Prefix,
+		TotalIPs:     input.TotalIPs,
+		UsedIPs:      input.UsedIPs,
+	}
+
+	_, err := db.GetIDB(tx, iussd.dbSession).NewInsert().Model(ius).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ius, nil
+}
+
+// GetAll returns all IPUsageSamples with various optional filters
+// If no records found, then error is nil, but length of returned slice is 0
+// If orderBy is nil, then records are ordered by column specified
+// in IPUsageSampleOrderByDefault in ascending order
+func (iussd IPUsageSampleSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter IPUsageSampleFilterInput, page paginator.PageInput) ([]IPUsageSample, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, ipUsageSampleDAOSpan := iussd.tracerSpan.CreateChildInCurrentContext(ctx, "IPUsageSampleDAO.GetAll")
+	if ipUsageSampleDAOSpan != nil {
+		defer ipUsageSampleDAOSpan.End()
+	}
+
+	iuss := []IPUsageSample{}
+
+	query := db.GetIDB(tx, iussd.dbSession).NewSelect().Model(&iuss)
+
+	if filter.ResourceTypes != nil {
+		query = query.Where("ius.resource_type IN (?)", bun.In(filter.ResourceTypes))
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "resource_types", filter.ResourceTypes)
+	}
+
+	if filter.ResourceIDs != nil {
+		query = query.Where("ius.resource_id IN (?)", bun.In(filter.ResourceIDs))
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "resource_ids", filter.ResourceIDs)
+	}
+
+	if filter.SiteIDs != nil {
+		query = query.Where("ius.site_id IN (?)", bun.In(filter.SiteIDs))
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "site_ids", filter.SiteIDs)
+	}
+
+	if filter.CreatedAfter != nil {
+		query = query.Where("ius.created > ?", *filter.CreatedAfter)
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "created_after", filter.CreatedAfter.String())
+	}
+
+	// if no order is passed, set default to ensure consistent ordering for pagination.
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(IPUsageSampleOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, IPUsageSampleOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return iuss, paginator.Total, nil
+}
+
+// GetLatestByResourceIDs returns the most recent IPUsageSample of each of the given resources
+// Resources without any samples are not present in the returned map
+func (iussd IPUsageSampleSQLDAO) GetLatestByResourceIDs(ctx context.Context, tx *db.Tx, resourceIDs []uuid.UUID) (map[uuid.UUID]*IPUsageSample, error) {
+	// Create a child span and set the attributes for current request
+	ctx, ipUsageSampleDAOSpan := iussd.tracerSpan.CreateChildInCurrentContext(ctx, "IPUsageSampleDAO.GetLatestByResourceIDs")
+	if ipUsageSampleDAOSpan != nil {
+		defer ipUsageSampleDAOSpan.End()
+
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "resource_ids", resourceIDs)
+	}
+
+	latest := map[uuid.UUID]*IPUsageSample{}
+	if len(resourceIDs) == 0 {
+		return latest, nil
+	}
+
+	iuss := []IPUsageSample{}
+
+	err := db.GetIDB(tx, iussd.dbSession).NewSelect().Model(&iuss).
+		DistinctOn("ius.resource_id").
+		Where("ius.resource_id IN (?)", bun.In(resourceIDs)).
+		OrderExpr("ius.resource_id, ius.created DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range iuss {
+		latest[iuss[i].ResourceID] = &iuss[i]
+	}
+
+	return latest, nil
+}
+
+// DeleteCreatedBefore permanently deletes all IPUsageSamples recorded before the given time
+// Returns the number of deleted samples
+func (iussd IPUsageSampleSQLDAO) DeleteCreatedBefore(ctx context.Context, tx *db.Tx, before time.Time) (int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, ipUsageSampleDAOSpan := iussd.tracerSpan.CreateChildInCurrentContext(ctx, "IPUsageSampleDAO.DeleteCreatedBefore")
+	if ipUsageSampleDAOSpan != nil {
+		defer ipUsageSampleDAOSpan.End()
+
+		iussd.tracerSpan.SetAttribute(ipUsageSampleDAOSpan, "before", before.String())
+	}
+
+	res, err := db.GetIDB(tx, iussd.dbSession).NewDelete().Model((*IPUsageSample)(nil)).Where("created < ?", before).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// NewIPUsageSampleDAO returns a new IPUsageSampleDAO
+func NewIPUsageSampleDAO(dbSession *db.Session) IPUsageSampleDAO {
+	return &IPUsageSampleSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
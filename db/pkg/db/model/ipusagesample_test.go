@@ -0,0 +1,261 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	stracer "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/tracer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+// reset the tables needed for IPUsageSample tests
+func testIPUsageSampleSetupSchema(t *testing.T, dbSession *db.Session) {
+	testInterfaceSetupSchema(t, dbSession)
+	// create IP usage sample table
+	err := dbSession.DB.ResetModel(context.Background(), (*IPUsageSample)(nil))
+	assert.Nil(t, err)
+}
+
+func TestIPUsageSampleSQLDAO_Create(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testIPUsageSampleSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+
+	iussd := NewIPUsageSampleDAO(dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx = testCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		desc               string
+		input              IPUsageSampleCreateInput
+		expectError        bool
+		verifyChildSpanner bool
+	}{
+		{
+			desc: "success - record sample for IP Block",
+			input: IPUsageSampleCreateInput{
+				ResourceType: IPUsageResourceTypeIPBlock,
+				ResourceID:   uuid.New(),
+				SiteID:       site.ID,
+				Prefix:       "192.0.2.0/24",
+				TotalIPs:     256,
+				UsedIPs:      64,
+			},
+			verifyChildSpanner: true,
+		},
+		{
+			desc: "error - when foreign key fails on Site ID",
+			input: IPUsageSampleCreateInput{
+				ResourceType: IPUsageResourceTypeSubnet,
+				ResourceID:   uuid.New(),
+				SiteID:       uuid.New(),
+				Prefix:       "192.0.2.0/28",
+				TotalIPs:     16,
+				UsedIPs:      2,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := iussd.Create(ctx, nil, tc.input)
+			assert.Equal(t, tc.expectError, err != nil)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, tc.input.ResourceType, got.ResourceType)
+			assert.Equal(t, tc.input.ResourceID, got.ResourceID)
+			assert.Equal(t, tc.input.TotalIPs, got.TotalIPs)
+			assert.Equal(t, tc.input.UsedIPs, got.UsedIPs)
+			assert.False(t, got.Created.IsZero())
+
+			if tc.verifyChildSpanner {
+				span := otrace.SpanFromContext(ctx)
+				assert.True(t, span.SpanContext().IsValid())
+				_, ok := ctx.Value(stracer.TracerKey).(otrace.Tracer)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestIPUsageSampleSQLDAO_GetAll(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testIPUsageSampleSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+
+	ipbID := uuid.New()
+	subnetID := uuid.New()
+	now := time.Now().UTC()
+
+	for i := 0; i < 5; i++ {
+		TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeIPBlock, ipbID, site, "192.0.2.0/24", 256, int64(10*i), now.Add(time.Duration(i-5)*24*time.Hour))
+	}
+	TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeSubnet, subnetID, site, "192.0.2.0/28", 16, 3, now)
+
+	iussd := NewIPUsageSampleDAO(dbSession)
+
+	tests := []struct {
+		desc          string
+		filter        IPUsageSampleFilterInput
+		expectedCount int
+	}{
+		{
+			desc:          "no filter returns all samples",
+			filter:        IPUsageSampleFilterInput{},
+			expectedCount: 6,
+		},
+		{
+			desc:          "filter by resource ID",
+			filter:        IPUsageSampleFilterInput{ResourceIDs: []uuid.UUID{ipbID}},
+			expectedCount: 5,
+		},
+		{
+			desc:          "filter by resource type",
+			filter:        IPUsageSampleFilterInput{ResourceTypes: []string{IPUsageResourceTypeSubnet}},
+			expectedCount: 1,
+		},
+		{
+			desc:          "filter by created after",
+			filter:        IPUsageSampleFilterInput{ResourceIDs: []uuid.UUID{ipbID}, CreatedAfter: db.GetTimePtr(now.Add(-3*24*time.Hour - time.Minute))},
+			expectedCount: 3,
+		},
+		{
+			desc:          "filter by Site ID with no samples",
+			filter:        IPUsageSampleFilterInput{SiteIDs: []uuid.UUID{uuid.New()}},
+			expectedCount: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, total, err := iussd.GetAll(ctx, nil, tc.filter, paginator.PageInput{Limit: db.GetIntPtr(paginator.TotalLimit)})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCount, total)
+			assert.Equal(t, tc.expectedCount, len(got))
+
+			// Samples are returned oldest first by default
+			for i := 1; i < len(got); i++ {
+				assert.False(t, got[i].Created.Before(got[i-1].Created))
+			}
+		})
+	}
+}
+
+func TestIPUsageSampleSQLDAO_GetLatestByResourceIDs(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testIPUsageSampleSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+
+	ipbID := uuid.New()
+	subnetID := uuid.New()
+	now := time.Now().UTC()
+
+	TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeIPBlock, ipbID, site, "192.0.2.0/24", 256, 10, now.Add(-2*time.Hour))
+	latest := TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeIPBlock, ipbID, site, "192.0.2.0/24", 256, 20, now.Add(-time.Hour))
+	TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeSubnet, subnetID, site, "192.0.2.0/28", 16, 3, now)
+
+	iussd := NewIPUsageSampleDAO(dbSession)
+
+	got, err := iussd.GetLatestByResourceIDs(ctx, nil, []uuid.UUID{ipbID, subnetID, uuid.New()})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, latest.ID, got[ipbID].ID)
+	assert.Equal(t, int64(3), got[subnetID].UsedIPs)
+
+	got, err = iussd.GetLatestByResourceIDs(ctx, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestIPUsageSampleSQLDAO_DeleteCreatedBefore(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testIPUsageSampleSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+
+	ipbID := uuid.New()
+	now := time.Now().UTC()
+
+	TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeIPBlock, ipbID, site, "192.0.2.0/24", 256, 10, now.Add(-100*24*time.Hour))
+	TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeIPBlock, ipbID, site, "192.0.2.0/24", 256, 20, now.Add(-95*24*time.Hour))
+	TestBuildIPUsageSample(t, dbSession, IPUsageResourceTypeIPBlock, ipbID, site, "192.0.2.0/24", 256, 30, now)
+
+	iussd := NewIPUsageSampleDAO(dbSession)
+
+	count, err := iussd.DeleteCreatedBefore(ctx, nil, now.Add(-90*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	got, total, err := iussd.GetAll(ctx, nil, IPUsageSampleFilterInput{ResourceIDs: []uuid.UUID{ipbID}}, paginator.PageInput{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, int64(30), got[0].UsedIPs)
+}
+
+func TestIPUsageSample_GetUtilization(t *testing.T) {
+	tests := []struct {
+		desc     string
+		sample   IPUsageSample
+		expected float64
+	}{
+		{
+			desc:     "partially used",
+			sample:   IPUsageSample{TotalIPs: 256, UsedIPs: 64},
+			expected: 25,
+		},
+		{
+			desc:     "fully used",
+			sample:   IPUsageSample{TotalIPs: 16, UsedIPs: 16},
+			expected: 100,
+		},
+		{
+			desc:     "empty prefix",
+			sample:   IPUsageSample{TotalIPs: 0, UsedIPs: 0},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.sample.GetUtilization())
+		})
+	}
+}
@@ -20,6 +20,7 @@ package model
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -119,6 +120,31 @@ func (s *NetworkSecurityGroup) GetRulesAsProtoRefs() []*cwssaws.NetworkSecurityG
 // that understands how to work with protobuf messages
 type NetworkSecurityGroupRule struct {
 	*cwssaws.NetworkSecurityGroupRuleAttributes
+	// SourceAddressGroupID, if set, is the AddressGroup whose prefixes
+	// replace the literal source prefix when the rule is sent to Site
+	SourceAddressGroupID *string
+	// DestinationAddressGroupID, if set, is the AddressGroup whose prefixes
+	// replace the literal destination prefix when the rule is sent to Site
+	DestinationAddressGroupID *string
+}
+
+// networkSecurityGroupRuleReferences holds the cloud-only fields of a rule
+// that are stored next to the protobuf fields in the JSON representation
+type networkSecurityGroupRuleReferences struct {
+	SourceAddressGroupID      *string `json:"sourceAddressGroupId,omitempty"`
+	DestinationAddressGroupID *string `json:"destinationAddressGroupId,omitempty"`
+}
+
+// AddressGroupIDs returns the IDs of the AddressGroups referenced by the rule
+func (s *NetworkSecurityGroupRule) AddressGroupIDs() []string {
+	ids := []string{}
+	if s.SourceAddressGroupID != nil {
+		ids = append(ids, *s.SourceAddressGroupID)
+	}
+	if s.DestinationAddressGroupID != nil {
+		ids = append(ids, *s.DestinationAddressGroupID)
+	}
+	return ids
 }
 
 func (s *NetworkSecurityGroupRule) UnmarshalJSON(b []byte) error {
@@ -135,11 +161,60 @@ func (s *NetworkSecurityGroupRule) UnmarshalJSON(b []byte) error {
 	// If they then save the change, the record on site would lose the detail.
 	_ = protoJsonUnmarshalOptions.Unmarshal(b, s)
 
+	refs := networkSecurityGroupRuleReferences{}
+	if err := json.Unmarshal(b, &refs); err == nil {
+		s.SourceAddressGroupID = refs.SourceAddressGroupID
+		s.DestinationAddressGroupID = refs.DestinationAddressGroupID
+	}
+
 	return nil
 }
 
 func (s *NetworkSecurityGroupRule) MarshalJSON() ([]byte, error) {
-	return protojson.Marshal(s)
+	b, err := protojson.Marshal(s)
+	if err != nil || (s.SourceAddressGroupID == nil && s.DestinationAddressGroupID == nil) {
+		return b, err
+	}
+
+	// Merge the address group references into the protobuf JSON
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	if s.SourceAddressGroupID != nil {
+		fields["sourceAddressGroupId"], _ = json.Marshal(*s.SourceAddressGroupID)
+	}
+	if s.DestinationAddressGroupID != nil {
+		fields["destinationAddressGroupId"], _ = json.Marshal(*s.DestinationAddressGroupID)
+	}
+
+	return json.Marshal(fields)
+}
+
+// HasAddressGroupReferences returns true if any rule of the NetworkSecurityGroup references an AddressGroup
+func (s *NetworkSecurityGroup) HasAddressGroupReferences() bool {
+	for _, rule := range s.Rules {
+		if rule != nil && len(rule.AddressGroupIDs()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferencesAddressGroup returns true if any rule of the NetworkSecurityGroup references the given AddressGroup
+func (s *NetworkSecurityGroup) ReferencesAddressGroup(addressGroupID string) bool {
+	for _, rule := range s.Rules {
+		if rule == nil {
+			continue
+		}
+		for _, id := range rule.AddressGroupIDs() {
+			if id == addressGroupID {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // A light wrapper around the protobuf so
@@ -71,7 +71,7 @@ func TestNetworkSecurityGroupSQLDAO_Create(t *testing.T) {
 	labels["key"] = "value"
 
 	rule := &NetworkSecurityGroupRule{
-		&cwssaws.NetworkSecurityGroupRuleAttributes{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
 			Id:             db.GetStrPtr(uuid.NewString()),
 			Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_EGRESS,
 			Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_ANY,
@@ -514,11 +514,11 @@ func TestNetworkSecurityGroupSQLDAO_Update(t *testing.T) {
 	labels["key"] = "value"
 
 	rules := []*NetworkSecurityGroupRule{
-		&NetworkSecurityGroupRule{&cwssaws.NetworkSecurityGroupRuleAttributes{}},
+		&NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{}},
 	}
 
 	badRules := []*NetworkSecurityGroupRule{
-		&NetworkSecurityGroupRule{&cwssaws.NetworkSecurityGroupRuleAttributes{}},
+		&NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{}},
 		nil,
 	}
 
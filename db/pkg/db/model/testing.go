@@ -20,6 +20,7 @@ package model
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -128,6 +129,15 @@ func TestSetupSchema(t *testing.T, dbSession *db.Session) {
 	// create network security group table
 	err = dbSession.DB.ResetModel(context.Background(), (*NetworkSecurityGroup)(nil))
 	assert.Nil(t, err)
+	// create address group table
+	err = dbSession.DB.ResetModel(context.Background(), (*AddressGroup)(nil))
+	assert.Nil(t, err)
+	// create floating ip table
+	err = dbSession.DB.ResetModel(context.Background(), (*FloatingIP)(nil))
+	assert.Nil(t, err)
+	// create ip usage sample table
+	err = dbSession.DB.ResetModel(context.Background(), (*IPUsageSample)(nil))
+	assert.Nil(t, err)
 	// create sku table
 	err = dbSession.DB.ResetModel(context.Background(), (*SKU)(nil))
 	assert.Nil(t, err)
@@ -471,3 +481,53 @@ func TestBuildNetworkSecurityGroup(t *testing.T, dbSession *db.Session, name str
 	assert.Nil(t, err)
 	return nsg
 }
+
+// TestBuildAddressGroup creates a test Address Group
+func TestBuildAddressGroup(t *testing.T, dbSession *db.Session, name string, tn *Tenant, st *Site, prefixes []string) *AddressGroup {
+	ag := &AddressGroup{
+		ID:        uuid.New(),
+		Name:      name,
+		SiteID:    st.ID,
+		TenantOrg: tn.Org,
+		TenantID:  tn.ID,
+		Prefixes:  prefixes,
+		Status:    AddressGroupStatusReady,
+	}
+	_, err := dbSession.DB.NewInsert().Model(ag).Exec(context.Background())
+	assert.Nil(t, err)
+	return ag
+}
+
+// TestBuildFloatingIP creates a test Floating IP
+func TestBuildFloatingIP(t *testing.T, dbSession *db.Session, name string, tn *Tenant, st *Site, ipb *IPBlock, ipAddress string) *FloatingIP {
+	fip := &FloatingIP{
+		ID:        uuid.New(),
+		Name:      name,
+		SiteID:    st.ID,
+		TenantOrg: tn.Org,
+		TenantID:  tn.ID,
+		IPBlockID: ipb.ID,
+		IPAddress: ipAddress,
+		Status:    FloatingIPStatusAvailable,
+	}
+	_, err := dbSession.DB.NewInsert().Model(fip).Exec(context.Background())
+	assert.Nil(t, err)
+	return fip
+}
+
+// TestBuildIPUsageSample creates a test IP usage sample recorded at the given time
+func TestBuildIPUsageSample(t *testing.T, dbSession *db.Session, resourceType string, resourceID uuid.UUID, st *Site, prefix string, totalIPs, usedIPs int64, created time.Time) *IPUsageSample {
+	ius := &IPUsageSample{
+		ID:           uuid.New(),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		SiteID:       st.ID,
+		Prefix:       prefix,
+		TotalIPs:     totalIPs,
+		UsedIPs:      usedIPs,
+		Created:      created,
+	}
+	_, err := dbSession.DB.NewInsert().Model(ius).Exec(context.Background())
+	assert.Nil(t, err)
+	return ius
+}
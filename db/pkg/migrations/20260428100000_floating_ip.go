@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uptrace/bun"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Start transactions
+		tx, terr := db.BeginTx(ctx, &sql.TxOptions{})
+		if terr != nil {
+			handlePanic(terr, "failed to begin transaction")
+		}
+
+		// Create FloatingIP table
+		_, err := tx.NewCreateTable().Model((*model.FloatingIP)(nil)).IfNotExists().Exec(ctx)
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS floating_ip_site_id_ip_address_idx")
+		handleError(tx, err)
+
+		// Add unique index for site_id and ip_address, an address can only be allocated once per Site
+		_, err = tx.Exec("CREATE UNIQUE INDEX floating_ip_site_id_ip_address_idx ON floating_ip(site_id, ip_address) WHERE deleted IS NULL")
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS floating_ip_tenant_id_site_id_idx")
+		handleError(tx, err)
+
+		// Add index for tenant_id and site_id
+		_, err = tx.Exec("CREATE INDEX floating_ip_tenant_id_site_id_idx ON floating_ip(tenant_id, site_id) WHERE deleted IS NULL")
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS floating_ip_instance_id_idx")
+		handleError(tx, err)
+
+		// Add index for instance_id, used to find Floating IPs when an Instance is deleted
+		_, err = tx.Exec("CREATE INDEX floating_ip_instance_id_idx ON floating_ip(instance_id) WHERE deleted IS NULL")
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS floating_ip_created_idx")
+		handleError(tx, err)
+
+		// Add index for created timestamp for default ordering
+		_, err = tx.Exec("CREATE INDEX floating_ip_created_idx ON floating_ip(created)")
+		handleError(tx, err)
+
+		terr = tx.Commit()
+		if terr != nil {
+			handlePanic(terr, "failed to commit transaction")
+		}
+
+		fmt.Print(" [up migration] Created 'floating_ip' table and created indices successfully. ")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] No action taken")
+		return nil
+	})
+}
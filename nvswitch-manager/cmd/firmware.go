@@ -18,6 +18,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager/packages"
 
 	log "github.com/sirupsen/logrus"
@@ -28,6 +29,8 @@ var (
 	fwPackagesDir   string
 	fwFirmwareDir   string
 	fwBundleVersion string
+	fwTrustBundle   string
+	fwAllowUnsigned bool
 )
 
 // firmwareCmd represents the firmware command group
@@ -160,10 +163,10 @@ var firmwareShowCmd = &cobra.Command{
 	},
 }
 
-// firmwareValidateCmd validates a bundle's files exist
+// firmwareValidateCmd validates a bundle's files exist and are the signed ones
 var firmwareValidateCmd = &cobra.Command{
 	Use:   "validate",
-	Short: "Validate firmware bundle files exist",
+	Short: "Validate firmware bundle files exist and match the signed bundle",
 	Run: func(cmd *cobra.Command, args []string) {
 		if fwBundleVersion == "" {
 			log.Fatal("Bundle version is required (--version)")
@@ -179,6 +182,11 @@ var firmwareValidateCmd = &cobra.Command{
 			log.Fatalf("Bundle not found: %v", err)
 		}
 
+		verifier, err := fwverify.New(fwverify.Config{TrustBundle: fwTrustBundle, AllowUnsigned: fwAllowUnsigned})
+		if err != nil {
+			log.Fatalf("Failed to load trust bundle: %v", err)
+		}
+
 		fmt.Printf("Validating bundle %s...\n\n", pkg.Version)
 
 		allValid := true
@@ -193,7 +201,14 @@ var firmwareValidateCmd = &cobra.Command{
 				continue
 			}
 
-			fmt.Printf("  [OK]   %s: %s (%d bytes)\n", strings.ToUpper(compName), filepath.Base(comp.File), info.Size())
+			signedBy, err := registry.Verify(verifier, pkg, compName)
+			if err != nil {
+				fmt.Printf("  [FAIL] %s: %v\n", strings.ToUpper(compName), err)
+				allValid = false
+				continue
+			}
+
+			fmt.Printf("  [OK]   %s: %s (%d bytes, %s, signed by %s)\n", strings.ToUpper(compName), filepath.Base(comp.File), info.Size(), comp.Checksum, signedBy)
 		}
 
 		fmt.Println()
@@ -222,4 +237,6 @@ func init() {
 
 	firmwareShowCmd.Flags().StringVar(&fwBundleVersion, "version", "", "Bundle version to show")
 	firmwareValidateCmd.Flags().StringVar(&fwBundleVersion, "version", "", "Bundle version to validate")
+	firmwareValidateCmd.Flags().StringVar(&fwTrustBundle, "trust-bundle", "", "PEM file of the public keys and CA certificates the bundle signature is verified against")
+	firmwareValidateCmd.Flags().BoolVar(&fwAllowUnsigned, "allow-unsigned", false, "Accept a bundle without a signature")
 }
@@ -14,6 +14,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/proto/v1"
@@ -27,17 +28,20 @@ import (
 
 var (
 	// Register command flags
-	regServerAddr string
-	regBmcMAC     string
-	regBmcIP      string
-	regBmcPort    int
-	regBmcUser    string
-	regBmcPass    string
-	regNvosMAC    string
-	regNvosIP     string
-	regNvosPort   int
-	regNvosUser   string
-	regNvosPass   string
+	regServerAddr  string
+	regBmcMAC      string
+	regBmcIP       string
+	regBmcPort     int
+	regBmcUser     string
+	regBmcPass     string
+	regNvosMAC     string
+	regNvosIP      string
+	regNvosPort    int
+	regNvosUser    string
+	regNvosPass    string
+	regNvosKeyFile string
+	regUUID        string
+	regHostKey     string
 )
 
 // registerCmd represents the register command group
@@ -56,7 +60,10 @@ Examples:
     --nvos-mac 00:00:00:00:00:02 --nvos-ip 192.168.1.101 --nvos-user admin --nvos-pass password
 
   # List registered switches
-  nvswitch-manager register list --server localhost:50051`,
+  nvswitch-manager register list --server localhost:50051
+
+  # Re-pin the NVOS host key after replacing a switch
+  nvswitch-manager register rekey --uuid <uuid> --host-key "ssh-ed25519 AAAA..."`,
 }
 
 // registerSwitchCmd registers a single switch
@@ -86,6 +93,15 @@ var registerSwitchCmd = &cobra.Command{
 
 		client := pb.NewNVSwitchManagerClient(conn)
 
+		var nvosKey string
+		if regNvosKeyFile != "" {
+			data, err := os.ReadFile(regNvosKeyFile)
+			if err != nil {
+				log.Fatalf("Failed to read NVOS SSH key file: %v", err)
+			}
+			nvosKey = string(data)
+		}
+
 		// Create registration request
 		req := &pb.RegisterNVSwitchesRequest{
 			RegistrationRequests: []*pb.RegisterNVSwitchRequest{
@@ -105,8 +121,9 @@ var registerSwitchCmd = &cobra.Command{
 						IpAddress:  regNvosIP,
 						Port:       int32(regNvosPort),
 						Credentials: &pb.Credentials{
-							Username: regNvosUser,
-							Password: regNvosPass,
+							Username:      regNvosUser,
+							Password:      regNvosPass,
+							SshPrivateKey: nvosKey,
 						},
 					},
 				},
@@ -179,6 +196,9 @@ var registerListCmd = &cobra.Command{
 				fmt.Printf("    Port:    %d\n", sw.Nvos.Port)
 				fmt.Printf("    MAC:     %s\n", sw.Nvos.MacAddress)
 				fmt.Printf("    Version: %s\n", sw.Nvos.Version)
+				if sw.Nvos.HostKeyFingerprint != "" {
+					fmt.Printf("    HostKey: %s\n", sw.Nvos.HostKeyFingerprint)
+				}
 			}
 			if sw.Chassis != nil {
 				fmt.Printf("  Chassis:\n")
@@ -190,6 +210,55 @@ var registerListCmd = &cobra.Command{
 	},
 }
 
+// registerRekeyCmd replaces the pinned NVOS host key of a switch
+var registerRekeyCmd = &cobra.Command{
+	Use:   "rekey",
+	Short: "Replace the pinned NVOS SSH host key of a switch",
+	Long: `Replace the pinned NVOS SSH host key of a registered switch, e.g. after a
+legitimate switch replacement. Without --host-key the pin is cleared and the
+next connection pins whatever key the switch presents.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if regUUID == "" {
+			log.Fatal("Switch UUID is required")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		conn, err := grpc.DialContext(ctx, regServerAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		)
+		if err != nil {
+			log.Fatalf("Failed to connect to server %s: %v", regServerAddr, err)
+		}
+		defer conn.Close()
+
+		client := pb.NewNVSwitchManagerClient(conn)
+
+		resp, err := client.RekeyNVOSHostKey(ctx, &pb.RekeyNVOSHostKeyRequest{
+			Uuid:    regUUID,
+			HostKey: regHostKey,
+		})
+		if err != nil {
+			log.Fatalf("Failed to re-key switch: %v", err)
+		}
+
+		if resp.Status != pb.StatusCode_SUCCESS {
+			fmt.Printf("Re-key failed:\n")
+			fmt.Printf("  Status: %s\n", resp.Status.String())
+			fmt.Printf("  Error:  %s\n", resp.Error)
+			return
+		}
+
+		if resp.HostKeyFingerprint == "" {
+			fmt.Printf("Host key pin cleared for %s; the next connection pins a new key\n", resp.Uuid)
+		} else {
+			fmt.Printf("Host key for %s pinned to %s\n", resp.Uuid, resp.HostKeyFingerprint)
+		}
+	},
+}
+
 // registerBundlesCmd lists available firmware bundles
 var registerBundlesCmd = &cobra.Command{
 	Use:   "bundles",
@@ -249,8 +318,14 @@ func init() {
 	registerSwitchCmd.Flags().IntVar(&regNvosPort, "nvos-port", 0, "NVOS port (0 = default 22)")
 	registerSwitchCmd.Flags().StringVar(&regNvosUser, "nvos-user", "admin", "NVOS username")
 	registerSwitchCmd.Flags().StringVar(&regNvosPass, "nvos-pass", "", "NVOS password (required)")
+	registerSwitchCmd.Flags().StringVar(&regNvosKeyFile, "nvos-key-file", "", "NVOS SSH private key file (optional, preferred over the password)")
+
+	// Re-key flags
+	registerRekeyCmd.Flags().StringVar(&regUUID, "uuid", "", "Switch UUID (required)")
+	registerRekeyCmd.Flags().StringVar(&regHostKey, "host-key", "", "New NVOS host key in authorized_keys format (empty = clear the pin)")
 
 	registerCmd.AddCommand(registerSwitchCmd)
 	registerCmd.AddCommand(registerListCmd)
+	registerCmd.AddCommand(registerRekeyCmd)
 	registerCmd.AddCommand(registerBundlesCmd)
 }
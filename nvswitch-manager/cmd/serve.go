@@ -26,9 +26,12 @@ import (
 
 	svc "github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/service"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentials"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/rollout"
 )
 
 // getEnvOrDefault returns the value of an environment variable or a default value.
@@ -49,6 +52,26 @@ func getEnvIntOrDefault(envVar string, defaultVal int) int {
 	return defaultVal
 }
 
+// getEnvBoolOrDefault returns the bool value of an environment variable or a default value.
+func getEnvBoolOrDefault(envVar string, defaultVal bool) bool {
+	if val := os.Getenv(envVar); val != "" {
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+	return defaultVal
+}
+
+// getEnvDurationOrDefault returns the duration value of an environment variable or a default value.
+func getEnvDurationOrDefault(envVar string, defaultVal time.Duration) time.Duration {
+	if val := os.Getenv(envVar); val != "" {
+		if d, err := time.ParseDuration(val); err == nil {
+			return d
+		}
+	}
+	return defaultVal
+}
+
 const (
 	// default service config
 	defaultServicePort   = 50051
@@ -91,10 +114,24 @@ var (
 	vaultAddress string
 
 	// Firmware config
-	firmwarePackagesDir string
-	firmwareFirmwareDir string
-	firmwareNumWorkers  int
-	firmwarePollSeconds int
+	firmwarePackagesDir   string
+	firmwareFirmwareDir   string
+	firmwareNumWorkers    int
+	firmwarePollSeconds   int
+	firmwareTrustBundle   string
+	firmwareAllowUnsigned bool
+
+	// Credential rotation config
+	bmcCredentialRotationInterval  time.Duration
+	nvosCredentialRotationInterval time.Duration
+
+	// Event config
+	eventListenAddress string
+	eventDestination   string
+	eventToken         string
+
+	// Rollout config
+	rolloutCheckInterval time.Duration
 )
 
 // serveCmd represents the serve command
@@ -130,6 +167,20 @@ func init() {
 	serveCmd.Flags().StringVar(&firmwareFirmwareDir, "fw_firmware_dir", getEnvOrDefault("FW_FIRMWARE_DIR", defaultFirmwareFirmwareDir), "Firmware files directory (env: FW_FIRMWARE_DIR)")
 	serveCmd.Flags().IntVar(&firmwareNumWorkers, "fw_workers", getEnvIntOrDefault("FW_WORKERS", defaultFirmwareNumWorkers), "Number of firmware update workers (env: FW_WORKERS)")
 	serveCmd.Flags().IntVar(&firmwarePollSeconds, "fw_poll_seconds", getEnvIntOrDefault("FW_POLL_SECONDS", defaultFirmwarePollSeconds), "Worker poll interval in seconds (env: FW_POLL_SECONDS)")
+	serveCmd.Flags().StringVar(&firmwareTrustBundle, "fw_trust_bundle", getEnvOrDefault("FW_TRUST_BUNDLE", ""), "PEM file of the public keys and CA certificates bundle signatures are verified against (env: FW_TRUST_BUNDLE)")
+	serveCmd.Flags().BoolVar(&firmwareAllowUnsigned, "fw_allow_unsigned", getEnvBoolOrDefault("FW_ALLOW_UNSIGNED", false), "Allow updates from bundles without a signature; checksums are still verified (env: FW_ALLOW_UNSIGNED)")
+
+	// Credential rotation flags
+	serveCmd.Flags().DurationVar(&bmcCredentialRotationInterval, "bmc_credential_rotation_interval", getEnvDurationOrDefault("NSM_BMC_CREDENTIAL_ROTATION_INTERVAL", 0), "Maximum age of a BMC password before it is rotated, e.g. 2160h for 90 days; 0 disables scheduled rotation (env: NSM_BMC_CREDENTIAL_ROTATION_INTERVAL)")
+	serveCmd.Flags().DurationVar(&nvosCredentialRotationInterval, "nvos_credential_rotation_interval", getEnvDurationOrDefault("NSM_NVOS_CREDENTIAL_ROTATION_INTERVAL", 0), "Maximum age of an NVOS password before it is rotated, e.g. 2160h for 90 days; 0 disables scheduled rotation (env: NSM_NVOS_CREDENTIAL_ROTATION_INTERVAL)")
+
+	// Event flags
+	serveCmd.Flags().StringVar(&eventListenAddress, "event_listen_address", getEnvOrDefault("NSM_EVENT_LISTEN_ADDRESS", ""), "Listen address of the Redfish event receiver, e.g. :8081; empty disables it (env: NSM_EVENT_LISTEN_ADDRESS)")
+	serveCmd.Flags().StringVar(&eventDestination, "event_destination", getEnvOrDefault("NSM_EVENT_DESTINATION", ""), "URL switch BMCs post Redfish events to, e.g. http://nsm:8081/redfish/events; empty uses Server-Sent Events instead (env: NSM_EVENT_DESTINATION)")
+	serveCmd.Flags().StringVar(&eventToken, "event_token", getEnvOrDefault("NSM_EVENT_TOKEN", ""), "Shared token BMCs must send with pushed Redfish events (env: NSM_EVENT_TOKEN)")
+
+	// Rollout flags
+	serveCmd.Flags().DurationVar(&rolloutCheckInterval, "rollout_check_interval", getEnvDurationOrDefault("NSM_ROLLOUT_CHECK_INTERVAL", rollout.DefaultCheckInterval), "How often firmware rollouts check their waves and health gates (env: NSM_ROLLOUT_CHECK_INTERVAL)")
 }
 
 func doServe() {
@@ -155,6 +206,20 @@ func doServe() {
 				FirmwareDir:       firmwareFirmwareDir,
 				NumWorkers:        firmwareNumWorkers,
 				SchedulerInterval: time.Duration(firmwarePollSeconds) * time.Second,
+				TrustBundle:       firmwareTrustBundle,
+				AllowUnsigned:     firmwareAllowUnsigned,
+			},
+			CredentialRotationConf: credentialrotation.Config{
+				BMCInterval:  bmcCredentialRotationInterval,
+				NVOSInterval: nvosCredentialRotationInterval,
+			},
+			EventConf: eventmanager.Config{
+				ListenAddress: eventListenAddress,
+				Destination:   eventDestination,
+				Token:         eventToken,
+			},
+			RolloutConf: rollout.Config{
+				CheckInterval: rolloutCheckInterval,
 			},
 		},
 	)
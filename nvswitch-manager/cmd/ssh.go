@@ -33,6 +33,7 @@ var (
 	sshCommand    string
 	sshLocalPath  string
 	sshRemotePath string
+	sshHostKey    string
 )
 
 // sshCmd represents the ssh command group
@@ -71,7 +72,17 @@ func newSSHClient() (*sshclient.NVOSClient, error) {
 		return nil, fmt.Errorf("failed to create NVOS: %v", err)
 	}
 
-	client, err := sshclient.NewWithPort(context.Background(), n, sshPort)
+	// Ad-hoc access targets switches outside the registry, so the host key is
+	// only verified when given explicitly.
+	var opts []sshclient.Option
+	if sshHostKey != "" {
+		n.SetHostKey(sshHostKey)
+	} else {
+		log.Warnf("No --host-key given; not verifying the host key of %s", sshIP)
+		opts = append(opts, sshclient.WithInsecureHostKey())
+	}
+
+	client, err := sshclient.NewWithPort(context.Background(), n, sshPort, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SSH client: %v", err)
 	}
@@ -281,6 +292,7 @@ func init() {
 	sshCmd.PersistentFlags().IntVar(&sshPort, "port", 22, "SSH port (default 22)")
 	sshCmd.PersistentFlags().StringVarP(&sshUsername, "user", "u", "admin", "SSH username")
 	sshCmd.PersistentFlags().StringVarP(&sshPassword, "pass", "p", "", "SSH password (required)")
+	sshCmd.PersistentFlags().StringVar(&sshHostKey, "host-key", "", "Expected SSH host key in authorized_keys format (empty = not verified)")
 
 	// Add subcommands
 	sshCmd.AddCommand(sshExecCmd)
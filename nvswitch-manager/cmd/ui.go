@@ -49,6 +49,7 @@ var uiCmd = &cobra.Command{
 The UI provides:
   - List of registered NV-switches
   - Firmware update monitoring with filtering
+  - Staged firmware rollouts with canary waves and health gates
   - Quick actions for power cycle and updates
 
 Example:
@@ -104,6 +105,7 @@ func runUI() {
 	http.HandleFunc("/", server.handleIndex)
 	http.HandleFunc("/switches", server.handleSwitches)
 	http.HandleFunc("/updates", server.handleUpdates)
+	http.HandleFunc("/rollouts", server.handleRollouts)
 	http.HandleFunc("/api/switches", server.handleAPISwitches)
 	http.HandleFunc("/api/updates", server.handleAPIUpdates)
 	http.HandleFunc("/api/bundles", server.handleAPIBundles)
@@ -112,6 +114,8 @@ func runUI() {
 	http.HandleFunc("/api/cancel-update", server.handleAPICancelUpdate)
 	http.HandleFunc("/api/register-switch", server.handleAPIRegisterSwitch)
 	http.HandleFunc("/api/update-log", server.handleAPIUpdateLog)
+	http.HandleFunc("/api/create-rollout", server.handleAPICreateRollout)
+	http.HandleFunc("/api/rollout-action", server.handleAPIRolloutAction)
 
 	addr := fmt.Sprintf(":%d", uiPort)
 	log.Infof("Starting dev UI at http://localhost%s (gRPC: %s)", addr, uiGRPCServer)
@@ -601,6 +605,176 @@ func (s *UIServer) handleUpdates(w http.ResponseWriter, r *http.Request) {
 	s.templates.ExecuteTemplate(w, "layout.html", data)
 }
 
+// RolloutView is a rollout prepared for the rollouts page
+type RolloutView struct {
+	Rollout     *pb.Rollout
+	State       string
+	StateClass  string
+	Components  string
+	WavePercent string
+	Progress    string
+	Waves       []RolloutWaveView
+}
+
+// RolloutWaveView is a rollout wave prepared for the rollouts page
+type RolloutWaveView struct {
+	Wave       *pb.RolloutWave
+	Label      string
+	State      string
+	StateClass string
+	Current    bool
+	Targets    []RolloutTargetView
+}
+
+// RolloutTargetView is a switch of a rollout wave prepared for the rollouts page
+type RolloutTargetView struct {
+	Target     *pb.RolloutTarget
+	RackID     string
+	State      string
+	StateClass string
+}
+
+// rolloutEnumName turns a rollout enum value such as ROLLOUT_WAVE_STATE_SUCCEEDED into "Succeeded"
+func rolloutEnumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "" {
+		return ""
+	}
+	return name[:1] + strings.ToLower(name[1:])
+}
+
+// rolloutStateClass maps rollout, wave and target states to status badge classes
+func rolloutStateClass(state string) string {
+	switch state {
+	case "Completed", "Succeeded":
+		return "status-completed"
+	case "Failed", "Aborted":
+		return "status-failed"
+	case "Cancelled":
+		return "status-cancelled"
+	case "Pending", "Paused":
+		return "status-queued"
+	default:
+		return "status-active"
+	}
+}
+
+func (s *UIServer) handleRollouts(w http.ResponseWriter, r *http.Request) {
+	client, conn, err := s.getGRPCClient()
+	if err != nil {
+		s.renderError(w, "Connection Error", err.Error())
+		return
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	showAll := r.URL.Query().Get("all") == "true"
+
+	rolloutsResp, err := client.ListRollouts(ctx, &emptypb.Empty{})
+	if err != nil {
+		s.renderError(w, "API Error", err.Error())
+		return
+	}
+
+	// Switches and bundles for the create form
+	switchesResp, err := client.GetNVSwitches(ctx, &pb.NVSwitchRequest{})
+	if err != nil {
+		s.renderError(w, "API Error", err.Error())
+		return
+	}
+	switchToRack := make(map[string]string)
+	for _, sw := range switchesResp.Nvswitches {
+		switchToRack[sw.Uuid] = sw.RackId
+	}
+
+	bundlesResp, _ := client.ListBundles(ctx, &emptypb.Empty{})
+	var bundles []string
+	if bundlesResp != nil {
+		for _, b := range bundlesResp.Bundles {
+			bundles = append(bundles, b.Version)
+		}
+	}
+
+	var rollouts []RolloutView
+	for _, ro := range rolloutsResp.Rollouts {
+		// By default, hide completed and aborted rollouts unless "all" is requested
+		if !showAll && (ro.State == pb.RolloutState_ROLLOUT_STATE_COMPLETED || ro.State == pb.RolloutState_ROLLOUT_STATE_ABORTED) {
+			continue
+		}
+
+		view := RolloutView{
+			Rollout:    ro,
+			State:      rolloutEnumName(ro.State.String(), "ROLLOUT_STATE_"),
+			Components: "all",
+		}
+		view.StateClass = rolloutStateClass(view.State)
+
+		if len(ro.Components) > 0 {
+			var names []string
+			for _, c := range ro.Components {
+				names = append(names, componentName(c))
+			}
+			view.Components = strings.Join(names, ", ")
+		}
+
+		if ro.Policy != nil {
+			var percents []string
+			for _, pct := range ro.Policy.WavePercents {
+				percents = append(percents, fmt.Sprintf("%d%%", pct))
+			}
+			view.WavePercent = strings.Join(percents, " / ")
+		}
+
+		var total, done int
+		for _, wave := range ro.Waves {
+			waveView := RolloutWaveView{
+				Wave:    wave,
+				Label:   fmt.Sprintf("Wave %d", wave.Index),
+				State:   rolloutEnumName(wave.State.String(), "ROLLOUT_WAVE_STATE_"),
+				Current: wave.Index == ro.CurrentWave && !isTerminalRollout(ro.State),
+			}
+			if wave.Index == 0 {
+				waveView.Label = "Canary"
+			}
+			waveView.StateClass = rolloutStateClass(waveView.State)
+
+			for _, target := range wave.Targets {
+				targetView := RolloutTargetView{
+					Target: target,
+					RackID: switchToRack[target.SwitchUuid],
+					State:  rolloutEnumName(target.State.String(), "ROLLOUT_TARGET_STATE_"),
+				}
+				targetView.StateClass = rolloutStateClass(targetView.State)
+				waveView.Targets = append(waveView.Targets, targetView)
+
+				total++
+				if target.State == pb.RolloutTargetState_ROLLOUT_TARGET_STATE_SUCCEEDED ||
+					target.State == pb.RolloutTargetState_ROLLOUT_TARGET_STATE_FAILED {
+					done++
+				}
+			}
+			view.Waves = append(view.Waves, waveView)
+		}
+		view.Progress = fmt.Sprintf("%d/%d", done, total)
+
+		rollouts = append(rollouts, view)
+	}
+
+	data := map[string]interface{}{
+		"Rollouts": rollouts,
+		"Switches": switchesResp.Nvswitches,
+		"Bundles":  bundles,
+		"Page":     "rollouts",
+		"ShowAll":  showAll,
+	}
+
+	s.templates.ExecuteTemplate(w, "layout.html", data)
+}
+
+func isTerminalRollout(state pb.RolloutState) bool {
+	return state == pb.RolloutState_ROLLOUT_STATE_COMPLETED || state == pb.RolloutState_ROLLOUT_STATE_ABORTED
+}
+
 // API handlers for AJAX calls
 
 func (s *UIServer) handleAPISwitches(w http.ResponseWriter, r *http.Request) {
@@ -767,28 +941,11 @@ func (s *UIServer) handleAPIQueueUpdate(w http.ResponseWriter, r *http.Request)
 	}
 	defer conn.Close()
 
-	// Parse components
-	var components []pb.NVSwitchComponent
-	if componentsStr != "" {
-		for _, c := range strings.Split(componentsStr, ",") {
-			switch strings.ToLower(strings.TrimSpace(c)) {
-			case "bmc":
-				components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_BMC)
-			case "cpld":
-				components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_CPLD)
-			case "bios":
-				components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_BIOS)
-			case "nvos":
-				components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_NVOS)
-			}
-		}
-	}
-
 	// Use bulk API for all requests
 	resp, err := client.QueueUpdates(context.Background(), &pb.QueueUpdatesRequest{
 		SwitchUuids:   switchUUIDs,
 		BundleVersion: bundleVersion,
-		Components:    components,
+		Components:    parseComponents(componentsStr),
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -799,6 +956,27 @@ func (s *UIServer) handleAPIQueueUpdate(w http.ResponseWriter, r *http.Request)
 	json.NewEncoder(w).Encode(resp)
 }
 
+// parseComponents parses a comma-separated list of component names, ignoring unknown names.
+func parseComponents(componentsStr string) []pb.NVSwitchComponent {
+	var components []pb.NVSwitchComponent
+	if componentsStr == "" {
+		return components
+	}
+	for _, c := range strings.Split(componentsStr, ",") {
+		switch strings.ToLower(strings.TrimSpace(c)) {
+		case "bmc":
+			components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_BMC)
+		case "cpld":
+			components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_CPLD)
+		case "bios":
+			components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_BIOS)
+		case "nvos":
+			components = append(components, pb.NVSwitchComponent_NVSWITCH_COMPONENT_NVOS)
+		}
+	}
+	return components
+}
+
 func (s *UIServer) handleAPICancelUpdate(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -828,6 +1006,115 @@ func (s *UIServer) handleAPICancelUpdate(w http.ResponseWriter, r *http.Request)
 	json.NewEncoder(w).Encode(resp)
 }
 
+// CreateRolloutRequest represents a rollout created from the rollouts page
+type CreateRolloutRequest struct {
+	SwitchUUIDs           []string `json:"switch_uuids"`
+	Bundle                string   `json:"bundle"`
+	Components            string   `json:"components"`
+	CanarySize            int32    `json:"canary_size"`
+	WavePercents          []int32  `json:"wave_percents"`
+	SoakSeconds           int64    `json:"soak_seconds"`
+	MaxFailureRate        float64  `json:"max_failure_rate"`
+	SkipReachabilityCheck bool     `json:"skip_reachability_check"`
+	SkipNVOSVersionCheck  bool     `json:"skip_nvos_version_check"`
+	OnGateFailure         string   `json:"on_gate_failure"`
+}
+
+func (s *UIServer) handleAPICreateRollout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req CreateRolloutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(req.SwitchUUIDs) == 0 || req.Bundle == "" {
+		http.Error(w, "switch_uuids and bundle required", http.StatusBadRequest)
+		return
+	}
+
+	onGateFailure := pb.RolloutGateAction_ROLLOUT_GATE_ACTION_PAUSE
+	if req.OnGateFailure == "abort" {
+		onGateFailure = pb.RolloutGateAction_ROLLOUT_GATE_ACTION_ABORT
+	}
+
+	client, conn, err := s.getGRPCClient()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer conn.Close()
+
+	resp, err := client.CreateRollout(context.Background(), &pb.CreateRolloutRequest{
+		BundleVersion: req.Bundle,
+		Components:    parseComponents(req.Components),
+		SwitchUuids:   req.SwitchUUIDs,
+		Policy: &pb.RolloutPolicy{
+			CanarySize:            req.CanarySize,
+			WavePercents:          req.WavePercents,
+			SoakSeconds:           req.SoakSeconds,
+			MaxFailureRate:        req.MaxFailureRate,
+			SkipReachabilityCheck: req.SkipReachabilityCheck,
+			SkipNvosVersionCheck:  req.SkipNVOSVersionCheck,
+			OnGateFailure:         onGateFailure,
+		},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *UIServer) handleAPIRolloutAction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	rolloutID := r.FormValue("rollout_id")
+	if rolloutID == "" {
+		http.Error(w, "rollout_id required", http.StatusBadRequest)
+		return
+	}
+
+	client, conn, err := s.getGRPCClient()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	req := &pb.RolloutRequest{RolloutId: rolloutID}
+
+	var resp *pb.RolloutResponse
+	switch action := r.FormValue("action"); action {
+	case "pause":
+		resp, err = client.PauseRollout(ctx, req)
+	case "resume", "accept":
+		resp, err = client.ResumeRollout(ctx, &pb.ResumeRolloutRequest{RolloutId: rolloutID, AcceptWave: action == "accept"})
+	case "abort":
+		resp, err = client.AbortRollout(ctx, req)
+	default:
+		http.Error(w, "invalid action: "+action, http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 // SwitchRegistrationRequest represents a single switch registration in JSON format
 type SwitchRegistrationRequest struct {
 	RackID string `json:"rack_id"`
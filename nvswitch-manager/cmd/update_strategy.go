@@ -675,7 +675,9 @@ func createSSHClient() (*sshclient.NVOSClient, error) {
 		return nil, fmt.Errorf("failed to create NVOS: %v", err)
 	}
 
-	return sshclient.NewWithPort(context.Background(), n, usNvosPort)
+	// Strategy testing targets switches outside the registry; there is no
+	// pinned host key to verify against.
+	return sshclient.NewWithPort(context.Background(), n, usNvosPort, sshclient.WithInsecureHostKey())
 }
 
 // ============================================================================
@@ -374,11 +374,353 @@ func (UpdateState) EnumDescriptor() ([]byte, []int) {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{5}
 }
 
-// Credentials wraps around a username and password.
+// RolloutState represents the state of a firmware rollout.
+type RolloutState int32
+
+const (
+	RolloutState_ROLLOUT_STATE_UNKNOWN   RolloutState = 0
+	RolloutState_ROLLOUT_STATE_RUNNING   RolloutState = 1
+	RolloutState_ROLLOUT_STATE_PAUSED    RolloutState = 2 // Waiting for ResumeRollout after a failed health gate or PauseRollout
+	RolloutState_ROLLOUT_STATE_COMPLETED RolloutState = 3
+	RolloutState_ROLLOUT_STATE_ABORTED   RolloutState = 4
+)
+
+// Enum value maps for RolloutState.
+var (
+	RolloutState_name = map[int32]string{
+		0: "ROLLOUT_STATE_UNKNOWN",
+		1: "ROLLOUT_STATE_RUNNING",
+		2: "ROLLOUT_STATE_PAUSED",
+		3: "ROLLOUT_STATE_COMPLETED",
+		4: "ROLLOUT_STATE_ABORTED",
+	}
+	RolloutState_value = map[string]int32{
+		"ROLLOUT_STATE_UNKNOWN":   0,
+		"ROLLOUT_STATE_RUNNING":   1,
+		"ROLLOUT_STATE_PAUSED":    2,
+		"ROLLOUT_STATE_COMPLETED": 3,
+		"ROLLOUT_STATE_ABORTED":   4,
+	}
+)
+
+func (x RolloutState) Enum() *RolloutState {
+	p := new(RolloutState)
+	*p = x
+	return p
+}
+
+func (x RolloutState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloutState) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[6].Descriptor()
+}
+
+func (RolloutState) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[6]
+}
+
+func (x RolloutState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloutState.Descriptor instead.
+func (RolloutState) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{6}
+}
+
+// RolloutWaveState represents the state of a rollout wave.
+type RolloutWaveState int32
+
+const (
+	RolloutWaveState_ROLLOUT_WAVE_STATE_UNKNOWN   RolloutWaveState = 0
+	RolloutWaveState_ROLLOUT_WAVE_STATE_PENDING   RolloutWaveState = 1
+	RolloutWaveState_ROLLOUT_WAVE_STATE_UPDATING  RolloutWaveState = 2
+	RolloutWaveState_ROLLOUT_WAVE_STATE_SOAKING   RolloutWaveState = 3 // Gates passed, waiting for the soak time before re-checking them
+	RolloutWaveState_ROLLOUT_WAVE_STATE_SUCCEEDED RolloutWaveState = 4
+	RolloutWaveState_ROLLOUT_WAVE_STATE_FAILED    RolloutWaveState = 5 // A health gate failed
+	RolloutWaveState_ROLLOUT_WAVE_STATE_CANCELLED RolloutWaveState = 6
+)
+
+// Enum value maps for RolloutWaveState.
+var (
+	RolloutWaveState_name = map[int32]string{
+		0: "ROLLOUT_WAVE_STATE_UNKNOWN",
+		1: "ROLLOUT_WAVE_STATE_PENDING",
+		2: "ROLLOUT_WAVE_STATE_UPDATING",
+		3: "ROLLOUT_WAVE_STATE_SOAKING",
+		4: "ROLLOUT_WAVE_STATE_SUCCEEDED",
+		5: "ROLLOUT_WAVE_STATE_FAILED",
+		6: "ROLLOUT_WAVE_STATE_CANCELLED",
+	}
+	RolloutWaveState_value = map[string]int32{
+		"ROLLOUT_WAVE_STATE_UNKNOWN":   0,
+		"ROLLOUT_WAVE_STATE_PENDING":   1,
+		"ROLLOUT_WAVE_STATE_UPDATING":  2,
+		"ROLLOUT_WAVE_STATE_SOAKING":   3,
+		"ROLLOUT_WAVE_STATE_SUCCEEDED": 4,
+		"ROLLOUT_WAVE_STATE_FAILED":    5,
+		"ROLLOUT_WAVE_STATE_CANCELLED": 6,
+	}
+)
+
+func (x RolloutWaveState) Enum() *RolloutWaveState {
+	p := new(RolloutWaveState)
+	*p = x
+	return p
+}
+
+func (x RolloutWaveState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloutWaveState) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[7].Descriptor()
+}
+
+func (RolloutWaveState) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[7]
+}
+
+func (x RolloutWaveState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloutWaveState.Descriptor instead.
+func (RolloutWaveState) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{7}
+}
+
+// RolloutTargetState represents the state of a switch within a rollout wave.
+type RolloutTargetState int32
+
+const (
+	RolloutTargetState_ROLLOUT_TARGET_STATE_UNKNOWN   RolloutTargetState = 0
+	RolloutTargetState_ROLLOUT_TARGET_STATE_PENDING   RolloutTargetState = 1
+	RolloutTargetState_ROLLOUT_TARGET_STATE_UPDATING  RolloutTargetState = 2
+	RolloutTargetState_ROLLOUT_TARGET_STATE_SUCCEEDED RolloutTargetState = 3
+	RolloutTargetState_ROLLOUT_TARGET_STATE_FAILED    RolloutTargetState = 4
+)
+
+// Enum value maps for RolloutTargetState.
+var (
+	RolloutTargetState_name = map[int32]string{
+		0: "ROLLOUT_TARGET_STATE_UNKNOWN",
+		1: "ROLLOUT_TARGET_STATE_PENDING",
+		2: "ROLLOUT_TARGET_STATE_UPDATING",
+		3: "ROLLOUT_TARGET_STATE_SUCCEEDED",
+		4: "ROLLOUT_TARGET_STATE_FAILED",
+	}
+	RolloutTargetState_value = map[string]int32{
+		"ROLLOUT_TARGET_STATE_UNKNOWN":   0,
+		"ROLLOUT_TARGET_STATE_PENDING":   1,
+		"ROLLOUT_TARGET_STATE_UPDATING":  2,
+		"ROLLOUT_TARGET_STATE_SUCCEEDED": 3,
+		"ROLLOUT_TARGET_STATE_FAILED":    4,
+	}
+)
+
+func (x RolloutTargetState) Enum() *RolloutTargetState {
+	p := new(RolloutTargetState)
+	*p = x
+	return p
+}
+
+func (x RolloutTargetState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloutTargetState) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[8].Descriptor()
+}
+
+func (RolloutTargetState) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[8]
+}
+
+func (x RolloutTargetState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloutTargetState.Descriptor instead.
+func (RolloutTargetState) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{8}
+}
+
+// RolloutGateAction is what a rollout does when a health gate fails.
+type RolloutGateAction int32
+
+const (
+	RolloutGateAction_ROLLOUT_GATE_ACTION_UNKNOWN RolloutGateAction = 0 // Defaults to PAUSE
+	RolloutGateAction_ROLLOUT_GATE_ACTION_PAUSE   RolloutGateAction = 1
+	RolloutGateAction_ROLLOUT_GATE_ACTION_ABORT   RolloutGateAction = 2
+)
+
+// Enum value maps for RolloutGateAction.
+var (
+	RolloutGateAction_name = map[int32]string{
+		0: "ROLLOUT_GATE_ACTION_UNKNOWN",
+		1: "ROLLOUT_GATE_ACTION_PAUSE",
+		2: "ROLLOUT_GATE_ACTION_ABORT",
+	}
+	RolloutGateAction_value = map[string]int32{
+		"ROLLOUT_GATE_ACTION_UNKNOWN": 0,
+		"ROLLOUT_GATE_ACTION_PAUSE":   1,
+		"ROLLOUT_GATE_ACTION_ABORT":   2,
+	}
+)
+
+func (x RolloutGateAction) Enum() *RolloutGateAction {
+	p := new(RolloutGateAction)
+	*p = x
+	return p
+}
+
+func (x RolloutGateAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloutGateAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[9].Descriptor()
+}
+
+func (RolloutGateAction) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[9]
+}
+
+func (x RolloutGateAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloutGateAction.Descriptor instead.
+func (RolloutGateAction) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{9}
+}
+
+// CredentialRotationState represents the state of the latest credential rotation of a switch account.
+type CredentialRotationState int32
+
+const (
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN       CredentialRotationState = 0
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED CredentialRotationState = 1
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS   CredentialRotationState = 2
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED     CredentialRotationState = 3
+	// The rotation failed and the device still accepts the stored password.
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED CredentialRotationState = 4
+	// The rotation failed and restoring the stored password failed too; the device needs manual attention.
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED CredentialRotationState = 5
+)
+
+// Enum value maps for CredentialRotationState.
+var (
+	CredentialRotationState_name = map[int32]string{
+		0: "CREDENTIAL_ROTATION_STATE_UNKNOWN",
+		1: "CREDENTIAL_ROTATION_STATE_NEVER_ROTATED",
+		2: "CREDENTIAL_ROTATION_STATE_IN_PROGRESS",
+		3: "CREDENTIAL_ROTATION_STATE_SUCCEEDED",
+		4: "CREDENTIAL_ROTATION_STATE_FAILED",
+		5: "CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED",
+	}
+	CredentialRotationState_value = map[string]int32{
+		"CREDENTIAL_ROTATION_STATE_UNKNOWN":         0,
+		"CREDENTIAL_ROTATION_STATE_NEVER_ROTATED":   1,
+		"CREDENTIAL_ROTATION_STATE_IN_PROGRESS":     2,
+		"CREDENTIAL_ROTATION_STATE_SUCCEEDED":       3,
+		"CREDENTIAL_ROTATION_STATE_FAILED":          4,
+		"CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED": 5,
+	}
+)
+
+func (x CredentialRotationState) Enum() *CredentialRotationState {
+	p := new(CredentialRotationState)
+	*p = x
+	return p
+}
+
+func (x CredentialRotationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialRotationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[10].Descriptor()
+}
+
+func (CredentialRotationState) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[10]
+}
+
+func (x CredentialRotationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialRotationState.Descriptor instead.
+func (CredentialRotationState) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{10}
+}
+
+// NVSwitchEventKind classifies Redfish events reported by switch BMCs.
+type NVSwitchEventKind int32
+
+const (
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN     NVSwitchEventKind = 0
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_PSU_FAULT   NVSwitchEventKind = 1
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_POWER_STATE NVSwitchEventKind = 2
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK        NVSwitchEventKind = 3
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_OTHER       NVSwitchEventKind = 4
+)
+
+// Enum value maps for NVSwitchEventKind.
+var (
+	NVSwitchEventKind_name = map[int32]string{
+		0: "NVSWITCH_EVENT_KIND_UNKNOWN",
+		1: "NVSWITCH_EVENT_KIND_PSU_FAULT",
+		2: "NVSWITCH_EVENT_KIND_POWER_STATE",
+		3: "NVSWITCH_EVENT_KIND_TASK",
+		4: "NVSWITCH_EVENT_KIND_OTHER",
+	}
+	NVSwitchEventKind_value = map[string]int32{
+		"NVSWITCH_EVENT_KIND_UNKNOWN":     0,
+		"NVSWITCH_EVENT_KIND_PSU_FAULT":   1,
+		"NVSWITCH_EVENT_KIND_POWER_STATE": 2,
+		"NVSWITCH_EVENT_KIND_TASK":        3,
+		"NVSWITCH_EVENT_KIND_OTHER":       4,
+	}
+)
+
+func (x NVSwitchEventKind) Enum() *NVSwitchEventKind {
+	p := new(NVSwitchEventKind)
+	*p = x
+	return p
+}
+
+func (x NVSwitchEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NVSwitchEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[11].Descriptor()
+}
+
+func (NVSwitchEventKind) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[11]
+}
+
+func (x NVSwitchEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NVSwitchEventKind.Descriptor instead.
+func (NVSwitchEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{11}
+}
+
+// Credentials wraps around a username and password, and optionally an SSH private key.
 type Credentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	SshPrivateKey string                 `protobuf:"bytes,3,opt,name=ssh_private_key,json=sshPrivateKey,proto3" json:"ssh_private_key,omitempty"` // Optional PEM-encoded SSH private key (NVOS only); preferred over the password
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -427,6 +769,13 @@ func (x *Credentials) GetPassword() string {
 	return ""
 }
 
+func (x *Credentials) GetSshPrivateKey() string {
+	if x != nil {
+		return x.SshPrivateKey
+	}
+	return ""
+}
+
 // Subsystem represents a network-accessible subsystem with MAC, IP, port, and credentials.
 type Subsystem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -599,13 +948,14 @@ func (x *BMCInfo) GetPort() int32 {
 
 // NVOSInfo contains NVOS identity and version information.
 type NVOSInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MacAddress    string                 `protobuf:"bytes,1,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"` // Custom port (0 = default 22)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MacAddress         string                 `protobuf:"bytes,1,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	IpAddress          string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Version            string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Port               int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`                                                        // Custom port (0 = default 22)
+	HostKeyFingerprint string                 `protobuf:"bytes,5,opt,name=host_key_fingerprint,json=hostKeyFingerprint,proto3" json:"host_key_fingerprint,omitempty"` // SHA256 fingerprint of the pinned SSH host key (empty = not yet pinned)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *NVOSInfo) Reset() {
@@ -666,6 +1016,13 @@ func (x *NVOSInfo) GetPort() int32 {
 	return 0
 }
 
+func (x *NVOSInfo) GetHostKeyFingerprint() string {
+	if x != nil {
+		return x.HostKeyFingerprint
+	}
+	return ""
+}
+
 // Chassis contains chassis identity from Redfish.
 type Chassis struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1101,30 +1458,29 @@ func (x *NVSwitchRequest) GetUuids() []string {
 	return nil
 }
 
-type NVSwitchResponse struct {
+// RekeyNVOSHostKeyRequest replaces the pinned NVOS SSH host key of a switch.
+type RekeyNVOSHostKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Status        StatusCode             `protobuf:"varint,2,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	BmcIp         string                 `protobuf:"bytes,4,opt,name=bmc_ip,json=bmcIp,proto3" json:"bmc_ip,omitempty"` // Set for direct PowerTarget responses; empty for registered switches
+	HostKey       string                 `protobuf:"bytes,2,opt,name=host_key,json=hostKey,proto3" json:"host_key,omitempty"` // New host key in authorized_keys format; empty clears the pin so the next connection pins the presented key
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NVSwitchResponse) Reset() {
-	*x = NVSwitchResponse{}
+func (x *RekeyNVOSHostKeyRequest) Reset() {
+	*x = RekeyNVOSHostKeyRequest{}
 	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NVSwitchResponse) String() string {
+func (x *RekeyNVOSHostKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NVSwitchResponse) ProtoMessage() {}
+func (*RekeyNVOSHostKeyRequest) ProtoMessage() {}
 
-func (x *NVSwitchResponse) ProtoReflect() protoreflect.Message {
+func (x *RekeyNVOSHostKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1136,64 +1492,49 @@ func (x *NVSwitchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NVSwitchResponse.ProtoReflect.Descriptor instead.
-func (*NVSwitchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RekeyNVOSHostKeyRequest.ProtoReflect.Descriptor instead.
+func (*RekeyNVOSHostKeyRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *NVSwitchResponse) GetUuid() string {
+func (x *RekeyNVOSHostKeyRequest) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *NVSwitchResponse) GetStatus() StatusCode {
-	if x != nil {
-		return x.Status
-	}
-	return StatusCode_SUCCESS
-}
-
-func (x *NVSwitchResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *NVSwitchResponse) GetBmcIp() string {
+func (x *RekeyNVOSHostKeyRequest) GetHostKey() string {
 	if x != nil {
-		return x.BmcIp
+		return x.HostKey
 	}
 	return ""
 }
 
-// PowerTarget allows power control against a device without prior registration.
-// Only IP and credentials are required; the registry and credential manager are bypassed.
-type PowerTarget struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	BmcIp          string                 `protobuf:"bytes,1,opt,name=bmc_ip,json=bmcIp,proto3" json:"bmc_ip,omitempty"`
-	BmcCredentials *Credentials           `protobuf:"bytes,2,opt,name=bmc_credentials,json=bmcCredentials,proto3" json:"bmc_credentials,omitempty"`
-	BmcPort        int32                  `protobuf:"varint,3,opt,name=bmc_port,json=bmcPort,proto3" json:"bmc_port,omitempty"` // 0 = default (443)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RekeyNVOSHostKeyResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Uuid               string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Status             StatusCode             `protobuf:"varint,2,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
+	Error              string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	HostKeyFingerprint string                 `protobuf:"bytes,4,opt,name=host_key_fingerprint,json=hostKeyFingerprint,proto3" json:"host_key_fingerprint,omitempty"` // SHA256 fingerprint of the new pinned key (empty if cleared)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *PowerTarget) Reset() {
-	*x = PowerTarget{}
+func (x *RekeyNVOSHostKeyResponse) Reset() {
+	*x = RekeyNVOSHostKeyResponse{}
 	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PowerTarget) String() string {
+func (x *RekeyNVOSHostKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PowerTarget) ProtoMessage() {}
+func (*RekeyNVOSHostKeyResponse) ProtoMessage() {}
 
-func (x *PowerTarget) ProtoReflect() protoreflect.Message {
+func (x *RekeyNVOSHostKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1205,57 +1546,63 @@ func (x *PowerTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PowerTarget.ProtoReflect.Descriptor instead.
-func (*PowerTarget) Descriptor() ([]byte, []int) {
+// Deprecated: Use RekeyNVOSHostKeyResponse.ProtoReflect.Descriptor instead.
+func (*RekeyNVOSHostKeyResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PowerTarget) GetBmcIp() string {
+func (x *RekeyNVOSHostKeyResponse) GetUuid() string {
 	if x != nil {
-		return x.BmcIp
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *PowerTarget) GetBmcCredentials() *Credentials {
+func (x *RekeyNVOSHostKeyResponse) GetStatus() StatusCode {
 	if x != nil {
-		return x.BmcCredentials
+		return x.Status
 	}
-	return nil
+	return StatusCode_SUCCESS
 }
 
-func (x *PowerTarget) GetBmcPort() int32 {
+func (x *RekeyNVOSHostKeyResponse) GetError() string {
 	if x != nil {
-		return x.BmcPort
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-// PowerControlRequest specifies the switches and the power action to perform.
-// Registered switches are identified by UUID; unregistered devices use PowerTarget.
-type PowerControlRequest struct {
+func (x *RekeyNVOSHostKeyResponse) GetHostKeyFingerprint() string {
+	if x != nil {
+		return x.HostKeyFingerprint
+	}
+	return ""
+}
+
+type NVSwitchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
-	Action        PowerAction            `protobuf:"varint,2,opt,name=action,proto3,enum=v1.PowerAction" json:"action,omitempty"`
-	Targets       []*PowerTarget         `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Status        StatusCode             `protobuf:"varint,2,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	BmcIp         string                 `protobuf:"bytes,4,opt,name=bmc_ip,json=bmcIp,proto3" json:"bmc_ip,omitempty"` // Set for direct PowerTarget responses; empty for registered switches
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PowerControlRequest) Reset() {
-	*x = PowerControlRequest{}
+func (x *NVSwitchResponse) Reset() {
+	*x = NVSwitchResponse{}
 	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PowerControlRequest) String() string {
+func (x *NVSwitchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PowerControlRequest) ProtoMessage() {}
+func (*NVSwitchResponse) ProtoMessage() {}
 
-func (x *PowerControlRequest) ProtoReflect() protoreflect.Message {
+func (x *NVSwitchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1267,14 +1614,145 @@ func (x *PowerControlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PowerControlRequest.ProtoReflect.Descriptor instead.
-func (*PowerControlRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NVSwitchResponse.ProtoReflect.Descriptor instead.
+func (*NVSwitchResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PowerControlRequest) GetUuids() []string {
+func (x *NVSwitchResponse) GetUuid() string {
 	if x != nil {
-		return x.Uuids
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NVSwitchResponse) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *NVSwitchResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NVSwitchResponse) GetBmcIp() string {
+	if x != nil {
+		return x.BmcIp
+	}
+	return ""
+}
+
+// PowerTarget allows power control against a device without prior registration.
+// Only IP and credentials are required; the registry and credential manager are bypassed.
+type PowerTarget struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BmcIp          string                 `protobuf:"bytes,1,opt,name=bmc_ip,json=bmcIp,proto3" json:"bmc_ip,omitempty"`
+	BmcCredentials *Credentials           `protobuf:"bytes,2,opt,name=bmc_credentials,json=bmcCredentials,proto3" json:"bmc_credentials,omitempty"`
+	BmcPort        int32                  `protobuf:"varint,3,opt,name=bmc_port,json=bmcPort,proto3" json:"bmc_port,omitempty"` // 0 = default (443)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PowerTarget) Reset() {
+	*x = PowerTarget{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerTarget) ProtoMessage() {}
+
+func (x *PowerTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerTarget.ProtoReflect.Descriptor instead.
+func (*PowerTarget) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PowerTarget) GetBmcIp() string {
+	if x != nil {
+		return x.BmcIp
+	}
+	return ""
+}
+
+func (x *PowerTarget) GetBmcCredentials() *Credentials {
+	if x != nil {
+		return x.BmcCredentials
+	}
+	return nil
+}
+
+func (x *PowerTarget) GetBmcPort() int32 {
+	if x != nil {
+		return x.BmcPort
+	}
+	return 0
+}
+
+// PowerControlRequest specifies the switches and the power action to perform.
+// Registered switches are identified by UUID; unregistered devices use PowerTarget.
+type PowerControlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Action        PowerAction            `protobuf:"varint,2,opt,name=action,proto3,enum=v1.PowerAction" json:"action,omitempty"`
+	Targets       []*PowerTarget         `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerControlRequest) Reset() {
+	*x = PowerControlRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerControlRequest) ProtoMessage() {}
+
+func (x *PowerControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerControlRequest.ProtoReflect.Descriptor instead.
+func (*PowerControlRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PowerControlRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
 	}
 	return nil
 }
@@ -1302,7 +1780,7 @@ type PowerControlResponse struct {
 
 func (x *PowerControlResponse) Reset() {
 	*x = PowerControlResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[14]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1314,7 +1792,7 @@ func (x *PowerControlResponse) String() string {
 func (*PowerControlResponse) ProtoMessage() {}
 
 func (x *PowerControlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[14]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,7 +1805,7 @@ func (x *PowerControlResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerControlResponse.ProtoReflect.Descriptor instead.
 func (*PowerControlResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{14}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PowerControlResponse) GetResponses() []*NVSwitchResponse {
@@ -1346,7 +1824,7 @@ type GetNVSwitchesResponse struct {
 
 func (x *GetNVSwitchesResponse) Reset() {
 	*x = GetNVSwitchesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[15]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +1836,7 @@ func (x *GetNVSwitchesResponse) String() string {
 func (*GetNVSwitchesResponse) ProtoMessage() {}
 
 func (x *GetNVSwitchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[15]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +1849,7 @@ func (x *GetNVSwitchesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNVSwitchesResponse.ProtoReflect.Descriptor instead.
 func (*GetNVSwitchesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{15}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetNVSwitchesResponse) GetNvswitches() []*NVSwitchTray {
@@ -1393,7 +1871,7 @@ type FirmwareBundle struct {
 
 func (x *FirmwareBundle) Reset() {
 	*x = FirmwareBundle{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[16]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +1883,7 @@ func (x *FirmwareBundle) String() string {
 func (*FirmwareBundle) ProtoMessage() {}
 
 func (x *FirmwareBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[16]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1896,7 @@ func (x *FirmwareBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareBundle.ProtoReflect.Descriptor instead.
 func (*FirmwareBundle) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{16}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FirmwareBundle) GetVersion() string {
@@ -1454,7 +1932,7 @@ type ComponentInfo struct {
 
 func (x *ComponentInfo) Reset() {
 	*x = ComponentInfo{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1466,7 +1944,7 @@ func (x *ComponentInfo) String() string {
 func (*ComponentInfo) ProtoMessage() {}
 
 func (x *ComponentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,7 +1957,7 @@ func (x *ComponentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentInfo.ProtoReflect.Descriptor instead.
 func (*ComponentInfo) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{17}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ComponentInfo) GetName() string {
@@ -1513,7 +1991,7 @@ type ListBundlesResponse struct {
 
 func (x *ListBundlesResponse) Reset() {
 	*x = ListBundlesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1525,7 +2003,7 @@ func (x *ListBundlesResponse) String() string {
 func (*ListBundlesResponse) ProtoMessage() {}
 
 func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +2016,7 @@ func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBundlesResponse.ProtoReflect.Descriptor instead.
 func (*ListBundlesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{18}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListBundlesResponse) GetBundles() []*FirmwareBundle {
@@ -1561,7 +2039,7 @@ type QueueUpdateRequest struct {
 
 func (x *QueueUpdateRequest) Reset() {
 	*x = QueueUpdateRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1573,7 +2051,7 @@ func (x *QueueUpdateRequest) String() string {
 func (*QueueUpdateRequest) ProtoMessage() {}
 
 func (x *QueueUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +2064,7 @@ func (x *QueueUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdateRequest.ProtoReflect.Descriptor instead.
 func (*QueueUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{19}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueueUpdateRequest) GetSwitchUuid() string {
@@ -1620,7 +2098,7 @@ type QueueUpdateResponse struct {
 
 func (x *QueueUpdateResponse) Reset() {
 	*x = QueueUpdateResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1632,7 +2110,7 @@ func (x *QueueUpdateResponse) String() string {
 func (*QueueUpdateResponse) ProtoMessage() {}
 
 func (x *QueueUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +2123,7 @@ func (x *QueueUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdateResponse.ProtoReflect.Descriptor instead.
 func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{20}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueueUpdateResponse) GetUpdates() []*FirmwareUpdateInfo {
@@ -1667,7 +2145,7 @@ type QueueUpdatesRequest struct {
 
 func (x *QueueUpdatesRequest) Reset() {
 	*x = QueueUpdatesRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1679,7 +2157,7 @@ func (x *QueueUpdatesRequest) String() string {
 func (*QueueUpdatesRequest) ProtoMessage() {}
 
 func (x *QueueUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,7 +2170,7 @@ func (x *QueueUpdatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*QueueUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{21}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueueUpdatesRequest) GetSwitchUuids() []string {
@@ -1726,7 +2204,7 @@ type QueueUpdatesResponse struct {
 
 func (x *QueueUpdatesResponse) Reset() {
 	*x = QueueUpdatesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1738,7 +2216,7 @@ func (x *QueueUpdatesResponse) String() string {
 func (*QueueUpdatesResponse) ProtoMessage() {}
 
 func (x *QueueUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,7 +2229,7 @@ func (x *QueueUpdatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*QueueUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{22}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueueUpdatesResponse) GetResults() []*QueueUpdateResult {
@@ -1774,7 +2252,7 @@ type QueueUpdateResult struct {
 
 func (x *QueueUpdateResult) Reset() {
 	*x = QueueUpdateResult{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1786,7 +2264,7 @@ func (x *QueueUpdateResult) String() string {
 func (*QueueUpdateResult) ProtoMessage() {}
 
 func (x *QueueUpdateResult) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +2277,7 @@ func (x *QueueUpdateResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdateResult.ProtoReflect.Descriptor instead.
 func (*QueueUpdateResult) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{23}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueueUpdateResult) GetSwitchUuid() string {
@@ -1840,7 +2318,7 @@ type GetUpdateRequest struct {
 
 func (x *GetUpdateRequest) Reset() {
 	*x = GetUpdateRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1852,7 +2330,7 @@ func (x *GetUpdateRequest) String() string {
 func (*GetUpdateRequest) ProtoMessage() {}
 
 func (x *GetUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,7 +2343,7 @@ func (x *GetUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdateRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{24}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetUpdateRequest) GetUpdateId() string {
@@ -1885,7 +2363,7 @@ type GetUpdateResponse struct {
 
 func (x *GetUpdateResponse) Reset() {
 	*x = GetUpdateResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1897,7 +2375,7 @@ func (x *GetUpdateResponse) String() string {
 func (*GetUpdateResponse) ProtoMessage() {}
 
 func (x *GetUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,7 +2388,7 @@ func (x *GetUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdateResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{25}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetUpdateResponse) GetUpdate() *FirmwareUpdateInfo {
@@ -1930,7 +2408,7 @@ type GetUpdatesForSwitchRequest struct {
 
 func (x *GetUpdatesForSwitchRequest) Reset() {
 	*x = GetUpdatesForSwitchRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1942,7 +2420,7 @@ func (x *GetUpdatesForSwitchRequest) String() string {
 func (*GetUpdatesForSwitchRequest) ProtoMessage() {}
 
 func (x *GetUpdatesForSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,7 +2433,7 @@ func (x *GetUpdatesForSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdatesForSwitchRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdatesForSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{26}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetUpdatesForSwitchRequest) GetSwitchUuid() string {
@@ -1975,7 +2453,7 @@ type GetUpdatesForSwitchResponse struct {
 
 func (x *GetUpdatesForSwitchResponse) Reset() {
 	*x = GetUpdatesForSwitchResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1987,7 +2465,7 @@ func (x *GetUpdatesForSwitchResponse) String() string {
 func (*GetUpdatesForSwitchResponse) ProtoMessage() {}
 
 func (x *GetUpdatesForSwitchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,7 +2478,7 @@ func (x *GetUpdatesForSwitchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdatesForSwitchResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdatesForSwitchResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{27}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetUpdatesForSwitchResponse) GetUpdates() []*FirmwareUpdateInfo {
@@ -2020,7 +2498,7 @@ type GetAllUpdatesResponse struct {
 
 func (x *GetAllUpdatesResponse) Reset() {
 	*x = GetAllUpdatesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2032,7 +2510,7 @@ func (x *GetAllUpdatesResponse) String() string {
 func (*GetAllUpdatesResponse) ProtoMessage() {}
 
 func (x *GetAllUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2045,7 +2523,7 @@ func (x *GetAllUpdatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*GetAllUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{28}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetAllUpdatesResponse) GetUpdates() []*FirmwareUpdateInfo {
@@ -2065,7 +2543,7 @@ type CancelUpdateRequest struct {
 
 func (x *CancelUpdateRequest) Reset() {
 	*x = CancelUpdateRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2077,7 +2555,7 @@ func (x *CancelUpdateRequest) String() string {
 func (*CancelUpdateRequest) ProtoMessage() {}
 
 func (x *CancelUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2568,7 @@ func (x *CancelUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelUpdateRequest.ProtoReflect.Descriptor instead.
 func (*CancelUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{29}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CancelUpdateRequest) GetUpdateId() string {
@@ -2111,7 +2589,7 @@ type CancelUpdateResponse struct {
 
 func (x *CancelUpdateResponse) Reset() {
 	*x = CancelUpdateResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2123,7 +2601,7 @@ func (x *CancelUpdateResponse) String() string {
 func (*CancelUpdateResponse) ProtoMessage() {}
 
 func (x *CancelUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2136,7 +2614,7 @@ func (x *CancelUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelUpdateResponse.ProtoReflect.Descriptor instead.
 func (*CancelUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{30}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CancelUpdateResponse) GetSuccess() bool {
@@ -2172,13 +2650,14 @@ type FirmwareUpdateInfo struct {
 	BundleUpdateId string `protobuf:"bytes,13,opt,name=bundle_update_id,json=bundleUpdateId,proto3" json:"bundle_update_id,omitempty"` // Groups related updates (UUID, optional)
 	SequenceOrder  int32  `protobuf:"varint,14,opt,name=sequence_order,json=sequenceOrder,proto3" json:"sequence_order,omitempty"`     // Order within bundle update (1, 2, 3...)
 	PredecessorId  string `protobuf:"bytes,15,opt,name=predecessor_id,json=predecessorId,proto3" json:"predecessor_id,omitempty"`      // Must complete before this one starts (UUID, optional)
+	SignedBy       string `protobuf:"bytes,16,opt,name=signed_by,json=signedBy,proto3" json:"signed_by,omitempty"`                     // Verified bundle signer, set before the first step ("unsigned" if unsigned firmware is allowed)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *FirmwareUpdateInfo) Reset() {
 	*x = FirmwareUpdateInfo{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[31]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2190,7 +2669,7 @@ func (x *FirmwareUpdateInfo) String() string {
 func (*FirmwareUpdateInfo) ProtoMessage() {}
 
 func (x *FirmwareUpdateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[31]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,7 +2682,7 @@ func (x *FirmwareUpdateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareUpdateInfo.ProtoReflect.Descriptor instead.
 func (*FirmwareUpdateInfo) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{31}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *FirmwareUpdateInfo) GetId() string {
@@ -2311,40 +2790,1141 @@ func (x *FirmwareUpdateInfo) GetPredecessorId() string {
 	return ""
 }
 
-var File_internal_proto_v1_nvswitch_manager_proto protoreflect.FileDescriptor
+func (x *FirmwareUpdateInfo) GetSignedBy() string {
+	if x != nil {
+		return x.SignedBy
+	}
+	return ""
+}
 
-const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
-	"\n" +
-	"(internal/proto/v1/nvswitch-manager.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
-	"\vCredentials\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x92\x01\n" +
-	"\tSubsystem\x12\x1f\n" +
-	"\vmac_address\x18\x01 \x01(\tR\n" +
-	"macAddress\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x121\n" +
-	"\vcredentials\x18\x03 \x01(\v2\x0f.v1.CredentialsR\vcredentials\x12\x12\n" +
-	"\x04port\x18\x04 \x01(\x05R\x04port\"\x88\x02\n" +
-	"\aBMCInfo\x12\x1f\n" +
-	"\vmac_address\x18\x01 \x01(\tR\n" +
-	"macAddress\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x12#\n" +
-	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12\x14\n" +
+// RolloutPolicy controls how a rollout is split into waves and gated. Unset fields use the defaults.
+type RolloutPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CanarySize            int32                  `protobuf:"varint,1,opt,name=canary_size,json=canarySize,proto3" json:"canary_size,omitempty"`                // Switches in the canary wave (default 1)
+	WavePercents          []int32                `protobuf:"varint,2,rep,packed,name=wave_percents,json=wavePercents,proto3" json:"wave_percents,omitempty"`   // Cumulative percentages of the targets after the canary, ascending and ending at 100 (default 25, 50, 100)
+	SoakSeconds           int64                  `protobuf:"varint,3,opt,name=soak_seconds,json=soakSeconds,proto3" json:"soak_seconds,omitempty"`             // Time a wave soaks after passing its gates before they are checked again
+	MaxFailureRate        float64                `protobuf:"fixed64,4,opt,name=max_failure_rate,json=maxFailureRate,proto3" json:"max_failure_rate,omitempty"` // Fraction (0-1) of failed switch updates a wave tolerates
+	SkipReachabilityCheck bool                   `protobuf:"varint,5,opt,name=skip_reachability_check,json=skipReachabilityCheck,proto3" json:"skip_reachability_check,omitempty"`
+	SkipNvosVersionCheck  bool                   `protobuf:"varint,6,opt,name=skip_nvos_version_check,json=skipNvosVersionCheck,proto3" json:"skip_nvos_version_check,omitempty"`
+	OnGateFailure         RolloutGateAction      `protobuf:"varint,7,opt,name=on_gate_failure,json=onGateFailure,proto3,enum=v1.RolloutGateAction" json:"on_gate_failure,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RolloutPolicy) Reset() {
+	*x = RolloutPolicy{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPolicy) ProtoMessage() {}
+
+func (x *RolloutPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPolicy.ProtoReflect.Descriptor instead.
+func (*RolloutPolicy) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RolloutPolicy) GetCanarySize() int32 {
+	if x != nil {
+		return x.CanarySize
+	}
+	return 0
+}
+
+func (x *RolloutPolicy) GetWavePercents() []int32 {
+	if x != nil {
+		return x.WavePercents
+	}
+	return nil
+}
+
+func (x *RolloutPolicy) GetSoakSeconds() int64 {
+	if x != nil {
+		return x.SoakSeconds
+	}
+	return 0
+}
+
+func (x *RolloutPolicy) GetMaxFailureRate() float64 {
+	if x != nil {
+		return x.MaxFailureRate
+	}
+	return 0
+}
+
+func (x *RolloutPolicy) GetSkipReachabilityCheck() bool {
+	if x != nil {
+		return x.SkipReachabilityCheck
+	}
+	return false
+}
+
+func (x *RolloutPolicy) GetSkipNvosVersionCheck() bool {
+	if x != nil {
+		return x.SkipNvosVersionCheck
+	}
+	return false
+}
+
+func (x *RolloutPolicy) GetOnGateFailure() RolloutGateAction {
+	if x != nil {
+		return x.OnGateFailure
+	}
+	return RolloutGateAction_ROLLOUT_GATE_ACTION_UNKNOWN
+}
+
+// CreateRolloutRequest specifies the bundle and switches of a rollout.
+type CreateRolloutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleVersion string                 `protobuf:"bytes,1,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`
+	Components    []NVSwitchComponent    `protobuf:"varint,2,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"` // Components to update (empty = all)
+	SwitchUuids   []string               `protobuf:"bytes,3,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`              // Target switches; the first canary_size form the canary wave
+	Policy        *RolloutPolicy         `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRolloutRequest) Reset() {
+	*x = CreateRolloutRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRolloutRequest) ProtoMessage() {}
+
+func (x *CreateRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRolloutRequest.ProtoReflect.Descriptor instead.
+func (*CreateRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateRolloutRequest) GetBundleVersion() string {
+	if x != nil {
+		return x.BundleVersion
+	}
+	return ""
+}
+
+func (x *CreateRolloutRequest) GetComponents() []NVSwitchComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CreateRolloutRequest) GetSwitchUuids() []string {
+	if x != nil {
+		return x.SwitchUuids
+	}
+	return nil
+}
+
+func (x *CreateRolloutRequest) GetPolicy() *RolloutPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// RolloutRequest identifies a rollout.
+type RolloutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RolloutId     string                 `protobuf:"bytes,1,opt,name=rollout_id,json=rolloutId,proto3" json:"rollout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutRequest) Reset() {
+	*x = RolloutRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutRequest) ProtoMessage() {}
+
+func (x *RolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutRequest.ProtoReflect.Descriptor instead.
+func (*RolloutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RolloutRequest) GetRolloutId() string {
+	if x != nil {
+		return x.RolloutId
+	}
+	return ""
+}
+
+// ResumeRolloutRequest resumes a paused rollout.
+type ResumeRolloutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RolloutId     string                 `protobuf:"bytes,1,opt,name=rollout_id,json=rolloutId,proto3" json:"rollout_id,omitempty"`
+	AcceptWave    bool                   `protobuf:"varint,2,opt,name=accept_wave,json=acceptWave,proto3" json:"accept_wave,omitempty"` // Mark a wave that failed its health gate as succeeded instead of re-checking it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRolloutRequest) Reset() {
+	*x = ResumeRolloutRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRolloutRequest) ProtoMessage() {}
+
+func (x *ResumeRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRolloutRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResumeRolloutRequest) GetRolloutId() string {
+	if x != nil {
+		return x.RolloutId
+	}
+	return ""
+}
+
+func (x *ResumeRolloutRequest) GetAcceptWave() bool {
+	if x != nil {
+		return x.AcceptWave
+	}
+	return false
+}
+
+type RolloutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rollout       *Rollout               `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutResponse) Reset() {
+	*x = RolloutResponse{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutResponse) ProtoMessage() {}
+
+func (x *RolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutResponse.ProtoReflect.Descriptor instead.
+func (*RolloutResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RolloutResponse) GetRollout() *Rollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type ListRolloutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rollouts      []*Rollout             `protobuf:"bytes,1,rep,name=rollouts,proto3" json:"rollouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolloutsResponse) Reset() {
+	*x = ListRolloutsResponse{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolloutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolloutsResponse) ProtoMessage() {}
+
+func (x *ListRolloutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolloutsResponse.ProtoReflect.Descriptor instead.
+func (*ListRolloutsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListRolloutsResponse) GetRollouts() []*Rollout {
+	if x != nil {
+		return x.Rollouts
+	}
+	return nil
+}
+
+// RolloutTarget contains the progress of a switch within a rollout wave.
+type RolloutTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid    string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`
+	State         RolloutTargetState     `protobuf:"varint,2,opt,name=state,proto3,enum=v1.RolloutTargetState" json:"state,omitempty"`
+	UpdateIds     []string               `protobuf:"bytes,3,rep,name=update_ids,json=updateIds,proto3" json:"update_ids,omitempty"` // Firmware updates queued for the switch
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutTarget) Reset() {
+	*x = RolloutTarget{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutTarget) ProtoMessage() {}
+
+func (x *RolloutTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutTarget.ProtoReflect.Descriptor instead.
+func (*RolloutTarget) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RolloutTarget) GetSwitchUuid() string {
+	if x != nil {
+		return x.SwitchUuid
+	}
+	return ""
+}
+
+func (x *RolloutTarget) GetState() RolloutTargetState {
+	if x != nil {
+		return x.State
+	}
+	return RolloutTargetState_ROLLOUT_TARGET_STATE_UNKNOWN
+}
+
+func (x *RolloutTarget) GetUpdateIds() []string {
+	if x != nil {
+		return x.UpdateIds
+	}
+	return nil
+}
+
+func (x *RolloutTarget) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RolloutWave contains a wave of a rollout. Wave 0 is the canary.
+type RolloutWave struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	State         RolloutWaveState       `protobuf:"varint,2,opt,name=state,proto3,enum=v1.RolloutWaveState" json:"state,omitempty"`
+	Targets       []*RolloutTarget       `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	SoakUntil     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=soak_until,json=soakUntil,proto3" json:"soak_until,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"` // Health gate outcome
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutWave) Reset() {
+	*x = RolloutWave{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutWave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutWave) ProtoMessage() {}
+
+func (x *RolloutWave) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutWave.ProtoReflect.Descriptor instead.
+func (*RolloutWave) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RolloutWave) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RolloutWave) GetState() RolloutWaveState {
+	if x != nil {
+		return x.State
+	}
+	return RolloutWaveState_ROLLOUT_WAVE_STATE_UNKNOWN
+}
+
+func (x *RolloutWave) GetTargets() []*RolloutTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *RolloutWave) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RolloutWave) GetSoakUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SoakUntil
+	}
+	return nil
+}
+
+func (x *RolloutWave) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *RolloutWave) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Rollout contains a firmware rollout and its waves.
+type Rollout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BundleVersion string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`
+	Components    []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"`
+	Policy        *RolloutPolicy         `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	State         RolloutState           `protobuf:"varint,5,opt,name=state,proto3,enum=v1.RolloutState" json:"state,omitempty"`
+	CurrentWave   int32                  `protobuf:"varint,6,opt,name=current_wave,json=currentWave,proto3" json:"current_wave,omitempty"`
+	Waves         []*RolloutWave         `protobuf:"bytes,7,rep,name=waves,proto3" json:"waves,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"` // Why the rollout paused or aborted
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rollout) Reset() {
+	*x = Rollout{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollout) ProtoMessage() {}
+
+func (x *Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Rollout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rollout) GetBundleVersion() string {
+	if x != nil {
+		return x.BundleVersion
+	}
+	return ""
+}
+
+func (x *Rollout) GetComponents() []NVSwitchComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Rollout) GetPolicy() *RolloutPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *Rollout) GetState() RolloutState {
+	if x != nil {
+		return x.State
+	}
+	return RolloutState_ROLLOUT_STATE_UNKNOWN
+}
+
+func (x *Rollout) GetCurrentWave() int32 {
+	if x != nil {
+		return x.CurrentWave
+	}
+	return 0
+}
+
+func (x *Rollout) GetWaves() []*RolloutWave {
+	if x != nil {
+		return x.Waves
+	}
+	return nil
+}
+
+func (x *Rollout) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Rollout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rollout) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RotateCredentialsRequest specifies the switches and accounts whose passwords are rotated.
+type RotateCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Components    []NVSwitchComponent    `protobuf:"varint,2,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"` // NVSWITCH_COMPONENT_BMC and/or NVSWITCH_COMPONENT_NVOS; empty = both
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsRequest) Reset() {
+	*x = RotateCredentialsRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsRequest) ProtoMessage() {}
+
+func (x *RotateCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RotateCredentialsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *RotateCredentialsRequest) GetComponents() []NVSwitchComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// RotateCredentialsResult reports whether a credential rotation of a switch account was started.
+type RotateCredentialsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Component     NVSwitchComponent      `protobuf:"varint,2,opt,name=component,proto3,enum=v1.NVSwitchComponent" json:"component,omitempty"`
+	Status        StatusCode             `protobuf:"varint,3,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsResult) Reset() {
+	*x = RotateCredentialsResult{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsResult) ProtoMessage() {}
+
+func (x *RotateCredentialsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsResult.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsResult) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RotateCredentialsResult) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RotateCredentialsResult) GetComponent() NVSwitchComponent {
+	if x != nil {
+		return x.Component
+	}
+	return NVSwitchComponent_NVSWITCH_COMPONENT_UNKNOWN
+}
+
+func (x *RotateCredentialsResult) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *RotateCredentialsResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RotateCredentialsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*RotateCredentialsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsResponse) Reset() {
+	*x = RotateCredentialsResponse{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsResponse) ProtoMessage() {}
+
+func (x *RotateCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RotateCredentialsResponse) GetResults() []*RotateCredentialsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CredentialRotationStatus contains the latest credential rotation of a switch's BMC or NVOS account.
+type CredentialRotationStatus struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Uuid          string                  `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Component     NVSwitchComponent       `protobuf:"varint,2,opt,name=component,proto3,enum=v1.NVSwitchComponent" json:"component,omitempty"`
+	State         CredentialRotationState `protobuf:"varint,3,opt,name=state,proto3,enum=v1.CredentialRotationState" json:"state,omitempty"`
+	LastAttempt   *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastRotated   *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=last_rotated,json=lastRotated,proto3" json:"last_rotated,omitempty"`
+	NextRotation  *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=next_rotation,json=nextRotation,proto3" json:"next_rotation,omitempty"`    // Unset if scheduled rotation of the component is disabled
+	RotationError string                  `protobuf:"bytes,7,opt,name=rotation_error,json=rotationError,proto3" json:"rotation_error,omitempty"` // Error of the latest rotation, if it failed
+	Status        StatusCode              `protobuf:"varint,8,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`                // Request status
+	Error         string                  `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                      // Request error message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialRotationStatus) Reset() {
+	*x = CredentialRotationStatus{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialRotationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialRotationStatus) ProtoMessage() {}
+
+func (x *CredentialRotationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialRotationStatus.ProtoReflect.Descriptor instead.
+func (*CredentialRotationStatus) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CredentialRotationStatus) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CredentialRotationStatus) GetComponent() NVSwitchComponent {
+	if x != nil {
+		return x.Component
+	}
+	return NVSwitchComponent_NVSWITCH_COMPONENT_UNKNOWN
+}
+
+func (x *CredentialRotationStatus) GetState() CredentialRotationState {
+	if x != nil {
+		return x.State
+	}
+	return CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN
+}
+
+func (x *CredentialRotationStatus) GetLastAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetLastRotated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotated
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetNextRotation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRotation
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetRotationError() string {
+	if x != nil {
+		return x.RotationError
+	}
+	return ""
+}
+
+func (x *CredentialRotationStatus) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *CredentialRotationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetCredentialRotationStatusResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Statuses      []*CredentialRotationStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCredentialRotationStatusResponse) Reset() {
+	*x = GetCredentialRotationStatusResponse{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialRotationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialRotationStatusResponse) ProtoMessage() {}
+
+func (x *GetCredentialRotationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCredentialRotationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCredentialRotationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCredentialRotationStatusResponse) GetStatuses() []*CredentialRotationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// StreamEventsRequest selects the events to stream.
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`                                   // Switch UUIDs; empty = all switches
+	Kinds         []NVSwitchEventKind    `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=v1.NVSwitchEventKind" json:"kinds,omitempty"` // Empty = all kinds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StreamEventsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetKinds() []NVSwitchEventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// NVSwitchEvent is a Redfish event reported by a switch BMC.
+type NVSwitchEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Kind          NVSwitchEventKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=v1.NVSwitchEventKind" json:"kind,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`                    // Redfish severity, e.g. OK, Warning, Critical
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Redfish MessageId, e.g. TaskEvent.1.0.TaskCompletedOK
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Origin        string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"` // URI of the resource the event is about
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NVSwitchEvent) Reset() {
+	*x = NVSwitchEvent{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NVSwitchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVSwitchEvent) ProtoMessage() {}
+
+func (x *NVSwitchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NVSwitchEvent.ProtoReflect.Descriptor instead.
+func (*NVSwitchEvent) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NVSwitchEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetKind() NVSwitchEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN
+}
+
+func (x *NVSwitchEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_internal_proto_v1_nvswitch_manager_proto protoreflect.FileDescriptor
+
+const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
+	"\n" +
+	"(internal/proto/v1/nvswitch-manager.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
+	"\vCredentials\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12&\n" +
+	"\x0fssh_private_key\x18\x03 \x01(\tR\rsshPrivateKey\"\x92\x01\n" +
+	"\tSubsystem\x12\x1f\n" +
+	"\vmac_address\x18\x01 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x121\n" +
+	"\vcredentials\x18\x03 \x01(\v2\x0f.v1.CredentialsR\vcredentials\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\"\x88\x02\n" +
+	"\aBMCInfo\x12\x1f\n" +
+	"\vmac_address\x18\x01 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12\x14\n" +
 	"\x05model\x18\x04 \x01(\tR\x05model\x12\"\n" +
 	"\fmanufacturer\x18\x05 \x01(\tR\fmanufacturer\x12\x1f\n" +
 	"\vpart_number\x18\x06 \x01(\tR\n" +
 	"partNumber\x12)\n" +
 	"\x10firmware_version\x18\a \x01(\tR\x0ffirmwareVersion\x12\x12\n" +
-	"\x04port\x18\b \x01(\x05R\x04port\"x\n" +
+	"\x04port\x18\b \x01(\x05R\x04port\"\xaa\x01\n" +
 	"\bNVOSInfo\x12\x1f\n" +
 	"\vmac_address\x18\x01 \x01(\tR\n" +
 	"macAddress\x12\x1d\n" +
 	"\n" +
 	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\x12\n" +
-	"\x04port\x18\x04 \x01(\x05R\x04port\"h\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x120\n" +
+	"\x14host_key_fingerprint\x18\x05 \x01(\tR\x12hostKeyFingerprint\"h\n" +
 	"\aChassis\x12#\n" +
 	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12\"\n" +
@@ -2375,7 +3955,15 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"\x1aRegisterNVSwitchesResponse\x12:\n" +
 	"\tresponses\x18\x01 \x03(\v2\x1c.v1.RegisterNVSwitchResponseR\tresponses\"'\n" +
 	"\x0fNVSwitchRequest\x12\x14\n" +
-	"\x05uuids\x18\x01 \x03(\tR\x05uuids\"{\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\"H\n" +
+	"\x17RekeyNVOSHostKeyRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x19\n" +
+	"\bhost_key\x18\x02 \x01(\tR\ahostKey\"\x9e\x01\n" +
+	"\x18RekeyNVOSHostKeyResponse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12&\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x120\n" +
+	"\x14host_key_fingerprint\x18\x04 \x01(\tR\x12hostKeyFingerprint\"{\n" +
 	"\x10NVSwitchResponse\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12&\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
@@ -2445,7 +4033,7 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"\tupdate_id\x18\x01 \x01(\tR\bupdateId\"J\n" +
 	"\x14CancelUpdateResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xf4\x04\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x05\n" +
 	"\x12FirmwareUpdateInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vswitch_uuid\x18\x02 \x01(\tR\n" +
@@ -2466,7 +4054,105 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
 	"\x10bundle_update_id\x18\r \x01(\tR\x0ebundleUpdateId\x12%\n" +
 	"\x0esequence_order\x18\x0e \x01(\x05R\rsequenceOrder\x12%\n" +
-	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId*/\n" +
+	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId\x12\x1b\n" +
+	"\tsigned_by\x18\x10 \x01(\tR\bsignedBy\"\xd0\x02\n" +
+	"\rRolloutPolicy\x12\x1f\n" +
+	"\vcanary_size\x18\x01 \x01(\x05R\n" +
+	"canarySize\x12#\n" +
+	"\rwave_percents\x18\x02 \x03(\x05R\fwavePercents\x12!\n" +
+	"\fsoak_seconds\x18\x03 \x01(\x03R\vsoakSeconds\x12(\n" +
+	"\x10max_failure_rate\x18\x04 \x01(\x01R\x0emaxFailureRate\x126\n" +
+	"\x17skip_reachability_check\x18\x05 \x01(\bR\x15skipReachabilityCheck\x125\n" +
+	"\x17skip_nvos_version_check\x18\x06 \x01(\bR\x14skipNvosVersionCheck\x12=\n" +
+	"\x0fon_gate_failure\x18\a \x01(\x0e2\x15.v1.RolloutGateActionR\ronGateFailure\"\xc2\x01\n" +
+	"\x14CreateRolloutRequest\x12%\n" +
+	"\x0ebundle_version\x18\x01 \x01(\tR\rbundleVersion\x125\n" +
+	"\n" +
+	"components\x18\x02 \x03(\x0e2\x15.v1.NVSwitchComponentR\n" +
+	"components\x12!\n" +
+	"\fswitch_uuids\x18\x03 \x03(\tR\vswitchUuids\x12)\n" +
+	"\x06policy\x18\x04 \x01(\v2\x11.v1.RolloutPolicyR\x06policy\"/\n" +
+	"\x0eRolloutRequest\x12\x1d\n" +
+	"\n" +
+	"rollout_id\x18\x01 \x01(\tR\trolloutId\"V\n" +
+	"\x14ResumeRolloutRequest\x12\x1d\n" +
+	"\n" +
+	"rollout_id\x18\x01 \x01(\tR\trolloutId\x12\x1f\n" +
+	"\vaccept_wave\x18\x02 \x01(\bR\n" +
+	"acceptWave\"8\n" +
+	"\x0fRolloutResponse\x12%\n" +
+	"\arollout\x18\x01 \x01(\v2\v.v1.RolloutR\arollout\"?\n" +
+	"\x14ListRolloutsResponse\x12'\n" +
+	"\brollouts\x18\x01 \x03(\v2\v.v1.RolloutR\brollouts\"\x93\x01\n" +
+	"\rRolloutTarget\x12\x1f\n" +
+	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
+	"switchUuid\x12,\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x16.v1.RolloutTargetStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"update_ids\x18\x03 \x03(\tR\tupdateIds\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xcb\x02\n" +
+	"\vRolloutWave\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12*\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x14.v1.RolloutWaveStateR\x05state\x12+\n" +
+	"\atargets\x18\x03 \x03(\v2\x11.v1.RolloutTargetR\atargets\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"soak_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tsoakUntil\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xa4\x03\n" +
+	"\aRollout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ebundle_version\x18\x02 \x01(\tR\rbundleVersion\x125\n" +
+	"\n" +
+	"components\x18\x03 \x03(\x0e2\x15.v1.NVSwitchComponentR\n" +
+	"components\x12)\n" +
+	"\x06policy\x18\x04 \x01(\v2\x11.v1.RolloutPolicyR\x06policy\x12&\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x10.v1.RolloutStateR\x05state\x12!\n" +
+	"\fcurrent_wave\x18\x06 \x01(\x05R\vcurrentWave\x12%\n" +
+	"\x05waves\x18\a \x03(\v2\x0f.v1.RolloutWaveR\x05waves\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
+	"\x18RotateCredentialsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x125\n" +
+	"\n" +
+	"components\x18\x02 \x03(\x0e2\x15.v1.NVSwitchComponentR\n" +
+	"components\"\xa0\x01\n" +
+	"\x17RotateCredentialsResult\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x123\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x15.v1.NVSwitchComponentR\tcomponent\x12&\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"R\n" +
+	"\x19RotateCredentialsResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.v1.RotateCredentialsResultR\aresults\"\xba\x03\n" +
+	"\x18CredentialRotationStatus\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x123\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x15.v1.NVSwitchComponentR\tcomponent\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.v1.CredentialRotationStateR\x05state\x12=\n" +
+	"\flast_attempt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlastAttempt\x12=\n" +
+	"\flast_rotated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastRotated\x12?\n" +
+	"\rnext_rotation\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fnextRotation\x12%\n" +
+	"\x0erotation_error\x18\a \x01(\tR\rrotationError\x12&\n" +
+	"\x06status\x18\b \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"_\n" +
+	"#GetCredentialRotationStatusResponse\x128\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1c.v1.CredentialRotationStatusR\bstatuses\"X\n" +
+	"\x13StreamEventsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12+\n" +
+	"\x05kinds\x18\x02 \x03(\x0e2\x15.v1.NVSwitchEventKindR\x05kinds\"\xf5\x01\n" +
+	"\rNVSwitchEvent\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12)\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x15.v1.NVSwitchEventKindR\x04kind\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x16\n" +
+	"\x06origin\x18\x06 \x01(\tR\x06origin\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*/\n" +
 	"\x06Vendor\x12\x12\n" +
 	"\x0eVENDOR_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rVENDOR_NVIDIA\x10\x01*C\n" +
@@ -2509,18 +4195,67 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"\x16UPDATE_STATE_COMPLETED\x10\n" +
 	"\x12\x17\n" +
 	"\x13UPDATE_STATE_FAILED\x10\v\x12\x1a\n" +
-	"\x16UPDATE_STATE_CANCELLED\x10\f2\xc6\x05\n" +
+	"\x16UPDATE_STATE_CANCELLED\x10\f*\x96\x01\n" +
+	"\fRolloutState\x12\x19\n" +
+	"\x15ROLLOUT_STATE_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15ROLLOUT_STATE_RUNNING\x10\x01\x12\x18\n" +
+	"\x14ROLLOUT_STATE_PAUSED\x10\x02\x12\x1b\n" +
+	"\x17ROLLOUT_STATE_COMPLETED\x10\x03\x12\x19\n" +
+	"\x15ROLLOUT_STATE_ABORTED\x10\x04*\xf6\x01\n" +
+	"\x10RolloutWaveState\x12\x1e\n" +
+	"\x1aROLLOUT_WAVE_STATE_UNKNOWN\x10\x00\x12\x1e\n" +
+	"\x1aROLLOUT_WAVE_STATE_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bROLLOUT_WAVE_STATE_UPDATING\x10\x02\x12\x1e\n" +
+	"\x1aROLLOUT_WAVE_STATE_SOAKING\x10\x03\x12 \n" +
+	"\x1cROLLOUT_WAVE_STATE_SUCCEEDED\x10\x04\x12\x1d\n" +
+	"\x19ROLLOUT_WAVE_STATE_FAILED\x10\x05\x12 \n" +
+	"\x1cROLLOUT_WAVE_STATE_CANCELLED\x10\x06*\xc0\x01\n" +
+	"\x12RolloutTargetState\x12 \n" +
+	"\x1cROLLOUT_TARGET_STATE_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cROLLOUT_TARGET_STATE_PENDING\x10\x01\x12!\n" +
+	"\x1dROLLOUT_TARGET_STATE_UPDATING\x10\x02\x12\"\n" +
+	"\x1eROLLOUT_TARGET_STATE_SUCCEEDED\x10\x03\x12\x1f\n" +
+	"\x1bROLLOUT_TARGET_STATE_FAILED\x10\x04*r\n" +
+	"\x11RolloutGateAction\x12\x1f\n" +
+	"\x1bROLLOUT_GATE_ACTION_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19ROLLOUT_GATE_ACTION_PAUSE\x10\x01\x12\x1d\n" +
+	"\x19ROLLOUT_GATE_ACTION_ABORT\x10\x02*\x96\x02\n" +
+	"\x17CredentialRotationState\x12%\n" +
+	"!CREDENTIAL_ROTATION_STATE_UNKNOWN\x10\x00\x12+\n" +
+	"'CREDENTIAL_ROTATION_STATE_NEVER_ROTATED\x10\x01\x12)\n" +
+	"%CREDENTIAL_ROTATION_STATE_IN_PROGRESS\x10\x02\x12'\n" +
+	"#CREDENTIAL_ROTATION_STATE_SUCCEEDED\x10\x03\x12$\n" +
+	" CREDENTIAL_ROTATION_STATE_FAILED\x10\x04\x12-\n" +
+	")CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED\x10\x05*\xb9\x01\n" +
+	"\x11NVSwitchEventKind\x12\x1f\n" +
+	"\x1bNVSWITCH_EVENT_KIND_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dNVSWITCH_EVENT_KIND_PSU_FAULT\x10\x01\x12#\n" +
+	"\x1fNVSWITCH_EVENT_KIND_POWER_STATE\x10\x02\x12\x1c\n" +
+	"\x18NVSWITCH_EVENT_KIND_TASK\x10\x03\x12\x1d\n" +
+	"\x19NVSWITCH_EVENT_KIND_OTHER\x10\x042\xed\n" +
+	"\n" +
 	"\x0fNVSwitchManager\x12S\n" +
 	"\x12RegisterNVSwitches\x12\x1d.v1.RegisterNVSwitchesRequest\x1a\x1e.v1.RegisterNVSwitchesResponse\x12?\n" +
-	"\rGetNVSwitches\x12\x13.v1.NVSwitchRequest\x1a\x19.v1.GetNVSwitchesResponse\x12>\n" +
+	"\rGetNVSwitches\x12\x13.v1.NVSwitchRequest\x1a\x19.v1.GetNVSwitchesResponse\x12M\n" +
+	"\x10RekeyNVOSHostKey\x12\x1b.v1.RekeyNVOSHostKeyRequest\x1a\x1c.v1.RekeyNVOSHostKeyResponse\x12>\n" +
 	"\vListBundles\x12\x16.google.protobuf.Empty\x1a\x17.v1.ListBundlesResponse\x12>\n" +
 	"\vQueueUpdate\x12\x16.v1.QueueUpdateRequest\x1a\x17.v1.QueueUpdateResponse\x12A\n" +
 	"\fQueueUpdates\x12\x17.v1.QueueUpdatesRequest\x1a\x18.v1.QueueUpdatesResponse\x128\n" +
 	"\tGetUpdate\x12\x14.v1.GetUpdateRequest\x1a\x15.v1.GetUpdateResponse\x12V\n" +
 	"\x13GetUpdatesForSwitch\x12\x1e.v1.GetUpdatesForSwitchRequest\x1a\x1f.v1.GetUpdatesForSwitchResponse\x12B\n" +
 	"\rGetAllUpdates\x12\x16.google.protobuf.Empty\x1a\x19.v1.GetAllUpdatesResponse\x12A\n" +
-	"\fCancelUpdate\x12\x17.v1.CancelUpdateRequest\x1a\x18.v1.CancelUpdateResponse\x12A\n" +
-	"\fPowerControl\x12\x17.v1.PowerControlRequest\x1a\x18.v1.PowerControlResponseB\n" +
+	"\fCancelUpdate\x12\x17.v1.CancelUpdateRequest\x1a\x18.v1.CancelUpdateResponse\x12>\n" +
+	"\rCreateRollout\x12\x18.v1.CreateRolloutRequest\x1a\x13.v1.RolloutResponse\x125\n" +
+	"\n" +
+	"GetRollout\x12\x12.v1.RolloutRequest\x1a\x13.v1.RolloutResponse\x12@\n" +
+	"\fListRollouts\x12\x16.google.protobuf.Empty\x1a\x18.v1.ListRolloutsResponse\x127\n" +
+	"\fPauseRollout\x12\x12.v1.RolloutRequest\x1a\x13.v1.RolloutResponse\x12>\n" +
+	"\rResumeRollout\x12\x18.v1.ResumeRolloutRequest\x1a\x13.v1.RolloutResponse\x127\n" +
+	"\fAbortRollout\x12\x12.v1.RolloutRequest\x1a\x13.v1.RolloutResponse\x12A\n" +
+	"\fPowerControl\x12\x17.v1.PowerControlRequest\x1a\x18.v1.PowerControlResponse\x12P\n" +
+	"\x11RotateCredentials\x12\x1c.v1.RotateCredentialsRequest\x1a\x1d.v1.RotateCredentialsResponse\x12[\n" +
+	"\x1bGetCredentialRotationStatus\x12\x13.v1.NVSwitchRequest\x1a'.v1.GetCredentialRotationStatusResponse\x12<\n" +
+	"\fStreamEvents\x12\x17.v1.StreamEventsRequest\x1a\x11.v1.NVSwitchEvent0\x01B\n" +
 	"Z\bproto/v1b\x06proto3"
 
 var (
@@ -2535,110 +4270,186 @@ func file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP() []byte {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescData
 }
 
-var file_internal_proto_v1_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_internal_proto_v1_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_internal_proto_v1_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_internal_proto_v1_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_internal_proto_v1_nvswitch_manager_proto_goTypes = []any{
-	(Vendor)(0),                         // 0: v1.Vendor
-	(StatusCode)(0),                     // 1: v1.StatusCode
-	(PowerAction)(0),                    // 2: v1.PowerAction
-	(NVSwitchComponent)(0),              // 3: v1.NVSwitchComponent
-	(UpdateStrategy)(0),                 // 4: v1.UpdateStrategy
-	(UpdateState)(0),                    // 5: v1.UpdateState
-	(*Credentials)(nil),                 // 6: v1.Credentials
-	(*Subsystem)(nil),                   // 7: v1.Subsystem
-	(*BMCInfo)(nil),                     // 8: v1.BMCInfo
-	(*NVOSInfo)(nil),                    // 9: v1.NVOSInfo
-	(*Chassis)(nil),                     // 10: v1.Chassis
-	(*NVSwitchTray)(nil),                // 11: v1.NVSwitchTray
-	(*RegisterNVSwitchRequest)(nil),     // 12: v1.RegisterNVSwitchRequest
-	(*RegisterNVSwitchesRequest)(nil),   // 13: v1.RegisterNVSwitchesRequest
-	(*RegisterNVSwitchResponse)(nil),    // 14: v1.RegisterNVSwitchResponse
-	(*RegisterNVSwitchesResponse)(nil),  // 15: v1.RegisterNVSwitchesResponse
-	(*NVSwitchRequest)(nil),             // 16: v1.NVSwitchRequest
-	(*NVSwitchResponse)(nil),            // 17: v1.NVSwitchResponse
-	(*PowerTarget)(nil),                 // 18: v1.PowerTarget
-	(*PowerControlRequest)(nil),         // 19: v1.PowerControlRequest
-	(*PowerControlResponse)(nil),        // 20: v1.PowerControlResponse
-	(*GetNVSwitchesResponse)(nil),       // 21: v1.GetNVSwitchesResponse
-	(*FirmwareBundle)(nil),              // 22: v1.FirmwareBundle
-	(*ComponentInfo)(nil),               // 23: v1.ComponentInfo
-	(*ListBundlesResponse)(nil),         // 24: v1.ListBundlesResponse
-	(*QueueUpdateRequest)(nil),          // 25: v1.QueueUpdateRequest
-	(*QueueUpdateResponse)(nil),         // 26: v1.QueueUpdateResponse
-	(*QueueUpdatesRequest)(nil),         // 27: v1.QueueUpdatesRequest
-	(*QueueUpdatesResponse)(nil),        // 28: v1.QueueUpdatesResponse
-	(*QueueUpdateResult)(nil),           // 29: v1.QueueUpdateResult
-	(*GetUpdateRequest)(nil),            // 30: v1.GetUpdateRequest
-	(*GetUpdateResponse)(nil),           // 31: v1.GetUpdateResponse
-	(*GetUpdatesForSwitchRequest)(nil),  // 32: v1.GetUpdatesForSwitchRequest
-	(*GetUpdatesForSwitchResponse)(nil), // 33: v1.GetUpdatesForSwitchResponse
-	(*GetAllUpdatesResponse)(nil),       // 34: v1.GetAllUpdatesResponse
-	(*CancelUpdateRequest)(nil),         // 35: v1.CancelUpdateRequest
-	(*CancelUpdateResponse)(nil),        // 36: v1.CancelUpdateResponse
-	(*FirmwareUpdateInfo)(nil),          // 37: v1.FirmwareUpdateInfo
-	(*timestamppb.Timestamp)(nil),       // 38: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 39: google.protobuf.Empty
+	(Vendor)(0),                                 // 0: v1.Vendor
+	(StatusCode)(0),                             // 1: v1.StatusCode
+	(PowerAction)(0),                            // 2: v1.PowerAction
+	(NVSwitchComponent)(0),                      // 3: v1.NVSwitchComponent
+	(UpdateStrategy)(0),                         // 4: v1.UpdateStrategy
+	(UpdateState)(0),                            // 5: v1.UpdateState
+	(RolloutState)(0),                           // 6: v1.RolloutState
+	(RolloutWaveState)(0),                       // 7: v1.RolloutWaveState
+	(RolloutTargetState)(0),                     // 8: v1.RolloutTargetState
+	(RolloutGateAction)(0),                      // 9: v1.RolloutGateAction
+	(CredentialRotationState)(0),                // 10: v1.CredentialRotationState
+	(NVSwitchEventKind)(0),                      // 11: v1.NVSwitchEventKind
+	(*Credentials)(nil),                         // 12: v1.Credentials
+	(*Subsystem)(nil),                           // 13: v1.Subsystem
+	(*BMCInfo)(nil),                             // 14: v1.BMCInfo
+	(*NVOSInfo)(nil),                            // 15: v1.NVOSInfo
+	(*Chassis)(nil),                             // 16: v1.Chassis
+	(*NVSwitchTray)(nil),                        // 17: v1.NVSwitchTray
+	(*RegisterNVSwitchRequest)(nil),             // 18: v1.RegisterNVSwitchRequest
+	(*RegisterNVSwitchesRequest)(nil),           // 19: v1.RegisterNVSwitchesRequest
+	(*RegisterNVSwitchResponse)(nil),            // 20: v1.RegisterNVSwitchResponse
+	(*RegisterNVSwitchesResponse)(nil),          // 21: v1.RegisterNVSwitchesResponse
+	(*NVSwitchRequest)(nil),                     // 22: v1.NVSwitchRequest
+	(*RekeyNVOSHostKeyRequest)(nil),             // 23: v1.RekeyNVOSHostKeyRequest
+	(*RekeyNVOSHostKeyResponse)(nil),            // 24: v1.RekeyNVOSHostKeyResponse
+	(*NVSwitchResponse)(nil),                    // 25: v1.NVSwitchResponse
+	(*PowerTarget)(nil),                         // 26: v1.PowerTarget
+	(*PowerControlRequest)(nil),                 // 27: v1.PowerControlRequest
+	(*PowerControlResponse)(nil),                // 28: v1.PowerControlResponse
+	(*GetNVSwitchesResponse)(nil),               // 29: v1.GetNVSwitchesResponse
+	(*FirmwareBundle)(nil),                      // 30: v1.FirmwareBundle
+	(*ComponentInfo)(nil),                       // 31: v1.ComponentInfo
+	(*ListBundlesResponse)(nil),                 // 32: v1.ListBundlesResponse
+	(*QueueUpdateRequest)(nil),                  // 33: v1.QueueUpdateRequest
+	(*QueueUpdateResponse)(nil),                 // 34: v1.QueueUpdateResponse
+	(*QueueUpdatesRequest)(nil),                 // 35: v1.QueueUpdatesRequest
+	(*QueueUpdatesResponse)(nil),                // 36: v1.QueueUpdatesResponse
+	(*QueueUpdateResult)(nil),                   // 37: v1.QueueUpdateResult
+	(*GetUpdateRequest)(nil),                    // 38: v1.GetUpdateRequest
+	(*GetUpdateResponse)(nil),                   // 39: v1.GetUpdateResponse
+	(*GetUpdatesForSwitchRequest)(nil),          // 40: v1.GetUpdatesForSwitchRequest
+	(*GetUpdatesForSwitchResponse)(nil),         // 41: v1.GetUpdatesForSwitchResponse
+	(*GetAllUpdatesResponse)(nil),               // 42: v1.GetAllUpdatesResponse
+	(*CancelUpdateRequest)(nil),                 // 43: v1.CancelUpdateRequest
+	(*CancelUpdateResponse)(nil),                // 44: v1.CancelUpdateResponse
+	(*FirmwareUpdateInfo)(nil),                  // 45: v1.FirmwareUpdateInfo
+	(*RolloutPolicy)(nil),                       // 46: v1.RolloutPolicy
+	(*CreateRolloutRequest)(nil),                // 47: v1.CreateRolloutRequest
+	(*RolloutRequest)(nil),                      // 48: v1.RolloutRequest
+	(*ResumeRolloutRequest)(nil),                // 49: v1.ResumeRolloutRequest
+	(*RolloutResponse)(nil),                     // 50: v1.RolloutResponse
+	(*ListRolloutsResponse)(nil),                // 51: v1.ListRolloutsResponse
+	(*RolloutTarget)(nil),                       // 52: v1.RolloutTarget
+	(*RolloutWave)(nil),                         // 53: v1.RolloutWave
+	(*Rollout)(nil),                             // 54: v1.Rollout
+	(*RotateCredentialsRequest)(nil),            // 55: v1.RotateCredentialsRequest
+	(*RotateCredentialsResult)(nil),             // 56: v1.RotateCredentialsResult
+	(*RotateCredentialsResponse)(nil),           // 57: v1.RotateCredentialsResponse
+	(*CredentialRotationStatus)(nil),            // 58: v1.CredentialRotationStatus
+	(*GetCredentialRotationStatusResponse)(nil), // 59: v1.GetCredentialRotationStatusResponse
+	(*StreamEventsRequest)(nil),                 // 60: v1.StreamEventsRequest
+	(*NVSwitchEvent)(nil),                       // 61: v1.NVSwitchEvent
+	(*timestamppb.Timestamp)(nil),               // 62: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 63: google.protobuf.Empty
 }
 var file_internal_proto_v1_nvswitch_manager_proto_depIdxs = []int32{
-	6,  // 0: v1.Subsystem.credentials:type_name -> v1.Credentials
+	12, // 0: v1.Subsystem.credentials:type_name -> v1.Credentials
 	0,  // 1: v1.NVSwitchTray.vendor:type_name -> v1.Vendor
-	8,  // 2: v1.NVSwitchTray.bmc:type_name -> v1.BMCInfo
-	9,  // 3: v1.NVSwitchTray.nvos:type_name -> v1.NVOSInfo
-	10, // 4: v1.NVSwitchTray.chassis:type_name -> v1.Chassis
+	14, // 2: v1.NVSwitchTray.bmc:type_name -> v1.BMCInfo
+	15, // 3: v1.NVSwitchTray.nvos:type_name -> v1.NVOSInfo
+	16, // 4: v1.NVSwitchTray.chassis:type_name -> v1.Chassis
 	0,  // 5: v1.RegisterNVSwitchRequest.vendor:type_name -> v1.Vendor
-	7,  // 6: v1.RegisterNVSwitchRequest.bmc:type_name -> v1.Subsystem
-	7,  // 7: v1.RegisterNVSwitchRequest.nvos:type_name -> v1.Subsystem
-	12, // 8: v1.RegisterNVSwitchesRequest.registration_requests:type_name -> v1.RegisterNVSwitchRequest
-	38, // 9: v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
+	13, // 6: v1.RegisterNVSwitchRequest.bmc:type_name -> v1.Subsystem
+	13, // 7: v1.RegisterNVSwitchRequest.nvos:type_name -> v1.Subsystem
+	18, // 8: v1.RegisterNVSwitchesRequest.registration_requests:type_name -> v1.RegisterNVSwitchRequest
+	62, // 9: v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
 	1,  // 10: v1.RegisterNVSwitchResponse.status:type_name -> v1.StatusCode
-	14, // 11: v1.RegisterNVSwitchesResponse.responses:type_name -> v1.RegisterNVSwitchResponse
-	1,  // 12: v1.NVSwitchResponse.status:type_name -> v1.StatusCode
-	6,  // 13: v1.PowerTarget.bmc_credentials:type_name -> v1.Credentials
-	2,  // 14: v1.PowerControlRequest.action:type_name -> v1.PowerAction
-	18, // 15: v1.PowerControlRequest.targets:type_name -> v1.PowerTarget
-	17, // 16: v1.PowerControlResponse.responses:type_name -> v1.NVSwitchResponse
-	11, // 17: v1.GetNVSwitchesResponse.nvswitches:type_name -> v1.NVSwitchTray
-	23, // 18: v1.FirmwareBundle.components:type_name -> v1.ComponentInfo
-	22, // 19: v1.ListBundlesResponse.bundles:type_name -> v1.FirmwareBundle
-	3,  // 20: v1.QueueUpdateRequest.components:type_name -> v1.NVSwitchComponent
-	37, // 21: v1.QueueUpdateResponse.updates:type_name -> v1.FirmwareUpdateInfo
-	3,  // 22: v1.QueueUpdatesRequest.components:type_name -> v1.NVSwitchComponent
-	29, // 23: v1.QueueUpdatesResponse.results:type_name -> v1.QueueUpdateResult
-	1,  // 24: v1.QueueUpdateResult.status:type_name -> v1.StatusCode
-	37, // 25: v1.QueueUpdateResult.updates:type_name -> v1.FirmwareUpdateInfo
-	37, // 26: v1.GetUpdateResponse.update:type_name -> v1.FirmwareUpdateInfo
-	37, // 27: v1.GetUpdatesForSwitchResponse.updates:type_name -> v1.FirmwareUpdateInfo
-	37, // 28: v1.GetAllUpdatesResponse.updates:type_name -> v1.FirmwareUpdateInfo
-	3,  // 29: v1.FirmwareUpdateInfo.component:type_name -> v1.NVSwitchComponent
-	4,  // 30: v1.FirmwareUpdateInfo.strategy:type_name -> v1.UpdateStrategy
-	5,  // 31: v1.FirmwareUpdateInfo.state:type_name -> v1.UpdateState
-	38, // 32: v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
-	38, // 33: v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 34: v1.NVSwitchManager.RegisterNVSwitches:input_type -> v1.RegisterNVSwitchesRequest
-	16, // 35: v1.NVSwitchManager.GetNVSwitches:input_type -> v1.NVSwitchRequest
-	39, // 36: v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
-	25, // 37: v1.NVSwitchManager.QueueUpdate:input_type -> v1.QueueUpdateRequest
-	27, // 38: v1.NVSwitchManager.QueueUpdates:input_type -> v1.QueueUpdatesRequest
-	30, // 39: v1.NVSwitchManager.GetUpdate:input_type -> v1.GetUpdateRequest
-	32, // 40: v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> v1.GetUpdatesForSwitchRequest
-	39, // 41: v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
-	35, // 42: v1.NVSwitchManager.CancelUpdate:input_type -> v1.CancelUpdateRequest
-	19, // 43: v1.NVSwitchManager.PowerControl:input_type -> v1.PowerControlRequest
-	15, // 44: v1.NVSwitchManager.RegisterNVSwitches:output_type -> v1.RegisterNVSwitchesResponse
-	21, // 45: v1.NVSwitchManager.GetNVSwitches:output_type -> v1.GetNVSwitchesResponse
-	24, // 46: v1.NVSwitchManager.ListBundles:output_type -> v1.ListBundlesResponse
-	26, // 47: v1.NVSwitchManager.QueueUpdate:output_type -> v1.QueueUpdateResponse
-	28, // 48: v1.NVSwitchManager.QueueUpdates:output_type -> v1.QueueUpdatesResponse
-	31, // 49: v1.NVSwitchManager.GetUpdate:output_type -> v1.GetUpdateResponse
-	33, // 50: v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> v1.GetUpdatesForSwitchResponse
-	34, // 51: v1.NVSwitchManager.GetAllUpdates:output_type -> v1.GetAllUpdatesResponse
-	36, // 52: v1.NVSwitchManager.CancelUpdate:output_type -> v1.CancelUpdateResponse
-	20, // 53: v1.NVSwitchManager.PowerControl:output_type -> v1.PowerControlResponse
-	44, // [44:54] is the sub-list for method output_type
-	34, // [34:44] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	20, // 11: v1.RegisterNVSwitchesResponse.responses:type_name -> v1.RegisterNVSwitchResponse
+	1,  // 12: v1.RekeyNVOSHostKeyResponse.status:type_name -> v1.StatusCode
+	1,  // 13: v1.NVSwitchResponse.status:type_name -> v1.StatusCode
+	12, // 14: v1.PowerTarget.bmc_credentials:type_name -> v1.Credentials
+	2,  // 15: v1.PowerControlRequest.action:type_name -> v1.PowerAction
+	26, // 16: v1.PowerControlRequest.targets:type_name -> v1.PowerTarget
+	25, // 17: v1.PowerControlResponse.responses:type_name -> v1.NVSwitchResponse
+	17, // 18: v1.GetNVSwitchesResponse.nvswitches:type_name -> v1.NVSwitchTray
+	31, // 19: v1.FirmwareBundle.components:type_name -> v1.ComponentInfo
+	30, // 20: v1.ListBundlesResponse.bundles:type_name -> v1.FirmwareBundle
+	3,  // 21: v1.QueueUpdateRequest.components:type_name -> v1.NVSwitchComponent
+	45, // 22: v1.QueueUpdateResponse.updates:type_name -> v1.FirmwareUpdateInfo
+	3,  // 23: v1.QueueUpdatesRequest.components:type_name -> v1.NVSwitchComponent
+	37, // 24: v1.QueueUpdatesResponse.results:type_name -> v1.QueueUpdateResult
+	1,  // 25: v1.QueueUpdateResult.status:type_name -> v1.StatusCode
+	45, // 26: v1.QueueUpdateResult.updates:type_name -> v1.FirmwareUpdateInfo
+	45, // 27: v1.GetUpdateResponse.update:type_name -> v1.FirmwareUpdateInfo
+	45, // 28: v1.GetUpdatesForSwitchResponse.updates:type_name -> v1.FirmwareUpdateInfo
+	45, // 29: v1.GetAllUpdatesResponse.updates:type_name -> v1.FirmwareUpdateInfo
+	3,  // 30: v1.FirmwareUpdateInfo.component:type_name -> v1.NVSwitchComponent
+	4,  // 31: v1.FirmwareUpdateInfo.strategy:type_name -> v1.UpdateStrategy
+	5,  // 32: v1.FirmwareUpdateInfo.state:type_name -> v1.UpdateState
+	62, // 33: v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
+	62, // 34: v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 35: v1.RolloutPolicy.on_gate_failure:type_name -> v1.RolloutGateAction
+	3,  // 36: v1.CreateRolloutRequest.components:type_name -> v1.NVSwitchComponent
+	46, // 37: v1.CreateRolloutRequest.policy:type_name -> v1.RolloutPolicy
+	54, // 38: v1.RolloutResponse.rollout:type_name -> v1.Rollout
+	54, // 39: v1.ListRolloutsResponse.rollouts:type_name -> v1.Rollout
+	8,  // 40: v1.RolloutTarget.state:type_name -> v1.RolloutTargetState
+	7,  // 41: v1.RolloutWave.state:type_name -> v1.RolloutWaveState
+	52, // 42: v1.RolloutWave.targets:type_name -> v1.RolloutTarget
+	62, // 43: v1.RolloutWave.started_at:type_name -> google.protobuf.Timestamp
+	62, // 44: v1.RolloutWave.soak_until:type_name -> google.protobuf.Timestamp
+	62, // 45: v1.RolloutWave.completed_at:type_name -> google.protobuf.Timestamp
+	3,  // 46: v1.Rollout.components:type_name -> v1.NVSwitchComponent
+	46, // 47: v1.Rollout.policy:type_name -> v1.RolloutPolicy
+	6,  // 48: v1.Rollout.state:type_name -> v1.RolloutState
+	53, // 49: v1.Rollout.waves:type_name -> v1.RolloutWave
+	62, // 50: v1.Rollout.created_at:type_name -> google.protobuf.Timestamp
+	62, // 51: v1.Rollout.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 52: v1.RotateCredentialsRequest.components:type_name -> v1.NVSwitchComponent
+	3,  // 53: v1.RotateCredentialsResult.component:type_name -> v1.NVSwitchComponent
+	1,  // 54: v1.RotateCredentialsResult.status:type_name -> v1.StatusCode
+	56, // 55: v1.RotateCredentialsResponse.results:type_name -> v1.RotateCredentialsResult
+	3,  // 56: v1.CredentialRotationStatus.component:type_name -> v1.NVSwitchComponent
+	10, // 57: v1.CredentialRotationStatus.state:type_name -> v1.CredentialRotationState
+	62, // 58: v1.CredentialRotationStatus.last_attempt:type_name -> google.protobuf.Timestamp
+	62, // 59: v1.CredentialRotationStatus.last_rotated:type_name -> google.protobuf.Timestamp
+	62, // 60: v1.CredentialRotationStatus.next_rotation:type_name -> google.protobuf.Timestamp
+	1,  // 61: v1.CredentialRotationStatus.status:type_name -> v1.StatusCode
+	58, // 62: v1.GetCredentialRotationStatusResponse.statuses:type_name -> v1.CredentialRotationStatus
+	11, // 63: v1.StreamEventsRequest.kinds:type_name -> v1.NVSwitchEventKind
+	11, // 64: v1.NVSwitchEvent.kind:type_name -> v1.NVSwitchEventKind
+	62, // 65: v1.NVSwitchEvent.timestamp:type_name -> google.protobuf.Timestamp
+	19, // 66: v1.NVSwitchManager.RegisterNVSwitches:input_type -> v1.RegisterNVSwitchesRequest
+	22, // 67: v1.NVSwitchManager.GetNVSwitches:input_type -> v1.NVSwitchRequest
+	23, // 68: v1.NVSwitchManager.RekeyNVOSHostKey:input_type -> v1.RekeyNVOSHostKeyRequest
+	63, // 69: v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
+	33, // 70: v1.NVSwitchManager.QueueUpdate:input_type -> v1.QueueUpdateRequest
+	35, // 71: v1.NVSwitchManager.QueueUpdates:input_type -> v1.QueueUpdatesRequest
+	38, // 72: v1.NVSwitchManager.GetUpdate:input_type -> v1.GetUpdateRequest
+	40, // 73: v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> v1.GetUpdatesForSwitchRequest
+	63, // 74: v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
+	43, // 75: v1.NVSwitchManager.CancelUpdate:input_type -> v1.CancelUpdateRequest
+	47, // 76: v1.NVSwitchManager.CreateRollout:input_type -> v1.CreateRolloutRequest
+	48, // 77: v1.NVSwitchManager.GetRollout:input_type -> v1.RolloutRequest
+	63, // 78: v1.NVSwitchManager.ListRollouts:input_type -> google.protobuf.Empty
+	48, // 79: v1.NVSwitchManager.PauseRollout:input_type -> v1.RolloutRequest
+	49, // 80: v1.NVSwitchManager.ResumeRollout:input_type -> v1.ResumeRolloutRequest
+	48, // 81: v1.NVSwitchManager.AbortRollout:input_type -> v1.RolloutRequest
+	27, // 82: v1.NVSwitchManager.PowerControl:input_type -> v1.PowerControlRequest
+	55, // 83: v1.NVSwitchManager.RotateCredentials:input_type -> v1.RotateCredentialsRequest
+	22, // 84: v1.NVSwitchManager.GetCredentialRotationStatus:input_type -> v1.NVSwitchRequest
+	60, // 85: v1.NVSwitchManager.StreamEvents:input_type -> v1.StreamEventsRequest
+	21, // 86: v1.NVSwitchManager.RegisterNVSwitches:output_type -> v1.RegisterNVSwitchesResponse
+	29, // 87: v1.NVSwitchManager.GetNVSwitches:output_type -> v1.GetNVSwitchesResponse
+	24, // 88: v1.NVSwitchManager.RekeyNVOSHostKey:output_type -> v1.RekeyNVOSHostKeyResponse
+	32, // 89: v1.NVSwitchManager.ListBundles:output_type -> v1.ListBundlesResponse
+	34, // 90: v1.NVSwitchManager.QueueUpdate:output_type -> v1.QueueUpdateResponse
+	36, // 91: v1.NVSwitchManager.QueueUpdates:output_type -> v1.QueueUpdatesResponse
+	39, // 92: v1.NVSwitchManager.GetUpdate:output_type -> v1.GetUpdateResponse
+	41, // 93: v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> v1.GetUpdatesForSwitchResponse
+	42, // 94: v1.NVSwitchManager.GetAllUpdates:output_type -> v1.GetAllUpdatesResponse
+	44, // 95: v1.NVSwitchManager.CancelUpdate:output_type -> v1.CancelUpdateResponse
+	50, // 96: v1.NVSwitchManager.CreateRollout:output_type -> v1.RolloutResponse
+	50, // 97: v1.NVSwitchManager.GetRollout:output_type -> v1.RolloutResponse
+	51, // 98: v1.NVSwitchManager.ListRollouts:output_type -> v1.ListRolloutsResponse
+	50, // 99: v1.NVSwitchManager.PauseRollout:output_type -> v1.RolloutResponse
+	50, // 100: v1.NVSwitchManager.ResumeRollout:output_type -> v1.RolloutResponse
+	50, // 101: v1.NVSwitchManager.AbortRollout:output_type -> v1.RolloutResponse
+	28, // 102: v1.NVSwitchManager.PowerControl:output_type -> v1.PowerControlResponse
+	57, // 103: v1.NVSwitchManager.RotateCredentials:output_type -> v1.RotateCredentialsResponse
+	59, // 104: v1.NVSwitchManager.GetCredentialRotationStatus:output_type -> v1.GetCredentialRotationStatusResponse
+	61, // 105: v1.NVSwitchManager.StreamEvents:output_type -> v1.NVSwitchEvent
+	86, // [86:106] is the sub-list for method output_type
+	66, // [66:86] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_v1_nvswitch_manager_proto_init() }
@@ -2651,8 +4462,8 @@ func file_internal_proto_v1_nvswitch_manager_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_v1_nvswitch_manager_proto_rawDesc), len(file_internal_proto_v1_nvswitch_manager_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   32,
+			NumEnums:      12,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,16 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NVSwitchManager_RegisterNVSwitches_FullMethodName  = "/v1.NVSwitchManager/RegisterNVSwitches"
-	NVSwitchManager_GetNVSwitches_FullMethodName       = "/v1.NVSwitchManager/GetNVSwitches"
-	NVSwitchManager_ListBundles_FullMethodName         = "/v1.NVSwitchManager/ListBundles"
-	NVSwitchManager_QueueUpdate_FullMethodName         = "/v1.NVSwitchManager/QueueUpdate"
-	NVSwitchManager_QueueUpdates_FullMethodName        = "/v1.NVSwitchManager/QueueUpdates"
-	NVSwitchManager_GetUpdate_FullMethodName           = "/v1.NVSwitchManager/GetUpdate"
-	NVSwitchManager_GetUpdatesForSwitch_FullMethodName = "/v1.NVSwitchManager/GetUpdatesForSwitch"
-	NVSwitchManager_GetAllUpdates_FullMethodName       = "/v1.NVSwitchManager/GetAllUpdates"
-	NVSwitchManager_CancelUpdate_FullMethodName        = "/v1.NVSwitchManager/CancelUpdate"
-	NVSwitchManager_PowerControl_FullMethodName        = "/v1.NVSwitchManager/PowerControl"
+	NVSwitchManager_RegisterNVSwitches_FullMethodName          = "/v1.NVSwitchManager/RegisterNVSwitches"
+	NVSwitchManager_GetNVSwitches_FullMethodName               = "/v1.NVSwitchManager/GetNVSwitches"
+	NVSwitchManager_RekeyNVOSHostKey_FullMethodName            = "/v1.NVSwitchManager/RekeyNVOSHostKey"
+	NVSwitchManager_ListBundles_FullMethodName                 = "/v1.NVSwitchManager/ListBundles"
+	NVSwitchManager_QueueUpdate_FullMethodName                 = "/v1.NVSwitchManager/QueueUpdate"
+	NVSwitchManager_QueueUpdates_FullMethodName                = "/v1.NVSwitchManager/QueueUpdates"
+	NVSwitchManager_GetUpdate_FullMethodName                   = "/v1.NVSwitchManager/GetUpdate"
+	NVSwitchManager_GetUpdatesForSwitch_FullMethodName         = "/v1.NVSwitchManager/GetUpdatesForSwitch"
+	NVSwitchManager_GetAllUpdates_FullMethodName               = "/v1.NVSwitchManager/GetAllUpdates"
+	NVSwitchManager_CancelUpdate_FullMethodName                = "/v1.NVSwitchManager/CancelUpdate"
+	NVSwitchManager_CreateRollout_FullMethodName               = "/v1.NVSwitchManager/CreateRollout"
+	NVSwitchManager_GetRollout_FullMethodName                  = "/v1.NVSwitchManager/GetRollout"
+	NVSwitchManager_ListRollouts_FullMethodName                = "/v1.NVSwitchManager/ListRollouts"
+	NVSwitchManager_PauseRollout_FullMethodName                = "/v1.NVSwitchManager/PauseRollout"
+	NVSwitchManager_ResumeRollout_FullMethodName               = "/v1.NVSwitchManager/ResumeRollout"
+	NVSwitchManager_AbortRollout_FullMethodName                = "/v1.NVSwitchManager/AbortRollout"
+	NVSwitchManager_PowerControl_FullMethodName                = "/v1.NVSwitchManager/PowerControl"
+	NVSwitchManager_RotateCredentials_FullMethodName           = "/v1.NVSwitchManager/RotateCredentials"
+	NVSwitchManager_GetCredentialRotationStatus_FullMethodName = "/v1.NVSwitchManager/GetCredentialRotationStatus"
+	NVSwitchManager_StreamEvents_FullMethodName                = "/v1.NVSwitchManager/StreamEvents"
 )
 
 // NVSwitchManagerClient is the client API for NVSwitchManager service.
@@ -44,6 +54,8 @@ type NVSwitchManagerClient interface {
 	// Inventory Management
 	// GetNVSwitches returns NV-Switch information for the specified switches.
 	GetNVSwitches(ctx context.Context, in *NVSwitchRequest, opts ...grpc.CallOption) (*GetNVSwitchesResponse, error)
+	// RekeyNVOSHostKey replaces the pinned NVOS SSH host key of a switch, e.g. after a legitimate switch replacement.
+	RekeyNVOSHostKey(ctx context.Context, in *RekeyNVOSHostKeyRequest, opts ...grpc.CallOption) (*RekeyNVOSHostKeyResponse, error)
 	// Firmware Management (New API)
 	// ListBundles returns all available firmware bundles.
 	ListBundles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBundlesResponse, error)
@@ -59,9 +71,30 @@ type NVSwitchManagerClient interface {
 	GetAllUpdates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllUpdatesResponse, error)
 	// CancelUpdate cancels an in-progress firmware update.
 	CancelUpdate(ctx context.Context, in *CancelUpdateRequest, opts ...grpc.CallOption) (*CancelUpdateResponse, error)
+	// Firmware Rollouts
+	// CreateRollout starts rolling a bundle out to switches in waves: a canary wave, then percentage-based waves, each checked by health gates.
+	CreateRollout(ctx context.Context, in *CreateRolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error)
+	// GetRollout returns a rollout with its waves and per-switch progress.
+	GetRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error)
+	// ListRollouts returns all rollouts, newest first.
+	ListRollouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRolloutsResponse, error)
+	// PauseRollout stops a running rollout from advancing. Updates already queued keep running.
+	PauseRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error)
+	// ResumeRollout continues a paused rollout, re-checking (or accepting) a wave whose health gate failed.
+	ResumeRollout(ctx context.Context, in *ResumeRolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error)
+	// AbortRollout cancels the running updates of a rollout and the waves not yet started.
+	AbortRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error)
 	// Power Control
 	// PowerControl performs a power action (e.g. PowerCycle, GracefulShutdown) on NV-Switch trays.
 	PowerControl(ctx context.Context, in *PowerControlRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	// Credential Management
+	// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+	RotateCredentials(ctx context.Context, in *RotateCredentialsRequest, opts ...grpc.CallOption) (*RotateCredentialsResponse, error)
+	// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches (all if none are specified).
+	GetCredentialRotationStatus(ctx context.Context, in *NVSwitchRequest, opts ...grpc.CallOption) (*GetCredentialRotationStatusResponse, error)
+	// Events
+	// StreamEvents streams Redfish events (PSU faults, power state changes, task progress) reported by the BMCs of the specified switches (all if none are specified) until the client cancels.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NVSwitchEvent], error)
 }
 
 type nVSwitchManagerClient struct {
@@ -92,6 +125,16 @@ func (c *nVSwitchManagerClient) GetNVSwitches(ctx context.Context, in *NVSwitchR
 	return out, nil
 }
 
+func (c *nVSwitchManagerClient) RekeyNVOSHostKey(ctx context.Context, in *RekeyNVOSHostKeyRequest, opts ...grpc.CallOption) (*RekeyNVOSHostKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RekeyNVOSHostKeyResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_RekeyNVOSHostKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nVSwitchManagerClient) ListBundles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBundlesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBundlesResponse)
@@ -162,6 +205,66 @@ func (c *nVSwitchManagerClient) CancelUpdate(ctx context.Context, in *CancelUpda
 	return out, nil
 }
 
+func (c *nVSwitchManagerClient) CreateRollout(ctx context.Context, in *CreateRolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloutResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_CreateRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) GetRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloutResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_GetRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) ListRollouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRolloutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolloutsResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_ListRollouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) PauseRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloutResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_PauseRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) ResumeRollout(ctx context.Context, in *ResumeRolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloutResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_ResumeRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) AbortRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*RolloutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloutResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_AbortRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nVSwitchManagerClient) PowerControl(ctx context.Context, in *PowerControlRequest, opts ...grpc.CallOption) (*PowerControlResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PowerControlResponse)
@@ -172,6 +275,45 @@ func (c *nVSwitchManagerClient) PowerControl(ctx context.Context, in *PowerContr
 	return out, nil
 }
 
+func (c *nVSwitchManagerClient) RotateCredentials(ctx context.Context, in *RotateCredentialsRequest, opts ...grpc.CallOption) (*RotateCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCredentialsResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_RotateCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) GetCredentialRotationStatus(ctx context.Context, in *NVSwitchRequest, opts ...grpc.CallOption) (*GetCredentialRotationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialRotationStatusResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_GetCredentialRotationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NVSwitchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NVSwitchManager_ServiceDesc.Streams[0], NVSwitchManager_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, NVSwitchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NVSwitchManager_StreamEventsClient = grpc.ServerStreamingClient[NVSwitchEvent]
+
 // NVSwitchManagerServer is the server API for NVSwitchManager service.
 // All implementations must embed UnimplementedNVSwitchManagerServer
 // for forward compatibility.
@@ -184,6 +326,8 @@ type NVSwitchManagerServer interface {
 	// Inventory Management
 	// GetNVSwitches returns NV-Switch information for the specified switches.
 	GetNVSwitches(context.Context, *NVSwitchRequest) (*GetNVSwitchesResponse, error)
+	// RekeyNVOSHostKey replaces the pinned NVOS SSH host key of a switch, e.g. after a legitimate switch replacement.
+	RekeyNVOSHostKey(context.Context, *RekeyNVOSHostKeyRequest) (*RekeyNVOSHostKeyResponse, error)
 	// Firmware Management (New API)
 	// ListBundles returns all available firmware bundles.
 	ListBundles(context.Context, *emptypb.Empty) (*ListBundlesResponse, error)
@@ -199,9 +343,30 @@ type NVSwitchManagerServer interface {
 	GetAllUpdates(context.Context, *emptypb.Empty) (*GetAllUpdatesResponse, error)
 	// CancelUpdate cancels an in-progress firmware update.
 	CancelUpdate(context.Context, *CancelUpdateRequest) (*CancelUpdateResponse, error)
+	// Firmware Rollouts
+	// CreateRollout starts rolling a bundle out to switches in waves: a canary wave, then percentage-based waves, each checked by health gates.
+	CreateRollout(context.Context, *CreateRolloutRequest) (*RolloutResponse, error)
+	// GetRollout returns a rollout with its waves and per-switch progress.
+	GetRollout(context.Context, *RolloutRequest) (*RolloutResponse, error)
+	// ListRollouts returns all rollouts, newest first.
+	ListRollouts(context.Context, *emptypb.Empty) (*ListRolloutsResponse, error)
+	// PauseRollout stops a running rollout from advancing. Updates already queued keep running.
+	PauseRollout(context.Context, *RolloutRequest) (*RolloutResponse, error)
+	// ResumeRollout continues a paused rollout, re-checking (or accepting) a wave whose health gate failed.
+	ResumeRollout(context.Context, *ResumeRolloutRequest) (*RolloutResponse, error)
+	// AbortRollout cancels the running updates of a rollout and the waves not yet started.
+	AbortRollout(context.Context, *RolloutRequest) (*RolloutResponse, error)
 	// Power Control
 	// PowerControl performs a power action (e.g. PowerCycle, GracefulShutdown) on NV-Switch trays.
 	PowerControl(context.Context, *PowerControlRequest) (*PowerControlResponse, error)
+	// Credential Management
+	// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+	RotateCredentials(context.Context, *RotateCredentialsRequest) (*RotateCredentialsResponse, error)
+	// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches (all if none are specified).
+	GetCredentialRotationStatus(context.Context, *NVSwitchRequest) (*GetCredentialRotationStatusResponse, error)
+	// Events
+	// StreamEvents streams Redfish events (PSU faults, power state changes, task progress) reported by the BMCs of the specified switches (all if none are specified) until the client cancels.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[NVSwitchEvent]) error
 	mustEmbedUnimplementedNVSwitchManagerServer()
 }
 
@@ -218,6 +383,9 @@ func (UnimplementedNVSwitchManagerServer) RegisterNVSwitches(context.Context, *R
 func (UnimplementedNVSwitchManagerServer) GetNVSwitches(context.Context, *NVSwitchRequest) (*GetNVSwitchesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetNVSwitches not implemented")
 }
+func (UnimplementedNVSwitchManagerServer) RekeyNVOSHostKey(context.Context, *RekeyNVOSHostKeyRequest) (*RekeyNVOSHostKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RekeyNVOSHostKey not implemented")
+}
 func (UnimplementedNVSwitchManagerServer) ListBundles(context.Context, *emptypb.Empty) (*ListBundlesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListBundles not implemented")
 }
@@ -239,9 +407,36 @@ func (UnimplementedNVSwitchManagerServer) GetAllUpdates(context.Context, *emptyp
 func (UnimplementedNVSwitchManagerServer) CancelUpdate(context.Context, *CancelUpdateRequest) (*CancelUpdateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelUpdate not implemented")
 }
+func (UnimplementedNVSwitchManagerServer) CreateRollout(context.Context, *CreateRolloutRequest) (*RolloutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRollout not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) GetRollout(context.Context, *RolloutRequest) (*RolloutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRollout not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) ListRollouts(context.Context, *emptypb.Empty) (*ListRolloutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRollouts not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) PauseRollout(context.Context, *RolloutRequest) (*RolloutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseRollout not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) ResumeRollout(context.Context, *ResumeRolloutRequest) (*RolloutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeRollout not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) AbortRollout(context.Context, *RolloutRequest) (*RolloutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortRollout not implemented")
+}
 func (UnimplementedNVSwitchManagerServer) PowerControl(context.Context, *PowerControlRequest) (*PowerControlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PowerControl not implemented")
 }
+func (UnimplementedNVSwitchManagerServer) RotateCredentials(context.Context, *RotateCredentialsRequest) (*RotateCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateCredentials not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) GetCredentialRotationStatus(context.Context, *NVSwitchRequest) (*GetCredentialRotationStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCredentialRotationStatus not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[NVSwitchEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamEvents not implemented")
+}
 func (UnimplementedNVSwitchManagerServer) mustEmbedUnimplementedNVSwitchManagerServer() {}
 func (UnimplementedNVSwitchManagerServer) testEmbeddedByValue()                         {}
 
@@ -299,6 +494,24 @@ func _NVSwitchManager_GetNVSwitches_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NVSwitchManager_RekeyNVOSHostKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RekeyNVOSHostKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).RekeyNVOSHostKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_RekeyNVOSHostKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).RekeyNVOSHostKey(ctx, req.(*RekeyNVOSHostKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NVSwitchManager_ListBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -425,6 +638,114 @@ func _NVSwitchManager_CancelUpdate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NVSwitchManager_CreateRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).CreateRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_CreateRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).CreateRollout(ctx, req.(*CreateRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_GetRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).GetRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_GetRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).GetRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_ListRollouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).ListRollouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_ListRollouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).ListRollouts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_PauseRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).PauseRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_PauseRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).PauseRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_ResumeRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).ResumeRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_ResumeRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).ResumeRollout(ctx, req.(*ResumeRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).AbortRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_AbortRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).AbortRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NVSwitchManager_PowerControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PowerControlRequest)
 	if err := dec(in); err != nil {
@@ -443,6 +764,53 @@ func _NVSwitchManager_PowerControl_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NVSwitchManager_RotateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).RotateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_RotateCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).RotateCredentials(ctx, req.(*RotateCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_GetCredentialRotationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NVSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).GetCredentialRotationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_GetCredentialRotationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).GetCredentialRotationStatus(ctx, req.(*NVSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NVSwitchManagerServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, NVSwitchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NVSwitchManager_StreamEventsServer = grpc.ServerStreamingServer[NVSwitchEvent]
+
 // NVSwitchManager_ServiceDesc is the grpc.ServiceDesc for NVSwitchManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -458,6 +826,10 @@ var NVSwitchManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNVSwitches",
 			Handler:    _NVSwitchManager_GetNVSwitches_Handler,
 		},
+		{
+			MethodName: "RekeyNVOSHostKey",
+			Handler:    _NVSwitchManager_RekeyNVOSHostKey_Handler,
+		},
 		{
 			MethodName: "ListBundles",
 			Handler:    _NVSwitchManager_ListBundles_Handler,
@@ -486,11 +858,49 @@ var NVSwitchManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpdate",
 			Handler:    _NVSwitchManager_CancelUpdate_Handler,
 		},
+		{
+			MethodName: "CreateRollout",
+			Handler:    _NVSwitchManager_CreateRollout_Handler,
+		},
+		{
+			MethodName: "GetRollout",
+			Handler:    _NVSwitchManager_GetRollout_Handler,
+		},
+		{
+			MethodName: "ListRollouts",
+			Handler:    _NVSwitchManager_ListRollouts_Handler,
+		},
+		{
+			MethodName: "PauseRollout",
+			Handler:    _NVSwitchManager_PauseRollout_Handler,
+		},
+		{
+			MethodName: "ResumeRollout",
+			Handler:    _NVSwitchManager_ResumeRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _NVSwitchManager_AbortRollout_Handler,
+		},
 		{
 			MethodName: "PowerControl",
 			Handler:    _NVSwitchManager_PowerControl_Handler,
 		},
+		{
+			MethodName: "RotateCredentials",
+			Handler:    _NVSwitchManager_RotateCredentials_Handler,
+		},
+		{
+			MethodName: "GetCredentialRotationStatus",
+			Handler:    _NVSwitchManager_GetCredentialRotationStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _NVSwitchManager_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/proto/v1/nvswitch-manager.proto",
 }
@@ -23,11 +23,15 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentials"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/rollout"
 )
 
 // Config captures runtime settings for running the gRPC service.
@@ -37,6 +41,10 @@ type Config struct {
 	VaultConf     credentials.VaultConfig
 	DBConf        db.Config
 	FirmwareConf  FirmwareConfig
+
+	CredentialRotationConf credentialrotation.Config
+	EventConf              eventmanager.Config
+	RolloutConf            rollout.Config
 }
 
 // FirmwareConfig contains firmware manager configuration.
@@ -45,6 +53,8 @@ type FirmwareConfig struct {
 	FirmwareDir       string        // Directory containing firmware files
 	NumWorkers        int           // Number of concurrent update workers
 	SchedulerInterval time.Duration // How often the scheduler queries for pending updates
+	TrustBundle       string        // PEM file of the keys and CAs bundle signatures are verified against
+	AllowUnsigned     bool          // Allow updates from bundles without a signature
 }
 
 // ToFirmwareManagerConfig converts FirmwareConfig to firmwaremanager.Config.
@@ -54,6 +64,10 @@ func (c *FirmwareConfig) ToFirmwareManagerConfig() firmwaremanager.Config {
 		FirmwareDir:       c.FirmwareDir,
 		NumWorkers:        c.NumWorkers,
 		SchedulerInterval: c.SchedulerInterval,
+		Verification: fwverify.Config{
+			TrustBundle:   c.TrustBundle,
+			AllowUnsigned: c.AllowUnsigned,
+		},
 	}
 }
 
@@ -19,6 +19,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -26,17 +27,24 @@ import (
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/proto/v1"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/secretstring"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/vendor"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/converter/protobuf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentialrotation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/bmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvos"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/redfish"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/rollout"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/sshclient"
 
+	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -47,13 +55,19 @@ import (
 type NVSwitchManagerServerImpl struct {
 	nsm *nvswitchmanager.NVSwitchManager
 	fwm *firmwaremanager.FirmwareManager
+	crm *credentialrotation.Manager
+	evm *eventmanager.Manager
+	rom *rollout.Manager
 	pb.UnimplementedNVSwitchManagerServer
 }
 
-func newServerImplementation(nsm *nvswitchmanager.NVSwitchManager, fwm *firmwaremanager.FirmwareManager) (*NVSwitchManagerServerImpl, error) {
+func newServerImplementation(nsm *nvswitchmanager.NVSwitchManager, fwm *firmwaremanager.FirmwareManager, crm *credentialrotation.Manager, evm *eventmanager.Manager, rom *rollout.Manager) (*NVSwitchManagerServerImpl, error) {
 	return &NVSwitchManagerServerImpl{
 		nsm: nsm,
 		fwm: fwm,
+		crm: crm,
+		evm: evm,
+		rom: rom,
 	}, nil
 }
 
@@ -97,6 +111,15 @@ func (s *NVSwitchManagerServerImpl) registerNVSwitch(
 	var nvosCred *credential.Credential
 	if req.Nvos.Credentials != nil {
 		nvosCred = credential.New(req.Nvos.Credentials.Username, req.Nvos.Credentials.Password)
+		if req.Nvos.Credentials.SshPrivateKey != "" {
+			if _, err := ssh.ParsePrivateKey([]byte(req.Nvos.Credentials.SshPrivateKey)); err != nil {
+				return &pb.RegisterNVSwitchResponse{
+					Status: pb.StatusCode_INVALID_ARGUMENT,
+					Error:  "Invalid NVOS SSH private key: " + err.Error(),
+				}
+			}
+			nvosCred.PrivateKey = secretstring.New(req.Nvos.Credentials.SshPrivateKey)
+		}
 	}
 	nvosObj, err := nvos.New(req.Nvos.MacAddress, req.Nvos.IpAddress, nvosCred)
 	if err != nil {
@@ -187,6 +210,42 @@ func (s *NVSwitchManagerServerImpl) GetNVSwitches(ctx context.Context, req *pb.N
 	}, nil
 }
 
+// RekeyNVOSHostKey replaces the pinned NVOS SSH host key of a switch.
+func (s *NVSwitchManagerServerImpl) RekeyNVOSHostKey(ctx context.Context, req *pb.RekeyNVOSHostKeyRequest) (*pb.RekeyNVOSHostKeyResponse, error) {
+	id, err := protobuf.ParseUUID(req.Uuid)
+	if err != nil {
+		return &pb.RekeyNVOSHostKeyResponse{
+			Uuid:   req.Uuid,
+			Status: pb.StatusCode_INVALID_ARGUMENT,
+			Error:  "invalid UUID: " + err.Error(),
+		}, nil
+	}
+
+	if req.HostKey != "" {
+		if _, err := sshclient.ParseHostKey(req.HostKey); err != nil {
+			return &pb.RekeyNVOSHostKeyResponse{
+				Uuid:   req.Uuid,
+				Status: pb.StatusCode_INVALID_ARGUMENT,
+				Error:  "invalid host key: " + err.Error(),
+			}, nil
+		}
+	}
+
+	if err := s.nsm.RekeyNVOS(ctx, id, req.HostKey); err != nil {
+		return &pb.RekeyNVOSHostKeyResponse{
+			Uuid:   req.Uuid,
+			Status: pb.StatusCode_INTERNAL_ERROR,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.RekeyNVOSHostKeyResponse{
+		Uuid:               req.Uuid,
+		Status:             pb.StatusCode_SUCCESS,
+		HostKeyFingerprint: sshclient.HostKeyFingerprint(req.HostKey),
+	}, nil
+}
+
 // protoToRedfishAction maps a proto PowerAction to a Redfish ResetType.
 func protoToRedfishAction(action pb.PowerAction) (redfish.ResetType, error) {
 	switch action {
@@ -543,6 +602,276 @@ func (s *NVSwitchManagerServerImpl) CancelUpdate(ctx context.Context, req *pb.Ca
 	}, nil
 }
 
+// ============================================================================
+// Firmware Rollout API
+// ============================================================================
+
+// CreateRollout starts rolling a bundle out to switches in health-gated waves.
+func (s *NVSwitchManagerServerImpl) CreateRollout(ctx context.Context, req *pb.CreateRolloutRequest) (*pb.RolloutResponse, error) {
+	if s.rom == nil {
+		return nil, status.Error(codes.Unavailable, "rollout manager not initialized")
+	}
+
+	var components []nvswitch.Component
+	for _, c := range req.Components {
+		component := protoComponentToDomain(c)
+		if component == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid component: %v", c)
+		}
+		components = append(components, component)
+	}
+
+	targets := make([]uuid.UUID, 0, len(req.SwitchUuids))
+	for _, uuidStr := range req.SwitchUuids {
+		id, err := protobuf.ParseUUID(uuidStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid switch UUID %q: %v", uuidStr, err)
+		}
+		targets = append(targets, id)
+	}
+
+	policy, err := protoRolloutPolicyToDomain(req.Policy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := s.rom.Create(ctx, req.BundleVersion, components, targets, policy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create rollout: %v", err)
+	}
+
+	return &pb.RolloutResponse{Rollout: rolloutToProto(r)}, nil
+}
+
+// GetRollout returns a rollout with its waves and per-switch progress.
+func (s *NVSwitchManagerServerImpl) GetRollout(ctx context.Context, req *pb.RolloutRequest) (*pb.RolloutResponse, error) {
+	return s.rolloutAction(ctx, req.RolloutId, s.rom.Get)
+}
+
+// ListRollouts returns all rollouts, newest first.
+func (s *NVSwitchManagerServerImpl) ListRollouts(ctx context.Context, _ *emptypb.Empty) (*pb.ListRolloutsResponse, error) {
+	if s.rom == nil {
+		return nil, status.Error(codes.Unavailable, "rollout manager not initialized")
+	}
+
+	rollouts, err := s.rom.List(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rollouts: %v", err)
+	}
+
+	protoRollouts := make([]*pb.Rollout, len(rollouts))
+	for i, r := range rollouts {
+		protoRollouts[i] = rolloutToProto(r)
+	}
+
+	return &pb.ListRolloutsResponse{Rollouts: protoRollouts}, nil
+}
+
+// PauseRollout stops a running rollout from advancing.
+func (s *NVSwitchManagerServerImpl) PauseRollout(ctx context.Context, req *pb.RolloutRequest) (*pb.RolloutResponse, error) {
+	return s.rolloutAction(ctx, req.RolloutId, s.rom.Pause)
+}
+
+// ResumeRollout continues a paused rollout.
+func (s *NVSwitchManagerServerImpl) ResumeRollout(ctx context.Context, req *pb.ResumeRolloutRequest) (*pb.RolloutResponse, error) {
+	return s.rolloutAction(ctx, req.RolloutId, func(ctx context.Context, id uuid.UUID) (*rollout.Rollout, error) {
+		return s.rom.Resume(ctx, id, req.AcceptWave)
+	})
+}
+
+// AbortRollout cancels the running updates of a rollout and the waves not yet started.
+func (s *NVSwitchManagerServerImpl) AbortRollout(ctx context.Context, req *pb.RolloutRequest) (*pb.RolloutResponse, error) {
+	return s.rolloutAction(ctx, req.RolloutId, s.rom.Abort)
+}
+
+// rolloutAction parses the rollout ID, applies action and maps its error to a gRPC status.
+func (s *NVSwitchManagerServerImpl) rolloutAction(ctx context.Context, idStr string, action func(context.Context, uuid.UUID) (*rollout.Rollout, error)) (*pb.RolloutResponse, error) {
+	if s.rom == nil {
+		return nil, status.Error(codes.Unavailable, "rollout manager not initialized")
+	}
+
+	id, err := protobuf.ParseUUID(idStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rollout ID: %v", err)
+	}
+
+	r, err := action(ctx, id)
+	switch {
+	case errors.Is(err, rollout.ErrNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, rollout.ErrInvalidState):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RolloutResponse{Rollout: rolloutToProto(r)}, nil
+}
+
+// ============================================================================
+// Credential Management API
+// ============================================================================
+
+// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+func (s *NVSwitchManagerServerImpl) RotateCredentials(ctx context.Context, req *pb.RotateCredentialsRequest) (*pb.RotateCredentialsResponse, error) {
+	if s.crm == nil {
+		return nil, status.Error(codes.Unavailable, "credential rotation not initialized")
+	}
+
+	components := credentialrotation.Components
+	if len(req.Components) > 0 {
+		components = make([]nvswitch.Component, 0, len(req.Components))
+		for _, c := range req.Components {
+			component := protoComponentToDomain(c)
+			if component != nvswitch.BMC && component != nvswitch.NVOS {
+				return nil, status.Errorf(codes.InvalidArgument, "credential rotation is not supported for component %v", c)
+			}
+			components = append(components, component)
+		}
+	}
+
+	var results []*pb.RotateCredentialsResult
+	for _, uuidStr := range req.Uuids {
+		for _, component := range components {
+			results = append(results, s.rotateCredential(ctx, uuidStr, component))
+		}
+	}
+
+	return &pb.RotateCredentialsResponse{
+		Results: results,
+	}, nil
+}
+
+// rotateCredential starts rotating the password of a single switch account.
+func (s *NVSwitchManagerServerImpl) rotateCredential(ctx context.Context, uuidStr string, component nvswitch.Component) *pb.RotateCredentialsResult {
+	result := &pb.RotateCredentialsResult{
+		Uuid:      uuidStr,
+		Component: domainComponentToProto(component),
+	}
+
+	id, err := protobuf.ParseUUID(uuidStr)
+	if err != nil {
+		result.Status = pb.StatusCode_INVALID_ARGUMENT
+		result.Error = "invalid UUID: " + err.Error()
+		return result
+	}
+
+	if err := s.crm.Trigger(ctx, id, component); err != nil {
+		result.Status = pb.StatusCode_INTERNAL_ERROR
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = pb.StatusCode_SUCCESS
+	return result
+}
+
+// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches, or of
+// all registered switches if none are specified.
+func (s *NVSwitchManagerServerImpl) GetCredentialRotationStatus(ctx context.Context, req *pb.NVSwitchRequest) (*pb.GetCredentialRotationStatusResponse, error) {
+	if s.crm == nil {
+		return nil, status.Error(codes.Unavailable, "credential rotation not initialized")
+	}
+
+	uuids := req.Uuids
+	if len(uuids) == 0 {
+		trays, err := s.nsm.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tray := range trays {
+			uuids = append(uuids, tray.UUID.String())
+		}
+	}
+
+	var statuses []*pb.CredentialRotationStatus
+	for _, uuidStr := range uuids {
+		for _, component := range credentialrotation.Components {
+			statuses = append(statuses, s.getCredentialRotationStatus(ctx, uuidStr, component))
+		}
+	}
+
+	return &pb.GetCredentialRotationStatusResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// getCredentialRotationStatus returns the latest credential rotation of a single switch account.
+func (s *NVSwitchManagerServerImpl) getCredentialRotationStatus(ctx context.Context, uuidStr string, component nvswitch.Component) *pb.CredentialRotationStatus {
+	id, err := protobuf.ParseUUID(uuidStr)
+	if err != nil {
+		return &pb.CredentialRotationStatus{
+			Uuid:      uuidStr,
+			Component: domainComponentToProto(component),
+			Status:    pb.StatusCode_INVALID_ARGUMENT,
+			Error:     "invalid UUID: " + err.Error(),
+		}
+	}
+
+	rotation, err := s.crm.Status(ctx, id, component)
+	if err != nil && !errors.Is(err, credentialrotation.ErrNotFound) {
+		return &pb.CredentialRotationStatus{
+			Uuid:      uuidStr,
+			Component: domainComponentToProto(component),
+			Status:    pb.StatusCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}
+	}
+
+	resp := credentialRotationStatusToProto(id, component, rotation)
+	if next := s.crm.NextRotation(component, rotation); !next.IsZero() {
+		resp.NextRotation = timestamppb.New(next)
+	}
+	return resp
+}
+
+// ============================================================================
+// Events API
+// ============================================================================
+
+// StreamEvents streams the Redfish events reported by the BMCs of the specified switches, or of all switches if
+// none are specified, until the client cancels or the service stops.
+func (s *NVSwitchManagerServerImpl) StreamEvents(req *pb.StreamEventsRequest, stream pb.NVSwitchManager_StreamEventsServer) error {
+	if s.evm == nil {
+		return status.Error(codes.Unavailable, "event manager not initialized")
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.Uuids))
+	for _, uuidStr := range req.Uuids {
+		id, err := protobuf.ParseUUID(uuidStr)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid UUID %q: %v", uuidStr, err)
+		}
+		ids = append(ids, id)
+	}
+
+	kinds := make([]events.Kind, 0, len(req.Kinds))
+	for _, k := range req.Kinds {
+		kind, ok := protoEventKindToDomain(k)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid event kind %v", k)
+		}
+		kinds = append(kinds, kind)
+	}
+
+	sub := s.evm.Subscribe(ids, kinds)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "event manager stopped")
+			}
+			if err := stream.Send(eventToProto(ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Helper functions for proto conversion
 
 func protoComponentToDomain(c pb.NVSwitchComponent) nvswitch.Component {
@@ -575,6 +904,91 @@ func domainComponentToProto(c nvswitch.Component) pb.NVSwitchComponent {
 	}
 }
 
+func credentialRotationStateToProto(s credentialrotation.State) pb.CredentialRotationState {
+	switch s {
+	case credentialrotation.StateInProgress:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS
+	case credentialrotation.StateSucceeded:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED
+	case credentialrotation.StateFailed:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED
+	case credentialrotation.StateRollbackFailed:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED
+	default:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN
+	}
+}
+
+// credentialRotationStatusToProto converts the latest rotation of a switch account; a nil rotation means the
+// credential was never rotated.
+func credentialRotationStatusToProto(id uuid.UUID, component nvswitch.Component, rotation *credentialrotation.Status) *pb.CredentialRotationStatus {
+	info := &pb.CredentialRotationStatus{
+		Uuid:      id.String(),
+		Component: domainComponentToProto(component),
+		State:     pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED,
+		Status:    pb.StatusCode_SUCCESS,
+	}
+	if rotation == nil {
+		return info
+	}
+
+	info.State = credentialRotationStateToProto(rotation.State)
+	info.RotationError = rotation.ErrorMessage
+	if !rotation.LastAttemptAt.IsZero() {
+		info.LastAttempt = timestamppb.New(rotation.LastAttemptAt)
+	}
+	if !rotation.LastRotatedAt.IsZero() {
+		info.LastRotated = timestamppb.New(rotation.LastRotatedAt)
+	}
+
+	return info
+}
+
+func protoEventKindToDomain(k pb.NVSwitchEventKind) (events.Kind, bool) {
+	switch k {
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_PSU_FAULT:
+		return events.KindPSUFault, true
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_POWER_STATE:
+		return events.KindPowerState, true
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK:
+		return events.KindTask, true
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_OTHER:
+		return events.KindOther, true
+	default:
+		return "", false
+	}
+}
+
+func domainEventKindToProto(k events.Kind) pb.NVSwitchEventKind {
+	switch k {
+	case events.KindPSUFault:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_PSU_FAULT
+	case events.KindPowerState:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_POWER_STATE
+	case events.KindTask:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK
+	case events.KindOther:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_OTHER
+	default:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN
+	}
+}
+
+func eventToProto(ev eventmanager.Event) *pb.NVSwitchEvent {
+	info := &pb.NVSwitchEvent{
+		Uuid:      ev.SwitchUUID.String(),
+		Kind:      domainEventKindToProto(ev.Kind),
+		Severity:  ev.Severity,
+		MessageId: ev.MessageID,
+		Message:   ev.Message,
+		Origin:    ev.Origin,
+	}
+	if !ev.Timestamp.IsZero() {
+		info.Timestamp = timestamppb.New(ev.Timestamp)
+	}
+	return info
+}
+
 func domainStrategyToProto(s firmwaremanager.Strategy) pb.UpdateStrategy {
 	switch s {
 	case firmwaremanager.StrategyScript:
@@ -631,6 +1045,7 @@ func firmwareUpdateToProto(update *firmwaremanager.FirmwareUpdate) *pb.FirmwareU
 		VersionTo:     update.VersionTo,
 		VersionActual: update.VersionActual,
 		ErrorMessage:  update.ErrorMessage,
+		SignedBy:      update.SignedBy,
 		CreatedAt:     timestamppb.New(update.CreatedAt),
 		UpdatedAt:     timestamppb.New(update.UpdatedAt),
 		SequenceOrder: int32(update.SequenceOrder),
@@ -646,3 +1061,148 @@ func firmwareUpdateToProto(update *firmwaremanager.FirmwareUpdate) *pb.FirmwareU
 
 	return info
 }
+
+func protoRolloutPolicyToDomain(p *pb.RolloutPolicy) (rollout.Policy, error) {
+	if p == nil {
+		return rollout.Policy{}, nil
+	}
+
+	policy := rollout.Policy{
+		CanarySize:            int(p.CanarySize),
+		SoakTime:              time.Duration(p.SoakSeconds) * time.Second,
+		MaxFailureRate:        p.MaxFailureRate,
+		SkipReachabilityCheck: p.SkipReachabilityCheck,
+		SkipNVOSVersionCheck:  p.SkipNvosVersionCheck,
+	}
+	for _, pct := range p.WavePercents {
+		policy.WavePercents = append(policy.WavePercents, int(pct))
+	}
+
+	switch p.OnGateFailure {
+	case pb.RolloutGateAction_ROLLOUT_GATE_ACTION_UNKNOWN:
+	case pb.RolloutGateAction_ROLLOUT_GATE_ACTION_PAUSE:
+		policy.OnGateFailure = rollout.GateActionPause
+	case pb.RolloutGateAction_ROLLOUT_GATE_ACTION_ABORT:
+		policy.OnGateFailure = rollout.GateActionAbort
+	default:
+		return rollout.Policy{}, fmt.Errorf("invalid gate failure action: %v", p.OnGateFailure)
+	}
+
+	return policy, nil
+}
+
+func rolloutPolicyToProto(p rollout.Policy) *pb.RolloutPolicy {
+	info := &pb.RolloutPolicy{
+		CanarySize:            int32(p.CanarySize),
+		SoakSeconds:           int64(p.SoakTime / time.Second),
+		MaxFailureRate:        p.MaxFailureRate,
+		SkipReachabilityCheck: p.SkipReachabilityCheck,
+		SkipNvosVersionCheck:  p.SkipNVOSVersionCheck,
+		OnGateFailure:         pb.RolloutGateAction_ROLLOUT_GATE_ACTION_PAUSE,
+	}
+	for _, pct := range p.WavePercents {
+		info.WavePercents = append(info.WavePercents, int32(pct))
+	}
+	if p.OnGateFailure == rollout.GateActionAbort {
+		info.OnGateFailure = pb.RolloutGateAction_ROLLOUT_GATE_ACTION_ABORT
+	}
+	return info
+}
+
+func rolloutStateToProto(s rollout.State) pb.RolloutState {
+	switch s {
+	case rollout.StateRunning:
+		return pb.RolloutState_ROLLOUT_STATE_RUNNING
+	case rollout.StatePaused:
+		return pb.RolloutState_ROLLOUT_STATE_PAUSED
+	case rollout.StateCompleted:
+		return pb.RolloutState_ROLLOUT_STATE_COMPLETED
+	case rollout.StateAborted:
+		return pb.RolloutState_ROLLOUT_STATE_ABORTED
+	default:
+		return pb.RolloutState_ROLLOUT_STATE_UNKNOWN
+	}
+}
+
+func rolloutWaveStateToProto(s rollout.WaveState) pb.RolloutWaveState {
+	switch s {
+	case rollout.WavePending:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_PENDING
+	case rollout.WaveUpdating:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_UPDATING
+	case rollout.WaveSoaking:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_SOAKING
+	case rollout.WaveSucceeded:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_SUCCEEDED
+	case rollout.WaveFailed:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_FAILED
+	case rollout.WaveCancelled:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_CANCELLED
+	default:
+		return pb.RolloutWaveState_ROLLOUT_WAVE_STATE_UNKNOWN
+	}
+}
+
+func rolloutTargetStateToProto(s rollout.TargetState) pb.RolloutTargetState {
+	switch s {
+	case rollout.TargetPending:
+		return pb.RolloutTargetState_ROLLOUT_TARGET_STATE_PENDING
+	case rollout.TargetUpdating:
+		return pb.RolloutTargetState_ROLLOUT_TARGET_STATE_UPDATING
+	case rollout.TargetSucceeded:
+		return pb.RolloutTargetState_ROLLOUT_TARGET_STATE_SUCCEEDED
+	case rollout.TargetFailed:
+		return pb.RolloutTargetState_ROLLOUT_TARGET_STATE_FAILED
+	default:
+		return pb.RolloutTargetState_ROLLOUT_TARGET_STATE_UNKNOWN
+	}
+}
+
+func rolloutToProto(r *rollout.Rollout) *pb.Rollout {
+	info := &pb.Rollout{
+		Id:            r.ID.String(),
+		BundleVersion: r.BundleVersion,
+		Policy:        rolloutPolicyToProto(r.Policy),
+		State:         rolloutStateToProto(r.State),
+		CurrentWave:   int32(r.CurrentWave),
+		Message:       r.Message,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+	for _, c := range r.Components {
+		info.Components = append(info.Components, domainComponentToProto(c))
+	}
+
+	for _, w := range r.Waves {
+		wave := &pb.RolloutWave{
+			Index:   int32(w.Index),
+			State:   rolloutWaveStateToProto(w.State),
+			Message: w.Message,
+		}
+		if w.StartedAt != nil {
+			wave.StartedAt = timestamppb.New(*w.StartedAt)
+		}
+		if w.SoakUntil != nil {
+			wave.SoakUntil = timestamppb.New(*w.SoakUntil)
+		}
+		if w.CompletedAt != nil {
+			wave.CompletedAt = timestamppb.New(*w.CompletedAt)
+		}
+
+		for _, t := range w.Targets {
+			target := &pb.RolloutTarget{
+				SwitchUuid: t.SwitchUUID.String(),
+				State:      rolloutTargetStateToProto(t.State),
+				Error:      t.Error,
+			}
+			for _, id := range t.UpdateIDs {
+				target.UpdateIds = append(target.UpdateIds, id.String())
+			}
+			wave.Targets = append(wave.Targets, target)
+		}
+
+		info.Waves = append(info.Waves, wave)
+	}
+
+	return info
+}
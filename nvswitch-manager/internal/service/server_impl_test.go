@@ -20,11 +20,21 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/proto/v1"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentialrotation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/redfish"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestResetTarget_InvalidIP(t *testing.T) {
@@ -95,3 +105,128 @@ func TestResetTarget_EmptyCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCredentialRotationStatus(t *testing.T) {
+	id := uuid.New()
+	rotated := time.Now().Add(-time.Hour)
+	store := credentialrotation.NewInMemoryStore()
+	require.NoError(t, store.Put(context.Background(), &credentialrotation.Status{
+		SwitchUUID:    id,
+		Component:     nvswitch.BMC,
+		State:         credentialrotation.StateSucceeded,
+		LastAttemptAt: rotated,
+		LastRotatedAt: rotated,
+	}))
+
+	s := &NVSwitchManagerServerImpl{
+		crm: credentialrotation.New(nil, store, credentialrotation.Config{BMCInterval: 24 * time.Hour}),
+	}
+
+	resp, err := s.GetCredentialRotationStatus(context.Background(), &pb.NVSwitchRequest{Uuids: []string{id.String(), "not-a-uuid"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Statuses, 4)
+
+	bmcStatus := resp.Statuses[0]
+	assert.Equal(t, pb.NVSwitchComponent_NVSWITCH_COMPONENT_BMC, bmcStatus.Component)
+	assert.Equal(t, pb.StatusCode_SUCCESS, bmcStatus.Status)
+	assert.Equal(t, pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED, bmcStatus.State)
+	assert.Equal(t, rotated.Add(24*time.Hour).Unix(), bmcStatus.NextRotation.AsTime().Unix())
+
+	nvosStatus := resp.Statuses[1]
+	assert.Equal(t, pb.NVSwitchComponent_NVSWITCH_COMPONENT_NVOS, nvosStatus.Component)
+	assert.Equal(t, pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED, nvosStatus.State)
+	assert.Nil(t, nvosStatus.NextRotation, "NVOS rotation is not scheduled")
+
+	for _, invalid := range resp.Statuses[2:] {
+		assert.Equal(t, pb.StatusCode_INVALID_ARGUMENT, invalid.Status)
+	}
+}
+
+func TestRotateCredentials_InvalidArgument(t *testing.T) {
+	s := &NVSwitchManagerServerImpl{
+		crm: credentialrotation.New(nil, credentialrotation.NewInMemoryStore(), credentialrotation.Config{}),
+	}
+
+	_, err := s.RotateCredentials(context.Background(), &pb.RotateCredentialsRequest{
+		Uuids:      []string{uuid.NewString()},
+		Components: []pb.NVSwitchComponent{pb.NVSwitchComponent_NVSWITCH_COMPONENT_CPLD},
+	})
+	assert.Error(t, err)
+
+	resp, err := s.RotateCredentials(context.Background(), &pb.RotateCredentialsRequest{
+		Uuids:      []string{"not-a-uuid"},
+		Components: []pb.NVSwitchComponent{pb.NVSwitchComponent_NVSWITCH_COMPONENT_NVOS},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, pb.StatusCode_INVALID_ARGUMENT, resp.Results[0].Status)
+	assert.Equal(t, pb.NVSwitchComponent_NVSWITCH_COMPONENT_NVOS, resp.Results[0].Component)
+}
+
+// fakeEventStream records the events sent by StreamEvents.
+type fakeEventStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.NVSwitchEvent
+}
+
+func (f *fakeEventStream) Context() context.Context { return f.ctx }
+
+func (f *fakeEventStream) Send(ev *pb.NVSwitchEvent) error {
+	f.sent = append(f.sent, ev)
+	return nil
+}
+
+func TestStreamEvents(t *testing.T) {
+	evm := eventmanager.New(nil, eventmanager.Config{})
+	s := &NVSwitchManagerServerImpl{evm: evm}
+
+	err := s.StreamEvents(&pb.StreamEventsRequest{Uuids: []string{"not-a-uuid"}}, &fakeEventStream{ctx: context.Background()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = s.StreamEvents(&pb.StreamEventsRequest{
+		Kinds: []pb.NVSwitchEventKind{pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN},
+	}, &fakeEventStream{ctx: context.Background()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = s.StreamEvents(&pb.StreamEventsRequest{Uuids: []string{uuid.NewString()}}, &fakeEventStream{ctx: ctx})
+	assert.NoError(t, err, "client cancellation ends the stream cleanly")
+
+	// Stopping the event manager closes all subscriptions.
+	require.NoError(t, evm.Stop(context.Background()))
+	err = s.StreamEvents(&pb.StreamEventsRequest{}, &fakeEventStream{ctx: context.Background()})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	err = (&NVSwitchManagerServerImpl{}).StreamEvents(&pb.StreamEventsRequest{}, &fakeEventStream{ctx: context.Background()})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestEventToProto(t *testing.T) {
+	id := uuid.New()
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	info := eventToProto(eventmanager.Event{
+		SwitchUUID: id,
+		Kind:       events.KindTask,
+		Severity:   "OK",
+		MessageID:  "TaskEvent.1.0.TaskCompletedOK",
+		Message:    "The task with Id '1' has completed.",
+		Origin:     "/redfish/v1/TaskService/Tasks/1",
+		Timestamp:  at,
+	})
+
+	assert.Equal(t, id.String(), info.Uuid)
+	assert.Equal(t, pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK, info.Kind)
+	assert.Equal(t, "TaskEvent.1.0.TaskCompletedOK", info.MessageId)
+	assert.Equal(t, "/redfish/v1/TaskService/Tasks/1", info.Origin)
+	assert.True(t, info.Timestamp.AsTime().Equal(at))
+	assert.Nil(t, eventToProto(eventmanager.Event{}).Timestamp)
+
+	for _, kind := range []events.Kind{events.KindPSUFault, events.KindPowerState, events.KindTask, events.KindOther} {
+		got, ok := protoEventKindToDomain(domainEventKindToProto(kind))
+		assert.True(t, ok)
+		assert.Equal(t, kind, got)
+	}
+}
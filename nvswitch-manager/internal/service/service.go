@@ -26,10 +26,13 @@ import (
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/certs"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/proto/v1"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/db/migrations"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/db/postgres"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/rollout"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -44,6 +47,9 @@ type Service struct {
 	db         *bun.DB
 	nsm        *nvswitchmanager.NVSwitchManager
 	fwm        *firmwaremanager.FirmwareManager
+	crm        *credentialrotation.Manager
+	evm        *eventmanager.Manager
+	rom        *rollout.Manager
 }
 
 // New initializes an NVSwitchManager and constructs a Service from the Config.
@@ -96,6 +102,11 @@ func (s *Service) Start(ctx context.Context) error {
 		return err
 	}
 
+	s.evm = eventmanager.New(s.nsm, s.conf.EventConf)
+	if err := s.evm.Start(ctx); err != nil {
+		return err
+	}
+
 	// Initialize FirmwareManager if firmware config is present
 	if s.conf.FirmwareConf.PackagesDir != "" {
 		fwmConfig := s.conf.FirmwareConf.ToFirmwareManagerConfig()
@@ -115,6 +126,7 @@ func (s *Service) Start(ctx context.Context) error {
 			log.Warnf("Failed to initialize FirmwareManager: %v", err)
 		} else {
 			s.fwm = fwm
+			s.fwm.SetTaskEventSource(s.evm)
 			if err := s.fwm.Start(ctx); err != nil {
 				log.Warnf("Failed to start FirmwareManager: %v", err)
 				s.fwm = nil
@@ -124,12 +136,42 @@ func (s *Service) Start(ctx context.Context) error {
 		}
 	}
 
+	// Rollouts drive the firmware manager, so they are only available with it
+	if s.fwm != nil {
+		var rolloutStore rollout.Store
+		if s.conf.DataStoreType == nvswitchmanager.DatastoreTypePersistent && s.db != nil {
+			rolloutStore = rollout.NewPostgresStore(s.db)
+		} else {
+			rolloutStore = rollout.NewInMemoryStore()
+			log.Info("Firmware rollouts using in-memory store (rollouts will not persist across restarts)")
+		}
+
+		s.rom = rollout.New(s.fwm, rollout.NewHealthChecker(s.nsm, s.fwm), rolloutStore, s.conf.RolloutConf)
+		if err := s.rom.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Use PostgreSQL store if in persistent mode with database, otherwise use in-memory store
+	var rotationStore credentialrotation.Store
+	if s.conf.DataStoreType == nvswitchmanager.DatastoreTypePersistent && s.db != nil {
+		rotationStore = credentialrotation.NewPostgresStore(s.db)
+	} else {
+		rotationStore = credentialrotation.NewInMemoryStore()
+		log.Info("Credential rotation using in-memory store (rotation status will not persist across restarts)")
+	}
+
+	s.crm = credentialrotation.New(s.nsm, rotationStore, s.conf.CredentialRotationConf)
+	if err := s.crm.Start(ctx); err != nil {
+		return err
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", s.conf.Port))
 	if err != nil {
 		return err
 	}
 
-	serverImpl, err := newServerImplementation(s.nsm, s.fwm)
+	serverImpl, err := newServerImplementation(s.nsm, s.fwm, s.crm, s.evm, s.rom)
 	if err != nil {
 		return err
 	}
@@ -158,13 +200,29 @@ func (s *Service) Start(ctx context.Context) error {
 func (s *Service) Stop(ctx context.Context) {
 	log.Printf("Starting graceful shutdown now...")
 
+	// Stop the event manager first so that event streams end and GracefulStop does not wait for them
+	if s.evm != nil {
+		if err := s.evm.Stop(ctx); err != nil {
+			log.Warnf("Failed to stop event manager: %v", err)
+		}
+	}
+
 	s.grpcServer.GracefulStop()
 
+	// Stop rollouts before the firmware manager they queue updates with
+	if s.rom != nil {
+		s.rom.Stop()
+	}
+
 	// Stop FirmwareManager first (waits for active jobs to complete)
 	if s.fwm != nil {
 		s.fwm.Stop()
 	}
 
+	if s.crm != nil {
+		s.crm.Stop()
+	}
+
 	s.nsm.Stop(ctx)
 }
 
@@ -26,8 +26,9 @@ import (
 
 // Credential holds authentication information with password protection
 type Credential struct {
-	User     string                    `json:"user"`     // User name
-	Password secretstring.SecretString `json:"password"` // Password (masked in JSON/logs)
+	User       string                    `json:"user"`                  // User name
+	Password   secretstring.SecretString `json:"password"`              // Password (masked in JSON/logs)
+	PrivateKey secretstring.SecretString `json:"private_key,omitempty"` // PEM-encoded SSH private key (masked in JSON/logs)
 }
 
 // New creates a Credential with the given user and password.
@@ -65,6 +66,11 @@ func (cred *Credential) Patch(nc *Credential) bool {
 		patched = true
 	}
 
+	if !nc.PrivateKey.IsEmpty() && !cred.PrivateKey.IsEqual(nc.PrivateKey) {
+		cred.PrivateKey = nc.PrivateKey
+		patched = true
+	}
+
 	return patched
 }
 
@@ -97,19 +103,34 @@ func (cred *Credential) Retrieve() (*string, *string) {
 	return &c.User, &c.Password.Value
 }
 
+// HasPrivateKey returns true if the credential carries an SSH private key.
+func (cred *Credential) HasPrivateKey() bool {
+	return !cred.PrivateKey.IsEmpty()
+}
+
 // ToMap converts a Credential to a map[string]interface{} suitable for Vault storage.
-// Uses Forge's credential format with a UsernamePassword wrapper.
+// Uses Forge's credential format with a UsernamePassword wrapper. An SSH
+// private key, if set, is stored alongside under an SSHPrivateKey field.
 func (c Credential) ToMap() map[string]interface{} {
-	return map[string]interface{}{
+	m := map[string]interface{}{
 		"UsernamePassword": map[string]interface{}{
 			"username": c.User,
 			"password": c.Password.Value,
 		},
 	}
+
+	if c.HasPrivateKey() {
+		m["SSHPrivateKey"] = map[string]interface{}{
+			"private_key": c.PrivateKey.Value,
+		}
+	}
+
+	return m
 }
 
 // FromMap converts a map[string]interface{} from Vault storage to a Credential.
-// Expects Forge's credential format: {"UsernamePassword": {"username": ..., "password": ...}}
+// Expects Forge's credential format: {"UsernamePassword": {"username": ..., "password": ...}},
+// optionally with {"SSHPrivateKey": {"private_key": ...}}.
 func FromMap(data map[string]interface{}) (*Credential, error) {
 	nested, ok := data["UsernamePassword"].(map[string]interface{})
 	if !ok {
@@ -126,5 +147,21 @@ func FromMap(data map[string]interface{}) (*Credential, error) {
 		return nil, errors.New("invalid password value")
 	}
 
-	return New(user, password), nil
+	cred := New(user, password)
+
+	if raw, exists := data["SSHPrivateKey"]; exists {
+		keyData, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("invalid SSHPrivateKey field")
+		}
+
+		key, ok := keyData["private_key"].(string)
+		if !ok {
+			return nil, errors.New("invalid private_key value")
+		}
+
+		cred.PrivateKey = secretstring.New(key)
+	}
+
+	return cred, nil
 }
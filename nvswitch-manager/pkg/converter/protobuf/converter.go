@@ -21,6 +21,7 @@ import (
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/internal/proto/v1"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/vendor"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/sshclient"
 
 	"github.com/google/uuid"
 )
@@ -99,6 +100,9 @@ func NVSwitchTrayTo(tray *nvswitch.NVSwitchTray) *pb.NVSwitchTray {
 			Port:       int32(tray.NVOS.GetPort()),
 			Version:    tray.NVOSVersion,
 		}
+		if tray.NVOS.HasHostKey() {
+			pbTray.Nvos.HostKeyFingerprint = sshclient.HostKeyFingerprint(tray.NVOS.GetHostKey())
+		}
 	}
 
 	// Chassis info
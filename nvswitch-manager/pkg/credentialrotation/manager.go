@@ -0,0 +1,418 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package credentialrotation rotates the BMC and NVOS passwords of NV-Switch trays. A rotation generates a new
+// password, sets it on the device through the Redfish AccountService (BMC) or the NVOS CLI (NVOS), verifies a
+// login with it and only then commits it to the credential manager. Any failure after the password was changed
+// restores the previous password on the device.
+package credentialrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	commoncredential "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/secretstring"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultCheckInterval is how often the scheduler looks for accounts due for rotation.
+	DefaultCheckInterval = time.Hour
+
+	// rotationTimeout bounds a single rotation, including a rollback.
+	rotationTimeout = 5 * time.Minute
+)
+
+// ErrInProgress is returned by Trigger when a rotation of the account is already running.
+var ErrInProgress = errors.New("credential rotation already in progress")
+
+// Components lists the switch accounts whose credentials are rotated.
+var Components = []nvswitch.Component{nvswitch.BMC, nvswitch.NVOS}
+
+// SwitchClient is the subset of the NV-Switch manager needed to rotate credentials. Component is
+// nvswitch.BMC or nvswitch.NVOS.
+type SwitchClient interface {
+	Get(ctx context.Context, id uuid.UUID) (*nvswitch.NVSwitchTray, error)
+	List(ctx context.Context) ([]*nvswitch.NVSwitchTray, error)
+	// ChangePassword logs in to the component with cred and sets the password of cred's user.
+	ChangePassword(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, cred *credential.Credential, newPassword string) error
+	// VerifyLogin logs in to the component with the user and password of cred.
+	VerifyLogin(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, cred *credential.Credential) error
+	// StoreCredential replaces the stored credential of the component.
+	StoreCredential(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, cred *credential.Credential) error
+}
+
+// Config specifies the rotation schedule of switch credentials per component.
+type Config struct {
+	// BMCInterval is the maximum age of a BMC password before the scheduler rotates it. Zero disables
+	// scheduled BMC rotation; Trigger still rotates on demand.
+	BMCInterval time.Duration
+	// NVOSInterval is the maximum age of an NVOS password before the scheduler rotates it. Zero disables
+	// scheduled NVOS rotation; Trigger still rotates on demand.
+	NVOSInterval time.Duration
+	// CheckInterval is how often the scheduler looks for accounts due for rotation (DefaultCheckInterval if zero).
+	CheckInterval time.Duration
+	// PasswordLength is the length of generated passwords (DefaultPasswordLength of common/pkg/credential if zero).
+	PasswordLength int
+}
+
+// Interval returns the rotation interval of a component, zero if scheduled rotation is disabled.
+func (c Config) Interval(component nvswitch.Component) time.Duration {
+	switch component {
+	case nvswitch.BMC:
+		return c.BMCInterval
+	case nvswitch.NVOS:
+		return c.NVOSInterval
+	default:
+		return 0
+	}
+}
+
+// Manager rotates switch credentials on demand and on a schedule and records the outcome in a Store.
+type Manager struct {
+	switches SwitchClient
+	store    Store
+	conf     Config
+	now      func() time.Time
+
+	mu       sync.Mutex
+	inflight map[string]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Manager rotating the credentials of the switches known to the given client.
+func New(switches SwitchClient, store Store, conf Config) *Manager {
+	if conf.CheckInterval <= 0 {
+		conf.CheckInterval = DefaultCheckInterval
+	}
+	if conf.PasswordLength <= 0 {
+		conf.PasswordLength = commoncredential.DefaultPasswordLength
+	}
+
+	return &Manager{
+		switches: switches,
+		store:    store,
+		conf:     conf,
+		now:      time.Now,
+		inflight: make(map[string]struct{}),
+	}
+}
+
+// Start marks rotations interrupted by a restart as failed and, if an interval is configured for any
+// component, starts the scheduler.
+func (m *Manager) Start(ctx context.Context) error {
+	if err := m.failInterrupted(ctx); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	if m.conf.BMCInterval > 0 || m.conf.NVOSInterval > 0 {
+		log.Infof("Starting credential rotation scheduler (BMC interval: %s, NVOS interval: %s, check interval: %s)",
+			m.conf.BMCInterval, m.conf.NVOSInterval, m.conf.CheckInterval)
+		m.wg.Add(1)
+		go m.schedule(runCtx)
+	}
+
+	return nil
+}
+
+// Stop stops the scheduler and waits for running rotations.
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+}
+
+// Trigger starts rotating the credential of a component of a registered switch in the background. The outcome
+// is reported by Status.
+func (m *Manager) Trigger(ctx context.Context, id uuid.UUID, component nvswitch.Component) error {
+	if err := validateComponent(component); err != nil {
+		return err
+	}
+	if _, err := m.switches.Get(ctx, id); err != nil {
+		return err
+	}
+
+	if !m.acquire(id, component) {
+		return ErrInProgress
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer m.release(id, component)
+
+		if err := m.rotate(context.Background(), id, component); err != nil {
+			log.Errorf("%s credential rotation of switch %s failed: %v", component, id, err)
+		}
+	}()
+
+	return nil
+}
+
+// Rotate rotates the credential of a component of a registered switch and waits for the outcome.
+func (m *Manager) Rotate(ctx context.Context, id uuid.UUID, component nvswitch.Component) error {
+	if err := validateComponent(component); err != nil {
+		return err
+	}
+
+	if !m.acquire(id, component) {
+		return ErrInProgress
+	}
+	defer m.release(id, component)
+
+	return m.rotate(ctx, id, component)
+}
+
+// Status returns the latest rotation of a switch account. Returns ErrNotFound if its credential was never rotated.
+func (m *Manager) Status(ctx context.Context, id uuid.UUID, component nvswitch.Component) (*Status, error) {
+	return m.store.Get(ctx, id, component)
+}
+
+// NextRotation returns when the scheduler rotates the credential of a switch account next, zero if scheduled
+// rotation of the component is disabled. An account whose credential was never rotated is due immediately.
+func (m *Manager) NextRotation(component nvswitch.Component, status *Status) time.Time {
+	interval := m.conf.Interval(component)
+	if interval <= 0 {
+		return time.Time{}
+	}
+	if status == nil || status.LastRotatedAt.IsZero() {
+		return m.now()
+	}
+	return status.LastRotatedAt.Add(interval)
+}
+
+func validateComponent(component nvswitch.Component) error {
+	if component != nvswitch.BMC && component != nvswitch.NVOS {
+		return fmt.Errorf("credential rotation is not supported for component %q", component)
+	}
+	return nil
+}
+
+func (m *Manager) acquire(id uuid.UUID, component nvswitch.Component) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := storeKey(id, component)
+	if _, ok := m.inflight[key]; ok {
+		return false
+	}
+	m.inflight[key] = struct{}{}
+	return true
+}
+
+func (m *Manager) release(id uuid.UUID, component nvswitch.Component) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.inflight, storeKey(id, component))
+}
+
+// rotate runs a single rotation and records its outcome. The caller must hold the account's inflight slot.
+func (m *Manager) rotate(ctx context.Context, id uuid.UUID, component nvswitch.Component) error {
+	ctx, cancel := context.WithTimeout(ctx, rotationTimeout)
+	defer cancel()
+
+	status := &Status{SwitchUUID: id, Component: component}
+	if prev, err := m.store.Get(ctx, id, component); err == nil {
+		status.LastRotatedAt = prev.LastRotatedAt
+	} else if !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to load the rotation status: %w", err)
+	}
+
+	status.State = StateInProgress
+	status.LastAttemptAt = m.now()
+	status.ErrorMessage = ""
+	if err := m.store.Put(ctx, status); err != nil {
+		return fmt.Errorf("failed to record the rotation start: %w", err)
+	}
+
+	state, err := m.changeCredential(ctx, id, component)
+	status.State = state
+	if err != nil {
+		status.ErrorMessage = err.Error()
+	} else {
+		status.LastRotatedAt = m.now()
+	}
+
+	if perr := m.store.Put(ctx, status); perr != nil {
+		log.Errorf("Failed to record the %s credential rotation outcome of switch %s (%s): %v", component, id, state, perr)
+	}
+
+	return err
+}
+
+// changeCredential performs the rotation steps and returns the resulting state.
+func (m *Manager) changeCredential(ctx context.Context, id uuid.UUID, component nvswitch.Component) (State, error) {
+	tray, err := m.switches.Get(ctx, id)
+	if err != nil {
+		return StateFailed, err
+	}
+
+	oldCred := trayCredential(tray, component)
+	if oldCred == nil || !oldCred.IsValid() || oldCred.Password.IsEmpty() {
+		return StateFailed, fmt.Errorf("switch %s has no %s password to rotate", id, component)
+	}
+
+	password, err := commoncredential.GeneratePassword(m.conf.PasswordLength)
+	if err != nil {
+		return StateFailed, err
+	}
+	// Keep the user and, for NVOS, the SSH private key.
+	newCred := *oldCred
+	newCred.Password = secretstring.New(password)
+
+	log.Infof("Rotating the %s credential of switch %s", component, id)
+	if err := m.switches.ChangePassword(ctx, tray, component, oldCred, password); err != nil {
+		// The device may have applied the change before failing the request.
+		return m.rollback(ctx, tray, component, oldCred, &newCred, fmt.Errorf("failed to set the new password: %w", err))
+	}
+
+	if err := m.switches.VerifyLogin(ctx, tray, component, &newCred); err != nil {
+		return m.rollback(ctx, tray, component, oldCred, &newCred, fmt.Errorf("failed to log in with the new password: %w", err))
+	}
+
+	if err := m.switches.StoreCredential(ctx, tray, component, &newCred); err != nil {
+		return m.rollback(ctx, tray, component, oldCred, &newCred, fmt.Errorf("failed to store the new credential: %w", err))
+	}
+
+	log.Infof("Rotated the %s credential of switch %s", component, id)
+	return StateSucceeded, nil
+}
+
+// rollback restores the stored password on a device after a failed rotation. oldCred is the stored
+// credential and newCred the credential the rotation tried to set.
+func (m *Manager) rollback(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, oldCred, newCred *credential.Credential, cause error) (State, error) {
+	// Nothing to restore if the device still accepts the stored password.
+	if err := m.switches.VerifyLogin(ctx, tray, component, oldCred); err == nil {
+		return StateFailed, cause
+	}
+
+	log.Warnf("Restoring the previous %s password of switch %s after a failed rotation: %v", component, tray.UUID, cause)
+	if err := m.switches.ChangePassword(ctx, tray, component, newCred, oldCred.Password.Value); err != nil {
+		return StateRollbackFailed, fmt.Errorf("%v; restoring the previous password failed: %w", cause, err)
+	}
+
+	if err := m.switches.VerifyLogin(ctx, tray, component, oldCred); err != nil {
+		return StateRollbackFailed, fmt.Errorf("%v; the previous password is not accepted after restoring it: %w", cause, err)
+	}
+
+	return StateFailed, cause
+}
+
+// trayCredential returns the stored credential of a switch component.
+func trayCredential(tray *nvswitch.NVSwitchTray, component nvswitch.Component) *credential.Credential {
+	switch {
+	case component == nvswitch.BMC && tray.BMC != nil:
+		return tray.BMC.GetCredential()
+	case component == nvswitch.NVOS && tray.NVOS != nil:
+		return tray.NVOS.GetCredential()
+	default:
+		return nil
+	}
+}
+
+// failInterrupted marks rotations left in progress by a previous run as failed. Such a device may hold a
+// password that was never stored.
+func (m *Manager) failInterrupted(ctx context.Context) error {
+	statuses, err := m.store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list credential rotations: %w", err)
+	}
+
+	for _, status := range statuses {
+		if status.State != StateInProgress {
+			continue
+		}
+
+		log.Warnf("%s credential rotation of switch %s was interrupted by a restart", status.Component, status.SwitchUUID)
+		status.State = StateFailed
+		status.ErrorMessage = "rotation interrupted by a service restart; verify the device accepts the stored credential"
+		if err := m.store.Put(ctx, status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) schedule(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.rotateDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rotateDue rotates, one at a time, every switch credential whose password is older than its component's interval.
+func (m *Manager) rotateDue(ctx context.Context) {
+	trays, err := m.switches.List(ctx)
+	if err != nil {
+		log.Errorf("Credential rotation scheduler: failed to list switches: %v", err)
+		return
+	}
+
+	for _, tray := range trays {
+		for _, component := range Components {
+			if ctx.Err() != nil {
+				return
+			}
+			if m.conf.Interval(component) <= 0 {
+				continue
+			}
+
+			status, err := m.store.Get(ctx, tray.UUID, component)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				log.Errorf("Credential rotation scheduler: failed to load the %s status of switch %s: %v", component, tray.UUID, err)
+				continue
+			}
+			if err == nil && status.State == StateRollbackFailed {
+				// Retrying could change the password of a device whose stored credential no longer works.
+				continue
+			}
+			if m.now().Before(m.NextRotation(component, status)) {
+				continue
+			}
+
+			if err := m.Rotate(ctx, tray.UUID, component); err != nil && !errors.Is(err, ErrInProgress) {
+				log.Errorf("Credential rotation scheduler: %s rotation of switch %s failed: %v", component, tray.UUID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentialrotation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned by Get when no rotation has been recorded for a switch account.
+var ErrNotFound = errors.New("credential rotation not found")
+
+// State is the state of the latest credential rotation of a switch account.
+type State string
+
+const (
+	// StateInProgress means a rotation is running.
+	StateInProgress State = "InProgress"
+	// StateSucceeded means the new password is set on the device and stored.
+	StateSucceeded State = "Succeeded"
+	// StateFailed means the rotation failed and the device still accepts the stored password.
+	StateFailed State = "Failed"
+	// StateRollbackFailed means the rotation failed and restoring the stored password on the device
+	// failed too. The device may no longer accept the stored credential and needs manual attention.
+	StateRollbackFailed State = "RollbackFailed"
+)
+
+// Status is the latest credential rotation of a switch's BMC or NVOS account.
+type Status struct {
+	SwitchUUID    uuid.UUID
+	Component     nvswitch.Component // nvswitch.BMC or nvswitch.NVOS
+	State         State
+	LastAttemptAt time.Time
+	// LastRotatedAt is when the credential was last rotated successfully, zero if never.
+	LastRotatedAt time.Time
+	ErrorMessage  string
+	UpdatedAt     time.Time
+}
+
+// Store provides persistence for credential rotation status.
+type Store interface {
+	// Get retrieves the rotation status of a switch account. Returns ErrNotFound if none is recorded.
+	Get(ctx context.Context, switchUUID uuid.UUID, component nvswitch.Component) (*Status, error)
+
+	// List returns the rotation status of every switch account with a recorded rotation.
+	List(ctx context.Context) ([]*Status, error)
+
+	// Put upserts the rotation status of a switch account.
+	Put(ctx context.Context, status *Status) error
+}
+
+// Ensure InMemoryStore implements Store.
+var _ Store = (*InMemoryStore)(nil)
+
+// InMemoryStore implements Store using in-memory storage.
+// All data is lost when the process exits.
+type InMemoryStore struct {
+	mu       sync.RWMutex
+	statuses map[string]*Status
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		statuses: make(map[string]*Status),
+	}
+}
+
+func storeKey(switchUUID uuid.UUID, component nvswitch.Component) string {
+	return switchUUID.String() + "/" + string(component)
+}
+
+// Get retrieves the rotation status of a switch account.
+func (s *InMemoryStore) Get(_ context.Context, switchUUID uuid.UUID, component nvswitch.Component) (*Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status, ok := s.statuses[storeKey(switchUUID, component)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	copy := *status
+	return &copy, nil
+}
+
+// List returns the rotation status of every switch account, ordered by switch and component.
+func (s *InMemoryStore) List(context.Context) ([]*Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]*Status, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		copy := *status
+		statuses = append(statuses, &copy)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return storeKey(statuses[i].SwitchUUID, statuses[i].Component) < storeKey(statuses[j].SwitchUUID, statuses[j].Component)
+	})
+	return statuses, nil
+}
+
+// Put upserts the rotation status of a switch account.
+func (s *InMemoryStore) Put(_ context.Context, status *Status) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copy := *status
+	copy.UpdatedAt = time.Now()
+	s.statuses[storeKey(status.SwitchUUID, status.Component)] = &copy
+	return nil
+}
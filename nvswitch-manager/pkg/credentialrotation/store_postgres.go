@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentialrotation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Ensure PostgresStore implements Store.
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	db *bun.DB
+}
+
+// NewPostgresStore creates a new PostgreSQL-backed store.
+func NewPostgresStore(db *bun.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// CredentialRotationModel is the database model for credential rotations.
+// This maps to the credential_rotation table.
+type CredentialRotationModel struct {
+	bun.BaseModel `bun:"table:credential_rotation,alias:cr"`
+
+	SwitchUUID    uuid.UUID          `bun:"switch_uuid,pk,type:uuid"`
+	Component     nvswitch.Component `bun:"component,pk"`
+	State         State              `bun:"state,notnull"`
+	LastAttemptAt time.Time          `bun:"last_attempt_at,notnull"`
+	LastRotatedAt *time.Time         `bun:"last_rotated_at"`
+	ErrorMessage  string             `bun:"error_message"`
+	UpdatedAt     time.Time          `bun:"updated_at,notnull,default:now()"`
+}
+
+func toModel(status *Status) *CredentialRotationModel {
+	m := &CredentialRotationModel{
+		SwitchUUID:    status.SwitchUUID,
+		Component:     status.Component,
+		State:         status.State,
+		LastAttemptAt: status.LastAttemptAt,
+		ErrorMessage:  status.ErrorMessage,
+		UpdatedAt:     status.UpdatedAt,
+	}
+	if !status.LastRotatedAt.IsZero() {
+		rotated := status.LastRotatedAt
+		m.LastRotatedAt = &rotated
+	}
+	return m
+}
+
+func fromModel(m *CredentialRotationModel) *Status {
+	status := &Status{
+		SwitchUUID:    m.SwitchUUID,
+		Component:     m.Component,
+		State:         m.State,
+		LastAttemptAt: m.LastAttemptAt,
+		ErrorMessage:  m.ErrorMessage,
+		UpdatedAt:     m.UpdatedAt,
+	}
+	if m.LastRotatedAt != nil {
+		status.LastRotatedAt = *m.LastRotatedAt
+	}
+	return status
+}
+
+// Get retrieves the rotation status of a switch account.
+func (s *PostgresStore) Get(ctx context.Context, switchUUID uuid.UUID, component nvswitch.Component) (*Status, error) {
+	model := &CredentialRotationModel{}
+	err := s.db.NewSelect().
+		Model(model).
+		Where("switch_uuid = ?", switchUUID).
+		Where("component = ?", component).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return fromModel(model), nil
+}
+
+// List returns the rotation status of every switch account, ordered by switch and component.
+func (s *PostgresStore) List(ctx context.Context) ([]*Status, error) {
+	var models []*CredentialRotationModel
+	err := s.db.NewSelect().
+		Model(&models).
+		Order("switch_uuid ASC", "component ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*Status, len(models))
+	for i, m := range models {
+		statuses[i] = fromModel(m)
+	}
+	return statuses, nil
+}
+
+// Put upserts the rotation status of a switch account.
+func (s *PostgresStore) Put(ctx context.Context, status *Status) error {
+	status.UpdatedAt = time.Now()
+
+	_, err := s.db.NewInsert().
+		Model(toModel(status)).
+		On("CONFLICT (switch_uuid, component) DO UPDATE").
+		Set("state = EXCLUDED.state").
+		Set("last_attempt_at = EXCLUDED.last_attempt_at").
+		Set("last_rotated_at = EXCLUDED.last_rotated_at").
+		Set("error_message = EXCLUDED.error_message").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
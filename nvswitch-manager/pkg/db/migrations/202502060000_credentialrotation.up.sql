@@ -0,0 +1,29 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Create credential_rotation table
+-- Holds the latest credential rotation of each switch's BMC and NVOS account so
+-- that the rotation schedule survives restarts.
+
+CREATE TABLE IF NOT EXISTS credential_rotation (
+    switch_uuid UUID NOT NULL,
+    component VARCHAR(16) NOT NULL,
+    state VARCHAR(32) NOT NULL,
+    last_attempt_at TIMESTAMPTZ NOT NULL,
+    last_rotated_at TIMESTAMPTZ,
+    error_message TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (switch_uuid, component)
+);
@@ -0,0 +1,34 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Create firmware_rollout table
+-- Holds staged firmware rollouts: the bundle, the rollout policy and the waves
+-- with the firmware updates queued for each switch, so that a rollout resumes
+-- where it was after a restart.
+
+CREATE TABLE IF NOT EXISTS firmware_rollout (
+    id UUID PRIMARY KEY,
+    bundle_version VARCHAR NOT NULL,
+    components JSONB,
+    policy JSONB,
+    state VARCHAR(32) NOT NULL,
+    current_wave INTEGER NOT NULL DEFAULT 0,
+    waves JSONB,
+    message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_firmware_rollout_state ON firmware_rollout(state);
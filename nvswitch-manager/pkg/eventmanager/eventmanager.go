@@ -0,0 +1,435 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package eventmanager consumes Redfish events from the BMCs of the registered NV-Switch trays and fans them out
+// to in-process subscribers. BMCs are watched through EventService push subscriptions when a receiver destination
+// is configured, otherwise through their Server-Sent Event stream; BMCs supporting neither are left to polling.
+// Task events are cached so that firmware updates can follow task progress without polling the BMC.
+package eventmanager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/redfish"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	gofish "github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// DefaultSyncInterval is how often newly registered switches are picked up and failed watches are retried.
+	DefaultSyncInterval = time.Minute
+	// ReceiverPath is the path at which the event receiver accepts pushed events.
+	ReceiverPath = "/redfish/events"
+
+	// unsubscribeTimeout bounds deleting a push subscription on shutdown.
+	unsubscribeTimeout = 10 * time.Second
+	// taskRetention is how long the last reported state of a task is kept.
+	taskRetention = time.Hour
+)
+
+// Mode is how a switch BMC's events are received.
+type Mode string
+
+const (
+	// ModePush receives events posted by the BMC to the event receiver.
+	ModePush Mode = "Push"
+	// ModeSSE reads the BMC's Server-Sent Event stream.
+	ModeSSE Mode = "SSE"
+	// ModePolling means the BMC supports no event delivery; its state is only collected by polling.
+	ModePolling Mode = "Polling"
+)
+
+// SwitchClient is the subset of the NV-Switch manager needed to receive events.
+type SwitchClient interface {
+	Get(ctx context.Context, id uuid.UUID) (*nvswitch.NVSwitchTray, error)
+	List(ctx context.Context) ([]*nvswitch.NVSwitchTray, error)
+}
+
+// Config specifies how events are received.
+type Config struct {
+	// ListenAddress is the address of the HTTP event receiver (e.g. ":8081"). Empty disables the receiver.
+	ListenAddress string
+	// Destination is the URL BMCs post events to, which must reach the receiver's ReceiverPath
+	// (e.g. "http://nsm.example.com:8081/redfish/events"). Empty disables push subscriptions in favor of SSE.
+	Destination string
+	// Token is a shared secret BMCs send with every pushed event. Empty accepts events without a token.
+	Token string
+	// SyncInterval is how often the switch list is re-read (DefaultSyncInterval if zero).
+	SyncInterval time.Duration
+}
+
+// Event is a Redfish event reported by a switch BMC.
+type Event struct {
+	SwitchUUID uuid.UUID
+	Kind       events.Kind
+	Severity   string
+	MessageID  string
+	Message    string
+	// Origin is the URI of the resource the event is about, e.g. a task.
+	Origin    string
+	Timestamp time.Time
+}
+
+// TaskStatus is the last state of a Redfish task reported through events.
+type TaskStatus struct {
+	State           string
+	PercentComplete int
+	UpdatedAt       time.Time
+}
+
+// watch tracks how the events of one switch are received.
+type watch struct {
+	mode   Mode
+	cancel context.CancelFunc
+}
+
+// Manager watches the registered switches for events and publishes them to subscribers.
+type Manager struct {
+	switches SwitchClient
+	conf     Config
+	broker   *events.Broker[Event]
+	now      func() time.Time
+
+	mu      sync.Mutex
+	watches map[uuid.UUID]*watch
+	tasks   map[uuid.UUID]map[string]TaskStatus
+
+	server *http.Server
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Manager watching the switches known to the given client.
+func New(switches SwitchClient, conf Config) *Manager {
+	if conf.SyncInterval <= 0 {
+		conf.SyncInterval = DefaultSyncInterval
+	}
+
+	return &Manager{
+		switches: switches,
+		conf:     conf,
+		broker:   events.NewBroker[Event](),
+		now:      time.Now,
+		watches:  make(map[uuid.UUID]*watch),
+		tasks:    make(map[uuid.UUID]map[string]TaskStatus),
+	}
+}
+
+// Start starts the event receiver, if configured, and the loop watching the registered switches.
+func (m *Manager) Start(ctx context.Context) error {
+	if m.conf.ListenAddress != "" {
+		listener, err := net.Listen("tcp", m.conf.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle(ReceiverPath, m.Receiver())
+		m.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+		log.Infof("Starting Redfish event receiver on %s", listener.Addr())
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			if err := m.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Errorf("Redfish event receiver failed: %v", err)
+			}
+		}()
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	m.wg.Add(1)
+	go m.run(runCtx)
+
+	return nil
+}
+
+// Stop stops watching, deletes push subscriptions, stops the receiver and closes all subscriptions.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	var err error
+	if m.server != nil {
+		err = m.server.Shutdown(ctx)
+	}
+
+	m.wg.Wait()
+	m.broker.Close()
+
+	return err
+}
+
+// Subscribe returns a subscription to the events of the given switches (all switches if empty) and kinds (all
+// kinds if empty). The caller must Close it.
+func (m *Manager) Subscribe(ids []uuid.UUID, kinds []events.Kind) *events.Subscription[Event] {
+	idSet := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		idSet[id] = true
+	}
+	kindSet := make(map[events.Kind]bool, len(kinds))
+	for _, kind := range kinds {
+		kindSet[kind] = true
+	}
+
+	return m.broker.Subscribe(0, func(ev Event) bool {
+		return (len(idSet) == 0 || idSet[ev.SwitchUUID]) && (len(kindSet) == 0 || kindSet[ev.Kind])
+	})
+}
+
+// Modes returns how the events of each watched switch are received.
+func (m *Manager) Modes() map[uuid.UUID]Mode {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	modes := make(map[uuid.UUID]Mode, len(m.watches))
+	for id, w := range m.watches {
+		modes[id] = w.mode
+	}
+	return modes
+}
+
+// TaskStatus returns the last state of a task of the switch reported through events. ok is false if no event was
+// received for the task, or the switch's events are not being received, in which case the caller must poll.
+func (m *Manager) TaskStatus(id uuid.UUID, taskURI string) (TaskStatus, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if w, ok := m.watches[id]; !ok || w.mode == ModePolling {
+		return TaskStatus{}, false
+	}
+
+	status, ok := m.tasks[id][normalizeTaskURI(taskURI)]
+	return status, ok
+}
+
+// Receiver returns the handler accepting events pushed by subscribed BMCs. The event Context is the switch UUID;
+// events of switches that are not watched through push subscriptions are ignored.
+func (m *Manager) Receiver() http.Handler {
+	return &events.Receiver{
+		Token: m.conf.Token,
+		Handler: func(ev *gofish.Event) {
+			id, err := uuid.Parse(ev.Context)
+
+			m.mu.Lock()
+			w, ok := m.watches[id]
+			push := err == nil && ok && w.mode == ModePush
+			m.mu.Unlock()
+
+			if !push {
+				log.Debugf("Ignoring Redfish event for unwatched switch %q", ev.Context)
+				return
+			}
+			m.handle(id, ev)
+		},
+	}
+}
+
+// run syncs the watched switches with the registry every SyncInterval until ctx is cancelled.
+func (m *Manager) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		m.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync starts watching newly registered switches, retries switches whose watch failed, stops watching switches
+// that are no longer registered and expires old task states.
+func (m *Manager) sync(ctx context.Context) {
+	trays, err := m.switches.List(ctx)
+	if err != nil {
+		log.Errorf("Event manager failed to list switches: %v", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	registered := make(map[uuid.UUID]bool, len(trays))
+	for _, tray := range trays {
+		registered[tray.UUID] = true
+	}
+	for id, w := range m.watches {
+		if !registered[id] {
+			w.cancel()
+			delete(m.watches, id)
+			delete(m.tasks, id)
+		}
+	}
+
+	cutoff := m.now().Add(-taskRetention)
+	for _, tasks := range m.tasks {
+		for uri, status := range tasks {
+			if status.UpdatedAt.Before(cutoff) {
+				delete(tasks, uri)
+			}
+		}
+	}
+
+	for _, tray := range trays {
+		if _, ok := m.watches[tray.UUID]; ok {
+			continue
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		w := &watch{mode: ModeSSE, cancel: cancel}
+		if m.conf.Destination != "" {
+			w.mode = ModePush
+		}
+		m.watches[tray.UUID] = w
+
+		m.wg.Add(1)
+		go m.watch(watchCtx, tray.UUID, w)
+	}
+}
+
+// watch receives the events of one switch until ctx is cancelled or delivery fails, in which case the next sync
+// retries.
+func (m *Manager) watch(ctx context.Context, id uuid.UUID, w *watch) {
+	defer m.wg.Done()
+	defer w.cancel()
+
+	err := m.receive(ctx, id, w.mode)
+	if ctx.Err() != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if errors.Is(err, events.ErrNotSupported) || errors.Is(err, events.ErrSSENotSupported) {
+		log.Infof("Switch %s BMC does not support Redfish %s events; relying on polling", id, w.mode)
+		w.mode = ModePolling
+		return
+	}
+
+	log.Warnf("Lost Redfish events of switch %s (%s): %v", id, w.mode, err)
+	if m.watches[id] == w {
+		delete(m.watches, id)
+	}
+}
+
+// receive connects to the switch BMC and receives its events in the given mode until ctx is cancelled or delivery
+// fails.
+func (m *Manager) receive(ctx context.Context, id uuid.UUID, mode Mode) error {
+	tray, err := m.switches.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The session outlives ctx so that it can still be used to unsubscribe and log out once ctx is cancelled.
+	client, err := redfish.New(context.WithoutCancel(ctx), tray.BMC, false)
+	if err != nil {
+		return err
+	}
+	defer client.Logout()
+
+	if mode == ModeSSE {
+		return client.StreamEvents(ctx, func(ev *gofish.Event) error {
+			m.handle(id, ev)
+			return nil
+		})
+	}
+
+	var headers map[string]string
+	if m.conf.Token != "" {
+		headers = map[string]string{events.TokenHeader: m.conf.Token}
+	}
+
+	uri, err := client.SubscribeEvents(m.conf.Destination, id.String(), headers)
+	if err != nil {
+		return err
+	}
+	log.Infof("Subscribed to Redfish events of switch %s (%s)", id, uri)
+
+	<-ctx.Done()
+
+	// Unsubscribing reuses the session, whose requests are not bound to ctx.
+	done := make(chan error, 1)
+	go func() { done <- client.UnsubscribeEvents(uri) }()
+	select {
+	case err = <-done:
+	case <-time.After(unsubscribeTimeout):
+		err = errors.New("timed out")
+	}
+	if err != nil {
+		log.Warnf("Failed to delete Redfish event subscription %s of switch %s: %v", uri, id, err)
+	}
+
+	return nil
+}
+
+// handle records task progress and publishes the records of an event.
+func (m *Manager) handle(id uuid.UUID, ev *gofish.Event) {
+	for i := range ev.Events {
+		rec := &ev.Events[i]
+		kind := events.Classify(rec)
+		timestamp, _ := time.Parse(time.RFC3339, rec.EventTimestamp)
+
+		if uri, state, percent, ok := events.TaskUpdate(rec); ok && uri != "" {
+			m.mu.Lock()
+			if m.tasks[id] == nil {
+				m.tasks[id] = make(map[string]TaskStatus)
+			}
+			m.tasks[id][normalizeTaskURI(uri)] = TaskStatus{State: state, PercentComplete: percent, UpdatedAt: m.now()}
+			m.mu.Unlock()
+		}
+
+		severity := string(rec.MessageSeverity)
+		if severity == "" {
+			severity = rec.Severity
+		}
+
+		m.broker.Publish(Event{
+			SwitchUUID: id,
+			Kind:       kind,
+			Severity:   severity,
+			MessageID:  rec.MessageID,
+			Message:    rec.Message,
+			Origin:     rec.OriginOfCondition,
+			Timestamp:  timestamp,
+		})
+	}
+}
+
+// normalizeTaskURI strips the trailing slash BMCs may or may not append to task URIs.
+func normalizeTaskURI(uri string) string {
+	return strings.TrimSuffix(uri, "/")
+}
@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventmanager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/bmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/redfish"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSwitches serves a single registered tray.
+type fakeSwitches struct {
+	tray *nvswitch.NVSwitchTray
+}
+
+func (f *fakeSwitches) Get(_ context.Context, id uuid.UUID) (*nvswitch.NVSwitchTray, error) {
+	if id != f.tray.UUID {
+		return nil, errors.New("switch not found")
+	}
+	return f.tray, nil
+}
+
+func (f *fakeSwitches) List(context.Context) ([]*nvswitch.NVSwitchTray, error) {
+	return []*nvswitch.NVSwitchTray{f.tray}, nil
+}
+
+// newEmulatedSwitch serves an emulated switch BMC and returns it with a tray pointing at it.
+func newEmulatedSwitch(t *testing.T) (*emulator.Emulator, *nvswitch.NVSwitchTray) {
+	emu := emulator.New(emulator.Config{Profile: emulator.NVSwitchBMC})
+	server := httptest.NewTLSServer(emu)
+	t.Cleanup(emu.Close)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	cred := credential.New(emulator.DefaultUsername, emulator.DefaultPassword)
+	b, err := bmc.NewFromAddr(net.HardwareAddr{0, 1, 2, 3, 4, 5}, net.ParseIP(u.Hostname()), cred)
+	require.NoError(t, err)
+	b.SetPort(port)
+
+	return emu, &nvswitch.NVSwitchTray{UUID: uuid.New(), BMC: b}
+}
+
+func startManager(t *testing.T, tray *nvswitch.NVSwitchTray, conf Config) *Manager {
+	m := New(&fakeSwitches{tray: tray}, conf)
+	require.NoError(t, m.Start(context.Background()))
+	t.Cleanup(func() { _ = m.Stop(context.Background()) })
+	return m
+}
+
+func waitForMode(t *testing.T, m *Manager, id uuid.UUID, mode Mode) {
+	require.Eventually(t, func() bool {
+		return m.Modes()[id] == mode
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func nextEvent(t *testing.T, sub *events.Subscription[Event]) Event {
+	select {
+	case ev := <-sub.C:
+		return ev
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event published")
+		return Event{}
+	}
+}
+
+// uploadFirmware starts a firmware update task on the tray's BMC and returns the task URI.
+func uploadFirmware(t *testing.T, tray *nvswitch.NVSwitchTray) string {
+	client, err := redfish.New(context.Background(), tray.BMC, false)
+	require.NoError(t, err)
+	defer client.Logout()
+
+	resp, err := client.UploadFirmware(strings.NewReader("firmware image"))
+	require.NoError(t, err)
+	taskURI, err := client.GetTaskURI(resp)
+	require.NoError(t, err)
+
+	return taskURI
+}
+
+func TestStreamEvents(t *testing.T) {
+	emu, tray := newEmulatedSwitch(t)
+	m := startManager(t, tray, Config{})
+	waitForMode(t, m, tray.UUID, ModeSSE)
+	require.Eventually(t, func() bool { return emu.StreamClients() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	sub := m.Subscribe([]uuid.UUID{tray.UUID}, []events.Kind{events.KindTask})
+	defer sub.Close()
+
+	taskURI := uploadFirmware(t, tray)
+
+	ev := nextEvent(t, sub)
+	assert.Equal(t, tray.UUID, ev.SwitchUUID)
+	assert.Equal(t, events.KindTask, ev.Kind)
+	assert.Equal(t, taskURI, ev.Origin)
+
+	require.Eventually(t, func() bool {
+		status, ok := m.TaskStatus(tray.UUID, taskURI+"/")
+		return ok && status.State == events.TaskStateCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, ok := m.TaskStatus(tray.UUID, "/redfish/v1/TaskService/Tasks/unknown")
+	assert.False(t, ok)
+}
+
+func TestPushEvents(t *testing.T) {
+	emu, tray := newEmulatedSwitch(t)
+
+	var m *Manager
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Receiver().ServeHTTP(w, r)
+	}))
+	t.Cleanup(receiver.Close)
+
+	m = New(&fakeSwitches{tray: tray}, Config{Destination: receiver.URL + ReceiverPath, Token: "secret"})
+	sub := m.Subscribe(nil, nil)
+	require.NoError(t, m.Start(context.Background()))
+	t.Cleanup(func() { _ = m.Stop(context.Background()) })
+
+	waitForMode(t, m, tray.UUID, ModePush)
+	require.Eventually(t, func() bool { return emu.Subscriptions() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	taskURI := uploadFirmware(t, tray)
+
+	ev := nextEvent(t, sub)
+	assert.Equal(t, tray.UUID, ev.SwitchUUID)
+	assert.Equal(t, "TaskEvent.1.0.TaskStarted", ev.MessageID)
+	assert.Equal(t, taskURI, ev.Origin)
+
+	require.NoError(t, m.Stop(context.Background()))
+	assert.Equal(t, 0, emu.Subscriptions(), "subscription is deleted on Stop")
+
+	// The subscription is closed on Stop; drain the events published before.
+	for range sub.C {
+	}
+}
+
+func TestPollingFallback(t *testing.T) {
+	emu, tray := newEmulatedSwitch(t)
+	emu.InjectFault(emulator.Fault{Path: "/redfish/v1/EventService", StatusCode: http.StatusNotFound})
+
+	m := startManager(t, tray, Config{})
+	waitForMode(t, m, tray.UUID, ModePolling)
+
+	taskURI := uploadFirmware(t, tray)
+	_, ok := m.TaskStatus(tray.UUID, taskURI)
+	assert.False(t, ok, "task states of polled switches are unknown")
+}
+
+func TestSubscribeFilter(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	m := New(&fakeSwitches{}, Config{})
+	byID := m.Subscribe([]uuid.UUID{first}, nil)
+	byKind := m.Subscribe(nil, []events.Kind{events.KindTask})
+
+	m.broker.Publish(Event{SwitchUUID: first, Kind: events.KindPowerState})
+	m.broker.Publish(Event{SwitchUUID: second, Kind: events.KindTask})
+
+	assert.Equal(t, events.KindPowerState, (<-byID.C).Kind)
+	assert.Equal(t, second, (<-byKind.C).SwitchUUID)
+	assert.Empty(t, byID.C)
+	assert.Empty(t, byKind.C)
+}
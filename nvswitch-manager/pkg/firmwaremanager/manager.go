@@ -28,6 +28,8 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
+
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
@@ -45,6 +47,9 @@ type Config struct {
 
 	// SchedulerInterval is how often the scheduler queries for pending updates
 	SchedulerInterval time.Duration
+
+	// Verification configures the trust bundle bundle signatures are verified against
+	Verification fwverify.Config
 }
 
 // FirmwareManager orchestrates firmware updates for NV-Switches.
@@ -86,6 +91,18 @@ func New(
 
 	log.Infof("Loaded %d firmware packages", pkgRegistry.Count())
 
+	verifier, err := fwverify.New(config.Verification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load firmware trust bundle: %w", err)
+	}
+	if config.Verification.TrustBundle == "" {
+		if config.Verification.AllowUnsigned {
+			log.Warn("No firmware trust bundle configured; bundle signatures will not be verified")
+		} else {
+			log.Warn("No firmware trust bundle configured; firmware updates will fail until one is configured")
+		}
+	}
+
 	// Create worker pool with scheduler
 	workerPool := NewWorkerPool(
 		config.NumWorkers,
@@ -93,6 +110,7 @@ func New(
 		store,
 		nsmgr,
 		pkgRegistry,
+		verifier,
 	)
 
 	return &FirmwareManager{
@@ -104,6 +122,12 @@ func New(
 	}, nil
 }
 
+// SetTaskEventSource makes Redfish updates follow task progress through BMC
+// events instead of polling every interval. Must be called before Start.
+func (m *FirmwareManager) SetTaskEventSource(src TaskEventSource) {
+	m.workerPool.taskEvents = src
+}
+
 // Start initializes and starts the firmware manager.
 func (m *FirmwareManager) Start(ctx context.Context) error {
 	log.Info("Starting firmware manager")
@@ -244,15 +268,44 @@ func (m *FirmwareManager) getCurrentVersion(
 	case StrategyRedfish:
 		s = NewRedfishStrategy(pkg.StrategyConfig.Redfish)
 	case StrategySSH:
-		s = NewSSHStrategy(pkg.StrategyConfig.SSH)
+		ss := NewSSHStrategy(pkg.StrategyConfig.SSH)
+		if m.nsmgr != nil {
+			ss.SetHostKeyPinner(m.nsmgr)
+		}
+		s = ss
 	case StrategyScript:
-		s = NewScriptStrategy(pkg.StrategyConfig.Script)
+		ss := NewScriptStrategy(pkg.StrategyConfig.Script)
+		if m.nsmgr != nil {
+			ss.SetHostKeyPinner(m.nsmgr)
+		}
+		s = ss
 	default:
 		return "", fmt.Errorf("unknown strategy: %s", strategy)
 	}
 	return s.GetCurrentVersion(ctx, tray, component)
 }
 
+// CurrentVersion queries the running firmware version of a switch component using the strategy the bundle
+// defines for it.
+func (m *FirmwareManager) CurrentVersion(ctx context.Context, switchUUID uuid.UUID, bundleVersion string, component nvswitch.Component) (string, error) {
+	pkg, err := m.packages.Get(bundleVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid bundle version: %w", err)
+	}
+
+	compDef := pkg.GetComponent(strings.ToLower(string(component)))
+	if compDef == nil {
+		return "", fmt.Errorf("component %s not found in bundle %s", component, bundleVersion)
+	}
+
+	tray, err := m.nsmgr.Get(ctx, switchUUID)
+	if err != nil {
+		return "", fmt.Errorf("switch not found: %w", err)
+	}
+
+	return m.getCurrentVersion(ctx, tray, component, Strategy(compDef.Strategy), pkg)
+}
+
 // GetUpdate retrieves a firmware update by ID.
 func (m *FirmwareManager) GetUpdate(ctx context.Context, updateID uuid.UUID) (*FirmwareUpdate, error) {
 	return m.store.Get(ctx, updateID)
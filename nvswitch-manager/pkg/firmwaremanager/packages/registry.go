@@ -24,6 +24,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
+
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
@@ -33,9 +35,21 @@ type Registry struct {
 	// firmwareDir is the base directory containing firmware files
 	firmwareDir string
 
+	// packagesDir is the directory the package definitions were loaded from
+	packagesDir string
+
 	// packages maps bundle version to package definition
 	packages map[string]*FirmwarePackage
-	mu       sync.RWMutex
+
+	// manifests maps bundle version to the package file it was parsed from
+	manifests map[string]manifest
+	mu        sync.RWMutex
+}
+
+// manifest is a package definition file as read from the packages directory.
+type manifest struct {
+	name string // File name within the packages directory
+	data []byte
 }
 
 // NewRegistry creates a new package registry.
@@ -43,6 +57,7 @@ func NewRegistry(firmwareDir string) *Registry {
 	return &Registry{
 		firmwareDir: firmwareDir,
 		packages:    make(map[string]*FirmwarePackage),
+		manifests:   make(map[string]manifest),
 	}
 }
 
@@ -52,7 +67,9 @@ func (r *Registry) LoadFromDirectory(packagesDir string) error {
 	defer r.mu.Unlock()
 
 	// Clear existing packages
+	r.packagesDir = packagesDir
 	r.packages = make(map[string]*FirmwarePackage)
+	r.manifests = make(map[string]manifest)
 
 	// Find all YAML files
 	entries, err := os.ReadDir(packagesDir)
@@ -114,6 +131,7 @@ func (r *Registry) loadPackageFile(path string) error {
 	}
 
 	r.packages[pkg.Version] = &pkg
+	r.manifests[pkg.Version] = manifest{name: filepath.Base(path), data: data}
 	log.Debugf("Loaded firmware package: version=%s, components=%d", pkg.Version, len(pkg.Components))
 	return nil
 }
@@ -163,6 +181,45 @@ func (r *Registry) GetFirmwarePath(pkg *FirmwarePackage, componentName string) (
 	return filepath.Join(r.firmwareDir, comp.File), nil
 }
 
+// Verify verifies the detached signature of the file pkg was loaded from and the checksum of the firmware file
+// of componentName. It returns the identity of the signer, or fwverify.Unsigned if v accepted the package
+// without a verified signature.
+func (r *Registry) Verify(v *fwverify.Verifier, pkg *FirmwarePackage, componentName string) (string, error) {
+	r.mu.RLock()
+	m, ok := r.manifests[pkg.Version]
+	packagesDir := r.packagesDir
+	r.mu.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("firmware bundle version %q not found", pkg.Version)
+	}
+
+	identity, err := v.VerifyManifest(os.DirFS(packagesDir), m.name, m.data)
+	if err != nil {
+		return "", fmt.Errorf("bundle %s signature: %w", pkg.Version, err)
+	}
+
+	comp := pkg.GetComponent(componentName)
+	if comp == nil {
+		return "", fmt.Errorf("component %q not found in package %s", componentName, pkg.Version)
+	}
+	checksum, err := fwverify.ParseChecksum(comp.Checksum)
+	if err != nil {
+		return "", fmt.Errorf("component %s: %w", componentName, err)
+	}
+
+	f, err := os.Open(filepath.Join(r.firmwareDir, comp.File))
+	if err != nil {
+		return "", fmt.Errorf("component %s: %w", componentName, err)
+	}
+	defer f.Close()
+
+	if err := checksum.Verify(f); err != nil {
+		return "", fmt.Errorf("component %s firmware file %s: %w", componentName, comp.File, err)
+	}
+	return identity, nil
+}
+
 // Count returns the number of loaded packages.
 func (r *Registry) Count() int {
 	r.mu.RLock()
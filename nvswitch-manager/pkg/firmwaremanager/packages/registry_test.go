@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packages
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bundleTemplate = `version: "1.0.0"
+components:
+  bmc:
+    version: "1.0"
+    file: "bmc.fwpkg"
+    checksum: "CHECKSUM"
+    strategy: redfish
+`
+
+type testBundle struct {
+	registry   *Registry
+	packageDir string
+	firmware   string
+	key        ed25519.PrivateKey
+	trust      string
+}
+
+// newTestBundle writes a bundle with a single BMC component whose checksum matches its firmware file.
+func newTestBundle(t *testing.T, checksum string) *testBundle {
+	t.Helper()
+	packageDir, firmwareDir := t.TempDir(), t.TempDir()
+
+	firmware := []byte("bmc firmware")
+	require.NoError(t, os.WriteFile(filepath.Join(firmwareDir, "bmc.fwpkg"), firmware, 0o600))
+	if checksum == "" {
+		sum := sha256.Sum256(firmware)
+		checksum = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	bundle := strings.Replace(bundleTemplate, "CHECKSUM", checksum, 1)
+	require.NoError(t, os.WriteFile(filepath.Join(packageDir, "1.0.0.yaml"), []byte(bundle), 0o600))
+
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	trust := filepath.Join(t.TempDir(), "trust.pem")
+	require.NoError(t, os.WriteFile(trust, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	registry := NewRegistry(firmwareDir)
+	require.NoError(t, registry.LoadFromDirectory(packageDir))
+
+	return &testBundle{
+		registry:   registry,
+		packageDir: packageDir,
+		firmware:   filepath.Join(firmwareDir, "bmc.fwpkg"),
+		key:        key,
+		trust:      trust,
+	}
+}
+
+func (b *testBundle) sign(t *testing.T) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(b.packageDir, "1.0.0.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(b.packageDir, "1.0.0.yaml.sig"), ed25519.Sign(b.key, data), 0o600))
+}
+
+func (b *testBundle) verify(t *testing.T, conf fwverify.Config) (string, error) {
+	t.Helper()
+	v, err := fwverify.New(conf)
+	require.NoError(t, err)
+	pkg, err := b.registry.Get("1.0.0")
+	require.NoError(t, err)
+	return b.registry.Verify(v, pkg, "bmc")
+}
+
+func TestLoadRequiresChecksum(t *testing.T) {
+	testCases := map[string]string{
+		"missing":               `""`,
+		"no algorithm":          strings.Repeat("ab", 32),
+		"unsupported algorithm": "md5:" + strings.Repeat("ab", 16),
+	}
+
+	for name, checksum := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := newTestBundle(t, checksum)
+			assert.Equal(t, 0, b.registry.Count())
+		})
+	}
+}
+
+func TestRegistryVerify(t *testing.T) {
+	t.Run("signed", func(t *testing.T) {
+		b := newTestBundle(t, "")
+		b.sign(t)
+
+		signedBy, err := b.verify(t, fwverify.Config{TrustBundle: b.trust})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(signedBy, "key:SHA256:"), signedBy)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		b := newTestBundle(t, "")
+
+		_, err := b.verify(t, fwverify.Config{TrustBundle: b.trust})
+		require.ErrorIs(t, err, fwverify.ErrUnsigned)
+
+		signedBy, err := b.verify(t, fwverify.Config{TrustBundle: b.trust, AllowUnsigned: true})
+		require.NoError(t, err)
+		assert.Equal(t, fwverify.Unsigned, signedBy)
+	})
+
+	t.Run("tampered firmware", func(t *testing.T) {
+		b := newTestBundle(t, "")
+		b.sign(t)
+		require.NoError(t, os.WriteFile(b.firmware, []byte("evil firmware"), 0o600))
+
+		_, err := b.verify(t, fwverify.Config{TrustBundle: b.trust})
+		require.ErrorIs(t, err, fwverify.ErrChecksumMismatch)
+	})
+
+	t.Run("manifest changed after signing", func(t *testing.T) {
+		b := newTestBundle(t, "")
+		require.NoError(t, os.WriteFile(filepath.Join(b.packageDir, "1.0.0.yaml.sig"), ed25519.Sign(b.key, []byte("other")), 0o600))
+
+		_, err := b.verify(t, fwverify.Config{TrustBundle: b.trust})
+		require.ErrorIs(t, err, fwverify.ErrUntrusted)
+	})
+}
@@ -18,6 +18,8 @@
 // Package packages provides firmware package definition and loading.
 package packages
 
+import "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
+
 // FirmwarePackage represents a firmware bundle defined in YAML.
 // The Version field serves as the unique identifier for the bundle.
 type FirmwarePackage struct {
@@ -48,8 +50,9 @@ type ComponentDef struct {
 	// File is the relative path to the firmware file within the firmware directory
 	File string `yaml:"file"`
 
-	// Checksum for integrity verification (optional, format: "sha256:abc123...")
-	Checksum string `yaml:"checksum,omitempty"`
+	// Checksum of the firmware file with its algorithm (required, format: "sha256:abc123...").
+	// Supported algorithms: sha256, sha384, sha512
+	Checksum string `yaml:"checksum"`
 
 	// Strategy specifies how this component is updated: "redfish", "ssh", or "script"
 	Strategy string `yaml:"strategy"`
@@ -167,6 +170,12 @@ func (p *FirmwarePackage) Validate() error {
 		if comp.File == "" {
 			return &ValidationError{Field: "components." + name + ".file", Message: "file is required"}
 		}
+		if comp.Checksum == "" {
+			return &ValidationError{Field: "components." + name + ".checksum", Message: "checksum is required"}
+		}
+		if _, err := fwverify.ParseChecksum(comp.Checksum); err != nil {
+			return &ValidationError{Field: "components." + name + ".checksum", Message: err.Error()}
+		}
 		if comp.Strategy == "" {
 			return &ValidationError{Field: "components." + name + ".strategy", Message: "strategy is required"}
 		}
@@ -58,6 +58,7 @@ type FirmwareUpdateModel struct {
 	VersionActual string             `bun:"version_actual"`
 	TaskURI       string             `bun:"task_uri"`
 	ErrorMessage  string             `bun:"error_message"`
+	SignedBy      string             `bun:"signed_by"`
 	// Sequencing fields for multi-component updates
 	BundleUpdateID *uuid.UUID `bun:"bundle_update_id,type:uuid"`
 	SequenceOrder  int        `bun:"sequence_order"`
@@ -84,6 +85,7 @@ func toModel(fu *FirmwareUpdate) *FirmwareUpdateModel {
 		VersionActual:  fu.VersionActual,
 		TaskURI:        fu.TaskURI,
 		ErrorMessage:   fu.ErrorMessage,
+		SignedBy:       fu.SignedBy,
 		BundleUpdateID: fu.BundleUpdateID,
 		SequenceOrder:  fu.SequenceOrder,
 		PredecessorID:  fu.PredecessorID,
@@ -108,6 +110,7 @@ func fromModel(m *FirmwareUpdateModel) *FirmwareUpdate {
 		VersionActual:  m.VersionActual,
 		TaskURI:        m.TaskURI,
 		ErrorMessage:   m.ErrorMessage,
+		SignedBy:       m.SignedBy,
 		BundleUpdateID: m.BundleUpdateID,
 		SequenceOrder:  m.SequenceOrder,
 		PredecessorID:  m.PredecessorID,
@@ -131,6 +134,7 @@ func (s *PostgresUpdateStore) Save(ctx context.Context, update *FirmwareUpdate)
 		Set("version_actual = EXCLUDED.version_actual").
 		Set("task_uri = EXCLUDED.task_uri").
 		Set("error_message = EXCLUDED.error_message").
+		Set("signed_by = EXCLUDED.signed_by").
 		Set("exec_context = EXCLUDED.exec_context").
 		Set("last_checked_at = EXCLUDED.last_checked_at").
 		Set("updated_at = EXCLUDED.updated_at").
@@ -23,18 +23,31 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager/packages"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/redfish"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
 
 // Ensure RedfishStrategy implements UpdateStrategy.
 var _ UpdateStrategy = (*RedfishStrategy)(nil)
 
-// Default timeout for connection retries during upload phase.
-const defaultUploadRetryTimeout = 10 * time.Minute
+const (
+	// Default timeout for connection retries during upload phase.
+	defaultUploadRetryTimeout = 10 * time.Minute
+
+	// taskEventPollInterval is how often a task whose progress is reported through events is still
+	// polled, in case an event was lost.
+	taskEventPollInterval = 5 * time.Minute
+)
+
+// TaskEventSource reports the state of Redfish tasks received through BMC events.
+type TaskEventSource interface {
+	TaskStatus(id uuid.UUID, taskURI string) (eventmanager.TaskStatus, bool)
+}
 
 // RedfishStrategy implements firmware updates via Redfish API.
 // Used for BMC/BIOS firmware updates.
@@ -42,7 +55,8 @@ const defaultUploadRetryTimeout = 10 * time.Minute
 // Steps: UPLOAD -> POLL_COMPLETION -> VERIFY
 type RedfishStrategy struct {
 	config       *packages.RedfishConfig
-	firmwarePath string // Set before each update
+	firmwarePath string          // Set before each update
+	taskEvents   TaskEventSource // Task states from BMC events (nil = poll only)
 }
 
 // NewRedfishStrategy creates a new Redfish update strategy.
@@ -67,6 +81,13 @@ func (s *RedfishStrategy) SetFirmwarePath(path string) {
 	s.firmwarePath = path
 }
 
+// SetTaskEventSource sets the source of task states received through BMC
+// events. While a task's events are received, the task is polled only every
+// taskEventPollInterval; without a source it is polled every interval.
+func (s *RedfishStrategy) SetTaskEventSource(src TaskEventSource) {
+	s.taskEvents = src
+}
+
 // Name returns the strategy type.
 func (s *RedfishStrategy) Name() Strategy {
 	return StrategyRedfish
@@ -186,6 +207,19 @@ func (s *RedfishStrategy) executePollCompletion(ctx context.Context, update *Fir
 			time.Since(execCtx.StartedAt).Round(time.Second)))
 	}
 
+	// Prefer the task state reported through BMC events and poll only as a fallback
+	if s.taskEvents != nil {
+		if status, ok := s.taskEvents.TaskStatus(tray.UUID, update.TaskURI); ok {
+			log.Debugf("[%s] Task state from events: %s, progress: %d%%", update.ID, status.State, status.PercentComplete)
+			if isTerminalTaskState(status.State) {
+				return s.taskOutcome(update, status.State, execCtx)
+			}
+			if execCtx.LastPolledAt != nil && time.Since(*execCtx.LastPolledAt) < taskEventPollInterval {
+				return Wait(execCtx)
+			}
+		}
+	}
+
 	// Create Redfish client
 	client, err := redfish.New(ctx, tray.BMC, true)
 	if err != nil {
@@ -197,6 +231,9 @@ func (s *RedfishStrategy) executePollCompletion(ctx context.Context, update *Fir
 	}
 	defer client.Logout()
 
+	polledAt := time.Now()
+	execCtx.LastPolledAt = &polledAt
+
 	state, percentComplete, err := client.GetTaskStatus(update.TaskURI)
 	if err != nil {
 		// Treat all polling errors as transient - the task may still be running
@@ -206,6 +243,21 @@ func (s *RedfishStrategy) executePollCompletion(ctx context.Context, update *Fir
 
 	log.Debugf("[%s] Task state: %s, progress: %d%%", update.ID, state, percentComplete)
 
+	return s.taskOutcome(update, state, execCtx)
+}
+
+// isTerminalTaskState reports whether a Redfish task in the given state has finished.
+func isTerminalTaskState(state string) bool {
+	switch state {
+	case "Completed", "Exception", "Killed", "Cancelled":
+		return true
+	default:
+		return false
+	}
+}
+
+// taskOutcome maps a Redfish task state to the outcome of the poll step.
+func (s *RedfishStrategy) taskOutcome(update *FirmwareUpdate, state string, execCtx *ExecContext) StepOutcome {
 	switch state {
 	case "Completed":
 		log.Infof("[%s] Redfish task completed successfully", update.ID)
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firmwaremanager
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/eventmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/bmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runRedfishStrategy drives the Redfish strategy state machine against an emulated BMC until it reaches
+// a terminal state, returning the final outcome.
+func runRedfishStrategy(t *testing.T, cfg emulator.Config, update *FirmwareUpdate) StepOutcome {
+	server := httptest.NewTLSServer(emulator.New(cfg))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	cred := credential.New(emulator.DefaultUsername, emulator.DefaultPassword)
+	b, err := bmc.NewFromAddr(net.HardwareAddr{0, 1, 2, 3, 4, 5}, net.ParseIP(u.Hostname()), cred)
+	require.NoError(t, err)
+	b.SetPort(port)
+	tray := &nvswitch.NVSwitchTray{UUID: uuid.New(), BMC: b}
+
+	firmwarePath := filepath.Join(t.TempDir(), "bmc.fwpkg")
+	require.NoError(t, os.WriteFile(firmwarePath, []byte("firmware image"), 0o600))
+
+	strategy := NewRedfishStrategy(nil)
+	strategy.SetFirmwarePath(firmwarePath)
+
+	update.State = strategy.Steps(update)[0]
+	for i := 0; i < 10; i++ {
+		outcome := strategy.ExecuteStep(context.Background(), update, tray)
+		switch outcome.Type {
+		case OutcomeWait:
+			update.ExecContext = outcome.ExecContext
+		case OutcomeTransition:
+			update.ExecContext = nil
+			update.State = outcome.NextState
+			if update.State == StateCompleted {
+				return outcome
+			}
+		default:
+			return outcome
+		}
+	}
+
+	t.Fatalf("strategy did not reach a terminal state, stuck in %s", update.State)
+	return StepOutcome{}
+}
+
+func TestRedfishStrategyWithEmulator(t *testing.T) {
+	t.Run("update completes and verifies", func(t *testing.T) {
+		update := &FirmwareUpdate{ID: uuid.New(), Component: nvswitch.BMC, Strategy: StrategyRedfish, VersionTo: "88.0002.1000"}
+
+		outcome := runRedfishStrategy(t, emulator.Config{Profile: emulator.NVSwitchBMC, UpdatedFirmwareVersion: "88.0002.1000"}, update)
+
+		assert.Equal(t, OutcomeTransition, outcome.Type)
+		assert.Equal(t, StateCompleted, update.State)
+		assert.NotEmpty(t, update.TaskURI)
+		assert.Equal(t, "88.0002.1000", update.VersionActual)
+	})
+
+	t.Run("failed task fails the update", func(t *testing.T) {
+		update := &FirmwareUpdate{ID: uuid.New(), Component: nvswitch.BMC, Strategy: StrategyRedfish, VersionTo: "88.0002.1000"}
+
+		outcome := runRedfishStrategy(t, emulator.Config{Profile: emulator.NVSwitchBMC, FailTasks: true}, update)
+
+		assert.Equal(t, OutcomeFailed, outcome.Type)
+		assert.Equal(t, StatePollCompletion, update.State)
+		assert.ErrorContains(t, outcome.Error, emulator.TaskStateException)
+	})
+}
+
+// fakeTaskEvents reports a fixed task state for every task.
+type fakeTaskEvents struct {
+	status *eventmanager.TaskStatus
+}
+
+func (f *fakeTaskEvents) TaskStatus(uuid.UUID, string) (eventmanager.TaskStatus, bool) {
+	if f.status == nil {
+		return eventmanager.TaskStatus{}, false
+	}
+	return *f.status, true
+}
+
+func TestRedfishStrategyTaskEvents(t *testing.T) {
+	// The BMC is unreachable, so any outcome other than Wait comes from the task events.
+	b, err := bmc.NewFromAddr(net.HardwareAddr{0, 1, 2, 3, 4, 5}, net.ParseIP("127.0.0.1"), credential.New("root", "password"))
+	require.NoError(t, err)
+	b.SetPort(1)
+	tray := &nvswitch.NVSwitchTray{UUID: uuid.New(), BMC: b}
+
+	recently := time.Now().Add(-time.Second)
+	testCases := map[string]struct {
+		state        string
+		lastPolledAt *time.Time
+		expected     OutcomeType
+	}{
+		"completed task transitions to verify": {state: "Completed", expected: OutcomeTransition},
+		"aborted task fails the update":        {state: "Exception", expected: OutcomeFailed},
+		"running task is not polled again":     {state: "Running", lastPolledAt: &recently, expected: OutcomeWait},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			strategy := NewRedfishStrategy(nil)
+			strategy.SetTaskEventSource(&fakeTaskEvents{status: &eventmanager.TaskStatus{State: tc.state}})
+
+			update := &FirmwareUpdate{
+				ID:       uuid.New(),
+				State:    StatePollCompletion,
+				TaskURI:  "/redfish/v1/TaskService/Tasks/1",
+				Strategy: StrategyRedfish,
+				ExecContext: &ExecContext{
+					StartedAt:    time.Now(),
+					DeadlineAt:   time.Now().Add(time.Minute),
+					LastPolledAt: tc.lastPolledAt,
+				},
+			}
+
+			outcome := strategy.ExecuteStep(context.Background(), update, tray)
+			assert.Equal(t, tc.expected, outcome.Type)
+			if tc.expected == OutcomeTransition {
+				assert.Equal(t, StateVerify, outcome.NextState)
+			}
+			if tc.expected == OutcomeWait {
+				assert.Same(t, tc.lastPolledAt, outcome.ExecContext.LastPolledAt)
+			}
+		})
+	}
+}
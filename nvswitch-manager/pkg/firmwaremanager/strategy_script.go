@@ -41,9 +41,10 @@ var _ UpdateStrategy = (*ScriptStrategy)(nil)
 // Steps: INSTALL -> VERIFY
 type ScriptStrategy struct {
 	config       *packages.ScriptConfig
-	firmwarePath string   // Set before each update
-	scriptName   string   // Script name from component definition
-	scriptArgs   []string // Argument tokens from YAML (e.g., ["nvos_ip", "nvos_user", "nvos_password", "fw_file"])
+	firmwarePath string        // Set before each update
+	scriptName   string        // Script name from component definition
+	scriptArgs   []string      // Argument tokens from YAML (e.g., ["nvos_ip", "nvos_user", "nvos_password", "fw_file"])
+	pinner       HostKeyPinner // Used for SSH version queries
 }
 
 // NewScriptStrategy creates a new script update strategy.
@@ -68,6 +69,12 @@ func (s *ScriptStrategy) SetFirmwarePath(path string) {
 	s.firmwarePath = path
 }
 
+// SetHostKeyPinner sets the pinner used by SSH version queries for switches
+// without a pinned NVOS host key.
+func (s *ScriptStrategy) SetHostKeyPinner(p HostKeyPinner) {
+	s.pinner = p
+}
+
 // SetScriptName sets the script name to execute (from component definition).
 func (s *ScriptStrategy) SetScriptName(name string) {
 	s.scriptName = name
@@ -338,6 +345,7 @@ func (s *ScriptStrategy) GetCurrentVersion(ctx context.Context, tray *nvswitch.N
 	case nvswitch.CPLD, nvswitch.NVOS:
 		// Use SSH for CPLD and NVOS
 		sshStrategy := NewSSHStrategy(nil)
+		sshStrategy.SetHostKeyPinner(s.pinner)
 		return sshStrategy.GetCurrentVersion(ctx, tray, component)
 	default:
 		return "", fmt.Errorf("unsupported component: %s", component)
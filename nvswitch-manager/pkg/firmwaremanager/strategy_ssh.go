@@ -32,6 +32,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/sshclient"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -50,7 +51,14 @@ var _ UpdateStrategy = (*SSHStrategy)(nil)
 // NVOS flow (standalone): WAIT_REACHABLE -> COPY -> UPLOAD -> INSTALL -> VERIFY -> CLEANUP
 type SSHStrategy struct {
 	config       *packages.SSHConfig
-	firmwarePath string // Set before each update
+	firmwarePath string        // Set before each update
+	pinner       HostKeyPinner // Pins NVOS host keys on first use (nil = pinned keys only)
+}
+
+// HostKeyPinner provides the recorder used to pin a switch's NVOS host key on
+// its first SSH connection.
+type HostKeyPinner interface {
+	HostKeyRecorder(ctx context.Context, id uuid.UUID) sshclient.HostKeyRecorder
 }
 
 // NewSSHStrategy creates a new SSH update strategy.
@@ -81,6 +89,22 @@ func (s *SSHStrategy) SetFirmwarePath(path string) {
 	s.firmwarePath = path
 }
 
+// SetHostKeyPinner sets the pinner used for switches without a pinned NVOS
+// host key. Without one, such switches are refused.
+func (s *SSHStrategy) SetHostKeyPinner(p HostKeyPinner) {
+	s.pinner = p
+}
+
+// connect opens an SSH connection to the tray's NVOS, verifying its pinned
+// host key or pinning it on first use.
+func (s *SSHStrategy) connect(ctx context.Context, tray *nvswitch.NVSwitchTray) (*sshclient.NVOSClient, error) {
+	var opts []sshclient.Option
+	if s.pinner != nil {
+		opts = append(opts, sshclient.WithHostKeyRecorder(s.pinner.HostKeyRecorder(ctx, tray.UUID)))
+	}
+	return sshclient.New(ctx, tray.NVOS, opts...)
+}
+
 // Name returns the strategy type.
 func (s *SSHStrategy) Name() Strategy {
 	return StrategySSH
@@ -259,13 +283,16 @@ func (s *SSHStrategy) executeCopy(ctx context.Context, update *FirmwareUpdate, t
 		return Failed(fmt.Errorf("firmware file not found: %w", err))
 	}
 
-	// Check sshpass is available for async SCP
-	if !isSshpassAvailable() {
-		return Failed(fmt.Errorf("sshpass not found in PATH: required for async SCP operations"))
+	// scp verifies the host key against the pinned one, so make sure a key
+	// is pinned before handing off to it.
+	if !tray.NVOS.HasHostKey() {
+		client, err := s.connect(ctx, tray)
+		if err != nil {
+			return Failed(fmt.Errorf("failed to pin NVOS host key: %w", err))
+		}
+		client.Close()
 	}
 
-	// Build SCP command
-	// Format: scp -P <port> -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null <local> <user>@<host>:<remote>
 	fileName := filepath.Base(s.firmwarePath)
 	remotePath := filepath.Join(s.config.RemoteDir, fileName)
 	targetAddr := fmt.Sprintf("%s@%s:%s",
@@ -274,21 +301,13 @@ func (s *SSHStrategy) executeCopy(ctx context.Context, update *FirmwareUpdate, t
 		remotePath,
 	)
 
-	// Get the port from NVOS config (uses custom port if set, otherwise default 22)
-	port := fmt.Sprintf("%d", tray.NVOS.GetPort())
-
-	cmd := exec.Command("sshpass", "-e",
-		"scp",
-		"-P", port,
-		"-o", "StrictHostKeyChecking=no",
-		"-o", "UserKnownHostsFile=/dev/null",
-		"-o", "ConnectTimeout=30",
-		s.firmwarePath,
-		targetAddr,
-	)
-	cmd.Env = append(os.Environ(), "SSHPASS="+tray.NVOS.Credential.Password.Value)
+	cmd, scpDir, err := s.buildSCPCommand(tray, targetAddr)
+	if err != nil {
+		return Failed(err)
+	}
 
 	if err := cmd.Start(); err != nil {
+		os.RemoveAll(scpDir)
 		return Failed(fmt.Errorf("failed to start SCP: %w", err))
 	}
 
@@ -302,14 +321,92 @@ func (s *SSHStrategy) executeCopy(ctx context.Context, update *FirmwareUpdate, t
 		StartedAt:  time.Now(),
 		DeadlineAt: time.Now().Add(scpTimeout),
 		PID:        pid,
+		SCPDir:     scpDir,
 		TargetIP:   tray.NVOS.IP.String(),
 	}
 
 	return Wait(execCtx)
 }
 
-// handleSCPWait checks if the async SCP process has completed.
+// buildSCPCommand builds the scp command copying the firmware to targetAddr.
+// The switch must present its pinned host key. Key credentials are used as the
+// scp identity; otherwise the password is passed through sshpass. The returned
+// directory holds the known_hosts and identity files and must be removed once
+// scp has exited.
+func (s *SSHStrategy) buildSCPCommand(tray *nvswitch.NVSwitchTray, targetAddr string) (*exec.Cmd, string, error) {
+	port := tray.NVOS.GetPort()
+
+	knownHosts, err := sshclient.KnownHostsLine(tray.NVOS, port)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid pinned NVOS host key: %w", err)
+	}
+
+	useKey := tray.NVOS.Credential.HasPrivateKey()
+	if !useKey && !isSshpassAvailable() {
+		return nil, "", fmt.Errorf("sshpass not found in PATH: required for async SCP operations")
+	}
+
+	scpDir, err := os.MkdirTemp("", "nvswitch-scp-")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create SCP directory: %w", err)
+	}
+
+	knownHostsPath := filepath.Join(scpDir, "known_hosts")
+	if err := os.WriteFile(knownHostsPath, []byte(knownHosts+"\n"), 0o600); err != nil {
+		os.RemoveAll(scpDir)
+		return nil, "", fmt.Errorf("failed to write known_hosts: %w", err)
+	}
+
+	args := []string{
+		"-P", fmt.Sprintf("%d", port),
+		"-o", "StrictHostKeyChecking=yes",
+		"-o", "UserKnownHostsFile=" + knownHostsPath,
+		"-o", "GlobalKnownHostsFile=/dev/null",
+		"-o", "ConnectTimeout=30",
+	}
+
+	if useKey {
+		keyPath := filepath.Join(scpDir, "id")
+		key := tray.NVOS.Credential.PrivateKey.Value
+		if !strings.HasSuffix(key, "\n") {
+			key += "\n"
+		}
+		if err := os.WriteFile(keyPath, []byte(key), 0o600); err != nil {
+			os.RemoveAll(scpDir)
+			return nil, "", fmt.Errorf("failed to write identity file: %w", err)
+		}
+
+		args = append(args,
+			"-i", keyPath,
+			"-o", "IdentitiesOnly=yes",
+			"-o", "BatchMode=yes",
+			s.firmwarePath,
+			targetAddr,
+		)
+		return exec.Command("scp", args...), scpDir, nil
+	}
+
+	args = append(args, s.firmwarePath, targetAddr)
+	cmd := exec.Command("sshpass", append([]string{"-e", "scp"}, args...)...)
+	cmd.Env = append(os.Environ(), "SSHPASS="+tray.NVOS.Credential.Password.Value)
+
+	return cmd, scpDir, nil
+}
+
+// handleSCPWait checks if the async SCP process has completed and removes its
+// files once it has.
 func (s *SSHStrategy) handleSCPWait(ctx context.Context, update *FirmwareUpdate, tray *nvswitch.NVSwitchTray, execCtx *ExecContext) StepOutcome {
+	outcome := s.pollSCP(ctx, update, tray, execCtx)
+	if outcome.Type != OutcomeWait && execCtx.SCPDir != "" {
+		if err := os.RemoveAll(execCtx.SCPDir); err != nil {
+			log.Warnf("[%s] Failed to remove SCP directory %s: %v", update.ID, execCtx.SCPDir, err)
+		}
+	}
+	return outcome
+}
+
+// pollSCP checks if the async SCP process has completed.
+func (s *SSHStrategy) pollSCP(ctx context.Context, update *FirmwareUpdate, tray *nvswitch.NVSwitchTray, execCtx *ExecContext) StepOutcome {
 	// Check for timeout
 	if time.Now().After(execCtx.DeadlineAt) {
 		// Try to kill the SCP process
@@ -358,7 +455,7 @@ func (s *SSHStrategy) handleSCPWait(ctx context.Context, update *FirmwareUpdate,
 
 // verifySCPComplete verifies the file was copied successfully.
 func (s *SSHStrategy) verifySCPComplete(ctx context.Context, update *FirmwareUpdate, tray *nvswitch.NVSwitchTray) StepOutcome {
-	client, err := sshclient.New(ctx, tray.NVOS)
+	client, err := s.connect(ctx, tray)
 	if err != nil {
 		return Failed(fmt.Errorf("failed to create SSH client for verification: %w", err))
 	}
@@ -384,7 +481,7 @@ func (s *SSHStrategy) verifySCPComplete(ctx context.Context, update *FirmwareUpd
 func (s *SSHStrategy) executeUpload(ctx context.Context, update *FirmwareUpdate, tray *nvswitch.NVSwitchTray) StepOutcome {
 	log.Infof("[%s] Fetching firmware into NVOS", update.ID)
 
-	client, err := sshclient.New(ctx, tray.NVOS)
+	client, err := s.connect(ctx, tray)
 	if err != nil {
 		return Failed(fmt.Errorf("failed to create SSH client: %w", err))
 	}
@@ -427,7 +524,7 @@ func (s *SSHStrategy) executeInstall(ctx context.Context, update *FirmwareUpdate
 
 	log.Infof("[%s] Installing firmware", update.ID)
 
-	client, err := sshclient.New(ctx, tray.NVOS)
+	client, err := s.connect(ctx, tray)
 	if err != nil {
 		return Failed(fmt.Errorf("failed to create SSH client: %w", err))
 	}
@@ -526,7 +623,7 @@ func (s *SSHStrategy) executeVerify(ctx context.Context, update *FirmwareUpdate,
 func (s *SSHStrategy) executeCleanup(ctx context.Context, update *FirmwareUpdate, tray *nvswitch.NVSwitchTray) StepOutcome {
 	log.Infof("[%s] Cleaning up temporary files", update.ID)
 
-	client, err := sshclient.New(ctx, tray.NVOS)
+	client, err := s.connect(ctx, tray)
 	if err != nil {
 		// Non-fatal: cleanup is best-effort
 		log.Warnf("[%s] Failed to create SSH client for cleanup: %v", update.ID, err)
@@ -569,7 +666,7 @@ func (s *SSHStrategy) executeCleanup(ctx context.Context, update *FirmwareUpdate
 
 // GetCurrentVersion queries the current firmware version via SSH.
 func (s *SSHStrategy) GetCurrentVersion(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component) (string, error) {
-	client, err := sshclient.New(ctx, tray.NVOS)
+	client, err := s.connect(ctx, tray)
 	if err != nil {
 		return "", fmt.Errorf("failed to create SSH client: %w", err)
 	}
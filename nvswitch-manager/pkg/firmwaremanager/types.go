@@ -126,9 +126,16 @@ type ExecContext struct {
 	// TaskURI is the Redfish task URI for polling (Redfish strategy).
 	TaskURI string `json:"task_uri,omitempty"`
 
+	// LastPolledAt is when the Redfish task was last polled (Redfish strategy).
+	LastPolledAt *time.Time `json:"last_polled_at,omitempty"`
+
 	// PID is the process ID for script/SCP operations (Script/SSH strategy).
 	PID int `json:"pid,omitempty"`
 
+	// SCPDir is the private directory holding the known_hosts and identity
+	// files of a running SCP process (SSH strategy). Removed when SCP ends.
+	SCPDir string `json:"scp_dir,omitempty"`
+
 	// TargetIP is the IP address being monitored for reachability checks.
 	TargetIP string `json:"target_ip,omitempty"`
 
@@ -211,6 +218,10 @@ type FirmwareUpdate struct {
 	// Error information
 	ErrorMessage string `json:"error_message,omitempty"`
 
+	// Identity that signed the bundle manifest, recorded once the bundle signature
+	// and firmware checksum are verified ("unsigned" if unsigned firmware is allowed)
+	SignedBy string `json:"signed_by,omitempty"`
+
 	// Sequencing fields for multi-component updates
 	BundleUpdateID *uuid.UUID `json:"bundle_update_id,omitempty"` // Groups related updates
 	SequenceOrder  int        `json:"sequence_order"`             // Order within bundle (1, 2, 3...)
@@ -24,6 +24,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager/packages"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchmanager"
 
@@ -58,6 +59,8 @@ type WorkerPool struct {
 	store             UpdateStore
 	nsmgr             *nvswitchmanager.NVSwitchManager
 	packages          *packages.Registry
+	verifier          *fwverify.Verifier
+	taskEvents        TaskEventSource // Optional; Redfish task states from BMC events
 
 	// Work dispatch channel - scheduler sends, workers receive
 	workChan chan WorkItem
@@ -80,6 +83,7 @@ func NewWorkerPool(
 	store UpdateStore,
 	nsmgr *nvswitchmanager.NVSwitchManager,
 	packages *packages.Registry,
+	verifier *fwverify.Verifier,
 ) *WorkerPool {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -96,6 +100,7 @@ func NewWorkerPool(
 		store:             store,
 		nsmgr:             nsmgr,
 		packages:          packages,
+		verifier:          verifier,
 		workChan:          make(chan WorkItem, numWorkers),
 		activeJobs:        make(map[uuid.UUID]context.CancelFunc),
 		ctx:               ctx,
@@ -295,6 +300,21 @@ func (p *WorkerPool) processUpdate(workerID int, update *FirmwareUpdate) {
 		return
 	}
 
+	// Verify the bundle signature and firmware checksum before the first step touches the switch
+	if update.SignedBy == "" {
+		signedBy, err := p.packages.Verify(p.verifier, pkg, componentName)
+		if err != nil {
+			p.failUpdate(ctx, update, fmt.Sprintf("firmware verification failed: %v", err))
+			return
+		}
+		log.Infof("Worker %d: [%s] Verified bundle %s %s firmware (signed by %s)", workerID, update.ID, pkg.Version, componentName, signedBy)
+		update.SignedBy = signedBy
+		if err := p.store.Save(ctx, update); err != nil {
+			log.Errorf("Worker %d: [%s] Failed to persist verified signer: %v", workerID, update.ID, err)
+			return
+		}
+	}
+
 	// Create strategy
 	strategy := p.createStrategy(update.Strategy, pkg, firmwarePath, compDef.Script, compDef.ScriptArgs)
 	if strategy == nil {
@@ -408,16 +428,19 @@ func (p *WorkerPool) createStrategy(strategyType Strategy, pkg *packages.Firmwar
 	case StrategyRedfish:
 		s := NewRedfishStrategy(pkg.StrategyConfig.Redfish)
 		s.SetFirmwarePath(firmwarePath)
+		s.SetTaskEventSource(p.taskEvents)
 		strategy = s
 	case StrategySSH:
 		s := NewSSHStrategy(pkg.StrategyConfig.SSH)
 		s.SetFirmwarePath(firmwarePath)
+		s.SetHostKeyPinner(p.hostKeyPinner())
 		strategy = s
 	case StrategyScript:
 		s := NewScriptStrategy(pkg.StrategyConfig.Script)
 		s.SetFirmwarePath(firmwarePath)
 		s.SetScriptName(scriptName)
 		s.SetScriptArgs(scriptArgs)
+		s.SetHostKeyPinner(p.hostKeyPinner())
 		strategy = s
 	default:
 		return nil
@@ -426,6 +449,15 @@ func (p *WorkerPool) createStrategy(strategyType Strategy, pkg *packages.Firmwar
 	return strategy
 }
 
+// hostKeyPinner returns the NV-Switch manager as the NVOS host key pinner, or
+// nil if there is none.
+func (p *WorkerPool) hostKeyPinner() HostKeyPinner {
+	if p.nsmgr == nil {
+		return nil
+	}
+	return p.nsmgr
+}
+
 // failUpdate marks an update as failed and persists the state.
 func (p *WorkerPool) failUpdate(ctx context.Context, update *FirmwareUpdate, reason string) {
 	log.Errorf("Update %s failed: %s", update.ID, reason)
@@ -21,12 +21,17 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/secretstring"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/credentials"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/nvswitchregistry"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/redfish"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/sshclient"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
 )
 
 // NVSwitchManager coordinates registry and credential management for NV-Switch trays.
@@ -156,3 +161,108 @@ func (nm *NVSwitchManager) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nm.Registry.Delete(ctx, id)
 }
+
+// HostKeyRecorder returns a recorder that pins the NVOS host key of the given
+// switch the first time it is seen.
+func (nm *NVSwitchManager) HostKeyRecorder(ctx context.Context, id uuid.UUID) sshclient.HostKeyRecorder {
+	return func(key ssh.PublicKey) error {
+		if err := nm.Registry.PinNVOSHostKey(ctx, id, sshclient.FormatHostKey(key)); err != nil {
+			return err
+		}
+		log.Infof("Pinned NVOS host key %s for switch %s", ssh.FingerprintSHA256(key), id)
+		return nil
+	}
+}
+
+// RekeyNVOS replaces the pinned NVOS host key of a switch, e.g. after the
+// switch was legitimately replaced. An empty hostKey clears the pin so that the
+// next connection pins whatever key the switch presents.
+func (nm *NVSwitchManager) RekeyNVOS(ctx context.Context, id uuid.UUID, hostKey string) error {
+	if hostKey != "" {
+		key, err := sshclient.ParseHostKey(hostKey)
+		if err != nil {
+			return fmt.Errorf("invalid host key: %v", err)
+		}
+		hostKey = sshclient.FormatHostKey(key)
+	}
+
+	if err := nm.Registry.SetNVOSHostKey(ctx, id, hostKey); err != nil {
+		return err
+	}
+
+	if hostKey == "" {
+		log.Infof("Cleared NVOS host key for switch %s; next connection pins a new one", id)
+	} else {
+		log.Infof("Re-keyed NVOS host key for switch %s", id)
+	}
+
+	return nil
+}
+
+// ChangePassword logs in to the BMC or NVOS of a switch with cred and sets
+// the password of cred's user.
+func (nm *NVSwitchManager) ChangePassword(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, cred *credential.Credential, newPassword string) error {
+	switch component {
+	case nvswitch.BMC:
+		b := *tray.BMC
+		b.SetCredential(cred)
+		client, err := redfish.New(ctx, &b, false)
+		if err != nil {
+			return fmt.Errorf("failed to create Redfish client: %w", err)
+		}
+		defer client.Logout()
+		return client.ChangePassword(newPassword)
+	case nvswitch.NVOS:
+		n := *tray.NVOS
+		n.SetCredential(cred)
+		client, err := sshclient.New(ctx, &n, sshclient.WithHostKeyRecorder(nm.HostKeyRecorder(ctx, tray.UUID)))
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		return client.ChangePassword(cred.User, newPassword)
+	default:
+		return fmt.Errorf("cannot change the password of component %s", component)
+	}
+}
+
+// VerifyLogin logs in to the BMC or NVOS of a switch with the user and
+// password of cred. An NVOS SSH private key is ignored so that the password
+// itself is verified.
+func (nm *NVSwitchManager) VerifyLogin(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, cred *credential.Credential) error {
+	switch component {
+	case nvswitch.BMC:
+		b := *tray.BMC
+		b.SetCredential(cred)
+		client, err := redfish.New(ctx, &b, false)
+		if err != nil {
+			return err
+		}
+		client.Logout()
+		return nil
+	case nvswitch.NVOS:
+		passwordOnly := *cred
+		passwordOnly.PrivateKey = secretstring.SecretString{}
+		n := *tray.NVOS
+		n.SetCredential(&passwordOnly)
+		client, err := sshclient.New(ctx, &n, sshclient.WithHostKeyRecorder(nm.HostKeyRecorder(ctx, tray.UUID)))
+		if err != nil {
+			return err
+		}
+		return client.Close()
+	default:
+		return fmt.Errorf("cannot log in to component %s", component)
+	}
+}
+
+// StoreCredential replaces the stored BMC or NVOS credential of a switch.
+func (nm *NVSwitchManager) StoreCredential(ctx context.Context, tray *nvswitch.NVSwitchTray, component nvswitch.Component, cred *credential.Credential) error {
+	switch component {
+	case nvswitch.BMC:
+		return nm.CredentialManager.PutBMC(ctx, tray.BMC.MAC, cred)
+	case nvswitch.NVOS:
+		return nm.CredentialManager.PutNVOS(ctx, tray.BMC.MAC, cred)
+	default:
+		return fmt.Errorf("cannot store the credential of component %s", component)
+	}
+}
@@ -19,6 +19,8 @@ package nvswitchmanager
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"testing"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
@@ -27,10 +29,12 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/bmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvos"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/sshclient"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 )
 
 func newTestManager() *NVSwitchManager {
@@ -214,3 +218,109 @@ func TestNVSwitchManager_Get_NilNVOS(t *testing.T) {
 	assert.Nil(t, got)
 	assert.Contains(t, err.Error(), "no NVOS subsystem")
 }
+
+func newTestHostKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return key
+}
+
+func TestNVSwitchManager_HostKeyRecorder(t *testing.T) {
+	nm := newTestManager()
+	ctx := context.Background()
+
+	tray := newTestTray(t)
+	_, _, err := nm.Registry.Register(ctx, tray)
+	require.NoError(t, err)
+
+	first := newTestHostKey(t)
+	record := nm.HostKeyRecorder(ctx, tray.UUID)
+
+	require.NoError(t, record(first))
+	got, err := nm.Registry.Get(ctx, tray.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, sshclient.FormatHostKey(first), got.NVOS.GetHostKey())
+
+	// Recording the same key again is a no-op; a different key is refused.
+	assert.NoError(t, record(first))
+	err = record(newTestHostKey(t))
+	assert.ErrorIs(t, err, nvswitchregistry.ErrHostKeyAlreadyPinned)
+}
+
+func TestNVSwitchManager_Register_KeepsPinnedHostKey(t *testing.T) {
+	nm := newTestManager()
+	ctx := context.Background()
+
+	tray := newTestTray(t)
+	id, _, err := nm.Register(ctx, tray)
+	require.NoError(t, err)
+
+	key := newTestHostKey(t)
+	require.NoError(t, nm.HostKeyRecorder(ctx, id)(key))
+
+	// Re-registering the same BMC must not clear the pin.
+	_, isNew, err := nm.Register(ctx, newTestTray(t))
+	require.NoError(t, err)
+	assert.False(t, isNew)
+
+	got, err := nm.Registry.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, sshclient.FormatHostKey(key), got.NVOS.GetHostKey())
+}
+
+func TestNVSwitchManager_RekeyNVOS(t *testing.T) {
+	testCases := map[string]struct {
+		hostKey     func(t *testing.T) string
+		wantErr     bool
+		errContains string
+	}{
+		"rekey with a new key pins it": {
+			hostKey: func(t *testing.T) string {
+				return sshclient.FormatHostKey(newTestHostKey(t))
+			},
+		},
+		"rekey with an empty key clears the pin": {
+			hostKey: func(t *testing.T) string { return "" },
+		},
+		"rekey with an invalid key returns error": {
+			hostKey:     func(t *testing.T) string { return "not-a-key" },
+			wantErr:     true,
+			errContains: "invalid host key",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			nm := newTestManager()
+			ctx := context.Background()
+
+			tray := newTestTray(t)
+			_, _, err := nm.Registry.Register(ctx, tray)
+			require.NoError(t, err)
+			require.NoError(t, nm.HostKeyRecorder(ctx, tray.UUID)(newTestHostKey(t)))
+
+			hostKey := tc.hostKey(t)
+			err = nm.RekeyNVOS(ctx, tray.UUID, hostKey)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := nm.Registry.Get(ctx, tray.UUID)
+			require.NoError(t, err)
+			assert.Equal(t, hostKey, got.NVOS.GetHostKey())
+		})
+	}
+}
+
+func TestNVSwitchManager_RekeyNVOS_NotFound(t *testing.T) {
+	nm := newTestManager()
+
+	err := nm.RekeyNVOS(context.Background(), uuid.New(), "")
+	assert.Error(t, err)
+}
@@ -71,8 +71,12 @@ func (r *InMemoryRegistry) Register(ctx context.Context, tray *nvswitch.NVSwitch
 
 	// Check if this BMC MAC is already registered
 	if existingUUID, exists := r.byBMCMAC[bmcMAC]; exists {
-		// Update existing entry
+		// Update existing entry, keeping the pinned host key: re-registration
+		// must not bypass an explicit re-key.
 		tray.UUID = existingUUID
+		if existing := r.switches[existingUUID]; existing != nil && existing.NVOS != nil {
+			tray.NVOS.SetHostKey(existing.NVOS.GetHostKey())
+		}
 		r.switches[existingUUID] = tray
 		return existingUUID, false, nil
 	}
@@ -153,3 +157,38 @@ func (r *InMemoryRegistry) Delete(ctx context.Context, id uuid.UUID) error {
 	delete(r.switches, id)
 	return nil
 }
+
+// PinNVOSHostKey records the NVOS host key of a switch on first use.
+func (r *InMemoryRegistry) PinNVOSHostKey(ctx context.Context, id uuid.UUID, hostKey string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tray, exists := r.switches[id]
+	if !exists {
+		return fmt.Errorf("NV-Switch with UUID %s not found", id)
+	}
+
+	if tray.NVOS.HasHostKey() {
+		if tray.NVOS.GetHostKey() != hostKey {
+			return ErrHostKeyAlreadyPinned
+		}
+		return nil
+	}
+
+	tray.NVOS.SetHostKey(hostKey)
+	return nil
+}
+
+// SetNVOSHostKey replaces (or clears) the pinned NVOS host key of a switch.
+func (r *InMemoryRegistry) SetNVOSHostKey(ctx context.Context, id uuid.UUID, hostKey string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tray, exists := r.switches[id]
+	if !exists {
+		return fmt.Errorf("NV-Switch with UUID %s not found", id)
+	}
+
+	tray.NVOS.SetHostKey(hostKey)
+	return nil
+}
@@ -58,6 +58,7 @@ type NVSwitchModel struct {
 	NVOSMACAddress string    `bun:"nvos_mac_address,notnull"`
 	NVOSIPAddress  string    `bun:"nvos_ip_address,notnull"`
 	NVOSPort       int       `bun:"nvos_port,notnull,default:22"`
+	NVOSHostKey    string    `bun:"nvos_host_key,nullzero"`
 	RackID         string    `bun:"rack_id"`
 }
 
@@ -110,8 +111,11 @@ func (r *PostgresRegistry) Register(ctx context.Context, tray *nvswitch.NVSwitch
 			existing.NVOSPort = tray.NVOS.GetPort()
 			existing.RackID = tray.RackID
 
+			// The pinned host key is left untouched: re-registration must not
+			// bypass an explicit re-key.
 			_, err = tx.NewUpdate().
 				Model(existing).
+				ExcludeColumn("nvos_host_key").
 				WherePK().
 				Exec(ctx)
 			if err != nil {
@@ -241,6 +245,62 @@ func (r *PostgresRegistry) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// PinNVOSHostKey records the NVOS host key of a switch on first use. The
+// conditional update makes concurrent first connections agree on one key.
+func (r *PostgresRegistry) PinNVOSHostKey(ctx context.Context, id uuid.UUID, hostKey string) error {
+	result, err := r.db.NewUpdate().
+		Model((*NVSwitchModel)(nil)).
+		Set("nvos_host_key = ?", hostKey).
+		Where("uuid = ?", id).
+		Where("nvos_host_key IS NULL OR nvos_host_key = ?", hostKey).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to pin nvos host key: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	exists, err := r.db.NewSelect().
+		Model((*NVSwitchModel)(nil)).
+		Where("uuid = ?", id).
+		Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check nvswitch: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("NV-Switch with UUID %s not found", id)
+	}
+
+	return ErrHostKeyAlreadyPinned
+}
+
+// SetNVOSHostKey replaces (or clears) the pinned NVOS host key of a switch.
+func (r *PostgresRegistry) SetNVOSHostKey(ctx context.Context, id uuid.UUID, hostKey string) error {
+	var value any
+	if hostKey != "" {
+		value = hostKey
+	}
+
+	result, err := r.db.NewUpdate().
+		Model((*NVSwitchModel)(nil)).
+		Set("nvos_host_key = ?", value).
+		Where("uuid = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set nvos host key: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("NV-Switch with UUID %s not found", id)
+	}
+
+	return nil
+}
+
 // modelToTray converts a database model to NVSwitchTray.
 func modelToTray(m *NVSwitchModel) (*nvswitch.NVSwitchTray, error) {
 	bmcMAC, err := net.ParseMAC(m.BMCMACAddress)
@@ -274,6 +334,7 @@ func modelToTray(m *NVSwitchModel) (*nvswitch.NVSwitchTray, error) {
 		return nil, fmt.Errorf("failed to create NVOS object: %w", err)
 	}
 	nvosObj.SetPort(m.NVOSPort)
+	nvosObj.SetHostKey(m.NVOSHostKey)
 
 	return &nvswitch.NVSwitchTray{
 		UUID:   m.UUID,
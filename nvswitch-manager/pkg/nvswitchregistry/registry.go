@@ -19,12 +19,17 @@ package nvswitchregistry
 
 import (
 	"context"
+	"errors"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
 
 	"github.com/google/uuid"
 )
 
+// ErrHostKeyAlreadyPinned is returned by PinNVOSHostKey when a different host
+// key is already pinned for the switch.
+var ErrHostKeyAlreadyPinned = errors.New("a different NVOS host key is already pinned")
+
 // Registry defines the interface for NV-Switch tray storage.
 type Registry interface {
 	Start(ctx context.Context) error
@@ -45,4 +50,13 @@ type Registry interface {
 
 	// Delete removes an NV-Switch by UUID.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// PinNVOSHostKey records the NVOS SSH host key of a switch that has none
+	// pinned yet (trust on first use). Pinning the already pinned key is a
+	// no-op; a different key fails with ErrHostKeyAlreadyPinned.
+	PinNVOSHostKey(ctx context.Context, id uuid.UUID, hostKey string) error
+
+	// SetNVOSHostKey replaces the pinned NVOS SSH host key of a switch. An
+	// empty key clears it so that the next connection pins a new one.
+	SetNVOSHostKey(ctx context.Context, id uuid.UUID, hostKey string) error
 }
@@ -35,6 +35,7 @@ type NVOS struct {
 	IP         net.IP                 `json:"ip"`
 	Port       int                    `json:"port"` // Custom port (0 = default 22)
 	Credential *credential.Credential `json:"credential"`
+	HostKey    string                 `json:"host_key,omitempty"` // Pinned SSH host key in authorized_keys format (empty = not yet pinned)
 }
 
 // New creates a new NVOS instance by parsing MAC and IP from strings.
@@ -125,3 +126,18 @@ func (n *NVOS) GetPort() int {
 func (n *NVOS) SetPort(port int) {
 	n.Port = port
 }
+
+// GetHostKey returns the pinned SSH host key or an empty string.
+func (n *NVOS) GetHostKey() string {
+	return n.HostKey
+}
+
+// SetHostKey sets the pinned SSH host key.
+func (n *NVOS) SetHostKey(key string) {
+	n.HostKey = key
+}
+
+// HasHostKey returns true if an SSH host key has been pinned.
+func (n *NVOS) HasHostKey() bool {
+	return strings.TrimSpace(n.HostKey) != ""
+}
@@ -29,6 +29,7 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/bmc"
 
 	log "github.com/sirupsen/logrus"
@@ -163,6 +164,55 @@ func (c *RedfishClient) ResetBMC(resetType ResetBMCType) (*http.Response, error)
 	return c.Post(uri, body)
 }
 
+// ChangePassword sets the password of the account the client is logged in with through the Redfish AccountService.
+// The current session stays valid; new sessions must use the new password.
+func (c *RedfishClient) ChangePassword(newPassword string) error {
+	accountService, err := c.Service.AccountService()
+	if err != nil {
+		return fmt.Errorf("failed to query the account service: %w", err)
+	}
+
+	accounts, err := accountService.Accounts()
+	if err != nil {
+		return fmt.Errorf("failed to query accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		if account.UserName != c.ClientConfig.Username {
+			continue
+		}
+
+		resp, err := c.Patch(account.ODataID, map[string]interface{}{"Password": newPassword})
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to change the password of %s: %w", c.ClientConfig.Username, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("could not find the account of %s", c.ClientConfig.Username)
+}
+
+// SubscribeEvents registers destination as a push target of the BMC's EventService and returns the subscription
+// URI. eventContext is echoed back in every event; an existing subscription with the same destination and context
+// is reused.
+func (c *RedfishClient) SubscribeEvents(destination, eventContext string, headers map[string]string) (string, error) {
+	return events.Subscribe(c.APIClient, destination, eventContext, headers)
+}
+
+// UnsubscribeEvents deletes an EventService subscription.
+func (c *RedfishClient) UnsubscribeEvents(uri string) error {
+	return events.Unsubscribe(c.APIClient, uri)
+}
+
+// StreamEvents reads the BMC's Server-Sent Event stream until ctx is cancelled, calling fn with every event.
+// It returns events.ErrSSENotSupported or events.ErrNotSupported if the BMC cannot stream events.
+func (c *RedfishClient) StreamEvents(ctx context.Context, fn func(*redfish.Event) error) error {
+	return events.Stream(ctx, c.APIClient, fn)
+}
+
 // UpdateService returns the Redfish UpdateService resource.
 func (c *RedfishClient) UpdateService() (*redfish.UpdateService, error) {
 	return c.Service.UpdateService()
@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redfish
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/bmc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEmulatedClient starts an emulated NV-Switch BMC and returns a RedfishClient connected to it.
+func newEmulatedClient(t *testing.T, cfg emulator.Config) (*RedfishClient, *emulator.Emulator) {
+	emu := emulator.New(cfg)
+	server := httptest.NewTLSServer(emu)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	cred := credential.New(emulator.DefaultUsername, emulator.DefaultPassword)
+	b, err := bmc.NewFromAddr(net.HardwareAddr{0, 1, 2, 3, 4, 5}, net.ParseIP(u.Hostname()), cred)
+	require.NoError(t, err)
+	b.SetPort(port)
+
+	client, err := New(context.Background(), b, false)
+	require.NoError(t, err)
+	t.Cleanup(client.Logout)
+
+	return client, emu
+}
+
+func TestQueryChassisAndManager(t *testing.T) {
+	client, _ := newEmulatedClient(t, emulator.Config{Profile: emulator.NVSwitchBMC})
+
+	chassis, err := client.QueryChassis()
+	require.NoError(t, err)
+	assert.Equal(t, emulator.NVSwitchBMC.ChassisID, chassis.ID)
+
+	manager, err := client.QueryManager()
+	require.NoError(t, err)
+	assert.Equal(t, emulator.NVSwitchBMC.FirmwareVersion, manager.FirmwareVersion)
+}
+
+func TestResetSystemAndBMC(t *testing.T) {
+	client, emu := newEmulatedClient(t, emulator.Config{Profile: emulator.NVSwitchBMC})
+
+	resp, err := client.ResetSystem(ResetForceOff)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "Off", emu.PowerState())
+
+	resp, err = client.PowerCycle()
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "On", emu.PowerState())
+
+	resp, err = client.ResetBMC(ForceBMCRestart)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 1, emu.ManagerResets())
+}
+
+func TestUpdateFirmwareAndPollTask(t *testing.T) {
+	testCases := map[string]struct {
+		cfg       emulator.Config
+		wantState string
+	}{
+		"task completes": {
+			cfg:       emulator.Config{Profile: emulator.NVSwitchBMC, UpdatedFirmwareVersion: "88.0002.1000"},
+			wantState: emulator.TaskStateCompleted,
+		},
+		"task fails": {
+			cfg:       emulator.Config{Profile: emulator.NVSwitchBMC, FailTasks: true},
+			wantState: emulator.TaskStateException,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client, emu := newEmulatedClient(t, tc.cfg)
+
+			applyTime, err := client.GetHttpPushUriApplyTime()
+			require.NoError(t, err)
+			assert.Equal(t, emulator.ApplyTimeOnReset, applyTime)
+
+			resp, err := client.UpdateFirmware(strings.NewReader("firmware image"))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+			assert.Equal(t, emulator.ApplyTimeImmediate, emu.ApplyTime())
+
+			taskURI, err := client.GetTaskURI(resp)
+			require.NoError(t, err)
+
+			state, percent, err := client.GetTaskStatus(taskURI)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantState, state)
+
+			if tc.wantState == emulator.TaskStateCompleted {
+				assert.Equal(t, 100, percent)
+				assert.Equal(t, tc.cfg.UpdatedFirmwareVersion, emu.FirmwareVersion())
+			} else {
+				assert.Equal(t, emulator.NVSwitchBMC.FirmwareVersion, emu.FirmwareVersion())
+			}
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	client, emu := newEmulatedClient(t, emulator.Config{Profile: emulator.NVSwitchBMC})
+
+	require.NoError(t, client.ChangePassword("N3w-Passw0rd"))
+	assert.Equal(t, "N3w-Passw0rd", emu.Password())
+
+	err := client.ChangePassword("short")
+	assert.ErrorContains(t, err, "400")
+	assert.Equal(t, "N3w-Passw0rd", emu.Password())
+}
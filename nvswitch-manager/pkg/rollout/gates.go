@@ -0,0 +1,82 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+)
+
+// HealthChecker runs the switch health gates of a wave.
+type HealthChecker interface {
+	// Reachable returns an error if the BMC or NVOS of a switch does not accept connections.
+	Reachable(ctx context.Context, id uuid.UUID) error
+	// NVOSVersion returns the NVOS version a switch runs, queried the way the bundle updates NVOS.
+	NVOSVersion(ctx context.Context, id uuid.UUID, bundleVersion string) (string, error)
+}
+
+// SwitchGetter loads registered switches.
+type SwitchGetter interface {
+	Get(ctx context.Context, id uuid.UUID) (*nvswitch.NVSwitchTray, error)
+}
+
+// VersionQuerier queries the running firmware version of a switch component.
+type VersionQuerier interface {
+	CurrentVersion(ctx context.Context, switchUUID uuid.UUID, bundleVersion string, component nvswitch.Component) (string, error)
+}
+
+// switchHealthChecker is the HealthChecker of registered switches.
+type switchHealthChecker struct {
+	switches SwitchGetter
+	versions VersionQuerier
+}
+
+// NewHealthChecker returns a HealthChecker that dials the BMC and NVOS of switches and queries their NVOS
+// version through the firmware manager.
+func NewHealthChecker(switches SwitchGetter, versions VersionQuerier) HealthChecker {
+	return &switchHealthChecker{switches: switches, versions: versions}
+}
+
+func (c *switchHealthChecker) Reachable(ctx context.Context, id uuid.UUID) error {
+	tray, err := c.switches.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var unreachable []string
+	if tray.BMC != nil && !firmwaremanager.IsReachable(tray.BMC.IP.String(), tray.BMC.GetPort()) {
+		unreachable = append(unreachable, fmt.Sprintf("BMC %s:%d", tray.BMC.IP, tray.BMC.GetPort()))
+	}
+	if tray.NVOS != nil && !firmwaremanager.IsReachable(tray.NVOS.IP.String(), tray.NVOS.GetPort()) {
+		unreachable = append(unreachable, fmt.Sprintf("NVOS %s:%d", tray.NVOS.IP, tray.NVOS.GetPort()))
+	}
+	if len(unreachable) > 0 {
+		return fmt.Errorf("%s unreachable", strings.Join(unreachable, ", "))
+	}
+	return nil
+}
+
+func (c *switchHealthChecker) NVOSVersion(ctx context.Context, id uuid.UUID, bundleVersion string) (string, error) {
+	return c.versions.CurrentVersion(ctx, id, bundleVersion, nvswitch.NVOS)
+}
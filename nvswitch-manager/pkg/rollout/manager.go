@@ -0,0 +1,547 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager/packages"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultCheckInterval is how often running rollouts are advanced.
+const DefaultCheckInterval = 10 * time.Second
+
+// FirmwareClient is the subset of the firmware manager a rollout drives.
+type FirmwareClient interface {
+	QueueUpdate(ctx context.Context, switchUUID uuid.UUID, bundleVersion string, components []nvswitch.Component) ([]*firmwaremanager.FirmwareUpdate, error)
+	GetUpdate(ctx context.Context, updateID uuid.UUID) (*firmwaremanager.FirmwareUpdate, error)
+	CancelUpdate(ctx context.Context, updateID uuid.UUID) error
+	GetBundle(version string) (*packages.FirmwarePackage, error)
+}
+
+// Config configures the rollout controller.
+type Config struct {
+	// CheckInterval is how often running rollouts are advanced (DefaultCheckInterval if zero).
+	CheckInterval time.Duration
+}
+
+// Manager creates rollouts and advances the running ones wave by wave.
+type Manager struct {
+	firmware FirmwareClient
+	health   HealthChecker
+	store    Store
+	conf     Config
+	now      func() time.Time
+
+	// createMu serializes Create so that a switch cannot join two active rollouts.
+	createMu sync.Mutex
+	// locks serializes the read-modify-write of a rollout between the controller and API calls.
+	locks sync.Map // map[uuid.UUID]*sync.Mutex
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Manager that updates switches through the firmware client.
+func New(firmware FirmwareClient, health HealthChecker, store Store, conf Config) *Manager {
+	if conf.CheckInterval <= 0 {
+		conf.CheckInterval = DefaultCheckInterval
+	}
+
+	return &Manager{
+		firmware: firmware,
+		health:   health,
+		store:    store,
+		conf:     conf,
+		now:      time.Now,
+	}
+}
+
+// Start starts the controller advancing running rollouts, including those that were running before a restart.
+func (m *Manager) Start(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	log.Infof("Starting firmware rollout controller (check interval: %s)", m.conf.CheckInterval)
+	m.wg.Add(1)
+	go m.run(runCtx)
+
+	return nil
+}
+
+// Stop stops the controller and waits for the step in progress.
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+}
+
+// Create starts rolling the bundle out to the targets in the order given: the first CanarySize switches form
+// the canary wave. If components is empty, all components of the bundle are updated.
+func (m *Manager) Create(ctx context.Context, bundleVersion string, components []nvswitch.Component, targets []uuid.UUID, policy Policy) (*Rollout, error) {
+	policy = policy.withDefaults()
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("no target switches")
+	}
+
+	pkg, err := m.firmware.GetBundle(bundleVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle version: %w", err)
+	}
+	for _, c := range components {
+		if !pkg.HasComponent(strings.ToLower(string(c))) {
+			return nil, fmt.Errorf("component %s not found in bundle %s", c, bundleVersion)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(targets))
+	for _, id := range targets {
+		if seen[id] {
+			return nil, fmt.Errorf("switch %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+
+	rollouts, err := m.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rollouts: %w", err)
+	}
+	for _, other := range rollouts {
+		if other.State.IsTerminal() {
+			continue
+		}
+		for _, wave := range other.Waves {
+			for _, t := range wave.Targets {
+				if seen[t.SwitchUUID] {
+					return nil, fmt.Errorf("switch %s is already part of rollout %s (%s)", t.SwitchUUID, other.ID, other.State)
+				}
+			}
+		}
+	}
+
+	r := &Rollout{
+		ID:            uuid.New(),
+		BundleVersion: bundleVersion,
+		Components:    components,
+		Policy:        policy,
+		State:         StateRunning,
+		Waves:         planWaves(targets, policy),
+		CreatedAt:     m.now(),
+	}
+	if err := m.store.Put(ctx, r); err != nil {
+		return nil, fmt.Errorf("failed to save rollout: %w", err)
+	}
+
+	log.Infof("Created rollout %s of bundle %s to %d switches in %d waves", r.ID, bundleVersion, len(targets), len(r.Waves))
+	return r, nil
+}
+
+// Get returns a rollout. Returns ErrNotFound if it does not exist.
+func (m *Manager) Get(ctx context.Context, id uuid.UUID) (*Rollout, error) {
+	return m.store.Get(ctx, id)
+}
+
+// List returns all rollouts, newest first.
+func (m *Manager) List(ctx context.Context) ([]*Rollout, error) {
+	return m.store.List(ctx)
+}
+
+// Pause stops a running rollout from starting further waves. Updates already queued keep running.
+func (m *Manager) Pause(ctx context.Context, id uuid.UUID) (*Rollout, error) {
+	return m.modify(ctx, id, func(r *Rollout) error {
+		if r.State != StateRunning {
+			return fmt.Errorf("%w: rollout is %s", ErrInvalidState, r.State)
+		}
+		r.State = StatePaused
+		r.Message = "paused by user"
+		log.Infof("Rollout %s paused by user", r.ID)
+		return nil
+	})
+}
+
+// Resume continues a paused rollout. If the current wave failed a health gate, its gates are checked again,
+// unless acceptWave is set, which marks the wave succeeded and moves on to the next wave.
+func (m *Manager) Resume(ctx context.Context, id uuid.UUID, acceptWave bool) (*Rollout, error) {
+	return m.modify(ctx, id, func(r *Rollout) error {
+		if r.State != StatePaused {
+			return fmt.Errorf("%w: rollout is %s", ErrInvalidState, r.State)
+		}
+		r.State = StateRunning
+		r.Message = ""
+
+		wave := &r.Waves[r.CurrentWave]
+		if wave.State == WaveFailed {
+			if acceptWave {
+				wave.Message += " (accepted by user)"
+				m.completeWave(r, wave)
+			} else {
+				// All updates of the wave are done, so the next step checks its gates again
+				wave.State = WaveUpdating
+			}
+		}
+
+		log.Infof("Rollout %s resumed at wave %d (accept wave: %v)", r.ID, wave.Index, acceptWave)
+		return nil
+	})
+}
+
+// Abort stops a rollout for good: updates still queued or running in the current wave are cancelled and the
+// remaining waves are not started.
+func (m *Manager) Abort(ctx context.Context, id uuid.UUID) (*Rollout, error) {
+	return m.modify(ctx, id, func(r *Rollout) error {
+		if r.State.IsTerminal() {
+			return fmt.Errorf("%w: rollout is %s", ErrInvalidState, r.State)
+		}
+		m.abort(ctx, r, "aborted by user")
+		return nil
+	})
+}
+
+// lock returns the mutex of a rollout, creating one if it doesn't exist.
+func (m *Manager) lock(id uuid.UUID) *sync.Mutex {
+	lock, _ := m.locks.LoadOrStore(id, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// modify loads a rollout, applies fn and saves the result unless fn fails.
+func (m *Manager) modify(ctx context.Context, id uuid.UUID, fn func(r *Rollout) error) (*Rollout, error) {
+	lock := m.lock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	r, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(r); err != nil {
+		return nil, err
+	}
+	if err := m.store.Put(ctx, r); err != nil {
+		return nil, fmt.Errorf("failed to save rollout: %w", err)
+	}
+	return r, nil
+}
+
+func (m *Manager) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.advanceAll(ctx)
+		}
+	}
+}
+
+// advanceAll runs one step of every running rollout.
+func (m *Manager) advanceAll(ctx context.Context) {
+	rollouts, err := m.store.List(ctx)
+	if err != nil {
+		log.Errorf("Failed to list rollouts: %v", err)
+		return
+	}
+
+	for _, r := range rollouts {
+		if r.State != StateRunning {
+			continue
+		}
+		if err := m.advance(ctx, r.ID); err != nil {
+			log.Errorf("Failed to advance rollout %s: %v", r.ID, err)
+		}
+	}
+}
+
+// advance runs one step of a rollout's current wave.
+func (m *Manager) advance(ctx context.Context, id uuid.UUID) error {
+	_, err := m.modify(ctx, id, func(r *Rollout) error {
+		if r.State != StateRunning {
+			return nil
+		}
+
+		wave := &r.Waves[r.CurrentWave]
+		switch wave.State {
+		case WavePending:
+			m.startWave(ctx, r, wave)
+
+		case WaveUpdating:
+			if !m.collectUpdates(ctx, wave) {
+				return nil
+			}
+			if problem := m.checkGates(ctx, r, wave, true); problem != "" {
+				m.failGate(ctx, r, wave, problem)
+				return nil
+			}
+			if r.Policy.SoakTime > 0 {
+				until := m.now().Add(r.Policy.SoakTime)
+				wave.State = WaveSoaking
+				wave.SoakUntil = &until
+				wave.Message = "gates passed, soaking"
+				log.Infof("Rollout %s: wave %d passed its gates, soaking until %s", r.ID, wave.Index, until.Format(time.RFC3339))
+				return nil
+			}
+			m.completeWave(r, wave)
+
+		case WaveSoaking:
+			if wave.SoakUntil != nil && m.now().Before(*wave.SoakUntil) {
+				return nil
+			}
+			if problem := m.checkGates(ctx, r, wave, false); problem != "" {
+				m.failGate(ctx, r, wave, problem)
+				return nil
+			}
+			m.completeWave(r, wave)
+		}
+		return nil
+	})
+	return err
+}
+
+// startWave queues the firmware updates of every switch in the wave. A switch whose update cannot be queued
+// counts as failed.
+func (m *Manager) startWave(ctx context.Context, r *Rollout, wave *Wave) {
+	now := m.now()
+	wave.StartedAt = &now
+	wave.State = WaveUpdating
+
+	for i := range wave.Targets {
+		t := &wave.Targets[i]
+		updates, err := m.firmware.QueueUpdate(ctx, t.SwitchUUID, r.BundleVersion, r.Components)
+		if err != nil {
+			t.State = TargetFailed
+			t.Error = fmt.Sprintf("failed to queue update: %v", err)
+			continue
+		}
+
+		t.State = TargetUpdating
+		t.UpdateIDs = make([]uuid.UUID, len(updates))
+		for j, u := range updates {
+			t.UpdateIDs[j] = u.ID
+		}
+	}
+
+	log.Infof("Rollout %s: started wave %d with %d switches (%d failed to queue)", r.ID, wave.Index, len(wave.Targets), wave.Failed())
+}
+
+// collectUpdates records the outcome of switches whose updates have finished and returns true once every
+// switch of the wave is done.
+func (m *Manager) collectUpdates(ctx context.Context, wave *Wave) bool {
+	done := true
+	for i := range wave.Targets {
+		t := &wave.Targets[i]
+		if t.State != TargetUpdating {
+			continue
+		}
+
+		finished, errMsg, err := m.targetOutcome(ctx, t)
+		if err != nil {
+			log.Warnf("Failed to query the updates of switch %s: %v", t.SwitchUUID, err)
+			done = false
+			continue
+		}
+		if !finished {
+			done = false
+			continue
+		}
+
+		if errMsg != "" {
+			t.State = TargetFailed
+			t.Error = errMsg
+		} else {
+			t.State = TargetSucceeded
+		}
+	}
+	return done
+}
+
+// targetOutcome reports whether all updates of a switch are terminal and, if one did not complete, why.
+func (m *Manager) targetOutcome(ctx context.Context, t *Target) (bool, string, error) {
+	var errMsg string
+	for _, id := range t.UpdateIDs {
+		update, err := m.firmware.GetUpdate(ctx, id)
+		if err != nil {
+			return false, "", err
+		}
+		if !update.State.IsTerminal() {
+			return false, "", nil
+		}
+		if update.State != firmwaremanager.StateCompleted && errMsg == "" {
+			errMsg = fmt.Sprintf("%s update %s: %s", update.Component, strings.ToLower(string(update.State)), update.ErrorMessage)
+		}
+	}
+	return true, errMsg, nil
+}
+
+// checkGates runs the health gates of a wave and returns what failed, empty if all passed. The switch gates
+// only check switches whose update succeeded.
+func (m *Manager) checkGates(ctx context.Context, r *Rollout, wave *Wave, checkFailureRate bool) string {
+	var problems []string
+
+	if checkFailureRate && wave.FailureRate() > r.Policy.MaxFailureRate {
+		problems = append(problems, fmt.Sprintf("%d of %d switch updates failed (%.0f%%, max %.0f%%)",
+			wave.Failed(), len(wave.Targets), wave.FailureRate()*100, r.Policy.MaxFailureRate*100))
+	}
+
+	nvosVersion := ""
+	if !r.Policy.SkipNVOSVersionCheck {
+		nvosVersion = m.expectedNVOSVersion(r)
+	}
+
+	for _, t := range wave.Targets {
+		if t.State != TargetSucceeded {
+			continue
+		}
+		if !r.Policy.SkipReachabilityCheck {
+			if err := m.health.Reachable(ctx, t.SwitchUUID); err != nil {
+				problems = append(problems, fmt.Sprintf("switch %s: %v", t.SwitchUUID, err))
+				continue
+			}
+		}
+		if nvosVersion != "" {
+			version, err := m.health.NVOSVersion(ctx, t.SwitchUUID, r.BundleVersion)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("switch %s: failed to query NVOS version: %v", t.SwitchUUID, err))
+			} else if version != nvosVersion {
+				problems = append(problems, fmt.Sprintf("switch %s runs NVOS %s, expected %s", t.SwitchUUID, version, nvosVersion))
+			}
+		}
+	}
+
+	return strings.Join(problems, "; ")
+}
+
+// expectedNVOSVersion returns the NVOS version of the bundle if the rollout updates NVOS, empty otherwise.
+func (m *Manager) expectedNVOSVersion(r *Rollout) string {
+	if len(r.Components) > 0 {
+		updatesNVOS := false
+		for _, c := range r.Components {
+			updatesNVOS = updatesNVOS || c == nvswitch.NVOS
+		}
+		if !updatesNVOS {
+			return ""
+		}
+	}
+
+	pkg, err := m.firmware.GetBundle(r.BundleVersion)
+	if err != nil {
+		log.Warnf("Rollout %s: cannot verify NVOS version: %v", r.ID, err)
+		return ""
+	}
+	if comp := pkg.GetComponent(strings.ToLower(string(nvswitch.NVOS))); comp != nil {
+		return comp.Version
+	}
+	return ""
+}
+
+// failGate records a failed gate and pauses or aborts the rollout per its policy.
+func (m *Manager) failGate(ctx context.Context, r *Rollout, wave *Wave, problem string) {
+	wave.State = WaveFailed
+	wave.Message = problem
+	reason := fmt.Sprintf("wave %d failed its health gate: %s", wave.Index, problem)
+
+	if r.Policy.OnGateFailure == GateActionAbort {
+		m.abort(ctx, r, reason)
+		return
+	}
+
+	r.State = StatePaused
+	r.Message = reason
+	log.Warnf("Rollout %s paused: %s", r.ID, reason)
+}
+
+// completeWave marks a wave succeeded and moves on to the next wave, completing the rollout after the last.
+func (m *Manager) completeWave(r *Rollout, wave *Wave) {
+	now := m.now()
+	wave.State = WaveSucceeded
+	wave.CompletedAt = &now
+
+	if wave.Index == len(r.Waves)-1 {
+		r.State = StateCompleted
+		log.Infof("Rollout %s of bundle %s completed", r.ID, r.BundleVersion)
+		return
+	}
+
+	r.CurrentWave++
+	log.Infof("Rollout %s: wave %d succeeded, moving on to wave %d", r.ID, wave.Index, r.CurrentWave)
+}
+
+// abort cancels the updates still running in the current wave and cancels the waves that have not finished.
+func (m *Manager) abort(ctx context.Context, r *Rollout, reason string) {
+	for i := r.CurrentWave; i < len(r.Waves); i++ {
+		wave := &r.Waves[i]
+		for j := range wave.Targets {
+			t := &wave.Targets[j]
+			if t.State != TargetUpdating {
+				continue
+			}
+			m.cancelTarget(ctx, t)
+			t.State = TargetFailed
+			t.Error = "cancelled: rollout aborted"
+		}
+
+		switch wave.State {
+		case WavePending, WaveUpdating, WaveSoaking:
+			wave.State = WaveCancelled
+		}
+	}
+
+	r.State = StateAborted
+	r.Message = reason
+	log.Warnf("Rollout %s aborted: %s", r.ID, reason)
+}
+
+// cancelTarget cancels the first unfinished update of a switch; the firmware manager cancels the updates
+// queued after it.
+func (m *Manager) cancelTarget(ctx context.Context, t *Target) {
+	for _, id := range t.UpdateIDs {
+		update, err := m.firmware.GetUpdate(ctx, id)
+		if err != nil {
+			log.Warnf("Failed to query update %s of switch %s: %v", id, t.SwitchUUID, err)
+			continue
+		}
+		if update.State.IsTerminal() {
+			continue
+		}
+		if err := m.firmware.CancelUpdate(ctx, id); err != nil {
+			log.Warnf("Failed to cancel update %s of switch %s: %v", id, t.SwitchUUID, err)
+		}
+		return
+	}
+}
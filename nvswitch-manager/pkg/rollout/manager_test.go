@@ -0,0 +1,463 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/firmwaremanager/packages"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBundle = "1.2.0"
+	testNVOS   = "25.02.2553"
+)
+
+// fakeFirmware queues one update per switch and lets tests decide how it ends.
+type fakeFirmware struct {
+	mu        sync.Mutex
+	updates   map[uuid.UUID]*firmwaremanager.FirmwareUpdate
+	cancelled []uuid.UUID
+	// queueErr fails QueueUpdate of the listed switches.
+	queueErr map[uuid.UUID]error
+}
+
+func newFakeFirmware() *fakeFirmware {
+	return &fakeFirmware{
+		updates:  make(map[uuid.UUID]*firmwaremanager.FirmwareUpdate),
+		queueErr: make(map[uuid.UUID]error),
+	}
+}
+
+func (f *fakeFirmware) QueueUpdate(_ context.Context, switchUUID uuid.UUID, bundleVersion string, _ []nvswitch.Component) ([]*firmwaremanager.FirmwareUpdate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.queueErr[switchUUID]; err != nil {
+		return nil, err
+	}
+	update := firmwaremanager.NewFirmwareUpdate(switchUUID, nvswitch.NVOS, bundleVersion, firmwaremanager.StrategySSH, testNVOS)
+	f.updates[update.ID] = update
+	return []*firmwaremanager.FirmwareUpdate{update}, nil
+}
+
+func (f *fakeFirmware) GetUpdate(_ context.Context, id uuid.UUID) (*firmwaremanager.FirmwareUpdate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	update, ok := f.updates[id]
+	if !ok {
+		return nil, fmt.Errorf("update %s not found", id)
+	}
+	copied := *update
+	return &copied, nil
+}
+
+func (f *fakeFirmware) CancelUpdate(_ context.Context, id uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cancelled = append(f.cancelled, id)
+	f.updates[id].SetState(firmwaremanager.StateCancelled)
+	return nil
+}
+
+func (f *fakeFirmware) GetBundle(version string) (*packages.FirmwarePackage, error) {
+	if version != testBundle {
+		return nil, fmt.Errorf("bundle %s not found", version)
+	}
+	return &packages.FirmwarePackage{
+		Version: testBundle,
+		Components: map[string]packages.ComponentDef{
+			"bmc":  {Version: "1.1.0"},
+			"nvos": {Version: testNVOS},
+		},
+	}, nil
+}
+
+// finish ends the updates of the given switches, failing those in failed.
+func (f *fakeFirmware) finish(switches []uuid.UUID, failed ...uuid.UUID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	isFailed := make(map[uuid.UUID]bool)
+	for _, id := range failed {
+		isFailed[id] = true
+	}
+	for _, id := range switches {
+		for _, update := range f.updates {
+			if update.SwitchUUID != id {
+				continue
+			}
+			if isFailed[id] {
+				update.SetError(errors.New("install failed"))
+			} else {
+				update.SetState(firmwaremanager.StateCompleted)
+			}
+		}
+	}
+}
+
+// fakeHealth reports every switch healthy and running the bundle's NVOS unless told otherwise.
+type fakeHealth struct {
+	mu          sync.Mutex
+	unreachable map[uuid.UUID]bool
+	nvos        map[uuid.UUID]string
+}
+
+func newFakeHealth() *fakeHealth {
+	return &fakeHealth{unreachable: make(map[uuid.UUID]bool), nvos: make(map[uuid.UUID]string)}
+}
+
+func (h *fakeHealth) Reachable(_ context.Context, id uuid.UUID) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.unreachable[id] {
+		return errors.New("NVOS unreachable")
+	}
+	return nil
+}
+
+func (h *fakeHealth) NVOSVersion(_ context.Context, id uuid.UUID, _ string) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if version, ok := h.nvos[id]; ok {
+		return version, nil
+	}
+	return testNVOS, nil
+}
+
+type testEnv struct {
+	firmware *fakeFirmware
+	health   *fakeHealth
+	manager  *Manager
+	now      time.Time
+}
+
+func newTestEnv() *testEnv {
+	env := &testEnv{firmware: newFakeFirmware(), health: newFakeHealth(), now: time.Now()}
+	env.manager = New(env.firmware, env.health, NewInMemoryStore(), Config{})
+	env.manager.now = func() time.Time { return env.now }
+	return env
+}
+
+func (e *testEnv) advance(t *testing.T, id uuid.UUID) *Rollout {
+	t.Helper()
+	require.NoError(t, e.manager.advance(context.Background(), id))
+	r, err := e.manager.Get(context.Background(), id)
+	require.NoError(t, err)
+	return r
+}
+
+func switches(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	return ids
+}
+
+func waveSwitches(w Wave) []uuid.UUID {
+	ids := make([]uuid.UUID, len(w.Targets))
+	for i, t := range w.Targets {
+		ids[i] = t.SwitchUUID
+	}
+	return ids
+}
+
+func TestPlanWaves(t *testing.T) {
+	tests := []struct {
+		name     string
+		targets  int
+		policy   Policy
+		expected []int
+	}{
+		{name: "defaults", targets: 10, policy: Policy{}, expected: []int{1, 2, 2, 5}},
+		{name: "single switch", targets: 1, policy: Policy{}, expected: []int{1}},
+		{name: "canary covers early waves", targets: 4, policy: Policy{CanarySize: 2}, expected: []int{2, 2}},
+		{name: "canary larger than targets", targets: 2, policy: Policy{CanarySize: 5}, expected: []int{2}},
+		{name: "single percentage", targets: 5, policy: Policy{WavePercents: []int{100}}, expected: []int{1, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := switches(tt.targets)
+			waves := planWaves(targets, tt.policy.withDefaults())
+
+			var sizes []int
+			var planned []uuid.UUID
+			for i, w := range waves {
+				assert.Equal(t, i, w.Index)
+				assert.Equal(t, WavePending, w.State)
+				sizes = append(sizes, len(w.Targets))
+				planned = append(planned, waveSwitches(w)...)
+			}
+			assert.Equal(t, tt.expected, sizes)
+			assert.Equal(t, targets, planned, "every target is planned once, in order")
+			assert.True(t, waves[0].IsCanary())
+		})
+	}
+}
+
+func TestCreateValidation(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv()
+	targets := switches(2)
+
+	tests := []struct {
+		name       string
+		bundle     string
+		components []nvswitch.Component
+		targets    []uuid.UUID
+		policy     Policy
+	}{
+		{name: "unknown bundle", bundle: "9.9.9", targets: targets},
+		{name: "component not in bundle", bundle: testBundle, components: []nvswitch.Component{nvswitch.CPLD}, targets: targets},
+		{name: "no targets", bundle: testBundle},
+		{name: "duplicate target", bundle: testBundle, targets: []uuid.UUID{targets[0], targets[0]}},
+		{name: "descending percents", bundle: testBundle, targets: targets, policy: Policy{WavePercents: []int{50, 25, 100}}},
+		{name: "percents not ending at 100", bundle: testBundle, targets: targets, policy: Policy{WavePercents: []int{50}}},
+		{name: "failure rate above 1", bundle: testBundle, targets: targets, policy: Policy{MaxFailureRate: 1.5}},
+		{name: "unknown gate action", bundle: testBundle, targets: targets, policy: Policy{OnGateFailure: "Retry"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := env.manager.Create(ctx, tt.bundle, tt.components, tt.targets, tt.policy)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("switch in another active rollout", func(t *testing.T) {
+		_, err := env.manager.Create(ctx, testBundle, nil, targets, Policy{})
+		require.NoError(t, err)
+
+		_, err = env.manager.Create(ctx, testBundle, nil, []uuid.UUID{uuid.New(), targets[1]}, Policy{})
+		assert.ErrorContains(t, err, "already part of rollout")
+	})
+}
+
+func TestRolloutCompletesWithSoak(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv()
+	targets := switches(4)
+
+	r, err := env.manager.Create(ctx, testBundle, nil, targets, Policy{WavePercents: []int{100}, SoakTime: time.Hour})
+	require.NoError(t, err)
+	require.Len(t, r.Waves, 2)
+
+	for i := range r.Waves {
+		wave := waveSwitches(r.Waves[i])
+
+		r = env.advance(t, r.ID)
+		assert.Equal(t, WaveUpdating, r.Waves[i].State)
+		for _, target := range r.Waves[i].Targets {
+			assert.Equal(t, TargetUpdating, target.State)
+			assert.Len(t, target.UpdateIDs, 1)
+		}
+		if i+1 < len(r.Waves) {
+			assert.Equal(t, WavePending, r.Waves[i+1].State, "the next wave waits for this one")
+		}
+
+		r = env.advance(t, r.ID)
+		assert.Equal(t, WaveUpdating, r.Waves[i].State, "updates are still running")
+
+		env.firmware.finish(wave)
+		r = env.advance(t, r.ID)
+		assert.Equal(t, WaveSoaking, r.Waves[i].State)
+		require.NotNil(t, r.Waves[i].SoakUntil)
+
+		env.now = env.now.Add(30 * time.Minute)
+		r = env.advance(t, r.ID)
+		assert.Equal(t, WaveSoaking, r.Waves[i].State, "still soaking")
+
+		env.now = env.now.Add(time.Hour)
+		r = env.advance(t, r.ID)
+		assert.Equal(t, WaveSucceeded, r.Waves[i].State)
+	}
+
+	assert.Equal(t, StateCompleted, r.State)
+	assert.Equal(t, 1, r.CurrentWave)
+}
+
+func TestFailureRatePausesRollout(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv()
+	targets := switches(5)
+	env.firmware.queueErr[targets[2]] = errors.New("switch busy")
+
+	r, err := env.manager.Create(ctx, testBundle, nil, targets, Policy{WavePercents: []int{100}, MaxFailureRate: 0.25})
+	require.NoError(t, err)
+
+	// Canary succeeds
+	r = env.advance(t, r.ID)
+	env.firmware.finish(waveSwitches(r.Waves[0]))
+	r = env.advance(t, r.ID)
+	require.Equal(t, WaveSucceeded, r.Waves[0].State)
+
+	// One of four fails to queue and another fails to install: 50% > 25%
+	r = env.advance(t, r.ID)
+	assert.Equal(t, TargetFailed, r.Waves[1].Targets[1].State)
+	env.firmware.finish(waveSwitches(r.Waves[1]), targets[3])
+	r = env.advance(t, r.ID)
+
+	assert.Equal(t, StatePaused, r.State)
+	assert.Equal(t, WaveFailed, r.Waves[1].State)
+	assert.Equal(t, 0.5, r.Waves[1].FailureRate())
+	assert.Contains(t, r.Message, "2 of 4 switch updates failed")
+
+	// A paused rollout does not advance
+	r = env.advance(t, r.ID)
+	assert.Equal(t, StatePaused, r.State)
+
+	// Resuming re-checks the gates, which still fail
+	r, err = env.manager.Resume(ctx, r.ID, false)
+	require.NoError(t, err)
+	r = env.advance(t, r.ID)
+	assert.Equal(t, StatePaused, r.State)
+
+	// Accepting the wave completes the rollout
+	r, err = env.manager.Resume(ctx, r.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, StateCompleted, r.State)
+	assert.Equal(t, WaveSucceeded, r.Waves[1].State)
+}
+
+func TestSwitchGates(t *testing.T) {
+	ctx := context.Background()
+	targets := switches(2)
+
+	tests := []struct {
+		name     string
+		setup    func(h *fakeHealth)
+		policy   Policy
+		message  string
+		expected State
+	}{
+		{
+			name:     "NVOS version mismatch pauses",
+			setup:    func(h *fakeHealth) { h.nvos[targets[0]] = "25.02.2000" },
+			message:  "runs NVOS 25.02.2000, expected " + testNVOS,
+			expected: StatePaused,
+		},
+		{
+			name:     "unreachable switch aborts",
+			setup:    func(h *fakeHealth) { h.unreachable[targets[0]] = true },
+			policy:   Policy{OnGateFailure: GateActionAbort},
+			message:  "NVOS unreachable",
+			expected: StateAborted,
+		},
+		{
+			name:     "skipped checks pass",
+			setup:    func(h *fakeHealth) { h.nvos[targets[0]] = "25.02.2000"; h.unreachable[targets[0]] = true },
+			policy:   Policy{SkipReachabilityCheck: true, SkipNVOSVersionCheck: true},
+			expected: StateRunning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv()
+			tt.setup(env.health)
+
+			r, err := env.manager.Create(ctx, testBundle, nil, targets, tt.policy)
+			require.NoError(t, err)
+
+			r = env.advance(t, r.ID)
+			env.firmware.finish(waveSwitches(r.Waves[0]))
+			r = env.advance(t, r.ID)
+
+			assert.Equal(t, tt.expected, r.State)
+			assert.Contains(t, r.Message, tt.message)
+			if tt.expected == StateAborted {
+				assert.Equal(t, WaveFailed, r.Waves[0].State)
+				assert.Equal(t, WaveCancelled, r.Waves[1].State)
+			}
+		})
+	}
+}
+
+func TestNVOSVersionGateOnlyWhenUpdatingNVOS(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv()
+	targets := switches(1)
+	env.health.nvos[targets[0]] = "25.02.2000"
+
+	r, err := env.manager.Create(ctx, testBundle, []nvswitch.Component{nvswitch.BMC}, targets, Policy{})
+	require.NoError(t, err)
+
+	r = env.advance(t, r.ID)
+	env.firmware.finish(targets)
+	r = env.advance(t, r.ID)
+	assert.Equal(t, StateCompleted, r.State)
+}
+
+func TestPauseAndAbort(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv()
+	targets := switches(3)
+
+	r, err := env.manager.Create(ctx, testBundle, nil, targets, Policy{})
+	require.NoError(t, err)
+	r = env.advance(t, r.ID)
+	require.Equal(t, WaveUpdating, r.Waves[0].State)
+
+	r, err = env.manager.Pause(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatePaused, r.State)
+
+	_, err = env.manager.Pause(ctx, r.ID)
+	assert.ErrorIs(t, err, ErrInvalidState, "only a running rollout can be paused")
+
+	r, err = env.manager.Abort(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateAborted, r.State)
+	assert.Equal(t, TargetFailed, r.Waves[0].Targets[0].State)
+	assert.Equal(t, r.Waves[0].Targets[0].UpdateIDs, env.firmware.cancelled)
+	for _, w := range r.Waves {
+		assert.Equal(t, WaveCancelled, w.State)
+	}
+
+	_, err = env.manager.Resume(ctx, r.ID, false)
+	assert.ErrorIs(t, err, ErrInvalidState)
+	_, err = env.manager.Abort(ctx, r.ID)
+	assert.ErrorIs(t, err, ErrInvalidState)
+
+	// The switches can join a new rollout once this one is aborted
+	_, err = env.manager.Create(ctx, testBundle, nil, targets, Policy{})
+	assert.NoError(t, err)
+}
+
+func TestGetUnknownRollout(t *testing.T) {
+	env := newTestEnv()
+	_, err := env.manager.Get(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
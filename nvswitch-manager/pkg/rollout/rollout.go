@@ -0,0 +1,258 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rollout rolls a firmware bundle out to a set of NV-Switch trays in waves: a canary wave first, then
+// waves that each bring the rollout to a cumulative percentage of the targets. Once the updates of a wave are
+// done, health gates check its failure rate, that its switches are reachable and that they run the bundle's
+// NVOS version; after the soak time the switch gates are checked again before the next wave starts. A failed
+// gate pauses or aborts the rollout.
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultCanarySize is the number of switches in the canary wave.
+	DefaultCanarySize = 1
+)
+
+// DefaultWavePercents are the cumulative percentages of the targets updated after each wave that follows the canary.
+var DefaultWavePercents = []int{25, 50, 100}
+
+// ErrNotFound is returned when a rollout does not exist.
+var ErrNotFound = errors.New("rollout not found")
+
+// ErrInvalidState is returned when a rollout cannot be paused, resumed or aborted in its current state.
+var ErrInvalidState = errors.New("invalid rollout state")
+
+// State is the state of a rollout.
+type State string
+
+const (
+	// StateRunning means the rollout advances through its waves.
+	StateRunning State = "Running"
+	// StatePaused means the rollout waits for Resume, after a failed health gate or a Pause.
+	StatePaused State = "Paused"
+	// StateCompleted means every wave succeeded.
+	StateCompleted State = "Completed"
+	// StateAborted means the rollout was stopped; the remaining waves are cancelled.
+	StateAborted State = "Aborted"
+)
+
+// IsTerminal returns true if the rollout no longer advances.
+func (s State) IsTerminal() bool {
+	return s == StateCompleted || s == StateAborted
+}
+
+// WaveState is the state of a wave.
+type WaveState string
+
+const (
+	// WavePending means the wave has not started.
+	WavePending WaveState = "Pending"
+	// WaveUpdating means the firmware updates of the wave are queued or running.
+	WaveUpdating WaveState = "Updating"
+	// WaveSoaking means the updates are done and the gates passed; the wave waits for the soak time.
+	WaveSoaking WaveState = "Soaking"
+	// WaveSucceeded means the wave passed its gates after the soak time.
+	WaveSucceeded WaveState = "Succeeded"
+	// WaveFailed means a health gate of the wave failed.
+	WaveFailed WaveState = "Failed"
+	// WaveCancelled means the rollout was aborted before the wave finished.
+	WaveCancelled WaveState = "Cancelled"
+)
+
+// TargetState is the state of the firmware update of a single switch in a wave.
+type TargetState string
+
+const (
+	TargetPending   TargetState = "Pending"
+	TargetUpdating  TargetState = "Updating"
+	TargetSucceeded TargetState = "Succeeded"
+	TargetFailed    TargetState = "Failed"
+)
+
+// GateAction is what a rollout does when a health gate fails.
+type GateAction string
+
+const (
+	// GateActionPause pauses the rollout until it is resumed.
+	GateActionPause GateAction = "Pause"
+	// GateActionAbort aborts the rollout.
+	GateActionAbort GateAction = "Abort"
+)
+
+// Policy controls how a rollout is split into waves and when it stops.
+type Policy struct {
+	// CanarySize is the number of switches in the canary wave (DefaultCanarySize if zero).
+	CanarySize int `json:"canary_size"`
+	// WavePercents are the cumulative percentages of the targets updated after each wave that follows the
+	// canary, ascending and ending at 100 (DefaultWavePercents if empty).
+	WavePercents []int `json:"wave_percents"`
+	// SoakTime is how long a wave runs on the new firmware before its gates are checked again and the next
+	// wave starts.
+	SoakTime time.Duration `json:"soak_time"`
+	// MaxFailureRate is the fraction (0-1) of the switches of a wave whose update may fail without failing
+	// the wave's gate.
+	MaxFailureRate float64 `json:"max_failure_rate"`
+	// SkipReachabilityCheck disables the gate checking that the BMC and NVOS of updated switches accept
+	// connections.
+	SkipReachabilityCheck bool `json:"skip_reachability_check"`
+	// SkipNVOSVersionCheck disables the gate checking that updated switches run the bundle's NVOS version.
+	SkipNVOSVersionCheck bool `json:"skip_nvos_version_check"`
+	// OnGateFailure is what the rollout does when a gate fails (GateActionPause if empty).
+	OnGateFailure GateAction `json:"on_gate_failure"`
+}
+
+// withDefaults returns the policy with unset fields set to their defaults.
+func (p Policy) withDefaults() Policy {
+	if p.CanarySize <= 0 {
+		p.CanarySize = DefaultCanarySize
+	}
+	if len(p.WavePercents) == 0 {
+		p.WavePercents = append([]int(nil), DefaultWavePercents...)
+	}
+	if p.OnGateFailure == "" {
+		p.OnGateFailure = GateActionPause
+	}
+	return p
+}
+
+// validate returns an error if the policy cannot be planned.
+func (p Policy) validate() error {
+	prev := 0
+	for _, pct := range p.WavePercents {
+		if pct <= prev || pct > 100 {
+			return fmt.Errorf("wave percents must be ascending between 1 and 100: %v", p.WavePercents)
+		}
+		prev = pct
+	}
+	if prev != 100 {
+		return fmt.Errorf("the last wave percent must be 100: %v", p.WavePercents)
+	}
+	if p.MaxFailureRate < 0 || p.MaxFailureRate > 1 {
+		return fmt.Errorf("max failure rate must be between 0 and 1: %v", p.MaxFailureRate)
+	}
+	if p.SoakTime < 0 {
+		return fmt.Errorf("soak time must not be negative: %s", p.SoakTime)
+	}
+	switch p.OnGateFailure {
+	case GateActionPause, GateActionAbort:
+	default:
+		return fmt.Errorf("unknown gate failure action %q", p.OnGateFailure)
+	}
+	return nil
+}
+
+// Target is the firmware update of a single switch in a wave.
+type Target struct {
+	SwitchUUID uuid.UUID   `json:"switch_uuid"`
+	State      TargetState `json:"state"`
+	// UpdateIDs are the firmware updates queued for the switch, in execution order.
+	UpdateIDs []uuid.UUID `json:"update_ids,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// Wave is a group of switches updated together. Wave 0 is the canary.
+type Wave struct {
+	Index       int        `json:"index"`
+	State       WaveState  `json:"state"`
+	Targets     []Target   `json:"targets"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	SoakUntil   *time.Time `json:"soak_until,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Message is the outcome of the wave's last gate check.
+	Message string `json:"message,omitempty"`
+}
+
+// IsCanary returns true for the canary wave.
+func (w *Wave) IsCanary() bool {
+	return w.Index == 0
+}
+
+// Failed returns the number of switches whose update failed.
+func (w *Wave) Failed() int {
+	failed := 0
+	for _, t := range w.Targets {
+		if t.State == TargetFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// FailureRate returns the fraction of switches whose update failed.
+func (w *Wave) FailureRate() float64 {
+	if len(w.Targets) == 0 {
+		return 0
+	}
+	return float64(w.Failed()) / float64(len(w.Targets))
+}
+
+// Rollout is the staged update of a set of switches to a firmware bundle.
+type Rollout struct {
+	ID            uuid.UUID
+	BundleVersion string
+	// Components are the components updated on every switch; all components of the bundle if empty.
+	Components []nvswitch.Component
+	Policy     Policy
+	State      State
+	// CurrentWave is the index of the wave the rollout is at.
+	CurrentWave int
+	Waves       []Wave
+	// Message explains why the rollout is paused or aborted.
+	Message   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// planWaves splits the targets into the canary wave followed by the waves of the policy's cumulative
+// percentages. Waves that would be empty are dropped.
+func planWaves(targets []uuid.UUID, policy Policy) []Wave {
+	var waves []Wave
+	add := func(switches []uuid.UUID) {
+		if len(switches) == 0 {
+			return
+		}
+		wave := Wave{Index: len(waves), State: WavePending, Targets: make([]Target, len(switches))}
+		for i, id := range switches {
+			wave.Targets[i] = Target{SwitchUUID: id, State: TargetPending}
+		}
+		waves = append(waves, wave)
+	}
+
+	end := min(policy.CanarySize, len(targets))
+	add(targets[:end])
+
+	for _, pct := range policy.WavePercents {
+		next := (len(targets)*pct + 99) / 100
+		if next > end {
+			add(targets[end:next])
+			end = next
+		}
+	}
+	add(targets[end:])
+
+	return waves
+}
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rollout
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Store provides persistence for rollouts.
+type Store interface {
+	// Get retrieves a rollout. Returns ErrNotFound if it does not exist.
+	Get(ctx context.Context, id uuid.UUID) (*Rollout, error)
+
+	// List returns all rollouts, newest first.
+	List(ctx context.Context) ([]*Rollout, error)
+
+	// Put upserts a rollout.
+	Put(ctx context.Context, rollout *Rollout) error
+}
+
+// Ensure InMemoryStore implements Store.
+var _ Store = (*InMemoryStore)(nil)
+
+// InMemoryStore implements Store using in-memory storage.
+// All data is lost when the process exits.
+type InMemoryStore struct {
+	mu       sync.RWMutex
+	rollouts map[uuid.UUID]*Rollout
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		rollouts: make(map[uuid.UUID]*Rollout),
+	}
+}
+
+// Get retrieves a rollout.
+func (s *InMemoryStore) Get(_ context.Context, id uuid.UUID) (*Rollout, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rollout, ok := s.rollouts[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return rollout.clone(), nil
+}
+
+// List returns all rollouts, newest first.
+func (s *InMemoryStore) List(context.Context) ([]*Rollout, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rollouts := make([]*Rollout, 0, len(s.rollouts))
+	for _, rollout := range s.rollouts {
+		rollouts = append(rollouts, rollout.clone())
+	}
+	sort.Slice(rollouts, func(i, j int) bool {
+		return rollouts[i].CreatedAt.After(rollouts[j].CreatedAt)
+	})
+	return rollouts, nil
+}
+
+// Put upserts a rollout.
+func (s *InMemoryStore) Put(_ context.Context, rollout *Rollout) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rollout.UpdatedAt = time.Now()
+	s.rollouts[rollout.ID] = rollout.clone()
+	return nil
+}
+
+// clone returns a deep copy of the rollout.
+func (r *Rollout) clone() *Rollout {
+	c := *r
+	c.Components = append(c.Components[:0:0], r.Components...)
+	c.Policy.WavePercents = append(c.Policy.WavePercents[:0:0], r.Policy.WavePercents...)
+	c.Waves = make([]Wave, len(r.Waves))
+	for i, w := range r.Waves {
+		w.Targets = append(w.Targets[:0:0], w.Targets...)
+		for j := range w.Targets {
+			w.Targets[j].UpdateIDs = append(w.Targets[j].UpdateIDs[:0:0], w.Targets[j].UpdateIDs...)
+		}
+		c.Waves[i] = w
+	}
+	return &c
+}
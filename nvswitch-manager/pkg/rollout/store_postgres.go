@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rollout
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Ensure PostgresStore implements Store.
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	db *bun.DB
+}
+
+// NewPostgresStore creates a new PostgreSQL-backed store.
+func NewPostgresStore(db *bun.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// RolloutModel is the database model for rollouts.
+// This maps to the firmware_rollout table; policy and waves are stored as JSON.
+type RolloutModel struct {
+	bun.BaseModel `bun:"table:firmware_rollout,alias:fr"`
+
+	ID            uuid.UUID            `bun:"id,pk,type:uuid"`
+	BundleVersion string               `bun:"bundle_version,notnull"`
+	Components    []nvswitch.Component `bun:"components,type:jsonb"`
+	Policy        Policy               `bun:"policy,type:jsonb"`
+	State         State                `bun:"state,notnull"`
+	CurrentWave   int                  `bun:"current_wave,notnull"`
+	Waves         []Wave               `bun:"waves,type:jsonb"`
+	Message       string               `bun:"message"`
+	CreatedAt     time.Time            `bun:"created_at,notnull,default:now()"`
+	UpdatedAt     time.Time            `bun:"updated_at,notnull,default:now()"`
+}
+
+func toModel(r *Rollout) *RolloutModel {
+	return &RolloutModel{
+		ID:            r.ID,
+		BundleVersion: r.BundleVersion,
+		Components:    r.Components,
+		Policy:        r.Policy,
+		State:         r.State,
+		CurrentWave:   r.CurrentWave,
+		Waves:         r.Waves,
+		Message:       r.Message,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+func fromModel(m *RolloutModel) *Rollout {
+	return &Rollout{
+		ID:            m.ID,
+		BundleVersion: m.BundleVersion,
+		Components:    m.Components,
+		Policy:        m.Policy,
+		State:         m.State,
+		CurrentWave:   m.CurrentWave,
+		Waves:         m.Waves,
+		Message:       m.Message,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// Get retrieves a rollout.
+func (s *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*Rollout, error) {
+	model := &RolloutModel{}
+	err := s.db.NewSelect().
+		Model(model).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return fromModel(model), nil
+}
+
+// List returns all rollouts, newest first.
+func (s *PostgresStore) List(ctx context.Context) ([]*Rollout, error) {
+	var models []*RolloutModel
+	err := s.db.NewSelect().
+		Model(&models).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rollouts := make([]*Rollout, len(models))
+	for i, m := range models {
+		rollouts[i] = fromModel(m)
+	}
+	return rollouts, nil
+}
+
+// Put upserts a rollout.
+func (s *PostgresStore) Put(ctx context.Context, rollout *Rollout) error {
+	rollout.UpdatedAt = time.Now()
+
+	_, err := s.db.NewInsert().
+		Model(toModel(rollout)).
+		On("CONFLICT (id) DO UPDATE").
+		Set("policy = EXCLUDED.policy").
+		Set("state = EXCLUDED.state").
+		Set("current_wave = EXCLUDED.current_wave").
+		Set("waves = EXCLUDED.waves").
+		Set("message = EXCLUDED.message").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
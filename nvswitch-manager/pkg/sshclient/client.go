@@ -20,24 +20,69 @@
 package sshclient
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
 	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvos"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
+// safeUserRe matches the characters NVOS allows in user names.
+var safeUserRe = regexp.MustCompile(`^[a-z_][a-z0-9_.\-]*$`)
+
 // safePathRe matches paths that contain only alphanumerics, slashes,
 // hyphens, underscores, and dots -- the characters safe to use in a
 // shell argument without quoting.
 var safePathRe = regexp.MustCompile(`^[a-zA-Z0-9/_.\-]+$`)
 
+// ErrHostKeyMismatch is returned when an NVOS presents a host key that differs
+// from the one pinned for it.
+var ErrHostKeyMismatch = errors.New("NVOS host key mismatch")
+
+// ErrHostKeyNotPinned is returned when connecting to an NVOS that has no pinned
+// host key and no HostKeyRecorder was given to pin one.
+var ErrHostKeyNotPinned = errors.New("NVOS host key not pinned")
+
+// HostKeyRecorder persists the host key presented by an NVOS that has no pinned
+// key yet (trust on first use). Returning an error aborts the connection.
+type HostKeyRecorder func(key ssh.PublicKey) error
+
+// Option configures how an NVOSClient connects.
+type Option func(*options)
+
+type options struct {
+	recorder HostKeyRecorder
+	insecure bool
+}
+
+// WithHostKeyRecorder pins the host key presented on the first connection to
+// an NVOS without a pinned key. Ignored once a key is pinned.
+func WithHostKeyRecorder(r HostKeyRecorder) Option {
+	return func(o *options) {
+		o.recorder = r
+	}
+}
+
+// WithInsecureHostKey accepts any host key when none is pinned. Only meant for
+// ad-hoc CLI access to switches that are not registered.
+func WithInsecureHostKey() Option {
+	return func(o *options) {
+		o.insecure = true
+	}
+}
+
 // NVOSClient provides SSH-based operations for NV-Switch NVOS.
 type NVOSClient struct {
 	nvos   *nvos.NVOS
@@ -45,12 +90,19 @@ type NVOSClient struct {
 }
 
 // New creates a new NVOSClient for the given NVOS subsystem using its configured port.
-func New(ctx context.Context, n *nvos.NVOS) (*NVOSClient, error) {
-	return NewWithPort(ctx, n, n.GetPort())
+func New(ctx context.Context, n *nvos.NVOS, opts ...Option) (*NVOSClient, error) {
+	if n == nil {
+		return nil, fmt.Errorf("NVOS is nil")
+	}
+	return NewWithPort(ctx, n, n.GetPort(), opts...)
 }
 
 // NewWithPort creates a new NVOSClient for the given NVOS subsystem with a custom port.
-func NewWithPort(ctx context.Context, n *nvos.NVOS, port int) (*NVOSClient, error) {
+//
+// If the NVOS has a pinned host key, the server must present exactly that key.
+// Otherwise the key is pinned through WithHostKeyRecorder, accepted blindly with
+// WithInsecureHostKey, or the connection is refused with ErrHostKeyNotPinned.
+func NewWithPort(ctx context.Context, n *nvos.NVOS, port int, opts ...Option) (*NVOSClient, error) {
 	if n == nil {
 		return nil, fmt.Errorf("NVOS is nil")
 	}
@@ -59,19 +111,45 @@ func NewWithPort(ctx context.Context, n *nvos.NVOS, port int) (*NVOSClient, erro
 		return nil, fmt.Errorf("NVOS credentials not set")
 	}
 
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	auth, err := authMethods(n.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := net.JoinHostPort(n.IP.String(), fmt.Sprintf("%d", port))
+
 	config := &ssh.ClientConfig{
-		User: n.Credential.User,
-		Auth: []ssh.AuthMethod{
-			ssh.Password(n.Credential.Password.Value),
-		},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		Timeout:         30 * time.Second,
+		User:    n.Credential.User,
+		Auth:    auth,
+		Timeout: 30 * time.Second,
+	}
+
+	if n.HasHostKey() {
+		pinned, err := ParseHostKey(n.HostKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pinned host key for NVOS at %s: %v", addr, err)
+		}
+		config.HostKeyCallback = pinnedHostKeyCallback(pinned)
+		config.HostKeyAlgorithms = hostKeyAlgorithms(pinned)
+	} else {
+		switch {
+		case o.recorder != nil:
+			config.HostKeyCallback = recordingHostKeyCallback(n, o.recorder)
+		case o.insecure:
+			config.HostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint:gosec // Explicitly requested for unregistered switches
+		default:
+			return nil, fmt.Errorf("%w for NVOS at %s", ErrHostKeyNotPinned, addr)
+		}
 	}
 
-	addr := fmt.Sprintf("%s:%d", n.IP.String(), port)
 	client, err := ssh.Dial("tcp", addr, config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to NVOS at %s: %v", addr, err)
+		return nil, fmt.Errorf("failed to connect to NVOS at %s: %w", addr, err)
 	}
 
 	return &NVOSClient{
@@ -80,6 +158,98 @@ func NewWithPort(ctx context.Context, n *nvos.NVOS, port int) (*NVOSClient, erro
 	}, nil
 }
 
+// authMethods returns the SSH auth methods for the credential, preferring key
+// authentication when a private key is set.
+func authMethods(cred *credential.Credential) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
+
+	if cred.HasPrivateKey() {
+		signer, err := ssh.ParsePrivateKey([]byte(cred.PrivateKey.Value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse NVOS private key: %v", err)
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if !cred.Password.IsEmpty() {
+		methods = append(methods, ssh.Password(cred.Password.Value))
+	}
+
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("NVOS credentials have neither a password nor a private key")
+	}
+
+	return methods, nil
+}
+
+// pinnedHostKeyCallback accepts only the pinned host key.
+func pinnedHostKeyCallback(pinned ssh.PublicKey) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if bytes.Equal(key.Marshal(), pinned.Marshal()) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s presented %s, expected %s",
+			ErrHostKeyMismatch, hostname, ssh.FingerprintSHA256(key), ssh.FingerprintSHA256(pinned))
+	}
+}
+
+// recordingHostKeyCallback pins the presented host key on first use and
+// updates the NVOS so later connections through it are verified.
+func recordingHostKeyCallback(n *nvos.NVOS, record HostKeyRecorder) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if err := record(key); err != nil {
+			return fmt.Errorf("failed to pin host key %s for %s: %w", ssh.FingerprintSHA256(key), hostname, err)
+		}
+		n.SetHostKey(FormatHostKey(key))
+		return nil
+	}
+}
+
+// hostKeyAlgorithms restricts negotiation to the algorithms of the pinned key
+// so that a server holding several host keys presents the pinned one.
+func hostKeyAlgorithms(pinned ssh.PublicKey) []string {
+	if pinned.Type() == ssh.KeyAlgoRSA {
+		return []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+	}
+	return []string{pinned.Type()}
+}
+
+// FormatHostKey returns the host key in authorized_keys format, as stored in
+// the registry.
+func FormatHostKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
+
+// ParseHostKey parses a host key in authorized_keys format.
+func ParseHostKey(s string) (ssh.PublicKey, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// HostKeyFingerprint returns the SHA256 fingerprint of a host key in
+// authorized_keys format, or an empty string if it cannot be parsed.
+func HostKeyFingerprint(s string) string {
+	key, err := ParseHostKey(s)
+	if err != nil {
+		return ""
+	}
+	return ssh.FingerprintSHA256(key)
+}
+
+// KnownHostsLine returns a known_hosts line pinning the NVOS host key at the
+// given port, for use by external ssh/scp commands.
+func KnownHostsLine(n *nvos.NVOS, port int) (string, error) {
+	key, err := ParseHostKey(n.HostKey)
+	if err != nil {
+		return "", err
+	}
+	addr := knownhosts.Normalize(net.JoinHostPort(n.IP.String(), fmt.Sprintf("%d", port)))
+	return knownhosts.Line([]string{addr}, key), nil
+}
+
 // Close closes the SSH connection.
 func (c *NVOSClient) Close() error {
 	if c.client != nil {
@@ -104,6 +274,41 @@ func (c *NVOSClient) RunCommand(cmd string) (string, error) {
 	return string(output), nil
 }
 
+// ChangePassword sets the password of an NVOS user and applies the
+// configuration. Sessions already open stay valid.
+func (c *NVOSClient) ChangePassword(user, newPassword string) error {
+	cmd, err := setPasswordCommand(user, newPassword)
+	if err != nil {
+		return err
+	}
+
+	// The output echoes the command line, which holds the password hash.
+	if _, err := c.RunCommand(cmd); err != nil {
+		return fmt.Errorf("failed to change the password of %s", user)
+	}
+
+	return nil
+}
+
+// setPasswordCommand builds the NVOS CLI command setting a user's password.
+// Only the SHA-512 crypt hash of the password is passed on the command line,
+// it consists of crypt(3) characters only and can't break out of its quotes.
+func setPasswordCommand(user, password string) (string, error) {
+	if !safeUserRe.MatchString(user) {
+		return "", fmt.Errorf("invalid NVOS user name: %q", user)
+	}
+	if password == "" {
+		return "", fmt.Errorf("NVOS password must be non-empty")
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("nv set system aaa user %s hashed-password '%s' && nv config apply -y", user, hash), nil
+}
+
 // CopyFile copies a local file to the remote NVOS.
 func (c *NVOSClient) CopyFile(localPath, remotePath string) error {
 	remotePath = filepath.Clean(remotePath)
@@ -0,0 +1,370 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sshclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/common/secretstring"
+	"github.com/NVIDIA/ncx-infra-controller-rest/nvswitch-manager/pkg/objects/nvos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testUser     = "admin"
+	testPassword = "nvos_secret"
+)
+
+// testServer is a minimal SSH server accepting password auth and, if set,
+// the authorized client key. It only completes handshakes.
+type testServer struct {
+	addr    *net.TCPAddr
+	hostKey ssh.PublicKey
+}
+
+func newTestSigner(t *testing.T) (ssh.Signer, []byte) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+	return signer, pem.EncodeToMemory(block)
+}
+
+func startTestServer(t *testing.T, authorized ssh.PublicKey) *testServer {
+	t.Helper()
+
+	hostSigner, _ := newTestSigner(t)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == testUser && string(pass) == testPassword {
+				return nil, nil
+			}
+			return nil, errors.New("bad password")
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if authorized != nil && bytes.Equal(key.Marshal(), authorized.Marshal()) {
+				return nil, nil
+			}
+			return nil, errors.New("unauthorized key")
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				sconn, chans, reqs, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					conn.Close()
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for ch := range chans {
+					ch.Reject(ssh.Prohibited, "not supported")
+				}
+				sconn.Close()
+			}()
+		}
+	}()
+
+	return &testServer{
+		addr:    ln.Addr().(*net.TCPAddr),
+		hostKey: hostSigner.PublicKey(),
+	}
+}
+
+func (s *testServer) nvos(t *testing.T, cred *credential.Credential) *nvos.NVOS {
+	t.Helper()
+	n, err := nvos.New("00:11:22:33:44:55", s.addr.IP.String(), cred)
+	require.NoError(t, err)
+	n.SetPort(s.addr.Port)
+	return n
+}
+
+func TestNew_HostKeyVerification(t *testing.T) {
+	srv := startTestServer(t, nil)
+	otherSigner, _ := newTestSigner(t)
+
+	testCases := map[string]struct {
+		pinned      string
+		opts        func(recorded *[]ssh.PublicKey) []Option
+		wantErrIs   error
+		errContains string
+		wantPinned  string
+		wantRecords int
+	}{
+		"pinned key matches": {
+			pinned:     FormatHostKey(srv.hostKey),
+			wantPinned: FormatHostKey(srv.hostKey),
+		},
+		"pinned key mismatch is refused": {
+			pinned:     FormatHostKey(otherSigner.PublicKey()),
+			wantErrIs:  ErrHostKeyMismatch,
+			wantPinned: FormatHostKey(otherSigner.PublicKey()),
+		},
+		"pinned key mismatch is refused even with a recorder": {
+			pinned: FormatHostKey(otherSigner.PublicKey()),
+			opts: func(recorded *[]ssh.PublicKey) []Option {
+				return []Option{WithHostKeyRecorder(func(key ssh.PublicKey) error {
+					*recorded = append(*recorded, key)
+					return nil
+				})}
+			},
+			wantErrIs:  ErrHostKeyMismatch,
+			wantPinned: FormatHostKey(otherSigner.PublicKey()),
+		},
+		"unpinned key without recorder is refused": {
+			wantErrIs: ErrHostKeyNotPinned,
+		},
+		"unpinned key is pinned on first use": {
+			opts: func(recorded *[]ssh.PublicKey) []Option {
+				return []Option{WithHostKeyRecorder(func(key ssh.PublicKey) error {
+					*recorded = append(*recorded, key)
+					return nil
+				})}
+			},
+			wantPinned:  FormatHostKey(srv.hostKey),
+			wantRecords: 1,
+		},
+		"recorder failure aborts the connection": {
+			opts: func(recorded *[]ssh.PublicKey) []Option {
+				return []Option{WithHostKeyRecorder(func(key ssh.PublicKey) error {
+					return errors.New("registry unavailable")
+				})}
+			},
+			errContains: "registry unavailable",
+		},
+		"insecure option accepts any key without pinning": {
+			opts: func(recorded *[]ssh.PublicKey) []Option {
+				return []Option{WithInsecureHostKey()}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			n := srv.nvos(t, credential.New(testUser, testPassword))
+			n.SetHostKey(tc.pinned)
+
+			var recorded []ssh.PublicKey
+			var opts []Option
+			if tc.opts != nil {
+				opts = tc.opts(&recorded)
+			}
+
+			client, err := New(context.Background(), n, opts...)
+			switch {
+			case tc.wantErrIs != nil:
+				assert.ErrorIs(t, err, tc.wantErrIs)
+			case tc.errContains != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+			default:
+				require.NoError(t, err)
+				client.Close()
+			}
+
+			assert.Equal(t, tc.wantPinned, n.GetHostKey())
+			assert.Len(t, recorded, tc.wantRecords)
+		})
+	}
+}
+
+func TestNew_PrivateKeyAuth(t *testing.T) {
+	clientSigner, clientKeyPEM := newTestSigner(t)
+	srv := startTestServer(t, clientSigner.PublicKey())
+
+	cred := &credential.Credential{
+		User:       testUser,
+		PrivateKey: secretstring.New(string(clientKeyPEM)),
+	}
+	n := srv.nvos(t, cred)
+	n.SetHostKey(FormatHostKey(srv.hostKey))
+
+	client, err := New(context.Background(), n)
+	require.NoError(t, err)
+	client.Close()
+}
+
+func TestNew_InvalidCredentials(t *testing.T) {
+	testCases := map[string]struct {
+		cred        *credential.Credential
+		errContains string
+	}{
+		"no credentials": {
+			cred:        nil,
+			errContains: "credentials not set",
+		},
+		"neither password nor key": {
+			cred:        &credential.Credential{User: testUser},
+			errContains: "neither a password nor a private key",
+		},
+		"unparseable private key": {
+			cred: &credential.Credential{
+				User:       testUser,
+				PrivateKey: secretstring.New("not a key"),
+			},
+			errContains: "failed to parse NVOS private key",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			n, err := nvos.New("00:11:22:33:44:55", "127.0.0.1", tc.cred)
+			require.NoError(t, err)
+
+			_, err = New(context.Background(), n, WithInsecureHostKey())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestKnownHostsLine(t *testing.T) {
+	signer, _ := newTestSigner(t)
+	hostKey := FormatHostKey(signer.PublicKey())
+
+	testCases := map[string]struct {
+		ip       string
+		port     int
+		wantHost string
+	}{
+		"default port": {
+			ip:       "10.0.0.2",
+			port:     22,
+			wantHost: "10.0.0.2",
+		},
+		"custom port": {
+			ip:       "10.0.0.2",
+			port:     2222,
+			wantHost: "[10.0.0.2]:2222",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			n, err := nvos.New("00:11:22:33:44:55", tc.ip, nil)
+			require.NoError(t, err)
+			n.SetHostKey(hostKey)
+
+			line, err := KnownHostsLine(n, tc.port)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantHost+" "+hostKey, line)
+		})
+	}
+}
+
+func TestHostKeyFingerprint(t *testing.T) {
+	signer, _ := newTestSigner(t)
+
+	fp := HostKeyFingerprint(FormatHostKey(signer.PublicKey()))
+	assert.True(t, strings.HasPrefix(fp, "SHA256:"))
+	assert.Equal(t, ssh.FingerprintSHA256(signer.PublicKey()), fp)
+
+	assert.Empty(t, HostKeyFingerprint("garbage"))
+}
+
+func TestSetPasswordCommand(t *testing.T) {
+	testCases := map[string]struct {
+		user     string
+		password string
+		wantErr  bool
+	}{
+		"valid": {
+			user:     "admin",
+			password: "N3w-Passw0rd!",
+		},
+		"quote in password": {
+			user:     "admin",
+			password: "pass'; reboot '",
+		},
+		"newline in password": {
+			user:     "admin",
+			password: "pass\nword",
+		},
+		"empty password": {
+			user:    "admin",
+			wantErr: true,
+		},
+		"invalid user": {
+			user:     "admin; reboot",
+			password: "N3w-Passw0rd!",
+			wantErr:  true,
+		},
+	}
+
+	cmdRe := regexp.MustCompile(`^nv set system aaa user admin hashed-password '(\$6\$([./0-9A-Za-z]{16})\$[./0-9A-Za-z]{86})' && nv config apply -y$`)
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cmd, err := setPasswordCommand(tc.user, tc.password)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotContains(t, cmd, tc.password)
+
+			m := cmdRe.FindStringSubmatch(cmd)
+			require.NotNil(t, m, cmd)
+			assert.Equal(t, sha512Crypt(tc.password, m[2]), m[1])
+		})
+	}
+}
+
+func TestSHA512Crypt(t *testing.T) {
+	// Test vectors of the SHA-crypt specification for the default rounds.
+	assert.Equal(t,
+		"$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+		sha512Crypt("Hello world!", "saltstring"))
+	assert.Equal(t,
+		"$6$toolongsaltstrin$lQ8jolhgVRVhY4b5pZKaysCLi0QBxGoNeKQzQ3glMhwllF7oGDZxUhx1yxdYcz/e1JSbq3y6JMxxl8audkUEm0",
+		sha512Crypt("This is just a test", "toolongsaltstringtoolongsaltstring"))
+
+	hash, err := hashPassword("N3w-Passw0rd!")
+	require.NoError(t, err)
+	other, err := hashPassword("N3w-Passw0rd!")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+	assert.Equal(t, sha512Crypt("N3w-Passw0rd!", hash[3:19]), hash)
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sshclient
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// cryptAlphabet is the alphabet of crypt(3) salts and hashes.
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const (
+	sha512CryptSaltLen = 16
+	sha512CryptRounds  = 5000
+)
+
+// sha512CryptPermutation is the order in which the digest bytes are encoded,
+// three at a time.
+var sha512CryptPermutation = [21][3]int{
+	{0, 21, 42}, {22, 43, 1}, {44, 2, 23}, {3, 24, 45}, {25, 46, 4},
+	{47, 5, 26}, {6, 27, 48}, {28, 49, 7}, {50, 8, 29}, {9, 30, 51},
+	{31, 52, 10}, {53, 11, 32}, {12, 33, 54}, {34, 55, 13}, {56, 14, 35},
+	{15, 36, 57}, {37, 58, 16}, {59, 17, 38}, {18, 39, 60}, {40, 61, 19},
+	{62, 20, 41},
+}
+
+// hashPassword returns the SHA-512 crypt(3) hash ("$6$salt$hash") of password
+// with a random salt, the format NVOS accepts as a hashed password.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, sha512CryptSaltLen)
+	for i := range salt {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(cryptAlphabet))))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate password salt: %v", err)
+		}
+		salt[i] = cryptAlphabet[n.Int64()]
+	}
+
+	return sha512Crypt(password, string(salt)), nil
+}
+
+// sha512Crypt implements the SHA-512 crypt(3) scheme with the default number
+// of rounds. salt is truncated to 16 characters.
+func sha512Crypt(password, salt string) string {
+	if len(salt) > sha512CryptSaltLen {
+		salt = salt[:sha512CryptSaltLen]
+	}
+	p, s := []byte(password), []byte(salt)
+
+	h := sha512.New()
+	h.Write(p)
+	h.Write(s)
+	h.Write(p)
+	b := h.Sum(nil)
+
+	h.Reset()
+	h.Write(p)
+	h.Write(s)
+	h.Write(repeatBytes(b, len(p)))
+	for n := len(p); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			h.Write(b)
+		} else {
+			h.Write(p)
+		}
+	}
+	a := h.Sum(nil)
+
+	h.Reset()
+	for range p {
+		h.Write(p)
+	}
+	pSeq := repeatBytes(h.Sum(nil), len(p))
+
+	h.Reset()
+	for i := 0; i < 16+int(a[0]); i++ {
+		h.Write(s)
+	}
+	sSeq := repeatBytes(h.Sum(nil), len(s))
+
+	c := a
+	for i := 0; i < sha512CryptRounds; i++ {
+		h.Reset()
+		if i&1 != 0 {
+			h.Write(pSeq)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(sSeq)
+		}
+		if i%7 != 0 {
+			h.Write(pSeq)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(pSeq)
+		}
+		c = h.Sum(nil)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("$6$")
+	sb.WriteString(salt)
+	sb.WriteString("$")
+	for _, g := range sha512CryptPermutation {
+		encodeCrypt24(&sb, c[g[0]], c[g[1]], c[g[2]], 4)
+	}
+	encodeCrypt24(&sb, 0, 0, c[63], 2)
+
+	return sb.String()
+}
+
+// repeatBytes repeats b until it is n bytes long.
+func repeatBytes(b []byte, n int) []byte {
+	out := make([]byte, 0, n)
+	for len(out) < n {
+		out = append(out, b[:min(len(b), n-len(out))]...)
+	}
+	return out
+}
+
+// encodeCrypt24 writes the n least significant 6-bit groups of the 24-bit
+// value b2 b1 b0 using the crypt(3) alphabet.
+func encodeCrypt24(sb *strings.Builder, b2, b1, b0 byte, n int) {
+	w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+	for i := 0; i < n; i++ {
+		sb.WriteByte(cryptAlphabet[w&0x3f])
+		w >>= 6
+	}
+}
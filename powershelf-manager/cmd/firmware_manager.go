@@ -86,6 +86,8 @@ func init() {
 	fwCmd.Flags().StringVar(&versionTo, "version", "", "Target Version to upgrade to")
 	fwCmd.Flags().StringVarP(&pmcMAC, "mac", "m", "", "PMC MAC address")
 	fwCmd.Flags().StringVar(&firmwareDir, "fw_dir", getEnvOrDefault("FW_DIR", "/var/lib/psm/firmware"), "Firmware files directory (env: FW_DIR)")
+	fwCmd.Flags().StringVar(&firmwareTrustBundle, "fw_trust_bundle", getEnvOrDefault("FW_TRUST_BUNDLE", ""), "PEM file of the public keys and CA certificates firmware manifest signatures are verified against (env: FW_TRUST_BUNDLE)")
+	fwCmd.Flags().BoolVar(&firmwareAllowUnsigned, "fw_allow_unsigned", getEnvBoolOrDefault("FW_ALLOW_UNSIGNED", false), "Allow firmware updates from unsigned manifests; checksums are still verified (env: FW_ALLOW_UNSIGNED)")
 }
 
 func doFw() {
@@ -115,7 +117,9 @@ func doFw() {
 			Credential:        credential.New(dbUser, dbPassword),
 			CACertificatePath: "",
 		},
-		FirmwareDir: firmwareDir,
+		FirmwareDir:           firmwareDir,
+		FirmwareTrustBundle:   firmwareTrustBundle,
+		FirmwareAllowUnsigned: firmwareAllowUnsigned,
 	}
 
 	psmConfig, err := svcConfig.ToPsmConf()
@@ -38,6 +38,7 @@ var pmcUsername string
 var pmcPassword string
 var redfish_action string
 var firmwarePath string
+var powerLimitWatts float64
 
 type Action string
 
@@ -58,6 +59,8 @@ const (
 	UploadFirmwareFile               Action = "upload_firmware_file"
 	QueryPowerSubsystem              Action = "query_power_subsystem"
 	QueryPowerSupply                 Action = "query_power_supply"
+	QueryPowerLimit                  Action = "query_power_limit"
+	SetPowerLimit                    Action = "set_power_limit"
 )
 
 var actions = []Action{
@@ -76,6 +79,8 @@ var actions = []Action{
 	SetHttpPushUriApplyTimeImmediate,
 	UploadFirmwareFile,
 	QueryPowerSupply,
+	QueryPowerLimit,
+	SetPowerLimit,
 }
 
 // redfishCmd represents the redfish command
@@ -113,6 +118,7 @@ func init() {
 	redfishCmd.Flags().StringVarP(&pmcPassword, "pass", "p", "0penBmc", "Password")
 	redfishCmd.Flags().StringVarP(&redfish_action, "action", "a", "", "Action to perform: "+getAvailableActions())
 	redfishCmd.Flags().StringVarP(&firmwarePath, "firmware", "f", "", "Path to firmware file (for upload_firmware_file action)")
+	redfishCmd.Flags().Float64VarP(&powerLimitWatts, "limit", "l", 0, "Power limit in watts, 0 disables power capping (for set_power_limit action)")
 }
 
 func doRedfish() {
@@ -258,6 +264,17 @@ func doRedfish() {
 				fmt.Printf("%s", psu.Summary())
 			}
 		}
+	case QueryPowerLimit:
+		limit, err := client.QueryPowerLimit()
+		if err != nil {
+			log.Fatalf("failed to query power limit: %v\n", err)
+		}
+		fmt.Printf("Power Limit: %v W\n", limit)
+	case SetPowerLimit:
+		if err := client.SetPowerLimit(powerLimitWatts); err != nil {
+			log.Fatalf("failed to set power limit: %v\n", err)
+		}
+		fmt.Printf("Power Limit set to %v W\n", powerLimitWatts)
 	default:
 		log.Fatalf("unknown action: %v\n", err)
 	}
@@ -23,6 +23,7 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -33,6 +34,7 @@ import (
 	svc "github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/internal/service"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentials"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/powershelfmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
 )
 
 // getEnvOrDefault returns the value of an environment variable or a default value.
@@ -53,9 +55,30 @@ func getEnvIntOrDefault(envVar string, defaultVal int) int {
 	return defaultVal
 }
 
+// getEnvBoolOrDefault returns the bool value of an environment variable or a default value.
+func getEnvBoolOrDefault(envVar string, defaultVal bool) bool {
+	if val := os.Getenv(envVar); val != "" {
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+	return defaultVal
+}
+
+// getEnvDurationOrDefault returns the duration value of an environment variable or a default value.
+func getEnvDurationOrDefault(envVar string, defaultVal time.Duration) time.Duration {
+	if val := os.Getenv(envVar); val != "" {
+		if d, err := time.ParseDuration(val); err == nil {
+			return d
+		}
+	}
+	return defaultVal
+}
+
 const (
 	// default service config
 	defaultServicePort   = 50051
+	defaultMetricsPort   = 9090
 	defaultDataStoreType = powershelfmanager.DatastoreTypeInMemory
 
 	// default db config
@@ -76,6 +99,23 @@ var (
 	port          int
 	datastoreType string
 
+	// Telemetry config
+	metricsPort        int
+	telemetryRetention time.Duration
+
+	// Credential rotation config
+	credentialRotationInterval time.Duration
+
+	// Redfish event config
+	eventListenAddress string
+	eventDestination   string
+	eventToken         string
+
+	// Firmware compliance config
+	complianceScanInterval time.Duration
+	complianceRemediation  bool
+	complianceWindow       string
+
 	// DB config
 	dbUser     string
 	dbPassword string
@@ -89,7 +129,9 @@ var (
 	vaultAddress string
 
 	// Firmware config
-	firmwareDir string
+	firmwareDir           string
+	firmwareTrustBundle   string
+	firmwareAllowUnsigned bool
 )
 
 // serveCmd represents the serve command
@@ -122,6 +164,21 @@ func init() {
 	serveCmd.Flags().StringVarP(&vaultAddress, "vault_address", "a", getEnvOrDefault("VAULT_ADDR", defaultVaultAddress), "Vault Address (env: VAULT_ADDR)")
 
 	serveCmd.Flags().StringVar(&firmwareDir, "fw_dir", getEnvOrDefault("FW_DIR", "/var/lib/psm/firmware"), "Firmware files directory (env: FW_DIR)")
+	serveCmd.Flags().StringVar(&firmwareTrustBundle, "fw_trust_bundle", getEnvOrDefault("FW_TRUST_BUNDLE", ""), "PEM file of the public keys and CA certificates firmware manifest signatures are verified against (env: FW_TRUST_BUNDLE)")
+	serveCmd.Flags().BoolVar(&firmwareAllowUnsigned, "fw_allow_unsigned", getEnvBoolOrDefault("FW_ALLOW_UNSIGNED", false), "Allow firmware updates from unsigned manifests; checksums are still verified (env: FW_ALLOW_UNSIGNED)")
+
+	serveCmd.Flags().IntVar(&metricsPort, "metrics_port", getEnvIntOrDefault("PSM_METRICS_PORT", defaultMetricsPort), "Port for the Prometheus /metrics endpoint, 0 to disable (env: PSM_METRICS_PORT)")
+	serveCmd.Flags().DurationVar(&telemetryRetention, "telemetry_retention", telemetry.DefaultRetention, "How long powershelf telemetry history is kept in memory")
+
+	serveCmd.Flags().DurationVar(&credentialRotationInterval, "credential_rotation_interval", getEnvDurationOrDefault("PSM_CREDENTIAL_ROTATION_INTERVAL", 0), "Maximum age of a PMC password before it is rotated, e.g. 2160h for 90 days; 0 disables scheduled rotation (env: PSM_CREDENTIAL_ROTATION_INTERVAL)")
+
+	serveCmd.Flags().StringVar(&eventListenAddress, "event_listen_address", getEnvOrDefault("PSM_EVENT_LISTEN_ADDRESS", ""), "Address of the Redfish event receiver, e.g. :8081; empty disables it (env: PSM_EVENT_LISTEN_ADDRESS)")
+	serveCmd.Flags().StringVar(&eventDestination, "event_destination", getEnvOrDefault("PSM_EVENT_DESTINATION", ""), "URL PMCs push Redfish events to, e.g. http://psm:8081/redfish/events; empty uses PMC event streams instead (env: PSM_EVENT_DESTINATION)")
+	serveCmd.Flags().StringVar(&eventToken, "event_token", getEnvOrDefault("PSM_EVENT_TOKEN", ""), "Shared secret PMCs send with pushed Redfish events (env: PSM_EVENT_TOKEN)")
+
+	serveCmd.Flags().DurationVar(&complianceScanInterval, "compliance_scan_interval", getEnvDurationOrDefault("PSM_COMPLIANCE_SCAN_INTERVAL", 0), "How often powershelf firmware is checked against the firmware baselines; 0 disables scheduled checks (env: PSM_COMPLIANCE_SCAN_INTERVAL)")
+	serveCmd.Flags().BoolVar(&complianceRemediation, "compliance_remediation", getEnvBoolOrDefault("PSM_COMPLIANCE_REMEDIATION", false), "Queue upgrades of non-compliant PMC firmware during scheduled compliance checks (env: PSM_COMPLIANCE_REMEDIATION)")
+	serveCmd.Flags().StringVar(&complianceWindow, "compliance_window", getEnvOrDefault("PSM_COMPLIANCE_WINDOW", ""), "Daily UTC maintenance window remediation is limited to, e.g. 22:00-04:00; empty allows any time (env: PSM_COMPLIANCE_WINDOW)")
 }
 
 func doServe() {
@@ -142,11 +199,22 @@ func doServe() {
 				Credential:        credential.New(dbUser, dbPassword),
 				CACertificatePath: dbCertPath,
 			},
-			FirmwareDir: firmwareDir,
+			FirmwareDir:                firmwareDir,
+			FirmwareTrustBundle:        firmwareTrustBundle,
+			FirmwareAllowUnsigned:      firmwareAllowUnsigned,
+			MetricsPort:                metricsPort,
+			TelemetryRetention:         telemetryRetention,
+			CredentialRotationInterval: credentialRotationInterval,
+			EventListenAddress:         eventListenAddress,
+			EventDestination:           eventDestination,
+			EventToken:                 eventToken,
+			ComplianceScanInterval:     complianceScanInterval,
+			ComplianceRemediation:      complianceRemediation,
+			ComplianceWindow:           complianceWindow,
 		},
 	)
 
-	log.Printf("New service is created with port: %+v, data store type: %s, vault address: %s, firmware dir: %s", port, datastoreType, vaultAddress, firmwareDir)
+	log.Printf("New service is created with port: %+v, metrics port: %v, data store type: %s, vault address: %s, firmware dir: %s", port, metricsPort, datastoreType, vaultAddress, firmwareDir)
 
 	if err != nil {
 		log.Fatalf("failed to create the new gRPC server: %v\n", err)
@@ -17,10 +17,16 @@
 package service
 
 import (
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentials"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/pmcregistry"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/powershelfmanager"
 )
@@ -33,6 +39,29 @@ type Config struct {
 	VaultConf     credentials.VaultConfig
 	DBConf        cdb.Config
 	FirmwareDir   string
+	// FirmwareTrustBundle is the PEM file of the keys and CAs firmware manifest signatures are verified against.
+	FirmwareTrustBundle string
+	// FirmwareAllowUnsigned allows firmware updates from unsigned manifests.
+	FirmwareAllowUnsigned bool
+	// MetricsPort is the port of the Prometheus /metrics endpoint (disabled if 0).
+	MetricsPort int
+	// TelemetryRetention is how long powershelf telemetry history is kept in memory.
+	TelemetryRetention time.Duration
+	// CredentialRotationInterval is the maximum age of a PMC password before it is rotated (disabled if 0).
+	CredentialRotationInterval time.Duration
+	// EventListenAddress is the address of the Redfish event receiver (disabled if empty).
+	EventListenAddress string
+	// EventDestination is the receiver URL registered with PMC event subscriptions; PMC Server-Sent Event
+	// streams are used instead if empty.
+	EventDestination string
+	// EventToken is the shared secret PMCs send with pushed events.
+	EventToken string
+	// ComplianceScanInterval is how often powershelf firmware is checked against the baselines (disabled if 0).
+	ComplianceScanInterval time.Duration
+	// ComplianceRemediation queues upgrades of non-compliant PMC firmware during scheduled checks.
+	ComplianceRemediation bool
+	// ComplianceWindow is the daily UTC maintenance window ("HH:MM-HH:MM") remediation is limited to; always open if empty.
+	ComplianceWindow string
 }
 
 // toCredentialManagerConf converts the public service Config into a pmcregistry.Config,
@@ -82,11 +111,36 @@ func (c *Config) ToPsmConf() (*powershelfmanager.Config, error) {
 		return nil, err
 	}
 
+	window, err := compliance.ParseWindow(c.ComplianceWindow)
+	if err != nil {
+		return nil, fmt.Errorf("invalid compliance maintenance window: %w", err)
+	}
+
 	psmConf := powershelfmanager.Config{
 		DSType:          c.DataStoreType,
 		CredentialConf:  *credentialManagerConf,
 		PmcRegistryConf: *dataStoreConf,
 		FirmwareDir:     c.FirmwareDir,
+		FirmwareVerification: fwverify.Config{
+			TrustBundle:   c.FirmwareTrustBundle,
+			AllowUnsigned: c.FirmwareAllowUnsigned,
+		},
+		TelemetryRetention: c.TelemetryRetention,
+		CredentialRotation: credentialrotation.Config{
+			Interval: c.CredentialRotationInterval,
+		},
+		Events: eventmanager.Config{
+			ListenAddress: c.EventListenAddress,
+			Destination:   c.EventDestination,
+			Token:         c.EventToken,
+		},
+		Compliance: compliance.Config{
+			ScanInterval: c.ComplianceScanInterval,
+			Remediation: compliance.RemediationConfig{
+				Enabled: c.ComplianceRemediation,
+				Window:  window,
+			},
+		},
 	}
 
 	return &psmConf, nil
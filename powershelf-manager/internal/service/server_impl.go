@@ -18,19 +18,27 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/internal/proto/v1"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/converter/protobuf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/powershelfmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/redfish"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -143,6 +151,38 @@ func (s *PowershelfManagerServerImpl) GetPowershelves(ctx context.Context, req *
 	}, nil
 }
 
+// GetPowershelfTelemetry returns the recent telemetry history of the requested powershelves, or of all powershelves with telemetry if none are requested.
+func (s *PowershelfManagerServerImpl) GetPowershelfTelemetry(ctx context.Context, req *pb.GetPowershelfTelemetryRequest) (*pb.GetPowershelfTelemetryResponse, error) {
+	pmcMacs := req.PmcMacs
+	if len(pmcMacs) == 0 {
+		pmcMacs = s.psm.GetTelemetryPmcMacs(ctx)
+	}
+
+	since := protobuf.TelemetrySinceFrom(req.Since)
+	responses := make([]*pb.PowershelfTelemetry, 0, len(pmcMacs))
+	for _, pmcMac := range pmcMacs {
+		responses = append(responses, s.getPowershelfTelemetry(ctx, pmcMac, since))
+	}
+
+	return &pb.GetPowershelfTelemetryResponse{
+		Telemetry: responses,
+	}, nil
+}
+
+// getPowershelfTelemetry returns the telemetry history of a single powershelf.
+func (s *PowershelfManagerServerImpl) getPowershelfTelemetry(ctx context.Context, pmcMac string, since time.Time) *pb.PowershelfTelemetry {
+	mac, err := net.ParseMAC(pmcMac)
+	if err != nil {
+		return &pb.PowershelfTelemetry{
+			PmcMacAddress: pmcMac,
+			Status:        pb.StatusCode_INVALID_ARGUMENT,
+			Error:         fmt.Sprintf("invalid MAC address: %v", err),
+		}
+	}
+
+	return protobuf.PowershelfTelemetryTo(mac.String(), s.psm.GetPowershelfTelemetry(ctx, mac, since))
+}
+
 func (s *PowershelfManagerServerImpl) ListAvailableFirmware(ctx context.Context, req *pb.PowershelfRequest) (*pb.ListAvailableFirmwareResponse, error) {
 	responses := make([]*pb.AvailableFirmware, 0, len(req.PmcMacs))
 	for _, mac := range req.PmcMacs {
@@ -377,6 +417,173 @@ func (s *PowershelfManagerServerImpl) PowerOn(ctx context.Context, req *pb.Power
 	}, nil
 }
 
+// SetPowerLimit caps the power of each requested powershelf via Redfish PowerLimit.
+func (s *PowershelfManagerServerImpl) SetPowerLimit(ctx context.Context, req *pb.SetPowerLimitRequest) (*pb.PowerControlResponse, error) {
+	responses := make([]*pb.PowershelfResponse, 0, len(req.Limits))
+	for _, limit := range req.Limits {
+		responses = append(responses, s.setPowerLimit(ctx, limit.GetPmcMacAddress(), limit.GetLimitWatts()))
+	}
+
+	return &pb.PowerControlResponse{
+		Responses: responses,
+	}, nil
+}
+
+// setPowerLimit caps the power of a single powershelf. A limit of 0 disables power capping.
+func (s *PowershelfManagerServerImpl) setPowerLimit(ctx context.Context, pmc_mac string, watts float64) *pb.PowershelfResponse {
+	mac, err := net.ParseMAC(pmc_mac)
+	if err != nil {
+		return &pb.PowershelfResponse{
+			PmcMacAddress: pmc_mac,
+			Status:        pb.StatusCode_INVALID_ARGUMENT,
+			Error:         err.Error(),
+		}
+	}
+
+	if watts < 0 || math.IsNaN(watts) || math.IsInf(watts, 0) {
+		return &pb.PowershelfResponse{
+			PmcMacAddress: pmc_mac,
+			Status:        pb.StatusCode_INVALID_ARGUMENT,
+			Error:         fmt.Sprintf("invalid power limit %v W", watts),
+		}
+	}
+
+	if err := s.psm.SetPowerLimit(ctx, mac, watts); err != nil {
+		status := pb.StatusCode_INTERNAL_ERROR
+		if errors.Is(err, redfish.ErrPowerLimitNotSupported) {
+			status = pb.StatusCode_NOT_SUPPORTED
+		}
+		return &pb.PowershelfResponse{
+			PmcMacAddress: pmc_mac,
+			Status:        status,
+			Error:         err.Error(),
+		}
+	}
+
+	return &pb.PowershelfResponse{
+		PmcMacAddress: pmc_mac,
+		Status:        pb.StatusCode_SUCCESS,
+	}
+}
+
+// RotateCredentials starts rotating the PMC passwords of the specified powershelves in the background.
+func (s *PowershelfManagerServerImpl) RotateCredentials(ctx context.Context, req *pb.PowershelfRequest) (*pb.RotateCredentialsResponse, error) {
+	responses := make([]*pb.PowershelfResponse, 0, len(req.PmcMacs))
+	for _, pmcMac := range req.PmcMacs {
+		responses = append(responses, s.rotateCredential(ctx, pmcMac))
+	}
+
+	return &pb.RotateCredentialsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// rotateCredential starts rotating the PMC password of a single powershelf.
+func (s *PowershelfManagerServerImpl) rotateCredential(ctx context.Context, pmcMac string) *pb.PowershelfResponse {
+	mac, err := net.ParseMAC(pmcMac)
+	if err != nil {
+		return &pb.PowershelfResponse{
+			PmcMacAddress: pmcMac,
+			Status:        pb.StatusCode_INVALID_ARGUMENT,
+			Error:         fmt.Sprintf("invalid MAC address: %v", err),
+		}
+	}
+
+	if err := s.psm.RotateCredential(ctx, mac); err != nil {
+		return &pb.PowershelfResponse{
+			PmcMacAddress: pmcMac,
+			Status:        pb.StatusCode_INTERNAL_ERROR,
+			Error:         err.Error(),
+		}
+	}
+
+	return &pb.PowershelfResponse{
+		PmcMacAddress: pmcMac,
+		Status:        pb.StatusCode_SUCCESS,
+	}
+}
+
+// GetCredentialRotationStatus returns the latest credential rotation of the requested powershelves, or of all registered powershelves if none are requested.
+func (s *PowershelfManagerServerImpl) GetCredentialRotationStatus(ctx context.Context, req *pb.PowershelfRequest) (*pb.GetCredentialRotationStatusResponse, error) {
+	pmcMacs := req.PmcMacs
+	if len(pmcMacs) == 0 {
+		pmcs, err := s.psm.PmcManager.GetAllPmcs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pmc := range pmcs {
+			pmcMacs = append(pmcMacs, pmc.MAC.String())
+		}
+	}
+
+	statuses := make([]*pb.CredentialRotationStatus, 0, len(pmcMacs))
+	for _, pmcMac := range pmcMacs {
+		statuses = append(statuses, s.getCredentialRotationStatus(ctx, pmcMac))
+	}
+
+	return &pb.GetCredentialRotationStatusResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// getCredentialRotationStatus returns the latest credential rotation of a single powershelf.
+func (s *PowershelfManagerServerImpl) getCredentialRotationStatus(ctx context.Context, pmcMac string) *pb.CredentialRotationStatus {
+	mac, err := net.ParseMAC(pmcMac)
+	if err != nil {
+		return &pb.CredentialRotationStatus{
+			PmcMacAddress: pmcMac,
+			Status:        pb.StatusCode_INVALID_ARGUMENT,
+			Error:         fmt.Sprintf("invalid MAC address: %v", err),
+		}
+	}
+
+	status, err := s.psm.GetCredentialRotationStatus(ctx, mac)
+	if err != nil && !errors.Is(err, credentialrotation.ErrNotFound) {
+		return &pb.CredentialRotationStatus{
+			PmcMacAddress: pmcMac,
+			Status:        pb.StatusCode_INTERNAL_ERROR,
+			Error:         err.Error(),
+		}
+	}
+
+	return protobuf.CredentialRotationStatusTo(mac.String(), status, s.psm.CredentialRotation.NextRotation(status))
+}
+
+// StreamEvents streams the events of the requested powershelves, or of all registered powershelves if none are
+// requested, until the client cancels or the service stops.
+func (s *PowershelfManagerServerImpl) StreamEvents(req *pb.StreamEventsRequest, stream pb.PowershelfManager_StreamEventsServer) error {
+	macs, err := parseMacs(req.PmcMacs)
+	if err != nil {
+		return err
+	}
+
+	kinds := make([]events.Kind, 0, len(req.Kinds))
+	for _, pbKind := range req.Kinds {
+		kind, ok := protobuf.EventKindFrom(pbKind)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid event kind %v", pbKind)
+		}
+		kinds = append(kinds, kind)
+	}
+
+	sub := s.psm.SubscribeEvents(macs, kinds)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "event stream closed by the service")
+			}
+			if err := stream.Send(protobuf.EventTo(ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // powerTarget performs a power action against an unregistered device using inline connection details.
 func (s *PowershelfManagerServerImpl) powerTarget(ctx context.Context, target *pb.PowerTarget, on bool) *pb.PowershelfResponse {
 	ip := net.ParseIP(target.PmcIp)
@@ -434,3 +641,104 @@ func (s *PowershelfManagerServerImpl) SetDryRun(
 	s.psm.FirmwareManager.SetDryRun(to)
 	return &emptypb.Empty{}, nil
 }
+
+// SetFirmwareBaseline creates or replaces a named firmware baseline.
+func (s *PowershelfManagerServerImpl) SetFirmwareBaseline(ctx context.Context, req *pb.SetFirmwareBaselineRequest) (*pb.FirmwareBaseline, error) {
+	baseline, err := protobuf.FirmwareBaselineFrom(req.Baseline)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	baseline, err = s.psm.SetFirmwareBaseline(ctx, baseline)
+	switch {
+	case errors.Is(err, compliance.ErrInvalid):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, compliance.ErrConflict):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return protobuf.FirmwareBaselineTo(baseline), nil
+}
+
+// DeleteFirmwareBaseline deletes a firmware baseline.
+func (s *PowershelfManagerServerImpl) DeleteFirmwareBaseline(ctx context.Context, req *pb.DeleteFirmwareBaselineRequest) (*emptypb.Empty, error) {
+	if err := s.psm.DeleteFirmwareBaseline(ctx, req.Name); err != nil {
+		if errors.Is(err, compliance.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "firmware baseline %q not found", req.Name)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListFirmwareBaselines lists all firmware baselines.
+func (s *PowershelfManagerServerImpl) ListFirmwareBaselines(ctx context.Context, _ *emptypb.Empty) (*pb.ListFirmwareBaselinesResponse, error) {
+	baselines, err := s.psm.ListFirmwareBaselines(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListFirmwareBaselinesResponse{}
+	for _, baseline := range baselines {
+		resp.Baselines = append(resp.Baselines, protobuf.FirmwareBaselineTo(baseline))
+	}
+	return resp, nil
+}
+
+// GetFirmwareComplianceReport checks the firmware of the requested powershelves, or of all registered powershelves
+// if none are requested, against the firmware baselines.
+func (s *PowershelfManagerServerImpl) GetFirmwareComplianceReport(ctx context.Context, req *pb.PowershelfRequest) (*pb.GetFirmwareComplianceReportResponse, error) {
+	macs, err := parseMacs(req.PmcMacs)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.psm.GetFirmwareComplianceReport(ctx, macs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return protobuf.FirmwareComplianceReportTo(report), nil
+}
+
+// GetFirmwareDriftHistory returns the recorded firmware drift of the requested powershelves, most recent first.
+func (s *PowershelfManagerServerImpl) GetFirmwareDriftHistory(ctx context.Context, req *pb.GetFirmwareDriftHistoryRequest) (*pb.GetFirmwareDriftHistoryResponse, error) {
+	macs, err := parseMacs(req.PmcMacs)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit %d", req.Limit)
+	}
+
+	events, err := s.psm.GetFirmwareDriftHistory(ctx, compliance.DriftFilter{
+		PmcMacAddresses: macs,
+		Since:           protobuf.TelemetrySinceFrom(req.Since),
+		Limit:           int(req.Limit),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.GetFirmwareDriftHistoryResponse{}
+	for _, event := range events {
+		resp.Events = append(resp.Events, protobuf.FirmwareDriftEventTo(event))
+	}
+	return resp, nil
+}
+
+// parseMacs parses the requested PMC MAC addresses, returning an InvalidArgument status for a malformed one.
+func parseMacs(pmcMacs []string) ([]net.HardwareAddr, error) {
+	macs := make([]net.HardwareAddr, 0, len(pmcMacs))
+	for _, pmcMac := range pmcMacs {
+		mac, err := net.ParseMAC(pmcMac)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid MAC address %q: %v", pmcMac, err)
+		}
+		macs = append(macs, mac)
+	}
+	return macs, nil
+}
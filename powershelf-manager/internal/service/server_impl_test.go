@@ -18,11 +18,25 @@ package service
 
 import (
 	"context"
+	"math"
+	"net"
 	"testing"
+	"time"
 
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/internal/proto/v1"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentialrotation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/eventmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/powershelfmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func newTestServer() *PowershelfManagerServerImpl {
@@ -59,6 +73,34 @@ func TestPowerTarget_InvalidIP(t *testing.T) {
 	}
 }
 
+func TestSetPowerLimit_InvalidArgument(t *testing.T) {
+	tests := map[string]struct {
+		mac   string
+		watts float64
+		err   string
+	}{
+		"bad mac":        {mac: "not-a-mac", watts: 1000, err: "invalid MAC address"},
+		"negative limit": {mac: "00:11:22:33:44:55", watts: -1, err: "invalid power limit"},
+		"nan limit":      {mac: "00:11:22:33:44:55", watts: math.NaN(), err: "invalid power limit"},
+	}
+
+	s := newTestServer()
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := s.SetPowerLimit(context.Background(), &pb.SetPowerLimitRequest{
+				Limits: []*pb.PowerLimit{{PmcMacAddress: tc.mac, LimitWatts: tc.watts}},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.Responses, 1)
+
+			assert.Equal(t, pb.StatusCode_INVALID_ARGUMENT, resp.Responses[0].Status)
+			assert.Equal(t, tc.mac, resp.Responses[0].PmcMacAddress)
+			assert.Contains(t, resp.Responses[0].Error, tc.err)
+		})
+	}
+}
+
 func TestPowerTarget_NilCredentials(t *testing.T) {
 	s := newTestServer()
 	target := &pb.PowerTarget{
@@ -102,3 +144,261 @@ func TestPowerTarget_EmptyCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPowershelfTelemetry(t *testing.T) {
+	now := time.Now()
+	store := telemetry.NewStore(time.Hour)
+	for _, mac := range []string{"00:11:22:33:44:55", "00:11:22:33:44:66"} {
+		store.Record(&telemetry.ShelfSample{PmcMAC: mac, Timestamp: now.Add(-2 * time.Minute)})
+		store.Record(&telemetry.ShelfSample{PmcMAC: mac, Timestamp: now.Add(-time.Minute)})
+	}
+
+	s := &PowershelfManagerServerImpl{psm: &powershelfmanager.PowershelfManager{Telemetry: store}}
+
+	tests := map[string]struct {
+		req          *pb.GetPowershelfTelemetryRequest
+		wantMacs     []string
+		wantStatuses []pb.StatusCode
+		wantSamples  []int
+	}{
+		"all shelves": {
+			req:          &pb.GetPowershelfTelemetryRequest{},
+			wantMacs:     []string{"00:11:22:33:44:55", "00:11:22:33:44:66"},
+			wantStatuses: []pb.StatusCode{pb.StatusCode_SUCCESS, pb.StatusCode_SUCCESS},
+			wantSamples:  []int{2, 2},
+		},
+		"since": {
+			req: &pb.GetPowershelfTelemetryRequest{
+				PmcMacs: []string{"00:11:22:33:44:55"},
+				Since:   timestamppb.New(now.Add(-90 * time.Second)),
+			},
+			wantMacs:     []string{"00:11:22:33:44:55"},
+			wantStatuses: []pb.StatusCode{pb.StatusCode_SUCCESS},
+			wantSamples:  []int{1},
+		},
+		"normalized and unknown MACs": {
+			req:          &pb.GetPowershelfTelemetryRequest{PmcMacs: []string{"00-11-22-33-44-66", "00:11:22:33:44:77"}},
+			wantMacs:     []string{"00:11:22:33:44:66", "00:11:22:33:44:77"},
+			wantStatuses: []pb.StatusCode{pb.StatusCode_SUCCESS, pb.StatusCode_SUCCESS},
+			wantSamples:  []int{2, 0},
+		},
+		"invalid MAC": {
+			req:          &pb.GetPowershelfTelemetryRequest{PmcMacs: []string{"not-a-mac"}},
+			wantMacs:     []string{"not-a-mac"},
+			wantStatuses: []pb.StatusCode{pb.StatusCode_INVALID_ARGUMENT},
+			wantSamples:  []int{0},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := s.GetPowershelfTelemetry(context.Background(), tc.req)
+			require.NoError(t, err)
+			require.Len(t, resp.Telemetry, len(tc.wantMacs))
+
+			for i, shelf := range resp.Telemetry {
+				assert.Equal(t, tc.wantMacs[i], shelf.PmcMacAddress)
+				assert.Equal(t, tc.wantStatuses[i], shelf.Status)
+				assert.Len(t, shelf.Samples, tc.wantSamples[i])
+			}
+		})
+	}
+}
+
+func TestGetCredentialRotationStatus(t *testing.T) {
+	rotated := time.Now().Add(-time.Hour)
+	store := credentialrotation.NewInMemoryStore()
+	require.NoError(t, store.Put(context.Background(), &credentialrotation.Status{
+		PmcMacAddress: mustParseMAC(t, "00:11:22:33:44:55"),
+		State:         credentialrotation.StateSucceeded,
+		LastAttemptAt: rotated,
+		LastRotatedAt: rotated,
+	}))
+	require.NoError(t, store.Put(context.Background(), &credentialrotation.Status{
+		PmcMacAddress: mustParseMAC(t, "00:11:22:33:44:66"),
+		State:         credentialrotation.StateRollbackFailed,
+		LastAttemptAt: rotated,
+		ErrorMessage:  "device unreachable",
+	}))
+
+	s := &PowershelfManagerServerImpl{psm: &powershelfmanager.PowershelfManager{
+		CredentialRotation: credentialrotation.New(nil, store, credentialrotation.Config{Interval: 24 * time.Hour}),
+	}}
+
+	tests := map[string]struct {
+		mac           string
+		wantStatus    pb.StatusCode
+		wantState     pb.CredentialRotationState
+		wantRotated   bool
+		wantRotateErr string
+	}{
+		"succeeded": {
+			mac:         "00-11-22-33-44-55",
+			wantStatus:  pb.StatusCode_SUCCESS,
+			wantState:   pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED,
+			wantRotated: true,
+		},
+		"rollback failed": {
+			mac:           "00:11:22:33:44:66",
+			wantStatus:    pb.StatusCode_SUCCESS,
+			wantState:     pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED,
+			wantRotateErr: "device unreachable",
+		},
+		"never rotated": {
+			mac:        "00:11:22:33:44:77",
+			wantStatus: pb.StatusCode_SUCCESS,
+			wantState:  pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED,
+		},
+		"invalid MAC": {
+			mac:        "not-a-mac",
+			wantStatus: pb.StatusCode_INVALID_ARGUMENT,
+			wantState:  pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := s.GetCredentialRotationStatus(context.Background(), &pb.PowershelfRequest{PmcMacs: []string{tc.mac}})
+			require.NoError(t, err)
+			require.Len(t, resp.Statuses, 1)
+
+			status := resp.Statuses[0]
+			assert.Equal(t, tc.wantStatus, status.Status)
+			assert.Equal(t, tc.wantState, status.State)
+			assert.Equal(t, tc.wantRotated, status.LastRotated != nil)
+			assert.Equal(t, tc.wantRotateErr, status.RotationError)
+			if tc.wantStatus == pb.StatusCode_SUCCESS {
+				assert.NotNil(t, status.NextRotation)
+			}
+		})
+	}
+}
+
+func TestRotateCredentials_InvalidMAC(t *testing.T) {
+	s := newTestServer()
+
+	resp, err := s.RotateCredentials(context.Background(), &pb.PowershelfRequest{PmcMacs: []string{"not-a-mac"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+
+	assert.Equal(t, pb.StatusCode_INVALID_ARGUMENT, resp.Responses[0].Status)
+	assert.Contains(t, resp.Responses[0].Error, "invalid MAC address")
+}
+
+// fakeEventStream records the events sent by StreamEvents.
+type fakeEventStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.PowershelfEvent
+}
+
+func (f *fakeEventStream) Context() context.Context { return f.ctx }
+
+func (f *fakeEventStream) Send(ev *pb.PowershelfEvent) error {
+	f.sent = append(f.sent, ev)
+	return nil
+}
+
+func TestStreamEvents(t *testing.T) {
+	tests := map[string]struct {
+		req      *pb.StreamEventsRequest
+		stopped  bool
+		wantCode codes.Code
+	}{
+		"invalid MAC": {
+			req:      &pb.StreamEventsRequest{PmcMacs: []string{"not-a-mac"}},
+			wantCode: codes.InvalidArgument,
+		},
+		"invalid kind": {
+			req:      &pb.StreamEventsRequest{Kinds: []pb.PowershelfEventKind{pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_UNKNOWN}},
+			wantCode: codes.InvalidArgument,
+		},
+		"client cancels": {
+			req: &pb.StreamEventsRequest{
+				PmcMacs: []string{"00:11:22:33:44:55"},
+				Kinds:   []pb.PowershelfEventKind{pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT},
+			},
+			wantCode: codes.OK,
+		},
+		"service stopped": {
+			req:      &pb.StreamEventsRequest{},
+			stopped:  true,
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			manager := eventmanager.New(nil, nil, eventmanager.Config{})
+			s := &PowershelfManagerServerImpl{psm: &powershelfmanager.PowershelfManager{Events: manager}}
+			if tc.stopped {
+				require.NoError(t, manager.Stop(context.Background()))
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			if tc.stopped {
+				ctx = context.Background()
+			}
+
+			err := s.StreamEvents(tc.req, &fakeEventStream{ctx: ctx})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+func mustParseMAC(t *testing.T, s string) net.HardwareAddr {
+	t.Helper()
+	mac, err := net.ParseMAC(s)
+	require.NoError(t, err)
+	return mac
+}
+
+func TestFirmwareBaselines(t *testing.T) {
+	s := &PowershelfManagerServerImpl{psm: &powershelfmanager.PowershelfManager{
+		Compliance: compliance.New(nil, nil, nil, compliance.NewInMemoryStore(), compliance.Config{}),
+	}}
+	ctx := context.Background()
+
+	baseline := &pb.FirmwareBaseline{
+		Name:      "liteon-pmc",
+		Vendor:    pb.PMCVendor_PMC_TYPE_LITEON,
+		Component: pb.PowershelfComponent_PMC,
+		Version:   "r1.3.9",
+	}
+	resp, err := s.SetFirmwareBaseline(ctx, &pb.SetFirmwareBaselineRequest{Baseline: baseline})
+	require.NoError(t, err)
+	assert.Equal(t, "r1.3.9", resp.Version)
+	assert.NotNil(t, resp.CreatedAt)
+
+	conflicting := &pb.FirmwareBaseline{Name: "other", Vendor: pb.PMCVendor_PMC_TYPE_LITEON, Component: pb.PowershelfComponent_PMC, Version: "r1.4.0"}
+	_, err = s.SetFirmwareBaseline(ctx, &pb.SetFirmwareBaselineRequest{Baseline: conflicting})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	invalid := &pb.FirmwareBaseline{Name: "unknown", Vendor: pb.PMCVendor_PMC_TYPE_UNKNOWN, Component: pb.PowershelfComponent_PMC, Version: "r1.4.0"}
+	_, err = s.SetFirmwareBaseline(ctx, &pb.SetFirmwareBaselineRequest{Baseline: invalid})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SetFirmwareBaseline(ctx, &pb.SetFirmwareBaselineRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	list, err := s.ListFirmwareBaselines(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, list.Baselines, 1)
+	assert.Equal(t, pb.PMCVendor_PMC_TYPE_LITEON, list.Baselines[0].Vendor)
+
+	_, err = s.DeleteFirmwareBaseline(ctx, &pb.DeleteFirmwareBaselineRequest{Name: "liteon-pmc"})
+	require.NoError(t, err)
+	_, err = s.DeleteFirmwareBaseline(ctx, &pb.DeleteFirmwareBaselineRequest{Name: "liteon-pmc"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetFirmwareDriftHistory_InvalidArgument(t *testing.T) {
+	s := newTestServer()
+
+	_, err := s.GetFirmwareDriftHistory(context.Background(), &pb.GetFirmwareDriftHistoryRequest{PmcMacs: []string{"not-a-mac"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.GetFirmwareComplianceReport(context.Background(), &pb.PowershelfRequest{PmcMacs: []string{"not-a-mac"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
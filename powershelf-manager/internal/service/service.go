@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"time"
 
@@ -42,9 +43,10 @@ import (
 
 // Service owns the gRPC server lifecycle and a PowershelfManager orchestrator.
 type Service struct {
-	conf       Config
-	grpcServer *grpc.Server
-	psm        *powershelfmanager.PowershelfManager
+	conf          Config
+	grpcServer    *grpc.Server
+	metricsServer *http.Server
+	psm           *powershelfmanager.PowershelfManager
 }
 
 // New initializes a PowershelfManager and constructs a Service from the Config.
@@ -74,6 +76,10 @@ func (s *Service) Start(ctx context.Context) error {
 		return err
 	}
 
+	if s.conf.MetricsPort > 0 {
+		s.startMetricsServer()
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", s.conf.Port))
 	if err != nil {
 		return err
@@ -109,6 +115,11 @@ func (s *Service) Stop(ctx context.Context) {
 	log.Printf("Starting graceful shutdown now...")
 
 	s.grpcServer.GracefulStop()
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			log.Warnf("Failed to shut down metrics server: %v", err)
+		}
+	}
 	s.psm.Stop(ctx)
 }
 
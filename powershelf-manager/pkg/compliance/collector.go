@@ -0,0 +1,92 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"context"
+	"strings"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/redfish"
+)
+
+// Collector reads the firmware inventory of a power shelf.
+type Collector interface {
+	Inventory(ctx context.Context, pmc *pmc.PMC) ([]InventoryItem, error)
+}
+
+// RedfishTxer runs a function with an authenticated Redfish session to a PMC.
+type RedfishTxer interface {
+	RedfishTx(ctx context.Context, pmc *pmc.PMC, tx func(client *redfish.RedfishClient) error) error
+}
+
+var _ Collector = (*RedfishCollector)(nil)
+
+// RedfishCollector reads the firmware inventory of a power shelf from the Redfish UpdateService of its PMC.
+// The PMC's own firmware is reported as "BMC" or "PMC"; power supply firmware as "PSU<n>". Models are taken
+// from the Redfish manager and power supplies.
+type RedfishCollector struct {
+	pmcs RedfishTxer
+}
+
+// NewRedfishCollector creates a collector using the given Redfish sessions.
+func NewRedfishCollector(pmcs RedfishTxer) *RedfishCollector {
+	return &RedfishCollector{pmcs: pmcs}
+}
+
+func (c *RedfishCollector) Inventory(ctx context.Context, pmc *pmc.PMC) ([]InventoryItem, error) {
+	var items []InventoryItem
+
+	err := c.pmcs.RedfishTx(ctx, pmc, func(client *redfish.RedfishClient) error {
+		inventories, err := client.FirmwareInventories()
+		if err != nil {
+			return err
+		}
+
+		manager, err := client.QueryManager()
+		if err != nil {
+			return err
+		}
+
+		psus, err := client.QueryPowerSupplies()
+		if err != nil {
+			return err
+		}
+		psuModels := make(map[string]string, len(psus))
+		for _, psu := range psus {
+			psuModels[psu.ID] = psu.Model
+		}
+
+		for _, inv := range inventories {
+			id := inv.ID
+			switch {
+			case strings.EqualFold(id, "BMC") || strings.EqualFold(id, "PMC"):
+				items = append(items, InventoryItem{Component: powershelf.PMC, ID: id, Model: manager.Model, Version: inv.Version})
+			case strings.HasPrefix(strings.ToUpper(id), "PSU"):
+				items = append(items, InventoryItem{Component: powershelf.PSU, ID: id, Model: psuModels[id], Version: inv.Version})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
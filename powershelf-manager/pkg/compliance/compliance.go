@@ -0,0 +1,272 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compliance checks the firmware of the registered power shelves against baselines. A baseline names
+// the desired firmware version of a component for a vendor and, optionally, a model. A compliance check reads
+// the Redfish firmware inventory of every shelf, matches each item to a baseline and records a drift event
+// whenever an item drifts from or returns to its baseline. Optionally, non-compliant PMC firmware is upgraded
+// through the firmware manager within a maintenance window.
+package compliance
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+)
+
+// ErrNotFound is returned when a baseline does not exist.
+var ErrNotFound = errors.New("firmware baseline not found")
+
+// ErrInvalid is returned when a baseline is incomplete or names an unsupported vendor or component.
+var ErrInvalid = errors.New("invalid firmware baseline")
+
+// ErrConflict is returned when a baseline would apply to the same vendor, model and component as another one.
+var ErrConflict = errors.New("conflicting firmware baseline")
+
+// Baseline is the desired firmware version of a component for a vendor and, optionally, a model.
+type Baseline struct {
+	Name string
+	// Vendor is the PMC vendor name the baseline applies to ("Liteon", "Delta").
+	Vendor string
+	// Model is the model of the component the baseline applies to; any model if empty. A baseline for a model
+	// takes precedence over a baseline for any model.
+	Model     string
+	Component powershelf.Component
+	Version   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// validate checks the baseline and normalizes its vendor name.
+func (b *Baseline) validate() error {
+	if b.Name == "" {
+		return errors.New("baseline name is required")
+	}
+	if b.Version == "" {
+		return errors.New("baseline version is required")
+	}
+	switch b.Component {
+	case powershelf.PMC, powershelf.PSU:
+	default:
+		return fmt.Errorf("unsupported component %q", b.Component)
+	}
+
+	for code := vendor.VendorCodeUnsupported + 1; code < vendor.VendorCodeMax; code++ {
+		if v := vendor.CodeToVendor(code); strings.EqualFold(v.Name, b.Vendor) {
+			b.Vendor = v.Name
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported vendor %q", b.Vendor)
+}
+
+// conflicts returns true if both baselines apply to the same vendor, model and component.
+func (b *Baseline) conflicts(other *Baseline) bool {
+	return b.Name != other.Name &&
+		strings.EqualFold(b.Vendor, other.Vendor) &&
+		strings.EqualFold(b.Model, other.Model) &&
+		b.Component == other.Component
+}
+
+// matches returns true if the baseline applies to an inventory item of a shelf of the given vendor.
+func (b *Baseline) matches(vendorName string, item InventoryItem) bool {
+	return strings.EqualFold(b.Vendor, vendorName) &&
+		b.Component == item.Component &&
+		(b.Model == "" || strings.EqualFold(b.Model, item.Model))
+}
+
+// selectBaseline returns the baseline applying to an inventory item, preferring a baseline for the item's model
+// over one for any model. Returns nil if no baseline applies.
+func selectBaseline(baselines []*Baseline, vendorName string, item InventoryItem) *Baseline {
+	var match *Baseline
+	for _, b := range baselines {
+		if !b.matches(vendorName, item) {
+			continue
+		}
+		if b.Model != "" {
+			return b
+		}
+		match = b
+	}
+	return match
+}
+
+// InventoryItem is a firmware inventory entry of a power shelf.
+type InventoryItem struct {
+	Component powershelf.Component
+	// ID is the Redfish firmware inventory ID, e.g. "BMC" or "PSU0".
+	ID      string
+	Model   string
+	Version string
+}
+
+// State is the compliance state of an inventory item.
+type State string
+
+const (
+	// StateCompliant means the item runs its baseline's version.
+	StateCompliant State = "Compliant"
+	// StateNonCompliant means the item does not run its baseline's version.
+	StateNonCompliant State = "NonCompliant"
+	// StateNoBaseline means no baseline applies to the item.
+	StateNoBaseline State = "NoBaseline"
+)
+
+// Entry is the compliance of a single inventory item.
+type Entry struct {
+	Component powershelf.Component
+	ItemID    string
+	Model     string
+	// Baseline is the name of the baseline applying to the item, empty if none.
+	Baseline string
+	Expected string
+	Actual   string
+	State    State
+	// Remediation describes the upgrade queued for a non-compliant item, or why none was queued.
+	Remediation string
+}
+
+// ShelfReport is the compliance of the firmware of a power shelf.
+type ShelfReport struct {
+	PmcMacAddress net.HardwareAddr
+	Vendor        string
+	Entries       []Entry
+	// Error is set if the firmware inventory of the shelf could not be read.
+	Error string
+}
+
+// Compliant returns true if the inventory was read and no item is non-compliant.
+func (r *ShelfReport) Compliant() bool {
+	if r.Error != "" {
+		return false
+	}
+	for _, e := range r.Entries {
+		if e.State == StateNonCompliant {
+			return false
+		}
+	}
+	return true
+}
+
+// Report is the firmware compliance of a set of power shelves.
+type Report struct {
+	GeneratedAt time.Time
+	Shelves     []*ShelfReport
+}
+
+// NonCompliant returns the shelves that are not compliant, including those whose inventory could not be read.
+func (r *Report) NonCompliant() []*ShelfReport {
+	var shelves []*ShelfReport
+	for _, s := range r.Shelves {
+		if !s.Compliant() {
+			shelves = append(shelves, s)
+		}
+	}
+	return shelves
+}
+
+// ItemStatus is the last known compliance of an inventory item, used to detect drift between checks.
+type ItemStatus struct {
+	PmcMacAddress net.HardwareAddr
+	Component     powershelf.Component
+	ItemID        string
+	Baseline      string
+	Expected      string
+	Actual        string
+	Compliant     bool
+	CheckedAt     time.Time
+}
+
+// DriftEvent records an inventory item drifting from its baseline, or returning to it.
+type DriftEvent struct {
+	PmcMacAddress net.HardwareAddr
+	Component     powershelf.Component
+	ItemID        string
+	Baseline      string
+	Expected      string
+	Actual        string
+	// Compliant is true if the item returned to its baseline.
+	Compliant  bool
+	DetectedAt time.Time
+}
+
+// Window is a daily maintenance window in UTC. The window wraps past midnight if End is before Start.
+type Window struct {
+	Start time.Duration // offset from midnight
+	End   time.Duration // offset from midnight
+}
+
+// ParseWindow parses a maintenance window in the form "HH:MM-HH:MM" (UTC). An empty string yields the zero
+// Window, which is always open.
+func ParseWindow(s string) (Window, error) {
+	if s == "" {
+		return Window{}, nil
+	}
+
+	startStr, endStr, ok := strings.Cut(s, "-")
+	if !ok {
+		return Window{}, fmt.Errorf("invalid maintenance window %q: expected HH:MM-HH:MM", s)
+	}
+
+	start, err := time.Parse("15:04", strings.TrimSpace(startStr))
+	if err != nil {
+		return Window{}, fmt.Errorf("invalid maintenance window start %q: %w", startStr, err)
+	}
+	end, err := time.Parse("15:04", strings.TrimSpace(endStr))
+	if err != nil {
+		return Window{}, fmt.Errorf("invalid maintenance window end %q: %w", endStr, err)
+	}
+
+	w := Window{
+		Start: time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		End:   time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute,
+	}
+	if w.Start == w.End {
+		return Window{}, fmt.Errorf("invalid maintenance window %q: start equals end", s)
+	}
+	return w, nil
+}
+
+// Contains returns true if t is within the window.
+func (w Window) Contains(t time.Time) bool {
+	if w.Start == w.End {
+		return true
+	}
+
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// String returns the window in the form accepted by ParseWindow.
+func (w Window) String() string {
+	if w.Start == w.End {
+		return ""
+	}
+	hhmm := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return hhmm(w.Start) + "-" + hhmm(w.End)
+}
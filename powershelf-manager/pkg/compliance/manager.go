@@ -0,0 +1,380 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/firmwaremanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// inventoryTimeout bounds reading the firmware inventory of a single shelf.
+const inventoryTimeout = time.Minute
+
+// PmcClient is the subset of the PMC manager needed to check firmware compliance.
+type PmcClient interface {
+	GetPmc(ctx context.Context, mac net.HardwareAddr) (*pmc.PMC, error)
+	GetAllPmcs(ctx context.Context) ([]*pmc.PMC, error)
+}
+
+// Upgrader is the subset of the firmware manager needed to remediate non-compliant firmware.
+type Upgrader interface {
+	Upgrade(ctx context.Context, pmc *pmc.PMC, component powershelf.Component, targetVersion string) error
+	GetFirmwareUpdate(ctx context.Context, mac net.HardwareAddr, component powershelf.Component) (*powershelf.FirmwareUpdate, error)
+}
+
+// RemediationConfig controls the automatic upgrade of non-compliant firmware.
+type RemediationConfig struct {
+	// Enabled queues upgrades of non-compliant PMC firmware during scheduled checks.
+	Enabled bool
+	// Window is the maintenance window upgrades are queued in; always open if zero.
+	Window Window
+}
+
+// Config specifies the compliance check schedule and remediation.
+type Config struct {
+	// ScanInterval is how often the firmware of all shelves is checked. Zero disables scheduled checks;
+	// reports still check on demand.
+	ScanInterval time.Duration
+	Remediation  RemediationConfig
+}
+
+// Manager manages firmware baselines, checks the firmware of the shelves against them and records drift.
+type Manager struct {
+	pmcs      PmcClient
+	collector Collector
+	upgrader  Upgrader
+	store     Store
+	conf      Config
+	now       func() time.Time
+
+	// mu serializes checks so that concurrent checks do not record the same drift twice.
+	mu sync.Mutex
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Manager checking the firmware of the PMCs known to the given client.
+func New(pmcs PmcClient, collector Collector, upgrader Upgrader, store Store, conf Config) *Manager {
+	return &Manager{
+		pmcs:      pmcs,
+		collector: collector,
+		upgrader:  upgrader,
+		store:     store,
+		conf:      conf,
+		now:       time.Now,
+	}
+}
+
+// Start starts the store and, if a ScanInterval is configured, the scheduled checks.
+func (m *Manager) Start(ctx context.Context) error {
+	if err := m.store.Start(ctx); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	if m.conf.ScanInterval > 0 {
+		log.Printf("Starting firmware compliance checks (interval: %s, remediation: %t, window: %q)",
+			m.conf.ScanInterval, m.conf.Remediation.Enabled, m.conf.Remediation.Window)
+		m.wg.Add(1)
+		go m.schedule(runCtx)
+	}
+
+	return nil
+}
+
+// Stop stops the scheduled checks and the store.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+
+	return m.store.Stop(ctx)
+}
+
+// SetBaseline creates or replaces a baseline by name. Returns ErrInvalid if the baseline is invalid and
+// ErrConflict if another baseline applies to the same vendor, model and component.
+func (m *Manager) SetBaseline(ctx context.Context, baseline *Baseline) (*Baseline, error) {
+	b := *baseline
+	b.Model = strings.TrimSpace(b.Model)
+	b.Version = strings.TrimSpace(b.Version)
+	if err := b.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	baselines, err := m.store.ListBaselines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range baselines {
+		if b.conflicts(other) {
+			return nil, fmt.Errorf("%w: baseline %q already applies to %s %s firmware of model %q", ErrConflict, other.Name, other.Vendor, other.Component, other.Model)
+		}
+	}
+
+	if err := m.store.PutBaseline(ctx, &b); err != nil {
+		return nil, err
+	}
+	return m.store.GetBaseline(ctx, b.Name)
+}
+
+// DeleteBaseline deletes a baseline. Returns ErrNotFound if it does not exist.
+func (m *Manager) DeleteBaseline(ctx context.Context, name string) error {
+	return m.store.DeleteBaseline(ctx, name)
+}
+
+// Baselines returns all baselines ordered by name.
+func (m *Manager) Baselines(ctx context.Context) ([]*Baseline, error) {
+	return m.store.ListBaselines(ctx)
+}
+
+// DriftHistory returns the drift events matching the filter, most recent first.
+func (m *Manager) DriftHistory(ctx context.Context, filter DriftFilter) ([]*DriftEvent, error) {
+	return m.store.ListDriftEvents(ctx, filter)
+}
+
+// Report checks the firmware of the given shelves (all registered shelves if empty) against the baselines and
+// records drift. It does not queue upgrades.
+func (m *Manager) Report(ctx context.Context, macs []net.HardwareAddr) (*Report, error) {
+	pmcs, err := m.resolve(ctx, macs)
+	if err != nil {
+		return nil, err
+	}
+	return m.check(ctx, pmcs, false)
+}
+
+// Scan checks the firmware of all registered shelves and, if remediation is enabled and the maintenance window
+// is open, queues upgrades of non-compliant PMC firmware.
+func (m *Manager) Scan(ctx context.Context) (*Report, error) {
+	pmcs, err := m.pmcs.GetAllPmcs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remediate := m.conf.Remediation.Enabled && m.conf.Remediation.Window.Contains(m.now())
+	return m.check(ctx, pmcs, remediate)
+}
+
+func (m *Manager) resolve(ctx context.Context, macs []net.HardwareAddr) ([]*pmc.PMC, error) {
+	if len(macs) == 0 {
+		return m.pmcs.GetAllPmcs(ctx)
+	}
+
+	pmcs := make([]*pmc.PMC, 0, len(macs))
+	for _, mac := range macs {
+		p, err := m.pmcs.GetPmc(ctx, mac)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get PMC %s: %w", mac, err)
+		}
+		pmcs = append(pmcs, p)
+	}
+	return pmcs, nil
+}
+
+func (m *Manager) schedule(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.ScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if report, err := m.Scan(ctx); err != nil {
+			log.Errorf("Firmware compliance check failed: %v", err)
+		} else if nonCompliant := report.NonCompliant(); len(nonCompliant) > 0 {
+			log.Warnf("%d of %d powershelves are not on their firmware baseline", len(nonCompliant), len(report.Shelves))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) check(ctx context.Context, pmcs []*pmc.PMC, remediate bool) (*Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	baselines, err := m.store.ListBaselines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{GeneratedAt: m.now()}
+	for _, p := range pmcs {
+		shelf := &ShelfReport{PmcMacAddress: p.MAC, Vendor: p.Vendor.Name}
+		report.Shelves = append(report.Shelves, shelf)
+
+		invCtx, cancel := context.WithTimeout(ctx, inventoryTimeout)
+		items, err := m.collector.Inventory(invCtx, p)
+		cancel()
+		if err != nil {
+			shelf.Error = err.Error()
+			continue
+		}
+
+		previous, err := m.previousStatuses(ctx, p.MAC)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			entry := evaluate(baselines, p.Vendor.Name, item)
+			if err := m.recordDrift(ctx, p.MAC, entry, previous[itemKey(item.Component, item.ID)], report.GeneratedAt); err != nil {
+				return nil, err
+			}
+			if remediate && entry.State == StateNonCompliant {
+				entry.Remediation = m.remediate(ctx, p, entry)
+			}
+			shelf.Entries = append(shelf.Entries, entry)
+		}
+	}
+
+	return report, nil
+}
+
+// evaluate compares an inventory item against the baseline applying to it.
+func evaluate(baselines []*Baseline, vendorName string, item InventoryItem) Entry {
+	entry := Entry{
+		Component: item.Component,
+		ItemID:    item.ID,
+		Model:     item.Model,
+		Actual:    item.Version,
+		State:     StateNoBaseline,
+	}
+
+	if b := selectBaseline(baselines, vendorName, item); b != nil {
+		entry.Baseline = b.Name
+		entry.Expected = b.Version
+		entry.State = StateNonCompliant
+		if strings.TrimSpace(item.Version) == b.Version {
+			entry.State = StateCompliant
+		}
+	}
+	return entry
+}
+
+func itemKey(component powershelf.Component, id string) string {
+	return string(component) + "/" + id
+}
+
+func (m *Manager) previousStatuses(ctx context.Context, mac net.HardwareAddr) (map[string]*ItemStatus, error) {
+	statuses, err := m.store.ListItemStatuses(ctx, mac)
+	if err != nil {
+		return nil, err
+	}
+	previous := make(map[string]*ItemStatus, len(statuses))
+	for _, s := range statuses {
+		previous[itemKey(s.Component, s.ItemID)] = s
+	}
+	return previous, nil
+}
+
+// recordDrift stores the compliance of an item and records a drift event if the item drifted from its baseline,
+// changed version while off its baseline, or returned to its baseline. Items without a baseline never drift.
+func (m *Manager) recordDrift(ctx context.Context, mac net.HardwareAddr, entry Entry, prev *ItemStatus, now time.Time) error {
+	status := &ItemStatus{
+		PmcMacAddress: mac,
+		Component:     entry.Component,
+		ItemID:        entry.ItemID,
+		Baseline:      entry.Baseline,
+		Expected:      entry.Expected,
+		Actual:        entry.Actual,
+		Compliant:     entry.State != StateNonCompliant,
+		CheckedAt:     now,
+	}
+
+	drifted := false
+	if entry.State != StateNoBaseline {
+		switch {
+		case prev == nil || prev.Baseline == "":
+			drifted = !status.Compliant
+		case prev.Compliant != status.Compliant:
+			drifted = true
+		case !status.Compliant:
+			drifted = prev.Actual != status.Actual || prev.Expected != status.Expected
+		}
+	}
+
+	if drifted {
+		event := &DriftEvent{
+			PmcMacAddress: mac,
+			Component:     entry.Component,
+			ItemID:        entry.ItemID,
+			Baseline:      entry.Baseline,
+			Expected:      entry.Expected,
+			Actual:        entry.Actual,
+			Compliant:     status.Compliant,
+			DetectedAt:    now,
+		}
+		if err := m.store.AddDriftEvent(ctx, event); err != nil {
+			return err
+		}
+		if status.Compliant {
+			log.Infof("%s firmware %s of %s is back on baseline %q (%s)", entry.Component, entry.ItemID, mac, entry.Baseline, entry.Expected)
+		} else {
+			log.Warnf("%s firmware %s of %s drifted from baseline %q: expected %s, found %s", entry.Component, entry.ItemID, mac, entry.Baseline, entry.Expected, entry.Actual)
+		}
+	}
+
+	return m.store.PutItemStatus(ctx, status)
+}
+
+// remediate queues an upgrade of a non-compliant item to its baseline version and describes the outcome.
+// Only PMC firmware can be upgraded; an update that is pending or that already failed for the same target
+// version is not queued again.
+func (m *Manager) remediate(ctx context.Context, p *pmc.PMC, entry Entry) string {
+	if entry.Component != powershelf.PMC {
+		return fmt.Sprintf("%s firmware cannot be upgraded by the firmware manager", entry.Component)
+	}
+
+	update, err := m.upgrader.GetFirmwareUpdate(ctx, p.MAC, entry.Component)
+	if err != nil && !errors.Is(err, firmwaremanager.ErrNotFound) {
+		return fmt.Sprintf("failed to get the firmware update status: %v", err)
+	}
+	if update != nil && update.VersionTo == entry.Expected {
+		switch update.State {
+		case powershelf.FirmwareStateQueued, powershelf.FirmwareStateVerifying:
+			return fmt.Sprintf("upgrade to %s in progress", entry.Expected)
+		case powershelf.FirmwareStateFailed:
+			return fmt.Sprintf("previous upgrade to %s failed: %s", entry.Expected, update.ErrorMessage)
+		}
+	}
+
+	if err := m.upgrader.Upgrade(ctx, p, entry.Component, entry.Expected); err != nil {
+		log.Errorf("Failed to queue %s firmware upgrade of %s to %s: %v", entry.Component, p.MAC, entry.Expected, err)
+		return fmt.Sprintf("failed to queue upgrade to %s: %v", entry.Expected, err)
+	}
+
+	log.Infof("Queued %s firmware upgrade of %s to baseline %q (%s)", entry.Component, p.MAC, entry.Baseline, entry.Expected)
+	return fmt.Sprintf("queued upgrade to %s", entry.Expected)
+}
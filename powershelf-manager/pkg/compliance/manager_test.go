@@ -0,0 +1,349 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/firmwaremanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	liteonMAC = net.HardwareAddr{0, 1, 2, 3, 4, 5}
+	deltaMAC  = net.HardwareAddr{0, 1, 2, 3, 4, 6}
+)
+
+// fakeFleet emulates registered PMCs, their firmware inventory and the firmware manager.
+type fakeFleet struct {
+	mu        sync.Mutex
+	vendors   map[string]vendor.VendorCode
+	inventory map[string][]InventoryItem
+	invErr    map[string]error
+	updates   map[string]*powershelf.FirmwareUpdate
+	upgrades  []string
+}
+
+func newFakeFleet() *fakeFleet {
+	return &fakeFleet{
+		vendors: map[string]vendor.VendorCode{
+			liteonMAC.String(): vendor.VendorCodeLiteon,
+			deltaMAC.String():  vendor.VendorCodeDelta,
+		},
+		inventory: map[string][]InventoryItem{
+			liteonMAC.String(): {
+				{Component: powershelf.PMC, ID: "BMC", Model: "CM14MP1R", Version: "r1.3.8"},
+				{Component: powershelf.PSU, ID: "PSU0", Model: "PS-2551-9L", Version: "2.1"},
+			},
+			deltaMAC.String(): {
+				{Component: powershelf.PMC, ID: "BMC", Model: "ECD16010096", Version: "r1.3.8"},
+			},
+		},
+		invErr:  make(map[string]error),
+		updates: make(map[string]*powershelf.FirmwareUpdate),
+	}
+}
+
+func (f *fakeFleet) setVersion(mac net.HardwareAddr, id, version string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := range f.inventory[mac.String()] {
+		if f.inventory[mac.String()][i].ID == id {
+			f.inventory[mac.String()][i].Version = version
+		}
+	}
+}
+
+func (f *fakeFleet) GetPmc(_ context.Context, mac net.HardwareAddr) (*pmc.PMC, error) {
+	code, ok := f.vendors[mac.String()]
+	if !ok {
+		return nil, errors.New("PMC not found")
+	}
+	cred := credential.New("root", "0penBmc!")
+	return pmc.NewFromAddr(mac, net.ParseIP("10.0.0.1"), code, &cred)
+}
+
+func (f *fakeFleet) GetAllPmcs(ctx context.Context) ([]*pmc.PMC, error) {
+	var pmcs []*pmc.PMC
+	for _, mac := range []net.HardwareAddr{liteonMAC, deltaMAC} {
+		p, err := f.GetPmc(ctx, mac)
+		if err != nil {
+			return nil, err
+		}
+		pmcs = append(pmcs, p)
+	}
+	return pmcs, nil
+}
+
+func (f *fakeFleet) Inventory(_ context.Context, p *pmc.PMC) ([]InventoryItem, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.invErr[p.MAC.String()]; err != nil {
+		return nil, err
+	}
+	return append([]InventoryItem(nil), f.inventory[p.MAC.String()]...), nil
+}
+
+func (f *fakeFleet) Upgrade(_ context.Context, p *pmc.PMC, component powershelf.Component, targetVersion string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.upgrades = append(f.upgrades, p.MAC.String()+"/"+string(component)+"@"+targetVersion)
+	f.updates[p.MAC.String()] = &powershelf.FirmwareUpdate{
+		PmcMacAddress: p.MAC.String(),
+		Component:     component,
+		VersionTo:     targetVersion,
+		State:         powershelf.FirmwareStateQueued,
+	}
+	return nil
+}
+
+func (f *fakeFleet) GetFirmwareUpdate(_ context.Context, mac net.HardwareAddr, _ powershelf.Component) (*powershelf.FirmwareUpdate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	update, ok := f.updates[mac.String()]
+	if !ok {
+		return nil, firmwaremanager.ErrNotFound
+	}
+	copy := *update
+	return &copy, nil
+}
+
+func newTestManager(t *testing.T, conf Config) (*Manager, *fakeFleet) {
+	t.Helper()
+
+	fleet := newFakeFleet()
+	m := New(fleet, fleet, fleet, NewInMemoryStore(), conf)
+	return m, fleet
+}
+
+func setBaseline(t *testing.T, m *Manager, b Baseline) {
+	t.Helper()
+
+	_, err := m.SetBaseline(context.Background(), &b)
+	require.NoError(t, err)
+}
+
+func findShelf(t *testing.T, report *Report, mac net.HardwareAddr) *ShelfReport {
+	t.Helper()
+
+	for _, s := range report.Shelves {
+		if s.PmcMacAddress.String() == mac.String() {
+			return s
+		}
+	}
+	t.Fatalf("no report for %s", mac)
+	return nil
+}
+
+func TestSetBaselineValidation(t *testing.T) {
+	m, _ := newTestManager(t, Config{})
+	ctx := context.Background()
+
+	_, err := m.SetBaseline(ctx, &Baseline{Name: "b", Vendor: "Acme", Component: powershelf.PMC, Version: "r1"})
+	assert.ErrorIs(t, err, ErrInvalid, "unsupported vendor")
+
+	_, err = m.SetBaseline(ctx, &Baseline{Name: "b", Vendor: "Liteon", Component: "FAN", Version: "r1"})
+	assert.Error(t, err, "unsupported component")
+
+	_, err = m.SetBaseline(ctx, &Baseline{Name: "b", Vendor: "Liteon", Component: powershelf.PMC})
+	assert.Error(t, err, "missing version")
+
+	b, err := m.SetBaseline(ctx, &Baseline{Name: "liteon-pmc", Vendor: "liteon", Component: powershelf.PMC, Version: "r1.3.9"})
+	require.NoError(t, err)
+	assert.Equal(t, "Liteon", b.Vendor, "vendor is normalized")
+	assert.False(t, b.CreatedAt.IsZero())
+
+	_, err = m.SetBaseline(ctx, &Baseline{Name: "other", Vendor: "Liteon", Component: powershelf.PMC, Version: "r1.4.0"})
+	assert.ErrorIs(t, err, ErrConflict)
+
+	_, err = m.SetBaseline(ctx, &Baseline{Name: "liteon-pmc", Vendor: "Liteon", Component: powershelf.PMC, Version: "r1.4.0"})
+	require.NoError(t, err, "replacing a baseline by name is not a conflict")
+
+	baselines, err := m.Baselines(ctx)
+	require.NoError(t, err)
+	require.Len(t, baselines, 1)
+	assert.Equal(t, "r1.4.0", baselines[0].Version)
+
+	require.NoError(t, m.DeleteBaseline(ctx, "liteon-pmc"))
+	assert.ErrorIs(t, m.DeleteBaseline(ctx, "liteon-pmc"), ErrNotFound)
+}
+
+func TestReport(t *testing.T) {
+	m, fleet := newTestManager(t, Config{})
+	ctx := context.Background()
+
+	setBaseline(t, m, Baseline{Name: "liteon-pmc", Vendor: "Liteon", Component: powershelf.PMC, Version: "r1.3.9"})
+	setBaseline(t, m, Baseline{Name: "psu-any", Vendor: "Liteon", Component: powershelf.PSU, Version: "2.0"})
+	setBaseline(t, m, Baseline{Name: "psu-model", Vendor: "Liteon", Model: "PS-2551-9L", Component: powershelf.PSU, Version: "2.1"})
+	fleet.invErr[deltaMAC.String()] = errors.New("connection refused")
+
+	report, err := m.Report(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, report.Shelves, 2)
+
+	liteon := findShelf(t, report, liteonMAC)
+	require.Len(t, liteon.Entries, 2)
+	assert.Equal(t, StateNonCompliant, liteon.Entries[0].State)
+	assert.Equal(t, "r1.3.9", liteon.Entries[0].Expected)
+	assert.Equal(t, "r1.3.8", liteon.Entries[0].Actual)
+	assert.Equal(t, StateCompliant, liteon.Entries[1].State)
+	assert.Equal(t, "psu-model", liteon.Entries[1].Baseline, "a baseline for the model takes precedence")
+	assert.Empty(t, liteon.Entries[0].Remediation, "reports do not remediate")
+
+	delta := findShelf(t, report, deltaMAC)
+	assert.Equal(t, "connection refused", delta.Error)
+	assert.Len(t, report.NonCompliant(), 2)
+
+	fleet.invErr = map[string]error{}
+	report, err = m.Report(ctx, []net.HardwareAddr{deltaMAC})
+	require.NoError(t, err)
+	require.Len(t, report.Shelves, 1)
+	assert.Equal(t, StateNoBaseline, report.Shelves[0].Entries[0].State)
+	assert.True(t, report.Shelves[0].Compliant())
+	assert.Empty(t, fleet.upgrades)
+
+	_, err = m.Report(ctx, []net.HardwareAddr{{9, 9, 9, 9, 9, 9}})
+	assert.Error(t, err, "unknown PMC")
+}
+
+func TestDriftHistory(t *testing.T) {
+	m, fleet := newTestManager(t, Config{})
+	ctx := context.Background()
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+
+	setBaseline(t, m, Baseline{Name: "liteon-pmc", Vendor: "Liteon", Component: powershelf.PMC, Version: "r1.3.8"})
+
+	check := func() {
+		t.Helper()
+		_, err := m.Report(ctx, []net.HardwareAddr{liteonMAC})
+		require.NoError(t, err)
+		now = now.Add(time.Hour)
+	}
+
+	check() // compliant from the start: no drift
+	fleet.setVersion(liteonMAC, "BMC", "r1.3.7")
+	check() // drifted
+	check() // unchanged: no new event
+	fleet.setVersion(liteonMAC, "BMC", "r1.3.6")
+	check() // changed version while off baseline
+	fleet.setVersion(liteonMAC, "BMC", "r1.3.8")
+	check() // back on baseline
+
+	events, err := m.DriftHistory(ctx, DriftFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.True(t, events[0].Compliant, "most recent first")
+	assert.Equal(t, "r1.3.8", events[0].Actual)
+	assert.Equal(t, "r1.3.6", events[1].Actual)
+	assert.Equal(t, "r1.3.7", events[2].Actual)
+	assert.False(t, events[2].Compliant)
+
+	since := time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC)
+	events, err = m.DriftHistory(ctx, DriftFilter{Since: since})
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = m.DriftHistory(ctx, DriftFilter{PmcMacAddresses: []net.HardwareAddr{deltaMAC}})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = m.DriftHistory(ctx, DriftFilter{Limit: 1})
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestScanRemediation(t *testing.T) {
+	window, err := ParseWindow("22:00-02:00")
+	require.NoError(t, err)
+
+	m, fleet := newTestManager(t, Config{Remediation: RemediationConfig{Enabled: true, Window: window}})
+	ctx := context.Background()
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+
+	setBaseline(t, m, Baseline{Name: "liteon-pmc", Vendor: "Liteon", Component: powershelf.PMC, Version: "r1.3.9"})
+	setBaseline(t, m, Baseline{Name: "liteon-psu", Vendor: "Liteon", Component: powershelf.PSU, Version: "2.2"})
+
+	report, err := m.Scan(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, fleet.upgrades, "outside the maintenance window")
+	assert.Empty(t, findShelf(t, report, liteonMAC).Entries[0].Remediation)
+
+	now = time.Date(2026, 10, 20, 23, 30, 0, 0, time.UTC)
+	report, err = m.Scan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{liteonMAC.String() + "/PMC@r1.3.9"}, fleet.upgrades)
+	liteon := findShelf(t, report, liteonMAC)
+	assert.Equal(t, "queued upgrade to r1.3.9", liteon.Entries[0].Remediation)
+	assert.Contains(t, liteon.Entries[1].Remediation, "cannot be upgraded", "PSU drift is only reported")
+
+	report, err = m.Scan(ctx)
+	require.NoError(t, err)
+	assert.Len(t, fleet.upgrades, 1, "a pending upgrade is not queued again")
+	assert.Equal(t, "upgrade to r1.3.9 in progress", findShelf(t, report, liteonMAC).Entries[0].Remediation)
+
+	fleet.updates[liteonMAC.String()].State = powershelf.FirmwareStateFailed
+	fleet.updates[liteonMAC.String()].ErrorMessage = "flash failed"
+	report, err = m.Scan(ctx)
+	require.NoError(t, err)
+	assert.Len(t, fleet.upgrades, 1, "a failed upgrade is not retried")
+	assert.Contains(t, findShelf(t, report, liteonMAC).Entries[0].Remediation, "flash failed")
+}
+
+func TestWindow(t *testing.T) {
+	at := func(hh, mm int) time.Time { return time.Date(2026, 10, 20, hh, mm, 0, 0, time.UTC) }
+
+	w, err := ParseWindow("02:00-04:30")
+	require.NoError(t, err)
+	assert.Equal(t, "02:00-04:30", w.String())
+	assert.True(t, w.Contains(at(2, 0)))
+	assert.True(t, w.Contains(at(4, 29)))
+	assert.False(t, w.Contains(at(4, 30)))
+	assert.False(t, w.Contains(at(1, 59)))
+
+	w, err = ParseWindow("23:00-01:00")
+	require.NoError(t, err)
+	assert.True(t, w.Contains(at(23, 30)))
+	assert.True(t, w.Contains(at(0, 30)))
+	assert.False(t, w.Contains(at(12, 0)))
+
+	w, err = ParseWindow("")
+	require.NoError(t, err)
+	assert.True(t, w.Contains(at(12, 0)), "the zero window is always open")
+
+	for _, s := range []string{"02:00", "2am-4am", "03:00-03:00", "25:00-01:00"} {
+		_, err := ParseWindow(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DriftFilter selects drift events. Zero values match everything.
+type DriftFilter struct {
+	PmcMacAddresses []net.HardwareAddr
+	Since           time.Time
+	// Limit caps the number of events returned, most recent first; no cap if zero.
+	Limit int
+}
+
+func (f *DriftFilter) matches(e *DriftEvent) bool {
+	if e.DetectedAt.Before(f.Since) {
+		return false
+	}
+	if len(f.PmcMacAddresses) == 0 {
+		return true
+	}
+	for _, mac := range f.PmcMacAddresses {
+		if mac.String() == e.PmcMacAddress.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Store abstracts baseline, compliance status and drift persistence so the Manager can operate
+// against either a Postgres-backed or in-memory backend.
+type Store interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+
+	// GetBaseline retrieves a baseline by name. Returns ErrNotFound if it does not exist.
+	GetBaseline(ctx context.Context, name string) (*Baseline, error)
+
+	// ListBaselines returns all baselines ordered by name.
+	ListBaselines(ctx context.Context) ([]*Baseline, error)
+
+	// PutBaseline upserts a baseline by name.
+	PutBaseline(ctx context.Context, baseline *Baseline) error
+
+	// DeleteBaseline deletes a baseline. Returns ErrNotFound if it does not exist.
+	DeleteBaseline(ctx context.Context, name string) error
+
+	// ListItemStatuses returns the last known compliance of the inventory items of a PMC.
+	ListItemStatuses(ctx context.Context, mac net.HardwareAddr) ([]*ItemStatus, error)
+
+	// PutItemStatus upserts the last known compliance of an inventory item.
+	PutItemStatus(ctx context.Context, status *ItemStatus) error
+
+	// AddDriftEvent appends a drift event.
+	AddDriftEvent(ctx context.Context, event *DriftEvent) error
+
+	// ListDriftEvents returns the drift events matching the filter, most recent first.
+	ListDriftEvents(ctx context.Context, filter DriftFilter) ([]*DriftEvent, error)
+}
+
+var _ Store = (*InMemoryStore)(nil)
+
+// InMemoryStore is an in-memory implementation of Store.
+// All data is lost when the process exits.
+type InMemoryStore struct {
+	mu        sync.RWMutex
+	baselines map[string]*Baseline
+	statuses  map[string]map[string]*ItemStatus // by PMC MAC, then component and item ID
+	drift     []*DriftEvent
+}
+
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		baselines: make(map[string]*Baseline),
+		statuses:  make(map[string]map[string]*ItemStatus),
+	}
+}
+
+func (s *InMemoryStore) Start(context.Context) error { return nil }
+func (s *InMemoryStore) Stop(context.Context) error  { return nil }
+
+func (s *InMemoryStore) GetBaseline(_ context.Context, name string) (*Baseline, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	baseline, ok := s.baselines[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	copy := *baseline
+	return &copy, nil
+}
+
+func (s *InMemoryStore) ListBaselines(context.Context) ([]*Baseline, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	baselines := make([]*Baseline, 0, len(s.baselines))
+	for _, baseline := range s.baselines {
+		copy := *baseline
+		baselines = append(baselines, &copy)
+	}
+	sort.Slice(baselines, func(i, j int) bool {
+		return baselines[i].Name < baselines[j].Name
+	})
+	return baselines, nil
+}
+
+func (s *InMemoryStore) PutBaseline(_ context.Context, baseline *Baseline) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copy := *baseline
+	copy.UpdatedAt = time.Now()
+	if existing, ok := s.baselines[baseline.Name]; ok {
+		copy.CreatedAt = existing.CreatedAt
+	} else {
+		copy.CreatedAt = copy.UpdatedAt
+	}
+	s.baselines[baseline.Name] = &copy
+	return nil
+}
+
+func (s *InMemoryStore) DeleteBaseline(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.baselines[name]; !ok {
+		return ErrNotFound
+	}
+	delete(s.baselines, name)
+	return nil
+}
+
+func (s *InMemoryStore) ListItemStatuses(_ context.Context, mac net.HardwareAddr) ([]*ItemStatus, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := s.statuses[mac.String()]
+	statuses := make([]*ItemStatus, 0, len(items))
+	for _, status := range items {
+		copy := *status
+		statuses = append(statuses, &copy)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Component != statuses[j].Component {
+			return statuses[i].Component < statuses[j].Component
+		}
+		return statuses[i].ItemID < statuses[j].ItemID
+	})
+	return statuses, nil
+}
+
+func (s *InMemoryStore) PutItemStatus(_ context.Context, status *ItemStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mac := status.PmcMacAddress.String()
+	if s.statuses[mac] == nil {
+		s.statuses[mac] = make(map[string]*ItemStatus)
+	}
+	copy := *status
+	s.statuses[mac][string(status.Component)+"/"+status.ItemID] = &copy
+	return nil
+}
+
+func (s *InMemoryStore) AddDriftEvent(_ context.Context, event *DriftEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copy := *event
+	s.drift = append(s.drift, &copy)
+	return nil
+}
+
+func (s *InMemoryStore) ListDriftEvents(_ context.Context, filter DriftFilter) ([]*DriftEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []*DriftEvent
+	for i := len(s.drift) - 1; i >= 0; i-- {
+		if !filter.matches(s.drift[i]) {
+			continue
+		}
+		copy := *s.drift[i]
+		events = append(events, &copy)
+		if filter.Limit > 0 && len(events) == filter.Limit {
+			break
+		}
+	}
+	return events, nil
+}
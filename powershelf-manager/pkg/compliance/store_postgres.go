@@ -0,0 +1,189 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compliance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/db/migrations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStore is a Postgres-backed implementation of Store.
+type PostgresStore struct {
+	session *cdb.Session
+}
+
+// NewPostgresStore initializes connectivity to Postgres and runs any pending migrations.
+func NewPostgresStore(ctx context.Context, c cdb.Config) (*PostgresStore, error) {
+	session, err := cdb.NewSessionFromConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrations.MigrateWithDB(ctx, session.DB); err != nil {
+		session.Close()
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return &PostgresStore{session}, nil
+}
+
+func (ps *PostgresStore) Start(ctx context.Context) error {
+	log.Printf("Starting PostgresQL firmware compliance store")
+	return nil
+}
+
+func (ps *PostgresStore) Stop(ctx context.Context) error {
+	log.Printf("Stopping PostgresQL firmware compliance store")
+	ps.session.Close()
+	return nil
+}
+
+func (ps *PostgresStore) GetBaseline(ctx context.Context, name string) (*Baseline, error) {
+	fb, err := model.GetFirmwareBaseline(ctx, ps.session.DB, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return modelToBaseline(fb), nil
+}
+
+func (ps *PostgresStore) ListBaselines(ctx context.Context) ([]*Baseline, error) {
+	fbs, err := model.ListFirmwareBaselines(ctx, ps.session.DB)
+	if err != nil {
+		return nil, err
+	}
+	baselines := make([]*Baseline, len(fbs))
+	for i := range fbs {
+		baselines[i] = modelToBaseline(&fbs[i])
+	}
+	return baselines, nil
+}
+
+func (ps *PostgresStore) PutBaseline(ctx context.Context, baseline *Baseline) error {
+	return model.UpsertFirmwareBaseline(ctx, ps.session.DB, &model.FirmwareBaseline{
+		Name:      baseline.Name,
+		Vendor:    baseline.Vendor,
+		Model:     baseline.Model,
+		Component: string(baseline.Component),
+		Version:   baseline.Version,
+	})
+}
+
+func (ps *PostgresStore) DeleteBaseline(ctx context.Context, name string) error {
+	if err := model.DeleteFirmwareBaseline(ctx, ps.session.DB, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (ps *PostgresStore) ListItemStatuses(ctx context.Context, mac net.HardwareAddr) ([]*ItemStatus, error) {
+	rows, err := model.ListFirmwareComplianceStatuses(ctx, ps.session.DB, mac)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*ItemStatus, len(rows))
+	for i, row := range rows {
+		statuses[i] = &ItemStatus{
+			PmcMacAddress: net.HardwareAddr(row.PmcMacAddress),
+			Component:     powershelf.Component(row.Component),
+			ItemID:        row.ItemID,
+			Baseline:      row.Baseline,
+			Expected:      row.Expected,
+			Actual:        row.Actual,
+			Compliant:     row.Compliant,
+			CheckedAt:     row.CheckedAt,
+		}
+	}
+	return statuses, nil
+}
+
+func (ps *PostgresStore) PutItemStatus(ctx context.Context, status *ItemStatus) error {
+	return model.UpsertFirmwareComplianceStatus(ctx, ps.session.DB, &model.FirmwareComplianceStatus{
+		PmcMacAddress: model.MacAddr(status.PmcMacAddress),
+		Component:     string(status.Component),
+		ItemID:        status.ItemID,
+		Baseline:      status.Baseline,
+		Expected:      status.Expected,
+		Actual:        status.Actual,
+		Compliant:     status.Compliant,
+		CheckedAt:     status.CheckedAt,
+	})
+}
+
+func (ps *PostgresStore) AddDriftEvent(ctx context.Context, event *DriftEvent) error {
+	return model.InsertFirmwareDrift(ctx, ps.session.DB, &model.FirmwareDrift{
+		PmcMacAddress: model.MacAddr(event.PmcMacAddress),
+		Component:     string(event.Component),
+		ItemID:        event.ItemID,
+		Baseline:      event.Baseline,
+		Expected:      event.Expected,
+		Actual:        event.Actual,
+		Compliant:     event.Compliant,
+		DetectedAt:    event.DetectedAt,
+	})
+}
+
+func (ps *PostgresStore) ListDriftEvents(ctx context.Context, filter DriftFilter) ([]*DriftEvent, error) {
+	rows, err := model.ListFirmwareDrift(ctx, ps.session.DB, filter.PmcMacAddresses, filter.Since, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*DriftEvent, len(rows))
+	for i, row := range rows {
+		events[i] = &DriftEvent{
+			PmcMacAddress: net.HardwareAddr(row.PmcMacAddress),
+			Component:     powershelf.Component(row.Component),
+			ItemID:        row.ItemID,
+			Baseline:      row.Baseline,
+			Expected:      row.Expected,
+			Actual:        row.Actual,
+			Compliant:     row.Compliant,
+			DetectedAt:    row.DetectedAt,
+		}
+	}
+	return events, nil
+}
+
+func modelToBaseline(fb *model.FirmwareBaseline) *Baseline {
+	return &Baseline{
+		Name:      fb.Name,
+		Vendor:    fb.Vendor,
+		Model:     fb.Model,
+		Component: powershelf.Component(fb.Component),
+		Version:   fb.Version,
+		CreatedAt: fb.CreatedAt,
+		UpdatedAt: fb.UpdatedAt,
+	}
+}
@@ -18,15 +18,22 @@ package protobuf
 
 import (
 	"fmt"
+	"time"
 
 	gofish "github.com/stmcginnis/gofish/redfish"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/internal/proto/v1"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentialrotation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powersupply"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
 )
 
 var pmcTypeToMap map[vendor.VendorCode]pb.PMCVendor
@@ -204,9 +211,10 @@ func PowershelfTo(powershelf *powershelf.PowerShelf) *pb.PowerShelf {
 	}
 
 	return &pb.PowerShelf{
-		Pmc:     pmc,
-		Chassis: ChassisTo(powershelf.Chassis),
-		Psus:    psus,
+		Pmc:             pmc,
+		Chassis:         ChassisTo(powershelf.Chassis),
+		Psus:            psus,
+		PowerLimitWatts: powershelf.PowerLimitWatts,
 	}
 }
 
@@ -260,3 +268,293 @@ func FirmwareUpdateStatusTo(update *powershelf.FirmwareUpdate, pbComponent pb.Po
 		Status:        pb.StatusCode_SUCCESS,
 	}
 }
+
+// CredentialRotationStateTo converts a credential rotation state to protobuf.
+func CredentialRotationStateTo(state credentialrotation.State) pb.CredentialRotationState {
+	switch state {
+	case credentialrotation.StateInProgress:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS
+	case credentialrotation.StateSucceeded:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED
+	case credentialrotation.StateFailed:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED
+	case credentialrotation.StateRollbackFailed:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED
+	default:
+		return pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN
+	}
+}
+
+// CredentialRotationStatusTo converts the latest credential rotation of a PMC to protobuf. A nil status means
+// the credential was never rotated. A zero next leaves NextRotation unset.
+func CredentialRotationStatusTo(pmcMac string, status *credentialrotation.Status, next time.Time) *pb.CredentialRotationStatus {
+	resp := &pb.CredentialRotationStatus{
+		PmcMacAddress: pmcMac,
+		State:         pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED,
+		Status:        pb.StatusCode_SUCCESS,
+	}
+	if !next.IsZero() {
+		resp.NextRotation = timestamppb.New(next)
+	}
+	if status == nil {
+		return resp
+	}
+
+	resp.State = CredentialRotationStateTo(status.State)
+	resp.RotationError = status.ErrorMessage
+	if !status.LastAttemptAt.IsZero() {
+		resp.LastAttempt = timestamppb.New(status.LastAttemptAt)
+	}
+	if !status.LastRotatedAt.IsZero() {
+		resp.LastRotated = timestamppb.New(status.LastRotatedAt)
+	}
+
+	return resp
+}
+
+// EventKindTo converts an event kind to protobuf.
+func EventKindTo(kind events.Kind) pb.PowershelfEventKind {
+	switch kind {
+	case events.KindPSUFault:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT
+	case events.KindPowerState:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_POWER_STATE
+	case events.KindTask:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_TASK
+	case events.KindOther:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_OTHER
+	default:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_UNKNOWN
+	}
+}
+
+// EventKindFrom converts a protobuf event kind, returning false for POWERSHELF_EVENT_KIND_UNKNOWN or unknown values.
+func EventKindFrom(kind pb.PowershelfEventKind) (events.Kind, bool) {
+	switch kind {
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT:
+		return events.KindPSUFault, true
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_POWER_STATE:
+		return events.KindPowerState, true
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_TASK:
+		return events.KindTask, true
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_OTHER:
+		return events.KindOther, true
+	default:
+		return "", false
+	}
+}
+
+// EventTo converts a PMC event to protobuf, leaving Timestamp unset if the PMC did not report one.
+func EventTo(ev eventmanager.Event) *pb.PowershelfEvent {
+	resp := &pb.PowershelfEvent{
+		PmcMacAddress: ev.PmcMAC,
+		Kind:          EventKindTo(ev.Kind),
+		Severity:      ev.Severity,
+		MessageId:     ev.MessageID,
+		Message:       ev.Message,
+		Origin:        ev.Origin,
+	}
+	if !ev.Timestamp.IsZero() {
+		resp.Timestamp = timestamppb.New(ev.Timestamp)
+	}
+
+	return resp
+}
+
+// TelemetryReadingsTo converts telemetry Readings to protobuf, leaving metrics without a reading unset.
+func TelemetryReadingsTo(readings telemetry.Readings) *pb.TelemetryReadings {
+	reading := func(m telemetry.Metric) *float64 {
+		if v, ok := readings[m]; ok {
+			return &v
+		}
+		return nil
+	}
+
+	return &pb.TelemetryReadings{
+		InputPowerWatts:    reading(telemetry.InputPowerWatts),
+		OutputPowerWatts:   reading(telemetry.OutputPowerWatts),
+		InputVoltageVolts:  reading(telemetry.InputVoltageVolts),
+		OutputVoltageVolts: reading(telemetry.OutputVoltageVolts),
+		InputCurrentAmps:   reading(telemetry.InputCurrentAmps),
+		OutputCurrentAmps:  reading(telemetry.OutputCurrentAmps),
+		TemperatureCelsius: reading(telemetry.TemperatureCelsius),
+		FanSpeedRpm:        reading(telemetry.FanSpeedRPM),
+	}
+}
+
+// TelemetrySampleTo converts a telemetry ShelfSample to a protobuf TelemetrySample.
+func TelemetrySampleTo(sample *telemetry.ShelfSample) *pb.TelemetrySample {
+	if sample == nil {
+		return nil
+	}
+
+	psus := make([]*pb.PowerSupplyTelemetry, 0, len(sample.PowerSupplies))
+	for _, psu := range sample.PowerSupplies {
+		psus = append(psus, &pb.PowerSupplyTelemetry{
+			Id:         psu.ID,
+			Name:       psu.Name,
+			PowerState: psu.PowerState,
+			Health:     psu.Health,
+			Readings:   TelemetryReadingsTo(psu.Readings),
+		})
+	}
+
+	return &pb.TelemetrySample{
+		Timestamp:       timestamppb.New(sample.Timestamp),
+		Readings:        TelemetryReadingsTo(sample.Readings),
+		Psus:            psus,
+		PowerLimitWatts: sample.PowerLimitWatts,
+	}
+}
+
+// PowershelfTelemetryTo converts the telemetry history of a PMC to protobuf. Vendor and rack are taken from the latest sample.
+func PowershelfTelemetryTo(pmcMac string, samples []*telemetry.ShelfSample) *pb.PowershelfTelemetry {
+	result := &pb.PowershelfTelemetry{
+		PmcMacAddress: pmcMac,
+		Samples:       make([]*pb.TelemetrySample, 0, len(samples)),
+		Status:        pb.StatusCode_SUCCESS,
+	}
+
+	for _, sample := range samples {
+		result.Samples = append(result.Samples, TelemetrySampleTo(sample))
+	}
+
+	if len(samples) > 0 {
+		latest := samples[len(samples)-1]
+		result.Vendor = VendorCodeTo(latest.Vendor.Code)
+		result.Rack = latest.Rack
+	}
+
+	return result
+}
+
+// TelemetrySinceFrom converts the protobuf since timestamp, returning the zero time (all retained samples) if unset.
+func TelemetrySinceFrom(since *timestamppb.Timestamp) time.Time {
+	if since == nil {
+		return time.Time{}
+	}
+	return since.AsTime()
+}
+
+// ComponentTo maps a powershelf.Component to its protobuf Component.
+func ComponentTo(component powershelf.Component) pb.PowershelfComponent {
+	for pbComponent, c := range componentTypeFromMap {
+		if c == component {
+			return pbComponent
+		}
+	}
+
+	return pb.PowershelfComponent_PMC
+}
+
+// FirmwareBaselineTo converts a firmware baseline to protobuf.
+func FirmwareBaselineTo(baseline *compliance.Baseline) *pb.FirmwareBaseline {
+	if baseline == nil {
+		return nil
+	}
+
+	resp := &pb.FirmwareBaseline{
+		Name:      baseline.Name,
+		Vendor:    VendorCodeTo(vendor.StringToVendor(baseline.Vendor).Code),
+		Model:     baseline.Model,
+		Component: ComponentTo(baseline.Component),
+		Version:   baseline.Version,
+	}
+	if !baseline.CreatedAt.IsZero() {
+		resp.CreatedAt = timestamppb.New(baseline.CreatedAt)
+	}
+	if !baseline.UpdatedAt.IsZero() {
+		resp.UpdatedAt = timestamppb.New(baseline.UpdatedAt)
+	}
+
+	return resp
+}
+
+// FirmwareBaselineFrom converts a protobuf firmware baseline.
+func FirmwareBaselineFrom(baseline *pb.FirmwareBaseline) (*compliance.Baseline, error) {
+	if baseline == nil {
+		return nil, fmt.Errorf("baseline is required")
+	}
+
+	component, err := ComponentTypeFromMap(baseline.Component)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compliance.Baseline{
+		Name:      baseline.Name,
+		Vendor:    vendor.CodeToVendor(PMCVendorFrom(baseline.Vendor)).Name,
+		Model:     baseline.Model,
+		Component: component,
+		Version:   baseline.Version,
+	}, nil
+}
+
+// FirmwareComplianceStateTo converts a firmware compliance state to protobuf.
+func FirmwareComplianceStateTo(state compliance.State) pb.FirmwareComplianceState {
+	switch state {
+	case compliance.StateCompliant:
+		return pb.FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_COMPLIANT
+	case compliance.StateNonCompliant:
+		return pb.FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_NON_COMPLIANT
+	case compliance.StateNoBaseline:
+		return pb.FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_NO_BASELINE
+	default:
+		return pb.FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_UNKNOWN
+	}
+}
+
+// PowershelfFirmwareComplianceTo converts the firmware compliance of a powershelf to protobuf.
+func PowershelfFirmwareComplianceTo(shelf *compliance.ShelfReport) *pb.PowershelfFirmwareCompliance {
+	resp := &pb.PowershelfFirmwareCompliance{
+		PmcMacAddress: shelf.PmcMacAddress.String(),
+		Compliant:     shelf.Compliant(),
+		Status:        pb.StatusCode_SUCCESS,
+	}
+	if shelf.Error != "" {
+		resp.Status = pb.StatusCode_INTERNAL_ERROR
+		resp.Error = shelf.Error
+	}
+
+	for _, entry := range shelf.Entries {
+		resp.Entries = append(resp.Entries, &pb.FirmwareComplianceEntry{
+			Component:       ComponentTo(entry.Component),
+			ItemId:          entry.ItemID,
+			Model:           entry.Model,
+			Baseline:        entry.Baseline,
+			ExpectedVersion: entry.Expected,
+			ActualVersion:   entry.Actual,
+			State:           FirmwareComplianceStateTo(entry.State),
+			Remediation:     entry.Remediation,
+		})
+	}
+
+	return resp
+}
+
+// FirmwareComplianceReportTo converts a firmware compliance report to protobuf.
+func FirmwareComplianceReportTo(report *compliance.Report) *pb.GetFirmwareComplianceReportResponse {
+	resp := &pb.GetFirmwareComplianceReportResponse{
+		GeneratedAt:  timestamppb.New(report.GeneratedAt),
+		NonCompliant: int32(len(report.NonCompliant())),
+	}
+	for _, shelf := range report.Shelves {
+		resp.Powershelves = append(resp.Powershelves, PowershelfFirmwareComplianceTo(shelf))
+	}
+
+	return resp
+}
+
+// FirmwareDriftEventTo converts a firmware drift event to protobuf.
+func FirmwareDriftEventTo(event *compliance.DriftEvent) *pb.FirmwareDriftEvent {
+	return &pb.FirmwareDriftEvent{
+		PmcMacAddress:   event.PmcMacAddress.String(),
+		Component:       ComponentTo(event.Component),
+		ItemId:          event.ItemID,
+		Baseline:        event.Baseline,
+		ExpectedVersion: event.Expected,
+		ActualVersion:   event.Actual,
+		Compliant:       event.Compliant,
+		DetectedAt:      timestamppb.New(event.DetectedAt),
+	}
+}
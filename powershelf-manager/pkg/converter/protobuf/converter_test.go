@@ -18,15 +18,21 @@ package protobuf
 
 import (
 	"testing"
+	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/internal/proto/v1"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powersupply"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
 
 	rfcommon "github.com/stmcginnis/gofish/common"
 	gofish "github.com/stmcginnis/gofish/redfish"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // pickValidVendor returns a supported vendor code; hard-fails if not accepted.
@@ -630,3 +636,191 @@ func TestPMCFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestTelemetryReadingsTo(t *testing.T) {
+	got := TelemetryReadingsTo(telemetry.Readings{
+		telemetry.OutputPowerWatts: 2150,
+		telemetry.FanSpeedRPM:      9800,
+	})
+
+	if got.OutputPowerWatts == nil || *got.OutputPowerWatts != 2150 {
+		t.Errorf("OutputPowerWatts = %v; want 2150", got.OutputPowerWatts)
+	}
+	if got.FanSpeedRpm == nil || *got.FanSpeedRpm != 9800 {
+		t.Errorf("FanSpeedRpm = %v; want 9800", got.FanSpeedRpm)
+	}
+	if got.InputPowerWatts != nil || got.InputVoltageVolts != nil || got.TemperatureCelsius != nil {
+		t.Errorf("expected readings without sensors to be unset, got %v", got)
+	}
+}
+
+func TestPowershelfTelemetryTo(t *testing.T) {
+	first := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	samples := []*telemetry.ShelfSample{
+		{
+			PmcMAC:    "00:11:22:33:44:55",
+			Vendor:    vendor.CodeToVendor(vendor.VendorCodeUnsupported),
+			Timestamp: first,
+			Readings:  telemetry.Readings{telemetry.OutputPowerWatts: 1000},
+		},
+		{
+			PmcMAC:    "00:11:22:33:44:55",
+			Vendor:    vendor.CodeToVendor(vendor.VendorCodeLiteon),
+			Rack:      "rack-01",
+			Timestamp: first.Add(30 * time.Second),
+			Readings:  telemetry.Readings{telemetry.OutputPowerWatts: 2000},
+			PowerLimitWatts: func() *float64 {
+				limit := 12000.0
+				return &limit
+			}(),
+			PowerSupplies: []telemetry.PowerSupplySample{
+				{ID: "PSU0", Name: "PSU 0", PowerState: true, Health: "OK", Readings: telemetry.Readings{telemetry.OutputPowerWatts: 2000}},
+			},
+		},
+	}
+
+	got := PowershelfTelemetryTo("00:11:22:33:44:55", samples)
+	if got.Status != pb.StatusCode_SUCCESS {
+		t.Errorf("Status = %v; want SUCCESS", got.Status)
+	}
+	if got.Vendor != pb.PMCVendor_PMC_TYPE_LITEON || got.Rack != "rack-01" {
+		t.Errorf("vendor/rack = %v/%q; want latest sample's LITEON/rack-01", got.Vendor, got.Rack)
+	}
+	if len(got.Samples) != 2 {
+		t.Fatalf("samples len = %d; want 2", len(got.Samples))
+	}
+	if !got.Samples[0].Timestamp.AsTime().Equal(first) {
+		t.Errorf("samples[0].Timestamp = %v; want %v", got.Samples[0].Timestamp.AsTime(), first)
+	}
+	if len(got.Samples[1].Psus) != 1 || got.Samples[1].Psus[0].Id != "PSU0" || !got.Samples[1].Psus[0].PowerState {
+		t.Errorf("samples[1].Psus = %v; want PSU0 powered on", got.Samples[1].Psus)
+	}
+	if got.Samples[0].PowerLimitWatts != nil || got.Samples[1].GetPowerLimitWatts() != 12000 {
+		t.Errorf("power limits = %v/%v; want unset/12000", got.Samples[0].PowerLimitWatts, got.Samples[1].PowerLimitWatts)
+	}
+
+	empty := PowershelfTelemetryTo("00:11:22:33:44:66", nil)
+	if empty.Status != pb.StatusCode_SUCCESS || len(empty.Samples) != 0 || empty.Rack != "" {
+		t.Errorf("expected successful empty telemetry, got %v", empty)
+	}
+}
+
+func TestTelemetrySinceFrom(t *testing.T) {
+	if got := TelemetrySinceFrom(nil); !got.IsZero() {
+		t.Errorf("TelemetrySinceFrom(nil) = %v; want zero time", got)
+	}
+
+	since := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	if got := TelemetrySinceFrom(timestamppb.New(since)); !got.Equal(since) {
+		t.Errorf("TelemetrySinceFrom = %v; want %v", got, since)
+	}
+}
+
+func TestEventKindRoundTrip(t *testing.T) {
+	for _, kind := range []events.Kind{events.KindPSUFault, events.KindPowerState, events.KindTask, events.KindOther} {
+		got, ok := EventKindFrom(EventKindTo(kind))
+		if !ok || got != kind {
+			t.Errorf("EventKindFrom(EventKindTo(%q)) = %q, %v; want %q, true", kind, got, ok, kind)
+		}
+	}
+
+	if _, ok := EventKindFrom(pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_UNKNOWN); ok {
+		t.Errorf("EventKindFrom(UNKNOWN) succeeded; want failure")
+	}
+}
+
+func TestEventTo(t *testing.T) {
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	got := EventTo(eventmanager.Event{
+		PmcMAC:    "00:11:22:33:44:55",
+		Kind:      events.KindPSUFault,
+		Severity:  "Critical",
+		MessageID: "ResourceEvent.1.3.ResourceStatusChangedCritical",
+		Message:   "PSU1 failed.",
+		Origin:    "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU1",
+		Timestamp: at,
+	})
+
+	if got.PmcMacAddress != "00:11:22:33:44:55" || got.Kind != pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT {
+		t.Errorf("unexpected identity fields: %v", got)
+	}
+	if got.Severity != "Critical" || got.MessageId != "ResourceEvent.1.3.ResourceStatusChangedCritical" || got.Message != "PSU1 failed." {
+		t.Errorf("unexpected message fields: %v", got)
+	}
+	if got.Origin != "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU1" || !got.Timestamp.AsTime().Equal(at) {
+		t.Errorf("unexpected origin or timestamp: %v", got)
+	}
+
+	if EventTo(eventmanager.Event{Kind: events.KindTask}).Timestamp != nil {
+		t.Errorf("expected unset timestamp for an event without one")
+	}
+}
+
+func TestFirmwareBaselineRoundTrip(t *testing.T) {
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	baseline := &compliance.Baseline{
+		Name:      "delta-psu",
+		Vendor:    vendor.VendorDelta,
+		Model:     "ECD15020056",
+		Component: powershelf.PSU,
+		Version:   "2.1",
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+
+	pbBaseline := FirmwareBaselineTo(baseline)
+	if pbBaseline.Vendor != pb.PMCVendor_PMC_TYPE_DELTA || pbBaseline.Component != pb.PowershelfComponent_PSU {
+		t.Errorf("unexpected vendor or component: %v", pbBaseline)
+	}
+	if !pbBaseline.CreatedAt.AsTime().Equal(at) {
+		t.Errorf("unexpected created_at: %v", pbBaseline.CreatedAt)
+	}
+
+	got, err := FirmwareBaselineFrom(pbBaseline)
+	if err != nil {
+		t.Fatalf("FirmwareBaselineFrom failed: %v", err)
+	}
+	if got.Name != baseline.Name || got.Vendor != baseline.Vendor || got.Model != baseline.Model || got.Component != baseline.Component || got.Version != baseline.Version {
+		t.Errorf("round trip = %+v; want %+v", got, baseline)
+	}
+
+	if _, err := FirmwareBaselineFrom(nil); err == nil {
+		t.Errorf("FirmwareBaselineFrom(nil) succeeded; want failure")
+	}
+}
+
+func TestFirmwareComplianceReportTo(t *testing.T) {
+	report := &compliance.Report{
+		GeneratedAt: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		Shelves: []*compliance.ShelfReport{
+			{
+				PmcMacAddress: []byte{0, 0x11, 0x22, 0x33, 0x44, 0x55},
+				Entries: []compliance.Entry{
+					{Component: powershelf.PMC, ItemID: "BMC", Baseline: "pmc", Expected: "r1.3.9", Actual: "r1.3.8", State: compliance.StateNonCompliant, Remediation: "queued upgrade to r1.3.9"},
+				},
+			},
+			{
+				PmcMacAddress: []byte{0, 0x11, 0x22, 0x33, 0x44, 0x56},
+				Error:         "connection refused",
+			},
+		},
+	}
+
+	got := FirmwareComplianceReportTo(report)
+	if got.NonCompliant != 2 || len(got.Powershelves) != 2 {
+		t.Fatalf("unexpected report: %v", got)
+	}
+
+	shelf := got.Powershelves[0]
+	if shelf.PmcMacAddress != "00:11:22:33:44:55" || shelf.Compliant || shelf.Status != pb.StatusCode_SUCCESS {
+		t.Errorf("unexpected shelf: %v", shelf)
+	}
+	entry := shelf.Entries[0]
+	if entry.State != pb.FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_NON_COMPLIANT || entry.ExpectedVersion != "r1.3.9" || entry.ActualVersion != "r1.3.8" || entry.Remediation != "queued upgrade to r1.3.9" {
+		t.Errorf("unexpected entry: %v", entry)
+	}
+
+	if failed := got.Powershelves[1]; failed.Status != pb.StatusCode_INTERNAL_ERROR || failed.Error != "connection refused" {
+		t.Errorf("unexpected failed shelf: %v", failed)
+	}
+}
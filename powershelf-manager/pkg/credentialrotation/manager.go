@@ -0,0 +1,371 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package credentialrotation rotates PMC passwords. A rotation generates a new password, sets it on the PMC
+// through the Redfish AccountService, verifies a login with it and only then commits it to the credential
+// manager. Any failure after the password was changed restores the previous password on the PMC.
+package credentialrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultCheckInterval is how often the scheduler looks for PMCs due for rotation.
+	DefaultCheckInterval = time.Hour
+
+	// rotationTimeout bounds a single rotation, including a rollback.
+	rotationTimeout = 5 * time.Minute
+)
+
+// ErrInProgress is returned by Trigger when a rotation of the PMC is already running.
+var ErrInProgress = errors.New("credential rotation already in progress")
+
+// PmcClient is the subset of the PMC manager needed to rotate credentials.
+type PmcClient interface {
+	GetPmc(ctx context.Context, mac net.HardwareAddr) (*pmc.PMC, error)
+	GetAllPmcs(ctx context.Context) ([]*pmc.PMC, error)
+	ChangePassword(ctx context.Context, pmc *pmc.PMC, newPassword string) error
+	VerifyLogin(ctx context.Context, pmc *pmc.PMC) error
+	StoreCredential(ctx context.Context, mac net.HardwareAddr, cred *credential.Credential) error
+}
+
+// Config specifies the rotation schedule of PMC credentials.
+type Config struct {
+	// Interval is the maximum age of a PMC password before the scheduler rotates it. Zero disables
+	// scheduled rotation; Trigger still rotates on demand.
+	Interval time.Duration
+	// CheckInterval is how often the scheduler looks for PMCs due for rotation (DefaultCheckInterval if zero).
+	CheckInterval time.Duration
+	// PasswordLength is the length of generated passwords (credential.DefaultPasswordLength if zero).
+	PasswordLength int
+}
+
+// Manager rotates PMC credentials on demand and on a schedule and records the outcome in a Store.
+type Manager struct {
+	pmcs  PmcClient
+	store Store
+	conf  Config
+	now   func() time.Time
+
+	mu       sync.Mutex
+	inflight map[string]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Manager rotating the credentials of the PMCs known to the given client.
+func New(pmcs PmcClient, store Store, conf Config) *Manager {
+	if conf.CheckInterval <= 0 {
+		conf.CheckInterval = DefaultCheckInterval
+	}
+	if conf.PasswordLength <= 0 {
+		conf.PasswordLength = credential.DefaultPasswordLength
+	}
+
+	return &Manager{
+		pmcs:     pmcs,
+		store:    store,
+		conf:     conf,
+		now:      time.Now,
+		inflight: make(map[string]struct{}),
+	}
+}
+
+// Start starts the store, marks rotations interrupted by a restart as failed and, if an Interval is configured,
+// starts the scheduler.
+func (m *Manager) Start(ctx context.Context) error {
+	if err := m.store.Start(ctx); err != nil {
+		return err
+	}
+
+	if err := m.failInterrupted(ctx); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	if m.conf.Interval > 0 {
+		log.Printf("Starting credential rotation scheduler (interval: %s, check interval: %s)", m.conf.Interval, m.conf.CheckInterval)
+		m.wg.Add(1)
+		go m.schedule(runCtx)
+	}
+
+	return nil
+}
+
+// Stop stops the scheduler, waits for running rotations and stops the store.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+
+	return m.store.Stop(ctx)
+}
+
+// Trigger starts rotating the credential of a registered PMC in the background. The outcome is reported by Status.
+func (m *Manager) Trigger(ctx context.Context, mac net.HardwareAddr) error {
+	if _, err := m.pmcs.GetPmc(ctx, mac); err != nil {
+		return err
+	}
+
+	if !m.acquire(mac) {
+		return ErrInProgress
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer m.release(mac)
+
+		if err := m.rotate(context.Background(), mac); err != nil {
+			log.Errorf("Credential rotation of PMC %s failed: %v", mac, err)
+		}
+	}()
+
+	return nil
+}
+
+// Rotate rotates the credential of a registered PMC and waits for the outcome.
+func (m *Manager) Rotate(ctx context.Context, mac net.HardwareAddr) error {
+	if !m.acquire(mac) {
+		return ErrInProgress
+	}
+	defer m.release(mac)
+
+	return m.rotate(ctx, mac)
+}
+
+// Status returns the latest rotation of a PMC. Returns ErrNotFound if its credential was never rotated.
+func (m *Manager) Status(ctx context.Context, mac net.HardwareAddr) (*Status, error) {
+	return m.store.Get(ctx, mac)
+}
+
+// Statuses returns the latest rotation of every PMC with a recorded rotation.
+func (m *Manager) Statuses(ctx context.Context) ([]*Status, error) {
+	return m.store.List(ctx)
+}
+
+// NextRotation returns when the scheduler rotates the credential of a PMC next, zero if scheduled rotation is
+// disabled. A PMC whose credential was never rotated is due immediately.
+func (m *Manager) NextRotation(status *Status) time.Time {
+	if m.conf.Interval <= 0 {
+		return time.Time{}
+	}
+	if status == nil || status.LastRotatedAt.IsZero() {
+		return m.now()
+	}
+	return status.LastRotatedAt.Add(m.conf.Interval)
+}
+
+func (m *Manager) acquire(mac net.HardwareAddr) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.inflight[mac.String()]; ok {
+		return false
+	}
+	m.inflight[mac.String()] = struct{}{}
+	return true
+}
+
+func (m *Manager) release(mac net.HardwareAddr) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.inflight, mac.String())
+}
+
+// rotate runs a single rotation and records its outcome. The caller must hold the PMC's inflight slot.
+func (m *Manager) rotate(ctx context.Context, mac net.HardwareAddr) error {
+	ctx, cancel := context.WithTimeout(ctx, rotationTimeout)
+	defer cancel()
+
+	status := &Status{PmcMacAddress: mac}
+	if prev, err := m.store.Get(ctx, mac); err == nil {
+		status.LastRotatedAt = prev.LastRotatedAt
+	} else if !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to load the rotation status: %w", err)
+	}
+
+	status.State = StateInProgress
+	status.LastAttemptAt = m.now()
+	status.ErrorMessage = ""
+	if err := m.store.Put(ctx, status); err != nil {
+		return fmt.Errorf("failed to record the rotation start: %w", err)
+	}
+
+	state, err := m.changeCredential(ctx, mac)
+	status.State = state
+	if err != nil {
+		status.ErrorMessage = err.Error()
+	} else {
+		status.LastRotatedAt = m.now()
+	}
+
+	if perr := m.store.Put(ctx, status); perr != nil {
+		log.Errorf("failed to record the credential rotation outcome of PMC %s (%s): %v", mac, state, perr)
+	}
+
+	return err
+}
+
+// changeCredential performs the rotation steps and returns the resulting state.
+func (m *Manager) changeCredential(ctx context.Context, mac net.HardwareAddr) (State, error) {
+	current, err := m.pmcs.GetPmc(ctx, mac)
+	if err != nil {
+		return StateFailed, err
+	}
+
+	oldCred := current.GetCredential()
+	if oldCred == nil || !oldCred.IsValid() {
+		return StateFailed, fmt.Errorf("PMC %s has no credential to rotate", mac)
+	}
+
+	password, err := credential.GeneratePassword(m.conf.PasswordLength)
+	if err != nil {
+		return StateFailed, err
+	}
+	newCred := credential.New(oldCred.User, password)
+
+	log.Infof("Rotating the credential of PMC %s", mac)
+	if err := m.pmcs.ChangePassword(ctx, current, password); err != nil {
+		// The PMC may have applied the change before failing the request.
+		return m.rollback(ctx, current, &newCred, fmt.Errorf("failed to set the new password: %w", err))
+	}
+
+	rotated := *current
+	rotated.SetCredential(&newCred)
+	if err := m.pmcs.VerifyLogin(ctx, &rotated); err != nil {
+		return m.rollback(ctx, current, &newCred, fmt.Errorf("failed to log in with the new password: %w", err))
+	}
+
+	if err := m.pmcs.StoreCredential(ctx, mac, &newCred); err != nil {
+		return m.rollback(ctx, current, &newCred, fmt.Errorf("failed to store the new credential: %w", err))
+	}
+
+	log.Infof("Rotated the credential of PMC %s", mac)
+	return StateSucceeded, nil
+}
+
+// rollback restores the stored password on a PMC after a failed rotation. current carries the stored
+// credential and newCred the credential the rotation tried to set.
+func (m *Manager) rollback(ctx context.Context, current *pmc.PMC, newCred *credential.Credential, cause error) (State, error) {
+	// Nothing to restore if the PMC still accepts the stored password.
+	if err := m.pmcs.VerifyLogin(ctx, current); err == nil {
+		return StateFailed, cause
+	}
+
+	log.Warnf("Restoring the previous password of PMC %s after a failed rotation: %v", current.MAC, cause)
+	rotated := *current
+	rotated.SetCredential(newCred)
+	if err := m.pmcs.ChangePassword(ctx, &rotated, current.GetCredential().Password.Value); err != nil {
+		return StateRollbackFailed, fmt.Errorf("%v; restoring the previous password failed: %w", cause, err)
+	}
+
+	if err := m.pmcs.VerifyLogin(ctx, current); err != nil {
+		return StateRollbackFailed, fmt.Errorf("%v; the previous password is not accepted after restoring it: %w", cause, err)
+	}
+
+	return StateFailed, cause
+}
+
+// failInterrupted marks rotations left in progress by a previous run as failed. Such a PMC may hold a
+// password that was never stored.
+func (m *Manager) failInterrupted(ctx context.Context) error {
+	statuses, err := m.store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list credential rotations: %w", err)
+	}
+
+	for _, status := range statuses {
+		if status.State != StateInProgress {
+			continue
+		}
+
+		log.Warnf("Credential rotation of PMC %s was interrupted by a restart", status.PmcMacAddress)
+		status.State = StateFailed
+		status.ErrorMessage = "rotation interrupted by a service restart; verify the PMC accepts the stored credential"
+		if err := m.store.Put(ctx, status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) schedule(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.rotateDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rotateDue rotates, one at a time, the credential of every PMC whose password is older than the Interval.
+func (m *Manager) rotateDue(ctx context.Context) {
+	pmcs, err := m.pmcs.GetAllPmcs(ctx)
+	if err != nil {
+		log.Errorf("Credential rotation scheduler: failed to list PMCs: %v", err)
+		return
+	}
+
+	for _, p := range pmcs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		status, err := m.store.Get(ctx, p.MAC)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			log.Errorf("Credential rotation scheduler: failed to load the status of PMC %s: %v", p.MAC, err)
+			continue
+		}
+		if err == nil && status.State == StateRollbackFailed {
+			// Retrying could change the password of a PMC whose stored credential no longer works.
+			continue
+		}
+		if m.now().Before(m.NextRotation(status)) {
+			continue
+		}
+
+		if err := m.Rotate(ctx, p.MAC); err != nil && !errors.Is(err, ErrInProgress) {
+			log.Errorf("Credential rotation scheduler: rotation of PMC %s failed: %v", p.MAC, err)
+		}
+	}
+}
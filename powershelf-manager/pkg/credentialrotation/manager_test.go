@@ -0,0 +1,341 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentialrotation
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUser     = "root"
+	testPassword = "0penBmc!"
+)
+
+var testMAC = net.HardwareAddr{0, 1, 2, 3, 4, 5}
+
+// fakePmcs emulates registered PMCs, the password each device accepts and the credential store.
+type fakePmcs struct {
+	mu      sync.Mutex
+	device  map[string]string
+	stored  map[string]*credential.Credential
+	changes int
+
+	// changeErr fails every password change; changeApplies makes the device apply it anyway.
+	changeErr     error
+	changeApplies bool
+	// rejectNew makes the device refuse logins with any password but the original one.
+	rejectNew bool
+	// storeErr fails StoreCredential.
+	storeErr error
+	// block, if set, is waited on by ChangePassword.
+	block chan struct{}
+}
+
+func newFakePmcs() *fakePmcs {
+	cred := credential.New(testUser, testPassword)
+	return &fakePmcs{
+		device: map[string]string{testMAC.String(): testPassword},
+		stored: map[string]*credential.Credential{testMAC.String(): &cred},
+	}
+}
+
+func (f *fakePmcs) GetPmc(_ context.Context, mac net.HardwareAddr) (*pmc.PMC, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cred, ok := f.stored[mac.String()]
+	if !ok {
+		return nil, errors.New("PMC not found")
+	}
+	return pmc.NewFromAddr(mac, net.ParseIP("10.0.0.1"), vendor.VendorCodeLiteon, cred)
+}
+
+func (f *fakePmcs) GetAllPmcs(ctx context.Context) ([]*pmc.PMC, error) {
+	f.mu.Lock()
+	macs := make([]string, 0, len(f.stored))
+	for mac := range f.stored {
+		macs = append(macs, mac)
+	}
+	f.mu.Unlock()
+
+	pmcs := make([]*pmc.PMC, 0, len(macs))
+	for _, mac := range macs {
+		hw, _ := net.ParseMAC(mac)
+		p, err := f.GetPmc(ctx, hw)
+		if err != nil {
+			return nil, err
+		}
+		pmcs = append(pmcs, p)
+	}
+	return pmcs, nil
+}
+
+func (f *fakePmcs) login(p *pmc.PMC) error {
+	password := p.GetCredential().Password.Value
+	if f.device[p.MAC.String()] != password {
+		return errors.New("401 Unauthorized")
+	}
+	if f.rejectNew && password != testPassword {
+		return errors.New("401 Unauthorized")
+	}
+	return nil
+}
+
+func (f *fakePmcs) ChangePassword(_ context.Context, p *pmc.PMC, newPassword string) error {
+	if f.block != nil {
+		<-f.block
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.device[p.MAC.String()] != p.GetCredential().Password.Value {
+		return errors.New("401 Unauthorized")
+	}
+	if f.changeErr != nil {
+		if f.changeApplies {
+			f.device[p.MAC.String()] = newPassword
+		}
+		return f.changeErr
+	}
+	f.device[p.MAC.String()] = newPassword
+	f.changes++
+	return nil
+}
+
+func (f *fakePmcs) VerifyLogin(_ context.Context, p *pmc.PMC) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.login(p)
+}
+
+func (f *fakePmcs) StoreCredential(_ context.Context, mac net.HardwareAddr, cred *credential.Credential) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.storeErr != nil {
+		return f.storeErr
+	}
+	f.stored[mac.String()] = cred
+	return nil
+}
+
+func (f *fakePmcs) devicePassword() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.device[testMAC.String()]
+}
+
+func (f *fakePmcs) storedPassword() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.stored[testMAC.String()].Password.Value
+}
+
+func TestManager_Rotate(t *testing.T) {
+	testCases := map[string]struct {
+		setup       func(f *fakePmcs)
+		wantState   State
+		errContains string
+		// wantRotated is true if the device and the store end up on a new password.
+		wantRotated bool
+	}{
+		"rotation succeeds": {
+			wantState:   StateSucceeded,
+			wantRotated: true,
+		},
+		"password change rejected": {
+			setup: func(f *fakePmcs) {
+				f.changeErr = errors.New("400 Bad Request")
+			},
+			wantState:   StateFailed,
+			errContains: "failed to set the new password",
+		},
+		"password change fails after being applied is rolled back": {
+			setup: func(f *fakePmcs) {
+				f.changeErr = errors.New("connection reset")
+				f.changeApplies = true
+			},
+			// The rollback itself changes the password through the same failing call.
+			wantState:   StateRollbackFailed,
+			errContains: "restoring the previous password failed",
+		},
+		"login with the new password fails is rolled back": {
+			setup: func(f *fakePmcs) {
+				f.rejectNew = true
+			},
+			wantState:   StateFailed,
+			errContains: "failed to log in with the new password",
+		},
+		"storing the new credential fails is rolled back": {
+			setup: func(f *fakePmcs) {
+				f.storeErr = errors.New("vault sealed")
+			},
+			wantState:   StateFailed,
+			errContains: "failed to store the new credential",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFakePmcs()
+			if tc.setup != nil {
+				tc.setup(f)
+			}
+			m := New(f, NewInMemoryStore(), Config{})
+
+			err := m.Rotate(context.Background(), testMAC)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+
+			status, err := m.Status(context.Background(), testMAC)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantState, status.State)
+			assert.False(t, status.LastAttemptAt.IsZero())
+
+			if tc.wantRotated {
+				assert.NotEqual(t, testPassword, f.devicePassword())
+				assert.Equal(t, f.devicePassword(), f.storedPassword())
+				assert.Len(t, f.storedPassword(), credential.DefaultPasswordLength)
+				assert.False(t, status.LastRotatedAt.IsZero())
+				assert.Empty(t, status.ErrorMessage)
+				return
+			}
+
+			assert.Equal(t, testPassword, f.storedPassword())
+			assert.True(t, status.LastRotatedAt.IsZero())
+			assert.NotEmpty(t, status.ErrorMessage)
+			if tc.wantState == StateFailed {
+				assert.Equal(t, testPassword, f.devicePassword(), "device must accept the stored password after a failed rotation")
+			}
+		})
+	}
+}
+
+func TestManager_Trigger(t *testing.T) {
+	f := newFakePmcs()
+	f.block = make(chan struct{})
+	m := New(f, NewInMemoryStore(), Config{})
+	ctx := context.Background()
+
+	require.NoError(t, m.Trigger(ctx, testMAC))
+	assert.ErrorIs(t, m.Trigger(ctx, testMAC), ErrInProgress)
+	assert.Error(t, m.Trigger(ctx, net.HardwareAddr{9, 9, 9, 9, 9, 9}))
+
+	close(f.block)
+	require.NoError(t, m.Stop(ctx))
+
+	status, err := m.Status(ctx, testMAC)
+	require.NoError(t, err)
+	assert.Equal(t, StateSucceeded, status.State)
+	assert.Equal(t, f.devicePassword(), f.storedPassword())
+}
+
+func TestManager_RotateDue(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	interval := 90 * 24 * time.Hour
+
+	testCases := map[string]struct {
+		status      *Status
+		wantRotated bool
+	}{
+		"never rotated is due": {
+			wantRotated: true,
+		},
+		"rotated within the interval is not due": {
+			status:      &Status{State: StateSucceeded, LastRotatedAt: now.Add(-interval + time.Hour)},
+			wantRotated: false,
+		},
+		"rotated before the interval is due": {
+			status:      &Status{State: StateSucceeded, LastRotatedAt: now.Add(-interval)},
+			wantRotated: true,
+		},
+		"failed rotation is retried": {
+			status:      &Status{State: StateFailed, LastRotatedAt: now.Add(-interval - time.Hour)},
+			wantRotated: true,
+		},
+		"failed rollback is not retried": {
+			status:      &Status{State: StateRollbackFailed},
+			wantRotated: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFakePmcs()
+			store := NewInMemoryStore()
+			ctx := context.Background()
+			if tc.status != nil {
+				tc.status.PmcMacAddress = testMAC
+				require.NoError(t, store.Put(ctx, tc.status))
+			}
+
+			m := New(f, store, Config{Interval: interval})
+			m.now = func() time.Time { return now }
+
+			m.rotateDue(ctx)
+
+			assert.Equal(t, tc.wantRotated, f.changes == 1)
+		})
+	}
+}
+
+func TestManager_StartFailsInterrupted(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, &Status{PmcMacAddress: testMAC, State: StateInProgress}))
+
+	m := New(newFakePmcs(), store, Config{})
+	require.NoError(t, m.Start(ctx))
+	defer m.Stop(ctx)
+
+	status, err := m.Status(ctx, testMAC)
+	require.NoError(t, err)
+	assert.Equal(t, StateFailed, status.State)
+	assert.Contains(t, status.ErrorMessage, "interrupted")
+}
+
+func TestManager_NextRotation(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	rotated := now.Add(-time.Hour)
+
+	disabled := New(newFakePmcs(), NewInMemoryStore(), Config{})
+	assert.True(t, disabled.NextRotation(&Status{LastRotatedAt: rotated}).IsZero())
+
+	m := New(newFakePmcs(), NewInMemoryStore(), Config{Interval: 24 * time.Hour})
+	m.now = func() time.Time { return now }
+	assert.Equal(t, now, m.NextRotation(nil))
+	assert.Equal(t, rotated.Add(24*time.Hour), m.NextRotation(&Status{LastRotatedAt: rotated}))
+}
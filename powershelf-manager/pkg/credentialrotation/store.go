@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentialrotation
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by Get when no rotation has been recorded for a PMC.
+var ErrNotFound = errors.New("credential rotation not found")
+
+// State is the state of the latest credential rotation of a PMC.
+type State string
+
+const (
+	// StateInProgress means a rotation is running.
+	StateInProgress State = "InProgress"
+	// StateSucceeded means the new password is set on the PMC and stored.
+	StateSucceeded State = "Succeeded"
+	// StateFailed means the rotation failed and the PMC still accepts the stored password.
+	StateFailed State = "Failed"
+	// StateRollbackFailed means the rotation failed and restoring the stored password on the PMC
+	// failed too. The PMC may no longer accept the stored credential and needs manual attention.
+	StateRollbackFailed State = "RollbackFailed"
+)
+
+// Status is the latest credential rotation of a PMC.
+type Status struct {
+	PmcMacAddress net.HardwareAddr
+	State         State
+	LastAttemptAt time.Time
+	// LastRotatedAt is when the credential was last rotated successfully, zero if never.
+	LastRotatedAt time.Time
+	ErrorMessage  string
+	UpdatedAt     time.Time
+}
+
+// Store abstracts rotation status persistence so the Manager can operate
+// against either a Postgres-backed or in-memory backend.
+type Store interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+
+	// Get retrieves the rotation status of a PMC. Returns ErrNotFound if none is recorded.
+	Get(ctx context.Context, mac net.HardwareAddr) (*Status, error)
+
+	// List returns the rotation status of every PMC with a recorded rotation.
+	List(ctx context.Context) ([]*Status, error)
+
+	// Put upserts the rotation status of a PMC.
+	Put(ctx context.Context, status *Status) error
+}
+
+var _ Store = (*InMemoryStore)(nil)
+
+// InMemoryStore is an in-memory implementation of Store.
+// All data is lost when the process exits.
+type InMemoryStore struct {
+	mu       sync.RWMutex
+	statuses map[string]*Status
+}
+
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		statuses: make(map[string]*Status),
+	}
+}
+
+func (s *InMemoryStore) Start(context.Context) error { return nil }
+func (s *InMemoryStore) Stop(context.Context) error  { return nil }
+
+func (s *InMemoryStore) Get(_ context.Context, mac net.HardwareAddr) (*Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status, ok := s.statuses[mac.String()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	copy := *status
+	return &copy, nil
+}
+
+func (s *InMemoryStore) List(context.Context) ([]*Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]*Status, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		copy := *status
+		statuses = append(statuses, &copy)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].PmcMacAddress.String() < statuses[j].PmcMacAddress.String()
+	})
+	return statuses, nil
+}
+
+func (s *InMemoryStore) Put(_ context.Context, status *Status) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copy := *status
+	copy.UpdatedAt = time.Now()
+	s.statuses[status.PmcMacAddress.String()] = &copy
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentialrotation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/db/migrations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/db/model"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStore is a Postgres-backed implementation of Store.
+type PostgresStore struct {
+	session *cdb.Session
+}
+
+// NewPostgresStore initializes connectivity to Postgres and runs any pending migrations.
+func NewPostgresStore(ctx context.Context, c cdb.Config) (*PostgresStore, error) {
+	session, err := cdb.NewSessionFromConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrations.MigrateWithDB(ctx, session.DB); err != nil {
+		session.Close()
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return &PostgresStore{session}, nil
+}
+
+func (ps *PostgresStore) Start(ctx context.Context) error {
+	log.Printf("Starting PostgresQL credential rotation store")
+	return nil
+}
+
+func (ps *PostgresStore) Stop(ctx context.Context) error {
+	log.Printf("Stopping PostgresQL credential rotation store")
+	ps.session.Close()
+	return nil
+}
+
+func (ps *PostgresStore) Get(ctx context.Context, mac net.HardwareAddr) (*Status, error) {
+	cr, err := model.GetCredentialRotation(ctx, ps.session.DB, mac)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return modelToStatus(cr), nil
+}
+
+func (ps *PostgresStore) List(ctx context.Context) ([]*Status, error) {
+	rotations, err := model.ListCredentialRotations(ctx, ps.session.DB)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*Status, len(rotations))
+	for i := range rotations {
+		statuses[i] = modelToStatus(&rotations[i])
+	}
+	return statuses, nil
+}
+
+func (ps *PostgresStore) Put(ctx context.Context, status *Status) error {
+	return model.UpsertCredentialRotation(ctx, ps.session.DB, &model.CredentialRotation{
+		PmcMacAddress: model.MacAddr(status.PmcMacAddress),
+		State:         string(status.State),
+		LastAttemptAt: status.LastAttemptAt,
+		LastRotatedAt: status.LastRotatedAt,
+		ErrorMessage:  status.ErrorMessage,
+	})
+}
+
+func modelToStatus(cr *model.CredentialRotation) *Status {
+	return &Status{
+		PmcMacAddress: net.HardwareAddr(cr.PmcMacAddress),
+		State:         State(cr.State),
+		LastAttemptAt: cr.LastAttemptAt,
+		LastRotatedAt: cr.LastRotatedAt,
+		ErrorMessage:  cr.ErrorMessage,
+		UpdatedAt:     cr.UpdatedAt,
+	}
+}
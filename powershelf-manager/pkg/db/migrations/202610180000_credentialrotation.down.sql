@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.credential_rotation;
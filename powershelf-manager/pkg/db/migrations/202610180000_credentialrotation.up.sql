@@ -0,0 +1,16 @@
+--
+-- Name: credential_rotation; Type: TABLE; Schema: public
+-- Matches Go model: pkg/db/model/credential_rotation.go
+--
+
+CREATE TABLE public.credential_rotation (
+    pmc_mac_address macaddr NOT NULL,
+    state character varying NOT NULL,
+    last_attempt_at timestamp with time zone NOT NULL,
+    last_rotated_at timestamp with time zone,
+    error_message character varying,
+    updated_at timestamp with time zone NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE ONLY public.credential_rotation
+    ADD CONSTRAINT credential_rotation_pkey PRIMARY KEY (pmc_mac_address);
@@ -0,0 +1 @@
+ALTER TABLE public.firmware_update DROP COLUMN IF EXISTS signed_by;
@@ -0,0 +1,9 @@
+--
+-- Name: firmware_update.signed_by; Type: COLUMN; Schema: public
+-- Matches Go model: pkg/db/model/firmware_update.go
+--
+-- Identity that signed the firmware manifest, recorded once the manifest signature
+-- and artifact checksum were verified before the upload started.
+--
+
+ALTER TABLE public.firmware_update ADD COLUMN signed_by character varying;
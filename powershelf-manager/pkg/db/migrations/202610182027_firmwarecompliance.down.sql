@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.firmware_drift;
+DROP TABLE IF EXISTS public.firmware_compliance_status;
+DROP TABLE IF EXISTS public.firmware_baseline;
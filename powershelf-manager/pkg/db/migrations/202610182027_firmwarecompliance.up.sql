@@ -0,0 +1,58 @@
+--
+-- Name: firmware_baseline; Type: TABLE; Schema: public
+-- Matches Go model: pkg/db/model/firmware_compliance.go
+--
+
+CREATE TABLE public.firmware_baseline (
+    name character varying NOT NULL,
+    vendor character varying NOT NULL,
+    model character varying NOT NULL DEFAULT '',
+    component character varying NOT NULL,
+    version character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT NOW(),
+    updated_at timestamp with time zone NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE ONLY public.firmware_baseline
+    ADD CONSTRAINT firmware_baseline_pkey PRIMARY KEY (name);
+
+--
+-- Name: firmware_compliance_status; Type: TABLE; Schema: public
+-- Matches Go model: pkg/db/model/firmware_compliance.go
+--
+
+CREATE TABLE public.firmware_compliance_status (
+    pmc_mac_address macaddr NOT NULL,
+    component character varying NOT NULL,
+    item_id character varying NOT NULL,
+    baseline character varying NOT NULL DEFAULT '',
+    expected character varying NOT NULL DEFAULT '',
+    actual character varying NOT NULL,
+    compliant boolean NOT NULL,
+    checked_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE ONLY public.firmware_compliance_status
+    ADD CONSTRAINT firmware_compliance_status_pkey PRIMARY KEY (pmc_mac_address, component, item_id);
+
+--
+-- Name: firmware_drift; Type: TABLE; Schema: public
+-- Matches Go model: pkg/db/model/firmware_compliance.go
+--
+
+CREATE TABLE public.firmware_drift (
+    id bigserial NOT NULL,
+    pmc_mac_address macaddr NOT NULL,
+    component character varying NOT NULL,
+    item_id character varying NOT NULL,
+    baseline character varying NOT NULL,
+    expected character varying NOT NULL,
+    actual character varying NOT NULL,
+    compliant boolean NOT NULL,
+    detected_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE ONLY public.firmware_drift
+    ADD CONSTRAINT firmware_drift_pkey PRIMARY KEY (id);
+
+CREATE INDEX firmware_drift_detected_at_idx ON public.firmware_drift USING btree (detected_at);
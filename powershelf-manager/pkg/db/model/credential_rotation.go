@@ -0,0 +1,69 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// CredentialRotation records the latest credential rotation of a PMC.
+type CredentialRotation struct {
+	bun.BaseModel `bun:"table:credential_rotation,alias:cr"`
+
+	PmcMacAddress MacAddr   `bun:"pmc_mac_address,pk,notnull,type:macaddr"` // MAC address of the rotated PMC
+	State         string    `bun:"state,notnull"`                           // Rotation state ("InProgress", "Succeeded", "Failed", "RollbackFailed")
+	LastAttemptAt time.Time `bun:"last_attempt_at,notnull"`                 // When the latest rotation started
+	LastRotatedAt time.Time `bun:"last_rotated_at,nullzero"`                // When the credential was last rotated successfully
+	ErrorMessage  string    `bun:"error_message"`                           // Error message if the latest rotation failed
+	UpdatedAt     time.Time `bun:"updated_at,notnull,default:now()"`        // When this record was last updated
+}
+
+// UpsertCredentialRotation inserts or replaces the rotation record of a PMC.
+func UpsertCredentialRotation(ctx context.Context, db bun.IDB, cr *CredentialRotation) error {
+	cr.UpdatedAt = time.Now()
+	_, err := db.NewInsert().
+		Model(cr).
+		On("CONFLICT (pmc_mac_address) DO UPDATE").
+		Set("state = EXCLUDED.state, last_attempt_at = EXCLUDED.last_attempt_at, last_rotated_at = EXCLUDED.last_rotated_at, error_message = EXCLUDED.error_message, updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+// GetCredentialRotation fetches the rotation record of a PMC.
+func GetCredentialRotation(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr) (*CredentialRotation, error) {
+	var cr CredentialRotation
+	err := db.NewSelect().
+		Model(&cr).
+		Where("pmc_mac_address = ?", MacAddr(pmcMac)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &cr, nil
+}
+
+// ListCredentialRotations lists the rotation records of all PMCs.
+func ListCredentialRotations(ctx context.Context, db bun.IDB) ([]CredentialRotation, error) {
+	var rotations []CredentialRotation
+	err := db.NewSelect().Model(&rotations).Order("pmc_mac_address").Scan(ctx)
+	return rotations, err
+}
@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// FirmwareBaseline is the desired firmware version of a component for a vendor and, optionally, a model.
+type FirmwareBaseline struct {
+	bun.BaseModel `bun:"table:firmware_baseline,alias:fb"`
+
+	Name      string    `bun:"name,pk,notnull"`                  // Unique baseline name
+	Vendor    string    `bun:"vendor,notnull"`                   // PMC vendor name ("Liteon", "Delta")
+	Model     string    `bun:"model,notnull,default:''"`         // Component model, empty for any model
+	Component string    `bun:"component,notnull"`                // Component ("PMC", "PSU")
+	Version   string    `bun:"version,notnull"`                  // Desired firmware version
+	CreatedAt time.Time `bun:"created_at,notnull,default:now()"` // When the baseline was created
+	UpdatedAt time.Time `bun:"updated_at,notnull,default:now()"` // When the baseline was last updated
+}
+
+// FirmwareComplianceStatus is the last known compliance of a firmware inventory item of a PMC.
+type FirmwareComplianceStatus struct {
+	bun.BaseModel `bun:"table:firmware_compliance_status,alias:fcs"`
+
+	PmcMacAddress MacAddr   `bun:"pmc_mac_address,pk,notnull,type:macaddr"` // MAC address of the PMC
+	Component     string    `bun:"component,pk,notnull"`                    // Component ("PMC", "PSU")
+	ItemID        string    `bun:"item_id,pk,notnull"`                      // Redfish firmware inventory ID
+	Baseline      string    `bun:"baseline,notnull,default:''"`             // Name of the applying baseline, empty if none
+	Expected      string    `bun:"expected,notnull,default:''"`             // Baseline version
+	Actual        string    `bun:"actual,notnull"`                          // Installed version
+	Compliant     bool      `bun:"compliant,notnull"`                       // Whether the item ran the baseline version
+	CheckedAt     time.Time `bun:"checked_at,notnull"`                      // When the item was last checked
+}
+
+// FirmwareDrift records a firmware inventory item drifting from its baseline, or returning to it.
+type FirmwareDrift struct {
+	bun.BaseModel `bun:"table:firmware_drift,alias:fd"`
+
+	ID            int64     `bun:"id,pk,autoincrement"`                  // Sequence number
+	PmcMacAddress MacAddr   `bun:"pmc_mac_address,notnull,type:macaddr"` // MAC address of the PMC
+	Component     string    `bun:"component,notnull"`                    // Component ("PMC", "PSU")
+	ItemID        string    `bun:"item_id,notnull"`                      // Redfish firmware inventory ID
+	Baseline      string    `bun:"baseline,notnull"`                     // Name of the baseline
+	Expected      string    `bun:"expected,notnull"`                     // Baseline version
+	Actual        string    `bun:"actual,notnull"`                       // Installed version
+	Compliant     bool      `bun:"compliant,notnull"`                    // Whether the item returned to its baseline
+	DetectedAt    time.Time `bun:"detected_at,notnull"`                  // When the drift was detected
+}
+
+// UpsertFirmwareBaseline inserts or replaces a baseline by name, keeping its creation time.
+func UpsertFirmwareBaseline(ctx context.Context, db bun.IDB, fb *FirmwareBaseline) error {
+	fb.UpdatedAt = time.Now()
+	if fb.CreatedAt.IsZero() {
+		fb.CreatedAt = fb.UpdatedAt
+	}
+	_, err := db.NewInsert().
+		Model(fb).
+		On("CONFLICT (name) DO UPDATE").
+		Set("vendor = EXCLUDED.vendor, model = EXCLUDED.model, component = EXCLUDED.component, version = EXCLUDED.version, updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+// GetFirmwareBaseline fetches a baseline by name.
+func GetFirmwareBaseline(ctx context.Context, db bun.IDB, name string) (*FirmwareBaseline, error) {
+	var fb FirmwareBaseline
+	err := db.NewSelect().
+		Model(&fb).
+		Where("name = ?", name).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &fb, nil
+}
+
+// ListFirmwareBaselines lists all baselines ordered by name.
+func ListFirmwareBaselines(ctx context.Context, db bun.IDB) ([]FirmwareBaseline, error) {
+	var baselines []FirmwareBaseline
+	err := db.NewSelect().Model(&baselines).Order("name").Scan(ctx)
+	return baselines, err
+}
+
+// DeleteFirmwareBaseline deletes a baseline by name. Returns sql.ErrNoRows if it does not exist.
+func DeleteFirmwareBaseline(ctx context.Context, db bun.IDB, name string) error {
+	res, err := db.NewDelete().
+		Model((*FirmwareBaseline)(nil)).
+		Where("name = ?", name).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// UpsertFirmwareComplianceStatus inserts or replaces the compliance of an inventory item.
+func UpsertFirmwareComplianceStatus(ctx context.Context, db bun.IDB, fcs *FirmwareComplianceStatus) error {
+	_, err := db.NewInsert().
+		Model(fcs).
+		On("CONFLICT (pmc_mac_address, component, item_id) DO UPDATE").
+		Set("baseline = EXCLUDED.baseline, expected = EXCLUDED.expected, actual = EXCLUDED.actual, compliant = EXCLUDED.compliant, checked_at = EXCLUDED.checked_at").
+		Exec(ctx)
+	return err
+}
+
+// ListFirmwareComplianceStatuses lists the compliance of the inventory items of a PMC.
+func ListFirmwareComplianceStatuses(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr) ([]FirmwareComplianceStatus, error) {
+	var statuses []FirmwareComplianceStatus
+	err := db.NewSelect().
+		Model(&statuses).
+		Where("pmc_mac_address = ?", MacAddr(pmcMac)).
+		Order("component", "item_id").
+		Scan(ctx)
+	return statuses, err
+}
+
+// InsertFirmwareDrift appends a drift record.
+func InsertFirmwareDrift(ctx context.Context, db bun.IDB, fd *FirmwareDrift) error {
+	_, err := db.NewInsert().Model(fd).Exec(ctx)
+	return err
+}
+
+// ListFirmwareDrift lists drift records detected at or after since, most recent first. The records of all PMCs
+// are listed if pmcMacs is empty; limit caps the number of records if positive.
+func ListFirmwareDrift(ctx context.Context, db bun.IDB, pmcMacs []net.HardwareAddr, since time.Time, limit int) ([]FirmwareDrift, error) {
+	var drift []FirmwareDrift
+	q := db.NewSelect().Model(&drift).Order("detected_at DESC", "id DESC")
+	if !since.IsZero() {
+		q = q.Where("detected_at >= ?", since)
+	}
+	if len(pmcMacs) > 0 {
+		macs := make([]MacAddr, len(pmcMacs))
+		for i, mac := range pmcMacs {
+			macs[i] = MacAddr(mac)
+		}
+		q = q.Where("pmc_mac_address IN (?)", bun.In(macs))
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Scan(ctx)
+	return drift, err
+}
@@ -41,6 +41,7 @@ type FirmwareUpdate struct {
 	LastTransitionTime time.Time                `bun:"last_transition_time,notnull"`            // When the state last changed
 	JobID              string                   `bun:"job_id"`                                  // Device job/task ID, if provided by hardware
 	ErrorMessage       string                   `bun:"error_message"`                           // Error message if the upgrade failed
+	SignedBy           string                   `bun:"signed_by"`                               // Identity that signed the verified firmware manifest
 	CreatedAt          time.Time                `bun:"created_at,notnull,default:now()"`        // When this record was created
 	UpdatedAt          time.Time                `bun:"updated_at,notnull,default:now()"`        // When this record was last updated
 }
@@ -64,7 +65,7 @@ func NewFirmwareUpdate(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr,
 	_, err := db.NewInsert().
 		Model(fu).
 		On("CONFLICT (pmc_mac_address, component) DO UPDATE").
-		Set("version_from = EXCLUDED.version_from, version_to = EXCLUDED.version_to, state = EXCLUDED.state, last_transition_time = EXCLUDED.last_transition_time, job_id = EXCLUDED.job_id, error_message = EXCLUDED.error_message, updated_at = EXCLUDED.updated_at").
+		Set("version_from = EXCLUDED.version_from, version_to = EXCLUDED.version_to, state = EXCLUDED.state, last_transition_time = EXCLUDED.last_transition_time, job_id = EXCLUDED.job_id, error_message = EXCLUDED.error_message, signed_by = EXCLUDED.signed_by, updated_at = EXCLUDED.updated_at").
 		Exec(ctx)
 
 	return fu, err
@@ -142,6 +143,23 @@ func SetFirmwareUpdateState(ctx context.Context, db bun.IDB, pmcMac net.Hardware
 	return err
 }
 
+// SetFirmwareUpdateSignedBy records the identity that signed the firmware of the update identified by (pmcMac, comp).
+func SetFirmwareUpdateSignedBy(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr, comp powershelf.Component, signedBy string) error {
+	fu := &FirmwareUpdate{
+		PmcMacAddress: MacAddr(pmcMac),
+		Component:     comp,
+		SignedBy:      signedBy,
+		UpdatedAt:     time.Now(),
+	}
+
+	_, err := db.NewUpdate().
+		Model(fu).
+		Column("signed_by", "updated_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
 // UpdateFirmwareUpdateState sets the state and optional error message for a FirmwareUpdate.
 // Only updates LastTransitionTime if the state actually changes.
 func (fu *FirmwareUpdate) UpdateFirmwareUpdateState(ctx context.Context, db bun.IDB, newState powershelf.FirmwareState, errMsg string) error {
@@ -0,0 +1,413 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package eventmanager consumes Redfish events from the registered PMCs and fans them out to in-process
+// subscribers. PMCs are watched through EventService push subscriptions when a receiver destination is configured,
+// otherwise through their Server-Sent Event stream; PMCs supporting neither are left to the inventory collector.
+// PSU fault and power state events also trigger an immediate inventory refresh of the shelf.
+package eventmanager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+
+	log "github.com/sirupsen/logrus"
+	gofish "github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// DefaultSyncInterval is how often newly registered PMCs are picked up and failed watches are retried.
+	DefaultSyncInterval = time.Minute
+	// ReceiverPath is the path at which the event receiver accepts pushed events.
+	ReceiverPath = "/redfish/events"
+
+	// unsubscribeTimeout bounds deleting a push subscription on shutdown.
+	unsubscribeTimeout = 10 * time.Second
+	// refreshTimeout bounds an event-triggered inventory refresh.
+	refreshTimeout = time.Minute
+)
+
+// Mode is how a PMC's events are received.
+type Mode string
+
+const (
+	// ModePush receives events posted by the PMC to the event receiver.
+	ModePush Mode = "Push"
+	// ModeSSE reads the PMC's Server-Sent Event stream.
+	ModeSSE Mode = "SSE"
+	// ModePolling means the PMC supports no event delivery; its state is only collected by polling.
+	ModePolling Mode = "Polling"
+)
+
+// PmcClient is the subset of the PMC manager needed to receive events.
+type PmcClient interface {
+	GetAllPmcs(ctx context.Context) ([]*pmc.PMC, error)
+	SubscribeEvents(ctx context.Context, pmc *pmc.PMC, destination, eventContext string, headers map[string]string) (string, error)
+	UnsubscribeEvents(ctx context.Context, pmc *pmc.PMC, uri string) error
+	StreamEvents(ctx context.Context, pmc *pmc.PMC, fn func(*gofish.Event) error) error
+}
+
+// RefreshFunc re-collects the inventory of a single PMC.
+type RefreshFunc func(ctx context.Context, mac net.HardwareAddr) error
+
+// Config specifies how events are received.
+type Config struct {
+	// ListenAddress is the address of the HTTP event receiver (e.g. ":8081"). Empty disables the receiver.
+	ListenAddress string
+	// Destination is the URL PMCs post events to, which must reach the receiver's ReceiverPath
+	// (e.g. "http://psm.example.com:8081/redfish/events"). Empty disables push subscriptions in favor of SSE.
+	Destination string
+	// Token is a shared secret PMCs send with every pushed event. Empty accepts events without a token.
+	Token string
+	// SyncInterval is how often the PMC list is re-read (DefaultSyncInterval if zero).
+	SyncInterval time.Duration
+}
+
+// Event is a Redfish event reported by a PMC.
+type Event struct {
+	PmcMAC    string
+	Kind      events.Kind
+	Severity  string
+	MessageID string
+	Message   string
+	// Origin is the URI of the resource the event is about, e.g. a power supply or task.
+	Origin    string
+	Timestamp time.Time
+}
+
+// watch tracks how the events of one PMC are received.
+type watch struct {
+	mode   Mode
+	cancel context.CancelFunc
+}
+
+// Manager watches the registered PMCs for events and publishes them to subscribers.
+type Manager struct {
+	pmcs    PmcClient
+	refresh RefreshFunc
+	conf    Config
+	broker  *events.Broker[Event]
+
+	mu         sync.Mutex
+	watches    map[string]*watch
+	refreshing map[string]bool
+
+	server *http.Server
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Manager watching the PMCs known to the given client. refresh, if not nil, is called for PMCs
+// reporting PSU fault or power state events.
+func New(pmcs PmcClient, refresh RefreshFunc, conf Config) *Manager {
+	if conf.SyncInterval <= 0 {
+		conf.SyncInterval = DefaultSyncInterval
+	}
+
+	return &Manager{
+		pmcs:       pmcs,
+		refresh:    refresh,
+		conf:       conf,
+		broker:     events.NewBroker[Event](),
+		watches:    make(map[string]*watch),
+		refreshing: make(map[string]bool),
+	}
+}
+
+// Start starts the event receiver, if configured, and the loop watching the registered PMCs.
+func (m *Manager) Start(ctx context.Context) error {
+	if m.conf.ListenAddress != "" {
+		listener, err := net.Listen("tcp", m.conf.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle(ReceiverPath, m.Receiver())
+		m.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+		log.Printf("Starting Redfish event receiver on %s", listener.Addr())
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			if err := m.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Errorf("Redfish event receiver failed: %v", err)
+			}
+		}()
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	m.wg.Add(1)
+	go m.run(runCtx)
+
+	return nil
+}
+
+// Stop stops watching, deletes push subscriptions, stops the receiver and closes all subscriptions.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	var err error
+	if m.server != nil {
+		err = m.server.Shutdown(ctx)
+	}
+
+	m.wg.Wait()
+	m.broker.Close()
+
+	return err
+}
+
+// Subscribe returns a subscription to the events of the given PMCs (all PMCs if empty) and kinds (all kinds if
+// empty). The caller must Close it.
+func (m *Manager) Subscribe(macs []string, kinds []events.Kind) *events.Subscription[Event] {
+	macSet := make(map[string]bool, len(macs))
+	for _, mac := range macs {
+		macSet[mac] = true
+	}
+	kindSet := make(map[events.Kind]bool, len(kinds))
+	for _, kind := range kinds {
+		kindSet[kind] = true
+	}
+
+	return m.broker.Subscribe(0, func(ev Event) bool {
+		return (len(macSet) == 0 || macSet[ev.PmcMAC]) && (len(kindSet) == 0 || kindSet[ev.Kind])
+	})
+}
+
+// Modes returns how the events of each watched PMC are received, keyed by PMC MAC.
+func (m *Manager) Modes() map[string]Mode {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	modes := make(map[string]Mode, len(m.watches))
+	for mac, w := range m.watches {
+		modes[mac] = w.mode
+	}
+	return modes
+}
+
+// Receiver returns the handler accepting events pushed by subscribed PMCs. The event Context is the PMC MAC;
+// events of PMCs that are not watched through push subscriptions are ignored.
+func (m *Manager) Receiver() http.Handler {
+	return &events.Receiver{
+		Token: m.conf.Token,
+		Handler: func(ev *gofish.Event) {
+			m.mu.Lock()
+			w, ok := m.watches[ev.Context]
+			push := ok && w.mode == ModePush
+			m.mu.Unlock()
+
+			if !push {
+				log.Debugf("Ignoring Redfish event for unwatched PMC %q", ev.Context)
+				return
+			}
+			m.handle(ev.Context, ev)
+		},
+	}
+}
+
+// run syncs the watched PMCs with the registry every SyncInterval until ctx is cancelled.
+func (m *Manager) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.conf.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		m.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync starts watching newly registered PMCs, retries PMCs whose watch failed and stops watching PMCs that are no
+// longer registered.
+func (m *Manager) sync(ctx context.Context) {
+	pmcs, err := m.pmcs.GetAllPmcs(ctx)
+	if err != nil {
+		log.Errorf("Event manager failed to list PMCs: %v", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	registered := make(map[string]bool, len(pmcs))
+	for _, p := range pmcs {
+		registered[p.MAC.String()] = true
+	}
+	for mac, w := range m.watches {
+		if !registered[mac] {
+			w.cancel()
+			delete(m.watches, mac)
+		}
+	}
+
+	for _, p := range pmcs {
+		mac := p.MAC.String()
+		if _, ok := m.watches[mac]; ok {
+			continue
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		w := &watch{mode: ModeSSE, cancel: cancel}
+		if m.conf.Destination != "" {
+			w.mode = ModePush
+		}
+		m.watches[mac] = w
+
+		m.wg.Add(1)
+		go m.watch(watchCtx, p, w)
+	}
+}
+
+// watch receives the events of one PMC until ctx is cancelled or delivery fails, in which case the next sync
+// retries.
+func (m *Manager) watch(ctx context.Context, p *pmc.PMC, w *watch) {
+	defer m.wg.Done()
+	defer w.cancel()
+
+	mac := p.MAC.String()
+	var err error
+	switch w.mode {
+	case ModePush:
+		err = m.watchPush(ctx, p)
+	case ModeSSE:
+		err = m.pmcs.StreamEvents(ctx, p, func(ev *gofish.Event) error {
+			m.handle(mac, ev)
+			return nil
+		})
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if errors.Is(err, events.ErrNotSupported) || errors.Is(err, events.ErrSSENotSupported) {
+		log.Printf("PMC %s does not support Redfish %s events; relying on inventory polling", mac, w.mode)
+		w.mode = ModePolling
+		return
+	}
+
+	log.Warnf("Lost Redfish events of PMC %s (%s): %v", mac, w.mode, err)
+	if m.watches[mac] == w {
+		delete(m.watches, mac)
+	}
+}
+
+// watchPush subscribes the receiver to the PMC's events and deletes the subscription once ctx is cancelled.
+func (m *Manager) watchPush(ctx context.Context, p *pmc.PMC) error {
+	var headers map[string]string
+	if m.conf.Token != "" {
+		headers = map[string]string{events.TokenHeader: m.conf.Token}
+	}
+
+	uri, err := m.pmcs.SubscribeEvents(ctx, p, m.conf.Destination, p.MAC.String(), headers)
+	if err != nil {
+		return err
+	}
+	log.Printf("Subscribed to Redfish events of PMC %s (%s)", p.MAC, uri)
+
+	<-ctx.Done()
+
+	unsubscribeCtx, cancel := context.WithTimeout(context.Background(), unsubscribeTimeout)
+	defer cancel()
+	if err := m.pmcs.UnsubscribeEvents(unsubscribeCtx, p, uri); err != nil {
+		log.Warnf("Failed to delete Redfish event subscription %s of PMC %s: %v", uri, p.MAC, err)
+	}
+
+	return nil
+}
+
+// handle publishes the records of an event and triggers an inventory refresh for PSU and power state changes.
+func (m *Manager) handle(mac string, ev *gofish.Event) {
+	refresh := false
+	for i := range ev.Events {
+		rec := &ev.Events[i]
+		kind := events.Classify(rec)
+		timestamp, _ := time.Parse(time.RFC3339, rec.EventTimestamp)
+
+		severity := string(rec.MessageSeverity)
+		if severity == "" {
+			severity = rec.Severity
+		}
+
+		m.broker.Publish(Event{
+			PmcMAC:    mac,
+			Kind:      kind,
+			Severity:  severity,
+			MessageID: rec.MessageID,
+			Message:   rec.Message,
+			Origin:    rec.OriginOfCondition,
+			Timestamp: timestamp,
+		})
+		refresh = refresh || kind == events.KindPSUFault || kind == events.KindPowerState
+	}
+
+	if refresh {
+		m.refreshShelf(mac)
+	}
+}
+
+// refreshShelf re-collects the inventory of a PMC in the background. Events arriving while a refresh is running
+// are covered by it.
+func (m *Manager) refreshShelf(mac string) {
+	hw, err := net.ParseMAC(mac)
+	if m.refresh == nil || err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	if m.refreshing[mac] {
+		m.mu.Unlock()
+		return
+	}
+	m.refreshing[mac] = true
+	m.mu.Unlock()
+
+	go func() {
+		defer func() {
+			m.mu.Lock()
+			delete(m.refreshing, mac)
+			m.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+		if err := m.refresh(ctx, hw); err != nil {
+			log.Warnf("Event-triggered inventory refresh of PMC %s failed: %v", mac, err)
+		}
+	}()
+}
@@ -0,0 +1,234 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventmanager
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+
+	gofish "github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMAC = net.HardwareAddr{0, 1, 2, 3, 4, 5}
+
+const psuFaultEvent = `{
+	"Context": "00:01:02:03:04:05",
+	"Events": [{
+		"MessageId": "ResourceEvent.1.3.ResourceStatusChangedCritical",
+		"MessageSeverity": "Critical",
+		"Message": "PSU1 failed.",
+		"EventTimestamp": "2026-01-01T00:00:00Z",
+		"OriginOfCondition": {"@odata.id": "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU1"}
+	}]
+}`
+
+// fakePmcs emulates a single registered PMC and records subscription and refresh calls.
+type fakePmcs struct {
+	mu           sync.Mutex
+	subscribed   []string
+	unsubscribed []string
+	refreshed    []string
+
+	// streamErr is returned by StreamEvents; nil streams the events in stream and then blocks.
+	streamErr error
+	stream    []*gofish.Event
+}
+
+func (f *fakePmcs) GetAllPmcs(context.Context) ([]*pmc.PMC, error) {
+	cred := credential.New("root", "0penBmc")
+	p, err := pmc.NewFromAddr(testMAC, net.ParseIP("10.0.0.1"), vendor.VendorCodeLiteon, &cred)
+	if err != nil {
+		return nil, err
+	}
+	return []*pmc.PMC{p}, nil
+}
+
+func (f *fakePmcs) SubscribeEvents(_ context.Context, _ *pmc.PMC, destination, eventContext string, _ map[string]string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.subscribed = append(f.subscribed, destination+"#"+eventContext)
+	return "/redfish/v1/EventService/Subscriptions/1", nil
+}
+
+func (f *fakePmcs) UnsubscribeEvents(_ context.Context, _ *pmc.PMC, uri string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.unsubscribed = append(f.unsubscribed, uri)
+	return nil
+}
+
+func (f *fakePmcs) StreamEvents(ctx context.Context, _ *pmc.PMC, fn func(*gofish.Event) error) error {
+	if f.streamErr != nil {
+		return f.streamErr
+	}
+	for _, ev := range f.stream {
+		if err := fn(ev); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (f *fakePmcs) refresh(_ context.Context, mac net.HardwareAddr) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.refreshed = append(f.refreshed, mac.String())
+	return nil
+}
+
+func (f *fakePmcs) refreshes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.refreshed...)
+}
+
+func startManager(t *testing.T, f *fakePmcs, conf Config) *Manager {
+	m := New(f, f.refresh, conf)
+	require.NoError(t, m.Start(context.Background()))
+	t.Cleanup(func() { _ = m.Stop(context.Background()) })
+	return m
+}
+
+func waitForMode(t *testing.T, m *Manager, mode Mode) {
+	require.Eventually(t, func() bool {
+		return m.Modes()[testMAC.String()] == mode
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func nextEvent(t *testing.T, sub *events.Subscription[Event]) Event {
+	select {
+	case ev := <-sub.C:
+		return ev
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event published")
+		return Event{}
+	}
+}
+
+func TestPushEvents(t *testing.T) {
+	f := &fakePmcs{}
+	m := startManager(t, f, Config{Destination: "http://psm:8081" + ReceiverPath, Token: "secret"})
+	waitForMode(t, m, ModePush)
+
+	sub := m.Subscribe([]string{testMAC.String()}, []events.Kind{events.KindPSUFault})
+	defer sub.Close()
+
+	receiver := m.Receiver()
+	post := func(body, token string) int {
+		req := httptest.NewRequest(http.MethodPost, ReceiverPath, strings.NewReader(body))
+		req.Header.Set(events.TokenHeader, token)
+		w := httptest.NewRecorder()
+		receiver.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, post(psuFaultEvent, "wrong"))
+	assert.Equal(t, http.StatusNoContent, post(psuFaultEvent, "secret"))
+
+	ev := nextEvent(t, sub)
+	assert.Equal(t, testMAC.String(), ev.PmcMAC)
+	assert.Equal(t, events.KindPSUFault, ev.Kind)
+	assert.Equal(t, "Critical", ev.Severity)
+	assert.Equal(t, "/redfish/v1/Chassis/powershelf/PowerSubsystem/PowerSupplies/PSU1", ev.Origin)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), ev.Timestamp)
+
+	require.Eventually(t, func() bool { return len(f.refreshes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{testMAC.String()}, f.refreshes())
+
+	// Events of unknown PMCs are accepted but not published.
+	assert.Equal(t, http.StatusNoContent, post(strings.Replace(psuFaultEvent, "00:01:02:03:04:05", "00:00:00:00:00:09", 1), "secret"))
+	select {
+	case ev := <-sub.C:
+		assert.Fail(t, "unexpected event", "%+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, m.Stop(context.Background()))
+	assert.Equal(t, []string{"http://psm:8081" + ReceiverPath + "#" + testMAC.String()}, f.subscribed)
+	assert.Equal(t, []string{"/redfish/v1/EventService/Subscriptions/1"}, f.unsubscribed)
+
+	_, open := <-sub.C
+	assert.False(t, open, "subscriptions are closed on Stop")
+}
+
+func TestStreamEvents(t *testing.T) {
+	ev, err := events.Decode(strings.NewReader(`{"Events": [
+		{"MessageId": "TaskEvent.1.0.TaskStarted", "OriginOfCondition": {"@odata.id": "/redfish/v1/TaskService/Tasks/1"}},
+		{"MessageId": "ResourceEvent.1.3.ResourcePoweredOff", "OriginOfCondition": {"@odata.id": "/redfish/v1/Chassis/powershelf"}}
+	]}`))
+	require.NoError(t, err)
+
+	f := &fakePmcs{stream: []*gofish.Event{ev}}
+	m := New(f, f.refresh, Config{})
+	sub := m.Subscribe(nil, nil)
+	require.NoError(t, m.Start(context.Background()))
+	t.Cleanup(func() { _ = m.Stop(context.Background()) })
+
+	assert.Equal(t, events.KindTask, nextEvent(t, sub).Kind)
+	assert.Equal(t, events.KindPowerState, nextEvent(t, sub).Kind)
+	assert.Equal(t, ModeSSE, m.Modes()[testMAC.String()])
+	require.Eventually(t, func() bool { return len(f.refreshes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPollingFallback(t *testing.T) {
+	testCases := map[string]struct {
+		err error
+	}{
+		"no event service": {err: events.ErrNotSupported},
+		"no sse endpoint":  {err: events.ErrSSENotSupported},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := startManager(t, &fakePmcs{streamErr: tc.err}, Config{})
+			waitForMode(t, m, ModePolling)
+		})
+	}
+}
+
+func TestSubscribeFilter(t *testing.T) {
+	m := New(&fakePmcs{}, nil, Config{})
+	mac := m.Subscribe([]string{"00:00:00:00:00:01"}, nil)
+	kind := m.Subscribe(nil, []events.Kind{events.KindTask})
+
+	m.broker.Publish(Event{PmcMAC: "00:00:00:00:00:01", Kind: events.KindPowerState})
+	m.broker.Publish(Event{PmcMAC: "00:00:00:00:00:02", Kind: events.KindTask})
+
+	assert.Equal(t, events.KindPowerState, (<-mac.C).Kind)
+	assert.Equal(t, "00:00:00:00:00:02", (<-kind.C).PmcMAC)
+	assert.Empty(t, mac.C)
+	assert.Empty(t, kind.C)
+}
@@ -28,6 +28,10 @@ import (
 
 const pmcPath = "pmc"
 
+// manifestName is the file in a vendor's pmc directory that declares the checksums of its firmware artifacts.
+// The detached signature (manifest.yaml.sig) and signing certificate (manifest.yaml.crt) sit next to it.
+const manifestName = "manifest.yaml"
+
 // FirmwareFetcher provides read-only access to firmware assets organized as firmware/<vendor>/pmc.
 type FirmwareFetcher struct {
 	fs fs.FS
@@ -50,6 +54,7 @@ func (ff *FirmwareFetcher) getVendorDirectories() ([]fs.DirEntry, error) {
 }
 
 // getPmcFirmwareEntries returns all PMC firmware files for a vendor; entries are non-empty .tar files.
+// The manifest and its signature files are not firmware entries.
 func (ff *FirmwareFetcher) getPmcFirmwareEntries(v vendor.Vendor) ([]FirmwareEntry, error) {
 	vendors, err := ff.getVendorDirectories()
 	if err != nil {
@@ -76,6 +81,10 @@ func (ff *FirmwareFetcher) getPmcFirmwareEntries(v vendor.Vendor) ([]FirmwareEnt
 					}
 
 					name := entry.Name()
+					if name == manifestName || strings.HasPrefix(name, manifestName+".") {
+						continue
+					}
+
 					info, err := entry.Info()
 					if err != nil {
 						log.Printf("failed to get info for entry in {%s}: {%s}, err: %v\n", path, entry.Name(), err)
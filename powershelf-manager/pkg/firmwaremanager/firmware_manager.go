@@ -26,6 +26,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/runner"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
@@ -48,8 +49,22 @@ type Manager struct {
 }
 
 // New constructs a Manager with the given FirmwareUpdateStore backend.
-// firmwareDir specifies the on-disk directory containing firmware artifacts (env: FW_DIR).
-func New(store FirmwareUpdateStore, pmcManager *pmcmanager.PmcManager, dryRun bool, firmwareDir string) (*Manager, error) {
+// firmwareDir specifies the on-disk directory containing firmware artifacts (env: FW_DIR); verification configures
+// the trust bundle firmware manifests are verified against before an artifact is uploaded.
+func New(store FirmwareUpdateStore, pmcManager *pmcmanager.PmcManager, dryRun bool, firmwareDir string, verification fwverify.Config) (*Manager, error) {
+	verifier, err := fwverify.New(verification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load firmware trust bundle: %w", err)
+	}
+
+	if verification.TrustBundle == "" {
+		if verification.AllowUnsigned {
+			log.Warn("No firmware trust bundle configured; firmware updates proceed without signature verification")
+		} else {
+			log.Warn("No firmware trust bundle configured; firmware updates will fail verification")
+		}
+	}
+
 	manager := Manager{
 		firmwareUpdater: make(map[vendor.Vendor]*FirmwareUpdater),
 		store:           store,
@@ -65,7 +80,7 @@ func New(store FirmwareUpdateStore, pmcManager *pmcmanager.PmcManager, dryRun bo
 			continue
 		}
 
-		updater, err := newFirmwareUpdater(vendor, firmwareDir)
+		updater, err := newFirmwareUpdater(vendor, firmwareDir, verifier)
 		if err != nil {
 			log.Printf("skipping firmware support for vendor %s: %v", vendor.Name, err)
 			continue
@@ -220,6 +235,13 @@ func (manager *Manager) SetUpdateState(ctx context.Context, rec *FirmwareUpdateR
 	return manager.store.SetState(dbCtx, rec.PmcMacAddress, rec.Component, newState, errMsg)
 }
 
+func (manager *Manager) setSignedBy(ctx context.Context, rec *FirmwareUpdateRecord, signedBy string) error {
+	dbCtx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	return manager.store.SetSignedBy(dbCtx, rec.PmcMacAddress, rec.Component, signedBy)
+}
+
 func (manager *Manager) handleOnePmcUpdate(ctx context.Context, pmc *pmc.PMC, update *FirmwareUpdateRecord) (powershelf.FirmwareState, error) {
 	ctx, cancel := context.WithTimeout(ctx, redfishTimeout)
 	defer cancel()
@@ -242,7 +264,12 @@ func (manager *Manager) handleOnePmcUpdate(ctx context.Context, pmc *pmc.PMC, up
 			dryRun = true
 			log.Printf("Re-flash detected for component %v on PMC %v (version %v); forcing dry-run", update.Component, pmc, update.VersionFrom)
 		}
-		err = updater.upgrade(ctx, pmc, version, dryRun)
+		signedBy, err := updater.upgrade(ctx, pmc, version, dryRun)
+		if signedBy != "" {
+			if setErr := manager.setSignedBy(ctx, update, signedBy); setErr != nil {
+				log.Printf("failed to record signer %v of firmware update of component %v on PMC %v: %v", signedBy, update.Component, pmc, setErr)
+			}
+		}
 		if err != nil {
 			return powershelf.FirmwareStateFailed, fmt.Errorf("failed to initiate firmware update of component %v for powershelf with PMC MAC %v from %v to %v: %w", update.Component, pmc, update.VersionFrom, update.VersionTo, err)
 		} else {
@@ -350,5 +377,6 @@ func recordToDomain(rec *FirmwareUpdateRecord) *powershelf.FirmwareUpdate {
 		State:         rec.State,
 		JobID:         rec.JobID,
 		ErrorMessage:  rec.ErrorMessage,
+		SignedBy:      rec.SignedBy,
 	}
 }
@@ -18,6 +18,7 @@ package firmwaremanager
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -31,6 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	dbtestutil "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/testutil"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
@@ -132,8 +134,14 @@ func TestIntegration_FirmwareManager_CanUpdate(t *testing.T) {
 	require.NoError(t, os.MkdirAll(pmcDir, 0o755))
 	require.NoError(t, os.WriteFile(pmcDir+"/cm14mp1r-r1.3.7_to_r1.3.8.tar", make([]byte, 2048), 0o644))
 	require.NoError(t, os.WriteFile(pmcDir+"/cm14mp1r-r1.3.8_to_r1.3.9.tar", make([]byte, 2048), 0o644))
+	checksum := fmt.Sprintf("sha256:%x", sha256.Sum256(make([]byte, 2048)))
+	manifest := fmt.Sprintf("artifacts:\n  cm14mp1r-r1.3.7_to_r1.3.8.tar: %s\n  cm14mp1r-r1.3.8_to_r1.3.9.tar: %s\n", checksum, checksum)
+	require.NoError(t, os.WriteFile(pmcDir+"/"+manifestName, []byte(manifest), 0o644))
 
-	updater, err := newFirmwareUpdater(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+	verifier, err := fwverify.New(fwverify.Config{AllowUnsigned: true})
+	require.NoError(t, err)
+
+	updater, err := newFirmwareUpdater(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir, verifier)
 	require.NoError(t, err)
 	manager.firmwareUpdater[vendor.CodeToVendor(vendor.VendorCodeLiteon)] = updater
 
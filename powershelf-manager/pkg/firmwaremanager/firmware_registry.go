@@ -99,6 +99,10 @@ func (ps *PostgresStore) SetState(ctx context.Context, mac net.HardwareAddr, com
 	return model.SetFirmwareUpdateState(ctx, ps.session.DB, mac, component, newState, errMsg)
 }
 
+func (ps *PostgresStore) SetSignedBy(ctx context.Context, mac net.HardwareAddr, component powershelf.Component, signedBy string) error {
+	return model.SetFirmwareUpdateSignedBy(ctx, ps.session.DB, mac, component, signedBy)
+}
+
 func modelToRecord(fu *model.FirmwareUpdate) *FirmwareUpdateRecord {
 	return &FirmwareUpdateRecord{
 		PmcMacAddress:      net.HardwareAddr(fu.PmcMacAddress),
@@ -108,6 +112,7 @@ func modelToRecord(fu *model.FirmwareUpdate) *FirmwareUpdateRecord {
 		State:              fu.State,
 		JobID:              fu.JobID,
 		ErrorMessage:       fu.ErrorMessage,
+		SignedBy:           fu.SignedBy,
 		LastTransitionTime: fu.LastTransitionTime,
 		UpdatedAt:          fu.UpdatedAt,
 	}
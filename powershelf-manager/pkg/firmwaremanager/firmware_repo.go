@@ -17,11 +17,16 @@
 package firmwaremanager
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/util"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
 )
@@ -32,6 +37,13 @@ type FirmwareRepo struct {
 	minStartingFwVersion firmwareVersion
 	maxStartingFwVersion firmwareVersion
 	upgrades             []FirmwareUpgrade
+	manifestPath         string
+	manifest             []byte // raw manifest as loaded; its signature is verified against these bytes
+}
+
+// firmwareManifest declares the checksum of every firmware artifact of a vendor, keyed by file name.
+type firmwareManifest struct {
+	Artifacts map[string]string `yaml:"artifacts"`
 }
 
 // summary returns a human-readable report of supported versions and artifacts.
@@ -75,6 +87,36 @@ func (repo *FirmwareRepo) open(upgrade *FirmwareUpgrade) (fs.File, error) {
 	return repo.ff.open(upgrade.path)
 }
 
+// verify checks the manifest signature against the verifier's trust bundle and the artifact of the edge against
+// its declared checksum, returning the identity that signed the manifest.
+func (repo *FirmwareRepo) verify(v *fwverify.Verifier, upgrade *FirmwareUpgrade) (string, error) {
+	signedBy, err := v.VerifyManifest(repo.ff.fs, repo.manifestPath, repo.manifest)
+	if err != nil {
+		return "", fmt.Errorf("manifest %s: %w", repo.manifestPath, err)
+	}
+
+	if err := fwverify.VerifyFile(repo.ff.fs, upgrade.path, upgrade.checksum); err != nil {
+		return "", fmt.Errorf("firmware artifact %s: %w", upgrade.path, err)
+	}
+
+	return signedBy, nil
+}
+
+// loadManifest reads and parses the manifest of a vendor's pmc directory.
+func (ff *FirmwareFetcher) loadManifest(path string) (*firmwareManifest, []byte, error) {
+	data, err := fs.ReadFile(ff.fs, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manifest firmwareManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return &manifest, data, nil
+}
+
 // newFirmwareRepo discovers firmware artifacts for a vendor, parses filename-encoded edges, and computes supported range.
 func newFirmwareRepo(v vendor.Vendor, firmwareDir string) (*FirmwareRepo, error) {
 	if firmwareDir == "" {
@@ -98,6 +140,15 @@ func newFirmwareRepo(v vendor.Vendor, firmwareDir string) (*FirmwareRepo, error)
 		return &FirmwareRepo{ff: ff}, nil
 	}
 
+	manifestPath := fmt.Sprintf("%s/%s/%s", strings.ToLower(v.Name), pmcPath, manifestName)
+	manifest, manifestData, err := ff.loadManifest(manifestPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("Vendor %s: no %s found; firmware artifacts without a declared checksum are not offered", v.Name, manifestPath)
+		return &FirmwareRepo{ff: ff}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
 	var upgrades []FirmwareUpgrade = make([]FirmwareUpgrade, 0, len(fw_entries))
 	var minStartingFwVersion firmwareVersion
 	var maxStartingFwVersion firmwareVersion
@@ -110,10 +161,17 @@ func newFirmwareRepo(v vendor.Vendor, firmwareDir string) (*FirmwareRepo, error)
 			&from.major, &from.minor, &from.patch,
 			&to.major, &to.minor, &to.patch)
 		if err == nil {
+			checksum := manifest.Artifacts[name]
+			if _, err := fwverify.ParseChecksum(checksum); err != nil {
+				log.Printf("Vendor %s: skipping fw {%s} without a valid checksum in %s: %v", v.Name, name, manifestPath, err)
+				continue
+			}
+
 			upgrades = append(upgrades, FirmwareUpgrade{
-				from: from,
-				to:   to,
-				path: fw.path,
+				from:     from,
+				to:       to,
+				path:     fw.path,
+				checksum: checksum,
 			})
 
 			if len(upgrades) == 1 {
@@ -129,5 +187,12 @@ func newFirmwareRepo(v vendor.Vendor, firmwareDir string) (*FirmwareRepo, error)
 		}
 	}
 
-	return &FirmwareRepo{ff: ff, minStartingFwVersion: minStartingFwVersion, maxStartingFwVersion: maxStartingFwVersion, upgrades: upgrades}, nil
+	return &FirmwareRepo{
+		ff:                   ff,
+		minStartingFwVersion: minStartingFwVersion,
+		maxStartingFwVersion: maxStartingFwVersion,
+		upgrades:             upgrades,
+		manifestPath:         manifestPath,
+		manifest:             manifestData,
+	}, nil
 }
@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package firmwaremanager
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+)
+
+const (
+	testUpgradeFile   = "cm14mp1r-r1.3.7_to_r1.3.8.tar"
+	testUnlistedFile  = "cm14mp1r-r1.3.8_to_r1.3.9.tar"
+	testFirmwareBytes = 2048
+)
+
+// writeTestRepo lays out a Liteon pmc directory with two artifacts, only the first of which is listed in the manifest.
+func writeTestRepo(t *testing.T) (string, []byte) {
+	t.Helper()
+	fwDir := t.TempDir()
+	pmcDir := filepath.Join(fwDir, "liteon", pmcPath)
+	require.NoError(t, os.MkdirAll(pmcDir, 0o755))
+
+	firmware := make([]byte, testFirmwareBytes)
+	require.NoError(t, os.WriteFile(filepath.Join(pmcDir, testUpgradeFile), firmware, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(pmcDir, testUnlistedFile), firmware, 0o644))
+
+	manifest := []byte(fmt.Sprintf("artifacts:\n  %s: sha256:%x\n", testUpgradeFile, sha256.Sum256(firmware)))
+	require.NoError(t, os.WriteFile(filepath.Join(pmcDir, manifestName), manifest, 0o644))
+
+	return fwDir, manifest
+}
+
+// signTestRepo signs the manifest with a fresh ed25519 key and returns a verifier trusting it.
+func signTestRepo(t *testing.T, fwDir string, manifest []byte) *fwverify.Verifier {
+	t.Helper()
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	sig := ed25519.Sign(key, manifest)
+	require.NoError(t, os.WriteFile(filepath.Join(fwDir, "liteon", pmcPath, manifestName+fwverify.SignatureSuffix), sig, 0o644))
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	trust := filepath.Join(t.TempDir(), "trust.pem")
+	require.NoError(t, os.WriteFile(trust, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	verifier, err := fwverify.New(fwverify.Config{TrustBundle: trust})
+	require.NoError(t, err)
+	return verifier
+}
+
+func TestFirmwareRepo_OnlyOffersArtifactsWithChecksum(t *testing.T) {
+	fwDir, _ := writeTestRepo(t)
+
+	repo, err := newFirmwareRepo(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+	require.NoError(t, err)
+
+	require.Len(t, repo.upgrades, 1)
+	assert.Equal(t, "liteon/pmc/"+testUpgradeFile, repo.upgrades[0].path)
+	assert.Equal(t, "r1.3.8", repo.upgrades[0].to.String())
+}
+
+func TestFirmwareRepo_NoManifest(t *testing.T) {
+	fwDir, _ := writeTestRepo(t)
+	require.NoError(t, os.Remove(filepath.Join(fwDir, "liteon", pmcPath, manifestName)))
+
+	repo, err := newFirmwareRepo(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+	require.NoError(t, err)
+	assert.Empty(t, repo.upgrades)
+}
+
+func TestFirmwareRepo_Verify(t *testing.T) {
+	t.Run("signed", func(t *testing.T) {
+		fwDir, manifest := writeTestRepo(t)
+		verifier := signTestRepo(t, fwDir, manifest)
+
+		repo, err := newFirmwareRepo(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+		require.NoError(t, err)
+
+		signedBy, err := repo.verify(verifier, &repo.upgrades[0])
+		require.NoError(t, err)
+		assert.Contains(t, signedBy, "key:SHA256:")
+	})
+
+	t.Run("unsigned rejected", func(t *testing.T) {
+		fwDir, manifest := writeTestRepo(t)
+		verifier := signTestRepo(t, fwDir, manifest)
+		require.NoError(t, os.Remove(filepath.Join(fwDir, "liteon", pmcPath, manifestName+fwverify.SignatureSuffix)))
+
+		repo, err := newFirmwareRepo(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+		require.NoError(t, err)
+
+		_, err = repo.verify(verifier, &repo.upgrades[0])
+		assert.ErrorIs(t, err, fwverify.ErrUnsigned)
+	})
+
+	t.Run("unsigned allowed", func(t *testing.T) {
+		fwDir, _ := writeTestRepo(t)
+		verifier, err := fwverify.New(fwverify.Config{AllowUnsigned: true})
+		require.NoError(t, err)
+
+		repo, err := newFirmwareRepo(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+		require.NoError(t, err)
+
+		signedBy, err := repo.verify(verifier, &repo.upgrades[0])
+		require.NoError(t, err)
+		assert.Equal(t, fwverify.Unsigned, signedBy)
+	})
+
+	t.Run("tampered artifact", func(t *testing.T) {
+		fwDir, manifest := writeTestRepo(t)
+		verifier := signTestRepo(t, fwDir, manifest)
+
+		repo, err := newFirmwareRepo(vendor.CodeToVendor(vendor.VendorCodeLiteon), fwDir)
+		require.NoError(t, err)
+
+		tampered := make([]byte, testFirmwareBytes)
+		tampered[0] = 1
+		require.NoError(t, os.WriteFile(filepath.Join(fwDir, repo.upgrades[0].path), tampered, 0o644))
+
+		_, err = repo.verify(verifier, &repo.upgrades[0])
+		assert.ErrorIs(t, err, fwverify.ErrChecksumMismatch)
+	})
+}
@@ -121,3 +121,18 @@ func (s *InMemoryStore) SetState(_ context.Context, mac net.HardwareAddr, compon
 	rec.UpdatedAt = now
 	return nil
 }
+
+func (s *InMemoryStore) SetSignedBy(_ context.Context, mac net.HardwareAddr, component powershelf.Component, signedBy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := fwUpdateKey{mac: mac.String(), component: component}
+	rec, ok := s.updates[key]
+	if !ok {
+		return fmt.Errorf("firmware update not found for %s/%s", mac, component)
+	}
+
+	rec.SignedBy = signedBy
+	rec.UpdatedAt = time.Now()
+	return nil
+}
@@ -47,6 +47,9 @@ type FirmwareUpdateStore interface {
 
 	// SetState transitions a record to a new state with an optional error message.
 	SetState(ctx context.Context, mac net.HardwareAddr, component powershelf.Component, newState powershelf.FirmwareState, errMsg string) error
+
+	// SetSignedBy records the identity that signed the verified firmware manifest of a record.
+	SetSignedBy(ctx context.Context, mac net.HardwareAddr, component powershelf.Component, signedBy string) error
 }
 
 // FirmwareUpdateRecord is a storage-agnostic representation of a firmware update
@@ -59,6 +62,7 @@ type FirmwareUpdateRecord struct {
 	State              powershelf.FirmwareState
 	JobID              string
 	ErrorMessage       string
+	SignedBy           string
 	LastTransitionTime time.Time
 	UpdatedAt          time.Time
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/redfish"
@@ -32,10 +33,12 @@ import (
 const minFirmwareSize = 1024
 
 // FirmwareUpdater encapsulates a vendor's FirmwareRepo and UpgradeRule to select and execute upgrades.
+// Artifacts are verified against the verifier's trust bundle before they are uploaded.
 type FirmwareUpdater struct {
-	vendor vendor.Vendor
-	repo   *FirmwareRepo
-	rule   UpgradeRule
+	vendor   vendor.Vendor
+	repo     *FirmwareRepo
+	rule     UpgradeRule
+	verifier *fwverify.Verifier
 }
 
 // Summary returns the repo and rule summaries for the vendor.
@@ -54,7 +57,7 @@ func (updater *FirmwareUpdater) Summary() (string, error) {
 	return sb.String(), nil
 }
 
-func newFirmwareUpdater(v vendor.Vendor, firmwareDir string) (*FirmwareUpdater, error) {
+func newFirmwareUpdater(v vendor.Vendor, firmwareDir string, verifier *fwverify.Verifier) (*FirmwareUpdater, error) {
 	if err := v.IsSupported(); err != nil {
 		return nil, err
 	}
@@ -73,7 +76,7 @@ func newFirmwareUpdater(v vendor.Vendor, firmwareDir string) (*FirmwareUpdater,
 		return nil, err
 	}
 
-	return &FirmwareUpdater{vendor: v, repo: repo, rule: rule}, nil
+	return &FirmwareUpdater{vendor: v, repo: repo, rule: rule, verifier: verifier}, nil
 }
 
 // canUpdate checks the repo's support range for the current version.
@@ -117,10 +120,11 @@ func (fp *FirmwareUpdater) getFwVersion(client *redfish.RedfishClient) (firmware
 }
 
 // update executes the upgrade for an existing Redfish client; when dryRun, returns a synthetic 200 OK without uploading.
-func (fp *FirmwareUpdater) update(client *redfish.RedfishClient, targetVersion firmwareVersion, dryRun bool) error {
+// The artifact is verified before anything is uploaded; the identity that signed its manifest is returned.
+func (fp *FirmwareUpdater) update(client *redfish.RedfishClient, targetVersion firmwareVersion, dryRun bool) (string, error) {
 	currentVersion, err := fp.getFwVersion(client)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if fp.canUpdate(currentVersion, targetVersion) {
@@ -128,39 +132,45 @@ func (fp *FirmwareUpdater) update(client *redfish.RedfishClient, targetVersion f
 		if upgrade != nil {
 			fw, err := fp.repo.open(upgrade)
 			if err != nil {
-				return err
+				return "", err
 			}
 			defer fw.Close()
 
 			info, err := fw.Stat()
 			if err != nil {
-				return err
+				return "", err
 			}
 			size := info.Size()
 
 			if size < minFirmwareSize {
-				return fmt.Errorf("firmware artifact %s is only %d bytes -- this is likely a Git LFS pointer, not the actual firmware (run 'git lfs pull')", upgrade.path, size)
+				return "", fmt.Errorf("firmware artifact %s is only %d bytes -- this is likely a Git LFS pointer, not the actual firmware (run 'git lfs pull')", upgrade.path, size)
 			}
 
+			signedBy, err := fp.repo.verify(fp.verifier, upgrade)
+			if err != nil {
+				return "", fmt.Errorf("firmware verification failed: %w", err)
+			}
+			log.Printf("Verified firmware artifact %s (checksum %s, signed by %s)\n", upgrade.path, upgrade.checksum, signedBy)
+
 			log.Printf("Upgrading firmware from %s to %s using %s (size: %d bytes, dry_run: %v)\n", upgrade.from.String(), upgrade.to.String(), upgrade.path, size, dryRun)
 
 			if dryRun {
 				log.Printf("Dry run: would upgrade firmware from %s to %s using %s (size: %d bytes)\n", upgrade.from.String(), upgrade.to.String(), upgrade.path, size)
-				return nil
+				return signedBy, nil
 			}
 
-			return client.UpdateFirmware(fw)
+			return signedBy, client.UpdateFirmware(fw)
 		}
 	}
 
-	return fmt.Errorf("FW Updater does not support updating powershelf that has a PMC fw version of r.%v.%v.%v\n", currentVersion.major, currentVersion.minor, currentVersion.patch)
+	return "", fmt.Errorf("FW Updater does not support updating powershelf that has a PMC fw version of r.%v.%v.%v\n", currentVersion.major, currentVersion.minor, currentVersion.patch)
 }
 
 // upgrade opens a Redfish session and delegates to update.
-func (fp *FirmwareUpdater) upgrade(ctx context.Context, pmc *pmc.PMC, targetVersion firmwareVersion, dryRun bool) error {
+func (fp *FirmwareUpdater) upgrade(ctx context.Context, pmc *pmc.PMC, targetVersion firmwareVersion, dryRun bool) (string, error) {
 	client, err := redfish.New(ctx, pmc, false)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer client.Logout()
 
@@ -20,11 +20,13 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
 )
 
-// FirmwareUpgrade represents a single directed edge from a source firmware version to a target version, with the artifact path.
+// FirmwareUpgrade represents a single directed edge from a source firmware version to a target version, with the artifact path
+// and the checksum ("<algorithm>:<hex>") the manifest declares for it.
 type FirmwareUpgrade struct {
-	from firmwareVersion
-	to   firmwareVersion
-	path string
+	from     firmwareVersion
+	to       firmwareVersion
+	path     string
+	checksum string
 }
 
 /*
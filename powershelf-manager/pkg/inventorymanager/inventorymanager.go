@@ -18,10 +18,12 @@ package inventorymanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/runner"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/pmcmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
 	"net"
 	"sync"
 
@@ -33,6 +35,8 @@ import (
 var (
 	inventory            sync.Map
 	collector            *runner.Runner
+	pmcManager           *pmcmanager.PmcManager
+	telemetryStore       *telemetry.Store
 	collectorWaiterSleep = time.Second * 30
 )
 
@@ -74,7 +78,10 @@ func GetAllPowershelves() []*powershelf.PowerShelf {
 	return shelves
 }
 
-func Start(registry *pmcmanager.PmcManager) error {
+// Start launches the inventory collector. Each collected powershelf is also recorded in store, if not nil.
+func Start(registry *pmcmanager.PmcManager, store *telemetry.Store) error {
+	pmcManager = registry
+	telemetryStore = store
 	collector = runner.New("inventory collector", func() interface{} { return registry }, collectorWaiter, collectorRunner)
 	return nil
 }
@@ -84,6 +91,35 @@ func Stop() error {
 	return nil
 }
 
+// Refresh re-collects the powershelf of a single PMC outside the collector cycle, e.g. when the PMC reported an
+// event. The periodic collector keeps running as the fallback for PMCs without event support.
+func Refresh(ctx context.Context, mac net.HardwareAddr) error {
+	if pmcManager == nil {
+		return errors.New("inventory collector is not started")
+	}
+
+	pmc, err := pmcManager.GetPmc(ctx, mac)
+	if err != nil {
+		return err
+	}
+
+	powershelf, err := pmcManager.QueryPowerShelf(ctx, pmc)
+	if err != nil {
+		return fmt.Errorf("failed to query powershelf for pmc %s: %w", mac, err)
+	}
+
+	store(powershelf)
+	return nil
+}
+
+// store records a collected powershelf in the inventory and the telemetry store.
+func store(powershelf *powershelf.PowerShelf) {
+	inventory.Store(powershelf.PMC.MAC.String(), powershelf)
+	if telemetryStore != nil {
+		telemetryStore.Record(telemetry.FromPowerShelf(powershelf, time.Now()))
+	}
+}
+
 func collectorWaiter(ctx interface{}) interface{} {
 	log.Println("Inventory Collector: Waiter")
 	time.Sleep(collectorWaiterSleep)
@@ -113,7 +149,7 @@ func collectorRunner(ctx interface{}, task interface{}) {
 			continue
 		}
 
-		inventory.Store(pmcMAC, powershelf)
+		store(powershelf)
 		successCount++
 		if (i+1)%10 == 0 || i == total-1 {
 			log.Printf("Inventory Collector: processed %d/%d PMCs", i+1, total)
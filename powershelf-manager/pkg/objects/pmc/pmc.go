@@ -26,11 +26,15 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
 )
 
+// DefaultPMCPort is the default Redfish HTTPS port.
+const DefaultPMCPort = 443
+
 // PMC specifies the information for a PMC which includes MAC address, IP
 // address, and access credential.
 type PMC struct {
 	MAC        net.HardwareAddr       `json:"mac"`
 	IP         net.IP                 `json:"ip"`
+	Port       int                    `json:"port"` // Custom port (0 = default 443)
 	Vendor     vendor.Vendor          `json:"vendor"`
 	Credential *credential.Credential `json:"credential"`
 }
@@ -97,6 +101,19 @@ func (pmc *PMC) GetIp() net.IP {
 	return pmc.IP
 }
 
+// GetPort returns the effective port (custom or default 443).
+func (pmc *PMC) GetPort() int {
+	if pmc.Port > 0 {
+		return pmc.Port
+	}
+	return DefaultPMCPort
+}
+
+// SetPort sets a custom port for PMC access.
+func (pmc *PMC) SetPort(port int) {
+	pmc.Port = port
+}
+
 // GetVendor returns the PMC vendor.
 func (pmc *PMC) GetVendor() vendor.Vendor {
 	return pmc.Vendor
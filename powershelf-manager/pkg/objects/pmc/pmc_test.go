@@ -371,3 +371,11 @@ func TestPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPort(t *testing.T) {
+	p := &PMC{}
+	assert.Equal(t, DefaultPMCPort, p.GetPort())
+
+	p.SetPort(8443)
+	assert.Equal(t, 8443, p.GetPort())
+}
@@ -29,6 +29,8 @@ type PowerShelf struct {
 	Chassis       *gofish.Chassis
 	Manager       *gofish.Manager
 	PowerSupplies []*powersupply.PowerSupply
+	// PowerLimitWatts is the chassis power cap; nil if the PMC does not support power capping, zero if capping is disabled.
+	PowerLimitWatts *float64
 }
 
 type Component string
@@ -56,4 +58,5 @@ type FirmwareUpdate struct {
 	State         FirmwareState
 	JobID         string
 	ErrorMessage  string
+	SignedBy      string // identity that signed the verified firmware manifest
 }
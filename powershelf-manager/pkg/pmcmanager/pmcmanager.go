@@ -24,7 +24,9 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	gofish "github.com/stmcginnis/gofish/redfish"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentials"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
@@ -109,6 +111,11 @@ func (pm *PmcManager) GetAllPmcs(ctx context.Context) ([]*pmc.PMC, error) {
 	return pmcs, nil
 }
 
+// StoreCredential replaces the stored credential of a registered PMC.
+func (pm *PmcManager) StoreCredential(ctx context.Context, mac net.HardwareAddr, cred *credential.Credential) error {
+	return pm.credentialManager.Put(ctx, mac, cred)
+}
+
 func (pm *PmcManager) RedfishTx(ctx context.Context, pmc *pmc.PMC, tx func(client *redfish.RedfishClient) error) error {
 	if pmc == nil {
 		return errors.New("cannot query redfish with a null PMC")
@@ -158,6 +165,69 @@ func (pm *PmcManager) powerControlPmc(ctx context.Context, pmc *pmc.PMC, on bool
 	return pm.RedfishTx(ctx, pmc, tx)
 }
 
+// SetPowerLimit caps the power of the PMC's powershelf at the given watts. Zero disables power capping.
+func (pm *PmcManager) SetPowerLimit(ctx context.Context, mac net.HardwareAddr, watts float64) error {
+	pmc, err := pm.GetPmc(ctx, mac)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Setting power limit of %s to %v W", pmc.IP, watts)
+	return pm.RedfishTx(ctx, pmc, func(client *redfish.RedfishClient) error {
+		return client.SetPowerLimit(watts)
+	})
+}
+
+// ChangePassword sets a new password for the PMC's account through the Redfish AccountService,
+// logging in with the PMC's current credential.
+func (pm *PmcManager) ChangePassword(ctx context.Context, pmc *pmc.PMC, newPassword string) error {
+	log.Infof("Changing the PMC password of %s", pmc.IP)
+	return pm.RedfishTx(ctx, pmc, func(client *redfish.RedfishClient) error {
+		return client.ChangePassword(newPassword)
+	})
+}
+
+// VerifyLogin opens and closes a Redfish session with the PMC's credential.
+func (pm *PmcManager) VerifyLogin(ctx context.Context, pmc *pmc.PMC) error {
+	return pm.RedfishTx(ctx, pmc, func(*redfish.RedfishClient) error { return nil })
+}
+
+// SubscribeEvents registers destination as a push target of the PMC's Redfish EventService and returns the
+// subscription URI.
+func (pm *PmcManager) SubscribeEvents(ctx context.Context, pmc *pmc.PMC, destination, eventContext string, headers map[string]string) (string, error) {
+	var uri string
+	err := pm.RedfishTx(ctx, pmc, func(client *redfish.RedfishClient) error {
+		var err error
+		uri, err = client.SubscribeEvents(destination, eventContext, headers)
+		return err
+	})
+	return uri, err
+}
+
+// UnsubscribeEvents deletes a Redfish EventService subscription of the PMC.
+func (pm *PmcManager) UnsubscribeEvents(ctx context.Context, pmc *pmc.PMC, uri string) error {
+	return pm.RedfishTx(ctx, pmc, func(client *redfish.RedfishClient) error {
+		return client.UnsubscribeEvents(uri)
+	})
+}
+
+// StreamEvents reads the PMC's Redfish Server-Sent Event stream until ctx is cancelled or the stream fails.
+// Unlike RedfishTx, the session is held for the lifetime of the stream.
+func (pm *PmcManager) StreamEvents(ctx context.Context, pmc *pmc.PMC, fn func(*gofish.Event) error) error {
+	if pmc == nil {
+		return errors.New("cannot stream events from a null PMC")
+	}
+
+	// The session outlives ctx so that it can still be logged out once the stream is cancelled.
+	client, err := redfish.New(context.WithoutCancel(ctx), pmc, false)
+	if err != nil {
+		return err
+	}
+	defer client.Logout()
+
+	return client.StreamEvents(ctx, fn)
+}
+
 func (pm *PmcManager) QueryPowerShelf(ctx context.Context, pmc *pmc.PMC) (*powershelf.PowerShelf, error) {
 	if pmc == nil {
 		return nil, errors.New("cannot query redfish with a null PMC")
@@ -17,7 +17,13 @@
 package powershelfmanager
 
 import (
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/fwverify"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentials"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/pmcregistry"
 )
 
@@ -35,6 +41,16 @@ type Config struct {
 	PmcRegistryConf pmcregistry.Config
 	CredentialConf  credentials.Config
 	FirmwareDir     string
+	// FirmwareVerification configures the trust bundle firmware manifests are verified against.
+	FirmwareVerification fwverify.Config
+	// TelemetryRetention is how long per-shelf telemetry history is kept (telemetry.DefaultRetention if zero).
+	TelemetryRetention time.Duration
+	// CredentialRotation is the rotation schedule of PMC credentials.
+	CredentialRotation credentialrotation.Config
+	// Events configures how Redfish events are received from the PMCs.
+	Events eventmanager.Config
+	// Compliance configures the firmware compliance checks and auto-remediation.
+	Compliance compliance.Config
 }
 
 // StringToDSType converts a string to a DataStoreType, returning false if unsupported.
@@ -20,23 +20,33 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/compliance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/credentials"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/eventmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/firmwaremanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/inventorymanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/pmcmanager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/pmcregistry"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
 
 	log "github.com/sirupsen/logrus"
 )
 
 // PowershelfManager coordinates registry, credential manager, firmware manager, and Redfish sessions to implement service operations.
 type PowershelfManager struct {
-	DataStoreType   DataStoreType
-	PmcManager      *pmcmanager.PmcManager
-	FirmwareManager *firmwaremanager.Manager
+	DataStoreType      DataStoreType
+	PmcManager         *pmcmanager.PmcManager
+	FirmwareManager    *firmwaremanager.Manager
+	Telemetry          *telemetry.Store
+	CredentialRotation *credentialrotation.Manager
+	Events             *eventmanager.Manager
+	Compliance         *compliance.Manager
 }
 
 // New creates a new instance of PowershelfManager with firmware, credential, and registry backends based on the given configuration.
@@ -86,33 +96,91 @@ func New(ctx context.Context, c Config) (*PowershelfManager, error) {
 		return nil, fmt.Errorf("unsupported datastore type for firmware manager: %v", c.DSType)
 	}
 
-	firmwareManager, err := firmwaremanager.New(fwStore, pmcManager, false, c.FirmwareDir)
+	firmwareManager, err := firmwaremanager.New(fwStore, pmcManager, false, c.FirmwareDir, c.FirmwareVerification)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize firmware manager (conf: %v): %w", c, err)
 	}
 
+	var rotationStore credentialrotation.Store
+	switch c.DSType {
+	case DatastoreTypePersistent:
+		log.Printf("Initializing credential rotation with a PostgreSQL store")
+		rotationStore, err = credentialrotation.NewPostgresStore(ctx, c.PmcRegistryConf.DSConf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize credential rotation postgres store (conf: %v): %w", c, err)
+		}
+	case DatastoreTypeInMemory:
+		log.Printf("Initializing credential rotation with an in-memory store (rotation history will not persist across restarts)")
+		rotationStore = credentialrotation.NewInMemoryStore()
+	default:
+		return nil, fmt.Errorf("unsupported datastore type for credential rotation: %v", c.DSType)
+	}
+
+	var complianceStore compliance.Store
+	switch c.DSType {
+	case DatastoreTypePersistent:
+		log.Printf("Initializing firmware compliance with a PostgreSQL store")
+		complianceStore, err = compliance.NewPostgresStore(ctx, c.PmcRegistryConf.DSConf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize firmware compliance postgres store (conf: %v): %w", c, err)
+		}
+	case DatastoreTypeInMemory:
+		log.Printf("Initializing firmware compliance with an in-memory store (baselines and drift history will not persist across restarts)")
+		complianceStore = compliance.NewInMemoryStore()
+	default:
+		return nil, fmt.Errorf("unsupported datastore type for firmware compliance: %v", c.DSType)
+	}
+
 	return &PowershelfManager{
-		DataStoreType:   c.DSType,
-		PmcManager:      pmcManager,
-		FirmwareManager: firmwareManager,
+		DataStoreType:      c.DSType,
+		PmcManager:         pmcManager,
+		FirmwareManager:    firmwareManager,
+		Telemetry:          telemetry.NewStore(c.TelemetryRetention),
+		CredentialRotation: credentialrotation.New(pmcManager, rotationStore, c.CredentialRotation),
+		Events:             eventmanager.New(pmcManager, inventorymanager.Refresh, c.Events),
+		Compliance:         compliance.New(pmcManager, compliance.NewRedfishCollector(pmcManager), firmwareManager, complianceStore, c.Compliance),
 	}, nil
 }
 
-// Start initializes the registry and credential manager.
+// Start initializes the registry and credential manager and starts collecting inventory and events.
 func (pm *PowershelfManager) Start(ctx context.Context) error {
 	if err := pm.PmcManager.Start(ctx); err != nil {
 		return err
 	}
 
-	return inventorymanager.Start(pm.PmcManager)
+	if err := pm.CredentialRotation.Start(ctx); err != nil {
+		return err
+	}
+
+	if err := pm.Compliance.Start(ctx); err != nil {
+		return err
+	}
+
+	if err := inventorymanager.Start(pm.PmcManager, pm.Telemetry); err != nil {
+		return err
+	}
+
+	return pm.Events.Start(ctx)
 }
 
-// Stop shuts down the registry and credential manager.
+// Stop shuts down the event and inventory collection, the registry and the credential manager.
 func (pm *PowershelfManager) Stop(ctx context.Context) error {
+	if err := pm.Events.Stop(ctx); err != nil {
+		return err
+	}
+
 	if err := inventorymanager.Stop(); err != nil {
 		return err
 	}
 
+	if err := pm.Compliance.Stop(ctx); err != nil {
+		return err
+	}
+
+	if err := pm.CredentialRotation.Stop(ctx); err != nil {
+		return err
+	}
+
 	return pm.PmcManager.Stop(ctx)
 }
 
@@ -131,6 +199,16 @@ func (pm *PowershelfManager) GetAllPowershelves(ctx context.Context) ([]*powersh
 	return inventorymanager.GetAllPowershelves(), nil
 }
 
+// GetPowershelfTelemetry returns the retained telemetry samples of a PMC taken at or after since, oldest first.
+func (pm *PowershelfManager) GetPowershelfTelemetry(ctx context.Context, mac net.HardwareAddr, since time.Time) []*telemetry.ShelfSample {
+	return pm.Telemetry.History(mac.String(), since)
+}
+
+// GetTelemetryPmcMacs returns the MACs of all PMCs with retained telemetry.
+func (pm *PowershelfManager) GetTelemetryPmcMacs(ctx context.Context) []string {
+	return pm.Telemetry.MACs()
+}
+
 // RegisterPmc persists PMC identity in the registry and stores credentials keyed by MAC in the credential manager.
 func (pm *PowershelfManager) RegisterPmc(ctx context.Context, pmc *pmc.PMC) error {
 	return pm.PmcManager.Register(ctx, pmc)
@@ -175,6 +253,56 @@ func (pm *PowershelfManager) PowerOff(ctx context.Context, mac net.HardwareAddr)
 	return pm.powerControl(ctx, mac, false)
 }
 
+// SetPowerLimit caps the power of the powershelf at the given watts via Redfish PowerLimit. Zero disables power capping.
+func (pm *PowershelfManager) SetPowerLimit(ctx context.Context, mac net.HardwareAddr, watts float64) error {
+	return pm.PmcManager.SetPowerLimit(ctx, mac, watts)
+}
+
+// RotateCredential starts rotating the credential of a registered PMC in the background.
+func (pm *PowershelfManager) RotateCredential(ctx context.Context, mac net.HardwareAddr) error {
+	return pm.CredentialRotation.Trigger(ctx, mac)
+}
+
+// GetCredentialRotationStatus returns the latest credential rotation of a PMC.
+func (pm *PowershelfManager) GetCredentialRotationStatus(ctx context.Context, mac net.HardwareAddr) (*credentialrotation.Status, error) {
+	return pm.CredentialRotation.Status(ctx, mac)
+}
+
+// SetFirmwareBaseline creates or replaces a firmware baseline.
+func (pm *PowershelfManager) SetFirmwareBaseline(ctx context.Context, baseline *compliance.Baseline) (*compliance.Baseline, error) {
+	return pm.Compliance.SetBaseline(ctx, baseline)
+}
+
+// DeleteFirmwareBaseline deletes a firmware baseline.
+func (pm *PowershelfManager) DeleteFirmwareBaseline(ctx context.Context, name string) error {
+	return pm.Compliance.DeleteBaseline(ctx, name)
+}
+
+// ListFirmwareBaselines returns all firmware baselines.
+func (pm *PowershelfManager) ListFirmwareBaselines(ctx context.Context) ([]*compliance.Baseline, error) {
+	return pm.Compliance.Baselines(ctx)
+}
+
+// GetFirmwareComplianceReport checks the firmware of the given powershelves (all if empty) against the baselines.
+func (pm *PowershelfManager) GetFirmwareComplianceReport(ctx context.Context, macs []net.HardwareAddr) (*compliance.Report, error) {
+	return pm.Compliance.Report(ctx, macs)
+}
+
+// GetFirmwareDriftHistory returns the recorded firmware drift matching the filter, most recent first.
+func (pm *PowershelfManager) GetFirmwareDriftHistory(ctx context.Context, filter compliance.DriftFilter) ([]*compliance.DriftEvent, error) {
+	return pm.Compliance.DriftHistory(ctx, filter)
+}
+
+// SubscribeEvents returns a subscription to the events of the given PMCs (all if empty) and kinds (all if empty).
+// The caller must Close it.
+func (pm *PowershelfManager) SubscribeEvents(macs []net.HardwareAddr, kinds []events.Kind) *events.Subscription[eventmanager.Event] {
+	pmcMacs := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		pmcMacs = append(pmcMacs, mac.String())
+	}
+	return pm.Events.Subscribe(pmcMacs, kinds)
+}
+
 // PowerControlDirect performs a power action using pre-built connection details,
 // bypassing registry and credential manager lookups.
 func (pm *PowershelfManager) PowerControlDirect(ctx context.Context, pmc *pmc.PMC, on bool) error {
@@ -28,6 +28,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/events"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powersupply"
@@ -40,6 +41,9 @@ import (
 	"github.com/stmcginnis/gofish/redfish"
 )
 
+// ErrPowerLimitNotSupported is returned when the powershelf chassis does not expose a Redfish Power resource with a PowerControl.
+var ErrPowerLimitNotSupported = errors.New("power limiting is not supported by the powershelf")
+
 // checkResponse returns an error if the HTTP status code indicates failure (>= 300),
 // including the response body in the error message for diagnostics.
 func checkResponse(resp *http.Response) error {
@@ -66,8 +70,10 @@ type RedfishClient struct {
 // New creates a RedfishClient for the given PMC and context.
 func New(ctx context.Context, pmc *pmc.PMC, reuse_connections bool) (*RedfishClient, error) {
 	endpoint := fmt.Sprintf("https://%s", pmc.IP.String())
-	// TODO: remove this--hack for running the service from my macbook
-	if pmc.IP.String() == "127.0.0.1" {
+	if pmc.Port > 0 {
+		endpoint = fmt.Sprintf("%s:%d", endpoint, pmc.GetPort())
+	} else if pmc.IP.String() == "127.0.0.1" {
+		// TODO: remove this--hack for running the service from my macbook
 		endpoint = endpoint + ":8443"
 	}
 
@@ -256,6 +262,114 @@ func (c *RedfishClient) UpdateFirmware(fw io.Reader) error {
 	return checkResponse(resp)
 }
 
+// QueryPowerControl returns the chassis power control, or ErrPowerLimitNotSupported if the PMC does not expose one.
+func (c *RedfishClient) QueryPowerControl() (*redfish.PowerControl, error) {
+	chassis, err := c.QueryChassis()
+	if err != nil {
+		return nil, err
+	}
+
+	power, err := chassis.Power()
+	if err != nil {
+		return nil, err
+	}
+
+	if power == nil || len(power.PowerControl) == 0 {
+		return nil, ErrPowerLimitNotSupported
+	}
+
+	return &power.PowerControl[0], nil
+}
+
+// QueryPowerLimit returns the chassis power limit in watts. Zero means power capping is disabled.
+func (c *RedfishClient) QueryPowerLimit() (float64, error) {
+	control, err := c.QueryPowerControl()
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(control.PowerLimit.LimitInWatts), nil
+}
+
+// SetPowerLimit caps the chassis power at the given watts through PowerControl.PowerLimit. Zero disables power capping.
+func (c *RedfishClient) SetPowerLimit(watts float64) error {
+	if watts < 0 {
+		return fmt.Errorf("invalid power limit %v W", watts)
+	}
+
+	if _, err := c.QueryPowerControl(); err != nil {
+		return err
+	}
+
+	var limit any
+	if watts > 0 {
+		limit = watts
+	}
+	body := map[string]interface{}{
+		"PowerControl": []interface{}{
+			map[string]interface{}{
+				"PowerLimit": map[string]interface{}{
+					"LimitInWatts": limit,
+				},
+			},
+		},
+	}
+
+	log.Printf("setting power limit... body: %v \n", body)
+	resp, err := c.Patch("/redfish/v1/Chassis/powershelf/Power", body)
+	if err != nil {
+		return fmt.Errorf("failed to set power limit: %w", err)
+	}
+
+	return checkResponse(resp)
+}
+
+// ChangePassword sets the password of the account the client is logged in with through the Redfish AccountService.
+// The current session stays valid; new sessions must use the new password.
+func (c *RedfishClient) ChangePassword(newPassword string) error {
+	accountService, err := c.Service.AccountService()
+	if err != nil {
+		return fmt.Errorf("failed to query the account service: %w", err)
+	}
+
+	accounts, err := accountService.Accounts()
+	if err != nil {
+		return fmt.Errorf("failed to query accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		if account.UserName != c.ClientConfig.Username {
+			continue
+		}
+
+		resp, err := c.Patch(account.ODataID, map[string]interface{}{"Password": newPassword})
+		if err != nil {
+			return fmt.Errorf("failed to change the password of %s: %w", c.ClientConfig.Username, err)
+		}
+		return checkResponse(resp)
+	}
+
+	return fmt.Errorf("could not find the account of %s", c.ClientConfig.Username)
+}
+
+// SubscribeEvents registers destination as a push target of the PMC's EventService and returns the subscription
+// URI. eventContext is echoed back in every event; an existing subscription with the same destination and context
+// is reused.
+func (c *RedfishClient) SubscribeEvents(destination, eventContext string, headers map[string]string) (string, error) {
+	return events.Subscribe(c.APIClient, destination, eventContext, headers)
+}
+
+// UnsubscribeEvents deletes an EventService subscription.
+func (c *RedfishClient) UnsubscribeEvents(uri string) error {
+	return events.Unsubscribe(c.APIClient, uri)
+}
+
+// StreamEvents reads the PMC's Server-Sent Event stream until ctx is cancelled, calling fn with every event.
+// It returns events.ErrSSENotSupported or events.ErrNotSupported if the PMC cannot stream events.
+func (c *RedfishClient) StreamEvents(ctx context.Context, fn func(*redfish.Event) error) error {
+	return events.Stream(ctx, c.APIClient, fn)
+}
+
 // QueryPowerSubsystem returns the chassis PowerSubsystem resource.
 func (c *RedfishClient) QueryPowerSubsystem() (*redfish.PowerSubsystem, error) {
 	chassis, err := c.QueryChassis()
@@ -365,5 +479,14 @@ func (c *RedfishClient) QueryPowerShelf() (*powershelf.PowerShelf, error) {
 	}
 	powershelf.PowerSupplies = psus
 
+	// Power capping is optional, so a PMC without it still yields a powershelf view.
+	limit, err := c.QueryPowerLimit()
+	switch {
+	case err == nil:
+		powershelf.PowerLimitWatts = &limit
+	case !errors.Is(err, ErrPowerLimitNotSupported):
+		log.Warnf("failed to query the power limit of %s: %v", c.pmc.IP, err)
+	}
+
 	return powershelf, nil
 }
@@ -0,0 +1,190 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redfish
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/telemetry"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEmulatedClient starts an emulated PMC and returns a RedfishClient connected to it.
+func newEmulatedClient(t *testing.T, cfg emulator.Config) (*RedfishClient, *emulator.Emulator) {
+	emu := emulator.New(cfg)
+	server := httptest.NewTLSServer(emu)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	cred := credential.New(emulator.DefaultUsername, emulator.DefaultPassword)
+	p, err := pmc.NewFromAddr(net.HardwareAddr{0, 1, 2, 3, 4, 5}, net.ParseIP(u.Hostname()), vendor.VendorCodeLiteon, &cred)
+	require.NoError(t, err)
+	p.SetPort(port)
+
+	client, err := New(context.Background(), p, false)
+	require.NoError(t, err)
+	t.Cleanup(client.Logout)
+
+	return client, emu
+}
+
+func TestQueryPowerShelf(t *testing.T) {
+	for _, profile := range []emulator.Profile{emulator.LiteonPowerShelf, emulator.DeltaPowerShelf} {
+		t.Run(profile.Name, func(t *testing.T) {
+			client, _ := newEmulatedClient(t, emulator.Config{Profile: profile})
+
+			shelf, err := client.QueryPowerShelf()
+			require.NoError(t, err)
+
+			assert.Equal(t, profile.Model, shelf.Chassis.Model)
+			assert.Equal(t, profile.FirmwareVersion, shelf.Manager.FirmwareVersion)
+			require.Len(t, shelf.PowerSupplies, profile.PowerSupplies)
+
+			psu := shelf.PowerSupplies[0]
+			assert.Equal(t, strconv.Itoa(profile.PSUCapacityWatts), psu.CapacityWatts)
+			assert.Equal(t, profile.PSUModel, psu.Model)
+			assert.True(t, psu.PowerState)
+			require.Len(t, psu.Sensors, 5)
+			assert.Equal(t, float32(profile.PSUOutputPowerWatts), psu.Sensors[0].Reading)
+		})
+	}
+}
+
+func TestQueryPowerShelfTelemetry(t *testing.T) {
+	profile := emulator.LiteonPowerShelf
+	client, _ := newEmulatedClient(t, emulator.Config{Profile: profile, Rack: "rack-07"})
+
+	shelf, err := client.QueryPowerShelf()
+	require.NoError(t, err)
+
+	sample := telemetry.FromPowerShelf(shelf, time.Now())
+	assert.Equal(t, "rack-07", sample.Rack)
+	require.Len(t, sample.PowerSupplies, profile.PowerSupplies)
+
+	psu := sample.PowerSupplies[0].Readings
+	assert.Equal(t, profile.PSUOutputPowerWatts, psu[telemetry.OutputPowerWatts])
+	assert.Equal(t, profile.PSUInputVoltage, psu[telemetry.InputVoltageVolts])
+	assert.InDelta(t, profile.PSUOutputPowerWatts/profile.PSUInputVoltage, psu[telemetry.InputCurrentAmps], 0.01)
+	assert.Equal(t, profile.PSUTemperatureCelsius, psu[telemetry.TemperatureCelsius])
+	assert.Equal(t, profile.PSUFanSpeedRPM, psu[telemetry.FanSpeedRPM])
+
+	assert.Equal(t, profile.PSUOutputPowerWatts*float64(profile.PowerSupplies), sample.Readings[telemetry.OutputPowerWatts])
+	assert.Equal(t, profile.PSUInputVoltage, sample.Readings[telemetry.InputVoltageVolts])
+}
+
+func TestPowerControl(t *testing.T) {
+	client, emu := newEmulatedClient(t, emulator.Config{Profile: emulator.LiteonPowerShelf})
+
+	resp, err := client.PowerOff()
+	require.NoError(t, err)
+	require.NoError(t, checkResponse(resp))
+
+	state, err := client.QueryPowerState()
+	require.NoError(t, err)
+	assert.Equal(t, redfish.OffPowerState, state)
+
+	resp, err = client.PowerOn()
+	require.NoError(t, err)
+	require.NoError(t, checkResponse(resp))
+	assert.Equal(t, "On", emu.PowerState())
+
+	resp, err = client.ResetPmc(GracefulRestart)
+	require.NoError(t, err)
+	require.NoError(t, checkResponse(resp))
+	assert.Equal(t, 1, emu.ManagerResets())
+}
+
+func TestPowerLimit(t *testing.T) {
+	t.Run("supported", func(t *testing.T) {
+		profile := emulator.LiteonPowerShelf
+		client, emu := newEmulatedClient(t, emulator.Config{Profile: profile})
+
+		limit, err := client.QueryPowerLimit()
+		require.NoError(t, err)
+		assert.Zero(t, limit)
+
+		require.NoError(t, client.SetPowerLimit(9000))
+		assert.Equal(t, 9000.0, emu.PowerLimit())
+
+		shelf, err := client.QueryPowerShelf()
+		require.NoError(t, err)
+		require.NotNil(t, shelf.PowerLimitWatts)
+		assert.Equal(t, 9000.0, *shelf.PowerLimitWatts)
+		assert.Equal(t, 9000.0, telemetry.FromPowerShelf(shelf, time.Now()).Readings[telemetry.OutputPowerWatts])
+
+		require.NoError(t, client.SetPowerLimit(0))
+		assert.Zero(t, emu.PowerLimit())
+		assert.Error(t, client.SetPowerLimit(-1))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		client, _ := newEmulatedClient(t, emulator.Config{Profile: emulator.DeltaPowerShelf})
+
+		assert.ErrorIs(t, client.SetPowerLimit(9000), ErrPowerLimitNotSupported)
+
+		shelf, err := client.QueryPowerShelf()
+		require.NoError(t, err)
+		assert.Nil(t, shelf.PowerLimitWatts)
+	})
+}
+
+func TestUpdateFirmware(t *testing.T) {
+	t.Run("upload succeeds", func(t *testing.T) {
+		client, emu := newEmulatedClient(t, emulator.Config{Profile: emulator.LiteonPowerShelf})
+
+		require.NoError(t, client.UpdateFirmware(strings.NewReader("firmware image")))
+		assert.Equal(t, emulator.ApplyTimeImmediate, emu.ApplyTime())
+	})
+
+	t.Run("upload rejected", func(t *testing.T) {
+		client, emu := newEmulatedClient(t, emulator.Config{Profile: emulator.DeltaPowerShelf})
+		emu.InjectFault(emulator.Fault{Method: http.MethodPost, Path: "/redfish/v1/UpdateService", StatusCode: http.StatusServiceUnavailable})
+
+		err := client.UpdateFirmware(strings.NewReader("firmware image"))
+		assert.ErrorContains(t, err, "503")
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	client, emu := newEmulatedClient(t, emulator.Config{Profile: emulator.LiteonPowerShelf})
+
+	require.NoError(t, client.ChangePassword("N3w-Passw0rd"))
+	assert.Equal(t, "N3w-Passw0rd", emu.Password())
+
+	err := client.ChangePassword("short")
+	assert.ErrorContains(t, err, "400")
+	assert.Equal(t, "N3w-Passw0rd", emu.Password())
+}
@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricNamespace = "psm"
+
+var (
+	shelfLabels = []string{"pmc_mac", "rack", "vendor"}
+	psuLabels   = []string{"pmc_mac", "rack", "vendor", "psu"}
+)
+
+// Collector exports the latest sample of every shelf in a Store as Prometheus gauges.
+type Collector struct {
+	store *Store
+
+	shelfDescs     map[Metric]*prometheus.Desc
+	psuDescs       map[Metric]*prometheus.Desc
+	sampleTimeDesc *prometheus.Desc
+	powerLimitDesc *prometheus.Desc
+	psuUpDesc      *prometheus.Desc
+	psuHealthyDesc *prometheus.Desc
+}
+
+// Ensure Collector implements prometheus.Collector.
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector creates a Collector reading from the given Store.
+func NewCollector(store *Store) *Collector {
+	c := &Collector{
+		store:      store,
+		shelfDescs: make(map[Metric]*prometheus.Desc, len(Metrics)),
+		psuDescs:   make(map[Metric]*prometheus.Desc, len(Metrics)),
+		sampleTimeDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "powershelf", "last_sample_timestamp_seconds"),
+			"Unix time of the latest telemetry sample collected from the powershelf.",
+			shelfLabels, nil,
+		),
+		powerLimitDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "powershelf", "power_limit_watts"),
+			"Power cap configured on the powershelf in watts (0 if capping is disabled). Absent if the PMC does not support power capping.",
+			shelfLabels, nil,
+		),
+		psuUpDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "psu", "power_state"),
+			"Whether the PSU is powered on (1) or off (0).",
+			psuLabels, nil,
+		),
+		psuHealthyDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "psu", "healthy"),
+			"Whether the PSU reports OK health (1) or not (0).",
+			psuLabels, nil,
+		),
+	}
+
+	for _, m := range Metrics {
+		c.shelfDescs[m] = prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "powershelf", string(m)),
+			"Powershelf "+help(m)+" aggregated across its PSUs.",
+			shelfLabels, nil,
+		)
+		c.psuDescs[m] = prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "psu", string(m)),
+			"PSU "+help(m)+".",
+			psuLabels, nil,
+		)
+	}
+
+	return c
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, m := range Metrics {
+		ch <- c.shelfDescs[m]
+		ch <- c.psuDescs[m]
+	}
+	ch <- c.sampleTimeDesc
+	ch <- c.powerLimitDesc
+	ch <- c.psuUpDesc
+	ch <- c.psuHealthyDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	for _, sample := range c.store.Latest() {
+		labels := []string{sample.PmcMAC, sample.Rack, sample.Vendor.Name}
+
+		ch <- prometheus.MustNewConstMetric(c.sampleTimeDesc, prometheus.GaugeValue, float64(sample.Timestamp.UnixNano())/1e9, labels...)
+		if sample.PowerLimitWatts != nil {
+			ch <- prometheus.MustNewConstMetric(c.powerLimitDesc, prometheus.GaugeValue, *sample.PowerLimitWatts, labels...)
+		}
+		for _, m := range Metrics {
+			if v, ok := sample.Readings[m]; ok {
+				ch <- prometheus.MustNewConstMetric(c.shelfDescs[m], prometheus.GaugeValue, v, labels...)
+			}
+		}
+
+		for _, psu := range sample.PowerSupplies {
+			psuLabelValues := append(append([]string{}, labels...), psu.ID)
+			ch <- prometheus.MustNewConstMetric(c.psuUpDesc, prometheus.GaugeValue, boolToFloat(psu.PowerState), psuLabelValues...)
+			ch <- prometheus.MustNewConstMetric(c.psuHealthyDesc, prometheus.GaugeValue, boolToFloat(healthOK(psu.Health)), psuLabelValues...)
+			for _, m := range Metrics {
+				if v, ok := psu.Readings[m]; ok {
+					ch <- prometheus.MustNewConstMetric(c.psuDescs[m], prometheus.GaugeValue, v, psuLabelValues...)
+				}
+			}
+		}
+	}
+}
+
+// help returns a human-readable description of a metric.
+func help(m Metric) string {
+	switch m {
+	case InputPowerWatts:
+		return "input power in watts"
+	case OutputPowerWatts:
+		return "output power in watts"
+	case InputVoltageVolts:
+		return "input voltage in volts"
+	case OutputVoltageVolts:
+		return "output voltage in volts"
+	case InputCurrentAmps:
+		return "input current in amperes"
+	case OutputCurrentAmps:
+		return "output current in amperes"
+	case TemperatureCelsius:
+		return "temperature in degrees Celsius"
+	case FanSpeedRPM:
+		return "fan speed in RPM"
+	default:
+		return string(m)
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	now := time.Unix(1767225600, 0)
+	store := newTestStore(time.Hour, now)
+	store.Record(&ShelfSample{
+		PmcMAC:    "00:11:22:33:44:55",
+		Vendor:    vendor.CodeToVendor(vendor.VendorCodeLiteon),
+		Rack:      "rack-01",
+		Timestamp: now,
+		Readings:  Readings{OutputPowerWatts: 3500, InputVoltageVolts: 230},
+		PowerSupplies: []PowerSupplySample{
+			{ID: "PSU0", PowerState: true, Health: "OK", Readings: Readings{OutputPowerWatts: 2000, InputVoltageVolts: 228}},
+			{ID: "PSU1", PowerState: false, Health: "Critical", Readings: Readings{OutputPowerWatts: 1500, InputVoltageVolts: 232}},
+		},
+	})
+
+	collector := NewCollector(store)
+
+	expected := `
+# HELP psm_powershelf_output_power_watts Powershelf output power in watts aggregated across its PSUs.
+# TYPE psm_powershelf_output_power_watts gauge
+psm_powershelf_output_power_watts{pmc_mac="00:11:22:33:44:55",rack="rack-01",vendor="Liteon"} 3500
+# HELP psm_psu_output_power_watts PSU output power in watts.
+# TYPE psm_psu_output_power_watts gauge
+psm_psu_output_power_watts{pmc_mac="00:11:22:33:44:55",psu="PSU0",rack="rack-01",vendor="Liteon"} 2000
+psm_psu_output_power_watts{pmc_mac="00:11:22:33:44:55",psu="PSU1",rack="rack-01",vendor="Liteon"} 1500
+# HELP psm_psu_power_state Whether the PSU is powered on (1) or off (0).
+# TYPE psm_psu_power_state gauge
+psm_psu_power_state{pmc_mac="00:11:22:33:44:55",psu="PSU0",rack="rack-01",vendor="Liteon"} 1
+psm_psu_power_state{pmc_mac="00:11:22:33:44:55",psu="PSU1",rack="rack-01",vendor="Liteon"} 0
+# HELP psm_psu_healthy Whether the PSU reports OK health (1) or not (0).
+# TYPE psm_psu_healthy gauge
+psm_psu_healthy{pmc_mac="00:11:22:33:44:55",psu="PSU0",rack="rack-01",vendor="Liteon"} 1
+psm_psu_healthy{pmc_mac="00:11:22:33:44:55",psu="PSU1",rack="rack-01",vendor="Liteon"} 0
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"psm_powershelf_output_power_watts", "psm_psu_output_power_watts", "psm_psu_power_state", "psm_psu_healthy"))
+
+	// 2 shelf readings + 4 PSU readings + 4 PSU state/health gauges + 1 sample timestamp.
+	assert.Equal(t, 11, testutil.CollectAndCount(collector))
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP psm_powershelf_last_sample_timestamp_seconds Unix time of the latest telemetry sample collected from the powershelf.
+# TYPE psm_powershelf_last_sample_timestamp_seconds gauge
+psm_powershelf_last_sample_timestamp_seconds{pmc_mac="00:11:22:33:44:55",rack="rack-01",vendor="Liteon"} 1.7672256e+09
+`), "psm_powershelf_last_sample_timestamp_seconds"))
+}
+
+func TestCollectorPowerLimit(t *testing.T) {
+	now := time.Unix(1767225600, 0)
+	store := newTestStore(time.Hour, now)
+	limit := 12000.0
+	store.Record(&ShelfSample{PmcMAC: "00:11:22:33:44:55", Rack: "rack-01", Timestamp: now, PowerLimitWatts: &limit})
+	store.Record(&ShelfSample{PmcMAC: "00:11:22:33:44:66", Rack: "rack-01", Timestamp: now})
+
+	require.NoError(t, testutil.CollectAndCompare(NewCollector(store), strings.NewReader(`
+# HELP psm_powershelf_power_limit_watts Power cap configured on the powershelf in watts (0 if capping is disabled). Absent if the PMC does not support power capping.
+# TYPE psm_powershelf_power_limit_watts gauge
+psm_powershelf_power_limit_watts{pmc_mac="00:11:22:33:44:55",rack="rack-01",vendor=""} 12000
+`), "psm_powershelf_power_limit_watts"))
+}
+
+func TestCollectorEmptyStore(t *testing.T) {
+	assert.Equal(t, 0, testutil.CollectAndCount(NewCollector(NewStore(0))))
+}
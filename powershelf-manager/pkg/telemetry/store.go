@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultRetention is how long samples are kept when no retention is configured.
+const DefaultRetention = time.Hour
+
+// Store keeps a bounded, time-ordered history of samples per powershelf.
+type Store struct {
+	mu        sync.RWMutex
+	retention time.Duration
+	samples   map[string][]*ShelfSample
+	now       func() time.Time
+}
+
+// NewStore creates a Store retaining samples for the given duration (DefaultRetention if zero).
+func NewStore(retention time.Duration) *Store {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &Store{
+		retention: retention,
+		samples:   make(map[string][]*ShelfSample),
+		now:       time.Now,
+	}
+}
+
+// Retention returns how long samples are kept.
+func (s *Store) Retention() time.Duration {
+	return s.retention
+}
+
+// Record appends a sample to its shelf's history and drops samples that fell out of the retention window.
+func (s *Store) Record(sample *ShelfSample) {
+	if sample == nil || sample.PmcMAC == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := append(s.samples[sample.PmcMAC], sample)
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Timestamp.Before(history[j].Timestamp)
+	})
+	s.samples[sample.PmcMAC] = s.prune(history)
+}
+
+// Latest returns the most recent sample of every shelf that reported within the retention window, ordered by PMC MAC.
+func (s *Store) Latest() []*ShelfSample {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cutoff := s.now().Add(-s.retention)
+	latest := make([]*ShelfSample, 0, len(s.samples))
+	for _, history := range s.samples {
+		if len(history) == 0 {
+			continue
+		}
+		if last := history[len(history)-1]; !last.Timestamp.Before(cutoff) {
+			latest = append(latest, last)
+		}
+	}
+
+	sort.Slice(latest, func(i, j int) bool {
+		return latest[i].PmcMAC < latest[j].PmcMAC
+	})
+
+	return latest
+}
+
+// History returns the retained samples of a shelf taken at or after since, oldest first.
+func (s *Store) History(pmcMAC string, since time.Time) []*ShelfSample {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cutoff := s.now().Add(-s.retention)
+	if since.Before(cutoff) {
+		since = cutoff
+	}
+
+	var history []*ShelfSample
+	for _, sample := range s.samples[pmcMAC] {
+		if !sample.Timestamp.Before(since) {
+			history = append(history, sample)
+		}
+	}
+
+	return history
+}
+
+// MACs returns the PMC MACs with retained samples, sorted.
+func (s *Store) MACs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	macs := make([]string, 0, len(s.samples))
+	for mac := range s.samples {
+		macs = append(macs, mac)
+	}
+	sort.Strings(macs)
+
+	return macs
+}
+
+// prune drops samples older than the retention window. Must be called with s.mu held.
+func (s *Store) prune(history []*ShelfSample) []*ShelfSample {
+	cutoff := s.now().Add(-s.retention)
+	i := sort.Search(len(history), func(i int) bool {
+		return !history[i].Timestamp.Before(cutoff)
+	})
+
+	return history[i:]
+}
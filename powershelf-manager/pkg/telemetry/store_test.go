@@ -0,0 +1,102 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(retention time.Duration, now time.Time) *Store {
+	s := NewStore(retention)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func sampleAt(mac string, at time.Time, power float64) *ShelfSample {
+	return &ShelfSample{PmcMAC: mac, Timestamp: at, Readings: Readings{OutputPowerWatts: power}}
+}
+
+func TestNewStoreDefaultRetention(t *testing.T) {
+	assert.Equal(t, DefaultRetention, NewStore(0).Retention())
+	assert.Equal(t, time.Minute, NewStore(time.Minute).Retention())
+}
+
+func TestStoreRecordPrunesExpiredSamples(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newTestStore(10*time.Minute, now)
+
+	s.Record(sampleAt("aa", now.Add(-20*time.Minute), 1))
+	s.Record(sampleAt("aa", now.Add(-5*time.Minute), 2))
+	s.Record(sampleAt("aa", now.Add(-8*time.Minute), 3))
+	s.Record(nil)
+	s.Record(&ShelfSample{})
+
+	history := s.History("aa", time.Time{})
+	require.Len(t, history, 2)
+	assert.Equal(t, 3.0, history[0].Readings[OutputPowerWatts])
+	assert.Equal(t, 2.0, history[1].Readings[OutputPowerWatts])
+	assert.Equal(t, []string{"aa"}, s.MACs())
+}
+
+func TestStoreHistorySince(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newTestStore(time.Hour, now)
+	for i := 5; i > 0; i-- {
+		s.Record(sampleAt("aa", now.Add(-time.Duration(i)*time.Minute), float64(i)))
+	}
+
+	tests := map[string]struct {
+		mac      string
+		since    time.Time
+		expected int
+	}{
+		"all":           {mac: "aa", since: time.Time{}, expected: 5},
+		"inclusive":     {mac: "aa", since: now.Add(-3 * time.Minute), expected: 3},
+		"future":        {mac: "aa", since: now.Add(time.Minute), expected: 0},
+		"unknown shelf": {mac: "bb", since: time.Time{}, expected: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Len(t, s.History(tc.mac, tc.since), tc.expected)
+		})
+	}
+}
+
+func TestStoreLatest(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newTestStore(10*time.Minute, now)
+
+	s.Record(sampleAt("bb", now.Add(-2*time.Minute), 1))
+	s.Record(sampleAt("bb", now.Add(-time.Minute), 2))
+	s.Record(sampleAt("aa", now.Add(-time.Minute), 3))
+
+	// A shelf whose samples all expired after it stopped reporting is no longer exported.
+	s.Record(sampleAt("cc", now.Add(-9*time.Minute), 4))
+	s.now = func() time.Time { return now.Add(2 * time.Minute) }
+
+	latest := s.Latest()
+	require.Len(t, latest, 2)
+	assert.Equal(t, "aa", latest[0].PmcMAC)
+	assert.Equal(t, "bb", latest[1].PmcMAC)
+	assert.Equal(t, 2.0, latest[1].Readings[OutputPowerWatts])
+}
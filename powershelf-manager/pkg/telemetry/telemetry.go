@@ -0,0 +1,234 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package telemetry extracts power, voltage, current, temperature and fan readings from powershelf snapshots,
+// keeps a bounded history per shelf, and exports the latest readings as Prometheus metrics.
+package telemetry
+
+import (
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powersupply"
+
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Metric identifies a telemetry reading. The value doubles as the Prometheus metric name suffix.
+type Metric string
+
+const (
+	InputPowerWatts    Metric = "input_power_watts"
+	OutputPowerWatts   Metric = "output_power_watts"
+	InputVoltageVolts  Metric = "input_voltage_volts"
+	OutputVoltageVolts Metric = "output_voltage_volts"
+	InputCurrentAmps   Metric = "input_current_amps"
+	OutputCurrentAmps  Metric = "output_current_amps"
+	TemperatureCelsius Metric = "temperature_celsius"
+	FanSpeedRPM        Metric = "fan_speed_rpm"
+)
+
+// Metrics lists all telemetry metrics in a stable order.
+var Metrics = []Metric{
+	InputPowerWatts,
+	OutputPowerWatts,
+	InputVoltageVolts,
+	OutputVoltageVolts,
+	InputCurrentAmps,
+	OutputCurrentAmps,
+	TemperatureCelsius,
+	FanSpeedRPM,
+}
+
+// aggregation describes how multiple readings of the same metric are combined.
+type aggregation int
+
+const (
+	aggregateSum aggregation = iota
+	aggregateMean
+	aggregateMax
+)
+
+// aggregationOf returns how readings of m are combined across sensors of a PSU and across PSUs of a shelf:
+// power and current add up, voltages are averaged, and temperatures and fan speeds report the hottest/fastest.
+func aggregationOf(m Metric) aggregation {
+	switch m {
+	case InputVoltageVolts, OutputVoltageVolts:
+		return aggregateMean
+	case TemperatureCelsius, FanSpeedRPM:
+		return aggregateMax
+	default:
+		return aggregateSum
+	}
+}
+
+// Readings holds the available readings of a PSU or shelf. Metrics without a sensor are absent.
+type Readings map[Metric]float64
+
+// PowerSupplySample is the telemetry of a single PSU at a point in time.
+type PowerSupplySample struct {
+	ID         string
+	Name       string
+	PowerState bool
+	Health     string
+	Readings   Readings
+}
+
+// ShelfSample is the telemetry of a powershelf and its PSUs at a point in time.
+type ShelfSample struct {
+	PmcMAC        string
+	Vendor        vendor.Vendor
+	Rack          string
+	Timestamp     time.Time
+	Readings      Readings
+	PowerSupplies []PowerSupplySample
+	// PowerLimitWatts is the shelf power cap; nil if the PMC does not support power capping, zero if capping is disabled.
+	PowerLimitWatts *float64
+}
+
+// FromPowerShelf builds a ShelfSample from a powershelf snapshot collected at the given time.
+func FromPowerShelf(ps *powershelf.PowerShelf, at time.Time) *ShelfSample {
+	sample := &ShelfSample{
+		Timestamp:       at,
+		Rack:            rackOf(ps),
+		PowerSupplies:   make([]PowerSupplySample, 0, len(ps.PowerSupplies)),
+		PowerLimitWatts: ps.PowerLimitWatts,
+	}
+
+	if ps.PMC != nil {
+		sample.PmcMAC = ps.PMC.GetMac().String()
+		sample.Vendor = ps.PMC.GetVendor()
+	}
+
+	perPSU := make([]Readings, 0, len(ps.PowerSupplies))
+	for _, psu := range ps.PowerSupplies {
+		if psu == nil {
+			continue
+		}
+		psuSample := powerSupplySample(psu)
+		sample.PowerSupplies = append(sample.PowerSupplies, psuSample)
+		perPSU = append(perPSU, psuSample.Readings)
+	}
+	sample.Readings = aggregate(perPSU)
+
+	return sample
+}
+
+func powerSupplySample(psu *powersupply.PowerSupply) PowerSupplySample {
+	perSensor := make([]Readings, 0, len(psu.Sensors))
+	for _, sensor := range psu.Sensors {
+		if sensor == nil {
+			continue
+		}
+		if m, ok := classifySensor(sensor); ok {
+			perSensor = append(perSensor, Readings{m: float64(sensor.Reading)})
+		}
+	}
+
+	return PowerSupplySample{
+		ID:         psu.ID,
+		Name:       psu.Name,
+		PowerState: psu.PowerState,
+		Health:     string(psu.Status.Health),
+		Readings:   aggregate(perSensor),
+	}
+}
+
+// aggregate combines several Readings using each metric's aggregation.
+func aggregate(all []Readings) Readings {
+	sums := Readings{}
+	counts := map[Metric]int{}
+	for _, readings := range all {
+		for m, v := range readings {
+			switch aggregationOf(m) {
+			case aggregateMax:
+				if cur, ok := sums[m]; !ok || v > cur {
+					sums[m] = v
+				}
+			default:
+				sums[m] += v
+			}
+			counts[m]++
+		}
+	}
+
+	for m := range sums {
+		if aggregationOf(m) == aggregateMean {
+			sums[m] /= float64(counts[m])
+		}
+	}
+
+	return sums
+}
+
+// classifySensor maps a Redfish sensor to a Metric based on its ReadingType and, for electrical readings,
+// whether its ID or name refers to the input or output side. Unclassified electrical readings are
+// assumed to be output power/current and input voltage, which is what PSUs usually report.
+func classifySensor(sensor *redfish.Sensor) (Metric, bool) {
+	label := strings.ToLower(sensor.ID + " " + sensor.Name)
+	input := strings.Contains(label, "input") || strings.Contains(label, "vin") ||
+		strings.Contains(label, "pin") || strings.Contains(label, "iin")
+	output := strings.Contains(label, "output") || strings.Contains(label, "vout") ||
+		strings.Contains(label, "pout") || strings.Contains(label, "iout")
+
+	switch sensor.ReadingType {
+	case redfish.PowerReadingType:
+		if input && !output {
+			return InputPowerWatts, true
+		}
+		return OutputPowerWatts, true
+	case redfish.VoltageReadingType:
+		if output && !input {
+			return OutputVoltageVolts, true
+		}
+		return InputVoltageVolts, true
+	case redfish.CurrentReadingType:
+		if input && !output {
+			return InputCurrentAmps, true
+		}
+		return OutputCurrentAmps, true
+	case redfish.TemperatureReadingType:
+		return TemperatureCelsius, true
+	case redfish.RotationalReadingType:
+		return FanSpeedRPM, true
+	}
+
+	if strings.EqualFold(sensor.ReadingUnits, "RPM") {
+		return FanSpeedRPM, true
+	}
+
+	return "", false
+}
+
+// rackOf returns the rack reported in the chassis placement, falling back to the manager placement.
+func rackOf(ps *powershelf.PowerShelf) string {
+	if ps.Chassis != nil && ps.Chassis.Location.Placement.Rack != "" {
+		return ps.Chassis.Location.Placement.Rack
+	}
+	if ps.Manager != nil {
+		return ps.Manager.Location.Placement.Rack
+	}
+	return ""
+}
+
+// healthOK reports whether a PSU health string denotes a healthy PSU.
+func healthOK(health string) bool {
+	return health == "" || health == string(common.OKHealth)
+}
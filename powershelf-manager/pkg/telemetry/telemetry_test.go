@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/common/vendor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/pmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powershelf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/powershelf-manager/pkg/objects/powersupply"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSensor(id string, readingType redfish.ReadingType, units string, reading float32) *redfish.Sensor {
+	s := &redfish.Sensor{ReadingType: readingType, ReadingUnits: units, Reading: reading}
+	s.ID = id
+	return s
+}
+
+func newPowerShelf(t *testing.T, mac string, psus ...*powersupply.PowerSupply) *powershelf.PowerShelf {
+	t.Helper()
+
+	cred := credential.New("root", "password")
+	p, err := pmc.New(mac, "10.0.0.1", vendor.VendorCodeLiteon, &cred)
+	require.NoError(t, err)
+
+	return &powershelf.PowerShelf{PMC: p, PowerSupplies: psus}
+}
+
+func TestClassifySensor(t *testing.T) {
+	tests := map[string]struct {
+		sensor   *redfish.Sensor
+		expected Metric
+		ok       bool
+	}{
+		"input power":           {sensor: newSensor("PSU0_InputPower", redfish.PowerReadingType, "W", 1), expected: InputPowerWatts, ok: true},
+		"pin power":             {sensor: newSensor("PSU0_PIN", redfish.PowerReadingType, "W", 1), expected: InputPowerWatts, ok: true},
+		"output power":          {sensor: newSensor("PSU0_OutputPower", redfish.PowerReadingType, "W", 1), expected: OutputPowerWatts, ok: true},
+		"unqualified power":     {sensor: newSensor("PSU0_Power", redfish.PowerReadingType, "W", 1), expected: OutputPowerWatts, ok: true},
+		"input voltage":         {sensor: newSensor("PSU0_InputVoltage", redfish.VoltageReadingType, "V", 1), expected: InputVoltageVolts, ok: true},
+		"vout voltage":          {sensor: newSensor("PSU0_VOUT", redfish.VoltageReadingType, "V", 1), expected: OutputVoltageVolts, ok: true},
+		"unqualified voltage":   {sensor: newSensor("PSU0_Voltage", redfish.VoltageReadingType, "V", 1), expected: InputVoltageVolts, ok: true},
+		"input current":         {sensor: newSensor("PSU0_InputCurrent", redfish.CurrentReadingType, "A", 1), expected: InputCurrentAmps, ok: true},
+		"output current":        {sensor: newSensor("PSU0_IOUT", redfish.CurrentReadingType, "A", 1), expected: OutputCurrentAmps, ok: true},
+		"temperature":           {sensor: newSensor("PSU0_Temperature", redfish.TemperatureReadingType, "Cel", 1), expected: TemperatureCelsius, ok: true},
+		"fan by reading type":   {sensor: newSensor("PSU0_Fan", redfish.RotationalReadingType, "RPM", 1), expected: FanSpeedRPM, ok: true},
+		"fan by units":          {sensor: newSensor("PSU0_Fan", "", "RPM", 1), expected: FanSpeedRPM, ok: true},
+		"unsupported frequency": {sensor: newSensor("PSU0_Frequency", redfish.FrequencyReadingType, "Hz", 1), ok: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m, ok := classifySensor(tc.sensor)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, m)
+		})
+	}
+}
+
+func TestFromPowerShelf(t *testing.T) {
+	psu := func(id string, power, voltage, temperature float32, health common.Health) *powersupply.PowerSupply {
+		p := &powersupply.PowerSupply{
+			PowerState: true,
+			Status:     common.Status{Health: health},
+			Sensors: []*redfish.Sensor{
+				newSensor(id+"_OutputPower", redfish.PowerReadingType, "W", power),
+				newSensor(id+"_InputVoltage", redfish.VoltageReadingType, "V", voltage),
+				newSensor(id+"_Temperature", redfish.TemperatureReadingType, "Cel", temperature),
+			},
+		}
+		p.ID = id
+		return p
+	}
+
+	ps := newPowerShelf(t, "00:11:22:33:44:55",
+		psu("PSU0", 2000, 228, 30, common.OKHealth),
+		psu("PSU1", 1500, 232, 40, common.WarningHealth),
+		nil,
+	)
+	ps.Chassis = &redfish.Chassis{}
+	ps.Chassis.Location.Placement.Rack = "rack-01"
+	limit := 9000.0
+	ps.PowerLimitWatts = &limit
+
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	sample := FromPowerShelf(ps, at)
+
+	assert.Equal(t, "00:11:22:33:44:55", sample.PmcMAC)
+	assert.Equal(t, vendor.VendorCodeLiteon, sample.Vendor.Code)
+	assert.Equal(t, "rack-01", sample.Rack)
+	assert.Equal(t, at, sample.Timestamp)
+	require.NotNil(t, sample.PowerLimitWatts)
+	assert.Equal(t, 9000.0, *sample.PowerLimitWatts)
+	require.Len(t, sample.PowerSupplies, 2)
+
+	assert.Equal(t, Readings{OutputPowerWatts: 2000, InputVoltageVolts: 228, TemperatureCelsius: 30}, sample.PowerSupplies[0].Readings)
+	assert.Equal(t, "Warning", sample.PowerSupplies[1].Health)
+
+	// Power is summed, voltage averaged and temperature maximised across PSUs.
+	assert.Equal(t, Readings{OutputPowerWatts: 3500, InputVoltageVolts: 230, TemperatureCelsius: 40}, sample.Readings)
+}
+
+func TestRackOf(t *testing.T) {
+	withChassis := &powershelf.PowerShelf{Chassis: &redfish.Chassis{}, Manager: &redfish.Manager{}}
+	withChassis.Chassis.Location.Placement.Rack = "chassis-rack"
+	withChassis.Manager.Location.Placement.Rack = "manager-rack"
+
+	withManager := &powershelf.PowerShelf{Chassis: &redfish.Chassis{}, Manager: &redfish.Manager{}}
+	withManager.Manager.Location.Placement.Rack = "manager-rack"
+
+	assert.Equal(t, "chassis-rack", rackOf(withChassis))
+	assert.Equal(t, "manager-rack", rackOf(withManager))
+	assert.Equal(t, "", rackOf(&powershelf.PowerShelf{}))
+}
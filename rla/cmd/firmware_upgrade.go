@@ -65,6 +65,9 @@ Examples:
 
   # Upgrade with explicit time window
   rla firmware upgrade --rack-names "rack-name-1" --type compute --start "2025-01-02T03:04:05" --end "2025-01-02T06:04:05"
+
+  # Show the execution plan without submitting anything
+  rla firmware upgrade --rack-names "rack-name-1" --type compute --dry-run
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			doFirmwareUpgrade()
@@ -77,6 +80,7 @@ Examples:
 	firmwareUpgradeComponentType string
 	firmwareUpgradeStartTime     string
 	firmwareUpgradeEndTime       string
+	firmwareUpgradeDryRun        bool
 )
 
 func init() {
@@ -88,6 +92,7 @@ func init() {
 	firmwareUpgradeCmd.Flags().StringVarP(&firmwareUpgradeComponentType, "type", "t", "", "Component type: compute, nvlswitch, powershelf (required for rack-ids/rack-names)")
 	firmwareUpgradeCmd.Flags().StringVarP(&firmwareUpgradeStartTime, "start", "s", "", "Start time (default: now)")
 	firmwareUpgradeCmd.Flags().StringVarP(&firmwareUpgradeEndTime, "end", "e", "", "End time (default: start + 24h)")
+	firmwareUpgradeCmd.Flags().BoolVar(&firmwareUpgradeDryRun, "dry-run", false, "Print the execution plan without submitting any task")
 }
 
 // parseTimeString parses time string in the following formats:
@@ -111,7 +116,8 @@ func parseTimeString(s string) (time.Time, error) {
 
 // doFirmwareUpgrade validates the CLI inputs, resolves the upgrade time window,
 // and calls the appropriate UpgradeFirmware client method based on whether the
-// caller specified rack IDs, rack names, or component IDs.
+// caller specified rack IDs, rack names, or component IDs. With --dry-run it
+// prints the execution plan instead.
 func doFirmwareUpgrade() {
 	// Validate inputs - only one of the three options can be specified
 	hasRackIDs := firmwareUpgradeRackIDs != ""
@@ -182,6 +188,7 @@ func doFirmwareUpgrade() {
 
 	// Execute based on the specified option
 	var result *client.UpgradeFirmwareResult
+	var plan *client.PlanResult
 
 	switch {
 	case hasComponentIDs:
@@ -194,7 +201,11 @@ func doFirmwareUpgrade() {
 			Time("start_time", startTime).
 			Time("end_time", endTime).
 			Msg("Upgrading firmware by component IDs")
-		result, err = rlaClient.UpgradeFirmwareByMachineIDs(ctx, componentIDs, &startTime, &endTime)
+		if firmwareUpgradeDryRun {
+			plan, err = rlaClient.PlanUpgradeFirmwareByMachineIDs(ctx, componentIDs, &startTime, &endTime)
+		} else {
+			result, err = rlaClient.UpgradeFirmwareByMachineIDs(ctx, componentIDs, &startTime, &endTime)
+		}
 
 	case hasRackIDs:
 		rackIDs := parseUUIDList(firmwareUpgradeRackIDs)
@@ -207,7 +218,11 @@ func doFirmwareUpgrade() {
 			Time("start_time", startTime).
 			Time("end_time", endTime).
 			Msg("Upgrading firmware by rack IDs")
-		result, err = rlaClient.UpgradeFirmwareByRackIDs(ctx, rackIDs, componentType, &startTime, &endTime)
+		if firmwareUpgradeDryRun {
+			plan, err = rlaClient.PlanUpgradeFirmwareByRackIDs(ctx, rackIDs, componentType, &startTime, &endTime)
+		} else {
+			result, err = rlaClient.UpgradeFirmwareByRackIDs(ctx, rackIDs, componentType, &startTime, &endTime)
+		}
 
 	case hasRackNames:
 		rackNames := parseCommaSeparatedList(firmwareUpgradeRackNames)
@@ -220,13 +235,22 @@ func doFirmwareUpgrade() {
 			Time("start_time", startTime).
 			Time("end_time", endTime).
 			Msg("Upgrading firmware by rack names")
-		result, err = rlaClient.UpgradeFirmwareByRackNames(ctx, rackNames, componentType, &startTime, &endTime)
+		if firmwareUpgradeDryRun {
+			plan, err = rlaClient.PlanUpgradeFirmwareByRackNames(ctx, rackNames, componentType, &startTime, &endTime)
+		} else {
+			result, err = rlaClient.UpgradeFirmwareByRackNames(ctx, rackNames, componentType, &startTime, &endTime)
+		}
 	}
 
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to upgrade firmware")
 	}
 
+	if firmwareUpgradeDryRun {
+		printOperationPlans(plan.Plans)
+		return
+	}
+
 	taskIDStrs := make([]string, 0, len(result.TaskIDs))
 	for _, id := range result.TaskIDs {
 		taskIDStrs = append(taskIDStrs, id.String())
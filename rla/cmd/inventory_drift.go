@@ -0,0 +1,283 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/client"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/types"
+)
+
+// driftCmd is the parent command for reviewing the drifts found by the
+// inventory sync.
+var driftCmd = &cobra.Command{
+	Use:   "drift",
+	Short: "Review inventory drift",
+	Long: `Review the differences the inventory sync found between the RLA inventory
+and the component manager services (Carbide, PSM, NSM).
+
+A drift can be accepted, which writes the observed values into the inventory,
+or dismissed with a reason, which hides it until the observed values change.`,
+}
+
+var driftListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List inventory drifts",
+	Long: `List the open drifts found by the inventory sync.
+
+Examples:
+  # List all open drifts
+  rla inventory drift list
+
+  # List serial number and position mismatches of compute trays in one rack
+  rla inventory drift list --rack-id <uuid> --type mismatch --component-type compute
+
+  # Include dismissed drifts
+  rla inventory drift list --all`,
+	Args: cobra.NoArgs,
+	RunE: runDriftList,
+}
+
+var driftAcceptCmd = &cobra.Command{
+	Use:   "accept <drift-id>",
+	Short: "Accept the observed values of a drift into the inventory",
+	Long: `Write the values reported by the component manager service into the
+expected component and remove the drift. Only mismatch drifts can be accepted.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDriftAccept,
+}
+
+var driftDismissCmd = &cobra.Command{
+	Use:   "dismiss <drift-id>",
+	Short: "Dismiss a drift",
+	Long: `Mark a drift as reviewed. The drift stays dismissed as long as the inventory
+sync keeps finding the same difference.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDriftDismiss,
+}
+
+var driftResyncCmd = &cobra.Command{
+	Use:   "resync <rack-id>",
+	Short: "Run the inventory sync for one rack",
+	Long: `Compare the components of one rack against the component manager services
+now instead of waiting for the next scheduled inventory sync, and list the
+rack's open drifts.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDriftResync,
+}
+
+var (
+	driftListRackID         string
+	driftListTypes          []string
+	driftListComponentTypes []string
+	driftListAll            bool
+
+	driftDismissReason string
+	driftDismissBy     string
+)
+
+func init() {
+	inventoryCmd.AddCommand(driftCmd)
+	driftCmd.AddCommand(driftListCmd)
+	driftCmd.AddCommand(driftAcceptCmd)
+	driftCmd.AddCommand(driftDismissCmd)
+	driftCmd.AddCommand(driftResyncCmd)
+
+	driftListCmd.Flags().StringVar(&driftListRackID, "rack-id", "", "Only list drifts of this rack")
+	driftListCmd.Flags().StringSliceVar(&driftListTypes, "type", nil, "Drift types to list (missing-in-expected, missing-in-actual, mismatch)")
+	driftListCmd.Flags().StringSliceVar(&driftListComponentTypes, "component-type", nil, "Component types to list (compute, nvlswitch, powershelf, cdu)")
+	driftListCmd.Flags().BoolVar(&driftListAll, "all", false, "Include dismissed drifts")
+
+	driftDismissCmd.Flags().StringVar(&driftDismissReason, "reason", "", "Why the drift is dismissed (required)")
+	driftDismissCmd.Flags().StringVar(&driftDismissBy, "by", "", "Who dismisses the drift")
+	_ = driftDismissCmd.MarkFlagRequired("reason")
+}
+
+// parseDriftType converts a CLI drift type to types.DriftType.
+func parseDriftType(s string) types.DriftType {
+	switch strings.ToLower(s) {
+	case "missing-in-expected":
+		return types.DriftTypeMissingInExpected
+	case "missing-in-actual":
+		return types.DriftTypeMissingInActual
+	case "mismatch":
+		return types.DriftTypeMismatch
+	default:
+		return types.DriftTypeUnknown
+	}
+}
+
+// runDriftList is the RunE handler for driftListCmd.
+func runDriftList(cmd *cobra.Command, args []string) error {
+	filter := types.DriftFilter{
+		IncludeDismissed: driftListAll,
+	}
+	if driftListRackID != "" {
+		rackID, err := uuid.Parse(driftListRackID)
+		if err != nil {
+			return fmt.Errorf("invalid rack ID: %w", err)
+		}
+		filter.RackID = rackID
+	}
+	for _, s := range driftListTypes {
+		t := parseDriftType(strings.TrimSpace(s))
+		if t == types.DriftTypeUnknown {
+			return fmt.Errorf("invalid drift type: %s (must be missing-in-expected, missing-in-actual or mismatch)", s)
+		}
+		filter.Types = append(filter.Types, t)
+	}
+	for _, s := range driftListComponentTypes {
+		ct := parseComponentTypeToTypes(strings.TrimSpace(s))
+		if ct == types.ComponentTypeUnknown {
+			return fmt.Errorf("invalid component type: %s", s)
+		}
+		filter.ComponentTypes = append(filter.ComponentTypes, ct)
+	}
+
+	rlaClient, err := client.New(newGlobalClientConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	drifts, err := rlaClient.ListDrifts(context.Background(), filter)
+	if err != nil {
+		return fmt.Errorf("failed to list drifts: %w", err)
+	}
+
+	return printDrifts(drifts)
+}
+
+// runDriftAccept is the RunE handler for driftAcceptCmd.
+func runDriftAccept(cmd *cobra.Command, args []string) error {
+	driftID, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid drift ID: %w", err)
+	}
+
+	rlaClient, err := client.New(newGlobalClientConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	comp, err := rlaClient.AcceptDrift(context.Background(), driftID)
+	if err != nil {
+		return fmt.Errorf("failed to accept drift: %w", err)
+	}
+
+	fmt.Printf("Drift %s accepted, component %s updated\n", driftID, comp.Info.ID)
+	return nil
+}
+
+// runDriftDismiss is the RunE handler for driftDismissCmd.
+func runDriftDismiss(cmd *cobra.Command, args []string) error {
+	driftID, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid drift ID: %w", err)
+	}
+	if strings.TrimSpace(driftDismissReason) == "" {
+		return fmt.Errorf("--reason must not be empty")
+	}
+
+	rlaClient, err := client.New(newGlobalClientConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	if _, err := rlaClient.DismissDrift(context.Background(), driftID, driftDismissReason, driftDismissBy); err != nil {
+		return fmt.Errorf("failed to dismiss drift: %w", err)
+	}
+
+	fmt.Printf("Drift %s dismissed\n", driftID)
+	return nil
+}
+
+// runDriftResync is the RunE handler for driftResyncCmd.
+func runDriftResync(cmd *cobra.Command, args []string) error {
+	rackID, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid rack ID: %w", err)
+	}
+
+	rlaClient, err := client.New(newGlobalClientConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	drifts, err := rlaClient.ResyncRack(context.Background(), rackID)
+	if err != nil {
+		return fmt.Errorf("failed to resync rack: %w", err)
+	}
+
+	return printDrifts(drifts)
+}
+
+// printDrifts prints drifts as a table, one line per field difference.
+func printDrifts(drifts []*types.ComponentDrift) error {
+	if len(drifts) == 0 {
+		fmt.Println("No drifts found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTYPE\tCOMPONENT\tRACK\tSTATUS\tFIELD\tEXPECTED\tACTUAL\tCHECKED")
+	for _, d := range drifts {
+		component := d.ExternalID
+		if d.ComponentID != uuid.Nil {
+			component = fmt.Sprintf("%s %s", d.ComponentType, d.ComponentID)
+		} else if component == "" {
+			component = "-"
+		}
+
+		rack := "-"
+		if d.RackID != uuid.Nil {
+			rack = d.RackID.String()
+		}
+
+		status := string(d.Status)
+		if d.Status == types.DriftStatusDismissed && d.DismissReason != "" {
+			status = fmt.Sprintf("%s (%s)", d.Status, d.DismissReason)
+		}
+
+		checked := d.CheckedAt.Local().Format("2006-01-02 15:04:05")
+
+		if len(d.FieldDiffs) == 0 {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t-\t-\t-\t%s\n",
+				d.ID, d.Type, component, rack, status, checked)
+			continue
+		}
+		for _, fd := range d.FieldDiffs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				d.ID, d.Type, component, rack, status,
+				fd.FieldName, fd.ExpectedValue, fd.ActualValue, checked)
+		}
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,88 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/types"
+)
+
+// printOperationPlans prints the execution plan returned for a --dry-run
+// request: per rack, the resolved rule, whether the task would run, queue or
+// be rejected, and the stage/batch timeline with its estimated duration.
+func printOperationPlans(plans []*types.OperationPlan) {
+	for i, plan := range plans {
+		if i > 0 {
+			fmt.Println()
+		}
+		printOperationPlan(plan)
+	}
+
+	fmt.Println()
+	fmt.Println("Dry run: nothing was submitted.")
+}
+
+func printOperationPlan(plan *types.OperationPlan) {
+	fmt.Printf("Rack:            %s (%s)\n", plan.RackName, plan.RackID)
+	fmt.Printf("Operation:       %s\n", plan.Operation)
+	if plan.Rule != nil {
+		ruleID := "built-in"
+		if plan.Rule.ID != uuid.Nil {
+			ruleID = plan.Rule.ID.String()
+		}
+		fmt.Printf("Rule:            %s (%s, source: %s)\n",
+			plan.Rule.Name, ruleID, strings.ToLower(string(plan.RuleSource)))
+	}
+
+	outcome := strings.ToLower(string(plan.Outcome))
+	if plan.Reason != "" {
+		outcome += ": " + plan.Reason
+	}
+	fmt.Printf("Outcome:         %s\n", outcome)
+	fmt.Printf("Estimated Time:  %s\n", plan.EstimatedDuration)
+
+	for _, t := range plan.ConflictingTasks {
+		fmt.Printf("Conflicts With:  %s (%s, %s)\n",
+			t.ID, t.Operation, strings.ToLower(string(t.Status)))
+	}
+
+	for _, stage := range plan.Stages {
+		fmt.Printf("  Stage %d (%s)\n", stage.Number, stage.EstimatedDuration)
+		for _, step := range stage.Steps {
+			fmt.Printf("    %s: %s (%s)\n",
+				strings.ToLower(string(step.ComponentType)),
+				strings.Join(step.Actions, " -> "),
+				step.EstimatedDuration)
+			for j, batch := range step.Batches {
+				ids := make([]string, 0, len(batch))
+				for _, id := range batch {
+					ids = append(ids, id.String())
+				}
+				fmt.Printf("      Batch %d: %s\n", j+1, strings.Join(ids, ", "))
+			}
+		}
+	}
+
+	for _, w := range plan.Warnings {
+		fmt.Printf("Warning:         %s\n", w)
+	}
+}
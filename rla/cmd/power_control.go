@@ -73,6 +73,9 @@ Examples:
 
   # Power control by component IDs (no --type needed)
   rla power control --component-ids "machine1,machine2" --op restart
+
+  # Show the execution plan without submitting anything
+  rla power control --rack-names "rack-name-1" --type powershelf --op off --dry-run
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			doPowerControl()
@@ -84,6 +87,7 @@ Examples:
 	powerControlComponentIDs  string
 	powerControlComponentType string
 	powerControlOp            string
+	powerControlDryRun        bool
 )
 
 func init() {
@@ -95,6 +99,8 @@ func init() {
 	powerControlCmd.Flags().StringVarP(&powerControlComponentType, "type", "t", "", "Component type: compute, nvlswitch, powershelf (required for rack-ids/rack-names)")
 	powerControlCmd.Flags().StringVar(&powerControlOp, "op", "", "Power operation: on, off, force-off, reset, force-reset, ac-powercycle")
 
+	powerControlCmd.Flags().BoolVar(&powerControlDryRun, "dry-run", false, "Print the execution plan without submitting any task")
+
 	powerControlCmd.MarkFlagRequired("op") //nolint
 }
 
@@ -129,7 +135,8 @@ func parsePowerOpToTypes(op string) types.PowerControlOp {
 
 // doPowerControl validates the CLI inputs and calls the appropriate
 // PowerControl client method based on whether the caller specified rack IDs,
-// rack names, or component IDs.
+// rack names, or component IDs. With --dry-run it prints the execution plan
+// instead.
 func doPowerControl() {
 	// Validate inputs - only one of the options can be specified
 	hasRackIDs := powerControlRackIDs != ""
@@ -178,6 +185,7 @@ func doPowerControl() {
 
 	// Execute based on the specified option
 	var result *client.PowerControlResult
+	var plan *client.PlanResult
 
 	switch {
 	case hasRackIDs:
@@ -190,7 +198,11 @@ func doPowerControl() {
 			Str("component_type", powerControlComponentType).
 			Str("operation", powerControlOp).
 			Msg("Executing power control by rack IDs")
-		result, err = rlaClient.PowerControlByRackIDs(ctx, rackIDs, componentType, op)
+		if powerControlDryRun {
+			plan, err = rlaClient.PlanPowerControlByRackIDs(ctx, rackIDs, componentType, op)
+		} else {
+			result, err = rlaClient.PowerControlByRackIDs(ctx, rackIDs, componentType, op)
+		}
 
 	case hasRackNames:
 		rackNames := parseCommaSeparatedList(powerControlRackNames)
@@ -202,7 +214,11 @@ func doPowerControl() {
 			Str("component_type", powerControlComponentType).
 			Str("operation", powerControlOp).
 			Msg("Executing power control by rack names")
-		result, err = rlaClient.PowerControlByRackNames(ctx, rackNames, componentType, op)
+		if powerControlDryRun {
+			plan, err = rlaClient.PlanPowerControlByRackNames(ctx, rackNames, componentType, op)
+		} else {
+			result, err = rlaClient.PowerControlByRackNames(ctx, rackNames, componentType, op)
+		}
 
 	case hasComponentIDs:
 		componentIDs := parseCommaSeparatedList(powerControlComponentIDs)
@@ -213,13 +229,22 @@ func doPowerControl() {
 			Strs("component_ids", componentIDs).
 			Str("operation", powerControlOp).
 			Msg("Executing power control by component IDs")
-		result, err = rlaClient.PowerControlByMachineIDs(ctx, componentIDs, op)
+		if powerControlDryRun {
+			plan, err = rlaClient.PlanPowerControlByMachineIDs(ctx, componentIDs, op)
+		} else {
+			result, err = rlaClient.PowerControlByMachineIDs(ctx, componentIDs, op)
+		}
 	}
 
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to execute power control")
 	}
 
+	if powerControlDryRun {
+		printOperationPlans(plan.Plans)
+		return
+	}
+
 	// Log results
 	taskIDStrs := make([]string, 0, len(result.TaskIDs))
 	for _, id := range result.TaskIDs {
@@ -33,8 +33,10 @@ import (
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	inventorystore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/store"
 	svc "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/service"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager"
+	cduredfish "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/cdu/redfish"
 	computecarbide "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/compute/carbide"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/mock"
 	nvlswitchcarbide "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/nvlswitch/carbide"
@@ -42,19 +44,25 @@ import (
 	powershelfcarbide "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/powershelf/carbide"
 	powershelfpsm "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/powershelf/psm"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/carbide"
+	cduprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/cdu"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvswitchmanager"
+	nvueprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvue"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/psm"
+	torswitchnvue "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/torswitch/nvue"
 	temporalmanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/manager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/torapi"
 	pkgcerts "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/certs"
 )
 
 const (
 	defaultServicePort    = 50051
+	defaultMetricsPort    = 9090
 	componentMgrCfgEnvVar = "COMPONENT_MANAGER_CONFIG"
 )
 
 var (
 	port               int
+	metricsPort        int
 	componentMgrConfig string
 	devMode            bool
 
@@ -90,13 +98,20 @@ func init() {
 	}
 
 	serveCmd.Flags().IntVarP(&port, "listen-port", "p", defaultServicePort, "Port for the gRPC server") //nolint:lll
+	serveCmd.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Port for the Prometheus /metrics endpoint, 0 to disable")
 	// Component manager config: priority is CLI flag > env var > default prod config
 	serveCmd.Flags().StringVarP(&componentMgrConfig, "component-config", "c", "", "Path to component manager config file (YAML)")               //nolint:lll
 	serveCmd.Flags().BoolVar(&devMode, "dev-mode", false, "Enable developer options (gRPC reflection, debug logging). Not for production use.") //nolint:lll
 }
 
 // initProviderRegistry creates and initializes the provider registry based on configuration.
-func initProviderRegistry(config componentmanager.Config) (*componentmanager.ProviderRegistry, error) {
+// The CDU and NVUE providers look up BMCs in the inventory, so each opens
+// its own database session from dbConf.
+func initProviderRegistry(
+	ctx context.Context,
+	config componentmanager.Config,
+	dbConf cdb.Config,
+) (*componentmanager.ProviderRegistry, error) {
 	providerRegistry := componentmanager.NewProviderRegistry()
 
 	// Initialize Carbide provider if configured
@@ -138,6 +153,55 @@ func initProviderRegistry(config componentmanager.Config) (*componentmanager.Pro
 		}
 	}
 
+	// Initialize CDU provider if configured
+	if config.Providers.CDU != nil {
+		session, err := cdb.NewSessionFromConfig(ctx, dbConf)
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to open inventory for CDU provider (CDU operations may not work)")
+		} else {
+			resolver := cduprovider.NewInventoryResolver(inventorystore.NewPostgres(session))
+			cduProvider, err := cduprovider.New(*config.Providers.CDU, resolver)
+			if err != nil {
+				session.Close()
+				log.Warn().Err(err).Msg("Unable to create CDU client (CDU operations may not work)")
+			} else {
+				providerRegistry.Register(cduProvider)
+				log.Info().
+					Dur("timeout", config.Providers.CDU.Timeout).
+					Msg("Initialized CDU provider")
+			}
+		}
+	}
+
+	// Initialize NVUE provider for ToR switches if configured
+	if config.Providers.NVUE != nil {
+		session, err := cdb.NewSessionFromConfig(ctx, dbConf)
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to open inventory for NVUE provider (ToR switch operations may not work)")
+		} else {
+			resolver := nvueprovider.NewInventoryResolver(
+				inventorystore.NewPostgres(session),
+				config.Providers.NVUE.NVUEPort,
+			)
+			nvueProvider, err := nvueprovider.New(
+				*config.Providers.NVUE,
+				resolver,
+				torapi.NewPostgresInstallStore(session),
+			)
+			if err != nil {
+				session.Close()
+				log.Warn().Err(err).Msg("Unable to create ToR switch client (ToR switch operations may not work)")
+			} else {
+				providerRegistry.Register(nvueProvider)
+				log.Info().
+					Dur("timeout", config.Providers.NVUE.Timeout).
+					Int("nvue_port", config.Providers.NVUE.NVUEPort).
+					Bool("insecure_skip_verify", config.Providers.NVUE.InsecureSkipVerify).
+					Msg("Initialized NVUE provider")
+			}
+		}
+	}
+
 	// Log all registered providers
 	registeredProviders := providerRegistry.List()
 	log.Info().
@@ -161,6 +225,8 @@ func initComponentManagerRegistry(config componentmanager.Config, providerRegist
 	nvlswitchnsm.Register(registry)
 	powershelfcarbide.Register(registry)
 	powershelfpsm.Register(registry)
+	cduredfish.Register(registry)
+	torswitchnvue.Register(registry)
 	mock.RegisterAll(registry)
 
 	// Initialize registry with the config and providers
@@ -255,8 +321,10 @@ func doServe() {
 		log.Fatal().Msgf("failed to load component manager config: %v", err)
 	}
 
+	ctx := context.Background()
+
 	// Initialize provider registry (creates API clients based on config)
-	providerRegistry, err := initProviderRegistry(cmConfig)
+	providerRegistry, err := initProviderRegistry(ctx, cmConfig, dbConf)
 	if err != nil {
 		log.Fatal().Msgf("failed to initialize provider registry: %v", err)
 	}
@@ -275,8 +343,6 @@ func doServe() {
 		ComponentManagerRegistry: cmRegistry,
 	}
 
-	ctx := context.Background()
-
 	if os.Getenv("REPORT_CARBIDE_API_VERSION") != "" {
 		// Do some basic carbide-api requests, mainly for early testing; this code can be removed when we're doing actual communication
 		go func() {
@@ -311,6 +377,7 @@ func doServe() {
 		ctx,
 		svc.Config{
 			Port:             port,
+			MetricsPort:      metricsPort,
 			DBConf:           dbConf,
 			ExecutorConf:     &temporalManagerConf,
 			RLAConfig:        rlaConfig,
@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/client"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/types"
+)
+
+var taskReportCmd = &cobra.Command{
+	Use:   "report <task-id>",
+	Short: "Show the report of a task",
+	Long: `Show what a task did to each component: every action with its stage,
+step, outcome and message, followed by how the task finished.
+
+Use --format json or --format markdown to export the report, for example to
+attach it to an incident ticket with --output.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTaskReport,
+}
+
+var (
+	taskReportFormat string
+	taskReportOutput string
+)
+
+func init() {
+	taskCmd.AddCommand(taskReportCmd)
+
+	taskReportCmd.Flags().StringVar(&taskReportFormat, "format", "table", "Output format (table, json, markdown)")
+	taskReportCmd.Flags().StringVarP(&taskReportOutput, "output", "o", "", "Write the report to this file instead of stdout")
+}
+
+// runTaskReport is the RunE handler for taskReportCmd. It fetches the report
+// of the task and prints it as a table or as the JSON or Markdown rendered
+// by the server.
+func runTaskReport(cmd *cobra.Command, args []string) error {
+	taskID, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %w", err)
+	}
+
+	var format types.TaskReportFormat
+	switch taskReportFormat {
+	case "table":
+		format = types.TaskReportFormatNone
+	case "json":
+		format = types.TaskReportFormatJSON
+	case "markdown":
+		format = types.TaskReportFormatMarkdown
+	default:
+		return fmt.Errorf("invalid format: %s (must be table, json or markdown)", taskReportFormat)
+	}
+
+	rlaClient, err := client.New(newGlobalClientConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	report, err := rlaClient.GetTaskReport(context.Background(), taskID, format)
+	if err != nil {
+		return fmt.Errorf("failed to get task report: %w", err)
+	}
+
+	out := os.Stdout
+	if taskReportOutput != "" {
+		f, err := os.Create(taskReportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if format != types.TaskReportFormatNone {
+		_, err := fmt.Fprintln(out, report.Content)
+		return err
+	}
+
+	if report.Task != nil {
+		fmt.Fprintf(out, "Task:       %s\n", report.Task.ID)
+		fmt.Fprintf(out, "Rack:       %s\n", report.Task.RackID)
+		fmt.Fprintf(out, "Operation:  %s\n", report.Task.Operation)
+		fmt.Fprintf(out, "Status:     %s\n", report.Task.Status)
+		if report.Task.Message != "" {
+			fmt.Fprintf(out, "Message:    %s\n", report.Task.Message)
+		}
+		fmt.Fprintln(out)
+	}
+
+	if len(report.Entries) == 0 {
+		fmt.Fprintln(out, "No report entries were recorded.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tCOMPONENT\tSTAGE\tSTEP\tACTION\tOUTCOME\tMESSAGE")
+	for _, e := range report.Entries {
+		component := "-"
+		if e.ComponentID != "" {
+			component = fmt.Sprintf("%s %s", e.ComponentType, e.ComponentID)
+		}
+
+		stage := "-"
+		if e.Stage > 0 {
+			stage = fmt.Sprintf("%d", e.Stage)
+		}
+
+		step := e.Step
+		if step == "" {
+			step = "-"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			e.Time.Local().Format("2006-01-02 15:04:05"),
+			component, stage, step, e.Action, e.Outcome, e.Message)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package campaign rolls an operation out across many racks. The task manager
+// fans every request out to all targeted racks at once; a campaign instead
+// starts the racks in batches bounded by a rack-concurrency limit and an
+// optional per-NVL-domain limit, waits for a batch to finish before starting
+// the next one, and pauses when a batch falls short of its success-rate gate.
+// Each rack runs as an ordinary task, and the campaign's progress is built
+// from the outcome of those tasks.
+package campaign
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	identifier "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/Identifier"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+var (
+	// ErrNotFound is returned when a campaign does not exist.
+	ErrNotFound = errors.New("campaign not found")
+	// ErrInvalid is returned for requests that cannot be carried out, such
+	// as resuming a campaign that is not paused.
+	ErrInvalid = errors.New("invalid campaign request")
+)
+
+// State is where a campaign stands.
+type State string
+
+const (
+	// StateRunning means batches are started as earlier ones finish.
+	StateRunning State = "running"
+	// StatePaused means no new batch is started; tasks already running
+	// are left to finish and are still tracked.
+	StatePaused State = "paused"
+	// StateCompleted means every rack has finished, successfully or not.
+	StateCompleted State = "completed"
+	// StateAborted means an operator aborted the campaign: running tasks
+	// were cancelled and racks not yet started were skipped.
+	StateAborted State = "aborted"
+)
+
+// IsFinished reports whether the campaign has reached a terminal state.
+func (s State) IsFinished() bool {
+	return s == StateCompleted || s == StateAborted
+}
+
+// RackState is where a rack of a campaign stands.
+type RackState string
+
+const (
+	// RackStatePending means the rack has not been started yet.
+	RackStatePending RackState = "pending"
+	// RackStateRunning means the rack's task was submitted and has not
+	// finished.
+	RackStateRunning RackState = "running"
+	// RackStateSucceeded means the rack's task completed.
+	RackStateSucceeded RackState = "succeeded"
+	// RackStateFailed means the rack's task failed or was terminated, or
+	// could not be submitted.
+	RackStateFailed RackState = "failed"
+	// RackStateSkipped means the campaign was aborted before the rack was
+	// started.
+	RackStateSkipped RackState = "skipped"
+	// RackStateCancelled means the rack's task was cancelled when the
+	// campaign was aborted.
+	RackStateCancelled RackState = "cancelled"
+)
+
+// Selector chooses the racks of a campaign. Exactly one of Racks,
+// NVLDomains and Location is set.
+type Selector struct {
+	// Racks lists the racks by ID or name; they are rolled out in this
+	// order.
+	Racks []identifier.Identifier `json:"racks,omitempty"`
+	// NVLDomains selects every rack of the given NVL domains.
+	NVLDomains []identifier.Identifier `json:"nvl_domains,omitempty"`
+	// Location selects the racks at a site.
+	Location *Location `json:"location,omitempty"`
+}
+
+// Location selects the racks whose location matches every non-empty field.
+type Location struct {
+	Region     string `json:"region,omitempty"`
+	DataCenter string `json:"data_center,omitempty"`
+	Room       string `json:"room,omitempty"`
+}
+
+// Policy controls how a campaign moves through its racks.
+type Policy struct {
+	// MaxConcurrentRacks is the number of racks started per batch.
+	MaxConcurrentRacks int
+	// MaxRacksPerNVLDomain caps the racks of one NVL domain in a batch; 1
+	// rolls out one rack per domain at a time. Zero means no limit.
+	MaxRacksPerNVLDomain int
+	// MinSuccessRate is the share of a batch's racks, between 0 and 1, that
+	// must succeed for the next batch to start. Zero disables the gate.
+	MinSuccessRate float64
+}
+
+// Spec describes a campaign to create.
+type Spec struct {
+	Name        string
+	Description string
+	// Operation is the operation run on every rack, in the operation
+	// template format of task schedules.
+	Operation []byte
+	// ComponentTypes restricts the operation to these component types in
+	// every rack. Empty means all components.
+	ComponentTypes []devicetypes.ComponentType
+	Selector       Selector
+	Policy         Policy
+}
+
+// Progress counts the racks of a campaign in each state.
+type Progress struct {
+	Total     int
+	Pending   int
+	Running   int
+	Succeeded int
+	Failed    int
+	Skipped   int
+	Cancelled int
+}
+
+// Campaign is a campaign together with its racks in rollout order.
+type Campaign struct {
+	*dbmodel.Campaign
+	Racks []*dbmodel.CampaignRack
+}
+
+// Progress aggregates the state of the campaign's racks.
+func (c *Campaign) Progress() Progress {
+	p := Progress{Total: len(c.Racks)}
+	for _, r := range c.Racks {
+		switch RackState(r.State) {
+		case RackStatePending:
+			p.Pending++
+		case RackStateRunning:
+			p.Running++
+		case RackStateSucceeded:
+			p.Succeeded++
+		case RackStateFailed:
+			p.Failed++
+		case RackStateSkipped:
+			p.Skipped++
+		case RackStateCancelled:
+			p.Cancelled++
+		}
+	}
+
+	return p
+}
+
+// RackInfo is the inventory view of a rack that campaigns select from.
+type RackInfo struct {
+	ID            uuid.UUID
+	Name          string
+	NVLDomainID   uuid.UUID
+	NVLDomainName string
+	Region        string
+	DataCenter    string
+	Room          string
+}
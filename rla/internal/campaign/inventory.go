@@ -0,0 +1,78 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package campaign
+
+import (
+	"context"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// Inventory is the view of the racks that campaigns select from.
+type Inventory interface {
+	// Racks returns every rack with its NVL domain and location.
+	Racks(ctx context.Context) ([]RackInfo, error)
+}
+
+// PostgresInventory implements Inventory on the RLA rack tables.
+type PostgresInventory struct {
+	pg *cdb.Session
+}
+
+// NewPostgresInventory creates an Inventory backed by the RLA database.
+func NewPostgresInventory(pg *cdb.Session) *PostgresInventory {
+	return &PostgresInventory{pg: pg}
+}
+
+// Racks implements Inventory.
+func (i *PostgresInventory) Racks(ctx context.Context) ([]RackInfo, error) {
+	var racks []dbmodel.Rack
+
+	err := i.pg.DB.NewSelect().
+		Model(&racks).
+		Relation("NVLDomain").
+		OrderExpr("r.name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]RackInfo, 0, len(racks))
+	for _, r := range racks {
+		info := RackInfo{
+			ID:          r.ID,
+			Name:        r.Name,
+			NVLDomainID: r.NVLDomainID,
+			Region:      locationField(r.Location, "region"),
+			DataCenter:  locationField(r.Location, "data_center"),
+			Room:        locationField(r.Location, "room"),
+		}
+		if r.NVLDomain != nil {
+			info.NVLDomainName = r.NVLDomain.Name
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+func locationField(loc map[string]any, key string) string {
+	s, _ := loc[key].(string)
+	return s
+}
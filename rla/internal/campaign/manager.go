@@ -0,0 +1,801 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/taskschedule"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	identifier "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/Identifier"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// TaskManager is the subset of the task manager a campaign needs to start
+// and cancel the task of each rack.
+type TaskManager interface {
+	SubmitTask(ctx context.Context, req *operation.Request) ([]uuid.UUID, error)
+	CancelTask(ctx context.Context, taskID uuid.UUID) error
+}
+
+// TaskStore is the subset of the task store a campaign reads rack outcomes
+// from.
+type TaskStore interface {
+	GetTasks(ctx context.Context, ids []uuid.UUID) ([]*taskdef.Task, error)
+}
+
+// Manager creates campaigns and moves them through their racks.
+//
+// Every RLA instance runs Advance. Campaign state changes lock the campaign
+// row, so a campaign is advanced by one instance at a time and operator
+// requests wait for an advancement in progress.
+type Manager struct {
+	store       Store
+	inventory   Inventory
+	taskManager TaskManager
+	taskStore   TaskStore
+	now         func() time.Time
+}
+
+// NewManager creates a campaign manager.
+func NewManager(
+	store Store,
+	inventory Inventory,
+	taskManager TaskManager,
+	taskStore TaskStore,
+) *Manager {
+	return &Manager{
+		store:       store,
+		inventory:   inventory,
+		taskManager: taskManager,
+		taskStore:   taskStore,
+		now:         time.Now,
+	}
+}
+
+// Create resolves the racks of a campaign, stores it and starts its first
+// batch.
+func (m *Manager) Create(ctx context.Context, spec Spec) (*Campaign, error) {
+	if err := validateSpec(&spec); err != nil {
+		return nil, err
+	}
+
+	all, err := m.inventory.Racks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list racks: %w", err)
+	}
+
+	selected, err := selectRacks(spec.Selector, all)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := json.Marshal(spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("marshal selector: %w", err)
+	}
+
+	componentTypes := make([]string, 0, len(spec.ComponentTypes))
+	for _, t := range spec.ComponentTypes {
+		componentTypes = append(componentTypes, devicetypes.ComponentTypeToString(t))
+	}
+
+	now := m.now()
+	c := &dbmodel.Campaign{
+		ID:                   uuid.New(),
+		Name:                 spec.Name,
+		Description:          spec.Description,
+		OperationTemplate:    spec.Operation,
+		ComponentTypes:       componentTypes,
+		Selector:             selector,
+		MaxConcurrentRacks:   spec.Policy.MaxConcurrentRacks,
+		MaxRacksPerNVLDomain: spec.Policy.MaxRacksPerNVLDomain,
+		MinSuccessRate:       spec.Policy.MinSuccessRate,
+		State:                string(StateRunning),
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	racks := make([]*dbmodel.CampaignRack, 0, len(selected))
+	for i, r := range selected {
+		cr := &dbmodel.CampaignRack{
+			CampaignID: c.ID,
+			RackID:     r.ID,
+			RackName:   r.Name,
+			Position:   i,
+			State:      string(RackStatePending),
+			UpdatedAt:  now,
+		}
+		if r.NVLDomainID != uuid.Nil {
+			domainID := r.NVLDomainID
+			cr.NVLDomainID = &domainID
+		}
+		racks = append(racks, cr)
+	}
+
+	if err := m.store.Create(ctx, c, racks); err != nil {
+		return nil, fmt.Errorf("create campaign: %w", err)
+	}
+
+	log.Info().
+		Str("campaign_id", c.ID.String()).
+		Str("campaign_name", c.Name).
+		Int("racks", len(racks)).
+		Msg("Campaign created")
+
+	// The campaign is stored, so a failure to start the first batch is left
+	// to the next Advance rather than reported as a failed creation.
+	camp := &Campaign{Campaign: c, Racks: racks}
+	advanced, err := m.advanceOne(ctx, c.ID)
+	if err != nil {
+		log.Error().Err(err).
+			Str("campaign_id", c.ID.String()).
+			Msg("Failed to start campaign")
+	} else if advanced != nil {
+		camp = advanced
+	}
+
+	return camp, nil
+}
+
+// Get returns a campaign with its racks.
+func (m *Manager) Get(ctx context.Context, id uuid.UUID) (*Campaign, error) {
+	c, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.withRacks(ctx, c)
+}
+
+// List returns the campaigns in the given states, or all campaigns when
+// states is empty, with their racks.
+func (m *Manager) List(ctx context.Context, states []State) ([]*Campaign, error) {
+	campaigns, err := m.store.List(ctx, states)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Campaign, 0, len(campaigns))
+	for _, c := range campaigns {
+		camp, err := m.withRacks(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, camp)
+	}
+
+	return result, nil
+}
+
+// Pause stops a running campaign from starting new batches. Racks already
+// running are left to finish.
+func (m *Manager) Pause(ctx context.Context, id uuid.UUID) (*Campaign, error) {
+	return m.update(ctx, id, func(ctx context.Context, camp *Campaign) error {
+		if State(camp.State) != StateRunning {
+			return fmt.Errorf("%w: campaign %s is %s, not running", ErrInvalid, id, camp.State)
+		}
+
+		camp.State = string(StatePaused)
+		camp.Message = "paused by operator"
+		if err := m.store.Update(ctx, camp.Campaign); err != nil {
+			return fmt.Errorf("update campaign: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Resume lets a paused campaign continue. A campaign paused by a
+// success-rate gate moves on to its next batch.
+func (m *Manager) Resume(ctx context.Context, id uuid.UUID) (*Campaign, error) {
+	return m.update(ctx, id, func(ctx context.Context, camp *Campaign) error {
+		if State(camp.State) != StatePaused {
+			return fmt.Errorf("%w: campaign %s is %s, not paused", ErrInvalid, id, camp.State)
+		}
+
+		camp.State = string(StateRunning)
+		camp.Message = ""
+		return m.advance(ctx, camp)
+	})
+}
+
+// Abort ends a campaign: the tasks of running racks are cancelled and racks
+// not yet started are skipped. If a task cannot be cancelled, its rack keeps
+// running, the campaign is paused instead of aborted and the error is
+// returned, so the abort can be retried.
+func (m *Manager) Abort(ctx context.Context, id uuid.UUID) (*Campaign, error) {
+	var cancelErrs []error
+
+	camp, err := m.update(ctx, id, func(ctx context.Context, camp *Campaign) error {
+		if State(camp.State).IsFinished() {
+			return fmt.Errorf("%w: campaign %s is already %s", ErrInvalid, id, camp.State)
+		}
+
+		now := m.now()
+		var changed []*dbmodel.CampaignRack
+		for _, r := range camp.Racks {
+			if RackState(r.State) != RackStateRunning {
+				continue
+			}
+
+			if err := m.taskManager.CancelTask(ctx, *r.TaskID); err != nil {
+				log.Warn().Err(err).
+					Str("campaign_id", camp.ID.String()).
+					Str("task_id", r.TaskID.String()).
+					Msg("Failed to cancel campaign task")
+				cancelErrs = append(cancelErrs, fmt.Errorf("cancel task %s of rack %s: %w", r.TaskID, r.RackName, err))
+				continue
+			}
+
+			r.State = string(RackStateCancelled)
+			r.FinishedAt = &now
+			changed = append(changed, r)
+		}
+
+		if len(cancelErrs) == 0 {
+			for _, r := range camp.Racks {
+				if RackState(r.State) == RackStatePending {
+					r.State = string(RackStateSkipped)
+					r.FinishedAt = &now
+					changed = append(changed, r)
+				}
+			}
+		}
+
+		if err := m.store.UpdateRacks(ctx, changed); err != nil {
+			return fmt.Errorf("update campaign racks: %w", err)
+		}
+
+		if len(cancelErrs) > 0 {
+			camp.State = string(StatePaused)
+			camp.Message = fmt.Sprintf("abort incomplete: %d rack tasks could not be cancelled; retry the abort", len(cancelErrs))
+		} else {
+			camp.State = string(StateAborted)
+			camp.Message = "aborted by operator"
+			camp.FinishedAt = &now
+		}
+		if err := m.store.Update(ctx, camp.Campaign); err != nil {
+			return fmt.Errorf("update campaign: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cancelErrs) > 0 {
+		return nil, fmt.Errorf("abort campaign %s: %w", id, errors.Join(cancelErrs...))
+	}
+
+	log.Info().
+		Str("campaign_id", camp.ID.String()).
+		Str("campaign_name", camp.Name).
+		Msg("Campaign aborted")
+
+	return camp, nil
+}
+
+// Advance brings every unfinished campaign up to date: it records the
+// outcome of finished rack tasks, checks the success-rate gate of batches
+// that just finished and starts the next batch of running campaigns.
+//
+// Campaigns another instance is advancing are skipped.
+func (m *Manager) Advance(ctx context.Context) error {
+	campaigns, err := m.store.List(ctx, []State{StateRunning, StatePaused})
+	if err != nil {
+		return fmt.Errorf("list campaigns: %w", err)
+	}
+
+	for _, c := range campaigns {
+		if _, err := m.advanceOne(ctx, c.ID); err != nil {
+			log.Error().Err(err).
+				Str("campaign_id", c.ID.String()).
+				Str("campaign_name", c.Name).
+				Msg("Failed to advance campaign")
+		}
+	}
+
+	return nil
+}
+
+// advanceOne locks a campaign and moves it forward. It returns nil if
+// another instance holds the lock or the campaign has finished.
+func (m *Manager) advanceOne(ctx context.Context, id uuid.UUID) (*Campaign, error) {
+	var camp *Campaign
+
+	err := m.store.RunInTransaction(ctx, func(ctx context.Context) error {
+		c, err := m.store.LockForAdvance(ctx, id)
+		if err != nil {
+			return fmt.Errorf("lock campaign: %w", err)
+		}
+
+		if c == nil {
+			return nil
+		}
+
+		locked, err := m.withRacks(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		if err := m.advance(ctx, locked); err != nil {
+			return err
+		}
+
+		camp = locked
+		return nil
+	})
+
+	return camp, err
+}
+
+// update runs fn on a campaign whose row is locked for the duration of fn,
+// waiting for an advancement in progress, and returns the campaign.
+func (m *Manager) update(
+	ctx context.Context,
+	id uuid.UUID,
+	fn func(ctx context.Context, camp *Campaign) error,
+) (*Campaign, error) {
+	var camp *Campaign
+
+	err := m.store.RunInTransaction(ctx, func(ctx context.Context) error {
+		c, err := m.store.LockForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		locked, err := m.withRacks(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(ctx, locked); err != nil {
+			return err
+		}
+
+		camp = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return camp, nil
+}
+
+// advance moves one campaign forward and saves it. Must be called with the
+// campaign row locked.
+func (m *Manager) advance(ctx context.Context, camp *Campaign) error {
+	finished, err := m.refreshRacks(ctx, camp)
+	if err != nil {
+		return err
+	}
+
+	if finished && !m.passesGate(camp) {
+		return m.store.Update(ctx, camp.Campaign)
+	}
+
+	if State(camp.State) != StateRunning || camp.Progress().Running > 0 {
+		return m.store.Update(ctx, camp.Campaign)
+	}
+
+	batch := nextBatch(camp)
+	if len(batch) == 0 {
+		now := m.now()
+		camp.State = string(StateCompleted)
+		camp.FinishedAt = &now
+
+		p := camp.Progress()
+		camp.Message = fmt.Sprintf("%d of %d racks succeeded", p.Succeeded, p.Total)
+
+		log.Info().
+			Str("campaign_id", camp.ID.String()).
+			Str("campaign_name", camp.Name).
+			Int("succeeded", p.Succeeded).
+			Int("failed", p.Failed).
+			Msg("Campaign completed")
+
+		return m.store.Update(ctx, camp.Campaign)
+	}
+
+	if err := m.startBatch(ctx, camp, batch); err != nil {
+		return err
+	}
+
+	// A batch whose every rack failed to start is already over.
+	if camp.Progress().Running == 0 {
+		m.passesGate(camp)
+	}
+
+	return m.store.Update(ctx, camp.Campaign)
+}
+
+// refreshRacks records the outcome of the tasks of running racks. It
+// reports whether the current batch finished with this refresh.
+func (m *Manager) refreshRacks(ctx context.Context, camp *Campaign) (bool, error) {
+	var taskIDs []uuid.UUID
+	for _, r := range camp.Racks {
+		if RackState(r.State) == RackStateRunning {
+			taskIDs = append(taskIDs, *r.TaskID)
+		}
+	}
+
+	if len(taskIDs) == 0 {
+		return false, nil
+	}
+
+	tasks, err := m.taskStore.GetTasks(ctx, taskIDs)
+	if err != nil {
+		return false, fmt.Errorf("get campaign tasks: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*taskdef.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+
+	now := m.now()
+	var changed []*dbmodel.CampaignRack
+	for _, r := range camp.Racks {
+		if RackState(r.State) != RackStateRunning {
+			continue
+		}
+
+		t, ok := byID[*r.TaskID]
+		switch {
+		case !ok:
+			r.State = string(RackStateFailed)
+			r.Message = fmt.Sprintf("task %s not found", r.TaskID)
+		case !t.Status.IsFinished():
+			continue
+		case t.Status == taskcommon.TaskStatusCompleted:
+			r.State = string(RackStateSucceeded)
+			r.Message = ""
+		default:
+			r.State = string(RackStateFailed)
+			r.Message = fmt.Sprintf("task %s: %s", t.Status, t.Message)
+		}
+		r.FinishedAt = &now
+		changed = append(changed, r)
+	}
+
+	if err := m.store.UpdateRacks(ctx, changed); err != nil {
+		return false, fmt.Errorf("update campaign racks: %w", err)
+	}
+
+	return len(changed) > 0 && camp.Progress().Running == 0, nil
+}
+
+// passesGate checks the success rate of the current batch against the
+// campaign's gate and pauses the campaign when it falls short.
+func (m *Manager) passesGate(camp *Campaign) bool {
+	if camp.MinSuccessRate <= 0 || camp.CurrentBatch == 0 {
+		return true
+	}
+
+	var total, succeeded int
+	for _, r := range camp.Racks {
+		if r.Batch != camp.CurrentBatch {
+			continue
+		}
+		total++
+		if RackState(r.State) == RackStateSucceeded {
+			succeeded++
+		}
+	}
+
+	if total == 0 || float64(succeeded)/float64(total) >= camp.MinSuccessRate {
+		return true
+	}
+
+	camp.State = string(StatePaused)
+	camp.Message = fmt.Sprintf(
+		"batch %d: %d of %d racks succeeded, below the %.0f%% success-rate gate; resume to continue or abort",
+		camp.CurrentBatch, succeeded, total, camp.MinSuccessRate*100,
+	)
+
+	log.Warn().
+		Str("campaign_id", camp.ID.String()).
+		Str("campaign_name", camp.Name).
+		Int("batch", camp.CurrentBatch).
+		Int("succeeded", succeeded).
+		Int("total", total).
+		Msg("Campaign paused by success-rate gate")
+
+	return false
+}
+
+// startBatch submits one task per rack of the batch.
+func (m *Manager) startBatch(
+	ctx context.Context,
+	camp *Campaign,
+	batch []*dbmodel.CampaignRack,
+) error {
+	op, err := taskschedule.WrapperFromTemplate(camp.OperationTemplate)
+	if err != nil {
+		return err
+	}
+
+	opts, err := taskschedule.OptionsFromTemplate(camp.OperationTemplate)
+	if err != nil {
+		return err
+	}
+
+	var ruleID *uuid.UUID
+	if opts.RuleID != "" {
+		if id, err := uuid.Parse(opts.RuleID); err == nil {
+			ruleID = &id
+		}
+	}
+
+	componentTypes := make([]devicetypes.ComponentType, 0, len(camp.ComponentTypes))
+	for _, t := range camp.ComponentTypes {
+		componentTypes = append(componentTypes, devicetypes.ComponentTypeFromString(t))
+	}
+
+	camp.CurrentBatch++
+	desc := fmt.Sprintf("%s — batch %d", camp.Name, camp.CurrentBatch)
+
+	now := m.now()
+	for _, r := range batch {
+		req := &operation.Request{
+			Operation: op,
+			TargetSpec: operation.TargetSpec{
+				Racks: []operation.RackTarget{{
+					Identifier:     identifier.Identifier{ID: r.RackID},
+					ComponentTypes: componentTypes,
+				}},
+			},
+			Description:      desc,
+			RequiredRackID:   r.RackID,
+			ConflictStrategy: operation.ConflictStrategy(opts.ConflictStrategy),
+			QueueTimeout:     time.Duration(opts.QueueTimeoutSecs) * time.Second,
+			RuleID:           ruleID,
+			Priority:         opts.Priority,
+			// Racks closed for maintenance wait for their next window;
+			// the batch finishes once they have run.
+			MaintenancePolicy: operation.MaintenancePolicyDefer,
+		}
+
+		r.Batch = camp.CurrentBatch
+		r.StartedAt = &now
+
+		taskIDs, err := m.taskManager.SubmitTask(ctx, req)
+		if err == nil && len(taskIDs) == 0 {
+			err = fmt.Errorf("SubmitTask returned no task IDs")
+		}
+		if err != nil {
+			log.Warn().Err(err).
+				Str("campaign_id", camp.ID.String()).
+				Str("rack_id", r.RackID.String()).
+				Msg("Failed to start campaign rack")
+
+			r.State = string(RackStateFailed)
+			r.Message = fmt.Sprintf("submit task: %v", err)
+			r.FinishedAt = &now
+			continue
+		}
+
+		// RequiredRackID guarantees a single task.
+		taskID := taskIDs[0]
+		r.TaskID = &taskID
+		r.State = string(RackStateRunning)
+	}
+
+	if err := m.store.UpdateRacks(ctx, batch); err != nil {
+		return fmt.Errorf("update campaign racks: %w", err)
+	}
+
+	log.Info().
+		Str("campaign_id", camp.ID.String()).
+		Str("campaign_name", camp.Name).
+		Int("batch", camp.CurrentBatch).
+		Int("racks", len(batch)).
+		Msg("Campaign batch started")
+
+	return nil
+}
+
+func (m *Manager) withRacks(ctx context.Context, c *dbmodel.Campaign) (*Campaign, error) {
+	racks, err := m.store.ListRacks(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list campaign racks: %w", err)
+	}
+
+	return &Campaign{Campaign: c, Racks: racks}, nil
+}
+
+// nextBatch picks the pending racks to start next, in rollout order, up to
+// the concurrency limit and the per-NVL-domain limit. Racks outside any NVL
+// domain are not subject to the domain limit.
+func nextBatch(camp *Campaign) []*dbmodel.CampaignRack {
+	var batch []*dbmodel.CampaignRack
+	perDomain := make(map[uuid.UUID]int)
+
+	for _, r := range camp.Racks {
+		if len(batch) >= camp.MaxConcurrentRacks {
+			break
+		}
+
+		if RackState(r.State) != RackStatePending {
+			continue
+		}
+
+		if r.NVLDomainID != nil && camp.MaxRacksPerNVLDomain > 0 {
+			if perDomain[*r.NVLDomainID] >= camp.MaxRacksPerNVLDomain {
+				continue
+			}
+			perDomain[*r.NVLDomainID]++
+		}
+
+		batch = append(batch, r)
+	}
+
+	return batch
+}
+
+func validateSpec(spec *Spec) error {
+	if strings.TrimSpace(spec.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+
+	if _, err := taskschedule.WrapperFromTemplate(spec.Operation); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	if spec.Policy.MaxConcurrentRacks < 1 {
+		return fmt.Errorf("%w: max concurrent racks must be at least 1", ErrInvalid)
+	}
+
+	if spec.Policy.MaxRacksPerNVLDomain < 0 {
+		return fmt.Errorf("%w: max racks per NVL domain must not be negative", ErrInvalid)
+	}
+
+	if spec.Policy.MinSuccessRate < 0 || spec.Policy.MinSuccessRate > 1 {
+		return fmt.Errorf("%w: min success rate must be between 0 and 1", ErrInvalid)
+	}
+
+	for _, t := range spec.ComponentTypes {
+		if t == devicetypes.ComponentTypeUnknown {
+			return fmt.Errorf("%w: unknown component type", ErrInvalid)
+		}
+	}
+
+	sel := spec.Selector
+	set := 0
+	if len(sel.Racks) > 0 {
+		set++
+	}
+	if len(sel.NVLDomains) > 0 {
+		set++
+	}
+	if sel.Location != nil {
+		set++
+		if sel.Location.Region == "" && sel.Location.DataCenter == "" && sel.Location.Room == "" {
+			return fmt.Errorf("%w: location selector needs a region, data center or room", ErrInvalid)
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("%w: exactly one of racks, NVL domains or location must select the racks", ErrInvalid)
+	}
+
+	return nil
+}
+
+// selectRacks returns the racks chosen by the selector in rollout order:
+// the given order for an explicit list, rack name order otherwise.
+func selectRacks(sel Selector, all []RackInfo) ([]RackInfo, error) {
+	var selected []RackInfo
+
+	switch {
+	case len(sel.Racks) > 0:
+		seen := make(map[uuid.UUID]bool, len(sel.Racks))
+		for _, id := range sel.Racks {
+			r, ok := findRack(all, id)
+			if !ok {
+				return nil, fmt.Errorf("%w: rack %s not found", ErrInvalid, identifierString(id))
+			}
+			if !seen[r.ID] {
+				seen[r.ID] = true
+				selected = append(selected, r)
+			}
+		}
+
+	case len(sel.NVLDomains) > 0:
+		for _, d := range sel.NVLDomains {
+			found := false
+			for _, r := range all {
+				if inDomain(r, d) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("%w: NVL domain %s has no racks", ErrInvalid, identifierString(d))
+			}
+		}
+
+		for _, r := range all {
+			for _, d := range sel.NVLDomains {
+				if inDomain(r, d) {
+					selected = append(selected, r)
+					break
+				}
+			}
+		}
+
+	case sel.Location != nil:
+		loc := sel.Location
+		for _, r := range all {
+			if (loc.Region == "" || r.Region == loc.Region) &&
+				(loc.DataCenter == "" || r.DataCenter == loc.DataCenter) &&
+				(loc.Room == "" || r.Room == loc.Room) {
+				selected = append(selected, r)
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("%w: the selector matches no racks", ErrInvalid)
+	}
+
+	return selected, nil
+}
+
+func findRack(all []RackInfo, id identifier.Identifier) (RackInfo, bool) {
+	for _, r := range all {
+		if (id.ID != uuid.Nil && r.ID == id.ID) || (id.ID == uuid.Nil && r.Name == id.Name) {
+			return r, true
+		}
+	}
+
+	return RackInfo{}, false
+}
+
+func inDomain(r RackInfo, domain identifier.Identifier) bool {
+	if r.NVLDomainID == uuid.Nil {
+		return false
+	}
+
+	if domain.ID != uuid.Nil {
+		return r.NVLDomainID == domain.ID
+	}
+
+	return r.NVLDomainName == domain.Name
+}
+
+func identifierString(id identifier.Identifier) string {
+	if id.ID != uuid.Nil {
+		return id.ID.String()
+	}
+
+	return id.Name
+}
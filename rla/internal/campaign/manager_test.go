@@ -0,0 +1,587 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/taskschedule"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	identifier "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/Identifier"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// --- fakes ---
+
+type fakeStore struct {
+	campaigns map[uuid.UUID]*dbmodel.Campaign
+	racks     map[uuid.UUID][]*dbmodel.CampaignRack
+	// lockedElsewhere holds the campaigns another instance has locked.
+	lockedElsewhere map[uuid.UUID]bool
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		campaigns:       make(map[uuid.UUID]*dbmodel.Campaign),
+		racks:           make(map[uuid.UUID][]*dbmodel.CampaignRack),
+		lockedElsewhere: make(map[uuid.UUID]bool),
+	}
+}
+
+func (s *fakeStore) Create(_ context.Context, c *dbmodel.Campaign, racks []*dbmodel.CampaignRack) error {
+	cc := *c
+	s.campaigns[c.ID] = &cc
+	for _, r := range racks {
+		rc := *r
+		s.racks[c.ID] = append(s.racks[c.ID], &rc)
+	}
+	return nil
+}
+
+func (s *fakeStore) Get(_ context.Context, id uuid.UUID) (*dbmodel.Campaign, error) {
+	c, ok := s.campaigns[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	cc := *c
+	return &cc, nil
+}
+
+func (s *fakeStore) List(_ context.Context, states []State) ([]*dbmodel.Campaign, error) {
+	var out []*dbmodel.Campaign
+	for _, c := range s.campaigns {
+		for _, st := range states {
+			if State(c.State) == st {
+				cc := *c
+				out = append(out, &cc)
+			}
+		}
+		if len(states) == 0 {
+			cc := *c
+			out = append(out, &cc)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (s *fakeStore) Update(_ context.Context, c *dbmodel.Campaign) error {
+	cc := *c
+	s.campaigns[c.ID] = &cc
+	return nil
+}
+
+func (s *fakeStore) ListRacks(_ context.Context, campaignID uuid.UUID) ([]*dbmodel.CampaignRack, error) {
+	var out []*dbmodel.CampaignRack
+	for _, r := range s.racks[campaignID] {
+		rc := *r
+		out = append(out, &rc)
+	}
+	return out, nil
+}
+
+func (s *fakeStore) UpdateRacks(_ context.Context, racks []*dbmodel.CampaignRack) error {
+	for _, r := range racks {
+		for i, stored := range s.racks[r.CampaignID] {
+			if stored.RackID == r.RackID {
+				rc := *r
+				s.racks[r.CampaignID][i] = &rc
+			}
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *fakeStore) LockForAdvance(ctx context.Context, id uuid.UUID) (*dbmodel.Campaign, error) {
+	if s.lockedElsewhere[id] {
+		return nil, nil
+	}
+	c, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if State(c.State).IsFinished() {
+		return nil, nil
+	}
+	return c, nil
+}
+
+func (s *fakeStore) LockForUpdate(ctx context.Context, id uuid.UUID) (*dbmodel.Campaign, error) {
+	return s.Get(ctx, id)
+}
+
+type fakeInventory struct {
+	racks []RackInfo
+}
+
+func (i *fakeInventory) Racks(context.Context) ([]RackInfo, error) {
+	return i.racks, nil
+}
+
+// fakeTasks is both the task manager and the task store.
+type fakeTasks struct {
+	tasks     map[uuid.UUID]*taskdef.Task
+	requests  []*operation.Request
+	failRacks map[uuid.UUID]bool
+	cancelled []uuid.UUID
+	cancelErr error
+}
+
+func newFakeTasks() *fakeTasks {
+	return &fakeTasks{
+		tasks:     make(map[uuid.UUID]*taskdef.Task),
+		failRacks: make(map[uuid.UUID]bool),
+	}
+}
+
+func (f *fakeTasks) SubmitTask(_ context.Context, req *operation.Request) ([]uuid.UUID, error) {
+	f.requests = append(f.requests, req)
+	if f.failRacks[req.RequiredRackID] {
+		return nil, errors.New("rack busy")
+	}
+	t := &taskdef.Task{ID: uuid.New(), RackID: req.RequiredRackID, Status: taskcommon.TaskStatusRunning}
+	f.tasks[t.ID] = t
+	return []uuid.UUID{t.ID}, nil
+}
+
+func (f *fakeTasks) CancelTask(_ context.Context, taskID uuid.UUID) error {
+	if f.cancelErr != nil {
+		return f.cancelErr
+	}
+	f.cancelled = append(f.cancelled, taskID)
+	f.tasks[taskID].Status = taskcommon.TaskStatusTerminated
+	return nil
+}
+
+func (f *fakeTasks) GetTasks(_ context.Context, ids []uuid.UUID) ([]*taskdef.Task, error) {
+	var out []*taskdef.Task
+	for _, id := range ids {
+		if t, ok := f.tasks[id]; ok {
+			tc := *t
+			out = append(out, &tc)
+		}
+	}
+	return out, nil
+}
+
+// finish sets the status of the running task of every given rack.
+func (f *fakeTasks) finish(status taskcommon.TaskStatus, rackIDs ...uuid.UUID) {
+	for _, t := range f.tasks {
+		for _, id := range rackIDs {
+			if t.RackID == id && !t.Status.IsFinished() {
+				t.Status = status
+			}
+		}
+	}
+}
+
+func (f *fakeTasks) startedRacks() []uuid.UUID {
+	var ids []uuid.UUID
+	for _, req := range f.requests {
+		ids = append(ids, req.RequiredRackID)
+	}
+	return ids
+}
+
+// --- helpers ---
+
+type testEnv struct {
+	manager *Manager
+	store   *fakeStore
+	tasks   *fakeTasks
+	racks   []RackInfo
+}
+
+// newTestEnv creates racks a1, a2 in NVL domain A, b1, b2 in domain B and
+// c1 outside any domain, in two rooms.
+func newTestEnv() *testEnv {
+	domainA, domainB := uuid.New(), uuid.New()
+	rack := func(name string, domain uuid.UUID, domainName, room string) RackInfo {
+		return RackInfo{
+			ID:            uuid.New(),
+			Name:          name,
+			NVLDomainID:   domain,
+			NVLDomainName: domainName,
+			Region:        "us-west",
+			DataCenter:    "dc1",
+			Room:          room,
+		}
+	}
+
+	racks := []RackInfo{
+		rack("a1", domainA, "A", "r1"),
+		rack("a2", domainA, "A", "r1"),
+		rack("b1", domainB, "B", "r2"),
+		rack("b2", domainB, "B", "r2"),
+		rack("c1", uuid.Nil, "", "r2"),
+	}
+
+	store := newFakeStore()
+	tasks := newFakeTasks()
+	return &testEnv{
+		manager: NewManager(store, &fakeInventory{racks: racks}, tasks, tasks),
+		store:   store,
+		tasks:   tasks,
+		racks:   racks,
+	}
+}
+
+func (e *testEnv) rack(name string) uuid.UUID {
+	for _, r := range e.racks {
+		if r.Name == name {
+			return r.ID
+		}
+	}
+	panic("unknown rack " + name)
+}
+
+func (e *testEnv) rackStates(t *testing.T, id uuid.UUID) map[string]RackState {
+	t.Helper()
+	camp, err := e.manager.Get(context.Background(), id)
+	require.NoError(t, err)
+	states := make(map[string]RackState)
+	for _, r := range camp.Racks {
+		states[r.RackName] = RackState(r.State)
+	}
+	return states
+}
+
+func powerOnTemplate(t *testing.T) []byte {
+	t.Helper()
+	raw, err := taskschedule.MarshalTemplate(
+		taskcommon.TaskTypePowerControl,
+		taskcommon.OpCodePowerControlPowerOn,
+		json.RawMessage(`{"operation":"power_on"}`),
+		taskschedule.TemplateOptions{},
+	)
+	require.NoError(t, err)
+	return raw
+}
+
+func spec(t *testing.T, sel Selector, policy Policy) Spec {
+	return Spec{
+		Name:      "fw-rollout",
+		Operation: powerOnTemplate(t),
+		Selector:  sel,
+		Policy:    policy,
+	}
+}
+
+// --- tests ---
+
+func TestCreateSelectsRacks(t *testing.T) {
+	e := newTestEnv()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		sel     Selector
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "explicit list keeps the given order",
+			sel: Selector{Racks: []identifier.Identifier{
+				{Name: "b2"}, {ID: e.rack("a1")}, {Name: "b2"},
+			}},
+			want: []string{"b2", "a1"},
+		},
+		{
+			name:    "unknown rack",
+			sel:     Selector{Racks: []identifier.Identifier{{Name: "zz"}}},
+			wantErr: true,
+		},
+		{
+			name: "NVL domains by name",
+			sel:  Selector{NVLDomains: []identifier.Identifier{{Name: "B"}}},
+			want: []string{"b1", "b2"},
+		},
+		{
+			name:    "NVL domain without racks",
+			sel:     Selector{NVLDomains: []identifier.Identifier{{Name: "Z"}}},
+			wantErr: true,
+		},
+		{
+			name: "location",
+			sel:  Selector{Location: &Location{DataCenter: "dc1", Room: "r2"}},
+			want: []string{"b1", "b2", "c1"},
+		},
+		{
+			name:    "empty location",
+			sel:     Selector{Location: &Location{}},
+			wantErr: true,
+		},
+		{
+			name: "two selectors",
+			sel: Selector{
+				Racks:    []identifier.Identifier{{Name: "a1"}},
+				Location: &Location{Room: "r1"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			camp, err := e.manager.Create(ctx, spec(t, tt.sel, Policy{MaxConcurrentRacks: 10}))
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalid)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, r := range camp.Racks {
+				names = append(names, r.RackName)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestCreateValidatesPolicy(t *testing.T) {
+	e := newTestEnv()
+	sel := Selector{Racks: []identifier.Identifier{{Name: "a1"}}}
+
+	for name, policy := range map[string]Policy{
+		"no concurrency":      {},
+		"negative per-domain": {MaxConcurrentRacks: 1, MaxRacksPerNVLDomain: -1},
+		"success rate over 1": {MaxConcurrentRacks: 1, MinSuccessRate: 1.5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := e.manager.Create(context.Background(), spec(t, sel, policy))
+			require.ErrorIs(t, err, ErrInvalid)
+		})
+	}
+
+	t.Run("bad operation", func(t *testing.T) {
+		s := spec(t, sel, Policy{MaxConcurrentRacks: 1})
+		s.Operation = []byte("not json")
+		_, err := e.manager.Create(context.Background(), s)
+		require.ErrorIs(t, err, ErrInvalid)
+	})
+}
+
+func TestCampaignRollsOutInBatches(t *testing.T) {
+	e := newTestEnv()
+	ctx := context.Background()
+
+	s := spec(t,
+		Selector{Location: &Location{Region: "us-west"}},
+		Policy{MaxConcurrentRacks: 3, MaxRacksPerNVLDomain: 1},
+	)
+	s.ComponentTypes = []devicetypes.ComponentType{devicetypes.ComponentTypeCompute}
+
+	camp, err := e.manager.Create(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, 1, camp.CurrentBatch)
+
+	// One rack per NVL domain; c1 has no domain.
+	assert.ElementsMatch(t, []uuid.UUID{e.rack("a1"), e.rack("b1"), e.rack("c1")}, e.tasks.startedRacks())
+
+	req := e.tasks.requests[0]
+	require.Len(t, req.TargetSpec.Racks, 1)
+	assert.Equal(t, req.RequiredRackID, req.TargetSpec.Racks[0].Identifier.ID)
+	assert.Equal(t, []devicetypes.ComponentType{devicetypes.ComponentTypeCompute}, req.TargetSpec.Racks[0].ComponentTypes)
+	assert.Equal(t, taskcommon.TaskTypePowerControl, req.Operation.Type)
+
+	// Nothing new starts while the batch is running.
+	e.tasks.finish(taskcommon.TaskStatusCompleted, e.rack("a1"), e.rack("b1"))
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 3)
+
+	e.tasks.finish(taskcommon.TaskStatusFailed, e.rack("c1"))
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 5)
+	assert.ElementsMatch(t, []uuid.UUID{e.rack("a2"), e.rack("b2")}, e.tasks.startedRacks()[3:])
+
+	e.tasks.finish(taskcommon.TaskStatusCompleted, e.rack("a2"), e.rack("b2"))
+	require.NoError(t, e.manager.Advance(ctx))
+
+	camp, err = e.manager.Get(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateCompleted), camp.State)
+	assert.NotNil(t, camp.FinishedAt)
+	assert.Equal(t, Progress{Total: 5, Succeeded: 4, Failed: 1}, camp.Progress())
+}
+
+func TestSuccessRateGatePausesCampaign(t *testing.T) {
+	e := newTestEnv()
+	ctx := context.Background()
+
+	camp, err := e.manager.Create(ctx, spec(t,
+		Selector{NVLDomains: []identifier.Identifier{{Name: "A"}, {Name: "B"}}},
+		Policy{MaxConcurrentRacks: 2, MinSuccessRate: 1},
+	))
+	require.NoError(t, err)
+
+	e.tasks.finish(taskcommon.TaskStatusCompleted, e.rack("a1"))
+	e.tasks.finish(taskcommon.TaskStatusFailed, e.rack("a2"))
+	require.NoError(t, e.manager.Advance(ctx))
+
+	camp, err = e.manager.Get(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StatePaused), camp.State)
+	assert.Contains(t, camp.Message, "1 of 2 racks succeeded")
+
+	// The gate holds until an operator resumes.
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 2)
+
+	camp, err = e.manager.Resume(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRunning), camp.State)
+	assert.Equal(t, 2, camp.CurrentBatch)
+	assert.Len(t, e.tasks.requests, 4)
+}
+
+func TestSubmitFailureCountsAgainstGate(t *testing.T) {
+	e := newTestEnv()
+	e.tasks.failRacks[e.rack("a1")] = true
+
+	camp, err := e.manager.Create(context.Background(), spec(t,
+		Selector{Racks: []identifier.Identifier{{Name: "a1"}, {Name: "a2"}}},
+		Policy{MaxConcurrentRacks: 1, MinSuccessRate: 0.5},
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(StatePaused), camp.State)
+	assert.Equal(t, map[string]RackState{"a1": RackStateFailed, "a2": RackStatePending}, e.rackStates(t, camp.ID))
+}
+
+func TestPauseResumeAbort(t *testing.T) {
+	e := newTestEnv()
+	ctx := context.Background()
+
+	camp, err := e.manager.Create(ctx, spec(t,
+		Selector{Racks: []identifier.Identifier{{Name: "a1"}, {Name: "a2"}, {Name: "b1"}}},
+		Policy{MaxConcurrentRacks: 1},
+	))
+	require.NoError(t, err)
+
+	_, err = e.manager.Resume(ctx, camp.ID)
+	require.ErrorIs(t, err, ErrInvalid)
+
+	camp, err = e.manager.Pause(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StatePaused), camp.State)
+
+	// A paused campaign still records finished racks but starts no batch.
+	e.tasks.finish(taskcommon.TaskStatusCompleted, e.rack("a1"))
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 1)
+	assert.Equal(t, RackStateSucceeded, e.rackStates(t, camp.ID)["a1"])
+
+	_, err = e.manager.Resume(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Len(t, e.tasks.requests, 2)
+
+	camp, err = e.manager.Abort(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateAborted), camp.State)
+	assert.Len(t, e.tasks.cancelled, 1)
+	assert.Equal(t, map[string]RackState{
+		"a1": RackStateSucceeded,
+		"a2": RackStateCancelled,
+		"b1": RackStateSkipped,
+	}, e.rackStates(t, camp.ID))
+
+	_, err = e.manager.Abort(ctx, camp.ID)
+	require.ErrorIs(t, err, ErrInvalid)
+
+	// Finished campaigns are not advanced.
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 2)
+}
+
+func TestAbortKeepsRackRunningWhenCancelFails(t *testing.T) {
+	e := newTestEnv()
+	ctx := context.Background()
+
+	camp, err := e.manager.Create(ctx, spec(t,
+		Selector{Racks: []identifier.Identifier{{Name: "a1"}, {Name: "a2"}}},
+		Policy{MaxConcurrentRacks: 1},
+	))
+	require.NoError(t, err)
+
+	e.tasks.cancelErr = errors.New("task manager unavailable")
+	_, err = e.manager.Abort(ctx, camp.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "task manager unavailable")
+
+	stored, err := e.manager.Get(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StatePaused), stored.State)
+	assert.Nil(t, stored.FinishedAt)
+	assert.Equal(t, map[string]RackState{
+		"a1": RackStateRunning,
+		"a2": RackStatePending,
+	}, e.rackStates(t, camp.ID))
+
+	// The abort can be retried once the task can be cancelled.
+	e.tasks.cancelErr = nil
+	aborted, err := e.manager.Abort(ctx, camp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateAborted), aborted.State)
+	assert.Equal(t, map[string]RackState{
+		"a1": RackStateCancelled,
+		"a2": RackStateSkipped,
+	}, e.rackStates(t, camp.ID))
+}
+
+func TestAdvanceSkipsCampaignLockedElsewhere(t *testing.T) {
+	e := newTestEnv()
+	ctx := context.Background()
+
+	camp, err := e.manager.Create(ctx, spec(t,
+		Selector{Racks: []identifier.Identifier{{Name: "a1"}, {Name: "a2"}}},
+		Policy{MaxConcurrentRacks: 1},
+	))
+	require.NoError(t, err)
+	require.Len(t, e.tasks.requests, 1)
+
+	e.tasks.finish(taskcommon.TaskStatusCompleted, e.rack("a1"))
+
+	// Another instance is advancing the campaign: its next batch is not
+	// submitted a second time.
+	e.store.lockedElsewhere[camp.ID] = true
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 1)
+	assert.Equal(t, RackStateRunning, e.rackStates(t, camp.ID)["a1"])
+
+	e.store.lockedElsewhere[camp.ID] = false
+	require.NoError(t, e.manager.Advance(ctx))
+	assert.Len(t, e.tasks.requests, 2)
+	assert.Equal(t, RackStateSucceeded, e.rackStates(t, camp.ID)["a1"])
+}
@@ -0,0 +1,300 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package campaign
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// Store is the persistence layer for campaigns.
+type Store interface {
+	// Create stores a new campaign and its racks, assigning the campaign
+	// an ID if it has none.
+	Create(ctx context.Context, c *dbmodel.Campaign, racks []*dbmodel.CampaignRack) error
+
+	// Get returns the campaign with the given ID, or an error wrapping
+	// ErrNotFound.
+	Get(ctx context.Context, id uuid.UUID) (*dbmodel.Campaign, error)
+
+	// List returns the campaigns in the given states, or all campaigns
+	// when states is empty, oldest first.
+	List(ctx context.Context, states []State) ([]*dbmodel.Campaign, error)
+
+	// Update saves the state of a campaign.
+	Update(ctx context.Context, c *dbmodel.Campaign) error
+
+	// ListRacks returns the racks of a campaign in rollout order.
+	ListRacks(ctx context.Context, campaignID uuid.UUID) ([]*dbmodel.CampaignRack, error)
+
+	// UpdateRacks saves the state of campaign racks.
+	UpdateRacks(ctx context.Context, racks []*dbmodel.CampaignRack) error
+
+	// RunInTransaction executes fn within a database transaction. The
+	// transaction is propagated through ctx so Store calls made with the ctx
+	// fn receives participate in it.
+	RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// LockForAdvance attempts SELECT ... FOR UPDATE SKIP LOCKED on the
+	// campaign row. Returns the locked campaign if it is still running or
+	// paused, or nil (no error) if another instance holds the lock or the
+	// campaign has finished.
+	// Must be called within a RunInTransaction block.
+	LockForAdvance(ctx context.Context, id uuid.UUID) (*dbmodel.Campaign, error)
+
+	// LockForUpdate acquires a blocking SELECT ... FOR UPDATE lock on the
+	// campaign row, so operator requests wait for an advancement in progress.
+	// Returns an error wrapping ErrNotFound if the campaign does not exist.
+	// Must be called within a RunInTransaction block.
+	LockForUpdate(ctx context.Context, id uuid.UUID) (*dbmodel.Campaign, error)
+}
+
+// txKeyType is an unexported type for the transaction context key.
+type txKeyType struct{}
+
+var txKey = txKeyType{}
+
+// PostgresStore implements Store using PostgreSQL via bun.
+type PostgresStore struct {
+	pg *cdb.Session
+}
+
+// NewPostgresStore creates a new PostgreSQL-backed campaign store.
+func NewPostgresStore(pg *cdb.Session) *PostgresStore {
+	return &PostgresStore{pg: pg}
+}
+
+// idb returns the bun.Tx stored in ctx by RunInTransaction, falling back to
+// the underlying *bun.DB when called outside a transaction.
+func (s *PostgresStore) idb(ctx context.Context) bun.IDB {
+	if tx, ok := ctx.Value(txKey).(bun.Tx); ok {
+		return tx
+	}
+	return s.pg.DB
+}
+
+// RunInTransaction implements Store.
+func (s *PostgresStore) RunInTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return s.pg.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		return fn(context.WithValue(ctx, txKey, tx))
+	})
+}
+
+// LockForAdvance implements Store.
+func (s *PostgresStore) LockForAdvance(
+	ctx context.Context,
+	id uuid.UUID,
+) (*dbmodel.Campaign, error) {
+	var rows []dbmodel.Campaign
+
+	err := s.idb(ctx).NewSelect().
+		Model(&rows).
+		Where("cp.id = ?", id).
+		Where("cp.state IN (?)", bun.In([]State{StateRunning, StatePaused})).
+		For("UPDATE SKIP LOCKED").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil // locked by another instance or finished
+	}
+
+	return &rows[0], nil
+}
+
+// LockForUpdate implements Store.
+func (s *PostgresStore) LockForUpdate(
+	ctx context.Context,
+	id uuid.UUID,
+) (*dbmodel.Campaign, error) {
+	var c dbmodel.Campaign
+
+	err := s.idb(ctx).NewSelect().
+		Model(&c).
+		Where("cp.id = ?", id).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", ErrNotFound, id)
+		}
+
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Create implements Store.
+func (s *PostgresStore) Create(
+	ctx context.Context,
+	c *dbmodel.Campaign,
+	racks []*dbmodel.CampaignRack,
+) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+
+	return s.idb(ctx).RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(c).Exec(ctx); err != nil {
+			return err
+		}
+
+		for _, r := range racks {
+			r.CampaignID = c.ID
+		}
+
+		if len(racks) > 0 {
+			if _, err := tx.NewInsert().Model(&racks).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Get implements Store.
+func (s *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*dbmodel.Campaign, error) {
+	var c dbmodel.Campaign
+
+	err := s.idb(ctx).NewSelect().
+		Model(&c).
+		Where("cp.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", ErrNotFound, id)
+		}
+
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// List implements Store.
+func (s *PostgresStore) List(
+	ctx context.Context,
+	states []State,
+) ([]*dbmodel.Campaign, error) {
+	var rows []dbmodel.Campaign
+
+	q := s.idb(ctx).NewSelect().Model(&rows)
+	if len(states) > 0 {
+		q = q.Where("cp.state IN (?)", bun.In(states))
+	}
+
+	if err := q.OrderExpr("cp.created_at ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	campaigns := make([]*dbmodel.Campaign, len(rows))
+	for i := range rows {
+		c := rows[i]
+		campaigns[i] = &c
+	}
+
+	return campaigns, nil
+}
+
+// Update implements Store.
+func (s *PostgresStore) Update(ctx context.Context, c *dbmodel.Campaign) error {
+	c.UpdatedAt = time.Now()
+
+	res, err := s.idb(ctx).NewUpdate().
+		Model(c).
+		Column("state", "message", "current_batch", "finished_at", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %v", ErrNotFound, c.ID)
+	}
+
+	return nil
+}
+
+// ListRacks implements Store.
+func (s *PostgresStore) ListRacks(
+	ctx context.Context,
+	campaignID uuid.UUID,
+) ([]*dbmodel.CampaignRack, error) {
+	var rows []dbmodel.CampaignRack
+
+	err := s.idb(ctx).NewSelect().
+		Model(&rows).
+		Where("cr.campaign_id = ?", campaignID).
+		OrderExpr("cr.position ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	racks := make([]*dbmodel.CampaignRack, len(rows))
+	for i := range rows {
+		r := rows[i]
+		racks[i] = &r
+	}
+
+	return racks, nil
+}
+
+// UpdateRacks implements Store.
+func (s *PostgresStore) UpdateRacks(
+	ctx context.Context,
+	racks []*dbmodel.CampaignRack,
+) error {
+	if len(racks) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	return s.idb(ctx).RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, r := range racks {
+			r.UpdatedAt = now
+			_, err := tx.NewUpdate().
+				Model(r).
+				Column("batch", "task_id", "state", "message", "started_at", "finished_at", "updated_at").
+				WherePK().
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,90 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cduapi
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+)
+
+type mockClient struct {
+	mu   sync.Mutex
+	cdus map[string]CDU
+}
+
+// NewMockClient returns a client that serves CDUs added with AddCDU so it can be used in unit tests.
+func NewMockClient() Client {
+	return &mockClient{cdus: map[string]CDU{}}
+}
+
+func (c *mockClient) Close() error {
+	return nil
+}
+
+func (c *mockClient) AddCDU(cdu CDU) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cdus[utils.NormalizeMAC(cdu.ComponentID)] = cdu
+}
+
+func (c *mockClient) GetCDUs(_ context.Context, componentIDs []string) ([]CDU, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []CDU
+	if len(componentIDs) == 0 {
+		for _, cdu := range c.cdus {
+			result = append(result, cdu)
+		}
+		sort.Slice(result, func(i, j int) bool { return result[i].ComponentID < result[j].ComponentID })
+		return result, nil
+	}
+
+	for _, id := range componentIDs {
+		if cdu, ok := c.cdus[utils.NormalizeMAC(id)]; ok {
+			result = append(result, cdu)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *mockClient) SetMode(_ context.Context, componentIDs []string, enabled bool) ([]ModeResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]ModeResult, 0, len(componentIDs))
+	for _, id := range componentIDs {
+		key := utils.NormalizeMAC(id)
+		cdu, ok := c.cdus[key]
+		if !ok {
+			result = append(result, ModeResult{ComponentID: id, Error: "CDU not found"})
+			continue
+		}
+
+		cdu.Enabled = enabled
+		c.cdus[key] = cdu
+		result = append(result, ModeResult{ComponentID: id})
+	}
+
+	return result, nil
+}
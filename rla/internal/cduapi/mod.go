@@ -0,0 +1,66 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cduapi reads and controls coolant distribution units (CDUs) over
+// Redfish. A CDU is addressed by its component ID, the MAC address of its BMC;
+// an EndpointResolver maps component IDs to the Redfish endpoint and
+// credentials recorded in the inventory. New clients can be created with
+// NewClient to talk to real CDUs or NewMockClient which fakes everything for
+// unit tests.
+
+package cduapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Client allows us to have both a real implementation and a mock implementation for unit tests which can be switched transparently.
+type Client interface {
+	// GetCDUs returns the state of the CDUs with the specified component IDs.
+	// If componentIDs is empty, returns every CDU known to the resolver. A CDU
+	// that cannot be read is still returned, with Error set.
+	GetCDUs(ctx context.Context, componentIDs []string) ([]CDU, error)
+
+	// SetMode enables or disables the specified CDUs.
+	SetMode(ctx context.Context, componentIDs []string, enabled bool) ([]ModeResult, error)
+
+	// Close releases the resources held by the client.
+	Close() error
+
+	// The following are only valid in the mock environment and should only be called by unit tests.
+	AddCDU(CDU)
+}
+
+// Endpoint is where and how to reach the Redfish service of a CDU.
+type Endpoint struct {
+	// ComponentID is the component ID of the CDU, the MAC address of its BMC.
+	ComponentID string
+	// RackID is the rack the CDU belongs to in the inventory.
+	RackID   uuid.UUID
+	Address  string
+	Username string
+	Password string
+}
+
+// EndpointResolver maps CDU component IDs to their Redfish endpoints.
+type EndpointResolver interface {
+	// Endpoints returns the endpoints of the CDUs with the specified
+	// component IDs. If componentIDs is empty, returns every known CDU.
+	Endpoints(ctx context.Context, componentIDs []string) ([]Endpoint, error)
+}
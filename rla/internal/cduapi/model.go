@@ -0,0 +1,166 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cduapi
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Health is the health reported by a CDU, one of its parts or a leak detector.
+type Health string
+
+const (
+	HealthUnknown  Health = "Unknown"
+	HealthOK       Health = "OK"
+	HealthWarning  Health = "Warning"
+	HealthCritical Health = "Critical"
+)
+
+// CDU is the state of a coolant distribution unit.
+type CDU struct {
+	ComponentID     string
+	RackID          uuid.UUID
+	Model           string
+	SerialNumber    string
+	FirmwareVersion string
+	// Enabled reports whether the CDU is circulating coolant.
+	Enabled       bool
+	Health        Health
+	Pumps         []Pump
+	Valves        []Valve
+	Coolant       Coolant
+	LeakDetectors []LeakDetector
+	// Error is set if the CDU could not be read; the other fields are then
+	// unset.
+	Error string
+}
+
+// Pump is a coolant pump of a CDU.
+type Pump struct {
+	ID           string
+	Name         string
+	Enabled      bool
+	Health       Health
+	SpeedPercent float64
+}
+
+// Valve is a coolant valve of a CDU.
+type Valve struct {
+	ID              string
+	Name            string
+	SetPointPercent float64
+	PositionPercent float32
+}
+
+// Coolant holds the readings of the primary coolant connectors of a CDU: the
+// total flow and the highest supply and return temperatures.
+type Coolant struct {
+	SupplyTemperatureCelsius float32
+	ReturnTemperatureCelsius float32
+	FlowLitersPerMinute      float32
+}
+
+// LeakDetector is a leak sensor of a CDU.
+type LeakDetector struct {
+	ID    string
+	Name  string
+	State Health
+}
+
+// Leaking reports whether the detector senses a leak.
+func (d LeakDetector) Leaking() bool {
+	return d.State == HealthWarning || d.State == HealthCritical
+}
+
+// ModeResult is the outcome of SetMode for one CDU. Error is empty on success.
+type ModeResult struct {
+	ComponentID string
+	Error       string
+}
+
+// Limits are the readings a CDU must stay within to count as healthy. A zero
+// limit is not checked.
+type Limits struct {
+	MinFlowLitersPerMinute      float32
+	MaxSupplyTemperatureCelsius float32
+	MinRunningPumps             int
+}
+
+// DefaultLimits only require a single running pump.
+var DefaultLimits = Limits{MinRunningPumps: 1}
+
+// Leaks returns the leak detectors of the CDU that sense a leak.
+func (c CDU) Leaks() []LeakDetector {
+	var leaks []LeakDetector
+	for _, d := range c.LeakDetectors {
+		if d.Leaking() {
+			leaks = append(leaks, d)
+		}
+	}
+	return leaks
+}
+
+// RunningPumps returns the number of enabled pumps that are not critical.
+func (c CDU) RunningPumps() int {
+	n := 0
+	for _, p := range c.Pumps {
+		if p.Enabled && p.Health != HealthCritical {
+			n++
+		}
+	}
+	return n
+}
+
+// Problems returns why the CDU cannot be relied on to cool its rack, or nil if
+// it is healthy within limits.
+func (c CDU) Problems(limits Limits) []string {
+	if c.Error != "" {
+		return []string{"unreachable: " + c.Error}
+	}
+
+	var problems []string
+	if !c.Enabled {
+		problems = append(problems, "disabled")
+	}
+	if c.Health == HealthCritical {
+		problems = append(problems, "health is critical")
+	}
+	for _, leak := range c.Leaks() {
+		problems = append(problems, fmt.Sprintf("leak detected by %s", leak.Name))
+	}
+	if running := c.RunningPumps(); running < limits.MinRunningPumps {
+		problems = append(problems, fmt.Sprintf("%d of %d required pumps running", running, limits.MinRunningPumps))
+	}
+	if limits.MinFlowLitersPerMinute > 0 && c.Coolant.FlowLitersPerMinute < limits.MinFlowLitersPerMinute {
+		problems = append(problems, fmt.Sprintf(
+			"flow %.1f L/min below %.1f L/min",
+			c.Coolant.FlowLitersPerMinute, limits.MinFlowLitersPerMinute,
+		))
+	}
+	if limits.MaxSupplyTemperatureCelsius > 0 &&
+		c.Coolant.SupplyTemperatureCelsius > limits.MaxSupplyTemperatureCelsius {
+		problems = append(problems, fmt.Sprintf(
+			"supply temperature %.1f°C above %.1f°C",
+			c.Coolant.SupplyTemperatureCelsius, limits.MaxSupplyTemperatureCelsius,
+		))
+	}
+
+	return problems
+}
@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cduapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func healthyCDU() CDU {
+	return CDU{
+		ComponentID: "aa:bb:cc:00:00:01",
+		Enabled:     true,
+		Health:      HealthOK,
+		Pumps: []Pump{
+			{ID: "1", Enabled: true, Health: HealthOK},
+			{ID: "2", Enabled: true, Health: HealthOK},
+		},
+		Coolant: Coolant{
+			SupplyTemperatureCelsius: 30,
+			ReturnTemperatureCelsius: 40,
+			FlowLitersPerMinute:      80,
+		},
+		LeakDetectors: []LeakDetector{{ID: "tray", Name: "Drip tray", State: HealthOK}},
+	}
+}
+
+func TestCDU_Problems(t *testing.T) {
+	limits := Limits{
+		MinFlowLitersPerMinute:      50,
+		MaxSupplyTemperatureCelsius: 35,
+		MinRunningPumps:             2,
+	}
+
+	testCases := map[string]struct {
+		modify   func(*CDU)
+		limits   Limits
+		expected []string
+	}{
+		"healthy": {
+			modify: func(*CDU) {},
+			limits: limits,
+		},
+		"unreachable": {
+			modify:   func(c *CDU) { c.Error = "connection refused" },
+			limits:   limits,
+			expected: []string{"unreachable: connection refused"},
+		},
+		"disabled and critical": {
+			modify: func(c *CDU) {
+				c.Enabled = false
+				c.Health = HealthCritical
+			},
+			limits:   limits,
+			expected: []string{"disabled", "health is critical"},
+		},
+		"leak": {
+			modify:   func(c *CDU) { c.LeakDetectors[0].State = HealthWarning },
+			limits:   limits,
+			expected: []string{"leak detected by Drip tray"},
+		},
+		"failed pump": {
+			modify:   func(c *CDU) { c.Pumps[1].Health = HealthCritical },
+			limits:   limits,
+			expected: []string{"1 of 2 required pumps running"},
+		},
+		"low flow and hot supply": {
+			modify: func(c *CDU) {
+				c.Coolant.FlowLitersPerMinute = 20
+				c.Coolant.SupplyTemperatureCelsius = 38
+			},
+			limits: limits,
+			expected: []string{
+				"flow 20.0 L/min below 50.0 L/min",
+				"supply temperature 38.0°C above 35.0°C",
+			},
+		},
+		"zero limits are not checked": {
+			modify: func(c *CDU) {
+				c.Coolant.FlowLitersPerMinute = 0
+				c.Coolant.SupplyTemperatureCelsius = 90
+			},
+			limits: DefaultLimits,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cdu := healthyCDU()
+			tc.modify(&cdu)
+			assert.Equal(t, tc.expected, cdu.Problems(tc.limits))
+		})
+	}
+}
+
+func TestMockClient(t *testing.T) {
+	ctx := context.Background()
+	client := NewMockClient()
+	client.AddCDU(healthyCDU())
+
+	cdus, err := client.GetCDUs(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, cdus, 1)
+
+	cdus, err = client.GetCDUs(ctx, []string{"AA:BB:CC:00:00:01", "aa:bb:cc:00:00:09"})
+	require.NoError(t, err)
+	require.Len(t, cdus, 1)
+
+	results, err := client.SetMode(ctx, []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:09"}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Empty(t, results[0].Error)
+	assert.NotEmpty(t, results[1].Error)
+
+	cdus, err = client.GetCDUs(ctx, nil)
+	require.NoError(t, err)
+	assert.False(t, cdus[0].Enabled)
+}
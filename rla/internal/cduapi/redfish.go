@@ -0,0 +1,312 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cduapi
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stmcginnis/gofish"
+	gofishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+type redfishClient struct {
+	resolver   EndpointResolver
+	timeout    time.Duration
+	httpClient *http.Client
+}
+
+// NewClient creates a client that talks to each CDU's Redfish service, found
+// through resolver. Every request to a CDU is bounded by timeout.
+func NewClient(timeout time.Duration, resolver EndpointResolver) (Client, error) {
+	if resolver == nil {
+		return nil, errors.New("CDU endpoint resolver is required")
+	}
+
+	return &redfishClient{
+		resolver: resolver,
+		timeout:  timeout,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				// CDU BMCs ship self-signed certificates.
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+			},
+		},
+	}, nil
+}
+
+func (c *redfishClient) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func (c *redfishClient) AddCDU(CDU) {
+	panic("AddCDU is only valid in the mock environment")
+}
+
+func (c *redfishClient) GetCDUs(ctx context.Context, componentIDs []string) ([]CDU, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve CDU endpoints: %w", err)
+	}
+
+	result := make([]CDU, 0, len(endpoints))
+	for _, ep := range endpoints {
+		cdu, err := c.readCDU(ctx, ep)
+		if err != nil {
+			log.Warn().Err(err).Str("component_id", ep.ComponentID).Msg("Unable to read CDU")
+			cdu = &CDU{Health: HealthUnknown, Error: err.Error()}
+		}
+		cdu.ComponentID = ep.ComponentID
+		cdu.RackID = ep.RackID
+		result = append(result, *cdu)
+	}
+
+	return result, nil
+}
+
+func (c *redfishClient) SetMode(ctx context.Context, componentIDs []string, enabled bool) ([]ModeResult, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve CDU endpoints: %w", err)
+	}
+
+	mode := "Disabled"
+	if enabled {
+		mode = "Enabled"
+	}
+
+	result := make([]ModeResult, 0, len(endpoints))
+	for _, ep := range endpoints {
+		res := ModeResult{ComponentID: ep.ComponentID}
+		if err := c.setMode(ctx, ep, mode); err != nil {
+			res.Error = err.Error()
+		}
+		result = append(result, res)
+	}
+
+	return result, nil
+}
+
+// connect logs in to the Redfish service of ep. The caller must log out.
+func (c *redfishClient) connect(ctx context.Context, ep Endpoint) (*gofish.APIClient, error) {
+	return gofish.ConnectContext(ctx, gofish.ClientConfig{
+		Endpoint:   ep.Address,
+		Username:   ep.Username,
+		Password:   ep.Password,
+		Insecure:   true,
+		HTTPClient: c.httpClient,
+	})
+}
+
+// coolingUnit returns the cooling unit of a CDU. A CDU BMC manages a single
+// cooling unit.
+func coolingUnit(service *gofish.Service) (*redfish.CoolingUnit, error) {
+	thermal, err := service.ThermalEquipment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thermal equipment: %w", err)
+	}
+
+	units, err := thermal.CDUs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cooling units: %w", err)
+	}
+	if len(units) == 0 {
+		return nil, errors.New("no cooling unit found")
+	}
+
+	return units[0], nil
+}
+
+func (c *redfishClient) readCDU(ctx context.Context, ep Endpoint) (*CDU, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	client, err := c.connect(ctx, ep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", ep.Address, err)
+	}
+	defer client.Logout()
+
+	unit, err := coolingUnit(client.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	cdu := &CDU{
+		Model:           unit.Model,
+		SerialNumber:    unit.SerialNumber,
+		FirmwareVersion: unit.FirmwareVersion,
+		Enabled:         unit.Status.State == gofishcommon.EnabledState,
+		Health:          healthFrom(unit.Status.Health),
+	}
+
+	pumps, err := unit.Pumps()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pumps: %w", err)
+	}
+	for _, p := range pumps {
+		cdu.Pumps = append(cdu.Pumps, Pump{
+			ID:           p.ID,
+			Name:         p.Name,
+			Enabled:      p.Status.State == gofishcommon.EnabledState,
+			Health:       healthFrom(p.Status.Health),
+			SpeedPercent: p.PumpSpeedPercent.Reading,
+		})
+	}
+
+	// gofish reads collection members concurrently.
+	sort.Slice(cdu.Pumps, func(i, j int) bool { return cdu.Pumps[i].ID < cdu.Pumps[j].ID })
+
+	connectors, err := unit.PrimaryCoolantConnectors()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read coolant connectors: %w", err)
+	}
+	for _, cc := range connectors {
+		cdu.Coolant.FlowLitersPerMinute += cc.FlowLitersPerMinute.Reading
+		cdu.Coolant.SupplyTemperatureCelsius = max(
+			cdu.Coolant.SupplyTemperatureCelsius, cc.SupplyTemperatureCelsius.Reading,
+		)
+		cdu.Coolant.ReturnTemperatureCelsius = max(
+			cdu.Coolant.ReturnTemperatureCelsius, cc.ReturnTemperatureCelsius.Reading,
+		)
+	}
+
+	detectors, err := readLeakDetectors(client, unit)
+	if err != nil {
+		return nil, err
+	}
+	cdu.LeakDetectors = detectors
+
+	valves, err := readValves(client.Service)
+	if err != nil {
+		return nil, err
+	}
+	cdu.Valves = valves
+
+	return cdu, nil
+}
+
+// readLeakDetectors returns the leak detectors of unit. The LeakDetection
+// resource lives at a fixed path below the cooling unit; gofish reads its link
+// as a collection, so it is fetched directly. A unit without leak detection
+// has no detectors.
+func readLeakDetectors(client *gofish.APIClient, unit *redfish.CoolingUnit) ([]LeakDetector, error) {
+	leakDetection, err := redfish.GetLeakDetection(client, unit.ODataID+"/LeakDetection")
+	if err != nil {
+		var rfErr *gofishcommon.Error
+		if errors.As(err, &rfErr) && rfErr.HTTPReturnedStatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read leak detection: %w", err)
+	}
+
+	detectors, err := leakDetection.LeakDetectors()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leak detectors: %w", err)
+	}
+
+	result := make([]LeakDetector, 0, len(detectors))
+	for _, d := range detectors {
+		result = append(result, LeakDetector{
+			ID:    d.ID,
+			Name:  d.Name,
+			State: healthFrom(d.DetectorState),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return result, nil
+}
+
+// readValves returns the valve controls of every chassis of the CDU.
+func readValves(service *gofish.Service) ([]Valve, error) {
+	chassis, err := service.Chassis()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chassis: %w", err)
+	}
+
+	var valves []Valve
+	for _, ch := range chassis {
+		controls, err := ch.Controls()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read controls of chassis %s: %w", ch.ID, err)
+		}
+		for _, ctl := range controls {
+			if ctl.ControlType != redfish.ValveControlType {
+				continue
+			}
+			valves = append(valves, Valve{
+				ID:              ctl.ID,
+				Name:            ctl.Name,
+				SetPointPercent: ctl.SetPoint,
+				PositionPercent: ctl.Sensor.Reading,
+			})
+		}
+	}
+
+	sort.Slice(valves, func(i, j int) bool { return valves[i].ID < valves[j].ID })
+
+	return valves, nil
+}
+
+// setMode posts the CoolingUnit.SetMode action, which gofish does not model.
+func (c *redfishClient) setMode(ctx context.Context, ep Endpoint, mode string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	client, err := c.connect(ctx, ep)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", ep.Address, err)
+	}
+	defer client.Logout()
+
+	unit, err := coolingUnit(client.Service)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Post(unit.ODataID+"/Actions/CoolingUnit.SetMode", map[string]string{"Mode": mode})
+	if err != nil {
+		return fmt.Errorf("failed to set mode %s: %w", mode, err)
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+func healthFrom(h gofishcommon.Health) Health {
+	switch h {
+	case gofishcommon.OKHealth:
+		return HealthOK
+	case gofishcommon.WarningHealth:
+		return HealthWarning
+	case gofishcommon.CriticalHealth:
+		return HealthCritical
+	default:
+		return HealthUnknown
+	}
+}
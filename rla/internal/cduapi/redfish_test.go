@@ -0,0 +1,278 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cduapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cduPath = "/redfish/v1/ThermalEquipment/CDUs/1"
+
+// fakeCDU serves the Redfish resources of a CDU and records SetMode calls.
+type fakeCDU struct {
+	mu        sync.Mutex
+	resources map[string]any
+	modes     []string
+}
+
+func newFakeCDU() *fakeCDU {
+	link := func(path string) map[string]string { return map[string]string{"@odata.id": path} }
+	collection := func(members ...string) map[string]any {
+		links := make([]map[string]string, 0, len(members))
+		for _, m := range members {
+			links = append(links, link(m))
+		}
+		return map[string]any{"Members": links, "Members@odata.count": len(links)}
+	}
+
+	return &fakeCDU{resources: map[string]any{
+		"/redfish/v1/": map[string]any{
+			"@odata.id":        "/redfish/v1/",
+			"Id":               "RootService",
+			"Chassis":          link("/redfish/v1/Chassis"),
+			"ThermalEquipment": link("/redfish/v1/ThermalEquipment"),
+			"SessionService":   link("/redfish/v1/SessionService"),
+			"Links":            map[string]any{"Sessions": link("/redfish/v1/SessionService/Sessions")},
+		},
+		"/redfish/v1/ThermalEquipment": map[string]any{
+			"@odata.id": "/redfish/v1/ThermalEquipment",
+			"CDUs":      link("/redfish/v1/ThermalEquipment/CDUs"),
+		},
+		"/redfish/v1/ThermalEquipment/CDUs": collection(cduPath),
+		cduPath: map[string]any{
+			"@odata.id":                cduPath,
+			"Id":                       "1",
+			"Model":                    "CDU-1000",
+			"SerialNumber":             "CDU0001",
+			"FirmwareVersion":          "2.1.0",
+			"Status":                   map[string]string{"State": "Enabled", "Health": "OK"},
+			"Pumps":                    link(cduPath + "/Pumps"),
+			"PrimaryCoolantConnectors": link(cduPath + "/PrimaryCoolantConnectors"),
+			"LeakDetection":            link(cduPath + "/LeakDetection"),
+		},
+		cduPath + "/Pumps": collection(cduPath+"/Pumps/1", cduPath+"/Pumps/2"),
+		cduPath + "/Pumps/1": map[string]any{
+			"@odata.id":        cduPath + "/Pumps/1",
+			"Id":               "1",
+			"Name":             "Pump 1",
+			"Status":           map[string]string{"State": "Enabled", "Health": "OK"},
+			"PumpSpeedPercent": map[string]any{"Reading": 62.5},
+		},
+		cduPath + "/Pumps/2": map[string]any{
+			"@odata.id":        cduPath + "/Pumps/2",
+			"Id":               "2",
+			"Name":             "Pump 2",
+			"Status":           map[string]string{"State": "StandbySpare", "Health": "OK"},
+			"PumpSpeedPercent": map[string]any{"Reading": 0},
+		},
+		cduPath + "/PrimaryCoolantConnectors": collection(
+			cduPath+"/PrimaryCoolantConnectors/A", cduPath+"/PrimaryCoolantConnectors/B",
+		),
+		cduPath + "/PrimaryCoolantConnectors/A": map[string]any{
+			"@odata.id":                cduPath + "/PrimaryCoolantConnectors/A",
+			"Id":                       "A",
+			"FlowLitersPerMinute":      map[string]any{"Reading": 40},
+			"SupplyTemperatureCelsius": map[string]any{"Reading": 31},
+			"ReturnTemperatureCelsius": map[string]any{"Reading": 42},
+		},
+		cduPath + "/PrimaryCoolantConnectors/B": map[string]any{
+			"@odata.id":                cduPath + "/PrimaryCoolantConnectors/B",
+			"Id":                       "B",
+			"FlowLitersPerMinute":      map[string]any{"Reading": 35},
+			"SupplyTemperatureCelsius": map[string]any{"Reading": 32},
+			"ReturnTemperatureCelsius": map[string]any{"Reading": 41},
+		},
+		cduPath + "/LeakDetection": map[string]any{
+			"@odata.id":     cduPath + "/LeakDetection",
+			"Id":            "LeakDetection",
+			"LeakDetectors": link(cduPath + "/LeakDetection/LeakDetectors"),
+		},
+		cduPath + "/LeakDetection/LeakDetectors": collection(cduPath + "/LeakDetection/LeakDetectors/Tray"),
+		cduPath + "/LeakDetection/LeakDetectors/Tray": map[string]any{
+			"@odata.id":     cduPath + "/LeakDetection/LeakDetectors/Tray",
+			"Id":            "Tray",
+			"Name":          "Drip tray",
+			"DetectorState": "Critical",
+		},
+		"/redfish/v1/Chassis": collection("/redfish/v1/Chassis/CDU"),
+		"/redfish/v1/Chassis/CDU": map[string]any{
+			"@odata.id": "/redfish/v1/Chassis/CDU",
+			"Id":        "CDU",
+			"Controls":  link("/redfish/v1/Chassis/CDU/Controls"),
+		},
+		"/redfish/v1/Chassis/CDU/Controls": collection(
+			"/redfish/v1/Chassis/CDU/Controls/Valve1", "/redfish/v1/Chassis/CDU/Controls/Fan1",
+		),
+		"/redfish/v1/Chassis/CDU/Controls/Valve1": map[string]any{
+			"@odata.id":   "/redfish/v1/Chassis/CDU/Controls/Valve1",
+			"Id":          "Valve1",
+			"Name":        "Bypass valve",
+			"ControlType": "Valve",
+			"SetPoint":    30,
+			"Sensor":      map[string]any{"Reading": 28.5},
+		},
+		"/redfish/v1/Chassis/CDU/Controls/Fan1": map[string]any{
+			"@odata.id":   "/redfish/v1/Chassis/CDU/Controls/Fan1",
+			"Id":          "Fan1",
+			"ControlType": "Percent",
+		},
+	}}
+}
+
+func (f *fakeCDU) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/redfish/v1/SessionService/Sessions":
+		w.Header().Set("X-Auth-Token", "token")
+		w.Header().Set("Location", "/redfish/v1/SessionService/Sessions/1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"Id":"1"}`))
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == cduPath+"/Actions/CoolingUnit.SetMode":
+		var body struct{ Mode string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.modes = append(f.modes, body.Mode)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet:
+		res, ok := f.resources[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+type staticResolver []Endpoint
+
+func (r staticResolver) Endpoints(_ context.Context, componentIDs []string) ([]Endpoint, error) {
+	if len(componentIDs) == 0 {
+		return r, nil
+	}
+
+	var result []Endpoint
+	for _, ep := range r {
+		for _, id := range componentIDs {
+			if ep.ComponentID == id {
+				result = append(result, ep)
+			}
+		}
+	}
+	return result, nil
+}
+
+func newTestClient(t *testing.T, endpoints ...Endpoint) Client {
+	client, err := NewClient(5*time.Second, staticResolver(endpoints))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestRedfishClient_GetCDUs(t *testing.T) {
+	ts := httptest.NewTLSServer(newFakeCDU())
+	t.Cleanup(ts.Close)
+
+	rackID := uuid.New()
+	client := newTestClient(t, Endpoint{
+		ComponentID: "aa:bb:cc:00:00:01",
+		RackID:      rackID,
+		Address:     ts.URL,
+		Username:    "root",
+		Password:    "secret",
+	})
+
+	cdus, err := client.GetCDUs(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, cdus, 1)
+
+	cdu := cdus[0]
+	assert.Empty(t, cdu.Error)
+	assert.Equal(t, "aa:bb:cc:00:00:01", cdu.ComponentID)
+	assert.Equal(t, rackID, cdu.RackID)
+	assert.Equal(t, "CDU-1000", cdu.Model)
+	assert.Equal(t, "2.1.0", cdu.FirmwareVersion)
+	assert.True(t, cdu.Enabled)
+	assert.Equal(t, HealthOK, cdu.Health)
+
+	require.Len(t, cdu.Pumps, 2)
+	assert.Equal(t, 62.5, cdu.Pumps[0].SpeedPercent)
+	assert.False(t, cdu.Pumps[1].Enabled)
+	assert.Equal(t, 1, cdu.RunningPumps())
+
+	assert.InDelta(t, 75, cdu.Coolant.FlowLitersPerMinute, 0.01)
+	assert.InDelta(t, 32, cdu.Coolant.SupplyTemperatureCelsius, 0.01)
+	assert.InDelta(t, 42, cdu.Coolant.ReturnTemperatureCelsius, 0.01)
+
+	require.Len(t, cdu.Valves, 1)
+	assert.Equal(t, "Bypass valve", cdu.Valves[0].Name)
+	assert.Equal(t, 30.0, cdu.Valves[0].SetPointPercent)
+	assert.InDelta(t, 28.5, cdu.Valves[0].PositionPercent, 0.01)
+
+	require.Len(t, cdu.Leaks(), 1)
+	assert.Equal(t, "Drip tray", cdu.Leaks()[0].Name)
+}
+
+func TestRedfishClient_GetCDUs_Unreachable(t *testing.T) {
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	addr := ts.URL
+	ts.Close()
+
+	client := newTestClient(t, Endpoint{ComponentID: "aa:bb:cc:00:00:02", Address: addr})
+
+	cdus, err := client.GetCDUs(context.Background(), []string{"aa:bb:cc:00:00:02"})
+	require.NoError(t, err)
+	require.Len(t, cdus, 1)
+	assert.NotEmpty(t, cdus[0].Error)
+	assert.Equal(t, HealthUnknown, cdus[0].Health)
+	assert.NotEmpty(t, cdus[0].Problems(DefaultLimits))
+}
+
+func TestRedfishClient_SetMode(t *testing.T) {
+	fake := newFakeCDU()
+	ts := httptest.NewTLSServer(fake)
+	t.Cleanup(ts.Close)
+
+	client := newTestClient(t, Endpoint{ComponentID: "aa:bb:cc:00:00:01", Address: ts.URL})
+
+	results, err := client.SetMode(context.Background(), []string{"aa:bb:cc:00:00:01"}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Empty(t, results[0].Error)
+	assert.Equal(t, []string{"Disabled"}, fake.modes)
+}
+
+func TestNewClient_RequiresResolver(t *testing.T) {
+	_, err := NewClient(time.Second, nil)
+	require.Error(t, err)
+}
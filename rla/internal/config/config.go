@@ -26,6 +26,8 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
 const defaultLocation = "/etc/rla/rlaconfig.yaml"
@@ -37,6 +39,56 @@ type Config struct {
 	DisableInventory      bool          `yaml:"disable_inventory"`
 	LeakDetectionInterval time.Duration `yaml:"leak_detection_interval"`
 	DisableLeakDetection  bool          `yaml:"disable_leak_detection"`
+	PowerBudgetInterval   time.Duration `yaml:"power_budget_interval"`
+	DisablePowerBudget    bool          `yaml:"disable_power_budget"`
+	// PowerEstimates is the expected draw in watts of a component of each type
+	// once powered on, keyed by component type name (e.g. "Compute"). Power
+	// budget admission checks add it for every component an operation powers on.
+	PowerEstimates map[devicetypes.ComponentType]float64 `yaml:"power_estimates"`
+	// Discovery configures the Redfish sweep of management subnets that finds
+	// power shelves and NV-Switch trays and registers them.
+	Discovery DiscoveryConfig `yaml:"discovery"`
+	// CampaignInterval is how often running campaigns check their rack tasks
+	// and start their next batch.
+	CampaignInterval time.Duration `yaml:"campaign_interval"`
+	// Maintenance configures how disruptive tasks treat the maintenance
+	// calendar.
+	Maintenance MaintenanceConfig `yaml:"maintenance"`
+}
+
+// MaintenanceConfig configures the maintenance calendar.
+type MaintenanceConfig struct {
+	// DeferByDefault makes disruptive tasks submitted while their rack is
+	// closed for maintenance wait for the next opening, unless the request
+	// asks otherwise. By default they are rejected.
+	DeferByDefault bool `yaml:"defer_by_default"`
+}
+
+// DiscoveryConfig configures device discovery. Discovery is disabled when no
+// subnets are configured.
+type DiscoveryConfig struct {
+	// Subnets are the management subnets swept, in CIDR notation.
+	Subnets []string `yaml:"subnets"`
+	// Interval is how often the subnets are swept.
+	Interval time.Duration `yaml:"interval"`
+	// Port is the HTTPS port of the Redfish service probed on every address.
+	Port int `yaml:"port"`
+	// Concurrency is the number of addresses probed in parallel.
+	Concurrency int `yaml:"concurrency"`
+	// ProbeTimeout bounds the probe of a single address.
+	ProbeTimeout time.Duration `yaml:"probe_timeout"`
+	// Credentials are tried in order to read Chassis and Manager data; the
+	// first accepted one is also used to register power shelves.
+	Credentials []DiscoveryCredential `yaml:"credentials"`
+	// AutoRegister registers devices matched to an expected component right
+	// away instead of holding them for approval.
+	AutoRegister bool `yaml:"auto_register"`
+}
+
+// DiscoveryCredential is a Redfish account tried while probing devices.
+type DiscoveryCredential struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
 }
 
 // defaultConfig sets up the default values used when something is not specified
@@ -45,6 +97,14 @@ func defaultConfig() Config {
 		GRPCTimeout:           time.Minute,
 		LeakDetectionInterval: time.Minute,
 		DisableLeakDetection:  false,
+		PowerBudgetInterval:   time.Minute,
+		Discovery: DiscoveryConfig{
+			Interval:     10 * time.Minute,
+			Port:         443,
+			Concurrency:  32,
+			ProbeTimeout: 5 * time.Second,
+		},
+		CampaignInterval: 30 * time.Second,
 	}
 }
 
@@ -197,6 +197,8 @@ func TaskFrom(dao *model.Task) *taskdef.Task {
 		StartedAt:      dao.StartedAt,
 		FinishedAt:     dao.FinishedAt,
 		QueueExpiresAt: dao.QueueExpiresAt,
+		NotBefore:      dao.NotBefore,
+		Priority:       dao.Priority,
 	}
 }
 
@@ -328,6 +330,44 @@ func TaskTo(task *taskdef.Task) *model.Task {
 		Message:        task.Message,
 		AppliedRuleID:  task.AppliedRuleID,
 		QueueExpiresAt: task.QueueExpiresAt,
+		NotBefore:      task.NotBefore,
+		Priority:       task.Priority,
+	}
+}
+
+// TaskReportEntryTo converts a task report entry to its database model.
+// Entries about the whole task are stored with an empty component type.
+func TaskReportEntryTo(taskID uuid.UUID, entry taskdef.ReportEntry) model.TaskReportEntry {
+	componentType := ""
+	if entry.ComponentType != devicetypes.ComponentTypeUnknown {
+		componentType = ComponentTypeTo(entry.ComponentType)
+	}
+
+	return model.TaskReportEntry{
+		TaskID:        taskID,
+		RecordedAt:    entry.Time,
+		ComponentType: componentType,
+		ComponentID:   entry.ComponentID,
+		Stage:         entry.Stage,
+		Step:          entry.Step,
+		Action:        entry.Action,
+		Outcome:       string(entry.Outcome),
+		Message:       entry.Message,
+	}
+}
+
+// TaskReportEntryFrom converts a task report entry database model to the
+// internal model.
+func TaskReportEntryFrom(dao *model.TaskReportEntry) taskdef.ReportEntry {
+	return taskdef.ReportEntry{
+		Time:          dao.RecordedAt,
+		ComponentType: devicetypes.ComponentTypeFromString(dao.ComponentType),
+		ComponentID:   dao.ComponentID,
+		Stage:         dao.Stage,
+		Step:          dao.Step,
+		Action:        dao.Action,
+		Outcome:       taskdef.ReportOutcome(dao.Outcome),
+		Message:       dao.Message,
 	}
 }
 
@@ -434,6 +434,8 @@ func TaskStatusFrom(status pb.TaskStatus) taskcommon.TaskStatus {
 		return taskcommon.TaskStatusTerminated
 	case pb.TaskStatus_TASK_STATUS_WAITING:
 		return taskcommon.TaskStatusWaiting
+	case pb.TaskStatus_TASK_STATUS_WAITING_APPROVAL:
+		return taskcommon.TaskStatusWaitingApproval
 	default:
 		return taskcommon.TaskStatusUnknown
 	}
@@ -454,6 +456,8 @@ func TaskStatusTo(status taskcommon.TaskStatus) pb.TaskStatus {
 		return pb.TaskStatus_TASK_STATUS_TERMINATED
 	case taskcommon.TaskStatusWaiting:
 		return pb.TaskStatus_TASK_STATUS_WAITING
+	case taskcommon.TaskStatusWaitingApproval:
+		return pb.TaskStatus_TASK_STATUS_WAITING_APPROVAL
 	default:
 		return pb.TaskStatus_TASK_STATUS_UNKNOWN
 	}
@@ -482,6 +486,7 @@ func TaskTo(task *taskdef.Task) *pb.Task {
 		Message:        task.Message,
 		CreatedAt:      timestamppb.New(task.CreatedAt),
 		UpdatedAt:      timestamppb.New(task.UpdatedAt),
+		Priority:       TaskPriorityTo(task.Priority),
 	}
 	if task.AppliedRuleID != nil {
 		pbTask.AppliedRuleId = UUIDTo(*task.AppliedRuleID)
@@ -496,10 +501,127 @@ func TaskTo(task *taskdef.Task) *pb.Task {
 	if task.QueueExpiresAt != nil {
 		pbTask.QueueExpiresAt = timestamppb.New(*task.QueueExpiresAt)
 	}
+	if task.NotBefore != nil {
+		pbTask.NotBefore = timestamppb.New(*task.NotBefore)
+	}
+
+	for _, approval := range task.Attributes.Approvals {
+		pbTask.Approvals = append(pbTask.Approvals, TaskApprovalTo(approval))
+	}
+	for _, preemption := range task.Attributes.Preemptions {
+		pbTask.Preemptions = append(pbTask.Preemptions, &pb.TaskPreemption{
+			PreemptedBy: UUIDTo(preemption.By),
+			Priority:    TaskPriorityTo(preemption.Priority),
+			Reason:      preemption.Reason,
+			Requeued:    preemption.Requeued,
+			PreemptedAt: timestamppb.New(preemption.At),
+		})
+	}
 
 	return pbTask
 }
 
+// TaskPriorityTo converts an internal TaskPriority to protobuf. An empty
+// priority is normal.
+func TaskPriorityTo(priority taskcommon.TaskPriority) pb.TaskPriority {
+	switch priority {
+	case taskcommon.TaskPriorityEmergency:
+		return pb.TaskPriority_TASK_PRIORITY_EMERGENCY
+	case taskcommon.TaskPriorityBackground:
+		return pb.TaskPriority_TASK_PRIORITY_BACKGROUND
+	default:
+		return pb.TaskPriority_TASK_PRIORITY_NORMAL
+	}
+}
+
+// TaskPriorityFrom converts a protobuf TaskPriority to internal. Unspecified
+// is normal.
+func TaskPriorityFrom(priority pb.TaskPriority) taskcommon.TaskPriority {
+	switch priority {
+	case pb.TaskPriority_TASK_PRIORITY_EMERGENCY:
+		return taskcommon.TaskPriorityEmergency
+	case pb.TaskPriority_TASK_PRIORITY_BACKGROUND:
+		return taskcommon.TaskPriorityBackground
+	default:
+		return taskcommon.TaskPriorityNormal
+	}
+}
+
+// TaskReportEntryTo converts a task report entry to protobuf.
+func TaskReportEntryTo(entry taskdef.ReportEntry) *pb.TaskReportEntry {
+	return &pb.TaskReportEntry{
+		Time:          timestamppb.New(entry.Time),
+		ComponentType: ComponentTypeTo(entry.ComponentType),
+		ComponentId:   entry.ComponentID,
+		Stage:         int32(entry.Stage),
+		Step:          entry.Step,
+		Action:        entry.Action,
+		Outcome:       TaskReportOutcomeTo(entry.Outcome),
+		Message:       entry.Message,
+	}
+}
+
+// TaskReportOutcomeTo converts an internal ReportOutcome to protobuf.
+func TaskReportOutcomeTo(outcome taskdef.ReportOutcome) pb.TaskReportOutcome {
+	switch outcome {
+	case taskdef.ReportOutcomeSucceeded:
+		return pb.TaskReportOutcome_TASK_REPORT_OUTCOME_SUCCEEDED
+	case taskdef.ReportOutcomeFailed:
+		return pb.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED
+	case taskdef.ReportOutcomeTerminated:
+		return pb.TaskReportOutcome_TASK_REPORT_OUTCOME_TERMINATED
+	default:
+		return pb.TaskReportOutcome_TASK_REPORT_OUTCOME_UNKNOWN
+	}
+}
+
+// TaskReportFormatFrom converts a protobuf TaskReportFormat to internal.
+// Unspecified returns an empty format: no rendering.
+func TaskReportFormatFrom(format pb.TaskReportFormat) taskdef.ReportFormat {
+	switch format {
+	case pb.TaskReportFormat_TASK_REPORT_FORMAT_JSON:
+		return taskdef.ReportFormatJSON
+	case pb.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN:
+		return taskdef.ReportFormatMarkdown
+	default:
+		return ""
+	}
+}
+
+// TaskApprovalTo converts an approval gate record to protobuf.
+func TaskApprovalTo(approval taskcommon.Approval) *pb.TaskApproval {
+	pbApproval := &pb.TaskApproval{
+		Id:              approval.ID,
+		Step:            approval.Step,
+		Prompt:          approval.Prompt,
+		RequestedAt:     timestamppb.New(approval.RequestedAt),
+		Deadline:        timestamppb.New(approval.Deadline),
+		DefaultDecision: ApprovalDecisionTo(approval.DefaultDecision),
+		Decision:        ApprovalDecisionTo(approval.Decision),
+		Approver:        approval.Approver,
+		Comment:         approval.Comment,
+		TimedOut:        approval.TimedOut,
+	}
+	if approval.DecidedAt != nil {
+		pbApproval.DecidedAt = timestamppb.New(*approval.DecidedAt)
+	}
+	return pbApproval
+}
+
+// ApprovalDecisionTo converts an internal ApprovalDecision to protobuf.
+func ApprovalDecisionTo(decision taskcommon.ApprovalDecision) pb.ApprovalDecision {
+	switch decision {
+	case taskcommon.ApprovalDecisionApprove:
+		return pb.ApprovalDecision_APPROVAL_DECISION_APPROVE
+	case taskcommon.ApprovalDecisionReject:
+		return pb.ApprovalDecision_APPROVAL_DECISION_REJECT
+	case taskcommon.ApprovalDecisionAbort:
+		return pb.ApprovalDecision_APPROVAL_DECISION_ABORT
+	default:
+		return pb.ApprovalDecision_APPROVAL_DECISION_NONE
+	}
+}
+
 // ComponentTypeTo converts an internal ComponentType to a protobuf
 // ComponentType
 func ComponentTypeTo(t devicetypes.ComponentType) pb.ComponentType {
@@ -1162,22 +1284,36 @@ func ComponentTargetFrom(ct *pb.ComponentTarget) (operation.ComponentTarget, err
 func ScheduledOperationFrom(
 	scheduled *pb.ScheduledOperation,
 ) (operations.Operation, operation.TargetSpec, *pb.QueueOptions, *pb.UUID, error) {
+	info, pbTargetSpec, queueOpts, ruleID, err := ScheduledOperationInfoFrom(scheduled)
+	if err != nil {
+		return nil, operation.TargetSpec{}, nil, nil, err
+	}
+
+	ts, err := TargetSpecFrom(pbTargetSpec)
+	if err != nil {
+		return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
+			"invalid target_spec: %w", err,
+		)
+	}
+
+	return info, ts, queueOpts, ruleID, nil
+}
+
+// ScheduledOperationInfoFrom is ScheduledOperationFrom without the target
+// conversion: it returns the operation's target_spec as given, possibly nil,
+// for callers that choose the targets themselves.
+func ScheduledOperationInfoFrom(
+	scheduled *pb.ScheduledOperation,
+) (operations.Operation, *pb.OperationTargetSpec, *pb.QueueOptions, *pb.UUID, error) {
 	if scheduled == nil || scheduled.GetOperation() == nil {
-		return nil, operation.TargetSpec{}, nil, nil, errors.New("operation is required")
+		return nil, nil, nil, nil, errors.New("operation is required")
 	}
 
 	switch r := scheduled.GetOperation().(type) {
 	case *pb.ScheduledOperation_PowerOn:
-		ts, err := TargetSpecFrom(r.PowerOn.GetTargetSpec())
-		if err != nil {
-			return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
-				"invalid target_spec: %w", err,
-			)
-		}
-
 		return &operations.PowerControlTaskInfo{
 			Operation: operations.PowerOperationPowerOn,
-		}, ts, r.PowerOn.GetQueueOptions(), r.PowerOn.GetRuleId(), nil
+		}, r.PowerOn.GetTargetSpec(), r.PowerOn.GetQueueOptions(), r.PowerOn.GetRuleId(), nil
 
 	case *pb.ScheduledOperation_PowerOff:
 		powerOp := operations.PowerOperationPowerOff
@@ -1185,17 +1321,10 @@ func ScheduledOperationFrom(
 			powerOp = operations.PowerOperationForcePowerOff
 		}
 
-		ts, err := TargetSpecFrom(r.PowerOff.GetTargetSpec())
-		if err != nil {
-			return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
-				"invalid target_spec: %w", err,
-			)
-		}
-
 		return &operations.PowerControlTaskInfo{
 			Operation: powerOp,
 			Forced:    r.PowerOff.GetForced(),
-		}, ts, r.PowerOff.GetQueueOptions(), r.PowerOff.GetRuleId(), nil
+		}, r.PowerOff.GetTargetSpec(), r.PowerOff.GetQueueOptions(), r.PowerOff.GetRuleId(), nil
 
 	case *pb.ScheduledOperation_PowerReset:
 		powerOp := operations.PowerOperationRestart
@@ -1203,37 +1332,17 @@ func ScheduledOperationFrom(
 			powerOp = operations.PowerOperationForceRestart
 		}
 
-		ts, err := TargetSpecFrom(r.PowerReset.GetTargetSpec())
-		if err != nil {
-			return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
-				"invalid target_spec: %w", err,
-			)
-		}
-
 		return &operations.PowerControlTaskInfo{
 			Operation: powerOp,
 			Forced:    r.PowerReset.GetForced(),
-		}, ts, r.PowerReset.GetQueueOptions(), r.PowerReset.GetRuleId(), nil
+		}, r.PowerReset.GetTargetSpec(), r.PowerReset.GetQueueOptions(), r.PowerReset.GetRuleId(), nil
 
 	case *pb.ScheduledOperation_BringUp:
-		ts, err := TargetSpecFrom(r.BringUp.GetTargetSpec())
-		if err != nil {
-			return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
-				"invalid target_spec: %w", err,
-			)
-		}
-
-		return &operations.BringUpTaskInfo{}, ts, nil, r.BringUp.GetRuleId(), nil
+		return &operations.BringUpTaskInfo{}, r.BringUp.GetTargetSpec(), nil, r.BringUp.GetRuleId(), nil
 
 	case *pb.ScheduledOperation_Ingest:
-		ts, err := TargetSpecFrom(r.Ingest.GetTargetSpec())
-		if err != nil {
-			return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
-				"invalid target_spec: %w", err,
-			)
-		}
-
-		return &operations.BringUpTaskInfo{OpCode: taskcommon.OpCodeIngest}, ts, nil, r.Ingest.GetRuleId(), nil
+		return &operations.BringUpTaskInfo{OpCode: taskcommon.OpCodeIngest},
+			r.Ingest.GetTargetSpec(), nil, r.Ingest.GetRuleId(), nil
 
 	case *pb.ScheduledOperation_UpgradeFirmware:
 		info := &operations.FirmwareControlTaskInfo{
@@ -1249,26 +1358,65 @@ func ScheduledOperationFrom(
 			info.EndTime = r.UpgradeFirmware.GetEndTime().AsTime().Unix()
 		}
 
-		ts, err := TargetSpecFrom(r.UpgradeFirmware.GetTargetSpec())
-		if err != nil {
-			return nil, operation.TargetSpec{}, nil, nil, fmt.Errorf(
-				"invalid target_spec: %w", err,
-			)
-		}
-
-		return info, ts, r.UpgradeFirmware.GetQueueOptions(), r.UpgradeFirmware.GetRuleId(), nil
+		return info, r.UpgradeFirmware.GetTargetSpec(), r.UpgradeFirmware.GetQueueOptions(), r.UpgradeFirmware.GetRuleId(), nil
 
 	default:
 		// Unreachable with well-typed proto code: all
 		// isScheduledOperation_Operation implementations are generated types
 		// with explicit cases above. This fires only if a new oneof variant
 		// is added to the proto without updating this switch.
-		return nil, operation.TargetSpec{}, nil, nil, errors.New(
+		return nil, nil, nil, nil, errors.New(
 			"unsupported scheduled operation type",
 		)
 	}
 }
 
+// MaintenanceOptionsFrom converts a proto MaintenanceOptions message to the
+// two fields used on operation.Request. A nil opts yields the server default
+// policy and no override.
+func MaintenanceOptionsFrom(
+	opts *pb.MaintenanceOptions,
+) (operation.MaintenancePolicy, *operation.MaintenanceOverride) {
+	if opts == nil {
+		return operation.MaintenancePolicyDefault, nil
+	}
+
+	var override *operation.MaintenanceOverride
+	if o := opts.GetOverride(); o != nil {
+		override = &operation.MaintenanceOverride{
+			Reason:      o.GetReason(),
+			RequestedBy: o.GetRequestedBy(),
+		}
+	}
+
+	switch opts.GetPolicy() {
+	case pb.MaintenancePolicy_MAINTENANCE_POLICY_REJECT:
+		return operation.MaintenancePolicyReject, override
+	case pb.MaintenancePolicy_MAINTENANCE_POLICY_DEFER:
+		return operation.MaintenancePolicyDefer, override
+	default:
+		return operation.MaintenancePolicyDefault, override
+	}
+}
+
+// PriorityOptionsFrom extracts the priority class and preemption mode from
+// a proto QueueOptions message. A nil opts yields normal priority without
+// preemption.
+func PriorityOptionsFrom(
+	opts *pb.QueueOptions,
+) (taskcommon.TaskPriority, operation.Preemption) {
+	priority := TaskPriorityFrom(opts.GetPriority())
+
+	switch opts.GetPreemption() {
+	case pb.Preemption_PREEMPTION_CANCEL:
+		return priority, operation.PreemptionCancel
+	case pb.Preemption_PREEMPTION_REQUEUE:
+		return priority, operation.PreemptionRequeue
+	default:
+		return priority, operation.PreemptionNone
+	}
+}
+
 // QueueOptionsFrom converts a proto QueueOptions message to the two fields
 // used on operation.Request. A nil opts is handled safely — both return
 // values will be their zero values (reject on conflict, server default timeout).
@@ -779,6 +779,26 @@ func TestOptionalUUIDFrom(t *testing.T) {
 	})
 }
 
+func TestPriorityOptionsFrom(t *testing.T) {
+	priority, preemption := PriorityOptionsFrom(nil)
+	assert.Equal(t, taskcommon.TaskPriorityNormal, priority)
+	assert.Equal(t, operation.PreemptionNone, preemption)
+
+	priority, preemption = PriorityOptionsFrom(&pb.QueueOptions{
+		Priority:   pb.TaskPriority_TASK_PRIORITY_EMERGENCY,
+		Preemption: pb.Preemption_PREEMPTION_REQUEUE,
+	})
+	assert.Equal(t, taskcommon.TaskPriorityEmergency, priority)
+	assert.Equal(t, operation.PreemptionRequeue, preemption)
+
+	priority, _ = PriorityOptionsFrom(&pb.QueueOptions{
+		Priority: pb.TaskPriority_TASK_PRIORITY_BACKGROUND,
+	})
+	assert.Equal(t, taskcommon.TaskPriorityBackground, priority)
+	assert.Equal(t, pb.TaskPriority_TASK_PRIORITY_BACKGROUND, TaskPriorityTo(priority))
+	assert.Equal(t, pb.TaskPriority_TASK_PRIORITY_NORMAL, TaskPriorityTo(""))
+}
+
 func TestRackTargetFrom(t *testing.T) {
 	rackID := uuid.New()
 
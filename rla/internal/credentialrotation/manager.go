@@ -0,0 +1,470 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package credentialrotation triggers and reports device credential
+// rotation rack-wide. The rotation itself (new password, device update,
+// login verification, vault commit and rollback) is done by the Powershelf
+// Manager for PMC accounts and by the NV-Switch Manager for switch BMC and
+// NVOS accounts; RLA resolves the devices of a rack and fans out to them.
+package credentialrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// InventoryReader is the subset of the inventory store the manager needs.
+type InventoryReader interface {
+	GetRackByID(ctx context.Context, id uuid.UUID, withComponents bool) (*rack.Rack, error)
+}
+
+// PowershelfClient is the subset of the Powershelf Manager client the
+// manager needs to rotate PMC credentials.
+type PowershelfClient interface {
+	RotateCredentials(ctx context.Context, pmcMacs []string) ([]psmapi.PowerControlResult, error)
+	GetCredentialRotationStatus(ctx context.Context, pmcMacs []string) ([]psmapi.CredentialRotationStatus, error)
+}
+
+// NVSwitchClient is the subset of the NV-Switch Manager client the manager
+// needs to rotate switch BMC and NVOS credentials.
+type NVSwitchClient interface {
+	RotateCredentials(ctx context.Context, uuids []string, components []nsmapi.NVSwitchComponent) ([]nsmapi.RotateCredentialsResult, error)
+	GetCredentialRotationStatus(ctx context.Context, uuids []string) ([]nsmapi.CredentialRotationStatus, error)
+}
+
+// Account identifies a credential of a device.
+type Account string
+
+const (
+	// AccountPMC is the Redfish account of a power shelf controller.
+	AccountPMC Account = "PMC"
+	// AccountBMC is the Redfish account of an NVLink switch tray BMC.
+	AccountBMC Account = "BMC"
+	// AccountNVOS is the SSH account of an NVLink switch tray's NVOS.
+	AccountNVOS Account = "NVOS"
+)
+
+// State is the state of the latest credential rotation of an account.
+type State string
+
+const (
+	StateUnknown      State = "unknown"
+	StateNeverRotated State = "never_rotated"
+	StateInProgress   State = "in_progress"
+	StateSucceeded    State = "succeeded"
+	// StateFailed means the rotation failed and the device still accepts
+	// the stored password.
+	StateFailed State = "failed"
+	// StateRollbackFailed means restoring the stored password failed too;
+	// the device needs manual attention.
+	StateRollbackFailed State = "rollback_failed"
+)
+
+// TriggerResult reports whether a rotation of one device account was
+// started.
+type TriggerResult struct {
+	ComponentID   uuid.UUID
+	ComponentType devicetypes.ComponentType
+	// DeviceID is the PMC MAC of a power shelf or the NV-Switch Manager
+	// UUID of an NVLink switch.
+	DeviceID string
+	Account  Account
+	Started  bool
+	Error    string
+}
+
+// AccountStatus is the latest credential rotation of one device account.
+// Zero timestamps mean the event never happened (or, for NextRotation, that
+// scheduled rotation is disabled for the account).
+type AccountStatus struct {
+	ComponentID   uuid.UUID
+	ComponentType devicetypes.ComponentType
+	DeviceID      string
+	Account       Account
+	State         State
+	LastAttempt   time.Time
+	LastRotated   time.Time
+	NextRotation  time.Time
+	RotationError string
+	// Error is set when the status of the account could not be read.
+	Error string
+}
+
+// Manager triggers and reports the credential rotation of the power shelves
+// and NVLink switches of a rack.
+type Manager struct {
+	inventory InventoryReader
+	psm       PowershelfClient
+	nsm       NVSwitchClient
+}
+
+// NewManager creates a credential rotation manager. psm and nsm may be nil
+// when the corresponding service is not configured; the devices it manages
+// are then reported with an error instead of being rotated.
+func NewManager(inventory InventoryReader, psm PowershelfClient, nsm NVSwitchClient) *Manager {
+	return &Manager{
+		inventory: inventory,
+		psm:       psm,
+		nsm:       nsm,
+	}
+}
+
+// RotateRack starts rotating the credentials of the power shelves and/or
+// NVLink switches of a rack. An empty types rotates both. The rotations run
+// in the background; poll RackStatus for their outcome.
+func (m *Manager) RotateRack(
+	ctx context.Context,
+	rackID uuid.UUID,
+	types []devicetypes.ComponentType,
+) ([]TriggerResult, error) {
+	shelves, switches, err := m.rackDevices(ctx, rackID, types)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []TriggerResult
+	results = append(results, m.rotateShelves(ctx, shelves)...)
+	results = append(results, m.rotateSwitches(ctx, switches)...)
+
+	log.Info().
+		Str("rack_id", rackID.String()).
+		Int("accounts", len(results)).
+		Msg("Triggered rack credential rotation")
+
+	return results, nil
+}
+
+// RackStatus returns the latest credential rotation of every power shelf
+// and NVLink switch account in a rack.
+func (m *Manager) RackStatus(ctx context.Context, rackID uuid.UUID) ([]AccountStatus, error) {
+	shelves, switches, err := m.rackDevices(ctx, rackID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []AccountStatus
+	statuses = append(statuses, m.shelfStatuses(ctx, shelves)...)
+	statuses = append(statuses, m.switchStatuses(ctx, switches)...)
+	return statuses, nil
+}
+
+func (m *Manager) rotateShelves(ctx context.Context, shelves []component.Component) []TriggerResult {
+	if len(shelves) == 0 {
+		return nil
+	}
+
+	results := make([]TriggerResult, 0, len(shelves))
+	byMAC := make(map[string]int, len(shelves))
+	macs := make([]string, 0, len(shelves))
+	for _, c := range shelves {
+		byMAC[utils.NormalizeMAC(c.ComponentID)] = len(results)
+		macs = append(macs, c.ComponentID)
+		results = append(results, TriggerResult{
+			ComponentID:   c.Info.ID,
+			ComponentType: c.Type,
+			DeviceID:      c.ComponentID,
+			Account:       AccountPMC,
+		})
+	}
+
+	if m.psm == nil {
+		return failTriggers(results, "powershelf manager is not available")
+	}
+
+	resp, err := m.psm.RotateCredentials(ctx, macs)
+	if err != nil {
+		return failTriggers(results, err.Error())
+	}
+
+	failTriggers(results, "no response from powershelf manager")
+	for _, r := range resp {
+		i, ok := byMAC[utils.NormalizeMAC(r.PMCMACAddress)]
+		if !ok {
+			continue
+		}
+		results[i].Started = r.Status == psmapi.StatusSuccess
+		results[i].Error = r.Error
+	}
+
+	return results
+}
+
+func (m *Manager) rotateSwitches(ctx context.Context, switches []component.Component) []TriggerResult {
+	if len(switches) == 0 {
+		return nil
+	}
+
+	results := make([]TriggerResult, 0, 2*len(switches))
+	byAccount := make(map[string]int, 2*len(switches))
+	ids := make([]string, 0, len(switches))
+	for _, c := range switches {
+		ids = append(ids, c.ComponentID)
+		for _, account := range []Account{AccountBMC, AccountNVOS} {
+			byAccount[switchAccountKey(c.ComponentID, account)] = len(results)
+			results = append(results, TriggerResult{
+				ComponentID:   c.Info.ID,
+				ComponentType: c.Type,
+				DeviceID:      c.ComponentID,
+				Account:       account,
+			})
+		}
+	}
+
+	if m.nsm == nil {
+		return failTriggers(results, "nvswitch manager is not available")
+	}
+
+	resp, err := m.nsm.RotateCredentials(ctx, ids, nil)
+	if err != nil {
+		return failTriggers(results, err.Error())
+	}
+
+	failTriggers(results, "no response from nvswitch manager")
+	for _, r := range resp {
+		i, ok := byAccount[switchAccountKey(r.UUID, accountFromNSM(r.Component))]
+		if !ok {
+			continue
+		}
+		results[i].Started = r.Status == nsmapi.StatusSuccess
+		results[i].Error = r.Error
+	}
+
+	return results
+}
+
+func (m *Manager) shelfStatuses(ctx context.Context, shelves []component.Component) []AccountStatus {
+	if len(shelves) == 0 {
+		return nil
+	}
+
+	statuses := make([]AccountStatus, 0, len(shelves))
+	byMAC := make(map[string]int, len(shelves))
+	macs := make([]string, 0, len(shelves))
+	for _, c := range shelves {
+		byMAC[utils.NormalizeMAC(c.ComponentID)] = len(statuses)
+		macs = append(macs, c.ComponentID)
+		statuses = append(statuses, AccountStatus{
+			ComponentID:   c.Info.ID,
+			ComponentType: c.Type,
+			DeviceID:      c.ComponentID,
+			Account:       AccountPMC,
+			State:         StateUnknown,
+		})
+	}
+
+	if m.psm == nil {
+		return failStatuses(statuses, "powershelf manager is not available")
+	}
+
+	resp, err := m.psm.GetCredentialRotationStatus(ctx, macs)
+	if err != nil {
+		return failStatuses(statuses, err.Error())
+	}
+
+	failStatuses(statuses, "no status from powershelf manager")
+	for _, s := range resp {
+		i, ok := byMAC[utils.NormalizeMAC(s.PMCMACAddress)]
+		if !ok {
+			continue
+		}
+		statuses[i].State = stateFromPSM(s.State)
+		statuses[i].LastAttempt = s.LastAttempt
+		statuses[i].LastRotated = s.LastRotated
+		statuses[i].NextRotation = s.NextRotation
+		statuses[i].RotationError = s.RotationError
+		statuses[i].Error = s.Error
+	}
+
+	return statuses
+}
+
+func (m *Manager) switchStatuses(ctx context.Context, switches []component.Component) []AccountStatus {
+	if len(switches) == 0 {
+		return nil
+	}
+
+	statuses := make([]AccountStatus, 0, 2*len(switches))
+	byAccount := make(map[string]int, 2*len(switches))
+	ids := make([]string, 0, len(switches))
+	for _, c := range switches {
+		ids = append(ids, c.ComponentID)
+		for _, account := range []Account{AccountBMC, AccountNVOS} {
+			byAccount[switchAccountKey(c.ComponentID, account)] = len(statuses)
+			statuses = append(statuses, AccountStatus{
+				ComponentID:   c.Info.ID,
+				ComponentType: c.Type,
+				DeviceID:      c.ComponentID,
+				Account:       account,
+				State:         StateUnknown,
+			})
+		}
+	}
+
+	if m.nsm == nil {
+		return failStatuses(statuses, "nvswitch manager is not available")
+	}
+
+	resp, err := m.nsm.GetCredentialRotationStatus(ctx, ids)
+	if err != nil {
+		return failStatuses(statuses, err.Error())
+	}
+
+	failStatuses(statuses, "no status from nvswitch manager")
+	for _, s := range resp {
+		i, ok := byAccount[switchAccountKey(s.UUID, accountFromNSM(s.Component))]
+		if !ok {
+			continue
+		}
+		statuses[i].State = stateFromNSM(s.State)
+		statuses[i].LastAttempt = s.LastAttempt
+		statuses[i].LastRotated = s.LastRotated
+		statuses[i].NextRotation = s.NextRotation
+		statuses[i].RotationError = s.RotationError
+		statuses[i].Error = s.Error
+	}
+
+	return statuses
+}
+
+// rackDevices returns the power shelves and NVLink switches of a rack that
+// are registered with their manager, restricted to types if non-empty.
+func (m *Manager) rackDevices(
+	ctx context.Context,
+	rackID uuid.UUID,
+	types []devicetypes.ComponentType,
+) (shelves, switches []component.Component, err error) {
+	for _, t := range types {
+		if t != devicetypes.ComponentTypePowerShelf && t != devicetypes.ComponentTypeNVLSwitch {
+			return nil, nil, fmt.Errorf(
+				"credential rotation is not supported for %s components",
+				devicetypes.ComponentTypeToString(t),
+			)
+		}
+	}
+
+	r, err := m.inventory.GetRackByID(ctx, rackID, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("rack %s: %w", rackID, err)
+	}
+
+	for _, c := range r.Components {
+		if c.ComponentID == "" || !wantType(types, c.Type) {
+			continue
+		}
+		switch c.Type {
+		case devicetypes.ComponentTypePowerShelf:
+			shelves = append(shelves, c)
+		case devicetypes.ComponentTypeNVLSwitch:
+			switches = append(switches, c)
+		}
+	}
+
+	return shelves, switches, nil
+}
+
+func wantType(types []devicetypes.ComponentType, t devicetypes.ComponentType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, want := range types {
+		if want == t {
+			return true
+		}
+	}
+	return false
+}
+
+func switchAccountKey(id string, account Account) string {
+	return id + "/" + string(account)
+}
+
+// failTriggers sets msg as the error of every result that has none yet.
+func failTriggers(results []TriggerResult, msg string) []TriggerResult {
+	for i := range results {
+		if !results[i].Started && results[i].Error == "" {
+			results[i].Error = msg
+		}
+	}
+	return results
+}
+
+// failStatuses sets msg as the error of every status that has not been
+// read yet.
+func failStatuses(statuses []AccountStatus, msg string) []AccountStatus {
+	for i := range statuses {
+		if statuses[i].State == StateUnknown && statuses[i].Error == "" {
+			statuses[i].Error = msg
+		}
+	}
+	return statuses
+}
+
+func accountFromNSM(c nsmapi.NVSwitchComponent) Account {
+	switch c {
+	case nsmapi.NVSwitchComponentBMC:
+		return AccountBMC
+	case nsmapi.NVSwitchComponentNVOS:
+		return AccountNVOS
+	default:
+		return Account(c.String())
+	}
+}
+
+func stateFromPSM(s psmapi.CredentialRotationState) State {
+	switch s {
+	case psmapi.CredentialRotationStateNeverRotated:
+		return StateNeverRotated
+	case psmapi.CredentialRotationStateInProgress:
+		return StateInProgress
+	case psmapi.CredentialRotationStateSucceeded:
+		return StateSucceeded
+	case psmapi.CredentialRotationStateFailed:
+		return StateFailed
+	case psmapi.CredentialRotationStateRollbackFailed:
+		return StateRollbackFailed
+	default:
+		return StateUnknown
+	}
+}
+
+func stateFromNSM(s nsmapi.CredentialRotationState) State {
+	switch s {
+	case nsmapi.CredentialRotationStateNeverRotated:
+		return StateNeverRotated
+	case nsmapi.CredentialRotationStateInProgress:
+		return StateInProgress
+	case nsmapi.CredentialRotationStateSucceeded:
+		return StateSucceeded
+	case nsmapi.CredentialRotationStateFailed:
+		return StateFailed
+	case nsmapi.CredentialRotationStateRollbackFailed:
+		return StateRollbackFailed
+	default:
+		return StateUnknown
+	}
+}
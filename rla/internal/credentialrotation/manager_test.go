@@ -0,0 +1,235 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentialrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/deviceinfo"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// --- fakes ---
+
+type fakeInventory struct {
+	racks map[uuid.UUID]*rack.Rack
+}
+
+func (f *fakeInventory) GetRackByID(_ context.Context, id uuid.UUID, _ bool) (*rack.Rack, error) {
+	r, ok := f.racks[id]
+	if !ok {
+		return nil, fmt.Errorf("rack %s not found", id)
+	}
+	return r, nil
+}
+
+type failingPSM struct {
+	psmapi.Client
+}
+
+func (failingPSM) RotateCredentials(context.Context, []string) ([]psmapi.PowerControlResult, error) {
+	return nil, errors.New("connection refused")
+}
+
+// --- helpers ---
+
+const (
+	shelfMAC   = "aa:bb:cc:dd:ee:01"
+	switchUUID = "3f2b1c7e-0000-4000-8000-000000000001"
+)
+
+// newTestRack returns a rack with one power shelf, one NVLink switch and one
+// compute tray, the shelf and switch registered with the mock managers.
+func newTestRack(psm psmapi.Client, nsm nsmapi.Client) *rack.Rack {
+	r := &rack.Rack{Info: deviceinfo.DeviceInfo{ID: uuid.New()}}
+	for _, c := range []struct {
+		typ devicetypes.ComponentType
+		id  string
+	}{
+		{devicetypes.ComponentTypePowerShelf, shelfMAC},
+		{devicetypes.ComponentTypeNVLSwitch, switchUUID},
+		{devicetypes.ComponentTypeCompute, "compute-1"},
+	} {
+		r.Components = append(r.Components, component.Component{
+			Type:        c.typ,
+			Info:        deviceinfo.DeviceInfo{ID: uuid.New()},
+			ComponentID: c.id,
+			RackID:      r.Info.ID,
+		})
+	}
+
+	psm.AddPowershelf(psmapi.PowerShelf{PMC: psmapi.PowerManagementController{MACAddress: shelfMAC}})
+	nsm.AddNVSwitch(nsmapi.NVSwitchTray{UUID: switchUUID, BMCMACAddress: "aa:bb:cc:dd:ee:02"})
+	return r
+}
+
+func newTestManager(r *rack.Rack, psm PowershelfClient, nsm NVSwitchClient) *Manager {
+	inv := &fakeInventory{racks: map[uuid.UUID]*rack.Rack{r.Info.ID: r}}
+	return NewManager(inv, psm, nsm)
+}
+
+func accountsOf(results []TriggerResult) []Account {
+	var out []Account
+	for _, r := range results {
+		out = append(out, r.Account)
+	}
+	return out
+}
+
+// --- tests ---
+
+func TestRotateRack(t *testing.T) {
+	testCases := map[string]struct {
+		types    []devicetypes.ComponentType
+		expected []Account
+	}{
+		"all supported types": {
+			expected: []Account{AccountPMC, AccountBMC, AccountNVOS},
+		},
+		"power shelves only": {
+			types:    []devicetypes.ComponentType{devicetypes.ComponentTypePowerShelf},
+			expected: []Account{AccountPMC},
+		},
+		"nvlink switches only": {
+			types:    []devicetypes.ComponentType{devicetypes.ComponentTypeNVLSwitch},
+			expected: []Account{AccountBMC, AccountNVOS},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			psm, nsm := psmapi.NewMockClient(), nsmapi.NewMockClient()
+			r := newTestRack(psm, nsm)
+			m := newTestManager(r, psm, nsm)
+
+			results, err := m.RotateRack(context.Background(), r.Info.ID, tc.types)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, accountsOf(results))
+			for _, res := range results {
+				assert.True(t, res.Started, "%s %s", res.DeviceID, res.Account)
+				assert.Empty(t, res.Error)
+			}
+		})
+	}
+}
+
+func TestRotateRack_UnsupportedType(t *testing.T) {
+	psm, nsm := psmapi.NewMockClient(), nsmapi.NewMockClient()
+	r := newTestRack(psm, nsm)
+	m := newTestManager(r, psm, nsm)
+
+	_, err := m.RotateRack(
+		context.Background(),
+		r.Info.ID,
+		[]devicetypes.ComponentType{devicetypes.ComponentTypeCompute},
+	)
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestRotateRack_UnknownRack(t *testing.T) {
+	psm, nsm := psmapi.NewMockClient(), nsmapi.NewMockClient()
+	m := newTestManager(newTestRack(psm, nsm), psm, nsm)
+
+	_, err := m.RotateRack(context.Background(), uuid.New(), nil)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestRotateRack_ManagerUnavailable(t *testing.T) {
+	testCases := map[string]struct {
+		psm       PowershelfClient
+		nsm       NVSwitchClient
+		failedPMC string
+		failedNSM string
+	}{
+		"no managers configured": {
+			failedPMC: "powershelf manager is not available",
+			failedNSM: "nvswitch manager is not available",
+		},
+		"powershelf manager unreachable": {
+			psm:       failingPSM{},
+			nsm:       nsmapi.NewMockClient(),
+			failedPMC: "connection refused",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := newTestRack(psmapi.NewMockClient(), nsmapi.NewMockClient())
+			if mock, ok := tc.nsm.(nsmapi.Client); ok {
+				mock.AddNVSwitch(nsmapi.NVSwitchTray{UUID: switchUUID, BMCMACAddress: "aa:bb:cc:dd:ee:02"})
+			}
+			m := newTestManager(r, tc.psm, tc.nsm)
+
+			results, err := m.RotateRack(context.Background(), r.Info.ID, nil)
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+			for _, res := range results {
+				expected := tc.failedNSM
+				if res.Account == AccountPMC {
+					expected = tc.failedPMC
+				}
+				assert.Equal(t, expected == "", res.Started, "%s", res.Account)
+				assert.Equal(t, expected, res.Error, "%s", res.Account)
+			}
+		})
+	}
+}
+
+func TestRackStatus(t *testing.T) {
+	psm, nsm := psmapi.NewMockClient(), nsmapi.NewMockClient()
+	r := newTestRack(psm, nsm)
+	m := newTestManager(r, psm, nsm)
+	ctx := context.Background()
+
+	statuses, err := m.RackStatus(ctx, r.Info.ID)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for _, s := range statuses {
+		assert.Equal(t, StateNeverRotated, s.State, "%s", s.Account)
+		assert.Empty(t, s.Error)
+	}
+
+	_, err = m.RotateRack(ctx, r.Info.ID, []devicetypes.ComponentType{devicetypes.ComponentTypeNVLSwitch})
+	require.NoError(t, err)
+
+	statuses, err = m.RackStatus(ctx, r.Info.ID)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+
+	byAccount := make(map[Account]AccountStatus)
+	for _, s := range statuses {
+		byAccount[s.Account] = s
+	}
+	assert.Equal(t, StateNeverRotated, byAccount[AccountPMC].State)
+	assert.Equal(t, shelfMAC, byAccount[AccountPMC].DeviceID)
+	assert.Equal(t, StateSucceeded, byAccount[AccountBMC].State)
+	assert.Equal(t, StateSucceeded, byAccount[AccountNVOS].State)
+	assert.Equal(t, switchUUID, byAccount[AccountNVOS].DeviceID)
+	assert.False(t, byAccount[AccountNVOS].LastRotated.IsZero())
+}
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS power_budget_set_updated_at ON power_budget;
+DROP INDEX IF EXISTS idx_power_budget_component;
+DROP INDEX IF EXISTS idx_power_budget_rack;
+DROP TABLE IF EXISTS power_budget;
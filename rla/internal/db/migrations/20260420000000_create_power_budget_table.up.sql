@@ -0,0 +1,18 @@
+CREATE TABLE power_budget (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    rack_id         UUID NOT NULL REFERENCES rack(id) ON DELETE CASCADE,
+    component_id    UUID REFERENCES component(id) ON DELETE CASCADE,  -- NULL = rack-wide budget; set = per-power-shelf cap
+    limit_watts     DOUBLE PRECISION NOT NULL CHECK (limit_watts > 0),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX idx_power_budget_rack ON power_budget (rack_id)
+    WHERE component_id IS NULL;
+
+CREATE UNIQUE INDEX idx_power_budget_component ON power_budget (component_id)
+    WHERE component_id IS NOT NULL;
+
+CREATE TRIGGER power_budget_set_updated_at
+    BEFORE UPDATE ON power_budget
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
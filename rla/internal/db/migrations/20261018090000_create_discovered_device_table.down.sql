@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS discovered_device_set_updated_at ON discovered_device;
+DROP INDEX IF EXISTS idx_discovered_device_state;
+DROP TABLE IF EXISTS discovered_device;
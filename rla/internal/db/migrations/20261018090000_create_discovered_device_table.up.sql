@@ -0,0 +1,24 @@
+CREATE TABLE discovered_device (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mac_address        VARCHAR(17) NOT NULL UNIQUE,  -- BMC/PMC MAC reported by the Redfish manager
+    ip_address         VARCHAR(64) NOT NULL,
+    type               VARCHAR(16) NOT NULL,         -- component type fingerprinted from Chassis/Manager data
+    manufacturer       VARCHAR(128) NOT NULL DEFAULT '',
+    model              VARCHAR(128) NOT NULL DEFAULT '',
+    serial_number      VARCHAR(128) NOT NULL DEFAULT '',
+    firmware_version   VARCHAR(128) NOT NULL DEFAULT '',
+    host_mac_address   VARCHAR(17),                  -- NVOS port of NV-Switch trays, if reported
+    host_ip_address    VARCHAR(64),
+    component_id       UUID REFERENCES component(id) ON DELETE SET NULL,  -- matched expected component
+    state              VARCHAR(16) NOT NULL,
+    message            TEXT NOT NULL DEFAULT '',
+    first_seen_at      TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    last_seen_at       TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_discovered_device_state ON discovered_device (state);
+
+CREATE TRIGGER discovered_device_set_updated_at
+    BEFORE UPDATE ON discovered_device
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS campaign_rack_set_updated_at ON campaign_rack;
+DROP TABLE IF EXISTS campaign_rack;
+DROP TRIGGER IF EXISTS campaign_set_updated_at ON campaign;
+DROP INDEX IF EXISTS idx_campaign_state;
+DROP TABLE IF EXISTS campaign;
@@ -0,0 +1,43 @@
+CREATE TABLE campaign (
+    id                       UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name                     VARCHAR(256) NOT NULL,
+    description              TEXT NOT NULL DEFAULT '',
+    operation_template       JSONB NOT NULL,               -- same format as task_schedule.operation_template
+    component_types          JSONB NOT NULL DEFAULT '[]',  -- component types targeted in every rack; empty = all
+    selector                 JSONB NOT NULL,               -- how the racks were chosen, kept for display
+    max_concurrent_racks     INTEGER NOT NULL,
+    max_racks_per_nvl_domain INTEGER NOT NULL DEFAULT 0,   -- 0 = no per-domain limit
+    min_success_rate         DOUBLE PRECISION NOT NULL DEFAULT 0,  -- gate between batches, 0 = disabled
+    state                    VARCHAR(16) NOT NULL,
+    message                  TEXT NOT NULL DEFAULT '',
+    current_batch            INTEGER NOT NULL DEFAULT 0,
+    created_at               TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at               TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    finished_at              TIMESTAMPTZ
+);
+
+CREATE INDEX idx_campaign_state ON campaign (state);
+
+CREATE TRIGGER campaign_set_updated_at
+    BEFORE UPDATE ON campaign
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE campaign_rack (
+    campaign_id    UUID NOT NULL REFERENCES campaign(id) ON DELETE CASCADE,
+    rack_id        UUID NOT NULL,                 -- no FK: the record outlives the rack
+    rack_name      VARCHAR(256) NOT NULL,
+    nvl_domain_id  UUID,
+    position       INTEGER NOT NULL,              -- rollout order within the campaign
+    batch          INTEGER NOT NULL DEFAULT 0,    -- batch the rack was started in, 0 = not started
+    task_id        UUID,
+    state          VARCHAR(16) NOT NULL,
+    message        TEXT NOT NULL DEFAULT '',
+    started_at     TIMESTAMPTZ,
+    finished_at    TIMESTAMPTZ,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (campaign_id, rack_id)
+);
+
+CREATE TRIGGER campaign_rack_set_updated_at
+    BEFORE UPDATE ON campaign_rack
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
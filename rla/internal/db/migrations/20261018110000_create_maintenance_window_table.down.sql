@@ -0,0 +1,6 @@
+ALTER TABLE task DROP COLUMN IF EXISTS not_before;
+DROP INDEX IF EXISTS idx_maintenance_override_rack;
+DROP TABLE IF EXISTS maintenance_override;
+DROP TRIGGER IF EXISTS maintenance_window_set_updated_at ON maintenance_window;
+DROP INDEX IF EXISTS idx_maintenance_window_scope;
+DROP TABLE IF EXISTS maintenance_window;
@@ -0,0 +1,45 @@
+CREATE TABLE maintenance_window (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name              VARCHAR(256) NOT NULL,
+    description       TEXT NOT NULL DEFAULT '',
+    kind              VARCHAR(16) NOT NULL,           -- 'window' | 'blackout'
+    scope             VARCHAR(16) NOT NULL,           -- 'rack' | 'nvl_domain' | 'site'
+    scope_id          UUID,                           -- rack or NVL domain ID; null for site scope
+    region            VARCHAR(256) NOT NULL DEFAULT '',  -- site scope: empty fields match any value
+    data_center       VARCHAR(256) NOT NULL DEFAULT '',
+    room              VARCHAR(256) NOT NULL DEFAULT '',
+    start_at          TIMESTAMPTZ,                    -- one-off occurrence
+    end_at            TIMESTAMPTZ,
+    recurrence        VARCHAR(256) NOT NULL DEFAULT '',  -- recurring occurrence: 5-field cron spec of each start
+    duration_seconds  INTEGER NOT NULL DEFAULT 0,
+    timezone          VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    CONSTRAINT maintenance_window_occurrence CHECK (
+        (start_at IS NOT NULL AND end_at IS NOT NULL AND end_at > start_at AND recurrence = '')
+        OR (start_at IS NULL AND end_at IS NULL AND recurrence <> '' AND duration_seconds > 0)
+    )
+);
+
+CREATE INDEX idx_maintenance_window_scope ON maintenance_window (scope, scope_id);
+
+CREATE TRIGGER maintenance_window_set_updated_at
+    BEFORE UPDATE ON maintenance_window
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE maintenance_override (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id         UUID NOT NULL,                    -- no FK: the audit record outlives the task
+    rack_id         UUID NOT NULL,
+    operation_type  VARCHAR(64) NOT NULL,
+    operation_code  VARCHAR(64) NOT NULL,
+    reason          TEXT NOT NULL,
+    requested_by    VARCHAR(256) NOT NULL DEFAULT '',
+    bypassed        TEXT NOT NULL,                    -- what the calendar would have done without the override
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_maintenance_override_rack ON maintenance_override (rack_id, created_at);
+
+-- Deferred tasks wait in the queue until their maintenance window opens.
+ALTER TABLE task ADD COLUMN IF NOT EXISTS not_before TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+-- Priority class of the task: 'emergency' | 'normal' | 'background'.
+-- Waiting tasks of a rack are promoted highest priority first.
+ALTER TABLE task ADD COLUMN IF NOT EXISTS priority VARCHAR(16) NOT NULL DEFAULT 'normal';
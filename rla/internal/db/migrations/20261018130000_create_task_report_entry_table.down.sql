@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_report_entry_task;
+DROP TABLE IF EXISTS task_report_entry;
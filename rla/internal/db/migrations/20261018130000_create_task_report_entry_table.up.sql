@@ -0,0 +1,14 @@
+CREATE TABLE task_report_entry (
+    id              BIGSERIAL PRIMARY KEY,
+    task_id         UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    recorded_at     TIMESTAMPTZ NOT NULL,             -- when the workflow observed the outcome
+    component_type  VARCHAR(32) NOT NULL DEFAULT '',  -- empty for entries about the whole task
+    component_id    VARCHAR(256) NOT NULL DEFAULT '',
+    stage           INTEGER NOT NULL DEFAULT 0,
+    step            VARCHAR(32) NOT NULL DEFAULT '',  -- 'pre_operation' | 'main_operation' | 'post_operation' | 'compensation'
+    action          VARCHAR(64) NOT NULL,
+    outcome         VARCHAR(16) NOT NULL,             -- 'succeeded' | 'failed' | 'terminated'
+    message         TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_task_report_entry_task ON task_report_entry (task_id, recorded_at, id);
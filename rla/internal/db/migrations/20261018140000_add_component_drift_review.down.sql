@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_component_drift_component;
+
+ALTER TABLE component_drift DROP COLUMN IF EXISTS dismissed_at;
+ALTER TABLE component_drift DROP COLUMN IF EXISTS dismissed_by;
+ALTER TABLE component_drift DROP COLUMN IF EXISTS dismiss_reason;
+ALTER TABLE component_drift DROP COLUMN IF EXISTS status;
+ALTER TABLE component_drift DROP COLUMN IF EXISTS component_type;
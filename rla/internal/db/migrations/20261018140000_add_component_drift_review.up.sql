@@ -0,0 +1,12 @@
+-- Review state of a drift. Rows keep their ID and review state across
+-- inventory cycles for as long as the same drift is observed.
+--
+-- component_type: type of the synced component, e.g. 'Compute'
+-- status:         'open' | 'dismissed'
+ALTER TABLE component_drift ADD COLUMN IF NOT EXISTS component_type VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE component_drift ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'open';
+ALTER TABLE component_drift ADD COLUMN IF NOT EXISTS dismiss_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE component_drift ADD COLUMN IF NOT EXISTS dismissed_by VARCHAR(256) NOT NULL DEFAULT '';
+ALTER TABLE component_drift ADD COLUMN IF NOT EXISTS dismissed_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_component_drift_component ON component_drift (component_id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_task_report_entry_key;
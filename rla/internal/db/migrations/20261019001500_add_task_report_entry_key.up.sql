@@ -0,0 +1,15 @@
+-- A report entry is identified by where and when the workflow observed it, so
+-- that a retried RecordTaskReport activity does not record it twice.
+DELETE FROM task_report_entry a
+    USING task_report_entry b
+    WHERE a.id > b.id
+      AND a.task_id = b.task_id
+      AND a.recorded_at = b.recorded_at
+      AND a.component_type = b.component_type
+      AND a.component_id = b.component_id
+      AND a.stage = b.stage
+      AND a.step = b.step
+      AND a.action = b.action;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_report_entry_key
+    ON task_report_entry (task_id, recorded_at, component_type, component_id, stage, step, action);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS tor_switch_install;
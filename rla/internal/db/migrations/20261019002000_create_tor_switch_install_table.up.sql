@@ -0,0 +1,6 @@
+CREATE TABLE tor_switch_install (
+    component_id VARCHAR(64) PRIMARY KEY,  -- MAC address of the switch's management interface
+    action_id    VARCHAR(64) NOT NULL,     -- NVUE install action
+    version      VARCHAR(128) NOT NULL,    -- NOS version the install leads to
+    started_at   TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,69 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Campaign is the bun model for the campaign table. A campaign rolls one
+// operation out across many racks in batches; its racks are the
+// campaign_rack rows.
+type Campaign struct {
+	bun.BaseModel `bun:"table:campaign,alias:cp"`
+
+	ID                   uuid.UUID       `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name                 string          `bun:"name,notnull"`
+	Description          string          `bun:"description,notnull"`
+	OperationTemplate    json.RawMessage `bun:"operation_template,type:jsonb,notnull"`
+	ComponentTypes       []string        `bun:"component_types,type:jsonb,notnull"`
+	Selector             json.RawMessage `bun:"selector,type:jsonb,notnull"`
+	MaxConcurrentRacks   int             `bun:"max_concurrent_racks,notnull"`
+	MaxRacksPerNVLDomain int             `bun:"max_racks_per_nvl_domain,notnull"`
+	MinSuccessRate       float64         `bun:"min_success_rate,notnull"`
+	State                string          `bun:"state,type:varchar(16),notnull"`
+	Message              string          `bun:"message,notnull"`
+	CurrentBatch         int             `bun:"current_batch,notnull"`
+	CreatedAt            time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt            time.Time       `bun:"updated_at,notnull,default:current_timestamp"`
+	FinishedAt           *time.Time      `bun:"finished_at"`
+}
+
+// CampaignRack is the bun model for the campaign_rack table. A row tracks
+// one rack of a campaign: the batch it was started in, the task that runs
+// the operation on it and the outcome of that task.
+type CampaignRack struct {
+	bun.BaseModel `bun:"table:campaign_rack,alias:cr"`
+
+	CampaignID  uuid.UUID  `bun:"campaign_id,pk,type:uuid"`
+	RackID      uuid.UUID  `bun:"rack_id,pk,type:uuid"`
+	RackName    string     `bun:"rack_name,notnull"`
+	NVLDomainID *uuid.UUID `bun:"nvl_domain_id,type:uuid"`
+	Position    int        `bun:"position,notnull"`
+	Batch       int        `bun:"batch,notnull"`
+	TaskID      *uuid.UUID `bun:"task_id,type:uuid"`
+	State       string     `bun:"state,type:varchar(16),notnull"`
+	Message     string     `bun:"message,notnull"`
+	StartedAt   *time.Time `bun:"started_at"`
+	FinishedAt  *time.Time `bun:"finished_at"`
+	UpdatedAt   time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
+}
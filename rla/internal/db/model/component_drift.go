@@ -19,6 +19,10 @@ package model
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -42,6 +46,30 @@ const (
 	DriftTypeMismatch DriftType = "mismatch"
 )
 
+// DriftStatus is the review state of a drift.
+type DriftStatus string
+
+const (
+	// DriftStatusOpen means the drift awaits review.
+	DriftStatusOpen DriftStatus = "open"
+
+	// DriftStatusDismissed means an operator dismissed the drift. It stays
+	// dismissed for as long as the same drift is observed.
+	DriftStatusDismissed DriftStatus = "dismissed"
+)
+
+// Names of the fields compared for drift.
+const (
+	DriftFieldSerialNumber = "serial_number"
+	DriftFieldSlotID       = "slot_id"
+	DriftFieldTrayIndex    = "tray_index"
+	DriftFieldHostID       = "host_id"
+)
+
+// DriftValueMissing is the actual value of a field the source system does
+// not report.
+const DriftValueMissing = "<missing>"
+
 // FieldDiff represents a single field difference between expected and actual values.
 type FieldDiff struct {
 	FieldName     string `json:"field_name"`
@@ -51,21 +79,109 @@ type FieldDiff struct {
 
 // ComponentDrift stores validation drift detected by the inventory loop.
 // Each row represents one drift record between expected (component table)
-// and actual (source system) data.
+// and actual (source system) data. A row keeps its ID and review state
+// across inventory cycles for as long as the same drift is observed.
 type ComponentDrift struct {
 	bun.BaseModel `bun:"table:component_drift,alias:cd"`
 
-	ID          uuid.UUID   `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
-	ComponentID *uuid.UUID  `bun:"component_id,type:uuid"` // NULL for missing_in_expected
-	ExternalID  *string     `bun:"external_id"`            // Component ID from the component manager service; NULL for missing_in_actual
-	DriftType   DriftType   `bun:"drift_type,type:varchar(32),notnull"`
-	Diffs       []FieldDiff `bun:"diffs,type:jsonb,notnull,default:'[]'"`
-	CheckedAt   time.Time   `bun:"checked_at,notnull,default:current_timestamp"`
+	ID            uuid.UUID   `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ComponentID   *uuid.UUID  `bun:"component_id,type:uuid"` // NULL for missing_in_expected
+	ExternalID    *string     `bun:"external_id"`            // Component ID from the component manager service; NULL for missing_in_actual
+	DriftType     DriftType   `bun:"drift_type,type:varchar(32),notnull"`
+	Diffs         []FieldDiff `bun:"diffs,type:jsonb,notnull,default:'[]'"`
+	CheckedAt     time.Time   `bun:"checked_at,notnull,default:current_timestamp"`
+	ComponentType string      `bun:"component_type,type:varchar(32),notnull,default:''"`
+	Status        DriftStatus `bun:"status,type:varchar(16),notnull,default:'open'"`
+	DismissReason string      `bun:"dismiss_reason,notnull,default:''"`
+	DismissedBy   string      `bun:"dismissed_by,notnull,default:''"`
+	DismissedAt   *time.Time  `bun:"dismissed_at"`
+	Component     *Component  `bun:"rel:belongs-to,join:component_id=id"`
+}
+
+// DriftFilter selects drift records. Zero-valued fields do not filter.
+type DriftFilter struct {
+	RackID           *uuid.UUID
+	ComponentIDs     []uuid.UUID
+	Types            []DriftType
+	ComponentTypes   []string
+	IncludeDismissed bool
+}
+
+// DriftCount is the number of drift records of one type, component type and
+// status.
+type DriftCount struct {
+	DriftType     DriftType   `bun:"drift_type"`
+	ComponentType string      `bun:"component_type"`
+	Status        DriftStatus `bun:"status"`
+	Count         int         `bun:"count"`
+}
+
+// driftKey identifies what a drift observed: the component on both sides,
+// the type of drift and the differing values.
+func driftKey(d *ComponentDrift) string {
+	var componentID, externalID string
+	if d.ComponentID != nil {
+		componentID = d.ComponentID.String()
+	}
+	if d.ExternalID != nil {
+		externalID = *d.ExternalID
+	}
+
+	diffs, _ := json.Marshal(d.Diffs)
+
+	return fmt.Sprintf("%s|%s|%s|%s", componentID, externalID, d.DriftType, diffs)
+}
+
+// carryOverReview gives each new drift the ID and review state of the
+// existing drift it repeats. Drifts seen for the first time get a new ID and
+// are open.
+func carryOverReview(existing []ComponentDrift, drifts []ComponentDrift) {
+	byKey := make(map[string]*ComponentDrift, len(existing))
+	for i := range existing {
+		byKey[driftKey(&existing[i])] = &existing[i]
+	}
+
+	for i := range drifts {
+		d := &drifts[i]
+		key := driftKey(d)
+
+		prev, ok := byKey[key]
+		if !ok {
+			d.ID = uuid.New()
+			d.Status = DriftStatusOpen
+			continue
+		}
+
+		d.ID = prev.ID
+		d.Status = prev.Status
+		d.DismissReason = prev.DismissReason
+		d.DismissedBy = prev.DismissedBy
+		d.DismissedAt = prev.DismissedAt
+		delete(byKey, key)
+	}
+}
+
+// rackComponentIDs selects the IDs of the components of a rack, soft-deleted
+// ones included.
+func rackComponentIDs(idb bun.IDB, rackID uuid.UUID) *bun.SelectQuery {
+	return idb.NewSelect().
+		Model((*Component)(nil)).
+		Column("id").
+		Where("rack_id = ?", rackID).
+		WhereAllWithDeleted()
 }
 
 // ReplaceAllDrifts replaces all component_drift rows with the given set.
 // This is called once per inventory loop cycle to overwrite stale data.
+// Drifts observed again keep their ID and review state.
 func ReplaceAllDrifts(ctx context.Context, idb bun.IDB, drifts []ComponentDrift) error {
+	var existing []ComponentDrift
+	if err := idb.NewSelect().Model(&existing).Scan(ctx); err != nil {
+		return err
+	}
+
+	carryOverReview(existing, drifts)
+
 	// Delete all existing drift records
 	if _, err := idb.NewDelete().Model((*ComponentDrift)(nil)).Where("TRUE").Exec(ctx); err != nil {
 		return err
@@ -97,3 +213,163 @@ func GetAllDrifts(ctx context.Context, idb bun.IDB) ([]ComponentDrift, error) {
 	err := idb.NewSelect().Model(&drifts).Scan(ctx)
 	return drifts, err
 }
+
+// ReplaceRackDrifts replaces the component_drift rows of the components of a
+// rack with the drifts of the given set that concern those components. It
+// returns the drifts kept. Drifts observed again keep their ID and review
+// state.
+func ReplaceRackDrifts(ctx context.Context, idb bun.IDB, rackID uuid.UUID, drifts []ComponentDrift) ([]ComponentDrift, error) {
+	var componentIDs []uuid.UUID
+	if err := rackComponentIDs(idb, rackID).Scan(ctx, &componentIDs); err != nil {
+		return nil, err
+	}
+
+	inRack := make(map[uuid.UUID]bool, len(componentIDs))
+	for _, id := range componentIDs {
+		inRack[id] = true
+	}
+
+	var rackDrifts []ComponentDrift
+	for _, d := range drifts {
+		if d.ComponentID != nil && inRack[*d.ComponentID] {
+			rackDrifts = append(rackDrifts, d)
+		}
+	}
+
+	var existing []ComponentDrift
+	if err := idb.NewSelect().
+		Model(&existing).
+		Where("component_id IN (?)", rackComponentIDs(idb, rackID)).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	carryOverReview(existing, rackDrifts)
+
+	if _, err := idb.NewDelete().
+		Model((*ComponentDrift)(nil)).
+		Where("component_id IN (?)", rackComponentIDs(idb, rackID)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(rackDrifts) > 0 {
+		if _, err := idb.NewInsert().Model(&rackDrifts).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return rackDrifts, nil
+}
+
+// ListDrifts retrieves the drift records matching the filter, with their
+// component, oldest check first.
+func ListDrifts(ctx context.Context, idb bun.IDB, filter DriftFilter) ([]ComponentDrift, error) {
+	var drifts []ComponentDrift
+	q := idb.NewSelect().Model(&drifts).Relation("Component")
+
+	if filter.RackID != nil {
+		q = q.Where("cd.component_id IN (?)", rackComponentIDs(idb, *filter.RackID))
+	}
+	if len(filter.ComponentIDs) > 0 {
+		q = q.Where("cd.component_id IN (?)", bun.In(filter.ComponentIDs))
+	}
+	if len(filter.Types) > 0 {
+		q = q.Where("cd.drift_type IN (?)", bun.In(filter.Types))
+	}
+	if len(filter.ComponentTypes) > 0 {
+		q = q.Where("cd.component_type IN (?)", bun.In(filter.ComponentTypes))
+	}
+	if !filter.IncludeDismissed {
+		q = q.Where("cd.status = ?", DriftStatusOpen)
+	}
+
+	err := q.OrderExpr("cd.checked_at, cd.id").Scan(ctx)
+	return drifts, err
+}
+
+// GetDriftByID retrieves a drift record and its component.
+func GetDriftByID(ctx context.Context, idb bun.IDB, id uuid.UUID) (*ComponentDrift, error) {
+	var drift ComponentDrift
+	err := idb.NewSelect().
+		Model(&drift).
+		Relation("Component").
+		Where("cd.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &drift, nil
+}
+
+// DismissDrift marks a drift record dismissed. Returns sql.ErrNoRows if the
+// drift does not exist.
+func DismissDrift(ctx context.Context, idb bun.IDB, id uuid.UUID, reason string, by string, at time.Time) error {
+	res, err := idb.NewUpdate().
+		Model((*ComponentDrift)(nil)).
+		Set("status = ?", DriftStatusDismissed).
+		Set("dismiss_reason = ?", reason).
+		Set("dismissed_by = ?", by).
+		Set("dismissed_at = ?", at).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteDrift removes a drift record.
+func DeleteDrift(ctx context.Context, idb bun.IDB, id uuid.UUID) error {
+	_, err := idb.NewDelete().Model((*ComponentDrift)(nil)).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// CountDrifts counts the drift records by type, component type and status.
+func CountDrifts(ctx context.Context, idb bun.IDB) ([]DriftCount, error) {
+	var counts []DriftCount
+	err := idb.NewSelect().
+		Model((*ComponentDrift)(nil)).
+		Column("drift_type", "component_type", "status").
+		ColumnExpr("COUNT(*) AS count").
+		Group("drift_type", "component_type", "status").
+		Scan(ctx, &counts)
+	return counts, err
+}
+
+// ApplyFieldDiff sets the field of the component named by diff to the
+// actual value and returns the column that changed.
+func (cd *Component) ApplyFieldDiff(diff FieldDiff) (string, error) {
+	if diff.ActualValue == DriftValueMissing {
+		return "", fmt.Errorf("%s is not reported by the source system", diff.FieldName)
+	}
+
+	switch diff.FieldName {
+	case DriftFieldSerialNumber:
+		cd.SerialNumber = diff.ActualValue
+		return DriftFieldSerialNumber, nil
+	case DriftFieldSlotID, DriftFieldTrayIndex, DriftFieldHostID:
+		v, err := strconv.Atoi(diff.ActualValue)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s %q", diff.FieldName, diff.ActualValue)
+		}
+
+		switch diff.FieldName {
+		case DriftFieldSlotID:
+			cd.SlotID = v
+		case DriftFieldTrayIndex:
+			cd.TrayIndex = v
+		default:
+			cd.HostID = v
+		}
+
+		return diff.FieldName, nil
+	default:
+		return "", fmt.Errorf("field %s cannot be accepted", diff.FieldName)
+	}
+}
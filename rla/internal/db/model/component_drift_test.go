@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarryOverReview(t *testing.T) {
+	compID := uuid.New()
+	externalID := "machine-1"
+	dismissedAt := time.Now().Add(-time.Hour)
+
+	serialDiff := []FieldDiff{{FieldName: DriftFieldSerialNumber, ExpectedValue: "SN1", ActualValue: "SN2"}}
+
+	existing := []ComponentDrift{
+		{
+			ID:            uuid.New(),
+			ComponentID:   &compID,
+			ExternalID:    &externalID,
+			DriftType:     DriftTypeMismatch,
+			Diffs:         serialDiff,
+			Status:        DriftStatusDismissed,
+			DismissReason: "RMA in progress",
+			DismissedBy:   "ops",
+			DismissedAt:   &dismissedAt,
+		},
+	}
+
+	testCases := map[string]struct {
+		drift         ComponentDrift
+		wantCarryOver bool
+	}{
+		"same drift keeps review state": {
+			drift: ComponentDrift{
+				ComponentID: &compID,
+				ExternalID:  &externalID,
+				DriftType:   DriftTypeMismatch,
+				Diffs:       serialDiff,
+			},
+			wantCarryOver: true,
+		},
+		"changed value opens a new drift": {
+			drift: ComponentDrift{
+				ComponentID: &compID,
+				ExternalID:  &externalID,
+				DriftType:   DriftTypeMismatch,
+				Diffs:       []FieldDiff{{FieldName: DriftFieldSerialNumber, ExpectedValue: "SN1", ActualValue: "SN3"}},
+			},
+		},
+		"different type opens a new drift": {
+			drift: ComponentDrift{
+				ComponentID: &compID,
+				DriftType:   DriftTypeMissingInActual,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			drifts := []ComponentDrift{tc.drift}
+			carryOverReview(existing, drifts)
+
+			got := drifts[0]
+			if tc.wantCarryOver {
+				assert.Equal(t, existing[0].ID, got.ID)
+				assert.Equal(t, DriftStatusDismissed, got.Status)
+				assert.Equal(t, "RMA in progress", got.DismissReason)
+				assert.Equal(t, "ops", got.DismissedBy)
+				assert.Equal(t, &dismissedAt, got.DismissedAt)
+			} else {
+				assert.NotEqual(t, uuid.Nil, got.ID)
+				assert.NotEqual(t, existing[0].ID, got.ID)
+				assert.Equal(t, DriftStatusOpen, got.Status)
+				assert.Empty(t, got.DismissReason)
+				assert.Nil(t, got.DismissedAt)
+			}
+		})
+	}
+}
+
+func TestComponentApplyFieldDiff(t *testing.T) {
+	testCases := map[string]struct {
+		diff       FieldDiff
+		wantColumn string
+		wantErr    bool
+		check      func(t *testing.T, c *Component)
+	}{
+		"serial number": {
+			diff:       FieldDiff{FieldName: DriftFieldSerialNumber, ExpectedValue: "SN1", ActualValue: "SN2"},
+			wantColumn: "serial_number",
+			check: func(t *testing.T, c *Component) {
+				assert.Equal(t, "SN2", c.SerialNumber)
+			},
+		},
+		"slot id": {
+			diff:       FieldDiff{FieldName: DriftFieldSlotID, ExpectedValue: "3", ActualValue: "7"},
+			wantColumn: "slot_id",
+			check: func(t *testing.T, c *Component) {
+				assert.Equal(t, 7, c.SlotID)
+			},
+		},
+		"host id": {
+			diff:       FieldDiff{FieldName: DriftFieldHostID, ExpectedValue: "1", ActualValue: "2"},
+			wantColumn: "host_id",
+			check: func(t *testing.T, c *Component) {
+				assert.Equal(t, 2, c.HostID)
+			},
+		},
+		"value not reported": {
+			diff:    FieldDiff{FieldName: DriftFieldSerialNumber, ExpectedValue: "SN1", ActualValue: DriftValueMissing},
+			wantErr: true,
+		},
+		"invalid number": {
+			diff:    FieldDiff{FieldName: DriftFieldTrayIndex, ExpectedValue: "1", ActualValue: "abc"},
+			wantErr: true,
+		},
+		"unsupported field": {
+			diff:    FieldDiff{FieldName: "firmware_version", ExpectedValue: "1.0", ActualValue: "2.0"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := &Component{SerialNumber: "SN1", SlotID: 3, TrayIndex: 1, HostID: 1}
+
+			column, err := c.ApplyFieldDiff(tc.diff)
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, &Component{SerialNumber: "SN1", SlotID: 3, TrayIndex: 1, HostID: 1}, c)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantColumn, column)
+			tc.check(t, c)
+		})
+	}
+}
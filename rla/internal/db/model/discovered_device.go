@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// DiscoveredDevice is the bun model for the discovered_device table. A row
+// records a power shelf PMC or NV-Switch BMC found by a Redfish subnet sweep,
+// the expected component it was matched to, and whether it was registered or
+// awaits approval.
+type DiscoveredDevice struct {
+	bun.BaseModel `bun:"table:discovered_device,alias:dd"`
+
+	ID              uuid.UUID  `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	MacAddress      string     `bun:"mac_address,notnull"`
+	IPAddress       string     `bun:"ip_address,notnull"`
+	Type            string     `bun:"type,type:varchar(16),notnull"`
+	Manufacturer    string     `bun:"manufacturer,notnull"`
+	Model           string     `bun:"model,notnull"`
+	SerialNumber    string     `bun:"serial_number,notnull"`
+	FirmwareVersion string     `bun:"firmware_version,notnull"`
+	HostMacAddress  *string    `bun:"host_mac_address"`
+	HostIPAddress   *string    `bun:"host_ip_address"`
+	ComponentID     *uuid.UUID `bun:"component_id,type:uuid"`
+	State           string     `bun:"state,type:varchar(16),notnull"`
+	Message         string     `bun:"message,notnull"`
+	FirstSeenAt     time.Time  `bun:"first_seen_at,notnull,default:current_timestamp"`
+	LastSeenAt      time.Time  `bun:"last_seen_at,notnull,default:current_timestamp"`
+	UpdatedAt       time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,67 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MaintenanceWindow is the bun model for the maintenance_window table. A row
+// is either a maintenance window, during which disruptive operations may run,
+// or a blackout, during which they may not. It occurs once (StartAt/EndAt) or
+// repeatedly (Recurrence/DurationSeconds/Timezone).
+type MaintenanceWindow struct {
+	bun.BaseModel `bun:"table:maintenance_window,alias:mw"`
+
+	ID              uuid.UUID  `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name            string     `bun:"name,notnull"`
+	Description     string     `bun:"description,notnull"`
+	Kind            string     `bun:"kind,type:varchar(16),notnull"`
+	Scope           string     `bun:"scope,type:varchar(16),notnull"`
+	ScopeID         *uuid.UUID `bun:"scope_id,type:uuid"`
+	Region          string     `bun:"region,notnull"`
+	DataCenter      string     `bun:"data_center,notnull"`
+	Room            string     `bun:"room,notnull"`
+	StartAt         *time.Time `bun:"start_at"`
+	EndAt           *time.Time `bun:"end_at"`
+	Recurrence      string     `bun:"recurrence,notnull"`
+	DurationSeconds int        `bun:"duration_seconds,notnull"`
+	Timezone        string     `bun:"timezone,notnull"`
+	CreatedAt       time.Time  `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt       time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// MaintenanceOverride is the bun model for the maintenance_override table,
+// the audit trail of tasks submitted with a maintenance override that ran
+// outside what the maintenance calendar allowed.
+type MaintenanceOverride struct {
+	bun.BaseModel `bun:"table:maintenance_override,alias:mo"`
+
+	ID            uuid.UUID `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	TaskID        uuid.UUID `bun:"task_id,type:uuid,notnull"`
+	RackID        uuid.UUID `bun:"rack_id,type:uuid,notnull"`
+	OperationType string    `bun:"operation_type,type:varchar(64),notnull"`
+	OperationCode string    `bun:"operation_code,type:varchar(64),notnull"`
+	Reason        string    `bun:"reason,notnull"`
+	RequestedBy   string    `bun:"requested_by,notnull"`
+	Bypassed      string    `bun:"bypassed,notnull"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,45 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// PowerBudget is the bun model for the power_budget table. A row with a nil
+// ComponentID is the rack-wide budget; a row with ComponentID set caps a
+// single power shelf within the rack.
+type PowerBudget struct {
+	bun.BaseModel `bun:"table:power_budget,alias:pbg"`
+
+	ID          uuid.UUID  `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	RackID      uuid.UUID  `bun:"rack_id,type:uuid,notnull"`
+	ComponentID *uuid.UUID `bun:"component_id,type:uuid"`
+	LimitWatts  float64    `bun:"limit_watts,notnull"`
+	CreatedAt   time.Time  `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time  `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// IsRackBudget reports whether the budget applies to the whole rack rather
+// than a single power shelf.
+func (b *PowerBudget) IsRackBudget() bool {
+	return b.ComponentID == nil || *b.ComponentID == uuid.Nil
+}
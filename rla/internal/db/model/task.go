@@ -59,6 +59,13 @@ type Task struct {
 	// QueueExpiresAt is set only for waiting tasks. After this time, the
 	// Promoter will discard the task instead of promoting it.
 	QueueExpiresAt *time.Time `bun:"queue_expires_at"`
+
+	// NotBefore is set only for tasks deferred to a maintenance window.
+	// The Promoter leaves the task waiting until this time.
+	NotBefore *time.Time `bun:"not_before"`
+
+	// Priority orders waiting tasks of a rack for promotion.
+	Priority taskcommon.TaskPriority `bun:"priority,type:varchar(16),notnull,default:'normal'"`
 }
 
 // Create inserts the task record into the backing store.
@@ -108,6 +115,12 @@ func (t *Task) UpdateTaskStatus(
 		t.StartedAt = &t.UpdatedAt
 		columns = append(columns, "started_at")
 	}
+	// A waiting task has no workflow; a preempted task sent back to the
+	// queue must not keep pointing at the one that was terminated.
+	if status == taskcommon.TaskStatusWaiting {
+		t.ExecutionID = ""
+		columns = append(columns, "execution_id")
+	}
 	if status.IsFinished() {
 		t.FinishedAt = &t.UpdatedAt
 	} else {
@@ -123,6 +136,19 @@ func (t *Task) UpdateTaskStatus(
 	return err
 }
 
+// UpdateAttributes persists the attributes of the task.
+func (t *Task) UpdateAttributes(ctx context.Context, idb bun.IDB) error {
+	t.UpdatedAt = time.Now().UTC()
+
+	_, err := idb.NewUpdate().
+		Model(t).
+		Column("attributes", "updated_at").
+		Where("id = ?", t.ID).
+		Exec(ctx)
+
+	return err
+}
+
 func taskListOptionsToFilterable(
 	options *taskcommon.TaskListOptions,
 ) dbquery.Filterable {
@@ -160,6 +186,7 @@ func taskListOptionsToFilterable(
 				taskcommon.TaskStatusWaiting,
 				taskcommon.TaskStatusPending,
 				taskcommon.TaskStatusRunning,
+				taskcommon.TaskStatusWaitingApproval,
 			},
 		})
 	}
@@ -186,6 +213,20 @@ func GetTask(ctx context.Context, idb bun.IDB, id uuid.UUID) (*Task, error) {
 	return &task, nil
 }
 
+// GetTaskForUpdate retrieves the task by its UUID and locks its row until the
+// surrounding transaction ends.
+func GetTaskForUpdate(ctx context.Context, tx bun.Tx, id uuid.UUID) (*Task, error) {
+	var task Task
+	if err := tx.NewSelect().
+		Model(&task).
+		Where("id = ?", id).
+		For("UPDATE").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
 // ListTasksForRackByStatus returns tasks for a rack matching any of the given
 // statuses, ordered oldest-first.
 func ListTasksForRackByStatus(
@@ -0,0 +1,78 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// TaskReportEntry is the bun model for the task_report_entry table, one line
+// of the structured report recorded while a task runs.
+type TaskReportEntry struct {
+	bun.BaseModel `bun:"table:task_report_entry,alias:tre"`
+
+	ID            int64     `bun:"id,pk,autoincrement"`
+	TaskID        uuid.UUID `bun:"task_id,type:uuid,notnull"`
+	RecordedAt    time.Time `bun:"recorded_at,notnull"`
+	ComponentType string    `bun:"component_type,type:varchar(32),notnull"`
+	ComponentID   string    `bun:"component_id,notnull"`
+	Stage         int       `bun:"stage,notnull"`
+	Step          string    `bun:"step,type:varchar(32),notnull"`
+	Action        string    `bun:"action,type:varchar(64),notnull"`
+	Outcome       string    `bun:"outcome,type:varchar(16),notnull"`
+	Message       string    `bun:"message,notnull"`
+}
+
+// CreateTaskReportEntries inserts the report entries in one statement.
+// Entries already recorded, for example by an earlier attempt of the same
+// activity, are skipped.
+func CreateTaskReportEntries(
+	ctx context.Context,
+	idb bun.IDB,
+	entries []TaskReportEntry,
+) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, err := idb.NewInsert().
+		Model(&entries).
+		On("CONFLICT (task_id, recorded_at, component_type, component_id, stage, step, action) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+// ListTaskReportEntries returns the report entries of a task in the order
+// they were recorded.
+func ListTaskReportEntries(
+	ctx context.Context,
+	idb bun.IDB,
+	taskID uuid.UUID,
+) ([]TaskReportEntry, error) {
+	var entries []TaskReportEntry
+	err := idb.NewSelect().
+		Model(&entries).
+		Where("tre.task_id = ?", taskID).
+		OrderExpr("tre.recorded_at ASC, tre.id ASC").
+		Scan(ctx)
+	return entries, err
+}
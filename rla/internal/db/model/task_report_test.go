@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+)
+
+func TestCreateTaskReportEntries_Idempotent(t *testing.T) {
+	ctx := context.Background()
+
+	if os.Getenv("DB_PORT") == "" {
+		t.Skip("Skipping integration test: no DB environment specified")
+	}
+
+	dbConf, err := cdb.ConfigFromEnv()
+	require.NoError(t, err)
+
+	pool, err := utils.UnitTestDB(ctx, t, dbConf)
+	require.NoError(t, err)
+
+	task := Task{
+		Type:        taskcommon.TaskTypePowerControl,
+		RackID:      uuid.New(),
+		ExecutionID: "report-execution",
+		Status:      taskcommon.TaskStatusRunning,
+	}
+	require.NoError(t, task.Create(ctx, pool.DB))
+
+	recordedAt := time.Now().UTC().Truncate(time.Microsecond)
+	newEntries := func() []TaskReportEntry {
+		return []TaskReportEntry{
+			{TaskID: task.ID, RecordedAt: recordedAt, ComponentType: "compute", ComponentID: "c1", Stage: 1, Step: "main_operation", Action: "PowerOff", Outcome: "succeeded"},
+			{TaskID: task.ID, RecordedAt: recordedAt, ComponentType: "compute", ComponentID: "c2", Stage: 1, Step: "main_operation", Action: "PowerOff", Outcome: "succeeded"},
+		}
+	}
+
+	require.NoError(t, CreateTaskReportEntries(ctx, pool.DB, newEntries()))
+
+	// A retried activity inserts the same entries again.
+	require.NoError(t, CreateTaskReportEntries(ctx, pool.DB, newEntries()))
+
+	entries, err := ListTaskReportEntries(ctx, pool.DB, task.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "c1", entries[0].ComponentID)
+	assert.Equal(t, "c2", entries[1].ComponentID)
+}
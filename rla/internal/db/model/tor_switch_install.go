@@ -0,0 +1,36 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// TorSwitchInstall is the bun model for the tor_switch_install table. A row
+// records the last NOS image install started on a ToR switch, so any RLA
+// instance can follow its progress.
+type TorSwitchInstall struct {
+	bun.BaseModel `bun:"table:tor_switch_install,alias:tsi"`
+
+	ComponentID string    `bun:"component_id,pk"`
+	ActionID    string    `bun:"action_id,notnull"`
+	Version     string    `bun:"version,notnull"`
+	StartedAt   time.Time `bun:"started_at,notnull"`
+}
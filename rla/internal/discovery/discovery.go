@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package discovery finds power shelves and NV-Switch trays on management
+// subnets so racks can be onboarded without lists of MAC addresses. It sweeps
+// the configured subnets for Redfish services, fingerprints the vendor, model
+// and serial number of every device from its Chassis and Manager data, and
+// matches the device against the expected components by serial number. A
+// matched device is registered with the Powershelf Manager or the NV-Switch
+// Manager, right away or once approved, and its BMC is recorded on the
+// expected component so inventory sync takes over from there.
+package discovery
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+var (
+	// ErrNotFound is returned when a discovered device does not exist.
+	ErrNotFound = errors.New("discovered device not found")
+	// ErrInvalid is returned for requests that cannot be carried out, such
+	// as approving a device that is already registered.
+	ErrInvalid = errors.New("invalid discovery request")
+	// ErrSweepRunning is returned when a sweep is requested while another
+	// one is still probing.
+	ErrSweepRunning = errors.New("a discovery sweep is already running")
+	// ErrNotRedfish is returned by a Prober when no Redfish service answers
+	// at the address.
+	ErrNotRedfish = errors.New("no Redfish service")
+	// ErrUnsupported is returned by a Prober when the device is neither a
+	// power shelf nor an NV-Switch tray.
+	ErrUnsupported = errors.New("unsupported device")
+)
+
+// State is where a discovered device stands in onboarding.
+type State string
+
+const (
+	// StatePending means the device matched an expected component and
+	// awaits approval before it is registered.
+	StatePending State = "pending"
+	// StateRegistered means the device was registered and its BMC recorded
+	// on the expected component.
+	StateRegistered State = "registered"
+	// StateKnown means the expected component already lists the device's
+	// BMC, so inventory sync registers it without discovery.
+	StateKnown State = "known"
+	// StateUnmatched means no expected component has the device's serial
+	// number.
+	StateUnmatched State = "unmatched"
+	// StateConflict means the matched expected component lists a different
+	// BMC, or the serial number matches several components.
+	StateConflict State = "conflict"
+	// StateFailed means registration was attempted and failed.
+	StateFailed State = "failed"
+	// StateRejected means an operator rejected the device; sweeps leave it
+	// alone until it is approved.
+	StateRejected State = "rejected"
+)
+
+// Fingerprint is what probing a Redfish service revealed about a device.
+type Fingerprint struct {
+	IPAddress string
+	// MACAddress is the MAC of the BMC or PMC, the key the device is
+	// registered under.
+	MACAddress      string
+	Type            devicetypes.ComponentType
+	Manufacturer    string
+	Model           string
+	SerialNumber    string
+	FirmwareVersion string
+	// HostMACAddress and HostIPAddress are the NVOS management port of an
+	// NV-Switch tray, if its BMC reports them.
+	HostMACAddress string
+	HostIPAddress  string
+	// Credential is the probe credential the device accepted.
+	Credential config.DiscoveryCredential
+}
+
+// Classify derives the component type of a Redfish device from the
+// manufacturer it reports and whether it exposes a computer system. Power
+// shelf PMCs report the shelf vendor and no system; NV-Switch BMCs report
+// NVIDIA and the switch tray as a system. It returns false for anything else.
+func Classify(manufacturer string, hasSystem bool) (devicetypes.ComponentType, bool) {
+	if _, ok := PMCVendor(manufacturer); ok {
+		return devicetypes.ComponentTypePowerShelf, true
+	}
+
+	if strings.Contains(strings.ToLower(manufacturer), "nvidia") && hasSystem {
+		return devicetypes.ComponentTypeNVLSwitch, true
+	}
+
+	return devicetypes.ComponentTypeUnknown, false
+}
+
+// PMCVendor maps the manufacturer a power shelf reports to the PMC vendor it
+// is registered with.
+func PMCVendor(manufacturer string) (psmapi.PMCVendor, bool) {
+	m := strings.ToLower(manufacturer)
+	switch {
+	case strings.Contains(m, "liteon"), strings.Contains(m, "lite-on"):
+		return psmapi.PMCVendorLiteon, true
+	case strings.Contains(m, "delta"):
+		return psmapi.PMCVendorDelta, true
+	default:
+		return psmapi.PMCVendorUnknown, false
+	}
+}
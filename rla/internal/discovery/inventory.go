@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// Inventory is the view of the expected components that discovered devices
+// are matched against.
+type Inventory interface {
+	// ExpectedComponents returns the components of the given types with
+	// their BMCs.
+	ExpectedComponents(ctx context.Context, types []devicetypes.ComponentType) ([]dbmodel.Component, error)
+
+	// AddBMC records the BMC of a component.
+	AddBMC(ctx context.Context, componentID uuid.UUID, mac string, ip string) error
+}
+
+// PostgresInventory implements Inventory on the RLA component tables.
+type PostgresInventory struct {
+	pg *cdb.Session
+}
+
+// NewPostgresInventory creates an Inventory backed by the RLA database.
+func NewPostgresInventory(pg *cdb.Session) *PostgresInventory {
+	return &PostgresInventory{pg: pg}
+}
+
+// ExpectedComponents implements Inventory.
+func (i *PostgresInventory) ExpectedComponents(
+	ctx context.Context,
+	types []devicetypes.ComponentType,
+) ([]dbmodel.Component, error) {
+	var components []dbmodel.Component
+	for _, t := range types {
+		c, err := dbmodel.GetComponentsByType(ctx, i.pg.DB, t)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, c...)
+	}
+
+	return components, nil
+}
+
+// AddBMC implements Inventory.
+func (i *PostgresInventory) AddBMC(
+	ctx context.Context,
+	componentID uuid.UUID,
+	mac string,
+	ip string,
+) error {
+	_, err := i.pg.DB.NewInsert().
+		Model(&dbmodel.BMC{
+			MacAddress:  mac,
+			Type:        devicetypes.BMCTypeToString(devicetypes.BMCTypeHost),
+			ComponentID: componentID,
+			IPAddress:   &ip,
+		}).
+		Exec(ctx)
+
+	return err
+}
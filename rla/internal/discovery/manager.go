@@ -0,0 +1,587 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// discoveredTypes are the component types discovery fingerprints and matches.
+var discoveredTypes = []devicetypes.ComponentType{
+	devicetypes.ComponentTypePowerShelf,
+	devicetypes.ComponentTypeNVLSwitch,
+}
+
+// PowershelfClient is the subset of the Powershelf Manager client the
+// manager needs to register power shelves.
+type PowershelfClient interface {
+	RegisterPowershelves(ctx context.Context, requests []psmapi.RegisterPowershelfRequest) ([]psmapi.RegisterPowershelfResponse, error)
+}
+
+// NVSwitchClient is the subset of the NV-Switch Manager client the manager
+// needs to register NV-Switch trays.
+type NVSwitchClient interface {
+	RegisterNVSwitches(ctx context.Context, requests []nsmapi.RegisterNVSwitchRequest) ([]nsmapi.RegisterNVSwitchResponse, error)
+}
+
+// Approval carries the operator input of an approval. All fields are
+// optional.
+type Approval struct {
+	// ComponentID binds the device to this expected component instead of
+	// the one matched by serial number.
+	ComponentID uuid.UUID
+	// HostMACAddress and HostIPAddress give the NVOS management port of an
+	// NV-Switch tray whose BMC does not report it.
+	HostMACAddress string
+	HostIPAddress  string
+}
+
+// Manager sweeps the management subnets, records the devices it finds and
+// registers them.
+type Manager struct {
+	conf      config.DiscoveryConfig
+	prober    Prober
+	store     Store
+	inventory Inventory
+	psm       PowershelfClient
+	nsm       NVSwitchClient
+	now       func() time.Time
+
+	sweeping sync.Mutex
+}
+
+// NewManager creates a discovery manager. psm and nsm may be nil when the
+// Powershelf Manager or the NV-Switch Manager is not configured, in which
+// case registering the devices they manage fails.
+func NewManager(
+	conf config.DiscoveryConfig,
+	prober Prober,
+	store Store,
+	inventory Inventory,
+	psm PowershelfClient,
+	nsm NVSwitchClient,
+) *Manager {
+	return &Manager{
+		conf:      conf,
+		prober:    prober,
+		store:     store,
+		inventory: inventory,
+		psm:       psm,
+		nsm:       nsm,
+		now:       time.Now,
+	}
+}
+
+// Enabled reports whether management subnets are configured for scheduled
+// sweeps.
+func (m *Manager) Enabled() bool {
+	return len(m.conf.Subnets) > 0
+}
+
+// Sweep probes every address of the given subnets, or of the configured
+// subnets if none are given, and records the power shelves and NV-Switch
+// trays found. Devices matched to an expected component are registered if
+// auto-registration is enabled and held for approval otherwise. It returns
+// the devices seen by this sweep.
+func (m *Manager) Sweep(
+	ctx context.Context,
+	subnets []string,
+) ([]*dbmodel.DiscoveredDevice, error) {
+	if !m.sweeping.TryLock() {
+		return nil, ErrSweepRunning
+	}
+	defer m.sweeping.Unlock()
+
+	if len(subnets) == 0 {
+		subnets = m.conf.Subnets
+	}
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("%w: no subnets to sweep", ErrInvalid)
+	}
+
+	var hosts []string
+	seen := make(map[string]bool)
+	for _, subnet := range subnets {
+		addrs, err := Hosts(subnet)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			if !seen[addr] {
+				seen[addr] = true
+				hosts = append(hosts, addr)
+			}
+		}
+	}
+
+	log.Info().Strs("subnets", subnets).Int("addresses", len(hosts)).
+		Msg("Starting discovery sweep")
+
+	fingerprints := m.probeAll(ctx, hosts)
+
+	expected, err := m.inventory.ExpectedComponents(ctx, discoveredTypes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read expected components: %w", err)
+	}
+
+	devices := make([]*dbmodel.DiscoveredDevice, 0, len(fingerprints))
+	for _, fp := range fingerprints {
+		d, err := m.record(ctx, fp, expected)
+		if err != nil {
+			log.Error().Err(err).Str("ip", fp.IPAddress).
+				Msg("Unable to record discovered device")
+			continue
+		}
+		devices = append(devices, d)
+	}
+
+	log.Info().Int("devices", len(devices)).Msg("Discovery sweep completed")
+
+	return devices, nil
+}
+
+// probeAll probes the hosts with bounded concurrency and returns the
+// fingerprints of the supported devices, ordered by address.
+func (m *Manager) probeAll(ctx context.Context, hosts []string) []*Fingerprint {
+	workers := m.conf.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		mu           sync.Mutex
+		wg           sync.WaitGroup
+		fingerprints []*Fingerprint
+	)
+
+	queue := make(chan string)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ip := range queue {
+				fp, err := m.probe(ctx, ip)
+				if err != nil {
+					if errors.Is(err, ErrNotRedfish) || errors.Is(err, ErrUnsupported) {
+						log.Debug().Err(err).Str("ip", ip).Msg("Skipping address")
+					} else {
+						log.Warn().Err(err).Str("ip", ip).Msg("Unable to fingerprint Redfish device")
+					}
+					continue
+				}
+
+				mu.Lock()
+				fingerprints = append(fingerprints, fp)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, ip := range hosts {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- ip
+	}
+	close(queue)
+	wg.Wait()
+
+	sort.Slice(fingerprints, func(i, j int) bool {
+		return ipLess(fingerprints[i].IPAddress, fingerprints[j].IPAddress)
+	})
+
+	return fingerprints
+}
+
+func (m *Manager) probe(ctx context.Context, ip string) (*Fingerprint, error) {
+	if m.conf.ProbeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.conf.ProbeTimeout)
+		defer cancel()
+	}
+
+	return m.prober.Probe(ctx, ip)
+}
+
+// record stores what a sweep learned about a device. Registered and rejected
+// devices keep their state; every other device is matched again, and
+// registered right away if auto-registration is enabled.
+func (m *Manager) record(
+	ctx context.Context,
+	fp *Fingerprint,
+	expected []dbmodel.Component,
+) (*dbmodel.DiscoveredDevice, error) {
+	now := m.now()
+
+	d, err := m.store.GetByMAC(ctx, fp.MACAddress)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		d = &dbmodel.DiscoveredDevice{MacAddress: fp.MACAddress, FirstSeenAt: now}
+	}
+
+	d.IPAddress = fp.IPAddress
+	d.Type = devicetypes.ComponentTypeToString(fp.Type)
+	d.Manufacturer = fp.Manufacturer
+	d.Model = fp.Model
+	d.SerialNumber = fp.SerialNumber
+	d.FirmwareVersion = fp.FirmwareVersion
+	if fp.HostMACAddress != "" {
+		d.HostMacAddress = &fp.HostMACAddress
+		d.HostIPAddress = optional(fp.HostIPAddress)
+	}
+	d.LastSeenAt = now
+
+	switch State(d.State) {
+	case StateRegistered, StateRejected:
+	default:
+		m.match(d, fp.Type, expected)
+		if State(d.State) == StatePending && m.conf.AutoRegister {
+			m.register(ctx, d, fp.Credential)
+		}
+	}
+
+	if err := m.store.Put(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// match looks up the expected component of the device by serial number and
+// sets its component and state.
+func (m *Manager) match(
+	d *dbmodel.DiscoveredDevice,
+	typ devicetypes.ComponentType,
+	expected []dbmodel.Component,
+) {
+	d.ComponentID = nil
+
+	var candidates []*dbmodel.Component
+	for i := range expected {
+		c := &expected[i]
+		if devicetypes.ComponentTypeFromString(c.Type) == typ &&
+			d.SerialNumber != "" &&
+			strings.EqualFold(strings.TrimSpace(c.SerialNumber), d.SerialNumber) {
+			candidates = append(candidates, c)
+		}
+	}
+
+	// A serial number is only unique per manufacturer.
+	if len(candidates) > 1 {
+		var sameVendor []*dbmodel.Component
+		for _, c := range candidates {
+			if strings.EqualFold(c.Manufacturer, d.Manufacturer) {
+				sameVendor = append(sameVendor, c)
+			}
+		}
+		if len(sameVendor) > 0 {
+			candidates = sameVendor
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		setState(d, StateUnmatched, fmt.Sprintf(
+			"no expected %s with serial number %q",
+			devicetypes.ComponentTypeToString(typ), d.SerialNumber,
+		))
+	case 1:
+		d.ComponentID = &candidates[0].ID
+		bind(d, candidates[0])
+	default:
+		setState(d, StateConflict, fmt.Sprintf(
+			"serial number %q matches %d expected components", d.SerialNumber, len(candidates),
+		))
+	}
+}
+
+// bind sets the state of a device matched to component c from the BMCs the
+// component already lists.
+func bind(d *dbmodel.DiscoveredDevice, c *dbmodel.Component) {
+	for _, b := range c.BMCs {
+		if utils.NormalizeMAC(b.MacAddress) == d.MacAddress {
+			setState(d, StateKnown, "the expected component already lists this BMC")
+			return
+		}
+	}
+
+	if len(c.BMCs) > 0 {
+		setState(d, StateConflict, fmt.Sprintf(
+			"the expected component lists BMC %s", c.BMCs[0].MacAddress,
+		))
+		return
+	}
+
+	setState(d, StatePending, "awaiting approval")
+}
+
+// register registers the device with the manager of its type and records
+// its BMC on the matched component. The outcome is reflected in the device
+// state.
+func (m *Manager) register(
+	ctx context.Context,
+	d *dbmodel.DiscoveredDevice,
+	cred config.DiscoveryCredential,
+) {
+	var err error
+	switch devicetypes.ComponentTypeFromString(d.Type) {
+	case devicetypes.ComponentTypePowerShelf:
+		err = m.registerPowershelf(ctx, d, cred)
+	case devicetypes.ComponentTypeNVLSwitch:
+		err = m.registerNVSwitch(ctx, d)
+	default:
+		err = fmt.Errorf("%s devices cannot be registered", d.Type)
+	}
+
+	if err == nil {
+		err = m.inventory.AddBMC(ctx, *d.ComponentID, d.MacAddress, d.IPAddress)
+		if err != nil {
+			err = fmt.Errorf("registered, but recording the BMC failed: %w", err)
+		}
+	}
+
+	if err != nil {
+		log.Error().Err(err).Str("mac", d.MacAddress).Str("ip", d.IPAddress).
+			Msg("Unable to register discovered device")
+		setState(d, StateFailed, err.Error())
+		return
+	}
+
+	log.Info().Str("mac", d.MacAddress).Str("ip", d.IPAddress).
+		Str("serial", d.SerialNumber).Str("type", d.Type).
+		Msg("Registered discovered device")
+	setState(d, StateRegistered, "")
+}
+
+func (m *Manager) registerPowershelf(
+	ctx context.Context,
+	d *dbmodel.DiscoveredDevice,
+	cred config.DiscoveryCredential,
+) error {
+	if m.psm == nil {
+		return errors.New("powershelf manager is not available")
+	}
+
+	vendor, _ := PMCVendor(d.Manufacturer)
+	results, err := m.psm.RegisterPowershelves(ctx, []psmapi.RegisterPowershelfRequest{{
+		PMCMACAddress: d.MacAddress,
+		PMCIPAddress:  d.IPAddress,
+		PMCVendor:     vendor,
+		PMCCredentials: psmapi.Credentials{
+			Username: cred.Username,
+			Password: cred.Password,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+
+	if len(results) != 1 {
+		return errors.New("no response from Powershelf Manager")
+	}
+	if results[0].Status != psmapi.StatusSuccess {
+		return fmt.Errorf("powershelf manager rejected registration: %s", results[0].Error)
+	}
+
+	return nil
+}
+
+func (m *Manager) registerNVSwitch(ctx context.Context, d *dbmodel.DiscoveredDevice) error {
+	if m.nsm == nil {
+		return errors.New("nv-switch manager is not available")
+	}
+
+	if d.HostMacAddress == nil || d.HostIPAddress == nil {
+		return errors.New("the NVOS MAC and IP address are unknown; approve the device with them")
+	}
+
+	results, err := m.nsm.RegisterNVSwitches(ctx, []nsmapi.RegisterNVSwitchRequest{{
+		BMCMACAddress:  d.MacAddress,
+		BMCIPAddress:   d.IPAddress,
+		NVOSMACAddress: *d.HostMacAddress,
+		NVOSIPAddress:  *d.HostIPAddress,
+	}})
+	if err != nil {
+		return err
+	}
+
+	if len(results) != 1 {
+		return errors.New("no response from NV-Switch Manager")
+	}
+	if results[0].Status != nsmapi.StatusSuccess {
+		return fmt.Errorf("nv-switch manager rejected registration: %s", results[0].Error)
+	}
+
+	return nil
+}
+
+// List returns the discovered devices in the given states, or all devices
+// when states is empty.
+func (m *Manager) List(
+	ctx context.Context,
+	states []State,
+) ([]*dbmodel.DiscoveredDevice, error) {
+	return m.store.List(ctx, states)
+}
+
+// Approve registers a device held for approval, matched by serial number or
+// bound to the component given in the approval. The device is probed again
+// to confirm it still answers at its address and to pick the credential its
+// registration uses. A failed registration is reported in the device state.
+func (m *Manager) Approve(
+	ctx context.Context,
+	id uuid.UUID,
+	approval Approval,
+) (*dbmodel.DiscoveredDevice, error) {
+	d, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch State(d.State) {
+	case StateRegistered, StateKnown:
+		return nil, fmt.Errorf("%w: device %s is already registered", ErrInvalid, d.MacAddress)
+	}
+
+	if approval.HostMACAddress != "" || approval.HostIPAddress != "" {
+		mac, err := net.ParseMAC(approval.HostMACAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w: NVOS MAC address: %v", ErrInvalid, err)
+		}
+		if net.ParseIP(approval.HostIPAddress) == nil {
+			return nil, fmt.Errorf("%w: NVOS IP address %q is not valid", ErrInvalid, approval.HostIPAddress)
+		}
+		hostMAC := mac.String()
+		d.HostMacAddress = &hostMAC
+		d.HostIPAddress = &approval.HostIPAddress
+	}
+
+	typ := devicetypes.ComponentTypeFromString(d.Type)
+	expected, err := m.inventory.ExpectedComponents(ctx, []devicetypes.ComponentType{typ})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read expected components: %w", err)
+	}
+
+	if approval.ComponentID == uuid.Nil {
+		m.match(d, typ, expected)
+	} else {
+		var component *dbmodel.Component
+		for i := range expected {
+			if expected[i].ID == approval.ComponentID {
+				component = &expected[i]
+				break
+			}
+		}
+		if component == nil {
+			return nil, fmt.Errorf(
+				"%w: component %s is not an expected %s", ErrInvalid, approval.ComponentID, d.Type,
+			)
+		}
+
+		d.ComponentID = &component.ID
+		bind(d, component)
+	}
+
+	if State(d.State) != StatePending {
+		return nil, fmt.Errorf("%w: device %s cannot be registered: %s", ErrInvalid, d.MacAddress, d.Message)
+	}
+
+	fp, err := m.probe(ctx, d.IPAddress)
+	if err != nil {
+		return nil, fmt.Errorf("device %s did not answer at %s: %w", d.MacAddress, d.IPAddress, err)
+	}
+	if fp.MACAddress != d.MacAddress {
+		return nil, fmt.Errorf(
+			"%w: %s now answers with BMC %s; sweep again", ErrInvalid, d.IPAddress, fp.MACAddress,
+		)
+	}
+
+	m.register(ctx, d, fp.Credential)
+	if err := m.store.Put(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Reject marks a device as rejected so sweeps stop matching it until it is
+// approved.
+func (m *Manager) Reject(ctx context.Context, id uuid.UUID) (*dbmodel.DiscoveredDevice, error) {
+	d, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if State(d.State) == StateRegistered {
+		return nil, fmt.Errorf("%w: device %s is already registered", ErrInvalid, d.MacAddress)
+	}
+
+	setState(d, StateRejected, "rejected by an operator")
+	if err := m.store.Put(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func setState(d *dbmodel.DiscoveredDevice, state State, message string) {
+	d.State = string(state)
+	d.Message = message
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// ipLess orders dotted IPv4 addresses numerically.
+func ipLess(a, b string) bool {
+	ia, ib := net.ParseIP(a).To4(), net.ParseIP(b).To4()
+	if ia == nil || ib == nil {
+		return a < b
+	}
+
+	for i := range ia {
+		if ia[i] != ib[i] {
+			return ia[i] < ib[i]
+		}
+	}
+
+	return false
+}
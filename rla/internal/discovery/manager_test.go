@@ -0,0 +1,470 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// --- fakes ---
+
+type fakeStore struct {
+	devices map[uuid.UUID]*dbmodel.DiscoveredDevice
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{devices: make(map[uuid.UUID]*dbmodel.DiscoveredDevice)}
+}
+
+func (s *fakeStore) Get(_ context.Context, id uuid.UUID) (*dbmodel.DiscoveredDevice, error) {
+	d, ok := s.devices[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	c := *d
+	return &c, nil
+}
+
+func (s *fakeStore) GetByMAC(_ context.Context, mac string) (*dbmodel.DiscoveredDevice, error) {
+	for _, d := range s.devices {
+		if d.MacAddress == mac {
+			c := *d
+			return &c, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, mac)
+}
+
+func (s *fakeStore) List(_ context.Context, states []State) ([]*dbmodel.DiscoveredDevice, error) {
+	var out []*dbmodel.DiscoveredDevice
+	for _, d := range s.devices {
+		if len(states) == 0 || containsState(states, State(d.State)) {
+			c := *d
+			out = append(out, &c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return ipLess(out[i].IPAddress, out[j].IPAddress) })
+	return out, nil
+}
+
+func (s *fakeStore) Put(_ context.Context, d *dbmodel.DiscoveredDevice) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	c := *d
+	s.devices[d.ID] = &c
+	return nil
+}
+
+func containsState(states []State, s State) bool {
+	for _, st := range states {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeInventory struct {
+	components []dbmodel.Component
+}
+
+func (i *fakeInventory) ExpectedComponents(_ context.Context, types []devicetypes.ComponentType) ([]dbmodel.Component, error) {
+	var out []dbmodel.Component
+	for _, c := range i.components {
+		for _, t := range types {
+			if devicetypes.ComponentTypeFromString(c.Type) == t {
+				out = append(out, c)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (i *fakeInventory) AddBMC(_ context.Context, componentID uuid.UUID, mac string, ip string) error {
+	for n := range i.components {
+		if i.components[n].ID == componentID {
+			i.components[n].BMCs = append(i.components[n].BMCs, dbmodel.BMC{
+				MacAddress:  mac,
+				ComponentID: componentID,
+				IPAddress:   &ip,
+			})
+			return nil
+		}
+	}
+	return fmt.Errorf("component %s not found", componentID)
+}
+
+func (i *fakeInventory) add(typ devicetypes.ComponentType, serial string, bmcMACs ...string) uuid.UUID {
+	c := dbmodel.Component{
+		ID:           uuid.New(),
+		Type:         devicetypes.ComponentTypeToString(typ),
+		Manufacturer: "Vendor",
+		SerialNumber: serial,
+	}
+	for _, mac := range bmcMACs {
+		c.BMCs = append(c.BMCs, dbmodel.BMC{MacAddress: mac, ComponentID: c.ID})
+	}
+	i.components = append(i.components, c)
+	return c.ID
+}
+
+type fakeProber struct {
+	devices map[string]*Fingerprint
+}
+
+func (p *fakeProber) Probe(_ context.Context, ip string) (*Fingerprint, error) {
+	fp, ok := p.devices[ip]
+	if !ok {
+		return nil, fmt.Errorf("%w at %s", ErrNotRedfish, ip)
+	}
+	c := *fp
+	c.IPAddress = ip
+	return &c, nil
+}
+
+func shelf(mac, serial string) *Fingerprint {
+	return &Fingerprint{
+		MACAddress:   mac,
+		Type:         devicetypes.ComponentTypePowerShelf,
+		Manufacturer: "Liteon",
+		Model:        "CM14MP1R",
+		SerialNumber: serial,
+		Credential:   config.DiscoveryCredential{Username: "root", Password: "0penBmc"},
+	}
+}
+
+func nvswitch(mac, serial, hostMAC, hostIP string) *Fingerprint {
+	return &Fingerprint{
+		MACAddress:     mac,
+		Type:           devicetypes.ComponentTypeNVLSwitch,
+		Manufacturer:   "NVIDIA",
+		Model:          "P4978",
+		SerialNumber:   serial,
+		HostMACAddress: hostMAC,
+		HostIPAddress:  hostIP,
+	}
+}
+
+type testEnv struct {
+	manager   *Manager
+	store     *fakeStore
+	inventory *fakeInventory
+	prober    *fakeProber
+	psm       psmapi.Client
+	nsm       nsmapi.Client
+}
+
+func newTestEnv(autoRegister bool) *testEnv {
+	env := &testEnv{
+		store:     newFakeStore(),
+		inventory: &fakeInventory{},
+		prober:    &fakeProber{devices: make(map[string]*Fingerprint)},
+		psm:       psmapi.NewMockClient(),
+		nsm:       nsmapi.NewMockClient(),
+	}
+	env.manager = NewManager(
+		config.DiscoveryConfig{
+			Subnets:      []string{"10.0.0.0/29"},
+			Concurrency:  4,
+			AutoRegister: autoRegister,
+		},
+		env.prober, env.store, env.inventory, env.psm, env.nsm,
+	)
+	return env
+}
+
+func byIP(devices []*dbmodel.DiscoveredDevice) map[string]*dbmodel.DiscoveredDevice {
+	out := make(map[string]*dbmodel.DiscoveredDevice, len(devices))
+	for _, d := range devices {
+		out[d.IPAddress] = d
+	}
+	return out
+}
+
+// --- tests ---
+
+func TestHosts(t *testing.T) {
+	hosts, err := Hosts("10.0.0.5/29")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"}, hosts)
+
+	hosts, err = Hosts("10.0.0.7/32")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.7"}, hosts)
+
+	for _, bad := range []string{"10.0.0.0", "10.0.0.0/8", "fd00::/120"} {
+		_, err := Hosts(bad)
+		assert.ErrorIs(t, err, ErrInvalid, bad)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	typ, ok := Classify("LITEON Technology", false)
+	assert.True(t, ok)
+	assert.Equal(t, devicetypes.ComponentTypePowerShelf, typ)
+
+	vendor, ok := PMCVendor("Delta Electronics")
+	assert.True(t, ok)
+	assert.Equal(t, psmapi.PMCVendorDelta, vendor)
+
+	typ, ok = Classify("NVIDIA", true)
+	assert.True(t, ok)
+	assert.Equal(t, devicetypes.ComponentTypeNVLSwitch, typ)
+
+	_, ok = Classify("NVIDIA", false)
+	assert.False(t, ok, "an NVIDIA BMC without a system is not a switch tray")
+	_, ok = Classify("Wiwynn", true)
+	assert.False(t, ok)
+}
+
+func TestSweepMatchesBySerial(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(false)
+
+	pendingID := env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN001")
+	env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN002", "aa:00:00:00:00:02")
+	env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN003", "aa:00:00:00:00:99")
+
+	env.prober.devices["10.0.0.1"] = shelf("aa:00:00:00:00:01", "ltn001")
+	env.prober.devices["10.0.0.2"] = shelf("aa:00:00:00:00:02", "LTN002")
+	env.prober.devices["10.0.0.3"] = shelf("aa:00:00:00:00:03", "LTN003")
+	env.prober.devices["10.0.0.4"] = shelf("aa:00:00:00:00:04", "LTN404")
+
+	devices, err := env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, devices, 4)
+	assert.Equal(t, "10.0.0.1", devices[0].IPAddress)
+
+	got := byIP(devices)
+	assert.Equal(t, string(StatePending), got["10.0.0.1"].State)
+	require.NotNil(t, got["10.0.0.1"].ComponentID)
+	assert.Equal(t, pendingID, *got["10.0.0.1"].ComponentID)
+	assert.Equal(t, string(StateKnown), got["10.0.0.2"].State)
+	assert.Equal(t, string(StateConflict), got["10.0.0.3"].State)
+	assert.Equal(t, string(StateUnmatched), got["10.0.0.4"].State)
+	assert.Nil(t, got["10.0.0.4"].ComponentID)
+
+	// Nothing is registered without approval.
+	shelves, err := env.psm.GetPowershelves(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, shelves)
+
+	pending, err := env.manager.List(ctx, []State{StatePending})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// A second sweep updates the same record.
+	env.prober.devices["10.0.0.1"].FirmwareVersion = "r1.3.8"
+	_, err = env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	again, err := env.manager.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, again, 4)
+	assert.Equal(t, pending[0].ID, byIP(again)["10.0.0.1"].ID)
+	assert.Equal(t, "r1.3.8", byIP(again)["10.0.0.1"].FirmwareVersion)
+}
+
+func TestSweepAutoRegister(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(true)
+
+	shelfID := env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN001")
+	env.inventory.add(devicetypes.ComponentTypeNVLSwitch, "NVS001")
+	env.inventory.add(devicetypes.ComponentTypeNVLSwitch, "NVS002")
+
+	env.prober.devices["10.0.0.1"] = shelf("aa:00:00:00:00:01", "LTN001")
+	env.prober.devices["10.0.0.2"] = nvswitch("bb:00:00:00:00:01", "NVS001", "cc:00:00:00:00:01", "10.1.0.1")
+	env.prober.devices["10.0.0.3"] = nvswitch("bb:00:00:00:00:02", "NVS002", "", "")
+
+	devices, err := env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	got := byIP(devices)
+
+	assert.Equal(t, string(StateRegistered), got["10.0.0.1"].State)
+	assert.Equal(t, string(StateRegistered), got["10.0.0.2"].State)
+	assert.Equal(t, string(StateFailed), got["10.0.0.3"].State)
+	assert.Contains(t, got["10.0.0.3"].Message, "NVOS")
+
+	shelves, err := env.psm.GetPowershelves(ctx, []string{"aa:00:00:00:00:01"})
+	require.NoError(t, err)
+	require.Len(t, shelves, 1)
+	assert.Equal(t, "10.0.0.1", shelves[0].PMC.IPAddress)
+	assert.Equal(t, psmapi.PMCVendorLiteon, shelves[0].PMC.Vendor)
+
+	switches, err := env.nsm.GetNVSwitches(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, switches, 1)
+	assert.Equal(t, "bb:00:00:00:00:01", switches[0].BMCMACAddress)
+
+	// The BMC is recorded on the expected component for inventory sync.
+	expected, err := env.inventory.ExpectedComponents(ctx, []devicetypes.ComponentType{devicetypes.ComponentTypePowerShelf})
+	require.NoError(t, err)
+	require.Len(t, expected, 1)
+	assert.Equal(t, shelfID, expected[0].ID)
+	require.Len(t, expected[0].BMCs, 1)
+	assert.Equal(t, "aa:00:00:00:00:01", expected[0].BMCs[0].MacAddress)
+
+	// Registered devices keep their state on later sweeps.
+	devices, err = env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRegistered), byIP(devices)["10.0.0.1"].State)
+}
+
+func TestSweepWithoutManager(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(true)
+	env.manager.psm = nil
+
+	env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN001")
+	env.prober.devices["10.0.0.1"] = shelf("aa:00:00:00:00:01", "LTN001")
+
+	devices, err := env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, string(StateFailed), devices[0].State)
+
+	expected, err := env.inventory.ExpectedComponents(ctx, discoveredTypes)
+	require.NoError(t, err)
+	assert.Empty(t, expected[0].BMCs, "the BMC is only recorded once registration succeeds")
+}
+
+func TestSweepRejectsOverlap(t *testing.T) {
+	env := newTestEnv(false)
+	env.manager.sweeping.Lock()
+	defer env.manager.sweeping.Unlock()
+
+	_, err := env.manager.Sweep(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrSweepRunning)
+}
+
+func TestApprove(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(false)
+
+	shelfID := env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN001")
+	switchID := env.inventory.add(devicetypes.ComponentTypeNVLSwitch, "NVS001")
+	env.prober.devices["10.0.0.1"] = shelf("aa:00:00:00:00:01", "LTN001")
+	env.prober.devices["10.0.0.2"] = nvswitch("bb:00:00:00:00:01", "NVS-UNLABELLED", "", "")
+
+	devices, err := env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	got := byIP(devices)
+	require.Equal(t, string(StatePending), got["10.0.0.1"].State)
+	require.Equal(t, string(StateUnmatched), got["10.0.0.2"].State)
+
+	d, err := env.manager.Approve(ctx, got["10.0.0.1"].ID, Approval{})
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRegistered), d.State)
+	require.NotNil(t, d.ComponentID)
+	assert.Equal(t, shelfID, *d.ComponentID)
+
+	_, err = env.manager.Approve(ctx, d.ID, Approval{})
+	assert.ErrorIs(t, err, ErrInvalid, "already registered")
+
+	// An unmatched device needs a component, and a switch its NVOS port.
+	_, err = env.manager.Approve(ctx, got["10.0.0.2"].ID, Approval{})
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	_, err = env.manager.Approve(ctx, got["10.0.0.2"].ID, Approval{ComponentID: shelfID})
+	assert.ErrorIs(t, err, ErrInvalid, "a power shelf component cannot take a switch")
+
+	_, err = env.manager.Approve(ctx, got["10.0.0.2"].ID, Approval{
+		ComponentID: switchID, HostMACAddress: "not-a-mac", HostIPAddress: "10.1.0.1",
+	})
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	d, err = env.manager.Approve(ctx, got["10.0.0.2"].ID, Approval{
+		ComponentID: switchID, HostMACAddress: "CC-00-00-00-00-01", HostIPAddress: "10.1.0.1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRegistered), d.State)
+	assert.Equal(t, "cc:00:00:00:00:01", *d.HostMacAddress)
+
+	_, err = env.manager.Approve(ctx, uuid.New(), Approval{})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestApproveDeviceGone(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(false)
+
+	env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN001")
+	env.prober.devices["10.0.0.1"] = shelf("aa:00:00:00:00:01", "LTN001")
+
+	devices, err := env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+
+	delete(env.prober.devices, "10.0.0.1")
+	_, err = env.manager.Approve(ctx, devices[0].ID, Approval{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotRedfish))
+
+	shelves, err := env.psm.GetPowershelves(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, shelves)
+}
+
+func TestReject(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(true)
+
+	env.prober.devices["10.0.0.1"] = shelf("aa:00:00:00:00:01", "LTN001")
+
+	devices, err := env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	require.Equal(t, string(StateUnmatched), devices[0].State)
+
+	d, err := env.manager.Reject(ctx, devices[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRejected), d.State)
+
+	// A rejected device is not registered even once it matches.
+	env.inventory.add(devicetypes.ComponentTypePowerShelf, "LTN001")
+	devices, err = env.manager.Sweep(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRejected), devices[0].State)
+
+	shelves, err := env.psm.GetPowershelves(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, shelves)
+
+	// Approving it registers it after all.
+	d, err = env.manager.Approve(ctx, d.ID, Approval{})
+	require.NoError(t, err)
+	assert.Equal(t, string(StateRegistered), d.State)
+
+	_, err = env.manager.Reject(ctx, d.ID)
+	assert.ErrorIs(t, err, ErrInvalid)
+}
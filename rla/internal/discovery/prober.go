@@ -0,0 +1,274 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+)
+
+// DefaultCredentials are tried when no probe credentials are configured: the
+// factory default of power shelf PMCs.
+var DefaultCredentials = []config.DiscoveryCredential{
+	{Username: "root", Password: "0penBmc"},
+}
+
+// Prober fingerprints the device at an IP address.
+type Prober interface {
+	// Probe returns the fingerprint of the device at ip. It returns an error
+	// wrapping ErrNotRedfish if no Redfish service answers, and one wrapping
+	// ErrUnsupported if the device is neither a power shelf nor an NV-Switch
+	// tray.
+	Probe(ctx context.Context, ip string) (*Fingerprint, error)
+}
+
+// RedfishProber probes Redfish services over HTTPS. It reads the public
+// service root first so addresses without Redfish are skipped cheaply, then
+// logs in with each probe credential in turn to read Chassis, Manager and
+// ComputerSystem data.
+type RedfishProber struct {
+	port        int
+	credentials []config.DiscoveryCredential
+	httpClient  *http.Client
+}
+
+// NewRedfishProber creates a prober for Redfish services listening on port.
+// DefaultCredentials are used if credentials is empty.
+func NewRedfishProber(port int, credentials []config.DiscoveryCredential) *RedfishProber {
+	if len(credentials) == 0 {
+		credentials = DefaultCredentials
+	}
+
+	return &RedfishProber{
+		port:        port,
+		credentials: credentials,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				// BMCs and PMCs ship self-signed certificates.
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+			},
+		},
+	}
+}
+
+// serviceRoot is the part of the Redfish service root read before logging in.
+type serviceRoot struct {
+	Vendor  string `json:"Vendor"`
+	Product string `json:"Product"`
+	Systems *struct {
+		ODataID string `json:"@odata.id"`
+	} `json:"Systems"`
+}
+
+// Probe implements Prober.
+func (p *RedfishProber) Probe(ctx context.Context, ip string) (*Fingerprint, error) {
+	endpoint := "https://" + net.JoinHostPort(ip, strconv.Itoa(p.port))
+
+	root, err := p.serviceRoot(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w at %s: %v", ErrNotRedfish, ip, err)
+	}
+
+	var loginErr error
+	for _, cred := range p.credentials {
+		client, err := gofish.ConnectContext(ctx, gofish.ClientConfig{
+			Endpoint:   endpoint,
+			Username:   cred.Username,
+			Password:   cred.Password,
+			Insecure:   true,
+			HTTPClient: p.httpClient,
+		})
+		if err != nil {
+			loginErr = err
+			continue
+		}
+
+		fp, err := inspect(client.Service, root)
+		client.Logout()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Redfish data of %s: %w", ip, err)
+		}
+
+		fp.IPAddress = ip
+		fp.Credential = cred
+		return fp, nil
+	}
+
+	return nil, fmt.Errorf("no probe credential accepted by %s: %w", ip, loginErr)
+}
+
+func (p *RedfishProber) serviceRoot(ctx context.Context, endpoint string) (*serviceRoot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/redfish/v1/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("service root returned %s", resp.Status)
+	}
+
+	var root serviceRoot
+	if err := json.NewDecoder(resp.Body).Decode(&root); err != nil {
+		return nil, fmt.Errorf("invalid service root: %w", err)
+	}
+
+	return &root, nil
+}
+
+// inspect builds the fingerprint of a device from its Redfish resources. The
+// chassis identifies the device, the BMC manager provides the MAC it is
+// registered under and its firmware, and the computer system of an NV-Switch
+// tray provides the NVOS management port.
+func inspect(service *gofish.Service, root *serviceRoot) (*Fingerprint, error) {
+	chassis, err := service.Chassis()
+	if err != nil {
+		return nil, err
+	}
+
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+
+	var systems []*redfish.ComputerSystem
+	if root.Systems != nil {
+		if systems, err = service.Systems(); err != nil {
+			return nil, err
+		}
+	}
+
+	fp := &Fingerprint{Manufacturer: root.Vendor, Model: root.Product}
+	if c := pickChassis(chassis); c != nil {
+		fp.Manufacturer = firstNonEmpty(c.Manufacturer, fp.Manufacturer)
+		fp.Model = firstNonEmpty(c.Model, fp.Model)
+		fp.SerialNumber = c.SerialNumber
+	}
+
+	typ, ok := Classify(fp.Manufacturer, len(systems) > 0)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnsupported, fp.Manufacturer, fp.Model)
+	}
+	fp.Type = typ
+
+	if m := pickManager(managers); m != nil {
+		fp.Model = firstNonEmpty(fp.Model, m.Model)
+		fp.FirmwareVersion = m.FirmwareVersion
+
+		ifaces, err := m.EthernetInterfaces()
+		if err != nil {
+			return nil, err
+		}
+		fp.MACAddress, _ = pickInterface(ifaces)
+	}
+
+	if len(systems) > 0 {
+		sys := systems[0]
+		fp.SerialNumber = firstNonEmpty(fp.SerialNumber, sys.SerialNumber)
+
+		ifaces, err := sys.EthernetInterfaces()
+		if err != nil {
+			return nil, err
+		}
+		fp.HostMACAddress, fp.HostIPAddress = pickInterface(ifaces)
+	}
+
+	if fp.MACAddress == "" {
+		return nil, errors.New("the BMC manager reports no MAC address")
+	}
+
+	return fp, nil
+}
+
+// pickChassis returns the first chassis that reports a serial number, or the
+// first chassis if none does.
+func pickChassis(chassis []*redfish.Chassis) *redfish.Chassis {
+	for _, c := range chassis {
+		if c.SerialNumber != "" {
+			return c
+		}
+	}
+
+	if len(chassis) > 0 {
+		return chassis[0]
+	}
+
+	return nil
+}
+
+// pickManager returns the manager with ID "bmc", or the first manager.
+func pickManager(managers []*redfish.Manager) *redfish.Manager {
+	for _, m := range managers {
+		if m.ID == "bmc" {
+			return m
+		}
+	}
+
+	if len(managers) > 0 {
+		return managers[0]
+	}
+
+	return nil
+}
+
+// pickInterface returns the normalized MAC and the first IPv4 address of the
+// first interface that reports a MAC.
+func pickInterface(ifaces []*redfish.EthernetInterface) (string, string) {
+	for _, iface := range ifaces {
+		mac := firstNonEmpty(iface.MACAddress, iface.PermanentMACAddress)
+		if mac == "" {
+			continue
+		}
+
+		var ip string
+		if len(iface.IPv4Addresses) > 0 {
+			ip = iface.IPv4Addresses[0].Address
+		}
+
+		return utils.NormalizeMAC(mac), ip
+	}
+
+	return "", ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
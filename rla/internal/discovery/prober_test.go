@@ -0,0 +1,129 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/redfish/emulator"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// serveEmulator serves an emulated device over HTTPS and returns its IP and
+// port.
+func serveEmulator(t *testing.T, cfg emulator.Config) (string, int) {
+	ts := httptest.NewTLSServer(emulator.New(cfg))
+	t.Cleanup(ts.Close)
+
+	host, port, err := net.SplitHostPort(ts.Listener.Addr().String())
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return host, p
+}
+
+func TestRedfishProberPowerShelf(t *testing.T) {
+	profile := emulator.DeltaPowerShelf
+	profile.SerialNumber = "DLT0000000042"
+	ip, port := serveEmulator(t, emulator.Config{
+		Profile:    profile,
+		Password:   "S3cret-pass",
+		MACAddress: "AA:BB:CC:00:00:01",
+	})
+
+	prober := NewRedfishProber(port, []config.DiscoveryCredential{
+		{Username: emulator.DefaultUsername, Password: "wrong-pass"},
+		{Username: emulator.DefaultUsername, Password: "S3cret-pass"},
+	})
+
+	fp, err := prober.Probe(context.Background(), ip)
+	require.NoError(t, err)
+	assert.Equal(t, ip, fp.IPAddress)
+	assert.Equal(t, "aa:bb:cc:00:00:01", fp.MACAddress)
+	assert.Equal(t, devicetypes.ComponentTypePowerShelf, fp.Type)
+	assert.Equal(t, "Delta", fp.Manufacturer)
+	assert.Equal(t, "ECD16010096", fp.Model)
+	assert.Equal(t, "DLT0000000042", fp.SerialNumber)
+	assert.Equal(t, "r2.0.4", fp.FirmwareVersion)
+	assert.Empty(t, fp.HostMACAddress)
+	assert.Equal(t, "S3cret-pass", fp.Credential.Password, "the accepted credential is kept")
+
+	vendor, _ := PMCVendor(fp.Manufacturer)
+	assert.Equal(t, psmapi.PMCVendorDelta, vendor)
+}
+
+func TestRedfishProberNVSwitch(t *testing.T) {
+	ip, port := serveEmulator(t, emulator.Config{
+		Profile:        emulator.NVSwitchBMC,
+		Username:       "root",
+		Password:       "0penBmc",
+		MACAddress:     "aa:bb:cc:00:00:02",
+		HostMACAddress: "aa:bb:cc:00:00:03",
+		HostIPAddress:  "10.1.0.7",
+	})
+
+	fp, err := NewRedfishProber(port, nil).Probe(context.Background(), ip)
+	require.NoError(t, err)
+	assert.Equal(t, devicetypes.ComponentTypeNVLSwitch, fp.Type)
+	assert.Equal(t, "NVS0000000001", fp.SerialNumber)
+	assert.Equal(t, "aa:bb:cc:00:00:02", fp.MACAddress)
+	assert.Equal(t, "aa:bb:cc:00:00:03", fp.HostMACAddress)
+	assert.Equal(t, "10.1.0.7", fp.HostIPAddress)
+}
+
+func TestRedfishProberErrors(t *testing.T) {
+	ctx := context.Background()
+
+	// Nothing listens on the port.
+	ts := httptest.NewTLSServer(nil)
+	_, port, _ := net.SplitHostPort(ts.Listener.Addr().String())
+	ts.Close()
+	p, _ := strconv.Atoi(port)
+	_, err := NewRedfishProber(p, nil).Probe(ctx, "127.0.0.1")
+	assert.ErrorIs(t, err, ErrNotRedfish)
+
+	// No credential is accepted.
+	ip, p := serveEmulator(t, emulator.Config{
+		Profile:    emulator.LiteonPowerShelf,
+		Password:   "S3cret-pass",
+		MACAddress: "aa:bb:cc:00:00:04",
+	})
+	_, err = NewRedfishProber(p, nil).Probe(ctx, ip)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotRedfish)
+	assert.Contains(t, err.Error(), "no probe credential accepted")
+
+	// An NVIDIA BMC without a system is not a switch tray.
+	profile := emulator.NVSwitchBMC
+	profile.SystemID = ""
+	ip, p = serveEmulator(t, emulator.Config{Profile: profile, MACAddress: "aa:bb:cc:00:00:05"})
+	_, err = NewRedfishProber(p, []config.DiscoveryCredential{
+		{Username: emulator.DefaultUsername, Password: emulator.DefaultPassword},
+	}).Probe(ctx, ip)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// Store is the persistence layer for discovered devices.
+type Store interface {
+	// Get returns the device with the given ID, or an error wrapping
+	// ErrNotFound.
+	Get(ctx context.Context, id uuid.UUID) (*dbmodel.DiscoveredDevice, error)
+
+	// GetByMAC returns the device with the given BMC MAC address, or an
+	// error wrapping ErrNotFound.
+	GetByMAC(ctx context.Context, mac string) (*dbmodel.DiscoveredDevice, error)
+
+	// List returns the devices in the given states, or all devices when
+	// states is empty, ordered by IP address.
+	List(ctx context.Context, states []State) ([]*dbmodel.DiscoveredDevice, error)
+
+	// Put creates the device, assigning an ID if it has none, or replaces
+	// the stored device with the same ID.
+	Put(ctx context.Context, d *dbmodel.DiscoveredDevice) error
+}
+
+// PostgresStore implements Store using PostgreSQL via bun.
+type PostgresStore struct {
+	pg *cdb.Session
+}
+
+// NewPostgresStore creates a new PostgreSQL-backed discovered device store.
+func NewPostgresStore(pg *cdb.Session) *PostgresStore {
+	return &PostgresStore{pg: pg}
+}
+
+// Get implements Store.
+func (s *PostgresStore) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (*dbmodel.DiscoveredDevice, error) {
+	return s.get(ctx, "dd.id = ?", id)
+}
+
+// GetByMAC implements Store.
+func (s *PostgresStore) GetByMAC(
+	ctx context.Context,
+	mac string,
+) (*dbmodel.DiscoveredDevice, error) {
+	return s.get(ctx, "dd.mac_address = ?", mac)
+}
+
+func (s *PostgresStore) get(
+	ctx context.Context,
+	where string,
+	arg any,
+) (*dbmodel.DiscoveredDevice, error) {
+	var d dbmodel.DiscoveredDevice
+
+	err := s.pg.DB.NewSelect().
+		Model(&d).
+		Where(where, arg).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", ErrNotFound, arg)
+		}
+
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// List implements Store.
+func (s *PostgresStore) List(
+	ctx context.Context,
+	states []State,
+) ([]*dbmodel.DiscoveredDevice, error) {
+	var rows []dbmodel.DiscoveredDevice
+
+	q := s.pg.DB.NewSelect().Model(&rows)
+	if len(states) > 0 {
+		q = q.Where("dd.state IN (?)", bun.In(states))
+	}
+
+	if err := q.OrderExpr("dd.ip_address ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	devices := make([]*dbmodel.DiscoveredDevice, len(rows))
+	for i := range rows {
+		d := rows[i]
+		devices[i] = &d
+	}
+
+	return devices, nil
+}
+
+// Put implements Store.
+func (s *PostgresStore) Put(ctx context.Context, d *dbmodel.DiscoveredDevice) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	d.UpdatedAt = time.Now()
+
+	_, err := s.pg.DB.NewInsert().
+		Model(d).
+		On("CONFLICT (id) DO UPDATE").
+		Set("mac_address = EXCLUDED.mac_address").
+		Set("ip_address = EXCLUDED.ip_address").
+		Set("type = EXCLUDED.type").
+		Set("manufacturer = EXCLUDED.manufacturer").
+		Set("model = EXCLUDED.model").
+		Set("serial_number = EXCLUDED.serial_number").
+		Set("firmware_version = EXCLUDED.firmware_version").
+		Set("host_mac_address = EXCLUDED.host_mac_address").
+		Set("host_ip_address = EXCLUDED.host_ip_address").
+		Set("component_id = EXCLUDED.component_id").
+		Set("state = EXCLUDED.state").
+		Set("message = EXCLUDED.message").
+		Set("last_seen_at = EXCLUDED.last_seen_at").
+		Exec(ctx)
+
+	return err
+}
@@ -0,0 +1,56 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// minPrefixBits bounds the size of a swept subnet so a typo such as a /8 does
+// not turn into millions of probes.
+const minPrefixBits = 16
+
+// Hosts returns the host addresses of an IPv4 subnet in CIDR notation. The
+// network and broadcast addresses are left out of subnets that have them.
+func Hosts(cidr string) ([]string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: subnet %q: %v", ErrInvalid, cidr, err)
+	}
+
+	if !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("%w: subnet %q: only IPv4 subnets are supported", ErrInvalid, cidr)
+	}
+
+	if prefix.Bits() < minPrefixBits {
+		return nil, fmt.Errorf("%w: subnet %q is larger than a /%d", ErrInvalid, cidr, minPrefixBits)
+	}
+
+	prefix = prefix.Masked()
+	var hosts []string
+	for addr := prefix.Addr(); addr.IsValid() && prefix.Contains(addr); addr = addr.Next() {
+		hosts = append(hosts, addr.String())
+	}
+
+	if prefix.Bits() < 31 {
+		hosts = hosts[1 : len(hosts)-1]
+	}
+
+	return hosts, nil
+}
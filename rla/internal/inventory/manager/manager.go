@@ -38,6 +38,8 @@ import (
 // Re-export for convenience
 type ComponentDrift = inventorystore.ComponentDrift
 type FieldDiff = inventorystore.FieldDiff
+type DriftFilter = inventorystore.DriftFilter
+type DriftCount = inventorystore.DriftCount
 
 // Manager defines the interface for inventory management business logic.
 // It wraps InventoryStore and provides a consistent API for the service layer.
@@ -71,6 +73,11 @@ type Manager interface {
 	// Component drift operations
 	GetDriftsByComponentIDs(ctx context.Context, componentIDs []uuid.UUID) ([]inventorystore.ComponentDrift, error)
 	GetAllDrifts(ctx context.Context) ([]inventorystore.ComponentDrift, error)
+	ListDrifts(ctx context.Context, filter inventorystore.DriftFilter) ([]inventorystore.ComponentDrift, error)
+	GetDriftByID(ctx context.Context, id uuid.UUID) (*inventorystore.ComponentDrift, error)
+	AcceptDrift(ctx context.Context, id uuid.UUID) (*component.Component, error)
+	DismissDrift(ctx context.Context, id uuid.UUID, reason string, dismissedBy string) (*inventorystore.ComponentDrift, error)
+	CountDrifts(ctx context.Context) ([]inventorystore.DriftCount, error)
 
 	// NVL Domain operations
 	CreateNVLDomain(ctx context.Context, nvlDomain *nvldomain.NVLDomain) (uuid.UUID, error)
@@ -239,3 +246,29 @@ func (m *ManagerImpl) GetDriftsByComponentIDs(ctx context.Context, componentIDs
 func (m *ManagerImpl) GetAllDrifts(ctx context.Context) ([]inventorystore.ComponentDrift, error) {
 	return m.store.GetAllDrifts(ctx)
 }
+
+// ListDrifts retrieves the drifts matching the filter.
+func (m *ManagerImpl) ListDrifts(ctx context.Context, filter inventorystore.DriftFilter) ([]inventorystore.ComponentDrift, error) {
+	return m.store.ListDrifts(ctx, filter)
+}
+
+// GetDriftByID retrieves a drift by ID.
+func (m *ManagerImpl) GetDriftByID(ctx context.Context, id uuid.UUID) (*inventorystore.ComponentDrift, error) {
+	return m.store.GetDriftByID(ctx, id)
+}
+
+// AcceptDrift writes the observed values of a mismatch drift into the
+// expected component and removes the drift.
+func (m *ManagerImpl) AcceptDrift(ctx context.Context, id uuid.UUID) (*component.Component, error) {
+	return m.store.AcceptDrift(ctx, id)
+}
+
+// DismissDrift dismisses a drift for as long as the same drift is observed.
+func (m *ManagerImpl) DismissDrift(ctx context.Context, id uuid.UUID, reason string, dismissedBy string) (*inventorystore.ComponentDrift, error) {
+	return m.store.DismissDrift(ctx, id, reason, dismissedBy)
+}
+
+// CountDrifts counts the drifts by type, component type and status.
+func (m *ManagerImpl) CountDrifts(ctx context.Context) ([]inventorystore.DriftCount, error) {
+	return m.store.CountDrifts(ctx)
+}
@@ -0,0 +1,81 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// driftCountTimeout bounds the query run on each scrape.
+const driftCountTimeout = 10 * time.Second
+
+// DriftCollector exports the number of drifts found by the inventory sync
+// as Prometheus gauges, counted on each scrape.
+type DriftCollector struct {
+	manager   Manager
+	driftDesc *prometheus.Desc
+}
+
+// Ensure DriftCollector implements prometheus.Collector.
+var _ prometheus.Collector = (*DriftCollector)(nil)
+
+// NewDriftCollector creates a DriftCollector reading from the given manager.
+func NewDriftCollector(m Manager) *DriftCollector {
+	return &DriftCollector{
+		manager: m,
+		driftDesc: prometheus.NewDesc(
+			prometheus.BuildFQName("rla", "inventory", "drifts"),
+			"Number of drifts between the inventory and the component manager services.",
+			[]string{"drift_type", "component_type", "status"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *DriftCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.driftDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *DriftCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), driftCountTimeout)
+	defer cancel()
+
+	counts, err := c.manager.CountDrifts(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.driftDesc, err)
+		return
+	}
+
+	for _, dc := range counts {
+		componentType := ""
+		if dc.ComponentType != devicetypes.ComponentTypeUnknown {
+			componentType = devicetypes.ComponentTypeToString(dc.ComponentType)
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.driftDesc, prometheus.GaugeValue, float64(dc.Count),
+			dc.DriftType, componentType, dc.Status,
+		)
+	}
+}
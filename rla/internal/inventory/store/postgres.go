@@ -20,6 +20,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -1100,26 +1101,155 @@ func (s *PostgresStore) GetAllDrifts(ctx context.Context) ([]ComponentDrift, err
 	return convertDriftsFromModel(drifts), nil
 }
 
+// ListDrifts retrieves the drifts matching the filter.
+func (s *PostgresStore) ListDrifts(ctx context.Context, filter DriftFilter) ([]ComponentDrift, error) {
+	modelFilter := model.DriftFilter{
+		RackID:           filter.RackID,
+		ComponentIDs:     filter.ComponentIDs,
+		IncludeDismissed: filter.IncludeDismissed,
+	}
+	for _, t := range filter.DriftTypes {
+		modelFilter.Types = append(modelFilter.Types, model.DriftType(t))
+	}
+	for _, t := range filter.ComponentTypes {
+		modelFilter.ComponentTypes = append(modelFilter.ComponentTypes, dao.ComponentTypeTo(t))
+	}
+
+	drifts, err := model.ListDrifts(ctx, s.pg.DB, modelFilter)
+	if err != nil {
+		return nil, errors.GRPCErrorInternal(err.Error())
+	}
+	return convertDriftsFromModel(drifts), nil
+}
+
+// GetDriftByID retrieves a drift by ID.
+func (s *PostgresStore) GetDriftByID(ctx context.Context, id uuid.UUID) (*ComponentDrift, error) {
+	drift, err := model.GetDriftByID(ctx, s.pg.DB, id)
+	if err != nil {
+		return nil, s.checkDBGetError(err, fmt.Sprintf("drift %s", id))
+	}
+	return convertDriftFromModel(drift), nil
+}
+
+// AcceptDrift writes the actual values of a mismatch drift into its
+// component and removes the drift, in one transaction. Drifts of other types
+// and values the source system does not report cannot be accepted.
+func (s *PostgresStore) AcceptDrift(ctx context.Context, id uuid.UUID) (*component.Component, error) {
+	var accepted *model.Component
+
+	operation := func(ctx context.Context, tx bun.Tx) error {
+		drift, err := model.GetDriftByID(ctx, tx, id)
+		if err != nil {
+			return s.checkDBGetError(err, fmt.Sprintf("drift %s", id))
+		}
+
+		if drift.DriftType != model.DriftTypeMismatch || drift.ComponentID == nil {
+			return errors.GRPCErrorPreconditionFailed(
+				fmt.Sprintf("drift %s is %s; only mismatch drifts can be accepted", id, drift.DriftType))
+		}
+
+		comp, err := (&model.Component{ID: *drift.ComponentID}).Get(ctx, tx)
+		if err != nil {
+			return s.checkDBGetError(err, fmt.Sprintf("component %s", *drift.ComponentID))
+		}
+
+		columns := make([]string, 0, len(drift.Diffs))
+		for _, diff := range drift.Diffs {
+			column, err := comp.ApplyFieldDiff(diff)
+			if err != nil {
+				return errors.GRPCErrorPreconditionFailed(
+					fmt.Sprintf("drift %s cannot be accepted: %v", id, err))
+			}
+			columns = append(columns, column)
+		}
+
+		if len(columns) > 0 {
+			if _, err := tx.NewUpdate().Model(comp).Column(columns...).WherePK().Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if err := model.DeleteDrift(ctx, tx, id); err != nil {
+			return err
+		}
+
+		accepted = comp
+		return nil
+	}
+
+	if err := s.runInTx(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return dao.ComponentFrom(*accepted), nil
+}
+
+// DismissDrift marks a drift dismissed with the given reason.
+func (s *PostgresStore) DismissDrift(ctx context.Context, id uuid.UUID, reason string, dismissedBy string) (*ComponentDrift, error) {
+	if err := model.DismissDrift(ctx, s.pg.DB, id, reason, dismissedBy, time.Now().UTC()); err != nil {
+		return nil, s.checkDBGetError(err, fmt.Sprintf("drift %s", id))
+	}
+	return s.GetDriftByID(ctx, id)
+}
+
+// CountDrifts counts the drifts by type, component type and status.
+func (s *PostgresStore) CountDrifts(ctx context.Context) ([]DriftCount, error) {
+	counts, err := model.CountDrifts(ctx, s.pg.DB)
+	if err != nil {
+		return nil, errors.GRPCErrorInternal(err.Error())
+	}
+
+	result := make([]DriftCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, DriftCount{
+			DriftType:     string(c.DriftType),
+			ComponentType: dao.ComponentTypeFrom(c.ComponentType),
+			Status:        string(c.Status),
+			Count:         c.Count,
+		})
+	}
+	return result, nil
+}
+
 // convertDriftsFromModel converts model-layer drift records to store-layer ones.
 func convertDriftsFromModel(drifts []model.ComponentDrift) []ComponentDrift {
 	result := make([]ComponentDrift, 0, len(drifts))
-	for _, d := range drifts {
-		fieldDiffs := make([]FieldDiff, 0, len(d.Diffs))
-		for _, fd := range d.Diffs {
-			fieldDiffs = append(fieldDiffs, FieldDiff{
-				FieldName:     fd.FieldName,
-				ExpectedValue: fd.ExpectedValue,
-				ActualValue:   fd.ActualValue,
-			})
-		}
-		result = append(result, ComponentDrift{
-			ID:          d.ID,
-			ComponentID: d.ComponentID,
-			ExternalID:  d.ExternalID,
-			DriftType:   string(d.DriftType),
-			Diffs:       fieldDiffs,
-			CheckedAt:   d.CheckedAt,
-		})
+	for i := range drifts {
+		result = append(result, *convertDriftFromModel(&drifts[i]))
 	}
 	return result
 }
+
+// convertDriftFromModel converts a model-layer drift record to a store-layer
+// one. The rack is known when the record was read with its component.
+func convertDriftFromModel(d *model.ComponentDrift) *ComponentDrift {
+	fieldDiffs := make([]FieldDiff, 0, len(d.Diffs))
+	for _, fd := range d.Diffs {
+		fieldDiffs = append(fieldDiffs, FieldDiff{
+			FieldName:     fd.FieldName,
+			ExpectedValue: fd.ExpectedValue,
+			ActualValue:   fd.ActualValue,
+		})
+	}
+
+	var rackID *uuid.UUID
+	if d.Component != nil && d.Component.RackID != uuid.Nil {
+		id := d.Component.RackID
+		rackID = &id
+	}
+
+	return &ComponentDrift{
+		ID:            d.ID,
+		ComponentID:   d.ComponentID,
+		ExternalID:    d.ExternalID,
+		DriftType:     string(d.DriftType),
+		Diffs:         fieldDiffs,
+		CheckedAt:     d.CheckedAt,
+		ComponentType: dao.ComponentTypeFrom(d.ComponentType),
+		RackID:        rackID,
+		Status:        string(d.Status),
+		DismissReason: d.DismissReason,
+		DismissedBy:   d.DismissedBy,
+		DismissedAt:   d.DismissedAt,
+	}
+}
@@ -36,12 +36,35 @@ import (
 
 // ComponentDrift represents a drift detected between expected (local DB) and actual (source system) data.
 type ComponentDrift struct {
-	ID          uuid.UUID
-	ComponentID *uuid.UUID  // NULL for missing_in_expected
-	ExternalID  *string     // Component ID from the component manager service; NULL for missing_in_actual
-	DriftType   string      // "missing_in_expected", "missing_in_actual", "mismatch"
-	Diffs       []FieldDiff // Field-level differences (for mismatch type)
-	CheckedAt   time.Time
+	ID            uuid.UUID
+	ComponentID   *uuid.UUID  // NULL for missing_in_expected
+	ExternalID    *string     // Component ID from the component manager service; NULL for missing_in_actual
+	DriftType     string      // "missing_in_expected", "missing_in_actual", "mismatch"
+	Diffs         []FieldDiff // Field-level differences (for mismatch type)
+	CheckedAt     time.Time
+	ComponentType devicetypes.ComponentType
+	RackID        *uuid.UUID // rack of the component; NULL for missing_in_expected
+	Status        string     // "open", "dismissed"
+	DismissReason string
+	DismissedBy   string
+	DismissedAt   *time.Time
+}
+
+// DriftFilter selects drifts. Zero-valued fields do not filter.
+type DriftFilter struct {
+	RackID           *uuid.UUID
+	ComponentIDs     []uuid.UUID
+	DriftTypes       []string
+	ComponentTypes   []devicetypes.ComponentType
+	IncludeDismissed bool // dismissed drifts are left out unless set
+}
+
+// DriftCount is the number of drifts of one type, component type and status.
+type DriftCount struct {
+	DriftType     string
+	ComponentType devicetypes.ComponentType
+	Status        string
+	Count         int
 }
 
 // FieldDiff represents a single field difference between expected and actual values.
@@ -83,6 +106,11 @@ type Store interface {
 	// Component drift operations
 	GetDriftsByComponentIDs(ctx context.Context, componentIDs []uuid.UUID) ([]ComponentDrift, error)
 	GetAllDrifts(ctx context.Context) ([]ComponentDrift, error)
+	ListDrifts(ctx context.Context, filter DriftFilter) ([]ComponentDrift, error)
+	GetDriftByID(ctx context.Context, id uuid.UUID) (*ComponentDrift, error)
+	AcceptDrift(ctx context.Context, id uuid.UUID) (*component.Component, error)
+	DismissDrift(ctx context.Context, id uuid.UUID, reason string, dismissedBy string) (*ComponentDrift, error)
+	CountDrifts(ctx context.Context) ([]DriftCount, error)
 
 	// NVL Domain operations
 	CreateNVLDomain(ctx context.Context, nvlDomain *nvldomain.NVLDomain) (uuid.UUID, error)
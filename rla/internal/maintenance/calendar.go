@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+)
+
+// Calendar manages maintenance windows and blackouts and answers when
+// disruptive operations may run on a rack.
+type Calendar struct {
+	store     Store
+	inventory Inventory
+}
+
+// NewCalendar creates a Calendar.
+func NewCalendar(store Store, inventory Inventory) *Calendar {
+	return &Calendar{
+		store:     store,
+		inventory: inventory,
+	}
+}
+
+// CreateWindow validates spec and stores it as a new maintenance window or
+// blackout.
+func (c *Calendar) CreateWindow(ctx context.Context, spec *Spec) (*dbmodel.MaintenanceWindow, error) {
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	row := &dbmodel.MaintenanceWindow{
+		Name:            spec.Name,
+		Description:     spec.Description,
+		Kind:            string(spec.Kind),
+		Scope:           string(spec.Scope),
+		Region:          spec.Site.Region,
+		DataCenter:      spec.Site.DataCenter,
+		Room:            spec.Site.Room,
+		Recurrence:      spec.Recurrence,
+		DurationSeconds: int(spec.Duration / time.Second),
+		Timezone:        spec.Timezone,
+	}
+	if spec.Scope != ScopeSite {
+		row.ScopeID = &spec.ScopeID
+	}
+	if spec.Recurrence == "" {
+		start, end := spec.Start.UTC(), spec.End.UTC()
+		row.StartAt, row.EndAt = &start, &end
+	} else if row.Timezone == "" {
+		row.Timezone = "UTC"
+	}
+
+	if _, err := newEntry(row); err != nil {
+		return nil, err
+	}
+
+	if err := c.store.CreateWindow(ctx, row); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// GetWindow returns a maintenance window or blackout.
+func (c *Calendar) GetWindow(ctx context.Context, id uuid.UUID) (*dbmodel.MaintenanceWindow, error) {
+	return c.store.GetWindow(ctx, id)
+}
+
+// DeleteWindow removes a maintenance window or blackout. Tasks already
+// deferred by it keep their start time.
+func (c *Calendar) DeleteWindow(ctx context.Context, id uuid.UUID) error {
+	return c.store.DeleteWindow(ctx, id)
+}
+
+// ListWindows returns the maintenance windows and blackouts that apply to
+// rackID, or every one when rackID is nil.
+func (c *Calendar) ListWindows(ctx context.Context, rackID *uuid.UUID) ([]*dbmodel.MaintenanceWindow, error) {
+	rows, err := c.store.ListWindows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if rackID == nil {
+		return rows, nil
+	}
+
+	info, err := c.inventory.Rack(ctx, *rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	var inScope []*dbmodel.MaintenanceWindow
+	for _, row := range rows {
+		if appliesTo(row, info) {
+			inScope = append(inScope, row)
+		}
+	}
+
+	return inScope, nil
+}
+
+// IsDisruptive reports whether op is subject to the calendar.
+func (c *Calendar) IsDisruptive(op operation.Wrapper) bool {
+	return IsDisruptive(op)
+}
+
+// RackStatus returns the maintenance status of a rack at the given time.
+// One-off entries that have already ended are ignored.
+func (c *Calendar) RackStatus(ctx context.Context, rackID uuid.UUID, at time.Time) (*Status, error) {
+	rows, err := c.ListWindows(ctx, &rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	var windows, blackouts []*entry
+	for _, row := range rows {
+		if row.EndAt != nil && !row.EndAt.After(at) {
+			continue
+		}
+
+		e, err := newEntry(row)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window %s: %w", row.ID, err)
+		}
+
+		if Kind(row.Kind) == KindBlackout {
+			blackouts = append(blackouts, e)
+		} else {
+			windows = append(windows, e)
+		}
+	}
+
+	return evaluate(windows, blackouts, at), nil
+}
+
+// RecordOverride records one use of a maintenance override.
+func (c *Calendar) RecordOverride(ctx context.Context, o *Override) error {
+	return c.store.CreateOverride(ctx, &dbmodel.MaintenanceOverride{
+		TaskID:        o.TaskID,
+		RackID:        o.RackID,
+		OperationType: string(o.Operation.Type),
+		OperationCode: o.Operation.Code,
+		Reason:        o.Reason,
+		RequestedBy:   o.RequestedBy,
+		Bypassed:      o.Bypassed,
+	})
+}
+
+// ListOverrides returns recorded overrides, newest first, for one rack or for
+// all racks when rackID is nil.
+func (c *Calendar) ListOverrides(
+	ctx context.Context,
+	rackID *uuid.UUID,
+	limit int,
+) ([]*dbmodel.MaintenanceOverride, error) {
+	return c.store.ListOverrides(ctx, rackID, limit)
+}
+
+// appliesTo reports whether a calendar entry covers the rack.
+func appliesTo(row *dbmodel.MaintenanceWindow, info *RackInfo) bool {
+	switch Scope(row.Scope) {
+	case ScopeRack:
+		return row.ScopeID != nil && *row.ScopeID == info.ID
+	case ScopeNVLDomain:
+		return row.ScopeID != nil && info.NVLDomainID != uuid.Nil &&
+			*row.ScopeID == info.NVLDomainID
+	case ScopeSite:
+		return matches(row.Region, info.Region) &&
+			matches(row.DataCenter, info.DataCenter) &&
+			matches(row.Room, info.Room)
+	default:
+		return false
+	}
+}
+
+func matches(want, got string) bool {
+	return want == "" || want == got
+}
@@ -0,0 +1,389 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+)
+
+// --- fakes ---
+
+type fakeStore struct {
+	windows   []*dbmodel.MaintenanceWindow
+	overrides []*dbmodel.MaintenanceOverride
+}
+
+func (s *fakeStore) CreateWindow(_ context.Context, w *dbmodel.MaintenanceWindow) error {
+	w.ID = uuid.New()
+	s.windows = append(s.windows, w)
+	return nil
+}
+
+func (s *fakeStore) GetWindow(_ context.Context, id uuid.UUID) (*dbmodel.MaintenanceWindow, error) {
+	for _, w := range s.windows {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+func (s *fakeStore) DeleteWindow(_ context.Context, id uuid.UUID) error {
+	for i, w := range s.windows {
+		if w.ID == id {
+			s.windows = append(s.windows[:i], s.windows[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+func (s *fakeStore) ListWindows(_ context.Context) ([]*dbmodel.MaintenanceWindow, error) {
+	return s.windows, nil
+}
+
+func (s *fakeStore) CreateOverride(_ context.Context, o *dbmodel.MaintenanceOverride) error {
+	s.overrides = append(s.overrides, o)
+	return nil
+}
+
+func (s *fakeStore) ListOverrides(_ context.Context, rackID *uuid.UUID, _ int) ([]*dbmodel.MaintenanceOverride, error) {
+	var out []*dbmodel.MaintenanceOverride
+	for _, o := range s.overrides {
+		if rackID == nil || o.RackID == *rackID {
+			out = append(out, o)
+		}
+	}
+	return out, nil
+}
+
+type fakeInventory map[uuid.UUID]*RackInfo
+
+func (i fakeInventory) Rack(_ context.Context, id uuid.UUID) (*RackInfo, error) {
+	r, ok := i[id]
+	if !ok {
+		return nil, fmt.Errorf("rack %s not found", id)
+	}
+	return r, nil
+}
+
+// --- helpers ---
+
+var (
+	rackA  = uuid.New()
+	rackB  = uuid.New()
+	domain = uuid.New()
+)
+
+func newTestCalendar() (*Calendar, *fakeStore) {
+	store := &fakeStore{}
+	inventory := fakeInventory{
+		rackA: {ID: rackA, NVLDomainID: domain, Region: "us-west", DataCenter: "dc1", Room: "r1"},
+		rackB: {ID: rackB, Region: "us-east", DataCenter: "dc2"},
+	}
+	return NewCalendar(store, inventory), store
+}
+
+// date returns a UTC time in November 2026. The 7th is a Saturday.
+func date(day, hour, minute int) time.Time {
+	return time.Date(2026, time.November, day, hour, minute, 0, 0, time.UTC)
+}
+
+func mustCreate(t *testing.T, c *Calendar, spec Spec) *dbmodel.MaintenanceWindow {
+	t.Helper()
+	w, err := c.CreateWindow(context.Background(), &spec)
+	require.NoError(t, err)
+	return w
+}
+
+// --- tests ---
+
+func TestIsDisruptive(t *testing.T) {
+	tests := []struct {
+		typ  taskcommon.TaskType
+		code string
+		want bool
+	}{
+		{taskcommon.TaskTypePowerControl, taskcommon.OpCodePowerControlPowerOn, false},
+		{taskcommon.TaskTypePowerControl, taskcommon.OpCodePowerControlForcePowerOn, false},
+		{taskcommon.TaskTypePowerControl, taskcommon.OpCodePowerControlPowerOff, true},
+		{taskcommon.TaskTypePowerControl, taskcommon.OpCodePowerControlWarmReset, true},
+		{taskcommon.TaskTypeFirmwareControl, taskcommon.OpCodeFirmwareControlUpgrade, true},
+		{taskcommon.TaskTypeBringUp, taskcommon.OpCodeBringUp, true},
+		{taskcommon.TaskTypeBringUp, taskcommon.OpCodeIngest, false},
+		{taskcommon.TaskTypeInjectExpectation, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.typ)+"/"+tt.code, func(t *testing.T) {
+			op := operation.Wrapper{Type: tt.typ, Code: tt.code}
+			assert.Equal(t, tt.want, IsDisruptive(op))
+		})
+	}
+}
+
+func TestCreateWindowValidation(t *testing.T) {
+	base := Spec{
+		Name:       "weekly",
+		Kind:       KindWindow,
+		Scope:      ScopeRack,
+		ScopeID:    rackA,
+		Recurrence: "0 2 * * 6",
+		Duration:   4 * time.Hour,
+	}
+
+	tests := map[string]func(s *Spec){
+		"no name":            func(s *Spec) { s.Name = "" },
+		"unknown kind":       func(s *Spec) { s.Kind = "freeze" },
+		"rack without id":    func(s *Spec) { s.ScopeID = uuid.Nil },
+		"empty site":         func(s *Spec) { s.Scope = ScopeSite },
+		"bad cron":           func(s *Spec) { s.Recurrence = "every saturday" },
+		"bad timezone":       func(s *Spec) { s.Timezone = "Mars/Olympus" },
+		"no duration":        func(s *Spec) { s.Duration = 0 },
+		"both forms":         func(s *Spec) { s.Start, s.End = date(7, 0, 0), date(7, 1, 0) },
+		"neither form":       func(s *Spec) { s.Recurrence, s.Duration = "", 0 },
+		"end before start":   func(s *Spec) { s.Recurrence, s.Duration, s.Start, s.End = "", 0, date(7, 1, 0), date(7, 0, 0) },
+		"unknown scope kind": func(s *Spec) { s.Scope = "row" },
+	}
+
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, store := newTestCalendar()
+			spec := base
+			mutate(&spec)
+
+			_, err := c.CreateWindow(context.Background(), &spec)
+			assert.ErrorIs(t, err, ErrInvalid)
+			assert.Empty(t, store.windows)
+		})
+	}
+
+	c, _ := newTestCalendar()
+	w := mustCreate(t, c, base)
+	assert.Equal(t, "UTC", w.Timezone)
+	assert.Equal(t, 4*3600, w.DurationSeconds)
+}
+
+func TestRackStatusUnrestricted(t *testing.T) {
+	c, _ := newTestCalendar()
+
+	status, err := c.RackStatus(context.Background(), rackA, date(7, 12, 0))
+	require.NoError(t, err)
+
+	assert.True(t, status.Open)
+	assert.False(t, status.Restricted)
+	require.NotNil(t, status.Opening)
+	assert.True(t, status.Opening.End.IsZero())
+}
+
+func TestRackStatusRecurringWindow(t *testing.T) {
+	c, _ := newTestCalendar()
+	mustCreate(t, c, Spec{
+		Name:       "saturday",
+		Kind:       KindWindow,
+		Scope:      ScopeNVLDomain,
+		ScopeID:    domain,
+		Recurrence: "0 2 * * 6",
+		Duration:   4 * time.Hour,
+	})
+
+	// Friday: closed until Saturday 02:00.
+	status, err := c.RackStatus(context.Background(), rackA, date(6, 12, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, "outside maintenance windows", status.Reason)
+	require.NotNil(t, status.Opening)
+	assert.Equal(t, date(7, 2, 0), status.Opening.Start)
+	assert.Equal(t, date(7, 6, 0), status.Opening.End)
+
+	// Saturday 03:00: open until 06:00.
+	status, err = c.RackStatus(context.Background(), rackA, date(7, 3, 0))
+	require.NoError(t, err)
+	assert.True(t, status.Open)
+	assert.Equal(t, date(7, 3, 0), status.Opening.Start)
+	assert.Equal(t, date(7, 6, 0), status.Opening.End)
+
+	// Saturday 06:00 is the exclusive end: closed until next Saturday.
+	status, err = c.RackStatus(context.Background(), rackA, date(7, 6, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, date(14, 2, 0), status.Opening.Start)
+
+	// The rack outside the domain is unaffected.
+	status, err = c.RackStatus(context.Background(), rackB, date(6, 12, 0))
+	require.NoError(t, err)
+	assert.True(t, status.Open)
+	assert.False(t, status.Restricted)
+}
+
+func TestRackStatusTimezone(t *testing.T) {
+	c, _ := newTestCalendar()
+	mustCreate(t, c, Spec{
+		Name:       "nightly",
+		Kind:       KindWindow,
+		Scope:      ScopeRack,
+		ScopeID:    rackA,
+		Recurrence: "0 1 * * *",
+		Duration:   time.Hour,
+		Timezone:   "America/Los_Angeles",
+	})
+
+	// 01:00 PST is 09:00 UTC.
+	status, err := c.RackStatus(context.Background(), rackA, date(7, 12, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, date(8, 9, 0), status.Opening.Start)
+	assert.Equal(t, date(8, 10, 0), status.Opening.End)
+}
+
+func TestRackStatusBlackout(t *testing.T) {
+	c, _ := newTestCalendar()
+	mustCreate(t, c, Spec{
+		Name:  "training",
+		Kind:  KindBlackout,
+		Scope: ScopeSite,
+		Site:  Site{Region: "us-west"},
+		Start: date(7, 0, 0),
+		End:   date(9, 0, 0),
+	})
+
+	// Before the blackout the rack is open until it starts.
+	status, err := c.RackStatus(context.Background(), rackA, date(6, 12, 0))
+	require.NoError(t, err)
+	assert.True(t, status.Open)
+	assert.Equal(t, date(7, 0, 0), status.Opening.End)
+
+	// During it the rack is closed until it ends.
+	status, err = c.RackStatus(context.Background(), rackA, date(8, 12, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, `blackout "training" is active`, status.Reason)
+	assert.Equal(t, date(9, 0, 0), status.Opening.Start)
+	assert.True(t, status.Opening.End.IsZero())
+
+	// The other site is unaffected.
+	status, err = c.RackStatus(context.Background(), rackB, date(8, 12, 0))
+	require.NoError(t, err)
+	assert.True(t, status.Open)
+
+	// Once it is over, the blackout no longer restricts the rack.
+	status, err = c.RackStatus(context.Background(), rackA, date(10, 0, 0))
+	require.NoError(t, err)
+	assert.True(t, status.Open)
+	assert.False(t, status.Restricted)
+}
+
+func TestRackStatusBlackoutInsideWindows(t *testing.T) {
+	c, _ := newTestCalendar()
+	mustCreate(t, c, Spec{
+		Name:       "daily",
+		Kind:       KindWindow,
+		Scope:      ScopeRack,
+		ScopeID:    rackA,
+		Recurrence: "0 2 * * *",
+		Duration:   4 * time.Hour,
+	})
+	mustCreate(t, c, Spec{
+		Name:    "freeze",
+		Kind:    KindBlackout,
+		Scope:   ScopeRack,
+		ScopeID: rackA,
+		Start:   date(7, 1, 0),
+		End:     date(8, 3, 0),
+	})
+
+	// The Saturday window is blacked out entirely and Sunday's starts an
+	// hour late.
+	status, err := c.RackStatus(context.Background(), rackA, date(6, 12, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, date(8, 3, 0), status.Opening.Start)
+	assert.Equal(t, date(8, 6, 0), status.Opening.End)
+
+	// Friday's window ends before the blackout starts.
+	status, err = c.RackStatus(context.Background(), rackA, date(6, 0, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, date(6, 2, 0), status.Opening.Start)
+	assert.Equal(t, date(6, 6, 0), status.Opening.End)
+}
+
+func TestRackStatusNoOpening(t *testing.T) {
+	c, _ := newTestCalendar()
+	mustCreate(t, c, Spec{
+		Name:    "once",
+		Kind:    KindWindow,
+		Scope:   ScopeRack,
+		ScopeID: rackA,
+		Start:   date(7, 2, 0),
+		End:     date(7, 4, 0),
+	})
+	mustCreate(t, c, Spec{
+		Name:    "freeze",
+		Kind:    KindBlackout,
+		Scope:   ScopeRack,
+		ScopeID: rackA,
+		Start:   date(7, 0, 0),
+		End:     date(7, 5, 0),
+	})
+
+	status, err := c.RackStatus(context.Background(), rackA, date(6, 12, 0))
+	require.NoError(t, err)
+	assert.False(t, status.Open)
+	assert.Equal(t, "outside maintenance windows", status.Reason)
+	assert.Nil(t, status.Opening)
+}
+
+func TestListWindowsAndOverrides(t *testing.T) {
+	c, store := newTestCalendar()
+	mustCreate(t, c, Spec{Name: "a", Kind: KindWindow, Scope: ScopeRack, ScopeID: rackA, Start: date(7, 0, 0), End: date(7, 1, 0)})
+	mustCreate(t, c, Spec{Name: "b", Kind: KindBlackout, Scope: ScopeRack, ScopeID: rackB, Start: date(7, 0, 0), End: date(7, 1, 0)})
+
+	all, err := c.ListWindows(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	forA, err := c.ListWindows(context.Background(), &rackA)
+	require.NoError(t, err)
+	require.Len(t, forA, 1)
+	assert.Equal(t, "a", forA[0].Name)
+
+	require.NoError(t, c.RecordOverride(context.Background(), &Override{
+		TaskID:      uuid.New(),
+		RackID:      rackA,
+		Operation:   operation.Wrapper{Type: taskcommon.TaskTypeFirmwareControl, Code: taskcommon.OpCodeFirmwareControlUpgrade},
+		Reason:      "security fix",
+		RequestedBy: "oncall",
+		Bypassed:    "outside maintenance windows",
+	}))
+	require.Len(t, store.overrides, 1)
+	assert.Equal(t, "firmware_control", store.overrides[0].OperationType)
+	assert.Equal(t, "upgrade", store.overrides[0].OperationCode)
+	assert.Equal(t, "security fix", store.overrides[0].Reason)
+}
@@ -0,0 +1,77 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// Inventory is the view of a rack the calendar needs to find the entries in
+// its scope.
+type Inventory interface {
+	// Rack returns the NVL domain and location of a rack.
+	Rack(ctx context.Context, id uuid.UUID) (*RackInfo, error)
+}
+
+// PostgresInventory implements Inventory on the RLA rack table.
+type PostgresInventory struct {
+	pg *cdb.Session
+}
+
+// NewPostgresInventory creates an Inventory backed by the RLA database.
+func NewPostgresInventory(pg *cdb.Session) *PostgresInventory {
+	return &PostgresInventory{pg: pg}
+}
+
+// Rack implements Inventory.
+func (i *PostgresInventory) Rack(ctx context.Context, id uuid.UUID) (*RackInfo, error) {
+	var r dbmodel.Rack
+
+	err := i.pg.DB.NewSelect().
+		Model(&r).
+		Where("r.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("rack %s not found", id)
+		}
+
+		return nil, err
+	}
+
+	return &RackInfo{
+		ID:          r.ID,
+		NVLDomainID: r.NVLDomainID,
+		Region:      locationField(r.Location, "region"),
+		DataCenter:  locationField(r.Location, "data_center"),
+		Room:        locationField(r.Location, "room"),
+	}, nil
+}
+
+func locationField(loc map[string]any, key string) string {
+	s, _ := loc[key].(string)
+	return s
+}
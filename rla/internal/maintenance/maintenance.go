@@ -0,0 +1,213 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package maintenance implements the maintenance calendar. Maintenance
+// windows say when disruptive operations may run on a rack; blackouts say
+// when they may not. Both are scoped to a rack, an NVL domain or a site and
+// occur once or on a cron recurrence. The task manager consults the calendar
+// before it creates a disruptive task and either defers the task to the next
+// opening or rejects it, unless the request carries an audited override.
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+)
+
+var (
+	// ErrNotFound is returned when a maintenance window does not exist.
+	ErrNotFound = errors.New("maintenance window not found")
+
+	// ErrInvalid is returned when a maintenance window spec is invalid.
+	ErrInvalid = errors.New("invalid maintenance window")
+)
+
+// Kind says whether a calendar entry allows or forbids disruptive work.
+type Kind string
+
+const (
+	// KindWindow is a maintenance window. A rack with at least one window
+	// in scope runs disruptive operations only inside one of them.
+	KindWindow Kind = "window"
+
+	// KindBlackout is a blackout. No disruptive operation runs on a rack
+	// in scope while it lasts.
+	KindBlackout Kind = "blackout"
+)
+
+// Scope says which racks a calendar entry applies to.
+type Scope string
+
+const (
+	// ScopeRack applies to one rack.
+	ScopeRack Scope = "rack"
+
+	// ScopeNVLDomain applies to every rack of one NVL domain.
+	ScopeNVLDomain Scope = "nvl_domain"
+
+	// ScopeSite applies to every rack whose location matches each
+	// non-empty site field.
+	ScopeSite Scope = "site"
+)
+
+// Site selects racks by location. Empty fields match any value.
+type Site struct {
+	Region     string
+	DataCenter string
+	Room       string
+}
+
+// Spec describes a new maintenance window or blackout. Exactly one of
+// Start/End and Recurrence/Duration is set.
+type Spec struct {
+	Name        string
+	Description string
+	Kind        Kind
+
+	Scope   Scope
+	ScopeID uuid.UUID // rack or NVL domain ID; unused for ScopeSite
+	Site    Site      // ScopeSite only
+
+	// Start and End bound a one-off occurrence.
+	Start time.Time
+	End   time.Time
+
+	// Recurrence is a 5-field cron spec of the start of every occurrence,
+	// interpreted in Timezone (UTC when empty). Each occurrence lasts
+	// Duration.
+	Recurrence string
+	Duration   time.Duration
+	Timezone   string
+}
+
+// IsDisruptive reports whether op takes a rack's components out of service
+// and is therefore subject to the maintenance calendar. Powering on and
+// ingesting inventory are not disruptive.
+func IsDisruptive(op operation.Wrapper) bool {
+	switch op.Type {
+	case taskcommon.TaskTypePowerControl:
+		return op.Code != taskcommon.OpCodePowerControlPowerOn &&
+			op.Code != taskcommon.OpCodePowerControlForcePowerOn
+	case taskcommon.TaskTypeFirmwareControl:
+		return true
+	case taskcommon.TaskTypeBringUp:
+		return op.Code != taskcommon.OpCodeIngest
+	default:
+		return false
+	}
+}
+
+// Opening is a period during which disruptive operations may run on a rack.
+type Opening struct {
+	Start time.Time
+
+	// End is when the opening closes, because its maintenance window
+	// ends or a blackout starts. Zero means the opening does not close
+	// within the calendar's lookahead.
+	End time.Time
+}
+
+// Status is the maintenance state of a rack at a point in time.
+type Status struct {
+	// Open reports whether disruptive operations may run now.
+	Open bool
+
+	// Reason says why the rack is closed, e.g. which blackout is active.
+	// Empty when Open.
+	Reason string
+
+	// Opening is the current opening when Open, otherwise the next one.
+	// Nil when the rack does not open within the calendar's lookahead.
+	Opening *Opening
+
+	// Restricted reports whether any maintenance window or blackout
+	// applies to the rack at all.
+	Restricted bool
+}
+
+// RackInfo is what the calendar needs to know about a rack to find the
+// entries in its scope.
+type RackInfo struct {
+	ID          uuid.UUID
+	NVLDomainID uuid.UUID // uuid.Nil when the rack is in no NVL domain
+	Region      string
+	DataCenter  string
+	Room        string
+}
+
+// Override is one use of a maintenance override, recorded for audit.
+type Override struct {
+	TaskID      uuid.UUID
+	RackID      uuid.UUID
+	Operation   operation.Wrapper
+	Reason      string
+	RequestedBy string
+
+	// Bypassed is what the calendar would have done without the
+	// override, e.g. "blackout \"q4-training\" is active".
+	Bypassed string
+}
+
+func (s *Spec) validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+
+	switch s.Kind {
+	case KindWindow, KindBlackout:
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalid, s.Kind)
+	}
+
+	switch s.Scope {
+	case ScopeRack, ScopeNVLDomain:
+		if s.ScopeID == uuid.Nil {
+			return fmt.Errorf("%w: %s scope requires an ID", ErrInvalid, s.Scope)
+		}
+	case ScopeSite:
+		if s.Site == (Site{}) {
+			return fmt.Errorf("%w: site scope requires a region, data center or room", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: unknown scope %q", ErrInvalid, s.Scope)
+	}
+
+	oneOff := !s.Start.IsZero() || !s.End.IsZero()
+	recurring := s.Recurrence != "" || s.Duration != 0
+	switch {
+	case oneOff && recurring:
+		return fmt.Errorf("%w: set either start and end or a recurrence, not both", ErrInvalid)
+	case oneOff:
+		if s.Start.IsZero() || !s.End.After(s.Start) {
+			return fmt.Errorf("%w: end must be after start", ErrInvalid)
+		}
+	case recurring:
+		if s.Duration < time.Minute {
+			return fmt.Errorf("%w: duration must be at least a minute", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: set either start and end or a recurrence", ErrInvalid)
+	}
+
+	return nil
+}
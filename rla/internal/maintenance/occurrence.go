@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// lookahead bounds how far ahead the calendar searches for an opening.
+const lookahead = 366 * 24 * time.Hour
+
+// entry is a calendar row with its recurrence parsed.
+type entry struct {
+	row *dbmodel.MaintenanceWindow
+
+	schedule cron.Schedule
+	location *time.Location
+	duration time.Duration
+}
+
+func newEntry(row *dbmodel.MaintenanceWindow) (*entry, error) {
+	e := &entry{row: row}
+	if row.Recurrence == "" {
+		if row.StartAt == nil || row.EndAt == nil {
+			return nil, fmt.Errorf("%w: %q has neither start and end nor a recurrence", ErrInvalid, row.Name)
+		}
+		return e, nil
+	}
+
+	tz := row.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timezone %q: %v", ErrInvalid, tz, err)
+	}
+
+	schedule, err := cron.ParseStandard(row.Recurrence)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid recurrence %q: %v", ErrInvalid, row.Recurrence, err)
+	}
+
+	e.schedule = schedule
+	e.location = loc
+	e.duration = time.Duration(row.DurationSeconds) * time.Second
+
+	return e, nil
+}
+
+// activeAt returns the end of the occurrence that covers t, if any.
+func (e *entry) activeAt(t time.Time) (time.Time, bool) {
+	if e.schedule == nil {
+		if !t.Before(*e.row.StartAt) && t.Before(*e.row.EndAt) {
+			return *e.row.EndAt, true
+		}
+		return time.Time{}, false
+	}
+
+	// The earliest occurrence still running at t started after t-duration.
+	start := e.schedule.Next(t.Add(-e.duration).In(e.location))
+	if start.IsZero() || start.After(t) {
+		return time.Time{}, false
+	}
+
+	return start.Add(e.duration).UTC(), true
+}
+
+// nextStart returns the start of the first occurrence after t, if any.
+func (e *entry) nextStart(t time.Time) (time.Time, bool) {
+	if e.schedule == nil {
+		if e.row.StartAt.After(t) {
+			return *e.row.StartAt, true
+		}
+		return time.Time{}, false
+	}
+
+	next := e.schedule.Next(t.In(e.location))
+	if next.IsZero() {
+		return time.Time{}, false
+	}
+
+	return next.UTC(), true
+}
+
+// evaluate works out the maintenance status at now from the windows and
+// blackouts in a rack's scope.
+func evaluate(windows, blackouts []*entry, now time.Time) *Status {
+	status := &Status{Restricted: len(windows) > 0 || len(blackouts) > 0}
+	limit := now.Add(lookahead)
+
+	t := now
+	for !t.After(limit) {
+		if end, name, ok := latestEnd(blackouts, t); ok {
+			if t.Equal(now) {
+				status.Reason = fmt.Sprintf("blackout %q is active", name)
+			}
+			t = end
+			continue
+		}
+
+		var opening Opening
+		if len(windows) > 0 {
+			end, _, ok := latestEnd(windows, t)
+			if !ok {
+				if t.Equal(now) {
+					status.Reason = "outside maintenance windows"
+				}
+				next, ok := earliestStart(windows, t)
+				if !ok {
+					break
+				}
+				t = next
+				continue
+			}
+			opening.End = end
+		}
+
+		if next, ok := earliestStart(blackouts, t); ok && (opening.End.IsZero() || next.Before(opening.End)) {
+			opening.End = next
+		}
+
+		opening.Start = t
+		status.Open = t.Equal(now)
+		status.Opening = &opening
+		return status
+	}
+
+	return status
+}
+
+// latestEnd returns the latest end among the occurrences covering t and the
+// name of the entry it belongs to.
+func latestEnd(entries []*entry, t time.Time) (time.Time, string, bool) {
+	var end time.Time
+	var name string
+	for _, e := range entries {
+		if eEnd, ok := e.activeAt(t); ok && eEnd.After(end) {
+			end, name = eEnd, e.row.Name
+		}
+	}
+
+	return end, name, !end.IsZero()
+}
+
+// earliestStart returns the earliest occurrence start after t.
+func earliestStart(entries []*entry, t time.Time) (time.Time, bool) {
+	var start time.Time
+	for _, e := range entries {
+		if s, ok := e.nextStart(t); ok && (start.IsZero() || s.Before(start)) {
+			start = s
+		}
+	}
+
+	return start, !start.IsZero()
+}
@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// Store is the persistence layer for the maintenance calendar.
+type Store interface {
+	// CreateWindow stores a new maintenance window or blackout, assigning
+	// it an ID if it has none.
+	CreateWindow(ctx context.Context, w *dbmodel.MaintenanceWindow) error
+
+	// GetWindow returns the window with the given ID, or an error wrapping
+	// ErrNotFound.
+	GetWindow(ctx context.Context, id uuid.UUID) (*dbmodel.MaintenanceWindow, error)
+
+	// DeleteWindow removes a window, or returns an error wrapping
+	// ErrNotFound.
+	DeleteWindow(ctx context.Context, id uuid.UUID) error
+
+	// ListWindows returns every window and blackout, oldest first.
+	ListWindows(ctx context.Context) ([]*dbmodel.MaintenanceWindow, error)
+
+	// CreateOverride records one use of a maintenance override.
+	CreateOverride(ctx context.Context, o *dbmodel.MaintenanceOverride) error
+
+	// ListOverrides returns recorded overrides, newest first, for one rack
+	// or for all racks when rackID is nil. limit <= 0 means no limit.
+	ListOverrides(ctx context.Context, rackID *uuid.UUID, limit int) ([]*dbmodel.MaintenanceOverride, error)
+}
+
+// PostgresStore implements Store using PostgreSQL via bun.
+type PostgresStore struct {
+	pg *cdb.Session
+}
+
+// NewPostgresStore creates a new PostgreSQL-backed maintenance store.
+func NewPostgresStore(pg *cdb.Session) *PostgresStore {
+	return &PostgresStore{pg: pg}
+}
+
+// CreateWindow implements Store.
+func (s *PostgresStore) CreateWindow(ctx context.Context, w *dbmodel.MaintenanceWindow) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+
+	_, err := s.pg.DB.NewInsert().Model(w).Exec(ctx)
+	return err
+}
+
+// GetWindow implements Store.
+func (s *PostgresStore) GetWindow(ctx context.Context, id uuid.UUID) (*dbmodel.MaintenanceWindow, error) {
+	var w dbmodel.MaintenanceWindow
+
+	err := s.pg.DB.NewSelect().
+		Model(&w).
+		Where("mw.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", ErrNotFound, id)
+		}
+
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+// DeleteWindow implements Store.
+func (s *PostgresStore) DeleteWindow(ctx context.Context, id uuid.UUID) error {
+	res, err := s.pg.DB.NewDelete().
+		Model((*dbmodel.MaintenanceWindow)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %v", ErrNotFound, id)
+	}
+
+	return nil
+}
+
+// ListWindows implements Store.
+func (s *PostgresStore) ListWindows(ctx context.Context) ([]*dbmodel.MaintenanceWindow, error) {
+	var rows []dbmodel.MaintenanceWindow
+
+	if err := s.pg.DB.NewSelect().Model(&rows).OrderExpr("mw.created_at ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	windows := make([]*dbmodel.MaintenanceWindow, len(rows))
+	for i := range rows {
+		w := rows[i]
+		windows[i] = &w
+	}
+
+	return windows, nil
+}
+
+// CreateOverride implements Store.
+func (s *PostgresStore) CreateOverride(ctx context.Context, o *dbmodel.MaintenanceOverride) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+
+	_, err := s.pg.DB.NewInsert().Model(o).Exec(ctx)
+	return err
+}
+
+// ListOverrides implements Store.
+func (s *PostgresStore) ListOverrides(
+	ctx context.Context,
+	rackID *uuid.UUID,
+	limit int,
+) ([]*dbmodel.MaintenanceOverride, error) {
+	var rows []dbmodel.MaintenanceOverride
+
+	q := s.pg.DB.NewSelect().Model(&rows)
+	if rackID != nil {
+		q = q.Where("mo.rack_id = ?", *rackID)
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.OrderExpr("mo.created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	overrides := make([]*dbmodel.MaintenanceOverride, len(rows))
+	for i := range rows {
+		o := rows[i]
+		overrides[i] = &o
+	}
+
+	return overrides, nil
+}
@@ -374,6 +374,123 @@ func (UpdateState) EnumDescriptor() ([]byte, []int) {
 	return file_nvswitch_manager_proto_rawDescGZIP(), []int{5}
 }
 
+// CredentialRotationState represents the state of the latest credential rotation of a switch account.
+type CredentialRotationState int32
+
+const (
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN       CredentialRotationState = 0
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED CredentialRotationState = 1
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS   CredentialRotationState = 2
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED     CredentialRotationState = 3
+	// The rotation failed and the device still accepts the stored password.
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED CredentialRotationState = 4
+	// The rotation failed and restoring the stored password failed too; the device needs manual attention.
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED CredentialRotationState = 5
+)
+
+// Enum value maps for CredentialRotationState.
+var (
+	CredentialRotationState_name = map[int32]string{
+		0: "CREDENTIAL_ROTATION_STATE_UNKNOWN",
+		1: "CREDENTIAL_ROTATION_STATE_NEVER_ROTATED",
+		2: "CREDENTIAL_ROTATION_STATE_IN_PROGRESS",
+		3: "CREDENTIAL_ROTATION_STATE_SUCCEEDED",
+		4: "CREDENTIAL_ROTATION_STATE_FAILED",
+		5: "CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED",
+	}
+	CredentialRotationState_value = map[string]int32{
+		"CREDENTIAL_ROTATION_STATE_UNKNOWN":         0,
+		"CREDENTIAL_ROTATION_STATE_NEVER_ROTATED":   1,
+		"CREDENTIAL_ROTATION_STATE_IN_PROGRESS":     2,
+		"CREDENTIAL_ROTATION_STATE_SUCCEEDED":       3,
+		"CREDENTIAL_ROTATION_STATE_FAILED":          4,
+		"CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED": 5,
+	}
+)
+
+func (x CredentialRotationState) Enum() *CredentialRotationState {
+	p := new(CredentialRotationState)
+	*p = x
+	return p
+}
+
+func (x CredentialRotationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialRotationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_nvswitch_manager_proto_enumTypes[6].Descriptor()
+}
+
+func (CredentialRotationState) Type() protoreflect.EnumType {
+	return &file_nvswitch_manager_proto_enumTypes[6]
+}
+
+func (x CredentialRotationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialRotationState.Descriptor instead.
+func (CredentialRotationState) EnumDescriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{6}
+}
+
+// NVSwitchEventKind classifies Redfish events reported by switch BMCs.
+type NVSwitchEventKind int32
+
+const (
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN     NVSwitchEventKind = 0
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_PSU_FAULT   NVSwitchEventKind = 1
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_POWER_STATE NVSwitchEventKind = 2
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK        NVSwitchEventKind = 3
+	NVSwitchEventKind_NVSWITCH_EVENT_KIND_OTHER       NVSwitchEventKind = 4
+)
+
+// Enum value maps for NVSwitchEventKind.
+var (
+	NVSwitchEventKind_name = map[int32]string{
+		0: "NVSWITCH_EVENT_KIND_UNKNOWN",
+		1: "NVSWITCH_EVENT_KIND_PSU_FAULT",
+		2: "NVSWITCH_EVENT_KIND_POWER_STATE",
+		3: "NVSWITCH_EVENT_KIND_TASK",
+		4: "NVSWITCH_EVENT_KIND_OTHER",
+	}
+	NVSwitchEventKind_value = map[string]int32{
+		"NVSWITCH_EVENT_KIND_UNKNOWN":     0,
+		"NVSWITCH_EVENT_KIND_PSU_FAULT":   1,
+		"NVSWITCH_EVENT_KIND_POWER_STATE": 2,
+		"NVSWITCH_EVENT_KIND_TASK":        3,
+		"NVSWITCH_EVENT_KIND_OTHER":       4,
+	}
+)
+
+func (x NVSwitchEventKind) Enum() *NVSwitchEventKind {
+	p := new(NVSwitchEventKind)
+	*p = x
+	return p
+}
+
+func (x NVSwitchEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NVSwitchEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_nvswitch_manager_proto_enumTypes[7].Descriptor()
+}
+
+func (NVSwitchEventKind) Type() protoreflect.EnumType {
+	return &file_nvswitch_manager_proto_enumTypes[7]
+}
+
+func (x NVSwitchEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NVSwitchEventKind.Descriptor instead.
+func (NVSwitchEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{7}
+}
+
 // Credentials wraps around a username and password.
 type Credentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2232,6 +2349,471 @@ func (x *FirmwareUpdateInfo) GetPredecessorId() string {
 	return ""
 }
 
+// RotateCredentialsRequest specifies the switches and accounts whose passwords are rotated.
+type RotateCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Components    []NVSwitchComponent    `protobuf:"varint,2,rep,packed,name=components,proto3,enum=nsm.v1.NVSwitchComponent" json:"components,omitempty"` // NVSWITCH_COMPONENT_BMC and/or NVSWITCH_COMPONENT_NVOS; empty = both
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsRequest) Reset() {
+	*x = RotateCredentialsRequest{}
+	mi := &file_nvswitch_manager_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsRequest) ProtoMessage() {}
+
+func (x *RotateCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RotateCredentialsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *RotateCredentialsRequest) GetComponents() []NVSwitchComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// RotateCredentialsResult reports whether a credential rotation of a switch account was started.
+type RotateCredentialsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Component     NVSwitchComponent      `protobuf:"varint,2,opt,name=component,proto3,enum=nsm.v1.NVSwitchComponent" json:"component,omitempty"`
+	Status        StatusCode             `protobuf:"varint,3,opt,name=status,proto3,enum=nsm.v1.StatusCode" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsResult) Reset() {
+	*x = RotateCredentialsResult{}
+	mi := &file_nvswitch_manager_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsResult) ProtoMessage() {}
+
+func (x *RotateCredentialsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsResult.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsResult) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RotateCredentialsResult) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RotateCredentialsResult) GetComponent() NVSwitchComponent {
+	if x != nil {
+		return x.Component
+	}
+	return NVSwitchComponent_NVSWITCH_COMPONENT_UNKNOWN
+}
+
+func (x *RotateCredentialsResult) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *RotateCredentialsResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RotateCredentialsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*RotateCredentialsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsResponse) Reset() {
+	*x = RotateCredentialsResponse{}
+	mi := &file_nvswitch_manager_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsResponse) ProtoMessage() {}
+
+func (x *RotateCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RotateCredentialsResponse) GetResults() []*RotateCredentialsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CredentialRotationStatus contains the latest credential rotation of a switch's BMC or NVOS account.
+type CredentialRotationStatus struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Uuid          string                  `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Component     NVSwitchComponent       `protobuf:"varint,2,opt,name=component,proto3,enum=nsm.v1.NVSwitchComponent" json:"component,omitempty"`
+	State         CredentialRotationState `protobuf:"varint,3,opt,name=state,proto3,enum=nsm.v1.CredentialRotationState" json:"state,omitempty"`
+	LastAttempt   *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastRotated   *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=last_rotated,json=lastRotated,proto3" json:"last_rotated,omitempty"`
+	NextRotation  *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=next_rotation,json=nextRotation,proto3" json:"next_rotation,omitempty"`    // Unset if scheduled rotation of the component is disabled
+	RotationError string                  `protobuf:"bytes,7,opt,name=rotation_error,json=rotationError,proto3" json:"rotation_error,omitempty"` // Error of the latest rotation, if it failed
+	Status        StatusCode              `protobuf:"varint,8,opt,name=status,proto3,enum=nsm.v1.StatusCode" json:"status,omitempty"`            // Request status
+	Error         string                  `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                      // Request error message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialRotationStatus) Reset() {
+	*x = CredentialRotationStatus{}
+	mi := &file_nvswitch_manager_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialRotationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialRotationStatus) ProtoMessage() {}
+
+func (x *CredentialRotationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialRotationStatus.ProtoReflect.Descriptor instead.
+func (*CredentialRotationStatus) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CredentialRotationStatus) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CredentialRotationStatus) GetComponent() NVSwitchComponent {
+	if x != nil {
+		return x.Component
+	}
+	return NVSwitchComponent_NVSWITCH_COMPONENT_UNKNOWN
+}
+
+func (x *CredentialRotationStatus) GetState() CredentialRotationState {
+	if x != nil {
+		return x.State
+	}
+	return CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN
+}
+
+func (x *CredentialRotationStatus) GetLastAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetLastRotated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotated
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetNextRotation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRotation
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetRotationError() string {
+	if x != nil {
+		return x.RotationError
+	}
+	return ""
+}
+
+func (x *CredentialRotationStatus) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *CredentialRotationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetCredentialRotationStatusResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Statuses      []*CredentialRotationStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCredentialRotationStatusResponse) Reset() {
+	*x = GetCredentialRotationStatusResponse{}
+	mi := &file_nvswitch_manager_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialRotationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialRotationStatusResponse) ProtoMessage() {}
+
+func (x *GetCredentialRotationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCredentialRotationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCredentialRotationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCredentialRotationStatusResponse) GetStatuses() []*CredentialRotationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// StreamEventsRequest selects the events to stream.
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`                                       // Switch UUIDs; empty = all switches
+	Kinds         []NVSwitchEventKind    `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=nsm.v1.NVSwitchEventKind" json:"kinds,omitempty"` // Empty = all kinds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_nvswitch_manager_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamEventsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetKinds() []NVSwitchEventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// NVSwitchEvent is a Redfish event reported by a switch BMC.
+type NVSwitchEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Kind          NVSwitchEventKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=nsm.v1.NVSwitchEventKind" json:"kind,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`                    // Redfish severity, e.g. OK, Warning, Critical
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Redfish MessageId, e.g. TaskEvent.1.0.TaskCompletedOK
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Origin        string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"` // URI of the resource the event is about
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NVSwitchEvent) Reset() {
+	*x = NVSwitchEvent{}
+	mi := &file_nvswitch_manager_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NVSwitchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NVSwitchEvent) ProtoMessage() {}
+
+func (x *NVSwitchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NVSwitchEvent.ProtoReflect.Descriptor instead.
+func (*NVSwitchEvent) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NVSwitchEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetKind() NVSwitchEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN
+}
+
+func (x *NVSwitchEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *NVSwitchEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 var File_nvswitch_manager_proto protoreflect.FileDescriptor
 
 const file_nvswitch_manager_proto_rawDesc = "" +
@@ -2379,7 +2961,43 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
 	"\x10bundle_update_id\x18\r \x01(\tR\x0ebundleUpdateId\x12%\n" +
 	"\x0esequence_order\x18\x0e \x01(\x05R\rsequenceOrder\x12%\n" +
-	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId*/\n" +
+	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId\"k\n" +
+	"\x18RotateCredentialsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x129\n" +
+	"\n" +
+	"components\x18\x02 \x03(\x0e2\x19.nsm.v1.NVSwitchComponentR\n" +
+	"components\"\xa8\x01\n" +
+	"\x17RotateCredentialsResult\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x127\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x19.nsm.v1.NVSwitchComponentR\tcomponent\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.nsm.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"V\n" +
+	"\x19RotateCredentialsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.nsm.v1.RotateCredentialsResultR\aresults\"\xc6\x03\n" +
+	"\x18CredentialRotationStatus\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x127\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x19.nsm.v1.NVSwitchComponentR\tcomponent\x125\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1f.nsm.v1.CredentialRotationStateR\x05state\x12=\n" +
+	"\flast_attempt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlastAttempt\x12=\n" +
+	"\flast_rotated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastRotated\x12?\n" +
+	"\rnext_rotation\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fnextRotation\x12%\n" +
+	"\x0erotation_error\x18\a \x01(\tR\rrotationError\x12*\n" +
+	"\x06status\x18\b \x01(\x0e2\x12.nsm.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"c\n" +
+	"#GetCredentialRotationStatusResponse\x12<\n" +
+	"\bstatuses\x18\x01 \x03(\v2 .nsm.v1.CredentialRotationStatusR\bstatuses\"\\\n" +
+	"\x13StreamEventsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12/\n" +
+	"\x05kinds\x18\x02 \x03(\x0e2\x19.nsm.v1.NVSwitchEventKindR\x05kinds\"\xf9\x01\n" +
+	"\rNVSwitchEvent\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.nsm.v1.NVSwitchEventKindR\x04kind\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x16\n" +
+	"\x06origin\x18\x06 \x01(\tR\x06origin\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*/\n" +
 	"\x06Vendor\x12\x12\n" +
 	"\x0eVENDOR_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rVENDOR_NVIDIA\x10\x01*C\n" +
@@ -2422,7 +3040,20 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"\x16UPDATE_STATE_COMPLETED\x10\n" +
 	"\x12\x17\n" +
 	"\x13UPDATE_STATE_FAILED\x10\v\x12\x1a\n" +
-	"\x16UPDATE_STATE_CANCELLED\x10\f2\x8e\x06\n" +
+	"\x16UPDATE_STATE_CANCELLED\x10\f*\x96\x02\n" +
+	"\x17CredentialRotationState\x12%\n" +
+	"!CREDENTIAL_ROTATION_STATE_UNKNOWN\x10\x00\x12+\n" +
+	"'CREDENTIAL_ROTATION_STATE_NEVER_ROTATED\x10\x01\x12)\n" +
+	"%CREDENTIAL_ROTATION_STATE_IN_PROGRESS\x10\x02\x12'\n" +
+	"#CREDENTIAL_ROTATION_STATE_SUCCEEDED\x10\x03\x12$\n" +
+	" CREDENTIAL_ROTATION_STATE_FAILED\x10\x04\x12-\n" +
+	")CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED\x10\x05*\xb9\x01\n" +
+	"\x11NVSwitchEventKind\x12\x1f\n" +
+	"\x1bNVSWITCH_EVENT_KIND_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dNVSWITCH_EVENT_KIND_PSU_FAULT\x10\x01\x12#\n" +
+	"\x1fNVSWITCH_EVENT_KIND_POWER_STATE\x10\x02\x12\x1c\n" +
+	"\x18NVSWITCH_EVENT_KIND_TASK\x10\x03\x12\x1d\n" +
+	"\x19NVSWITCH_EVENT_KIND_OTHER\x10\x042\x93\b\n" +
 	"\x0fNVSwitchManager\x12[\n" +
 	"\x12RegisterNVSwitches\x12!.nsm.v1.RegisterNVSwitchesRequest\x1a\".nsm.v1.RegisterNVSwitchesResponse\x12G\n" +
 	"\rGetNVSwitches\x12\x17.nsm.v1.NVSwitchRequest\x1a\x1d.nsm.v1.GetNVSwitchesResponse\x12B\n" +
@@ -2433,7 +3064,10 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"\x13GetUpdatesForSwitch\x12\".nsm.v1.GetUpdatesForSwitchRequest\x1a#.nsm.v1.GetUpdatesForSwitchResponse\x12F\n" +
 	"\rGetAllUpdates\x12\x16.google.protobuf.Empty\x1a\x1d.nsm.v1.GetAllUpdatesResponse\x12I\n" +
 	"\fCancelUpdate\x12\x1b.nsm.v1.CancelUpdateRequest\x1a\x1c.nsm.v1.CancelUpdateResponse\x12I\n" +
-	"\fPowerControl\x12\x1b.nsm.v1.PowerControlRequest\x1a\x1c.nsm.v1.PowerControlResponseBEZCgithub.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi/genb\x06proto3"
+	"\fPowerControl\x12\x1b.nsm.v1.PowerControlRequest\x1a\x1c.nsm.v1.PowerControlResponse\x12X\n" +
+	"\x11RotateCredentials\x12 .nsm.v1.RotateCredentialsRequest\x1a!.nsm.v1.RotateCredentialsResponse\x12c\n" +
+	"\x1bGetCredentialRotationStatus\x12\x17.nsm.v1.NVSwitchRequest\x1a+.nsm.v1.GetCredentialRotationStatusResponse\x12D\n" +
+	"\fStreamEvents\x12\x1b.nsm.v1.StreamEventsRequest\x1a\x15.nsm.v1.NVSwitchEvent0\x01BEZCgithub.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi/genb\x06proto3"
 
 var (
 	file_nvswitch_manager_proto_rawDescOnce sync.Once
@@ -2447,107 +3081,136 @@ func file_nvswitch_manager_proto_rawDescGZIP() []byte {
 	return file_nvswitch_manager_proto_rawDescData
 }
 
-var file_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_nvswitch_manager_proto_goTypes = []any{
-	(Vendor)(0),                         // 0: nsm.v1.Vendor
-	(StatusCode)(0),                     // 1: nsm.v1.StatusCode
-	(PowerAction)(0),                    // 2: nsm.v1.PowerAction
-	(NVSwitchComponent)(0),              // 3: nsm.v1.NVSwitchComponent
-	(UpdateStrategy)(0),                 // 4: nsm.v1.UpdateStrategy
-	(UpdateState)(0),                    // 5: nsm.v1.UpdateState
-	(*Credentials)(nil),                 // 6: nsm.v1.Credentials
-	(*Subsystem)(nil),                   // 7: nsm.v1.Subsystem
-	(*BMCInfo)(nil),                     // 8: nsm.v1.BMCInfo
-	(*NVOSInfo)(nil),                    // 9: nsm.v1.NVOSInfo
-	(*Chassis)(nil),                     // 10: nsm.v1.Chassis
-	(*NVSwitchTray)(nil),                // 11: nsm.v1.NVSwitchTray
-	(*RegisterNVSwitchRequest)(nil),     // 12: nsm.v1.RegisterNVSwitchRequest
-	(*RegisterNVSwitchesRequest)(nil),   // 13: nsm.v1.RegisterNVSwitchesRequest
-	(*RegisterNVSwitchResponse)(nil),    // 14: nsm.v1.RegisterNVSwitchResponse
-	(*RegisterNVSwitchesResponse)(nil),  // 15: nsm.v1.RegisterNVSwitchesResponse
-	(*NVSwitchRequest)(nil),             // 16: nsm.v1.NVSwitchRequest
-	(*NVSwitchResponse)(nil),            // 17: nsm.v1.NVSwitchResponse
-	(*PowerControlRequest)(nil),         // 18: nsm.v1.PowerControlRequest
-	(*PowerControlResponse)(nil),        // 19: nsm.v1.PowerControlResponse
-	(*GetNVSwitchesResponse)(nil),       // 20: nsm.v1.GetNVSwitchesResponse
-	(*FirmwareBundle)(nil),              // 21: nsm.v1.FirmwareBundle
-	(*ComponentInfo)(nil),               // 22: nsm.v1.ComponentInfo
-	(*ListBundlesResponse)(nil),         // 23: nsm.v1.ListBundlesResponse
-	(*QueueUpdateRequest)(nil),          // 24: nsm.v1.QueueUpdateRequest
-	(*QueueUpdateResponse)(nil),         // 25: nsm.v1.QueueUpdateResponse
-	(*QueueUpdatesRequest)(nil),         // 26: nsm.v1.QueueUpdatesRequest
-	(*QueueUpdatesResponse)(nil),        // 27: nsm.v1.QueueUpdatesResponse
-	(*QueueUpdateResult)(nil),           // 28: nsm.v1.QueueUpdateResult
-	(*GetUpdateRequest)(nil),            // 29: nsm.v1.GetUpdateRequest
-	(*GetUpdateResponse)(nil),           // 30: nsm.v1.GetUpdateResponse
-	(*GetUpdatesForSwitchRequest)(nil),  // 31: nsm.v1.GetUpdatesForSwitchRequest
-	(*GetUpdatesForSwitchResponse)(nil), // 32: nsm.v1.GetUpdatesForSwitchResponse
-	(*GetAllUpdatesResponse)(nil),       // 33: nsm.v1.GetAllUpdatesResponse
-	(*CancelUpdateRequest)(nil),         // 34: nsm.v1.CancelUpdateRequest
-	(*CancelUpdateResponse)(nil),        // 35: nsm.v1.CancelUpdateResponse
-	(*FirmwareUpdateInfo)(nil),          // 36: nsm.v1.FirmwareUpdateInfo
-	(*timestamppb.Timestamp)(nil),       // 37: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 38: google.protobuf.Empty
+	(Vendor)(0),                                 // 0: nsm.v1.Vendor
+	(StatusCode)(0),                             // 1: nsm.v1.StatusCode
+	(PowerAction)(0),                            // 2: nsm.v1.PowerAction
+	(NVSwitchComponent)(0),                      // 3: nsm.v1.NVSwitchComponent
+	(UpdateStrategy)(0),                         // 4: nsm.v1.UpdateStrategy
+	(UpdateState)(0),                            // 5: nsm.v1.UpdateState
+	(CredentialRotationState)(0),                // 6: nsm.v1.CredentialRotationState
+	(NVSwitchEventKind)(0),                      // 7: nsm.v1.NVSwitchEventKind
+	(*Credentials)(nil),                         // 8: nsm.v1.Credentials
+	(*Subsystem)(nil),                           // 9: nsm.v1.Subsystem
+	(*BMCInfo)(nil),                             // 10: nsm.v1.BMCInfo
+	(*NVOSInfo)(nil),                            // 11: nsm.v1.NVOSInfo
+	(*Chassis)(nil),                             // 12: nsm.v1.Chassis
+	(*NVSwitchTray)(nil),                        // 13: nsm.v1.NVSwitchTray
+	(*RegisterNVSwitchRequest)(nil),             // 14: nsm.v1.RegisterNVSwitchRequest
+	(*RegisterNVSwitchesRequest)(nil),           // 15: nsm.v1.RegisterNVSwitchesRequest
+	(*RegisterNVSwitchResponse)(nil),            // 16: nsm.v1.RegisterNVSwitchResponse
+	(*RegisterNVSwitchesResponse)(nil),          // 17: nsm.v1.RegisterNVSwitchesResponse
+	(*NVSwitchRequest)(nil),                     // 18: nsm.v1.NVSwitchRequest
+	(*NVSwitchResponse)(nil),                    // 19: nsm.v1.NVSwitchResponse
+	(*PowerControlRequest)(nil),                 // 20: nsm.v1.PowerControlRequest
+	(*PowerControlResponse)(nil),                // 21: nsm.v1.PowerControlResponse
+	(*GetNVSwitchesResponse)(nil),               // 22: nsm.v1.GetNVSwitchesResponse
+	(*FirmwareBundle)(nil),                      // 23: nsm.v1.FirmwareBundle
+	(*ComponentInfo)(nil),                       // 24: nsm.v1.ComponentInfo
+	(*ListBundlesResponse)(nil),                 // 25: nsm.v1.ListBundlesResponse
+	(*QueueUpdateRequest)(nil),                  // 26: nsm.v1.QueueUpdateRequest
+	(*QueueUpdateResponse)(nil),                 // 27: nsm.v1.QueueUpdateResponse
+	(*QueueUpdatesRequest)(nil),                 // 28: nsm.v1.QueueUpdatesRequest
+	(*QueueUpdatesResponse)(nil),                // 29: nsm.v1.QueueUpdatesResponse
+	(*QueueUpdateResult)(nil),                   // 30: nsm.v1.QueueUpdateResult
+	(*GetUpdateRequest)(nil),                    // 31: nsm.v1.GetUpdateRequest
+	(*GetUpdateResponse)(nil),                   // 32: nsm.v1.GetUpdateResponse
+	(*GetUpdatesForSwitchRequest)(nil),          // 33: nsm.v1.GetUpdatesForSwitchRequest
+	(*GetUpdatesForSwitchResponse)(nil),         // 34: nsm.v1.GetUpdatesForSwitchResponse
+	(*GetAllUpdatesResponse)(nil),               // 35: nsm.v1.GetAllUpdatesResponse
+	(*CancelUpdateRequest)(nil),                 // 36: nsm.v1.CancelUpdateRequest
+	(*CancelUpdateResponse)(nil),                // 37: nsm.v1.CancelUpdateResponse
+	(*FirmwareUpdateInfo)(nil),                  // 38: nsm.v1.FirmwareUpdateInfo
+	(*RotateCredentialsRequest)(nil),            // 39: nsm.v1.RotateCredentialsRequest
+	(*RotateCredentialsResult)(nil),             // 40: nsm.v1.RotateCredentialsResult
+	(*RotateCredentialsResponse)(nil),           // 41: nsm.v1.RotateCredentialsResponse
+	(*CredentialRotationStatus)(nil),            // 42: nsm.v1.CredentialRotationStatus
+	(*GetCredentialRotationStatusResponse)(nil), // 43: nsm.v1.GetCredentialRotationStatusResponse
+	(*StreamEventsRequest)(nil),                 // 44: nsm.v1.StreamEventsRequest
+	(*NVSwitchEvent)(nil),                       // 45: nsm.v1.NVSwitchEvent
+	(*timestamppb.Timestamp)(nil),               // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 47: google.protobuf.Empty
 }
 var file_nvswitch_manager_proto_depIdxs = []int32{
-	6,  // 0: nsm.v1.Subsystem.credentials:type_name -> nsm.v1.Credentials
+	8,  // 0: nsm.v1.Subsystem.credentials:type_name -> nsm.v1.Credentials
 	0,  // 1: nsm.v1.NVSwitchTray.vendor:type_name -> nsm.v1.Vendor
-	8,  // 2: nsm.v1.NVSwitchTray.bmc:type_name -> nsm.v1.BMCInfo
-	9,  // 3: nsm.v1.NVSwitchTray.nvos:type_name -> nsm.v1.NVOSInfo
-	10, // 4: nsm.v1.NVSwitchTray.chassis:type_name -> nsm.v1.Chassis
+	10, // 2: nsm.v1.NVSwitchTray.bmc:type_name -> nsm.v1.BMCInfo
+	11, // 3: nsm.v1.NVSwitchTray.nvos:type_name -> nsm.v1.NVOSInfo
+	12, // 4: nsm.v1.NVSwitchTray.chassis:type_name -> nsm.v1.Chassis
 	0,  // 5: nsm.v1.RegisterNVSwitchRequest.vendor:type_name -> nsm.v1.Vendor
-	7,  // 6: nsm.v1.RegisterNVSwitchRequest.bmc:type_name -> nsm.v1.Subsystem
-	7,  // 7: nsm.v1.RegisterNVSwitchRequest.nvos:type_name -> nsm.v1.Subsystem
-	12, // 8: nsm.v1.RegisterNVSwitchesRequest.registration_requests:type_name -> nsm.v1.RegisterNVSwitchRequest
-	37, // 9: nsm.v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
+	9,  // 6: nsm.v1.RegisterNVSwitchRequest.bmc:type_name -> nsm.v1.Subsystem
+	9,  // 7: nsm.v1.RegisterNVSwitchRequest.nvos:type_name -> nsm.v1.Subsystem
+	14, // 8: nsm.v1.RegisterNVSwitchesRequest.registration_requests:type_name -> nsm.v1.RegisterNVSwitchRequest
+	46, // 9: nsm.v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
 	1,  // 10: nsm.v1.RegisterNVSwitchResponse.status:type_name -> nsm.v1.StatusCode
-	14, // 11: nsm.v1.RegisterNVSwitchesResponse.responses:type_name -> nsm.v1.RegisterNVSwitchResponse
+	16, // 11: nsm.v1.RegisterNVSwitchesResponse.responses:type_name -> nsm.v1.RegisterNVSwitchResponse
 	1,  // 12: nsm.v1.NVSwitchResponse.status:type_name -> nsm.v1.StatusCode
 	2,  // 13: nsm.v1.PowerControlRequest.action:type_name -> nsm.v1.PowerAction
-	17, // 14: nsm.v1.PowerControlResponse.responses:type_name -> nsm.v1.NVSwitchResponse
-	11, // 15: nsm.v1.GetNVSwitchesResponse.nvswitches:type_name -> nsm.v1.NVSwitchTray
-	22, // 16: nsm.v1.FirmwareBundle.components:type_name -> nsm.v1.ComponentInfo
-	21, // 17: nsm.v1.ListBundlesResponse.bundles:type_name -> nsm.v1.FirmwareBundle
+	19, // 14: nsm.v1.PowerControlResponse.responses:type_name -> nsm.v1.NVSwitchResponse
+	13, // 15: nsm.v1.GetNVSwitchesResponse.nvswitches:type_name -> nsm.v1.NVSwitchTray
+	24, // 16: nsm.v1.FirmwareBundle.components:type_name -> nsm.v1.ComponentInfo
+	23, // 17: nsm.v1.ListBundlesResponse.bundles:type_name -> nsm.v1.FirmwareBundle
 	3,  // 18: nsm.v1.QueueUpdateRequest.components:type_name -> nsm.v1.NVSwitchComponent
-	36, // 19: nsm.v1.QueueUpdateResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 19: nsm.v1.QueueUpdateResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
 	3,  // 20: nsm.v1.QueueUpdatesRequest.components:type_name -> nsm.v1.NVSwitchComponent
-	28, // 21: nsm.v1.QueueUpdatesResponse.results:type_name -> nsm.v1.QueueUpdateResult
+	30, // 21: nsm.v1.QueueUpdatesResponse.results:type_name -> nsm.v1.QueueUpdateResult
 	1,  // 22: nsm.v1.QueueUpdateResult.status:type_name -> nsm.v1.StatusCode
-	36, // 23: nsm.v1.QueueUpdateResult.updates:type_name -> nsm.v1.FirmwareUpdateInfo
-	36, // 24: nsm.v1.GetUpdateResponse.update:type_name -> nsm.v1.FirmwareUpdateInfo
-	36, // 25: nsm.v1.GetUpdatesForSwitchResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
-	36, // 26: nsm.v1.GetAllUpdatesResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 23: nsm.v1.QueueUpdateResult.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 24: nsm.v1.GetUpdateResponse.update:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 25: nsm.v1.GetUpdatesForSwitchResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 26: nsm.v1.GetAllUpdatesResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
 	3,  // 27: nsm.v1.FirmwareUpdateInfo.component:type_name -> nsm.v1.NVSwitchComponent
 	4,  // 28: nsm.v1.FirmwareUpdateInfo.strategy:type_name -> nsm.v1.UpdateStrategy
 	5,  // 29: nsm.v1.FirmwareUpdateInfo.state:type_name -> nsm.v1.UpdateState
-	37, // 30: nsm.v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
-	37, // 31: nsm.v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 32: nsm.v1.NVSwitchManager.RegisterNVSwitches:input_type -> nsm.v1.RegisterNVSwitchesRequest
-	16, // 33: nsm.v1.NVSwitchManager.GetNVSwitches:input_type -> nsm.v1.NVSwitchRequest
-	38, // 34: nsm.v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
-	24, // 35: nsm.v1.NVSwitchManager.QueueUpdate:input_type -> nsm.v1.QueueUpdateRequest
-	26, // 36: nsm.v1.NVSwitchManager.QueueUpdates:input_type -> nsm.v1.QueueUpdatesRequest
-	29, // 37: nsm.v1.NVSwitchManager.GetUpdate:input_type -> nsm.v1.GetUpdateRequest
-	31, // 38: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> nsm.v1.GetUpdatesForSwitchRequest
-	38, // 39: nsm.v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
-	34, // 40: nsm.v1.NVSwitchManager.CancelUpdate:input_type -> nsm.v1.CancelUpdateRequest
-	18, // 41: nsm.v1.NVSwitchManager.PowerControl:input_type -> nsm.v1.PowerControlRequest
-	15, // 42: nsm.v1.NVSwitchManager.RegisterNVSwitches:output_type -> nsm.v1.RegisterNVSwitchesResponse
-	20, // 43: nsm.v1.NVSwitchManager.GetNVSwitches:output_type -> nsm.v1.GetNVSwitchesResponse
-	23, // 44: nsm.v1.NVSwitchManager.ListBundles:output_type -> nsm.v1.ListBundlesResponse
-	25, // 45: nsm.v1.NVSwitchManager.QueueUpdate:output_type -> nsm.v1.QueueUpdateResponse
-	27, // 46: nsm.v1.NVSwitchManager.QueueUpdates:output_type -> nsm.v1.QueueUpdatesResponse
-	30, // 47: nsm.v1.NVSwitchManager.GetUpdate:output_type -> nsm.v1.GetUpdateResponse
-	32, // 48: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> nsm.v1.GetUpdatesForSwitchResponse
-	33, // 49: nsm.v1.NVSwitchManager.GetAllUpdates:output_type -> nsm.v1.GetAllUpdatesResponse
-	35, // 50: nsm.v1.NVSwitchManager.CancelUpdate:output_type -> nsm.v1.CancelUpdateResponse
-	19, // 51: nsm.v1.NVSwitchManager.PowerControl:output_type -> nsm.v1.PowerControlResponse
-	42, // [42:52] is the sub-list for method output_type
-	32, // [32:42] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	46, // 30: nsm.v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
+	46, // 31: nsm.v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 32: nsm.v1.RotateCredentialsRequest.components:type_name -> nsm.v1.NVSwitchComponent
+	3,  // 33: nsm.v1.RotateCredentialsResult.component:type_name -> nsm.v1.NVSwitchComponent
+	1,  // 34: nsm.v1.RotateCredentialsResult.status:type_name -> nsm.v1.StatusCode
+	40, // 35: nsm.v1.RotateCredentialsResponse.results:type_name -> nsm.v1.RotateCredentialsResult
+	3,  // 36: nsm.v1.CredentialRotationStatus.component:type_name -> nsm.v1.NVSwitchComponent
+	6,  // 37: nsm.v1.CredentialRotationStatus.state:type_name -> nsm.v1.CredentialRotationState
+	46, // 38: nsm.v1.CredentialRotationStatus.last_attempt:type_name -> google.protobuf.Timestamp
+	46, // 39: nsm.v1.CredentialRotationStatus.last_rotated:type_name -> google.protobuf.Timestamp
+	46, // 40: nsm.v1.CredentialRotationStatus.next_rotation:type_name -> google.protobuf.Timestamp
+	1,  // 41: nsm.v1.CredentialRotationStatus.status:type_name -> nsm.v1.StatusCode
+	42, // 42: nsm.v1.GetCredentialRotationStatusResponse.statuses:type_name -> nsm.v1.CredentialRotationStatus
+	7,  // 43: nsm.v1.StreamEventsRequest.kinds:type_name -> nsm.v1.NVSwitchEventKind
+	7,  // 44: nsm.v1.NVSwitchEvent.kind:type_name -> nsm.v1.NVSwitchEventKind
+	46, // 45: nsm.v1.NVSwitchEvent.timestamp:type_name -> google.protobuf.Timestamp
+	15, // 46: nsm.v1.NVSwitchManager.RegisterNVSwitches:input_type -> nsm.v1.RegisterNVSwitchesRequest
+	18, // 47: nsm.v1.NVSwitchManager.GetNVSwitches:input_type -> nsm.v1.NVSwitchRequest
+	47, // 48: nsm.v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
+	26, // 49: nsm.v1.NVSwitchManager.QueueUpdate:input_type -> nsm.v1.QueueUpdateRequest
+	28, // 50: nsm.v1.NVSwitchManager.QueueUpdates:input_type -> nsm.v1.QueueUpdatesRequest
+	31, // 51: nsm.v1.NVSwitchManager.GetUpdate:input_type -> nsm.v1.GetUpdateRequest
+	33, // 52: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> nsm.v1.GetUpdatesForSwitchRequest
+	47, // 53: nsm.v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
+	36, // 54: nsm.v1.NVSwitchManager.CancelUpdate:input_type -> nsm.v1.CancelUpdateRequest
+	20, // 55: nsm.v1.NVSwitchManager.PowerControl:input_type -> nsm.v1.PowerControlRequest
+	39, // 56: nsm.v1.NVSwitchManager.RotateCredentials:input_type -> nsm.v1.RotateCredentialsRequest
+	18, // 57: nsm.v1.NVSwitchManager.GetCredentialRotationStatus:input_type -> nsm.v1.NVSwitchRequest
+	44, // 58: nsm.v1.NVSwitchManager.StreamEvents:input_type -> nsm.v1.StreamEventsRequest
+	17, // 59: nsm.v1.NVSwitchManager.RegisterNVSwitches:output_type -> nsm.v1.RegisterNVSwitchesResponse
+	22, // 60: nsm.v1.NVSwitchManager.GetNVSwitches:output_type -> nsm.v1.GetNVSwitchesResponse
+	25, // 61: nsm.v1.NVSwitchManager.ListBundles:output_type -> nsm.v1.ListBundlesResponse
+	27, // 62: nsm.v1.NVSwitchManager.QueueUpdate:output_type -> nsm.v1.QueueUpdateResponse
+	29, // 63: nsm.v1.NVSwitchManager.QueueUpdates:output_type -> nsm.v1.QueueUpdatesResponse
+	32, // 64: nsm.v1.NVSwitchManager.GetUpdate:output_type -> nsm.v1.GetUpdateResponse
+	34, // 65: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> nsm.v1.GetUpdatesForSwitchResponse
+	35, // 66: nsm.v1.NVSwitchManager.GetAllUpdates:output_type -> nsm.v1.GetAllUpdatesResponse
+	37, // 67: nsm.v1.NVSwitchManager.CancelUpdate:output_type -> nsm.v1.CancelUpdateResponse
+	21, // 68: nsm.v1.NVSwitchManager.PowerControl:output_type -> nsm.v1.PowerControlResponse
+	41, // 69: nsm.v1.NVSwitchManager.RotateCredentials:output_type -> nsm.v1.RotateCredentialsResponse
+	43, // 70: nsm.v1.NVSwitchManager.GetCredentialRotationStatus:output_type -> nsm.v1.GetCredentialRotationStatusResponse
+	45, // 71: nsm.v1.NVSwitchManager.StreamEvents:output_type -> nsm.v1.NVSwitchEvent
+	59, // [59:72] is the sub-list for method output_type
+	46, // [46:59] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_nvswitch_manager_proto_init() }
@@ -2560,8 +3223,8 @@ func file_nvswitch_manager_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nvswitch_manager_proto_rawDesc), len(file_nvswitch_manager_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   31,
+			NumEnums:      8,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
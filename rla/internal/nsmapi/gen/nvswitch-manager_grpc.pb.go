@@ -20,16 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NVSwitchManager_RegisterNVSwitches_FullMethodName  = "/nsm.v1.NVSwitchManager/RegisterNVSwitches"
-	NVSwitchManager_GetNVSwitches_FullMethodName       = "/nsm.v1.NVSwitchManager/GetNVSwitches"
-	NVSwitchManager_ListBundles_FullMethodName         = "/nsm.v1.NVSwitchManager/ListBundles"
-	NVSwitchManager_QueueUpdate_FullMethodName         = "/nsm.v1.NVSwitchManager/QueueUpdate"
-	NVSwitchManager_QueueUpdates_FullMethodName        = "/nsm.v1.NVSwitchManager/QueueUpdates"
-	NVSwitchManager_GetUpdate_FullMethodName           = "/nsm.v1.NVSwitchManager/GetUpdate"
-	NVSwitchManager_GetUpdatesForSwitch_FullMethodName = "/nsm.v1.NVSwitchManager/GetUpdatesForSwitch"
-	NVSwitchManager_GetAllUpdates_FullMethodName       = "/nsm.v1.NVSwitchManager/GetAllUpdates"
-	NVSwitchManager_CancelUpdate_FullMethodName        = "/nsm.v1.NVSwitchManager/CancelUpdate"
-	NVSwitchManager_PowerControl_FullMethodName        = "/nsm.v1.NVSwitchManager/PowerControl"
+	NVSwitchManager_RegisterNVSwitches_FullMethodName          = "/nsm.v1.NVSwitchManager/RegisterNVSwitches"
+	NVSwitchManager_GetNVSwitches_FullMethodName               = "/nsm.v1.NVSwitchManager/GetNVSwitches"
+	NVSwitchManager_ListBundles_FullMethodName                 = "/nsm.v1.NVSwitchManager/ListBundles"
+	NVSwitchManager_QueueUpdate_FullMethodName                 = "/nsm.v1.NVSwitchManager/QueueUpdate"
+	NVSwitchManager_QueueUpdates_FullMethodName                = "/nsm.v1.NVSwitchManager/QueueUpdates"
+	NVSwitchManager_GetUpdate_FullMethodName                   = "/nsm.v1.NVSwitchManager/GetUpdate"
+	NVSwitchManager_GetUpdatesForSwitch_FullMethodName         = "/nsm.v1.NVSwitchManager/GetUpdatesForSwitch"
+	NVSwitchManager_GetAllUpdates_FullMethodName               = "/nsm.v1.NVSwitchManager/GetAllUpdates"
+	NVSwitchManager_CancelUpdate_FullMethodName                = "/nsm.v1.NVSwitchManager/CancelUpdate"
+	NVSwitchManager_PowerControl_FullMethodName                = "/nsm.v1.NVSwitchManager/PowerControl"
+	NVSwitchManager_RotateCredentials_FullMethodName           = "/nsm.v1.NVSwitchManager/RotateCredentials"
+	NVSwitchManager_GetCredentialRotationStatus_FullMethodName = "/nsm.v1.NVSwitchManager/GetCredentialRotationStatus"
+	NVSwitchManager_StreamEvents_FullMethodName                = "/nsm.v1.NVSwitchManager/StreamEvents"
 )
 
 // NVSwitchManagerClient is the client API for NVSwitchManager service.
@@ -62,6 +65,14 @@ type NVSwitchManagerClient interface {
 	// Power Control
 	// PowerControl performs a power action (e.g. PowerCycle, GracefulShutdown) on NV-Switch trays.
 	PowerControl(ctx context.Context, in *PowerControlRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	// Credential Rotation
+	// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+	RotateCredentials(ctx context.Context, in *RotateCredentialsRequest, opts ...grpc.CallOption) (*RotateCredentialsResponse, error)
+	// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches (all if none are specified).
+	GetCredentialRotationStatus(ctx context.Context, in *NVSwitchRequest, opts ...grpc.CallOption) (*GetCredentialRotationStatusResponse, error)
+	// Events
+	// StreamEvents streams Redfish events (PSU faults, power state changes, task progress) reported by the BMCs of the specified switches (all if none are specified) until the client cancels.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NVSwitchEvent], error)
 }
 
 type nVSwitchManagerClient struct {
@@ -172,6 +183,45 @@ func (c *nVSwitchManagerClient) PowerControl(ctx context.Context, in *PowerContr
 	return out, nil
 }
 
+func (c *nVSwitchManagerClient) RotateCredentials(ctx context.Context, in *RotateCredentialsRequest, opts ...grpc.CallOption) (*RotateCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCredentialsResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_RotateCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) GetCredentialRotationStatus(ctx context.Context, in *NVSwitchRequest, opts ...grpc.CallOption) (*GetCredentialRotationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialRotationStatusResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_GetCredentialRotationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nVSwitchManagerClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NVSwitchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NVSwitchManager_ServiceDesc.Streams[0], NVSwitchManager_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, NVSwitchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NVSwitchManager_StreamEventsClient = grpc.ServerStreamingClient[NVSwitchEvent]
+
 // NVSwitchManagerServer is the server API for NVSwitchManager service.
 // All implementations should embed UnimplementedNVSwitchManagerServer
 // for forward compatibility.
@@ -202,6 +252,14 @@ type NVSwitchManagerServer interface {
 	// Power Control
 	// PowerControl performs a power action (e.g. PowerCycle, GracefulShutdown) on NV-Switch trays.
 	PowerControl(context.Context, *PowerControlRequest) (*PowerControlResponse, error)
+	// Credential Rotation
+	// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+	RotateCredentials(context.Context, *RotateCredentialsRequest) (*RotateCredentialsResponse, error)
+	// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches (all if none are specified).
+	GetCredentialRotationStatus(context.Context, *NVSwitchRequest) (*GetCredentialRotationStatusResponse, error)
+	// Events
+	// StreamEvents streams Redfish events (PSU faults, power state changes, task progress) reported by the BMCs of the specified switches (all if none are specified) until the client cancels.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[NVSwitchEvent]) error
 }
 
 // UnimplementedNVSwitchManagerServer should be embedded to have
@@ -241,6 +299,15 @@ func (UnimplementedNVSwitchManagerServer) CancelUpdate(context.Context, *CancelU
 func (UnimplementedNVSwitchManagerServer) PowerControl(context.Context, *PowerControlRequest) (*PowerControlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PowerControl not implemented")
 }
+func (UnimplementedNVSwitchManagerServer) RotateCredentials(context.Context, *RotateCredentialsRequest) (*RotateCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateCredentials not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) GetCredentialRotationStatus(context.Context, *NVSwitchRequest) (*GetCredentialRotationStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCredentialRotationStatus not implemented")
+}
+func (UnimplementedNVSwitchManagerServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[NVSwitchEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamEvents not implemented")
+}
 func (UnimplementedNVSwitchManagerServer) testEmbeddedByValue() {}
 
 // UnsafeNVSwitchManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -441,6 +508,53 @@ func _NVSwitchManager_PowerControl_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NVSwitchManager_RotateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).RotateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_RotateCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).RotateCredentials(ctx, req.(*RotateCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_GetCredentialRotationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NVSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).GetCredentialRotationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_GetCredentialRotationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).GetCredentialRotationStatus(ctx, req.(*NVSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NVSwitchManager_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NVSwitchManagerServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, NVSwitchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NVSwitchManager_StreamEventsServer = grpc.ServerStreamingServer[NVSwitchEvent]
+
 // NVSwitchManager_ServiceDesc is the grpc.ServiceDesc for NVSwitchManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -488,7 +602,21 @@ var NVSwitchManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PowerControl",
 			Handler:    _NVSwitchManager_PowerControl_Handler,
 		},
+		{
+			MethodName: "RotateCredentials",
+			Handler:    _NVSwitchManager_RotateCredentials_Handler,
+		},
+		{
+			MethodName: "GetCredentialRotationStatus",
+			Handler:    _NVSwitchManager_GetCredentialRotationStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _NVSwitchManager_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "nvswitch-manager.proto",
 }
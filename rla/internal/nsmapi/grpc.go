@@ -20,11 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/certs"
@@ -134,6 +138,10 @@ func (c *grpcClient) SetNVSwitchFirmware(_ string, _ string) {
 	panic("Not a unit test")
 }
 
+func (c *grpcClient) PublishEvent(_ NVSwitchEvent) {
+	panic("Not a unit test")
+}
+
 // PowerControl performs a power action on the specified NV-Switch trays.
 func (c *grpcClient) PowerControl(ctx context.Context, uuids []string, action PowerAction) ([]PowerControlResult, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
@@ -227,3 +235,82 @@ func (c *grpcClient) ListBundles(ctx context.Context) ([]FirmwareBundle, error)
 	}
 	return results, nil
 }
+
+// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+func (c *grpcClient) RotateCredentials(ctx context.Context, uuids []string, components []NVSwitchComponent) ([]RotateCredentialsResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	pbComponents := make([]pb.NVSwitchComponent, 0, len(components))
+	for _, comp := range components {
+		pbComponents = append(pbComponents, nvSwitchComponentToPb(comp))
+	}
+
+	resp, err := c.client.RotateCredentials(ctx, &pb.RotateCredentialsRequest{
+		Uuids:      uuids,
+		Components: pbComponents,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []RotateCredentialsResult
+	for _, r := range resp.GetResults() {
+		results = append(results, rotateCredentialsResultFromPb(r))
+	}
+	return results, nil
+}
+
+// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches.
+func (c *grpcClient) GetCredentialRotationStatus(ctx context.Context, uuids []string) ([]CredentialRotationStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetCredentialRotationStatus(ctx, &pb.NVSwitchRequest{Uuids: uuids})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []CredentialRotationStatus
+	for _, s := range resp.GetStatuses() {
+		results = append(results, credentialRotationStatusFromPb(s))
+	}
+	return results, nil
+}
+
+// StreamEvents streams the Redfish events reported by the BMCs of the specified switches. The stream is not
+// bounded by the gRPC timeout; it ends when ctx is cancelled or NSM closes it.
+func (c *grpcClient) StreamEvents(ctx context.Context, uuids []string, kinds []EventKind) (<-chan NVSwitchEvent, error) {
+	pbKinds := make([]pb.NVSwitchEventKind, 0, len(kinds))
+	for _, k := range kinds {
+		pbKinds = append(pbKinds, eventKindToPb(k))
+	}
+
+	stream, err := c.client.StreamEvents(ctx, &pb.StreamEventsRequest{
+		Uuids: uuids,
+		Kinds: pbKinds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan NVSwitchEvent)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && status.Code(err) != codes.Canceled {
+					log.Warn().Err(err).Msg("NV-Switch Manager event stream ended")
+				}
+				return
+			}
+			select {
+			case ch <- nvSwitchEventFromPb(ev):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -18,20 +18,42 @@ package nsmapi
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
 	"github.com/google/uuid"
 )
 
+// mockEventBuffer is the number of published events a mock event stream holds before further events are dropped.
+const mockEventBuffer = 64
+
 type mockClient struct {
-	switches map[string]NVSwitchTray // keyed by BMC MAC
+	switches  map[string]NVSwitchTray // keyed by BMC MAC
+	rotations map[string]time.Time    // keyed by switch UUID and component
+
+	mu      sync.Mutex
+	streams map[*mockEventStream]struct{}
+}
+
+type mockEventStream struct {
+	uuids []string
+	kinds []EventKind
+	ch    chan NVSwitchEvent
+}
+
+func (s *mockEventStream) matches(ev NVSwitchEvent) bool {
+	return (len(s.uuids) == 0 || slices.Contains(s.uuids, ev.UUID)) &&
+		(len(s.kinds) == 0 || slices.Contains(s.kinds, ev.Kind))
 }
 
 // NewMockClient returns a gRPC client that returns mock values so it can be used in unit tests.
 func NewMockClient() Client {
 	return &mockClient{
-		switches: make(map[string]NVSwitchTray),
+		switches:  make(map[string]NVSwitchTray),
+		rotations: make(map[string]time.Time),
+		streams:   make(map[*mockEventStream]struct{}),
 	}
 }
 
@@ -99,6 +121,22 @@ func (c *mockClient) SetNVSwitchFirmware(bmcMAC string, firmware string) {
 	}
 }
 
+// PublishEvent delivers ev to the open event streams whose filters match it.
+func (c *mockClient) PublishEvent(ev NVSwitchEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for s := range c.streams {
+		if !s.matches(ev) {
+			continue
+		}
+		select {
+		case s.ch <- ev:
+		default:
+		}
+	}
+}
+
 func (c *mockClient) PowerControl(_ context.Context, uuids []string, _ PowerAction) ([]PowerControlResult, error) {
 	var results []PowerControlResult
 	for _, id := range uuids {
@@ -164,3 +202,87 @@ func (c *mockClient) ListBundles(_ context.Context) ([]FirmwareBundle, error) {
 		},
 	}, nil
 }
+
+// credentialComponents are the switch accounts whose credentials NSM rotates.
+var credentialComponents = []NVSwitchComponent{NVSwitchComponentBMC, NVSwitchComponentNVOS}
+
+func rotationKey(uuid string, component NVSwitchComponent) string {
+	return uuid + "/" + component.String()
+}
+
+// RotateCredentials immediately marks the requested accounts of the known switches as rotated.
+func (c *mockClient) RotateCredentials(ctx context.Context, uuids []string, components []NVSwitchComponent) ([]RotateCredentialsResult, error) {
+	if len(components) == 0 {
+		components = credentialComponents
+	}
+
+	known, err := c.GetNVSwitches(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+	knownSet := make(map[string]struct{}, len(known))
+	for _, sw := range known {
+		knownSet[sw.UUID] = struct{}{}
+	}
+
+	var results []RotateCredentialsResult
+	for _, id := range uuids {
+		for _, comp := range components {
+			result := RotateCredentialsResult{UUID: id, Component: comp, Status: StatusSuccess}
+			if _, ok := knownSet[id]; !ok {
+				result.Status = StatusInvalidArgument
+				result.Error = "switch not found"
+			} else {
+				c.rotations[rotationKey(id, comp)] = time.Now()
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func (c *mockClient) GetCredentialRotationStatus(ctx context.Context, uuids []string) ([]CredentialRotationStatus, error) {
+	switches, err := c.GetNVSwitches(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []CredentialRotationStatus
+	for _, sw := range switches {
+		for _, comp := range credentialComponents {
+			status := CredentialRotationStatus{
+				UUID:      sw.UUID,
+				Component: comp,
+				State:     CredentialRotationStateNeverRotated,
+				Status:    StatusSuccess,
+			}
+			if rotated, ok := c.rotations[rotationKey(sw.UUID, comp)]; ok {
+				status.State = CredentialRotationStateSucceeded
+				status.LastAttempt = rotated
+				status.LastRotated = rotated
+			}
+			results = append(results, status)
+		}
+	}
+	return results, nil
+}
+
+// StreamEvents returns a stream of the events passed to PublishEvent that is closed when ctx is cancelled.
+func (c *mockClient) StreamEvents(ctx context.Context, uuids []string, kinds []EventKind) (<-chan NVSwitchEvent, error) {
+	s := &mockEventStream{uuids: uuids, kinds: kinds, ch: make(chan NVSwitchEvent, mockEventBuffer)}
+
+	c.mu.Lock()
+	c.streams[s] = struct{}{}
+	c.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.streams, s)
+		close(s.ch)
+	}()
+	return s.ch, nil
+}
@@ -44,10 +44,23 @@ type Client interface {
 	// ListBundles returns all available firmware bundles.
 	ListBundles(ctx context.Context) ([]FirmwareBundle, error)
 
+	// RotateCredentials starts rotating the BMC and/or NVOS passwords of the specified switches in the background.
+	// If components is empty, both accounts are rotated.
+	RotateCredentials(ctx context.Context, uuids []string, components []NVSwitchComponent) ([]RotateCredentialsResult, error)
+
+	// GetCredentialRotationStatus returns the latest BMC and NVOS credential rotation of the specified switches.
+	// If uuids is empty, returns the status of all registered switches.
+	GetCredentialRotationStatus(ctx context.Context, uuids []string) ([]CredentialRotationStatus, error)
+
+	// StreamEvents streams the Redfish events reported by the BMCs of the specified switches until ctx is cancelled
+	// or the stream breaks, after which the returned channel is closed. Empty uuids or kinds select everything.
+	StreamEvents(ctx context.Context, uuids []string, kinds []EventKind) (<-chan NVSwitchEvent, error)
+
 	// Close closes the underlying gRPC connection.
 	Close() error
 
 	// The following are only valid in the mock environment and should only be called by unit tests.
 	AddNVSwitch(NVSwitchTray)
 	SetNVSwitchFirmware(bmcMAC string, firmware string)
+	PublishEvent(NVSwitchEvent)
 }
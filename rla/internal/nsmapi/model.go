@@ -359,3 +359,166 @@ func firmwareBundleFromPb(bundle *pb.FirmwareBundle) FirmwareBundle {
 	}
 	return result
 }
+
+// CredentialRotationState represents the state of the latest credential rotation of a switch account.
+type CredentialRotationState int
+
+const (
+	CredentialRotationStateUnknown        CredentialRotationState = 0
+	CredentialRotationStateNeverRotated   CredentialRotationState = 1
+	CredentialRotationStateInProgress     CredentialRotationState = 2
+	CredentialRotationStateSucceeded      CredentialRotationState = 3
+	CredentialRotationStateFailed         CredentialRotationState = 4
+	CredentialRotationStateRollbackFailed CredentialRotationState = 5
+)
+
+func credentialRotationStateFromPb(s pb.CredentialRotationState) CredentialRotationState {
+	switch s {
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED:
+		return CredentialRotationStateNeverRotated
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS:
+		return CredentialRotationStateInProgress
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED:
+		return CredentialRotationStateSucceeded
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED:
+		return CredentialRotationStateFailed
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED:
+		return CredentialRotationStateRollbackFailed
+	default:
+		return CredentialRotationStateUnknown
+	}
+}
+
+// RotateCredentialsResult reports whether a credential rotation of a switch account was started.
+type RotateCredentialsResult struct {
+	UUID      string
+	Component NVSwitchComponent
+	Status    StatusCode
+	Error     string
+}
+
+func rotateCredentialsResultFromPb(r *pb.RotateCredentialsResult) RotateCredentialsResult {
+	return RotateCredentialsResult{
+		UUID:      r.GetUuid(),
+		Component: nvSwitchComponentFromPb(r.GetComponent()),
+		Status:    statusCodeFromPb(r.GetStatus()),
+		Error:     r.GetError(),
+	}
+}
+
+// CredentialRotationStatus contains the latest credential rotation of a switch's BMC or NVOS account.
+// Zero timestamps mean the event never happened (or, for NextRotation, that scheduled rotation is disabled).
+type CredentialRotationStatus struct {
+	UUID          string
+	Component     NVSwitchComponent
+	State         CredentialRotationState
+	LastAttempt   time.Time
+	LastRotated   time.Time
+	NextRotation  time.Time
+	RotationError string
+	Status        StatusCode
+	Error         string
+}
+
+func credentialRotationStatusFromPb(s *pb.CredentialRotationStatus) CredentialRotationStatus {
+	result := CredentialRotationStatus{
+		UUID:          s.GetUuid(),
+		Component:     nvSwitchComponentFromPb(s.GetComponent()),
+		State:         credentialRotationStateFromPb(s.GetState()),
+		RotationError: s.GetRotationError(),
+		Status:        statusCodeFromPb(s.GetStatus()),
+		Error:         s.GetError(),
+	}
+	if s.LastAttempt != nil {
+		result.LastAttempt = s.LastAttempt.AsTime()
+	}
+	if s.LastRotated != nil {
+		result.LastRotated = s.LastRotated.AsTime()
+	}
+	if s.NextRotation != nil {
+		result.NextRotation = s.NextRotation.AsTime()
+	}
+	return result
+}
+
+// EventKind classifies the Redfish events reported by switch BMCs.
+type EventKind int
+
+const (
+	EventKindUnknown    EventKind = 0
+	EventKindPSUFault   EventKind = 1
+	EventKindPowerState EventKind = 2
+	EventKindTask       EventKind = 3
+	EventKindOther      EventKind = 4
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventKindPSUFault:
+		return "PSUFault"
+	case EventKindPowerState:
+		return "PowerState"
+	case EventKindTask:
+		return "Task"
+	case EventKindOther:
+		return "Other"
+	default:
+		return fmt.Sprintf("Unknown(%d)", int(k))
+	}
+}
+
+func eventKindFromPb(k pb.NVSwitchEventKind) EventKind {
+	switch k {
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_PSU_FAULT:
+		return EventKindPSUFault
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_POWER_STATE:
+		return EventKindPowerState
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK:
+		return EventKindTask
+	case pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_OTHER:
+		return EventKindOther
+	default:
+		return EventKindUnknown
+	}
+}
+
+func eventKindToPb(k EventKind) pb.NVSwitchEventKind {
+	switch k {
+	case EventKindPSUFault:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_PSU_FAULT
+	case EventKindPowerState:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_POWER_STATE
+	case EventKindTask:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_TASK
+	case EventKindOther:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_OTHER
+	default:
+		return pb.NVSwitchEventKind_NVSWITCH_EVENT_KIND_UNKNOWN
+	}
+}
+
+// NVSwitchEvent is a Redfish event reported by the BMC of a switch.
+type NVSwitchEvent struct {
+	UUID      string
+	Kind      EventKind
+	Severity  string
+	MessageID string
+	Message   string
+	Origin    string // URI of the resource the event is about
+	Timestamp time.Time
+}
+
+func nvSwitchEventFromPb(ev *pb.NVSwitchEvent) NVSwitchEvent {
+	result := NVSwitchEvent{
+		UUID:      ev.GetUuid(),
+		Kind:      eventKindFromPb(ev.GetKind()),
+		Severity:  ev.GetSeverity(),
+		MessageID: ev.GetMessageId(),
+		Message:   ev.GetMessage(),
+		Origin:    ev.GetOrigin(),
+	}
+	if ev.Timestamp != nil {
+		result.Timestamp = ev.Timestamp.AsTime()
+	}
+	return result
+}
@@ -177,6 +177,43 @@ const (
 	ConflictStrategyQueue
 )
 
+// MaintenancePolicy controls how a disruptive task behaves when its rack is
+// outside a maintenance window or inside a blackout.
+type MaintenancePolicy int
+
+const (
+	// MaintenancePolicyDefault applies the server's configured behaviour.
+	MaintenancePolicyDefault MaintenancePolicy = iota
+	// MaintenancePolicyReject rejects the task.
+	MaintenancePolicyReject
+	// MaintenancePolicyDefer queues the task until the next maintenance
+	// window opens.
+	MaintenancePolicyDefer
+)
+
+// Preemption controls what an emergency task does to conflicting active tasks
+// of lower priority on its rack.
+type Preemption int
+
+const (
+	// PreemptionNone leaves conflicting tasks alone; the conflict strategy
+	// applies as usual (default).
+	PreemptionNone Preemption = iota
+	// PreemptionCancel terminates the conflicting tasks.
+	PreemptionCancel
+	// PreemptionRequeue interrupts the conflicting tasks and puts them back
+	// in the rack's waiting queue, so they run again from the start once the
+	// emergency task has finished.
+	PreemptionRequeue
+)
+
+// MaintenanceOverride lets a task run regardless of the maintenance calendar.
+// Every override is recorded for audit.
+type MaintenanceOverride struct {
+	Reason      string
+	RequestedBy string
+}
+
 // Request represents the specification of an operation submitted by the user.
 // The Task Manager resolves the TargetSpec, splits by rack, and creates one
 // Task per rack.
@@ -209,6 +246,21 @@ type Request struct {
 	// this would need to become []uuid.UUID (or a separate AllowedRackIDs
 	// field). Do not add that generalization until there is a concrete caller.
 	RequiredRackID uuid.UUID
+
+	// MaintenancePolicy controls what happens to a disruptive task whose
+	// rack is outside a maintenance window or inside a blackout.
+	MaintenancePolicy MaintenancePolicy
+
+	// MaintenanceOverride, when set, runs the task regardless of the
+	// maintenance calendar. The override is audited.
+	MaintenanceOverride *MaintenanceOverride
+
+	// Priority is the priority class of the task. Empty means normal.
+	Priority taskcommon.TaskPriority
+
+	// Preemption controls what happens to conflicting active tasks of lower
+	// priority. Only emergency tasks may preempt.
+	Preemption Preemption
 }
 
 func (r *Request) Validate() error {
@@ -224,5 +276,17 @@ func (r *Request) Validate() error {
 		return fmt.Errorf("invalid target spec: %w", err)
 	}
 
+	if r.MaintenanceOverride != nil && r.MaintenanceOverride.Reason == "" {
+		return fmt.Errorf("maintenance override requires a reason")
+	}
+
+	if !r.Priority.IsValid() {
+		return fmt.Errorf("unknown task priority %q", r.Priority)
+	}
+
+	if r.Preemption != PreemptionNone && r.Priority != taskcommon.TaskPriorityEmergency {
+		return fmt.Errorf("only emergency tasks may preempt other tasks")
+	}
+
 	return nil
 }
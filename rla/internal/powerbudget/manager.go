@@ -0,0 +1,576 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package powerbudget enforces per-rack power budgets. Budgets are stored in
+// RLA, pushed to the power shelves as Redfish PowerLimit caps through the
+// Powershelf Manager where the vendor supports it, and compared against the
+// measured shelf draw to alert on overruns and to refuse operations that
+// would push a rack past its budget.
+package powerbudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// telemetryWindow bounds how far back the latest shelf reading may be to
+// count as a measurement of the current draw.
+const telemetryWindow = 5 * time.Minute
+
+// ErrOverBudget is returned by CheckAdmission when an operation would push
+// a rack's power draw past its budget.
+var ErrOverBudget = errors.New("operation would exceed the rack power budget")
+
+// InventoryReader is the subset of the inventory store the manager needs.
+type InventoryReader interface {
+	GetRackByID(ctx context.Context, id uuid.UUID, withComponents bool) (*rack.Rack, error)
+	GetComponentByID(ctx context.Context, id uuid.UUID) (*component.Component, error)
+}
+
+// PowershelfClient is the subset of the Powershelf Manager client the
+// manager needs to apply limits and read the measured draw.
+type PowershelfClient interface {
+	SetPowerLimit(ctx context.Context, limits []psmapi.PowerLimit) ([]psmapi.PowerControlResult, error)
+	GetPowershelfTelemetry(ctx context.Context, pmcMacs []string, since time.Time) ([]psmapi.PowershelfTelemetry, error)
+}
+
+// ApplyStatus describes the outcome of pushing a limit to one power shelf.
+type ApplyStatus string
+
+const (
+	// ApplyStatusApplied means the shelf accepted the PowerLimit.
+	ApplyStatusApplied ApplyStatus = "applied"
+	// ApplyStatusNotSupported means the shelf does not expose PowerLimit;
+	// its budget is enforced by monitoring and admission checks only.
+	ApplyStatusNotSupported ApplyStatus = "not_supported"
+	// ApplyStatusFailed means the Powershelf Manager rejected the request.
+	ApplyStatusFailed ApplyStatus = "failed"
+	// ApplyStatusSkipped means no Powershelf Manager is configured.
+	ApplyStatusSkipped ApplyStatus = "skipped"
+)
+
+// ShelfLimit is the limit computed for one power shelf and the outcome of
+// applying it. A LimitWatts of zero clears any limit on the shelf.
+type ShelfLimit struct {
+	ComponentID   uuid.UUID
+	PMCMACAddress string
+	LimitWatts    float64
+	Status        ApplyStatus
+	Error         string
+}
+
+// ShelfStatus is the measured state of one power shelf in a rack.
+type ShelfStatus struct {
+	ComponentID   uuid.UUID
+	PMCMACAddress string
+	// CapWatts is the per-shelf cap configured in RLA, zero if none.
+	CapWatts float64
+	// AppliedLimitWatts is the limit the shelf reports, nil if none.
+	AppliedLimitWatts *float64
+	DrawWatts         float64
+	Measured          bool
+}
+
+// OverCap reports whether the shelf draws more than its configured cap.
+func (s *ShelfStatus) OverCap() bool {
+	return s.CapWatts > 0 && s.Measured && s.DrawWatts > s.CapWatts
+}
+
+// RackStatus is the measured power draw of a rack against its budget.
+type RackStatus struct {
+	RackID uuid.UUID
+	// BudgetWatts is the rack-wide budget, zero if none.
+	BudgetWatts float64
+	// DrawWatts sums the draw of every shelf that reported a reading.
+	DrawWatts float64
+	// Measured is true only when every shelf in the rack reported a reading;
+	// otherwise DrawWatts is a lower bound.
+	Measured bool
+	Shelves  []ShelfStatus
+}
+
+// OverBudget reports whether the rack draws more than its budget or any
+// shelf draws more than its cap.
+func (s *RackStatus) OverBudget() bool {
+	if s.BudgetWatts > 0 && s.DrawWatts > s.BudgetWatts {
+		return true
+	}
+
+	for i := range s.Shelves {
+		if s.Shelves[i].OverCap() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Manager stores power budgets, applies them to the power shelves and
+// evaluates the measured draw against them.
+type Manager struct {
+	store     Store
+	inventory InventoryReader
+	psm       PowershelfClient
+	estimates map[devicetypes.ComponentType]float64
+}
+
+// NewManager creates a power budget manager. psm may be nil when no
+// Powershelf Manager is configured, in which case budgets are stored but
+// neither applied nor measured. estimates gives the expected draw in watts
+// of a component of each type once powered on; it feeds admission checks.
+func NewManager(
+	store Store,
+	inventory InventoryReader,
+	psm PowershelfClient,
+	estimates map[devicetypes.ComponentType]float64,
+) *Manager {
+	return &Manager{
+		store:     store,
+		inventory: inventory,
+		psm:       psm,
+		estimates: estimates,
+	}
+}
+
+// CanMeasure reports whether a Powershelf Manager is available to apply
+// limits and read the measured draw.
+func (m *Manager) CanMeasure() bool {
+	return m.psm != nil
+}
+
+// SetRackBudget sets the rack-wide budget and re-applies the shelf limits
+// of the rack.
+func (m *Manager) SetRackBudget(
+	ctx context.Context,
+	rackID uuid.UUID,
+	limitWatts float64,
+) (*dbmodel.PowerBudget, []ShelfLimit, error) {
+	if err := validateLimit(limitWatts); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := m.inventory.GetRackByID(ctx, rackID, false); err != nil {
+		return nil, nil, fmt.Errorf("rack %s: %w", rackID, err)
+	}
+
+	b, err := m.store.Upsert(ctx, &dbmodel.PowerBudget{
+		RackID:     rackID,
+		LimitWatts: limitWatts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limits, err := m.Apply(ctx, rackID)
+	return b, limits, err
+}
+
+// SetShelfBudget caps a single power shelf and re-applies the shelf limits
+// of its rack.
+func (m *Manager) SetShelfBudget(
+	ctx context.Context,
+	componentID uuid.UUID,
+	limitWatts float64,
+) (*dbmodel.PowerBudget, []ShelfLimit, error) {
+	if err := validateLimit(limitWatts); err != nil {
+		return nil, nil, err
+	}
+
+	comp, err := m.inventory.GetComponentByID(ctx, componentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("component %s: %w", componentID, err)
+	}
+
+	if comp.Type != devicetypes.ComponentTypePowerShelf {
+		return nil, nil, fmt.Errorf(
+			"component %s is a %s, power caps apply to power shelves only",
+			componentID, devicetypes.ComponentTypeToString(comp.Type),
+		)
+	}
+
+	if comp.RackID == uuid.Nil {
+		return nil, nil, fmt.Errorf("power shelf %s is not in a rack", componentID)
+	}
+
+	b, err := m.store.Upsert(ctx, &dbmodel.PowerBudget{
+		RackID:      comp.RackID,
+		ComponentID: &componentID,
+		LimitWatts:  limitWatts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limits, err := m.Apply(ctx, comp.RackID)
+	return b, limits, err
+}
+
+// DeleteBudget removes a budget and re-applies the shelf limits of its
+// rack, clearing the limits the budget imposed.
+func (m *Manager) DeleteBudget(
+	ctx context.Context,
+	id uuid.UUID,
+) ([]ShelfLimit, error) {
+	b, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.store.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return m.Apply(ctx, b.RackID)
+}
+
+// ListBudgets returns the budgets of the given racks, or of all racks when
+// rackIDs is empty.
+func (m *Manager) ListBudgets(
+	ctx context.Context,
+	rackIDs []uuid.UUID,
+) ([]*dbmodel.PowerBudget, error) {
+	return m.store.List(ctx, rackIDs)
+}
+
+// BudgetedRacks returns the IDs of the racks with a rack budget or at least
+// one shelf cap.
+func (m *Manager) BudgetedRacks(ctx context.Context) ([]uuid.UUID, error) {
+	budgets, err := m.store.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var rackIDs []uuid.UUID
+	for _, b := range budgets {
+		if !seen[b.RackID] {
+			seen[b.RackID] = true
+			rackIDs = append(rackIDs, b.RackID)
+		}
+	}
+
+	return rackIDs, nil
+}
+
+// Apply computes the limit of every power shelf in the rack and pushes it
+// to the shelves. A shelf cap applies as is; a rack budget is split evenly
+// across the shelves, bounded by any shelf cap. Shelves without either have
+// their limit cleared.
+func (m *Manager) Apply(
+	ctx context.Context,
+	rackID uuid.UUID,
+) ([]ShelfLimit, error) {
+	r, err := m.inventory.GetRackByID(ctx, rackID, true)
+	if err != nil {
+		return nil, fmt.Errorf("rack %s: %w", rackID, err)
+	}
+
+	budgets, err := m.store.ListByRack(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := shelfLimits(r, budgets)
+	if len(limits) == 0 {
+		return nil, nil
+	}
+
+	if m.psm == nil {
+		log.Warn().Str("rack_id", rackID.String()).
+			Msg("Powershelf Manager not available; power budget stored but not applied")
+		for i := range limits {
+			limits[i].Status = ApplyStatusSkipped
+		}
+		return limits, nil
+	}
+
+	req := make([]psmapi.PowerLimit, 0, len(limits))
+	for _, l := range limits {
+		req = append(req, psmapi.PowerLimit{
+			PMCMACAddress: l.PMCMACAddress,
+			LimitWatts:    l.LimitWatts,
+		})
+	}
+
+	results, err := m.psm.SetPowerLimit(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply power limits to rack %s: %w", rackID, err)
+	}
+
+	byMAC := make(map[string]psmapi.PowerControlResult, len(results))
+	for _, res := range results {
+		byMAC[res.PMCMACAddress] = res
+	}
+
+	for i := range limits {
+		res, ok := byMAC[limits[i].PMCMACAddress]
+		switch {
+		case !ok:
+			limits[i].Status = ApplyStatusFailed
+			limits[i].Error = "no response from Powershelf Manager"
+		case res.Status == psmapi.StatusSuccess:
+			limits[i].Status = ApplyStatusApplied
+		case res.Status == psmapi.StatusNotSupported:
+			limits[i].Status = ApplyStatusNotSupported
+			limits[i].Error = res.Error
+			log.Warn().
+				Str("rack_id", rackID.String()).
+				Str("pmc_mac", limits[i].PMCMACAddress).
+				Msg("Power shelf does not support PowerLimit; budget enforced by monitoring only")
+		default:
+			limits[i].Status = ApplyStatusFailed
+			limits[i].Error = res.Error
+		}
+	}
+
+	return limits, nil
+}
+
+// RackStatus reads the latest draw of every power shelf in the rack and
+// compares it against the rack budget and shelf caps.
+func (m *Manager) RackStatus(
+	ctx context.Context,
+	rackID uuid.UUID,
+) (*RackStatus, error) {
+	r, err := m.inventory.GetRackByID(ctx, rackID, true)
+	if err != nil {
+		return nil, fmt.Errorf("rack %s: %w", rackID, err)
+	}
+
+	budgets, err := m.store.ListByRack(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &RackStatus{RackID: rackID}
+	caps := make(map[uuid.UUID]float64)
+	for _, b := range budgets {
+		if b.IsRackBudget() {
+			status.BudgetWatts = b.LimitWatts
+		} else {
+			caps[*b.ComponentID] = b.LimitWatts
+		}
+	}
+
+	var macs []string
+	for _, c := range powerShelves(r) {
+		status.Shelves = append(status.Shelves, ShelfStatus{
+			ComponentID:   c.Info.ID,
+			PMCMACAddress: c.ComponentID,
+			CapWatts:      caps[c.Info.ID],
+		})
+		macs = append(macs, c.ComponentID)
+	}
+
+	if len(macs) == 0 {
+		return status, nil
+	}
+
+	if m.psm == nil {
+		return nil, fmt.Errorf("powershelf manager is not available")
+	}
+
+	telemetry, err := m.psm.GetPowershelfTelemetry(
+		ctx, macs, time.Now().Add(-telemetryWindow),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read telemetry of rack %s: %w", rackID, err)
+	}
+
+	byMAC := make(map[string]psmapi.PowershelfTelemetry, len(telemetry))
+	for _, t := range telemetry {
+		byMAC[t.PMCMACAddress] = t
+	}
+
+	status.Measured = true
+	for i := range status.Shelves {
+		shelf := &status.Shelves[i]
+		t, ok := byMAC[shelf.PMCMACAddress]
+		if !ok || t.Status != psmapi.StatusSuccess {
+			status.Measured = false
+			continue
+		}
+
+		sample, ok := t.Latest()
+		if !ok {
+			status.Measured = false
+			continue
+		}
+
+		shelf.AppliedLimitWatts = sample.PowerLimitWatts
+		if watts, ok := sample.Readings.PowerWatts(); ok {
+			shelf.DrawWatts = watts
+			shelf.Measured = true
+			status.DrawWatts += watts
+		} else {
+			status.Measured = false
+		}
+	}
+
+	return status, nil
+}
+
+// CheckAdmission refuses an operation on target that would push the rack
+// draw past its budget: the measured draw plus the estimated draw of every
+// targeted component that is not already on. Only operations that power
+// components on are checked. When the draw cannot be read the operation is
+// admitted, since an unreachable Powershelf Manager must not block
+// recovery work.
+func (m *Manager) CheckAdmission(
+	ctx context.Context,
+	req *operation.Request,
+	target *rack.Rack,
+) error {
+	if !increasesPower(req) {
+		return nil
+	}
+
+	rackID := target.Info.ID
+	budgets, err := m.store.ListByRack(ctx, rackID)
+	if err != nil {
+		return fmt.Errorf("failed to load power budget of rack %s: %w", rackID, err)
+	}
+
+	if !hasRackBudget(budgets) {
+		return nil
+	}
+
+	status, err := m.RackStatus(ctx, rackID)
+	if err != nil {
+		log.Warn().Err(err).Str("rack_id", rackID.String()).
+			Msg("Unable to measure rack power draw; admitting operation without budget check")
+		return nil
+	}
+
+	var added float64
+	for _, c := range target.Components {
+		if c.PowerState != "on" {
+			added += m.estimates[c.Type]
+		}
+	}
+
+	if status.DrawWatts+added > status.BudgetWatts {
+		return fmt.Errorf(
+			"%w: rack %s draws %.0f W, operation adds an estimated %.0f W, budget is %.0f W",
+			ErrOverBudget, rackID, status.DrawWatts, added, status.BudgetWatts,
+		)
+	}
+
+	if !status.Measured {
+		log.Warn().Str("rack_id", rackID.String()).
+			Msg("Not every power shelf reported its draw; budget check used a partial reading")
+	}
+
+	return nil
+}
+
+// increasesPower reports whether the operation powers components on.
+func increasesPower(req *operation.Request) bool {
+	switch req.Operation.Type {
+	case taskcommon.TaskTypeBringUp:
+		return true
+	case taskcommon.TaskTypePowerControl:
+		switch req.Operation.Code {
+		case taskcommon.OpCodePowerControlPowerOn,
+			taskcommon.OpCodePowerControlForcePowerOn:
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasRackBudget(budgets []*dbmodel.PowerBudget) bool {
+	for _, b := range budgets {
+		if b.IsRackBudget() {
+			return true
+		}
+	}
+	return false
+}
+
+// shelfLimits computes the limit of each power shelf of r from its budgets.
+func shelfLimits(r *rack.Rack, budgets []*dbmodel.PowerBudget) []ShelfLimit {
+	shelves := powerShelves(r)
+	if len(shelves) == 0 {
+		return nil
+	}
+
+	var rackBudget float64
+	caps := make(map[uuid.UUID]float64)
+	for _, b := range budgets {
+		if b.IsRackBudget() {
+			rackBudget = b.LimitWatts
+		} else {
+			caps[*b.ComponentID] = b.LimitWatts
+		}
+	}
+
+	var share float64
+	if rackBudget > 0 {
+		share = rackBudget / float64(len(shelves))
+	}
+
+	limits := make([]ShelfLimit, 0, len(shelves))
+	for _, c := range shelves {
+		limit := share
+		if shelfCap, ok := caps[c.Info.ID]; ok && (limit == 0 || shelfCap < limit) {
+			limit = shelfCap
+		}
+
+		limits = append(limits, ShelfLimit{
+			ComponentID:   c.Info.ID,
+			PMCMACAddress: c.ComponentID,
+			LimitWatts:    limit,
+		})
+	}
+
+	return limits
+}
+
+// powerShelves returns the power shelves of r that the Powershelf Manager
+// can address, i.e. those with a PMC MAC address.
+func powerShelves(r *rack.Rack) []component.Component {
+	var shelves []component.Component
+	for _, c := range r.Components {
+		if c.Type == devicetypes.ComponentTypePowerShelf && c.ComponentID != "" {
+			shelves = append(shelves, c)
+		}
+	}
+	return shelves
+}
+
+func validateLimit(limitWatts float64) error {
+	if math.IsNaN(limitWatts) || math.IsInf(limitWatts, 0) || limitWatts <= 0 {
+		return fmt.Errorf("limit_watts must be a positive number of watts")
+	}
+	return nil
+}
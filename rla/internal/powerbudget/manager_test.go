@@ -0,0 +1,431 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package powerbudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/deviceinfo"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// --- fakes ---
+
+type fakeStore struct {
+	budgets map[uuid.UUID]*dbmodel.PowerBudget
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{budgets: make(map[uuid.UUID]*dbmodel.PowerBudget)}
+}
+
+func (s *fakeStore) Upsert(_ context.Context, b *dbmodel.PowerBudget) (*dbmodel.PowerBudget, error) {
+	for _, existing := range s.budgets {
+		if existing.IsRackBudget() == b.IsRackBudget() &&
+			((b.IsRackBudget() && existing.RackID == b.RackID) ||
+				(!b.IsRackBudget() && *existing.ComponentID == *b.ComponentID)) {
+			existing.RackID = b.RackID
+			existing.LimitWatts = b.LimitWatts
+			return existing, nil
+		}
+	}
+	row := *b
+	row.ID = uuid.New()
+	s.budgets[row.ID] = &row
+	return &row, nil
+}
+
+func (s *fakeStore) Get(_ context.Context, id uuid.UUID) (*dbmodel.PowerBudget, error) {
+	b, ok := s.budgets[id]
+	if !ok {
+		return nil, fmt.Errorf("power budget %s not found", id)
+	}
+	return b, nil
+}
+
+func (s *fakeStore) ListByRack(ctx context.Context, rackID uuid.UUID) ([]*dbmodel.PowerBudget, error) {
+	return s.List(ctx, []uuid.UUID{rackID})
+}
+
+func (s *fakeStore) List(_ context.Context, rackIDs []uuid.UUID) ([]*dbmodel.PowerBudget, error) {
+	var out []*dbmodel.PowerBudget
+	for _, b := range s.budgets {
+		if len(rackIDs) == 0 || contains(rackIDs, b.RackID) {
+			out = append(out, b)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].IsRackBudget() && !out[j].IsRackBudget() })
+	return out, nil
+}
+
+func (s *fakeStore) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := s.budgets[id]; !ok {
+		return fmt.Errorf("power budget %s not found", id)
+	}
+	delete(s.budgets, id)
+	return nil
+}
+
+func contains(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeInventory struct {
+	racks map[uuid.UUID]*rack.Rack
+}
+
+func (f *fakeInventory) GetRackByID(_ context.Context, id uuid.UUID, _ bool) (*rack.Rack, error) {
+	r, ok := f.racks[id]
+	if !ok {
+		return nil, fmt.Errorf("rack %s not found", id)
+	}
+	return r, nil
+}
+
+func (f *fakeInventory) GetComponentByID(_ context.Context, id uuid.UUID) (*component.Component, error) {
+	for _, r := range f.racks {
+		for i := range r.Components {
+			if r.Components[i].Info.ID == id {
+				return &r.Components[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("component %s not found", id)
+}
+
+type fakePSM struct {
+	applied      map[string]float64
+	unsupported  map[string]bool
+	drawWatts    map[string]float64
+	telemetryErr error
+}
+
+func newFakePSM() *fakePSM {
+	return &fakePSM{
+		applied:     make(map[string]float64),
+		unsupported: make(map[string]bool),
+		drawWatts:   make(map[string]float64),
+	}
+}
+
+func (p *fakePSM) SetPowerLimit(_ context.Context, limits []psmapi.PowerLimit) ([]psmapi.PowerControlResult, error) {
+	results := make([]psmapi.PowerControlResult, 0, len(limits))
+	for _, l := range limits {
+		if p.unsupported[l.PMCMACAddress] {
+			results = append(results, psmapi.PowerControlResult{
+				PMCMACAddress: l.PMCMACAddress,
+				Status:        psmapi.StatusNotSupported,
+				Error:         "power limit not supported",
+			})
+			continue
+		}
+		p.applied[l.PMCMACAddress] = l.LimitWatts
+		results = append(results, psmapi.PowerControlResult{PMCMACAddress: l.PMCMACAddress})
+	}
+	return results, nil
+}
+
+func (p *fakePSM) GetPowershelfTelemetry(_ context.Context, pmcMacs []string, _ time.Time) ([]psmapi.PowershelfTelemetry, error) {
+	if p.telemetryErr != nil {
+		return nil, p.telemetryErr
+	}
+	var out []psmapi.PowershelfTelemetry
+	for _, mac := range pmcMacs {
+		watts, ok := p.drawWatts[mac]
+		if !ok {
+			continue
+		}
+		out = append(out, psmapi.PowershelfTelemetry{
+			PMCMACAddress: mac,
+			Samples: []psmapi.TelemetrySample{{
+				Timestamp: time.Now(),
+				Readings:  psmapi.TelemetryReadings{InputPowerWatts: &watts},
+			}},
+		})
+	}
+	return out, nil
+}
+
+// --- helpers ---
+
+type testRack struct {
+	rack    *rack.Rack
+	shelves []uuid.UUID
+}
+
+func newTestRack(shelfMACs ...string) *testRack {
+	tr := &testRack{rack: &rack.Rack{Info: deviceinfo.DeviceInfo{ID: uuid.New()}}}
+	for _, mac := range shelfMACs {
+		id := uuid.New()
+		tr.shelves = append(tr.shelves, id)
+		tr.rack.Components = append(tr.rack.Components, component.Component{
+			Type:        devicetypes.ComponentTypePowerShelf,
+			Info:        deviceinfo.DeviceInfo{ID: id},
+			ComponentID: mac,
+			RackID:      tr.rack.Info.ID,
+			PowerState:  "on",
+		})
+	}
+	return tr
+}
+
+func newTestManager(tr *testRack, psm *fakePSM) (*Manager, *fakeStore) {
+	store := newFakeStore()
+	inv := &fakeInventory{racks: map[uuid.UUID]*rack.Rack{tr.rack.Info.ID: tr.rack}}
+	estimates := map[devicetypes.ComponentType]float64{
+		devicetypes.ComponentTypeCompute: 1000,
+	}
+	if psm == nil {
+		return NewManager(store, inv, nil, estimates), store
+	}
+	return NewManager(store, inv, psm, estimates), store
+}
+
+func powerOnRequest() *operation.Request {
+	return &operation.Request{Operation: operation.Wrapper{
+		Type: taskcommon.TaskTypePowerControl,
+		Code: taskcommon.OpCodePowerControlPowerOn,
+	}}
+}
+
+func computeTarget(rackID uuid.UUID, n int, powerState string) *rack.Rack {
+	target := &rack.Rack{Info: deviceinfo.DeviceInfo{ID: rackID}}
+	for i := 0; i < n; i++ {
+		target.Components = append(target.Components, component.Component{
+			Type:       devicetypes.ComponentTypeCompute,
+			Info:       deviceinfo.DeviceInfo{ID: uuid.New()},
+			RackID:     rackID,
+			PowerState: powerState,
+		})
+	}
+	return target
+}
+
+// --- tests ---
+
+func TestSetRackBudget_SplitsAcrossShelves(t *testing.T) {
+	tr := newTestRack("aa:aa", "bb:bb")
+	psm := newFakePSM()
+	m, _ := newTestManager(tr, psm)
+
+	b, limits, err := m.SetRackBudget(context.Background(), tr.rack.Info.ID, 10000)
+	require.NoError(t, err)
+	assert.True(t, b.IsRackBudget())
+	require.Len(t, limits, 2)
+	for _, l := range limits {
+		assert.Equal(t, ApplyStatusApplied, l.Status)
+		assert.Equal(t, 5000.0, l.LimitWatts)
+	}
+	assert.Equal(t, map[string]float64{"aa:aa": 5000, "bb:bb": 5000}, psm.applied)
+}
+
+func TestSetShelfBudget_BoundsRackShare(t *testing.T) {
+	tr := newTestRack("aa:aa", "bb:bb")
+	psm := newFakePSM()
+	m, _ := newTestManager(tr, psm)
+	ctx := context.Background()
+
+	_, _, err := m.SetRackBudget(ctx, tr.rack.Info.ID, 10000)
+	require.NoError(t, err)
+
+	b, _, err := m.SetShelfBudget(ctx, tr.shelves[0], 3000)
+	require.NoError(t, err)
+	assert.False(t, b.IsRackBudget())
+	assert.Equal(t, tr.rack.Info.ID, b.RackID)
+	assert.Equal(t, map[string]float64{"aa:aa": 3000, "bb:bb": 5000}, psm.applied)
+
+	// A cap above the rack share does not raise the shelf limit.
+	_, _, err = m.SetShelfBudget(ctx, tr.shelves[0], 8000)
+	require.NoError(t, err)
+	assert.Equal(t, 5000.0, psm.applied["aa:aa"])
+}
+
+func TestSetShelfBudget_RejectsNonPowerShelf(t *testing.T) {
+	tr := newTestRack("aa:aa")
+	computeID := uuid.New()
+	tr.rack.Components = append(tr.rack.Components, component.Component{
+		Type:   devicetypes.ComponentTypeCompute,
+		Info:   deviceinfo.DeviceInfo{ID: computeID},
+		RackID: tr.rack.Info.ID,
+	})
+	m, _ := newTestManager(tr, newFakePSM())
+
+	_, _, err := m.SetShelfBudget(context.Background(), computeID, 1000)
+	assert.ErrorContains(t, err, "power shelves only")
+}
+
+func TestSetRackBudget_InvalidLimit(t *testing.T) {
+	tr := newTestRack("aa:aa")
+	m, _ := newTestManager(tr, newFakePSM())
+
+	for _, watts := range []float64{0, -5} {
+		_, _, err := m.SetRackBudget(context.Background(), tr.rack.Info.ID, watts)
+		assert.Error(t, err, "limit %v", watts)
+	}
+}
+
+func TestDeleteBudget_ClearsLimits(t *testing.T) {
+	tr := newTestRack("aa:aa", "bb:bb")
+	psm := newFakePSM()
+	m, _ := newTestManager(tr, psm)
+	ctx := context.Background()
+
+	b, _, err := m.SetRackBudget(ctx, tr.rack.Info.ID, 10000)
+	require.NoError(t, err)
+
+	limits, err := m.DeleteBudget(ctx, b.ID)
+	require.NoError(t, err)
+	require.Len(t, limits, 2)
+	assert.Equal(t, map[string]float64{"aa:aa": 0, "bb:bb": 0}, psm.applied)
+}
+
+func TestApply_NotSupportedAndNoPSM(t *testing.T) {
+	tr := newTestRack("aa:aa", "bb:bb")
+	psm := newFakePSM()
+	psm.unsupported["bb:bb"] = true
+	m, _ := newTestManager(tr, psm)
+
+	_, limits, err := m.SetRackBudget(context.Background(), tr.rack.Info.ID, 10000)
+	require.NoError(t, err)
+	statuses := map[string]ApplyStatus{}
+	for _, l := range limits {
+		statuses[l.PMCMACAddress] = l.Status
+	}
+	assert.Equal(t, ApplyStatusApplied, statuses["aa:aa"])
+	assert.Equal(t, ApplyStatusNotSupported, statuses["bb:bb"])
+
+	m, _ = newTestManager(tr, nil)
+	_, limits, err = m.SetRackBudget(context.Background(), tr.rack.Info.ID, 10000)
+	require.NoError(t, err)
+	for _, l := range limits {
+		assert.Equal(t, ApplyStatusSkipped, l.Status)
+	}
+}
+
+func TestRackStatus(t *testing.T) {
+	tr := newTestRack("aa:aa", "bb:bb")
+	psm := newFakePSM()
+	psm.drawWatts["aa:aa"] = 4000
+	psm.drawWatts["bb:bb"] = 4500
+	m, _ := newTestManager(tr, psm)
+	ctx := context.Background()
+
+	_, _, err := m.SetRackBudget(ctx, tr.rack.Info.ID, 8000)
+	require.NoError(t, err)
+
+	status, err := m.RackStatus(ctx, tr.rack.Info.ID)
+	require.NoError(t, err)
+	assert.True(t, status.Measured)
+	assert.Equal(t, 8500.0, status.DrawWatts)
+	assert.Equal(t, 8000.0, status.BudgetWatts)
+	assert.True(t, status.OverBudget())
+
+	// A missing shelf reading leaves the draw as a lower bound.
+	delete(psm.drawWatts, "bb:bb")
+	status, err = m.RackStatus(ctx, tr.rack.Info.ID)
+	require.NoError(t, err)
+	assert.False(t, status.Measured)
+	assert.Equal(t, 4000.0, status.DrawWatts)
+	assert.False(t, status.OverBudget())
+
+	// A shelf over its own cap marks the rack over budget.
+	_, _, err = m.SetShelfBudget(ctx, tr.shelves[0], 3500)
+	require.NoError(t, err)
+	status, err = m.RackStatus(ctx, tr.rack.Info.ID)
+	require.NoError(t, err)
+	assert.True(t, status.OverBudget())
+}
+
+func TestCheckAdmission(t *testing.T) {
+	tr := newTestRack("aa:aa")
+	psm := newFakePSM()
+	psm.drawWatts["aa:aa"] = 6000
+	m, _ := newTestManager(tr, psm)
+	ctx := context.Background()
+	rackID := tr.rack.Info.ID
+
+	// No budget: everything is admitted.
+	require.NoError(t, m.CheckAdmission(ctx, powerOnRequest(), computeTarget(rackID, 10, "off")))
+
+	_, _, err := m.SetRackBudget(ctx, rackID, 8000)
+	require.NoError(t, err)
+
+	// 6000 W measured + 2 x 1000 W estimated fits the 8000 W budget.
+	require.NoError(t, m.CheckAdmission(ctx, powerOnRequest(), computeTarget(rackID, 2, "off")))
+
+	// A third machine does not.
+	err = m.CheckAdmission(ctx, powerOnRequest(), computeTarget(rackID, 3, "off"))
+	assert.True(t, errors.Is(err, ErrOverBudget))
+
+	// Components that are already on add nothing.
+	require.NoError(t, m.CheckAdmission(ctx, powerOnRequest(), computeTarget(rackID, 3, "on")))
+
+	// Bring-up is checked too; power-off is not.
+	bringUp := &operation.Request{Operation: operation.Wrapper{Type: taskcommon.TaskTypeBringUp}}
+	err = m.CheckAdmission(ctx, bringUp, computeTarget(rackID, 3, "off"))
+	assert.True(t, errors.Is(err, ErrOverBudget))
+
+	powerOff := &operation.Request{Operation: operation.Wrapper{
+		Type: taskcommon.TaskTypePowerControl,
+		Code: taskcommon.OpCodePowerControlPowerOff,
+	}}
+	require.NoError(t, m.CheckAdmission(ctx, powerOff, computeTarget(rackID, 3, "off")))
+
+	// Unreadable telemetry fails open.
+	psm.telemetryErr = errors.New("psm unavailable")
+	require.NoError(t, m.CheckAdmission(ctx, powerOnRequest(), computeTarget(rackID, 3, "off")))
+}
+
+func TestBudgetedRacks(t *testing.T) {
+	tr := newTestRack("aa:aa", "bb:bb")
+	m, _ := newTestManager(tr, newFakePSM())
+	ctx := context.Background()
+
+	rackIDs, err := m.BudgetedRacks(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, rackIDs)
+
+	_, _, err = m.SetRackBudget(ctx, tr.rack.Info.ID, 10000)
+	require.NoError(t, err)
+	_, _, err = m.SetShelfBudget(ctx, tr.shelves[1], 4000)
+	require.NoError(t, err)
+
+	rackIDs, err = m.BudgetedRacks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tr.rack.Info.ID}, rackIDs)
+}
@@ -0,0 +1,179 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package powerbudget
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// Store is the persistence layer for rack and power shelf power budgets.
+type Store interface {
+	// Upsert creates the budget or, if the rack (rack budget) or power shelf
+	// (shelf cap) already has one, replaces its limit. Returns the stored row.
+	Upsert(ctx context.Context, b *dbmodel.PowerBudget) (*dbmodel.PowerBudget, error)
+
+	// Get returns the budget with the given ID, or an error if not found.
+	Get(ctx context.Context, id uuid.UUID) (*dbmodel.PowerBudget, error)
+
+	// ListByRack returns the rack budget and all shelf caps of the rack.
+	ListByRack(ctx context.Context, rackID uuid.UUID) ([]*dbmodel.PowerBudget, error)
+
+	// List returns the budgets of the given racks, or of all racks when
+	// rackIDs is empty.
+	List(ctx context.Context, rackIDs []uuid.UUID) ([]*dbmodel.PowerBudget, error)
+
+	// Delete removes the budget with the given ID.
+	// Returns an error if no row with the given ID exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// PostgresStore implements Store using PostgreSQL via bun.
+type PostgresStore struct {
+	pg *cdb.Session
+}
+
+// NewPostgresStore creates a new PostgreSQL-backed power budget store.
+func NewPostgresStore(pg *cdb.Session) *PostgresStore {
+	return &PostgresStore{pg: pg}
+}
+
+// Upsert implements Store.
+func (s *PostgresStore) Upsert(
+	ctx context.Context,
+	b *dbmodel.PowerBudget,
+) (*dbmodel.PowerBudget, error) {
+	// The conflict target must repeat the predicate of the partial unique
+	// index for Postgres to infer it.
+	row := *b
+	conflict := "CONFLICT (component_id) WHERE component_id IS NOT NULL DO UPDATE"
+	if row.IsRackBudget() {
+		row.ComponentID = nil
+		conflict = "CONFLICT (rack_id) WHERE component_id IS NULL DO UPDATE"
+	}
+
+	if row.ID == uuid.Nil {
+		row.ID = uuid.New()
+	}
+	now := time.Now()
+	row.CreatedAt, row.UpdatedAt = now, now
+
+	// On conflict the existing row keeps its ID and created_at; RETURNING
+	// reads it back so callers see the stored identity.
+	err := s.pg.DB.NewInsert().
+		Model(&row).
+		On(conflict).
+		Set("rack_id = EXCLUDED.rack_id").
+		Set("limit_watts = EXCLUDED.limit_watts").
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+// Get implements Store.
+func (s *PostgresStore) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (*dbmodel.PowerBudget, error) {
+	var b dbmodel.PowerBudget
+
+	err := s.pg.DB.NewSelect().
+		Model(&b).
+		Where("pbg.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("power budget %s not found", id)
+		}
+
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// ListByRack implements Store.
+func (s *PostgresStore) ListByRack(
+	ctx context.Context,
+	rackID uuid.UUID,
+) ([]*dbmodel.PowerBudget, error) {
+	return s.List(ctx, []uuid.UUID{rackID})
+}
+
+// List implements Store.
+func (s *PostgresStore) List(
+	ctx context.Context,
+	rackIDs []uuid.UUID,
+) ([]*dbmodel.PowerBudget, error) {
+	var rows []dbmodel.PowerBudget
+
+	q := s.pg.DB.NewSelect().Model(&rows)
+	if len(rackIDs) > 0 {
+		q = q.Where("pbg.rack_id IN (?)", bun.In(rackIDs))
+	}
+
+	// Rack budgets sort ahead of the shelf caps of the same rack.
+	err := q.OrderExpr("pbg.rack_id ASC, pbg.component_id ASC NULLS FIRST").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := make([]*dbmodel.PowerBudget, len(rows))
+	for i := range rows {
+		b := rows[i]
+		budgets[i] = &b
+	}
+
+	return budgets, nil
+}
+
+// Delete implements Store.
+func (s *PostgresStore) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := s.pg.DB.NewDelete().
+		TableExpr("power_budget").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("power budget %s not found", id)
+	}
+
+	return nil
+}
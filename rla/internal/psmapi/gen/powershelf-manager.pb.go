@@ -45,6 +45,7 @@ type PMCVendor int32
 const (
 	PMCVendor_PMC_TYPE_UNKNOWN PMCVendor = 0
 	PMCVendor_PMC_TYPE_LITEON  PMCVendor = 1
+	PMCVendor_PMC_TYPE_DELTA   PMCVendor = 2
 )
 
 // Enum value maps for PMCVendor.
@@ -52,10 +53,12 @@ var (
 	PMCVendor_name = map[int32]string{
 		0: "PMC_TYPE_UNKNOWN",
 		1: "PMC_TYPE_LITEON",
+		2: "PMC_TYPE_DELTA",
 	}
 	PMCVendor_value = map[string]int32{
 		"PMC_TYPE_UNKNOWN": 0,
 		"PMC_TYPE_LITEON":  1,
+		"PMC_TYPE_DELTA":   2,
 	}
 )
 
@@ -92,6 +95,7 @@ const (
 	StatusCode_SUCCESS          StatusCode = 0
 	StatusCode_INVALID_ARGUMENT StatusCode = 1
 	StatusCode_INTERNAL_ERROR   StatusCode = 2
+	StatusCode_NOT_SUPPORTED    StatusCode = 3
 )
 
 // Enum value maps for StatusCode.
@@ -100,11 +104,13 @@ var (
 		0: "SUCCESS",
 		1: "INVALID_ARGUMENT",
 		2: "INTERNAL_ERROR",
+		3: "NOT_SUPPORTED",
 	}
 	StatusCode_value = map[string]int32{
 		"SUCCESS":          0,
 		"INVALID_ARGUMENT": 1,
 		"INTERNAL_ERROR":   2,
+		"NOT_SUPPORTED":    3,
 	}
 )
 
@@ -237,6 +243,176 @@ func (FirmwareUpdateState) EnumDescriptor() ([]byte, []int) {
 	return file_powershelf_manager_proto_rawDescGZIP(), []int{3}
 }
 
+// CredentialRotationState represents the state of the latest credential rotation of a PMC.
+type CredentialRotationState int32
+
+const (
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN       CredentialRotationState = 0
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED CredentialRotationState = 1
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS   CredentialRotationState = 2
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED     CredentialRotationState = 3
+	// The rotation failed and the PMC still accepts the stored password.
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED CredentialRotationState = 4
+	// The rotation failed and restoring the stored password failed too; the PMC needs manual attention.
+	CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED CredentialRotationState = 5
+)
+
+// Enum value maps for CredentialRotationState.
+var (
+	CredentialRotationState_name = map[int32]string{
+		0: "CREDENTIAL_ROTATION_STATE_UNKNOWN",
+		1: "CREDENTIAL_ROTATION_STATE_NEVER_ROTATED",
+		2: "CREDENTIAL_ROTATION_STATE_IN_PROGRESS",
+		3: "CREDENTIAL_ROTATION_STATE_SUCCEEDED",
+		4: "CREDENTIAL_ROTATION_STATE_FAILED",
+		5: "CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED",
+	}
+	CredentialRotationState_value = map[string]int32{
+		"CREDENTIAL_ROTATION_STATE_UNKNOWN":         0,
+		"CREDENTIAL_ROTATION_STATE_NEVER_ROTATED":   1,
+		"CREDENTIAL_ROTATION_STATE_IN_PROGRESS":     2,
+		"CREDENTIAL_ROTATION_STATE_SUCCEEDED":       3,
+		"CREDENTIAL_ROTATION_STATE_FAILED":          4,
+		"CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED": 5,
+	}
+)
+
+func (x CredentialRotationState) Enum() *CredentialRotationState {
+	p := new(CredentialRotationState)
+	*p = x
+	return p
+}
+
+func (x CredentialRotationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialRotationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_powershelf_manager_proto_enumTypes[4].Descriptor()
+}
+
+func (CredentialRotationState) Type() protoreflect.EnumType {
+	return &file_powershelf_manager_proto_enumTypes[4]
+}
+
+func (x CredentialRotationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialRotationState.Descriptor instead.
+func (CredentialRotationState) EnumDescriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{4}
+}
+
+// PowershelfEventKind classifies a Redfish event reported by a PMC.
+type PowershelfEventKind int32
+
+const (
+	PowershelfEventKind_POWERSHELF_EVENT_KIND_UNKNOWN     PowershelfEventKind = 0
+	PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT   PowershelfEventKind = 1 // A PSU fault was raised or cleared
+	PowershelfEventKind_POWERSHELF_EVENT_KIND_POWER_STATE PowershelfEventKind = 2 // The powershelf was powered on or off
+	PowershelfEventKind_POWERSHELF_EVENT_KIND_TASK        PowershelfEventKind = 3 // Progress of a Redfish task, e.g. a firmware update
+	PowershelfEventKind_POWERSHELF_EVENT_KIND_OTHER       PowershelfEventKind = 4
+)
+
+// Enum value maps for PowershelfEventKind.
+var (
+	PowershelfEventKind_name = map[int32]string{
+		0: "POWERSHELF_EVENT_KIND_UNKNOWN",
+		1: "POWERSHELF_EVENT_KIND_PSU_FAULT",
+		2: "POWERSHELF_EVENT_KIND_POWER_STATE",
+		3: "POWERSHELF_EVENT_KIND_TASK",
+		4: "POWERSHELF_EVENT_KIND_OTHER",
+	}
+	PowershelfEventKind_value = map[string]int32{
+		"POWERSHELF_EVENT_KIND_UNKNOWN":     0,
+		"POWERSHELF_EVENT_KIND_PSU_FAULT":   1,
+		"POWERSHELF_EVENT_KIND_POWER_STATE": 2,
+		"POWERSHELF_EVENT_KIND_TASK":        3,
+		"POWERSHELF_EVENT_KIND_OTHER":       4,
+	}
+)
+
+func (x PowershelfEventKind) Enum() *PowershelfEventKind {
+	p := new(PowershelfEventKind)
+	*p = x
+	return p
+}
+
+func (x PowershelfEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowershelfEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_powershelf_manager_proto_enumTypes[5].Descriptor()
+}
+
+func (PowershelfEventKind) Type() protoreflect.EnumType {
+	return &file_powershelf_manager_proto_enumTypes[5]
+}
+
+func (x PowershelfEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowershelfEventKind.Descriptor instead.
+func (PowershelfEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{5}
+}
+
+// FirmwareComplianceState is the compliance of a firmware inventory item with its baseline.
+type FirmwareComplianceState int32
+
+const (
+	FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_UNKNOWN       FirmwareComplianceState = 0
+	FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_COMPLIANT     FirmwareComplianceState = 1
+	FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_NON_COMPLIANT FirmwareComplianceState = 2
+	FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_NO_BASELINE   FirmwareComplianceState = 3 // No baseline applies to the item
+)
+
+// Enum value maps for FirmwareComplianceState.
+var (
+	FirmwareComplianceState_name = map[int32]string{
+		0: "FIRMWARE_COMPLIANCE_STATE_UNKNOWN",
+		1: "FIRMWARE_COMPLIANCE_STATE_COMPLIANT",
+		2: "FIRMWARE_COMPLIANCE_STATE_NON_COMPLIANT",
+		3: "FIRMWARE_COMPLIANCE_STATE_NO_BASELINE",
+	}
+	FirmwareComplianceState_value = map[string]int32{
+		"FIRMWARE_COMPLIANCE_STATE_UNKNOWN":       0,
+		"FIRMWARE_COMPLIANCE_STATE_COMPLIANT":     1,
+		"FIRMWARE_COMPLIANCE_STATE_NON_COMPLIANT": 2,
+		"FIRMWARE_COMPLIANCE_STATE_NO_BASELINE":   3,
+	}
+)
+
+func (x FirmwareComplianceState) Enum() *FirmwareComplianceState {
+	p := new(FirmwareComplianceState)
+	*p = x
+	return p
+}
+
+func (x FirmwareComplianceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirmwareComplianceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_powershelf_manager_proto_enumTypes[6].Descriptor()
+}
+
+func (FirmwareComplianceState) Type() protoreflect.EnumType {
+	return &file_powershelf_manager_proto_enumTypes[6]
+}
+
+func (x FirmwareComplianceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirmwareComplianceState.Descriptor instead.
+func (FirmwareComplianceState) EnumDescriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{6}
+}
+
 // Credentials wraps around a username and password
 type Credentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -795,9 +971,11 @@ type PowerShelf struct {
 	Pmc     *PowerManagementController `protobuf:"bytes,1,opt,name=pmc,proto3" json:"pmc,omitempty"`
 	Chassis *Chassis                   `protobuf:"bytes,2,opt,name=chassis,proto3" json:"chassis,omitempty"`
 	// TODO: system
-	Psus          []*PowerSupplyUnit `protobuf:"bytes,3,rep,name=psus,proto3" json:"psus,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Psus []*PowerSupplyUnit `protobuf:"bytes,3,rep,name=psus,proto3" json:"psus,omitempty"`
+	// Power cap in watts (0 if capping is disabled). Unset if the PMC does not support power capping.
+	PowerLimitWatts *float64 `protobuf:"fixed64,4,opt,name=power_limit_watts,json=powerLimitWatts,proto3,oneof" json:"power_limit_watts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PowerShelf) Reset() {
@@ -851,6 +1029,13 @@ func (x *PowerShelf) GetPsus() []*PowerSupplyUnit {
 	return nil
 }
 
+func (x *PowerShelf) GetPowerLimitWatts() float64 {
+	if x != nil && x.PowerLimitWatts != nil {
+		return *x.PowerLimitWatts
+	}
+	return 0
+}
+
 type RegisterPowershelfRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	PmcMacAddress  string                 `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
@@ -1127,18 +1312,74 @@ func (x *PowershelfRequest) GetPmcMacs() []string {
 	return nil
 }
 
+// PowerRequest is used by PowerOn/PowerOff RPCs. Registered devices are
+// identified by MAC; unregistered devices use PowerTarget with inline
+// connection details.
+type PowerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacs       []string               `protobuf:"bytes,1,rep,name=pmc_macs,json=pmcMacs,proto3" json:"pmc_macs,omitempty"`
+	Targets       []*PowerTarget         `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerRequest) Reset() {
+	*x = PowerRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerRequest) ProtoMessage() {}
+
+func (x *PowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerRequest.ProtoReflect.Descriptor instead.
+func (*PowerRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PowerRequest) GetPmcMacs() []string {
+	if x != nil {
+		return x.PmcMacs
+	}
+	return nil
+}
+
+func (x *PowerRequest) GetTargets() []*PowerTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
 type PowershelfResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PmcMacAddress string                 `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
 	Status        StatusCode             `protobuf:"varint,2,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
 	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	PmcIp         string                 `protobuf:"bytes,4,opt,name=pmc_ip,json=pmcIp,proto3" json:"pmc_ip,omitempty"` // Set for direct PowerTarget responses; empty for registered shelves
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PowershelfResponse) Reset() {
 	*x = PowershelfResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[13]
+	mi := &file_powershelf_manager_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1150,7 +1391,7 @@ func (x *PowershelfResponse) String() string {
 func (*PowershelfResponse) ProtoMessage() {}
 
 func (x *PowershelfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[13]
+	mi := &file_powershelf_manager_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +1404,7 @@ func (x *PowershelfResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowershelfResponse.ProtoReflect.Descriptor instead.
 func (*PowershelfResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{13}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PowershelfResponse) GetPmcMacAddress() string {
@@ -1187,6 +1428,13 @@ func (x *PowershelfResponse) GetError() string {
 	return ""
 }
 
+func (x *PowershelfResponse) GetPmcIp() string {
+	if x != nil {
+		return x.PmcIp
+	}
+	return ""
+}
+
 type PowerControlResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Responses     []*PowershelfResponse  `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
@@ -1196,7 +1444,7 @@ type PowerControlResponse struct {
 
 func (x *PowerControlResponse) Reset() {
 	*x = PowerControlResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[14]
+	mi := &file_powershelf_manager_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1208,7 +1456,7 @@ func (x *PowerControlResponse) String() string {
 func (*PowerControlResponse) ProtoMessage() {}
 
 func (x *PowerControlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[14]
+	mi := &file_powershelf_manager_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1469,7 @@ func (x *PowerControlResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerControlResponse.ProtoReflect.Descriptor instead.
 func (*PowerControlResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{14}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PowerControlResponse) GetResponses() []*PowershelfResponse {
@@ -1231,28 +1479,32 @@ func (x *PowerControlResponse) GetResponses() []*PowershelfResponse {
 	return nil
 }
 
-type GetPowershelvesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Powershelves  []*PowerShelf          `protobuf:"bytes,1,rep,name=powershelves,proto3" json:"powershelves,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// PowerTarget allows power control against a device without prior registration.
+// Only IP and credentials are required; the registry and credential manager are bypassed.
+type PowerTarget struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PmcIp          string                 `protobuf:"bytes,1,opt,name=pmc_ip,json=pmcIp,proto3" json:"pmc_ip,omitempty"`
+	PmcCredentials *Credentials           `protobuf:"bytes,2,opt,name=pmc_credentials,json=pmcCredentials,proto3" json:"pmc_credentials,omitempty"`
+	PmcVendor      PMCVendor              `protobuf:"varint,3,opt,name=pmc_vendor,json=pmcVendor,proto3,enum=v1.PMCVendor" json:"pmc_vendor,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetPowershelvesResponse) Reset() {
-	*x = GetPowershelvesResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[15]
+func (x *PowerTarget) Reset() {
+	*x = PowerTarget{}
+	mi := &file_powershelf_manager_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPowershelvesResponse) String() string {
+func (x *PowerTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPowershelvesResponse) ProtoMessage() {}
+func (*PowerTarget) ProtoMessage() {}
 
-func (x *GetPowershelvesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[15]
+func (x *PowerTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,41 +1515,55 @@ func (x *GetPowershelvesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPowershelvesResponse.ProtoReflect.Descriptor instead.
-func (*GetPowershelvesResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use PowerTarget.ProtoReflect.Descriptor instead.
+func (*PowerTarget) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetPowershelvesResponse) GetPowershelves() []*PowerShelf {
+func (x *PowerTarget) GetPmcIp() string {
 	if x != nil {
-		return x.Powershelves
+		return x.PmcIp
+	}
+	return ""
+}
+
+func (x *PowerTarget) GetPmcCredentials() *Credentials {
+	if x != nil {
+		return x.PmcCredentials
 	}
 	return nil
 }
 
-type UpdateComponentFirmwareRequest struct {
+func (x *PowerTarget) GetPmcVendor() PMCVendor {
+	if x != nil {
+		return x.PmcVendor
+	}
+	return PMCVendor_PMC_TYPE_UNKNOWN
+}
+
+// SetPowerLimitRequest is used by the SetPowerLimit RPC.
+type SetPowerLimitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Component     PowershelfComponent    `protobuf:"varint,1,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
-	UpgradeTo     *FirmwareVersion       `protobuf:"bytes,2,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
+	Limits        []*PowerLimit          `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateComponentFirmwareRequest) Reset() {
-	*x = UpdateComponentFirmwareRequest{}
-	mi := &file_powershelf_manager_proto_msgTypes[16]
+func (x *SetPowerLimitRequest) Reset() {
+	*x = SetPowerLimitRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateComponentFirmwareRequest) String() string {
+func (x *SetPowerLimitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateComponentFirmwareRequest) ProtoMessage() {}
+func (*SetPowerLimitRequest) ProtoMessage() {}
 
-func (x *UpdateComponentFirmwareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[16]
+func (x *SetPowerLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,48 +1574,42 @@ func (x *UpdateComponentFirmwareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateComponentFirmwareRequest.ProtoReflect.Descriptor instead.
-func (*UpdateComponentFirmwareRequest) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *UpdateComponentFirmwareRequest) GetComponent() PowershelfComponent {
-	if x != nil {
-		return x.Component
-	}
-	return PowershelfComponent_PMC
+// Deprecated: Use SetPowerLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerLimitRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateComponentFirmwareRequest) GetUpgradeTo() *FirmwareVersion {
+func (x *SetPowerLimitRequest) GetLimits() []*PowerLimit {
 	if x != nil {
-		return x.UpgradeTo
+		return x.Limits
 	}
 	return nil
 }
 
-type UpdatePowershelfFirmwareRequest struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	PmcMacAddress string                            `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
-	Components    []*UpdateComponentFirmwareRequest `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+// PowerLimit caps the power of a registered powershelf. A limit of 0 disables power capping.
+type PowerLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacAddress string                 `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	LimitWatts    float64                `protobuf:"fixed64,2,opt,name=limit_watts,json=limitWatts,proto3" json:"limit_watts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePowershelfFirmwareRequest) Reset() {
-	*x = UpdatePowershelfFirmwareRequest{}
-	mi := &file_powershelf_manager_proto_msgTypes[17]
+func (x *PowerLimit) Reset() {
+	*x = PowerLimit{}
+	mi := &file_powershelf_manager_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePowershelfFirmwareRequest) String() string {
+func (x *PowerLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePowershelfFirmwareRequest) ProtoMessage() {}
+func (*PowerLimit) ProtoMessage() {}
 
-func (x *UpdatePowershelfFirmwareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[17]
+func (x *PowerLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,47 +1620,47 @@ func (x *UpdatePowershelfFirmwareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePowershelfFirmwareRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePowershelfFirmwareRequest) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use PowerLimit.ProtoReflect.Descriptor instead.
+func (*PowerLimit) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdatePowershelfFirmwareRequest) GetPmcMacAddress() string {
+func (x *PowerLimit) GetPmcMacAddress() string {
 	if x != nil {
 		return x.PmcMacAddress
 	}
 	return ""
 }
 
-func (x *UpdatePowershelfFirmwareRequest) GetComponents() []*UpdateComponentFirmwareRequest {
+func (x *PowerLimit) GetLimitWatts() float64 {
 	if x != nil {
-		return x.Components
+		return x.LimitWatts
 	}
-	return nil
+	return 0
 }
 
-type UpdateFirmwareRequest struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Upgrades      []*UpdatePowershelfFirmwareRequest `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+type GetPowershelvesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Powershelves  []*PowerShelf          `protobuf:"bytes,1,rep,name=powershelves,proto3" json:"powershelves,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateFirmwareRequest) Reset() {
-	*x = UpdateFirmwareRequest{}
-	mi := &file_powershelf_manager_proto_msgTypes[18]
+func (x *GetPowershelvesResponse) Reset() {
+	*x = GetPowershelvesResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFirmwareRequest) String() string {
+func (x *GetPowershelvesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFirmwareRequest) ProtoMessage() {}
+func (*GetPowershelvesResponse) ProtoMessage() {}
 
-func (x *UpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[18]
+func (x *GetPowershelvesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1411,12 +1671,160 @@ func (x *UpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFirmwareRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFirmwareRequest) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetPowershelvesResponse.ProtoReflect.Descriptor instead.
+func (*GetPowershelvesResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateFirmwareRequest) GetUpgrades() []*UpdatePowershelfFirmwareRequest {
+func (x *GetPowershelvesResponse) GetPowershelves() []*PowerShelf {
+	if x != nil {
+		return x.Powershelves
+	}
+	return nil
+}
+
+type UpdateComponentFirmwareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     PowershelfComponent    `protobuf:"varint,1,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
+	UpgradeTo     *FirmwareVersion       `protobuf:"bytes,2,opt,name=upgradeTo,proto3" json:"upgradeTo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateComponentFirmwareRequest) Reset() {
+	*x = UpdateComponentFirmwareRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateComponentFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateComponentFirmwareRequest) ProtoMessage() {}
+
+func (x *UpdateComponentFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateComponentFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*UpdateComponentFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateComponentFirmwareRequest) GetComponent() PowershelfComponent {
+	if x != nil {
+		return x.Component
+	}
+	return PowershelfComponent_PMC
+}
+
+func (x *UpdateComponentFirmwareRequest) GetUpgradeTo() *FirmwareVersion {
+	if x != nil {
+		return x.UpgradeTo
+	}
+	return nil
+}
+
+type UpdatePowershelfFirmwareRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	PmcMacAddress string                            `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	Components    []*UpdateComponentFirmwareRequest `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePowershelfFirmwareRequest) Reset() {
+	*x = UpdatePowershelfFirmwareRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePowershelfFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePowershelfFirmwareRequest) ProtoMessage() {}
+
+func (x *UpdatePowershelfFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePowershelfFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePowershelfFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdatePowershelfFirmwareRequest) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *UpdatePowershelfFirmwareRequest) GetComponents() []*UpdateComponentFirmwareRequest {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type UpdateFirmwareRequest struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Upgrades      []*UpdatePowershelfFirmwareRequest `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFirmwareRequest) Reset() {
+	*x = UpdateFirmwareRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirmwareRequest) ProtoMessage() {}
+
+func (x *UpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateFirmwareRequest) GetUpgrades() []*UpdatePowershelfFirmwareRequest {
 	if x != nil {
 		return x.Upgrades
 	}
@@ -1434,7 +1842,7 @@ type UpdateComponentFirmwareResponse struct {
 
 func (x *UpdateComponentFirmwareResponse) Reset() {
 	*x = UpdateComponentFirmwareResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[19]
+	mi := &file_powershelf_manager_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1446,7 +1854,7 @@ func (x *UpdateComponentFirmwareResponse) String() string {
 func (*UpdateComponentFirmwareResponse) ProtoMessage() {}
 
 func (x *UpdateComponentFirmwareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[19]
+	mi := &file_powershelf_manager_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +1867,7 @@ func (x *UpdateComponentFirmwareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateComponentFirmwareResponse.ProtoReflect.Descriptor instead.
 func (*UpdateComponentFirmwareResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{19}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateComponentFirmwareResponse) GetComponent() PowershelfComponent {
@@ -1493,7 +1901,7 @@ type UpdatePowershelfFirmwareResponse struct {
 
 func (x *UpdatePowershelfFirmwareResponse) Reset() {
 	*x = UpdatePowershelfFirmwareResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[20]
+	mi := &file_powershelf_manager_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1505,7 +1913,7 @@ func (x *UpdatePowershelfFirmwareResponse) String() string {
 func (*UpdatePowershelfFirmwareResponse) ProtoMessage() {}
 
 func (x *UpdatePowershelfFirmwareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[20]
+	mi := &file_powershelf_manager_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,7 +1926,7 @@ func (x *UpdatePowershelfFirmwareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePowershelfFirmwareResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePowershelfFirmwareResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{20}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdatePowershelfFirmwareResponse) GetPmcMacAddress() string {
@@ -1544,7 +1952,7 @@ type UpdateFirmwareResponse struct {
 
 func (x *UpdateFirmwareResponse) Reset() {
 	*x = UpdateFirmwareResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[21]
+	mi := &file_powershelf_manager_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1556,7 +1964,7 @@ func (x *UpdateFirmwareResponse) String() string {
 func (*UpdateFirmwareResponse) ProtoMessage() {}
 
 func (x *UpdateFirmwareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[21]
+	mi := &file_powershelf_manager_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,7 +1977,7 @@ func (x *UpdateFirmwareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFirmwareResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFirmwareResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{21}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpdateFirmwareResponse) GetResponses() []*UpdatePowershelfFirmwareResponse {
@@ -1588,7 +1996,7 @@ type CanUpdateFirmwareResponse struct {
 
 func (x *CanUpdateFirmwareResponse) Reset() {
 	*x = CanUpdateFirmwareResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[22]
+	mi := &file_powershelf_manager_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1600,7 +2008,7 @@ func (x *CanUpdateFirmwareResponse) String() string {
 func (*CanUpdateFirmwareResponse) ProtoMessage() {}
 
 func (x *CanUpdateFirmwareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[22]
+	mi := &file_powershelf_manager_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,7 +2021,7 @@ func (x *CanUpdateFirmwareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanUpdateFirmwareResponse.ProtoReflect.Descriptor instead.
 func (*CanUpdateFirmwareResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{22}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CanUpdateFirmwareResponse) GetCanUpdate() bool {
@@ -1632,7 +2040,7 @@ type FirmwareVersion struct {
 
 func (x *FirmwareVersion) Reset() {
 	*x = FirmwareVersion{}
-	mi := &file_powershelf_manager_proto_msgTypes[23]
+	mi := &file_powershelf_manager_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1644,7 +2052,7 @@ func (x *FirmwareVersion) String() string {
 func (*FirmwareVersion) ProtoMessage() {}
 
 func (x *FirmwareVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[23]
+	mi := &file_powershelf_manager_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,7 +2065,7 @@ func (x *FirmwareVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareVersion.ProtoReflect.Descriptor instead.
 func (*FirmwareVersion) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{23}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *FirmwareVersion) GetVersion() string {
@@ -1677,7 +2085,7 @@ type ComponentFirmwareUpgrades struct {
 
 func (x *ComponentFirmwareUpgrades) Reset() {
 	*x = ComponentFirmwareUpgrades{}
-	mi := &file_powershelf_manager_proto_msgTypes[24]
+	mi := &file_powershelf_manager_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1689,7 +2097,7 @@ func (x *ComponentFirmwareUpgrades) String() string {
 func (*ComponentFirmwareUpgrades) ProtoMessage() {}
 
 func (x *ComponentFirmwareUpgrades) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[24]
+	mi := &file_powershelf_manager_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1702,7 +2110,7 @@ func (x *ComponentFirmwareUpgrades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentFirmwareUpgrades.ProtoReflect.Descriptor instead.
 func (*ComponentFirmwareUpgrades) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{24}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ComponentFirmwareUpgrades) GetComponent() PowershelfComponent {
@@ -1729,7 +2137,7 @@ type AvailableFirmware struct {
 
 func (x *AvailableFirmware) Reset() {
 	*x = AvailableFirmware{}
-	mi := &file_powershelf_manager_proto_msgTypes[25]
+	mi := &file_powershelf_manager_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1741,7 +2149,7 @@ func (x *AvailableFirmware) String() string {
 func (*AvailableFirmware) ProtoMessage() {}
 
 func (x *AvailableFirmware) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[25]
+	mi := &file_powershelf_manager_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +2162,7 @@ func (x *AvailableFirmware) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvailableFirmware.ProtoReflect.Descriptor instead.
 func (*AvailableFirmware) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{25}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AvailableFirmware) GetPmcMacAddress() string {
@@ -1780,7 +2188,7 @@ type ListAvailableFirmwareResponse struct {
 
 func (x *ListAvailableFirmwareResponse) Reset() {
 	*x = ListAvailableFirmwareResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[26]
+	mi := &file_powershelf_manager_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1792,7 +2200,7 @@ func (x *ListAvailableFirmwareResponse) String() string {
 func (*ListAvailableFirmwareResponse) ProtoMessage() {}
 
 func (x *ListAvailableFirmwareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[26]
+	mi := &file_powershelf_manager_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,7 +2213,7 @@ func (x *ListAvailableFirmwareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAvailableFirmwareResponse.ProtoReflect.Descriptor instead.
 func (*ListAvailableFirmwareResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{26}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ListAvailableFirmwareResponse) GetUpgrades() []*AvailableFirmware {
@@ -1824,7 +2232,7 @@ type SetDryRunRequest struct {
 
 func (x *SetDryRunRequest) Reset() {
 	*x = SetDryRunRequest{}
-	mi := &file_powershelf_manager_proto_msgTypes[27]
+	mi := &file_powershelf_manager_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1836,7 +2244,7 @@ func (x *SetDryRunRequest) String() string {
 func (*SetDryRunRequest) ProtoMessage() {}
 
 func (x *SetDryRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[27]
+	mi := &file_powershelf_manager_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,7 +2257,7 @@ func (x *SetDryRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDryRunRequest.ProtoReflect.Descriptor instead.
 func (*SetDryRunRequest) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{27}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SetDryRunRequest) GetDryRun() bool {
@@ -1869,7 +2277,7 @@ type GetFirmwareUpdateStatusRequest struct {
 
 func (x *GetFirmwareUpdateStatusRequest) Reset() {
 	*x = GetFirmwareUpdateStatusRequest{}
-	mi := &file_powershelf_manager_proto_msgTypes[28]
+	mi := &file_powershelf_manager_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1881,7 +2289,7 @@ func (x *GetFirmwareUpdateStatusRequest) String() string {
 func (*GetFirmwareUpdateStatusRequest) ProtoMessage() {}
 
 func (x *GetFirmwareUpdateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[28]
+	mi := &file_powershelf_manager_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,7 +2302,7 @@ func (x *GetFirmwareUpdateStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirmwareUpdateStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetFirmwareUpdateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{28}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetFirmwareUpdateStatusRequest) GetQueries() []*FirmwareUpdateQuery {
@@ -1915,7 +2323,7 @@ type FirmwareUpdateQuery struct {
 
 func (x *FirmwareUpdateQuery) Reset() {
 	*x = FirmwareUpdateQuery{}
-	mi := &file_powershelf_manager_proto_msgTypes[29]
+	mi := &file_powershelf_manager_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1927,7 +2335,7 @@ func (x *FirmwareUpdateQuery) String() string {
 func (*FirmwareUpdateQuery) ProtoMessage() {}
 
 func (x *FirmwareUpdateQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[29]
+	mi := &file_powershelf_manager_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,7 +2348,7 @@ func (x *FirmwareUpdateQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareUpdateQuery.ProtoReflect.Descriptor instead.
 func (*FirmwareUpdateQuery) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{29}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *FirmwareUpdateQuery) GetPmcMacAddress() string {
@@ -1967,7 +2375,7 @@ type GetFirmwareUpdateStatusResponse struct {
 
 func (x *GetFirmwareUpdateStatusResponse) Reset() {
 	*x = GetFirmwareUpdateStatusResponse{}
-	mi := &file_powershelf_manager_proto_msgTypes[30]
+	mi := &file_powershelf_manager_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1979,7 +2387,7 @@ func (x *GetFirmwareUpdateStatusResponse) String() string {
 func (*GetFirmwareUpdateStatusResponse) ProtoMessage() {}
 
 func (x *GetFirmwareUpdateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[30]
+	mi := &file_powershelf_manager_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,7 +2400,7 @@ func (x *GetFirmwareUpdateStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirmwareUpdateStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetFirmwareUpdateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{30}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetFirmwareUpdateStatusResponse) GetStatuses() []*FirmwareUpdateStatus {
@@ -2016,7 +2424,7 @@ type FirmwareUpdateStatus struct {
 
 func (x *FirmwareUpdateStatus) Reset() {
 	*x = FirmwareUpdateStatus{}
-	mi := &file_powershelf_manager_proto_msgTypes[31]
+	mi := &file_powershelf_manager_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2028,7 +2436,7 @@ func (x *FirmwareUpdateStatus) String() string {
 func (*FirmwareUpdateStatus) ProtoMessage() {}
 
 func (x *FirmwareUpdateStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_powershelf_manager_proto_msgTypes[31]
+	mi := &file_powershelf_manager_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2449,7 @@ func (x *FirmwareUpdateStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareUpdateStatus.ProtoReflect.Descriptor instead.
 func (*FirmwareUpdateStatus) Descriptor() ([]byte, []int) {
-	return file_powershelf_manager_proto_rawDescGZIP(), []int{31}
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FirmwareUpdateStatus) GetPmcMacAddress() string {
@@ -2079,44 +2487,1485 @@ func (x *FirmwareUpdateStatus) GetError() string {
 	return ""
 }
 
-var File_powershelf_manager_proto protoreflect.FileDescriptor
+// GetPowershelfTelemetryRequest queries the telemetry history of specific PMC(s).
+// Samples older than since (or older than the service retention window) are omitted.
+type GetPowershelfTelemetryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacs       []string               `protobuf:"bytes,1,rep,name=pmc_macs,json=pmcMacs,proto3" json:"pmc_macs,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_powershelf_manager_proto_rawDesc = "" +
-	"\n" +
-	"\x18powershelf-manager.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
-	"\vCredentials\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd8\x02\n" +
-	"\x19PowerManagementController\x12\x1f\n" +
-	"\vmac_address\x18\x01 \x01(\tR\n" +
-	"macAddress\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x12%\n" +
-	"\x06vendor\x18\x03 \x01(\x0e2\r.v1.PMCVendorR\x06vendor\x12#\n" +
-	"\rserial_number\x18\x04 \x01(\tR\fserialNumber\x12\x14\n" +
-	"\x05model\x18\x05 \x01(\tR\x05model\x12\"\n" +
-	"\fmanufacturer\x18\x06 \x01(\tR\fmanufacturer\x12\x1f\n" +
-	"\vpart_number\x18\a \x01(\tR\n" +
-	"partNumber\x12)\n" +
-	"\x10firmware_version\x18\b \x01(\tR\x0ffirmwareVersion\x12)\n" +
-	"\x10hardware_version\x18\t \x01(\tR\x0fhardwareVersion\"h\n" +
-	"\aChassis\x12#\n" +
-	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\x12\x14\n" +
-	"\x05model\x18\x02 \x01(\tR\x05model\x12\"\n" +
-	"\fmanufacturer\x18\x03 \x01(\tR\fmanufacturer\"+\n" +
-	"\x0fSensorThreshold\x12\x18\n" +
-	"\areading\x18\x01 \x01(\x02R\areading\"\xfe\x01\n" +
-	"\x10SensorThresholds\x128\n" +
-	"\rlower_caution\x18\x01 \x01(\v2\x13.v1.SensorThresholdR\flowerCaution\x12:\n" +
-	"\x0elower_critical\x18\x02 \x01(\v2\x13.v1.SensorThresholdR\rlowerCritical\x128\n" +
-	"\rupper_caution\x18\x03 \x01(\v2\x13.v1.SensorThresholdR\fupperCaution\x12:\n" +
-	"\x0eupper_critical\x18\x04 \x01(\v2\x13.v1.SensorThresholdR\rupperCritical\"\x9c\x02\n" +
-	"\x06Sensor\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
-	"\areading\x18\x03 \x01(\x02R\areading\x12*\n" +
-	"\x11reading_range_max\x18\x04 \x01(\x01R\x0freadingRangeMax\x12*\n" +
-	"\x11reading_range_min\x18\x05 \x01(\x02R\x0freadingRangeMin\x12!\n" +
+func (x *GetPowershelfTelemetryRequest) Reset() {
+	*x = GetPowershelfTelemetryRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPowershelfTelemetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPowershelfTelemetryRequest) ProtoMessage() {}
+
+func (x *GetPowershelfTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPowershelfTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*GetPowershelfTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPowershelfTelemetryRequest) GetPmcMacs() []string {
+	if x != nil {
+		return x.PmcMacs
+	}
+	return nil
+}
+
+func (x *GetPowershelfTelemetryRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// TelemetryReadings contains the readings of a PSU, or a powershelf aggregate across its PSUs
+// (power and current summed, voltage averaged, temperature and fan speed maximum).
+// Readings without a corresponding sensor are unset.
+type TelemetryReadings struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	InputPowerWatts    *float64               `protobuf:"fixed64,1,opt,name=input_power_watts,json=inputPowerWatts,proto3,oneof" json:"input_power_watts,omitempty"`
+	OutputPowerWatts   *float64               `protobuf:"fixed64,2,opt,name=output_power_watts,json=outputPowerWatts,proto3,oneof" json:"output_power_watts,omitempty"`
+	InputVoltageVolts  *float64               `protobuf:"fixed64,3,opt,name=input_voltage_volts,json=inputVoltageVolts,proto3,oneof" json:"input_voltage_volts,omitempty"`
+	OutputVoltageVolts *float64               `protobuf:"fixed64,4,opt,name=output_voltage_volts,json=outputVoltageVolts,proto3,oneof" json:"output_voltage_volts,omitempty"`
+	InputCurrentAmps   *float64               `protobuf:"fixed64,5,opt,name=input_current_amps,json=inputCurrentAmps,proto3,oneof" json:"input_current_amps,omitempty"`
+	OutputCurrentAmps  *float64               `protobuf:"fixed64,6,opt,name=output_current_amps,json=outputCurrentAmps,proto3,oneof" json:"output_current_amps,omitempty"`
+	TemperatureCelsius *float64               `protobuf:"fixed64,7,opt,name=temperature_celsius,json=temperatureCelsius,proto3,oneof" json:"temperature_celsius,omitempty"`
+	FanSpeedRpm        *float64               `protobuf:"fixed64,8,opt,name=fan_speed_rpm,json=fanSpeedRpm,proto3,oneof" json:"fan_speed_rpm,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TelemetryReadings) Reset() {
+	*x = TelemetryReadings{}
+	mi := &file_powershelf_manager_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelemetryReadings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemetryReadings) ProtoMessage() {}
+
+func (x *TelemetryReadings) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemetryReadings.ProtoReflect.Descriptor instead.
+func (*TelemetryReadings) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TelemetryReadings) GetInputPowerWatts() float64 {
+	if x != nil && x.InputPowerWatts != nil {
+		return *x.InputPowerWatts
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetOutputPowerWatts() float64 {
+	if x != nil && x.OutputPowerWatts != nil {
+		return *x.OutputPowerWatts
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetInputVoltageVolts() float64 {
+	if x != nil && x.InputVoltageVolts != nil {
+		return *x.InputVoltageVolts
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetOutputVoltageVolts() float64 {
+	if x != nil && x.OutputVoltageVolts != nil {
+		return *x.OutputVoltageVolts
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetInputCurrentAmps() float64 {
+	if x != nil && x.InputCurrentAmps != nil {
+		return *x.InputCurrentAmps
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetOutputCurrentAmps() float64 {
+	if x != nil && x.OutputCurrentAmps != nil {
+		return *x.OutputCurrentAmps
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetTemperatureCelsius() float64 {
+	if x != nil && x.TemperatureCelsius != nil {
+		return *x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *TelemetryReadings) GetFanSpeedRpm() float64 {
+	if x != nil && x.FanSpeedRpm != nil {
+		return *x.FanSpeedRpm
+	}
+	return 0
+}
+
+// PowerSupplyTelemetry contains the readings of a single PSU.
+type PowerSupplyTelemetry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PowerState    bool                   `protobuf:"varint,3,opt,name=power_state,json=powerState,proto3" json:"power_state,omitempty"`
+	Health        string                 `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	Readings      *TelemetryReadings     `protobuf:"bytes,5,opt,name=readings,proto3" json:"readings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerSupplyTelemetry) Reset() {
+	*x = PowerSupplyTelemetry{}
+	mi := &file_powershelf_manager_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerSupplyTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerSupplyTelemetry) ProtoMessage() {}
+
+func (x *PowerSupplyTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerSupplyTelemetry.ProtoReflect.Descriptor instead.
+func (*PowerSupplyTelemetry) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PowerSupplyTelemetry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PowerSupplyTelemetry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PowerSupplyTelemetry) GetPowerState() bool {
+	if x != nil {
+		return x.PowerState
+	}
+	return false
+}
+
+func (x *PowerSupplyTelemetry) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *PowerSupplyTelemetry) GetReadings() *TelemetryReadings {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+// TelemetrySample contains the readings of a powershelf and its PSUs at a point in time.
+type TelemetrySample struct {
+	state     protoimpl.MessageState  `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Readings  *TelemetryReadings      `protobuf:"bytes,2,opt,name=readings,proto3" json:"readings,omitempty"`
+	Psus      []*PowerSupplyTelemetry `protobuf:"bytes,3,rep,name=psus,proto3" json:"psus,omitempty"`
+	// Power cap in watts (0 if capping is disabled). Unset if the PMC does not support power capping.
+	PowerLimitWatts *float64 `protobuf:"fixed64,4,opt,name=power_limit_watts,json=powerLimitWatts,proto3,oneof" json:"power_limit_watts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TelemetrySample) Reset() {
+	*x = TelemetrySample{}
+	mi := &file_powershelf_manager_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelemetrySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemetrySample) ProtoMessage() {}
+
+func (x *TelemetrySample) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemetrySample.ProtoReflect.Descriptor instead.
+func (*TelemetrySample) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TelemetrySample) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TelemetrySample) GetReadings() *TelemetryReadings {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+func (x *TelemetrySample) GetPsus() []*PowerSupplyTelemetry {
+	if x != nil {
+		return x.Psus
+	}
+	return nil
+}
+
+func (x *TelemetrySample) GetPowerLimitWatts() float64 {
+	if x != nil && x.PowerLimitWatts != nil {
+		return *x.PowerLimitWatts
+	}
+	return 0
+}
+
+// PowershelfTelemetry contains the telemetry history of a powershelf, oldest sample first.
+type PowershelfTelemetry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacAddress string                 `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	Vendor        PMCVendor              `protobuf:"varint,2,opt,name=vendor,proto3,enum=v1.PMCVendor" json:"vendor,omitempty"`
+	Rack          string                 `protobuf:"bytes,3,opt,name=rack,proto3" json:"rack,omitempty"`
+	Samples       []*TelemetrySample     `protobuf:"bytes,4,rep,name=samples,proto3" json:"samples,omitempty"`
+	Status        StatusCode             `protobuf:"varint,5,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowershelfTelemetry) Reset() {
+	*x = PowershelfTelemetry{}
+	mi := &file_powershelf_manager_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowershelfTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowershelfTelemetry) ProtoMessage() {}
+
+func (x *PowershelfTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowershelfTelemetry.ProtoReflect.Descriptor instead.
+func (*PowershelfTelemetry) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PowershelfTelemetry) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *PowershelfTelemetry) GetVendor() PMCVendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return PMCVendor_PMC_TYPE_UNKNOWN
+}
+
+func (x *PowershelfTelemetry) GetRack() string {
+	if x != nil {
+		return x.Rack
+	}
+	return ""
+}
+
+func (x *PowershelfTelemetry) GetSamples() []*TelemetrySample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *PowershelfTelemetry) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *PowershelfTelemetry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GetPowershelfTelemetryResponse contains the telemetry history of the requested powershelves.
+type GetPowershelfTelemetryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Telemetry     []*PowershelfTelemetry `protobuf:"bytes,1,rep,name=telemetry,proto3" json:"telemetry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPowershelfTelemetryResponse) Reset() {
+	*x = GetPowershelfTelemetryResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPowershelfTelemetryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPowershelfTelemetryResponse) ProtoMessage() {}
+
+func (x *GetPowershelfTelemetryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPowershelfTelemetryResponse.ProtoReflect.Descriptor instead.
+func (*GetPowershelfTelemetryResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPowershelfTelemetryResponse) GetTelemetry() []*PowershelfTelemetry {
+	if x != nil {
+		return x.Telemetry
+	}
+	return nil
+}
+
+// RotateCredentialsResponse reports, per powershelf, whether a credential rotation was started.
+type RotateCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Responses     []*PowershelfResponse  `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsResponse) Reset() {
+	*x = RotateCredentialsResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsResponse) ProtoMessage() {}
+
+func (x *RotateCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RotateCredentialsResponse) GetResponses() []*PowershelfResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// CredentialRotationStatus contains the latest credential rotation of a PMC.
+type CredentialRotationStatus struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PmcMacAddress string                  `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	State         CredentialRotationState `protobuf:"varint,2,opt,name=state,proto3,enum=v1.CredentialRotationState" json:"state,omitempty"`
+	LastAttempt   *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastRotated   *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=last_rotated,json=lastRotated,proto3" json:"last_rotated,omitempty"`
+	// Unset if scheduled rotation is disabled.
+	NextRotation  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_rotation,json=nextRotation,proto3" json:"next_rotation,omitempty"`
+	RotationError string                 `protobuf:"bytes,6,opt,name=rotation_error,json=rotationError,proto3" json:"rotation_error,omitempty"` // Error of the latest rotation, if it failed
+	Status        StatusCode             `protobuf:"varint,7,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`                // Request status (SUCCESS if found, error otherwise)
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                                      // Request error message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialRotationStatus) Reset() {
+	*x = CredentialRotationStatus{}
+	mi := &file_powershelf_manager_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialRotationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialRotationStatus) ProtoMessage() {}
+
+func (x *CredentialRotationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialRotationStatus.ProtoReflect.Descriptor instead.
+func (*CredentialRotationStatus) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CredentialRotationStatus) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *CredentialRotationStatus) GetState() CredentialRotationState {
+	if x != nil {
+		return x.State
+	}
+	return CredentialRotationState_CREDENTIAL_ROTATION_STATE_UNKNOWN
+}
+
+func (x *CredentialRotationStatus) GetLastAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetLastRotated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotated
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetNextRotation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRotation
+	}
+	return nil
+}
+
+func (x *CredentialRotationStatus) GetRotationError() string {
+	if x != nil {
+		return x.RotationError
+	}
+	return ""
+}
+
+func (x *CredentialRotationStatus) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *CredentialRotationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GetCredentialRotationStatusResponse contains the latest credential rotation of the requested powershelves.
+type GetCredentialRotationStatusResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Statuses      []*CredentialRotationStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCredentialRotationStatusResponse) Reset() {
+	*x = GetCredentialRotationStatusResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialRotationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialRotationStatusResponse) ProtoMessage() {}
+
+func (x *GetCredentialRotationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCredentialRotationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCredentialRotationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCredentialRotationStatusResponse) GetStatuses() []*CredentialRotationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// StreamEventsRequest selects the events streamed by StreamEvents.
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacs       []string               `protobuf:"bytes,1,rep,name=pmc_macs,json=pmcMacs,proto3" json:"pmc_macs,omitempty"`                  // All powershelves if empty
+	Kinds         []PowershelfEventKind  `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=v1.PowershelfEventKind" json:"kinds,omitempty"` // All kinds if empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StreamEventsRequest) GetPmcMacs() []string {
+	if x != nil {
+		return x.PmcMacs
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetKinds() []PowershelfEventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// PowershelfEvent is a Redfish event reported by a PMC.
+type PowershelfEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacAddress string                 `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	Kind          PowershelfEventKind    `protobuf:"varint,2,opt,name=kind,proto3,enum=v1.PowershelfEventKind" json:"kind,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`                    // Redfish severity: OK, Warning or Critical
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Redfish MessageId, e.g. ResourceEvent.1.3.ResourceStatusChangedCritical
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Origin        string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"` // URI of the resource the event is about
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowershelfEvent) Reset() {
+	*x = PowershelfEvent{}
+	mi := &file_powershelf_manager_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowershelfEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowershelfEvent) ProtoMessage() {}
+
+func (x *PowershelfEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowershelfEvent.ProtoReflect.Descriptor instead.
+func (*PowershelfEvent) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PowershelfEvent) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *PowershelfEvent) GetKind() PowershelfEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowershelfEventKind_POWERSHELF_EVENT_KIND_UNKNOWN
+}
+
+func (x *PowershelfEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *PowershelfEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *PowershelfEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PowershelfEvent) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *PowershelfEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// FirmwareBaseline is the desired firmware version of a component for a PMC vendor and, optionally, a model.
+type FirmwareBaseline struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Vendor        PMCVendor              `protobuf:"varint,2,opt,name=vendor,proto3,enum=v1.PMCVendor" json:"vendor,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"` // Any model if empty; a baseline for a model takes precedence
+	Component     PowershelfComponent    `protobuf:"varint,4,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
+	Version       string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FirmwareBaseline) Reset() {
+	*x = FirmwareBaseline{}
+	mi := &file_powershelf_manager_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirmwareBaseline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareBaseline) ProtoMessage() {}
+
+func (x *FirmwareBaseline) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareBaseline.ProtoReflect.Descriptor instead.
+func (*FirmwareBaseline) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FirmwareBaseline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FirmwareBaseline) GetVendor() PMCVendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return PMCVendor_PMC_TYPE_UNKNOWN
+}
+
+func (x *FirmwareBaseline) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *FirmwareBaseline) GetComponent() PowershelfComponent {
+	if x != nil {
+		return x.Component
+	}
+	return PowershelfComponent_PMC
+}
+
+func (x *FirmwareBaseline) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *FirmwareBaseline) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FirmwareBaseline) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetFirmwareBaselineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Baseline      *FirmwareBaseline      `protobuf:"bytes,1,opt,name=baseline,proto3" json:"baseline,omitempty"` // Timestamps are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFirmwareBaselineRequest) Reset() {
+	*x = SetFirmwareBaselineRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFirmwareBaselineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFirmwareBaselineRequest) ProtoMessage() {}
+
+func (x *SetFirmwareBaselineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFirmwareBaselineRequest.ProtoReflect.Descriptor instead.
+func (*SetFirmwareBaselineRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetFirmwareBaselineRequest) GetBaseline() *FirmwareBaseline {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+type DeleteFirmwareBaselineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFirmwareBaselineRequest) Reset() {
+	*x = DeleteFirmwareBaselineRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFirmwareBaselineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFirmwareBaselineRequest) ProtoMessage() {}
+
+func (x *DeleteFirmwareBaselineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFirmwareBaselineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFirmwareBaselineRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteFirmwareBaselineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListFirmwareBaselinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Baselines     []*FirmwareBaseline    `protobuf:"bytes,1,rep,name=baselines,proto3" json:"baselines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFirmwareBaselinesResponse) Reset() {
+	*x = ListFirmwareBaselinesResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFirmwareBaselinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFirmwareBaselinesResponse) ProtoMessage() {}
+
+func (x *ListFirmwareBaselinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFirmwareBaselinesResponse.ProtoReflect.Descriptor instead.
+func (*ListFirmwareBaselinesResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListFirmwareBaselinesResponse) GetBaselines() []*FirmwareBaseline {
+	if x != nil {
+		return x.Baselines
+	}
+	return nil
+}
+
+// FirmwareComplianceEntry is the compliance of a firmware inventory item of a powershelf.
+type FirmwareComplianceEntry struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Component       PowershelfComponent     `protobuf:"varint,1,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
+	ItemId          string                  `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // Redfish firmware inventory ID, e.g. BMC or PSU0
+	Model           string                  `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Baseline        string                  `protobuf:"bytes,4,opt,name=baseline,proto3" json:"baseline,omitempty"` // Name of the applying baseline, empty if none
+	ExpectedVersion string                  `protobuf:"bytes,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	ActualVersion   string                  `protobuf:"bytes,6,opt,name=actual_version,json=actualVersion,proto3" json:"actual_version,omitempty"`
+	State           FirmwareComplianceState `protobuf:"varint,7,opt,name=state,proto3,enum=v1.FirmwareComplianceState" json:"state,omitempty"`
+	Remediation     string                  `protobuf:"bytes,8,opt,name=remediation,proto3" json:"remediation,omitempty"` // Upgrade queued by auto-remediation, or why none was queued
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FirmwareComplianceEntry) Reset() {
+	*x = FirmwareComplianceEntry{}
+	mi := &file_powershelf_manager_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirmwareComplianceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareComplianceEntry) ProtoMessage() {}
+
+func (x *FirmwareComplianceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareComplianceEntry.ProtoReflect.Descriptor instead.
+func (*FirmwareComplianceEntry) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FirmwareComplianceEntry) GetComponent() PowershelfComponent {
+	if x != nil {
+		return x.Component
+	}
+	return PowershelfComponent_PMC
+}
+
+func (x *FirmwareComplianceEntry) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FirmwareComplianceEntry) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *FirmwareComplianceEntry) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+func (x *FirmwareComplianceEntry) GetExpectedVersion() string {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return ""
+}
+
+func (x *FirmwareComplianceEntry) GetActualVersion() string {
+	if x != nil {
+		return x.ActualVersion
+	}
+	return ""
+}
+
+func (x *FirmwareComplianceEntry) GetState() FirmwareComplianceState {
+	if x != nil {
+		return x.State
+	}
+	return FirmwareComplianceState_FIRMWARE_COMPLIANCE_STATE_UNKNOWN
+}
+
+func (x *FirmwareComplianceEntry) GetRemediation() string {
+	if x != nil {
+		return x.Remediation
+	}
+	return ""
+}
+
+// PowershelfFirmwareCompliance is the firmware compliance of a powershelf.
+type PowershelfFirmwareCompliance struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	PmcMacAddress string                     `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	Compliant     bool                       `protobuf:"varint,2,opt,name=compliant,proto3" json:"compliant,omitempty"`
+	Entries       []*FirmwareComplianceEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Status        StatusCode                 `protobuf:"varint,4,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"` // SUCCESS if the firmware inventory was read
+	Error         string                     `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowershelfFirmwareCompliance) Reset() {
+	*x = PowershelfFirmwareCompliance{}
+	mi := &file_powershelf_manager_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowershelfFirmwareCompliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowershelfFirmwareCompliance) ProtoMessage() {}
+
+func (x *PowershelfFirmwareCompliance) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowershelfFirmwareCompliance.ProtoReflect.Descriptor instead.
+func (*PowershelfFirmwareCompliance) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PowershelfFirmwareCompliance) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *PowershelfFirmwareCompliance) GetCompliant() bool {
+	if x != nil {
+		return x.Compliant
+	}
+	return false
+}
+
+func (x *PowershelfFirmwareCompliance) GetEntries() []*FirmwareComplianceEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *PowershelfFirmwareCompliance) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *PowershelfFirmwareCompliance) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetFirmwareComplianceReportResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	GeneratedAt   *timestamppb.Timestamp          `protobuf:"bytes,1,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Powershelves  []*PowershelfFirmwareCompliance `protobuf:"bytes,2,rep,name=powershelves,proto3" json:"powershelves,omitempty"`
+	NonCompliant  int32                           `protobuf:"varint,3,opt,name=non_compliant,json=nonCompliant,proto3" json:"non_compliant,omitempty"` // Number of powershelves that are not compliant or could not be checked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirmwareComplianceReportResponse) Reset() {
+	*x = GetFirmwareComplianceReportResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirmwareComplianceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirmwareComplianceReportResponse) ProtoMessage() {}
+
+func (x *GetFirmwareComplianceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirmwareComplianceReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFirmwareComplianceReportResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetFirmwareComplianceReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *GetFirmwareComplianceReportResponse) GetPowershelves() []*PowershelfFirmwareCompliance {
+	if x != nil {
+		return x.Powershelves
+	}
+	return nil
+}
+
+func (x *GetFirmwareComplianceReportResponse) GetNonCompliant() int32 {
+	if x != nil {
+		return x.NonCompliant
+	}
+	return 0
+}
+
+// GetFirmwareDriftHistoryRequest selects the drift events returned by GetFirmwareDriftHistory.
+type GetFirmwareDriftHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacs       []string               `protobuf:"bytes,1,rep,name=pmc_macs,json=pmcMacs,proto3" json:"pmc_macs,omitempty"` // All powershelves if empty
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`                    // All events if unset
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                   // No limit if zero
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirmwareDriftHistoryRequest) Reset() {
+	*x = GetFirmwareDriftHistoryRequest{}
+	mi := &file_powershelf_manager_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirmwareDriftHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirmwareDriftHistoryRequest) ProtoMessage() {}
+
+func (x *GetFirmwareDriftHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirmwareDriftHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFirmwareDriftHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetFirmwareDriftHistoryRequest) GetPmcMacs() []string {
+	if x != nil {
+		return x.PmcMacs
+	}
+	return nil
+}
+
+func (x *GetFirmwareDriftHistoryRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetFirmwareDriftHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FirmwareDriftEvent records a firmware inventory item drifting from its baseline, or returning to it.
+type FirmwareDriftEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PmcMacAddress   string                 `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	Component       PowershelfComponent    `protobuf:"varint,2,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
+	ItemId          string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Baseline        string                 `protobuf:"bytes,4,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	ExpectedVersion string                 `protobuf:"bytes,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	ActualVersion   string                 `protobuf:"bytes,6,opt,name=actual_version,json=actualVersion,proto3" json:"actual_version,omitempty"`
+	Compliant       bool                   `protobuf:"varint,7,opt,name=compliant,proto3" json:"compliant,omitempty"` // True if the item returned to its baseline
+	DetectedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FirmwareDriftEvent) Reset() {
+	*x = FirmwareDriftEvent{}
+	mi := &file_powershelf_manager_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirmwareDriftEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareDriftEvent) ProtoMessage() {}
+
+func (x *FirmwareDriftEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareDriftEvent.ProtoReflect.Descriptor instead.
+func (*FirmwareDriftEvent) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FirmwareDriftEvent) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *FirmwareDriftEvent) GetComponent() PowershelfComponent {
+	if x != nil {
+		return x.Component
+	}
+	return PowershelfComponent_PMC
+}
+
+func (x *FirmwareDriftEvent) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FirmwareDriftEvent) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+func (x *FirmwareDriftEvent) GetExpectedVersion() string {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return ""
+}
+
+func (x *FirmwareDriftEvent) GetActualVersion() string {
+	if x != nil {
+		return x.ActualVersion
+	}
+	return ""
+}
+
+func (x *FirmwareDriftEvent) GetCompliant() bool {
+	if x != nil {
+		return x.Compliant
+	}
+	return false
+}
+
+func (x *FirmwareDriftEvent) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+type GetFirmwareDriftHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*FirmwareDriftEvent  `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Most recent first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirmwareDriftHistoryResponse) Reset() {
+	*x = GetFirmwareDriftHistoryResponse{}
+	mi := &file_powershelf_manager_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirmwareDriftHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirmwareDriftHistoryResponse) ProtoMessage() {}
+
+func (x *GetFirmwareDriftHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powershelf_manager_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirmwareDriftHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFirmwareDriftHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_powershelf_manager_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetFirmwareDriftHistoryResponse) GetEvents() []*FirmwareDriftEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_powershelf_manager_proto protoreflect.FileDescriptor
+
+const file_powershelf_manager_proto_rawDesc = "" +
+	"\n" +
+	"\x18powershelf-manager.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
+	"\vCredentials\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd8\x02\n" +
+	"\x19PowerManagementController\x12\x1f\n" +
+	"\vmac_address\x18\x01 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12%\n" +
+	"\x06vendor\x18\x03 \x01(\x0e2\r.v1.PMCVendorR\x06vendor\x12#\n" +
+	"\rserial_number\x18\x04 \x01(\tR\fserialNumber\x12\x14\n" +
+	"\x05model\x18\x05 \x01(\tR\x05model\x12\"\n" +
+	"\fmanufacturer\x18\x06 \x01(\tR\fmanufacturer\x12\x1f\n" +
+	"\vpart_number\x18\a \x01(\tR\n" +
+	"partNumber\x12)\n" +
+	"\x10firmware_version\x18\b \x01(\tR\x0ffirmwareVersion\x12)\n" +
+	"\x10hardware_version\x18\t \x01(\tR\x0fhardwareVersion\"h\n" +
+	"\aChassis\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\"\n" +
+	"\fmanufacturer\x18\x03 \x01(\tR\fmanufacturer\"+\n" +
+	"\x0fSensorThreshold\x12\x18\n" +
+	"\areading\x18\x01 \x01(\x02R\areading\"\xfe\x01\n" +
+	"\x10SensorThresholds\x128\n" +
+	"\rlower_caution\x18\x01 \x01(\v2\x13.v1.SensorThresholdR\flowerCaution\x12:\n" +
+	"\x0elower_critical\x18\x02 \x01(\v2\x13.v1.SensorThresholdR\rlowerCritical\x128\n" +
+	"\rupper_caution\x18\x03 \x01(\v2\x13.v1.SensorThresholdR\fupperCaution\x12:\n" +
+	"\x0eupper_critical\x18\x04 \x01(\v2\x13.v1.SensorThresholdR\rupperCritical\"\x9c\x02\n" +
+	"\x06Sensor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\areading\x18\x03 \x01(\x02R\areading\x12*\n" +
+	"\x11reading_range_max\x18\x04 \x01(\x01R\x0freadingRangeMax\x12*\n" +
+	"\x11reading_range_min\x18\x05 \x01(\x02R\x0freadingRangeMin\x12!\n" +
 	"\freading_type\x18\x06 \x01(\tR\vreadingType\x12#\n" +
 	"\rreading_units\x18\a \x01(\tR\freadingUnits\x124\n" +
 	"\n" +
@@ -2135,12 +3984,14 @@ const file_powershelf_manager_proto_rawDesc = "" +
 	"\asensors\x18\t \x03(\v2\n" +
 	".v1.SensorR\asensors\x12#\n" +
 	"\rserial_number\x18\n" +
-	" \x01(\tR\fserialNumber\"\x8d\x01\n" +
+	" \x01(\tR\fserialNumber\"\xd4\x01\n" +
 	"\n" +
 	"PowerShelf\x12/\n" +
 	"\x03pmc\x18\x01 \x01(\v2\x1d.v1.PowerManagementControllerR\x03pmc\x12%\n" +
 	"\achassis\x18\x02 \x01(\v2\v.v1.ChassisR\achassis\x12'\n" +
-	"\x04psus\x18\x03 \x03(\v2\x13.v1.PowerSupplyUnitR\x04psus\"\xd1\x01\n" +
+	"\x04psus\x18\x03 \x03(\v2\x13.v1.PowerSupplyUnitR\x04psus\x12/\n" +
+	"\x11power_limit_watts\x18\x04 \x01(\x01H\x00R\x0fpowerLimitWatts\x88\x01\x01B\x14\n" +
+	"\x12_power_limit_watts\"\xd1\x01\n" +
 	"\x19RegisterPowershelfRequest\x12&\n" +
 	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12$\n" +
 	"\x0epmc_ip_address\x18\x02 \x01(\tR\fpmcIpAddress\x12,\n" +
@@ -2158,13 +4009,29 @@ const file_powershelf_manager_proto_rawDesc = "" +
 	"\x1cRegisterPowershelvesResponse\x12<\n" +
 	"\tresponses\x18\x01 \x03(\v2\x1e.v1.RegisterPowershelfResponseR\tresponses\".\n" +
 	"\x11PowershelfRequest\x12\x19\n" +
-	"\bpmc_macs\x18\x01 \x03(\tR\apmcMacs\"z\n" +
+	"\bpmc_macs\x18\x01 \x03(\tR\apmcMacs\"T\n" +
+	"\fPowerRequest\x12\x19\n" +
+	"\bpmc_macs\x18\x01 \x03(\tR\apmcMacs\x12)\n" +
+	"\atargets\x18\x02 \x03(\v2\x0f.v1.PowerTargetR\atargets\"\x91\x01\n" +
 	"\x12PowershelfResponse\x12&\n" +
 	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12&\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"L\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x15\n" +
+	"\x06pmc_ip\x18\x04 \x01(\tR\x05pmcIp\"L\n" +
 	"\x14PowerControlResponse\x124\n" +
-	"\tresponses\x18\x01 \x03(\v2\x16.v1.PowershelfResponseR\tresponses\"M\n" +
+	"\tresponses\x18\x01 \x03(\v2\x16.v1.PowershelfResponseR\tresponses\"\x8c\x01\n" +
+	"\vPowerTarget\x12\x15\n" +
+	"\x06pmc_ip\x18\x01 \x01(\tR\x05pmcIp\x128\n" +
+	"\x0fpmc_credentials\x18\x02 \x01(\v2\x0f.v1.CredentialsR\x0epmcCredentials\x12,\n" +
+	"\n" +
+	"pmc_vendor\x18\x03 \x01(\x0e2\r.v1.PMCVendorR\tpmcVendor\">\n" +
+	"\x14SetPowerLimitRequest\x12&\n" +
+	"\x06limits\x18\x01 \x03(\v2\x0e.v1.PowerLimitR\x06limits\"U\n" +
+	"\n" +
+	"PowerLimit\x12&\n" +
+	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12\x1f\n" +
+	"\vlimit_watts\x18\x02 \x01(\x01R\n" +
+	"limitWatts\"M\n" +
 	"\x17GetPowershelvesResponse\x122\n" +
 	"\fpowershelves\x18\x01 \x03(\v2\x0e.v1.PowerShelfR\fpowershelves\"\x8a\x01\n" +
 	"\x1eUpdateComponentFirmwareRequest\x125\n" +
@@ -2215,15 +4082,135 @@ const file_powershelf_manager_proto_rawDesc = "" +
 	"\tcomponent\x18\x02 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12-\n" +
 	"\x05state\x18\x03 \x01(\x0e2\x17.v1.FirmwareUpdateStateR\x05state\x12&\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
-	"\x05error\x18\x05 \x01(\tR\x05error*6\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"l\n" +
+	"\x1dGetPowershelfTelemetryRequest\x12\x19\n" +
+	"\bpmc_macs\x18\x01 \x03(\tR\apmcMacs\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\xe1\x04\n" +
+	"\x11TelemetryReadings\x12/\n" +
+	"\x11input_power_watts\x18\x01 \x01(\x01H\x00R\x0finputPowerWatts\x88\x01\x01\x121\n" +
+	"\x12output_power_watts\x18\x02 \x01(\x01H\x01R\x10outputPowerWatts\x88\x01\x01\x123\n" +
+	"\x13input_voltage_volts\x18\x03 \x01(\x01H\x02R\x11inputVoltageVolts\x88\x01\x01\x125\n" +
+	"\x14output_voltage_volts\x18\x04 \x01(\x01H\x03R\x12outputVoltageVolts\x88\x01\x01\x121\n" +
+	"\x12input_current_amps\x18\x05 \x01(\x01H\x04R\x10inputCurrentAmps\x88\x01\x01\x123\n" +
+	"\x13output_current_amps\x18\x06 \x01(\x01H\x05R\x11outputCurrentAmps\x88\x01\x01\x124\n" +
+	"\x13temperature_celsius\x18\a \x01(\x01H\x06R\x12temperatureCelsius\x88\x01\x01\x12'\n" +
+	"\rfan_speed_rpm\x18\b \x01(\x01H\aR\vfanSpeedRpm\x88\x01\x01B\x14\n" +
+	"\x12_input_power_wattsB\x15\n" +
+	"\x13_output_power_wattsB\x16\n" +
+	"\x14_input_voltage_voltsB\x17\n" +
+	"\x15_output_voltage_voltsB\x15\n" +
+	"\x13_input_current_ampsB\x16\n" +
+	"\x14_output_current_ampsB\x16\n" +
+	"\x14_temperature_celsiusB\x10\n" +
+	"\x0e_fan_speed_rpm\"\xa6\x01\n" +
+	"\x14PowerSupplyTelemetry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vpower_state\x18\x03 \x01(\bR\n" +
+	"powerState\x12\x16\n" +
+	"\x06health\x18\x04 \x01(\tR\x06health\x121\n" +
+	"\breadings\x18\x05 \x01(\v2\x15.v1.TelemetryReadingsR\breadings\"\xf3\x01\n" +
+	"\x0fTelemetrySample\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x121\n" +
+	"\breadings\x18\x02 \x01(\v2\x15.v1.TelemetryReadingsR\breadings\x12,\n" +
+	"\x04psus\x18\x03 \x03(\v2\x18.v1.PowerSupplyTelemetryR\x04psus\x12/\n" +
+	"\x11power_limit_watts\x18\x04 \x01(\x01H\x00R\x0fpowerLimitWatts\x88\x01\x01B\x14\n" +
+	"\x12_power_limit_watts\"\xe5\x01\n" +
+	"\x13PowershelfTelemetry\x12&\n" +
+	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12%\n" +
+	"\x06vendor\x18\x02 \x01(\x0e2\r.v1.PMCVendorR\x06vendor\x12\x12\n" +
+	"\x04rack\x18\x03 \x01(\tR\x04rack\x12-\n" +
+	"\asamples\x18\x04 \x03(\v2\x13.v1.TelemetrySampleR\asamples\x12&\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"W\n" +
+	"\x1eGetPowershelfTelemetryResponse\x125\n" +
+	"\ttelemetry\x18\x01 \x03(\v2\x17.v1.PowershelfTelemetryR\ttelemetry\"Q\n" +
+	"\x19RotateCredentialsResponse\x124\n" +
+	"\tresponses\x18\x01 \x03(\v2\x16.v1.PowershelfResponseR\tresponses\"\x99\x03\n" +
+	"\x18CredentialRotationStatus\x12&\n" +
+	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x121\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1b.v1.CredentialRotationStateR\x05state\x12=\n" +
+	"\flast_attempt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastAttempt\x12=\n" +
+	"\flast_rotated\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlastRotated\x12?\n" +
+	"\rnext_rotation\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fnextRotation\x12%\n" +
+	"\x0erotation_error\x18\x06 \x01(\tR\rrotationError\x12&\n" +
+	"\x06status\x18\a \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"_\n" +
+	"#GetCredentialRotationStatusResponse\x128\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1c.v1.CredentialRotationStatusR\bstatuses\"_\n" +
+	"\x13StreamEventsRequest\x12\x19\n" +
+	"\bpmc_macs\x18\x01 \x03(\tR\apmcMacs\x12-\n" +
+	"\x05kinds\x18\x02 \x03(\x0e2\x17.v1.PowershelfEventKindR\x05kinds\"\x8d\x02\n" +
+	"\x0fPowershelfEvent\x12&\n" +
+	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12+\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x17.v1.PowershelfEventKindR\x04kind\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x16\n" +
+	"\x06origin\x18\x06 \x01(\tR\x06origin\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xaa\x02\n" +
+	"\x10FirmwareBaseline\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x06vendor\x18\x02 \x01(\x0e2\r.v1.PMCVendorR\x06vendor\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x125\n" +
+	"\tcomponent\x18\x04 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"N\n" +
+	"\x1aSetFirmwareBaselineRequest\x120\n" +
+	"\bbaseline\x18\x01 \x01(\v2\x14.v1.FirmwareBaselineR\bbaseline\"3\n" +
+	"\x1dDeleteFirmwareBaselineRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"S\n" +
+	"\x1dListFirmwareBaselinesResponse\x122\n" +
+	"\tbaselines\x18\x01 \x03(\v2\x14.v1.FirmwareBaselineR\tbaselines\"\xc2\x02\n" +
+	"\x17FirmwareComplianceEntry\x125\n" +
+	"\tcomponent\x18\x01 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x1a\n" +
+	"\bbaseline\x18\x04 \x01(\tR\bbaseline\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\tR\x0fexpectedVersion\x12%\n" +
+	"\x0eactual_version\x18\x06 \x01(\tR\ractualVersion\x121\n" +
+	"\x05state\x18\a \x01(\x0e2\x1b.v1.FirmwareComplianceStateR\x05state\x12 \n" +
+	"\vremediation\x18\b \x01(\tR\vremediation\"\xd9\x01\n" +
+	"\x1cPowershelfFirmwareCompliance\x12&\n" +
+	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12\x1c\n" +
+	"\tcompliant\x18\x02 \x01(\bR\tcompliant\x125\n" +
+	"\aentries\x18\x03 \x03(\v2\x1b.v1.FirmwareComplianceEntryR\aentries\x12&\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xcf\x01\n" +
+	"#GetFirmwareComplianceReportResponse\x12=\n" +
+	"\fgenerated_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12D\n" +
+	"\fpowershelves\x18\x02 \x03(\v2 .v1.PowershelfFirmwareComplianceR\fpowershelves\x12#\n" +
+	"\rnon_compliant\x18\x03 \x01(\x05R\fnonCompliant\"\x83\x01\n" +
+	"\x1eGetFirmwareDriftHistoryRequest\x12\x19\n" +
+	"\bpmc_macs\x18\x01 \x03(\tR\apmcMacs\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xd5\x02\n" +
+	"\x12FirmwareDriftEvent\x12&\n" +
+	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x125\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bbaseline\x18\x04 \x01(\tR\bbaseline\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\tR\x0fexpectedVersion\x12%\n" +
+	"\x0eactual_version\x18\x06 \x01(\tR\ractualVersion\x12\x1c\n" +
+	"\tcompliant\x18\a \x01(\bR\tcompliant\x12;\n" +
+	"\vdetected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\"Q\n" +
+	"\x1fGetFirmwareDriftHistoryResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.v1.FirmwareDriftEventR\x06events*J\n" +
 	"\tPMCVendor\x12\x14\n" +
 	"\x10PMC_TYPE_UNKNOWN\x10\x00\x12\x13\n" +
-	"\x0fPMC_TYPE_LITEON\x10\x01*C\n" +
+	"\x0fPMC_TYPE_LITEON\x10\x01\x12\x12\n" +
+	"\x0ePMC_TYPE_DELTA\x10\x02*V\n" +
 	"\n" +
 	"StatusCode\x12\v\n" +
 	"\aSUCCESS\x10\x00\x12\x14\n" +
 	"\x10INVALID_ARGUMENT\x10\x01\x12\x12\n" +
-	"\x0eINTERNAL_ERROR\x10\x02*'\n" +
+	"\x0eINTERNAL_ERROR\x10\x02\x12\x11\n" +
+	"\rNOT_SUPPORTED\x10\x03*'\n" +
 	"\x13PowershelfComponent\x12\a\n" +
 	"\x03PMC\x10\x00\x12\a\n" +
 	"\x03PSU\x10\x01*\xc6\x01\n" +
@@ -2232,17 +4219,44 @@ const file_powershelf_manager_proto_rawDesc = "" +
 	"\x1cFIRMWARE_UPDATE_STATE_QUEUED\x10\x01\x12#\n" +
 	"\x1fFIRMWARE_UPDATE_STATE_VERIFYING\x10\x02\x12#\n" +
 	"\x1fFIRMWARE_UPDATE_STATE_COMPLETED\x10\x03\x12 \n" +
-	"\x1cFIRMWARE_UPDATE_STATE_FAILED\x10\x042\xe9\x04\n" +
+	"\x1cFIRMWARE_UPDATE_STATE_FAILED\x10\x04*\x96\x02\n" +
+	"\x17CredentialRotationState\x12%\n" +
+	"!CREDENTIAL_ROTATION_STATE_UNKNOWN\x10\x00\x12+\n" +
+	"'CREDENTIAL_ROTATION_STATE_NEVER_ROTATED\x10\x01\x12)\n" +
+	"%CREDENTIAL_ROTATION_STATE_IN_PROGRESS\x10\x02\x12'\n" +
+	"#CREDENTIAL_ROTATION_STATE_SUCCEEDED\x10\x03\x12$\n" +
+	" CREDENTIAL_ROTATION_STATE_FAILED\x10\x04\x12-\n" +
+	")CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED\x10\x05*\xc5\x01\n" +
+	"\x13PowershelfEventKind\x12!\n" +
+	"\x1dPOWERSHELF_EVENT_KIND_UNKNOWN\x10\x00\x12#\n" +
+	"\x1fPOWERSHELF_EVENT_KIND_PSU_FAULT\x10\x01\x12%\n" +
+	"!POWERSHELF_EVENT_KIND_POWER_STATE\x10\x02\x12\x1e\n" +
+	"\x1aPOWERSHELF_EVENT_KIND_TASK\x10\x03\x12\x1f\n" +
+	"\x1bPOWERSHELF_EVENT_KIND_OTHER\x10\x04*\xc1\x01\n" +
+	"\x17FirmwareComplianceState\x12%\n" +
+	"!FIRMWARE_COMPLIANCE_STATE_UNKNOWN\x10\x00\x12'\n" +
+	"#FIRMWARE_COMPLIANCE_STATE_COMPLIANT\x10\x01\x12+\n" +
+	"'FIRMWARE_COMPLIANCE_STATE_NON_COMPLIANT\x10\x02\x12)\n" +
+	"%FIRMWARE_COMPLIANCE_STATE_NO_BASELINE\x10\x032\xa8\v\n" +
 	"\x11PowershelfManager\x12Y\n" +
 	"\x14RegisterPowershelves\x12\x1f.v1.RegisterPowershelvesRequest\x1a .v1.RegisterPowershelvesResponse\x12E\n" +
-	"\x0fGetPowershelves\x12\x15.v1.PowershelfRequest\x1a\x1b.v1.GetPowershelvesResponse\x12G\n" +
+	"\x0fGetPowershelves\x12\x15.v1.PowershelfRequest\x1a\x1b.v1.GetPowershelvesResponse\x12_\n" +
+	"\x16GetPowershelfTelemetry\x12!.v1.GetPowershelfTelemetryRequest\x1a\".v1.GetPowershelfTelemetryResponse\x12G\n" +
 	"\x0eUpdateFirmware\x12\x19.v1.UpdateFirmwareRequest\x1a\x1a.v1.UpdateFirmwareResponse\x12b\n" +
 	"\x17GetFirmwareUpdateStatus\x12\".v1.GetFirmwareUpdateStatusRequest\x1a#.v1.GetFirmwareUpdateStatusResponse\x12Q\n" +
 	"\x15ListAvailableFirmware\x12\x15.v1.PowershelfRequest\x1a!.v1.ListAvailableFirmwareResponse\x129\n" +
-	"\tSetDryRun\x12\x14.v1.SetDryRunRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
-	"\bPowerOff\x12\x15.v1.PowershelfRequest\x1a\x18.v1.PowerControlResponse\x12:\n" +
-	"\aPowerOn\x12\x15.v1.PowershelfRequest\x1a\x18.v1.PowerControlResponseB\x8d\x01\n" +
-	"\x06com.v1B\x16PowershelfManagerProtoP\x01ZCgithub.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi/gen\xa2\x02\x03VXX\xaa\x02\x02V1\xca\x02\x02V1\xe2\x02\x0eV1\\GPBMetadata\xea\x02\x02V1b\x06proto3"
+	"\tSetDryRun\x12\x14.v1.SetDryRunRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x13SetFirmwareBaseline\x12\x1e.v1.SetFirmwareBaselineRequest\x1a\x14.v1.FirmwareBaseline\x12S\n" +
+	"\x16DeleteFirmwareBaseline\x12!.v1.DeleteFirmwareBaselineRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x15ListFirmwareBaselines\x12\x16.google.protobuf.Empty\x1a!.v1.ListFirmwareBaselinesResponse\x12]\n" +
+	"\x1bGetFirmwareComplianceReport\x12\x15.v1.PowershelfRequest\x1a'.v1.GetFirmwareComplianceReportResponse\x12b\n" +
+	"\x17GetFirmwareDriftHistory\x12\".v1.GetFirmwareDriftHistoryRequest\x1a#.v1.GetFirmwareDriftHistoryResponse\x126\n" +
+	"\bPowerOff\x12\x10.v1.PowerRequest\x1a\x18.v1.PowerControlResponse\x125\n" +
+	"\aPowerOn\x12\x10.v1.PowerRequest\x1a\x18.v1.PowerControlResponse\x12C\n" +
+	"\rSetPowerLimit\x12\x18.v1.SetPowerLimitRequest\x1a\x18.v1.PowerControlResponse\x12I\n" +
+	"\x11RotateCredentials\x12\x15.v1.PowershelfRequest\x1a\x1d.v1.RotateCredentialsResponse\x12]\n" +
+	"\x1bGetCredentialRotationStatus\x12\x15.v1.PowershelfRequest\x1a'.v1.GetCredentialRotationStatusResponse\x12>\n" +
+	"\fStreamEvents\x12\x17.v1.StreamEventsRequest\x1a\x13.v1.PowershelfEvent0\x01BEZCgithub.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi/genb\x06proto3"
 
 var (
 	file_powershelf_manager_proto_rawDescOnce sync.Once
@@ -2256,107 +4270,194 @@ func file_powershelf_manager_proto_rawDescGZIP() []byte {
 	return file_powershelf_manager_proto_rawDescData
 }
 
-var file_powershelf_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_powershelf_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_powershelf_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_powershelf_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_powershelf_manager_proto_goTypes = []any{
-	(PMCVendor)(0),                           // 0: v1.PMCVendor
-	(StatusCode)(0),                          // 1: v1.StatusCode
-	(PowershelfComponent)(0),                 // 2: v1.PowershelfComponent
-	(FirmwareUpdateState)(0),                 // 3: v1.FirmwareUpdateState
-	(*Credentials)(nil),                      // 4: v1.Credentials
-	(*PowerManagementController)(nil),        // 5: v1.PowerManagementController
-	(*Chassis)(nil),                          // 6: v1.Chassis
-	(*SensorThreshold)(nil),                  // 7: v1.SensorThreshold
-	(*SensorThresholds)(nil),                 // 8: v1.SensorThresholds
-	(*Sensor)(nil),                           // 9: v1.Sensor
-	(*PowerSupplyUnit)(nil),                  // 10: v1.PowerSupplyUnit
-	(*PowerShelf)(nil),                       // 11: v1.PowerShelf
-	(*RegisterPowershelfRequest)(nil),        // 12: v1.RegisterPowershelfRequest
-	(*RegisterPowershelvesRequest)(nil),      // 13: v1.RegisterPowershelvesRequest
-	(*RegisterPowershelfResponse)(nil),       // 14: v1.RegisterPowershelfResponse
-	(*RegisterPowershelvesResponse)(nil),     // 15: v1.RegisterPowershelvesResponse
-	(*PowershelfRequest)(nil),                // 16: v1.PowershelfRequest
-	(*PowershelfResponse)(nil),               // 17: v1.PowershelfResponse
-	(*PowerControlResponse)(nil),             // 18: v1.PowerControlResponse
-	(*GetPowershelvesResponse)(nil),          // 19: v1.GetPowershelvesResponse
-	(*UpdateComponentFirmwareRequest)(nil),   // 20: v1.UpdateComponentFirmwareRequest
-	(*UpdatePowershelfFirmwareRequest)(nil),  // 21: v1.UpdatePowershelfFirmwareRequest
-	(*UpdateFirmwareRequest)(nil),            // 22: v1.UpdateFirmwareRequest
-	(*UpdateComponentFirmwareResponse)(nil),  // 23: v1.UpdateComponentFirmwareResponse
-	(*UpdatePowershelfFirmwareResponse)(nil), // 24: v1.UpdatePowershelfFirmwareResponse
-	(*UpdateFirmwareResponse)(nil),           // 25: v1.UpdateFirmwareResponse
-	(*CanUpdateFirmwareResponse)(nil),        // 26: v1.CanUpdateFirmwareResponse
-	(*FirmwareVersion)(nil),                  // 27: v1.FirmwareVersion
-	(*ComponentFirmwareUpgrades)(nil),        // 28: v1.ComponentFirmwareUpgrades
-	(*AvailableFirmware)(nil),                // 29: v1.AvailableFirmware
-	(*ListAvailableFirmwareResponse)(nil),    // 30: v1.ListAvailableFirmwareResponse
-	(*SetDryRunRequest)(nil),                 // 31: v1.SetDryRunRequest
-	(*GetFirmwareUpdateStatusRequest)(nil),   // 32: v1.GetFirmwareUpdateStatusRequest
-	(*FirmwareUpdateQuery)(nil),              // 33: v1.FirmwareUpdateQuery
-	(*GetFirmwareUpdateStatusResponse)(nil),  // 34: v1.GetFirmwareUpdateStatusResponse
-	(*FirmwareUpdateStatus)(nil),             // 35: v1.FirmwareUpdateStatus
-	(*timestamppb.Timestamp)(nil),            // 36: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 37: google.protobuf.Empty
+	(PMCVendor)(0),                              // 0: v1.PMCVendor
+	(StatusCode)(0),                             // 1: v1.StatusCode
+	(PowershelfComponent)(0),                    // 2: v1.PowershelfComponent
+	(FirmwareUpdateState)(0),                    // 3: v1.FirmwareUpdateState
+	(CredentialRotationState)(0),                // 4: v1.CredentialRotationState
+	(PowershelfEventKind)(0),                    // 5: v1.PowershelfEventKind
+	(FirmwareComplianceState)(0),                // 6: v1.FirmwareComplianceState
+	(*Credentials)(nil),                         // 7: v1.Credentials
+	(*PowerManagementController)(nil),           // 8: v1.PowerManagementController
+	(*Chassis)(nil),                             // 9: v1.Chassis
+	(*SensorThreshold)(nil),                     // 10: v1.SensorThreshold
+	(*SensorThresholds)(nil),                    // 11: v1.SensorThresholds
+	(*Sensor)(nil),                              // 12: v1.Sensor
+	(*PowerSupplyUnit)(nil),                     // 13: v1.PowerSupplyUnit
+	(*PowerShelf)(nil),                          // 14: v1.PowerShelf
+	(*RegisterPowershelfRequest)(nil),           // 15: v1.RegisterPowershelfRequest
+	(*RegisterPowershelvesRequest)(nil),         // 16: v1.RegisterPowershelvesRequest
+	(*RegisterPowershelfResponse)(nil),          // 17: v1.RegisterPowershelfResponse
+	(*RegisterPowershelvesResponse)(nil),        // 18: v1.RegisterPowershelvesResponse
+	(*PowershelfRequest)(nil),                   // 19: v1.PowershelfRequest
+	(*PowerRequest)(nil),                        // 20: v1.PowerRequest
+	(*PowershelfResponse)(nil),                  // 21: v1.PowershelfResponse
+	(*PowerControlResponse)(nil),                // 22: v1.PowerControlResponse
+	(*PowerTarget)(nil),                         // 23: v1.PowerTarget
+	(*SetPowerLimitRequest)(nil),                // 24: v1.SetPowerLimitRequest
+	(*PowerLimit)(nil),                          // 25: v1.PowerLimit
+	(*GetPowershelvesResponse)(nil),             // 26: v1.GetPowershelvesResponse
+	(*UpdateComponentFirmwareRequest)(nil),      // 27: v1.UpdateComponentFirmwareRequest
+	(*UpdatePowershelfFirmwareRequest)(nil),     // 28: v1.UpdatePowershelfFirmwareRequest
+	(*UpdateFirmwareRequest)(nil),               // 29: v1.UpdateFirmwareRequest
+	(*UpdateComponentFirmwareResponse)(nil),     // 30: v1.UpdateComponentFirmwareResponse
+	(*UpdatePowershelfFirmwareResponse)(nil),    // 31: v1.UpdatePowershelfFirmwareResponse
+	(*UpdateFirmwareResponse)(nil),              // 32: v1.UpdateFirmwareResponse
+	(*CanUpdateFirmwareResponse)(nil),           // 33: v1.CanUpdateFirmwareResponse
+	(*FirmwareVersion)(nil),                     // 34: v1.FirmwareVersion
+	(*ComponentFirmwareUpgrades)(nil),           // 35: v1.ComponentFirmwareUpgrades
+	(*AvailableFirmware)(nil),                   // 36: v1.AvailableFirmware
+	(*ListAvailableFirmwareResponse)(nil),       // 37: v1.ListAvailableFirmwareResponse
+	(*SetDryRunRequest)(nil),                    // 38: v1.SetDryRunRequest
+	(*GetFirmwareUpdateStatusRequest)(nil),      // 39: v1.GetFirmwareUpdateStatusRequest
+	(*FirmwareUpdateQuery)(nil),                 // 40: v1.FirmwareUpdateQuery
+	(*GetFirmwareUpdateStatusResponse)(nil),     // 41: v1.GetFirmwareUpdateStatusResponse
+	(*FirmwareUpdateStatus)(nil),                // 42: v1.FirmwareUpdateStatus
+	(*GetPowershelfTelemetryRequest)(nil),       // 43: v1.GetPowershelfTelemetryRequest
+	(*TelemetryReadings)(nil),                   // 44: v1.TelemetryReadings
+	(*PowerSupplyTelemetry)(nil),                // 45: v1.PowerSupplyTelemetry
+	(*TelemetrySample)(nil),                     // 46: v1.TelemetrySample
+	(*PowershelfTelemetry)(nil),                 // 47: v1.PowershelfTelemetry
+	(*GetPowershelfTelemetryResponse)(nil),      // 48: v1.GetPowershelfTelemetryResponse
+	(*RotateCredentialsResponse)(nil),           // 49: v1.RotateCredentialsResponse
+	(*CredentialRotationStatus)(nil),            // 50: v1.CredentialRotationStatus
+	(*GetCredentialRotationStatusResponse)(nil), // 51: v1.GetCredentialRotationStatusResponse
+	(*StreamEventsRequest)(nil),                 // 52: v1.StreamEventsRequest
+	(*PowershelfEvent)(nil),                     // 53: v1.PowershelfEvent
+	(*FirmwareBaseline)(nil),                    // 54: v1.FirmwareBaseline
+	(*SetFirmwareBaselineRequest)(nil),          // 55: v1.SetFirmwareBaselineRequest
+	(*DeleteFirmwareBaselineRequest)(nil),       // 56: v1.DeleteFirmwareBaselineRequest
+	(*ListFirmwareBaselinesResponse)(nil),       // 57: v1.ListFirmwareBaselinesResponse
+	(*FirmwareComplianceEntry)(nil),             // 58: v1.FirmwareComplianceEntry
+	(*PowershelfFirmwareCompliance)(nil),        // 59: v1.PowershelfFirmwareCompliance
+	(*GetFirmwareComplianceReportResponse)(nil), // 60: v1.GetFirmwareComplianceReportResponse
+	(*GetFirmwareDriftHistoryRequest)(nil),      // 61: v1.GetFirmwareDriftHistoryRequest
+	(*FirmwareDriftEvent)(nil),                  // 62: v1.FirmwareDriftEvent
+	(*GetFirmwareDriftHistoryResponse)(nil),     // 63: v1.GetFirmwareDriftHistoryResponse
+	(*timestamppb.Timestamp)(nil),               // 64: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 65: google.protobuf.Empty
 }
 var file_powershelf_manager_proto_depIdxs = []int32{
 	0,  // 0: v1.PowerManagementController.vendor:type_name -> v1.PMCVendor
-	7,  // 1: v1.SensorThresholds.lower_caution:type_name -> v1.SensorThreshold
-	7,  // 2: v1.SensorThresholds.lower_critical:type_name -> v1.SensorThreshold
-	7,  // 3: v1.SensorThresholds.upper_caution:type_name -> v1.SensorThreshold
-	7,  // 4: v1.SensorThresholds.upper_critical:type_name -> v1.SensorThreshold
-	8,  // 5: v1.Sensor.thresholds:type_name -> v1.SensorThresholds
-	9,  // 6: v1.PowerSupplyUnit.sensors:type_name -> v1.Sensor
-	5,  // 7: v1.PowerShelf.pmc:type_name -> v1.PowerManagementController
-	6,  // 8: v1.PowerShelf.chassis:type_name -> v1.Chassis
-	10, // 9: v1.PowerShelf.psus:type_name -> v1.PowerSupplyUnit
+	10, // 1: v1.SensorThresholds.lower_caution:type_name -> v1.SensorThreshold
+	10, // 2: v1.SensorThresholds.lower_critical:type_name -> v1.SensorThreshold
+	10, // 3: v1.SensorThresholds.upper_caution:type_name -> v1.SensorThreshold
+	10, // 4: v1.SensorThresholds.upper_critical:type_name -> v1.SensorThreshold
+	11, // 5: v1.Sensor.thresholds:type_name -> v1.SensorThresholds
+	12, // 6: v1.PowerSupplyUnit.sensors:type_name -> v1.Sensor
+	8,  // 7: v1.PowerShelf.pmc:type_name -> v1.PowerManagementController
+	9,  // 8: v1.PowerShelf.chassis:type_name -> v1.Chassis
+	13, // 9: v1.PowerShelf.psus:type_name -> v1.PowerSupplyUnit
 	0,  // 10: v1.RegisterPowershelfRequest.pmc_vendor:type_name -> v1.PMCVendor
-	4,  // 11: v1.RegisterPowershelfRequest.pmc_credentials:type_name -> v1.Credentials
-	12, // 12: v1.RegisterPowershelvesRequest.registration_requests:type_name -> v1.RegisterPowershelfRequest
-	36, // 13: v1.RegisterPowershelfResponse.created:type_name -> google.protobuf.Timestamp
+	7,  // 11: v1.RegisterPowershelfRequest.pmc_credentials:type_name -> v1.Credentials
+	15, // 12: v1.RegisterPowershelvesRequest.registration_requests:type_name -> v1.RegisterPowershelfRequest
+	64, // 13: v1.RegisterPowershelfResponse.created:type_name -> google.protobuf.Timestamp
 	1,  // 14: v1.RegisterPowershelfResponse.status:type_name -> v1.StatusCode
-	14, // 15: v1.RegisterPowershelvesResponse.responses:type_name -> v1.RegisterPowershelfResponse
-	1,  // 16: v1.PowershelfResponse.status:type_name -> v1.StatusCode
-	17, // 17: v1.PowerControlResponse.responses:type_name -> v1.PowershelfResponse
-	11, // 18: v1.GetPowershelvesResponse.powershelves:type_name -> v1.PowerShelf
-	2,  // 19: v1.UpdateComponentFirmwareRequest.component:type_name -> v1.PowershelfComponent
-	27, // 20: v1.UpdateComponentFirmwareRequest.upgradeTo:type_name -> v1.FirmwareVersion
-	20, // 21: v1.UpdatePowershelfFirmwareRequest.components:type_name -> v1.UpdateComponentFirmwareRequest
-	21, // 22: v1.UpdateFirmwareRequest.upgrades:type_name -> v1.UpdatePowershelfFirmwareRequest
-	2,  // 23: v1.UpdateComponentFirmwareResponse.component:type_name -> v1.PowershelfComponent
-	1,  // 24: v1.UpdateComponentFirmwareResponse.status:type_name -> v1.StatusCode
-	23, // 25: v1.UpdatePowershelfFirmwareResponse.components:type_name -> v1.UpdateComponentFirmwareResponse
-	24, // 26: v1.UpdateFirmwareResponse.responses:type_name -> v1.UpdatePowershelfFirmwareResponse
-	2,  // 27: v1.ComponentFirmwareUpgrades.component:type_name -> v1.PowershelfComponent
-	27, // 28: v1.ComponentFirmwareUpgrades.upgrades:type_name -> v1.FirmwareVersion
-	28, // 29: v1.AvailableFirmware.upgrades:type_name -> v1.ComponentFirmwareUpgrades
-	29, // 30: v1.ListAvailableFirmwareResponse.upgrades:type_name -> v1.AvailableFirmware
-	33, // 31: v1.GetFirmwareUpdateStatusRequest.queries:type_name -> v1.FirmwareUpdateQuery
-	2,  // 32: v1.FirmwareUpdateQuery.component:type_name -> v1.PowershelfComponent
-	35, // 33: v1.GetFirmwareUpdateStatusResponse.statuses:type_name -> v1.FirmwareUpdateStatus
-	2,  // 34: v1.FirmwareUpdateStatus.component:type_name -> v1.PowershelfComponent
-	3,  // 35: v1.FirmwareUpdateStatus.state:type_name -> v1.FirmwareUpdateState
-	1,  // 36: v1.FirmwareUpdateStatus.status:type_name -> v1.StatusCode
-	13, // 37: v1.PowershelfManager.RegisterPowershelves:input_type -> v1.RegisterPowershelvesRequest
-	16, // 38: v1.PowershelfManager.GetPowershelves:input_type -> v1.PowershelfRequest
-	22, // 39: v1.PowershelfManager.UpdateFirmware:input_type -> v1.UpdateFirmwareRequest
-	32, // 40: v1.PowershelfManager.GetFirmwareUpdateStatus:input_type -> v1.GetFirmwareUpdateStatusRequest
-	16, // 41: v1.PowershelfManager.ListAvailableFirmware:input_type -> v1.PowershelfRequest
-	31, // 42: v1.PowershelfManager.SetDryRun:input_type -> v1.SetDryRunRequest
-	16, // 43: v1.PowershelfManager.PowerOff:input_type -> v1.PowershelfRequest
-	16, // 44: v1.PowershelfManager.PowerOn:input_type -> v1.PowershelfRequest
-	15, // 45: v1.PowershelfManager.RegisterPowershelves:output_type -> v1.RegisterPowershelvesResponse
-	19, // 46: v1.PowershelfManager.GetPowershelves:output_type -> v1.GetPowershelvesResponse
-	25, // 47: v1.PowershelfManager.UpdateFirmware:output_type -> v1.UpdateFirmwareResponse
-	34, // 48: v1.PowershelfManager.GetFirmwareUpdateStatus:output_type -> v1.GetFirmwareUpdateStatusResponse
-	30, // 49: v1.PowershelfManager.ListAvailableFirmware:output_type -> v1.ListAvailableFirmwareResponse
-	37, // 50: v1.PowershelfManager.SetDryRun:output_type -> google.protobuf.Empty
-	18, // 51: v1.PowershelfManager.PowerOff:output_type -> v1.PowerControlResponse
-	18, // 52: v1.PowershelfManager.PowerOn:output_type -> v1.PowerControlResponse
-	45, // [45:53] is the sub-list for method output_type
-	37, // [37:45] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	17, // 15: v1.RegisterPowershelvesResponse.responses:type_name -> v1.RegisterPowershelfResponse
+	23, // 16: v1.PowerRequest.targets:type_name -> v1.PowerTarget
+	1,  // 17: v1.PowershelfResponse.status:type_name -> v1.StatusCode
+	21, // 18: v1.PowerControlResponse.responses:type_name -> v1.PowershelfResponse
+	7,  // 19: v1.PowerTarget.pmc_credentials:type_name -> v1.Credentials
+	0,  // 20: v1.PowerTarget.pmc_vendor:type_name -> v1.PMCVendor
+	25, // 21: v1.SetPowerLimitRequest.limits:type_name -> v1.PowerLimit
+	14, // 22: v1.GetPowershelvesResponse.powershelves:type_name -> v1.PowerShelf
+	2,  // 23: v1.UpdateComponentFirmwareRequest.component:type_name -> v1.PowershelfComponent
+	34, // 24: v1.UpdateComponentFirmwareRequest.upgradeTo:type_name -> v1.FirmwareVersion
+	27, // 25: v1.UpdatePowershelfFirmwareRequest.components:type_name -> v1.UpdateComponentFirmwareRequest
+	28, // 26: v1.UpdateFirmwareRequest.upgrades:type_name -> v1.UpdatePowershelfFirmwareRequest
+	2,  // 27: v1.UpdateComponentFirmwareResponse.component:type_name -> v1.PowershelfComponent
+	1,  // 28: v1.UpdateComponentFirmwareResponse.status:type_name -> v1.StatusCode
+	30, // 29: v1.UpdatePowershelfFirmwareResponse.components:type_name -> v1.UpdateComponentFirmwareResponse
+	31, // 30: v1.UpdateFirmwareResponse.responses:type_name -> v1.UpdatePowershelfFirmwareResponse
+	2,  // 31: v1.ComponentFirmwareUpgrades.component:type_name -> v1.PowershelfComponent
+	34, // 32: v1.ComponentFirmwareUpgrades.upgrades:type_name -> v1.FirmwareVersion
+	35, // 33: v1.AvailableFirmware.upgrades:type_name -> v1.ComponentFirmwareUpgrades
+	36, // 34: v1.ListAvailableFirmwareResponse.upgrades:type_name -> v1.AvailableFirmware
+	40, // 35: v1.GetFirmwareUpdateStatusRequest.queries:type_name -> v1.FirmwareUpdateQuery
+	2,  // 36: v1.FirmwareUpdateQuery.component:type_name -> v1.PowershelfComponent
+	42, // 37: v1.GetFirmwareUpdateStatusResponse.statuses:type_name -> v1.FirmwareUpdateStatus
+	2,  // 38: v1.FirmwareUpdateStatus.component:type_name -> v1.PowershelfComponent
+	3,  // 39: v1.FirmwareUpdateStatus.state:type_name -> v1.FirmwareUpdateState
+	1,  // 40: v1.FirmwareUpdateStatus.status:type_name -> v1.StatusCode
+	64, // 41: v1.GetPowershelfTelemetryRequest.since:type_name -> google.protobuf.Timestamp
+	44, // 42: v1.PowerSupplyTelemetry.readings:type_name -> v1.TelemetryReadings
+	64, // 43: v1.TelemetrySample.timestamp:type_name -> google.protobuf.Timestamp
+	44, // 44: v1.TelemetrySample.readings:type_name -> v1.TelemetryReadings
+	45, // 45: v1.TelemetrySample.psus:type_name -> v1.PowerSupplyTelemetry
+	0,  // 46: v1.PowershelfTelemetry.vendor:type_name -> v1.PMCVendor
+	46, // 47: v1.PowershelfTelemetry.samples:type_name -> v1.TelemetrySample
+	1,  // 48: v1.PowershelfTelemetry.status:type_name -> v1.StatusCode
+	47, // 49: v1.GetPowershelfTelemetryResponse.telemetry:type_name -> v1.PowershelfTelemetry
+	21, // 50: v1.RotateCredentialsResponse.responses:type_name -> v1.PowershelfResponse
+	4,  // 51: v1.CredentialRotationStatus.state:type_name -> v1.CredentialRotationState
+	64, // 52: v1.CredentialRotationStatus.last_attempt:type_name -> google.protobuf.Timestamp
+	64, // 53: v1.CredentialRotationStatus.last_rotated:type_name -> google.protobuf.Timestamp
+	64, // 54: v1.CredentialRotationStatus.next_rotation:type_name -> google.protobuf.Timestamp
+	1,  // 55: v1.CredentialRotationStatus.status:type_name -> v1.StatusCode
+	50, // 56: v1.GetCredentialRotationStatusResponse.statuses:type_name -> v1.CredentialRotationStatus
+	5,  // 57: v1.StreamEventsRequest.kinds:type_name -> v1.PowershelfEventKind
+	5,  // 58: v1.PowershelfEvent.kind:type_name -> v1.PowershelfEventKind
+	64, // 59: v1.PowershelfEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 60: v1.FirmwareBaseline.vendor:type_name -> v1.PMCVendor
+	2,  // 61: v1.FirmwareBaseline.component:type_name -> v1.PowershelfComponent
+	64, // 62: v1.FirmwareBaseline.created_at:type_name -> google.protobuf.Timestamp
+	64, // 63: v1.FirmwareBaseline.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 64: v1.SetFirmwareBaselineRequest.baseline:type_name -> v1.FirmwareBaseline
+	54, // 65: v1.ListFirmwareBaselinesResponse.baselines:type_name -> v1.FirmwareBaseline
+	2,  // 66: v1.FirmwareComplianceEntry.component:type_name -> v1.PowershelfComponent
+	6,  // 67: v1.FirmwareComplianceEntry.state:type_name -> v1.FirmwareComplianceState
+	58, // 68: v1.PowershelfFirmwareCompliance.entries:type_name -> v1.FirmwareComplianceEntry
+	1,  // 69: v1.PowershelfFirmwareCompliance.status:type_name -> v1.StatusCode
+	64, // 70: v1.GetFirmwareComplianceReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	59, // 71: v1.GetFirmwareComplianceReportResponse.powershelves:type_name -> v1.PowershelfFirmwareCompliance
+	64, // 72: v1.GetFirmwareDriftHistoryRequest.since:type_name -> google.protobuf.Timestamp
+	2,  // 73: v1.FirmwareDriftEvent.component:type_name -> v1.PowershelfComponent
+	64, // 74: v1.FirmwareDriftEvent.detected_at:type_name -> google.protobuf.Timestamp
+	62, // 75: v1.GetFirmwareDriftHistoryResponse.events:type_name -> v1.FirmwareDriftEvent
+	16, // 76: v1.PowershelfManager.RegisterPowershelves:input_type -> v1.RegisterPowershelvesRequest
+	19, // 77: v1.PowershelfManager.GetPowershelves:input_type -> v1.PowershelfRequest
+	43, // 78: v1.PowershelfManager.GetPowershelfTelemetry:input_type -> v1.GetPowershelfTelemetryRequest
+	29, // 79: v1.PowershelfManager.UpdateFirmware:input_type -> v1.UpdateFirmwareRequest
+	39, // 80: v1.PowershelfManager.GetFirmwareUpdateStatus:input_type -> v1.GetFirmwareUpdateStatusRequest
+	19, // 81: v1.PowershelfManager.ListAvailableFirmware:input_type -> v1.PowershelfRequest
+	38, // 82: v1.PowershelfManager.SetDryRun:input_type -> v1.SetDryRunRequest
+	55, // 83: v1.PowershelfManager.SetFirmwareBaseline:input_type -> v1.SetFirmwareBaselineRequest
+	56, // 84: v1.PowershelfManager.DeleteFirmwareBaseline:input_type -> v1.DeleteFirmwareBaselineRequest
+	65, // 85: v1.PowershelfManager.ListFirmwareBaselines:input_type -> google.protobuf.Empty
+	19, // 86: v1.PowershelfManager.GetFirmwareComplianceReport:input_type -> v1.PowershelfRequest
+	61, // 87: v1.PowershelfManager.GetFirmwareDriftHistory:input_type -> v1.GetFirmwareDriftHistoryRequest
+	20, // 88: v1.PowershelfManager.PowerOff:input_type -> v1.PowerRequest
+	20, // 89: v1.PowershelfManager.PowerOn:input_type -> v1.PowerRequest
+	24, // 90: v1.PowershelfManager.SetPowerLimit:input_type -> v1.SetPowerLimitRequest
+	19, // 91: v1.PowershelfManager.RotateCredentials:input_type -> v1.PowershelfRequest
+	19, // 92: v1.PowershelfManager.GetCredentialRotationStatus:input_type -> v1.PowershelfRequest
+	52, // 93: v1.PowershelfManager.StreamEvents:input_type -> v1.StreamEventsRequest
+	18, // 94: v1.PowershelfManager.RegisterPowershelves:output_type -> v1.RegisterPowershelvesResponse
+	26, // 95: v1.PowershelfManager.GetPowershelves:output_type -> v1.GetPowershelvesResponse
+	48, // 96: v1.PowershelfManager.GetPowershelfTelemetry:output_type -> v1.GetPowershelfTelemetryResponse
+	32, // 97: v1.PowershelfManager.UpdateFirmware:output_type -> v1.UpdateFirmwareResponse
+	41, // 98: v1.PowershelfManager.GetFirmwareUpdateStatus:output_type -> v1.GetFirmwareUpdateStatusResponse
+	37, // 99: v1.PowershelfManager.ListAvailableFirmware:output_type -> v1.ListAvailableFirmwareResponse
+	65, // 100: v1.PowershelfManager.SetDryRun:output_type -> google.protobuf.Empty
+	54, // 101: v1.PowershelfManager.SetFirmwareBaseline:output_type -> v1.FirmwareBaseline
+	65, // 102: v1.PowershelfManager.DeleteFirmwareBaseline:output_type -> google.protobuf.Empty
+	57, // 103: v1.PowershelfManager.ListFirmwareBaselines:output_type -> v1.ListFirmwareBaselinesResponse
+	60, // 104: v1.PowershelfManager.GetFirmwareComplianceReport:output_type -> v1.GetFirmwareComplianceReportResponse
+	63, // 105: v1.PowershelfManager.GetFirmwareDriftHistory:output_type -> v1.GetFirmwareDriftHistoryResponse
+	22, // 106: v1.PowershelfManager.PowerOff:output_type -> v1.PowerControlResponse
+	22, // 107: v1.PowershelfManager.PowerOn:output_type -> v1.PowerControlResponse
+	22, // 108: v1.PowershelfManager.SetPowerLimit:output_type -> v1.PowerControlResponse
+	49, // 109: v1.PowershelfManager.RotateCredentials:output_type -> v1.RotateCredentialsResponse
+	51, // 110: v1.PowershelfManager.GetCredentialRotationStatus:output_type -> v1.GetCredentialRotationStatusResponse
+	53, // 111: v1.PowershelfManager.StreamEvents:output_type -> v1.PowershelfEvent
+	94, // [94:112] is the sub-list for method output_type
+	76, // [76:94] is the sub-list for method input_type
+	76, // [76:76] is the sub-list for extension type_name
+	76, // [76:76] is the sub-list for extension extendee
+	0,  // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_powershelf_manager_proto_init() }
@@ -2364,13 +4465,16 @@ func file_powershelf_manager_proto_init() {
 	if File_powershelf_manager_proto != nil {
 		return
 	}
+	file_powershelf_manager_proto_msgTypes[7].OneofWrappers = []any{}
+	file_powershelf_manager_proto_msgTypes[37].OneofWrappers = []any{}
+	file_powershelf_manager_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_powershelf_manager_proto_rawDesc), len(file_powershelf_manager_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   32,
+			NumEnums:      7,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,14 +36,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PowershelfManager_RegisterPowershelves_FullMethodName    = "/v1.PowershelfManager/RegisterPowershelves"
-	PowershelfManager_GetPowershelves_FullMethodName         = "/v1.PowershelfManager/GetPowershelves"
-	PowershelfManager_UpdateFirmware_FullMethodName          = "/v1.PowershelfManager/UpdateFirmware"
-	PowershelfManager_GetFirmwareUpdateStatus_FullMethodName = "/v1.PowershelfManager/GetFirmwareUpdateStatus"
-	PowershelfManager_ListAvailableFirmware_FullMethodName   = "/v1.PowershelfManager/ListAvailableFirmware"
-	PowershelfManager_SetDryRun_FullMethodName               = "/v1.PowershelfManager/SetDryRun"
-	PowershelfManager_PowerOff_FullMethodName                = "/v1.PowershelfManager/PowerOff"
-	PowershelfManager_PowerOn_FullMethodName                 = "/v1.PowershelfManager/PowerOn"
+	PowershelfManager_RegisterPowershelves_FullMethodName        = "/v1.PowershelfManager/RegisterPowershelves"
+	PowershelfManager_GetPowershelves_FullMethodName             = "/v1.PowershelfManager/GetPowershelves"
+	PowershelfManager_GetPowershelfTelemetry_FullMethodName      = "/v1.PowershelfManager/GetPowershelfTelemetry"
+	PowershelfManager_UpdateFirmware_FullMethodName              = "/v1.PowershelfManager/UpdateFirmware"
+	PowershelfManager_GetFirmwareUpdateStatus_FullMethodName     = "/v1.PowershelfManager/GetFirmwareUpdateStatus"
+	PowershelfManager_ListAvailableFirmware_FullMethodName       = "/v1.PowershelfManager/ListAvailableFirmware"
+	PowershelfManager_SetDryRun_FullMethodName                   = "/v1.PowershelfManager/SetDryRun"
+	PowershelfManager_SetFirmwareBaseline_FullMethodName         = "/v1.PowershelfManager/SetFirmwareBaseline"
+	PowershelfManager_DeleteFirmwareBaseline_FullMethodName      = "/v1.PowershelfManager/DeleteFirmwareBaseline"
+	PowershelfManager_ListFirmwareBaselines_FullMethodName       = "/v1.PowershelfManager/ListFirmwareBaselines"
+	PowershelfManager_GetFirmwareComplianceReport_FullMethodName = "/v1.PowershelfManager/GetFirmwareComplianceReport"
+	PowershelfManager_GetFirmwareDriftHistory_FullMethodName     = "/v1.PowershelfManager/GetFirmwareDriftHistory"
+	PowershelfManager_PowerOff_FullMethodName                    = "/v1.PowershelfManager/PowerOff"
+	PowershelfManager_PowerOn_FullMethodName                     = "/v1.PowershelfManager/PowerOn"
+	PowershelfManager_SetPowerLimit_FullMethodName               = "/v1.PowershelfManager/SetPowerLimit"
+	PowershelfManager_RotateCredentials_FullMethodName           = "/v1.PowershelfManager/RotateCredentials"
+	PowershelfManager_GetCredentialRotationStatus_FullMethodName = "/v1.PowershelfManager/GetCredentialRotationStatus"
+	PowershelfManager_StreamEvents_FullMethodName                = "/v1.PowershelfManager/StreamEvents"
 )
 
 // PowershelfManagerClient is the client API for PowershelfManager service.
@@ -58,6 +68,8 @@ type PowershelfManagerClient interface {
 	// Inventory Management
 	// GetPowershelves returns powershelf information for all the powershelves requested.
 	GetPowershelves(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*GetPowershelvesResponse, error)
+	// GetPowershelfTelemetry returns the recent power, voltage, current, temperature and fan history of the requested powershelves.
+	GetPowershelfTelemetry(ctx context.Context, in *GetPowershelfTelemetryRequest, opts ...grpc.CallOption) (*GetPowershelfTelemetryResponse, error)
 	// Firmware Management
 	// UpdateFirmware performs a firmware upgrade (supports dry-run).
 	UpdateFirmware(ctx context.Context, in *UpdateFirmwareRequest, opts ...grpc.CallOption) (*UpdateFirmwareResponse, error)
@@ -67,11 +79,32 @@ type PowershelfManagerClient interface {
 	ListAvailableFirmware(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*ListAvailableFirmwareResponse, error)
 	// SetDryRun configures whether the firmware manager is in Dry Run mode.
 	SetDryRun(ctx context.Context, in *SetDryRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Firmware Compliance
+	// SetFirmwareBaseline creates or replaces a named desired firmware version for a vendor, model and component.
+	SetFirmwareBaseline(ctx context.Context, in *SetFirmwareBaselineRequest, opts ...grpc.CallOption) (*FirmwareBaseline, error)
+	// DeleteFirmwareBaseline deletes a firmware baseline.
+	DeleteFirmwareBaseline(ctx context.Context, in *DeleteFirmwareBaselineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListFirmwareBaselines lists all firmware baselines.
+	ListFirmwareBaselines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFirmwareBaselinesResponse, error)
+	// GetFirmwareComplianceReport checks the firmware inventory of the specified powershelves (all if none are specified) against the baselines.
+	GetFirmwareComplianceReport(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*GetFirmwareComplianceReportResponse, error)
+	// GetFirmwareDriftHistory returns the recorded drift of powershelf firmware from, and back to, the baselines.
+	GetFirmwareDriftHistory(ctx context.Context, in *GetFirmwareDriftHistoryRequest, opts ...grpc.CallOption) (*GetFirmwareDriftHistoryResponse, error)
 	// Power Control
 	// Power OFF the rack
-	PowerOff(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	PowerOff(ctx context.Context, in *PowerRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
 	// Power ON the rack
-	PowerOn(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	PowerOn(ctx context.Context, in *PowerRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	// SetPowerLimit caps the power of the specified powershelves via Redfish PowerLimit.
+	SetPowerLimit(ctx context.Context, in *SetPowerLimitRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	// Credential Management
+	// RotateCredentials starts rotating the PMC passwords of the specified powershelves in the background.
+	RotateCredentials(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*RotateCredentialsResponse, error)
+	// GetCredentialRotationStatus returns the latest credential rotation of the specified powershelves (all if none are specified).
+	GetCredentialRotationStatus(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*GetCredentialRotationStatusResponse, error)
+	// Events
+	// StreamEvents streams the PSU fault, power state and task events reported by the specified powershelves (all if none are specified) until the client cancels.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PowershelfEvent], error)
 }
 
 type powershelfManagerClient struct {
@@ -102,6 +135,16 @@ func (c *powershelfManagerClient) GetPowershelves(ctx context.Context, in *Power
 	return out, nil
 }
 
+func (c *powershelfManagerClient) GetPowershelfTelemetry(ctx context.Context, in *GetPowershelfTelemetryRequest, opts ...grpc.CallOption) (*GetPowershelfTelemetryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPowershelfTelemetryResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_GetPowershelfTelemetry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *powershelfManagerClient) UpdateFirmware(ctx context.Context, in *UpdateFirmwareRequest, opts ...grpc.CallOption) (*UpdateFirmwareResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateFirmwareResponse)
@@ -142,7 +185,57 @@ func (c *powershelfManagerClient) SetDryRun(ctx context.Context, in *SetDryRunRe
 	return out, nil
 }
 
-func (c *powershelfManagerClient) PowerOff(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*PowerControlResponse, error) {
+func (c *powershelfManagerClient) SetFirmwareBaseline(ctx context.Context, in *SetFirmwareBaselineRequest, opts ...grpc.CallOption) (*FirmwareBaseline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FirmwareBaseline)
+	err := c.cc.Invoke(ctx, PowershelfManager_SetFirmwareBaseline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) DeleteFirmwareBaseline(ctx context.Context, in *DeleteFirmwareBaselineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PowershelfManager_DeleteFirmwareBaseline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) ListFirmwareBaselines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFirmwareBaselinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFirmwareBaselinesResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_ListFirmwareBaselines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) GetFirmwareComplianceReport(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*GetFirmwareComplianceReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFirmwareComplianceReportResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_GetFirmwareComplianceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) GetFirmwareDriftHistory(ctx context.Context, in *GetFirmwareDriftHistoryRequest, opts ...grpc.CallOption) (*GetFirmwareDriftHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFirmwareDriftHistoryResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_GetFirmwareDriftHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) PowerOff(ctx context.Context, in *PowerRequest, opts ...grpc.CallOption) (*PowerControlResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PowerControlResponse)
 	err := c.cc.Invoke(ctx, PowershelfManager_PowerOff_FullMethodName, in, out, cOpts...)
@@ -152,7 +245,7 @@ func (c *powershelfManagerClient) PowerOff(ctx context.Context, in *PowershelfRe
 	return out, nil
 }
 
-func (c *powershelfManagerClient) PowerOn(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*PowerControlResponse, error) {
+func (c *powershelfManagerClient) PowerOn(ctx context.Context, in *PowerRequest, opts ...grpc.CallOption) (*PowerControlResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PowerControlResponse)
 	err := c.cc.Invoke(ctx, PowershelfManager_PowerOn_FullMethodName, in, out, cOpts...)
@@ -162,6 +255,55 @@ func (c *powershelfManagerClient) PowerOn(ctx context.Context, in *PowershelfReq
 	return out, nil
 }
 
+func (c *powershelfManagerClient) SetPowerLimit(ctx context.Context, in *SetPowerLimitRequest, opts ...grpc.CallOption) (*PowerControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PowerControlResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_SetPowerLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) RotateCredentials(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*RotateCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCredentialsResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_RotateCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) GetCredentialRotationStatus(ctx context.Context, in *PowershelfRequest, opts ...grpc.CallOption) (*GetCredentialRotationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialRotationStatusResponse)
+	err := c.cc.Invoke(ctx, PowershelfManager_GetCredentialRotationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powershelfManagerClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PowershelfEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PowershelfManager_ServiceDesc.Streams[0], PowershelfManager_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, PowershelfEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PowershelfManager_StreamEventsClient = grpc.ServerStreamingClient[PowershelfEvent]
+
 // PowershelfManagerServer is the server API for PowershelfManager service.
 // All implementations should embed UnimplementedPowershelfManagerServer
 // for forward compatibility.
@@ -174,6 +316,8 @@ type PowershelfManagerServer interface {
 	// Inventory Management
 	// GetPowershelves returns powershelf information for all the powershelves requested.
 	GetPowershelves(context.Context, *PowershelfRequest) (*GetPowershelvesResponse, error)
+	// GetPowershelfTelemetry returns the recent power, voltage, current, temperature and fan history of the requested powershelves.
+	GetPowershelfTelemetry(context.Context, *GetPowershelfTelemetryRequest) (*GetPowershelfTelemetryResponse, error)
 	// Firmware Management
 	// UpdateFirmware performs a firmware upgrade (supports dry-run).
 	UpdateFirmware(context.Context, *UpdateFirmwareRequest) (*UpdateFirmwareResponse, error)
@@ -183,11 +327,32 @@ type PowershelfManagerServer interface {
 	ListAvailableFirmware(context.Context, *PowershelfRequest) (*ListAvailableFirmwareResponse, error)
 	// SetDryRun configures whether the firmware manager is in Dry Run mode.
 	SetDryRun(context.Context, *SetDryRunRequest) (*emptypb.Empty, error)
+	// Firmware Compliance
+	// SetFirmwareBaseline creates or replaces a named desired firmware version for a vendor, model and component.
+	SetFirmwareBaseline(context.Context, *SetFirmwareBaselineRequest) (*FirmwareBaseline, error)
+	// DeleteFirmwareBaseline deletes a firmware baseline.
+	DeleteFirmwareBaseline(context.Context, *DeleteFirmwareBaselineRequest) (*emptypb.Empty, error)
+	// ListFirmwareBaselines lists all firmware baselines.
+	ListFirmwareBaselines(context.Context, *emptypb.Empty) (*ListFirmwareBaselinesResponse, error)
+	// GetFirmwareComplianceReport checks the firmware inventory of the specified powershelves (all if none are specified) against the baselines.
+	GetFirmwareComplianceReport(context.Context, *PowershelfRequest) (*GetFirmwareComplianceReportResponse, error)
+	// GetFirmwareDriftHistory returns the recorded drift of powershelf firmware from, and back to, the baselines.
+	GetFirmwareDriftHistory(context.Context, *GetFirmwareDriftHistoryRequest) (*GetFirmwareDriftHistoryResponse, error)
 	// Power Control
 	// Power OFF the rack
-	PowerOff(context.Context, *PowershelfRequest) (*PowerControlResponse, error)
+	PowerOff(context.Context, *PowerRequest) (*PowerControlResponse, error)
 	// Power ON the rack
-	PowerOn(context.Context, *PowershelfRequest) (*PowerControlResponse, error)
+	PowerOn(context.Context, *PowerRequest) (*PowerControlResponse, error)
+	// SetPowerLimit caps the power of the specified powershelves via Redfish PowerLimit.
+	SetPowerLimit(context.Context, *SetPowerLimitRequest) (*PowerControlResponse, error)
+	// Credential Management
+	// RotateCredentials starts rotating the PMC passwords of the specified powershelves in the background.
+	RotateCredentials(context.Context, *PowershelfRequest) (*RotateCredentialsResponse, error)
+	// GetCredentialRotationStatus returns the latest credential rotation of the specified powershelves (all if none are specified).
+	GetCredentialRotationStatus(context.Context, *PowershelfRequest) (*GetCredentialRotationStatusResponse, error)
+	// Events
+	// StreamEvents streams the PSU fault, power state and task events reported by the specified powershelves (all if none are specified) until the client cancels.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[PowershelfEvent]) error
 }
 
 // UnimplementedPowershelfManagerServer should be embedded to have
@@ -203,6 +368,9 @@ func (UnimplementedPowershelfManagerServer) RegisterPowershelves(context.Context
 func (UnimplementedPowershelfManagerServer) GetPowershelves(context.Context, *PowershelfRequest) (*GetPowershelvesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPowershelves not implemented")
 }
+func (UnimplementedPowershelfManagerServer) GetPowershelfTelemetry(context.Context, *GetPowershelfTelemetryRequest) (*GetPowershelfTelemetryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPowershelfTelemetry not implemented")
+}
 func (UnimplementedPowershelfManagerServer) UpdateFirmware(context.Context, *UpdateFirmwareRequest) (*UpdateFirmwareResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateFirmware not implemented")
 }
@@ -215,12 +383,39 @@ func (UnimplementedPowershelfManagerServer) ListAvailableFirmware(context.Contex
 func (UnimplementedPowershelfManagerServer) SetDryRun(context.Context, *SetDryRunRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetDryRun not implemented")
 }
-func (UnimplementedPowershelfManagerServer) PowerOff(context.Context, *PowershelfRequest) (*PowerControlResponse, error) {
+func (UnimplementedPowershelfManagerServer) SetFirmwareBaseline(context.Context, *SetFirmwareBaselineRequest) (*FirmwareBaseline, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFirmwareBaseline not implemented")
+}
+func (UnimplementedPowershelfManagerServer) DeleteFirmwareBaseline(context.Context, *DeleteFirmwareBaselineRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFirmwareBaseline not implemented")
+}
+func (UnimplementedPowershelfManagerServer) ListFirmwareBaselines(context.Context, *emptypb.Empty) (*ListFirmwareBaselinesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFirmwareBaselines not implemented")
+}
+func (UnimplementedPowershelfManagerServer) GetFirmwareComplianceReport(context.Context, *PowershelfRequest) (*GetFirmwareComplianceReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFirmwareComplianceReport not implemented")
+}
+func (UnimplementedPowershelfManagerServer) GetFirmwareDriftHistory(context.Context, *GetFirmwareDriftHistoryRequest) (*GetFirmwareDriftHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFirmwareDriftHistory not implemented")
+}
+func (UnimplementedPowershelfManagerServer) PowerOff(context.Context, *PowerRequest) (*PowerControlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PowerOff not implemented")
 }
-func (UnimplementedPowershelfManagerServer) PowerOn(context.Context, *PowershelfRequest) (*PowerControlResponse, error) {
+func (UnimplementedPowershelfManagerServer) PowerOn(context.Context, *PowerRequest) (*PowerControlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PowerOn not implemented")
 }
+func (UnimplementedPowershelfManagerServer) SetPowerLimit(context.Context, *SetPowerLimitRequest) (*PowerControlResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPowerLimit not implemented")
+}
+func (UnimplementedPowershelfManagerServer) RotateCredentials(context.Context, *PowershelfRequest) (*RotateCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateCredentials not implemented")
+}
+func (UnimplementedPowershelfManagerServer) GetCredentialRotationStatus(context.Context, *PowershelfRequest) (*GetCredentialRotationStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCredentialRotationStatus not implemented")
+}
+func (UnimplementedPowershelfManagerServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[PowershelfEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamEvents not implemented")
+}
 func (UnimplementedPowershelfManagerServer) testEmbeddedByValue() {}
 
 // UnsafePowershelfManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -277,6 +472,24 @@ func _PowershelfManager_GetPowershelves_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PowershelfManager_GetPowershelfTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPowershelfTelemetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).GetPowershelfTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_GetPowershelfTelemetry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).GetPowershelfTelemetry(ctx, req.(*GetPowershelfTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PowershelfManager_UpdateFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateFirmwareRequest)
 	if err := dec(in); err != nil {
@@ -349,11 +562,101 @@ func _PowershelfManager_SetDryRun_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PowershelfManager_PowerOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _PowershelfManager_SetFirmwareBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFirmwareBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).SetFirmwareBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_SetFirmwareBaseline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).SetFirmwareBaseline(ctx, req.(*SetFirmwareBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_DeleteFirmwareBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFirmwareBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).DeleteFirmwareBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_DeleteFirmwareBaseline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).DeleteFirmwareBaseline(ctx, req.(*DeleteFirmwareBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_ListFirmwareBaselines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).ListFirmwareBaselines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_ListFirmwareBaselines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).ListFirmwareBaselines(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_GetFirmwareComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PowershelfRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).GetFirmwareComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_GetFirmwareComplianceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).GetFirmwareComplianceReport(ctx, req.(*PowershelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_GetFirmwareDriftHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFirmwareDriftHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).GetFirmwareDriftHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_GetFirmwareDriftHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).GetFirmwareDriftHistory(ctx, req.(*GetFirmwareDriftHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_PowerOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
 	if interceptor == nil {
 		return srv.(PowershelfManagerServer).PowerOff(ctx, in)
 	}
@@ -362,13 +665,13 @@ func _PowershelfManager_PowerOff_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: PowershelfManager_PowerOff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PowershelfManagerServer).PowerOff(ctx, req.(*PowershelfRequest))
+		return srv.(PowershelfManagerServer).PowerOff(ctx, req.(*PowerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _PowershelfManager_PowerOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PowershelfRequest)
+	in := new(PowerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -380,11 +683,76 @@ func _PowershelfManager_PowerOn_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: PowershelfManager_PowerOn_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PowershelfManagerServer).PowerOn(ctx, req.(*PowershelfRequest))
+		return srv.(PowershelfManagerServer).PowerOn(ctx, req.(*PowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_SetPowerLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPowerLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).SetPowerLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_SetPowerLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).SetPowerLimit(ctx, req.(*SetPowerLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_RotateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowershelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).RotateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_RotateCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).RotateCredentials(ctx, req.(*PowershelfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowershelfManager_GetCredentialRotationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowershelfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowershelfManagerServer).GetCredentialRotationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PowershelfManager_GetCredentialRotationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowershelfManagerServer).GetCredentialRotationStatus(ctx, req.(*PowershelfRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PowershelfManager_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PowershelfManagerServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, PowershelfEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PowershelfManager_StreamEventsServer = grpc.ServerStreamingServer[PowershelfEvent]
+
 // PowershelfManager_ServiceDesc is the grpc.ServiceDesc for PowershelfManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -400,6 +768,10 @@ var PowershelfManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPowershelves",
 			Handler:    _PowershelfManager_GetPowershelves_Handler,
 		},
+		{
+			MethodName: "GetPowershelfTelemetry",
+			Handler:    _PowershelfManager_GetPowershelfTelemetry_Handler,
+		},
 		{
 			MethodName: "UpdateFirmware",
 			Handler:    _PowershelfManager_UpdateFirmware_Handler,
@@ -416,6 +788,26 @@ var PowershelfManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDryRun",
 			Handler:    _PowershelfManager_SetDryRun_Handler,
 		},
+		{
+			MethodName: "SetFirmwareBaseline",
+			Handler:    _PowershelfManager_SetFirmwareBaseline_Handler,
+		},
+		{
+			MethodName: "DeleteFirmwareBaseline",
+			Handler:    _PowershelfManager_DeleteFirmwareBaseline_Handler,
+		},
+		{
+			MethodName: "ListFirmwareBaselines",
+			Handler:    _PowershelfManager_ListFirmwareBaselines_Handler,
+		},
+		{
+			MethodName: "GetFirmwareComplianceReport",
+			Handler:    _PowershelfManager_GetFirmwareComplianceReport_Handler,
+		},
+		{
+			MethodName: "GetFirmwareDriftHistory",
+			Handler:    _PowershelfManager_GetFirmwareDriftHistory_Handler,
+		},
 		{
 			MethodName: "PowerOff",
 			Handler:    _PowershelfManager_PowerOff_Handler,
@@ -424,7 +816,25 @@ var PowershelfManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PowerOn",
 			Handler:    _PowershelfManager_PowerOn_Handler,
 		},
+		{
+			MethodName: "SetPowerLimit",
+			Handler:    _PowershelfManager_SetPowerLimit_Handler,
+		},
+		{
+			MethodName: "RotateCredentials",
+			Handler:    _PowershelfManager_RotateCredentials_Handler,
+		},
+		{
+			MethodName: "GetCredentialRotationStatus",
+			Handler:    _PowershelfManager_GetCredentialRotationStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _PowershelfManager_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "powershelf-manager.proto",
 }
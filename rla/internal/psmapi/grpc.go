@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"sync"
 	"testing"
@@ -30,7 +31,10 @@ import (
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi/gen"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type grpcClient struct {
@@ -128,7 +132,7 @@ func (c *grpcClient) PowerOn(ctx context.Context, pmcMacs []string) ([]PowerCont
 	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
 	defer cancel()
 
-	resp, err := c.client.PowerOn(ctx, &pb.PowershelfRequest{PmcMacs: pmcMacs})
+	resp, err := c.client.PowerOn(ctx, &pb.PowerRequest{PmcMacs: pmcMacs})
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +149,7 @@ func (c *grpcClient) PowerOff(ctx context.Context, pmcMacs []string) ([]PowerCon
 	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
 	defer cancel()
 
-	resp, err := c.client.PowerOff(ctx, &pb.PowershelfRequest{PmcMacs: pmcMacs})
+	resp, err := c.client.PowerOff(ctx, &pb.PowerRequest{PmcMacs: pmcMacs})
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +161,84 @@ func (c *grpcClient) PowerOff(ctx context.Context, pmcMacs []string) ([]PowerCon
 	return result, nil
 }
 
+// SetPowerLimit caps the power of the specified powershelves via Redfish PowerLimit.
+func (c *grpcClient) SetPowerLimit(ctx context.Context, limits []PowerLimit) ([]PowerControlResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	pbLimits := make([]*pb.PowerLimit, 0, len(limits))
+	for _, l := range limits {
+		pbLimits = append(pbLimits, powerLimitToPb(l))
+	}
+
+	resp, err := c.client.SetPowerLimit(ctx, &pb.SetPowerLimitRequest{Limits: pbLimits})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []PowerControlResult
+	for _, r := range resp.Responses {
+		result = append(result, powerControlResultFromPb(r))
+	}
+	return result, nil
+}
+
+// GetPowershelfTelemetry returns the telemetry history of the specified powershelves taken at or after since.
+func (c *grpcClient) GetPowershelfTelemetry(ctx context.Context, pmcMacs []string, since time.Time) ([]PowershelfTelemetry, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	req := &pb.GetPowershelfTelemetryRequest{PmcMacs: pmcMacs}
+	if !since.IsZero() {
+		req.Since = timestamppb.New(since)
+	}
+
+	resp, err := c.client.GetPowershelfTelemetry(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []PowershelfTelemetry
+	for _, t := range resp.Telemetry {
+		result = append(result, powershelfTelemetryFromPb(t))
+	}
+	return result, nil
+}
+
+// RotateCredentials starts rotating the PMC passwords of the specified powershelves in the background.
+func (c *grpcClient) RotateCredentials(ctx context.Context, pmcMacs []string) ([]PowerControlResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	resp, err := c.client.RotateCredentials(ctx, &pb.PowershelfRequest{PmcMacs: pmcMacs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []PowerControlResult
+	for _, r := range resp.Responses {
+		result = append(result, powerControlResultFromPb(r))
+	}
+	return result, nil
+}
+
+// GetCredentialRotationStatus returns the latest credential rotation of the specified powershelves.
+func (c *grpcClient) GetCredentialRotationStatus(ctx context.Context, pmcMacs []string) ([]CredentialRotationStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetCredentialRotationStatus(ctx, &pb.PowershelfRequest{PmcMacs: pmcMacs})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []CredentialRotationStatus
+	for _, s := range resp.Statuses {
+		result = append(result, credentialRotationStatusFromPb(s))
+	}
+	return result, nil
+}
+
 // UpdateFirmware performs firmware upgrades on the specified powershelves.
 func (c *grpcClient) UpdateFirmware(ctx context.Context, requests []UpdatePowershelfFirmwareRequest) ([]UpdatePowershelfFirmwareResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
@@ -218,6 +300,43 @@ func (c *grpcClient) ListAvailableFirmware(ctx context.Context, pmcMacs []string
 	return result, nil
 }
 
+// StreamEvents streams the Redfish events reported by the PMCs of the specified powershelves. The stream is not
+// bounded by the gRPC timeout; it ends when ctx is cancelled or PSM closes it.
+func (c *grpcClient) StreamEvents(ctx context.Context, pmcMacs []string, kinds []EventKind) (<-chan PowershelfEvent, error) {
+	pbKinds := make([]pb.PowershelfEventKind, 0, len(kinds))
+	for _, k := range kinds {
+		pbKinds = append(pbKinds, eventKindToPb(k))
+	}
+
+	stream, err := c.client.StreamEvents(ctx, &pb.StreamEventsRequest{
+		PmcMacs: pmcMacs,
+		Kinds:   pbKinds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan PowershelfEvent)
+	go func() {
+		defer close(ch)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && status.Code(err) != codes.Canceled {
+					log.Warn().Err(err).Msg("Powershelf Manager event stream ended")
+				}
+				return
+			}
+			select {
+			case ch <- powershelfEventFromPb(ev):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
 // SetDryRun configures whether the firmware manager is in Dry Run mode.
 func (c *grpcClient) SetDryRun(ctx context.Context, dryRun bool) error {
 	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
@@ -231,3 +350,8 @@ func (c *grpcClient) SetDryRun(ctx context.Context, dryRun bool) error {
 func (c *grpcClient) AddPowershelf(PowerShelf) {
 	panic("Not a unit test")
 }
+
+// PublishEvent is only valid in the mock environment.
+func (c *grpcClient) PublishEvent(PowershelfEvent) {
+	panic("Not a unit test")
+}
@@ -19,18 +19,42 @@ package psmapi
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
 )
 
+// mockEventBuffer is the number of published events a mock event stream holds before further events are dropped.
+const mockEventBuffer = 64
+
 type mockClient struct {
 	powershelves map[string]PowerShelf
+	rotations    map[string]time.Time
+
+	mu      sync.Mutex
+	streams map[*mockEventStream]struct{}
+}
+
+type mockEventStream struct {
+	pmcMacs []string // normalized
+	kinds   []EventKind
+	ch      chan PowershelfEvent
+}
+
+func (s *mockEventStream) matches(ev PowershelfEvent) bool {
+	return (len(s.pmcMacs) == 0 || slices.Contains(s.pmcMacs, utils.NormalizeMAC(ev.PMCMACAddress))) &&
+		(len(s.kinds) == 0 || slices.Contains(s.kinds, ev.Kind))
 }
 
 // NewMockClient returns a "GRPC" client that returns mock values so it can be used in unit tests.
 func NewMockClient() Client {
-	return &mockClient{powershelves: map[string]PowerShelf{}}
+	return &mockClient{
+		powershelves: map[string]PowerShelf{},
+		rotations:    map[string]time.Time{},
+		streams:      map[*mockEventStream]struct{}{},
+	}
 }
 
 func (c *mockClient) Close() error {
@@ -106,6 +130,105 @@ func (c *mockClient) PowerOff(ctx context.Context, pmcMacs []string) ([]PowerCon
 	return result, nil
 }
 
+func (c *mockClient) SetPowerLimit(ctx context.Context, limits []PowerLimit) ([]PowerControlResult, error) {
+	var result []PowerControlResult
+	for _, l := range limits {
+		key := utils.NormalizeMAC(l.PMCMACAddress)
+		if ps, ok := c.powershelves[key]; ok {
+			limit := l.LimitWatts
+			ps.PowerLimitWatts = &limit
+			c.powershelves[key] = ps
+		}
+		result = append(result, PowerControlResult{
+			PMCMACAddress: l.PMCMACAddress,
+			Status:        StatusSuccess,
+		})
+	}
+	return result, nil
+}
+
+// GetPowershelfTelemetry returns a single sample per known powershelf whose output power is the sum of the
+// power sensor readings of its powered-on PSUs.
+func (c *mockClient) GetPowershelfTelemetry(ctx context.Context, pmcMacs []string, since time.Time) ([]PowershelfTelemetry, error) {
+	powershelves, err := c.GetPowershelves(ctx, pmcMacs)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []PowershelfTelemetry
+	for _, ps := range powershelves {
+		var power float64
+		for _, psu := range ps.PSUs {
+			if !psu.PowerState {
+				continue
+			}
+			for _, sensor := range psu.Sensors {
+				if sensor.ReadingType == "Power" {
+					power += float64(sensor.Reading)
+				}
+			}
+		}
+
+		result = append(result, PowershelfTelemetry{
+			PMCMACAddress: ps.PMC.MACAddress,
+			Samples: []TelemetrySample{
+				{
+					Timestamp:       time.Now(),
+					Readings:        TelemetryReadings{OutputPowerWatts: &power},
+					PowerLimitWatts: ps.PowerLimitWatts,
+				},
+			},
+			Status: StatusSuccess,
+		})
+	}
+	return result, nil
+}
+
+// RotateCredentials immediately marks the PMC credentials of the known powershelves as rotated.
+func (c *mockClient) RotateCredentials(ctx context.Context, pmcMacs []string) ([]PowerControlResult, error) {
+	var result []PowerControlResult
+	for _, mac := range pmcMacs {
+		key := utils.NormalizeMAC(mac)
+		if _, ok := c.powershelves[key]; !ok {
+			result = append(result, PowerControlResult{
+				PMCMACAddress: mac,
+				Status:        StatusInvalidArgument,
+				Error:         "powershelf not found",
+			})
+			continue
+		}
+		c.rotations[key] = time.Now()
+		result = append(result, PowerControlResult{
+			PMCMACAddress: mac,
+			Status:        StatusSuccess,
+		})
+	}
+	return result, nil
+}
+
+func (c *mockClient) GetCredentialRotationStatus(ctx context.Context, pmcMacs []string) ([]CredentialRotationStatus, error) {
+	powershelves, err := c.GetPowershelves(ctx, pmcMacs)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []CredentialRotationStatus
+	for _, ps := range powershelves {
+		status := CredentialRotationStatus{
+			PMCMACAddress: ps.PMC.MACAddress,
+			State:         CredentialRotationStateNeverRotated,
+			Status:        StatusSuccess,
+		}
+		if rotated, ok := c.rotations[utils.NormalizeMAC(ps.PMC.MACAddress)]; ok {
+			status.State = CredentialRotationStateSucceeded
+			status.LastAttempt = rotated
+			status.LastRotated = rotated
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
 func (c *mockClient) UpdateFirmware(ctx context.Context, requests []UpdatePowershelfFirmwareRequest) ([]UpdatePowershelfFirmwareResponse, error) {
 	var result []UpdatePowershelfFirmwareResponse
 	for _, req := range requests {
@@ -155,6 +278,29 @@ func (c *mockClient) ListAvailableFirmware(ctx context.Context, pmcMacs []string
 	return result, nil
 }
 
+// StreamEvents returns a stream of the events passed to PublishEvent that is closed when ctx is cancelled.
+func (c *mockClient) StreamEvents(ctx context.Context, pmcMacs []string, kinds []EventKind) (<-chan PowershelfEvent, error) {
+	s := &mockEventStream{kinds: kinds, ch: make(chan PowershelfEvent, mockEventBuffer)}
+	for _, mac := range pmcMacs {
+		s.pmcMacs = append(s.pmcMacs, utils.NormalizeMAC(mac))
+	}
+
+	c.mu.Lock()
+	c.streams[s] = struct{}{}
+	c.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.streams, s)
+		close(s.ch)
+	}()
+	return s.ch, nil
+}
+
 func (c *mockClient) SetDryRun(ctx context.Context, dryRun bool) error {
 	return nil
 }
@@ -162,3 +308,19 @@ func (c *mockClient) SetDryRun(ctx context.Context, dryRun bool) error {
 func (c *mockClient) AddPowershelf(ps PowerShelf) {
 	c.powershelves[utils.NormalizeMAC(ps.PMC.MACAddress)] = ps
 }
+
+// PublishEvent delivers ev to the open event streams whose filters match it.
+func (c *mockClient) PublishEvent(ev PowershelfEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for s := range c.streams {
+		if !s.matches(ev) {
+			continue
+		}
+		select {
+		case s.ch <- ev:
+		default:
+		}
+	}
+}
@@ -21,7 +21,10 @@
 
 package psmapi
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Client allows us to have both a real implementation and a mock implementation for unit tests which can be switched transparently.
 type Client interface {
@@ -38,6 +41,20 @@ type Client interface {
 	// PowerOff powers off the specified powershelves.
 	PowerOff(ctx context.Context, pmcMacs []string) ([]PowerControlResult, error)
 
+	// SetPowerLimit caps the power of the specified powershelves via Redfish PowerLimit.
+	SetPowerLimit(ctx context.Context, limits []PowerLimit) ([]PowerControlResult, error)
+
+	// GetPowershelfTelemetry returns the telemetry history of the specified powershelves taken at or after since.
+	// If pmcMacs is empty, returns the telemetry of all powershelves.
+	GetPowershelfTelemetry(ctx context.Context, pmcMacs []string, since time.Time) ([]PowershelfTelemetry, error)
+
+	// RotateCredentials starts rotating the PMC passwords of the specified powershelves in the background.
+	RotateCredentials(ctx context.Context, pmcMacs []string) ([]PowerControlResult, error)
+
+	// GetCredentialRotationStatus returns the latest credential rotation of the specified powershelves.
+	// If pmcMacs is empty, returns the status of all powershelves.
+	GetCredentialRotationStatus(ctx context.Context, pmcMacs []string) ([]CredentialRotationStatus, error)
+
 	// UpdateFirmware performs firmware upgrades on the specified powershelves.
 	UpdateFirmware(ctx context.Context, requests []UpdatePowershelfFirmwareRequest) ([]UpdatePowershelfFirmwareResponse, error)
 
@@ -50,9 +67,14 @@ type Client interface {
 	// SetDryRun configures whether the firmware manager is in Dry Run mode.
 	SetDryRun(ctx context.Context, dryRun bool) error
 
+	// StreamEvents streams the Redfish events reported by the PMCs of the specified powershelves until ctx is cancelled
+	// or the stream breaks, after which the returned channel is closed. Empty pmcMacs or kinds select everything.
+	StreamEvents(ctx context.Context, pmcMacs []string, kinds []EventKind) (<-chan PowershelfEvent, error)
+
 	// Close closes the underlying gRPC connection.
 	Close() error
 
 	// The following are only valid in the mock environment and should only be called by unit tests.
 	AddPowershelf(PowerShelf)
+	PublishEvent(PowershelfEvent)
 }
@@ -20,6 +20,7 @@ package psmapi
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestMockClient_GetPowershelves(t *testing.T) {
@@ -151,6 +152,100 @@ func TestMockClient_PowerControl(t *testing.T) {
 	}
 }
 
+func TestMockClient_PowerLimitAndTelemetry(t *testing.T) {
+	client := NewMockClient()
+	ctx := context.Background()
+
+	client.AddPowershelf(PowerShelf{
+		PMC: PowerManagementController{MACAddress: "aa:bb:cc:dd:ee:ff"},
+		PSUs: []PowerSupplyUnit{
+			{ID: "PSU0", PowerState: true, Sensors: []Sensor{{ReadingType: "Power", Reading: 2000}, {ReadingType: "Voltage", Reading: 230}}},
+			{ID: "PSU1", PowerState: true, Sensors: []Sensor{{ReadingType: "Power", Reading: 1500}}},
+			{ID: "PSU2", PowerState: false, Sensors: []Sensor{{ReadingType: "Power", Reading: 900}}},
+		},
+	})
+
+	results, err := client.SetPowerLimit(ctx, []PowerLimit{{PMCMACAddress: "AA:BB:CC:DD:EE:FF", LimitWatts: 12000}})
+	if err != nil {
+		t.Fatalf("SetPowerLimit failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Status != StatusSuccess {
+		t.Fatalf("Expected 1 successful result, got %v", results)
+	}
+
+	telemetry, err := client.GetPowershelfTelemetry(ctx, nil, time.Time{})
+	if err != nil {
+		t.Fatalf("GetPowershelfTelemetry failed: %v", err)
+	}
+	if len(telemetry) != 1 {
+		t.Fatalf("Expected telemetry of 1 powershelf, got %d", len(telemetry))
+	}
+
+	sample, ok := telemetry[0].Latest()
+	if !ok {
+		t.Fatalf("Expected a telemetry sample")
+	}
+	if power, ok := sample.Readings.PowerWatts(); !ok || power != 3500 {
+		t.Errorf("Expected 3500 W from the powered-on PSUs, got %v (ok=%v)", power, ok)
+	}
+	if sample.PowerLimitWatts == nil || *sample.PowerLimitWatts != 12000 {
+		t.Errorf("Expected a 12000 W power limit, got %v", sample.PowerLimitWatts)
+	}
+}
+
+func TestTelemetryReadings_PowerWatts(t *testing.T) {
+	input, output := 3600.0, 3500.0
+
+	if _, ok := (TelemetryReadings{}).PowerWatts(); ok {
+		t.Errorf("Expected no power without power readings")
+	}
+	if power, _ := (TelemetryReadings{OutputPowerWatts: &output}).PowerWatts(); power != output {
+		t.Errorf("Expected output power %v, got %v", output, power)
+	}
+	if power, _ := (TelemetryReadings{InputPowerWatts: &input, OutputPowerWatts: &output}).PowerWatts(); power != input {
+		t.Errorf("Expected input power %v to take precedence, got %v", input, power)
+	}
+}
+
+func TestMockClient_CredentialRotation(t *testing.T) {
+	client := NewMockClient()
+	client.AddPowershelf(PowerShelf{PMC: PowerManagementController{MACAddress: "aa:bb:cc:dd:ee:ff"}})
+	ctx := context.Background()
+
+	statuses, err := client.GetCredentialRotationStatus(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetCredentialRotationStatus failed: %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].State != CredentialRotationStateNeverRotated {
+		t.Fatalf("Expected 1 never rotated status, got %+v", statuses)
+	}
+
+	results, err := client.RotateCredentials(ctx, []string{"AA:BB:CC:DD:EE:FF", "11:22:33:44:55:66"})
+	if err != nil {
+		t.Fatalf("RotateCredentials failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if results[0].Status != StatusSuccess {
+		t.Errorf("Expected StatusSuccess for known powershelf, got %v", results[0].Status)
+	}
+	if results[1].Status != StatusInvalidArgument {
+		t.Errorf("Expected StatusInvalidArgument for unknown powershelf, got %v", results[1].Status)
+	}
+
+	statuses, err = client.GetCredentialRotationStatus(ctx, []string{"aa:bb:cc:dd:ee:ff"})
+	if err != nil {
+		t.Fatalf("GetCredentialRotationStatus failed: %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].State != CredentialRotationStateSucceeded {
+		t.Fatalf("Expected 1 succeeded status, got %+v", statuses)
+	}
+	if statuses[0].LastRotated.IsZero() {
+		t.Error("Expected LastRotated to be set")
+	}
+}
+
 func TestMockClient_UpdateFirmware(t *testing.T) {
 	client := NewMockClient()
 	ctx := context.Background()
@@ -221,6 +316,41 @@ func TestMockClient_ListAvailableFirmware(t *testing.T) {
 	}
 }
 
+func TestMockClient_StreamEvents(t *testing.T) {
+	client := NewMockClient()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	all, err := client.StreamEvents(ctx, nil, nil)
+	if err != nil {
+		t.Fatalf("StreamEvents failed: %v", err)
+	}
+	faults, err := client.StreamEvents(ctx, []string{"AA:BB:CC:DD:EE:FF"}, []EventKind{EventKindPSUFault})
+	if err != nil {
+		t.Fatalf("StreamEvents failed: %v", err)
+	}
+
+	client.PublishEvent(PowershelfEvent{PMCMACAddress: "aa:bb:cc:dd:ee:ff", Kind: EventKindPowerState})
+	client.PublishEvent(PowershelfEvent{PMCMACAddress: "aa:bb:cc:dd:ee:ff", Kind: EventKindPSUFault, Severity: "Critical"})
+
+	if ev := <-all; ev.Kind != EventKindPowerState {
+		t.Errorf("Expected power state event, got %v", ev.Kind)
+	}
+	if ev := <-all; ev.Kind != EventKindPSUFault {
+		t.Errorf("Expected PSU fault event, got %v", ev.Kind)
+	}
+	if ev := <-faults; ev.Kind != EventKindPSUFault || ev.Severity != "Critical" {
+		t.Errorf("Expected critical PSU fault event, got %+v", ev)
+	}
+
+	cancel()
+	if _, ok := <-faults; ok {
+		t.Error("Expected stream to be closed after cancel")
+	}
+	if _, ok := <-all; ok {
+		t.Error("Expected stream to be closed after cancel")
+	}
+}
+
 func TestMockClient_SetDryRun(t *testing.T) {
 	client := NewMockClient()
 	ctx := context.Background()
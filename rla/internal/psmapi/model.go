@@ -30,12 +30,15 @@ type PMCVendor int
 const (
 	PMCVendorUnknown PMCVendor = 0
 	PMCVendorLiteon  PMCVendor = 1
+	PMCVendorDelta   PMCVendor = 2
 )
 
 func pmcVendorFromPb(v pb.PMCVendor) PMCVendor {
 	switch v {
 	case pb.PMCVendor_PMC_TYPE_LITEON:
 		return PMCVendorLiteon
+	case pb.PMCVendor_PMC_TYPE_DELTA:
+		return PMCVendorDelta
 	default:
 		return PMCVendorUnknown
 	}
@@ -45,6 +48,8 @@ func pmcVendorToPb(v PMCVendor) pb.PMCVendor {
 	switch v {
 	case PMCVendorLiteon:
 		return pb.PMCVendor_PMC_TYPE_LITEON
+	case PMCVendorDelta:
+		return pb.PMCVendor_PMC_TYPE_DELTA
 	default:
 		return pb.PMCVendor_PMC_TYPE_UNKNOWN
 	}
@@ -194,6 +199,8 @@ type PowerShelf struct {
 	PMC     PowerManagementController
 	Chassis Chassis
 	PSUs    []PowerSupplyUnit
+	// PowerLimitWatts is the configured power cap; nil if the PMC does not support power capping, zero if capping is disabled.
+	PowerLimitWatts *float64
 }
 
 func powerShelfFromPb(ps *pb.PowerShelf) PowerShelf {
@@ -201,8 +208,9 @@ func powerShelfFromPb(ps *pb.PowerShelf) PowerShelf {
 		return PowerShelf{}
 	}
 	result := PowerShelf{
-		PMC:     pmcFromPb(ps.Pmc),
-		Chassis: chassisFromPb(ps.Chassis),
+		PMC:             pmcFromPb(ps.Pmc),
+		Chassis:         chassisFromPb(ps.Chassis),
+		PowerLimitWatts: ps.PowerLimitWatts,
 	}
 	for _, psu := range ps.Psus {
 		result.PSUs = append(result.PSUs, psuFromPb(psu))
@@ -247,6 +255,7 @@ const (
 	StatusSuccess         StatusCode = 0
 	StatusInvalidArgument StatusCode = 1
 	StatusInternalError   StatusCode = 2
+	StatusNotSupported    StatusCode = 3
 )
 
 func statusCodeFromPb(sc pb.StatusCode) StatusCode {
@@ -257,6 +266,8 @@ func statusCodeFromPb(sc pb.StatusCode) StatusCode {
 		return StatusInvalidArgument
 	case pb.StatusCode_INTERNAL_ERROR:
 		return StatusInternalError
+	case pb.StatusCode_NOT_SUPPORTED:
+		return StatusNotSupported
 	default:
 		return StatusInternalError
 	}
@@ -299,6 +310,107 @@ func powerControlResultFromPb(resp *pb.PowershelfResponse) PowerControlResult {
 	}
 }
 
+// PowerLimit caps the power of a powershelf. A LimitWatts of 0 disables power capping.
+type PowerLimit struct {
+	PMCMACAddress string
+	LimitWatts    float64
+}
+
+func powerLimitToPb(l PowerLimit) *pb.PowerLimit {
+	return &pb.PowerLimit{
+		PmcMacAddress: l.PMCMACAddress,
+		LimitWatts:    l.LimitWatts,
+	}
+}
+
+// TelemetryReadings contains the readings of a powershelf aggregated across its PSUs. Readings without a sensor are nil.
+type TelemetryReadings struct {
+	InputPowerWatts    *float64
+	OutputPowerWatts   *float64
+	InputVoltageVolts  *float64
+	OutputVoltageVolts *float64
+	InputCurrentAmps   *float64
+	OutputCurrentAmps  *float64
+	TemperatureCelsius *float64
+	FanSpeedRPM        *float64
+}
+
+// PowerWatts returns the power drawn by the powershelf: the input power if measured, otherwise the output power.
+func (r TelemetryReadings) PowerWatts() (float64, bool) {
+	if r.InputPowerWatts != nil {
+		return *r.InputPowerWatts, true
+	}
+	if r.OutputPowerWatts != nil {
+		return *r.OutputPowerWatts, true
+	}
+	return 0, false
+}
+
+func telemetryReadingsFromPb(r *pb.TelemetryReadings) TelemetryReadings {
+	if r == nil {
+		return TelemetryReadings{}
+	}
+	return TelemetryReadings{
+		InputPowerWatts:    r.InputPowerWatts,
+		OutputPowerWatts:   r.OutputPowerWatts,
+		InputVoltageVolts:  r.InputVoltageVolts,
+		OutputVoltageVolts: r.OutputVoltageVolts,
+		InputCurrentAmps:   r.InputCurrentAmps,
+		OutputCurrentAmps:  r.OutputCurrentAmps,
+		TemperatureCelsius: r.TemperatureCelsius,
+		FanSpeedRPM:        r.FanSpeedRpm,
+	}
+}
+
+// TelemetrySample contains the aggregate readings of a powershelf at a point in time.
+type TelemetrySample struct {
+	Timestamp time.Time
+	Readings  TelemetryReadings
+	// PowerLimitWatts is the power cap at the time of the sample; nil if the PMC does not support power capping.
+	PowerLimitWatts *float64
+}
+
+func telemetrySampleFromPb(s *pb.TelemetrySample) TelemetrySample {
+	result := TelemetrySample{
+		Readings:        telemetryReadingsFromPb(s.Readings),
+		PowerLimitWatts: s.PowerLimitWatts,
+	}
+	if s.Timestamp != nil {
+		result.Timestamp = s.Timestamp.AsTime()
+	}
+	return result
+}
+
+// PowershelfTelemetry contains the telemetry history of a powershelf, oldest sample first.
+type PowershelfTelemetry struct {
+	PMCMACAddress string
+	Rack          string
+	Samples       []TelemetrySample
+	Status        StatusCode
+	Error         string
+}
+
+// Latest returns the most recent sample, if any.
+func (t PowershelfTelemetry) Latest() (TelemetrySample, bool) {
+	if len(t.Samples) == 0 {
+		return TelemetrySample{}, false
+	}
+	return t.Samples[len(t.Samples)-1], true
+}
+
+func powershelfTelemetryFromPb(t *pb.PowershelfTelemetry) PowershelfTelemetry {
+	result := PowershelfTelemetry{
+		PMCMACAddress: t.PmcMacAddress,
+		Rack:          t.Rack,
+		Status:        statusCodeFromPb(t.Status),
+		Error:         t.Error,
+	}
+	for _, s := range t.Samples {
+		result.Samples = append(result.Samples, telemetrySampleFromPb(s))
+	}
+	return result
+}
+
 // PowershelfComponent represents a component type for firmware updates.
 type PowershelfComponent int
 
@@ -490,3 +602,147 @@ func availableFirmwareFromPb(a *pb.AvailableFirmware) AvailableFirmware {
 	}
 	return result
 }
+
+// CredentialRotationState represents the state of the latest credential rotation of a PMC.
+type CredentialRotationState int
+
+const (
+	CredentialRotationStateUnknown        CredentialRotationState = 0
+	CredentialRotationStateNeverRotated   CredentialRotationState = 1
+	CredentialRotationStateInProgress     CredentialRotationState = 2
+	CredentialRotationStateSucceeded      CredentialRotationState = 3
+	CredentialRotationStateFailed         CredentialRotationState = 4
+	CredentialRotationStateRollbackFailed CredentialRotationState = 5
+)
+
+func credentialRotationStateFromPb(s pb.CredentialRotationState) CredentialRotationState {
+	switch s {
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED:
+		return CredentialRotationStateNeverRotated
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_IN_PROGRESS:
+		return CredentialRotationStateInProgress
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_SUCCEEDED:
+		return CredentialRotationStateSucceeded
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_FAILED:
+		return CredentialRotationStateFailed
+	case pb.CredentialRotationState_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED:
+		return CredentialRotationStateRollbackFailed
+	default:
+		return CredentialRotationStateUnknown
+	}
+}
+
+// CredentialRotationStatus contains the latest credential rotation of a PMC.
+// Zero timestamps mean the event never happened (or, for NextRotation, that scheduled rotation is disabled).
+type CredentialRotationStatus struct {
+	PMCMACAddress string
+	State         CredentialRotationState
+	LastAttempt   time.Time
+	LastRotated   time.Time
+	NextRotation  time.Time
+	RotationError string
+	Status        StatusCode
+	Error         string
+}
+
+func credentialRotationStatusFromPb(s *pb.CredentialRotationStatus) CredentialRotationStatus {
+	result := CredentialRotationStatus{
+		PMCMACAddress: s.PmcMacAddress,
+		State:         credentialRotationStateFromPb(s.State),
+		RotationError: s.RotationError,
+		Status:        statusCodeFromPb(s.Status),
+		Error:         s.Error,
+	}
+	if s.LastAttempt != nil {
+		result.LastAttempt = s.LastAttempt.AsTime()
+	}
+	if s.LastRotated != nil {
+		result.LastRotated = s.LastRotated.AsTime()
+	}
+	if s.NextRotation != nil {
+		result.NextRotation = s.NextRotation.AsTime()
+	}
+	return result
+}
+
+// EventKind classifies the Redfish events reported by PMCs.
+type EventKind int
+
+const (
+	EventKindUnknown    EventKind = 0
+	EventKindPSUFault   EventKind = 1
+	EventKindPowerState EventKind = 2
+	EventKindTask       EventKind = 3
+	EventKindOther      EventKind = 4
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventKindPSUFault:
+		return "PSUFault"
+	case EventKindPowerState:
+		return "PowerState"
+	case EventKindTask:
+		return "Task"
+	case EventKindOther:
+		return "Other"
+	default:
+		return fmt.Sprintf("Unknown(%d)", int(k))
+	}
+}
+
+func eventKindFromPb(k pb.PowershelfEventKind) EventKind {
+	switch k {
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT:
+		return EventKindPSUFault
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_POWER_STATE:
+		return EventKindPowerState
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_TASK:
+		return EventKindTask
+	case pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_OTHER:
+		return EventKindOther
+	default:
+		return EventKindUnknown
+	}
+}
+
+func eventKindToPb(k EventKind) pb.PowershelfEventKind {
+	switch k {
+	case EventKindPSUFault:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_PSU_FAULT
+	case EventKindPowerState:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_POWER_STATE
+	case EventKindTask:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_TASK
+	case EventKindOther:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_OTHER
+	default:
+		return pb.PowershelfEventKind_POWERSHELF_EVENT_KIND_UNKNOWN
+	}
+}
+
+// PowershelfEvent is a Redfish event reported by the PMC of a powershelf.
+type PowershelfEvent struct {
+	PMCMACAddress string
+	Kind          EventKind
+	Severity      string
+	MessageID     string
+	Message       string
+	Origin        string // URI of the resource the event is about
+	Timestamp     time.Time
+}
+
+func powershelfEventFromPb(ev *pb.PowershelfEvent) PowershelfEvent {
+	result := PowershelfEvent{
+		PMCMACAddress: ev.PmcMacAddress,
+		Kind:          eventKindFromPb(ev.Kind),
+		Severity:      ev.Severity,
+		MessageID:     ev.MessageId,
+		Message:       ev.Message,
+		Origin:        ev.Origin,
+	}
+	if ev.Timestamp != nil {
+		result.Timestamp = ev.Timestamp.AsTime()
+	}
+	return result
+}
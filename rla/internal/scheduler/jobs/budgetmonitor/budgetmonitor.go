@@ -0,0 +1,121 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package budgetmonitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/alert"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/powerbudget"
+)
+
+const alertComponent = "power-budget"
+
+// budgetReader is the subset of powerbudget.Manager the monitor needs.
+type budgetReader interface {
+	BudgetedRacks(ctx context.Context) ([]uuid.UUID, error)
+	RackStatus(ctx context.Context, rackID uuid.UUID) (*powerbudget.RackStatus, error)
+}
+
+// runBudgetMonitorOne compares the measured draw of every budgeted rack
+// against its budget. It raises a critical alert when a rack goes over budget
+// and an info alert when it recovers; over tracks which racks are currently
+// alerted so a rack that stays over budget is reported once.
+func runBudgetMonitorOne(
+	ctx context.Context,
+	budgets budgetReader,
+	over map[uuid.UUID]bool,
+	send func(ctx context.Context, a alert.Alert) error,
+) {
+	rackIDs, err := budgets.BudgetedRacks(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Unable to list racks with a power budget")
+		return
+	}
+
+	budgeted := make(map[uuid.UUID]bool, len(rackIDs))
+	for _, rackID := range rackIDs {
+		budgeted[rackID] = true
+
+		status, err := budgets.RackStatus(ctx, rackID)
+		if err != nil {
+			log.Warn().Err(err).Str("rack_id", rackID.String()).
+				Msg("Unable to measure rack power draw")
+			continue
+		}
+
+		isOver := status.OverBudget()
+		switch {
+		case isOver && !over[rackID]:
+			over[rackID] = true
+			send(ctx, overBudgetAlert(status))
+		case !isOver && over[rackID]:
+			delete(over, rackID)
+			send(ctx, recoveredAlert(status))
+		}
+	}
+
+	// Forget racks whose budget was removed.
+	for rackID := range over {
+		if !budgeted[rackID] {
+			delete(over, rackID)
+		}
+	}
+}
+
+func overBudgetAlert(status *powerbudget.RackStatus) alert.Alert {
+	details := statusDetails(status)
+	for _, shelf := range status.Shelves {
+		if shelf.OverCap() {
+			details["shelf_"+shelf.PMCMACAddress] = fmt.Sprintf(
+				"%.0f W over %.0f W cap", shelf.DrawWatts, shelf.CapWatts,
+			)
+		}
+	}
+
+	return alert.Alert{
+		Severity:  alert.SeverityCritical,
+		Message:   fmt.Sprintf("Rack %s is over its power budget", status.RackID),
+		Component: alertComponent,
+		Details:   details,
+	}
+}
+
+func recoveredAlert(status *powerbudget.RackStatus) alert.Alert {
+	return alert.Alert{
+		Severity:  alert.SeverityInfo,
+		Message:   fmt.Sprintf("Rack %s is back within its power budget", status.RackID),
+		Component: alertComponent,
+		Details:   statusDetails(status),
+	}
+}
+
+func statusDetails(status *powerbudget.RackStatus) map[string]string {
+	details := map[string]string{
+		"rack_id":    status.RackID.String(),
+		"draw_watts": fmt.Sprintf("%.0f", status.DrawWatts),
+	}
+	if status.BudgetWatts > 0 {
+		details["budget_watts"] = fmt.Sprintf("%.0f", status.BudgetWatts)
+	}
+	return details
+}
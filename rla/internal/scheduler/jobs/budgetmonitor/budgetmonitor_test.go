@@ -0,0 +1,146 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package budgetmonitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/alert"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/powerbudget"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
+)
+
+// --- mock budgetReader ---
+
+type mockBudgets struct {
+	statuses  map[uuid.UUID]*powerbudget.RackStatus
+	statusErr error
+}
+
+func (m *mockBudgets) BudgetedRacks(_ context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for id := range m.statuses {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (m *mockBudgets) RackStatus(_ context.Context, rackID uuid.UUID) (*powerbudget.RackStatus, error) {
+	if m.statusErr != nil {
+		return nil, m.statusErr
+	}
+	return m.statuses[rackID], nil
+}
+
+type recorder struct {
+	alerts []alert.Alert
+}
+
+func (r *recorder) send(_ context.Context, a alert.Alert) error {
+	r.alerts = append(r.alerts, a)
+	return nil
+}
+
+// --- tests ---
+
+func TestRun_AlertsOnTransitions(t *testing.T) {
+	ctx := context.Background()
+	rackID := uuid.New()
+	status := &powerbudget.RackStatus{RackID: rackID, BudgetWatts: 8000, DrawWatts: 7000, Measured: true}
+	budgets := &mockBudgets{statuses: map[uuid.UUID]*powerbudget.RackStatus{rackID: status}}
+	rec := &recorder{}
+	job := newJob(budgets)
+	job.send = rec.send
+
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	assert.Empty(t, rec.alerts, "within budget")
+
+	status.DrawWatts = 8500
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	require.Len(t, rec.alerts, 1)
+	assert.Equal(t, alert.SeverityCritical, rec.alerts[0].Severity)
+	assert.Equal(t, "8500", rec.alerts[0].Details["draw_watts"])
+	assert.Equal(t, "8000", rec.alerts[0].Details["budget_watts"])
+
+	// Staying over budget does not alert again.
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	assert.Len(t, rec.alerts, 1)
+
+	status.DrawWatts = 7500
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	require.Len(t, rec.alerts, 2)
+	assert.Equal(t, alert.SeverityInfo, rec.alerts[1].Severity)
+}
+
+func TestRun_ShelfOverCap(t *testing.T) {
+	ctx := context.Background()
+	rackID := uuid.New()
+	status := &powerbudget.RackStatus{
+		RackID:   rackID,
+		Measured: true,
+		Shelves: []powerbudget.ShelfStatus{
+			{PMCMACAddress: "aa:aa", CapWatts: 3000, DrawWatts: 3200, Measured: true},
+		},
+	}
+	budgets := &mockBudgets{statuses: map[uuid.UUID]*powerbudget.RackStatus{rackID: status}}
+	rec := &recorder{}
+	job := newJob(budgets)
+	job.send = rec.send
+
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	require.Len(t, rec.alerts, 1)
+	assert.Contains(t, rec.alerts[0].Details, "shelf_aa:aa")
+}
+
+func TestRun_StatusErrorKeepsState(t *testing.T) {
+	ctx := context.Background()
+	rackID := uuid.New()
+	status := &powerbudget.RackStatus{RackID: rackID, BudgetWatts: 8000, DrawWatts: 9000, Measured: true}
+	budgets := &mockBudgets{statuses: map[uuid.UUID]*powerbudget.RackStatus{rackID: status}}
+	rec := &recorder{}
+	job := newJob(budgets)
+	job.send = rec.send
+
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	require.Len(t, rec.alerts, 1)
+
+	// An unreadable rack is neither recovered nor re-alerted.
+	budgets.statusErr = errors.New("psm unavailable")
+	require.NoError(t, job.Run(ctx, types.Event{}))
+	assert.Len(t, rec.alerts, 1)
+	assert.True(t, job.over[rackID])
+}
+
+func TestNew_Disabled(t *testing.T) {
+	cfg := config.UnitTestConfig()
+	cfg.DisablePowerBudget = true
+	job, err := New(nil, cfg)
+	require.NoError(t, err)
+	assert.Nil(t, job)
+
+	job, err = New(nil, config.UnitTestConfig())
+	require.NoError(t, err)
+	assert.Nil(t, job, "no manager")
+}
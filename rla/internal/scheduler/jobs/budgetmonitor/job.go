@@ -0,0 +1,77 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package budgetmonitor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/alert"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/powerbudget"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
+)
+
+// Job implements scheduler.Job for the rack power budget monitor.
+type Job struct {
+	budgets budgetReader
+	send    func(ctx context.Context, a alert.Alert) error
+
+	mu   sync.Mutex
+	over map[uuid.UUID]bool // racks currently alerted as over budget
+}
+
+// New constructs a power budget monitor Job. Returns nil, nil if the monitor
+// is disabled or no Powershelf Manager is available to measure the draw.
+func New(budgets *powerbudget.Manager, cfg config.Config) (*Job, error) {
+	if cfg.DisablePowerBudget {
+		log.Info().Msg("Power budget monitoring disabled by configuration")
+		return nil, nil
+	}
+
+	if budgets == nil || !budgets.CanMeasure() {
+		log.Error().
+			Msg("Powershelf Manager not available; power budget monitoring disabled")
+		return nil, nil
+	}
+
+	return newJob(budgets), nil
+}
+
+func newJob(budgets budgetReader) *Job {
+	return &Job{
+		budgets: budgets,
+		send:    alert.Send,
+		over:    make(map[uuid.UUID]bool),
+	}
+}
+
+// Name returns the job name.
+func (j *Job) Name() string { return "power-budget-monitor" }
+
+// Run executes one iteration of the power budget monitor.
+func (j *Job) Run(ctx context.Context, _ types.Event) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	runBudgetMonitorOne(ctx, j.budgets, j.over, j.send)
+	return nil
+}
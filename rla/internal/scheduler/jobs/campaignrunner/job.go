@@ -0,0 +1,56 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package campaignrunner schedules the advancement of fleet rollout
+// campaigns: recording the outcome of rack tasks and starting the next batch.
+package campaignrunner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/campaign"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
+)
+
+// Job implements scheduler.Job for campaigns.
+type Job struct {
+	campaigns *campaign.Manager
+}
+
+// New constructs a campaign Job.
+func New(manager *campaign.Manager) (*Job, error) {
+	if manager == nil {
+		return nil, errors.New("campaign manager is required")
+	}
+
+	return &Job{campaigns: manager}, nil
+}
+
+// Name returns the job name.
+func (j *Job) Name() string { return "campaign-runner" }
+
+// Run advances every unfinished campaign once. The job runs on every RLA
+// instance; campaigns another instance is advancing are skipped.
+func (j *Job) Run(ctx context.Context, _ types.Event) error {
+	if err := j.campaigns.Advance(ctx); err != nil {
+		log.Error().Err(err).Msg("Campaign advancement failed")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package devicediscovery schedules the Redfish sweep of the management
+// subnets that finds power shelves and NV-Switch trays.
+package devicediscovery
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/discovery"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
+)
+
+// Job implements scheduler.Job for device discovery.
+type Job struct {
+	discovery *discovery.Manager
+}
+
+// New constructs a device discovery Job. Returns nil, nil if no management
+// subnets are configured.
+func New(manager *discovery.Manager) (*Job, error) {
+	if manager == nil || !manager.Enabled() {
+		log.Info().Msg("No discovery subnets configured; device discovery disabled")
+		return nil, nil
+	}
+
+	return &Job{discovery: manager}, nil
+}
+
+// Name returns the job name.
+func (j *Job) Name() string { return "device-discovery" }
+
+// Run sweeps the configured subnets once. A sweep already started through
+// the API is left to finish.
+func (j *Job) Run(ctx context.Context, _ types.Event) error {
+	_, err := j.discovery.Sweep(ctx, nil)
+	switch {
+	case errors.Is(err, discovery.ErrSweepRunning):
+		log.Debug().Msg("Device discovery sweep already running; skipping")
+	case err != nil:
+		log.Error().Err(err).Msg("Device discovery sweep failed")
+	}
+	return nil
+}
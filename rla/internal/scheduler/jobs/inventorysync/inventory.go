@@ -23,12 +23,14 @@ import (
 	"net"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/uptrace/bun"
 
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi/gen"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
@@ -37,8 +39,6 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
-const driftFieldSerialNumber = "serial_number"
-
 // runInventoryOne is a single iteration for RunInventory.
 // It syncs each resource type against its external source, collects all drifts,
 // and persists them in one shot.
@@ -48,13 +48,68 @@ func runInventoryOne(
 	carbideClient carbideapi.Client,
 	psmClient psmapi.Client,
 	nsmClient nsmapi.Client,
+	cduClient cduapi.Client,
 	cmConfig componentmanager.Config,
 ) {
+	allDrifts := collectDrifts(ctx, pool, carbideClient, psmClient, nsmClient, cduClient, cmConfig)
+
+	// Persist all drifts atomically (replace entire table)
+	if err := pool.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		return model.ReplaceAllDrifts(ctx, tx, allDrifts)
+	}); err != nil {
+		log.Error().Msgf("Unable to persist drift records: %v", err)
+	} else {
+		log.Info().Msgf("Drift detection complete: %d drift(s) detected", len(allDrifts))
+	}
+}
+
+// runInventoryRack is a single iteration for one rack. Sources are read in
+// bulk, so every resource type is synced as in runInventoryOne, but only the
+// drift records of the rack's components are replaced. Drifts of components
+// missing from the inventory belong to no rack and are left alone.
+func runInventoryRack(
+	ctx context.Context,
+	pool *cdb.Session,
+	carbideClient carbideapi.Client,
+	psmClient psmapi.Client,
+	nsmClient nsmapi.Client,
+	cduClient cduapi.Client,
+	cmConfig componentmanager.Config,
+	rackID uuid.UUID,
+) error {
+	allDrifts := collectDrifts(ctx, pool, carbideClient, psmClient, nsmClient, cduClient, cmConfig)
+
+	var rackDrifts []model.ComponentDrift
+	if err := pool.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		rackDrifts, err = model.ReplaceRackDrifts(ctx, tx, rackID, allDrifts)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to persist drift records of rack %s: %w", rackID, err)
+	}
+
+	log.Info().Msgf("Drift detection complete for rack %s: %d drift(s) detected", rackID, len(rackDrifts))
+
+	return nil
+}
+
+// collectDrifts syncs each resource type against its external source and
+// returns the drifts found, each recorded with the type of the synced
+// components.
+func collectDrifts(
+	ctx context.Context,
+	pool *cdb.Session,
+	carbideClient carbideapi.Client,
+	psmClient psmapi.Client,
+	nsmClient nsmapi.Client,
+	cduClient cduapi.Client,
+	cmConfig componentmanager.Config,
+) []model.ComponentDrift {
 	var allDrifts []model.ComponentDrift
 
 	// Sync machines against Carbide
 	machineDrifts := syncMachines(ctx, pool, carbideClient)
-	allDrifts = append(allDrifts, machineDrifts...)
+	allDrifts = append(allDrifts, withComponentType(machineDrifts, devicetypes.ComponentTypeCompute)...)
 
 	// Sync NVL switches: dispatch based on configured component manager
 	var nvlSwitchDrifts []model.ComponentDrift
@@ -63,7 +118,7 @@ func runInventoryOne(
 	} else {
 		nvlSwitchDrifts = syncNVSwitches(ctx, pool, carbideClient, nsmClient)
 	}
-	allDrifts = append(allDrifts, nvlSwitchDrifts...)
+	allDrifts = append(allDrifts, withComponentType(nvlSwitchDrifts, devicetypes.ComponentTypeNVLSwitch)...)
 
 	// Sync powershelves: dispatch based on configured component manager
 	var powershelfDrifts []model.ComponentDrift
@@ -72,16 +127,21 @@ func runInventoryOne(
 	} else {
 		powershelfDrifts = syncPowershelves(ctx, pool, carbideClient, psmClient)
 	}
-	allDrifts = append(allDrifts, powershelfDrifts...)
+	allDrifts = append(allDrifts, withComponentType(powershelfDrifts, devicetypes.ComponentTypePowerShelf)...)
 
-	// Persist all drifts atomically (replace entire table)
-	if err := pool.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
-		return model.ReplaceAllDrifts(ctx, tx, allDrifts)
-	}); err != nil {
-		log.Error().Msgf("Unable to persist drift records: %v", err)
-	} else {
-		log.Info().Msgf("Drift detection complete: %d drift(s) detected", len(allDrifts))
+	// Sync CDUs against their Redfish BMCs
+	cduDrifts := syncCDUs(ctx, pool, cduClient)
+	allDrifts = append(allDrifts, withComponentType(cduDrifts, devicetypes.ComponentTypeCDU)...)
+
+	return allDrifts
+}
+
+// withComponentType records the type of the synced components on drifts.
+func withComponentType(drifts []model.ComponentDrift, t devicetypes.ComponentType) []model.ComponentDrift {
+	for i := range drifts {
+		drifts[i].ComponentType = devicetypes.ComponentTypeToString(t)
 	}
+	return drifts
 }
 
 func isMachineComponentType(t string) bool {
@@ -423,21 +483,21 @@ func compareMachineFieldsForDrift(
 	if position != nil {
 		if position.PhysicalSlotNum != nil && expected.SlotID != int(*position.PhysicalSlotNum) {
 			diffs = append(diffs, model.FieldDiff{
-				FieldName:     "slot_id",
+				FieldName:     model.DriftFieldSlotID,
 				ExpectedValue: fmt.Sprintf("%d", expected.SlotID),
 				ActualValue:   fmt.Sprintf("%d", *position.PhysicalSlotNum),
 			})
 		}
 		if position.ComputeTrayIndex != nil && expected.TrayIndex != int(*position.ComputeTrayIndex) {
 			diffs = append(diffs, model.FieldDiff{
-				FieldName:     "tray_index",
+				FieldName:     model.DriftFieldTrayIndex,
 				ExpectedValue: fmt.Sprintf("%d", expected.TrayIndex),
 				ActualValue:   fmt.Sprintf("%d", *position.ComputeTrayIndex),
 			})
 		}
 		if position.TopologyID != nil && expected.HostID != int(*position.TopologyID) {
 			diffs = append(diffs, model.FieldDiff{
-				FieldName:     "host_id",
+				FieldName:     model.DriftFieldHostID,
 				ExpectedValue: fmt.Sprintf("%d", expected.HostID),
 				ActualValue:   fmt.Sprintf("%d", *position.TopologyID),
 			})
@@ -445,23 +505,23 @@ func compareMachineFieldsForDrift(
 	} else {
 		if expected.SlotID != 0 {
 			diffs = append(diffs, model.FieldDiff{
-				FieldName:     "slot_id",
+				FieldName:     model.DriftFieldSlotID,
 				ExpectedValue: fmt.Sprintf("%d", expected.SlotID),
-				ActualValue:   "<missing>",
+				ActualValue:   model.DriftValueMissing,
 			})
 		}
 		if expected.TrayIndex != 0 {
 			diffs = append(diffs, model.FieldDiff{
-				FieldName:     "tray_index",
+				FieldName:     model.DriftFieldTrayIndex,
 				ExpectedValue: fmt.Sprintf("%d", expected.TrayIndex),
-				ActualValue:   "<missing>",
+				ActualValue:   model.DriftValueMissing,
 			})
 		}
 		if expected.HostID != 0 {
 			diffs = append(diffs, model.FieldDiff{
-				FieldName:     "host_id",
+				FieldName:     model.DriftFieldHostID,
 				ExpectedValue: fmt.Sprintf("%d", expected.HostID),
-				ActualValue:   "<missing>",
+				ActualValue:   model.DriftValueMissing,
 			})
 		}
 	}
@@ -469,7 +529,7 @@ func compareMachineFieldsForDrift(
 	// Compare serial_number (chassis_serial)
 	if actual.ChassisSerial != nil && expected.SerialNumber != *actual.ChassisSerial {
 		diffs = append(diffs, model.FieldDiff{
-			FieldName:     driftFieldSerialNumber,
+			FieldName:     model.DriftFieldSerialNumber,
 			ExpectedValue: expected.SerialNumber,
 			ActualValue:   *actual.ChassisSerial,
 		})
@@ -609,7 +669,7 @@ func syncNVSwitches(
 					ExternalID:  &registeredSW.UUID,
 					DriftType:   model.DriftTypeMismatch,
 					Diffs: []model.FieldDiff{{
-						FieldName:     driftFieldSerialNumber,
+						FieldName:     model.DriftFieldSerialNumber,
 						ExpectedValue: nvswitch.SerialNumber,
 						ActualValue:   registeredSW.ChassisSerial,
 					}},
@@ -907,6 +967,132 @@ func syncPowershelves(
 	return drifts
 }
 
+// ---------------------------------------------------------------------------
+// syncCDUs: sync CDU components against their Redfish BMCs
+// ---------------------------------------------------------------------------
+//
+// Flow:
+//  1. DB: get all CDU components with BMCs
+//  2. Direct-write external_id (the BMC MAC), which the CDU component manager
+//     uses to address the CDU
+//  3. CDU GetCDUs: read each CDU from its BMC
+//  4. Direct-write: firmware_version, power_state (enabled → on)
+//  5. Return drifts (missing_in_actual for CDUs that cannot be read)
+
+func syncCDUs(
+	ctx context.Context,
+	pool *cdb.Session,
+	cduClient cduapi.Client,
+) []model.ComponentDrift {
+	if cduClient == nil {
+		log.Debug().Msg("CDU client not available, skipping CDU sync")
+		return nil
+	}
+
+	log.Debug().Msg("Syncing CDUs...")
+
+	// Step 1: Get all CDU components with their BMCs
+	expectedCDUs, err := model.GetComponentsByType(ctx, pool.DB, devicetypes.ComponentTypeCDU)
+	if err != nil {
+		log.Error().Msgf("Unable to retrieve CDU components from db: %v", err)
+		return nil
+	}
+
+	if len(expectedCDUs) == 0 {
+		return nil
+	}
+
+	// Each CDU should have exactly one BMC
+	expectedByMac := make(map[string]*model.Component)
+	for i := range expectedCDUs {
+		cdu := &expectedCDUs[i]
+		if len(cdu.BMCs) != 1 {
+			log.Error().Msgf("CDU %s has %d BMCs, expected exactly 1; skipping", cdu.SerialNumber, len(cdu.BMCs))
+			continue
+		}
+
+		macAddr, err := net.ParseMAC(cdu.BMCs[0].MacAddress)
+		if err != nil || macAddr == nil {
+			log.Error().Msgf("CDU %s has invalid BMC MAC address %s; skipping", cdu.SerialNumber, cdu.BMCs[0].MacAddress)
+			continue
+		}
+
+		expectedByMac[macAddr.String()] = cdu
+	}
+
+	// Step 2: Direct-write external_id
+	macs := make([]string, 0, len(expectedByMac))
+	for mac, cdu := range expectedByMac {
+		macs = append(macs, mac)
+		if cdu.ComponentID == nil || *cdu.ComponentID != mac {
+			extID := mac
+			cdu.ComponentID = &extID
+			if err := cdu.Patch(ctx, pool.DB); err != nil {
+				log.Error().Msgf("Unable to set external_id for CDU %s: %v", mac, err)
+			} else {
+				log.Info().Msgf("Setting external_id for CDU %s to BMC MAC", mac)
+			}
+		}
+	}
+
+	// Step 3: Read the CDUs
+	actual, err := cduClient.GetCDUs(ctx, macs)
+	if err != nil {
+		log.Error().Msgf("Unable to read CDUs: %v", err)
+		return nil
+	}
+
+	actualByMac := make(map[string]cduapi.CDU, len(actual))
+	for _, cdu := range actual {
+		actualByMac[utils.NormalizeMAC(cdu.ComponentID)] = cdu
+	}
+
+	// Steps 4 & 5
+	now := time.Now()
+	var drifts []model.ComponentDrift
+	for mac, expected := range expectedByMac {
+		cdu, found := actualByMac[mac]
+		if !found || cdu.Error != "" {
+			log.Warn().Msgf("CDU %s cannot be read: %s", mac, cdu.Error)
+			compID := expected.ID
+			drifts = append(drifts, model.ComponentDrift{
+				ComponentID: &compID,
+				ExternalID:  nil,
+				DriftType:   model.DriftTypeMissingInActual,
+				Diffs:       []model.FieldDiff{},
+				CheckedAt:   now,
+			})
+			continue
+		}
+
+		needsUpdate := false
+		if cdu.FirmwareVersion != "" && expected.FirmwareVersion != cdu.FirmwareVersion {
+			expected.FirmwareVersion = cdu.FirmwareVersion
+			needsUpdate = true
+			log.Info().Msgf("Updating firmware version for CDU %s to %s", mac, cdu.FirmwareVersion)
+		}
+
+		powerState := carbideapi.PowerStateOff
+		if cdu.Enabled {
+			powerState = carbideapi.PowerStateOn
+		}
+		if expected.PowerState == nil || *expected.PowerState != powerState {
+			expected.PowerState = &powerState
+			needsUpdate = true
+			log.Info().Msgf("Updating power state for CDU %s to %v", mac, powerState)
+		}
+
+		if needsUpdate {
+			if err := expected.Patch(ctx, pool.DB); err != nil {
+				log.Error().Msgf("Unable to update CDU %s: %v", mac, err)
+			}
+		}
+	}
+
+	log.Info().Msgf("CDU sync: %d drift(s) out of %d expected", len(drifts), len(expectedCDUs))
+	return drifts
+}
+
 // ---------------------------------------------------------------------------
 // syncNVSwitchesCarbide: sync NVLSwitch components via Core (Carbide)
 // ---------------------------------------------------------------------------
@@ -1210,7 +1396,7 @@ func applyInventoryToComponents(
 					ExternalID:  &extID,
 					DriftType:   model.DriftTypeMismatch,
 					Diffs: []model.FieldDiff{{
-						FieldName:     driftFieldSerialNumber,
+						FieldName:     model.DriftFieldSerialNumber,
 						ExpectedValue: comp.SerialNumber,
 						ActualValue:   sn,
 					}},
@@ -29,6 +29,7 @@ import (
 
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
@@ -82,7 +83,7 @@ func TestInventory(t *testing.T) {
 
 	psmMock := psmapi.NewMockClient()
 	nsmMock := nsmapi.NewMockClient()
-	runInventoryOne(ctx, pool, grpcMock, psmMock, nsmMock, componentmanager.DefaultTestConfig())
+	runInventoryOne(ctx, pool, grpcMock, psmMock, nsmMock, nil, componentmanager.DefaultTestConfig())
 
 	rows, err := pool.DB.Query("SELECT serial_number, power_state FROM component;")
 	assert.NotNil(t, rows)
@@ -150,7 +151,7 @@ func TestSyncFirmwareVersion(t *testing.T) {
 
 	psmMock := psmapi.NewMockClient()
 	nsmMock := nsmapi.NewMockClient()
-	runInventoryOne(ctx, pool, grpcMock, psmMock, nsmMock, componentmanager.DefaultTestConfig())
+	runInventoryOne(ctx, pool, grpcMock, psmMock, nsmMock, nil, componentmanager.DefaultTestConfig())
 
 	var updated1 model.Component
 	err = pool.DB.NewSelect().Model(&updated1).Where("id = ?", c1.ID).Scan(ctx)
@@ -419,7 +420,7 @@ func TestHandleExpectedPowershelves(t *testing.T) {
 
 	// Run the inventory loop
 	nsmMock := nsmapi.NewMockClient()
-	runInventoryOne(ctx, pool, carbideMock, psmMock, nsmMock, componentmanager.DefaultTestConfig())
+	runInventoryOne(ctx, pool, carbideMock, psmMock, nsmMock, nil, componentmanager.DefaultTestConfig())
 
 	// Verify that only expected PMCs that have DHCPed were registered with PSM
 	registeredPowershelves, err := psmMock.GetPowershelves(ctx, []string{})
@@ -742,7 +743,7 @@ func TestHandleExpectedNVSwitches(t *testing.T) {
 	assert.Equal(t, 1, len(preRegistered), "Should have 1 pre-registered switch (SW7)")
 
 	// Run the inventory loop
-	runInventoryOne(ctx, pool, carbideMock, psmMock, nsmMock, componentmanager.DefaultTestConfig())
+	runInventoryOne(ctx, pool, carbideMock, psmMock, nsmMock, nil, componentmanager.DefaultTestConfig())
 
 	// --- Verify NSM registrations ---
 	registeredSwitches, err := nsmMock.GetNVSwitches(ctx, nil)
@@ -808,7 +809,7 @@ func TestHandleExpectedNVSwitches(t *testing.T) {
 	nsmMock.SetNVSwitchFirmware("aa:bb:cc:11:11:01", "3.0.0")
 	nsmMock.SetNVSwitchFirmware("aa:bb:cc:11:11:02", "3.1.0")
 
-	runInventoryOne(ctx, pool, carbideMock, psmMock, nsmMock, componentmanager.DefaultTestConfig())
+	runInventoryOne(ctx, pool, carbideMock, psmMock, nsmMock, nil, componentmanager.DefaultTestConfig())
 
 	// SW1: external_id and firmware_version should now be set
 	var updatedSw1 model.Component
@@ -864,3 +865,77 @@ func TestHandleExpectedNVSwitches(t *testing.T) {
 		}
 	}
 }
+
+// TestSyncCDUs verifies that syncCDUs sets each CDU's external_id to its BMC
+// MAC, direct-writes firmware version and power state, and records
+// unreadable CDUs as missing.
+func TestSyncCDUs(t *testing.T) {
+	ctx := context.Background()
+
+	if os.Getenv("DB_PORT") == "" {
+		log.Warn().Msgf("Not running unit test due to no DB environment specified")
+		t.SkipNow()
+	}
+
+	dbConf, err := cdb.ConfigFromEnv()
+	assert.Nil(t, err)
+	pool, err := utils.UnitTestDB(ctx, t, dbConf)
+	assert.Nil(t, err)
+
+	rack := model.Rack{
+		Name:         "test-rack-cdu",
+		Manufacturer: "TestMfg",
+		SerialNumber: "rack-serial-cdu",
+	}
+	err = rack.Create(ctx, pool.DB)
+	assert.Nil(t, err)
+
+	createCDU := func(name string, mac string) model.Component {
+		c := model.Component{
+			Name:         name,
+			Type:         devicetypes.ComponentTypeCDU.String(),
+			Manufacturer: "CoolMfg",
+			SerialNumber: name + "-serial",
+			RackID:       rack.ID,
+		}
+		assert.Nil(t, c.Create(ctx, pool.DB))
+
+		b := model.BMC{
+			MacAddress:  mac,
+			Type:        devicetypes.BMCTypeHost.String(),
+			ComponentID: c.ID,
+		}
+		assert.Nil(t, pool.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+			return b.Create(ctx, tx)
+		}))
+		return c
+	}
+
+	enabled := createCDU("cdu-1", "AA:BB:CC:00:10:01")
+	missing := createCDU("cdu-2", "aa:bb:cc:00:10:02")
+
+	cduMock := cduapi.NewMockClient()
+	cduMock.AddCDU(cduapi.CDU{
+		ComponentID:     "aa:bb:cc:00:10:01",
+		FirmwareVersion: "1.4.2",
+		Enabled:         true,
+	})
+
+	drifts := syncCDUs(ctx, pool, cduMock)
+
+	var updated model.Component
+	err = pool.DB.NewSelect().Model(&updated).Where("id = ?", enabled.ID).Scan(ctx)
+	assert.Nil(t, err)
+	if assert.NotNil(t, updated.ComponentID) {
+		assert.Equal(t, "aa:bb:cc:00:10:01", *updated.ComponentID)
+	}
+	assert.Equal(t, "1.4.2", updated.FirmwareVersion)
+	if assert.NotNil(t, updated.PowerState) {
+		assert.Equal(t, carbideapi.PowerStateOn, *updated.PowerState)
+	}
+
+	if assert.Len(t, drifts, 1) {
+		assert.Equal(t, missing.ID, *drifts[0].ComponentID)
+		assert.Equal(t, model.DriftTypeMissingInActual, drifts[0].DriftType)
+	}
+}
@@ -20,17 +20,21 @@ package inventorysync
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager"
 	carbideprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/carbide"                 //nolint
+	cduprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/cdu"                         //nolint
 	nvswitchmanagerprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvswitchmanager" //nolint
 	psmprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/psm"                         //nolint
 )
@@ -41,8 +45,13 @@ type Job struct {
 	carbideClient carbideapi.Client
 	psmClient     psmapi.Client
 	nsmClient     nsmapi.Client
+	cduClient     cduapi.Client
 	pool          *cdb.Session
 	cmConfig      componentmanager.Config
+
+	// mu serializes scheduled runs and on-demand rack resyncs, which write
+	// the same components and drift records.
+	mu sync.Mutex
 }
 
 // New constructs an inventory sync Job using clients sourced from the provider
@@ -103,6 +112,20 @@ func New(
 		nsmClient = nsmProvider.Client()
 	}
 
+	// CDU provider is optional: only needed when the CDU component manager
+	// is configured to use the redfish implementation.
+	var cduClient cduapi.Client
+	cduProvider, err := componentmanager.GetTyped[*cduprovider.Provider](
+		providers, cduprovider.ProviderName,
+	)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Msg("CDU provider not available; CDU sync skipped")
+	} else {
+		cduClient = cduProvider.Client()
+	}
+
 	pool, err := cdb.NewSessionFromConfig(ctx, *dbConf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create database pool: %w", err)
@@ -125,6 +148,7 @@ func New(
 		carbideClient: carbideProvider.Client(),
 		psmClient:     psmClient,
 		nsmClient:     nsmClient,
+		cduClient:     cduClient,
 		pool:          pool,
 		cmConfig:      cmConfig,
 	}, nil
@@ -139,10 +163,27 @@ func (j *Job) Name() string { return "inventory-sync" }
 // error is also logged rather than propagated. A failed iteration is not
 // fatal — the scheduler will simply retry on the next trigger fire.
 func (j *Job) Run(ctx context.Context, _ types.Event) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
 	runInventoryOne(
 		ctx, j.pool,
-		j.carbideClient, j.psmClient, j.nsmClient,
+		j.carbideClient, j.psmClient, j.nsmClient, j.cduClient,
 		j.cmConfig,
 	)
 	return nil
 }
+
+// ResyncRack runs an inventory sync now and replaces the drift records of the
+// components of the rack. It waits for a scheduled run in progress to finish.
+func (j *Job) ResyncRack(ctx context.Context, rackID uuid.UUID) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return runInventoryRack(
+		ctx, j.pool,
+		j.carbideClient, j.psmClient, j.nsmClient, j.cduClient,
+		j.cmConfig,
+		rackID,
+	)
+}
@@ -23,22 +23,27 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/config"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager"
 	carbideprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/carbide" //nolint
+	cduprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/cdu"
 	taskmanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/manager"
 )
 
-// Job implements scheduler.Job for the leak detection task.
+// Job implements scheduler.Job for the leak detection task. It watches the
+// machine leak sensors reported by Carbide and the leak detectors of CDUs;
+// either source may be absent.
 type Job struct {
 	carbideClient carbideapi.Client
+	cduClient     cduapi.Client
 	taskMgr       taskmanager.Manager
 }
 
-// New constructs a leak detection Job using the Carbide provider from the
-// registry. Returns nil, nil if leak detection is disabled or the Carbide
-// provider is not registered (e.g. non-production environment).
+// New constructs a leak detection Job using the Carbide and CDU providers
+// from the registry. Returns nil, nil if leak detection is disabled or
+// neither provider is registered (e.g. non-production environment).
 func New(
 	taskMgr taskmanager.Manager,
 	providers *componentmanager.ProviderRegistry,
@@ -49,19 +54,31 @@ func New(
 		return nil, nil
 	}
 
+	job := &Job{taskMgr: taskMgr}
+
 	carbideProvider, err := componentmanager.GetTyped[*carbideprovider.Provider](
 		providers, carbideprovider.ProviderName,
 	)
 	if err != nil {
-		log.Error().Err(err).
-			Msg("Carbide provider not available; leak detection disabled")
+		log.Warn().Err(err).
+			Msg("Carbide provider not available; machine leak detection disabled")
+	} else {
+		job.carbideClient = carbideProvider.Client()
+	}
+
+	cduProvider, err := componentmanager.GetTyped[*cduprovider.Provider](
+		providers, cduprovider.ProviderName,
+	)
+	if err == nil {
+		job.cduClient = cduProvider.Client()
+	}
+
+	if job.carbideClient == nil && job.cduClient == nil {
+		log.Error().Msg("Neither Carbide nor CDU provider available; leak detection disabled")
 		return nil, nil
 	}
 
-	return &Job{
-		carbideClient: carbideProvider.Client(),
-		taskMgr:       taskMgr,
-	}, nil
+	return job, nil
 }
 
 // Name returns the job name.
@@ -69,6 +86,11 @@ func (j *Job) Name() string { return "leak-detection" }
 
 // Run executes one iteration of leak detection.
 func (j *Job) Run(ctx context.Context, _ types.Event) error {
-	runLeakDetectionOne(ctx, j.carbideClient, j.taskMgr)
+	if j.carbideClient != nil {
+		runLeakDetectionOne(ctx, j.carbideClient, j.taskMgr)
+	}
+	if j.cduClient != nil {
+		runCDULeakDetectionOne(ctx, j.cduClient, j.taskMgr)
+	}
 	return nil
 }
@@ -23,10 +23,15 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/google/uuid"
+
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
 	taskmanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/manager"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	identifier "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/Identifier"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
@@ -55,28 +60,63 @@ func runLeakDetectionOne(
 	}
 }
 
-func submitPowerOffTask(
+// runCDULeakDetectionOne powers off the compute of every rack whose CDU
+// reports a leak. CDUs that cannot be read are skipped; they are reported by
+// the cooling health checks instead.
+func runCDULeakDetectionOne(
 	ctx context.Context,
+	cduClient cduapi.Client,
 	taskMgr taskmanager.Manager,
-	machineID string,
-) error {
-	info := &operations.PowerControlTaskInfo{
-		Operation: operations.PowerOperationForcePowerOff,
-		Forced:    true,
-	}
+) {
+	log.Info().Msg("Running CDU leak detection")
 
-	raw, err := info.Marshal()
+	cdus, err := cduClient.GetCDUs(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to marshal power control info: %w", err)
+		log.Error().Err(err).Msg("Unable to retrieve CDUs")
+		return
 	}
 
-	req := &operation.Request{
-		Operation: operation.Wrapper{
-			Type: info.Type(),
-			Code: info.CodeString(),
-			Info: raw,
-		},
-		TargetSpec: operation.TargetSpec{
+	submitted := make(map[uuid.UUID]bool)
+	for _, cdu := range cdus {
+		leaks := cdu.Leaks()
+		if len(leaks) == 0 {
+			continue
+		}
+
+		if cdu.RackID == uuid.Nil {
+			log.Error().Str("cdu", cdu.ComponentID).
+				Msg("Leaking CDU is not in a rack, cannot power off its compute")
+			continue
+		}
+
+		// One task per rack is enough when several of its CDUs leak.
+		if submitted[cdu.RackID] {
+			continue
+		}
+		submitted[cdu.RackID] = true
+
+		log.Info().
+			Str("cdu", cdu.ComponentID).
+			Str("rack_id", cdu.RackID.String()).
+			Str("sensor", leaks[0].Name).
+			Msg("Leaking CDU, submitting force power-off task for rack compute")
+
+		if err := submitRackPowerOffTask(ctx, taskMgr, cdu); err != nil {
+			log.Error().Err(err).Str("cdu", cdu.ComponentID).
+				Msg("Failed to submit power-off task for leaking CDU")
+		}
+	}
+}
+
+func submitPowerOffTask(
+	ctx context.Context,
+	taskMgr taskmanager.Manager,
+	machineID string,
+) error {
+	taskIDs, err := submitForcePowerOff(
+		ctx,
+		taskMgr,
+		operation.TargetSpec{
 			Components: []operation.ComponentTarget{
 				{
 					External: &operation.ExternalRef{
@@ -86,13 +126,10 @@ func submitPowerOffTask(
 				},
 			},
 		},
-		Description:      fmt.Sprintf("Leak detection: force power-off machine %s", machineID),
-		ConflictStrategy: operation.ConflictStrategyQueue,
-	}
-
-	taskIDs, err := taskMgr.SubmitTask(ctx, req)
+		fmt.Sprintf("Leak detection: force power-off machine %s", machineID),
+	)
 	if err != nil {
-		return fmt.Errorf("failed to submit task: %w", err)
+		return err
 	}
 
 	if len(taskIDs) == 0 {
@@ -106,3 +143,85 @@ func submitPowerOffTask(
 
 	return nil
 }
+
+// submitRackPowerOffTask force powers off all compute in the rack of a
+// leaking CDU.
+func submitRackPowerOffTask(
+	ctx context.Context,
+	taskMgr taskmanager.Manager,
+	cdu cduapi.CDU,
+) error {
+	taskIDs, err := submitForcePowerOff(
+		ctx,
+		taskMgr,
+		operation.TargetSpec{
+			Racks: []operation.RackTarget{
+				{
+					Identifier:     identifier.Identifier{ID: cdu.RackID},
+					ComponentTypes: []devicetypes.ComponentType{devicetypes.ComponentTypeCompute},
+				},
+			},
+		},
+		fmt.Sprintf("Leak detection: CDU %s leak, force power-off rack %s compute", cdu.ComponentID, cdu.RackID),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(taskIDs) == 0 {
+		return fmt.Errorf("failed to create any power-off tasks for rack %s of leaking CDU %s", cdu.RackID, cdu.ComponentID)
+	}
+
+	log.Info().
+		Str("cdu", cdu.ComponentID).
+		Str("rack_id", cdu.RackID.String()).
+		Int("task_count", len(taskIDs)).
+		Msg("Power-off task submitted for rack of leaking CDU")
+
+	return nil
+}
+
+// submitForcePowerOff submits an emergency force power-off of target.
+func submitForcePowerOff(
+	ctx context.Context,
+	taskMgr taskmanager.Manager,
+	target operation.TargetSpec,
+	description string,
+) ([]uuid.UUID, error) {
+	info := &operations.PowerControlTaskInfo{
+		Operation: operations.PowerOperationForcePowerOff,
+		Forced:    true,
+	}
+
+	raw, err := info.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal power control info: %w", err)
+	}
+
+	req := &operation.Request{
+		Operation: operation.Wrapper{
+			Type: info.Type(),
+			Code: info.CodeString(),
+			Info: raw,
+		},
+		TargetSpec:       target,
+		Description:      description,
+		ConflictStrategy: operation.ConflictStrategyQueue,
+		// A leak cannot wait for a firmware upgrade or a maintenance
+		// window: cancel conflicting work and run regardless of the
+		// calendar.
+		Priority:   taskcommon.TaskPriorityEmergency,
+		Preemption: operation.PreemptionCancel,
+		MaintenanceOverride: &operation.MaintenanceOverride{
+			Reason:      "leak detected",
+			RequestedBy: "leak-detection",
+		},
+	}
+
+	taskIDs, err := taskMgr.SubmitTask(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit task: %w", err)
+	}
+
+	return taskIDs, nil
+}
@@ -27,7 +27,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/carbideapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
@@ -58,6 +61,14 @@ func (m *mockManager) CancelTask(_ context.Context, _ uuid.UUID) error {
 	return m.cancelErr
 }
 
+func (m *mockManager) DecideApproval(_ context.Context, _ uuid.UUID, _ *taskdef.ApprovalSignal) error {
+	return nil
+}
+
+func (m *mockManager) PlanTask(_ context.Context, _ *operation.Request) ([]*taskdef.OperationPlan, error) {
+	return nil, nil
+}
+
 // --- tests ---
 
 func TestSubmitPowerOffTask_Success(t *testing.T) {
@@ -84,6 +95,12 @@ func TestSubmitPowerOffTask_Success(t *testing.T) {
 	// Verify conflict strategy is queue
 	assert.Equal(t, operation.ConflictStrategyQueue, req.ConflictStrategy)
 
+	// Verify the task is an emergency that preempts conflicting work
+	assert.Equal(t, taskcommon.TaskPriorityEmergency, req.Priority)
+	assert.Equal(t, operation.PreemptionCancel, req.Preemption)
+	require.NotNil(t, req.MaintenanceOverride)
+	require.NoError(t, req.Validate())
+
 	// Verify description contains machine ID
 	assert.Contains(t, req.Description, machineID)
 }
@@ -143,3 +160,72 @@ func TestRunLeakDetectionOne_ContinuesOnSubmitError(t *testing.T) {
 	// Verify all machines were attempted despite errors
 	require.Len(t, mgr.requests, 2)
 }
+
+func leakingCDU(id string, rackID uuid.UUID) cduapi.CDU {
+	return cduapi.CDU{
+		ComponentID: id,
+		RackID:      rackID,
+		Enabled:     true,
+		LeakDetectors: []cduapi.LeakDetector{
+			{ID: "1", Name: "Drip tray", State: cduapi.HealthCritical},
+		},
+	}
+}
+
+func TestRunCDULeakDetectionOne_SubmitsRackPowerOff(t *testing.T) {
+	ctx := context.Background()
+	mgr := &mockManager{}
+	rackID := uuid.New()
+
+	cduClient := cduapi.NewMockClient()
+	cduClient.AddCDU(leakingCDU("aa:bb:cc:00:00:01", rackID))
+	cduClient.AddCDU(cduapi.CDU{ComponentID: "aa:bb:cc:00:00:02", RackID: uuid.New(), Enabled: true})
+
+	runCDULeakDetectionOne(ctx, cduClient, mgr)
+
+	require.Len(t, mgr.requests, 1)
+	req := mgr.requests[0]
+
+	// The whole rack's compute is powered off
+	assert.True(t, req.TargetSpec.IsRackTargeting())
+	require.Len(t, req.TargetSpec.Racks, 1)
+	assert.Equal(t, rackID, req.TargetSpec.Racks[0].Identifier.ID)
+	assert.Equal(t,
+		[]devicetypes.ComponentType{devicetypes.ComponentTypeCompute},
+		req.TargetSpec.Racks[0].ComponentTypes)
+
+	// Same emergency settings as the machine path
+	assert.Equal(t, operation.ConflictStrategyQueue, req.ConflictStrategy)
+	assert.Equal(t, taskcommon.TaskPriorityEmergency, req.Priority)
+	assert.Equal(t, operation.PreemptionCancel, req.Preemption)
+	require.NotNil(t, req.MaintenanceOverride)
+	require.NoError(t, req.Validate())
+	assert.Contains(t, req.Description, "aa:bb:cc:00:00:01")
+}
+
+func TestRunCDULeakDetectionOne_OneTaskPerRack(t *testing.T) {
+	ctx := context.Background()
+	mgr := &mockManager{}
+	rackID := uuid.New()
+
+	cduClient := cduapi.NewMockClient()
+	cduClient.AddCDU(leakingCDU("aa:bb:cc:00:00:01", rackID))
+	cduClient.AddCDU(leakingCDU("aa:bb:cc:00:00:02", rackID))
+
+	runCDULeakDetectionOne(ctx, cduClient, mgr)
+
+	require.Len(t, mgr.requests, 1)
+}
+
+func TestRunCDULeakDetectionOne_SkipsUnreadableAndUnracked(t *testing.T) {
+	ctx := context.Background()
+	mgr := &mockManager{}
+
+	cduClient := cduapi.NewMockClient()
+	cduClient.AddCDU(cduapi.CDU{ComponentID: "aa:bb:cc:00:00:01", RackID: uuid.New(), Error: "timeout"})
+	cduClient.AddCDU(leakingCDU("aa:bb:cc:00:00:02", uuid.Nil))
+
+	runCDULeakDetectionOne(ctx, cduClient, mgr)
+
+	assert.Empty(t, mgr.requests)
+}
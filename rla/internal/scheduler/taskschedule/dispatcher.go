@@ -445,6 +445,10 @@ func (d *Dispatcher) submitScopeTasks(
 			}(),
 			QueueTimeout: time.Duration(opts.QueueTimeoutSecs) * time.Second,
 			RuleID:       ruleID,
+			Priority:     opts.Priority,
+			// A schedule that fires while its rack is closed for
+			// maintenance waits for the next window instead of failing.
+			MaintenancePolicy: operation.MaintenancePolicyDefer,
 		}
 
 		taskIDs, err := d.taskManager.SubmitTask(ctx, req)
@@ -601,7 +605,8 @@ func (d *Dispatcher) filterScopesByPolicy(
 	return candidates, nil
 }
 
-// isTaskActive reports whether the given task is in a waiting/pending/running state.
+// isTaskActive reports whether the given task is in a waiting/pending/running
+// or waiting-approval state.
 func (d *Dispatcher) isTaskActive(ctx context.Context, taskID uuid.UUID) (bool, error) {
 	task, err := d.taskStore.GetTask(ctx, taskID)
 	if err != nil {
@@ -610,7 +615,8 @@ func (d *Dispatcher) isTaskActive(ctx context.Context, taskID uuid.UUID) (bool,
 
 	return task.Status == taskcommon.TaskStatusWaiting ||
 		task.Status == taskcommon.TaskStatusPending ||
-		task.Status == taskcommon.TaskStatusRunning, nil
+		task.Status == taskcommon.TaskStatusRunning ||
+		task.Status == taskcommon.TaskStatusWaitingApproval, nil
 }
 
 // updateScopeLastTaskIDsWithRetry writes back the scope→task mapping after a
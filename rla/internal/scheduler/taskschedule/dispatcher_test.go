@@ -205,6 +205,14 @@ func (m *mockTaskStore) ListRacksWithWaitingTasks(_ context.Context) ([]uuid.UUI
 	panic("mockTaskStore.ListRacksWithWaitingTasks: not implemented")
 }
 
+func (m *mockTaskStore) AppendTaskReport(_ context.Context, _ uuid.UUID, _ []taskdef.ReportEntry) error {
+	panic("mockTaskStore.AppendTaskReport: not implemented")
+}
+
+func (m *mockTaskStore) GetTaskReport(_ context.Context, _ uuid.UUID) ([]taskdef.ReportEntry, error) {
+	panic("mockTaskStore.GetTaskReport: not implemented")
+}
+
 func (m *mockTaskStore) CreateRule(_ context.Context, _ *operationrules.OperationRule) error {
 	panic("mockTaskStore.CreateRule: not implemented")
 }
@@ -276,6 +284,14 @@ func (m *mockTaskManager) CancelTask(_ context.Context, _ uuid.UUID) error {
 	panic("mockTaskManager.CancelTask: not implemented")
 }
 
+func (m *mockTaskManager) DecideApproval(_ context.Context, _ uuid.UUID, _ *taskdef.ApprovalSignal) error {
+	panic("mockTaskManager.DecideApproval: not implemented")
+}
+
+func (m *mockTaskManager) PlanTask(_ context.Context, _ *operation.Request) ([]*taskdef.OperationPlan, error) {
+	panic("mockTaskManager.PlanTask: not implemented")
+}
+
 // Compile-time interface checks.
 var _ Store = (*mockScheduleStore)(nil)
 var _ taskstore.Store = (*mockTaskStore)(nil)
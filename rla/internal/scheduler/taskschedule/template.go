@@ -36,6 +36,7 @@ type TaskTemplate struct {
 	ConflictStrategy int                 `json:"conflict_strategy,omitempty"`
 	QueueTimeoutSecs int64               `json:"queue_timeout_secs,omitempty"`
 	RuleID           string              `json:"rule_id,omitempty"`
+	Priority         string              `json:"priority,omitempty"`
 }
 
 // TemplateOptions holds the scheduling-policy fields stored alongside the
@@ -50,6 +51,9 @@ type TemplateOptions struct {
 	QueueTimeoutSecs int64
 	// RuleID is the override rule UUID as a string. Empty string means no override.
 	RuleID string
+	// Priority is the priority class of the submitted tasks. Empty means
+	// normal.
+	Priority taskcommon.TaskPriority
 }
 
 // WrapperFromTemplate unmarshals an operation_template JSON blob and returns
@@ -82,6 +86,7 @@ func MarshalTemplate(
 		ConflictStrategy: opts.ConflictStrategy,
 		QueueTimeoutSecs: opts.QueueTimeoutSecs,
 		RuleID:           opts.RuleID,
+		Priority:         string(opts.Priority),
 	}
 
 	return json.Marshal(tmpl)
@@ -89,7 +94,8 @@ func MarshalTemplate(
 
 // OptionsFromTemplate extracts the scheduling-policy fields from a stored
 // TaskTemplate JSON blob. These are restored at fire time to reconstruct the
-// full operation.Request (conflict strategy, queue timeout, rule override).
+// full operation.Request (conflict strategy, queue timeout, rule override,
+// priority).
 func OptionsFromTemplate(raw json.RawMessage) (TemplateOptions, error) {
 	var tmpl TaskTemplate
 	if err := json.Unmarshal(raw, &tmpl); err != nil {
@@ -100,6 +106,7 @@ func OptionsFromTemplate(raw json.RawMessage) (TemplateOptions, error) {
 		ConflictStrategy: tmpl.ConflictStrategy,
 		QueueTimeoutSecs: tmpl.QueueTimeoutSecs,
 		RuleID:           tmpl.RuleID,
+		Priority:         taskcommon.TaskPriority(tmpl.Priority),
 	}, nil
 }
 
@@ -76,9 +76,10 @@ func TestOptionsFromTemplate(t *testing.T) {
 		assert.Equal(t, 0, opts.ConflictStrategy)
 		assert.Equal(t, int64(0), opts.QueueTimeoutSecs)
 		assert.Equal(t, "", opts.RuleID)
+		assert.Equal(t, taskcommon.TaskPriority(""), opts.Priority)
 	})
 
-	t.Run("queue strategy and rule ID round-trip", func(t *testing.T) {
+	t.Run("queue strategy, rule ID and priority round-trip", func(t *testing.T) {
 		raw, err := MarshalTemplate(
 			taskcommon.TaskTypePowerControl,
 			taskcommon.OpCodePowerControlPowerOn,
@@ -87,6 +88,7 @@ func TestOptionsFromTemplate(t *testing.T) {
 				ConflictStrategy: int(operation.ConflictStrategyQueue),
 				QueueTimeoutSecs: 120,
 				RuleID:           ruleID,
+				Priority:         taskcommon.TaskPriorityBackground,
 			},
 		)
 		require.NoError(t, err)
@@ -96,6 +98,7 @@ func TestOptionsFromTemplate(t *testing.T) {
 		assert.Equal(t, int(operation.ConflictStrategyQueue), opts.ConflictStrategy)
 		assert.Equal(t, int64(120), opts.QueueTimeoutSecs)
 		assert.Equal(t, ruleID, opts.RuleID)
+		assert.Equal(t, taskcommon.TaskPriorityBackground, opts.Priority)
 	})
 
 	t.Run("invalid JSON", func(t *testing.T) {
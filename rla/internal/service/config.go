@@ -75,6 +75,7 @@ func GetDeploymentEnv() (string, error) {
 //   - ExecutorConfig: abstracts the task executor (e.g., Temporal)
 type Config struct {
 	Port             int
+	MetricsPort      int // port of the Prometheus /metrics endpoint, disabled if 0
 	DBConf           cdb.Config
 	ExecutorConf     executor.ExecutorConfig
 	RLAConfig        config.Config
@@ -0,0 +1,63 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+
+	inventorymanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/manager"
+)
+
+// newMetricsHandler returns a /metrics handler exporting inventory drift counts alongside Go runtime and process metrics.
+func newMetricsHandler(m inventorymanager.Manager) http.Handler {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		inventorymanager.NewDriftCollector(m),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg})
+}
+
+// startMetricsServer serves the Prometheus /metrics endpoint on the configured metrics port in the background.
+func (s *Service) startMetricsServer() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", newMetricsHandler(s.inventoryManager))
+
+	s.metricsServer = &http.Server{
+		Addr:              fmt.Sprintf(":%v", s.conf.MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: time.Minute,
+	}
+
+	go func() {
+		log.Info().Msgf("Serving Prometheus metrics on %s/metrics", s.metricsServer.Addr)
+		if err := s.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Msgf("Failed to serve Prometheus metrics: %v", err)
+		}
+	}()
+}
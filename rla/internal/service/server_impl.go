@@ -37,7 +37,12 @@ import (
 	dbquery "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/query"
 	inventorymanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/manager"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/campaign"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/credentialrotation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/discovery"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/maintenance"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/powerbudget"
 	taskschedule "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/taskschedule"
 	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/conflict"
@@ -45,6 +50,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
 	operations "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
 	taskstore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/store"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
 	identifier "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/Identifier"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
@@ -57,13 +63,19 @@ import (
 // It acts as an adapter between gRPC protobuf messages and the internal managers,
 // handling protobuf conversion and delegating business logic to the InventoryManager.
 type RLAServerImpl struct {
-	inventoryManager          inventorymanager.Manager // Business logic manager for inventory operations
-	taskManager               taskmanager.Manager      // Task manager for orchestrating task lifecycle
-	taskStore                 taskstore.Store          // Task store for task queries
-	taskScheduleStore         taskschedule.Store       // Persistence layer for task schedules
-	taskScheduleDispatcher    *taskschedule.Dispatcher // Background poller that fires due task schedules
-	conflictResolver          *conflict.Resolver       // Reused for inter-schedule conflict detection
-	pb.UnimplementedRLAServer                          // Embedded protobuf server interface for forward compatibility
+	inventoryManager          inventorymanager.Manager    // Business logic manager for inventory operations
+	taskManager               taskmanager.Manager         // Task manager for orchestrating task lifecycle
+	taskStore                 taskstore.Store             // Task store for task queries
+	taskScheduleStore         taskschedule.Store          // Persistence layer for task schedules
+	taskScheduleDispatcher    *taskschedule.Dispatcher    // Background poller that fires due task schedules
+	conflictResolver          *conflict.Resolver          // Reused for inter-schedule conflict detection
+	powerBudgetManager        *powerbudget.Manager        // Rack power budgets and shelf power limits
+	rotationManager           *credentialrotation.Manager // Rack-wide device credential rotation
+	discoveryManager          *discovery.Manager          // Redfish discovery of power shelves and NVLink switches
+	campaignManager           *campaign.Manager           // Batched rollouts of an operation across many racks
+	maintenanceCalendar       *maintenance.Calendar       // Maintenance windows and blackouts for disruptive tasks
+	inventorySync             rackResyncer                // On-demand inventory sync of a rack; nil when inventory is disabled
+	pb.UnimplementedRLAServer                             // Embedded protobuf server interface for forward compatibility
 }
 
 // newServerImplementation creates a new RLA gRPC server implementation.
@@ -73,6 +85,12 @@ type RLAServerImpl struct {
 //   - inventoryManager: The inventory manager instance for handling rack and component topology
 //   - taskManager: The Task manager for orchestrating task lifecycle
 //   - taskStore: The task store for task queries
+//   - powerBudgetManager: The power budget manager for rack power budgets
+//   - rotationManager: The credential rotation manager for rack-wide password rotation
+//   - discoveryManager: The discovery manager for onboarding power shelves and NVLink switches
+//   - campaignManager: The campaign manager for fleet-wide rollouts
+//   - maintenanceCalendar: The maintenance calendar of windows and blackouts
+//   - inventorySync: The inventory sync run on demand for a rack, or nil when inventory is disabled
 //
 // Returns:
 //   - *RLAServerImpl: A new server implementation instance
@@ -83,6 +101,12 @@ func newServerImplementation(
 	taskStore taskstore.Store,
 	taskScheduleStore taskschedule.Store,
 	taskScheduleDispatcher *taskschedule.Dispatcher,
+	powerBudgetManager *powerbudget.Manager,
+	rotationManager *credentialrotation.Manager,
+	discoveryManager *discovery.Manager,
+	campaignManager *campaign.Manager,
+	maintenanceCalendar *maintenance.Calendar,
+	inventorySync rackResyncer,
 ) (*RLAServerImpl, error) {
 	return &RLAServerImpl{
 		inventoryManager:       inventoryManager,
@@ -91,6 +115,12 @@ func newServerImplementation(
 		taskScheduleStore:      taskScheduleStore,
 		taskScheduleDispatcher: taskScheduleDispatcher,
 		conflictResolver:       conflict.NewResolver(taskStore),
+		powerBudgetManager:     powerBudgetManager,
+		rotationManager:        rotationManager,
+		discoveryManager:       discoveryManager,
+		campaignManager:        campaignManager,
+		maintenanceCalendar:    maintenanceCalendar,
+		inventorySync:          inventorySync,
 	}, nil
 }
 
@@ -649,32 +679,116 @@ func (rs *RLAServerImpl) PowerOnRack(
 	ctx context.Context,
 	req *pb.PowerOnRackRequest,
 ) (*pb.SubmitTaskResponse, error) {
-	return rs.handlePowerControlTask(
-		ctx,
+	opReq, err := rs.powerOnRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.submitOperation(ctx, opReq)
+}
+
+func (rs *RLAServerImpl) PowerOffRack(
+	ctx context.Context,
+	req *pb.PowerOffRackRequest,
+) (*pb.SubmitTaskResponse, error) {
+	opReq, err := rs.powerOffRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.submitOperation(ctx, opReq)
+}
+
+func (rs *RLAServerImpl) PowerResetRack(
+	ctx context.Context,
+	req *pb.PowerResetRackRequest,
+) (*pb.SubmitTaskResponse, error) {
+	opReq, err := rs.powerResetRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.submitOperation(ctx, opReq)
+}
+
+func (rs *RLAServerImpl) BringUpRack(
+	ctx context.Context,
+	req *pb.BringUpRackRequest,
+) (*pb.SubmitTaskResponse, error) {
+	opReq, err := rs.bringUpRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.submitOperation(ctx, opReq)
+}
+
+// IngestRack is a convenience API that triggers component ingestion by reusing
+// the BringUp workflow with an ingestion-only rule. This registers expected
+// components with their respective component manager services without
+// performing power or firmware operations.
+func (rs *RLAServerImpl) IngestRack(
+	ctx context.Context,
+	req *pb.IngestRackRequest,
+) (*pb.SubmitTaskResponse, error) {
+	opReq, err := rs.ingestRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.submitOperation(ctx, opReq)
+}
+
+// submitOperation hands an operation request to the Task Manager, which
+// resolves the targets, splits them by rack and creates the tasks.
+func (rs *RLAServerImpl) submitOperation(
+	ctx context.Context,
+	req *operation.Request,
+) (*pb.SubmitTaskResponse, error) {
+	if rs.taskManager == nil {
+		return nil, errors.New("task manager is not available")
+	}
+
+	taskIDs, err := rs.taskManager.SubmitTask(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskIDs) == 0 {
+		return nil, errors.New("failed to create any tasks")
+	}
+
+	return &pb.SubmitTaskResponse{TaskIds: protobuf.UUIDsTo(taskIDs)}, nil
+}
+
+func (rs *RLAServerImpl) powerOnRequest(
+	req *pb.PowerOnRackRequest,
+) (*operation.Request, error) {
+	return rs.powerControlRequest(
 		req.GetTargetSpec(),
 		req.GetDescription(),
 		req.GetQueueOptions(),
 		req.GetRuleId(),
+		nil,
 		&operations.PowerControlTaskInfo{
 			Operation: operations.PowerOperationPowerOn,
 		},
 	)
 }
 
-func (rs *RLAServerImpl) PowerOffRack(
-	ctx context.Context,
+func (rs *RLAServerImpl) powerOffRequest(
 	req *pb.PowerOffRackRequest,
-) (*pb.SubmitTaskResponse, error) {
+) (*operation.Request, error) {
 	op := operations.PowerOperationPowerOff
 	if req.GetForced() {
 		op = operations.PowerOperationForcePowerOff
 	}
-	return rs.handlePowerControlTask(
-		ctx,
+	return rs.powerControlRequest(
 		req.GetTargetSpec(),
 		req.GetDescription(),
 		req.GetQueueOptions(),
 		req.GetRuleId(),
+		req.GetMaintenance(),
 		&operations.PowerControlTaskInfo{
 			Operation: op,
 			Forced:    req.GetForced(),
@@ -682,20 +796,19 @@ func (rs *RLAServerImpl) PowerOffRack(
 	)
 }
 
-func (rs *RLAServerImpl) PowerResetRack(
-	ctx context.Context,
+func (rs *RLAServerImpl) powerResetRequest(
 	req *pb.PowerResetRackRequest,
-) (*pb.SubmitTaskResponse, error) {
+) (*operation.Request, error) {
 	op := operations.PowerOperationRestart
 	if req.GetForced() {
 		op = operations.PowerOperationForceRestart
 	}
-	return rs.handlePowerControlTask(
-		ctx,
+	return rs.powerControlRequest(
 		req.GetTargetSpec(),
 		req.GetDescription(),
 		req.GetQueueOptions(),
 		req.GetRuleId(),
+		req.GetMaintenance(),
 		&operations.PowerControlTaskInfo{
 			Operation: op,
 			Forced:    req.GetForced(),
@@ -703,21 +816,12 @@ func (rs *RLAServerImpl) PowerResetRack(
 	)
 }
 
-func (rs *RLAServerImpl) BringUpRack(
-	ctx context.Context,
+func (rs *RLAServerImpl) bringUpRequest(
 	req *pb.BringUpRackRequest,
-) (*pb.SubmitTaskResponse, error) {
-	if rs.taskManager == nil {
-		return nil, errors.New(
-			"task manager is not available",
-		)
-	}
-
+) (*operation.Request, error) {
 	targetSpec := req.GetTargetSpec()
 	if targetSpec == nil {
-		return nil, errors.New(
-			"target_spec is required",
-		)
+		return nil, errors.New("target_spec is required")
 	}
 
 	info := &operations.BringUpTaskInfo{
@@ -731,35 +835,16 @@ func (rs *RLAServerImpl) BringUpRack(
 	}
 
 	opReq.RuleID = protobuf.OptionalUUIDFrom(req.GetRuleId())
+	opReq.MaintenancePolicy, opReq.MaintenanceOverride = protobuf.MaintenanceOptionsFrom(
+		req.GetMaintenance(),
+	)
 
-	taskIDs, err := rs.taskManager.SubmitTask(ctx, opReq)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(taskIDs) == 0 {
-		return nil, errors.New(
-			"failed to create any tasks",
-		)
-	}
-
-	return &pb.SubmitTaskResponse{
-		TaskIds: protobuf.UUIDsTo(taskIDs),
-	}, nil
+	return opReq, nil
 }
 
-// IngestRack is a convenience API that triggers component ingestion by reusing
-// the BringUp workflow with an ingestion-only rule. This registers expected
-// components with their respective component manager services without
-// performing power or firmware operations.
-func (rs *RLAServerImpl) IngestRack(
-	ctx context.Context,
+func (rs *RLAServerImpl) ingestRequest(
 	req *pb.IngestRackRequest,
-) (*pb.SubmitTaskResponse, error) {
-	if rs.taskManager == nil {
-		return nil, errors.New("task manager is not available")
-	}
-
+) (*operation.Request, error) {
 	targetSpec := req.GetTargetSpec()
 	if targetSpec == nil {
 		return nil, errors.New("target_spec is required")
@@ -781,32 +866,17 @@ func (rs *RLAServerImpl) IngestRack(
 	opReq.Operation.Code = taskcommon.OpCodeIngest
 	opReq.RuleID = protobuf.OptionalUUIDFrom(req.GetRuleId())
 
-	taskIDs, err := rs.taskManager.SubmitTask(ctx, opReq)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(taskIDs) == 0 {
-		return nil, errors.New("failed to create any tasks")
-	}
-
-	return &pb.SubmitTaskResponse{
-		TaskIds: protobuf.UUIDsTo(taskIDs),
-	}, nil
+	return opReq, nil
 }
 
-func (rs *RLAServerImpl) handlePowerControlTask(
-	ctx context.Context,
+func (rs *RLAServerImpl) powerControlRequest(
 	targetSpec *pb.OperationTargetSpec,
 	description string,
 	queueOptions *pb.QueueOptions,
 	pbRuleID *pb.UUID,
+	maintenance *pb.MaintenanceOptions,
 	info *operations.PowerControlTaskInfo,
-) (*pb.SubmitTaskResponse, error) {
-	if rs.taskManager == nil {
-		return nil, errors.New("task manager is not available")
-	}
-
+) (*operation.Request, error) {
 	if targetSpec == nil {
 		return nil, errors.New("target_spec is required")
 	}
@@ -820,19 +890,11 @@ func (rs *RLAServerImpl) handlePowerControlTask(
 	}
 
 	req.ConflictStrategy, req.QueueTimeout = protobuf.QueueOptionsFrom(queueOptions)
+	req.Priority, req.Preemption = protobuf.PriorityOptionsFrom(queueOptions)
 	req.RuleID = protobuf.OptionalUUIDFrom(pbRuleID)
+	req.MaintenancePolicy, req.MaintenanceOverride = protobuf.MaintenanceOptionsFrom(maintenance)
 
-	// Task Manager handles resolve + split by rack + create tasks
-	taskIDs, err := rs.taskManager.SubmitTask(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(taskIDs) == 0 {
-		return nil, errors.New("failed to create any tasks")
-	}
-
-	return &pb.SubmitTaskResponse{TaskIds: protobuf.UUIDsTo(taskIDs)}, nil
+	return req, nil
 }
 
 // convertTargetSpecToOperationRequest converts pb.OperationTargetSpec to internal operation.Request.
@@ -938,6 +1000,122 @@ func (rs *RLAServerImpl) CancelTask(
 	return &pb.CancelTaskResponse{Task: protobuf.TaskTo(task)}, nil
 }
 
+func (rs *RLAServerImpl) ApproveTaskStep(
+	ctx context.Context,
+	req *pb.ApproveTaskStepRequest,
+) (*pb.ApproveTaskStepResponse, error) {
+	task, err := rs.decideTaskApproval(
+		ctx,
+		req.GetTaskId(),
+		&taskdef.ApprovalSignal{
+			ApprovalID: req.GetApprovalId(),
+			Decision:   taskcommon.ApprovalDecisionApprove,
+			Approver:   req.GetApprover(),
+			Comment:    req.GetComment(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveTaskStepResponse{Task: task}, nil
+}
+
+func (rs *RLAServerImpl) RejectTaskStep(
+	ctx context.Context,
+	req *pb.RejectTaskStepRequest,
+) (*pb.RejectTaskStepResponse, error) {
+	decision := taskcommon.ApprovalDecisionReject
+	if req.GetAbort() {
+		decision = taskcommon.ApprovalDecisionAbort
+	}
+
+	task, err := rs.decideTaskApproval(
+		ctx,
+		req.GetTaskId(),
+		&taskdef.ApprovalSignal{
+			ApprovalID: req.GetApprovalId(),
+			Decision:   decision,
+			Approver:   req.GetApprover(),
+			Comment:    req.GetComment(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectTaskStepResponse{Task: task}, nil
+}
+
+// GetTaskReport returns the report recorded while the task ran, rendered in
+// the requested format when one is given.
+func (rs *RLAServerImpl) GetTaskReport(
+	ctx context.Context,
+	req *pb.GetTaskReportRequest,
+) (*pb.GetTaskReportResponse, error) {
+	taskID, err := uuid.Parse(req.GetTaskId().GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid task ID: %w", err)
+	}
+
+	task, err := rs.taskStore.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := rs.taskStore.GetTaskReport(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetTaskReportResponse{
+		Task:    protobuf.TaskTo(task),
+		Entries: make([]*pb.TaskReportEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, protobuf.TaskReportEntryTo(entry))
+	}
+
+	if format := protobuf.TaskReportFormatFrom(req.GetFormat()); format != "" {
+		content, err := taskdef.NewReport(task, entries).Render(format)
+		if err != nil {
+			return nil, err
+		}
+		resp.Content = content
+	}
+
+	return resp, nil
+}
+
+// decideTaskApproval delivers an approval decision and returns the task as it
+// is stored afterwards. The workflow records the decision asynchronously, so
+// the returned task may still show the gate as pending.
+func (rs *RLAServerImpl) decideTaskApproval(
+	ctx context.Context,
+	id *pb.UUID,
+	signal *taskdef.ApprovalSignal,
+) (*pb.Task, error) {
+	if rs.taskManager == nil {
+		return nil, errors.New("task manager is not available")
+	}
+
+	taskID, err := uuid.Parse(id.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid task ID: %w", err)
+	}
+
+	if err := rs.taskManager.DecideApproval(ctx, taskID, signal); err != nil {
+		return nil, err
+	}
+
+	task, err := rs.taskStore.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task %s after approval decision: %w", taskID, err)
+	}
+
+	return protobuf.TaskTo(task), nil
+}
+
 // ========================================
 // Operation Rules API
 // ========================================
@@ -1227,10 +1405,17 @@ func (rs *RLAServerImpl) UpgradeFirmware(
 	ctx context.Context,
 	req *pb.UpgradeFirmwareRequest,
 ) (*pb.SubmitTaskResponse, error) {
-	if rs.taskManager == nil {
-		return nil, errors.New("task manager is not available")
+	opReq, err := rs.upgradeFirmwareRequest(req)
+	if err != nil {
+		return nil, err
 	}
 
+	return rs.submitOperation(ctx, opReq)
+}
+
+func (rs *RLAServerImpl) upgradeFirmwareRequest(
+	req *pb.UpgradeFirmwareRequest,
+) (*operation.Request, error) {
 	targetSpec := req.GetTargetSpec()
 	if targetSpec == nil {
 		return nil, errors.New("target_spec is required")
@@ -1260,19 +1445,15 @@ func (rs *RLAServerImpl) UpgradeFirmware(
 	opReq.ConflictStrategy, opReq.QueueTimeout = protobuf.QueueOptionsFrom(
 		req.GetQueueOptions(),
 	)
+	opReq.Priority, opReq.Preemption = protobuf.PriorityOptionsFrom(
+		req.GetQueueOptions(),
+	)
 	opReq.RuleID = protobuf.OptionalUUIDFrom(req.GetRuleId())
+	opReq.MaintenancePolicy, opReq.MaintenanceOverride = protobuf.MaintenanceOptionsFrom(
+		req.GetMaintenance(),
+	)
 
-	// Task Manager handles resolve + split by rack + create tasks
-	taskIDs, err := rs.taskManager.SubmitTask(ctx, opReq)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(taskIDs) == 0 {
-		return nil, errors.New("failed to create any tasks")
-	}
-
-	return &pb.SubmitTaskResponse{TaskIds: protobuf.UUIDsTo(taskIDs)}, nil
+	return opReq, nil
 }
 
 // GetComponents retrieves components from local database with filtering, pagination, and ordering support.
@@ -0,0 +1,364 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/campaign"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskschedule "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/taskschedule"
+	identifier "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/Identifier"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// CreateCampaign creates a campaign that rolls an operation out across the
+// selected racks in batches, and starts its first batch.
+func (rs *RLAServerImpl) CreateCampaign(
+	ctx context.Context,
+	req *pb.CreateCampaignRequest,
+) (*pb.Campaign, error) {
+	if rs.campaignManager == nil {
+		return nil, errors.New("campaign manager is not available")
+	}
+
+	opInfo, targetSpec, pbQueueOpts, pbRuleID, err := protobuf.ScheduledOperationInfoFrom(req.GetOperation())
+	if err != nil {
+		return nil, err
+	}
+
+	if targetSpec != nil {
+		return nil, errors.New("operation target_spec must be unset; the racks selector chooses the campaign racks")
+	}
+
+	raw, err := opInfo.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal operation: %w", err)
+	}
+
+	conflictStrategy, queueTimeout := protobuf.QueueOptionsFrom(pbQueueOpts)
+	priority, preemption := protobuf.PriorityOptionsFrom(pbQueueOpts)
+	if preemption != operation.PreemptionNone {
+		return nil, errors.New("campaign tasks cannot preempt other tasks")
+	}
+	templateOpts := taskschedule.TemplateOptions{
+		ConflictStrategy: int(conflictStrategy),
+		QueueTimeoutSecs: int64(queueTimeout.Seconds()),
+		Priority:         priority,
+	}
+	if ruleUUID := protobuf.UUIDFrom(pbRuleID); ruleUUID != uuid.Nil {
+		templateOpts.RuleID = ruleUUID.String()
+	}
+
+	template, err := taskschedule.MarshalTemplate(opInfo.Type(), opInfo.CodeString(), raw, templateOpts)
+	if err != nil {
+		return nil, fmt.Errorf("build operation template: %w", err)
+	}
+
+	componentTypes := make([]devicetypes.ComponentType, 0, len(req.GetComponentTypes()))
+	for _, t := range req.GetComponentTypes() {
+		componentTypes = append(componentTypes, protobuf.ComponentTypeFrom(t))
+	}
+
+	policy := req.GetPolicy()
+	camp, err := rs.campaignManager.Create(ctx, campaign.Spec{
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		Operation:      template,
+		ComponentTypes: componentTypes,
+		Selector:       campaignSelectorFrom(req.GetRacks()),
+		Policy: campaign.Policy{
+			MaxConcurrentRacks:   int(policy.GetMaxConcurrentRacks()),
+			MaxRacksPerNVLDomain: int(policy.GetMaxRacksPerNvlDomain()),
+			MinSuccessRate:       policy.GetMinSuccessRate(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return campaignToProto(camp, true), nil
+}
+
+// GetCampaign returns a campaign with its racks.
+func (rs *RLAServerImpl) GetCampaign(
+	ctx context.Context,
+	req *pb.GetCampaignRequest,
+) (*pb.Campaign, error) {
+	if rs.campaignManager == nil {
+		return nil, errors.New("campaign manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	camp, err := rs.campaignManager.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaignToProto(camp, true), nil
+}
+
+// ListCampaigns lists the campaigns in the given states, or all of them,
+// without their racks.
+func (rs *RLAServerImpl) ListCampaigns(
+	ctx context.Context,
+	req *pb.ListCampaignsRequest,
+) (*pb.ListCampaignsResponse, error) {
+	if rs.campaignManager == nil {
+		return nil, errors.New("campaign manager is not available")
+	}
+
+	states := make([]campaign.State, 0, len(req.GetStates()))
+	for _, s := range req.GetStates() {
+		states = append(states, campaignStateFrom(s))
+	}
+
+	campaigns, err := rs.campaignManager.List(ctx, states)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListCampaignsResponse{
+		Campaigns: make([]*pb.Campaign, 0, len(campaigns)),
+	}
+	for _, c := range campaigns {
+		resp.Campaigns = append(resp.Campaigns, campaignToProto(c, false))
+	}
+
+	return resp, nil
+}
+
+// PauseCampaign stops a running campaign from starting new batches.
+func (rs *RLAServerImpl) PauseCampaign(
+	ctx context.Context,
+	req *pb.PauseCampaignRequest,
+) (*pb.Campaign, error) {
+	if rs.campaignManager == nil {
+		return nil, errors.New("campaign manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	camp, err := rs.campaignManager.Pause(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaignToProto(camp, true), nil
+}
+
+// ResumeCampaign lets a paused campaign continue with its next batch.
+func (rs *RLAServerImpl) ResumeCampaign(
+	ctx context.Context,
+	req *pb.ResumeCampaignRequest,
+) (*pb.Campaign, error) {
+	if rs.campaignManager == nil {
+		return nil, errors.New("campaign manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	camp, err := rs.campaignManager.Resume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaignToProto(camp, true), nil
+}
+
+// AbortCampaign cancels the running rack tasks of a campaign and skips the
+// racks not yet started.
+func (rs *RLAServerImpl) AbortCampaign(
+	ctx context.Context,
+	req *pb.AbortCampaignRequest,
+) (*pb.Campaign, error) {
+	if rs.campaignManager == nil {
+		return nil, errors.New("campaign manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	camp, err := rs.campaignManager.Abort(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaignToProto(camp, true), nil
+}
+
+func campaignSelectorFrom(sel *pb.CampaignRackSelector) campaign.Selector {
+	switch s := sel.GetSelector().(type) {
+	case *pb.CampaignRackSelector_Racks:
+		return campaign.Selector{Racks: identifiersFrom(s.Racks.GetIdentifiers())}
+	case *pb.CampaignRackSelector_NvlDomains:
+		return campaign.Selector{NVLDomains: identifiersFrom(s.NvlDomains.GetIdentifiers())}
+	case *pb.CampaignRackSelector_Location:
+		return campaign.Selector{Location: &campaign.Location{
+			Region:     s.Location.GetRegion(),
+			DataCenter: s.Location.GetDatacenter(),
+			Room:       s.Location.GetRoom(),
+		}}
+	default:
+		return campaign.Selector{}
+	}
+}
+
+func identifiersFrom(ids []*pb.Identifier) []identifier.Identifier {
+	out := make([]identifier.Identifier, 0, len(ids))
+	for _, id := range ids {
+		if id := protobuf.IdentifierFrom(id); id != nil {
+			out = append(out, *id)
+		}
+	}
+	return out
+}
+
+func campaignToProto(c *campaign.Campaign, withRacks bool) *pb.Campaign {
+	out := &pb.Campaign{
+		Id:          protobuf.UUIDTo(c.ID),
+		Name:        c.Name,
+		Description: c.Description,
+		Policy: &pb.CampaignPolicy{
+			MaxConcurrentRacks:   int32(c.MaxConcurrentRacks),
+			MaxRacksPerNvlDomain: int32(c.MaxRacksPerNVLDomain),
+			MinSuccessRate:       c.MinSuccessRate,
+		},
+		State:        campaignStateTo(campaign.State(c.State)),
+		Message:      c.Message,
+		CurrentBatch: int32(c.CurrentBatch),
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+	}
+
+	// A template that cannot be summarized still leaves the rest of the
+	// campaign readable.
+	if opType, desc, err := taskschedule.SummaryFromTemplate(c.OperationTemplate); err == nil {
+		out.OperationType = opType
+		out.OperationDescription = desc
+	}
+
+	for _, t := range c.ComponentTypes {
+		out.ComponentTypes = append(out.ComponentTypes, protobuf.ComponentTypeTo(devicetypes.ComponentTypeFromString(t)))
+	}
+
+	if c.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*c.FinishedAt)
+	}
+
+	p := c.Progress()
+	out.Progress = &pb.CampaignProgress{
+		Total:     int32(p.Total),
+		Pending:   int32(p.Pending),
+		Running:   int32(p.Running),
+		Succeeded: int32(p.Succeeded),
+		Failed:    int32(p.Failed),
+		Skipped:   int32(p.Skipped),
+		Cancelled: int32(p.Cancelled),
+	}
+
+	if withRacks {
+		for _, r := range c.Racks {
+			out.Racks = append(out.Racks, campaignRackToProto(r))
+		}
+	}
+
+	return out
+}
+
+func campaignRackToProto(r *dbmodel.CampaignRack) *pb.CampaignRack {
+	out := &pb.CampaignRack{
+		RackId:   protobuf.UUIDTo(r.RackID),
+		RackName: r.RackName,
+		Batch:    int32(r.Batch),
+		State:    campaignRackStateTo(campaign.RackState(r.State)),
+		Message:  r.Message,
+	}
+	if r.NVLDomainID != nil {
+		out.NvlDomainId = protobuf.UUIDTo(*r.NVLDomainID)
+	}
+	if r.TaskID != nil {
+		out.TaskId = protobuf.UUIDTo(*r.TaskID)
+	}
+	if r.StartedAt != nil {
+		out.StartedAt = timestamppb.New(*r.StartedAt)
+	}
+	if r.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*r.FinishedAt)
+	}
+	return out
+}
+
+var campaignStates = map[campaign.State]pb.CampaignState{
+	campaign.StateRunning:   pb.CampaignState_CAMPAIGN_STATE_RUNNING,
+	campaign.StatePaused:    pb.CampaignState_CAMPAIGN_STATE_PAUSED,
+	campaign.StateCompleted: pb.CampaignState_CAMPAIGN_STATE_COMPLETED,
+	campaign.StateAborted:   pb.CampaignState_CAMPAIGN_STATE_ABORTED,
+}
+
+func campaignStateTo(s campaign.State) pb.CampaignState {
+	if ps, ok := campaignStates[s]; ok {
+		return ps
+	}
+	return pb.CampaignState_CAMPAIGN_STATE_UNKNOWN
+}
+
+func campaignStateFrom(ps pb.CampaignState) campaign.State {
+	for s, p := range campaignStates {
+		if p == ps {
+			return s
+		}
+	}
+	return ""
+}
+
+var campaignRackStates = map[campaign.RackState]pb.CampaignRackState{
+	campaign.RackStatePending:   pb.CampaignRackState_CAMPAIGN_RACK_STATE_PENDING,
+	campaign.RackStateRunning:   pb.CampaignRackState_CAMPAIGN_RACK_STATE_RUNNING,
+	campaign.RackStateSucceeded: pb.CampaignRackState_CAMPAIGN_RACK_STATE_SUCCEEDED,
+	campaign.RackStateFailed:    pb.CampaignRackState_CAMPAIGN_RACK_STATE_FAILED,
+	campaign.RackStateSkipped:   pb.CampaignRackState_CAMPAIGN_RACK_STATE_SKIPPED,
+	campaign.RackStateCancelled: pb.CampaignRackState_CAMPAIGN_RACK_STATE_CANCELLED,
+}
+
+func campaignRackStateTo(s campaign.RackState) pb.CampaignRackState {
+	if ps, ok := campaignRackStates[s]; ok {
+		return ps
+	}
+	return pb.CampaignRackState_CAMPAIGN_RACK_STATE_UNKNOWN
+}
@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/credentialrotation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// RotateRackCredentials starts rotating the passwords of the power shelves
+// and/or NVLink switches of a rack.
+func (rs *RLAServerImpl) RotateRackCredentials(
+	ctx context.Context,
+	req *pb.RotateRackCredentialsRequest,
+) (*pb.RotateRackCredentialsResponse, error) {
+	if rs.rotationManager == nil {
+		return nil, errors.New("credential rotation manager is not available")
+	}
+
+	rackID := protobuf.UUIDFrom(req.GetRackId())
+	if rackID == uuid.Nil {
+		return nil, errors.New("rack_id is required")
+	}
+
+	types := make([]devicetypes.ComponentType, 0, len(req.GetComponentTypes()))
+	for _, t := range req.GetComponentTypes() {
+		types = append(types, protobuf.ComponentTypeFrom(t))
+	}
+
+	results, err := rs.rotationManager.RotateRack(ctx, rackID, types)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RotateRackCredentialsResponse{
+		Results: make([]*pb.CredentialRotationTrigger, 0, len(results)),
+	}
+	for _, r := range results {
+		resp.Results = append(resp.Results, &pb.CredentialRotationTrigger{
+			ComponentId:   protobuf.UUIDTo(r.ComponentID),
+			ComponentType: protobuf.ComponentTypeTo(r.ComponentType),
+			DeviceId:      r.DeviceID,
+			Account:       credentialAccountToProto(r.Account),
+			Started:       r.Started,
+			Error:         r.Error,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetRackCredentialRotationStatus returns the latest credential rotation of
+// every power shelf and NVLink switch account in a rack.
+func (rs *RLAServerImpl) GetRackCredentialRotationStatus(
+	ctx context.Context,
+	req *pb.GetRackCredentialRotationStatusRequest,
+) (*pb.GetRackCredentialRotationStatusResponse, error) {
+	if rs.rotationManager == nil {
+		return nil, errors.New("credential rotation manager is not available")
+	}
+
+	rackID := protobuf.UUIDFrom(req.GetRackId())
+	if rackID == uuid.Nil {
+		return nil, errors.New("rack_id is required")
+	}
+
+	statuses, err := rs.rotationManager.RackStatus(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetRackCredentialRotationStatusResponse{
+		Statuses: make([]*pb.DeviceCredentialRotationStatus, 0, len(statuses)),
+	}
+	for _, s := range statuses {
+		resp.Statuses = append(resp.Statuses, &pb.DeviceCredentialRotationStatus{
+			ComponentId:   protobuf.UUIDTo(s.ComponentID),
+			ComponentType: protobuf.ComponentTypeTo(s.ComponentType),
+			DeviceId:      s.DeviceID,
+			Account:       credentialAccountToProto(s.Account),
+			State:         credentialRotationStateToProto(s.State),
+			LastAttempt:   optionalTimestamp(s.LastAttempt),
+			LastRotated:   optionalTimestamp(s.LastRotated),
+			NextRotation:  optionalTimestamp(s.NextRotation),
+			RotationError: s.RotationError,
+			Error:         s.Error,
+		})
+	}
+
+	return resp, nil
+}
+
+func credentialAccountToProto(a credentialrotation.Account) pb.CredentialAccount {
+	switch a {
+	case credentialrotation.AccountPMC:
+		return pb.CredentialAccount_CREDENTIAL_ACCOUNT_PMC
+	case credentialrotation.AccountBMC:
+		return pb.CredentialAccount_CREDENTIAL_ACCOUNT_BMC
+	case credentialrotation.AccountNVOS:
+		return pb.CredentialAccount_CREDENTIAL_ACCOUNT_NVOS
+	default:
+		return pb.CredentialAccount_CREDENTIAL_ACCOUNT_UNKNOWN
+	}
+}
+
+func credentialRotationStateToProto(s credentialrotation.State) pb.DeviceCredentialRotationState {
+	switch s {
+	case credentialrotation.StateNeverRotated:
+		return pb.DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED
+	case credentialrotation.StateInProgress:
+		return pb.DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_IN_PROGRESS
+	case credentialrotation.StateSucceeded:
+		return pb.DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_SUCCEEDED
+	case credentialrotation.StateFailed:
+		return pb.DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_FAILED
+	case credentialrotation.StateRollbackFailed:
+		return pb.DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED
+	default:
+		return pb.DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_UNKNOWN
+	}
+}
+
+// optionalTimestamp converts t to a protobuf timestamp, leaving it unset if
+// t is zero.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,183 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/discovery"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// DiscoverDevices sweeps the management subnets for power shelves and
+// NVLink switches and returns the devices found.
+func (rs *RLAServerImpl) DiscoverDevices(
+	ctx context.Context,
+	req *pb.DiscoverDevicesRequest,
+) (*pb.DiscoverDevicesResponse, error) {
+	if rs.discoveryManager == nil {
+		return nil, errors.New("discovery manager is not available")
+	}
+
+	devices, err := rs.discoveryManager.Sweep(ctx, req.GetSubnets())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiscoverDevicesResponse{
+		Devices: discoveredDevicesToProto(devices),
+	}, nil
+}
+
+// ListDiscoveredDevices lists the discovered devices in the given states, or
+// all of them.
+func (rs *RLAServerImpl) ListDiscoveredDevices(
+	ctx context.Context,
+	req *pb.ListDiscoveredDevicesRequest,
+) (*pb.ListDiscoveredDevicesResponse, error) {
+	if rs.discoveryManager == nil {
+		return nil, errors.New("discovery manager is not available")
+	}
+
+	states := make([]discovery.State, 0, len(req.GetStates()))
+	for _, s := range req.GetStates() {
+		states = append(states, discoveredDeviceStateFrom(s))
+	}
+
+	devices, err := rs.discoveryManager.List(ctx, states)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListDiscoveredDevicesResponse{
+		Devices: discoveredDevicesToProto(devices),
+	}, nil
+}
+
+// ApproveDiscoveredDevice registers a discovered device held for approval.
+func (rs *RLAServerImpl) ApproveDiscoveredDevice(
+	ctx context.Context,
+	req *pb.ApproveDiscoveredDeviceRequest,
+) (*pb.DiscoveredDevice, error) {
+	if rs.discoveryManager == nil {
+		return nil, errors.New("discovery manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	d, err := rs.discoveryManager.Approve(ctx, id, discovery.Approval{
+		ComponentID:    protobuf.UUIDFrom(req.GetComponentId()),
+		HostMACAddress: req.GetNvosMacAddress(),
+		HostIPAddress:  req.GetNvosIpAddress(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return discoveredDeviceToProto(d), nil
+}
+
+// RejectDiscoveredDevice rejects a discovered device so sweeps stop
+// matching it.
+func (rs *RLAServerImpl) RejectDiscoveredDevice(
+	ctx context.Context,
+	req *pb.RejectDiscoveredDeviceRequest,
+) (*pb.DiscoveredDevice, error) {
+	if rs.discoveryManager == nil {
+		return nil, errors.New("discovery manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	d, err := rs.discoveryManager.Reject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return discoveredDeviceToProto(d), nil
+}
+
+func discoveredDevicesToProto(devices []*dbmodel.DiscoveredDevice) []*pb.DiscoveredDevice {
+	out := make([]*pb.DiscoveredDevice, 0, len(devices))
+	for _, d := range devices {
+		out = append(out, discoveredDeviceToProto(d))
+	}
+	return out
+}
+
+func discoveredDeviceToProto(d *dbmodel.DiscoveredDevice) *pb.DiscoveredDevice {
+	out := &pb.DiscoveredDevice{
+		Id:              protobuf.UUIDTo(d.ID),
+		MacAddress:      d.MacAddress,
+		IpAddress:       d.IPAddress,
+		Type:            protobuf.ComponentTypeTo(devicetypes.ComponentTypeFromString(d.Type)),
+		Manufacturer:    d.Manufacturer,
+		Model:           d.Model,
+		SerialNumber:    d.SerialNumber,
+		FirmwareVersion: d.FirmwareVersion,
+		NvosMacAddress:  d.HostMacAddress,
+		NvosIpAddress:   d.HostIPAddress,
+		State:           discoveredDeviceStateTo(discovery.State(d.State)),
+		Message:         d.Message,
+		FirstSeenAt:     timestamppb.New(d.FirstSeenAt),
+		LastSeenAt:      timestamppb.New(d.LastSeenAt),
+	}
+	if d.ComponentID != nil {
+		out.ComponentId = protobuf.UUIDTo(*d.ComponentID)
+	}
+	return out
+}
+
+var discoveredDeviceStates = map[discovery.State]pb.DiscoveredDeviceState{
+	discovery.StatePending:    pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_PENDING,
+	discovery.StateRegistered: pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_REGISTERED,
+	discovery.StateKnown:      pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_KNOWN,
+	discovery.StateUnmatched:  pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_UNMATCHED,
+	discovery.StateConflict:   pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_CONFLICT,
+	discovery.StateFailed:     pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_FAILED,
+	discovery.StateRejected:   pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_REJECTED,
+}
+
+func discoveredDeviceStateTo(s discovery.State) pb.DiscoveredDeviceState {
+	if ps, ok := discoveredDeviceStates[s]; ok {
+		return ps
+	}
+	return pb.DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_UNKNOWN
+}
+
+func discoveredDeviceStateFrom(ps pb.DiscoveredDeviceState) discovery.State {
+	for s, p := range discoveredDeviceStates {
+		if p == ps {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,227 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	inventorymanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/manager"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// rackResyncer runs the inventory sync on demand for one rack.
+type rackResyncer interface {
+	ResyncRack(ctx context.Context, rackID uuid.UUID) error
+}
+
+// ListDrifts lists the drifts found by the inventory sync. Dismissed drifts
+// are left out unless requested.
+func (rs *RLAServerImpl) ListDrifts(
+	ctx context.Context,
+	req *pb.ListDriftsRequest,
+) (*pb.ListDriftsResponse, error) {
+	filter := inventorymanager.DriftFilter{
+		IncludeDismissed: req.GetIncludeDismissed(),
+	}
+	if req.RackId != nil {
+		rackID := protobuf.UUIDFrom(req.GetRackId())
+		if rackID == uuid.Nil {
+			return nil, status.Error(codes.InvalidArgument, "rack_id is invalid")
+		}
+		filter.RackID = &rackID
+	}
+	for _, t := range req.GetTypes() {
+		driftType, ok := driftTypeFrom(t)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "drift type %s is invalid", t)
+		}
+		filter.DriftTypes = append(filter.DriftTypes, driftType)
+	}
+	for _, t := range req.GetComponentTypes() {
+		filter.ComponentTypes = append(filter.ComponentTypes, protobuf.ComponentTypeFrom(t))
+	}
+
+	drifts, err := rs.inventoryManager.ListDrifts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListDriftsResponse{
+		Drifts: componentDriftsToProto(drifts),
+	}, nil
+}
+
+// AcceptDrift writes the values reported by the component manager service
+// into the expected component and removes the drift.
+func (rs *RLAServerImpl) AcceptDrift(
+	ctx context.Context,
+	req *pb.AcceptDriftRequest,
+) (*pb.AcceptDriftResponse, error) {
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	comp, err := rs.inventoryManager.AcceptDrift(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptDriftResponse{
+		Component: protobuf.ComponentTo(comp),
+	}, nil
+}
+
+// DismissDrift dismisses a drift for as long as the same drift is observed.
+func (rs *RLAServerImpl) DismissDrift(
+	ctx context.Context,
+	req *pb.DismissDriftRequest,
+) (*pb.ComponentDrift, error) {
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if req.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	drift, err := rs.inventoryManager.DismissDrift(ctx, id, req.GetReason(), req.GetDismissedBy())
+	if err != nil {
+		return nil, err
+	}
+
+	return componentDriftToProto(drift), nil
+}
+
+// ResyncRack runs the inventory sync now and returns the open drifts of the
+// rack afterwards.
+func (rs *RLAServerImpl) ResyncRack(
+	ctx context.Context,
+	req *pb.ResyncRackRequest,
+) (*pb.ResyncRackResponse, error) {
+	if rs.inventorySync == nil {
+		return nil, status.Error(codes.Unavailable, "inventory sync is not available")
+	}
+
+	rackID := protobuf.UUIDFrom(req.GetRackId())
+	if rackID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "rack_id is required")
+	}
+
+	if _, err := rs.inventoryManager.GetRackByID(ctx, rackID, false); err != nil {
+		return nil, err
+	}
+
+	if err := rs.inventorySync.ResyncRack(ctx, rackID); err != nil {
+		return nil, err
+	}
+
+	drifts, err := rs.inventoryManager.ListDrifts(ctx, inventorymanager.DriftFilter{RackID: &rackID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResyncRackResponse{
+		Drifts: componentDriftsToProto(drifts),
+	}, nil
+}
+
+func componentDriftsToProto(drifts []inventorymanager.ComponentDrift) []*pb.ComponentDrift {
+	out := make([]*pb.ComponentDrift, 0, len(drifts))
+	for i := range drifts {
+		out = append(out, componentDriftToProto(&drifts[i]))
+	}
+	return out
+}
+
+func componentDriftToProto(d *inventorymanager.ComponentDrift) *pb.ComponentDrift {
+	diffs := make([]*pb.FieldDiff, 0, len(d.Diffs))
+	for _, fd := range d.Diffs {
+		diffs = append(diffs, &pb.FieldDiff{
+			FieldName:     fd.FieldName,
+			ExpectedValue: fd.ExpectedValue,
+			ActualValue:   fd.ActualValue,
+		})
+	}
+
+	out := &pb.ComponentDrift{
+		Id:            protobuf.UUIDTo(d.ID),
+		ComponentType: protobuf.ComponentTypeTo(d.ComponentType),
+		Type:          driftTypeTo(d.DriftType),
+		Diffs:         diffs,
+		Status:        driftStatusTo(d.Status),
+		DismissReason: d.DismissReason,
+		DismissedBy:   d.DismissedBy,
+		CheckedAt:     timestamppb.New(d.CheckedAt),
+	}
+	if d.ComponentID != nil {
+		out.ComponentId = protobuf.UUIDTo(*d.ComponentID)
+	}
+	if d.ExternalID != nil {
+		out.ExternalId = *d.ExternalID
+	}
+	if d.RackID != nil {
+		out.RackId = protobuf.UUIDTo(*d.RackID)
+	}
+	if d.DismissedAt != nil {
+		out.DismissedAt = timestamppb.New(*d.DismissedAt)
+	}
+	return out
+}
+
+var driftTypes = map[dbmodel.DriftType]pb.DriftType{
+	dbmodel.DriftTypeMissingInExpected: pb.DriftType_DRIFT_TYPE_MISSING_IN_EXPECTED,
+	dbmodel.DriftTypeMissingInActual:   pb.DriftType_DRIFT_TYPE_MISSING_IN_ACTUAL,
+	dbmodel.DriftTypeMismatch:          pb.DriftType_DRIFT_TYPE_MISMATCH,
+}
+
+func driftTypeTo(t string) pb.DriftType {
+	if pt, ok := driftTypes[dbmodel.DriftType(t)]; ok {
+		return pt
+	}
+	return pb.DriftType_DRIFT_TYPE_UNKNOWN
+}
+
+// driftTypeFrom returns the drift type of pt, or false if pt is unknown.
+func driftTypeFrom(pt pb.DriftType) (string, bool) {
+	for t, p := range driftTypes {
+		if p == pt {
+			return string(t), true
+		}
+	}
+	return "", false
+}
+
+func driftStatusTo(s string) pb.DriftStatus {
+	switch dbmodel.DriftStatus(s) {
+	case dbmodel.DriftStatusOpen:
+		return pb.DriftStatus_DRIFT_STATUS_OPEN
+	case dbmodel.DriftStatusDismissed:
+		return pb.DriftStatus_DRIFT_STATUS_DISMISSED
+	default:
+		return pb.DriftStatus_DRIFT_STATUS_UNKNOWN
+	}
+}
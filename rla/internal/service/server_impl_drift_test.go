@@ -0,0 +1,202 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventorymanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/manager"
+	inventorystore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/store"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/deviceinfo"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+func (m *mockManager) ListDrifts(_ context.Context, filter inventorymanager.DriftFilter) ([]inventorystore.ComponentDrift, error) {
+	var result []inventorystore.ComponentDrift
+	for _, d := range m.drifts {
+		if filter.RackID != nil && (d.RackID == nil || *d.RackID != *filter.RackID) {
+			continue
+		}
+		if !filter.IncludeDismissed && d.Status != "open" {
+			continue
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+func (m *mockManager) DismissDrift(_ context.Context, id uuid.UUID, reason string, dismissedBy string) (*inventorystore.ComponentDrift, error) {
+	for i := range m.drifts {
+		if m.drifts[i].ID == id {
+			now := time.Now()
+			m.drifts[i].Status = "dismissed"
+			m.drifts[i].DismissReason = reason
+			m.drifts[i].DismissedBy = dismissedBy
+			m.drifts[i].DismissedAt = &now
+			return &m.drifts[i], nil
+		}
+	}
+	return nil, assert.AnError
+}
+
+type mockRackResyncer struct {
+	rackIDs []uuid.UUID
+}
+
+func (m *mockRackResyncer) ResyncRack(_ context.Context, rackID uuid.UUID) error {
+	m.rackIDs = append(m.rackIDs, rackID)
+	return nil
+}
+
+func newDriftTestManager(rackID uuid.UUID) *mockManager {
+	mgr := newMockManager()
+	mgr.racks[rackID] = &rack.Rack{Info: deviceinfo.DeviceInfo{ID: rackID, Name: "test-rack"}}
+
+	compID := uuid.New()
+	externalID := "machine-1"
+	mgr.drifts = []inventorystore.ComponentDrift{
+		{
+			ID:            uuid.New(),
+			ComponentID:   &compID,
+			ExternalID:    &externalID,
+			RackID:        &rackID,
+			ComponentType: devicetypes.ComponentTypeCompute,
+			DriftType:     "mismatch",
+			Diffs: []inventorystore.FieldDiff{
+				{FieldName: "serial_number", ExpectedValue: "SN1", ActualValue: "SN2"},
+			},
+			Status:    "open",
+			CheckedAt: time.Now(),
+		},
+		{
+			ID:            uuid.New(),
+			ExternalID:    &externalID,
+			ComponentType: devicetypes.ComponentTypeCompute,
+			DriftType:     "missing_in_expected",
+			Status:        "dismissed",
+			DismissReason: "lab machine",
+			CheckedAt:     time.Now(),
+		},
+	}
+	return mgr
+}
+
+func TestListDrifts(t *testing.T) {
+	rackID := uuid.New()
+	server := &RLAServerImpl{inventoryManager: newDriftTestManager(rackID)}
+
+	resp, err := server.ListDrifts(context.Background(), &pb.ListDriftsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Drifts, 1)
+
+	d := resp.Drifts[0]
+	assert.Equal(t, pb.DriftType_DRIFT_TYPE_MISMATCH, d.Type)
+	assert.Equal(t, pb.DriftStatus_DRIFT_STATUS_OPEN, d.Status)
+	assert.Equal(t, pb.ComponentType_COMPONENT_TYPE_COMPUTE, d.ComponentType)
+	assert.Equal(t, rackID.String(), d.RackId.Id)
+	assert.Equal(t, "machine-1", d.ExternalId)
+	require.Len(t, d.Diffs, 1)
+	assert.Equal(t, "SN2", d.Diffs[0].ActualValue)
+
+	resp, err = server.ListDrifts(context.Background(), &pb.ListDriftsRequest{IncludeDismissed: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Drifts, 2)
+	assert.Equal(t, pb.DriftType_DRIFT_TYPE_MISSING_IN_EXPECTED, resp.Drifts[1].Type)
+	assert.Equal(t, pb.DriftStatus_DRIFT_STATUS_DISMISSED, resp.Drifts[1].Status)
+	assert.Nil(t, resp.Drifts[1].ComponentId)
+	assert.Nil(t, resp.Drifts[1].RackId)
+
+	for _, dt := range []pb.DriftType{pb.DriftType_DRIFT_TYPE_UNKNOWN, pb.DriftType(42)} {
+		_, err = server.ListDrifts(context.Background(), &pb.ListDriftsRequest{Types: []pb.DriftType{pb.DriftType_DRIFT_TYPE_MISMATCH, dt}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestDismissDrift(t *testing.T) {
+	mgr := newDriftTestManager(uuid.New())
+	server := &RLAServerImpl{inventoryManager: mgr}
+	driftID := mgr.drifts[0].ID
+
+	_, err := server.DismissDrift(context.Background(), &pb.DismissDriftRequest{
+		Id: &pb.UUID{Id: driftID.String()},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "reason is required")
+
+	resp, err := server.DismissDrift(context.Background(), &pb.DismissDriftRequest{
+		Id:          &pb.UUID{Id: driftID.String()},
+		Reason:      "serial swapped during RMA",
+		DismissedBy: "ops",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.DriftStatus_DRIFT_STATUS_DISMISSED, resp.Status)
+	assert.Equal(t, "serial swapped during RMA", resp.DismissReason)
+	assert.Equal(t, "ops", resp.DismissedBy)
+	assert.NotNil(t, resp.DismissedAt)
+}
+
+func TestResyncRack(t *testing.T) {
+	rackID := uuid.New()
+
+	t.Run("sync not available", func(t *testing.T) {
+		server := &RLAServerImpl{inventoryManager: newDriftTestManager(rackID)}
+
+		_, err := server.ResyncRack(context.Background(), &pb.ResyncRackRequest{
+			RackId: &pb.UUID{Id: rackID.String()},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Contains(t, err.Error(), "inventory sync is not available")
+	})
+
+	t.Run("rack not found", func(t *testing.T) {
+		syncer := &mockRackResyncer{}
+		server := &RLAServerImpl{inventoryManager: newDriftTestManager(rackID), inventorySync: syncer}
+
+		_, err := server.ResyncRack(context.Background(), &pb.ResyncRackRequest{
+			RackId: &pb.UUID{Id: uuid.New().String()},
+		})
+		require.Error(t, err)
+		assert.Empty(t, syncer.rackIDs)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		syncer := &mockRackResyncer{}
+		server := &RLAServerImpl{inventoryManager: newDriftTestManager(rackID), inventorySync: syncer}
+
+		resp, err := server.ResyncRack(context.Background(), &pb.ResyncRackRequest{
+			RackId: &pb.UUID{Id: rackID.String()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{rackID}, syncer.rackIDs)
+		require.Len(t, resp.Drifts, 1)
+		assert.Equal(t, rackID.String(), resp.Drifts[0].RackId.Id)
+	})
+}
@@ -0,0 +1,288 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/maintenance"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// CreateMaintenanceWindow adds a maintenance window or blackout to the
+// maintenance calendar.
+func (rs *RLAServerImpl) CreateMaintenanceWindow(
+	ctx context.Context,
+	req *pb.CreateMaintenanceWindowRequest,
+) (*pb.MaintenanceWindow, error) {
+	if rs.maintenanceCalendar == nil {
+		return nil, errors.New("maintenance calendar is not available")
+	}
+
+	spec := &maintenance.Spec{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Kind:        maintenanceKindFrom(req.GetKind()),
+	}
+
+	switch scope := req.GetScope().(type) {
+	case *pb.CreateMaintenanceWindowRequest_RackId:
+		spec.Scope = maintenance.ScopeRack
+		spec.ScopeID = protobuf.UUIDFrom(scope.RackId)
+	case *pb.CreateMaintenanceWindowRequest_NvlDomainId:
+		spec.Scope = maintenance.ScopeNVLDomain
+		spec.ScopeID = protobuf.UUIDFrom(scope.NvlDomainId)
+	case *pb.CreateMaintenanceWindowRequest_Site:
+		spec.Scope = maintenance.ScopeSite
+		spec.Site = maintenance.Site{
+			Region:     scope.Site.GetRegion(),
+			DataCenter: scope.Site.GetDatacenter(),
+			Room:       scope.Site.GetRoom(),
+		}
+	default:
+		return nil, errors.New("scope is required")
+	}
+
+	switch occurrence := req.GetOccurrence().(type) {
+	case *pb.CreateMaintenanceWindowRequest_Once:
+		if occurrence.Once.GetStartTime() == nil || occurrence.Once.GetEndTime() == nil {
+			return nil, errors.New("once requires start_time and end_time")
+		}
+		spec.Start = occurrence.Once.GetStartTime().AsTime()
+		spec.End = occurrence.Once.GetEndTime().AsTime()
+	case *pb.CreateMaintenanceWindowRequest_Recurrence:
+		spec.Recurrence = occurrence.Recurrence.GetCron()
+		spec.Duration = time.Duration(occurrence.Recurrence.GetDurationSeconds()) * time.Second
+		spec.Timezone = occurrence.Recurrence.GetTimezone()
+	default:
+		return nil, errors.New("occurrence is required")
+	}
+
+	row, err := rs.maintenanceCalendar.CreateWindow(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return maintenanceWindowToProto(row), nil
+}
+
+// DeleteMaintenanceWindow removes a maintenance window or blackout.
+func (rs *RLAServerImpl) DeleteMaintenanceWindow(
+	ctx context.Context,
+	req *pb.DeleteMaintenanceWindowRequest,
+) (*emptypb.Empty, error) {
+	if rs.maintenanceCalendar == nil {
+		return nil, errors.New("maintenance calendar is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	if err := rs.maintenanceCalendar.DeleteWindow(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListMaintenanceWindows lists the maintenance windows and blackouts that
+// apply to a rack, or all of them.
+func (rs *RLAServerImpl) ListMaintenanceWindows(
+	ctx context.Context,
+	req *pb.ListMaintenanceWindowsRequest,
+) (*pb.ListMaintenanceWindowsResponse, error) {
+	if rs.maintenanceCalendar == nil {
+		return nil, errors.New("maintenance calendar is not available")
+	}
+
+	var rackID *uuid.UUID
+	if req.RackId != nil {
+		id := protobuf.UUIDFrom(req.GetRackId())
+		rackID = &id
+	}
+
+	rows, err := rs.maintenanceCalendar.ListWindows(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListMaintenanceWindowsResponse{
+		Windows: make([]*pb.MaintenanceWindow, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.Windows = append(resp.Windows, maintenanceWindowToProto(row))
+	}
+
+	return resp, nil
+}
+
+// GetRackMaintenanceStatus reports whether disruptive operations may run on a
+// rack and when it next opens.
+func (rs *RLAServerImpl) GetRackMaintenanceStatus(
+	ctx context.Context,
+	req *pb.GetRackMaintenanceStatusRequest,
+) (*pb.RackMaintenanceStatus, error) {
+	if rs.maintenanceCalendar == nil {
+		return nil, errors.New("maintenance calendar is not available")
+	}
+
+	rackID := protobuf.UUIDFrom(req.GetRackId())
+	if rackID == uuid.Nil {
+		return nil, errors.New("rack_id is required")
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = req.GetAt().AsTime()
+	}
+
+	status, err := rs.maintenanceCalendar.RackStatus(ctx, rackID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RackMaintenanceStatus{
+		RackId:     protobuf.UUIDTo(rackID),
+		Open:       status.Open,
+		Reason:     status.Reason,
+		Restricted: status.Restricted,
+	}
+	if status.Opening != nil {
+		resp.OpeningStart = timestamppb.New(status.Opening.Start)
+		if !status.Opening.End.IsZero() {
+			resp.OpeningEnd = timestamppb.New(status.Opening.End)
+		}
+	}
+
+	return resp, nil
+}
+
+// ListMaintenanceOverrides lists the operations that ran on a closed rack
+// because of a maintenance override, newest first.
+func (rs *RLAServerImpl) ListMaintenanceOverrides(
+	ctx context.Context,
+	req *pb.ListMaintenanceOverridesRequest,
+) (*pb.ListMaintenanceOverridesResponse, error) {
+	if rs.maintenanceCalendar == nil {
+		return nil, errors.New("maintenance calendar is not available")
+	}
+
+	var rackID *uuid.UUID
+	if req.RackId != nil {
+		id := protobuf.UUIDFrom(req.GetRackId())
+		rackID = &id
+	}
+
+	rows, err := rs.maintenanceCalendar.ListOverrides(ctx, rackID, int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListMaintenanceOverridesResponse{
+		Overrides: make([]*pb.MaintenanceOverrideRecord, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.Overrides = append(resp.Overrides, &pb.MaintenanceOverrideRecord{
+			Id:          protobuf.UUIDTo(row.ID),
+			TaskId:      protobuf.UUIDTo(row.TaskID),
+			RackId:      protobuf.UUIDTo(row.RackID),
+			Operation:   row.OperationType + "/" + row.OperationCode,
+			Reason:      row.Reason,
+			RequestedBy: row.RequestedBy,
+			Bypassed:    row.Bypassed,
+			CreatedAt:   timestamppb.New(row.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// --- Converters ---
+
+var maintenanceKinds = map[maintenance.Kind]pb.MaintenanceWindowKind{
+	maintenance.KindWindow:   pb.MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_WINDOW,
+	maintenance.KindBlackout: pb.MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_BLACKOUT,
+}
+
+func maintenanceKindTo(k maintenance.Kind) pb.MaintenanceWindowKind {
+	if pk, ok := maintenanceKinds[k]; ok {
+		return pk
+	}
+	return pb.MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_UNSPECIFIED
+}
+
+func maintenanceKindFrom(pk pb.MaintenanceWindowKind) maintenance.Kind {
+	for k, p := range maintenanceKinds {
+		if p == pk {
+			return k
+		}
+	}
+	return ""
+}
+
+func maintenanceWindowToProto(row *dbmodel.MaintenanceWindow) *pb.MaintenanceWindow {
+	w := &pb.MaintenanceWindow{
+		Id:          protobuf.UUIDTo(row.ID),
+		Name:        row.Name,
+		Description: row.Description,
+		Kind:        maintenanceKindTo(maintenance.Kind(row.Kind)),
+		CreatedAt:   timestamppb.New(row.CreatedAt),
+	}
+
+	switch maintenance.Scope(row.Scope) {
+	case maintenance.ScopeRack:
+		if row.ScopeID != nil {
+			w.Scope = &pb.MaintenanceWindow_RackId{RackId: protobuf.UUIDTo(*row.ScopeID)}
+		}
+	case maintenance.ScopeNVLDomain:
+		if row.ScopeID != nil {
+			w.Scope = &pb.MaintenanceWindow_NvlDomainId{NvlDomainId: protobuf.UUIDTo(*row.ScopeID)}
+		}
+	case maintenance.ScopeSite:
+		w.Scope = &pb.MaintenanceWindow_Site{Site: &pb.Location{
+			Region:     row.Region,
+			Datacenter: row.DataCenter,
+			Room:       row.Room,
+		}}
+	}
+
+	if row.Recurrence != "" {
+		w.Occurrence = &pb.MaintenanceWindow_Recurrence{Recurrence: &pb.MaintenanceRecurrence{
+			Cron:            row.Recurrence,
+			DurationSeconds: int32(row.DurationSeconds),
+			Timezone:        row.Timezone,
+		}}
+	} else if row.StartAt != nil && row.EndAt != nil {
+		w.Occurrence = &pb.MaintenanceWindow_Once{Once: &pb.MaintenanceOnce{
+			StartTime: timestamppb.New(*row.StartAt),
+			EndTime:   timestamppb.New(*row.EndAt),
+		}}
+	}
+
+	return w
+}
@@ -0,0 +1,178 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// PlanOperation shows how an operation would execute on each target rack:
+// the rule picked, its stages and batches, an estimated duration, and the
+// active tasks it would conflict with. Nothing is submitted.
+func (rs *RLAServerImpl) PlanOperation(
+	ctx context.Context,
+	req *pb.PlanOperationRequest,
+) (*pb.PlanOperationResponse, error) {
+	if rs.taskManager == nil {
+		return nil, errors.New("task manager is not available")
+	}
+
+	opReq, err := rs.planOperationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := rs.taskManager.PlanTask(ctx, opReq)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.RackOperationPlan, 0, len(plans))
+	for _, p := range plans {
+		pbPlan, err := operationPlanTo(p)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pbPlan)
+	}
+
+	return &pb.PlanOperationResponse{Plans: results}, nil
+}
+
+// planOperationRequest builds the request the operation's own RPC would
+// submit, so the plan resolves exactly as the submission would.
+func (rs *RLAServerImpl) planOperationRequest(
+	req *pb.PlanOperationRequest,
+) (*operation.Request, error) {
+	switch r := req.GetOperation().(type) {
+	case *pb.PlanOperationRequest_PowerOn:
+		return rs.powerOnRequest(r.PowerOn)
+	case *pb.PlanOperationRequest_PowerOff:
+		return rs.powerOffRequest(r.PowerOff)
+	case *pb.PlanOperationRequest_PowerReset:
+		return rs.powerResetRequest(r.PowerReset)
+	case *pb.PlanOperationRequest_UpgradeFirmware:
+		return rs.upgradeFirmwareRequest(r.UpgradeFirmware)
+	case *pb.PlanOperationRequest_BringUp:
+		return rs.bringUpRequest(r.BringUp)
+	case *pb.PlanOperationRequest_Ingest:
+		return rs.ingestRequest(r.Ingest)
+	default:
+		return nil, errors.New("operation is required")
+	}
+}
+
+func operationPlanTo(plan *taskdef.OperationPlan) (*pb.RackOperationPlan, error) {
+	rule, err := protobuf.OperationRuleTo(plan.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("convert rule for rack %s: %w", plan.RackID, err)
+	}
+
+	var opStr string
+	if op, err := operations.New(plan.Operation.Type, plan.Operation.Info); err == nil {
+		opStr = op.Description()
+	}
+
+	pbPlan := &pb.RackOperationPlan{
+		RackId:                   protobuf.UUIDTo(plan.RackID),
+		RackName:                 plan.RackName,
+		Operation:                opStr,
+		Rule:                     rule,
+		RuleSource:               ruleSourceTo(plan.RuleSource),
+		EstimatedDurationSeconds: durationSeconds(plan.EstimatedDuration),
+		Outcome:                  planOutcomeTo(plan.Outcome),
+		Reason:                   plan.Reason,
+		Warnings:                 plan.Warnings,
+	}
+
+	for _, stage := range plan.Stages {
+		pbPlan.Stages = append(pbPlan.Stages, plannedStageTo(stage))
+	}
+
+	for _, t := range plan.ConflictingTasks {
+		pbPlan.ConflictingTasks = append(pbPlan.ConflictingTasks, protobuf.TaskTo(t))
+	}
+
+	return pbPlan, nil
+}
+
+func plannedStageTo(stage taskdef.PlannedStage) *pb.PlannedStage {
+	pbStage := &pb.PlannedStage{
+		Number:                   int32(stage.Number),
+		EstimatedDurationSeconds: durationSeconds(stage.EstimatedDuration),
+	}
+
+	for _, step := range stage.Steps {
+		pbStep := &pb.PlannedStep{
+			ComponentType:            protobuf.ComponentTypeTo(step.ComponentType),
+			MaxParallel:              int32(step.MaxParallel),
+			Actions:                  step.Actions,
+			EstimatedDurationSeconds: durationSeconds(step.EstimatedDuration),
+		}
+		for _, batch := range step.Batches {
+			pbStep.Batches = append(pbStep.Batches, &pb.ComponentBatch{
+				ComponentIds: protobuf.UUIDsTo(batch),
+			})
+		}
+		pbStage.Steps = append(pbStage.Steps, pbStep)
+	}
+
+	return pbStage
+}
+
+func ruleSourceTo(source operationrules.RuleSource) pb.RuleSource {
+	switch source {
+	case operationrules.RuleSourceExplicit:
+		return pb.RuleSource_RULE_SOURCE_EXPLICIT
+	case operationrules.RuleSourceRackAssociation:
+		return pb.RuleSource_RULE_SOURCE_RACK_ASSOCIATION
+	case operationrules.RuleSourceDefault:
+		return pb.RuleSource_RULE_SOURCE_DEFAULT
+	case operationrules.RuleSourceHardcoded:
+		return pb.RuleSource_RULE_SOURCE_HARDCODED
+	default:
+		return pb.RuleSource_RULE_SOURCE_UNKNOWN
+	}
+}
+
+func planOutcomeTo(outcome taskdef.PlanOutcome) pb.PlanOutcome {
+	switch outcome {
+	case taskdef.PlanOutcomeRun:
+		return pb.PlanOutcome_PLAN_OUTCOME_RUN
+	case taskdef.PlanOutcomeQueue:
+		return pb.PlanOutcome_PLAN_OUTCOME_QUEUE
+	case taskdef.PlanOutcomeReject:
+		return pb.PlanOutcome_PLAN_OUTCOME_REJECT
+	default:
+		return pb.PlanOutcome_PLAN_OUTCOME_UNKNOWN
+	}
+}
+
+func durationSeconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
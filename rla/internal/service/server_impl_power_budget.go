@@ -0,0 +1,215 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/converter/protobuf"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/powerbudget"
+	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
+)
+
+// SetPowerBudget sets the budget of a rack or the cap of a power shelf and
+// applies the resulting limits to the rack's power shelves.
+func (rs *RLAServerImpl) SetPowerBudget(
+	ctx context.Context,
+	req *pb.SetPowerBudgetRequest,
+) (*pb.SetPowerBudgetResponse, error) {
+	if rs.powerBudgetManager == nil {
+		return nil, errors.New("power budget manager is not available")
+	}
+
+	var (
+		budget *dbmodel.PowerBudget
+		limits []powerbudget.ShelfLimit
+		err    error
+	)
+
+	switch target := req.GetTarget().(type) {
+	case *pb.SetPowerBudgetRequest_RackId:
+		rackID := protobuf.UUIDFrom(target.RackId)
+		if rackID == uuid.Nil {
+			return nil, errors.New("rack_id is required")
+		}
+		budget, limits, err = rs.powerBudgetManager.SetRackBudget(
+			ctx, rackID, req.GetLimitWatts(),
+		)
+	case *pb.SetPowerBudgetRequest_ComponentId:
+		componentID := protobuf.UUIDFrom(target.ComponentId)
+		if componentID == uuid.Nil {
+			return nil, errors.New("component_id is required")
+		}
+		budget, limits, err = rs.powerBudgetManager.SetShelfBudget(
+			ctx, componentID, req.GetLimitWatts(),
+		)
+	default:
+		return nil, errors.New("either rack_id or component_id is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetPowerBudgetResponse{
+		Budget: powerBudgetToProto(budget),
+		Limits: shelfPowerLimitsToProto(limits),
+	}, nil
+}
+
+// DeletePowerBudget removes a budget and clears the limits it imposed.
+func (rs *RLAServerImpl) DeletePowerBudget(
+	ctx context.Context,
+	req *pb.DeletePowerBudgetRequest,
+) (*pb.DeletePowerBudgetResponse, error) {
+	if rs.powerBudgetManager == nil {
+		return nil, errors.New("power budget manager is not available")
+	}
+
+	id := protobuf.UUIDFrom(req.GetId())
+	if id == uuid.Nil {
+		return nil, errors.New("id is required")
+	}
+
+	limits, err := rs.powerBudgetManager.DeleteBudget(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePowerBudgetResponse{
+		Limits: shelfPowerLimitsToProto(limits),
+	}, nil
+}
+
+// ListPowerBudgets lists the budgets of the given racks, or of all racks.
+func (rs *RLAServerImpl) ListPowerBudgets(
+	ctx context.Context,
+	req *pb.ListPowerBudgetsRequest,
+) (*pb.ListPowerBudgetsResponse, error) {
+	if rs.powerBudgetManager == nil {
+		return nil, errors.New("power budget manager is not available")
+	}
+
+	budgets, err := rs.powerBudgetManager.ListBudgets(
+		ctx, protobuf.UUIDsFrom(req.GetRackIds()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListPowerBudgetsResponse{
+		Budgets: make([]*pb.PowerBudget, 0, len(budgets)),
+	}
+	for _, b := range budgets {
+		resp.Budgets = append(resp.Budgets, powerBudgetToProto(b))
+	}
+
+	return resp, nil
+}
+
+// GetRackPowerStatus returns the measured power draw of a rack against its
+// budget and shelf caps.
+func (rs *RLAServerImpl) GetRackPowerStatus(
+	ctx context.Context,
+	req *pb.GetRackPowerStatusRequest,
+) (*pb.RackPowerStatus, error) {
+	if rs.powerBudgetManager == nil {
+		return nil, errors.New("power budget manager is not available")
+	}
+
+	rackID := protobuf.UUIDFrom(req.GetRackId())
+	if rackID == uuid.Nil {
+		return nil, errors.New("rack_id is required")
+	}
+
+	status, err := rs.powerBudgetManager.RackStatus(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rackPowerStatusToProto(status), nil
+}
+
+func powerBudgetToProto(b *dbmodel.PowerBudget) *pb.PowerBudget {
+	out := &pb.PowerBudget{
+		Id:         protobuf.UUIDTo(b.ID),
+		RackId:     protobuf.UUIDTo(b.RackID),
+		LimitWatts: b.LimitWatts,
+		CreatedAt:  timestamppb.New(b.CreatedAt),
+		UpdatedAt:  timestamppb.New(b.UpdatedAt),
+	}
+	if !b.IsRackBudget() {
+		out.ComponentId = protobuf.UUIDTo(*b.ComponentID)
+	}
+	return out
+}
+
+func shelfPowerLimitsToProto(limits []powerbudget.ShelfLimit) []*pb.ShelfPowerLimit {
+	out := make([]*pb.ShelfPowerLimit, 0, len(limits))
+	for _, l := range limits {
+		out = append(out, &pb.ShelfPowerLimit{
+			ComponentId:   protobuf.UUIDTo(l.ComponentID),
+			PmcMacAddress: l.PMCMACAddress,
+			LimitWatts:    l.LimitWatts,
+			Status:        applyStatusToProto(l.Status),
+			Error:         l.Error,
+		})
+	}
+	return out
+}
+
+func applyStatusToProto(s powerbudget.ApplyStatus) pb.PowerLimitApplyStatus {
+	switch s {
+	case powerbudget.ApplyStatusApplied:
+		return pb.PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_APPLIED
+	case powerbudget.ApplyStatusNotSupported:
+		return pb.PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_NOT_SUPPORTED
+	case powerbudget.ApplyStatusFailed:
+		return pb.PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_FAILED
+	case powerbudget.ApplyStatusSkipped:
+		return pb.PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_SKIPPED
+	default:
+		return pb.PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_UNKNOWN
+	}
+}
+
+func rackPowerStatusToProto(s *powerbudget.RackStatus) *pb.RackPowerStatus {
+	out := &pb.RackPowerStatus{
+		RackId:      protobuf.UUIDTo(s.RackID),
+		BudgetWatts: s.BudgetWatts,
+		DrawWatts:   s.DrawWatts,
+		Measured:    s.Measured,
+		OverBudget:  s.OverBudget(),
+		Shelves:     make([]*pb.ShelfPowerStatus, 0, len(s.Shelves)),
+	}
+	for _, shelf := range s.Shelves {
+		out.Shelves = append(out.Shelves, &pb.ShelfPowerStatus{
+			ComponentId:       protobuf.UUIDTo(shelf.ComponentID),
+			PmcMacAddress:     shelf.PMCMACAddress,
+			CapWatts:          shelf.CapWatts,
+			AppliedLimitWatts: shelf.AppliedLimitWatts,
+			DrawWatts:         shelf.DrawWatts,
+			Measured:          shelf.Measured,
+		})
+	}
+	return out
+}
@@ -96,6 +96,10 @@ func (rs *RLAServerImpl) CreateTaskSchedule(
 	// queue_options may still supply a timeout when the policy is QUEUE.
 	overlapPolicy := protoOverlapPolicyToModel(sched.GetOverlapPolicy())
 	_, queueTimeout := protobuf.QueueOptionsFrom(pbQueueOpts)
+	priority, preemption := protobuf.PriorityOptionsFrom(pbQueueOpts)
+	if preemption != operation.PreemptionNone {
+		return nil, errors.New("scheduled tasks cannot preempt other tasks")
+	}
 	conflictStrategy := operation.ConflictStrategyReject
 	if overlapPolicy == dbmodel.OverlapPolicyQueue {
 		conflictStrategy = operation.ConflictStrategyQueue
@@ -103,6 +107,7 @@ func (rs *RLAServerImpl) CreateTaskSchedule(
 	templateOpts := taskschedule.TemplateOptions{
 		ConflictStrategy: int(conflictStrategy),
 		QueueTimeoutSecs: int64(queueTimeout.Seconds()),
+		Priority:         priority,
 	}
 	if ruleUUID := protobuf.UUIDFrom(pbRuleID); ruleUUID != uuid.Nil {
 		templateOpts.RuleID = ruleUUID.String()
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 
 	"github.com/rs/zerolog/log"
@@ -30,15 +31,28 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/campaign"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/certs"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/credentialrotation"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/migrations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/discovery"
 	inventorymanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/manager"
 	inventorystore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/inventory/store"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/maintenance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/nsmapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/powerbudget"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/psmapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/jobs/budgetmonitor"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/jobs/campaignrunner"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/jobs/devicediscovery"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/jobs/inventorysync"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/jobs/leakdetection"
 	taskschedule "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/taskschedule"
 	schedtypes "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/scheduler/types"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager"
+	nsmprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvswitchmanager"
+	psmprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/psm"
 	taskmanager "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/manager"
 	taskstore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/store"
 	pb "github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1"
@@ -56,6 +70,13 @@ type Service struct {
 	sched                  *scheduler.Scheduler
 	taskScheduleStore      taskschedule.Store
 	taskScheduleDispatcher *taskschedule.Dispatcher
+	powerBudgetManager     *powerbudget.Manager
+	rotationManager        *credentialrotation.Manager
+	discoveryManager       *discovery.Manager
+	campaignManager        *campaign.Manager
+	maintenanceCalendar    *maintenance.Calendar
+	inventorySync          *inventorysync.Job
+	metricsServer          *http.Server
 }
 
 // New creates and initialises a Service from the provided Config. It opens the
@@ -87,27 +108,96 @@ func New(ctx context.Context, c Config) (*Service, error) {
 	// 3. Create InventoryManager (Business Logic Layer)
 	invManager := inventorymanager.New(invStore)
 
-	// 4. Create TaskManager (Business Logic Layer)
+	// 4. Create PowerBudgetManager (Business Logic Layer)
+	// Budgets are applied and measured through the Powershelf Manager when
+	// its provider is registered; otherwise they are only stored.
+	var psmClient psmapi.Client
+	var nsmClient nsmapi.Client
+	if c.ProviderRegistry != nil {
+		if p, err := componentmanager.GetTyped[*psmprovider.Provider](
+			c.ProviderRegistry, psmprovider.ProviderName,
+		); err == nil {
+			psmClient = p.Client()
+		}
+		if p, err := componentmanager.GetTyped[*nsmprovider.Provider](
+			c.ProviderRegistry, nsmprovider.ProviderName,
+		); err == nil {
+			nsmClient = p.Client()
+		}
+	}
+	budgetManager := powerbudget.NewManager(
+		powerbudget.NewPostgresStore(session),
+		invStore,
+		psmClient,
+		c.RLAConfig.PowerEstimates,
+	)
+
+	// 5. Create CredentialRotationManager (Business Logic Layer)
+	// Rotation is carried out by the Powershelf Manager and the NV-Switch
+	// Manager; devices whose manager is not registered are reported as such.
+	rotationManager := credentialrotation.NewManager(invStore, psmClient, nsmClient)
+
+	// 6. Create DiscoveryManager (Business Logic Layer)
+	// Devices found on the management subnets are registered with the
+	// Powershelf Manager and the NV-Switch Manager and recorded on their
+	// expected components.
+	discoveryConf := c.RLAConfig.Discovery
+	discoveryManager := discovery.NewManager(
+		discoveryConf,
+		discovery.NewRedfishProber(discoveryConf.Port, discoveryConf.Credentials),
+		discovery.NewPostgresStore(session),
+		discovery.NewPostgresInventory(session),
+		psmClient,
+		nsmClient,
+	)
+
+	// 7. Create MaintenanceCalendar (Business Logic Layer)
+	// The task manager holds disruptive tasks to the maintenance windows
+	// and blackouts of their racks.
+	maintenanceCalendar := maintenance.NewCalendar(
+		maintenance.NewPostgresStore(session),
+		maintenance.NewPostgresInventory(session),
+	)
+
+	// 8. Create TaskManager (Business Logic Layer)
 	// Note: Task manager creates its own rule resolver internally
 	taskManager, err := taskmanager.New(
 		ctx,
 		&taskmanager.Config{
-			InventoryStore: invStore,
-			TaskStore:      tskStore,
-			ExecutorConfig: c.ExecutorConf,
+			InventoryStore:          invStore,
+			TaskStore:               tskStore,
+			ExecutorConfig:          c.ExecutorConf,
+			AdmissionChecker:        budgetManager,
+			MaintenanceCalendar:     maintenanceCalendar,
+			DeferOutsideMaintenance: c.RLAConfig.Maintenance.DeferByDefault,
 		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task manager: %w", err)
 	}
 
+	// 9. Create CampaignManager (Business Logic Layer)
+	// Campaigns roll an operation out across many racks in batches, one
+	// task per rack.
+	campaignManager := campaign.NewManager(
+		campaign.NewPostgresStore(session),
+		campaign.NewPostgresInventory(session),
+		taskManager,
+		tskStore,
+	)
+
 	return &Service{
-		conf:              c,
-		session:           session,
-		inventoryManager:  invManager,
-		taskStore:         tskStore,
-		taskManager:       taskManager,
-		taskScheduleStore: schedStore,
+		conf:                c,
+		session:             session,
+		inventoryManager:    invManager,
+		taskStore:           tskStore,
+		taskManager:         taskManager,
+		taskScheduleStore:   schedStore,
+		powerBudgetManager:  budgetManager,
+		rotationManager:     rotationManager,
+		discoveryManager:    discoveryManager,
+		campaignManager:     campaignManager,
+		maintenanceCalendar: maintenanceCalendar,
 	}, nil
 }
 
@@ -135,6 +225,9 @@ func (s *Service) Start(ctx context.Context) (retErr error) {
 		}
 
 		// Stop the started resources in reverse start order.
+		if s.metricsServer != nil {
+			s.metricsServer.Close()
+		}
 		if s.taskScheduleDispatcher != nil {
 			s.taskScheduleDispatcher.Stop()
 		}
@@ -187,12 +280,36 @@ func (s *Service) Start(ctx context.Context) (retErr error) {
 		return err
 	}
 
+	// The inventory sync job is created here, ahead of the scheduler, so
+	// that the server can also run it on demand for a single rack.
+	s.inventorySync, err = inventorysync.New(
+		ctx,
+		&s.conf.DBConf,
+		s.conf.ProviderRegistry,
+		s.conf.RLAConfig,
+		s.conf.CMConfig,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create inventory sync job: %w", err)
+	}
+
+	var inventorySync rackResyncer
+	if s.inventorySync != nil {
+		inventorySync = s.inventorySync
+	}
+
 	serverImpl, err := newServerImplementation(
 		s.inventoryManager,
 		s.taskManager,
 		s.taskStore,
 		s.taskScheduleStore,
 		dispatcher,
+		s.powerBudgetManager,
+		s.rotationManager,
+		s.discoveryManager,
+		s.campaignManager,
+		s.maintenanceCalendar,
+		inventorySync,
 	)
 	if err != nil {
 		return err
@@ -210,6 +327,10 @@ func (s *Service) Start(ctx context.Context) (retErr error) {
 	s.taskScheduleDispatcher = dispatcher
 	log.Info().Msg("Task schedule dispatcher started")
 
+	if s.conf.MetricsPort > 0 {
+		s.startMetricsServer()
+	}
+
 	s.grpcServer = grpc.NewServer(certOpt)
 
 	log.Info().Msg("gRPC server is running")
@@ -259,6 +380,12 @@ func (s *Service) Stop(ctx context.Context) {
 		log.Info().Msg("gRPC server stopped")
 	}
 
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			log.Warn().Msgf("Failed to shut down metrics server: %v", err)
+		}
+	}
+
 	if s.taskManager != nil {
 		s.taskManager.Stop(ctx)
 		log.Info().Msg("Task manager stopped")
@@ -308,19 +435,8 @@ func (s *Service) startScheduler(ctx context.Context) error {
 
 	sched := scheduler.New()
 
-	// Create and register the inventory sync job
-	invJob, err := inventorysync.New(
-		ctx,
-		&s.conf.DBConf,
-		s.conf.ProviderRegistry,
-		s.conf.RLAConfig,
-		s.conf.CMConfig,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create inventory sync job: %w", err)
-	}
-
-	if invJob != nil {
+	// Register the inventory sync job
+	if invJob := s.inventorySync; invJob != nil {
 		invTrigger, err := schedtypes.NewIntervalTrigger(s.conf.RLAConfig.InventoryRunFrequency)
 		if err != nil {
 			return fmt.Errorf("invalid inventory sync interval: %w", err)
@@ -350,6 +466,52 @@ func (s *Service) startScheduler(ctx context.Context) error {
 		}
 	}
 
+	// Create and register the power budget monitor job
+	budgetJob, err := budgetmonitor.New(s.powerBudgetManager, s.conf.RLAConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create power budget monitor job: %w", err)
+	}
+
+	if budgetJob != nil {
+		budgetTrigger, err := schedtypes.NewIntervalTrigger(s.conf.RLAConfig.PowerBudgetInterval)
+		if err != nil {
+			return fmt.Errorf("invalid power budget interval: %w", err)
+		}
+		if err := sched.Schedule(budgetJob, budgetTrigger, schedtypes.Skip); err != nil {
+			return fmt.Errorf("failed to schedule power budget monitor job: %w", err)
+		}
+	}
+
+	// Create and register the device discovery job
+	discoveryJob, err := devicediscovery.New(s.discoveryManager)
+	if err != nil {
+		return fmt.Errorf("failed to create device discovery job: %w", err)
+	}
+
+	if discoveryJob != nil {
+		discoveryTrigger, err := schedtypes.NewIntervalTrigger(s.conf.RLAConfig.Discovery.Interval)
+		if err != nil {
+			return fmt.Errorf("invalid device discovery interval: %w", err)
+		}
+		if err := sched.Schedule(discoveryJob, discoveryTrigger, schedtypes.Skip); err != nil {
+			return fmt.Errorf("failed to schedule device discovery job: %w", err)
+		}
+	}
+
+	// Create and register the campaign job
+	campaignJob, err := campaignrunner.New(s.campaignManager)
+	if err != nil {
+		return fmt.Errorf("failed to create campaign job: %w", err)
+	}
+
+	campaignTrigger, err := schedtypes.NewIntervalTrigger(s.conf.RLAConfig.CampaignInterval)
+	if err != nil {
+		return fmt.Errorf("invalid campaign interval: %w", err)
+	}
+	if err := sched.Schedule(campaignJob, campaignTrigger, schedtypes.Skip); err != nil {
+		return fmt.Errorf("failed to schedule campaign job: %w", err)
+	}
+
 	if err := sched.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start system job scheduler: %w", err)
 	}
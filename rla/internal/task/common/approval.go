@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+// ApprovalDecision is the outcome of an AwaitApproval gate.
+type ApprovalDecision string
+
+const (
+	// ApprovalDecisionNone marks a gate that is still waiting for a person.
+	ApprovalDecisionNone ApprovalDecision = ""
+	// ApprovalDecisionApprove lets the workflow continue past the gate.
+	ApprovalDecisionApprove ApprovalDecision = "approve"
+	// ApprovalDecisionReject fails the step and therefore the task.
+	ApprovalDecisionReject ApprovalDecision = "reject"
+	// ApprovalDecisionAbort stops the task without treating it as a failure;
+	// the task ends terminated.
+	ApprovalDecisionAbort ApprovalDecision = "abort"
+)
+
+// ApprovalDecisionFromString parses a decision name. It returns an error for
+// anything other than approve, reject or abort.
+func ApprovalDecisionFromString(s string) (ApprovalDecision, error) {
+	switch d := ApprovalDecision(s); d {
+	case ApprovalDecisionApprove, ApprovalDecisionReject, ApprovalDecisionAbort:
+		return d, nil
+	default:
+		return ApprovalDecisionNone, fmt.Errorf(
+			"invalid approval decision %q (expected approve, reject or abort)", s,
+		)
+	}
+}
+
+// Approval is one AwaitApproval gate reached by a task. It is created when the
+// workflow starts waiting and completed when a decision arrives, either from
+// a person or from the gate's default once its timeout expires.
+type Approval struct {
+	// ID identifies the gate within the task; decisions must name it.
+	ID string `json:"id"`
+	// Step is the component type of the rule step that holds the gate.
+	Step string `json:"step"`
+	// Prompt is the text shown to the approver.
+	Prompt          string           `json:"prompt"`
+	RequestedAt     time.Time        `json:"requested_at"`
+	Deadline        time.Time        `json:"deadline"`
+	DefaultDecision ApprovalDecision `json:"default_decision"`
+
+	Decision  ApprovalDecision `json:"decision,omitempty"`
+	Approver  string           `json:"approver,omitempty"`
+	Comment   string           `json:"comment,omitempty"`
+	DecidedAt *time.Time       `json:"decided_at,omitempty"`
+	// TimedOut is set when nobody decided before the deadline and the
+	// default decision was applied.
+	TimedOut bool `json:"timed_out,omitempty"`
+}
+
+// IsPending reports whether the gate is still waiting for a decision.
+func (a *Approval) IsPending() bool {
+	return a.Decision == ApprovalDecisionNone
+}
+
+// SetApproval inserts approval or replaces the record with the same ID.
+func (a *TaskAttributes) SetApproval(approval Approval) {
+	for i := range a.Approvals {
+		if a.Approvals[i].ID == approval.ID {
+			a.Approvals[i] = approval
+			return
+		}
+	}
+	a.Approvals = append(a.Approvals, approval)
+}
+
+// PendingApprovals returns the gates that are still waiting for a decision.
+func (a TaskAttributes) PendingApprovals() []Approval {
+	var pending []Approval
+	for _, approval := range a.Approvals {
+		if approval.IsPending() {
+			pending = append(pending, approval)
+		}
+	}
+	return pending
+}
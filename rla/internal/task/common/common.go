@@ -85,6 +85,10 @@ const (
 	// TaskStatusWaiting means the task was queued due to a conflict and is
 	// waiting for the rack to become available. It is NOT a finished state.
 	TaskStatusWaiting TaskStatus = "waiting"
+	// TaskStatusWaitingApproval means the workflow reached an AwaitApproval
+	// action and is paused until a person approves or rejects the step. The
+	// task keeps its hold on the rack. It is NOT a finished state.
+	TaskStatusWaitingApproval TaskStatus = "waiting_approval"
 )
 
 func (s TaskStatus) IsFinished() bool {
@@ -110,6 +114,14 @@ type TaskAttributes struct {
 	// ComponentsByType maps each targeted component type to its UUIDs.
 	// Nil means the task targets no specific components.
 	ComponentsByType map[devicetypes.ComponentType][]uuid.UUID `json:"components_by_type,omitempty"` //nolint:lll
+
+	// Approvals records every AwaitApproval gate the task has reached, in
+	// the order they were requested, together with who decided them.
+	Approvals []Approval `json:"approvals,omitempty"`
+
+	// Preemptions records every time the task was stopped for a task of
+	// higher priority, oldest first.
+	Preemptions []Preemption `json:"preemptions,omitempty"`
 }
 
 // AllComponentUUIDs returns a flat slice of all component UUIDs across every
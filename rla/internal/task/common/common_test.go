@@ -85,3 +85,35 @@ func TestTaskStatus_IsFinished(t *testing.T) {
 		assert.False(t, s.IsFinished(), "%q should not be finished", s)
 	}
 }
+
+// --- Approvals ---
+
+func TestTaskAttributes_SetApproval(t *testing.T) {
+	var attrs TaskAttributes
+
+	attrs.SetApproval(Approval{ID: "a", Prompt: "first"})
+	attrs.SetApproval(Approval{ID: "b", Prompt: "second"})
+	assert.Len(t, attrs.PendingApprovals(), 2)
+
+	// Recording a decision replaces the gate in place.
+	attrs.SetApproval(Approval{ID: "a", Prompt: "first", Decision: ApprovalDecisionApprove})
+	assert.Len(t, attrs.Approvals, 2)
+	assert.Equal(t, "a", attrs.Approvals[0].ID)
+
+	pending := attrs.PendingApprovals()
+	assert.Len(t, pending, 1)
+	assert.Equal(t, "b", pending[0].ID)
+}
+
+func TestApprovalDecisionFromString(t *testing.T) {
+	for _, s := range []string{"approve", "reject", "abort"} {
+		d, err := ApprovalDecisionFromString(s)
+		assert.NoError(t, err)
+		assert.Equal(t, ApprovalDecision(s), d)
+	}
+
+	_, err := ApprovalDecisionFromString("")
+	assert.Error(t, err)
+	_, err = ApprovalDecisionFromString("Approve")
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskPriority is the priority class of a task. It orders the promotion of
+// waiting tasks on a rack and decides which running tasks an emergency task
+// may preempt.
+type TaskPriority string
+
+const (
+	// TaskPriorityEmergency is for urgent safety actions such as powering
+	// off a leaking tray. Emergency tasks are promoted first and may preempt
+	// conflicting tasks of lower priority.
+	TaskPriorityEmergency TaskPriority = "emergency"
+	// TaskPriorityNormal is the default class.
+	TaskPriorityNormal TaskPriority = "normal"
+	// TaskPriorityBackground is for work that should yield to everything
+	// else, such as fleet-wide rollouts.
+	TaskPriorityBackground TaskPriority = "background"
+)
+
+// TaskPriorityFromString parses a priority class name. An empty string is
+// normal priority.
+func TaskPriorityFromString(s string) (TaskPriority, error) {
+	switch p := TaskPriority(s); p {
+	case "":
+		return TaskPriorityNormal, nil
+	case TaskPriorityEmergency, TaskPriorityNormal, TaskPriorityBackground:
+		return p, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid task priority %q (expected emergency, normal or background)", s,
+		)
+	}
+}
+
+// IsValid reports whether p is a known class. The zero value is valid and
+// means normal.
+func (p TaskPriority) IsValid() bool {
+	_, err := TaskPriorityFromString(string(p))
+	return err == nil
+}
+
+// Rank orders priority classes; a higher rank is promoted first. Unknown
+// and empty classes rank as normal.
+func (p TaskPriority) Rank() int {
+	switch p {
+	case TaskPriorityEmergency:
+		return 2
+	case TaskPriorityBackground:
+		return 0
+	default:
+		return 1
+	}
+}
+
+func (p TaskPriority) String() string {
+	return string(p)
+}
+
+// Preemption records that a task was stopped to make way for a task of
+// higher priority.
+type Preemption struct {
+	// By is the task that preempted this one.
+	By uuid.UUID `json:"by"`
+	// Priority is the priority class of the preempting task.
+	Priority TaskPriority `json:"priority"`
+	// Reason is the preempting task's description, or its operation when
+	// it has none.
+	Reason string `json:"reason"`
+	// Requeued is set when the task was put back in the waiting queue
+	// instead of being terminated.
+	Requeued bool      `json:"requeued,omitempty"`
+	At       time.Time `json:"at"`
+}
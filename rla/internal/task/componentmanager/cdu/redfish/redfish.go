@@ -0,0 +1,269 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redfish
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager"
+	cduprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/cdu"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+const (
+	// ImplementationName is the name used to identify this implementation.
+	ImplementationName = "redfish"
+)
+
+// Manager manages coolant distribution units through their Redfish BMCs.
+// Powering a CDU on or off enables or disables the cooling unit.
+type Manager struct {
+	cduClient cduapi.Client
+	limits    cduapi.Limits
+}
+
+// New creates a new Redfish-based CDU Manager instance.
+func New(cduClient cduapi.Client, limits cduapi.Limits) *Manager {
+	return &Manager{
+		cduClient: cduClient,
+		limits:    limits,
+	}
+}
+
+// Factory creates a new Manager from the provided providers.
+// It retrieves the CDU provider from the registry and uses its client and
+// health limits.
+func Factory(
+	providerRegistry *componentmanager.ProviderRegistry,
+) (componentmanager.ComponentManager, error) {
+	provider, err := componentmanager.GetTyped[*cduprovider.Provider](
+		providerRegistry,
+		cduprovider.ProviderName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cdu/redfish requires cdu provider: %w", err)
+	}
+
+	return New(provider.Client(), provider.Limits()), nil
+}
+
+// Register registers the Redfish CDU manager factory with the given registry.
+func Register(registry *componentmanager.Registry) {
+	registry.RegisterFactory(devicetypes.ComponentTypeCDU, ImplementationName, Factory)
+}
+
+// Type returns the component type this manager handles.
+func (m *Manager) Type() devicetypes.ComponentType {
+	return devicetypes.ComponentTypeCDU
+}
+
+// InjectExpectation is a no-op for CDUs: they are read directly from their
+// BMC, so there is no external service to register them with.
+func (m *Manager) InjectExpectation(
+	ctx context.Context,
+	target common.Target,
+	info operations.InjectExpectationTaskInfo,
+) error {
+	log.Info().
+		Str("components", target.String()).
+		Msg("CDUs need no expectation, skipping")
+
+	return nil
+}
+
+// PowerControl enables or disables the cooling units of the target CDUs.
+func (m *Manager) PowerControl(
+	ctx context.Context,
+	target common.Target,
+	info operations.PowerControlTaskInfo,
+) error {
+	log.Debug().
+		Str("components", target.String()).
+		Str("operation", info.Operation.String()).
+		Msg("Power control request received")
+
+	if m.cduClient == nil {
+		return fmt.Errorf("cdu client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return fmt.Errorf("target is invalid: %w", err)
+	}
+
+	var enabled bool
+	switch info.Operation {
+	// CDUs do not distinguish between a graceful & forced power on or off.
+	case operations.PowerOperationPowerOn, operations.PowerOperationForcePowerOn:
+		enabled = true
+	case operations.PowerOperationPowerOff, operations.PowerOperationForcePowerOff:
+		enabled = false
+	default:
+		return fmt.Errorf("unsupported power operation: %v", info.Operation)
+	}
+
+	results, err := m.cduClient.SetMode(ctx, target.ComponentIDs, enabled)
+	if err != nil {
+		return fmt.Errorf("power control operation failed: %w", err)
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			return fmt.Errorf("power control operation failed for %s: %s", result.ComponentID, result.Error)
+		}
+
+		log.Info().
+			Str("cdu", result.ComponentID).
+			Str("operation", info.Operation.String()).
+			Msg("Power control operation completed successfully")
+	}
+
+	return nil
+}
+
+// GetPowerStatus reports an enabled cooling unit as on and a disabled one as
+// off. CDUs that cannot be read are left out of the result.
+func (m *Manager) GetPowerStatus(
+	ctx context.Context,
+	target common.Target,
+) (map[string]operations.PowerStatus, error) {
+	log.Debug().
+		Str("components", target.String()).
+		Msg("Get power status request received")
+
+	if m.cduClient == nil {
+		return nil, fmt.Errorf("cdu client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("target is invalid: %w", err)
+	}
+
+	cdus, err := m.cduClient.GetCDUs(ctx, target.ComponentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CDUs: %w", err)
+	}
+
+	result := make(map[string]operations.PowerStatus, len(cdus))
+	for _, cdu := range cdus {
+		if cdu.Error != "" {
+			log.Warn().
+				Str("cdu", cdu.ComponentID).
+				Str("error", cdu.Error).
+				Msg("CDU is unreachable")
+			continue
+		}
+
+		if cdu.Enabled {
+			result[cdu.ComponentID] = operations.PowerStatusOn
+		} else {
+			result[cdu.ComponentID] = operations.PowerStatusOff
+		}
+	}
+
+	log.Info().
+		Str("components", target.String()).
+		Int("result_count", len(result)).
+		Msg("Get power status completed")
+
+	return result, nil
+}
+
+// FirmwareControl is not supported for CDUs.
+func (m *Manager) FirmwareControl(
+	ctx context.Context,
+	target common.Target,
+	info operations.FirmwareControlTaskInfo,
+) error {
+	return fmt.Errorf("firmware updates are not supported for CDUs")
+}
+
+// GetFirmwareStatus is not supported for CDUs.
+func (m *Manager) GetFirmwareStatus(
+	ctx context.Context,
+	target common.Target,
+) (map[string]operations.FirmwareUpdateStatus, error) {
+	return nil, fmt.Errorf("firmware updates are not supported for CDUs")
+}
+
+// GetCoolingProblems checks the pumps, coolant flow, supply temperature and
+// leak sensors of the target CDUs against the configured limits. It returns
+// the problems of each unhealthy CDU; a CDU that cannot be read, or is not
+// found, is reported as a problem too.
+func (m *Manager) GetCoolingProblems(
+	ctx context.Context,
+	target common.Target,
+) (map[string][]string, error) {
+	log.Debug().
+		Str("components", target.String()).
+		Msg("Cooling health check request received")
+
+	if m.cduClient == nil {
+		return nil, fmt.Errorf("cdu client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("target is invalid: %w", err)
+	}
+
+	cdus, err := m.cduClient.GetCDUs(ctx, target.ComponentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CDUs: %w", err)
+	}
+
+	seen := make(map[string]bool, len(cdus))
+	problems := make(map[string][]string)
+	for _, cdu := range cdus {
+		seen[utils.NormalizeMAC(cdu.ComponentID)] = true
+		if p := cdu.Problems(m.limits); len(p) > 0 {
+			problems[cdu.ComponentID] = p
+		}
+	}
+
+	for _, id := range target.ComponentIDs {
+		if !seen[utils.NormalizeMAC(id)] {
+			problems[id] = []string{"not found"}
+		}
+	}
+
+	if len(problems) > 0 {
+		log.Info().
+			Str("components", target.String()).
+			Str("problems", summarize(problems)).
+			Msg("Cooling is unhealthy")
+	}
+
+	return problems, nil
+}
+
+// summarize renders problems as "id: p1, p2; id2: p3" for logging.
+func summarize(problems map[string][]string) string {
+	parts := make([]string, 0, len(problems))
+	for id, p := range problems {
+		parts = append(parts, id+": "+strings.Join(p, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
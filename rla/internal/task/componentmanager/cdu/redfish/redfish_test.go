@@ -0,0 +1,330 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redfish
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+const (
+	cdu1 = "aa:bb:cc:dd:ee:01"
+	cdu2 = "aa:bb:cc:dd:ee:02"
+)
+
+var testLimits = cduapi.Limits{
+	MinFlowLitersPerMinute:      100,
+	MaxSupplyTemperatureCelsius: 40,
+	MinRunningPumps:             1,
+}
+
+// failingClient is a CDU client whose calls fail.
+type failingClient struct {
+	cduapi.Client
+}
+
+func (c *failingClient) GetCDUs(context.Context, []string) ([]cduapi.CDU, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (c *failingClient) SetMode(context.Context, []string, bool) ([]cduapi.ModeResult, error) {
+	return nil, errors.New("connection refused")
+}
+
+func healthyCDU(componentID string) cduapi.CDU {
+	return cduapi.CDU{
+		ComponentID: componentID,
+		Enabled:     true,
+		Health:      cduapi.HealthOK,
+		Pumps:       []cduapi.Pump{{ID: "1", Name: "Pump 1", Enabled: true, Health: cduapi.HealthOK}},
+		Coolant: cduapi.Coolant{
+			SupplyTemperatureCelsius: 30,
+			FlowLitersPerMinute:      150,
+		},
+		LeakDetectors: []cduapi.LeakDetector{{ID: "1", Name: "Tray Leak", State: cduapi.HealthOK}},
+	}
+}
+
+func newMockClient(cdus ...cduapi.CDU) cduapi.Client {
+	client := cduapi.NewMockClient()
+	for _, cdu := range cdus {
+		client.AddCDU(cdu)
+	}
+	return client
+}
+
+func cduTarget(componentIDs ...string) common.Target {
+	return common.Target{Type: devicetypes.ComponentTypeCDU, ComponentIDs: componentIDs}
+}
+
+func TestPowerControl(t *testing.T) {
+	disabled := healthyCDU(cdu1)
+	disabled.Enabled = false
+
+	testCases := map[string]struct {
+		client      cduapi.Client
+		target      common.Target
+		operation   operations.PowerOperation
+		wantEnabled bool
+		errContains string
+	}{
+		"power on enables the CDU": {
+			client:      newMockClient(disabled),
+			target:      cduTarget(cdu1),
+			operation:   operations.PowerOperationPowerOn,
+			wantEnabled: true,
+		},
+		"force power on enables the CDU": {
+			client:      newMockClient(disabled),
+			target:      cduTarget(cdu1),
+			operation:   operations.PowerOperationForcePowerOn,
+			wantEnabled: true,
+		},
+		"power off disables the CDU": {
+			client:    newMockClient(healthyCDU(cdu1)),
+			target:    cduTarget(cdu1),
+			operation: operations.PowerOperationPowerOff,
+		},
+		"force power off disables the CDU": {
+			client:    newMockClient(healthyCDU(cdu1)),
+			target:    cduTarget(cdu1),
+			operation: operations.PowerOperationForcePowerOff,
+		},
+		"restart is not supported": {
+			client:      newMockClient(healthyCDU(cdu1)),
+			target:      cduTarget(cdu1),
+			operation:   operations.PowerOperationRestart,
+			errContains: "unsupported power operation",
+		},
+		"nil client": {
+			target:      cduTarget(cdu1),
+			operation:   operations.PowerOperationPowerOn,
+			errContains: "cdu client is not configured",
+		},
+		"empty target": {
+			client:      newMockClient(healthyCDU(cdu1)),
+			target:      cduTarget(),
+			operation:   operations.PowerOperationPowerOn,
+			errContains: "target is invalid",
+		},
+		"client error": {
+			client:      &failingClient{},
+			target:      cduTarget(cdu1),
+			operation:   operations.PowerOperationPowerOn,
+			errContains: "power control operation failed: connection refused",
+		},
+		"CDU not found": {
+			client:      newMockClient(healthyCDU(cdu1)),
+			target:      cduTarget(cdu1, cdu2),
+			operation:   operations.PowerOperationPowerOn,
+			errContains: "power control operation failed for " + cdu2 + ": CDU not found",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := New(tc.client, testLimits)
+
+			err := m.PowerControl(context.Background(), tc.target, operations.PowerControlTaskInfo{Operation: tc.operation})
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			cdus, err := tc.client.GetCDUs(context.Background(), tc.target.ComponentIDs)
+			require.NoError(t, err)
+			require.Len(t, cdus, 1)
+			assert.Equal(t, tc.wantEnabled, cdus[0].Enabled)
+		})
+	}
+}
+
+func TestGetPowerStatus(t *testing.T) {
+	disabled := healthyCDU(cdu2)
+	disabled.Enabled = false
+	unreachable := cduapi.CDU{ComponentID: "aa:bb:cc:dd:ee:03", Error: "timeout"}
+
+	testCases := map[string]struct {
+		client      cduapi.Client
+		target      common.Target
+		want        map[string]operations.PowerStatus
+		errContains string
+	}{
+		"enabled is on and disabled is off": {
+			client: newMockClient(healthyCDU(cdu1), disabled),
+			target: cduTarget(cdu1, cdu2),
+			want: map[string]operations.PowerStatus{
+				cdu1: operations.PowerStatusOn,
+				cdu2: operations.PowerStatusOff,
+			},
+		},
+		"unreachable CDU is left out": {
+			client: newMockClient(healthyCDU(cdu1), unreachable),
+			target: cduTarget(cdu1, unreachable.ComponentID),
+			want: map[string]operations.PowerStatus{
+				cdu1: operations.PowerStatusOn,
+			},
+		},
+		"nil client": {
+			target:      cduTarget(cdu1),
+			errContains: "cdu client is not configured",
+		},
+		"client error": {
+			client:      &failingClient{},
+			target:      cduTarget(cdu1),
+			errContains: "failed to get CDUs: connection refused",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := New(tc.client, testLimits)
+
+			got, err := m.GetPowerStatus(context.Background(), tc.target)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetCoolingProblems(t *testing.T) {
+	leaking := healthyCDU(cdu1)
+	leaking.LeakDetectors = []cduapi.LeakDetector{{ID: "1", Name: "Tray Leak", State: cduapi.HealthCritical}}
+
+	lowFlow := healthyCDU(cdu1)
+	lowFlow.Coolant.FlowLitersPerMinute = 50
+
+	hot := healthyCDU(cdu1)
+	hot.Coolant.SupplyTemperatureCelsius = 45
+
+	noPumps := healthyCDU(cdu1)
+	noPumps.Pumps[0].Health = cduapi.HealthCritical
+
+	disabled := healthyCDU(cdu1)
+	disabled.Enabled = false
+
+	unreachable := cduapi.CDU{ComponentID: cdu1, Error: "timeout"}
+
+	testCases := map[string]struct {
+		client      cduapi.Client
+		target      common.Target
+		want        map[string][]string
+		errContains string
+	}{
+		"healthy": {
+			client: newMockClient(healthyCDU(cdu1), healthyCDU(cdu2)),
+			target: cduTarget(cdu1, cdu2),
+			want:   map[string][]string{},
+		},
+		"component IDs are matched regardless of MAC format": {
+			client: newMockClient(healthyCDU(cdu1)),
+			target: cduTarget("AA-BB-CC-DD-EE-01"),
+			want:   map[string][]string{},
+		},
+		"leak": {
+			client: newMockClient(leaking),
+			target: cduTarget(cdu1),
+			want:   map[string][]string{cdu1: {"leak detected by Tray Leak"}},
+		},
+		"low flow": {
+			client: newMockClient(lowFlow),
+			target: cduTarget(cdu1),
+			want:   map[string][]string{cdu1: {"flow 50.0 L/min below 100.0 L/min"}},
+		},
+		"supply temperature too high": {
+			client: newMockClient(hot),
+			target: cduTarget(cdu1),
+			want:   map[string][]string{cdu1: {"supply temperature 45.0°C above 40.0°C"}},
+		},
+		"no running pump": {
+			client: newMockClient(noPumps),
+			target: cduTarget(cdu1),
+			want:   map[string][]string{cdu1: {"0 of 1 required pumps running"}},
+		},
+		"disabled": {
+			client: newMockClient(disabled),
+			target: cduTarget(cdu1),
+			want:   map[string][]string{cdu1: {"disabled"}},
+		},
+		"unreachable": {
+			client: newMockClient(unreachable),
+			target: cduTarget(cdu1),
+			want:   map[string][]string{cdu1: {"unreachable: timeout"}},
+		},
+		"not found": {
+			client: newMockClient(healthyCDU(cdu1)),
+			target: cduTarget(cdu1, cdu2),
+			want:   map[string][]string{cdu2: {"not found"}},
+		},
+		"nil client": {
+			target:      cduTarget(cdu1),
+			errContains: "cdu client is not configured",
+		},
+		"empty target": {
+			client:      newMockClient(healthyCDU(cdu1)),
+			target:      cduTarget(),
+			errContains: "target is invalid",
+		},
+		"client error": {
+			client:      &failingClient{},
+			target:      cduTarget(cdu1),
+			errContains: "failed to get CDUs: connection refused",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := New(tc.client, testLimits)
+
+			got, err := m.GetCoolingProblems(context.Background(), tc.target)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFirmwareNotSupported(t *testing.T) {
+	m := New(newMockClient(healthyCDU(cdu1)), testLimits)
+
+	err := m.FirmwareControl(context.Background(), cduTarget(cdu1), operations.FirmwareControlTaskInfo{TargetVersion: "1.0"})
+	assert.ErrorContains(t, err, "not supported for CDUs")
+
+	_, err = m.GetFirmwareStatus(context.Background(), cduTarget(cdu1))
+	assert.ErrorContains(t, err, "not supported for CDUs")
+}
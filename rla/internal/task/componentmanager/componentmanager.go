@@ -60,6 +60,20 @@ type FirmwareConsistencyChecker interface {
 	VerifyFirmwareConsistency(ctx context.Context, target common.Target) error
 }
 
+// CoolingHealthChecker is an optional interface for component managers of
+// cooling equipment. GetCoolingProblems returns, for each unhealthy
+// component, the reasons it is unhealthy; healthy components are omitted.
+type CoolingHealthChecker interface {
+	GetCoolingProblems(ctx context.Context, target common.Target) (map[string][]string, error)
+}
+
+// ConfigBackuper is an optional interface for component managers that can
+// save the configuration of their components. BackupConfig returns where the
+// backup of each component was stored.
+type ConfigBackuper interface {
+	BackupConfig(ctx context.Context, target common.Target) (map[string]string, error)
+}
+
 // ManagerFactory is a function that creates a ComponentManager instance.
 // It receives a ProviderRegistry from which it can retrieve the providers it needs.
 type ManagerFactory func(providers *ProviderRegistry) (ComponentManager, error)
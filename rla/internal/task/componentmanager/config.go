@@ -25,8 +25,11 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/carbide"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/cdu"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvswitchmanager"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvue"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/psm"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
@@ -42,6 +45,12 @@ type ProviderConfig struct {
 
 	// NVSwitchManager holds NV-Switch Manager-specific configuration. Nil means disabled.
 	NVSwitchManager *nvswitchmanager.Config
+
+	// CDU holds Redfish CDU configuration. Nil means disabled.
+	CDU *cdu.Config
+
+	// NVUE holds ToR switch configuration. Nil means disabled.
+	NVUE *nvue.Config
 }
 
 // Config holds the component manager configuration.
@@ -64,6 +73,8 @@ type rawProviderConfig struct {
 	Carbide         *rawCarbideConfig         `yaml:"carbide"`
 	PSM             *rawPSMConfig             `yaml:"psm"`
 	NVSwitchManager *rawNVSwitchManagerConfig `yaml:"nvswitchmanager"`
+	CDU             *rawCDUConfig             `yaml:"cdu"`
+	NVUE            *rawNVUEConfig            `yaml:"nvue"`
 }
 
 // rawCarbideConfig is the raw YAML structure for Carbide configuration.
@@ -82,6 +93,24 @@ type rawNVSwitchManagerConfig struct {
 	Timeout string `yaml:"timeout"`
 }
 
+// rawCDUConfig is the raw YAML structure for CDU configuration.
+type rawCDUConfig struct {
+	Timeout                     string   `yaml:"timeout"`
+	MinFlowLPM                  *float32 `yaml:"min_flow_lpm"`
+	MaxSupplyTemperatureCelsius *float32 `yaml:"max_supply_temperature_celsius"`
+	MinRunningPumps             *int     `yaml:"min_running_pumps"`
+}
+
+// rawNVUEConfig is the raw YAML structure for ToR switch configuration.
+type rawNVUEConfig struct {
+	Timeout            string `yaml:"timeout"`
+	NVUEPort           int    `yaml:"nvue_port"`
+	ImageURLTemplate   string `yaml:"image_url_template"`
+	ConfigBackupDir    string `yaml:"config_backup_dir"`
+	CACertFile         string `yaml:"ca_cert_file"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
 // LoadConfig loads the component manager configuration from a YAML file.
 func LoadConfig(path string) (Config, error) {
 	data, err := os.ReadFile(path)
@@ -167,17 +196,111 @@ func ParseConfig(data []byte) (Config, error) {
 		config.Providers.NVSwitchManager = nsmConfig
 	}
 
+	// Parse CDU config if present in YAML
+	if raw.Providers.CDU != nil {
+		cduConfig, err := parseCDUConfig(raw.Providers.CDU)
+		if err != nil {
+			return Config{}, err
+		}
+		config.Providers.CDU = cduConfig
+	}
+
+	// Parse NVUE config if present in YAML
+	if raw.Providers.NVUE != nil {
+		nvueConfig, err := parseNVUEConfig(raw.Providers.NVUE)
+		if err != nil {
+			return Config{}, err
+		}
+		config.Providers.NVUE = nvueConfig
+	}
+
 	// If no providers are explicitly configured, derive from component manager implementations
-	if config.Providers.Carbide == nil && config.Providers.PSM == nil && config.Providers.NVSwitchManager == nil {
+	if config.Providers.Carbide == nil && config.Providers.PSM == nil &&
+		config.Providers.NVSwitchManager == nil && config.Providers.CDU == nil &&
+		config.Providers.NVUE == nil {
 		deriveProviders(&config)
 	}
 
 	return config, nil
 }
 
+// parseCDUConfig converts the raw CDU block, falling back to the default
+// timeout and health limits for fields that are not set.
+func parseCDUConfig(raw *rawCDUConfig) (*cdu.Config, error) {
+	cduConfig := &cdu.Config{
+		Timeout: cdu.DefaultTimeout,
+		Limits:  cduapi.DefaultLimits,
+	}
+	if raw.Timeout != "" {
+		timeout, err := time.ParseDuration(raw.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cdu timeout: %w", err)
+		}
+		cduConfig.Timeout = timeout
+	}
+	if raw.MinFlowLPM != nil {
+		if *raw.MinFlowLPM < 0 {
+			return nil, fmt.Errorf("invalid cdu min_flow_lpm: must not be negative")
+		}
+		cduConfig.Limits.MinFlowLitersPerMinute = *raw.MinFlowLPM
+	}
+	if raw.MaxSupplyTemperatureCelsius != nil {
+		if *raw.MaxSupplyTemperatureCelsius < 0 {
+			return nil, fmt.Errorf("invalid cdu max_supply_temperature_celsius: must not be negative")
+		}
+		cduConfig.Limits.MaxSupplyTemperatureCelsius = *raw.MaxSupplyTemperatureCelsius
+	}
+	if raw.MinRunningPumps != nil {
+		if *raw.MinRunningPumps < 0 {
+			return nil, fmt.Errorf("invalid cdu min_running_pumps: must not be negative")
+		}
+		cduConfig.Limits.MinRunningPumps = *raw.MinRunningPumps
+	}
+	return cduConfig, nil
+}
+
+// parseNVUEConfig converts the raw NVUE block, falling back to the default
+// timeout and NVUE port for fields that are not set.
+func parseNVUEConfig(raw *rawNVUEConfig) (*nvue.Config, error) {
+	nvueConfig := &nvue.Config{
+		Timeout:            nvue.DefaultTimeout,
+		NVUEPort:           nvue.DefaultNVUEPort,
+		ImageURLTemplate:   strings.TrimSpace(raw.ImageURLTemplate),
+		ConfigBackupDir:    strings.TrimSpace(raw.ConfigBackupDir),
+		CACertFile:         strings.TrimSpace(raw.CACertFile),
+		InsecureSkipVerify: raw.InsecureSkipVerify,
+	}
+	if raw.Timeout != "" {
+		timeout, err := time.ParseDuration(raw.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nvue timeout: %w", err)
+		}
+		nvueConfig.Timeout = timeout
+	}
+	if raw.NVUEPort != 0 {
+		if raw.NVUEPort < 0 || raw.NVUEPort > 65535 {
+			return nil, fmt.Errorf("invalid nvue nvue_port: %d", raw.NVUEPort)
+		}
+		nvueConfig.NVUEPort = raw.NVUEPort
+	}
+	return nvueConfig, nil
+}
+
 // deriveProviders enables providers based on the component manager implementations configured.
 func deriveProviders(config *Config) {
-	for _, implName := range config.ComponentManagers {
+	for componentType, implName := range config.ComponentManagers {
+		// The Redfish CDU manager is named after the protocol rather than
+		// its provider.
+		if componentType == devicetypes.ComponentTypeCDU && implName == "redfish" {
+			if config.Providers.CDU == nil {
+				config.Providers.CDU = &cdu.Config{
+					Timeout: cdu.DefaultTimeout,
+					Limits:  cduapi.DefaultLimits,
+				}
+			}
+			continue
+		}
+
 		switch implName {
 		case carbide.ProviderName:
 			if config.Providers.Carbide == nil {
@@ -198,6 +321,13 @@ func deriveProviders(config *Config) {
 					Timeout: nvswitchmanager.DefaultTimeout,
 				}
 			}
+		case nvue.ProviderName:
+			if config.Providers.NVUE == nil {
+				config.Providers.NVUE = &nvue.Config{
+					Timeout:  nvue.DefaultTimeout,
+					NVUEPort: nvue.DefaultNVUEPort,
+				}
+			}
 			// mock implementations don't require any providers
 		}
 	}
@@ -212,6 +342,10 @@ func (c *Config) HasProvider(name string) bool {
 		return c.Providers.PSM != nil
 	case nvswitchmanager.ProviderName:
 		return c.Providers.NVSwitchManager != nil
+	case cdu.ProviderName:
+		return c.Providers.CDU != nil
+	case nvue.ProviderName:
+		return c.Providers.NVUE != nil
 	}
 	return false
 }
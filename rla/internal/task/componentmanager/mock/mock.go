@@ -72,6 +72,7 @@ func RegisterAll(registry *componentmanager.Registry) {
 		devicetypes.ComponentTypeCompute,
 		devicetypes.ComponentTypeNVLSwitch,
 		devicetypes.ComponentTypePowerShelf,
+		devicetypes.ComponentTypeToRSwitch,
 	} {
 		registry.RegisterFactory(ct, ImplementationName, FactoryFor(ct))
 	}
@@ -0,0 +1,90 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdu
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
+)
+
+const (
+	// ProviderName is the unique identifier for the CDU provider.
+	ProviderName = "cdu"
+
+	// DefaultTimeout is the default timeout for Redfish requests to a CDU.
+	DefaultTimeout = 30 * time.Second
+)
+
+// Config holds configuration for the CDU provider.
+type Config struct {
+	// Timeout is the timeout for Redfish requests to a CDU.
+	Timeout time.Duration
+
+	// Limits are the readings a CDU must stay within to count as healthy.
+	Limits cduapi.Limits
+}
+
+// Provider wraps a cduapi.Client and provides it to component manager implementations.
+type Provider struct {
+	client cduapi.Client
+	limits cduapi.Limits
+}
+
+// New creates a new Provider using the provided configuration. The resolver
+// maps CDU component IDs to the Redfish endpoints of their BMCs.
+func New(config Config, resolver cduapi.EndpointResolver) (*Provider, error) {
+	client, err := cduapi.NewClient(config.Timeout, resolver)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create CDU client")
+		return nil, err
+	}
+	log.Info().Msg("Successfully created CDU client")
+	return &Provider{client: client, limits: config.Limits}, nil
+}
+
+// NewFromClient creates a Provider from an existing client.
+// This is primarily useful for testing with mock clients.
+func NewFromClient(client cduapi.Client, limits cduapi.Limits) *Provider {
+	return &Provider{client: client, limits: limits}
+}
+
+// Name returns the unique identifier for this provider type.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// Client returns the underlying cduapi.Client.
+func (p *Provider) Client() cduapi.Client {
+	return p.client
+}
+
+// Limits returns the cooling health limits.
+func (p *Provider) Limits() cduapi.Limits {
+	return p.limits
+}
+
+// Close closes the underlying CDU client.
+func (p *Provider) Close() error {
+	if p.client != nil {
+		return p.client.Close()
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdu
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/cduapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+	dbquery "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/query"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+)
+
+// resolverPageSize is the number of CDUs read from the inventory at a time.
+const resolverPageSize = 100
+
+// InventoryReader is the part of the inventory store the resolver reads.
+type InventoryReader interface {
+	GetComponentByBMCMAC(ctx context.Context, macAddress string) (*component.Component, error)
+	GetListOfComponents(
+		ctx context.Context,
+		info dbquery.StringQueryInfo,
+		manufacturerFilter *dbquery.StringQueryInfo,
+		modelFilter *dbquery.StringQueryInfo,
+		componentTypes []devicetypes.ComponentType,
+		pagination *dbquery.Pagination,
+		orderBy *dbquery.OrderBy,
+	) ([]*component.Component, int32, error)
+}
+
+// InventoryResolver resolves CDU endpoints from the BMC records of the
+// inventory. The component ID of a CDU is the MAC address of its BMC.
+type InventoryResolver struct {
+	inventory InventoryReader
+}
+
+// NewInventoryResolver creates a resolver reading from inventory.
+func NewInventoryResolver(inventory InventoryReader) *InventoryResolver {
+	return &InventoryResolver{inventory: inventory}
+}
+
+// Endpoints implements cduapi.EndpointResolver.
+func (r *InventoryResolver) Endpoints(ctx context.Context, componentIDs []string) ([]cduapi.Endpoint, error) {
+	if len(componentIDs) == 0 {
+		return r.allEndpoints(ctx)
+	}
+
+	endpoints := make([]cduapi.Endpoint, 0, len(componentIDs))
+	for _, id := range componentIDs {
+		comp, err := r.inventory.GetComponentByBMCMAC(ctx, utils.NormalizeMAC(id))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find CDU %s: %w", id, err)
+		}
+		if comp.Type != devicetypes.ComponentTypeCDU {
+			return nil, fmt.Errorf("component with BMC %s is a %s, not a CDU", id, comp.Type.String())
+		}
+
+		ep, ok := endpointOf(comp, id)
+		if !ok {
+			return nil, fmt.Errorf("CDU %s has no BMC IP address", id)
+		}
+		endpoints = append(endpoints, ep)
+	}
+
+	return endpoints, nil
+}
+
+// allEndpoints returns the endpoints of every CDU in the inventory. CDUs
+// without a reachable BMC are left out.
+func (r *InventoryResolver) allEndpoints(ctx context.Context) ([]cduapi.Endpoint, error) {
+	var endpoints []cduapi.Endpoint
+	for offset := 0; ; offset += resolverPageSize {
+		comps, total, err := r.inventory.GetListOfComponents(
+			ctx,
+			dbquery.StringQueryInfo{},
+			nil,
+			nil,
+			[]devicetypes.ComponentType{devicetypes.ComponentTypeCDU},
+			&dbquery.Pagination{Offset: offset, Limit: resolverPageSize},
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list CDUs: %w", err)
+		}
+
+		for _, comp := range comps {
+			if ep, ok := endpointOf(comp, ""); ok {
+				endpoints = append(endpoints, ep)
+			}
+		}
+
+		if len(comps) == 0 || offset+len(comps) >= int(total) {
+			return endpoints, nil
+		}
+	}
+}
+
+// endpointOf returns the endpoint of the BMC of comp with the given MAC, or
+// of its first BMC if mac is empty.
+func endpointOf(comp *component.Component, mac string) (cduapi.Endpoint, bool) {
+	for _, typ := range devicetypes.BMCTypes() {
+		for _, b := range comp.BmcsByType[typ] {
+			if mac != "" && b.MAC.String() != utils.NormalizeMAC(mac) {
+				continue
+			}
+			if b.IP == nil {
+				return cduapi.Endpoint{}, false
+			}
+
+			ep := cduapi.Endpoint{
+				ComponentID: b.MAC.String(),
+				RackID:      comp.RackID,
+				Address:     "https://" + net.JoinHostPort(b.IP.String(), "443"),
+			}
+			if b.Credential != nil {
+				ep.Username = b.Credential.User
+				ep.Password = b.Credential.Password.Value
+			}
+			return ep, true
+		}
+	}
+
+	return cduapi.Endpoint{}, false
+}
@@ -0,0 +1,117 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdu
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/credential"
+	dbquery "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/query"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/bmc"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+)
+
+type fakeInventory struct {
+	components []*component.Component
+}
+
+func (f *fakeInventory) GetComponentByBMCMAC(_ context.Context, mac string) (*component.Component, error) {
+	for _, c := range f.components {
+		for _, b := range c.BmcsByType[devicetypes.BMCTypeHost] {
+			if b.MAC.String() == mac {
+				return c, nil
+			}
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (f *fakeInventory) GetListOfComponents(
+	_ context.Context,
+	_ dbquery.StringQueryInfo,
+	_ *dbquery.StringQueryInfo,
+	_ *dbquery.StringQueryInfo,
+	types []devicetypes.ComponentType,
+	pagination *dbquery.Pagination,
+	_ *dbquery.OrderBy,
+) ([]*component.Component, int32, error) {
+	var matched []*component.Component
+	for _, c := range f.components {
+		for _, t := range types {
+			if c.Type == t {
+				matched = append(matched, c)
+			}
+		}
+	}
+
+	end := min(pagination.Offset+pagination.Limit, len(matched))
+	if pagination.Offset >= end {
+		return nil, int32(len(matched)), nil
+	}
+	return matched[pagination.Offset:end], int32(len(matched)), nil
+}
+
+func newComponent(t *testing.T, typ devicetypes.ComponentType, rackID uuid.UUID, mac string, ip string) *component.Component {
+	cred := credential.New("admin", "secret")
+	b, err := bmc.New(mac, &cred, ip)
+	require.NoError(t, err)
+
+	c := component.New(typ, nil, "", nil)
+	c.RackID = rackID
+	c.AddBMC(devicetypes.BMCTypeHost, *b)
+	return &c
+}
+
+func TestInventoryResolver_Endpoints(t *testing.T) {
+	ctx := context.Background()
+	rackID := uuid.New()
+	inv := &fakeInventory{components: []*component.Component{
+		newComponent(t, devicetypes.ComponentTypeCDU, rackID, "aa:bb:cc:00:00:01", "10.0.0.1"),
+		newComponent(t, devicetypes.ComponentTypeCDU, rackID, "aa:bb:cc:00:00:02", ""),
+		newComponent(t, devicetypes.ComponentTypeCompute, rackID, "aa:bb:cc:00:00:03", "10.0.0.3"),
+	}}
+	resolver := NewInventoryResolver(inv)
+
+	eps, err := resolver.Endpoints(ctx, []string{"AA:BB:CC:00:00:01"})
+	require.NoError(t, err)
+	require.Len(t, eps, 1)
+	assert.Equal(t, "aa:bb:cc:00:00:01", eps[0].ComponentID)
+	assert.Equal(t, rackID, eps[0].RackID)
+	assert.Equal(t, "https://10.0.0.1:443", eps[0].Address)
+	assert.Equal(t, "admin", eps[0].Username)
+	assert.Equal(t, "secret", eps[0].Password)
+
+	_, err = resolver.Endpoints(ctx, []string{"aa:bb:cc:00:00:02"})
+	assert.ErrorContains(t, err, "no BMC IP address")
+
+	_, err = resolver.Endpoints(ctx, []string{"aa:bb:cc:00:00:03"})
+	assert.ErrorContains(t, err, "not a CDU")
+
+	// Listing skips CDUs without an IP address.
+	eps, err = resolver.Endpoints(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, eps, 1)
+	assert.Equal(t, "aa:bb:cc:00:00:01", eps[0].ComponentID)
+}
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvue
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/torapi"
+)
+
+const (
+	// ProviderName is the unique identifier for the NVUE provider.
+	ProviderName = "nvue"
+
+	// DefaultTimeout is the default timeout for requests to a ToR switch.
+	DefaultTimeout = 30 * time.Second
+
+	// DefaultNVUEPort is the port the NVUE REST API listens on by default.
+	DefaultNVUEPort = 8765
+)
+
+// Config holds configuration for the NVUE provider.
+type Config struct {
+	// Timeout is the timeout for requests to a ToR switch.
+	Timeout time.Duration
+
+	// NVUEPort is the port of the NVUE REST API on the switches.
+	NVUEPort int
+
+	// ImageURLTemplate is the URL NOS images are installed from. Every
+	// "{version}" in it is replaced with the target version.
+	ImageURLTemplate string
+
+	// ConfigBackupDir is the directory switch configuration backups are
+	// written to.
+	ConfigBackupDir string
+
+	// CACertFile is a PEM file of the CAs that sign the certificates of the
+	// switches and their BMCs. Empty uses the system roots.
+	CACertFile string
+
+	// InsecureSkipVerify disables verification of the switch and BMC
+	// certificates, for switches that still run self-signed ones.
+	InsecureSkipVerify bool
+}
+
+// TLSConfig returns the TLS configuration used to talk to the switches and
+// their BMCs.
+func (c Config) TLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify, //nolint:gosec // opt-in for self-signed switch certificates
+	}
+	if c.CACertFile == "" {
+		return tlsConfig, nil
+	}
+
+	pem, err := os.ReadFile(c.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ToR switch CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in ToR switch CA file %s", c.CACertFile)
+	}
+	tlsConfig.RootCAs = pool
+
+	return tlsConfig, nil
+}
+
+// Provider wraps a torapi.Client and provides it to component manager implementations.
+type Provider struct {
+	client torapi.Client
+	config Config
+}
+
+// New creates a new Provider using the provided configuration. The resolver
+// maps switch component IDs to the endpoints of their BMC and NOS, and
+// installs records the image installs in progress.
+func New(config Config, resolver torapi.EndpointResolver, installs torapi.InstallStore) (*Provider, error) {
+	tlsConfig, err := config.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := torapi.NewClient(config.Timeout, tlsConfig, resolver, installs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create ToR switch client")
+		return nil, err
+	}
+	log.Info().Msg("Successfully created ToR switch client")
+	return &Provider{client: client, config: config}, nil
+}
+
+// NewFromClient creates a Provider from an existing client.
+// This is primarily useful for testing with mock clients.
+func NewFromClient(client torapi.Client, config Config) *Provider {
+	return &Provider{client: client, config: config}
+}
+
+// Name returns the unique identifier for this provider type.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// Client returns the underlying torapi.Client.
+func (p *Provider) Client() torapi.Client {
+	return p.client
+}
+
+// ImageURLTemplate returns the URL template NOS images are installed from.
+func (p *Provider) ImageURLTemplate() string {
+	return p.config.ImageURLTemplate
+}
+
+// ConfigBackupDir returns the directory configuration backups are written to.
+func (p *Provider) ConfigBackupDir() string {
+	return p.config.ConfigBackupDir
+}
+
+// Close closes the underlying ToR switch client.
+func (p *Provider) Close() error {
+	if p.client != nil {
+		return p.client.Close()
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvue
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_TLSConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(ts.Close)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+	notPEM := filepath.Join(dir, "not.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+
+	t.Run("verifies against system roots by default", func(t *testing.T) {
+		tlsConfig, err := Config{}.TLSConfig()
+		require.NoError(t, err)
+		assert.False(t, tlsConfig.InsecureSkipVerify)
+		assert.Nil(t, tlsConfig.RootCAs)
+	})
+
+	t.Run("CA file", func(t *testing.T) {
+		tlsConfig, err := Config{CACertFile: caFile}.TLSConfig()
+		require.NoError(t, err)
+		require.NotNil(t, tlsConfig.RootCAs)
+
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		resp, err := client.Get(ts.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	})
+
+	t.Run("insecure skip verify", func(t *testing.T) {
+		tlsConfig, err := Config{InsecureSkipVerify: true}.TLSConfig()
+		require.NoError(t, err)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+	})
+
+	t.Run("missing CA file", func(t *testing.T) {
+		_, err := Config{CACertFile: filepath.Join(dir, "missing.pem")}.TLSConfig()
+		require.Error(t, err)
+	})
+
+	t.Run("CA file without certificates", func(t *testing.T) {
+		_, err := Config{CACertFile: notPEM}.TLSConfig()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no certificates found")
+	})
+}
@@ -0,0 +1,407 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvue
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager"
+	nvueprovider "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/componentmanager/providers/nvue"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/torapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+const (
+	// ImplementationName is the name used to identify this implementation.
+	ImplementationName = "nvue"
+
+	// versionPlaceholder is replaced with the target version in the image
+	// URL template.
+	versionPlaceholder = "{version}"
+)
+
+// Manager manages ToR switches: power through their BMC, NOS image installs
+// and configuration backups through NVUE.
+type Manager struct {
+	torClient        torapi.Client
+	imageURLTemplate string
+	configBackupDir  string
+}
+
+// New creates a new NVUE-based ToR switch Manager instance.
+func New(torClient torapi.Client, imageURLTemplate string, configBackupDir string) *Manager {
+	return &Manager{
+		torClient:        torClient,
+		imageURLTemplate: imageURLTemplate,
+		configBackupDir:  configBackupDir,
+	}
+}
+
+// Factory creates a new Manager from the provided providers.
+// It retrieves the NVUE provider from the registry and uses its client,
+// image URL template and backup directory.
+func Factory(
+	providerRegistry *componentmanager.ProviderRegistry,
+) (componentmanager.ComponentManager, error) {
+	provider, err := componentmanager.GetTyped[*nvueprovider.Provider](
+		providerRegistry,
+		nvueprovider.ProviderName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("torswitch/nvue requires nvue provider: %w", err)
+	}
+
+	return New(provider.Client(), provider.ImageURLTemplate(), provider.ConfigBackupDir()), nil
+}
+
+// Register registers the NVUE ToR switch manager factory with the given registry.
+func Register(registry *componentmanager.Registry) {
+	registry.RegisterFactory(devicetypes.ComponentTypeToRSwitch, ImplementationName, Factory)
+}
+
+// Type returns the component type this manager handles.
+func (m *Manager) Type() devicetypes.ComponentType {
+	return devicetypes.ComponentTypeToRSwitch
+}
+
+// InjectExpectation is a no-op for ToR switches: they are read directly from
+// their BMC and NOS, so there is no external service to register them with.
+func (m *Manager) InjectExpectation(
+	ctx context.Context,
+	target common.Target,
+	info operations.InjectExpectationTaskInfo,
+) error {
+	log.Info().
+		Str("components", target.String()).
+		Msg("ToR switches need no expectation, skipping")
+
+	return nil
+}
+
+// PowerControl applies a power operation to the target switches through
+// their BMC.
+func (m *Manager) PowerControl(
+	ctx context.Context,
+	target common.Target,
+	info operations.PowerControlTaskInfo,
+) error {
+	log.Debug().
+		Str("components", target.String()).
+		Str("operation", info.Operation.String()).
+		Msg("Power control request received")
+
+	if m.torClient == nil {
+		return fmt.Errorf("tor switch client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return fmt.Errorf("target is invalid: %w", err)
+	}
+
+	var action torapi.PowerAction
+	switch info.Operation {
+	case operations.PowerOperationPowerOn, operations.PowerOperationForcePowerOn:
+		action = torapi.PowerActionOn
+	case operations.PowerOperationPowerOff:
+		action = torapi.PowerActionGracefulShutdown
+	case operations.PowerOperationForcePowerOff:
+		action = torapi.PowerActionForceOff
+	case operations.PowerOperationRestart, operations.PowerOperationWarmReset:
+		action = torapi.PowerActionGracefulRestart
+	case operations.PowerOperationForceRestart:
+		action = torapi.PowerActionForceRestart
+	case operations.PowerOperationColdReset:
+		action = torapi.PowerActionPowerCycle
+	default:
+		return fmt.Errorf("unsupported power operation: %v", info.Operation)
+	}
+
+	results, err := m.torClient.SetPower(ctx, target.ComponentIDs, action)
+	if err != nil {
+		return fmt.Errorf("power control operation failed: %w", err)
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			return fmt.Errorf("power control operation failed for %s: %s", result.ComponentID, result.Error)
+		}
+
+		log.Info().
+			Str("switch", result.ComponentID).
+			Str("operation", info.Operation.String()).
+			Msg("Power control operation completed successfully")
+	}
+
+	return nil
+}
+
+// GetPowerStatus returns the power state reported by the BMC of each target
+// switch. Switches whose BMC cannot be read are left out of the result, and
+// so are switches that are on but whose NOS does not answer yet, so that
+// VerifyReachability waits for a switch to finish booting.
+func (m *Manager) GetPowerStatus(
+	ctx context.Context,
+	target common.Target,
+) (map[string]operations.PowerStatus, error) {
+	log.Debug().
+		Str("components", target.String()).
+		Msg("Get power status request received")
+
+	if m.torClient == nil {
+		return nil, fmt.Errorf("tor switch client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("target is invalid: %w", err)
+	}
+
+	switches, err := m.torClient.GetSwitches(ctx, target.ComponentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ToR switches: %w", err)
+	}
+
+	result := make(map[string]operations.PowerStatus, len(switches))
+	for _, sw := range switches {
+		if sw.Error != "" {
+			log.Warn().
+				Str("switch", sw.ComponentID).
+				Str("error", sw.Error).
+				Msg("ToR switch is unreachable")
+			continue
+		}
+
+		switch sw.PowerState {
+		case torapi.PowerStateOn:
+			if sw.NOSVersion == "" {
+				log.Debug().
+					Str("switch", sw.ComponentID).
+					Msg("ToR switch NOS is not answering yet")
+				continue
+			}
+			result[sw.ComponentID] = operations.PowerStatusOn
+		case torapi.PowerStateOff:
+			result[sw.ComponentID] = operations.PowerStatusOff
+		default:
+			result[sw.ComponentID] = operations.PowerStatusUnknown
+		}
+	}
+
+	log.Info().
+		Str("components", target.String()).
+		Int("result_count", len(result)).
+		Msg("Get power status completed")
+
+	return result, nil
+}
+
+// FirmwareControl installs the NOS image of the target version on the target
+// switches. The image is fetched from the configured URL template, or from
+// the target version itself when it is a URL. The switches reboot into the
+// new image; GetFirmwareStatus follows the install.
+func (m *Manager) FirmwareControl(
+	ctx context.Context,
+	target common.Target,
+	info operations.FirmwareControlTaskInfo,
+) error {
+	log.Debug().
+		Str("components", target.String()).
+		Str("operation", info.Operation.String()).
+		Str("target_version", info.TargetVersion).
+		Msg("Firmware control request received")
+
+	if m.torClient == nil {
+		return fmt.Errorf("tor switch client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return fmt.Errorf("target is invalid: %w", err)
+	}
+
+	switch info.Operation {
+	case operations.FirmwareOperationUpgrade, operations.FirmwareOperationDowngrade:
+	default:
+		return fmt.Errorf("unsupported firmware operation for ToR switches: %v", info.Operation)
+	}
+
+	if info.TargetVersion == "" {
+		return fmt.Errorf("target version is required for ToR switch NOS installs")
+	}
+
+	version, imageURL, err := m.image(info.TargetVersion)
+	if err != nil {
+		return err
+	}
+
+	results, err := m.torClient.InstallImage(ctx, target.ComponentIDs, version, imageURL)
+	if err != nil {
+		return fmt.Errorf("firmware control operation failed: %w", err)
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			return fmt.Errorf("firmware control operation failed for %s: %s", result.ComponentID, result.Error)
+		}
+
+		log.Info().
+			Str("switch", result.ComponentID).
+			Str("version", version).
+			Str("image_url", imageURL).
+			Msg("NOS image install started")
+	}
+
+	return nil
+}
+
+// image returns the version and image URL to install for targetVersion. A
+// target version that is itself a URL is installed as is; its version is the
+// image file name without extension.
+func (m *Manager) image(targetVersion string) (string, string, error) {
+	if strings.Contains(targetVersion, "://") {
+		name := filepath.Base(targetVersion)
+		return strings.TrimSuffix(name, filepath.Ext(name)), targetVersion, nil
+	}
+
+	if m.imageURLTemplate == "" {
+		return "", "", fmt.Errorf("NOS image URL template is not configured")
+	}
+
+	return targetVersion, strings.ReplaceAll(m.imageURLTemplate, versionPlaceholder, targetVersion), nil
+}
+
+// GetFirmwareStatus returns the progress of the NOS image install on each
+// target switch. An install that is still running is reported as queued, and
+// a switch rebooting into its new image as verifying.
+func (m *Manager) GetFirmwareStatus(
+	ctx context.Context,
+	target common.Target,
+) (map[string]operations.FirmwareUpdateStatus, error) {
+	log.Debug().
+		Str("components", target.String()).
+		Msg("Getting firmware update status")
+
+	if m.torClient == nil {
+		return nil, fmt.Errorf("tor switch client is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("target is invalid: %w", err)
+	}
+
+	statuses, err := m.torClient.GetInstallStatus(ctx, target.ComponentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NOS install status: %w", err)
+	}
+
+	result := make(map[string]operations.FirmwareUpdateStatus, len(statuses))
+	for _, status := range statuses {
+		state := operations.FirmwareUpdateStateUnknown
+		errorMsg := ""
+
+		switch status.State {
+		case torapi.InstallStateInstalling:
+			state = operations.FirmwareUpdateStateQueued
+		case torapi.InstallStateRebooting:
+			state = operations.FirmwareUpdateStateVerifying
+		case torapi.InstallStateCompleted:
+			state = operations.FirmwareUpdateStateCompleted
+		case torapi.InstallStateFailed:
+			state = operations.FirmwareUpdateStateFailed
+			errorMsg = status.Error
+		case torapi.InstallStateUnknown:
+			state = operations.FirmwareUpdateStateUnknown
+		}
+
+		result[status.ComponentID] = operations.FirmwareUpdateStatus{
+			ComponentID: status.ComponentID,
+			State:       state,
+			Error:       errorMsg,
+		}
+	}
+
+	log.Info().
+		Str("components", target.String()).
+		Int("result_count", len(result)).
+		Msg("Get firmware status completed")
+
+	return result, nil
+}
+
+// BackupConfig saves the applied NOS configuration of each target switch to
+// <backup dir>/<component ID>/<UTC timestamp>.json, with the colons of the
+// component ID replaced by dashes. It returns the file written for each
+// switch.
+func (m *Manager) BackupConfig(
+	ctx context.Context,
+	target common.Target,
+) (map[string]string, error) {
+	log.Debug().
+		Str("components", target.String()).
+		Msg("Config backup request received")
+
+	if m.torClient == nil {
+		return nil, fmt.Errorf("tor switch client is not configured")
+	}
+
+	if m.configBackupDir == "" {
+		return nil, fmt.Errorf("config backup directory is not configured")
+	}
+
+	if err := target.Validate(); err != nil {
+		return nil, fmt.Errorf("target is invalid: %w", err)
+	}
+
+	backups, err := m.torClient.BackupConfig(ctx, target.ComponentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("config backup failed: %w", err)
+	}
+
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	files := make(map[string]string, len(backups))
+	for _, backup := range backups {
+		if backup.Error != "" {
+			return nil, fmt.Errorf("config backup failed for %s: %s", backup.ComponentID, backup.Error)
+		}
+
+		dir := filepath.Join(m.configBackupDir, strings.ReplaceAll(backup.ComponentID, ":", "-"))
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create config backup directory for %s: %w", backup.ComponentID, err)
+		}
+
+		path := filepath.Join(dir, stamp+".json")
+		if err := os.WriteFile(path, backup.Config, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write config backup for %s: %w", backup.ComponentID, err)
+		}
+
+		files[backup.ComponentID] = path
+		log.Info().
+			Str("switch", backup.ComponentID).
+			Str("file", path).
+			Msg("Config backup saved")
+	}
+
+	return files, nil
+}
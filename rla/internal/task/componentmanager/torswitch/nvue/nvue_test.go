@@ -0,0 +1,422 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvue
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/torapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+const (
+	tor1 = "aa:bb:cc:00:20:01"
+	tor2 = "aa:bb:cc:00:20:02"
+
+	testImageURLTemplate = "http://images/cumulus-linux-{version}-mlx-amd64.bin"
+)
+
+// recordingClient records the power actions and image installs sent to the
+// mock client it wraps.
+type recordingClient struct {
+	torapi.Client
+	action   torapi.PowerAction
+	version  string
+	imageURL string
+}
+
+func (c *recordingClient) SetPower(
+	ctx context.Context,
+	componentIDs []string,
+	action torapi.PowerAction,
+) ([]torapi.Result, error) {
+	c.action = action
+	return c.Client.SetPower(ctx, componentIDs, action)
+}
+
+func (c *recordingClient) InstallImage(
+	ctx context.Context,
+	componentIDs []string,
+	version string,
+	imageURL string,
+) ([]torapi.Result, error) {
+	c.version, c.imageURL = version, imageURL
+	return c.Client.InstallImage(ctx, componentIDs, version, imageURL)
+}
+
+// failingClient is a ToR switch client whose calls fail.
+type failingClient struct {
+	torapi.Client
+}
+
+func (c *failingClient) SetPower(context.Context, []string, torapi.PowerAction) ([]torapi.Result, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (c *failingClient) GetSwitches(context.Context, []string) ([]torapi.Switch, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (c *failingClient) InstallImage(context.Context, []string, string, string) ([]torapi.Result, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (c *failingClient) GetInstallStatus(context.Context, []string) ([]torapi.InstallStatus, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (c *failingClient) BackupConfig(context.Context, []string) ([]torapi.ConfigBackup, error) {
+	return nil, errors.New("connection refused")
+}
+
+// installStatusClient reports fixed install statuses.
+type installStatusClient struct {
+	torapi.Client
+	statuses []torapi.InstallStatus
+}
+
+func (c *installStatusClient) GetInstallStatus(context.Context, []string) ([]torapi.InstallStatus, error) {
+	return c.statuses, nil
+}
+
+func newMockClient(switches ...torapi.Switch) torapi.Client {
+	client := torapi.NewMockClient()
+	for _, sw := range switches {
+		client.AddSwitch(sw)
+	}
+	return client
+}
+
+func runningSwitch(componentID string) torapi.Switch {
+	return torapi.Switch{
+		ComponentID: componentID,
+		RackID:      uuid.New(),
+		Hostname:    "tor-" + componentID[len(componentID)-2:],
+		NOSVersion:  "5.9.1",
+		PowerState:  torapi.PowerStateOn,
+	}
+}
+
+func torTarget(componentIDs ...string) common.Target {
+	return common.Target{Type: devicetypes.ComponentTypeToRSwitch, ComponentIDs: componentIDs}
+}
+
+func TestPowerControl(t *testing.T) {
+	testCases := map[string]struct {
+		operation  operations.PowerOperation
+		wantAction torapi.PowerAction
+	}{
+		"power on":        {operation: operations.PowerOperationPowerOn, wantAction: torapi.PowerActionOn},
+		"force power on":  {operation: operations.PowerOperationForcePowerOn, wantAction: torapi.PowerActionOn},
+		"power off":       {operation: operations.PowerOperationPowerOff, wantAction: torapi.PowerActionGracefulShutdown},
+		"force power off": {operation: operations.PowerOperationForcePowerOff, wantAction: torapi.PowerActionForceOff},
+		"restart":         {operation: operations.PowerOperationRestart, wantAction: torapi.PowerActionGracefulRestart},
+		"warm reset":      {operation: operations.PowerOperationWarmReset, wantAction: torapi.PowerActionGracefulRestart},
+		"force restart":   {operation: operations.PowerOperationForceRestart, wantAction: torapi.PowerActionForceRestart},
+		"cold reset":      {operation: operations.PowerOperationColdReset, wantAction: torapi.PowerActionPowerCycle},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &recordingClient{Client: newMockClient(runningSwitch(tor1))}
+			m := New(client, "", "")
+
+			err := m.PowerControl(
+				context.Background(),
+				torTarget(tor1),
+				operations.PowerControlTaskInfo{Operation: tc.operation},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAction, client.action)
+		})
+	}
+}
+
+func TestPowerControlErrors(t *testing.T) {
+	testCases := map[string]struct {
+		client    torapi.Client
+		target    common.Target
+		operation operations.PowerOperation
+		wantErr   string
+	}{
+		"no client": {
+			target:    torTarget(tor1),
+			operation: operations.PowerOperationPowerOn,
+			wantErr:   "not configured",
+		},
+		"empty target": {
+			client:    newMockClient(runningSwitch(tor1)),
+			target:    torTarget(),
+			operation: operations.PowerOperationPowerOn,
+			wantErr:   "target is invalid",
+		},
+		"unsupported operation": {
+			client:    newMockClient(runningSwitch(tor1)),
+			target:    torTarget(tor1),
+			operation: operations.PowerOperationUnknown,
+			wantErr:   "unsupported power operation",
+		},
+		"client error": {
+			client:    &failingClient{},
+			target:    torTarget(tor1),
+			operation: operations.PowerOperationPowerOn,
+			wantErr:   "connection refused",
+		},
+		"unknown switch": {
+			client:    newMockClient(runningSwitch(tor1)),
+			target:    torTarget(tor1, tor2),
+			operation: operations.PowerOperationPowerOff,
+			wantErr:   "failed for " + tor2 + ": switch not found",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := New(tc.client, "", "")
+
+			err := m.PowerControl(
+				context.Background(),
+				tc.target,
+				operations.PowerControlTaskInfo{Operation: tc.operation},
+			)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestGetPowerStatus(t *testing.T) {
+	off := runningSwitch(tor2)
+	off.PowerState = torapi.PowerStateOff
+	booting := runningSwitch(tor2)
+	booting.NOSVersion = ""
+	unreachable := runningSwitch(tor2)
+	unreachable.PowerState = torapi.PowerStateUnknown
+	unreachable.Error = "connection refused"
+	unknown := runningSwitch(tor2)
+	unknown.PowerState = torapi.PowerStateUnknown
+
+	testCases := map[string]struct {
+		client  torapi.Client
+		want    map[string]operations.PowerStatus
+		wantErr bool
+	}{
+		"on and off": {
+			client: newMockClient(runningSwitch(tor1), off),
+			want: map[string]operations.PowerStatus{
+				tor1: operations.PowerStatusOn,
+				tor2: operations.PowerStatusOff,
+			},
+		},
+		"NOS still booting is left out": {
+			client: newMockClient(runningSwitch(tor1), booting),
+			want:   map[string]operations.PowerStatus{tor1: operations.PowerStatusOn},
+		},
+		"unreachable BMC is left out": {
+			client: newMockClient(runningSwitch(tor1), unreachable),
+			want:   map[string]operations.PowerStatus{tor1: operations.PowerStatusOn},
+		},
+		"unknown power state": {
+			client: newMockClient(runningSwitch(tor1), unknown),
+			want: map[string]operations.PowerStatus{
+				tor1: operations.PowerStatusOn,
+				tor2: operations.PowerStatusUnknown,
+			},
+		},
+		"client error": {
+			client:  &failingClient{},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := New(tc.client, "", "")
+
+			got, err := m.GetPowerStatus(context.Background(), torTarget(tor1, tor2))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFirmwareControl(t *testing.T) {
+	testCases := map[string]struct {
+		template      string
+		info          operations.FirmwareControlTaskInfo
+		wantVersion   string
+		wantImageURL  string
+		wantErrSubstr string
+	}{
+		"version fills the template": {
+			template: testImageURLTemplate,
+			info: operations.FirmwareControlTaskInfo{
+				Operation:     operations.FirmwareOperationUpgrade,
+				TargetVersion: "5.10.0",
+			},
+			wantVersion:  "5.10.0",
+			wantImageURL: "http://images/cumulus-linux-5.10.0-mlx-amd64.bin",
+		},
+		"URL is installed as is": {
+			info: operations.FirmwareControlTaskInfo{
+				Operation:     operations.FirmwareOperationDowngrade,
+				TargetVersion: "http://images/5.8.0.bin",
+			},
+			wantVersion:  "5.8.0",
+			wantImageURL: "http://images/5.8.0.bin",
+		},
+		"version without template": {
+			info: operations.FirmwareControlTaskInfo{
+				Operation:     operations.FirmwareOperationUpgrade,
+				TargetVersion: "5.10.0",
+			},
+			wantErrSubstr: "URL template is not configured",
+		},
+		"no target version": {
+			template: testImageURLTemplate,
+			info: operations.FirmwareControlTaskInfo{
+				Operation: operations.FirmwareOperationUpgrade,
+			},
+			wantErrSubstr: "target version is required",
+		},
+		"rollback is not supported": {
+			template: testImageURLTemplate,
+			info: operations.FirmwareControlTaskInfo{
+				Operation:     operations.FirmwareOperationRollback,
+				TargetVersion: "5.10.0",
+			},
+			wantErrSubstr: "unsupported firmware operation",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &recordingClient{Client: newMockClient(runningSwitch(tor1))}
+			m := New(client, tc.template, "")
+
+			err := m.FirmwareControl(context.Background(), torTarget(tor1), tc.info)
+			if tc.wantErrSubstr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErrSubstr)
+				assert.Empty(t, client.imageURL)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantVersion, client.version)
+			assert.Equal(t, tc.wantImageURL, client.imageURL)
+		})
+	}
+}
+
+func TestGetFirmwareStatus(t *testing.T) {
+	client := &installStatusClient{statuses: []torapi.InstallStatus{
+		{ComponentID: "a", State: torapi.InstallStateInstalling},
+		{ComponentID: "b", State: torapi.InstallStateRebooting},
+		{ComponentID: "c", State: torapi.InstallStateCompleted},
+		{ComponentID: "d", State: torapi.InstallStateFailed, Error: "disk full"},
+		{ComponentID: "e", State: torapi.InstallStateUnknown},
+	}}
+	m := New(client, "", "")
+
+	got, err := m.GetFirmwareStatus(context.Background(), torTarget("a", "b", "c", "d", "e"))
+	require.NoError(t, err)
+
+	want := map[string]operations.FirmwareUpdateStatus{
+		"a": {ComponentID: "a", State: operations.FirmwareUpdateStateQueued},
+		"b": {ComponentID: "b", State: operations.FirmwareUpdateStateVerifying},
+		"c": {ComponentID: "c", State: operations.FirmwareUpdateStateCompleted},
+		"d": {ComponentID: "d", State: operations.FirmwareUpdateStateFailed, Error: "disk full"},
+		"e": {ComponentID: "e", State: operations.FirmwareUpdateStateUnknown},
+	}
+	assert.Equal(t, want, got)
+
+	_, err = New(&failingClient{}, "", "").GetFirmwareStatus(context.Background(), torTarget(tor1))
+	require.Error(t, err)
+}
+
+func TestBackupConfig(t *testing.T) {
+	dir := t.TempDir()
+	m := New(newMockClient(runningSwitch(tor1)), "", dir)
+
+	files, err := m.BackupConfig(context.Background(), torTarget(tor1))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	path := files[tor1]
+	assert.Equal(t, filepath.Join(dir, "aa-bb-cc-00-20-01"), filepath.Dir(path))
+	assert.Equal(t, ".json", filepath.Ext(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"system":{"hostname":"tor-01"}}`, string(data))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestBackupConfigErrors(t *testing.T) {
+	testCases := map[string]struct {
+		client  torapi.Client
+		dir     string
+		target  common.Target
+		wantErr string
+	}{
+		"no backup directory": {
+			client:  newMockClient(runningSwitch(tor1)),
+			target:  torTarget(tor1),
+			wantErr: "directory is not configured",
+		},
+		"client error": {
+			client:  &failingClient{},
+			dir:     t.TempDir(),
+			target:  torTarget(tor1),
+			wantErr: "connection refused",
+		},
+		"unknown switch": {
+			client:  newMockClient(runningSwitch(tor1)),
+			dir:     t.TempDir(),
+			target:  torTarget(tor2),
+			wantErr: "failed for " + tor2 + ": switch not found",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := New(tc.client, "", tc.dir)
+
+			_, err := m.BackupConfig(context.Background(), tc.target)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -240,36 +240,56 @@ func (r *Rule) Conflicts(
 	incoming *taskdef.Task,
 	activeTasks []*taskdef.Task,
 ) bool {
+	return len(r.ConflictingTasks(incoming, activeTasks)) > 0
+}
+
+// ConflictingTasks returns the active tasks the incoming task conflicts with
+// under this rule, in the order given. See Conflicts for the semantics.
+func (r *Rule) ConflictingTasks(
+	incoming *taskdef.Task,
+	activeTasks []*taskdef.Task,
+) []*taskdef.Task {
 	if len(r.ConflictingPairs) == 0 {
-		return len(activeTasks) > 0
+		return activeTasks
+	}
+
+	var conflicting []*taskdef.Task
+	for _, active := range activeTasks {
+		if r.conflictsWith(incoming, active) {
+			conflicting = append(conflicting, active)
+		}
 	}
 
+	return conflicting
+}
+
+// conflictsWith reports whether any ConflictingPairs entry forbids incoming
+// and active from running together.
+func (r *Rule) conflictsWith(incoming, active *taskdef.Task) bool {
 	incomingOp := OperationSpec{
 		OperationType: string(incoming.Operation.Type),
 		OperationCode: incoming.Operation.Code,
 	}
+	activeOp := OperationSpec{
+		OperationType: string(active.Operation.Type),
+		OperationCode: active.Operation.Code,
+	}
 
-	for _, active := range activeTasks {
-		activeOp := OperationSpec{
-			OperationType: string(active.Operation.Type),
-			OperationCode: active.Operation.Code,
+	for _, entry := range r.ConflictingPairs {
+		if !entry.opMatch(incomingOp, activeOp) && !entry.opMatch(activeOp, incomingOp) {
+			continue
 		}
-		for _, entry := range r.ConflictingPairs {
-			if !entry.opMatch(incomingOp, activeOp) && !entry.opMatch(activeOp, incomingOp) {
-				continue
-			}
 
-			// Apply component-type checks in the matched direction.
-			// Both directions may hold when A and B match the same op type.
-			componentMatch :=
-				(entry.opMatch(incomingOp, activeOp) && entry.componentMatch(incoming, active)) ||
-					(entry.opMatch(activeOp, incomingOp) && entry.componentMatch(active, incoming))
+		// Apply component-type checks in the matched direction.
+		// Both directions may hold when A and B match the same op type.
+		componentMatch :=
+			(entry.opMatch(incomingOp, activeOp) && entry.componentMatch(incoming, active)) ||
+				(entry.opMatch(activeOp, incomingOp) && entry.componentMatch(active, incoming))
 
-			if componentMatch &&
-				(!entry.RequireComponentOverlap ||
-					componentUUIDsOverlap(incoming, active)) {
-				return true
-			}
+		if componentMatch &&
+			(!entry.RequireComponentOverlap ||
+				componentUUIDsOverlap(incoming, active)) {
+			return true
 		}
 	}
 
@@ -338,6 +358,23 @@ func (r *Resolver) HasConflict(
 	return builtinRule.Conflicts(incoming, activeTasks), nil
 }
 
+// ConflictingTasks returns the active tasks on the incoming task's rack that
+// it would conflict with under the builtin conflict rule. Nothing is
+// submitted or locked, so the answer is advisory.
+func (r *Resolver) ConflictingTasks(
+	ctx context.Context,
+	incoming *taskdef.Task,
+) ([]*taskdef.Task, error) {
+	activeTasks, err := r.store.ListActiveTasksForRack(
+		ctx, incoming.RackID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return builtinRule.ConflictingTasks(incoming, activeTasks), nil
+}
+
 // HasScheduleConflict reports whether the incoming operation would conflict
 // with any of the existing schedule operations.
 //
@@ -652,3 +652,36 @@ func TestResolver_HasConflict(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_ConflictingTasks(t *testing.T) {
+	rackID := uuid.New()
+	shared := uuid.New()
+
+	shelfPowerOff := makeTaskWithType(rackID,
+		taskcommon.TaskTypePowerControl, "power_off",
+		devicetypes.ComponentTypePowerShelf, uuid.New())
+	computeUpgrade := makeTask(rackID,
+		taskcommon.TaskTypeFirmwareControl, "upgrade", shared)
+	unrelatedUpgrade := makeTask(rackID,
+		taskcommon.TaskTypeFirmwareControl, "upgrade", uuid.New())
+
+	store := newMockStore()
+	store.activeTasks[rackID] = []*taskdef.Task{
+		shelfPowerOff, computeUpgrade, unrelatedUpgrade,
+	}
+	resolver := NewResolver(store)
+
+	incoming := makeTask(rackID,
+		taskcommon.TaskTypeFirmwareControl, "upgrade", shared)
+
+	conflicting, err := resolver.ConflictingTasks(
+		context.Background(), incoming,
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]*taskdef.Task{shelfPowerOff, computeUpgrade}, conflicting)
+
+	store.listActiveErr = errors.New("db connection lost")
+	_, err = resolver.ConflictingTasks(context.Background(), incoming)
+	assert.Error(t, err)
+}
@@ -20,6 +20,7 @@ package conflict
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -69,7 +70,8 @@ func (c *PromoterConfig) applyDefaults() {
 //
 // It is event-driven: the notifyCh receives rack IDs whenever a task on that
 // rack finishes. A single background goroutine drains the channel, purges
-// expired waiting tasks, and promotes the oldest non-expired one.
+// expired waiting tasks, and promotes the highest-priority, oldest
+// non-expired one.
 // A periodic ticker additionally sweeps all racks so that expiry is
 // predictable and stranded waiting tasks are recovered after restarts.
 type Promoter struct {
@@ -191,12 +193,13 @@ func (p *Promoter) sweepAllRacks(ctx context.Context) {
 // processRack fetches all waiting tasks for a rack once, terminates expired
 // ones, then promotes eligible candidates using the builtinRule.
 //
-// Candidates are evaluated in FIFO order. Promotion continues as long as
+// Candidates are evaluated by priority class (emergency, normal, background)
+// and in FIFO order within a class. Promotion continues as long as
 // consecutive candidates do not conflict with the current active set
 // (including tasks promoted earlier in this pass). The loop stops at the
 // first conflicting candidate — tasks behind it are not promoted even if
 // they would not conflict themselves. This preserves strict submission
-// ordering at the cost of some parallelism, which is the right trade-off
+// ordering within a priority class at the cost of some parallelism, which is the right trade-off
 // for hardware operations where users expect sequential execution.
 func (p *Promoter) processRack(ctx context.Context, rackID uuid.UUID) {
 	waiting, err := p.store.ListWaitingTasksForRack(ctx, rackID)
@@ -208,6 +211,8 @@ func (p *Promoter) processRack(ctx context.Context, rackID uuid.UUID) {
 	}
 
 	// Split into expired (terminate immediately) and candidates (promote).
+	// Tasks deferred to a maintenance window that has not opened yet are
+	// neither; they stay waiting and do not hold up the tasks behind them.
 	now := time.Now()
 	candidates := make([]*taskdef.Task, 0, len(waiting))
 	for _, t := range waiting {
@@ -223,6 +228,9 @@ func (p *Promoter) processRack(ctx context.Context, rackID uuid.UUID) {
 			}
 			continue
 		}
+		if t.NotBefore != nil && now.Before(*t.NotBefore) {
+			continue
+		}
 		candidates = append(candidates, t)
 	}
 
@@ -230,8 +238,14 @@ func (p *Promoter) processRack(ctx context.Context, rackID uuid.UUID) {
 		return
 	}
 
-	// Atomically fetch the active set and promote candidates in FIFO
-	// order, stopping at the first conflict.
+	// The store lists waiting tasks oldest first; a stable sort keeps that
+	// order within each priority class.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Priority.Rank() > candidates[j].Priority.Rank()
+	})
+
+	// Atomically fetch the active set and promote candidates in order,
+	// stopping at the first conflict.
 	var toExecute []*taskdef.Task
 	txErr := p.store.RunInTransaction(ctx, func(txCtx context.Context) error {
 		toExecute = nil // reset on retry
@@ -248,7 +262,7 @@ func (p *Promoter) processRack(ctx context.Context, rackID uuid.UUID) {
 		copy(workingActive, active)
 		for _, candidate := range candidates {
 			if builtinRule.Conflicts(candidate, workingActive) {
-				break // stop; preserve FIFO ordering
+				break // stop; preserve queue ordering
 			}
 
 			if err := p.store.UpdateTaskStatus(txCtx,
@@ -277,6 +277,48 @@ func TestPromoter_ProcessRack(t *testing.T) {
 			},
 			wantPromotedCount: 2,
 		},
+		{
+			name: "deferred task stays waiting and does not block the queue",
+			setupStore: func(s *mockStore, rackID uuid.UUID) {
+				deferred := makeTaskWithType(
+					rackID,
+					taskcommon.TaskTypePowerControl, "power_off",
+					devicetypes.ComponentTypePowerShelf, uuid.New(),
+				)
+				deferred.QueueExpiresAt = &future
+				deferred.NotBefore = &future
+				deferred.Status = taskcommon.TaskStatusWaiting
+				behind := makeTaskWithType(
+					rackID,
+					taskcommon.TaskTypePowerControl, "power_on",
+					devicetypes.ComponentTypePowerShelf, uuid.New(),
+				)
+				behind.QueueExpiresAt = &future
+				behind.Status = taskcommon.TaskStatusWaiting
+				s.waitingTasks[rackID] = []*taskdef.Task{deferred, behind}
+			},
+			wantStatusUpdates: []taskdef.TaskStatusUpdate{
+				{Status: taskcommon.TaskStatusPending},
+			},
+			wantPromotedCount: 1,
+		},
+		{
+			name: "deferred task promoted once its window has opened",
+			setupStore: func(s *mockStore, rackID uuid.UUID) {
+				t := &taskdef.Task{
+					ID:             uuid.New(),
+					RackID:         rackID,
+					QueueExpiresAt: &future,
+					NotBefore:      &past,
+					Status:         taskcommon.TaskStatusWaiting,
+				}
+				s.waitingTasks[rackID] = []*taskdef.Task{t}
+			},
+			wantStatusUpdates: []taskdef.TaskStatusUpdate{
+				{Status: taskcommon.TaskStatusPending},
+			},
+			wantPromotedCount: 1,
+		},
 		{
 			name: "list waiting error — no panics, no updates",
 			setupStore: func(s *mockStore, _ uuid.UUID) {
@@ -310,6 +352,71 @@ func TestPromoter_ProcessRack(t *testing.T) {
 	}
 }
 
+func TestPromoter_ProcessRack_Priority(t *testing.T) {
+	rackID := uuid.New()
+	waitingTask := func(priority taskcommon.TaskPriority) *taskdef.Task {
+		// PowerShelf power_control tasks all conflict at rack scope, so
+		// only the first candidate is promoted.
+		t := makeTaskWithType(
+			rackID,
+			taskcommon.TaskTypePowerControl, "power_off",
+			devicetypes.ComponentTypePowerShelf, uuid.New(),
+		)
+		t.Status = taskcommon.TaskStatusWaiting
+		t.Priority = priority
+		return t
+	}
+
+	tests := []struct {
+		name    string
+		waiting []*taskdef.Task
+		want    int // index into waiting of the promoted task
+	}{
+		{
+			name: "emergency jumps ahead of older tasks",
+			waiting: []*taskdef.Task{
+				waitingTask(taskcommon.TaskPriorityNormal),
+				waitingTask(taskcommon.TaskPriorityBackground),
+				waitingTask(taskcommon.TaskPriorityEmergency),
+			},
+			want: 2,
+		},
+		{
+			name: "normal goes before older background",
+			waiting: []*taskdef.Task{
+				waitingTask(taskcommon.TaskPriorityBackground),
+				waitingTask(taskcommon.TaskPriorityNormal),
+			},
+			want: 1,
+		},
+		{
+			name: "FIFO within a class; unset priority counts as normal",
+			waiting: []*taskdef.Task{
+				waitingTask(""),
+				waitingTask(taskcommon.TaskPriorityNormal),
+			},
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newMockStore()
+			store.waitingTasks[rackID] = tc.waiting
+
+			var promoted []uuid.UUID
+			p := NewPromoter(store, func(_ context.Context, id uuid.UUID) error {
+				promoted = append(promoted, id)
+				return nil
+			}, PromoterConfig{})
+
+			p.processRack(context.Background(), rackID)
+
+			assert.Equal(t, []uuid.UUID{tc.waiting[tc.want].ID}, promoted)
+		})
+	}
+}
+
 func TestPromoter_SweepAllRacks(t *testing.T) {
 	future := time.Now().Add(1 * time.Hour)
 
@@ -109,6 +109,14 @@ func (m *mockStore) UpdateScheduledTask(_ context.Context, _ *taskdef.Task) erro
 	panic("mockStore.UpdateScheduledTask: not implemented")
 }
 
+func (m *mockStore) AppendTaskReport(_ context.Context, _ uuid.UUID, _ []taskdef.ReportEntry) error {
+	panic("mockStore.AppendTaskReport: not implemented")
+}
+
+func (m *mockStore) GetTaskReport(_ context.Context, _ uuid.UUID) ([]taskdef.ReportEntry, error) {
+	panic("mockStore.GetTaskReport: not implemented")
+}
+
 func (m *mockStore) CreateRule(_ context.Context, _ *operationrules.OperationRule) error {
 	panic("mockStore.CreateRule: not implemented")
 }
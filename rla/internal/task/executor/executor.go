@@ -37,6 +37,9 @@ type Executor interface {
 	Execute(ctx context.Context, req *task.ExecutionRequest) (*task.ExecutionResponse, error)
 	CheckStatus(ctx context.Context, executionID string) (common.TaskStatus, error)
 	TerminateTask(ctx context.Context, executionID string, reason string) error
+	// SignalApproval delivers a decision to the approval gate that the
+	// execution is waiting on in the rule step for component type step.
+	SignalApproval(ctx context.Context, executionID string, step string, signal *task.ApprovalSignal) error
 }
 
 // ExecutorConfig is implemented by engine-specific configuration structs.
@@ -42,6 +42,9 @@ const (
 	NameBringUpControl            = "BringUpControl"
 	NameGetBringUpStatus          = "GetBringUpStatus"
 	NameVerifyFirmwareConsistency = "VerifyFirmwareConsistency"
+	NameGetCoolingProblems        = "GetCoolingProblems"
+	NameBackupConfig              = "BackupConfig"
+	NameRecordTaskReport          = "RecordTaskReport"
 )
 
 // InjectExpectation is a Temporal activity that registers expected component
@@ -104,6 +107,24 @@ func (a *Activities) UpdateTaskStatus(
 	return a.updater.UpdateTaskStatus(ctx, arg)
 }
 
+// RecordTaskReport is a Temporal activity that appends entries to the
+// persisted report of a task.
+func (a *Activities) RecordTaskReport(
+	ctx context.Context,
+	taskID uuid.UUID,
+	entries []task.ReportEntry,
+) error {
+	if a.recorder == nil {
+		return fmt.Errorf("task report recorder is not configured")
+	}
+
+	if taskID == uuid.Nil {
+		return fmt.Errorf("invalid task identifier")
+	}
+
+	return a.recorder.AppendTaskReport(ctx, taskID, entries)
+}
+
 // FirmwareControl initiates firmware update without waiting for completion.
 // This activity returns immediately after the update request is accepted.
 func (a *Activities) FirmwareControl(
@@ -210,6 +231,48 @@ func (a *Activities) VerifyFirmwareConsistency(
 	return checker.VerifyFirmwareConsistency(ctx, target)
 }
 
+// GetCoolingProblems returns the problems of each unhealthy cooling
+// component in the target. Only supported by component managers that
+// implement CoolingHealthChecker.
+func (a *Activities) GetCoolingProblems(
+	ctx context.Context,
+	target common.Target,
+) (map[string][]string, error) {
+	cm, err := a.validAndGetComponentManager(target)
+	if err != nil {
+		return nil, err
+	}
+
+	checker, ok := cm.(componentmanager.CoolingHealthChecker)
+	if !ok {
+		return nil, fmt.Errorf("component manager for %s does not support cooling health check",
+			target.Type)
+	}
+
+	return checker.GetCoolingProblems(ctx, target)
+}
+
+// BackupConfig saves the configuration of the target components and returns
+// where each backup was stored. Only supported by component managers that
+// implement ConfigBackuper.
+func (a *Activities) BackupConfig(
+	ctx context.Context,
+	target common.Target,
+) (map[string]string, error) {
+	cm, err := a.validAndGetComponentManager(target)
+	if err != nil {
+		return nil, err
+	}
+
+	backuper, ok := cm.(componentmanager.ConfigBackuper)
+	if !ok {
+		return nil, fmt.Errorf("component manager for %s does not support config backup",
+			target.Type)
+	}
+
+	return backuper.BackupConfig(ctx, target)
+}
+
 // validAndGetComponentManager validates the target and returns the component
 // manager registered for its type. Returns an error if the target is invalid
 // or no manager is found.
@@ -30,18 +30,24 @@ import (
 // sharing mutable state.
 type Activities struct {
 	updater  task.TaskStatusUpdater
+	recorder task.TaskReportRecorder
 	registry *componentmanager.Registry
 }
 
 // New creates an Activities instance bound to the given status updater and
 // component manager registry. Either argument may be nil; activity calls that
 // require the missing dependency will return an error at invocation time.
+// Task reports are recorded through the updater when it also implements
+// task.TaskReportRecorder, as the task store does.
 func New(
 	updater task.TaskStatusUpdater,
 	registry *componentmanager.Registry,
 ) *Activities {
+	recorder, _ := updater.(task.TaskReportRecorder)
+
 	return &Activities{
 		updater:  updater,
+		recorder: recorder,
 		registry: registry,
 	}
 }
@@ -61,6 +67,9 @@ func (a *Activities) All() map[string]any {
 		NameBringUpControl:            a.BringUpControl,
 		NameGetBringUpStatus:          a.GetBringUpStatus,
 		NameVerifyFirmwareConsistency: a.VerifyFirmwareConsistency,
+		NameGetCoolingProblems:        a.GetCoolingProblems,
+		NameBackupConfig:              a.BackupConfig,
+		NameRecordTaskReport:          a.RecordTaskReport,
 	}
 }
 
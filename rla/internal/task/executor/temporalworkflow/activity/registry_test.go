@@ -42,6 +42,9 @@ func TestActivities_All_ContainsAllActivities(t *testing.T) {
 		NameBringUpControl,
 		NameGetBringUpStatus,
 		NameVerifyFirmwareConsistency,
+		NameGetCoolingProblems,
+		NameBackupConfig,
+		NameRecordTaskReport,
 	}
 	require.Len(t, all, len(expectedNames), "unexpected number of activities")
 
@@ -35,6 +35,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/workflow"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
 const (
@@ -248,6 +249,34 @@ func (m *Manager) TerminateTask(
 	))
 }
 
+// SignalApproval sends the decision to the component step child workflow of
+// the task workflow, which is where AwaitApproval actions wait.
+func (m *Manager) SignalApproval(
+	ctx context.Context,
+	encodedExecutionID string,
+	step string,
+	signal *task.ApprovalSignal,
+) error {
+	executionID, err := common.NewFromEncoded(encodedExecutionID)
+	if err != nil {
+		return fmt.Errorf("invalid execution ID %q: %w", encodedExecutionID, err)
+	}
+
+	componentType := devicetypes.ComponentTypeFromString(step)
+	if componentType == devicetypes.ComponentTypeUnknown {
+		return fmt.Errorf("unknown approval step %q", step)
+	}
+
+	// Empty runID targets the current run of the step workflow.
+	return m.publisherClient.Client().SignalWorkflow(
+		ctx,
+		workflow.ComponentStepWorkflowID(executionID.WorkflowID, componentType),
+		"",
+		workflow.SignalApprovalDecision,
+		signal,
+	)
+}
+
 // Execute dispatches the task to the Temporal workflow registered for its
 // OperationType. All Temporal mechanics (client, options, workflow submission)
 // are contained here — nothing engine-specific crosses the Executor boundary.
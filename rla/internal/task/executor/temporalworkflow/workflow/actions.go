@@ -20,6 +20,7 @@ package workflow
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -57,6 +58,9 @@ var actionExecutorRegistry = map[string]actionExecutor{
 	operationrules.ActionWaitBringUp:               executeWaitBringUpAction,
 	operationrules.ActionInjectExpectation:         executeInjectExpectationAction,
 	operationrules.ActionVerifyFirmwareConsistency: executeVerifyFirmwareConsistencyAction,
+	operationrules.ActionVerifyCoolingHealth:       executeVerifyCoolingHealthAction,
+	operationrules.ActionBackupConfig:              executeBackupConfigAction,
+	operationrules.ActionAwaitApproval:             executeAwaitApprovalAction,
 }
 
 // executeActionList executes a list of actions sequentially
@@ -75,7 +79,8 @@ func executeActionList(
 	return nil
 }
 
-// executeAction executes a single action using the registry
+// executeAction executes a single action using the registry and records its
+// outcome in the task report.
 func executeAction(
 	ctx workflow.Context,
 	config operationrules.ActionConfig,
@@ -96,7 +101,11 @@ func executeAction(
 		operationInfo:   operationInfo,
 	}
 
-	return executor(actx)
+	startedAt := workflow.Now(ctx)
+	err := executor(actx)
+	recordActionReport(ctx, config, target, startedAt, err)
+
+	return err
 }
 
 // executeSleepAction handles Sleep action
@@ -214,6 +223,24 @@ func executeFirmwareControlAction(actx actionExecutionContext) error {
 		fwInfo.Operation = operations.FirmwareOperationUpgrade
 	}
 
+	// An explicit operation (e.g. a compensating rollback) overrides the
+	// operation of the task.
+	if opParam, ok := actx.config.Parameters[operationrules.ParamOperation]; ok {
+		opStr, _ := opParam.(string)
+		op := operations.FirmwareOperationFromString(opStr)
+		if op == operations.FirmwareOperationUnknown {
+			return fmt.Errorf(
+				"FirmwareControl action: unrecognized operation %q", opStr,
+			)
+		}
+		fwInfo.Operation = op
+		if op == operations.FirmwareOperationRollback {
+			// Rolling back restores the previous image; the task's
+			// target version describes the image being rolled back.
+			fwInfo.TargetVersion = ""
+		}
+	}
+
 	fwInfo.TargetVersion = extractComponentTargetVersion(fwInfo.TargetVersion, target.Type)
 
 	if err := workflow.ExecuteActivity(
@@ -224,7 +251,7 @@ func executeFirmwareControlAction(actx actionExecutionContext) error {
 
 	// Determine poll parameters from action config
 	pollInterval := 2 * time.Minute
-	pollTimeout := 30 * time.Minute
+	pollTimeout := operationrules.DefaultFirmwarePollTimeout
 
 	if v, ok := actx.config.Parameters[operationrules.ParamPollInterval]; ok {
 		if d := parseDurationParam(v); d > 0 {
@@ -609,10 +636,129 @@ func executeVerifyFirmwareConsistencyAction(actx actionExecutionContext) error {
 	).Get(actx.workflowContext, nil)
 }
 
+// executeBackupConfigAction saves the configuration of the step's
+// components before the step changes them.
+func executeBackupConfigAction(actx actionExecutionContext) error {
+	var files map[string]string
+	if err := workflow.ExecuteActivity(
+		actx.workflowContext,
+		activity.NameBackupConfig,
+		actx.target,
+	).Get(actx.workflowContext, &files); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("component_type", devicetypes.ComponentTypeToString(actx.target.Type)).
+		Int("backups", len(files)).
+		Msg("Config backup completed")
+
+	return nil
+}
+
+// executeVerifyCoolingHealthAction polls the CDUs of the task until none
+// reports a problem. Tasks without CDUs pass straight through, so the action
+// can sit in any rule that powers compute.
+func executeVerifyCoolingHealthAction(actx actionExecutionContext) error {
+	target, ok := actx.allTargets[devicetypes.ComponentTypeCDU]
+	if !ok || len(target.ComponentIDs) == 0 {
+		log.Debug().Msg("No CDUs in task, skipping cooling health verification")
+		return nil
+	}
+
+	return verifyCoolingHealth(
+		actx.workflowContext,
+		target,
+		actx.config.Timeout,
+		actx.config.PollInterval,
+	)
+}
+
+// verifyCoolingHealth polls GetCoolingProblems until the target CDUs report
+// no problems or the timeout expires.
+func verifyCoolingHealth(
+	ctx workflow.Context,
+	target common.Target,
+	timeout time.Duration,
+	pollInterval time.Duration,
+) error {
+	log.Debug().
+		Str("components", target.String()).
+		Dur("timeout", timeout).
+		Dur("poll_interval", pollInterval).
+		Msg("Starting cooling health verification")
+
+	deadline := workflow.Now(ctx).Add(timeout)
+	attempt := 0
+	var lastProblems string
+
+	for {
+		attempt++
+
+		var problems map[string][]string
+		err := workflow.ExecuteActivity(
+			ctx,
+			activity.NameGetCoolingProblems,
+			target,
+		).Get(ctx, &problems)
+
+		if err == nil {
+			if len(problems) == 0 {
+				log.Info().
+					Int("attempt", attempt).
+					Msg("Cooling is healthy")
+				return nil
+			}
+
+			lastProblems = formatCoolingProblems(problems)
+			log.Info().
+				Int("attempt", attempt).
+				Str("problems", lastProblems).
+				Msg("Cooling is unhealthy, will retry")
+		} else {
+			lastProblems = err.Error()
+			log.Info().
+				Err(err).
+				Int("attempt", attempt).
+				Msg("GetCoolingProblems failed, will retry")
+		}
+
+		if workflow.Now(ctx).After(deadline) {
+			return fmt.Errorf(
+				"timeout after %v waiting for healthy cooling (attempts: %d): %s",
+				timeout,
+				attempt,
+				lastProblems,
+			)
+		}
+
+		if err := workflow.Sleep(ctx, pollInterval); err != nil {
+			return fmt.Errorf("workflow sleep interrupted: %w", err)
+		}
+	}
+}
+
+// formatCoolingProblems renders problems as "id: p1, p2; id2: p3", ordered
+// by component ID.
+func formatCoolingProblems(problems map[string][]string) string {
+	ids := make([]string, 0, len(problems))
+	for id := range problems {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, id+": "+strings.Join(problems[id], ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
 // knownComponentTypeKeys are the JSON keys recognised in a layered
 // TargetVersion object. Used to distinguish the new per-component-type
 // format from the legacy flat format.
-var knownComponentTypeKeys = []string{"compute", "nvlswitch", "powershelf"}
+var knownComponentTypeKeys = []string{"compute", "nvlswitch", "powershelf", "torswitch"}
 
 // extractComponentTargetVersion extracts the component-specific section from
 // a layered TargetVersion JSON string. The expected top-level structure is:
@@ -61,6 +61,16 @@ func TestExtractComponentTargetVersion(t *testing.T) {
 			componentType: devicetypes.ComponentTypeNVLSwitch,
 			expected:      "2.0.0",
 		},
+		"layered JSON — torswitch section extracted": {
+			rawVersion:    `{"compute":{"bmc":"7.10.30"},"torswitch":"5.10.0"}`,
+			componentType: devicetypes.ComponentTypeToRSwitch,
+			expected:      "5.10.0",
+		},
+		"layered JSON — torswitch only, other types get empty": {
+			rawVersion:    `{"torswitch":"5.10.0"}`,
+			componentType: devicetypes.ComponentTypeCompute,
+			expected:      "",
+		},
 		"layered JSON — string scalar with escapes is unquoted": {
 			rawVersion:    `{"nvlswitch":"r1.3.9-alpha"}`,
 			componentType: devicetypes.ComponentTypeNVLSwitch,
@@ -0,0 +1,280 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// SignalApprovalDecision is the Temporal signal that delivers a
+// task.ApprovalSignal to the component step workflow waiting in an
+// AwaitApproval action.
+const SignalApprovalDecision = "ApprovalDecision"
+
+// errTypeApprovalAborted is the application error type returned when an
+// approval gate ends with an abort decision. The task workflow maps it to
+// TaskStatusTerminated instead of TaskStatusFailed.
+const errTypeApprovalAborted = "ApprovalAborted"
+
+// ComponentStepWorkflowID returns the workflow ID of the component step child
+// workflow that the task workflow parentWorkflowID starts for componentType.
+// Signals for an approval gate are sent to this ID.
+func ComponentStepWorkflowID(
+	parentWorkflowID string,
+	componentType devicetypes.ComponentType,
+) string {
+	return fmt.Sprintf("component-step-%s-%s",
+		parentWorkflowID, devicetypes.ComponentTypeToString(componentType))
+}
+
+// executeAwaitApprovalAction records an approval gate on the task, moves the
+// task to waiting_approval and blocks until a matching decision signal
+// arrives or the action timeout applies the configured default. Approve
+// returns nil, reject returns an error that fails the step, and abort returns
+// an error that ends the task as terminated.
+func executeAwaitApprovalAction(actx actionExecutionContext) error {
+	ctx := actx.workflowContext
+
+	parent := workflow.GetInfo(ctx).ParentWorkflowExecution
+	if parent == nil {
+		return fmt.Errorf("AwaitApproval must run inside a component step workflow")
+	}
+
+	// The task workflow is started with the task ID as its workflow ID.
+	taskID, err := uuid.Parse(parent.ID)
+	if err != nil {
+		return fmt.Errorf("AwaitApproval: parent workflow ID %q is not a task ID", parent.ID)
+	}
+
+	approval, err := newApproval(ctx, actx.config, actx.target.Type)
+	if err != nil {
+		return err
+	}
+
+	if err := recordApproval(
+		ctx, taskID, taskcommon.TaskStatusWaitingApproval, approval.Prompt, approval,
+	); err != nil {
+		return fmt.Errorf("failed to record approval request: %w", err)
+	}
+
+	log.Info().
+		Str("task_id", taskID.String()).
+		Str("approval_id", approval.ID).
+		Str("step", approval.Step).
+		Time("deadline", approval.Deadline).
+		Msg("Waiting for approval")
+
+	signal, timedOut := waitForApprovalDecision(ctx, approval)
+
+	decidedAt := workflow.Now(ctx)
+	approval.DecidedAt = &decidedAt
+	if timedOut {
+		approval.Decision = approval.DefaultDecision
+		approval.TimedOut = true
+	} else {
+		approval.Decision = signal.Decision
+		approval.Approver = signal.Approver
+		approval.Comment = signal.Comment
+	}
+
+	message := approvalOutcome(approval)
+	if err := recordApproval(
+		ctx, taskID, taskcommon.TaskStatusRunning, message, approval,
+	); err != nil {
+		return fmt.Errorf("failed to record approval decision: %w", err)
+	}
+
+	log.Info().
+		Str("task_id", taskID.String()).
+		Str("approval_id", approval.ID).
+		Str("decision", string(approval.Decision)).
+		Bool("timed_out", approval.TimedOut).
+		Msg(message)
+
+	switch approval.Decision {
+	case taskcommon.ApprovalDecisionApprove:
+		return nil
+	case taskcommon.ApprovalDecisionAbort:
+		return temporal.NewNonRetryableApplicationError(
+			message, errTypeApprovalAborted, nil,
+		)
+	default:
+		return errors.New(message)
+	}
+}
+
+// newApproval builds the pending approval record for an AwaitApproval action.
+// The ID comes from a side effect so that replays see the same value.
+func newApproval(
+	ctx workflow.Context,
+	config operationrules.ActionConfig,
+	componentType devicetypes.ComponentType,
+) (taskcommon.Approval, error) {
+	prompt, _ := config.Parameters[operationrules.ParamPrompt].(string)
+
+	def := taskcommon.ApprovalDecisionReject
+	if v, ok := config.Parameters[operationrules.ParamDefault].(string); ok {
+		d, err := taskcommon.ApprovalDecisionFromString(v)
+		if err != nil {
+			return taskcommon.Approval{}, fmt.Errorf("AwaitApproval: %w", err)
+		}
+		def = d
+	}
+
+	if config.Timeout <= 0 {
+		return taskcommon.Approval{}, fmt.Errorf("AwaitApproval requires a timeout")
+	}
+
+	var id string
+	if err := workflow.SideEffect(ctx, func(workflow.Context) any {
+		return uuid.NewString()
+	}).Get(&id); err != nil {
+		return taskcommon.Approval{}, err
+	}
+
+	now := workflow.Now(ctx)
+	return taskcommon.Approval{
+		ID:              id,
+		Step:            devicetypes.ComponentTypeToString(componentType),
+		Prompt:          prompt,
+		RequestedAt:     now,
+		Deadline:        now.Add(config.Timeout),
+		DefaultDecision: def,
+	}, nil
+}
+
+// waitForApprovalDecision blocks until a decision for approval arrives or its
+// deadline passes. Signals naming a different gate (for example one that was
+// sent after an earlier gate already timed out) are dropped.
+func waitForApprovalDecision(
+	ctx workflow.Context,
+	approval taskcommon.Approval,
+) (task.ApprovalSignal, bool) {
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+
+	timer := workflow.NewTimer(timerCtx, approval.Deadline.Sub(approval.RequestedAt))
+	signals := workflow.GetSignalChannel(ctx, SignalApprovalDecision)
+
+	for {
+		var signal task.ApprovalSignal
+		timedOut := false
+
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signals, func(c workflow.ReceiveChannel, _ bool) {
+			c.Receive(ctx, &signal)
+		})
+		selector.AddFuture(timer, func(workflow.Future) {
+			timedOut = true
+		})
+		selector.Select(ctx)
+
+		if timedOut {
+			return task.ApprovalSignal{}, true
+		}
+
+		if signal.ApprovalID != approval.ID {
+			log.Warn().
+				Str("approval_id", approval.ID).
+				Str("signal_approval_id", signal.ApprovalID).
+				Msg("Ignoring decision for a different approval gate")
+			continue
+		}
+
+		return signal, false
+	}
+}
+
+// recordApproval stores approval on the task together with a status change.
+func recordApproval(
+	ctx workflow.Context,
+	taskID uuid.UUID,
+	status taskcommon.TaskStatus,
+	message string,
+	approval taskcommon.Approval,
+) error {
+	arg := &task.TaskStatusUpdate{
+		ID:       taskID,
+		Status:   status,
+		Message:  message,
+		Approval: &approval,
+	}
+
+	return workflow.ExecuteActivity(ctx, activity.NameUpdateTaskStatus, arg).Get(ctx, nil)
+}
+
+// approvalOutcome describes a decided approval gate for the task message.
+func approvalOutcome(approval taskcommon.Approval) string {
+	if approval.TimedOut {
+		return fmt.Sprintf(
+			"%s step: no approval decision before the deadline, applied default %q",
+			approval.Step, approval.DefaultDecision,
+		)
+	}
+
+	msg := fmt.Sprintf(
+		"%s step: %s by %s", approval.Step, pastTense(approval.Decision), approval.Approver,
+	)
+	if approval.Comment != "" {
+		msg += ": " + approval.Comment
+	}
+	return msg
+}
+
+func pastTense(decision taskcommon.ApprovalDecision) string {
+	switch decision {
+	case taskcommon.ApprovalDecisionApprove:
+		return "approved"
+	case taskcommon.ApprovalDecisionAbort:
+		return "aborted"
+	default:
+		return "rejected"
+	}
+}
+
+// isApprovalAborted reports whether err, possibly returned through a child
+// workflow, comes from an approval gate that ended with abort.
+func isApprovalAborted(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == errTypeApprovalAborted
+}
+
+// preserveApprovalAbort re-raises an abort from an approval gate as an
+// application error of its own type, so the cause survives the
+// workflow-to-failure conversion at the end of a child workflow, where only
+// the outermost error type is kept.
+func preserveApprovalAbort(err error) error {
+	if err == nil || !isApprovalAborted(err) {
+		return err
+	}
+	return temporal.NewNonRetryableApplicationError(
+		err.Error(), errTypeApprovalAborted, nil,
+	)
+}
@@ -0,0 +1,278 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/testsuite"
+	temporalworkflow "go.temporal.io/sdk/workflow"
+
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	activitypkg "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// approvalHarness runs a two-stage bring-up where the NVLSwitch stage ends
+// with an approval gate and the compute stage injects expectations.
+type approvalHarness struct {
+	env    *testsuite.TestWorkflowEnvironment
+	taskID uuid.UUID
+
+	mu       sync.Mutex
+	updates  []task.TaskStatusUpdate
+	injected []devicetypes.ComponentType
+}
+
+func newApprovalHarness(t *testing.T) *approvalHarness {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	h := &approvalHarness{
+		env:    testSuite.NewTestWorkflowEnvironment(),
+		taskID: uuid.New(),
+	}
+
+	mockInjectExpectation := func(
+		ctx context.Context,
+		target common.Target,
+		info operations.InjectExpectationTaskInfo,
+	) error {
+		return nil
+	}
+
+	h.env.RegisterWorkflowWithOptions(bringUp, temporalworkflow.RegisterOptions{Name: "BringUp"})
+	h.env.RegisterWorkflowWithOptions(genericComponentStepWorkflow, temporalworkflow.RegisterOptions{Name: nameGenericComponentStepWorkflow})
+	h.env.RegisterActivityWithOptions(mockUpdateTaskStatusForBringUp,
+		activity.RegisterOptions{Name: activitypkg.NameUpdateTaskStatus})
+	h.env.RegisterActivityWithOptions(mockInjectExpectation,
+		activity.RegisterOptions{Name: activitypkg.NameInjectExpectation})
+
+	h.env.OnActivity(activitypkg.NameUpdateTaskStatus, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, arg *task.TaskStatusUpdate) error {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			h.updates = append(h.updates, *arg)
+			return nil
+		})
+	h.env.OnActivity(activitypkg.NameInjectExpectation, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, target common.Target, _ operations.InjectExpectationTaskInfo) error {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			h.injected = append(h.injected, target.Type)
+			return nil
+		})
+
+	// The task workflow ID is the task ID, as in the Temporal executor.
+	h.env.SetStartWorkflowOptions(client.StartWorkflowOptions{ID: h.taskID.String()})
+
+	return h
+}
+
+func (h *approvalHarness) run(defaultDecision string) {
+	rule := &operationrules.RuleDefinition{
+		Version: "v1",
+		Steps: []operationrules.SequenceStep{
+			{
+				ComponentType: devicetypes.ComponentTypeNVLSwitch,
+				Stage:         1,
+				Timeout:       10 * time.Minute,
+				MainOperation: operationrules.ActionConfig{
+					Name: operationrules.ActionInjectExpectation,
+				},
+				PostOperation: []operationrules.ActionConfig{
+					{
+						Name:    operationrules.ActionAwaitApproval,
+						Timeout: time.Hour,
+						Parameters: map[string]any{
+							operationrules.ParamPrompt:  "NVLSwitch trays are up; continue with compute?",
+							operationrules.ParamDefault: defaultDecision,
+						},
+					},
+				},
+			},
+			{
+				ComponentType: devicetypes.ComponentTypeCompute,
+				Stage:         2,
+				Timeout:       10 * time.Minute,
+				MainOperation: operationrules.ActionConfig{
+					Name: operationrules.ActionInjectExpectation,
+				},
+			},
+		},
+	}
+
+	reqInfo := task.ExecutionInfo{
+		TaskID: h.taskID,
+		Components: []task.WorkflowComponent{
+			{ComponentID: "switch-1", Type: devicetypes.ComponentTypeNVLSwitch},
+			{ComponentID: "compute-1", Type: devicetypes.ComponentTypeCompute},
+		},
+		RuleDefinition: rule,
+	}
+
+	h.env.ExecuteWorkflow("BringUp", reqInfo, &operations.BringUpTaskInfo{})
+}
+
+// decideAfter sends a decision for the pending gate once delay has passed in
+// workflow time.
+func (h *approvalHarness) decideAfter(
+	t *testing.T,
+	delay time.Duration,
+	decision taskcommon.ApprovalDecision,
+	approvalID func() string,
+) {
+	h.env.RegisterDelayedCallback(func() {
+		err := h.env.SignalWorkflowByID(
+			ComponentStepWorkflowID(h.taskID.String(), devicetypes.ComponentTypeNVLSwitch),
+			SignalApprovalDecision,
+			task.ApprovalSignal{
+				ApprovalID: approvalID(),
+				Decision:   decision,
+				Approver:   "alice",
+				Comment:    "change CHG-42",
+			},
+		)
+		require.NoError(t, err)
+	}, delay)
+}
+
+// pendingApprovalID returns the ID of the gate recorded as waiting.
+func (h *approvalHarness) pendingApprovalID() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, u := range h.updates {
+		if u.Approval != nil && u.Status == taskcommon.TaskStatusWaitingApproval {
+			return u.Approval.ID
+		}
+	}
+	return ""
+}
+
+// approvalUpdates returns the status updates that carried an approval record.
+func (h *approvalHarness) approvalUpdates() []task.TaskStatusUpdate {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var out []task.TaskStatusUpdate
+	for _, u := range h.updates {
+		if u.Approval != nil {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+func (h *approvalHarness) finalStatus() taskcommon.TaskStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.updates[len(h.updates)-1].Status
+}
+
+func TestAwaitApproval_Approved(t *testing.T) {
+	h := newApprovalHarness(t)
+	h.decideAfter(t, 10*time.Minute, taskcommon.ApprovalDecisionApprove, h.pendingApprovalID)
+
+	h.run("reject")
+
+	require.True(t, h.env.IsWorkflowCompleted())
+	require.NoError(t, h.env.GetWorkflowError())
+
+	updates := h.approvalUpdates()
+	require.Len(t, updates, 2)
+
+	waiting := updates[0]
+	assert.Equal(t, taskcommon.TaskStatusWaitingApproval, waiting.Status)
+	assert.Equal(t, "NVLSwitch trays are up; continue with compute?", waiting.Message)
+	assert.True(t, waiting.Approval.IsPending())
+	assert.Equal(t, taskcommon.ApprovalDecisionReject, waiting.Approval.DefaultDecision)
+	assert.Equal(t, time.Hour, waiting.Approval.Deadline.Sub(waiting.Approval.RequestedAt))
+
+	decided := updates[1]
+	assert.Equal(t, taskcommon.TaskStatusRunning, decided.Status)
+	assert.Equal(t, waiting.Approval.ID, decided.Approval.ID)
+	assert.Equal(t, taskcommon.ApprovalDecisionApprove, decided.Approval.Decision)
+	assert.Equal(t, "alice", decided.Approval.Approver)
+	assert.Equal(t, "change CHG-42", decided.Approval.Comment)
+	assert.False(t, decided.Approval.TimedOut)
+	require.NotNil(t, decided.Approval.DecidedAt)
+
+	assert.Equal(t, []devicetypes.ComponentType{
+		devicetypes.ComponentTypeNVLSwitch, devicetypes.ComponentTypeCompute,
+	}, h.injected)
+	assert.Equal(t, taskcommon.TaskStatusCompleted, h.finalStatus())
+}
+
+func TestAwaitApproval_Rejected(t *testing.T) {
+	h := newApprovalHarness(t)
+	h.decideAfter(t, 10*time.Minute, taskcommon.ApprovalDecisionReject, h.pendingApprovalID)
+
+	h.run("approve")
+
+	require.True(t, h.env.IsWorkflowCompleted())
+	err := h.env.GetWorkflowError()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rejected by alice")
+
+	assert.Equal(t, []devicetypes.ComponentType{devicetypes.ComponentTypeNVLSwitch}, h.injected,
+		"compute must not be touched after a rejection")
+	assert.Equal(t, taskcommon.TaskStatusFailed, h.finalStatus())
+}
+
+func TestAwaitApproval_TimeoutAppliesDefault(t *testing.T) {
+	h := newApprovalHarness(t)
+
+	h.run("abort")
+
+	require.True(t, h.env.IsWorkflowCompleted())
+	require.Error(t, h.env.GetWorkflowError())
+
+	updates := h.approvalUpdates()
+	require.Len(t, updates, 2)
+	decided := updates[1].Approval
+	assert.True(t, decided.TimedOut)
+	assert.Equal(t, taskcommon.ApprovalDecisionAbort, decided.Decision)
+	assert.Empty(t, decided.Approver)
+
+	assert.Equal(t, []devicetypes.ComponentType{devicetypes.ComponentTypeNVLSwitch}, h.injected)
+	assert.Equal(t, taskcommon.TaskStatusTerminated, h.finalStatus(),
+		"an aborted gate ends the task as terminated, not failed")
+}
+
+func TestAwaitApproval_IgnoresDecisionForOtherGate(t *testing.T) {
+	h := newApprovalHarness(t)
+	h.decideAfter(t, 5*time.Minute, taskcommon.ApprovalDecisionReject,
+		func() string { return "stale-gate" })
+	h.decideAfter(t, 10*time.Minute, taskcommon.ApprovalDecisionApprove, h.pendingApprovalID)
+
+	h.run("reject")
+
+	require.True(t, h.env.IsWorkflowCompleted())
+	require.NoError(t, h.env.GetWorkflowError())
+	assert.Equal(t, taskcommon.TaskStatusCompleted, h.finalStatus())
+}
@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	temporalworkflow "go.temporal.io/sdk/workflow"
+
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	activitypkg "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+func mockBackupConfig(ctx context.Context, target common.Target) (map[string]string, error) {
+	return nil, nil
+}
+
+// createToRBackupThenRestartRuleDef backs up the ToR switch configuration
+// before power-cycling the switches.
+func createToRBackupThenRestartRuleDef() *operationrules.RuleDefinition {
+	return &operationrules.RuleDefinition{
+		Version: "v1",
+		Steps: []operationrules.SequenceStep{
+			{
+				ComponentType: devicetypes.ComponentTypeToRSwitch,
+				Stage:         1,
+				Timeout:       10 * time.Minute,
+				PreOperation: []operationrules.ActionConfig{
+					{Name: operationrules.ActionBackupConfig},
+				},
+				MainOperation: operationrules.ActionConfig{
+					Name: operationrules.ActionPowerControl,
+				},
+			},
+		},
+	}
+}
+
+func TestPowerControlWorkflow_BackupConfig(t *testing.T) {
+	testCases := map[string]struct {
+		backupErr       error
+		expectError     string
+		expectPowerCall bool
+	}{
+		"backup precedes the power cycle": {
+			expectPowerCall: true,
+		},
+		"failed backup blocks the power cycle": {
+			backupErr:   errors.New("config backup failed for tor-1: NVUE returned 500"),
+			expectError: "config backup failed for tor-1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			env.RegisterWorkflowWithOptions(genericComponentStepWorkflow, temporalworkflow.RegisterOptions{Name: nameGenericComponentStepWorkflow})
+			env.RegisterActivityWithOptions(mockPowerControl,
+				activity.RegisterOptions{Name: activitypkg.NamePowerControl})
+			env.RegisterActivityWithOptions(mockUpdateTaskStatus,
+				activity.RegisterOptions{Name: activitypkg.NameUpdateTaskStatus})
+			env.RegisterActivityWithOptions(mockBackupConfig,
+				activity.RegisterOptions{Name: activitypkg.NameBackupConfig})
+
+			env.OnActivity(activitypkg.NameUpdateTaskStatus, mock.Anything, mock.Anything).Return(nil)
+
+			backups := 0
+			env.OnActivity(activitypkg.NameBackupConfig, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, target common.Target) (map[string]string, error) {
+					assert.Equal(t, devicetypes.ComponentTypeToRSwitch, target.Type)
+					assert.Equal(t, []string{"tor-1"}, target.ComponentIDs)
+					backups++
+					if tc.backupErr != nil {
+						return nil, tc.backupErr
+					}
+					return map[string]string{"tor-1": "/backups/tor-1/20261018T120000Z.json"}, nil
+				},
+			)
+
+			powerCalls := 0
+			env.OnActivity(activitypkg.NamePowerControl, mock.Anything, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, info taskcommon.ComponentInfo, pcInfo *operations.PowerControlTaskInfo) error {
+					assert.Equal(t, 1, backups, "backup must run before power control")
+					powerCalls++
+					return nil
+				},
+			)
+
+			info := &operations.PowerControlTaskInfo{Operation: operations.PowerOperationColdReset}
+			reqInfo := task.ExecutionInfo{
+				TaskID: uuid.New(),
+				Components: []task.WorkflowComponent{
+					{ComponentID: "tor-1", Type: devicetypes.ComponentTypeToRSwitch},
+				},
+				RuleDefinition: createToRBackupThenRestartRuleDef(),
+			}
+
+			env.ExecuteWorkflow(powerControl, reqInfo, info)
+
+			assert.True(t, env.IsWorkflowCompleted())
+			if tc.expectError != "" {
+				wfErr := env.GetWorkflowError()
+				if assert.Error(t, wfErr) {
+					assert.Contains(t, wfErr.Error(), tc.expectError)
+				}
+			} else {
+				assert.NoError(t, env.GetWorkflowError())
+			}
+			assert.Equal(t, tc.expectPowerCall, powerCalls > 0)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	temporalworkflow "go.temporal.io/sdk/workflow"
+
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	activitypkg "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+func mockGetCoolingProblems(ctx context.Context, target common.Target) (map[string][]string, error) {
+	return nil, nil
+}
+
+// createCoolingGatedPowerOnRuleDef powers on compute only after the CDUs of
+// the task report healthy cooling.
+func createCoolingGatedPowerOnRuleDef() *operationrules.RuleDefinition {
+	return &operationrules.RuleDefinition{
+		Version: "v1",
+		Steps: []operationrules.SequenceStep{
+			{
+				ComponentType: devicetypes.ComponentTypeCompute,
+				Stage:         1,
+				Timeout:       10 * time.Minute,
+				PreOperation: []operationrules.ActionConfig{
+					{
+						Name:         operationrules.ActionVerifyCoolingHealth,
+						Timeout:      5 * time.Second,
+						PollInterval: 1 * time.Second,
+					},
+				},
+				MainOperation: operationrules.ActionConfig{
+					Name: operationrules.ActionPowerControl,
+				},
+			},
+		},
+	}
+}
+
+func TestPowerControlWorkflow_VerifyCoolingHealth(t *testing.T) {
+	withCDU := []task.WorkflowComponent{
+		{ComponentID: "cdu-1", Type: devicetypes.ComponentTypeCDU},
+		{ComponentID: "compute-1", Type: devicetypes.ComponentTypeCompute},
+	}
+
+	testCases := map[string]struct {
+		components      []task.WorkflowComponent
+		problems        []map[string][]string // one per poll; the last repeats
+		problemsErr     error
+		expectError     string
+		expectPowerCall bool
+	}{
+		"healthy cooling powers on compute": {
+			components:      withCDU,
+			problems:        []map[string][]string{{}},
+			expectPowerCall: true,
+		},
+		"cooling recovers before the timeout": {
+			components: withCDU,
+			problems: []map[string][]string{
+				{"cdu-1": {"0 of 1 required pumps running"}},
+				{},
+			},
+			expectPowerCall: true,
+		},
+		"leak blocks power on": {
+			components:  withCDU,
+			problems:    []map[string][]string{{"cdu-1": {"leak detected by Drip tray"}}},
+			expectError: "cdu-1: leak detected by Drip tray",
+		},
+		"unreadable CDU blocks power on": {
+			components:  withCDU,
+			problemsErr: errors.New("cdu client is not configured"),
+			expectError: "timeout",
+		},
+		"task without CDUs skips the check": {
+			components: []task.WorkflowComponent{
+				{ComponentID: "compute-1", Type: devicetypes.ComponentTypeCompute},
+			},
+			expectPowerCall: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			env.RegisterWorkflowWithOptions(genericComponentStepWorkflow, temporalworkflow.RegisterOptions{Name: nameGenericComponentStepWorkflow})
+			env.RegisterActivityWithOptions(mockPowerControl,
+				activity.RegisterOptions{Name: activitypkg.NamePowerControl})
+			env.RegisterActivityWithOptions(mockUpdateTaskStatus,
+				activity.RegisterOptions{Name: activitypkg.NameUpdateTaskStatus})
+			env.RegisterActivityWithOptions(mockGetCoolingProblems,
+				activity.RegisterOptions{Name: activitypkg.NameGetCoolingProblems})
+
+			env.OnActivity(activitypkg.NameUpdateTaskStatus, mock.Anything, mock.Anything).Return(nil)
+
+			powerCalls := 0
+			env.OnActivity(activitypkg.NamePowerControl, mock.Anything, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, info taskcommon.ComponentInfo, pcInfo *operations.PowerControlTaskInfo) error {
+					powerCalls++
+					return nil
+				},
+			)
+
+			polls := 0
+			env.OnActivity(activitypkg.NameGetCoolingProblems, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, target common.Target) (map[string][]string, error) {
+					assert.Equal(t, devicetypes.ComponentTypeCDU, target.Type)
+					polls++
+					if tc.problemsErr != nil {
+						return nil, tc.problemsErr
+					}
+					return tc.problems[min(polls, len(tc.problems))-1], nil
+				},
+			)
+
+			info := &operations.PowerControlTaskInfo{Operation: operations.PowerOperationPowerOn}
+			reqInfo := task.ExecutionInfo{
+				TaskID:         uuid.New(),
+				Components:     tc.components,
+				RuleDefinition: createCoolingGatedPowerOnRuleDef(),
+			}
+
+			env.ExecuteWorkflow(powerControl, reqInfo, info)
+
+			assert.True(t, env.IsWorkflowCompleted())
+			if tc.expectError != "" {
+				wfErr := env.GetWorkflowError()
+				if assert.Error(t, wfErr) {
+					assert.Contains(t, wfErr.Error(), tc.expectError)
+				}
+			} else {
+				assert.NoError(t, env.GetWorkflowError())
+			}
+			assert.Equal(t, tc.expectPowerCall, powerCalls > 0)
+			if tc.components[0].Type != devicetypes.ComponentTypeCDU {
+				assert.Zero(t, polls)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// runStepActionsPerComponent runs the step's actions against each component
+// of target on its own, max_parallel components at a time (0 = all at once).
+// It returns the IDs of the components that failed while the count stays
+// within the on_failure threshold, and an error once the threshold is
+// exceeded. No further batches start after that point.
+func runStepActionsPerComponent(
+	ctx workflow.Context,
+	step operationrules.SequenceStep,
+	target common.Target,
+	activityInfo any,
+	allTargets map[devicetypes.ComponentType]common.Target,
+) ([]string, error) {
+	total := len(target.ComponentIDs)
+	allowed := step.OnFailure.AllowedFailures(total)
+
+	batchSize := step.MaxParallel
+	if batchSize <= 0 || batchSize > total {
+		batchSize = total
+	}
+
+	componentErrs := make(map[string]error)
+	for start := 0; start < total && len(componentErrs) <= allowed; start += batchSize {
+		end := min(start+batchSize, total)
+
+		wg := workflow.NewWaitGroup(ctx)
+		for _, componentID := range target.ComponentIDs[start:end] {
+			wg.Add(1)
+			workflow.Go(ctx, func(gctx workflow.Context) {
+				defer wg.Done()
+				single := common.Target{
+					Type:         target.Type,
+					ComponentIDs: []string{componentID},
+				}
+				if err := runStepActions(gctx, step, single, activityInfo, allTargets); err != nil {
+					componentErrs[componentID] = err
+				}
+			})
+		}
+		wg.Wait(ctx)
+	}
+
+	// Report failures in the order the components were given so the result
+	// is deterministic across workflow replays.
+	failed := make([]string, 0, len(componentErrs))
+	errs := make([]error, 0, len(componentErrs))
+	for _, componentID := range target.ComponentIDs {
+		if err, ok := componentErrs[componentID]; ok {
+			failed = append(failed, componentID)
+			errs = append(errs, fmt.Errorf("component %s: %w", componentID, err))
+		}
+	}
+
+	if len(failed) > allowed {
+		return nil, fmt.Errorf(
+			"%d of %d components failed, more than the %d allowed: %w",
+			len(failed), total, allowed, errors.Join(errs...),
+		)
+	}
+
+	for i, componentID := range failed {
+		log.Warn().
+			Err(errs[i]).
+			Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
+			Str("component_id", componentID).
+			Msg("Component failed within on_failure threshold, dropping it from later stages")
+	}
+
+	return failed, nil
+}
+
+// compensateStep runs the step's compensating actions against all of its
+// components after the step failed with stepErr. The actions run on a
+// disconnected context so they still execute when the step was cancelled.
+// The returned error always wraps stepErr; compensation failures are joined.
+func compensateStep(
+	ctx workflow.Context,
+	step operationrules.SequenceStep,
+	target common.Target,
+	activityInfo any,
+	allTargets map[devicetypes.ComponentType]common.Target,
+	stepErr error,
+) error {
+	shouldDo, actions := step.DoCompensation()
+	if !shouldDo {
+		return stepErr
+	}
+
+	log.Warn().
+		Err(stepErr).
+		Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
+		Int("action_count", len(actions)).
+		Msg("Step failed, executing compensating actions")
+
+	compCtx, _ := workflow.NewDisconnectedContext(ctx)
+	compCtx = workflow.WithActivityOptions(compCtx, buildActivityOptions(step))
+	compCtx = withReportScope(compCtx, step.Stage, task.ReportStepCompensation)
+
+	if err := executeActionList(compCtx, actions, target, allTargets, activityInfo); err != nil {
+		return errors.Join(stepErr, fmt.Errorf("compensation failed: %w", err))
+	}
+
+	return fmt.Errorf("%w (compensated)", stepErr)
+}
@@ -0,0 +1,262 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	temporalworkflow "go.temporal.io/sdk/workflow"
+
+	activitypkg "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/component"
+)
+
+// powerCall records a single PowerControl activity invocation.
+type powerCall struct {
+	componentType devicetypes.ComponentType
+	componentIDs  []string
+	operation     operations.PowerOperation
+}
+
+// failurePolicyHarness runs power-control rules whose PowerControl activity
+// fails for a configurable set of components.
+type failurePolicyHarness struct {
+	env     *testsuite.TestWorkflowEnvironment
+	failing []string
+
+	mu    sync.Mutex
+	calls []powerCall
+}
+
+func newFailurePolicyHarness(failing ...string) *failurePolicyHarness {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	h := &failurePolicyHarness{
+		env:     testSuite.NewTestWorkflowEnvironment(),
+		failing: failing,
+	}
+
+	powerControl := func(
+		_ context.Context,
+		target common.Target,
+		info operations.PowerControlTaskInfo,
+	) error {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.calls = append(h.calls, powerCall{
+			componentType: target.Type,
+			componentIDs:  slices.Clone(target.ComponentIDs),
+			operation:     info.Operation,
+		})
+		if info.Operation == operations.PowerOperationPowerOff {
+			for _, id := range target.ComponentIDs {
+				if slices.Contains(h.failing, id) {
+					return errors.New("BMC unreachable")
+				}
+			}
+		}
+		return nil
+	}
+
+	h.env.RegisterWorkflowWithOptions(genericComponentStepWorkflow,
+		temporalworkflow.RegisterOptions{Name: nameGenericComponentStepWorkflow})
+	h.env.RegisterActivityWithOptions(powerControl,
+		activity.RegisterOptions{Name: activitypkg.NamePowerControl})
+	h.env.RegisterActivityWithOptions(mockUpdateTaskStatus,
+		activity.RegisterOptions{Name: activitypkg.NameUpdateTaskStatus})
+
+	return h
+}
+
+// run executes a power-off task for the given components under steps.
+func (h *failurePolicyHarness) run(
+	components []*component.Component,
+	steps ...operationrules.SequenceStep,
+) error {
+	reqInfo := taskdef.ExecutionInfo{
+		TaskID:     uuid.New(),
+		Components: toWorkflowComponents(components),
+		RuleDefinition: &operationrules.RuleDefinition{
+			Version: "v1",
+			Steps:   steps,
+		},
+	}
+	info := &operations.PowerControlTaskInfo{Operation: operations.PowerOperationPowerOff}
+
+	h.env.ExecuteWorkflow(powerControl, reqInfo, info)
+	if !h.env.IsWorkflowCompleted() {
+		return errors.New("workflow did not complete")
+	}
+	return h.env.GetWorkflowError()
+}
+
+// callsFor returns the PowerControl calls made for componentType and op.
+func (h *failurePolicyHarness) callsFor(
+	componentType devicetypes.ComponentType,
+	op operations.PowerOperation,
+) []powerCall {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var calls []powerCall
+	for _, c := range h.calls {
+		if c.componentType == componentType && c.operation == op {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func computeComponents(n int) []*component.Component {
+	components := make([]*component.Component, 0, n)
+	for i := 1; i <= n; i++ {
+		id := fmt.Sprintf("compute-%d", i)
+		components = append(components, newTestComponent(
+			uuid.New(), id, id, devicetypes.ComponentTypeCompute))
+	}
+	return components
+}
+
+// powerOffStep returns a single-attempt power-off step for componentType.
+func powerOffStep(
+	componentType devicetypes.ComponentType,
+	stage int,
+	onFailure *operationrules.FailurePolicy,
+) operationrules.SequenceStep {
+	return operationrules.SequenceStep{
+		ComponentType: componentType,
+		Stage:         stage,
+		Timeout:       5 * time.Minute,
+		RetryPolicy: &operationrules.RetryPolicy{
+			MaxAttempts:        1,
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 1,
+		},
+		MainOperation: operationrules.ActionConfig{Name: operationrules.ActionPowerControl},
+		OnFailure:     onFailure,
+	}
+}
+
+var powerOnCompensation = []operationrules.ActionConfig{
+	{
+		Name: operationrules.ActionPowerControl,
+		Parameters: map[string]any{
+			operationrules.ParamOperation: "power_on",
+		},
+	},
+}
+
+func TestFailurePolicy_WithinThresholdDropsFailedComponents(t *testing.T) {
+	h := newFailurePolicyHarness("compute-2")
+
+	first := powerOffStep(devicetypes.ComponentTypeCompute, 1,
+		&operationrules.FailurePolicy{MaxFailed: 1, Compensate: powerOnCompensation})
+	first.MaxParallel = 2
+	second := powerOffStep(devicetypes.ComponentTypeCompute, 2, nil)
+
+	err := h.run(computeComponents(4), first, second)
+	require.NoError(t, err)
+
+	// Stage 1 runs each component on its own; stage 2 only gets the three
+	// components that succeeded, all at once.
+	calls := h.callsFor(devicetypes.ComponentTypeCompute, operations.PowerOperationPowerOff)
+	require.Len(t, calls, 5)
+	for _, c := range calls[:4] {
+		assert.Len(t, c.componentIDs, 1)
+	}
+	assert.Equal(t, []string{"compute-1", "compute-3", "compute-4"}, calls[4].componentIDs)
+
+	// Within the threshold the step succeeded, so nothing is compensated.
+	assert.Empty(t, h.callsFor(devicetypes.ComponentTypeCompute, operations.PowerOperationPowerOn))
+}
+
+func TestFailurePolicy_ThresholdExceededRunsCompensation(t *testing.T) {
+	h := newFailurePolicyHarness("compute-1", "compute-2")
+
+	step := powerOffStep(devicetypes.ComponentTypeCompute, 1,
+		&operationrules.FailurePolicy{MaxFailedPercent: 25, Compensate: powerOnCompensation})
+	step.MaxParallel = 1
+	later := powerOffStep(devicetypes.ComponentTypePowerShelf, 2, nil)
+
+	components := append(computeComponents(4),
+		newTestComponent(uuid.New(), "ps-1", "ps-1", devicetypes.ComponentTypePowerShelf))
+	err := h.run(components, step, later)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 of 4 components failed, more than the 1 allowed")
+	assert.Contains(t, err.Error(), "compensated")
+
+	// Batches stop once the threshold is exceeded.
+	offCalls := h.callsFor(devicetypes.ComponentTypeCompute, operations.PowerOperationPowerOff)
+	assert.Len(t, offCalls, 2)
+
+	onCalls := h.callsFor(devicetypes.ComponentTypeCompute, operations.PowerOperationPowerOn)
+	require.Len(t, onCalls, 1)
+	assert.Equal(t, []string{"compute-1", "compute-2", "compute-3", "compute-4"}, onCalls[0].componentIDs)
+
+	// Without continue_on_error the later stage never runs.
+	assert.Empty(t, h.callsFor(devicetypes.ComponentTypePowerShelf, operations.PowerOperationPowerOff))
+}
+
+func TestFailurePolicy_ContinueOnErrorRunsLaterStages(t *testing.T) {
+	h := newFailurePolicyHarness("compute-1")
+
+	step := powerOffStep(devicetypes.ComponentTypeCompute, 1,
+		&operationrules.FailurePolicy{ContinueOnError: true})
+	computeAgain := powerOffStep(devicetypes.ComponentTypeCompute, 2, nil)
+	shelves := powerOffStep(devicetypes.ComponentTypePowerShelf, 2, nil)
+
+	components := append(computeComponents(2),
+		newTestComponent(uuid.New(), "ps-1", "ps-1", devicetypes.ComponentTypePowerShelf))
+	err := h.run(components, step, computeAgain, shelves)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "operation finished with failed steps")
+	assert.Contains(t, err.Error(), "stage 1: component type Compute failed")
+
+	// The failed compute step is dropped from stage 2; power shelves still run.
+	assert.Len(t, h.callsFor(devicetypes.ComponentTypeCompute, operations.PowerOperationPowerOff), 1)
+	assert.Len(t, h.callsFor(devicetypes.ComponentTypePowerShelf, operations.PowerOperationPowerOff), 1)
+}
+
+func TestChildWorkflowExecutionTimeout_FailurePolicy(t *testing.T) {
+	step := powerOffStep(devicetypes.ComponentTypeCompute, 1, nil)
+	step.MaxParallel = 2
+	base := childWorkflowExecutionTimeout(step, 8)
+	assert.Equal(t, 7*time.Minute, base)
+
+	// Thresholds run max_parallel components per batch: four batches.
+	step.OnFailure = &operationrules.FailurePolicy{MaxFailed: 1}
+	assert.Equal(t, 4*5*time.Minute+2*time.Minute, childWorkflowExecutionTimeout(step, 8))
+
+	// Compensating actions without a timeout get the step timeout.
+	step.OnFailure = &operationrules.FailurePolicy{Compensate: powerOnCompensation}
+	assert.Equal(t, base+5*time.Minute, childWorkflowExecutionTimeout(step, 8))
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
@@ -42,17 +43,44 @@ func init() {
 	})
 }
 
+// componentStepResult is returned by genericComponentStepWorkflow when the
+// step succeeds. FailedComponentIDs lists the components that failed within
+// the step's on_failure threshold; the parent drops them from later stages.
+type componentStepResult struct {
+	FailedComponentIDs []string
+}
+
 // genericComponentStepWorkflow is a child workflow that handles any operation
 // for a single component type. It processes components in batches according to
 // the step's max_parallel setting, providing isolation and independent lifecycle
-// per component type.
+// per component type. When the step fails its compensating actions run before
+// the error is returned.
 func genericComponentStepWorkflow(
 	ctx workflow.Context,
 	step operationrules.SequenceStep,
 	target common.Target,
 	activityInfo any,
 	allTargets map[devicetypes.ComponentType]common.Target,
-) error {
+) (*componentStepResult, error) {
+	result, err := runComponentStep(ctx, step, target, activityInfo, allTargets)
+	if err != nil {
+		err = compensateStep(ctx, step, target, activityInfo, allTargets, err)
+		return nil, preserveApprovalAbort(err)
+	}
+
+	return result, nil
+}
+
+// runComponentStep runs the pre-operation, main and post-operation actions of
+// step against target. With an on_failure threshold every component runs the
+// actions on its own so failures can be counted per component.
+func runComponentStep(
+	ctx workflow.Context,
+	step operationrules.SequenceStep,
+	target common.Target,
+	activityInfo any,
+	allTargets map[devicetypes.ComponentType]common.Target,
+) (*componentStepResult, error) {
 	log.Info().
 		Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
 		Int("component_count", len(target.ComponentIDs)).
@@ -63,12 +91,54 @@ func genericComponentStepWorkflow(
 	activityOpts := buildActivityOptions(step)
 	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 
+	result := &componentStepResult{}
+	if step.OnFailure.ToleratesFailures() {
+		failed, err := runStepActionsPerComponent(
+			ctx, step, target, activityInfo, allTargets,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.FailedComponentIDs = failed
+	} else if err := runStepActions(ctx, step, target, activityInfo, allTargets); err != nil {
+		return nil, err
+	}
+
+	// Apply delay_after (legacy field, after all actions complete)
+	if step.DelayAfter > 0 {
+		log.Info().
+			Dur("delay", step.DelayAfter).
+			Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
+			Msg("Applying delay after step (legacy)")
+		if err := workflow.Sleep(ctx, step.DelayAfter); err != nil {
+			return nil, fmt.Errorf("delay_after sleep interrupted: %w", err)
+		}
+	}
+
+	log.Info().
+		Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
+		Int("failed_count", len(result.FailedComponentIDs)).
+		Msg("Component step workflow completed successfully")
+
+	return result, nil
+}
+
+// runStepActions executes the pre-operation, main and post-operation actions
+// of step against target.
+func runStepActions(
+	ctx workflow.Context,
+	step operationrules.SequenceStep,
+	target common.Target,
+	activityInfo any,
+	allTargets map[devicetypes.ComponentType]common.Target,
+) error {
 	// 1. Execute pre-operation actions
 	if shouldDo, actions := step.DoPreOperations(); shouldDo {
 		log.Debug().
 			Int("action_count", len(actions)).
 			Msg("Executing pre-operation actions")
-		if err := executeActionList(ctx, actions, target, allTargets, activityInfo); err != nil {
+		pctx := withReportScope(ctx, step.Stage, task.ReportStepPreOperation)
+		if err := executeActionList(pctx, actions, target, allTargets, activityInfo); err != nil {
 			return fmt.Errorf("pre-operation failed: %w", err)
 		}
 	}
@@ -78,7 +148,8 @@ func genericComponentStepWorkflow(
 		log.Debug().
 			Str("action", action.Name).
 			Msg("Executing main operation action")
-		if err := executeAction(ctx, action, target, allTargets, activityInfo); err != nil {
+		mctx := withReportScope(ctx, step.Stage, task.ReportStepMainOperation)
+		if err := executeAction(mctx, action, target, allTargets, activityInfo); err != nil {
 			return fmt.Errorf("main operation failed: %w", err)
 		}
 	} else {
@@ -90,25 +161,11 @@ func genericComponentStepWorkflow(
 		log.Debug().
 			Int("action_count", len(actions)).
 			Msg("Executing post-operation actions")
-		if err := executeActionList(ctx, actions, target, allTargets, activityInfo); err != nil {
+		pctx := withReportScope(ctx, step.Stage, task.ReportStepPostOperation)
+		if err := executeActionList(pctx, actions, target, allTargets, activityInfo); err != nil {
 			return fmt.Errorf("post-operation failed: %w", err)
 		}
 	}
 
-	// Apply delay_after (legacy field, after all actions complete)
-	if step.DelayAfter > 0 {
-		log.Info().
-			Dur("delay", step.DelayAfter).
-			Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
-			Msg("Applying delay after step (legacy)")
-		if err := workflow.Sleep(ctx, step.DelayAfter); err != nil {
-			return fmt.Errorf("delay_after sleep interrupted: %w", err)
-		}
-	}
-
-	log.Info().
-		Str("component_type", devicetypes.ComponentTypeToString(step.ComponentType)).
-		Msg("Component step workflow completed successfully")
-
 	return nil
 }
@@ -21,6 +21,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -62,10 +64,11 @@ func updateRunningTaskStatus(
 	return workflow.ExecuteActivity(ctx, activity.NameUpdateTaskStatus, arg).Get(ctx, nil)
 }
 
-// updateFinishedTaskStatus records the terminal task status (Completed or Failed)
-// via the UpdateTaskStatus activity. If both the operation error and the status
-// update fail, the errors are joined. The operation error is always returned so
-// the workflow reflects the correct failure cause.
+// updateFinishedTaskStatus records the terminal task status (Completed or Failed,
+// or Terminated when an approval gate was aborted) via the UpdateTaskStatus
+// activity. If both the operation error and the status update fail, the errors
+// are joined. The operation error is always returned so the workflow reflects
+// the correct failure cause.
 func updateFinishedTaskStatus(
 	ctx workflow.Context,
 	taskID uuid.UUID,
@@ -76,21 +79,33 @@ func updateFinishedTaskStatus(
 	}
 
 	var arg *task.TaskStatusUpdate
+	var outcome task.ReportOutcome
 
-	if err != nil {
+	if isApprovalAborted(err) {
+		arg = &task.TaskStatusUpdate{
+			ID:      taskID,
+			Status:  taskcommon.TaskStatusTerminated,
+			Message: err.Error(),
+		}
+		outcome = task.ReportOutcomeTerminated
+	} else if err != nil {
 		arg = &task.TaskStatusUpdate{
 			ID:      taskID,
 			Status:  taskcommon.TaskStatusFailed,
 			Message: err.Error(),
 		}
+		outcome = task.ReportOutcomeFailed
 	} else {
 		arg = &task.TaskStatusUpdate{
 			ID:      taskID,
 			Status:  taskcommon.TaskStatusCompleted,
 			Message: "Completed successfully",
 		}
+		outcome = task.ReportOutcomeSucceeded
 	}
 
+	recordTaskOutcomeReport(ctx, taskID, outcome, arg.Message)
+
 	if lerr := workflow.ExecuteActivity(ctx, activity.NameUpdateTaskStatus, arg).Get(ctx, nil); lerr != nil { //nolint
 		return errors.Join(err, fmt.Errorf("failed to update task status: %w", lerr))
 	}
@@ -132,7 +147,7 @@ func buildTargets(
 // buildActivityOptions constructs activity options from a sequence step
 func buildActivityOptions(step operationrules.SequenceStep) workflow.ActivityOptions {
 	opts := workflow.ActivityOptions{
-		StartToCloseTimeout: 20 * time.Minute, // Default timeout
+		StartToCloseTimeout: operationrules.DefaultActionTimeout,
 	}
 
 	// Override timeout if specified in step
@@ -176,15 +191,21 @@ func buildActivityOptions(step operationrules.SequenceStep) workflow.ActivityOpt
 type childWorkflowEntry struct {
 	future        workflow.ChildWorkflowFuture
 	componentType devicetypes.ComponentType
+	onFailure     *operationrules.FailurePolicy
 }
 
 // childWorkflowExecutionTimeout returns a child workflow execution timeout that
 // accommodates the full retry budget for activities, the pre/post operation
-// durations, and a fixed scheduling buffer.
+// durations, any compensating actions, and a fixed scheduling buffer.
 //
 // The child workflow runs: pre-ops → main-op (with retries) → post-ops
-// sequentially, so the budget must cover all three phases.
-func childWorkflowExecutionTimeout(step operationrules.SequenceStep) time.Duration {
+// sequentially, so the budget must cover all three phases. With an on_failure
+// threshold and max_parallel set, components run in batches and each batch
+// needs the full budget.
+func childWorkflowExecutionTimeout(
+	step operationrules.SequenceStep,
+	componentCount int,
+) time.Duration {
 	base := step.Timeout
 	if base == 0 {
 		base = 30 * time.Minute
@@ -214,19 +235,49 @@ func childWorkflowExecutionTimeout(step operationrules.SequenceStep) time.Durati
 	for _, a := range step.PostOperation {
 		actionBudget += a.Timeout
 	}
+	// An approval gate used as the main operation waits for its own timeout
+	// rather than the step timeout.
+	if step.MainOperation.Name == operationrules.ActionAwaitApproval {
+		actionBudget += step.MainOperation.Timeout
+	}
+
+	batches := 1
+	if step.OnFailure.ToleratesFailures() && step.MaxParallel > 0 && componentCount > 0 {
+		batches = (componentCount + step.MaxParallel - 1) / step.MaxParallel
+	}
 
-	return mainBudget + actionBudget + 2*time.Minute
+	// Compensating actions run once after the step fails. Actions without a
+	// timeout (PowerControl, FirmwareControl) are bounded by the step timeout.
+	var compensationBudget time.Duration
+	if _, actions := step.DoCompensation(); len(actions) > 0 {
+		for _, a := range actions {
+			if a.Timeout > 0 {
+				compensationBudget += a.Timeout
+			} else {
+				compensationBudget += base
+			}
+		}
+	}
+
+	return (mainBudget+actionBudget)*time.Duration(batches) +
+		compensationBudget + 2*time.Minute
 }
 
 // executeGenericStageParallel executes all steps in a stage concurrently for any operation type.
 // Each component type in the stage runs as a child workflow (cross-type parallelism).
 // Within each type, components are batched according to the step's max_parallel setting.
+//
+// Once every child has finished, typeToTargets is updated for later stages:
+// components that failed within a step's on_failure threshold are removed, as
+// is the whole component type of a failed step with continue_on_error. The
+// failures of such steps are returned as continued; any other failure is
+// returned as err.
 func executeGenericStageParallel(
 	ctx workflow.Context,
 	steps []operationrules.SequenceStep,
 	typeToTargets map[devicetypes.ComponentType]common.Target,
 	activityInfo any,
-) error {
+) (continued []error, err error) {
 	// Launch a child workflow for each component type that has targets.
 	// Pair each future with its component type so error attribution is always
 	// correct even when some steps are skipped (skipped steps shrink the
@@ -249,11 +300,11 @@ func executeGenericStageParallel(
 			Msg("Starting component step as child workflow")
 
 		childOptions := workflow.ChildWorkflowOptions{
-			WorkflowID: fmt.Sprintf("component-step-%s-%s",
-				workflow.GetInfo(ctx).WorkflowExecution.ID,
-				devicetypes.ComponentTypeToString(step.ComponentType)),
+			WorkflowID: ComponentStepWorkflowID(
+				workflow.GetInfo(ctx).WorkflowExecution.ID, step.ComponentType),
 			// Give the child workflow enough time to run all retry attempts.
-			WorkflowExecutionTimeout: childWorkflowExecutionTimeout(step),
+			WorkflowExecutionTimeout: childWorkflowExecutionTimeout(
+				step, len(target.ComponentIDs)),
 		}
 		childCtx := workflow.WithChildOptions(ctx, childOptions)
 
@@ -268,22 +319,58 @@ func executeGenericStageParallel(
 		futures = append(futures, childWorkflowEntry{
 			future:        future,
 			componentType: step.ComponentType,
+			onFailure:     step.OnFailure,
 		})
 	}
 
-	// Wait for all child workflows and attribute any error to the correct type.
+	// Wait for all child workflows and attribute any error to the correct
+	// type. Every child is awaited, so a failure in one type never cuts the
+	// compensating actions of another short.
+	var failures []error
 	for _, entry := range futures {
-		if err := entry.future.Get(ctx, nil); err != nil {
-			return fmt.Errorf("component type %s failed: %w",
-				devicetypes.ComponentTypeToString(entry.componentType), err)
+		componentStr := devicetypes.ComponentTypeToString(entry.componentType)
+
+		var result componentStepResult
+		if cerr := entry.future.Get(ctx, &result); cerr != nil {
+			cerr = fmt.Errorf("component type %s failed: %w", componentStr, cerr)
+			if entry.onFailure.ContinuesOnError() {
+				log.Warn().
+					Err(cerr).
+					Str("component_type", componentStr).
+					Msg("Component step failed, continuing with remaining stages")
+				delete(typeToTargets, entry.componentType)
+				continued = append(continued, cerr)
+			} else {
+				failures = append(failures, cerr)
+			}
+			continue
+		}
+
+		if len(result.FailedComponentIDs) > 0 {
+			typeToTargets[entry.componentType] = withoutComponents(
+				typeToTargets[entry.componentType], result.FailedComponentIDs,
+			)
 		}
 
 		log.Info().
-			Str("component_type", devicetypes.ComponentTypeToString(entry.componentType)).
+			Str("component_type", componentStr).
+			Int("failed_count", len(result.FailedComponentIDs)).
 			Msg("Component step completed successfully")
 	}
 
-	return nil
+	return continued, errors.Join(failures...)
+}
+
+// withoutComponents returns a copy of target without the given component IDs.
+func withoutComponents(target common.Target, componentIDs []string) common.Target {
+	remaining := make([]string, 0, len(target.ComponentIDs))
+	for _, id := range target.ComponentIDs {
+		if !slices.Contains(componentIDs, id) {
+			remaining = append(remaining, id)
+		}
+	}
+
+	return common.Target{Type: target.Type, ComponentIDs: remaining}
 }
 
 // parseDurationParam extracts a duration from a parameter value.
@@ -306,7 +393,9 @@ func parseDurationParam(val any) time.Duration {
 
 // executeRuleBasedOperation drives any operation through its RuleDefinition.
 // Stages execute sequentially; steps within a stage execute in parallel via
-// child workflows.
+// child workflows. A failed step stops the operation unless its on_failure
+// policy sets continue_on_error, in which case the remaining stages run and
+// the operation fails afterwards.
 func executeRuleBasedOperation(
 	ctx workflow.Context,
 	typeToTargets map[devicetypes.ComponentType]common.Target,
@@ -327,6 +416,12 @@ func executeRuleBasedOperation(
 		Int("step_count", len(ruleDef.Steps)).
 		Msg("Executing operation with rule definition")
 
+	// Failed components are dropped from later stages; work on a copy so the
+	// caller's targets are left untouched.
+	typeToTargets = maps.Clone(typeToTargets)
+
+	var continued []error
+
 	iter := operationrules.NewStageIterator(ruleDef)
 	for stage := iter.Next(); stage != nil; stage = iter.Next() {
 		log.Info().
@@ -334,22 +429,34 @@ func executeRuleBasedOperation(
 			Int("step_count", len(stage.Steps)).
 			Msg("Executing stage")
 
-		if err := executeGenericStageParallel(
+		stageContinued, err := executeGenericStageParallel(
 			ctx,
 			stage.Steps,
 			typeToTargets,
 			operationInfo,
-		); err != nil {
+		)
+		for _, cerr := range stageContinued {
+			continued = append(continued, fmt.Errorf("stage %d: %w", stage.Number, cerr))
+		}
+		if err != nil {
 			log.Error().
 				Err(err).
 				Int("stage", stage.Number).
 				Msg("Stage execution failed")
-			return fmt.Errorf("stage %d failed: %w", stage.Number, err)
+			stageErr := fmt.Errorf("stage %d failed: %w", stage.Number, err)
+			return errors.Join(append([]error{stageErr}, continued...)...)
 		}
 
 		log.Info().
 			Int("stage", stage.Number).
-			Msg("Stage completed successfully")
+			Int("continued_failures", len(stageContinued)).
+			Msg("Stage completed")
+	}
+
+	if len(continued) > 0 {
+		return fmt.Errorf(
+			"operation finished with failed steps: %w", errors.Join(continued...),
+		)
 	}
 
 	log.Info().Msg("Rule-based operation completed successfully")
@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+)
+
+// reportTaskAction is the action of the report entry recording how the task
+// as a whole finished.
+const reportTaskAction = "Task"
+
+var recordTaskReportActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: 30 * time.Second,
+	RetryPolicy: &temporal.RetryPolicy{
+		MaximumAttempts:    3,
+		InitialInterval:    1 * time.Second,
+		MaximumInterval:    10 * time.Second,
+		BackoffCoefficient: 2,
+	},
+}
+
+// reportScopeKey is the workflow context key of the reportScope.
+type reportScopeKey struct{}
+
+// reportScope is the point of the operation rule that actions run at, as
+// recorded in report entries.
+type reportScope struct {
+	stage int
+	step  string
+}
+
+// withReportScope returns a context whose actions are reported as running in
+// the given stage and step.
+func withReportScope(ctx workflow.Context, stage int, step string) workflow.Context {
+	return workflow.WithValue(ctx, reportScopeKey{}, reportScope{stage: stage, step: step})
+}
+
+// reportTaskID returns the ID of the task the workflow runs for. The task
+// workflow is started with the task ID as its workflow ID, and component
+// steps run as its children. ok is false when the workflow does not belong to
+// a task, for example in tests.
+func reportTaskID(ctx workflow.Context) (uuid.UUID, bool) {
+	info := workflow.GetInfo(ctx)
+
+	workflowID := info.WorkflowExecution.ID
+	if info.ParentWorkflowExecution != nil {
+		workflowID = info.ParentWorkflowExecution.ID
+	}
+
+	taskID, err := uuid.Parse(workflowID)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return taskID, true
+}
+
+// recordTaskReport appends entries to the report of taskID. Reports are best
+// effort: a failure is logged and never fails the operation.
+func recordTaskReport(
+	ctx workflow.Context,
+	taskID uuid.UUID,
+	entries []task.ReportEntry,
+) {
+	if taskID == uuid.Nil || len(entries) == 0 {
+		return
+	}
+
+	actx := workflow.WithActivityOptions(ctx, recordTaskReportActivityOptions)
+	if err := workflow.ExecuteActivity(actx, activity.NameRecordTaskReport, taskID, entries).Get(actx, nil); err != nil {
+		log.Warn().
+			Err(err).
+			Str("task_id", taskID.String()).
+			Int("entry_count", len(entries)).
+			Msg("Failed to record task report")
+	}
+}
+
+// recordActionReport records the outcome of an action that started at
+// startedAt with one report entry per target component.
+func recordActionReport(
+	ctx workflow.Context,
+	config operationrules.ActionConfig,
+	target common.Target,
+	startedAt time.Time,
+	err error,
+) {
+	taskID, ok := reportTaskID(ctx)
+	if !ok {
+		return
+	}
+
+	scope, _ := ctx.Value(reportScopeKey{}).(reportScope)
+
+	now := workflow.Now(ctx)
+	outcome := task.ReportOutcomeSucceeded
+	message := "completed in " + now.Sub(startedAt).Round(time.Second).String()
+	if err != nil {
+		outcome = task.ReportOutcomeFailed
+		message = err.Error()
+	}
+
+	entries := make([]task.ReportEntry, 0, len(target.ComponentIDs))
+	for _, componentID := range target.ComponentIDs {
+		entries = append(entries, task.ReportEntry{
+			Time:          now,
+			ComponentType: target.Type,
+			ComponentID:   componentID,
+			Stage:         scope.stage,
+			Step:          scope.step,
+			Action:        config.Name,
+			Outcome:       outcome,
+			Message:       message,
+		})
+	}
+
+	recordTaskReport(ctx, taskID, entries)
+}
+
+// recordTaskOutcomeReport records how the task finished, with the same
+// status and message as the task itself.
+func recordTaskOutcomeReport(
+	ctx workflow.Context,
+	taskID uuid.UUID,
+	outcome task.ReportOutcome,
+	message string,
+) {
+	recordTaskReport(ctx, taskID, []task.ReportEntry{{
+		Time:    workflow.Now(ctx),
+		Action:  reportTaskAction,
+		Outcome: outcome,
+		Message: message,
+	}})
+}
@@ -0,0 +1,99 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+
+	activitypkg "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+func TestTaskReport_RecordsActionsAndOutcome(t *testing.T) {
+	h := newFailurePolicyHarness("compute-2")
+	taskID := uuid.New()
+
+	var mu sync.Mutex
+	var entries []taskdef.ReportEntry
+	recordTaskReport := func(_ context.Context, id uuid.UUID, recorded []taskdef.ReportEntry) error {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, taskID, id)
+		entries = append(entries, recorded...)
+		return nil
+	}
+	h.env.RegisterActivityWithOptions(recordTaskReport,
+		activity.RegisterOptions{Name: activitypkg.NameRecordTaskReport})
+
+	// The task workflow runs under the task ID, which is how the report
+	// finds the task from inside the component step workflows.
+	h.env.SetStartWorkflowOptions(client.StartWorkflowOptions{ID: taskID.String()})
+
+	step := powerOffStep(devicetypes.ComponentTypeCompute, 1,
+		&operationrules.FailurePolicy{Compensate: powerOnCompensation})
+	reqInfo := taskdef.ExecutionInfo{
+		TaskID:     taskID,
+		Components: toWorkflowComponents(computeComponents(2)),
+		RuleDefinition: &operationrules.RuleDefinition{
+			Version: "v1",
+			Steps:   []operationrules.SequenceStep{step},
+		},
+	}
+	info := &operations.PowerControlTaskInfo{Operation: operations.PowerOperationPowerOff}
+
+	h.env.ExecuteWorkflow(powerControl, reqInfo, info)
+	require.True(t, h.env.IsWorkflowCompleted())
+	require.Error(t, h.env.GetWorkflowError())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, entries, 5)
+
+	for i, componentID := range []string{"compute-1", "compute-2"} {
+		main := entries[i]
+		assert.Equal(t, devicetypes.ComponentTypeCompute, main.ComponentType)
+		assert.Equal(t, componentID, main.ComponentID)
+		assert.Equal(t, 1, main.Stage)
+		assert.Equal(t, taskdef.ReportStepMainOperation, main.Step)
+		assert.Equal(t, operationrules.ActionPowerControl, main.Action)
+		assert.Equal(t, taskdef.ReportOutcomeFailed, main.Outcome)
+		assert.Contains(t, main.Message, "BMC unreachable")
+
+		compensation := entries[2+i]
+		assert.Equal(t, componentID, compensation.ComponentID)
+		assert.Equal(t, taskdef.ReportStepCompensation, compensation.Step)
+		assert.Equal(t, taskdef.ReportOutcomeSucceeded, compensation.Outcome)
+	}
+
+	outcome := entries[4]
+	assert.Empty(t, outcome.ComponentID)
+	assert.Equal(t, reportTaskAction, outcome.Action)
+	assert.Equal(t, taskdef.ReportOutcomeFailed, outcome.Outcome)
+	assert.Contains(t, outcome.Message, "compensated")
+}
@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor"
+	taskstore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/store"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+)
+
+// approvalTaskStore serves a single task; every other Store method panics.
+type approvalTaskStore struct {
+	taskstore.Store
+	task *taskdef.Task
+}
+
+func (s *approvalTaskStore) GetTask(_ context.Context, _ uuid.UUID) (*taskdef.Task, error) {
+	return s.task, nil
+}
+
+// signalRecorder records SignalApproval calls; every other Executor method
+// panics.
+type signalRecorder struct {
+	executor.Executor
+	executionID string
+	step        string
+	signal      *taskdef.ApprovalSignal
+}
+
+func (e *signalRecorder) SignalApproval(
+	_ context.Context,
+	executionID string,
+	step string,
+	signal *taskdef.ApprovalSignal,
+) error {
+	e.executionID = executionID
+	e.step = step
+	e.signal = signal
+	return nil
+}
+
+func newApprovalTask(approvals ...taskcommon.Approval) *taskdef.Task {
+	return &taskdef.Task{
+		ID:          uuid.New(),
+		Status:      taskcommon.TaskStatusWaitingApproval,
+		ExecutionID: "exec-1",
+		Attributes:  taskcommon.TaskAttributes{Approvals: approvals},
+	}
+}
+
+func TestDecideApproval_SinglePendingGate(t *testing.T) {
+	task := newApprovalTask(
+		taskcommon.Approval{ID: "done", Step: "PowerShelf", Decision: taskcommon.ApprovalDecisionApprove},
+		taskcommon.Approval{ID: "gate", Step: "NVLSwitch"},
+	)
+	exec := &signalRecorder{}
+	m := &ManagerImpl{taskStore: &approvalTaskStore{task: task}, executor: exec}
+
+	err := m.DecideApproval(context.Background(), task.ID, &taskdef.ApprovalSignal{
+		Decision: taskcommon.ApprovalDecisionApprove,
+		Approver: "alice",
+		Comment:  "looks good",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "exec-1", exec.executionID)
+	assert.Equal(t, "NVLSwitch", exec.step)
+	require.NotNil(t, exec.signal)
+	assert.Equal(t, "gate", exec.signal.ApprovalID, "the only pending gate is chosen")
+	assert.Equal(t, "alice", exec.signal.Approver)
+}
+
+func TestDecideApproval_Errors(t *testing.T) {
+	approve := func(id string) *taskdef.ApprovalSignal {
+		return &taskdef.ApprovalSignal{
+			ApprovalID: id,
+			Decision:   taskcommon.ApprovalDecisionApprove,
+			Approver:   "alice",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		task   *taskdef.Task
+		signal *taskdef.ApprovalSignal
+		errMsg string
+	}{
+		{
+			name:   "not waiting",
+			task:   &taskdef.Task{ID: uuid.New(), Status: taskcommon.TaskStatusRunning},
+			signal: approve(""),
+			errMsg: "not waiting for approval",
+		},
+		{
+			name: "ambiguous gate",
+			task: newApprovalTask(
+				taskcommon.Approval{ID: "a", Step: "NVLSwitch"},
+				taskcommon.Approval{ID: "b", Step: "Compute"},
+			),
+			signal: approve(""),
+			errMsg: "approval ID is required",
+		},
+		{
+			name:   "unknown gate",
+			task:   newApprovalTask(taskcommon.Approval{ID: "a", Step: "NVLSwitch"}),
+			signal: approve("b"),
+			errMsg: "no pending approval b",
+		},
+		{
+			name: "missing approver",
+			task: newApprovalTask(taskcommon.Approval{ID: "a", Step: "NVLSwitch"}),
+			signal: &taskdef.ApprovalSignal{
+				Decision: taskcommon.ApprovalDecisionApprove,
+			},
+			errMsg: "approver is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &signalRecorder{}
+			m := &ManagerImpl{taskStore: &approvalTaskStore{task: tt.task}, executor: exec}
+
+			err := m.DecideApproval(context.Background(), tt.task.ID, tt.signal)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+			assert.Nil(t, exec.signal, "nothing must be signalled")
+		})
+	}
+}
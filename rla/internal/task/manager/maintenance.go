@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/maintenance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+)
+
+// errClosedForMaintenance marks the rejection of a disruptive task by the
+// maintenance calendar.
+var errClosedForMaintenance = errors.New("closed for maintenance")
+
+// MaintenanceCalendar decides when disruptive operations may run on a rack.
+type MaintenanceCalendar interface {
+	// IsDisruptive reports whether op is subject to the calendar.
+	IsDisruptive(op operation.Wrapper) bool
+
+	// RackStatus returns the maintenance status of a rack at the given
+	// time.
+	RackStatus(ctx context.Context, rackID uuid.UUID, at time.Time) (*maintenance.Status, error)
+
+	// RecordOverride records one use of a maintenance override.
+	RecordOverride(ctx context.Context, o *maintenance.Override) error
+}
+
+// maintenanceGate is the calendar's verdict on one rack of a submission that
+// the calendar does not reject.
+type maintenanceGate struct {
+	// deferTo is the opening a deferred task waits for. Nil when the task
+	// may start now.
+	deferTo *maintenance.Opening
+
+	// closesAt is when the current opening ends. A task that may start
+	// now but is queued behind a conflict expires at this time rather
+	// than start outside the opening. Zero means no limit.
+	closesAt time.Time
+
+	// reason says why the rack is closed. Set for deferred and overridden
+	// tasks.
+	reason string
+
+	// overridden is set when a maintenance override lets the task start
+	// on a closed rack.
+	overridden bool
+}
+
+// checkMaintenance consults the maintenance calendar for req on one rack at
+// now. It returns an error when the task must be rejected and a nil gate when
+// the calendar does not apply.
+func (m *ManagerImpl) checkMaintenance(
+	ctx context.Context,
+	req *operation.Request,
+	rackID uuid.UUID,
+	now time.Time,
+) (*maintenanceGate, error) {
+	if m.maintenanceCalendar == nil || !m.maintenanceCalendar.IsDisruptive(req.Operation) {
+		return nil, nil
+	}
+
+	status, err := m.maintenanceCalendar.RackStatus(ctx, rackID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check maintenance calendar for rack %s: %w", rackID, err)
+	}
+
+	if status.Open {
+		return &maintenanceGate{closesAt: status.Opening.End}, nil
+	}
+
+	if req.MaintenanceOverride != nil {
+		return &maintenanceGate{reason: status.Reason, overridden: true}, nil
+	}
+
+	if m.maintenancePolicy(req) != operation.MaintenancePolicyDefer {
+		return nil, fmt.Errorf("rack %s is %w: %s%s",
+			rackID, errClosedForMaintenance, status.Reason, nextOpeningSuffix(status.Opening))
+	}
+
+	if status.Opening == nil {
+		return nil, fmt.Errorf("rack %s is %w: %s, and no window opens within a year",
+			rackID, errClosedForMaintenance, status.Reason)
+	}
+
+	return &maintenanceGate{deferTo: status.Opening, reason: status.Reason}, nil
+}
+
+// maintenancePolicy resolves the default policy to the configured one.
+func (m *ManagerImpl) maintenancePolicy(req *operation.Request) operation.MaintenancePolicy {
+	if req.MaintenancePolicy != operation.MaintenancePolicyDefault {
+		return req.MaintenancePolicy
+	}
+
+	if m.deferOutsideMaintenance {
+		return operation.MaintenancePolicyDefer
+	}
+
+	return operation.MaintenancePolicyReject
+}
+
+// deferTask makes task wait for the opening in gate. The task expires when
+// the opening closes, or after the queue timeout if it never does.
+func (m *ManagerImpl) deferTask(
+	task *taskdef.Task,
+	req *operation.Request,
+	gate *maintenanceGate,
+) {
+	start := gate.deferTo.Start
+	expiresAt := gate.deferTo.End
+	if expiresAt.IsZero() {
+		expiresAt = start.Add(m.queueTimeout(req))
+	}
+
+	task.Status = taskcommon.TaskStatusWaiting
+	task.Message = fmt.Sprintf("Deferred: %s; waiting for maintenance opening at %s",
+		gate.reason, start.UTC().Format(time.RFC3339))
+	task.NotBefore = &start
+	task.QueueExpiresAt = &expiresAt
+}
+
+// recordOverride audits a task that starts on a closed rack because of a
+// maintenance override. It runs before the task is created so that an
+// override that cannot be audited is not used.
+func (m *ManagerImpl) recordOverride(
+	ctx context.Context,
+	task *taskdef.Task,
+	req *operation.Request,
+	gate *maintenanceGate,
+) error {
+	if gate == nil || !gate.overridden {
+		return nil
+	}
+
+	override := &maintenance.Override{
+		TaskID:      task.ID,
+		RackID:      task.RackID,
+		Operation:   task.Operation,
+		Reason:      req.MaintenanceOverride.Reason,
+		RequestedBy: req.MaintenanceOverride.RequestedBy,
+		Bypassed:    gate.reason,
+	}
+
+	if err := m.maintenanceCalendar.RecordOverride(ctx, override); err != nil {
+		return fmt.Errorf("failed to record maintenance override: %w", err)
+	}
+
+	log.Warn().
+		Str("task_id", task.ID.String()).
+		Str("rack_id", task.RackID.String()).
+		Str("bypassed", gate.reason).
+		Str("reason", override.Reason).
+		Str("requested_by", override.RequestedBy).
+		Msg("maintenance calendar overridden")
+
+	return nil
+}
+
+func nextOpeningSuffix(opening *maintenance.Opening) string {
+	if opening == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(" (next opening at %s)", opening.Start.UTC().Format(time.RFC3339))
+}
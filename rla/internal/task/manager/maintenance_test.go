@@ -0,0 +1,239 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/maintenance"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/conflict"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+)
+
+// fakeCalendar reports the same status for every rack and records overrides.
+type fakeCalendar struct {
+	status    *maintenance.Status
+	overrides []*maintenance.Override
+	recordErr error
+}
+
+func (c *fakeCalendar) IsDisruptive(op operation.Wrapper) bool {
+	return maintenance.IsDisruptive(op)
+}
+
+func (c *fakeCalendar) RackStatus(
+	_ context.Context, _ uuid.UUID, _ time.Time,
+) (*maintenance.Status, error) {
+	return c.status, nil
+}
+
+func (c *fakeCalendar) RecordOverride(_ context.Context, o *maintenance.Override) error {
+	if c.recordErr != nil {
+		return c.recordErr
+	}
+	c.overrides = append(c.overrides, o)
+	return nil
+}
+
+// deferTaskStore records created tasks on top of planTaskStore.
+type deferTaskStore struct {
+	planTaskStore
+	created []*taskdef.Task
+}
+
+func (s *deferTaskStore) RunInTransaction(
+	ctx context.Context, fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
+func (s *deferTaskStore) CreateTask(_ context.Context, task *taskdef.Task) error {
+	s.created = append(s.created, task)
+	return nil
+}
+
+var (
+	maintenanceNow  = time.Date(2026, time.November, 6, 12, 0, 0, 0, time.UTC)
+	windowStart     = time.Date(2026, time.November, 7, 2, 0, 0, 0, time.UTC)
+	windowEnd       = time.Date(2026, time.November, 7, 6, 0, 0, 0, time.UTC)
+	closedForWindow = &maintenance.Status{
+		Reason:     "outside maintenance windows",
+		Opening:    &maintenance.Opening{Start: windowStart, End: windowEnd},
+		Restricted: true,
+	}
+)
+
+func powerOffRequest() *operation.Request {
+	return &operation.Request{
+		Operation: operation.Wrapper{
+			Type: taskcommon.TaskTypePowerControl,
+			Code: operationrules.SequencePowerOff,
+			Info: []byte(`{}`),
+		},
+	}
+}
+
+func TestCheckMaintenance(t *testing.T) {
+	rackID := uuid.New()
+
+	tests := []struct {
+		name     string
+		status   *maintenance.Status
+		mutate   func(*operation.Request)
+		deferCfg bool
+		wantErr  string
+		wantGate *maintenanceGate
+	}{
+		{
+			name:     "open rack runs until the window closes",
+			status:   &maintenance.Status{Open: true, Opening: &maintenance.Opening{Start: maintenanceNow, End: windowEnd}},
+			wantGate: &maintenanceGate{closesAt: windowEnd},
+		},
+		{
+			name:    "closed rack rejects by default",
+			status:  closedForWindow,
+			wantErr: "is closed for maintenance: outside maintenance windows (next opening at 2026-11-07T02:00:00Z)",
+		},
+		{
+			name:     "closed rack defers when configured",
+			status:   closedForWindow,
+			deferCfg: true,
+			wantGate: &maintenanceGate{deferTo: closedForWindow.Opening, reason: "outside maintenance windows"},
+		},
+		{
+			name:     "request policy beats configuration",
+			status:   closedForWindow,
+			mutate:   func(r *operation.Request) { r.MaintenancePolicy = operation.MaintenancePolicyReject },
+			deferCfg: true,
+			wantErr:  "is closed for maintenance",
+		},
+		{
+			name:    "defer without an opening rejects",
+			status:  &maintenance.Status{Reason: `blackout "freeze" is active`, Restricted: true},
+			mutate:  func(r *operation.Request) { r.MaintenancePolicy = operation.MaintenancePolicyDefer },
+			wantErr: "no window opens within a year",
+		},
+		{
+			name:   "override lets the task run",
+			status: closedForWindow,
+			mutate: func(r *operation.Request) {
+				r.MaintenanceOverride = &operation.MaintenanceOverride{Reason: "incident"}
+			},
+			wantGate: &maintenanceGate{reason: "outside maintenance windows", overridden: true},
+		},
+		{
+			name:   "non-disruptive operation is not checked",
+			status: closedForWindow,
+			mutate: func(r *operation.Request) {
+				r.Operation.Code = operationrules.SequencePowerOn
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &ManagerImpl{
+				maintenanceCalendar:     &fakeCalendar{status: tc.status},
+				deferOutsideMaintenance: tc.deferCfg,
+			}
+			req := powerOffRequest()
+			if tc.mutate != nil {
+				tc.mutate(req)
+			}
+
+			gate, err := m.checkMaintenance(context.Background(), req, rackID, maintenanceNow)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, errClosedForMaintenance)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantGate, gate)
+		})
+	}
+}
+
+func TestCreateAndExecuteTask_Deferred(t *testing.T) {
+	targetRack, _, _ := newPlanRack()
+	store := &deferTaskStore{planTaskStore: planTaskStore{rule: newPlanRule()}}
+	m := &ManagerImpl{
+		taskStore:           store,
+		conflictResolver:    conflict.NewResolver(store),
+		maxWaitingPerRack:   defaultMaxWaitingPerRack,
+		defaultQueueTimeout: defaultQueueTimeout,
+	}
+	gate := &maintenanceGate{deferTo: closedForWindow.Opening, reason: "outside maintenance windows"}
+
+	taskID, err := m.createAndExecuteTask(context.Background(), powerOffRequest(), targetRack, gate)
+	require.NoError(t, err)
+
+	require.Len(t, store.created, 1)
+	task := store.created[0]
+	assert.Equal(t, taskID, task.ID)
+	assert.Equal(t, taskcommon.TaskStatusWaiting, task.Status)
+	assert.Equal(t, "Deferred: outside maintenance windows; waiting for maintenance opening at 2026-11-07T02:00:00Z", task.Message)
+	require.NotNil(t, task.NotBefore)
+	assert.Equal(t, windowStart, *task.NotBefore)
+	require.NotNil(t, task.QueueExpiresAt)
+	assert.Equal(t, windowEnd, *task.QueueExpiresAt)
+
+	// A full queue refuses the deferral.
+	store.waitingCount = defaultMaxWaitingPerRack
+	_, err = m.createAndExecuteTask(context.Background(), powerOffRequest(), targetRack, gate)
+	assert.ErrorContains(t, err, "waiting queue is full")
+}
+
+func TestRecordOverride(t *testing.T) {
+	calendar := &fakeCalendar{}
+	m := &ManagerImpl{maintenanceCalendar: calendar}
+	req := powerOffRequest()
+	req.MaintenanceOverride = &operation.MaintenanceOverride{Reason: "incident", RequestedBy: "oncall"}
+	task := &taskdef.Task{ID: uuid.New(), RackID: uuid.New(), Operation: req.Operation}
+
+	// No gate and an open rack record nothing.
+	require.NoError(t, m.recordOverride(context.Background(), task, req, nil))
+	require.NoError(t, m.recordOverride(context.Background(), task, req, &maintenanceGate{}))
+	assert.Empty(t, calendar.overrides)
+
+	gate := &maintenanceGate{reason: `blackout "freeze" is active`, overridden: true}
+	require.NoError(t, m.recordOverride(context.Background(), task, req, gate))
+	require.Len(t, calendar.overrides, 1)
+	assert.Equal(t, &maintenance.Override{
+		TaskID:      task.ID,
+		RackID:      task.RackID,
+		Operation:   req.Operation,
+		Reason:      "incident",
+		RequestedBy: "oncall",
+		Bypassed:    `blackout "freeze" is active`,
+	}, calendar.overrides[0])
+
+	// An override that cannot be audited is refused.
+	calendar.recordErr = errors.New("db down")
+	assert.ErrorContains(t, m.recordOverride(context.Background(), task, req, gate), "db down")
+}
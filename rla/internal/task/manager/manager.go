@@ -61,6 +61,22 @@ type Config struct {
 	// PromoterConfig tunes the Promoter's sweep interval and channel size.
 	// Zero values use the Promoter's own defaults.
 	PromoterConfig conflict.PromoterConfig
+	// AdmissionChecker, when non-nil, vets every submission against each
+	// target rack before any task is created (e.g. rack power budgets).
+	AdmissionChecker AdmissionChecker
+	// MaintenanceCalendar, when non-nil, holds disruptive tasks to the
+	// maintenance windows and blackouts of their racks.
+	MaintenanceCalendar MaintenanceCalendar
+	// DeferOutsideMaintenance makes requests with the default maintenance
+	// policy wait for the next opening instead of being rejected.
+	DeferOutsideMaintenance bool
+}
+
+// AdmissionChecker decides whether an operation may run against a rack.
+// target holds only the components selected by the request. A non-nil error
+// refuses the whole submission.
+type AdmissionChecker interface {
+	CheckAdmission(ctx context.Context, req *operation.Request, target *rack.Rack) error
 }
 
 func (c *Config) applyDefaults() {
@@ -99,7 +115,9 @@ type Manager interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context)
 	SubmitTask(ctx context.Context, req *operation.Request) ([]uuid.UUID, error)
+	PlanTask(ctx context.Context, req *operation.Request) ([]*taskdef.OperationPlan, error)
 	CancelTask(ctx context.Context, taskID uuid.UUID) error
+	DecideApproval(ctx context.Context, taskID uuid.UUID, signal *taskdef.ApprovalSignal) error
 }
 
 // ManagerImpl maintains unfinished tasks, schedules them via temporal workflows,
@@ -111,6 +129,10 @@ type ManagerImpl struct {
 	ruleResolver     *operationrules.Resolver // Resolves operation rules (created internally)
 	conflictResolver *conflict.Resolver
 	promoter         *conflict.Promoter
+	admissionChecker AdmissionChecker
+
+	maintenanceCalendar     MaintenanceCalendar
+	deferOutsideMaintenance bool
 
 	maxWaitingPerRack   int
 	defaultQueueTimeout time.Duration
@@ -130,9 +152,12 @@ func New(ctx context.Context, conf *Config) (*ManagerImpl, error) {
 
 	// Skeleton manager first — promoteTask is a bound method, m must exist.
 	m := &ManagerImpl{
-		inventoryStore:      conf.InventoryStore,
-		maxWaitingPerRack:   conf.MaxWaitingTasksPerRack,
-		defaultQueueTimeout: conf.DefaultQueueTimeout,
+		inventoryStore:          conf.InventoryStore,
+		admissionChecker:        conf.AdmissionChecker,
+		maintenanceCalendar:     conf.MaintenanceCalendar,
+		deferOutsideMaintenance: conf.DeferOutsideMaintenance,
+		maxWaitingPerRack:       conf.MaxWaitingTasksPerRack,
+		defaultQueueTimeout:     conf.DefaultQueueTimeout,
 	}
 
 	// Promoter needs m.promoteTask.
@@ -206,6 +231,68 @@ func (m *ManagerImpl) SubmitTask(
 	ctx context.Context,
 	req *operation.Request,
 ) ([]uuid.UUID, error) {
+	rackMap, err := m.resolveRequestRacks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admission runs for every rack before any task is created so that one
+	// refused rack does not leave the others half-submitted. Tasks queued
+	// behind a conflict are not re-checked when promoted.
+	if m.admissionChecker != nil {
+		for _, targetRack := range rackMap {
+			if err := m.admissionChecker.CheckAdmission(ctx, req, targetRack); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The maintenance calendar is consulted the same way, so a rack that is
+	// closed for maintenance refuses the submission before any task exists.
+	now := time.Now()
+	gates := make(map[uuid.UUID]*maintenanceGate, len(rackMap))
+	for rackID := range rackMap {
+		gate, err := m.checkMaintenance(ctx, req, rackID, now)
+		if err != nil {
+			return nil, err
+		}
+		gates[rackID] = gate
+	}
+
+	// Create and execute task for each rack.
+	var taskIDs []uuid.UUID
+	for rackID, targetRack := range rackMap {
+		taskID, err := m.createAndExecuteTask(ctx, req, targetRack, gates[rackID])
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("rack_id", targetRack.Info.ID.String()).
+				Msg("failed to create task for rack")
+
+			// RequiredRackID callers (e.g. the schedule dispatcher) depend on
+			// exactly one task ID being returned. Fail fast rather than
+			// returning nil error with zero IDs, which the dispatcher would
+			// misinterpret as a successful no-op.
+			if req.RequiredRackID != uuid.Nil {
+				return nil, fmt.Errorf(
+					"failed to create task for required rack %s: %w",
+					targetRack.Info.ID, err,
+				)
+			}
+			continue
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	return taskIDs, nil
+}
+
+// resolveRequestRacks validates req and resolves its TargetSpec to the racks
+// it touches, each holding only the targeted components.
+func (m *ManagerImpl) resolveRequestRacks(
+	ctx context.Context,
+	req *operation.Request,
+) (map[uuid.UUID]*rack.Rack, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request is nil")
 	}
@@ -256,40 +343,12 @@ func (m *ManagerImpl) SubmitTask(
 		}
 	}
 
-	// Create and execute task for each rack.
-	var taskIDs []uuid.UUID
-	for _, targetRack := range rackMap {
-		taskID, err := m.createAndExecuteTask(ctx, req, targetRack)
-		if err != nil {
-			log.Error().
-				Err(err).
-				Str("rack_id", targetRack.Info.ID.String()).
-				Msg("failed to create task for rack")
-
-			// RequiredRackID callers (e.g. the schedule dispatcher) depend on
-			// exactly one task ID being returned. Fail fast rather than
-			// returning nil error with zero IDs, which the dispatcher would
-			// misinterpret as a successful no-op.
-			if req.RequiredRackID != uuid.Nil {
-				return nil, fmt.Errorf(
-					"failed to create task for required rack %s: %w",
-					targetRack.Info.ID, err,
-				)
-			}
-			continue
-		}
-		taskIDs = append(taskIDs, taskID)
-	}
-
-	return taskIDs, nil
+	return rackMap, nil
 }
 
-// createAndExecuteTask creates a task for a single rack and executes it.
-func (m *ManagerImpl) createAndExecuteTask(
-	ctx context.Context,
-	req *operation.Request,
-	targetRack *rack.Rack,
-) (uuid.UUID, error) {
+// newRackTask builds the task record for req on a single rack. Status and
+// rule are determined by the caller.
+func newRackTask(req *operation.Request, targetRack *rack.Rack) taskdef.Task {
 	// Build component map by type for fine-grained conflict detection.
 	compsByType := make(
 		map[devicetypes.ComponentType][]uuid.UUID,
@@ -299,8 +358,12 @@ func (m *ManagerImpl) createAndExecuteTask(
 		compsByType[c.Type] = append(compsByType[c.Type], c.Info.ID)
 	}
 
-	// Build the task record (status and rule are determined below).
-	task := taskdef.Task{
+	priority := req.Priority
+	if priority == "" {
+		priority = taskcommon.TaskPriorityNormal
+	}
+
+	return taskdef.Task{
 		ID:        uuid.New(),
 		Operation: req.Operation,
 		RackID:    targetRack.Info.ID,
@@ -310,21 +373,56 @@ func (m *ManagerImpl) createAndExecuteTask(
 		Description:  req.Description,
 		ExecutorType: taskcommon.ExecutorTypeUnknown,
 		ExecutionID:  "",
+		Priority:     priority,
+	}
+}
+
+// createAndExecuteTask creates a task for a single rack and executes it.
+// gate is the maintenance calendar's verdict on the rack, nil when the
+// calendar does not apply.
+func (m *ManagerImpl) createAndExecuteTask(
+	ctx context.Context,
+	req *operation.Request,
+	targetRack *rack.Rack,
+	gate *maintenanceGate,
+) (uuid.UUID, error) {
+	// Build the task record (status and rule are determined below).
+	task := newRackTask(req, targetRack)
+
+	if err := m.recordOverride(ctx, &task, req, gate); err != nil {
+		return uuid.Nil, err
 	}
 
 	// Check for conflicts inside a transaction to avoid a race between the
-	// check and the creation.
+	// check and the creation. An emergency task that may preempt its
+	// conflicts is created pending; the conflicting tasks are stopped once
+	// it is committed.
+	var preempted []*taskdef.Task
 	txErr := m.taskStore.RunInTransaction(
 		ctx,
 		func(txCtx context.Context) error {
-			hasConflict, err := m.conflictResolver.HasConflict(
+			preempted = nil // reset on retry
+
+			// A task deferred to a maintenance window waits in the queue
+			// whether or not it conflicts with anything now.
+			if gate != nil && gate.deferTo != nil {
+				if err := m.checkWaitingQueue(txCtx, targetRack.Info.ID); err != nil {
+					return err
+				}
+
+				m.deferTask(&task, req, gate)
+				return m.taskStore.CreateTask(txCtx, &task)
+			}
+
+			conflicting, err := m.conflictResolver.ConflictingTasks(
 				txCtx, &task,
 			)
 			if err != nil {
 				return err
 			}
 
-			if hasConflict {
+			preempted = preemptionTargets(req, &task, conflicting)
+			if len(conflicting) > 0 && preempted == nil {
 				if req.ConflictStrategy != operation.ConflictStrategyQueue {
 					return fmt.Errorf(
 						"rack %s already has a conflicting task",
@@ -332,22 +430,16 @@ func (m *ManagerImpl) createAndExecuteTask(
 					)
 				}
 
-				count, err := m.taskStore.CountWaitingTasksForRack(
-					txCtx, targetRack.Info.ID,
-				)
-				if err != nil {
+				if err := m.checkWaitingQueue(txCtx, targetRack.Info.ID); err != nil {
 					return err
 				}
-				if count >= m.maxWaitingPerRack {
-					return fmt.Errorf(
-						"rack %s waiting queue is full (%d/%d tasks)",
-						targetRack.Info.ID, count, m.maxWaitingPerRack,
-					)
-				}
 
 				task.Status = taskcommon.TaskStatusWaiting
 				task.Message = "Queued: waiting for rack to become available"
 				task.QueueExpiresAt = m.getReqExpiresAt(req)
+				if gate != nil && !gate.closesAt.IsZero() && gate.closesAt.Before(*task.QueueExpiresAt) {
+					task.QueueExpiresAt = &gate.closesAt
+				}
 			} else {
 				task.Status = taskcommon.TaskStatusPending
 				task.Message = "Created"
@@ -360,6 +452,15 @@ func (m *ManagerImpl) createAndExecuteTask(
 		return uuid.Nil, txErr
 	}
 
+	if task.NotBefore != nil {
+		log.Info().
+			Str("task_id", task.ID.String()).
+			Str("rack_id", targetRack.Info.ID.String()).
+			Time("not_before", *task.NotBefore).
+			Msg("task deferred: waiting for maintenance window")
+		return task.ID, nil
+	}
+
 	if task.Status == taskcommon.TaskStatusWaiting {
 		log.Info().
 			Str("task_id", task.ID.String()).
@@ -368,6 +469,8 @@ func (m *ManagerImpl) createAndExecuteTask(
 		return task.ID, nil
 	}
 
+	m.preemptTasks(ctx, &task, preempted, req.Preemption)
+
 	// Task executes immediately — resolve rule and run.
 	if err := m.resolveAndExecuteTask(ctx, &task, targetRack); err != nil {
 		return uuid.Nil, err
@@ -376,6 +479,23 @@ func (m *ManagerImpl) createAndExecuteTask(
 	return task.ID, nil
 }
 
+// checkWaitingQueue returns an error when the rack's waiting queue is full.
+func (m *ManagerImpl) checkWaitingQueue(ctx context.Context, rackID uuid.UUID) error {
+	count, err := m.taskStore.CountWaitingTasksForRack(ctx, rackID)
+	if err != nil {
+		return err
+	}
+
+	if count >= m.maxWaitingPerRack {
+		return fmt.Errorf(
+			"rack %s waiting queue is full (%d/%d tasks)",
+			rackID, count, m.maxWaitingPerRack,
+		)
+	}
+
+	return nil
+}
+
 // promoteTask is invoked by the Promoter to execute a previously waiting task
 // that has been promoted to pending.
 func (m *ManagerImpl) promoteTask(ctx context.Context, taskID uuid.UUID) error {
@@ -496,6 +616,100 @@ func (m *ManagerImpl) CancelTask(ctx context.Context, taskID uuid.UUID) error {
 	)
 }
 
+// DecideApproval delivers a person's decision to an approval gate the task is
+// waiting on. signal.ApprovalID may be empty when the task has exactly one
+// pending gate. The decision is recorded on the task by the workflow once it
+// receives the signal, so the task record reflects it shortly after this
+// call returns.
+func (m *ManagerImpl) DecideApproval(
+	ctx context.Context,
+	taskID uuid.UUID,
+	signal *taskdef.ApprovalSignal,
+) error {
+	if signal == nil {
+		return fmt.Errorf("approval decision is nil")
+	}
+
+	if signal.Approver == "" {
+		return fmt.Errorf("approver is required")
+	}
+
+	if _, err := taskcommon.ApprovalDecisionFromString(string(signal.Decision)); err != nil {
+		return err
+	}
+
+	task, err := m.taskStore.GetTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task %s: %w", taskID, err)
+	}
+
+	if task.Status != taskcommon.TaskStatusWaitingApproval {
+		return fmt.Errorf(
+			"task %s is not waiting for approval (status: %s)", taskID, task.Status,
+		)
+	}
+
+	approval, err := pendingApproval(task, signal.ApprovalID)
+	if err != nil {
+		return err
+	}
+
+	decision := *signal
+	decision.ApprovalID = approval.ID
+
+	if err := m.executor.SignalApproval(
+		ctx, task.ExecutionID, approval.Step, &decision,
+	); err != nil {
+		return fmt.Errorf(
+			"failed to deliver approval decision for task %s: %w", taskID, err,
+		)
+	}
+
+	log.Info().
+		Str("task_id", taskID.String()).
+		Str("approval_id", approval.ID).
+		Str("decision", string(decision.Decision)).
+		Str("approver", decision.Approver).
+		Msg("approval decision delivered")
+
+	return nil
+}
+
+// pendingApproval selects the gate a decision applies to: the one named by
+// approvalID, or the only pending gate when approvalID is empty.
+func pendingApproval(
+	task *taskdef.Task,
+	approvalID string,
+) (taskcommon.Approval, error) {
+	pending := task.Attributes.PendingApprovals()
+
+	if approvalID == "" {
+		switch len(pending) {
+		case 0:
+			return taskcommon.Approval{}, fmt.Errorf(
+				"task %s has no pending approval", task.ID,
+			)
+		case 1:
+			return pending[0], nil
+		default:
+			return taskcommon.Approval{}, fmt.Errorf(
+				"task %s has %d pending approvals; approval ID is required",
+				task.ID, len(pending),
+			)
+		}
+	}
+
+	for _, approval := range pending {
+		if approval.ID == approvalID {
+			return approval, nil
+		}
+	}
+
+	return taskcommon.Approval{}, fmt.Errorf(
+		"task %s has no pending approval %s", task.ID, approvalID,
+	)
+}
+
 // loadRackForTask re-fetches the rack for a task and filters its component
 // list to only those tracked in task.Attributes.
 func (m *ManagerImpl) loadRackForTask(
@@ -570,11 +784,15 @@ func (m *ManagerImpl) executeTask(
 }
 
 func (m *ManagerImpl) getReqExpiresAt(req *operation.Request) *time.Time {
-	timeout := req.QueueTimeout
-	if timeout <= 0 {
-		timeout = m.defaultQueueTimeout
+	expiresAt := time.Now().Add(m.queueTimeout(req))
+	return &expiresAt
+}
+
+// queueTimeout returns how long a task of req may wait in the queue.
+func (m *ManagerImpl) queueTimeout(req *operation.Request) time.Duration {
+	if req.QueueTimeout > 0 {
+		return req.QueueTimeout
 	}
 
-	expiresAt := time.Now().Add(timeout)
-	return &expiresAt
+	return m.defaultQueueTimeout
 }
@@ -0,0 +1,285 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operations"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// PlanTask works out what SubmitTask would do with req without creating any
+// task or starting any workflow. It returns one plan per target rack, ordered
+// by rack name. Requests SubmitTask would refuse outright (invalid request,
+// unknown rule, no racks) return an error; refusals that depend on the rack,
+// such as admission or a conflict, are reported in the plan's outcome.
+func (m *ManagerImpl) PlanTask(
+	ctx context.Context,
+	req *operation.Request,
+) ([]*taskdef.OperationPlan, error) {
+	rackMap, err := m.resolveRequestRacks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*taskdef.OperationPlan, 0, len(rackMap))
+	for _, targetRack := range rackMap {
+		plan, err := m.planRackTask(ctx, req, targetRack)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to plan task for rack %s: %w", targetRack.Info.ID, err,
+			)
+		}
+		plans = append(plans, plan)
+	}
+
+	slices.SortFunc(plans, func(a, b *taskdef.OperationPlan) int {
+		if c := cmp.Compare(a.RackName, b.RackName); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.RackID.String(), b.RackID.String())
+	})
+
+	return plans, nil
+}
+
+// planRackTask builds the plan for req on a single rack, following the same
+// rule resolution, admission, maintenance and conflict checks as
+// SubmitTask.
+func (m *ManagerImpl) planRackTask(
+	ctx context.Context,
+	req *operation.Request,
+	targetRack *rack.Rack,
+) (*taskdef.OperationPlan, error) {
+	task := newRackTask(req, targetRack)
+
+	rule, source, err := m.ruleResolver.ResolveRuleWithSource(
+		ctx,
+		task.Operation.Type,
+		task.Operation.Code,
+		task.RackID,
+		operations.ExtractRuleID(task.Operation.Info),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve operation rule: %w", err)
+	}
+
+	stages, estimate, warnings := planStages(&rule.RuleDefinition, targetRack)
+
+	plan := &taskdef.OperationPlan{
+		RackID:            targetRack.Info.ID,
+		RackName:          targetRack.Info.Name,
+		Operation:         req.Operation,
+		Rule:              rule,
+		RuleSource:        source,
+		Stages:            stages,
+		EstimatedDuration: estimate,
+		Outcome:           taskdef.PlanOutcomeRun,
+		Warnings:          warnings,
+	}
+
+	if m.admissionChecker != nil {
+		if err := m.admissionChecker.CheckAdmission(ctx, req, targetRack); err != nil {
+			plan.Outcome = taskdef.PlanOutcomeReject
+			plan.Reason = err.Error()
+			return plan, nil
+		}
+	}
+
+	gate, err := m.checkMaintenance(ctx, req, targetRack.Info.ID, time.Now())
+	if err != nil {
+		if !errors.Is(err, errClosedForMaintenance) {
+			return nil, err
+		}
+		plan.Outcome = taskdef.PlanOutcomeReject
+		plan.Reason = err.Error()
+		return plan, nil
+	}
+
+	if gate != nil && gate.deferTo != nil {
+		count, err := m.taskStore.CountWaitingTasksForRack(ctx, targetRack.Info.ID)
+		if err != nil {
+			return nil, err
+		}
+		if count >= m.maxWaitingPerRack {
+			plan.Outcome = taskdef.PlanOutcomeReject
+			plan.Reason = fmt.Sprintf(
+				"rack %s waiting queue is full (%d/%d tasks)",
+				targetRack.Info.ID, count, m.maxWaitingPerRack,
+			)
+			return plan, nil
+		}
+
+		plan.Outcome = taskdef.PlanOutcomeQueue
+		plan.Reason = fmt.Sprintf("deferred to maintenance opening at %s: %s",
+			gate.deferTo.Start.UTC().Format(time.RFC3339), gate.reason)
+		return plan, nil
+	}
+
+	if gate != nil && gate.overridden {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+			"maintenance override: runs although %s", gate.reason,
+		))
+	}
+
+	conflicting, err := m.conflictResolver.ConflictingTasks(ctx, &task)
+	if err != nil {
+		return nil, err
+	}
+	plan.ConflictingTasks = conflicting
+
+	if len(conflicting) == 0 {
+		return plan, nil
+	}
+
+	if victims := preemptionTargets(req, &task, conflicting); victims != nil {
+		verb := "cancels"
+		if req.Preemption == operation.PreemptionRequeue {
+			verb = "interrupts and requeues"
+		}
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+			"preemption: %s %d lower-priority task(s)", verb, len(victims),
+		))
+		return plan, nil
+	}
+
+	if req.ConflictStrategy != operation.ConflictStrategyQueue {
+		plan.Outcome = taskdef.PlanOutcomeReject
+		plan.Reason = fmt.Sprintf(
+			"rack %s already has a conflicting task", targetRack.Info.ID,
+		)
+		return plan, nil
+	}
+
+	count, err := m.taskStore.CountWaitingTasksForRack(ctx, targetRack.Info.ID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= m.maxWaitingPerRack {
+		plan.Outcome = taskdef.PlanOutcomeReject
+		plan.Reason = fmt.Sprintf(
+			"rack %s waiting queue is full (%d/%d tasks)",
+			targetRack.Info.ID, count, m.maxWaitingPerRack,
+		)
+		return plan, nil
+	}
+
+	plan.Outcome = taskdef.PlanOutcomeQueue
+	plan.Reason = fmt.Sprintf(
+		"queued behind %d conflicting task(s)", len(conflicting),
+	)
+
+	return plan, nil
+}
+
+// planStages lays out ruleDef against the components of targetRack the way
+// the rule-based workflow executes it: stages in order, each step acting on
+// the components of its type in max_parallel batches. It assumes every step
+// succeeds, so no component is dropped between stages.
+func planStages(
+	ruleDef *operationrules.RuleDefinition,
+	targetRack *rack.Rack,
+) ([]taskdef.PlannedStage, time.Duration, []string) {
+	var warnings []string
+	if len(ruleDef.Steps) == 0 {
+		return nil, 0, []string{"rule has no steps; the operation will fail"}
+	}
+
+	componentsByType := make(map[devicetypes.ComponentType][]uuid.UUID)
+	for _, c := range targetRack.Components {
+		componentsByType[c.Type] = append(componentsByType[c.Type], c.Info.ID)
+	}
+
+	covered := make(map[devicetypes.ComponentType]bool)
+	var stages []taskdef.PlannedStage
+	var total time.Duration
+
+	iter := operationrules.NewStageIterator(ruleDef)
+	for stage := iter.Next(); stage != nil; stage = iter.Next() {
+		planned := taskdef.PlannedStage{Number: stage.Number}
+
+		for i := range stage.Steps {
+			step := &stage.Steps[i]
+			covered[step.ComponentType] = true
+
+			components := componentsByType[step.ComponentType]
+			if len(components) == 0 {
+				warnings = append(warnings, fmt.Sprintf(
+					"stage %d: no %s components, step skipped",
+					stage.Number,
+					devicetypes.ComponentTypeToString(step.ComponentType),
+				))
+				continue
+			}
+
+			batches := operationrules.StepBatches(step, components)
+			estimate := time.Duration(len(batches))*step.EstimateDuration() +
+				step.DelayAfter
+
+			planned.Steps = append(planned.Steps, taskdef.PlannedStep{
+				ComponentType:     step.ComponentType,
+				MaxParallel:       step.MaxParallel,
+				Batches:           batches,
+				Actions:           stepActionNames(step),
+				EstimatedDuration: estimate,
+			})
+			planned.EstimatedDuration = max(planned.EstimatedDuration, estimate)
+		}
+
+		total += planned.EstimatedDuration
+		stages = append(stages, planned)
+	}
+
+	for _, ct := range devicetypes.ComponentTypes() {
+		if n := len(componentsByType[ct]); n > 0 && !covered[ct] {
+			warnings = append(warnings, fmt.Sprintf(
+				"%d %s component(s) are not covered by any step",
+				n, devicetypes.ComponentTypeToString(ct),
+			))
+		}
+	}
+
+	return stages, total, warnings
+}
+
+// stepActionNames lists the actions of step in execution order.
+func stepActionNames(step *operationrules.SequenceStep) []string {
+	names := make([]string, 0, len(step.PreOperation)+1+len(step.PostOperation))
+	for _, a := range step.PreOperation {
+		names = append(names, a.Name)
+	}
+	if step.MainOperation.Name != "" {
+		names = append(names, step.MainOperation.Name)
+	}
+	for _, a := range step.PostOperation {
+		names = append(names, a.Name)
+	}
+	return names
+}
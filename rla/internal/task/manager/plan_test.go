@@ -0,0 +1,323 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/conflict"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	taskstore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/store"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// planTaskStore serves a rule, the active tasks and the waiting count of a
+// single rack; every other Store method panics.
+type planTaskStore struct {
+	taskstore.Store
+	rule         *operationrules.OperationRule
+	activeTasks  []*taskdef.Task
+	waitingCount int
+}
+
+func (s *planTaskStore) GetRuleByOperationAndRack(
+	_ context.Context, _ taskcommon.TaskType, _ string, _ *uuid.UUID,
+) (*operationrules.OperationRule, error) {
+	return s.rule, nil
+}
+
+func (s *planTaskStore) ListActiveTasksForRack(
+	_ context.Context, _ uuid.UUID,
+) ([]*taskdef.Task, error) {
+	return s.activeTasks, nil
+}
+
+func (s *planTaskStore) CountWaitingTasksForRack(
+	_ context.Context, _ uuid.UUID,
+) (int, error) {
+	return s.waitingCount, nil
+}
+
+// refusingAdmission refuses every submission with err.
+type refusingAdmission struct {
+	err error
+}
+
+func (a refusingAdmission) CheckAdmission(
+	_ context.Context, _ *operation.Request, _ *rack.Rack,
+) error {
+	return a.err
+}
+
+// newPlanRack returns a rack with two compute trays and one power shelf.
+func newPlanRack() (*rack.Rack, []uuid.UUID, uuid.UUID) {
+	r := newTestRack(uuid.New(), "rack-1")
+	computes := []uuid.UUID{uuid.New(), uuid.New()}
+	shelf := uuid.New()
+	for i, id := range computes {
+		r.AddComponent(newTestComponent(id, r.Info.ID,
+			devicetypes.ComponentTypeCompute, "compute-"+string(rune('a'+i))))
+	}
+	r.AddComponent(newTestComponent(shelf, r.Info.ID,
+		devicetypes.ComponentTypePowerShelf, "shelf"))
+	return r, computes, shelf
+}
+
+func newPlanRule() *operationrules.OperationRule {
+	return &operationrules.OperationRule{
+		ID:            uuid.New(),
+		Name:          "Power Off",
+		OperationType: taskcommon.TaskTypePowerControl,
+		OperationCode: operationrules.SequencePowerOff,
+		IsDefault:     true,
+		RuleDefinition: operationrules.RuleDefinition{
+			Version: operationrules.CurrentRuleDefinitionVersion,
+			Steps: []operationrules.SequenceStep{
+				{
+					ComponentType: devicetypes.ComponentTypeCompute,
+					Stage:         1,
+					MaxParallel:   1,
+					Timeout:       10 * time.Minute,
+					MainOperation: operationrules.ActionConfig{
+						Name: operationrules.ActionPowerControl,
+					},
+					OnFailure: &operationrules.FailurePolicy{MaxFailed: 1},
+				},
+				{
+					ComponentType: devicetypes.ComponentTypeNVLSwitch,
+					Stage:         1,
+					MainOperation: operationrules.ActionConfig{
+						Name: operationrules.ActionPowerControl,
+					},
+				},
+				{
+					ComponentType: devicetypes.ComponentTypePowerShelf,
+					Stage:         2,
+					Timeout:       5 * time.Minute,
+					DelayAfter:    30 * time.Second,
+					PreOperation: []operationrules.ActionConfig{{
+						Name: operationrules.ActionSleep,
+						Parameters: map[string]any{
+							operationrules.ParamDuration: "1m",
+						},
+					}},
+					MainOperation: operationrules.ActionConfig{
+						Name: operationrules.ActionPowerControl,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPlanStages(t *testing.T) {
+	targetRack, computes, shelf := newPlanRack()
+	rule := newPlanRule()
+
+	stages, estimate, warnings := planStages(&rule.RuleDefinition, targetRack)
+
+	require.Len(t, stages, 2)
+
+	assert.Equal(t, 1, stages[0].Number)
+	require.Len(t, stages[0].Steps, 1, "NVLSwitch step has no components")
+	assert.Equal(t,
+		[][]uuid.UUID{{computes[0]}, {computes[1]}}, stages[0].Steps[0].Batches)
+	assert.Equal(t, 20*time.Minute, stages[0].EstimatedDuration)
+
+	assert.Equal(t, 2, stages[1].Number)
+	require.Len(t, stages[1].Steps, 1)
+	assert.Equal(t, [][]uuid.UUID{{shelf}}, stages[1].Steps[0].Batches)
+	assert.Equal(t,
+		[]string{operationrules.ActionSleep, operationrules.ActionPowerControl},
+		stages[1].Steps[0].Actions)
+	assert.Equal(t, 6*time.Minute+30*time.Second, stages[1].EstimatedDuration)
+
+	assert.Equal(t, 26*time.Minute+30*time.Second, estimate)
+	assert.Equal(t, []string{"stage 1: no NVLSwitch components, step skipped"}, warnings)
+}
+
+func TestPlanStages_Warnings(t *testing.T) {
+	targetRack, _, _ := newPlanRack()
+
+	_, _, warnings := planStages(&operationrules.RuleDefinition{}, targetRack)
+	assert.Equal(t, []string{"rule has no steps; the operation will fail"}, warnings)
+
+	rule := newPlanRule()
+	rule.RuleDefinition.Steps = rule.RuleDefinition.Steps[:1]
+	_, _, warnings = planStages(&rule.RuleDefinition, targetRack)
+	assert.Contains(t, warnings, "1 PowerShelf component(s) are not covered by any step")
+}
+
+func TestPlanRackTask(t *testing.T) {
+	targetRack, computes, _ := newPlanRack()
+	conflictingTask := &taskdef.Task{
+		ID:     uuid.New(),
+		RackID: targetRack.Info.ID,
+		Operation: operation.Wrapper{
+			Type: taskcommon.TaskTypePowerControl,
+			Code: operationrules.SequencePowerOn,
+		},
+		Attributes: taskcommon.TaskAttributes{
+			ComponentsByType: map[devicetypes.ComponentType][]uuid.UUID{
+				devicetypes.ComponentTypeCompute: {computes[0]},
+			},
+		},
+	}
+	emergencyTask := *conflictingTask
+	emergencyTask.Priority = taskcommon.TaskPriorityEmergency
+
+	tests := []struct {
+		name         string
+		activeTasks  []*taskdef.Task
+		waitingCount int
+		strategy     operation.ConflictStrategy
+		admission    AdmissionChecker
+		calendar     MaintenanceCalendar
+		maintenance  operation.MaintenancePolicy
+		preemption   operation.Preemption
+		outcome      taskdef.PlanOutcome
+		reason       string
+		warning      string
+	}{
+		{
+			name:    "no conflicts runs",
+			outcome: taskdef.PlanOutcomeRun,
+		},
+		{
+			name:        "conflict rejects",
+			activeTasks: []*taskdef.Task{conflictingTask},
+			outcome:     taskdef.PlanOutcomeReject,
+			reason:      "already has a conflicting task",
+		},
+		{
+			name:        "conflict queues",
+			activeTasks: []*taskdef.Task{conflictingTask},
+			strategy:    operation.ConflictStrategyQueue,
+			outcome:     taskdef.PlanOutcomeQueue,
+			reason:      "queued behind 1 conflicting task(s)",
+		},
+		{
+			name:         "full queue rejects",
+			activeTasks:  []*taskdef.Task{conflictingTask},
+			waitingCount: 5,
+			strategy:     operation.ConflictStrategyQueue,
+			outcome:      taskdef.PlanOutcomeReject,
+			reason:       "waiting queue is full (5/5 tasks)",
+		},
+		{
+			name:      "admission refusal rejects",
+			admission: refusingAdmission{err: errors.New("power budget exceeded")},
+			outcome:   taskdef.PlanOutcomeReject,
+			reason:    "power budget exceeded",
+		},
+		{
+			name:     "closed for maintenance rejects",
+			calendar: &fakeCalendar{status: closedForWindow},
+			outcome:  taskdef.PlanOutcomeReject,
+			reason:   "closed for maintenance: outside maintenance windows",
+		},
+		{
+			name:        "closed for maintenance defers",
+			calendar:    &fakeCalendar{status: closedForWindow},
+			maintenance: operation.MaintenancePolicyDefer,
+			outcome:     taskdef.PlanOutcomeQueue,
+			reason:      "deferred to maintenance opening at 2026-11-07T02:00:00Z",
+		},
+		{
+			name:        "emergency preempts lower priority",
+			activeTasks: []*taskdef.Task{conflictingTask},
+			preemption:  operation.PreemptionCancel,
+			outcome:     taskdef.PlanOutcomeRun,
+			warning:     "preemption: cancels 1 lower-priority task(s)",
+		},
+		{
+			name:        "emergency requeues lower priority",
+			activeTasks: []*taskdef.Task{conflictingTask},
+			preemption:  operation.PreemptionRequeue,
+			outcome:     taskdef.PlanOutcomeRun,
+			warning:     "preemption: interrupts and requeues 1 lower-priority task(s)",
+		},
+		{
+			name:        "emergency does not preempt emergency",
+			activeTasks: []*taskdef.Task{conflictingTask, &emergencyTask},
+			preemption:  operation.PreemptionCancel,
+			strategy:    operation.ConflictStrategyQueue,
+			outcome:     taskdef.PlanOutcomeQueue,
+			reason:      "queued behind 2 conflicting task(s)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &planTaskStore{
+				rule:         newPlanRule(),
+				activeTasks:  tc.activeTasks,
+				waitingCount: tc.waitingCount,
+			}
+			m := &ManagerImpl{
+				taskStore:           store,
+				ruleResolver:        operationrules.NewResolver(store),
+				conflictResolver:    conflict.NewResolver(store),
+				admissionChecker:    tc.admission,
+				maintenanceCalendar: tc.calendar,
+				maxWaitingPerRack:   defaultMaxWaitingPerRack,
+			}
+			req := &operation.Request{
+				Operation: operation.Wrapper{
+					Type: taskcommon.TaskTypePowerControl,
+					Code: operationrules.SequencePowerOff,
+					Info: []byte(`{}`),
+				},
+				ConflictStrategy:  tc.strategy,
+				MaintenancePolicy: tc.maintenance,
+				Preemption:        tc.preemption,
+			}
+			if tc.preemption != operation.PreemptionNone {
+				req.Priority = taskcommon.TaskPriorityEmergency
+			}
+
+			plan, err := m.planRackTask(context.Background(), req, targetRack)
+			require.NoError(t, err)
+
+			assert.Equal(t, targetRack.Info.ID, plan.RackID)
+			assert.Equal(t, "rack-1", plan.RackName)
+			assert.Equal(t, "Power Off", plan.Rule.Name)
+			assert.Equal(t, operationrules.RuleSourceDefault, plan.RuleSource)
+			assert.Len(t, plan.Stages, 2)
+			assert.Equal(t, tc.outcome, plan.Outcome)
+			assert.Contains(t, plan.Reason, tc.reason)
+			if tc.outcome == taskdef.PlanOutcomeQueue {
+				assert.Equal(t, tc.activeTasks, plan.ConflictingTasks)
+			}
+			if tc.warning != "" {
+				assert.Contains(t, plan.Warnings, tc.warning)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+)
+
+// preemptionTargets returns the active tasks that task has to stop in order
+// to run now. It returns nil when the request does not preempt, or when any
+// conflicting task is of the same or a higher priority; the conflict
+// strategy then applies as usual.
+func preemptionTargets(
+	req *operation.Request,
+	task *taskdef.Task,
+	conflicting []*taskdef.Task,
+) []*taskdef.Task {
+	if req.Preemption == operation.PreemptionNone || len(conflicting) == 0 {
+		return nil
+	}
+
+	for _, c := range conflicting {
+		if c.Priority.Rank() >= task.Priority.Rank() {
+			return nil
+		}
+	}
+
+	return conflicting
+}
+
+// preemptTasks stops every victim to make way for task. A victim that cannot
+// be stopped is logged and skipped: the preempting task is urgent and runs
+// regardless.
+func (m *ManagerImpl) preemptTasks(
+	ctx context.Context,
+	task *taskdef.Task,
+	victims []*taskdef.Task,
+	mode operation.Preemption,
+) {
+	for _, victim := range victims {
+		if err := m.preemptTask(ctx, task, victim, mode); err != nil {
+			log.Error().Err(err).
+				Str("task_id", victim.ID.String()).
+				Str("preempted_by", task.ID.String()).
+				Msg("failed to preempt task")
+			continue
+		}
+
+		log.Info().
+			Str("task_id", victim.ID.String()).
+			Str("preempted_by", task.ID.String()).
+			Str("priority", task.Priority.String()).
+			Bool("requeued", mode == operation.PreemptionRequeue).
+			Msg("task preempted")
+	}
+}
+
+// preemptTask terminates the workflow of victim and records on it which task
+// preempted it and why. With PreemptionRequeue the victim goes back to the
+// waiting queue and runs again once its rack is free; otherwise it ends
+// terminated.
+func (m *ManagerImpl) preemptTask(
+	ctx context.Context,
+	task *taskdef.Task,
+	victim *taskdef.Task,
+	mode operation.Preemption,
+) error {
+	record := &taskcommon.Preemption{
+		By:       task.ID,
+		Priority: task.Priority,
+		Reason:   preemptionReason(task),
+		Requeued: mode == operation.PreemptionRequeue,
+		At:       time.Now().UTC(),
+	}
+
+	status := taskcommon.TaskStatusTerminated
+	message := fmt.Sprintf(
+		"Preempted by %s task %s: %s", record.Priority, record.By, record.Reason,
+	)
+	if record.Requeued {
+		status = taskcommon.TaskStatusWaiting
+		message = fmt.Sprintf(
+			"Interrupted by %s task %s: %s; queued to run again",
+			record.Priority, record.By, record.Reason,
+		)
+	}
+
+	if victim.ExecutionID != "" {
+		if err := m.executor.TerminateTask(
+			ctx, victim.ExecutionID, message,
+		); err != nil {
+			return fmt.Errorf(
+				"failed to terminate workflow for task %s: %w", victim.ID, err,
+			)
+		}
+	}
+
+	return m.taskStore.UpdateTaskStatus(
+		ctx,
+		&taskdef.TaskStatusUpdate{
+			ID:         victim.ID,
+			Status:     status,
+			Message:    message,
+			Preemption: record,
+		},
+	)
+}
+
+// preemptionReason describes a preempting task: its description, or its
+// operation when it has none.
+func preemptionReason(task *taskdef.Task) string {
+	if task.Description != "" {
+		return task.Description
+	}
+	return fmt.Sprintf("%s %s", task.Operation.Type, task.Operation.Code)
+}
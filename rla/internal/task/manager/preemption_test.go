@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/executor"
+	taskstore "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/store"
+	taskdef "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/task"
+)
+
+// statusRecorder records status updates; every other Store method panics.
+type statusRecorder struct {
+	taskstore.Store
+	updates []*taskdef.TaskStatusUpdate
+}
+
+func (s *statusRecorder) UpdateTaskStatus(
+	_ context.Context, u *taskdef.TaskStatusUpdate,
+) error {
+	s.updates = append(s.updates, u)
+	return nil
+}
+
+// terminateRecorder records TerminateTask calls and fails those for
+// executions listed in fail; every other Executor method panics.
+type terminateRecorder struct {
+	executor.Executor
+	terminated map[string]string
+	fail       map[string]bool
+}
+
+func (e *terminateRecorder) TerminateTask(
+	_ context.Context, executionID string, reason string,
+) error {
+	if e.fail[executionID] {
+		return errors.New("workflow not found")
+	}
+	e.terminated[executionID] = reason
+	return nil
+}
+
+func TestPreemptionTargets(t *testing.T) {
+	normal := &taskdef.Task{ID: uuid.New(), Priority: taskcommon.TaskPriorityNormal}
+	background := &taskdef.Task{ID: uuid.New(), Priority: taskcommon.TaskPriorityBackground}
+	legacy := &taskdef.Task{ID: uuid.New()}
+	emergency := &taskdef.Task{ID: uuid.New(), Priority: taskcommon.TaskPriorityEmergency}
+
+	incoming := &taskdef.Task{ID: uuid.New(), Priority: taskcommon.TaskPriorityEmergency}
+	cancel := &operation.Request{Preemption: operation.PreemptionCancel}
+
+	assert.Equal(t,
+		[]*taskdef.Task{normal, background, legacy},
+		preemptionTargets(cancel, incoming, []*taskdef.Task{normal, background, legacy}),
+	)
+	assert.Nil(t, preemptionTargets(cancel, incoming, nil))
+	assert.Nil(t, preemptionTargets(cancel, incoming, []*taskdef.Task{normal, emergency}),
+		"an equal-priority conflict blocks preemption")
+	assert.Nil(t, preemptionTargets(&operation.Request{}, incoming, []*taskdef.Task{normal}),
+		"the request does not preempt")
+
+	lowIncoming := &taskdef.Task{ID: uuid.New(), Priority: taskcommon.TaskPriorityNormal}
+	assert.Nil(t, preemptionTargets(cancel, lowIncoming, []*taskdef.Task{background, normal}))
+}
+
+func TestPreemptTasks(t *testing.T) {
+	store := &statusRecorder{}
+	exec := &terminateRecorder{
+		terminated: map[string]string{},
+		fail:       map[string]bool{"exec-broken": true},
+	}
+	m := &ManagerImpl{taskStore: store, executor: exec}
+
+	task := &taskdef.Task{
+		ID:          uuid.New(),
+		Priority:    taskcommon.TaskPriorityEmergency,
+		Description: "leak detected on tray compute-1",
+	}
+	running := &taskdef.Task{ID: uuid.New(), ExecutionID: "exec-1"}
+	pending := &taskdef.Task{ID: uuid.New()}
+	broken := &taskdef.Task{ID: uuid.New(), ExecutionID: "exec-broken"}
+
+	m.preemptTasks(context.Background(), task,
+		[]*taskdef.Task{running, pending, broken}, operation.PreemptionCancel)
+
+	wantMessage := "Preempted by emergency task " + task.ID.String() +
+		": leak detected on tray compute-1"
+	assert.Equal(t, map[string]string{"exec-1": wantMessage}, exec.terminated)
+
+	// The task whose workflow could not be stopped is left alone.
+	require.Len(t, store.updates, 2)
+	for i, victim := range []*taskdef.Task{running, pending} {
+		u := store.updates[i]
+		assert.Equal(t, victim.ID, u.ID)
+		assert.Equal(t, taskcommon.TaskStatusTerminated, u.Status)
+		assert.Equal(t, wantMessage, u.Message)
+		require.NotNil(t, u.Preemption)
+		assert.Equal(t, task.ID, u.Preemption.By)
+		assert.Equal(t, taskcommon.TaskPriorityEmergency, u.Preemption.Priority)
+		assert.Equal(t, "leak detected on tray compute-1", u.Preemption.Reason)
+		assert.False(t, u.Preemption.Requeued)
+	}
+}
+
+func TestPreemptTask_Requeue(t *testing.T) {
+	store := &statusRecorder{}
+	exec := &terminateRecorder{terminated: map[string]string{}}
+	m := &ManagerImpl{taskStore: store, executor: exec}
+
+	task := &taskdef.Task{
+		ID:       uuid.New(),
+		Priority: taskcommon.TaskPriorityEmergency,
+		Operation: operation.Wrapper{
+			Type: taskcommon.TaskTypePowerControl,
+			Code: taskcommon.OpCodePowerControlForcePowerOff,
+		},
+	}
+	victim := &taskdef.Task{ID: uuid.New(), ExecutionID: "exec-1"}
+
+	require.NoError(t, m.preemptTask(context.Background(), task, victim, operation.PreemptionRequeue))
+
+	require.Len(t, store.updates, 1)
+	u := store.updates[0]
+	assert.Equal(t, taskcommon.TaskStatusWaiting, u.Status)
+	assert.Equal(t,
+		"Interrupted by emergency task "+task.ID.String()+
+			": power_control force_power_off; queued to run again",
+		u.Message,
+	)
+	assert.True(t, u.Preemption.Requeued)
+	assert.Equal(t, u.Message, exec.terminated["exec-1"])
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
 	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
 )
 
@@ -73,6 +74,24 @@ var actionRegistry = map[string]actionSpec{
 		description:          "Poll until specified component types become reachable",
 		validateParams:       validateVerifyReachabilityParams,
 	},
+	ActionVerifyCoolingHealth: {
+		requiredParams:       []string{},
+		optionalParams:       []string{},
+		requiresPollInterval: true,
+		requiresTimeout:      true,
+		implementation:       "activity.GetCoolingProblems",
+		description:          "Poll until the task's CDUs report healthy pumps, flow, temperature and no leaks",
+		validateParams:       nil, // No custom validation
+	},
+	ActionBackupConfig: {
+		requiredParams:       []string{},
+		optionalParams:       []string{},
+		requiresPollInterval: false,
+		requiresTimeout:      false,
+		implementation:       "activity.BackupConfig",
+		description:          "Save the configuration of the step's components, e.g. ToR switches before a NOS upgrade",
+		validateParams:       nil, // No custom validation
+	},
 	ActionGetPowerStatus: {
 		requiredParams:       []string{},
 		optionalParams:       []string{},
@@ -91,6 +110,15 @@ var actionRegistry = map[string]actionSpec{
 		description:          "Start firmware update and poll for completion (upgrade/downgrade)",
 		validateParams:       nil,
 	},
+	ActionAwaitApproval: {
+		requiredParams:       []string{ParamPrompt},
+		optionalParams:       []string{ParamDefault},
+		requiresPollInterval: false,
+		requiresTimeout:      true,
+		implementation:       "workflow signal (ApproveTaskStep/RejectTaskStep)",
+		description:          "Wait for a person to approve the step, applying the default on timeout",
+		validateParams:       validateAwaitApprovalParams,
+	},
 }
 
 // Validate validates an action configuration
@@ -175,6 +203,27 @@ func validateVerifyPowerStatusParams(params map[string]any) error {
 	return nil
 }
 
+// validateAwaitApprovalParams validates AwaitApproval params
+func validateAwaitApprovalParams(params map[string]any) error {
+	prompt, ok := params[ParamPrompt].(string)
+	if !ok || prompt == "" {
+		return fmt.Errorf("%s must be a non-empty string", ParamPrompt)
+	}
+
+	def, ok := params[ParamDefault]
+	if !ok {
+		return nil
+	}
+
+	defStr, ok := def.(string)
+	if !ok {
+		return fmt.Errorf("%s must be string, got %T", ParamDefault, def)
+	}
+
+	_, err := common.ApprovalDecisionFromString(defStr)
+	return err
+}
+
 // validateVerifyReachabilityParams validates VerifyReachability params
 func validateVerifyReachabilityParams(params map[string]any) error {
 	types, ok := params[ParamComponentTypes]
@@ -122,6 +122,31 @@ func TestActionConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid component type",
 		},
+		{
+			name: "valid VerifyCoolingHealth action",
+			config: ActionConfig{
+				Name:         ActionVerifyCoolingHealth,
+				Timeout:      5 * time.Minute,
+				PollInterval: 15 * time.Second,
+			},
+			wantErr: false,
+		},
+		{
+			name: "VerifyCoolingHealth missing timeout",
+			config: ActionConfig{
+				Name:         ActionVerifyCoolingHealth,
+				PollInterval: 15 * time.Second,
+			},
+			wantErr: true,
+			errMsg:  "timeout",
+		},
+		{
+			name: "valid BackupConfig action",
+			config: ActionConfig{
+				Name: ActionBackupConfig,
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid PowerControl action",
 			config: ActionConfig{
@@ -129,6 +154,42 @@ func TestActionConfig_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid AwaitApproval action",
+			config: ActionConfig{
+				Name:    ActionAwaitApproval,
+				Timeout: 4 * time.Hour,
+				Parameters: map[string]any{
+					ParamPrompt:  "NVLSwitch trays are up; proceed to compute?",
+					ParamDefault: "abort",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AwaitApproval missing timeout",
+			config: ActionConfig{
+				Name: ActionAwaitApproval,
+				Parameters: map[string]any{
+					ParamPrompt: "Proceed?",
+				},
+			},
+			wantErr: true,
+			errMsg:  "requires timeout",
+		},
+		{
+			name: "AwaitApproval invalid default",
+			config: ActionConfig{
+				Name:    ActionAwaitApproval,
+				Timeout: time.Hour,
+				Parameters: map[string]any{
+					ParamPrompt:  "Proceed?",
+					ParamDefault: "maybe",
+				},
+			},
+			wantErr: true,
+			errMsg:  "invalid approval decision",
+		},
 		{
 			name: "unknown action",
 			config: ActionConfig{
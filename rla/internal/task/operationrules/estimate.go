@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operationrules
+
+import (
+	"time"
+)
+
+const (
+	// DefaultActionTimeout bounds an action that has no timeout of its own
+	// when its step sets none either.
+	DefaultActionTimeout = 20 * time.Minute
+
+	// DefaultFirmwarePollTimeout is how long FirmwareControl polls for
+	// completion when poll_timeout is not set.
+	DefaultFirmwarePollTimeout = 30 * time.Minute
+)
+
+// StepBatches splits the components of a step into the batches the executor
+// dispatches them in. Components only run in separate batches when they run
+// one by one, which an on_failure threshold requires; otherwise the whole set
+// is handed to the component manager at once.
+func StepBatches[T any](step *SequenceStep, components []T) [][]T {
+	if len(components) == 0 {
+		return nil
+	}
+
+	size := len(components)
+	if step.OnFailure.ToleratesFailures() && step.MaxParallel > 0 &&
+		step.MaxParallel < size {
+		size = step.MaxParallel
+	}
+
+	batches := make([][]T, 0, (len(components)+size-1)/size)
+	for start := 0; start < len(components); start += size {
+		end := min(start+size, len(components))
+		batches = append(batches, components[start:end])
+	}
+
+	return batches
+}
+
+// EstimateDuration returns an upper bound for one pass of the step's pre,
+// main and post actions over a batch of components. Sleeps count for their
+// duration and every other action for its timeout; retries and delay_after
+// are not included.
+func (step *SequenceStep) EstimateDuration() time.Duration {
+	var total time.Duration
+	for _, action := range step.PreOperation {
+		total += step.estimateAction(action)
+	}
+	total += step.estimateAction(step.MainOperation)
+	for _, action := range step.PostOperation {
+		total += step.estimateAction(action)
+	}
+
+	return total
+}
+
+// estimateAction returns an upper bound for a single action of the step.
+func (step *SequenceStep) estimateAction(action ActionConfig) time.Duration {
+	switch {
+	case action.Name == "":
+		return 0
+	case action.Name == ActionSleep:
+		return durationParam(action.Parameters[ParamDuration])
+	case action.Timeout > 0:
+		return action.Timeout
+	case action.Name == ActionFirmwareControl:
+		if d := durationParam(action.Parameters[ParamPollTimeout]); d > 0 {
+			return d
+		}
+		return DefaultFirmwarePollTimeout
+	case step.Timeout > 0:
+		return step.Timeout
+	default:
+		return DefaultActionTimeout
+	}
+}
+
+// durationParam reads a duration parameter as the executor does: a
+// time.Duration, a duration string, or a number of nanoseconds.
+func durationParam(val any) time.Duration {
+	switch v := val.(type) {
+	case time.Duration:
+		return v
+	case string:
+		d, _ := time.ParseDuration(v)
+		return d
+	case float64:
+		return time.Duration(v)
+	case int:
+		return time.Duration(v)
+	default:
+		return 0
+	}
+}
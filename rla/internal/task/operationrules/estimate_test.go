@@ -0,0 +1,115 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operationrules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepBatches(t *testing.T) {
+	components := []int{1, 2, 3, 4, 5}
+
+	testCases := map[string]struct {
+		step     SequenceStep
+		expected [][]int
+	}{
+		"max_parallel without threshold runs in one batch": {
+			step:     SequenceStep{MaxParallel: 2},
+			expected: [][]int{{1, 2, 3, 4, 5}},
+		},
+		"threshold with max_parallel batches": {
+			step: SequenceStep{
+				MaxParallel: 2,
+				OnFailure:   &FailurePolicy{MaxFailed: 1},
+			},
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		"threshold without max_parallel runs in one batch": {
+			step:     SequenceStep{OnFailure: &FailurePolicy{MaxFailed: 1}},
+			expected: [][]int{{1, 2, 3, 4, 5}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, StepBatches(&tc.step, components))
+		})
+	}
+
+	assert.Nil(t, StepBatches(&SequenceStep{}, []int{}))
+}
+
+func TestSequenceStep_EstimateDuration(t *testing.T) {
+	testCases := map[string]struct {
+		step     SequenceStep
+		expected time.Duration
+	}{
+		"default action timeout": {
+			step: SequenceStep{
+				MainOperation: ActionConfig{Name: ActionPowerControl},
+			},
+			expected: DefaultActionTimeout,
+		},
+		"step timeout bounds actions": {
+			step: SequenceStep{
+				Timeout:       5 * time.Minute,
+				MainOperation: ActionConfig{Name: ActionPowerControl},
+			},
+			expected: 5 * time.Minute,
+		},
+		"sleeps and action timeouts": {
+			step: SequenceStep{
+				Timeout: 5 * time.Minute,
+				PreOperation: []ActionConfig{{
+					Name:       ActionSleep,
+					Parameters: map[string]any{ParamDuration: "30s"},
+				}},
+				MainOperation: ActionConfig{Name: ActionPowerControl},
+				PostOperation: []ActionConfig{{
+					Name:    ActionVerifyPowerStatus,
+					Timeout: time.Minute,
+				}},
+			},
+			expected: 6*time.Minute + 30*time.Second,
+		},
+		"firmware poll timeout": {
+			step: SequenceStep{
+				MainOperation: ActionConfig{
+					Name:       ActionFirmwareControl,
+					Parameters: map[string]any{ParamPollTimeout: "45m"},
+				},
+			},
+			expected: 45 * time.Minute,
+		},
+		"firmware default poll timeout": {
+			step: SequenceStep{
+				MainOperation: ActionConfig{Name: ActionFirmwareControl},
+			},
+			expected: DefaultFirmwarePollTimeout,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.step.EstimateDuration())
+		})
+	}
+}
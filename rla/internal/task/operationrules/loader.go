@@ -146,9 +146,18 @@ type YAMLStep struct {
 	PreOperation  []YAMLActionConfig `yaml:"pre_operation,omitempty"`
 	MainOperation YAMLActionConfig   `yaml:"main_operation"`
 	PostOperation []YAMLActionConfig `yaml:"post_operation,omitempty"`
+	OnFailure     *YAMLFailurePolicy `yaml:"on_failure,omitempty"`
 	DelayAfter    string             `yaml:"delay_after,omitempty"` // Legacy
 }
 
+// YAMLFailurePolicy represents failure handling configuration in YAML
+type YAMLFailurePolicy struct {
+	MaxFailed        int                `yaml:"max_failed,omitempty"`
+	MaxFailedPercent int                `yaml:"max_failed_percent,omitempty"`
+	ContinueOnError  bool               `yaml:"continue_on_error,omitempty"`
+	Compensate       []YAMLActionConfig `yaml:"compensate,omitempty"`
+}
+
 // YAMLActionConfig represents an action configuration in YAML
 type YAMLActionConfig struct {
 	Name         string         `yaml:"name"`
@@ -189,6 +198,21 @@ func (yr *YAMLRetryPolicy) toRetryPolicy() (*RetryPolicy, error) {
 	return retryPolicy, nil
 }
 
+// toFailurePolicy converts YAML failure policy to FailurePolicy
+func (yf *YAMLFailurePolicy) toFailurePolicy() (*FailurePolicy, error) {
+	compensate, err := toActionConfigs(yf.Compensate)
+	if err != nil {
+		return nil, fmt.Errorf("compensate: %w", err)
+	}
+
+	return &FailurePolicy{
+		MaxFailed:        yf.MaxFailed,
+		MaxFailedPercent: yf.MaxFailedPercent,
+		ContinueOnError:  yf.ContinueOnError,
+		Compensate:       compensate,
+	}, nil
+}
+
 // toActionConfig converts YAML action config to ActionConfig
 func (ya *YAMLActionConfig) toActionConfig() (ActionConfig, error) {
 	action := ActionConfig{
@@ -274,6 +298,15 @@ func (ys *YAMLStep) toSequenceStep() (SequenceStep, error) {
 	}
 	step.PostOperation = postOps
 
+	// Convert failure policy if present
+	if ys.OnFailure != nil {
+		onFailure, err := ys.OnFailure.toFailurePolicy()
+		if err != nil {
+			return SequenceStep{}, fmt.Errorf("on_failure: %w", err)
+		}
+		step.OnFailure = onFailure
+	}
+
 	// Legacy field support
 	d, err = ParseDuration(ys.DelayAfter, "delay_after")
 	if err != nil {
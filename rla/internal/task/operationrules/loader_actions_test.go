@@ -552,6 +552,46 @@ rules:
 			wantErr: true,
 			errMsg:  "unknown action",
 		},
+		{
+			name: "on_failure with both thresholds",
+			yaml: `version: v1
+rules:
+  - name: "Invalid Rule"
+    operation_type: firmware_control
+    operation: upgrade
+    steps:
+      - component_type: compute
+        stage: 1
+        main_operation:
+          name: FirmwareControl
+        on_failure:
+          max_failed: 1
+          max_failed_percent: 10
+`,
+			wantErr: true,
+			errMsg:  "mutually exclusive",
+		},
+		{
+			name: "on_failure invalid compensating duration",
+			yaml: `version: v1
+rules:
+  - name: "Invalid Rule"
+    operation_type: firmware_control
+    operation: upgrade
+    steps:
+      - component_type: compute
+        stage: 1
+        main_operation:
+          name: FirmwareControl
+        on_failure:
+          compensate:
+            - name: Sleep
+              parameters:
+                duration: soon
+`,
+			wantErr: true,
+			errMsg:  "on_failure: compensate",
+		},
 	}
 
 	for _, tt := range tests {
@@ -592,3 +632,77 @@ rules:
 		})
 	}
 }
+
+func TestYAMLRuleLoader_OnFailure(t *testing.T) {
+	yamlContent := `version: v1
+rules:
+  - name: "Upgrade with failure handling"
+    operation_type: firmware_control
+    operation: upgrade
+    steps:
+      - component_type: compute
+        stage: 1
+        max_parallel: 4
+        main_operation:
+          name: FirmwareControl
+        on_failure:
+          max_failed_percent: 10
+          continue_on_error: true
+          compensate:
+            - name: FirmwareControl
+              parameters:
+                operation: rollback
+            - name: Sleep
+              parameters:
+                duration: 30s
+`
+
+	tmpfile, err := os.CreateTemp("", "test-on-failure-*.yaml")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	if _, err := tmpfile.Write([]byte(yamlContent)); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		t.Fatalf("Failed to close temp file: %v", err)
+	}
+
+	loader, err := NewYAMLRuleLoader(tmpfile.Name())
+	if err != nil {
+		t.Fatalf("NewYAMLRuleLoader() error = %v", err)
+	}
+
+	rules, err := loader.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	rule := rules[common.TaskTypeFirmwareControl][SequenceUpgrade]
+	if rule == nil {
+		t.Fatal("No upgrade rule loaded")
+	}
+
+	onFailure := rule.RuleDefinition.Steps[0].OnFailure
+	if onFailure == nil {
+		t.Fatal("step[0].OnFailure is nil")
+	}
+	if onFailure.MaxFailedPercent != 10 {
+		t.Errorf("OnFailure.MaxFailedPercent = %d, want 10", onFailure.MaxFailedPercent)
+	}
+	if !onFailure.ContinueOnError {
+		t.Error("OnFailure.ContinueOnError = false, want true")
+	}
+	if len(onFailure.Compensate) != 2 {
+		t.Fatalf("len(OnFailure.Compensate) = %d, want 2", len(onFailure.Compensate))
+	}
+	if op := onFailure.Compensate[0].Parameters[ParamOperation]; op != "rollback" {
+		t.Errorf("Compensate[0].Parameters[operation] = %v, want rollback", op)
+	}
+	if d, ok := onFailure.Compensate[1].Parameters[ParamDuration].(time.Duration); !ok || d != 30*time.Second {
+		t.Errorf("Compensate[1].Parameters[duration] = %v, want 30s",
+			onFailure.Compensate[1].Parameters[ParamDuration])
+	}
+}
@@ -50,6 +50,21 @@ func NewResolver(store RuleStore) *Resolver {
 	}
 }
 
+// RuleSource records where the Resolver found the rule it returned.
+type RuleSource string
+
+const (
+	// RuleSourceExplicit is a rule named by the caller.
+	RuleSourceExplicit RuleSource = "explicit"
+	// RuleSourceRackAssociation is a rule associated with the rack.
+	RuleSourceRackAssociation RuleSource = "rack_association"
+	// RuleSourceDefault is the database default for the operation. A rack
+	// associated with the default rule also reports this source.
+	RuleSourceDefault RuleSource = "default"
+	// RuleSourceHardcoded is the built-in fallback rule.
+	RuleSourceHardcoded RuleSource = "hardcoded"
+)
+
 // ResolveRule resolves the operation rule for a given operation type, operation, and rack.
 // It always returns a rule (never nil) or an error. The resolution follows this priority:
 // 0. Explicit rule ID override (caller-specified, highest priority)
@@ -62,22 +77,35 @@ func (r *Resolver) ResolveRule(
 	rackID uuid.UUID,
 	ruleID *uuid.UUID,
 ) (*OperationRule, error) {
+	rule, _, err := r.ResolveRuleWithSource(ctx, operationType, operation, rackID, ruleID)
+	return rule, err
+}
+
+// ResolveRuleWithSource is ResolveRule that also reports which level of the
+// priority hierarchy supplied the rule.
+func (r *Resolver) ResolveRuleWithSource(
+	ctx context.Context,
+	operationType common.TaskType,
+	operation string,
+	rackID uuid.UUID,
+	ruleID *uuid.UUID,
+) (*OperationRule, RuleSource, error) {
 	if r == nil {
 		// If resolver is nil, return hardcoded default
 		if rule := getHardcodedDefaultRule(operationType, operation); rule != nil {
-			return rule, nil
+			return rule, RuleSourceHardcoded, nil
 		}
-		return nil, fmt.Errorf("resolver is nil and no hardcoded default found for %s/%s", operationType, operation)
+		return nil, "", fmt.Errorf("resolver is nil and no hardcoded default found for %s/%s", operationType, operation)
 	}
 
 	// Priority 0: Explicit rule ID override
 	if ruleID != nil && *ruleID != uuid.Nil {
 		rule, err := r.store.GetRule(ctx, *ruleID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch explicitly requested rule %s: %w", ruleID, err)
+			return nil, "", fmt.Errorf("failed to fetch explicitly requested rule %s: %w", ruleID, err)
 		}
 		if rule == nil {
-			return nil, fmt.Errorf("explicitly requested rule %s not found", ruleID)
+			return nil, "", fmt.Errorf("explicitly requested rule %s not found", ruleID)
 		}
 		log.Info().
 			Str("rule_id", ruleID.String()).
@@ -86,7 +114,7 @@ func (r *Resolver) ResolveRule(
 			Str("operation", operation).
 			Str("rack_id", rackID.String()).
 			Msg("Using explicitly requested operation rule")
-		return rule, nil
+		return rule, RuleSourceExplicit, nil
 	}
 
 	// Priority 1: Query the database for the rule (rack association or default)
@@ -106,7 +134,10 @@ func (r *Resolver) ResolveRule(
 			Str("rack_id", rackID.String()).
 			Str("rule_name", dbRule.Name).
 			Msg("Using database operation rule")
-		return dbRule, nil
+		if dbRule.IsDefault {
+			return dbRule, RuleSourceDefault, nil
+		}
+		return dbRule, RuleSourceRackAssociation, nil
 	}
 
 	// Priority 2: Fall back to hardcoded default rule
@@ -117,11 +148,11 @@ func (r *Resolver) ResolveRule(
 
 	hardcoded := getHardcodedDefaultRule(operationType, operation)
 	if hardcoded != nil {
-		return hardcoded, nil
+		return hardcoded, RuleSourceHardcoded, nil
 	}
 
 	// This should never happen since hardcoded defaults cover all operations
-	return nil, fmt.Errorf("no rule or hardcoded default found for %s/%s", operationType, operation)
+	return nil, "", fmt.Errorf("no rule or hardcoded default found for %s/%s", operationType, operation)
 }
 
 // getHardcodedDefaultRule returns a pre-built hardcoded default rule for a specific operation.
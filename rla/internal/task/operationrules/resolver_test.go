@@ -219,3 +219,48 @@ func TestResolveRule_NilResolver_IgnoresRuleID(t *testing.T) {
 	require.NotNil(t, rule)
 	assert.Equal(t, "Hardcoded Default Power On", rule.Name)
 }
+
+func TestResolveRuleWithSource(t *testing.T) {
+	ctx := context.Background()
+	rackID := uuid.New()
+	explicitID := uuid.New()
+
+	testCases := map[string]struct {
+		assocRule *OperationRule
+		ruleID    *uuid.UUID
+		expected  RuleSource
+	}{
+		"explicit rule": {
+			assocRule: &OperationRule{Name: "Rack Association Rule"},
+			ruleID:    &explicitID,
+			expected:  RuleSourceExplicit,
+		},
+		"rack association": {
+			assocRule: &OperationRule{Name: "Rack Association Rule"},
+			expected:  RuleSourceRackAssociation,
+		},
+		"database default": {
+			assocRule: &OperationRule{Name: "Default Rule", IsDefault: true},
+			expected:  RuleSourceDefault,
+		},
+		"hardcoded": {
+			expected: RuleSourceHardcoded,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store := newMockRuleStore()
+			store.rackAssocRule = tc.assocRule
+			store.addRule(&OperationRule{ID: explicitID, Name: "Explicit"})
+
+			rule, source, err := NewResolver(store).ResolveRuleWithSource(
+				ctx, common.TaskTypePowerControl, SequencePowerOn, rackID, tc.ruleID,
+			)
+
+			require.NoError(t, err)
+			require.NotNil(t, rule)
+			assert.Equal(t, tc.expected, source)
+		})
+	}
+}
@@ -41,11 +41,17 @@ const (
 	ActionFirmwareControl           = "FirmwareControl"
 	ActionVerifyFirmwareVersion     = "VerifyFirmwareVersion"
 	ActionVerifyFirmwareConsistency = "VerifyFirmwareConsistency"
+	ActionVerifyCoolingHealth       = "VerifyCoolingHealth"
+	ActionBackupConfig              = "BackupConfig"
 
 	// Bring-up specific actions
 	ActionBringUpControl    = "BringUpControl"
 	ActionWaitBringUp       = "WaitBringUp"
 	ActionInjectExpectation = "InjectExpectation"
+
+	// ActionAwaitApproval pauses the step until a person approves or rejects
+	// it through the API, or the timeout applies the default decision.
+	ActionAwaitApproval = "AwaitApproval"
 )
 
 // Parameter keys for ActionConfig.Parameters
@@ -57,6 +63,8 @@ const (
 	ParamPollInterval   = "poll_interval"   // For FirmwareControl: firmware update poll interval
 	ParamPollTimeout    = "poll_timeout"    // For FirmwareControl: firmware update poll timeout
 	ParamRequireAll     = "require_all"     // For VerifyReachability: require every component to respond
+	ParamPrompt         = "prompt"          // For AwaitApproval: text shown to the approver
+	ParamDefault        = "default"         // For AwaitApproval: decision applied on timeout (approve/reject/abort)
 )
 
 // RackRuleAssociation represents an association between a rack and an operation rule.
@@ -197,6 +205,9 @@ type SequenceStep struct {
 	MainOperation ActionConfig   `json:"main_operation"`           // Primary operation
 	PostOperation []ActionConfig `json:"post_operation,omitempty"` // After main operation
 
+	// What happens when the step fails (nil = fail fast, no compensation)
+	OnFailure *FailurePolicy `json:"on_failure,omitempty"`
+
 	// Legacy field for backward compatibility (deprecated: use MainOperation)
 	DelayAfter time.Duration `json:"delay_after,omitempty"`
 }
@@ -209,6 +220,53 @@ type RetryPolicy struct {
 	MaxInterval        time.Duration `json:"max_interval,omitempty"` // Parsed once at rule creation time
 }
 
+// FailurePolicy defines how a step reacts when its components fail.
+//
+// Without a threshold the step runs against all of its components at once
+// and fails as soon as an action fails. With max_failed or max_failed_percent
+// set, components run individually (batched by max_parallel) and the step only
+// fails once more components than allowed have failed; the failed components
+// are dropped from later stages.
+type FailurePolicy struct {
+	// Number of components that may fail without failing the step
+	MaxFailed int `json:"max_failed,omitempty"`
+
+	// Percentage (0-100) of components that may fail without failing the step
+	MaxFailedPercent int `json:"max_failed_percent,omitempty"`
+
+	// Run the remaining stages even if this step fails. The operation still
+	// ends as failed once they have run.
+	ContinueOnError bool `json:"continue_on_error,omitempty"`
+
+	// Actions that undo the step, run against all of its components when
+	// the step fails (e.g. power the shelf back on, roll back firmware)
+	Compensate []ActionConfig `json:"compensate,omitempty"`
+}
+
+// ToleratesFailures reports whether the policy allows some components to
+// fail without failing the step. It is safe to call on a nil policy.
+func (fp *FailurePolicy) ToleratesFailures() bool {
+	return fp != nil && (fp.MaxFailed > 0 || fp.MaxFailedPercent > 0)
+}
+
+// AllowedFailures returns how many of total components may fail without
+// failing the step. Percentages round down.
+func (fp *FailurePolicy) AllowedFailures(total int) int {
+	if fp == nil {
+		return 0
+	}
+	if fp.MaxFailedPercent > 0 {
+		return total * fp.MaxFailedPercent / 100
+	}
+	return fp.MaxFailed
+}
+
+// ContinuesOnError reports whether later stages run after the step fails.
+// It is safe to call on a nil policy.
+func (fp *FailurePolicy) ContinuesOnError() bool {
+	return fp != nil && fp.ContinueOnError
+}
+
 // MarshalJSON customizes JSON output for ActionConfig
 func (ac ActionConfig) MarshalJSON() ([]byte, error) {
 	type Alias ActionConfig
@@ -617,9 +675,43 @@ func (step *SequenceStep) Validate() error {
 		}
 	}
 
+	// Validate failure policy if present
+	if step.OnFailure != nil {
+		if err := step.OnFailure.Validate(); err != nil {
+			return fmt.Errorf("invalid on_failure: %w", err)
+		}
+
+		// Thresholds run every component on its own, which would raise
+		// one approval gate per component.
+		if step.OnFailure.ToleratesFailures() && step.hasAction(ActionAwaitApproval) {
+			return fmt.Errorf(
+				"on_failure thresholds cannot be combined with %s actions",
+				ActionAwaitApproval,
+			)
+		}
+	}
+
 	return nil
 }
 
+// hasAction returns whether any pre, main or post action of the step is name.
+func (step *SequenceStep) hasAction(name string) bool {
+	if step.MainOperation.Name == name {
+		return true
+	}
+	for _, action := range step.PreOperation {
+		if action.Name == name {
+			return true
+		}
+	}
+	for _, action := range step.PostOperation {
+		if action.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // DoPreOperations returns whether there are pre-operation actions to execute
 // and the list of actions. This encapsulates the check and data access.
 func (step *SequenceStep) DoPreOperations() (bool, []ActionConfig) {
@@ -647,6 +739,47 @@ func (step *SequenceStep) DoPostOperations() (bool, []ActionConfig) {
 	return true, step.PostOperation
 }
 
+// DoCompensation returns whether there are compensating actions to execute
+// when the step fails and the list of actions.
+func (step *SequenceStep) DoCompensation() (bool, []ActionConfig) {
+	if step.OnFailure == nil || len(step.OnFailure.Compensate) == 0 {
+		return false, nil
+	}
+	return true, step.OnFailure.Compensate
+}
+
+// Validate validates a failure policy
+func (fp *FailurePolicy) Validate() error {
+	if fp.MaxFailed < 0 {
+		return fmt.Errorf("max_failed must be >= 0, got %d", fp.MaxFailed)
+	}
+
+	if fp.MaxFailedPercent < 0 || fp.MaxFailedPercent > 100 {
+		return fmt.Errorf(
+			"max_failed_percent must be between 0 and 100, got %d",
+			fp.MaxFailedPercent,
+		)
+	}
+
+	if fp.MaxFailed > 0 && fp.MaxFailedPercent > 0 {
+		return fmt.Errorf("max_failed and max_failed_percent are mutually exclusive")
+	}
+
+	for i, action := range fp.Compensate {
+		if err := action.Validate(); err != nil {
+			return fmt.Errorf("compensate[%d]: %w", i, err)
+		}
+		if action.Name == ActionAwaitApproval {
+			return fmt.Errorf(
+				"compensate[%d]: %s cannot be used as a compensating action",
+				i, ActionAwaitApproval,
+			)
+		}
+	}
+
+	return nil
+}
+
 // Validate validates a retry policy
 func (rp *RetryPolicy) Validate() error {
 	if rp.MaxAttempts < 1 {
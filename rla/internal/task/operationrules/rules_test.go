@@ -465,6 +465,125 @@ func TestSequenceStep_Validate(t *testing.T) {
 		}
 		assert.Error(t, step.Validate())
 	})
+
+	t.Run("valid on_failure", func(t *testing.T) {
+		step := SequenceStep{
+			ComponentType: devicetypes.ComponentTypePowerShelf,
+			Stage:         1,
+			MainOperation: ActionConfig{Name: ActionPowerControl},
+			OnFailure: &FailurePolicy{
+				MaxFailedPercent: 25,
+				ContinueOnError:  true,
+				Compensate: []ActionConfig{
+					{
+						Name:       ActionPowerControl,
+						Parameters: map[string]any{ParamOperation: "power_on"},
+					},
+				},
+			},
+		}
+		assert.NoError(t, step.Validate())
+	})
+
+	t.Run("on_failure with both thresholds", func(t *testing.T) {
+		step := SequenceStep{
+			ComponentType: devicetypes.ComponentTypeCompute,
+			Stage:         1,
+			MainOperation: ActionConfig{Name: ActionPowerControl},
+			OnFailure:     &FailurePolicy{MaxFailed: 1, MaxFailedPercent: 10},
+		}
+		assert.ErrorContains(t, step.Validate(), "mutually exclusive")
+	})
+
+	t.Run("on_failure percent out of range", func(t *testing.T) {
+		step := SequenceStep{
+			ComponentType: devicetypes.ComponentTypeCompute,
+			Stage:         1,
+			MainOperation: ActionConfig{Name: ActionPowerControl},
+			OnFailure:     &FailurePolicy{MaxFailedPercent: 150},
+		}
+		assert.ErrorContains(t, step.Validate(), "between 0 and 100")
+	})
+
+	t.Run("on_failure negative max_failed", func(t *testing.T) {
+		step := SequenceStep{
+			ComponentType: devicetypes.ComponentTypeCompute,
+			Stage:         1,
+			MainOperation: ActionConfig{Name: ActionPowerControl},
+			OnFailure:     &FailurePolicy{MaxFailed: -1},
+		}
+		assert.ErrorContains(t, step.Validate(), "max_failed must be >= 0")
+	})
+
+	t.Run("on_failure invalid compensating action", func(t *testing.T) {
+		step := SequenceStep{
+			ComponentType: devicetypes.ComponentTypeCompute,
+			Stage:         1,
+			MainOperation: ActionConfig{Name: ActionPowerControl},
+			OnFailure: &FailurePolicy{
+				Compensate: []ActionConfig{{Name: ActionSleep}},
+			},
+		}
+		assert.ErrorContains(t, step.Validate(), "compensate[0]")
+	})
+
+	t.Run("on_failure threshold with approval gate", func(t *testing.T) {
+		step := SequenceStep{
+			ComponentType: devicetypes.ComponentTypeCompute,
+			Stage:         1,
+			MainOperation: ActionConfig{Name: ActionPowerControl},
+			PostOperation: []ActionConfig{
+				{
+					Name:       ActionAwaitApproval,
+					Timeout:    time.Hour,
+					Parameters: map[string]any{ParamPrompt: "Proceed?"},
+				},
+			},
+			OnFailure: &FailurePolicy{MaxFailed: 1},
+		}
+		assert.ErrorContains(t, step.Validate(), "cannot be combined")
+	})
+}
+
+func TestFailurePolicy_AllowedFailures(t *testing.T) {
+	var none *FailurePolicy
+	assert.False(t, none.ToleratesFailures())
+	assert.False(t, none.ContinuesOnError())
+	assert.Equal(t, 0, none.AllowedFailures(10))
+
+	assert.Equal(t, 2, (&FailurePolicy{MaxFailed: 2}).AllowedFailures(10))
+	assert.Equal(t, 4, (&FailurePolicy{MaxFailedPercent: 25}).AllowedFailures(18))
+	assert.Equal(t, 0, (&FailurePolicy{MaxFailedPercent: 10}).AllowedFailures(9))
+	assert.False(t, (&FailurePolicy{ContinueOnError: true}).ToleratesFailures())
+}
+
+func TestSequenceStep_OnFailure_RoundTrip(t *testing.T) {
+	original := SequenceStep{
+		ComponentType: devicetypes.ComponentTypeCompute,
+		Stage:         2,
+		MainOperation: ActionConfig{Name: ActionFirmwareControl},
+		OnFailure: &FailurePolicy{
+			MaxFailed:       1,
+			ContinueOnError: true,
+			Compensate: []ActionConfig{
+				{
+					Name:       ActionFirmwareControl,
+					Parameters: map[string]any{ParamOperation: "rollback"},
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(original)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"on_failure":{"max_failed":1,"continue_on_error":true,"compensate":[`)
+
+	var decoded SequenceStep
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, original.OnFailure.MaxFailed, decoded.OnFailure.MaxFailed)
+	assert.True(t, decoded.OnFailure.ContinueOnError)
+	assert.Len(t, decoded.OnFailure.Compensate, 1)
+	assert.Equal(t, "rollback", decoded.OnFailure.Compensate[0].Parameters[ParamOperation])
 }
 
 func TestRetryPolicy_Validate(t *testing.T) {
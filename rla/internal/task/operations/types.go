@@ -174,3 +174,15 @@ func (o FirmwareOperation) CodeString() string {
 	}
 	return taskcommon.OpCodeFirmwareControlUpgrade // Default fallback
 }
+
+// FirmwareOperationFromString returns the FirmwareOperation for a given
+// operation code string (e.g. "upgrade", "rollback"). Returns
+// FirmwareOperationUnknown if the code is not recognized.
+func FirmwareOperationFromString(code string) FirmwareOperation {
+	for op, c := range firmwareOperationCodes {
+		if c == code {
+			return op
+		}
+	}
+	return FirmwareOperationUnknown
+}
@@ -109,6 +109,33 @@ func TestFirmwareOperationCodeString(t *testing.T) {
 	}
 }
 
+func TestFirmwareOperationFromString(t *testing.T) {
+	knownOps := []FirmwareOperation{
+		FirmwareOperationUpgrade,
+		FirmwareOperationDowngrade,
+		FirmwareOperationRollback,
+	}
+
+	for _, op := range knownOps {
+		t.Run(op.String(), func(t *testing.T) {
+			code := op.CodeString()
+			got := FirmwareOperationFromString(code)
+			if got != op {
+				t.Errorf("FirmwareOperationFromString(%q) = %v, want %v", code, got, op)
+			}
+		})
+	}
+
+	for _, code := range []string{"", "version", "UPGRADE"} {
+		t.Run("unknown_"+code, func(t *testing.T) {
+			got := FirmwareOperationFromString(code)
+			if got != FirmwareOperationUnknown {
+				t.Errorf("FirmwareOperationFromString(%q) = %v, want FirmwareOperationUnknown", code, got)
+			}
+		})
+	}
+}
+
 func TestExtractRuleID(t *testing.T) {
 	validID := uuid.New()
 
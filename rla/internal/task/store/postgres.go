@@ -157,6 +157,13 @@ func (s *PostgresStore) UpdateTaskStatus(
 	ctx context.Context,
 	arg *taskdef.TaskStatusUpdate,
 ) error {
+	if arg.Approval != nil {
+		return s.updateTaskApproval(ctx, arg)
+	}
+	if arg.Preemption != nil {
+		return s.updateTaskPreemption(ctx, arg)
+	}
+
 	taskDao := &model.Task{
 		ID: arg.ID,
 	}
@@ -169,7 +176,72 @@ func (s *PostgresStore) UpdateTaskStatus(
 	return nil
 }
 
-// ListActiveTasksForRack returns pending and running tasks for the given rack.
+// updateTaskApproval records arg.Approval in the task attributes and applies
+// the status change in one transaction. The task row is locked so that
+// parallel steps recording their own gates do not overwrite each other.
+func (s *PostgresStore) updateTaskApproval(
+	ctx context.Context,
+	arg *taskdef.TaskStatusUpdate,
+) error {
+	err := s.pg.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		taskDao, err := model.GetTaskForUpdate(ctx, tx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		taskDao.Attributes.SetApproval(*arg.Approval)
+		if err := taskDao.UpdateAttributes(ctx, tx); err != nil {
+			return err
+		}
+
+		// A step resuming after its decision must not hide a gate that a
+		// parallel step is still waiting on.
+		status, message := arg.Status, arg.Message
+		if pending := taskDao.Attributes.PendingApprovals(); len(pending) > 0 &&
+			status == taskcommon.TaskStatusRunning {
+			status = taskcommon.TaskStatusWaitingApproval
+			message = pending[0].Prompt
+		}
+
+		return taskDao.UpdateTaskStatus(ctx, tx, status, message)
+	})
+	if err != nil {
+		return errors.GRPCErrorInternal(err.Error())
+	}
+
+	return nil
+}
+
+// updateTaskPreemption records arg.Preemption in the task attributes and
+// applies the status change in one transaction.
+func (s *PostgresStore) updateTaskPreemption(
+	ctx context.Context,
+	arg *taskdef.TaskStatusUpdate,
+) error {
+	err := s.pg.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		taskDao, err := model.GetTaskForUpdate(ctx, tx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		taskDao.Attributes.Preemptions = append(
+			taskDao.Attributes.Preemptions, *arg.Preemption,
+		)
+		if err := taskDao.UpdateAttributes(ctx, tx); err != nil {
+			return err
+		}
+
+		return taskDao.UpdateTaskStatus(ctx, tx, arg.Status, arg.Message)
+	})
+	if err != nil {
+		return errors.GRPCErrorInternal(err.Error())
+	}
+
+	return nil
+}
+
+// ListActiveTasksForRack returns pending, running and waiting-approval tasks
+// for the given rack.
 func (s *PostgresStore) ListActiveTasksForRack(
 	ctx context.Context,
 	rackID uuid.UUID,
@@ -179,6 +251,7 @@ func (s *PostgresStore) ListActiveTasksForRack(
 		[]taskcommon.TaskStatus{
 			taskcommon.TaskStatusPending,
 			taskcommon.TaskStatusRunning,
+			taskcommon.TaskStatusWaitingApproval,
 		},
 	)
 	if err != nil {
@@ -235,6 +308,42 @@ func (s *PostgresStore) CountWaitingTasksForRack(
 	return count, nil
 }
 
+// AppendTaskReport appends entries to the report of a task.
+func (s *PostgresStore) AppendTaskReport(
+	ctx context.Context,
+	taskID uuid.UUID,
+	entries []taskdef.ReportEntry,
+) error {
+	daos := make([]model.TaskReportEntry, 0, len(entries))
+	for _, entry := range entries {
+		daos = append(daos, dao.TaskReportEntryTo(taskID, entry))
+	}
+
+	if err := model.CreateTaskReportEntries(ctx, s.idb(ctx), daos); err != nil {
+		return errors.GRPCErrorInternal(err.Error())
+	}
+
+	return nil
+}
+
+// GetTaskReport returns the report entries of a task, oldest first.
+func (s *PostgresStore) GetTaskReport(
+	ctx context.Context,
+	taskID uuid.UUID,
+) ([]taskdef.ReportEntry, error) {
+	daos, err := model.ListTaskReportEntries(ctx, s.idb(ctx), taskID)
+	if err != nil {
+		return nil, errors.GRPCErrorInternal(err.Error())
+	}
+
+	entries := make([]taskdef.ReportEntry, 0, len(daos))
+	for i := range daos {
+		entries = append(entries, dao.TaskReportEntryFrom(&daos[i]))
+	}
+
+	return entries, nil
+}
+
 // ========================================
 // Operation Rule Methods
 // ========================================
@@ -60,7 +60,7 @@ type Store interface {
 	UpdateTaskStatus(ctx context.Context, arg *taskdef.TaskStatusUpdate) error
 
 	// ListActiveTasksForRack returns non-finished, non-waiting tasks for a rack
-	// (i.e. tasks with status pending or running).
+	// (i.e. tasks with status pending, running or waiting_approval).
 	ListActiveTasksForRack(ctx context.Context, rackID uuid.UUID) ([]*taskdef.Task, error)
 
 	// ListWaitingTasksForRack returns waiting tasks for a rack, ordered oldest-first.
@@ -73,6 +73,14 @@ type Store interface {
 	// one task in the waiting state.
 	ListRacksWithWaitingTasks(ctx context.Context) ([]uuid.UUID, error)
 
+	// AppendTaskReport appends entries to the report of a task. Entries already
+	// in the report are not appended again, so retried calls are safe.
+	AppendTaskReport(ctx context.Context, taskID uuid.UUID, entries []taskdef.ReportEntry) error
+
+	// GetTaskReport returns the report entries of a task in the order they
+	// were recorded.
+	GetTaskReport(ctx context.Context, taskID uuid.UUID) ([]taskdef.ReportEntry, error)
+
 	// Operation rule operations
 
 	// CreateRule creates a new operation rule.
@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/operationrules"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// PlanOutcome is what a submission of the planned operation would do on a
+// rack at planning time.
+type PlanOutcome string
+
+const (
+	// PlanOutcomeRun means the task would start immediately.
+	PlanOutcomeRun PlanOutcome = "run"
+	// PlanOutcomeQueue means the task would wait behind conflicting tasks.
+	PlanOutcomeQueue PlanOutcome = "queue"
+	// PlanOutcomeReject means the submission would be refused.
+	PlanOutcomeReject PlanOutcome = "reject"
+)
+
+// OperationPlan describes how an operation would execute on one rack without
+// submitting it. It reflects the rule, inventory and active tasks at the time
+// it was built; a later submission may resolve differently.
+type OperationPlan struct {
+	RackID    uuid.UUID
+	RackName  string
+	Operation operation.Wrapper
+
+	// Rule is the rule the resolver picked and RuleSource where it came from.
+	Rule       *operationrules.OperationRule
+	RuleSource operationrules.RuleSource
+
+	// Stages run in order; EstimatedDuration is their sum assuming every
+	// action runs to its timeout and nothing is retried.
+	Stages            []PlannedStage
+	EstimatedDuration time.Duration
+
+	// ConflictingTasks are the active tasks on the rack the operation would
+	// conflict with. Outcome and Reason summarize the admission decision.
+	ConflictingTasks []*Task
+	Outcome          PlanOutcome
+	Reason           string
+
+	// Warnings flag parts of the rule or inventory that will not behave as
+	// an operator might expect, e.g. steps with no components to act on.
+	Warnings []string
+}
+
+// PlannedStage is one stage of an OperationPlan. Its steps run in parallel,
+// so the stage takes as long as its slowest step.
+type PlannedStage struct {
+	Number            int
+	Steps             []PlannedStep
+	EstimatedDuration time.Duration
+}
+
+// PlannedStep is one rule step applied to the rack's components of its type.
+// Batches run one after another; the components of a batch run together.
+type PlannedStep struct {
+	ComponentType     devicetypes.ComponentType
+	MaxParallel       int
+	Batches           [][]uuid.UUID
+	Actions           []string
+	EstimatedDuration time.Duration
+}
@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+// ReportOutcome is the outcome recorded in a task report entry.
+type ReportOutcome string
+
+const (
+	// ReportOutcomeSucceeded means the action or task completed.
+	ReportOutcomeSucceeded ReportOutcome = "succeeded"
+	// ReportOutcomeFailed means the action or task returned an error.
+	ReportOutcomeFailed ReportOutcome = "failed"
+	// ReportOutcomeTerminated means the task was stopped before it finished.
+	ReportOutcomeTerminated ReportOutcome = "terminated"
+)
+
+// Steps of an operation rule stage recorded in report entries. Entries about
+// the task as a whole have an empty step.
+const (
+	ReportStepPreOperation  = "pre_operation"
+	ReportStepMainOperation = "main_operation"
+	ReportStepPostOperation = "post_operation"
+	ReportStepCompensation  = "compensation"
+)
+
+// ReportEntry is one line of a task report: what happened to a component at
+// which point of the operation. ComponentID is empty for entries about the
+// task as a whole.
+type ReportEntry struct {
+	Time          time.Time                 `json:"time"`
+	ComponentType devicetypes.ComponentType `json:"component_type,omitempty"`
+	ComponentID   string                    `json:"component_id,omitempty"`
+	Stage         int                       `json:"stage,omitempty"`
+	Step          string                    `json:"step,omitempty"`
+	Action        string                    `json:"action"`
+	Outcome       ReportOutcome             `json:"outcome"`
+	Message       string                    `json:"message,omitempty"`
+}
+
+// Report is the persisted report of a task, entries in the order they were
+// recorded.
+type Report struct {
+	TaskID    uuid.UUID             `json:"task_id"`
+	RackID    uuid.UUID             `json:"rack_id"`
+	Operation string                `json:"operation"`
+	Status    taskcommon.TaskStatus `json:"status"`
+	Message   string                `json:"message,omitempty"`
+	Entries   []ReportEntry         `json:"entries"`
+}
+
+// NewReport returns the report of t made of entries.
+func NewReport(t *Task, entries []ReportEntry) *Report {
+	if entries == nil {
+		entries = []ReportEntry{}
+	}
+
+	return &Report{
+		TaskID:    t.ID,
+		RackID:    t.RackID,
+		Operation: fmt.Sprintf("%s/%s", t.Operation.Type, t.Operation.Code),
+		Status:    t.Status,
+		Message:   t.Message,
+		Entries:   entries,
+	}
+}
+
+// ReportFormat is an export format of a task report.
+type ReportFormat string
+
+const (
+	ReportFormatJSON     ReportFormat = "json"
+	ReportFormatMarkdown ReportFormat = "markdown"
+)
+
+// Render exports the report in the given format.
+func (r *Report) Render(format ReportFormat) (string, error) {
+	switch format {
+	case ReportFormatJSON:
+		return r.JSON()
+	case ReportFormatMarkdown:
+		return r.Markdown(), nil
+	default:
+		return "", fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+// JSON exports the report as indented JSON.
+func (r *Report) JSON() (string, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal task report: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Markdown exports the report as a Markdown document suitable for pasting
+// into an incident ticket.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Task %s\n\n", r.TaskID)
+	fmt.Fprintf(&b, "- Rack: %s\n", r.RackID)
+	fmt.Fprintf(&b, "- Operation: %s\n", r.Operation)
+	fmt.Fprintf(&b, "- Status: %s\n", r.Status)
+	if r.Message != "" {
+		fmt.Fprintf(&b, "- Message: %s\n", markdownCell(r.Message))
+	}
+	b.WriteString("\n")
+
+	if len(r.Entries) == 0 {
+		b.WriteString("No report entries were recorded.\n")
+		return b.String()
+	}
+
+	b.WriteString("| Time | Component | Stage | Step | Action | Outcome | Message |\n")
+	b.WriteString("|------|-----------|-------|------|--------|---------|---------|\n")
+	for _, e := range r.Entries {
+		component := "-"
+		if e.ComponentID != "" {
+			component = fmt.Sprintf("%s %s", devicetypes.ComponentTypeToString(e.ComponentType), e.ComponentID)
+		}
+
+		stage := "-"
+		if e.Stage > 0 {
+			stage = fmt.Sprintf("%d", e.Stage)
+		}
+
+		step := e.Step
+		if step == "" {
+			step = "-"
+		}
+
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | %s |\n",
+			e.Time.UTC().Format(time.RFC3339),
+			markdownCell(component),
+			stage,
+			step,
+			markdownCell(e.Action),
+			e.Outcome,
+			markdownCell(e.Message),
+		)
+	}
+
+	return b.String()
+}
+
+// markdownCell makes s safe to place in a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// TaskReportRecorder is implemented by any store that can persist task
+// report entries.
+type TaskReportRecorder interface {
+	// AppendTaskReport appends entries to the report of the task.
+	AppendTaskReport(ctx context.Context, taskID uuid.UUID, entries []ReportEntry) error
+}
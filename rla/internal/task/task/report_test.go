@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/operation"
+	taskcommon "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/task/common"
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/common/devicetypes"
+)
+
+func testReport() *Report {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	return NewReport(
+		&Task{
+			ID:        uuid.MustParse("6f1c2b9e-2d7a-4c55-9f0e-0a1b2c3d4e5f"),
+			RackID:    uuid.MustParse("0e2f9a64-7b2c-4b1e-8f3d-5a6b7c8d9e0f"),
+			Operation: operation.Wrapper{Type: taskcommon.TaskTypeFirmwareControl, Code: "upgrade"},
+			Status:    taskcommon.TaskStatusFailed,
+			Message:   "action 1 (PowerControl) failed",
+		},
+		[]ReportEntry{
+			{
+				Time:          at,
+				ComponentType: devicetypes.ComponentTypeCompute,
+				ComponentID:   "node-1",
+				Stage:         1,
+				Step:          ReportStepMainOperation,
+				Action:        "PowerControl",
+				Outcome:       ReportOutcomeFailed,
+				Message:       "bmc | timeout\nretry later",
+			},
+			{
+				Time:    at.Add(time.Minute),
+				Action:  "task",
+				Outcome: ReportOutcomeFailed,
+			},
+		},
+	)
+}
+
+func TestReport_JSON(t *testing.T) {
+	content, err := testReport().Render(ReportFormatJSON)
+	require.NoError(t, err)
+
+	var got Report
+	require.NoError(t, json.Unmarshal([]byte(content), &got))
+	assert.Equal(t, "firmware_control/upgrade", got.Operation)
+	require.Len(t, got.Entries, 2)
+	assert.Equal(t, devicetypes.ComponentTypeCompute, got.Entries[0].ComponentType)
+	assert.Equal(t, ReportOutcomeFailed, got.Entries[0].Outcome)
+	assert.Empty(t, got.Entries[1].ComponentID)
+}
+
+func TestReport_Markdown(t *testing.T) {
+	content, err := testReport().Render(ReportFormatMarkdown)
+	require.NoError(t, err)
+
+	assert.Contains(t, content, "# Task 6f1c2b9e-2d7a-4c55-9f0e-0a1b2c3d4e5f\n")
+	assert.Contains(t, content, "- Status: failed\n")
+	assert.Contains(t, content,
+		"| 2026-10-01T12:00:00Z | Compute node-1 | 1 | main_operation | PowerControl | failed | bmc \\| timeout retry later |\n")
+	assert.Contains(t, content, "| 2026-10-01T12:01:00Z | - | - | - | task | failed |  |\n")
+}
+
+func TestReport_MarkdownWithoutEntries(t *testing.T) {
+	r := NewReport(&Task{ID: uuid.New()}, nil)
+
+	assert.True(t, strings.HasSuffix(r.Markdown(), "No report entries were recorded.\n"))
+	assert.NotNil(t, r.Entries)
+}
+
+func TestReport_RenderUnsupportedFormat(t *testing.T) {
+	_, err := testReport().Render("yaml")
+	assert.Error(t, err)
+}
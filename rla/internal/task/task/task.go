@@ -62,6 +62,13 @@ type Task struct {
 	// After this time the Promoter terminates the task automatically.
 	// Nil for non-waiting tasks.
 	QueueExpiresAt *time.Time
+
+	// NotBefore is the earliest time a waiting task may be promoted. It is
+	// set for tasks deferred to the next maintenance window. Nil otherwise.
+	NotBefore *time.Time
+
+	// Priority orders the task in its rack's waiting queue.
+	Priority taskcommon.TaskPriority
 }
 
 // WorkflowComponent holds the minimal component data needed to execute
@@ -145,10 +152,24 @@ func (r *ExecutionResponse) IsValid() bool {
 }
 
 // TaskStatusUpdate carries the fields needed to update a task's status.
+// Approval, when set, is stored in the task attributes alongside the status
+// change, replacing any earlier record of the same gate. Preemption, when
+// set, is appended to the task attributes.
 type TaskStatusUpdate struct {
-	ID      uuid.UUID
-	Status  taskcommon.TaskStatus
-	Message string
+	ID         uuid.UUID
+	Status     taskcommon.TaskStatus
+	Message    string
+	Approval   *taskcommon.Approval
+	Preemption *taskcommon.Preemption
+}
+
+// ApprovalSignal carries a person's decision on an AwaitApproval gate from
+// the API to the workflow waiting on it.
+type ApprovalSignal struct {
+	ApprovalID string
+	Decision   taskcommon.ApprovalDecision
+	Approver   string
+	Comment    string
 }
 
 // TaskStatusUpdater is implemented by any store that can persist task status changes.
@@ -0,0 +1,490 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package torapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// NVUE action states reported by GET /nvue_v1/action/<id>.
+const (
+	nvueActionSuccess = "action_success"
+	nvueActionError   = "action_error"
+	nvueActionFail    = "action_fail"
+)
+
+// errNVUENotFound is returned by nvueDo for a 404 response.
+var errNVUENotFound = errors.New("not found")
+
+type client struct {
+	resolver   EndpointResolver
+	installs   InstallStore
+	timeout    time.Duration
+	httpClient *http.Client
+}
+
+// NewClient creates a client that talks to each switch's NVUE API and BMC,
+// found through resolver, and records the image installs it starts in
+// installs. Every request to a switch is bounded by timeout. tlsConfig
+// verifies the certificates of the switches and their BMCs; nil uses the
+// system roots.
+func NewClient(
+	timeout time.Duration,
+	tlsConfig *tls.Config,
+	resolver EndpointResolver,
+	installs InstallStore,
+) (Client, error) {
+	if resolver == nil {
+		return nil, errors.New("ToR switch endpoint resolver is required")
+	}
+	if installs == nil {
+		return nil, errors.New("ToR switch install store is required")
+	}
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return &client{
+		resolver: resolver,
+		installs: installs,
+		timeout:  timeout,
+		httpClient: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+func (c *client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func (c *client) AddSwitch(Switch) {
+	panic("AddSwitch is only valid in the mock environment")
+}
+
+func (c *client) GetSwitches(ctx context.Context, componentIDs []string) ([]Switch, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ToR switch endpoints: %w", err)
+	}
+
+	result := make([]Switch, 0, len(endpoints))
+	for _, ep := range endpoints {
+		sw := Switch{ComponentID: ep.ComponentID, RackID: ep.RackID, PowerState: PowerStateUnknown}
+
+		state, err := c.powerState(ctx, ep)
+		if err != nil {
+			log.Warn().Err(err).Str("component_id", ep.ComponentID).Msg("Unable to read ToR switch BMC")
+			sw.Error = err.Error()
+		} else {
+			sw.PowerState = state
+		}
+
+		// The NOS is expected to be unreachable while the switch is off or
+		// rebooting, so a failure here is not an error of the switch.
+		if hostname, version, err := c.nosInfo(ctx, ep); err != nil {
+			log.Debug().Err(err).Str("component_id", ep.ComponentID).Msg("ToR switch NOS is unreachable")
+		} else {
+			sw.Hostname = hostname
+			sw.NOSVersion = version
+		}
+
+		result = append(result, sw)
+	}
+
+	return result, nil
+}
+
+func (c *client) SetPower(ctx context.Context, componentIDs []string, action PowerAction) ([]Result, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ToR switch endpoints: %w", err)
+	}
+
+	result := make([]Result, 0, len(endpoints))
+	for _, ep := range endpoints {
+		res := Result{ComponentID: ep.ComponentID}
+		if err := c.reset(ctx, ep, action); err != nil {
+			res.Error = err.Error()
+		}
+		result = append(result, res)
+	}
+
+	return result, nil
+}
+
+func (c *client) InstallImage(ctx context.Context, componentIDs []string, version string, imageURL string) ([]Result, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ToR switch endpoints: %w", err)
+	}
+
+	result := make([]Result, 0, len(endpoints))
+	for _, ep := range endpoints {
+		res := Result{ComponentID: ep.ComponentID}
+
+		actionID, err := c.startInstall(ctx, ep, imageURL)
+		if err != nil {
+			res.Error = err.Error()
+		} else if err := c.installs.PutInstall(ctx, Install{
+			ComponentID: ep.ComponentID,
+			ActionID:    actionID,
+			Version:     version,
+			StartedAt:   time.Now(),
+		}); err != nil {
+			// The install runs, but its progress could not be followed.
+			res.Error = fmt.Sprintf("failed to record install action %s: %v", actionID, err)
+		}
+		result = append(result, res)
+	}
+
+	return result, nil
+}
+
+func (c *client) GetInstallStatus(ctx context.Context, componentIDs []string) ([]InstallStatus, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ToR switch endpoints: %w", err)
+	}
+
+	ids := make([]string, 0, len(endpoints))
+	for _, ep := range endpoints {
+		ids = append(ids, ep.ComponentID)
+	}
+	installs, err := c.installs.GetInstalls(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ToR switch installs: %w", err)
+	}
+
+	result := make([]InstallStatus, 0, len(endpoints))
+	for _, ep := range endpoints {
+		inst, ok := installs[ep.ComponentID]
+		if !ok {
+			result = append(result, InstallStatus{ComponentID: ep.ComponentID, State: InstallStateUnknown})
+			continue
+		}
+
+		status := c.installStatus(ctx, ep, inst)
+		status.ComponentID = ep.ComponentID
+		status.Version = inst.Version
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+func (c *client) BackupConfig(ctx context.Context, componentIDs []string) ([]ConfigBackup, error) {
+	endpoints, err := c.resolver.Endpoints(ctx, componentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ToR switch endpoints: %w", err)
+	}
+
+	result := make([]ConfigBackup, 0, len(endpoints))
+	for _, ep := range endpoints {
+		backup := ConfigBackup{ComponentID: ep.ComponentID, RackID: ep.RackID}
+
+		config, err := c.nvueDo(ctx, ep, http.MethodGet, "/nvue_v1/?rev=applied&filled=false", nil)
+		if err != nil {
+			backup.Error = fmt.Sprintf("failed to read applied configuration: %v", err)
+		} else {
+			backup.Config = config
+		}
+		result = append(result, backup)
+	}
+
+	return result, nil
+}
+
+// connect logs in to the Redfish service of the switch's BMC. The caller
+// must log out. Certificates are verified by the TLS configuration of
+// httpClient.
+func (c *client) connect(ctx context.Context, ep Endpoint) (*gofish.APIClient, error) {
+	return gofish.ConnectContext(ctx, gofish.ClientConfig{
+		Endpoint:   ep.RedfishAddress,
+		Username:   ep.Username,
+		Password:   ep.Password,
+		HTTPClient: c.httpClient,
+	})
+}
+
+// system returns the computer system of a switch. A switch BMC manages a
+// single system.
+func system(service *gofish.Service) (*redfish.ComputerSystem, error) {
+	systems, err := service.Systems()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read systems: %w", err)
+	}
+	if len(systems) == 0 {
+		return nil, errors.New("no system found")
+	}
+
+	return systems[0], nil
+}
+
+func (c *client) powerState(ctx context.Context, ep Endpoint) (PowerState, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	api, err := c.connect(ctx, ep)
+	if err != nil {
+		return PowerStateUnknown, fmt.Errorf("failed to connect to %s: %w", ep.RedfishAddress, err)
+	}
+	defer api.Logout()
+
+	sys, err := system(api.Service)
+	if err != nil {
+		return PowerStateUnknown, err
+	}
+
+	switch sys.PowerState {
+	case redfish.OnPowerState:
+		return PowerStateOn, nil
+	case redfish.OffPowerState:
+		return PowerStateOff, nil
+	default:
+		return PowerStateUnknown, nil
+	}
+}
+
+func (c *client) reset(ctx context.Context, ep Endpoint, action PowerAction) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	api, err := c.connect(ctx, ep)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", ep.RedfishAddress, err)
+	}
+	defer api.Logout()
+
+	sys, err := system(api.Service)
+	if err != nil {
+		return err
+	}
+
+	if err := sys.Reset(redfish.ResetType(action)); err != nil {
+		return fmt.Errorf("failed to apply %s: %w", action, err)
+	}
+
+	return nil
+}
+
+// nosInfo returns the hostname and running image version of the NOS.
+func (c *client) nosInfo(ctx context.Context, ep Endpoint) (string, string, error) {
+	body, err := c.nvueDo(ctx, ep, http.MethodGet, "/nvue_v1/system", nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	var sys struct {
+		Hostname string `json:"hostname"`
+	}
+	if err := json.Unmarshal(body, &sys); err != nil {
+		return "", "", fmt.Errorf("failed to parse system: %w", err)
+	}
+
+	version, err := c.nosVersion(ctx, ep)
+	if err != nil {
+		return "", "", err
+	}
+
+	return sys.Hostname, version, nil
+}
+
+// nosVersion returns the version of the running NOS image.
+func (c *client) nosVersion(ctx context.Context, ep Endpoint) (string, error) {
+	body, err := c.nvueDo(ctx, ep, http.MethodGet, "/nvue_v1/system/version", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var version struct {
+		Image string `json:"image"`
+	}
+	if err := json.Unmarshal(body, &version); err != nil {
+		return "", fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return version.Image, nil
+}
+
+// startInstall starts the NVUE install action for imageURL and returns its
+// action ID. The switch reboots into the new image once it is installed.
+func (c *client) startInstall(ctx context.Context, ep Endpoint, imageURL string) (string, error) {
+	payload := map[string]any{
+		"@install": map[string]any{
+			"state": "start",
+			"parameters": map[string]any{
+				"remote-url": imageURL,
+				"force":      true,
+				"reboot":     "immediate",
+			},
+		},
+	}
+
+	body, err := c.nvueDo(ctx, ep, http.MethodPost, "/nvue_v1/system/image", payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to start image install: %w", err)
+	}
+
+	actionID, err := parseActionID(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to start image install: %w", err)
+	}
+
+	return actionID, nil
+}
+
+// installStatus works out the progress of inst. While the action runs its
+// state is reported by NVUE. Once the switch reboots the action is gone, and
+// the install has succeeded if the NOS runs the expected version.
+func (c *client) installStatus(ctx context.Context, ep Endpoint, inst Install) InstallStatus {
+	body, err := c.nvueDo(ctx, ep, http.MethodGet, "/nvue_v1/action/"+inst.ActionID, nil)
+	switch {
+	case errors.Is(err, errNVUENotFound):
+		// The NOS is back up without the action: it has rebooted.
+		return c.versionStatus(ctx, ep, inst, true)
+	case err != nil:
+		// Unreachable: most likely rebooting into the new image.
+		return InstallStatus{State: InstallStateRebooting}
+	}
+
+	var action struct {
+		State  string `json:"state"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &action); err != nil {
+		return InstallStatus{State: InstallStateFailed, Error: fmt.Sprintf("failed to parse action: %v", err)}
+	}
+
+	switch action.State {
+	case nvueActionSuccess:
+		return c.versionStatus(ctx, ep, inst, false)
+	case nvueActionError, nvueActionFail:
+		return InstallStatus{State: InstallStateFailed, Error: action.Status}
+	default:
+		return InstallStatus{State: InstallStateInstalling}
+	}
+}
+
+// versionStatus compares the running NOS version with the one inst installs.
+// rebooted reports whether the switch has already rebooted, in which case a
+// different version means the install failed.
+func (c *client) versionStatus(ctx context.Context, ep Endpoint, inst Install, rebooted bool) InstallStatus {
+	running, err := c.nosVersion(ctx, ep)
+	if err != nil {
+		return InstallStatus{State: InstallStateRebooting}
+	}
+
+	if running == inst.Version {
+		return InstallStatus{State: InstallStateCompleted}
+	}
+
+	if rebooted {
+		return InstallStatus{
+			State: InstallStateFailed,
+			Error: fmt.Sprintf("switch runs %s after reboot, expected %s", running, inst.Version),
+		}
+	}
+
+	return InstallStatus{State: InstallStateRebooting}
+}
+
+// nvueDo sends a request to the NVUE API of ep and returns the response body.
+func (c *client) nvueDo(ctx context.Context, ep Endpoint, method string, path string, payload any) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(ep.NVUEAddress, "/")+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(ep.Username, ep.Password)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNVUENotFound
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("NVUE returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
+
+// parseActionID reads the action ID NVUE returns when an action starts: a
+// bare number or string, or an object keyed by the ID.
+func parseActionID(body []byte) (string, error) {
+	var id any
+	if err := json.Unmarshal(body, &id); err != nil {
+		return "", fmt.Errorf("failed to parse action ID: %w", err)
+	}
+
+	switch v := id.(type) {
+	case float64:
+		return fmt.Sprintf("%.0f", v), nil
+	case string:
+		if v != "" {
+			return v, nil
+		}
+	case map[string]any:
+		if len(v) == 1 {
+			for k := range v {
+				return k, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unexpected action response %s", strings.TrimSpace(string(body)))
+}
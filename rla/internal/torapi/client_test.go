@@ -0,0 +1,434 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package torapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const systemPath = "/redfish/v1/Systems/1"
+
+// fakeSwitch serves the Redfish resources of a switch BMC and the NVUE API of
+// its NOS from one server, and records what it is asked to do.
+type fakeSwitch struct {
+	mu          sync.Mutex
+	powerState  string
+	resets      []string
+	image       string
+	installURL  string
+	actionState string // "" once the switch has rebooted and forgotten the action
+}
+
+func newFakeSwitch() *fakeSwitch {
+	return &fakeSwitch{powerState: "On", image: "5.9.1"}
+}
+
+func (f *fakeSwitch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	link := func(path string) map[string]string { return map[string]string{"@odata.id": path} }
+	write := func(v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	if len(r.URL.Path) > len("/nvue_v1") && r.URL.Path[:len("/nvue_v1")] == "/nvue_v1" {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/redfish/v1/SessionService/Sessions":
+		w.Header().Set("X-Auth-Token", "token")
+		w.Header().Set("Location", "/redfish/v1/SessionService/Sessions/1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"Id":"1"}`))
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == "/redfish/v1/":
+		write(map[string]any{
+			"@odata.id":      "/redfish/v1/",
+			"Id":             "RootService",
+			"Systems":        link("/redfish/v1/Systems"),
+			"SessionService": link("/redfish/v1/SessionService"),
+			"Links":          map[string]any{"Sessions": link("/redfish/v1/SessionService/Sessions")},
+		})
+	case r.Method == http.MethodGet && r.URL.Path == "/redfish/v1/Systems":
+		write(map[string]any{"Members": []any{link(systemPath)}, "Members@odata.count": 1})
+	case r.Method == http.MethodGet && r.URL.Path == systemPath:
+		write(map[string]any{
+			"@odata.id":  systemPath,
+			"Id":         "1",
+			"PowerState": f.powerState,
+			"Actions": map[string]any{
+				"#ComputerSystem.Reset": map[string]any{
+					"target": systemPath + "/Actions/ComputerSystem.Reset",
+					"ResetType@Redfish.AllowableValues": []string{
+						"On", "ForceOff", "GracefulShutdown", "GracefulRestart", "ForceRestart", "PowerCycle",
+					},
+				},
+			},
+		})
+	case r.Method == http.MethodPost && r.URL.Path == systemPath+"/Actions/ComputerSystem.Reset":
+		var body struct{ ResetType string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.resets = append(f.resets, body.ResetType)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == "/nvue_v1/system":
+		write(map[string]any{"hostname": "tor-a1", "build": "Cumulus Linux " + f.image})
+	case r.Method == http.MethodGet && r.URL.Path == "/nvue_v1/system/version":
+		write(map[string]any{"image": f.image})
+	case r.Method == http.MethodPost && r.URL.Path == "/nvue_v1/system/image":
+		var body map[string]struct {
+			State      string         `json:"state"`
+			Parameters map[string]any `json:"parameters"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.installURL, _ = body["@install"].Parameters["remote-url"].(string)
+		f.actionState = "running"
+		write(map[string]any{"17": map[string]any{"state": "start"}})
+	case r.Method == http.MethodGet && r.URL.Path == "/nvue_v1/action/17":
+		if f.actionState == "" {
+			http.NotFound(w, r)
+			return
+		}
+		write(map[string]any{"state": f.actionState, "status": "disk full"})
+	case r.Method == http.MethodGet && r.URL.Path == "/nvue_v1/" && r.URL.Query().Get("rev") == "applied":
+		write(map[string]any{"system": map[string]any{"hostname": "tor-a1"}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+type staticResolver []Endpoint
+
+func (r staticResolver) Endpoints(_ context.Context, componentIDs []string) ([]Endpoint, error) {
+	if len(componentIDs) == 0 {
+		return r, nil
+	}
+
+	var result []Endpoint
+	for _, ep := range r {
+		for _, id := range componentIDs {
+			if ep.ComponentID == id {
+				result = append(result, ep)
+			}
+		}
+	}
+	return result, nil
+}
+
+const testSwitchID = "aa:bb:cc:00:20:01"
+
+// trusting returns a TLS configuration that trusts the certificate of ts.
+func trusting(ts *httptest.Server) *tls.Config {
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+	return &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+}
+
+// newClientFor creates a client for the switch served by ts.
+func newClientFor(
+	t *testing.T,
+	ts *httptest.Server,
+	tlsConfig *tls.Config,
+	rackID uuid.UUID,
+	installs InstallStore,
+) Client {
+	client, err := NewClient(5*time.Second, tlsConfig, staticResolver{{
+		ComponentID:    testSwitchID,
+		RackID:         rackID,
+		RedfishAddress: ts.URL,
+		NVUEAddress:    ts.URL,
+		Username:       "admin",
+		Password:       "secret",
+	}}, installs)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func newTestClient(t *testing.T, fake *fakeSwitch) (Client, uuid.UUID) {
+	ts := httptest.NewTLSServer(fake)
+	t.Cleanup(ts.Close)
+
+	rackID := uuid.New()
+	return newClientFor(t, ts, trusting(ts), rackID, NewMemoryInstallStore()), rackID
+}
+
+func TestClient_GetSwitches(t *testing.T) {
+	client, rackID := newTestClient(t, newFakeSwitch())
+
+	switches, err := client.GetSwitches(context.Background(), []string{testSwitchID})
+	require.NoError(t, err)
+	require.Len(t, switches, 1)
+
+	sw := switches[0]
+	assert.Empty(t, sw.Error)
+	assert.Equal(t, testSwitchID, sw.ComponentID)
+	assert.Equal(t, rackID, sw.RackID)
+	assert.Equal(t, PowerStateOn, sw.PowerState)
+	assert.Equal(t, "tor-a1", sw.Hostname)
+	assert.Equal(t, "5.9.1", sw.NOSVersion)
+}
+
+func TestClient_GetSwitches_Unreachable(t *testing.T) {
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	addr := ts.URL
+	ts.Close()
+
+	client, err := NewClient(time.Second, nil, staticResolver{{
+		ComponentID: testSwitchID, RedfishAddress: addr, NVUEAddress: addr,
+	}}, NewMemoryInstallStore())
+	require.NoError(t, err)
+
+	switches, err := client.GetSwitches(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, switches, 1)
+	assert.NotEmpty(t, switches[0].Error)
+	assert.Equal(t, PowerStateUnknown, switches[0].PowerState)
+	assert.Empty(t, switches[0].NOSVersion)
+}
+
+func TestClient_TLSVerification(t *testing.T) {
+	ts := httptest.NewTLSServer(newFakeSwitch())
+	t.Cleanup(ts.Close)
+
+	tests := map[string]struct {
+		tlsConfig *tls.Config
+		wantError bool
+	}{
+		"system roots reject self-signed": {tlsConfig: nil, wantError: true},
+		"trusted CA":                      {tlsConfig: trusting(ts)},
+		"verification disabled": {
+			tlsConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newClientFor(t, ts, tc.tlsConfig, uuid.New(), NewMemoryInstallStore())
+
+			switches, err := client.GetSwitches(context.Background(), []string{testSwitchID})
+			require.NoError(t, err)
+			require.Len(t, switches, 1)
+			if tc.wantError {
+				assert.Contains(t, switches[0].Error, "certificate")
+				assert.Empty(t, switches[0].NOSVersion)
+				return
+			}
+			assert.Empty(t, switches[0].Error)
+			assert.Equal(t, "5.9.1", switches[0].NOSVersion)
+		})
+	}
+}
+
+func TestClient_SetPower(t *testing.T) {
+	fake := newFakeSwitch()
+	client, _ := newTestClient(t, fake)
+
+	results, err := client.SetPower(context.Background(), []string{testSwitchID}, PowerActionPowerCycle)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Empty(t, results[0].Error)
+	assert.Equal(t, []string{"PowerCycle"}, fake.resets)
+}
+
+func TestClient_InstallImage(t *testing.T) {
+	fake := newFakeSwitch()
+	client, _ := newTestClient(t, fake)
+	ctx := context.Background()
+	ids := []string{testSwitchID}
+
+	status, err := client.GetInstallStatus(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, InstallStateUnknown, status[0].State)
+
+	results, err := client.InstallImage(ctx, ids, "5.10.0", "http://images/cumulus-5.10.0.bin")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Empty(t, results[0].Error)
+	assert.Equal(t, "http://images/cumulus-5.10.0.bin", fake.installURL)
+
+	steps := []struct {
+		name        string
+		actionState string
+		image       string
+		want        InstallState
+	}{
+		{"action running", "running", "5.9.1", InstallStateInstalling},
+		{"action done, not rebooted yet", "action_success", "5.9.1", InstallStateRebooting},
+		{"rebooted into new image", "", "5.10.0", InstallStateCompleted},
+	}
+	for _, step := range steps {
+		fake.mu.Lock()
+		fake.actionState = step.actionState
+		fake.image = step.image
+		fake.mu.Unlock()
+
+		status, err := client.GetInstallStatus(ctx, ids)
+		require.NoError(t, err, step.name)
+		require.Len(t, status, 1, step.name)
+		assert.Equal(t, step.want, status[0].State, step.name)
+		assert.Equal(t, "5.10.0", status[0].Version, step.name)
+	}
+}
+
+func TestClient_InstallImage_SharedStore(t *testing.T) {
+	fake := newFakeSwitch()
+	ts := httptest.NewTLSServer(fake)
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+	ids := []string{testSwitchID}
+	installs := NewMemoryInstallStore()
+
+	// The install is started by one instance and followed by another, as
+	// when the worker that started it restarts.
+	starter := newClientFor(t, ts, trusting(ts), uuid.New(), installs)
+	results, err := starter.InstallImage(ctx, ids, "5.10.0", "http://images/cumulus-5.10.0.bin")
+	require.NoError(t, err)
+	require.Empty(t, results[0].Error)
+	require.NoError(t, starter.Close())
+
+	follower := newClientFor(t, ts, trusting(ts), uuid.New(), installs)
+	status, err := follower.GetInstallStatus(ctx, ids)
+	require.NoError(t, err)
+	require.Len(t, status, 1)
+	assert.Equal(t, InstallStateInstalling, status[0].State)
+	assert.Equal(t, "5.10.0", status[0].Version)
+
+	fake.mu.Lock()
+	fake.actionState = ""
+	fake.image = "5.10.0"
+	fake.mu.Unlock()
+
+	status, err = follower.GetInstallStatus(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, InstallStateCompleted, status[0].State)
+}
+
+// failingInstallStore cannot record installs.
+type failingInstallStore struct{ InstallStore }
+
+func (failingInstallStore) PutInstall(context.Context, Install) error {
+	return errors.New("database unavailable")
+}
+
+func TestClient_InstallImage_StoreFailure(t *testing.T) {
+	ts := httptest.NewTLSServer(newFakeSwitch())
+	t.Cleanup(ts.Close)
+
+	client := newClientFor(t, ts, trusting(ts), uuid.New(), failingInstallStore{NewMemoryInstallStore()})
+	results, err := client.InstallImage(context.Background(), []string{testSwitchID}, "5.10.0", "http://images/x.bin")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Contains(t, results[0].Error, "failed to record install action 17")
+}
+
+func TestClient_InstallImage_Failures(t *testing.T) {
+	tests := map[string]struct {
+		actionState string
+		image       string
+		wantError   string
+	}{
+		"action error":            {actionState: "action_error", image: "5.9.1", wantError: "disk full"},
+		"rebooted into old image": {actionState: "", image: "5.9.1", wantError: "expected 5.10.0"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := newFakeSwitch()
+			client, _ := newTestClient(t, fake)
+			ctx := context.Background()
+
+			_, err := client.InstallImage(ctx, []string{testSwitchID}, "5.10.0", "http://images/x.bin")
+			require.NoError(t, err)
+
+			fake.mu.Lock()
+			fake.actionState = tc.actionState
+			fake.image = tc.image
+			fake.mu.Unlock()
+
+			status, err := client.GetInstallStatus(ctx, []string{testSwitchID})
+			require.NoError(t, err)
+			assert.Equal(t, InstallStateFailed, status[0].State)
+			assert.Contains(t, status[0].Error, tc.wantError)
+		})
+	}
+}
+
+func TestClient_BackupConfig(t *testing.T) {
+	client, rackID := newTestClient(t, newFakeSwitch())
+
+	backups, err := client.BackupConfig(context.Background(), []string{testSwitchID})
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Empty(t, backups[0].Error)
+	assert.Equal(t, rackID, backups[0].RackID)
+	assert.JSONEq(t, `{"system":{"hostname":"tor-a1"}}`, string(backups[0].Config))
+}
+
+func TestParseActionID(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		want    string
+		wantErr bool
+	}{
+		"number":       {body: `17`, want: "17"},
+		"string":       {body: `"17"`, want: "17"},
+		"keyed object": {body: `{"17": {"state": "start"}}`, want: "17"},
+		"empty object": {body: `{}`, wantErr: true},
+		"not json":     {body: `oops`, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseActionID([]byte(tc.body))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewClient_RequiresResolverAndStore(t *testing.T) {
+	_, err := NewClient(time.Second, nil, nil, NewMemoryInstallStore())
+	require.Error(t, err)
+
+	_, err = NewClient(time.Second, nil, staticResolver{}, nil)
+	require.Error(t, err)
+}
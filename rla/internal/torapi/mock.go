@@ -0,0 +1,149 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package torapi
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/common/utils"
+)
+
+type mockClient struct {
+	mu       sync.Mutex
+	switches map[string]Switch
+	installs map[string]string
+}
+
+// NewMockClient returns a client that serves switches added with AddSwitch so it can be used in unit tests.
+// Power actions and image installs take effect immediately.
+func NewMockClient() Client {
+	return &mockClient{switches: map[string]Switch{}, installs: map[string]string{}}
+}
+
+func (c *mockClient) Close() error {
+	return nil
+}
+
+func (c *mockClient) AddSwitch(sw Switch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.switches[utils.NormalizeMAC(sw.ComponentID)] = sw
+}
+
+func (c *mockClient) GetSwitches(_ context.Context, componentIDs []string) ([]Switch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []Switch
+	if len(componentIDs) == 0 {
+		for _, sw := range c.switches {
+			result = append(result, sw)
+		}
+		sort.Slice(result, func(i, j int) bool { return result[i].ComponentID < result[j].ComponentID })
+		return result, nil
+	}
+
+	for _, id := range componentIDs {
+		if sw, ok := c.switches[utils.NormalizeMAC(id)]; ok {
+			result = append(result, sw)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *mockClient) SetPower(_ context.Context, componentIDs []string, action PowerAction) ([]Result, error) {
+	return c.each(componentIDs, func(sw *Switch) {
+		switch action {
+		case PowerActionForceOff, PowerActionGracefulShutdown:
+			sw.PowerState = PowerStateOff
+		default:
+			sw.PowerState = PowerStateOn
+		}
+	}), nil
+}
+
+func (c *mockClient) InstallImage(_ context.Context, componentIDs []string, version string, _ string) ([]Result, error) {
+	return c.each(componentIDs, func(sw *Switch) {
+		sw.NOSVersion = version
+		c.installs[utils.NormalizeMAC(sw.ComponentID)] = version
+	}), nil
+}
+
+func (c *mockClient) GetInstallStatus(_ context.Context, componentIDs []string) ([]InstallStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]InstallStatus, 0, len(componentIDs))
+	for _, id := range componentIDs {
+		status := InstallStatus{ComponentID: id, State: InstallStateUnknown}
+		if version, ok := c.installs[utils.NormalizeMAC(id)]; ok {
+			status.Version = version
+			status.State = InstallStateCompleted
+		}
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+func (c *mockClient) BackupConfig(_ context.Context, componentIDs []string) ([]ConfigBackup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]ConfigBackup, 0, len(componentIDs))
+	for _, id := range componentIDs {
+		sw, ok := c.switches[utils.NormalizeMAC(id)]
+		if !ok {
+			result = append(result, ConfigBackup{ComponentID: id, Error: "switch not found"})
+			continue
+		}
+		result = append(result, ConfigBackup{
+			ComponentID: id,
+			RackID:      sw.RackID,
+			Config:      []byte(`{"system":{"hostname":"` + sw.Hostname + `"}}`),
+		})
+	}
+
+	return result, nil
+}
+
+// each applies fn to the specified switches, reporting unknown ones.
+func (c *mockClient) each(componentIDs []string, fn func(sw *Switch)) []Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]Result, 0, len(componentIDs))
+	for _, id := range componentIDs {
+		key := utils.NormalizeMAC(id)
+		sw, ok := c.switches[key]
+		if !ok {
+			result = append(result, Result{ComponentID: id, Error: "switch not found"})
+			continue
+		}
+
+		fn(&sw)
+		c.switches[key] = sw
+		result = append(result, Result{ComponentID: id})
+	}
+
+	return result
+}
@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package torapi manages top-of-rack (ToR) switches. The network operating
+// system is driven through its NVUE REST API: version, image install and
+// configuration backup. Power goes through the Redfish service of the
+// switch's BMC. A switch is addressed by its component ID, the MAC address of
+// its management interface; an EndpointResolver maps component IDs to the
+// addresses and credentials recorded in the inventory. New clients can be
+// created with NewClient to talk to real switches or NewMockClient which
+// fakes everything for unit tests.
+
+package torapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Client allows us to have both a real implementation and a mock implementation for unit tests which can be switched transparently.
+type Client interface {
+	// GetSwitches returns the state of the switches with the specified
+	// component IDs. A switch whose BMC cannot be read is still returned,
+	// with Error set. NOSVersion is empty while the NOS is unreachable, for
+	// example when the switch is off or rebooting.
+	GetSwitches(ctx context.Context, componentIDs []string) ([]Switch, error)
+
+	// SetPower applies a power action to the specified switches through
+	// their BMC.
+	SetPower(ctx context.Context, componentIDs []string, action PowerAction) ([]Result, error)
+
+	// InstallImage starts installing the NOS image at imageURL on the
+	// specified switches. The switches reboot into the new image, which must
+	// report version once it runs.
+	InstallImage(ctx context.Context, componentIDs []string, version string, imageURL string) ([]Result, error)
+
+	// GetInstallStatus returns the progress of the last image install
+	// recorded in the install store for each of the specified switches.
+	GetInstallStatus(ctx context.Context, componentIDs []string) ([]InstallStatus, error)
+
+	// BackupConfig returns the applied NOS configuration of the specified
+	// switches.
+	BackupConfig(ctx context.Context, componentIDs []string) ([]ConfigBackup, error)
+
+	// Close releases the resources held by the client.
+	Close() error
+
+	// The following are only valid in the mock environment and should only be called by unit tests.
+	AddSwitch(Switch)
+}
+
+// Endpoint is where and how to reach a switch.
+type Endpoint struct {
+	// ComponentID is the component ID of the switch, the MAC address of its
+	// management interface.
+	ComponentID string
+	// RackID is the rack the switch belongs to in the inventory.
+	RackID uuid.UUID
+	// RedfishAddress is the base URL of the BMC's Redfish service.
+	RedfishAddress string
+	// NVUEAddress is the base URL of the NOS's NVUE REST API.
+	NVUEAddress string
+	Username    string
+	Password    string
+}
+
+// EndpointResolver maps switch component IDs to their endpoints.
+type EndpointResolver interface {
+	// Endpoints returns the endpoints of the switches with the specified
+	// component IDs.
+	Endpoints(ctx context.Context, componentIDs []string) ([]Endpoint, error)
+}
+
+// InstallStore records the image installs started on the switches, so that
+// their progress can be followed by any RLA instance and across restarts.
+type InstallStore interface {
+	// PutInstall records inst as the last install of its switch.
+	PutInstall(ctx context.Context, inst Install) error
+
+	// GetInstalls returns the last install of each of the specified
+	// switches, keyed by component ID. Switches without one are left out.
+	GetInstalls(ctx context.Context, componentIDs []string) (map[string]Install, error)
+}
@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package torapi
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PowerState is the power state of a switch as reported by its BMC.
+type PowerState string
+
+const (
+	PowerStateUnknown PowerState = "Unknown"
+	PowerStateOn      PowerState = "On"
+	PowerStateOff     PowerState = "Off"
+)
+
+// PowerAction is a power action applied through the BMC. The values are
+// Redfish reset types.
+type PowerAction string
+
+const (
+	PowerActionOn               PowerAction = "On"
+	PowerActionForceOff         PowerAction = "ForceOff"
+	PowerActionGracefulShutdown PowerAction = "GracefulShutdown"
+	PowerActionGracefulRestart  PowerAction = "GracefulRestart"
+	PowerActionForceRestart     PowerAction = "ForceRestart"
+	PowerActionPowerCycle       PowerAction = "PowerCycle"
+)
+
+// Switch is the state of a ToR switch.
+type Switch struct {
+	ComponentID string
+	RackID      uuid.UUID
+	Hostname    string
+	NOSVersion  string
+	PowerState  PowerState
+	// Error is set when the switch's BMC could not be read.
+	Error string
+}
+
+// Result is the outcome of an action on one switch. Error is empty on
+// success.
+type Result struct {
+	ComponentID string
+	Error       string
+}
+
+// InstallState is the progress of a NOS image install.
+type InstallState string
+
+const (
+	// InstallStateUnknown means no install was recorded for the switch.
+	InstallStateUnknown InstallState = "Unknown"
+	// InstallStateInstalling means the NOS is still installing the image.
+	InstallStateInstalling InstallState = "Installing"
+	// InstallStateRebooting means the image is installed and the switch is
+	// rebooting into it.
+	InstallStateRebooting InstallState = "Rebooting"
+	InstallStateCompleted InstallState = "Completed"
+	InstallStateFailed    InstallState = "Failed"
+)
+
+// InstallStatus is the progress of the last image install on a switch.
+type InstallStatus struct {
+	ComponentID string
+	Version     string
+	State       InstallState
+	Error       string
+}
+
+// Install is an image install started on a switch. It is recorded in an
+// InstallStore so its progress can be followed by any client.
+type Install struct {
+	ComponentID string
+	// ActionID is the ID of the NVUE install action.
+	ActionID string
+	// Version is the version the switch runs once the install completes.
+	Version   string
+	StartedAt time.Time
+}
+
+// ConfigBackup is the applied NOS configuration of a switch.
+type ConfigBackup struct {
+	ComponentID string
+	RackID      uuid.UUID
+	Config      []byte
+	Error       string
+}
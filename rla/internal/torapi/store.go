@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package torapi
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	dbmodel "github.com/NVIDIA/ncx-infra-controller-rest/rla/internal/db/model"
+)
+
+// memoryInstallStore keeps installs in memory.
+type memoryInstallStore struct {
+	mu       sync.Mutex
+	installs map[string]Install
+}
+
+// NewMemoryInstallStore creates an InstallStore that keeps installs in
+// memory. Installs are lost when the process exits.
+func NewMemoryInstallStore() InstallStore {
+	return &memoryInstallStore{installs: make(map[string]Install)}
+}
+
+func (s *memoryInstallStore) PutInstall(_ context.Context, inst Install) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.installs[inst.ComponentID] = inst
+	return nil
+}
+
+func (s *memoryInstallStore) GetInstalls(_ context.Context, componentIDs []string) (map[string]Install, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]Install, len(componentIDs))
+	for _, id := range componentIDs {
+		if inst, ok := s.installs[id]; ok {
+			result[id] = inst
+		}
+	}
+	return result, nil
+}
+
+// PostgresInstallStore implements InstallStore using the tor_switch_install
+// table.
+type PostgresInstallStore struct {
+	pg *cdb.Session
+}
+
+// NewPostgresInstallStore creates a PostgreSQL-backed install store.
+func NewPostgresInstallStore(pg *cdb.Session) *PostgresInstallStore {
+	return &PostgresInstallStore{pg: pg}
+}
+
+// PutInstall implements InstallStore.
+func (s *PostgresInstallStore) PutInstall(ctx context.Context, inst Install) error {
+	row := &dbmodel.TorSwitchInstall{
+		ComponentID: inst.ComponentID,
+		ActionID:    inst.ActionID,
+		Version:     inst.Version,
+		StartedAt:   inst.StartedAt,
+	}
+
+	_, err := s.pg.DB.NewInsert().
+		Model(row).
+		On("CONFLICT (component_id) DO UPDATE").
+		Set("action_id = EXCLUDED.action_id").
+		Set("version = EXCLUDED.version").
+		Set("started_at = EXCLUDED.started_at").
+		Exec(ctx)
+	return err
+}
+
+// GetInstalls implements InstallStore.
+func (s *PostgresInstallStore) GetInstalls(ctx context.Context, componentIDs []string) (map[string]Install, error) {
+	result := make(map[string]Install, len(componentIDs))
+	if len(componentIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []dbmodel.TorSwitchInstall
+	err := s.pg.DB.NewSelect().
+		Model(&rows).
+		Where("tsi.component_id IN (?)", bun.In(componentIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.ComponentID] = Install{
+			ComponentID: row.ComponentID,
+			ActionID:    row.ActionID,
+			Version:     row.Version,
+			StartedAt:   row.StartedAt,
+		}
+	}
+	return result, nil
+}
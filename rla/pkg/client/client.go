@@ -342,36 +342,9 @@ func (c *Client) UpgradeFirmwareByRackIDs(
 	componentType types.ComponentType,
 	startTime, endTime *time.Time,
 ) (*UpgradeFirmwareResult, error) {
-	rackTargets := make([]*pb.RackTarget, 0, len(rackIDs))
-	for _, id := range rackIDs {
-		rackTargets = append(rackTargets, &pb.RackTarget{
-			Identifier:     &pb.RackTarget_Id{Id: uuidToProto(id)},
-			ComponentTypes: componentTypesFilter(componentType),
-		})
-	}
-
-	req := &pb.UpgradeFirmwareRequest{
-		TargetSpec: &pb.OperationTargetSpec{
-			Targets: &pb.OperationTargetSpec_Racks{
-				Racks: &pb.RackTargets{Targets: rackTargets},
-			},
-		},
-	}
-	if startTime != nil {
-		req.StartTime = timestamppb.New(*startTime)
-	}
-	if endTime != nil {
-		req.EndTime = timestamppb.New(*endTime)
-	}
-
-	rsp, err := c.client.UpgradeFirmware(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	return &UpgradeFirmwareResult{
-		TaskIDs: uuidsFromProto(rsp.GetTaskIds()),
-	}, nil
+	return c.executeUpgradeFirmware(
+		ctx, rackIDsTargetSpec(rackIDs, componentType), startTime, endTime,
+	)
 }
 
 // UpgradeFirmwareByRackNames upgrades firmware for components in the given rack names.
@@ -381,29 +354,81 @@ func (c *Client) UpgradeFirmwareByRackNames(
 	componentType types.ComponentType,
 	startTime, endTime *time.Time,
 ) (*UpgradeFirmwareResult, error) {
-	rackTargets := make([]*pb.RackTarget, 0, len(rackNames))
-	for _, name := range rackNames {
-		rackTargets = append(rackTargets, &pb.RackTarget{
-			Identifier:     &pb.RackTarget_Name{Name: name},
-			ComponentTypes: componentTypesFilter(componentType),
-		})
-	}
+	return c.executeUpgradeFirmware(
+		ctx, rackNamesTargetSpec(rackNames, componentType), startTime, endTime,
+	)
+}
 
-	req := &pb.UpgradeFirmwareRequest{
-		TargetSpec: &pb.OperationTargetSpec{
-			Targets: &pb.OperationTargetSpec_Racks{
-				Racks: &pb.RackTargets{Targets: rackTargets},
-			},
+// UpgradeFirmwareByMachineIDs upgrades firmware for the given machine IDs (external component IDs).
+func (c *Client) UpgradeFirmwareByMachineIDs(
+	ctx context.Context,
+	machineIDs []string,
+	startTime, endTime *time.Time,
+) (*UpgradeFirmwareResult, error) {
+	return c.executeUpgradeFirmware(
+		ctx, machineIDsTargetSpec(machineIDs), startTime, endTime,
+	)
+}
+
+// PlanUpgradeFirmwareByRackIDs plans a firmware upgrade for components in
+// the given rack IDs without submitting it.
+func (c *Client) PlanUpgradeFirmwareByRackIDs(
+	ctx context.Context,
+	rackIDs []uuid.UUID,
+	componentType types.ComponentType,
+	startTime, endTime *time.Time,
+) (*PlanResult, error) {
+	return c.planOperation(ctx, &pb.PlanOperationRequest{
+		Operation: &pb.PlanOperationRequest_UpgradeFirmware{
+			UpgradeFirmware: upgradeFirmwareRequest(
+				rackIDsTargetSpec(rackIDs, componentType), startTime, endTime,
+			),
 		},
-	}
-	if startTime != nil {
-		req.StartTime = timestamppb.New(*startTime)
-	}
-	if endTime != nil {
-		req.EndTime = timestamppb.New(*endTime)
-	}
+	})
+}
+
+// PlanUpgradeFirmwareByRackNames plans a firmware upgrade for components in
+// the given rack names without submitting it.
+func (c *Client) PlanUpgradeFirmwareByRackNames(
+	ctx context.Context,
+	rackNames []string,
+	componentType types.ComponentType,
+	startTime, endTime *time.Time,
+) (*PlanResult, error) {
+	return c.planOperation(ctx, &pb.PlanOperationRequest{
+		Operation: &pb.PlanOperationRequest_UpgradeFirmware{
+			UpgradeFirmware: upgradeFirmwareRequest(
+				rackNamesTargetSpec(rackNames, componentType), startTime, endTime,
+			),
+		},
+	})
+}
+
+// PlanUpgradeFirmwareByMachineIDs plans a firmware upgrade for the given
+// machine IDs without submitting it.
+func (c *Client) PlanUpgradeFirmwareByMachineIDs(
+	ctx context.Context,
+	machineIDs []string,
+	startTime, endTime *time.Time,
+) (*PlanResult, error) {
+	return c.planOperation(ctx, &pb.PlanOperationRequest{
+		Operation: &pb.PlanOperationRequest_UpgradeFirmware{
+			UpgradeFirmware: upgradeFirmwareRequest(
+				machineIDsTargetSpec(machineIDs), startTime, endTime,
+			),
+		},
+	})
+}
 
-	rsp, err := c.client.UpgradeFirmware(ctx, req)
+// executeUpgradeFirmware submits a firmware upgrade with the given target spec.
+func (c *Client) executeUpgradeFirmware(
+	ctx context.Context,
+	targetSpec *pb.OperationTargetSpec,
+	startTime, endTime *time.Time,
+) (*UpgradeFirmwareResult, error) {
+	rsp, err := c.client.UpgradeFirmware(
+		ctx, upgradeFirmwareRequest(targetSpec, startTime, endTime),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -413,55 +438,212 @@ func (c *Client) UpgradeFirmwareByRackNames(
 	}, nil
 }
 
-// UpgradeFirmwareByMachineIDs upgrades firmware for the given machine IDs (external component IDs).
-func (c *Client) UpgradeFirmwareByMachineIDs(
-	ctx context.Context,
-	machineIDs []string,
+// upgradeFirmwareRequest builds an UpgradeFirmwareRequest for the given
+// target spec and optional time window.
+func upgradeFirmwareRequest(
+	targetSpec *pb.OperationTargetSpec,
 	startTime, endTime *time.Time,
-) (*UpgradeFirmwareResult, error) {
-	compTargets := make([]*pb.ComponentTarget, 0, len(machineIDs))
-	for _, machineID := range machineIDs {
-		compTargets = append(compTargets, &pb.ComponentTarget{
-			Identifier: &pb.ComponentTarget_External{
-				External: &pb.ExternalRef{
-					Type: pb.ComponentType_COMPONENT_TYPE_COMPUTE,
-					Id:   machineID,
-				},
-			},
-		})
-	}
-
-	req := &pb.UpgradeFirmwareRequest{
-		TargetSpec: &pb.OperationTargetSpec{
-			Targets: &pb.OperationTargetSpec_Components{
-				Components: &pb.ComponentTargets{Targets: compTargets},
-			},
-		},
-	}
+) *pb.UpgradeFirmwareRequest {
+	req := &pb.UpgradeFirmwareRequest{TargetSpec: targetSpec}
 	if startTime != nil {
 		req.StartTime = timestamppb.New(*startTime)
 	}
 	if endTime != nil {
 		req.EndTime = timestamppb.New(*endTime)
 	}
+	return req
+}
+
+// PowerControlByRackIDs performs power control on components in the given rack IDs.
+func (c *Client) PowerControlByRackIDs(
+	ctx context.Context,
+	rackIDs []uuid.UUID,
+	componentType types.ComponentType,
+	op types.PowerControlOp,
+) (*PowerControlResult, error) {
+	return c.executePowerControl(ctx, rackIDsTargetSpec(rackIDs, componentType), op)
+}
+
+// PowerControlByRackNames performs power control on components in the given rack names.
+func (c *Client) PowerControlByRackNames(
+	ctx context.Context,
+	rackNames []string,
+	componentType types.ComponentType,
+	op types.PowerControlOp,
+) (*PowerControlResult, error) {
+	return c.executePowerControl(ctx, rackNamesTargetSpec(rackNames, componentType), op)
+}
+
+// PowerControlByMachineIDs performs power control on the given machine IDs.
+func (c *Client) PowerControlByMachineIDs(
+	ctx context.Context,
+	machineIDs []string,
+	op types.PowerControlOp,
+) (*PowerControlResult, error) {
+	return c.executePowerControl(ctx, machineIDsTargetSpec(machineIDs), op)
+}
 
-	rsp, err := c.client.UpgradeFirmware(ctx, req)
+// PlanPowerControlByRackIDs plans power control on components in the given
+// rack IDs without submitting it.
+func (c *Client) PlanPowerControlByRackIDs(
+	ctx context.Context,
+	rackIDs []uuid.UUID,
+	componentType types.ComponentType,
+	op types.PowerControlOp,
+) (*PlanResult, error) {
+	return c.planPowerControl(ctx, rackIDsTargetSpec(rackIDs, componentType), op)
+}
+
+// PlanPowerControlByRackNames plans power control on components in the given
+// rack names without submitting it.
+func (c *Client) PlanPowerControlByRackNames(
+	ctx context.Context,
+	rackNames []string,
+	componentType types.ComponentType,
+	op types.PowerControlOp,
+) (*PlanResult, error) {
+	return c.planPowerControl(ctx, rackNamesTargetSpec(rackNames, componentType), op)
+}
+
+// PlanPowerControlByMachineIDs plans power control on the given machine IDs
+// without submitting it.
+func (c *Client) PlanPowerControlByMachineIDs(
+	ctx context.Context,
+	machineIDs []string,
+	op types.PowerControlOp,
+) (*PlanResult, error) {
+	return c.planPowerControl(ctx, machineIDsTargetSpec(machineIDs), op)
+}
+
+// executePowerControl executes a power control operation with the given target spec.
+func (c *Client) executePowerControl(
+	ctx context.Context,
+	targetSpec *pb.OperationTargetSpec,
+	op types.PowerControlOp,
+) (*PowerControlResult, error) {
+	planReq, err := powerControlOperation(targetSpec, op)
 	if err != nil {
 		return nil, err
 	}
 
-	return &UpgradeFirmwareResult{
+	var rsp *pb.SubmitTaskResponse
+
+	switch r := planReq.GetOperation().(type) {
+	case *pb.PlanOperationRequest_PowerOn:
+		rsp, err = c.client.PowerOnRack(ctx, r.PowerOn)
+	case *pb.PlanOperationRequest_PowerOff:
+		rsp, err = c.client.PowerOffRack(ctx, r.PowerOff)
+	case *pb.PlanOperationRequest_PowerReset:
+		rsp, err = c.client.PowerResetRack(ctx, r.PowerReset)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &PowerControlResult{
 		TaskIDs: uuidsFromProto(rsp.GetTaskIds()),
 	}, nil
 }
 
-// PowerControlByRackIDs performs power control on components in the given rack IDs.
-func (c *Client) PowerControlByRackIDs(
+// planPowerControl plans a power control operation with the given target spec.
+func (c *Client) planPowerControl(
 	ctx context.Context,
+	targetSpec *pb.OperationTargetSpec,
+	op types.PowerControlOp,
+) (*PlanResult, error) {
+	planReq, err := powerControlOperation(targetSpec, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.planOperation(ctx, planReq)
+}
+
+// planOperation asks the server how an operation would execute.
+func (c *Client) planOperation(
+	ctx context.Context,
+	req *pb.PlanOperationRequest,
+) (*PlanResult, error) {
+	rsp, err := c.client.PlanOperation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*types.OperationPlan, 0, len(rsp.GetPlans()))
+	for _, p := range rsp.GetPlans() {
+		plans = append(plans, operationPlanFromProto(p))
+	}
+
+	return &PlanResult{Plans: plans}, nil
+}
+
+// powerControlOperation maps a power control operation onto the request of
+// the RPC that performs it.
+func powerControlOperation(
+	targetSpec *pb.OperationTargetSpec,
+	op types.PowerControlOp,
+) (*pb.PlanOperationRequest, error) {
+	switch powerControlOpToProto(op) {
+	case pb.PowerControlOp_POWER_CONTROL_OP_ON, pb.PowerControlOp_POWER_CONTROL_OP_FORCE_ON:
+		return &pb.PlanOperationRequest{
+			Operation: &pb.PlanOperationRequest_PowerOn{
+				PowerOn: &pb.PowerOnRackRequest{
+					TargetSpec: targetSpec,
+				},
+			},
+		}, nil
+
+	case pb.PowerControlOp_POWER_CONTROL_OP_OFF:
+		return &pb.PlanOperationRequest{
+			Operation: &pb.PlanOperationRequest_PowerOff{
+				PowerOff: &pb.PowerOffRackRequest{
+					TargetSpec: targetSpec,
+					Forced:     false,
+				},
+			},
+		}, nil
+
+	case pb.PowerControlOp_POWER_CONTROL_OP_FORCE_OFF:
+		return &pb.PlanOperationRequest{
+			Operation: &pb.PlanOperationRequest_PowerOff{
+				PowerOff: &pb.PowerOffRackRequest{
+					TargetSpec: targetSpec,
+					Forced:     true,
+				},
+			},
+		}, nil
+
+	case pb.PowerControlOp_POWER_CONTROL_OP_RESTART, pb.PowerControlOp_POWER_CONTROL_OP_WARM_RESET:
+		return &pb.PlanOperationRequest{
+			Operation: &pb.PlanOperationRequest_PowerReset{
+				PowerReset: &pb.PowerResetRackRequest{
+					TargetSpec: targetSpec,
+					Forced:     false,
+				},
+			},
+		}, nil
+
+	case pb.PowerControlOp_POWER_CONTROL_OP_FORCE_RESTART, pb.PowerControlOp_POWER_CONTROL_OP_COLD_RESET:
+		return &pb.PlanOperationRequest{
+			Operation: &pb.PlanOperationRequest_PowerReset{
+				PowerReset: &pb.PowerResetRackRequest{
+					TargetSpec: targetSpec,
+					Forced:     true,
+				},
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported power control operation: %v", op)
+	}
+}
+
+// rackIDsTargetSpec targets the components of the given type in the given racks.
+func rackIDsTargetSpec(
 	rackIDs []uuid.UUID,
 	componentType types.ComponentType,
-	op types.PowerControlOp,
-) (*PowerControlResult, error) {
+) *pb.OperationTargetSpec {
 	rackTargets := make([]*pb.RackTarget, 0, len(rackIDs))
 	for _, id := range rackIDs {
 		rackTargets = append(rackTargets, &pb.RackTarget{
@@ -470,22 +652,18 @@ func (c *Client) PowerControlByRackIDs(
 		})
 	}
 
-	targetSpec := &pb.OperationTargetSpec{
+	return &pb.OperationTargetSpec{
 		Targets: &pb.OperationTargetSpec_Racks{
 			Racks: &pb.RackTargets{Targets: rackTargets},
 		},
 	}
-
-	return c.executePowerControl(ctx, targetSpec, op)
 }
 
-// PowerControlByRackNames performs power control on components in the given rack names.
-func (c *Client) PowerControlByRackNames(
-	ctx context.Context,
+// rackNamesTargetSpec targets the components of the given type in the named racks.
+func rackNamesTargetSpec(
 	rackNames []string,
 	componentType types.ComponentType,
-	op types.PowerControlOp,
-) (*PowerControlResult, error) {
+) *pb.OperationTargetSpec {
 	rackTargets := make([]*pb.RackTarget, 0, len(rackNames))
 	for _, name := range rackNames {
 		rackTargets = append(rackTargets, &pb.RackTarget{
@@ -494,21 +672,15 @@ func (c *Client) PowerControlByRackNames(
 		})
 	}
 
-	targetSpec := &pb.OperationTargetSpec{
+	return &pb.OperationTargetSpec{
 		Targets: &pb.OperationTargetSpec_Racks{
 			Racks: &pb.RackTargets{Targets: rackTargets},
 		},
 	}
-
-	return c.executePowerControl(ctx, targetSpec, op)
 }
 
-// PowerControlByMachineIDs performs power control on the given machine IDs.
-func (c *Client) PowerControlByMachineIDs(
-	ctx context.Context,
-	machineIDs []string,
-	op types.PowerControlOp,
-) (*PowerControlResult, error) {
+// machineIDsTargetSpec targets compute components by machine ID.
+func machineIDsTargetSpec(machineIDs []string) *pb.OperationTargetSpec {
 	compTargets := make([]*pb.ComponentTarget, 0, len(machineIDs))
 	for _, machineID := range machineIDs {
 		compTargets = append(compTargets, &pb.ComponentTarget{
@@ -521,67 +693,11 @@ func (c *Client) PowerControlByMachineIDs(
 		})
 	}
 
-	targetSpec := &pb.OperationTargetSpec{
+	return &pb.OperationTargetSpec{
 		Targets: &pb.OperationTargetSpec_Components{
 			Components: &pb.ComponentTargets{Targets: compTargets},
 		},
 	}
-
-	return c.executePowerControl(ctx, targetSpec, op)
-}
-
-// executePowerControl executes a power control operation with the given target spec.
-func (c *Client) executePowerControl(
-	ctx context.Context,
-	targetSpec *pb.OperationTargetSpec,
-	op types.PowerControlOp,
-) (*PowerControlResult, error) {
-	var rsp *pb.SubmitTaskResponse
-	var err error
-
-	pbOp := powerControlOpToProto(op)
-
-	switch pbOp {
-	case pb.PowerControlOp_POWER_CONTROL_OP_ON, pb.PowerControlOp_POWER_CONTROL_OP_FORCE_ON:
-		rsp, err = c.client.PowerOnRack(ctx, &pb.PowerOnRackRequest{
-			TargetSpec: targetSpec,
-		})
-
-	case pb.PowerControlOp_POWER_CONTROL_OP_OFF:
-		rsp, err = c.client.PowerOffRack(ctx, &pb.PowerOffRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     false,
-		})
-
-	case pb.PowerControlOp_POWER_CONTROL_OP_FORCE_OFF:
-		rsp, err = c.client.PowerOffRack(ctx, &pb.PowerOffRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     true,
-		})
-
-	case pb.PowerControlOp_POWER_CONTROL_OP_RESTART, pb.PowerControlOp_POWER_CONTROL_OP_WARM_RESET:
-		rsp, err = c.client.PowerResetRack(ctx, &pb.PowerResetRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     false,
-		})
-
-	case pb.PowerControlOp_POWER_CONTROL_OP_FORCE_RESTART, pb.PowerControlOp_POWER_CONTROL_OP_COLD_RESET:
-		rsp, err = c.client.PowerResetRack(ctx, &pb.PowerResetRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     true,
-		})
-
-	default:
-		return nil, fmt.Errorf("unsupported power control operation: %v", op)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &PowerControlResult{
-		TaskIDs: uuidsFromProto(rsp.GetTaskIds()),
-	}, nil
 }
 
 // GetExpectedComponentsByRackIDs retrieves expected components from local database by rack IDs.
@@ -921,6 +1037,103 @@ func (c *Client) GetTasksByIDs(
 	return tasks, nil
 }
 
+// GetTaskReport retrieves the report recorded while a task ran. When format
+// is set the server also renders the report in that format into Content.
+func (c *Client) GetTaskReport(
+	ctx context.Context,
+	taskID uuid.UUID,
+	format types.TaskReportFormat,
+) (*types.TaskReport, error) {
+	rsp, err := c.client.GetTaskReport(ctx, &pb.GetTaskReportRequest{
+		TaskId: uuidToProto(taskID),
+		Format: taskReportFormatToProto(format),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskReportFromProto(rsp), nil
+}
+
+// ListDrifts lists the drifts found by the inventory sync that match the
+// filter.
+func (c *Client) ListDrifts(
+	ctx context.Context,
+	filter types.DriftFilter,
+) ([]*types.ComponentDrift, error) {
+	req := &pb.ListDriftsRequest{
+		IncludeDismissed: filter.IncludeDismissed,
+	}
+	if filter.RackID != uuid.Nil {
+		req.RackId = uuidToProto(filter.RackID)
+	}
+	for _, t := range filter.Types {
+		req.Types = append(req.Types, driftTypeToProto(t))
+	}
+	for _, ct := range filter.ComponentTypes {
+		req.ComponentTypes = append(req.ComponentTypes, componentTypeToProto(ct))
+	}
+
+	rsp, err := c.client.ListDrifts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return componentDriftsFromProto(rsp.GetDrifts()), nil
+}
+
+// AcceptDrift writes the values reported by the component manager service
+// into the expected component and removes the drift. It returns the updated
+// component.
+func (c *Client) AcceptDrift(
+	ctx context.Context,
+	driftID uuid.UUID,
+) (*types.Component, error) {
+	rsp, err := c.client.AcceptDrift(ctx, &pb.AcceptDriftRequest{
+		Id: uuidToProto(driftID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return componentFromProto(rsp.GetComponent()), nil
+}
+
+// DismissDrift marks a drift as reviewed so it is no longer listed as open.
+func (c *Client) DismissDrift(
+	ctx context.Context,
+	driftID uuid.UUID,
+	reason string,
+	dismissedBy string,
+) (*types.ComponentDrift, error) {
+	rsp, err := c.client.DismissDrift(ctx, &pb.DismissDriftRequest{
+		Id:          uuidToProto(driftID),
+		Reason:      reason,
+		DismissedBy: dismissedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return componentDriftFromProto(rsp), nil
+}
+
+// ResyncRack runs the inventory sync for one rack and returns the rack's
+// open drifts.
+func (c *Client) ResyncRack(
+	ctx context.Context,
+	rackID uuid.UUID,
+) ([]*types.ComponentDrift, error) {
+	rsp, err := c.client.ResyncRack(ctx, &pb.ResyncRackRequest{
+		RackId: uuidToProto(rackID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return componentDriftsFromProto(rsp.GetDrifts()), nil
+}
+
 // AddComponent creates a single component under an existing rack.
 func (c *Client) AddComponent(
 	ctx context.Context,
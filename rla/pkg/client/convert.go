@@ -19,6 +19,7 @@ package client
 
 import (
 	"net"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -593,3 +594,200 @@ func rackRuleAssociationFromProto(a *pb.RackRuleAssociation) *types.RackRuleAsso
 
 	return assoc
 }
+
+func operationPlanFromProto(p *pb.RackOperationPlan) *types.OperationPlan {
+	if p == nil {
+		return nil
+	}
+
+	plan := &types.OperationPlan{
+		RackID:            uuidFromProto(p.GetRackId()),
+		RackName:          p.GetRackName(),
+		Operation:         p.GetOperation(),
+		Rule:              operationRuleFromProto(p.GetRule()),
+		RuleSource:        ruleSourceFromProto(p.GetRuleSource()),
+		EstimatedDuration: time.Duration(p.GetEstimatedDurationSeconds()) * time.Second,
+		Outcome:           planOutcomeFromProto(p.GetOutcome()),
+		Reason:            p.GetReason(),
+		Warnings:          p.GetWarnings(),
+	}
+
+	for _, s := range p.GetStages() {
+		stage := types.PlannedStage{
+			Number:            int(s.GetNumber()),
+			EstimatedDuration: time.Duration(s.GetEstimatedDurationSeconds()) * time.Second,
+		}
+		for _, st := range s.GetSteps() {
+			step := types.PlannedStep{
+				ComponentType:     componentTypeFromProto(st.GetComponentType()),
+				MaxParallel:       int(st.GetMaxParallel()),
+				Actions:           st.GetActions(),
+				EstimatedDuration: time.Duration(st.GetEstimatedDurationSeconds()) * time.Second,
+			}
+			for _, b := range st.GetBatches() {
+				step.Batches = append(step.Batches, uuidsFromProto(b.GetComponentIds()))
+			}
+			stage.Steps = append(stage.Steps, step)
+		}
+		plan.Stages = append(plan.Stages, stage)
+	}
+
+	for _, t := range p.GetConflictingTasks() {
+		plan.ConflictingTasks = append(plan.ConflictingTasks, taskFromProto(t))
+	}
+
+	return plan
+}
+
+func ruleSourceFromProto(rs pb.RuleSource) types.RuleSource {
+	switch rs {
+	case pb.RuleSource_RULE_SOURCE_EXPLICIT:
+		return types.RuleSourceExplicit
+	case pb.RuleSource_RULE_SOURCE_RACK_ASSOCIATION:
+		return types.RuleSourceRackAssociation
+	case pb.RuleSource_RULE_SOURCE_DEFAULT:
+		return types.RuleSourceDefault
+	case pb.RuleSource_RULE_SOURCE_HARDCODED:
+		return types.RuleSourceHardcoded
+	default:
+		return types.RuleSourceUnknown
+	}
+}
+
+func planOutcomeFromProto(o pb.PlanOutcome) types.PlanOutcome {
+	switch o {
+	case pb.PlanOutcome_PLAN_OUTCOME_RUN:
+		return types.PlanOutcomeRun
+	case pb.PlanOutcome_PLAN_OUTCOME_QUEUE:
+		return types.PlanOutcomeQueue
+	case pb.PlanOutcome_PLAN_OUTCOME_REJECT:
+		return types.PlanOutcomeReject
+	default:
+		return types.PlanOutcomeUnknown
+	}
+}
+
+func taskReportFromProto(rsp *pb.GetTaskReportResponse) *types.TaskReport {
+	report := &types.TaskReport{
+		Task:    taskFromProto(rsp.GetTask()),
+		Entries: make([]types.TaskReportEntry, 0, len(rsp.GetEntries())),
+		Content: rsp.GetContent(),
+	}
+
+	for _, e := range rsp.GetEntries() {
+		report.Entries = append(report.Entries, types.TaskReportEntry{
+			Time:          e.GetTime().AsTime(),
+			ComponentType: componentTypeFromProto(e.GetComponentType()),
+			ComponentID:   e.GetComponentId(),
+			Stage:         int(e.GetStage()),
+			Step:          e.GetStep(),
+			Action:        e.GetAction(),
+			Outcome:       taskReportOutcomeFromProto(e.GetOutcome()),
+			Message:       e.GetMessage(),
+		})
+	}
+
+	return report
+}
+
+func taskReportOutcomeFromProto(o pb.TaskReportOutcome) types.TaskReportOutcome {
+	switch o {
+	case pb.TaskReportOutcome_TASK_REPORT_OUTCOME_SUCCEEDED:
+		return types.TaskReportOutcomeSucceeded
+	case pb.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED:
+		return types.TaskReportOutcomeFailed
+	case pb.TaskReportOutcome_TASK_REPORT_OUTCOME_TERMINATED:
+		return types.TaskReportOutcomeTerminated
+	default:
+		return types.TaskReportOutcomeUnknown
+	}
+}
+
+func taskReportFormatToProto(f types.TaskReportFormat) pb.TaskReportFormat {
+	switch f {
+	case types.TaskReportFormatJSON:
+		return pb.TaskReportFormat_TASK_REPORT_FORMAT_JSON
+	case types.TaskReportFormatMarkdown:
+		return pb.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN
+	default:
+		return pb.TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func componentDriftFromProto(d *pb.ComponentDrift) *types.ComponentDrift {
+	if d == nil {
+		return nil
+	}
+
+	drift := &types.ComponentDrift{
+		ID:            uuidFromProto(d.GetId()),
+		ComponentID:   uuidFromProto(d.GetComponentId()),
+		ExternalID:    d.GetExternalId(),
+		RackID:        uuidFromProto(d.GetRackId()),
+		ComponentType: componentTypeFromProto(d.GetComponentType()),
+		Type:          driftTypeFromProto(d.GetType()),
+		Status:        driftStatusFromProto(d.GetStatus()),
+		DismissReason: d.GetDismissReason(),
+		DismissedBy:   d.GetDismissedBy(),
+		CheckedAt:     d.GetCheckedAt().AsTime(),
+	}
+	if d.DismissedAt != nil {
+		dismissedAt := d.GetDismissedAt().AsTime()
+		drift.DismissedAt = &dismissedAt
+	}
+
+	for _, fd := range d.GetDiffs() {
+		drift.FieldDiffs = append(drift.FieldDiffs, types.FieldDiff{
+			FieldName:     fd.GetFieldName(),
+			ExpectedValue: fd.GetExpectedValue(),
+			ActualValue:   fd.GetActualValue(),
+		})
+	}
+
+	return drift
+}
+
+func componentDriftsFromProto(drifts []*pb.ComponentDrift) []*types.ComponentDrift {
+	result := make([]*types.ComponentDrift, 0, len(drifts))
+	for _, d := range drifts {
+		result = append(result, componentDriftFromProto(d))
+	}
+	return result
+}
+
+func driftTypeFromProto(dt pb.DriftType) types.DriftType {
+	switch dt {
+	case pb.DriftType_DRIFT_TYPE_MISSING_IN_EXPECTED:
+		return types.DriftTypeMissingInExpected
+	case pb.DriftType_DRIFT_TYPE_MISSING_IN_ACTUAL:
+		return types.DriftTypeMissingInActual
+	case pb.DriftType_DRIFT_TYPE_MISMATCH:
+		return types.DriftTypeMismatch
+	default:
+		return types.DriftTypeUnknown
+	}
+}
+
+func driftTypeToProto(dt types.DriftType) pb.DriftType {
+	switch dt {
+	case types.DriftTypeMissingInExpected:
+		return pb.DriftType_DRIFT_TYPE_MISSING_IN_EXPECTED
+	case types.DriftTypeMissingInActual:
+		return pb.DriftType_DRIFT_TYPE_MISSING_IN_ACTUAL
+	case types.DriftTypeMismatch:
+		return pb.DriftType_DRIFT_TYPE_MISMATCH
+	default:
+		return pb.DriftType_DRIFT_TYPE_UNKNOWN
+	}
+}
+
+func driftStatusFromProto(ds pb.DriftStatus) types.DriftStatus {
+	switch ds {
+	case pb.DriftStatus_DRIFT_STATUS_OPEN:
+		return types.DriftStatusOpen
+	case pb.DriftStatus_DRIFT_STATUS_DISMISSED:
+		return types.DriftStatusDismissed
+	default:
+		return types.DriftStatusUnknown
+	}
+}
@@ -59,3 +59,9 @@ type ListTasksResult struct {
 	Tasks []*types.Task
 	Total int
 }
+
+// PlanResult represents the result of planning an operation without
+// submitting it.
+type PlanResult struct {
+	Plans []*types.OperationPlan // One plan per target rack
+}
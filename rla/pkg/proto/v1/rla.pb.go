@@ -16,7 +16,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.1
+// 	protoc-gen-go v1.36.11
 // 	protoc        v5.29.2
 // source: rla.proto
 
@@ -30,6 +30,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -451,6 +452,10 @@ const (
 	// is active on the rack. It will be promoted automatically when the rack
 	// becomes available, or can be cancelled explicitly via CancelTask.
 	TaskStatus_TASK_STATUS_WAITING TaskStatus = 6
+	// TASK_STATUS_WAITING_APPROVAL means the task reached an AwaitApproval
+	// action and is paused until ApproveTaskStep or RejectTaskStep is called,
+	// or the gate's timeout applies its default decision.
+	TaskStatus_TASK_STATUS_WAITING_APPROVAL TaskStatus = 7
 )
 
 // Enum value maps for TaskStatus.
@@ -463,15 +468,17 @@ var (
 		4: "TASK_STATUS_FAILED",
 		5: "TASK_STATUS_TERMINATED",
 		6: "TASK_STATUS_WAITING",
+		7: "TASK_STATUS_WAITING_APPROVAL",
 	}
 	TaskStatus_value = map[string]int32{
-		"TASK_STATUS_UNKNOWN":    0,
-		"TASK_STATUS_PENDING":    1,
-		"TASK_STATUS_RUNNING":    2,
-		"TASK_STATUS_COMPLETED":  3,
-		"TASK_STATUS_FAILED":     4,
-		"TASK_STATUS_TERMINATED": 5,
-		"TASK_STATUS_WAITING":    6,
+		"TASK_STATUS_UNKNOWN":          0,
+		"TASK_STATUS_PENDING":          1,
+		"TASK_STATUS_RUNNING":          2,
+		"TASK_STATUS_COMPLETED":        3,
+		"TASK_STATUS_FAILED":           4,
+		"TASK_STATUS_TERMINATED":       5,
+		"TASK_STATUS_WAITING":          6,
+		"TASK_STATUS_WAITING_APPROVAL": 7,
 	}
 )
 
@@ -502,6 +509,58 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return file_rla_proto_rawDescGZIP(), []int{7}
 }
 
+type ApprovalDecision int32
+
+const (
+	ApprovalDecision_APPROVAL_DECISION_NONE    ApprovalDecision = 0 // still waiting
+	ApprovalDecision_APPROVAL_DECISION_APPROVE ApprovalDecision = 1
+	ApprovalDecision_APPROVAL_DECISION_REJECT  ApprovalDecision = 2 // the task fails
+	ApprovalDecision_APPROVAL_DECISION_ABORT   ApprovalDecision = 3 // the task is terminated
+)
+
+// Enum value maps for ApprovalDecision.
+var (
+	ApprovalDecision_name = map[int32]string{
+		0: "APPROVAL_DECISION_NONE",
+		1: "APPROVAL_DECISION_APPROVE",
+		2: "APPROVAL_DECISION_REJECT",
+		3: "APPROVAL_DECISION_ABORT",
+	}
+	ApprovalDecision_value = map[string]int32{
+		"APPROVAL_DECISION_NONE":    0,
+		"APPROVAL_DECISION_APPROVE": 1,
+		"APPROVAL_DECISION_REJECT":  2,
+		"APPROVAL_DECISION_ABORT":   3,
+	}
+)
+
+func (x ApprovalDecision) Enum() *ApprovalDecision {
+	p := new(ApprovalDecision)
+	*p = x
+	return p
+}
+
+func (x ApprovalDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[8].Descriptor()
+}
+
+func (ApprovalDecision) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[8]
+}
+
+func (x ApprovalDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalDecision.Descriptor instead.
+func (ApprovalDecision) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{8}
+}
+
 type TaskExecutorType int32
 
 const (
@@ -532,11 +591,11 @@ func (x TaskExecutorType) String() string {
 }
 
 func (TaskExecutorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[8].Descriptor()
+	return file_rla_proto_enumTypes[9].Descriptor()
 }
 
 func (TaskExecutorType) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[8]
+	return &file_rla_proto_enumTypes[9]
 }
 
 func (x TaskExecutorType) Number() protoreflect.EnumNumber {
@@ -545,7 +604,7 @@ func (x TaskExecutorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskExecutorType.Descriptor instead.
 func (TaskExecutorType) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{8}
+	return file_rla_proto_rawDescGZIP(), []int{9}
 }
 
 type DiffType int32
@@ -584,11 +643,11 @@ func (x DiffType) String() string {
 }
 
 func (DiffType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[9].Descriptor()
+	return file_rla_proto_enumTypes[10].Descriptor()
 }
 
 func (DiffType) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[9]
+	return &file_rla_proto_enumTypes[10]
 }
 
 func (x DiffType) Number() protoreflect.EnumNumber {
@@ -597,7 +656,7 @@ func (x DiffType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiffType.Descriptor instead.
 func (DiffType) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{9}
+	return file_rla_proto_rawDescGZIP(), []int{10}
 }
 
 // ConflictStrategy controls how a task behaves when a conflict is detected.
@@ -639,11 +698,11 @@ func (x ConflictStrategy) String() string {
 }
 
 func (ConflictStrategy) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[10].Descriptor()
+	return file_rla_proto_enumTypes[11].Descriptor()
 }
 
 func (ConflictStrategy) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[10]
+	return &file_rla_proto_enumTypes[11]
 }
 
 func (x ConflictStrategy) Number() protoreflect.EnumNumber {
@@ -652,7 +711,374 @@ func (x ConflictStrategy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConflictStrategy.Descriptor instead.
 func (ConflictStrategy) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{10}
+	return file_rla_proto_rawDescGZIP(), []int{11}
+}
+
+// TaskPriority is the priority class of a task.
+type TaskPriority int32
+
+const (
+	TaskPriority_TASK_PRIORITY_UNSPECIFIED TaskPriority = 0 // same as TASK_PRIORITY_NORMAL
+	TaskPriority_TASK_PRIORITY_EMERGENCY   TaskPriority = 1 // urgent safety actions; promoted first, may preempt
+	TaskPriority_TASK_PRIORITY_NORMAL      TaskPriority = 2
+	TaskPriority_TASK_PRIORITY_BACKGROUND  TaskPriority = 3 // yields to everything else
+)
+
+// Enum value maps for TaskPriority.
+var (
+	TaskPriority_name = map[int32]string{
+		0: "TASK_PRIORITY_UNSPECIFIED",
+		1: "TASK_PRIORITY_EMERGENCY",
+		2: "TASK_PRIORITY_NORMAL",
+		3: "TASK_PRIORITY_BACKGROUND",
+	}
+	TaskPriority_value = map[string]int32{
+		"TASK_PRIORITY_UNSPECIFIED": 0,
+		"TASK_PRIORITY_EMERGENCY":   1,
+		"TASK_PRIORITY_NORMAL":      2,
+		"TASK_PRIORITY_BACKGROUND":  3,
+	}
+)
+
+func (x TaskPriority) Enum() *TaskPriority {
+	p := new(TaskPriority)
+	*p = x
+	return p
+}
+
+func (x TaskPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[12].Descriptor()
+}
+
+func (TaskPriority) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[12]
+}
+
+func (x TaskPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskPriority.Descriptor instead.
+func (TaskPriority) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{12}
+}
+
+// Preemption controls what an emergency task does to conflicting active tasks
+// of lower priority on its rack.
+type Preemption int32
+
+const (
+	Preemption_PREEMPTION_NONE    Preemption = 0 // leave them; conflict_strategy applies
+	Preemption_PREEMPTION_CANCEL  Preemption = 1 // terminate them
+	Preemption_PREEMPTION_REQUEUE Preemption = 2 // interrupt them and queue them to run again
+)
+
+// Enum value maps for Preemption.
+var (
+	Preemption_name = map[int32]string{
+		0: "PREEMPTION_NONE",
+		1: "PREEMPTION_CANCEL",
+		2: "PREEMPTION_REQUEUE",
+	}
+	Preemption_value = map[string]int32{
+		"PREEMPTION_NONE":    0,
+		"PREEMPTION_CANCEL":  1,
+		"PREEMPTION_REQUEUE": 2,
+	}
+)
+
+func (x Preemption) Enum() *Preemption {
+	p := new(Preemption)
+	*p = x
+	return p
+}
+
+func (x Preemption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Preemption) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[13].Descriptor()
+}
+
+func (Preemption) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[13]
+}
+
+func (x Preemption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Preemption.Descriptor instead.
+func (Preemption) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{13}
+}
+
+// MaintenancePolicy controls what happens to a disruptive operation on a rack
+// that is outside its maintenance windows or inside a blackout.
+type MaintenancePolicy int32
+
+const (
+	// MAINTENANCE_POLICY_UNSPECIFIED applies the server's configured policy,
+	// which rejects unless maintenance.defer_by_default is set.
+	MaintenancePolicy_MAINTENANCE_POLICY_UNSPECIFIED MaintenancePolicy = 0
+	MaintenancePolicy_MAINTENANCE_POLICY_REJECT      MaintenancePolicy = 1 // refuse the operation
+	MaintenancePolicy_MAINTENANCE_POLICY_DEFER       MaintenancePolicy = 2 // queue the task until the rack's next opening
+)
+
+// Enum value maps for MaintenancePolicy.
+var (
+	MaintenancePolicy_name = map[int32]string{
+		0: "MAINTENANCE_POLICY_UNSPECIFIED",
+		1: "MAINTENANCE_POLICY_REJECT",
+		2: "MAINTENANCE_POLICY_DEFER",
+	}
+	MaintenancePolicy_value = map[string]int32{
+		"MAINTENANCE_POLICY_UNSPECIFIED": 0,
+		"MAINTENANCE_POLICY_REJECT":      1,
+		"MAINTENANCE_POLICY_DEFER":       2,
+	}
+)
+
+func (x MaintenancePolicy) Enum() *MaintenancePolicy {
+	p := new(MaintenancePolicy)
+	*p = x
+	return p
+}
+
+func (x MaintenancePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenancePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[14].Descriptor()
+}
+
+func (MaintenancePolicy) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[14]
+}
+
+func (x MaintenancePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenancePolicy.Descriptor instead.
+func (MaintenancePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{14}
+}
+
+type RuleSource int32
+
+const (
+	RuleSource_RULE_SOURCE_UNKNOWN          RuleSource = 0
+	RuleSource_RULE_SOURCE_EXPLICIT         RuleSource = 1 // rule_id given in the request
+	RuleSource_RULE_SOURCE_RACK_ASSOCIATION RuleSource = 2 // rule associated with the rack
+	RuleSource_RULE_SOURCE_DEFAULT          RuleSource = 3 // default rule for the operation
+	RuleSource_RULE_SOURCE_HARDCODED        RuleSource = 4 // built-in fallback rule
+)
+
+// Enum value maps for RuleSource.
+var (
+	RuleSource_name = map[int32]string{
+		0: "RULE_SOURCE_UNKNOWN",
+		1: "RULE_SOURCE_EXPLICIT",
+		2: "RULE_SOURCE_RACK_ASSOCIATION",
+		3: "RULE_SOURCE_DEFAULT",
+		4: "RULE_SOURCE_HARDCODED",
+	}
+	RuleSource_value = map[string]int32{
+		"RULE_SOURCE_UNKNOWN":          0,
+		"RULE_SOURCE_EXPLICIT":         1,
+		"RULE_SOURCE_RACK_ASSOCIATION": 2,
+		"RULE_SOURCE_DEFAULT":          3,
+		"RULE_SOURCE_HARDCODED":        4,
+	}
+)
+
+func (x RuleSource) Enum() *RuleSource {
+	p := new(RuleSource)
+	*p = x
+	return p
+}
+
+func (x RuleSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[15].Descriptor()
+}
+
+func (RuleSource) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[15]
+}
+
+func (x RuleSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleSource.Descriptor instead.
+func (RuleSource) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{15}
+}
+
+type PlanOutcome int32
+
+const (
+	PlanOutcome_PLAN_OUTCOME_UNKNOWN PlanOutcome = 0
+	PlanOutcome_PLAN_OUTCOME_RUN     PlanOutcome = 1 // the task would start immediately
+	PlanOutcome_PLAN_OUTCOME_QUEUE   PlanOutcome = 2 // the task would wait behind conflicting tasks
+	PlanOutcome_PLAN_OUTCOME_REJECT  PlanOutcome = 3 // the submission would be refused; see reason
+)
+
+// Enum value maps for PlanOutcome.
+var (
+	PlanOutcome_name = map[int32]string{
+		0: "PLAN_OUTCOME_UNKNOWN",
+		1: "PLAN_OUTCOME_RUN",
+		2: "PLAN_OUTCOME_QUEUE",
+		3: "PLAN_OUTCOME_REJECT",
+	}
+	PlanOutcome_value = map[string]int32{
+		"PLAN_OUTCOME_UNKNOWN": 0,
+		"PLAN_OUTCOME_RUN":     1,
+		"PLAN_OUTCOME_QUEUE":   2,
+		"PLAN_OUTCOME_REJECT":  3,
+	}
+)
+
+func (x PlanOutcome) Enum() *PlanOutcome {
+	p := new(PlanOutcome)
+	*p = x
+	return p
+}
+
+func (x PlanOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[16].Descriptor()
+}
+
+func (PlanOutcome) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[16]
+}
+
+func (x PlanOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanOutcome.Descriptor instead.
+func (PlanOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{16}
+}
+
+// TaskReportOutcome is the outcome of a task report entry.
+type TaskReportOutcome int32
+
+const (
+	TaskReportOutcome_TASK_REPORT_OUTCOME_UNKNOWN    TaskReportOutcome = 0
+	TaskReportOutcome_TASK_REPORT_OUTCOME_SUCCEEDED  TaskReportOutcome = 1
+	TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED     TaskReportOutcome = 2
+	TaskReportOutcome_TASK_REPORT_OUTCOME_TERMINATED TaskReportOutcome = 3 // the task was stopped before it finished
+)
+
+// Enum value maps for TaskReportOutcome.
+var (
+	TaskReportOutcome_name = map[int32]string{
+		0: "TASK_REPORT_OUTCOME_UNKNOWN",
+		1: "TASK_REPORT_OUTCOME_SUCCEEDED",
+		2: "TASK_REPORT_OUTCOME_FAILED",
+		3: "TASK_REPORT_OUTCOME_TERMINATED",
+	}
+	TaskReportOutcome_value = map[string]int32{
+		"TASK_REPORT_OUTCOME_UNKNOWN":    0,
+		"TASK_REPORT_OUTCOME_SUCCEEDED":  1,
+		"TASK_REPORT_OUTCOME_FAILED":     2,
+		"TASK_REPORT_OUTCOME_TERMINATED": 3,
+	}
+)
+
+func (x TaskReportOutcome) Enum() *TaskReportOutcome {
+	p := new(TaskReportOutcome)
+	*p = x
+	return p
+}
+
+func (x TaskReportOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskReportOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[17].Descriptor()
+}
+
+func (TaskReportOutcome) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[17]
+}
+
+func (x TaskReportOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskReportOutcome.Descriptor instead.
+func (TaskReportOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{17}
+}
+
+// TaskReportFormat selects how GetTaskReport renders the report.
+type TaskReportFormat int32
+
+const (
+	TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED TaskReportFormat = 0 // structured entries only
+	TaskReportFormat_TASK_REPORT_FORMAT_JSON        TaskReportFormat = 1
+	TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN    TaskReportFormat = 2
+)
+
+// Enum value maps for TaskReportFormat.
+var (
+	TaskReportFormat_name = map[int32]string{
+		0: "TASK_REPORT_FORMAT_UNSPECIFIED",
+		1: "TASK_REPORT_FORMAT_JSON",
+		2: "TASK_REPORT_FORMAT_MARKDOWN",
+	}
+	TaskReportFormat_value = map[string]int32{
+		"TASK_REPORT_FORMAT_UNSPECIFIED": 0,
+		"TASK_REPORT_FORMAT_JSON":        1,
+		"TASK_REPORT_FORMAT_MARKDOWN":    2,
+	}
+)
+
+func (x TaskReportFormat) Enum() *TaskReportFormat {
+	p := new(TaskReportFormat)
+	*p = x
+	return p
+}
+
+func (x TaskReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[18].Descriptor()
+}
+
+func (TaskReportFormat) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[18]
+}
+
+func (x TaskReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskReportFormat.Descriptor instead.
+func (TaskReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{18}
 }
 
 type OperationType int32
@@ -688,11 +1114,11 @@ func (x OperationType) String() string {
 }
 
 func (OperationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[11].Descriptor()
+	return file_rla_proto_enumTypes[19].Descriptor()
 }
 
 func (OperationType) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[11]
+	return &file_rla_proto_enumTypes[19]
 }
 
 func (x OperationType) Number() protoreflect.EnumNumber {
@@ -701,7 +1127,7 @@ func (x OperationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperationType.Descriptor instead.
 func (OperationType) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{11}
+	return file_rla_proto_rawDescGZIP(), []int{19}
 }
 
 type ScheduleSpecType int32
@@ -740,11 +1166,11 @@ func (x ScheduleSpecType) String() string {
 }
 
 func (ScheduleSpecType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[12].Descriptor()
+	return file_rla_proto_enumTypes[20].Descriptor()
 }
 
 func (ScheduleSpecType) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[12]
+	return &file_rla_proto_enumTypes[20]
 }
 
 func (x ScheduleSpecType) Number() protoreflect.EnumNumber {
@@ -753,7 +1179,7 @@ func (x ScheduleSpecType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduleSpecType.Descriptor instead.
 func (ScheduleSpecType) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{12}
+	return file_rla_proto_rawDescGZIP(), []int{20}
 }
 
 // OverlapPolicy controls what happens when a schedule fires while the previous
@@ -791,11 +1217,11 @@ func (x OverlapPolicy) String() string {
 }
 
 func (OverlapPolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[13].Descriptor()
+	return file_rla_proto_enumTypes[21].Descriptor()
 }
 
 func (OverlapPolicy) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[13]
+	return &file_rla_proto_enumTypes[21]
 }
 
 func (x OverlapPolicy) Number() protoreflect.EnumNumber {
@@ -804,26 +1230,531 @@ func (x OverlapPolicy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OverlapPolicy.Descriptor instead.
 func (OverlapPolicy) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{13}
+	return file_rla_proto_rawDescGZIP(), []int{21}
 }
 
-type UUID struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+type PowerLimitApplyStatus int32
 
-func (x *UUID) Reset() {
-	*x = UUID{}
-	mi := &file_rla_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+const (
+	PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_UNKNOWN       PowerLimitApplyStatus = 0
+	PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_APPLIED       PowerLimitApplyStatus = 1
+	PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_NOT_SUPPORTED PowerLimitApplyStatus = 2 // shelf has no PowerLimit; budget enforced by monitoring only
+	PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_FAILED        PowerLimitApplyStatus = 3
+	PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_SKIPPED       PowerLimitApplyStatus = 4 // no Powershelf Manager configured
+)
 
-func (x *UUID) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+// Enum value maps for PowerLimitApplyStatus.
+var (
+	PowerLimitApplyStatus_name = map[int32]string{
+		0: "POWER_LIMIT_APPLY_STATUS_UNKNOWN",
+		1: "POWER_LIMIT_APPLY_STATUS_APPLIED",
+		2: "POWER_LIMIT_APPLY_STATUS_NOT_SUPPORTED",
+		3: "POWER_LIMIT_APPLY_STATUS_FAILED",
+		4: "POWER_LIMIT_APPLY_STATUS_SKIPPED",
+	}
+	PowerLimitApplyStatus_value = map[string]int32{
+		"POWER_LIMIT_APPLY_STATUS_UNKNOWN":       0,
+		"POWER_LIMIT_APPLY_STATUS_APPLIED":       1,
+		"POWER_LIMIT_APPLY_STATUS_NOT_SUPPORTED": 2,
+		"POWER_LIMIT_APPLY_STATUS_FAILED":        3,
+		"POWER_LIMIT_APPLY_STATUS_SKIPPED":       4,
+	}
+)
+
+func (x PowerLimitApplyStatus) Enum() *PowerLimitApplyStatus {
+	p := new(PowerLimitApplyStatus)
+	*p = x
+	return p
+}
+
+func (x PowerLimitApplyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowerLimitApplyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[22].Descriptor()
+}
+
+func (PowerLimitApplyStatus) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[22]
+}
+
+func (x PowerLimitApplyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowerLimitApplyStatus.Descriptor instead.
+func (PowerLimitApplyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{22}
+}
+
+// CredentialAccount identifies a device account whose password is rotated.
+type CredentialAccount int32
+
+const (
+	CredentialAccount_CREDENTIAL_ACCOUNT_UNKNOWN CredentialAccount = 0
+	CredentialAccount_CREDENTIAL_ACCOUNT_PMC     CredentialAccount = 1 // power shelf controller Redfish account
+	CredentialAccount_CREDENTIAL_ACCOUNT_BMC     CredentialAccount = 2 // NVLink switch tray BMC Redfish account
+	CredentialAccount_CREDENTIAL_ACCOUNT_NVOS    CredentialAccount = 3 // NVLink switch tray NVOS SSH account
+)
+
+// Enum value maps for CredentialAccount.
+var (
+	CredentialAccount_name = map[int32]string{
+		0: "CREDENTIAL_ACCOUNT_UNKNOWN",
+		1: "CREDENTIAL_ACCOUNT_PMC",
+		2: "CREDENTIAL_ACCOUNT_BMC",
+		3: "CREDENTIAL_ACCOUNT_NVOS",
+	}
+	CredentialAccount_value = map[string]int32{
+		"CREDENTIAL_ACCOUNT_UNKNOWN": 0,
+		"CREDENTIAL_ACCOUNT_PMC":     1,
+		"CREDENTIAL_ACCOUNT_BMC":     2,
+		"CREDENTIAL_ACCOUNT_NVOS":    3,
+	}
+)
+
+func (x CredentialAccount) Enum() *CredentialAccount {
+	p := new(CredentialAccount)
+	*p = x
+	return p
+}
+
+func (x CredentialAccount) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialAccount) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[23].Descriptor()
+}
+
+func (CredentialAccount) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[23]
+}
+
+func (x CredentialAccount) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialAccount.Descriptor instead.
+func (CredentialAccount) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{23}
+}
+
+// DeviceCredentialRotationState is the state of the latest password rotation
+// of a device account.
+type DeviceCredentialRotationState int32
+
+const (
+	DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_UNKNOWN         DeviceCredentialRotationState = 0
+	DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED   DeviceCredentialRotationState = 1
+	DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_IN_PROGRESS     DeviceCredentialRotationState = 2
+	DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_SUCCEEDED       DeviceCredentialRotationState = 3
+	DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_FAILED          DeviceCredentialRotationState = 4 // device still accepts the stored password
+	DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED DeviceCredentialRotationState = 5 // restoring the stored password failed; needs manual attention
+)
+
+// Enum value maps for DeviceCredentialRotationState.
+var (
+	DeviceCredentialRotationState_name = map[int32]string{
+		0: "DEVICE_CREDENTIAL_ROTATION_STATE_UNKNOWN",
+		1: "DEVICE_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED",
+		2: "DEVICE_CREDENTIAL_ROTATION_STATE_IN_PROGRESS",
+		3: "DEVICE_CREDENTIAL_ROTATION_STATE_SUCCEEDED",
+		4: "DEVICE_CREDENTIAL_ROTATION_STATE_FAILED",
+		5: "DEVICE_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED",
+	}
+	DeviceCredentialRotationState_value = map[string]int32{
+		"DEVICE_CREDENTIAL_ROTATION_STATE_UNKNOWN":         0,
+		"DEVICE_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED":   1,
+		"DEVICE_CREDENTIAL_ROTATION_STATE_IN_PROGRESS":     2,
+		"DEVICE_CREDENTIAL_ROTATION_STATE_SUCCEEDED":       3,
+		"DEVICE_CREDENTIAL_ROTATION_STATE_FAILED":          4,
+		"DEVICE_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED": 5,
+	}
+)
+
+func (x DeviceCredentialRotationState) Enum() *DeviceCredentialRotationState {
+	p := new(DeviceCredentialRotationState)
+	*p = x
+	return p
+}
+
+func (x DeviceCredentialRotationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceCredentialRotationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[24].Descriptor()
+}
+
+func (DeviceCredentialRotationState) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[24]
+}
+
+func (x DeviceCredentialRotationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceCredentialRotationState.Descriptor instead.
+func (DeviceCredentialRotationState) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{24}
+}
+
+// DiscoveredDeviceState is where a device found by a discovery sweep stands
+// in onboarding.
+type DiscoveredDeviceState int32
+
+const (
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_UNKNOWN    DiscoveredDeviceState = 0
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_PENDING    DiscoveredDeviceState = 1 // matched an expected component; awaits approval
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_REGISTERED DiscoveredDeviceState = 2 // registered and its BMC recorded on the expected component
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_KNOWN      DiscoveredDeviceState = 3 // the expected component already lists its BMC
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_UNMATCHED  DiscoveredDeviceState = 4 // no expected component has its serial number
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_CONFLICT   DiscoveredDeviceState = 5 // the expected component lists another BMC, or the serial is ambiguous
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_FAILED     DiscoveredDeviceState = 6 // registration failed
+	DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_REJECTED   DiscoveredDeviceState = 7 // rejected by an operator
+)
+
+// Enum value maps for DiscoveredDeviceState.
+var (
+	DiscoveredDeviceState_name = map[int32]string{
+		0: "DISCOVERED_DEVICE_STATE_UNKNOWN",
+		1: "DISCOVERED_DEVICE_STATE_PENDING",
+		2: "DISCOVERED_DEVICE_STATE_REGISTERED",
+		3: "DISCOVERED_DEVICE_STATE_KNOWN",
+		4: "DISCOVERED_DEVICE_STATE_UNMATCHED",
+		5: "DISCOVERED_DEVICE_STATE_CONFLICT",
+		6: "DISCOVERED_DEVICE_STATE_FAILED",
+		7: "DISCOVERED_DEVICE_STATE_REJECTED",
+	}
+	DiscoveredDeviceState_value = map[string]int32{
+		"DISCOVERED_DEVICE_STATE_UNKNOWN":    0,
+		"DISCOVERED_DEVICE_STATE_PENDING":    1,
+		"DISCOVERED_DEVICE_STATE_REGISTERED": 2,
+		"DISCOVERED_DEVICE_STATE_KNOWN":      3,
+		"DISCOVERED_DEVICE_STATE_UNMATCHED":  4,
+		"DISCOVERED_DEVICE_STATE_CONFLICT":   5,
+		"DISCOVERED_DEVICE_STATE_FAILED":     6,
+		"DISCOVERED_DEVICE_STATE_REJECTED":   7,
+	}
+)
+
+func (x DiscoveredDeviceState) Enum() *DiscoveredDeviceState {
+	p := new(DiscoveredDeviceState)
+	*p = x
+	return p
+}
+
+func (x DiscoveredDeviceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscoveredDeviceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[25].Descriptor()
+}
+
+func (DiscoveredDeviceState) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[25]
+}
+
+func (x DiscoveredDeviceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscoveredDeviceState.Descriptor instead.
+func (DiscoveredDeviceState) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{25}
+}
+
+// CampaignState is where a campaign stands.
+type CampaignState int32
+
+const (
+	CampaignState_CAMPAIGN_STATE_UNKNOWN   CampaignState = 0
+	CampaignState_CAMPAIGN_STATE_RUNNING   CampaignState = 1 // batches start as earlier ones finish
+	CampaignState_CAMPAIGN_STATE_PAUSED    CampaignState = 2 // paused by an operator or a success-rate gate
+	CampaignState_CAMPAIGN_STATE_COMPLETED CampaignState = 3 // every rack finished
+	CampaignState_CAMPAIGN_STATE_ABORTED   CampaignState = 4
+)
+
+// Enum value maps for CampaignState.
+var (
+	CampaignState_name = map[int32]string{
+		0: "CAMPAIGN_STATE_UNKNOWN",
+		1: "CAMPAIGN_STATE_RUNNING",
+		2: "CAMPAIGN_STATE_PAUSED",
+		3: "CAMPAIGN_STATE_COMPLETED",
+		4: "CAMPAIGN_STATE_ABORTED",
+	}
+	CampaignState_value = map[string]int32{
+		"CAMPAIGN_STATE_UNKNOWN":   0,
+		"CAMPAIGN_STATE_RUNNING":   1,
+		"CAMPAIGN_STATE_PAUSED":    2,
+		"CAMPAIGN_STATE_COMPLETED": 3,
+		"CAMPAIGN_STATE_ABORTED":   4,
+	}
+)
+
+func (x CampaignState) Enum() *CampaignState {
+	p := new(CampaignState)
+	*p = x
+	return p
+}
+
+func (x CampaignState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[26].Descriptor()
+}
+
+func (CampaignState) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[26]
+}
+
+func (x CampaignState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignState.Descriptor instead.
+func (CampaignState) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{26}
+}
+
+// CampaignRackState is where a rack of a campaign stands.
+type CampaignRackState int32
+
+const (
+	CampaignRackState_CAMPAIGN_RACK_STATE_UNKNOWN   CampaignRackState = 0
+	CampaignRackState_CAMPAIGN_RACK_STATE_PENDING   CampaignRackState = 1 // not started yet
+	CampaignRackState_CAMPAIGN_RACK_STATE_RUNNING   CampaignRackState = 2 // its task has not finished
+	CampaignRackState_CAMPAIGN_RACK_STATE_SUCCEEDED CampaignRackState = 3
+	CampaignRackState_CAMPAIGN_RACK_STATE_FAILED    CampaignRackState = 4 // its task failed, or could not be submitted
+	CampaignRackState_CAMPAIGN_RACK_STATE_SKIPPED   CampaignRackState = 5 // the campaign was aborted before it started
+	CampaignRackState_CAMPAIGN_RACK_STATE_CANCELLED CampaignRackState = 6 // its task was cancelled by an abort
+)
+
+// Enum value maps for CampaignRackState.
+var (
+	CampaignRackState_name = map[int32]string{
+		0: "CAMPAIGN_RACK_STATE_UNKNOWN",
+		1: "CAMPAIGN_RACK_STATE_PENDING",
+		2: "CAMPAIGN_RACK_STATE_RUNNING",
+		3: "CAMPAIGN_RACK_STATE_SUCCEEDED",
+		4: "CAMPAIGN_RACK_STATE_FAILED",
+		5: "CAMPAIGN_RACK_STATE_SKIPPED",
+		6: "CAMPAIGN_RACK_STATE_CANCELLED",
+	}
+	CampaignRackState_value = map[string]int32{
+		"CAMPAIGN_RACK_STATE_UNKNOWN":   0,
+		"CAMPAIGN_RACK_STATE_PENDING":   1,
+		"CAMPAIGN_RACK_STATE_RUNNING":   2,
+		"CAMPAIGN_RACK_STATE_SUCCEEDED": 3,
+		"CAMPAIGN_RACK_STATE_FAILED":    4,
+		"CAMPAIGN_RACK_STATE_SKIPPED":   5,
+		"CAMPAIGN_RACK_STATE_CANCELLED": 6,
+	}
+)
+
+func (x CampaignRackState) Enum() *CampaignRackState {
+	p := new(CampaignRackState)
+	*p = x
+	return p
+}
+
+func (x CampaignRackState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignRackState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[27].Descriptor()
+}
+
+func (CampaignRackState) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[27]
+}
+
+func (x CampaignRackState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignRackState.Descriptor instead.
+func (CampaignRackState) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{27}
+}
+
+type MaintenanceWindowKind int32
+
+const (
+	MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_UNSPECIFIED MaintenanceWindowKind = 0
+	MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_WINDOW      MaintenanceWindowKind = 1 // disruptive operations may run; racks with windows run them only inside one
+	MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_BLACKOUT    MaintenanceWindowKind = 2 // no disruptive operation runs
+)
+
+// Enum value maps for MaintenanceWindowKind.
+var (
+	MaintenanceWindowKind_name = map[int32]string{
+		0: "MAINTENANCE_WINDOW_KIND_UNSPECIFIED",
+		1: "MAINTENANCE_WINDOW_KIND_WINDOW",
+		2: "MAINTENANCE_WINDOW_KIND_BLACKOUT",
+	}
+	MaintenanceWindowKind_value = map[string]int32{
+		"MAINTENANCE_WINDOW_KIND_UNSPECIFIED": 0,
+		"MAINTENANCE_WINDOW_KIND_WINDOW":      1,
+		"MAINTENANCE_WINDOW_KIND_BLACKOUT":    2,
+	}
+)
+
+func (x MaintenanceWindowKind) Enum() *MaintenanceWindowKind {
+	p := new(MaintenanceWindowKind)
+	*p = x
+	return p
+}
+
+func (x MaintenanceWindowKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceWindowKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[28].Descriptor()
+}
+
+func (MaintenanceWindowKind) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[28]
+}
+
+func (x MaintenanceWindowKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceWindowKind.Descriptor instead.
+func (MaintenanceWindowKind) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{28}
+}
+
+// DriftType is how the inventory and the component manager services disagree
+// about a component.
+type DriftType int32
+
+const (
+	DriftType_DRIFT_TYPE_UNKNOWN             DriftType = 0
+	DriftType_DRIFT_TYPE_MISSING_IN_EXPECTED DriftType = 1 // found in the component manager service but not in the inventory
+	DriftType_DRIFT_TYPE_MISSING_IN_ACTUAL   DriftType = 2 // in the inventory but not found in the component manager service
+	DriftType_DRIFT_TYPE_MISMATCH            DriftType = 3 // in both but with field differences
+)
+
+// Enum value maps for DriftType.
+var (
+	DriftType_name = map[int32]string{
+		0: "DRIFT_TYPE_UNKNOWN",
+		1: "DRIFT_TYPE_MISSING_IN_EXPECTED",
+		2: "DRIFT_TYPE_MISSING_IN_ACTUAL",
+		3: "DRIFT_TYPE_MISMATCH",
+	}
+	DriftType_value = map[string]int32{
+		"DRIFT_TYPE_UNKNOWN":             0,
+		"DRIFT_TYPE_MISSING_IN_EXPECTED": 1,
+		"DRIFT_TYPE_MISSING_IN_ACTUAL":   2,
+		"DRIFT_TYPE_MISMATCH":            3,
+	}
+)
+
+func (x DriftType) Enum() *DriftType {
+	p := new(DriftType)
+	*p = x
+	return p
+}
+
+func (x DriftType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[29].Descriptor()
+}
+
+func (DriftType) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[29]
+}
+
+func (x DriftType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftType.Descriptor instead.
+func (DriftType) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{29}
+}
+
+// DriftStatus is the review state of a drift.
+type DriftStatus int32
+
+const (
+	DriftStatus_DRIFT_STATUS_UNKNOWN   DriftStatus = 0
+	DriftStatus_DRIFT_STATUS_OPEN      DriftStatus = 1 // awaits review
+	DriftStatus_DRIFT_STATUS_DISMISSED DriftStatus = 2 // dismissed by an operator
+)
+
+// Enum value maps for DriftStatus.
+var (
+	DriftStatus_name = map[int32]string{
+		0: "DRIFT_STATUS_UNKNOWN",
+		1: "DRIFT_STATUS_OPEN",
+		2: "DRIFT_STATUS_DISMISSED",
+	}
+	DriftStatus_value = map[string]int32{
+		"DRIFT_STATUS_UNKNOWN":   0,
+		"DRIFT_STATUS_OPEN":      1,
+		"DRIFT_STATUS_DISMISSED": 2,
+	}
+)
+
+func (x DriftStatus) Enum() *DriftStatus {
+	p := new(DriftStatus)
+	*p = x
+	return p
+}
+
+func (x DriftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[30].Descriptor()
+}
+
+func (DriftStatus) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[30]
+}
+
+func (x DriftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftStatus.Descriptor instead.
+func (DriftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{30}
+}
+
+type UUID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UUID) Reset() {
+	*x = UUID{}
+	mi := &file_rla_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UUID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
 func (*UUID) ProtoMessage() {}
 
@@ -2194,8 +3125,17 @@ type Task struct {
 	AppliedRuleId  *UUID                  `protobuf:"bytes,13,opt,name=applied_rule_id,json=appliedRuleId,proto3,oneof" json:"applied_rule_id,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// approvals lists the AwaitApproval gates the task has reached, oldest first.
+	Approvals []*TaskApproval `protobuf:"bytes,16,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// not_before is set only for tasks deferred to a maintenance window; the
+	// task stays waiting until then.
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	Priority  TaskPriority           `protobuf:"varint,18,opt,name=priority,proto3,enum=v1.TaskPriority" json:"priority,omitempty"`
+	// preemptions lists every time the task was stopped for a task of higher
+	// priority, oldest first.
+	Preemptions   []*TaskPreemption `protobuf:"bytes,19,rep,name=preemptions,proto3" json:"preemptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -2333,17 +3273,245 @@ func (x *Task) GetStartedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateExpectedRackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rack          *Rack                  `protobuf:"bytes,1,opt,name=rack,proto3" json:"rack,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Task) GetApprovals() []*TaskApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
 }
 
-func (x *CreateExpectedRackRequest) Reset() {
-	*x = CreateExpectedRackRequest{}
-	mi := &file_rla_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *Task) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *Task) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *Task) GetPreemptions() []*TaskPreemption {
+	if x != nil {
+		return x.Preemptions
+	}
+	return nil
+}
+
+type TaskPreemption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreemptedBy   *UUID                  `protobuf:"bytes,1,opt,name=preempted_by,json=preemptedBy,proto3" json:"preempted_by,omitempty"`
+	Priority      TaskPriority           `protobuf:"varint,2,opt,name=priority,proto3,enum=v1.TaskPriority" json:"priority,omitempty"` // priority class of the preempting task
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Requeued      bool                   `protobuf:"varint,4,opt,name=requeued,proto3" json:"requeued,omitempty"` // put back in the waiting queue instead of terminated
+	PreemptedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=preempted_at,json=preemptedAt,proto3" json:"preempted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskPreemption) Reset() {
+	*x = TaskPreemption{}
+	mi := &file_rla_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskPreemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskPreemption) ProtoMessage() {}
+
+func (x *TaskPreemption) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskPreemption.ProtoReflect.Descriptor instead.
+func (*TaskPreemption) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TaskPreemption) GetPreemptedBy() *UUID {
+	if x != nil {
+		return x.PreemptedBy
+	}
+	return nil
+}
+
+func (x *TaskPreemption) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *TaskPreemption) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TaskPreemption) GetRequeued() bool {
+	if x != nil {
+		return x.Requeued
+	}
+	return false
+}
+
+func (x *TaskPreemption) GetPreemptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreemptedAt
+	}
+	return nil
+}
+
+type TaskApproval struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Step            string                 `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"` // component type of the rule step holding the gate
+	Prompt          string                 `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	RequestedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	Deadline        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	DefaultDecision ApprovalDecision       `protobuf:"varint,6,opt,name=default_decision,json=defaultDecision,proto3,enum=v1.ApprovalDecision" json:"default_decision,omitempty"` // applied when the deadline passes
+	Decision        ApprovalDecision       `protobuf:"varint,7,opt,name=decision,proto3,enum=v1.ApprovalDecision" json:"decision,omitempty"`
+	Approver        string                 `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	Comment         string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	DecidedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=decided_at,json=decidedAt,proto3,oneof" json:"decided_at,omitempty"`
+	TimedOut        bool                   `protobuf:"varint,11,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskApproval) Reset() {
+	*x = TaskApproval{}
+	mi := &file_rla_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskApproval) ProtoMessage() {}
+
+func (x *TaskApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskApproval.ProtoReflect.Descriptor instead.
+func (*TaskApproval) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskApproval) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskApproval) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *TaskApproval) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *TaskApproval) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *TaskApproval) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *TaskApproval) GetDefaultDecision() ApprovalDecision {
+	if x != nil {
+		return x.DefaultDecision
+	}
+	return ApprovalDecision_APPROVAL_DECISION_NONE
+}
+
+func (x *TaskApproval) GetDecision() ApprovalDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return ApprovalDecision_APPROVAL_DECISION_NONE
+}
+
+func (x *TaskApproval) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *TaskApproval) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *TaskApproval) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *TaskApproval) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+type CreateExpectedRackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rack          *Rack                  `protobuf:"bytes,1,opt,name=rack,proto3" json:"rack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpectedRackRequest) Reset() {
+	*x = CreateExpectedRackRequest{}
+	mi := &file_rla_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
@@ -2354,7 +3522,7 @@ func (x *CreateExpectedRackRequest) String() string {
 func (*CreateExpectedRackRequest) ProtoMessage() {}
 
 func (x *CreateExpectedRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[22]
+	mi := &file_rla_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,7 +3535,7 @@ func (x *CreateExpectedRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExpectedRackRequest.ProtoReflect.Descriptor instead.
 func (*CreateExpectedRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{22}
+	return file_rla_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateExpectedRackRequest) GetRack() *Rack {
@@ -2386,7 +3554,7 @@ type CreateExpectedRackResponse struct {
 
 func (x *CreateExpectedRackResponse) Reset() {
 	*x = CreateExpectedRackResponse{}
-	mi := &file_rla_proto_msgTypes[23]
+	mi := &file_rla_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2398,7 +3566,7 @@ func (x *CreateExpectedRackResponse) String() string {
 func (*CreateExpectedRackResponse) ProtoMessage() {}
 
 func (x *CreateExpectedRackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[23]
+	mi := &file_rla_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,7 +3579,7 @@ func (x *CreateExpectedRackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExpectedRackResponse.ProtoReflect.Descriptor instead.
 func (*CreateExpectedRackResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{23}
+	return file_rla_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateExpectedRackResponse) GetId() *UUID {
@@ -2431,7 +3599,7 @@ type GetRackInfoByIDRequest struct {
 
 func (x *GetRackInfoByIDRequest) Reset() {
 	*x = GetRackInfoByIDRequest{}
-	mi := &file_rla_proto_msgTypes[24]
+	mi := &file_rla_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2443,7 +3611,7 @@ func (x *GetRackInfoByIDRequest) String() string {
 func (*GetRackInfoByIDRequest) ProtoMessage() {}
 
 func (x *GetRackInfoByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[24]
+	mi := &file_rla_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2456,7 +3624,7 @@ func (x *GetRackInfoByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackInfoByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetRackInfoByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{24}
+	return file_rla_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetRackInfoByIDRequest) GetId() *UUID {
@@ -2483,7 +3651,7 @@ type GetRackInfoBySerialRequest struct {
 
 func (x *GetRackInfoBySerialRequest) Reset() {
 	*x = GetRackInfoBySerialRequest{}
-	mi := &file_rla_proto_msgTypes[25]
+	mi := &file_rla_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2495,7 +3663,7 @@ func (x *GetRackInfoBySerialRequest) String() string {
 func (*GetRackInfoBySerialRequest) ProtoMessage() {}
 
 func (x *GetRackInfoBySerialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[25]
+	mi := &file_rla_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +3676,7 @@ func (x *GetRackInfoBySerialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackInfoBySerialRequest.ProtoReflect.Descriptor instead.
 func (*GetRackInfoBySerialRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{25}
+	return file_rla_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetRackInfoBySerialRequest) GetSerialInfo() *DeviceSerialInfo {
@@ -2534,7 +3702,7 @@ type GetRackInfoResponse struct {
 
 func (x *GetRackInfoResponse) Reset() {
 	*x = GetRackInfoResponse{}
-	mi := &file_rla_proto_msgTypes[26]
+	mi := &file_rla_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2546,7 +3714,7 @@ func (x *GetRackInfoResponse) String() string {
 func (*GetRackInfoResponse) ProtoMessage() {}
 
 func (x *GetRackInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[26]
+	mi := &file_rla_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2559,7 +3727,7 @@ func (x *GetRackInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetRackInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{26}
+	return file_rla_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetRackInfoResponse) GetRack() *Rack {
@@ -2578,7 +3746,7 @@ type PatchRackRequest struct {
 
 func (x *PatchRackRequest) Reset() {
 	*x = PatchRackRequest{}
-	mi := &file_rla_proto_msgTypes[27]
+	mi := &file_rla_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2590,7 +3758,7 @@ func (x *PatchRackRequest) String() string {
 func (*PatchRackRequest) ProtoMessage() {}
 
 func (x *PatchRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[27]
+	mi := &file_rla_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2603,7 +3771,7 @@ func (x *PatchRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchRackRequest.ProtoReflect.Descriptor instead.
 func (*PatchRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{27}
+	return file_rla_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PatchRackRequest) GetRack() *Rack {
@@ -2622,7 +3790,7 @@ type PatchRackResponse struct {
 
 func (x *PatchRackResponse) Reset() {
 	*x = PatchRackResponse{}
-	mi := &file_rla_proto_msgTypes[28]
+	mi := &file_rla_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2634,7 +3802,7 @@ func (x *PatchRackResponse) String() string {
 func (*PatchRackResponse) ProtoMessage() {}
 
 func (x *PatchRackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[28]
+	mi := &file_rla_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2647,7 +3815,7 @@ func (x *PatchRackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchRackResponse.ProtoReflect.Descriptor instead.
 func (*PatchRackResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{28}
+	return file_rla_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PatchRackResponse) GetReport() string {
@@ -2667,7 +3835,7 @@ type GetComponentInfoByIDRequest struct {
 
 func (x *GetComponentInfoByIDRequest) Reset() {
 	*x = GetComponentInfoByIDRequest{}
-	mi := &file_rla_proto_msgTypes[29]
+	mi := &file_rla_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2679,7 +3847,7 @@ func (x *GetComponentInfoByIDRequest) String() string {
 func (*GetComponentInfoByIDRequest) ProtoMessage() {}
 
 func (x *GetComponentInfoByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[29]
+	mi := &file_rla_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2692,7 +3860,7 @@ func (x *GetComponentInfoByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentInfoByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetComponentInfoByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{29}
+	return file_rla_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetComponentInfoByIDRequest) GetId() *UUID {
@@ -2719,7 +3887,7 @@ type GetComponentInfoBySerialRequest struct {
 
 func (x *GetComponentInfoBySerialRequest) Reset() {
 	*x = GetComponentInfoBySerialRequest{}
-	mi := &file_rla_proto_msgTypes[30]
+	mi := &file_rla_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2731,7 +3899,7 @@ func (x *GetComponentInfoBySerialRequest) String() string {
 func (*GetComponentInfoBySerialRequest) ProtoMessage() {}
 
 func (x *GetComponentInfoBySerialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[30]
+	mi := &file_rla_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2744,7 +3912,7 @@ func (x *GetComponentInfoBySerialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentInfoBySerialRequest.ProtoReflect.Descriptor instead.
 func (*GetComponentInfoBySerialRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{30}
+	return file_rla_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetComponentInfoBySerialRequest) GetSerialInfo() *DeviceSerialInfo {
@@ -2771,7 +3939,7 @@ type GetComponentInfoResponse struct {
 
 func (x *GetComponentInfoResponse) Reset() {
 	*x = GetComponentInfoResponse{}
-	mi := &file_rla_proto_msgTypes[31]
+	mi := &file_rla_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2783,7 +3951,7 @@ func (x *GetComponentInfoResponse) String() string {
 func (*GetComponentInfoResponse) ProtoMessage() {}
 
 func (x *GetComponentInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[31]
+	mi := &file_rla_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,7 +3964,7 @@ func (x *GetComponentInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetComponentInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{31}
+	return file_rla_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetComponentInfoResponse) GetComponent() *Component {
@@ -2825,7 +3993,7 @@ type GetListOfRacksRequest struct {
 
 func (x *GetListOfRacksRequest) Reset() {
 	*x = GetListOfRacksRequest{}
-	mi := &file_rla_proto_msgTypes[32]
+	mi := &file_rla_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2837,7 +4005,7 @@ func (x *GetListOfRacksRequest) String() string {
 func (*GetListOfRacksRequest) ProtoMessage() {}
 
 func (x *GetListOfRacksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[32]
+	mi := &file_rla_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2850,7 +4018,7 @@ func (x *GetListOfRacksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListOfRacksRequest.ProtoReflect.Descriptor instead.
 func (*GetListOfRacksRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{32}
+	return file_rla_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetListOfRacksRequest) GetFilters() []*Filter {
@@ -2891,7 +4059,7 @@ type GetListOfRacksResponse struct {
 
 func (x *GetListOfRacksResponse) Reset() {
 	*x = GetListOfRacksResponse{}
-	mi := &file_rla_proto_msgTypes[33]
+	mi := &file_rla_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2903,7 +4071,7 @@ func (x *GetListOfRacksResponse) String() string {
 func (*GetListOfRacksResponse) ProtoMessage() {}
 
 func (x *GetListOfRacksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[33]
+	mi := &file_rla_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2916,7 +4084,7 @@ func (x *GetListOfRacksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListOfRacksResponse.ProtoReflect.Descriptor instead.
 func (*GetListOfRacksResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{33}
+	return file_rla_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetListOfRacksResponse) GetRacks() []*Rack {
@@ -2942,7 +4110,7 @@ type CreateNVLDomainRequest struct {
 
 func (x *CreateNVLDomainRequest) Reset() {
 	*x = CreateNVLDomainRequest{}
-	mi := &file_rla_proto_msgTypes[34]
+	mi := &file_rla_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2954,7 +4122,7 @@ func (x *CreateNVLDomainRequest) String() string {
 func (*CreateNVLDomainRequest) ProtoMessage() {}
 
 func (x *CreateNVLDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[34]
+	mi := &file_rla_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,7 +4135,7 @@ func (x *CreateNVLDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNVLDomainRequest.ProtoReflect.Descriptor instead.
 func (*CreateNVLDomainRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{34}
+	return file_rla_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateNVLDomainRequest) GetNvlDomain() *NVLDomain {
@@ -2986,7 +4154,7 @@ type CreateNVLDomainResponse struct {
 
 func (x *CreateNVLDomainResponse) Reset() {
 	*x = CreateNVLDomainResponse{}
-	mi := &file_rla_proto_msgTypes[35]
+	mi := &file_rla_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2998,7 +4166,7 @@ func (x *CreateNVLDomainResponse) String() string {
 func (*CreateNVLDomainResponse) ProtoMessage() {}
 
 func (x *CreateNVLDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[35]
+	mi := &file_rla_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3011,7 +4179,7 @@ func (x *CreateNVLDomainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNVLDomainResponse.ProtoReflect.Descriptor instead.
 func (*CreateNVLDomainResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{35}
+	return file_rla_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CreateNVLDomainResponse) GetId() *UUID {
@@ -3031,7 +4199,7 @@ type AttachRacksToNVLDomainRequest struct {
 
 func (x *AttachRacksToNVLDomainRequest) Reset() {
 	*x = AttachRacksToNVLDomainRequest{}
-	mi := &file_rla_proto_msgTypes[36]
+	mi := &file_rla_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3043,7 +4211,7 @@ func (x *AttachRacksToNVLDomainRequest) String() string {
 func (*AttachRacksToNVLDomainRequest) ProtoMessage() {}
 
 func (x *AttachRacksToNVLDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[36]
+	mi := &file_rla_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3056,7 +4224,7 @@ func (x *AttachRacksToNVLDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachRacksToNVLDomainRequest.ProtoReflect.Descriptor instead.
 func (*AttachRacksToNVLDomainRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{36}
+	return file_rla_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AttachRacksToNVLDomainRequest) GetNvlDomainIdentifier() *Identifier {
@@ -3082,7 +4250,7 @@ type DetachRacksFromNVLDomainRequest struct {
 
 func (x *DetachRacksFromNVLDomainRequest) Reset() {
 	*x = DetachRacksFromNVLDomainRequest{}
-	mi := &file_rla_proto_msgTypes[37]
+	mi := &file_rla_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3094,7 +4262,7 @@ func (x *DetachRacksFromNVLDomainRequest) String() string {
 func (*DetachRacksFromNVLDomainRequest) ProtoMessage() {}
 
 func (x *DetachRacksFromNVLDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[37]
+	mi := &file_rla_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,7 +4275,7 @@ func (x *DetachRacksFromNVLDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachRacksFromNVLDomainRequest.ProtoReflect.Descriptor instead.
 func (*DetachRacksFromNVLDomainRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{37}
+	return file_rla_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DetachRacksFromNVLDomainRequest) GetRackIdentifiers() []*Identifier {
@@ -3127,7 +4295,7 @@ type GetListOfNVLDomainsRequest struct {
 
 func (x *GetListOfNVLDomainsRequest) Reset() {
 	*x = GetListOfNVLDomainsRequest{}
-	mi := &file_rla_proto_msgTypes[38]
+	mi := &file_rla_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3139,7 +4307,7 @@ func (x *GetListOfNVLDomainsRequest) String() string {
 func (*GetListOfNVLDomainsRequest) ProtoMessage() {}
 
 func (x *GetListOfNVLDomainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[38]
+	mi := &file_rla_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3152,7 +4320,7 @@ func (x *GetListOfNVLDomainsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListOfNVLDomainsRequest.ProtoReflect.Descriptor instead.
 func (*GetListOfNVLDomainsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{38}
+	return file_rla_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetListOfNVLDomainsRequest) GetInfo() *StringQueryInfo {
@@ -3179,7 +4347,7 @@ type GetListOfNVLDomainsResponse struct {
 
 func (x *GetListOfNVLDomainsResponse) Reset() {
 	*x = GetListOfNVLDomainsResponse{}
-	mi := &file_rla_proto_msgTypes[39]
+	mi := &file_rla_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3191,7 +4359,7 @@ func (x *GetListOfNVLDomainsResponse) String() string {
 func (*GetListOfNVLDomainsResponse) ProtoMessage() {}
 
 func (x *GetListOfNVLDomainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[39]
+	mi := &file_rla_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3204,7 +4372,7 @@ func (x *GetListOfNVLDomainsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListOfNVLDomainsResponse.ProtoReflect.Descriptor instead.
 func (*GetListOfNVLDomainsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{39}
+	return file_rla_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetListOfNVLDomainsResponse) GetNvlDomains() []*NVLDomain {
@@ -3230,7 +4398,7 @@ type GetRacksForNVLDomainRequest struct {
 
 func (x *GetRacksForNVLDomainRequest) Reset() {
 	*x = GetRacksForNVLDomainRequest{}
-	mi := &file_rla_proto_msgTypes[40]
+	mi := &file_rla_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3242,7 +4410,7 @@ func (x *GetRacksForNVLDomainRequest) String() string {
 func (*GetRacksForNVLDomainRequest) ProtoMessage() {}
 
 func (x *GetRacksForNVLDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[40]
+	mi := &file_rla_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3255,7 +4423,7 @@ func (x *GetRacksForNVLDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRacksForNVLDomainRequest.ProtoReflect.Descriptor instead.
 func (*GetRacksForNVLDomainRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{40}
+	return file_rla_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetRacksForNVLDomainRequest) GetNvlDomainIdentifier() *Identifier {
@@ -3274,7 +4442,7 @@ type GetRacksForNVLDomainResponse struct {
 
 func (x *GetRacksForNVLDomainResponse) Reset() {
 	*x = GetRacksForNVLDomainResponse{}
-	mi := &file_rla_proto_msgTypes[41]
+	mi := &file_rla_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3286,7 +4454,7 @@ func (x *GetRacksForNVLDomainResponse) String() string {
 func (*GetRacksForNVLDomainResponse) ProtoMessage() {}
 
 func (x *GetRacksForNVLDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[41]
+	mi := &file_rla_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3299,7 +4467,7 @@ func (x *GetRacksForNVLDomainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRacksForNVLDomainResponse.ProtoReflect.Descriptor instead.
 func (*GetRacksForNVLDomainResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{41}
+	return file_rla_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetRacksForNVLDomainResponse) GetRacks() []*Rack {
@@ -3318,13 +4486,14 @@ type UpgradeFirmwareRequest struct {
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                // optional: task description
 	QueueOptions  *QueueOptions          `protobuf:"bytes,6,opt,name=queue_options,json=queueOptions,proto3,oneof" json:"queue_options,omitempty"`
 	RuleId        *UUID                  `protobuf:"bytes,7,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"` // optional: override rule resolution with a specific rule
+	Maintenance   *MaintenanceOptions    `protobuf:"bytes,8,opt,name=maintenance,proto3,oneof" json:"maintenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpgradeFirmwareRequest) Reset() {
 	*x = UpgradeFirmwareRequest{}
-	mi := &file_rla_proto_msgTypes[42]
+	mi := &file_rla_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3336,7 +4505,7 @@ func (x *UpgradeFirmwareRequest) String() string {
 func (*UpgradeFirmwareRequest) ProtoMessage() {}
 
 func (x *UpgradeFirmwareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[42]
+	mi := &file_rla_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3349,7 +4518,7 @@ func (x *UpgradeFirmwareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradeFirmwareRequest.ProtoReflect.Descriptor instead.
 func (*UpgradeFirmwareRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{42}
+	return file_rla_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UpgradeFirmwareRequest) GetTargetSpec() *OperationTargetSpec {
@@ -3401,6 +4570,13 @@ func (x *UpgradeFirmwareRequest) GetRuleId() *UUID {
 	return nil
 }
 
+func (x *UpgradeFirmwareRequest) GetMaintenance() *MaintenanceOptions {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
 // GetComponents - retrieves components from local database
 type GetComponentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3414,7 +4590,7 @@ type GetComponentsRequest struct {
 
 func (x *GetComponentsRequest) Reset() {
 	*x = GetComponentsRequest{}
-	mi := &file_rla_proto_msgTypes[43]
+	mi := &file_rla_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3426,7 +4602,7 @@ func (x *GetComponentsRequest) String() string {
 func (*GetComponentsRequest) ProtoMessage() {}
 
 func (x *GetComponentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[43]
+	mi := &file_rla_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3439,7 +4615,7 @@ func (x *GetComponentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentsRequest.ProtoReflect.Descriptor instead.
 func (*GetComponentsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{43}
+	return file_rla_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetComponentsRequest) GetTargetSpec() *OperationTargetSpec {
@@ -3480,7 +4656,7 @@ type GetComponentsResponse struct {
 
 func (x *GetComponentsResponse) Reset() {
 	*x = GetComponentsResponse{}
-	mi := &file_rla_proto_msgTypes[44]
+	mi := &file_rla_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3492,7 +4668,7 @@ func (x *GetComponentsResponse) String() string {
 func (*GetComponentsResponse) ProtoMessage() {}
 
 func (x *GetComponentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[44]
+	mi := &file_rla_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3505,7 +4681,7 @@ func (x *GetComponentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentsResponse.ProtoReflect.Descriptor instead.
 func (*GetComponentsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{44}
+	return file_rla_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetComponentsResponse) GetComponents() []*Component {
@@ -3534,7 +4710,7 @@ type ValidateComponentsRequest struct {
 
 func (x *ValidateComponentsRequest) Reset() {
 	*x = ValidateComponentsRequest{}
-	mi := &file_rla_proto_msgTypes[45]
+	mi := &file_rla_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3546,7 +4722,7 @@ func (x *ValidateComponentsRequest) String() string {
 func (*ValidateComponentsRequest) ProtoMessage() {}
 
 func (x *ValidateComponentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[45]
+	mi := &file_rla_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3559,7 +4735,7 @@ func (x *ValidateComponentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateComponentsRequest.ProtoReflect.Descriptor instead.
 func (*ValidateComponentsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{45}
+	return file_rla_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ValidateComponentsRequest) GetTargetSpec() *OperationTargetSpec {
@@ -3605,7 +4781,7 @@ type ValidateComponentsResponse struct {
 
 func (x *ValidateComponentsResponse) Reset() {
 	*x = ValidateComponentsResponse{}
-	mi := &file_rla_proto_msgTypes[46]
+	mi := &file_rla_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3617,7 +4793,7 @@ func (x *ValidateComponentsResponse) String() string {
 func (*ValidateComponentsResponse) ProtoMessage() {}
 
 func (x *ValidateComponentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[46]
+	mi := &file_rla_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +4806,7 @@ func (x *ValidateComponentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateComponentsResponse.ProtoReflect.Descriptor instead.
 func (*ValidateComponentsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{46}
+	return file_rla_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ValidateComponentsResponse) GetDiffs() []*ComponentDiff {
@@ -3689,7 +4865,7 @@ type ComponentDiff struct {
 
 func (x *ComponentDiff) Reset() {
 	*x = ComponentDiff{}
-	mi := &file_rla_proto_msgTypes[47]
+	mi := &file_rla_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3701,7 +4877,7 @@ func (x *ComponentDiff) String() string {
 func (*ComponentDiff) ProtoMessage() {}
 
 func (x *ComponentDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[47]
+	mi := &file_rla_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3714,7 +4890,7 @@ func (x *ComponentDiff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentDiff.ProtoReflect.Descriptor instead.
 func (*ComponentDiff) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{47}
+	return file_rla_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ComponentDiff) GetType() DiffType {
@@ -3770,7 +4946,7 @@ type FieldDiff struct {
 
 func (x *FieldDiff) Reset() {
 	*x = FieldDiff{}
-	mi := &file_rla_proto_msgTypes[48]
+	mi := &file_rla_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3782,7 +4958,7 @@ func (x *FieldDiff) String() string {
 func (*FieldDiff) ProtoMessage() {}
 
 func (x *FieldDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[48]
+	mi := &file_rla_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3795,7 +4971,7 @@ func (x *FieldDiff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
 func (*FieldDiff) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{48}
+	return file_rla_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *FieldDiff) GetFieldName() string {
@@ -3829,7 +5005,7 @@ type AddComponentRequest struct {
 
 func (x *AddComponentRequest) Reset() {
 	*x = AddComponentRequest{}
-	mi := &file_rla_proto_msgTypes[49]
+	mi := &file_rla_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3841,7 +5017,7 @@ func (x *AddComponentRequest) String() string {
 func (*AddComponentRequest) ProtoMessage() {}
 
 func (x *AddComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[49]
+	mi := &file_rla_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3854,7 +5030,7 @@ func (x *AddComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddComponentRequest.ProtoReflect.Descriptor instead.
 func (*AddComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{49}
+	return file_rla_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AddComponentRequest) GetComponent() *Component {
@@ -3873,7 +5049,7 @@ type AddComponentResponse struct {
 
 func (x *AddComponentResponse) Reset() {
 	*x = AddComponentResponse{}
-	mi := &file_rla_proto_msgTypes[50]
+	mi := &file_rla_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3885,7 +5061,7 @@ func (x *AddComponentResponse) String() string {
 func (*AddComponentResponse) ProtoMessage() {}
 
 func (x *AddComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[50]
+	mi := &file_rla_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3898,7 +5074,7 @@ func (x *AddComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddComponentResponse.ProtoReflect.Descriptor instead.
 func (*AddComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{50}
+	return file_rla_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AddComponentResponse) GetComponent() *Component {
@@ -3918,7 +5094,7 @@ type DeleteComponentRequest struct {
 
 func (x *DeleteComponentRequest) Reset() {
 	*x = DeleteComponentRequest{}
-	mi := &file_rla_proto_msgTypes[51]
+	mi := &file_rla_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3930,7 +5106,7 @@ func (x *DeleteComponentRequest) String() string {
 func (*DeleteComponentRequest) ProtoMessage() {}
 
 func (x *DeleteComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[51]
+	mi := &file_rla_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3943,7 +5119,7 @@ func (x *DeleteComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteComponentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{51}
+	return file_rla_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DeleteComponentRequest) GetId() *UUID {
@@ -3961,7 +5137,7 @@ type DeleteComponentResponse struct {
 
 func (x *DeleteComponentResponse) Reset() {
 	*x = DeleteComponentResponse{}
-	mi := &file_rla_proto_msgTypes[52]
+	mi := &file_rla_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3973,7 +5149,7 @@ func (x *DeleteComponentResponse) String() string {
 func (*DeleteComponentResponse) ProtoMessage() {}
 
 func (x *DeleteComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[52]
+	mi := &file_rla_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3986,7 +5162,7 @@ func (x *DeleteComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteComponentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{52}
+	return file_rla_proto_rawDescGZIP(), []int{54}
 }
 
 // DeleteRack - soft-delete a rack and cascade to its components
@@ -3999,7 +5175,7 @@ type DeleteRackRequest struct {
 
 func (x *DeleteRackRequest) Reset() {
 	*x = DeleteRackRequest{}
-	mi := &file_rla_proto_msgTypes[53]
+	mi := &file_rla_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4011,7 +5187,7 @@ func (x *DeleteRackRequest) String() string {
 func (*DeleteRackRequest) ProtoMessage() {}
 
 func (x *DeleteRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[53]
+	mi := &file_rla_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4024,7 +5200,7 @@ func (x *DeleteRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRackRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{53}
+	return file_rla_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DeleteRackRequest) GetId() *UUID {
@@ -4042,7 +5218,7 @@ type DeleteRackResponse struct {
 
 func (x *DeleteRackResponse) Reset() {
 	*x = DeleteRackResponse{}
-	mi := &file_rla_proto_msgTypes[54]
+	mi := &file_rla_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4054,7 +5230,7 @@ func (x *DeleteRackResponse) String() string {
 func (*DeleteRackResponse) ProtoMessage() {}
 
 func (x *DeleteRackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[54]
+	mi := &file_rla_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4067,7 +5243,7 @@ func (x *DeleteRackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRackResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRackResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{54}
+	return file_rla_proto_rawDescGZIP(), []int{56}
 }
 
 // PurgeRack - permanently remove a soft-deleted rack and its components
@@ -4080,7 +5256,7 @@ type PurgeRackRequest struct {
 
 func (x *PurgeRackRequest) Reset() {
 	*x = PurgeRackRequest{}
-	mi := &file_rla_proto_msgTypes[55]
+	mi := &file_rla_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4092,7 +5268,7 @@ func (x *PurgeRackRequest) String() string {
 func (*PurgeRackRequest) ProtoMessage() {}
 
 func (x *PurgeRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[55]
+	mi := &file_rla_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4105,7 +5281,7 @@ func (x *PurgeRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeRackRequest.ProtoReflect.Descriptor instead.
 func (*PurgeRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{55}
+	return file_rla_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *PurgeRackRequest) GetId() *UUID {
@@ -4123,7 +5299,7 @@ type PurgeRackResponse struct {
 
 func (x *PurgeRackResponse) Reset() {
 	*x = PurgeRackResponse{}
-	mi := &file_rla_proto_msgTypes[56]
+	mi := &file_rla_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4135,7 +5311,7 @@ func (x *PurgeRackResponse) String() string {
 func (*PurgeRackResponse) ProtoMessage() {}
 
 func (x *PurgeRackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[56]
+	mi := &file_rla_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4148,7 +5324,7 @@ func (x *PurgeRackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeRackResponse.ProtoReflect.Descriptor instead.
 func (*PurgeRackResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{56}
+	return file_rla_proto_rawDescGZIP(), []int{58}
 }
 
 // PurgeComponent - permanently remove a soft-deleted component
@@ -4161,7 +5337,7 @@ type PurgeComponentRequest struct {
 
 func (x *PurgeComponentRequest) Reset() {
 	*x = PurgeComponentRequest{}
-	mi := &file_rla_proto_msgTypes[57]
+	mi := &file_rla_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4173,7 +5349,7 @@ func (x *PurgeComponentRequest) String() string {
 func (*PurgeComponentRequest) ProtoMessage() {}
 
 func (x *PurgeComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[57]
+	mi := &file_rla_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4186,7 +5362,7 @@ func (x *PurgeComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeComponentRequest.ProtoReflect.Descriptor instead.
 func (*PurgeComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{57}
+	return file_rla_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *PurgeComponentRequest) GetId() *UUID {
@@ -4204,7 +5380,7 @@ type PurgeComponentResponse struct {
 
 func (x *PurgeComponentResponse) Reset() {
 	*x = PurgeComponentResponse{}
-	mi := &file_rla_proto_msgTypes[58]
+	mi := &file_rla_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4216,7 +5392,7 @@ func (x *PurgeComponentResponse) String() string {
 func (*PurgeComponentResponse) ProtoMessage() {}
 
 func (x *PurgeComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[58]
+	mi := &file_rla_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4229,7 +5405,7 @@ func (x *PurgeComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeComponentResponse.ProtoReflect.Descriptor instead.
 func (*PurgeComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{58}
+	return file_rla_proto_rawDescGZIP(), []int{60}
 }
 
 // PatchComponent - update a single component's fields
@@ -4247,7 +5423,7 @@ type PatchComponentRequest struct {
 
 func (x *PatchComponentRequest) Reset() {
 	*x = PatchComponentRequest{}
-	mi := &file_rla_proto_msgTypes[59]
+	mi := &file_rla_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4259,7 +5435,7 @@ func (x *PatchComponentRequest) String() string {
 func (*PatchComponentRequest) ProtoMessage() {}
 
 func (x *PatchComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[59]
+	mi := &file_rla_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4272,7 +5448,7 @@ func (x *PatchComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchComponentRequest.ProtoReflect.Descriptor instead.
 func (*PatchComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{59}
+	return file_rla_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *PatchComponentRequest) GetId() *UUID {
@@ -4326,7 +5502,7 @@ type PatchComponentResponse struct {
 
 func (x *PatchComponentResponse) Reset() {
 	*x = PatchComponentResponse{}
-	mi := &file_rla_proto_msgTypes[60]
+	mi := &file_rla_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4338,7 +5514,7 @@ func (x *PatchComponentResponse) String() string {
 func (*PatchComponentResponse) ProtoMessage() {}
 
 func (x *PatchComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[60]
+	mi := &file_rla_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4351,7 +5527,7 @@ func (x *PatchComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchComponentResponse.ProtoReflect.Descriptor instead.
 func (*PatchComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{60}
+	return file_rla_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *PatchComponentResponse) GetComponent() *Component {
@@ -4370,7 +5546,7 @@ type SubmitTaskResponse struct {
 
 func (x *SubmitTaskResponse) Reset() {
 	*x = SubmitTaskResponse{}
-	mi := &file_rla_proto_msgTypes[61]
+	mi := &file_rla_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4382,7 +5558,7 @@ func (x *SubmitTaskResponse) String() string {
 func (*SubmitTaskResponse) ProtoMessage() {}
 
 func (x *SubmitTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[61]
+	mi := &file_rla_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4395,7 +5571,7 @@ func (x *SubmitTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitTaskResponse.ProtoReflect.Descriptor instead.
 func (*SubmitTaskResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{61}
+	return file_rla_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *SubmitTaskResponse) GetTaskIds() []*UUID {
@@ -4415,13 +5591,19 @@ type QueueOptions struct {
 	// 0 means use the server default (~1h). Only relevant when
 	// conflict_strategy is CONFLICT_STRATEGY_QUEUE.
 	QueueTimeoutSeconds int32 `protobuf:"varint,2,opt,name=queue_timeout_seconds,json=queueTimeoutSeconds,proto3" json:"queue_timeout_seconds,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Priority class of the task. Waiting tasks of a rack are promoted
+	// highest priority first. Defaults to TASK_PRIORITY_NORMAL.
+	Priority TaskPriority `protobuf:"varint,3,opt,name=priority,proto3,enum=v1.TaskPriority" json:"priority,omitempty"`
+	// What an emergency task does to conflicting active tasks of lower
+	// priority. Only valid with TASK_PRIORITY_EMERGENCY.
+	Preemption    Preemption `protobuf:"varint,4,opt,name=preemption,proto3,enum=v1.Preemption" json:"preemption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueueOptions) Reset() {
 	*x = QueueOptions{}
-	mi := &file_rla_proto_msgTypes[62]
+	mi := &file_rla_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4433,7 +5615,7 @@ func (x *QueueOptions) String() string {
 func (*QueueOptions) ProtoMessage() {}
 
 func (x *QueueOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[62]
+	mi := &file_rla_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4446,7 +5628,7 @@ func (x *QueueOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueOptions.ProtoReflect.Descriptor instead.
 func (*QueueOptions) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{62}
+	return file_rla_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *QueueOptions) GetConflictStrategy() ConflictStrategy {
@@ -4463,6 +5645,128 @@ func (x *QueueOptions) GetQueueTimeoutSeconds() int32 {
 	return 0
 }
 
+func (x *QueueOptions) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *QueueOptions) GetPreemption() Preemption {
+	if x != nil {
+		return x.Preemption
+	}
+	return Preemption_PREEMPTION_NONE
+}
+
+// MaintenanceOptions controls how a disruptive operation (power off, reset,
+// bring-up, firmware update) treats the maintenance calendar.
+type MaintenanceOptions struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Policy MaintenancePolicy      `protobuf:"varint,1,opt,name=policy,proto3,enum=v1.MaintenancePolicy" json:"policy,omitempty"`
+	// override runs the operation regardless of the calendar. Every use on a
+	// closed rack is recorded and listed by ListMaintenanceOverrides.
+	Override      *MaintenanceOverride `protobuf:"bytes,2,opt,name=override,proto3,oneof" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceOptions) Reset() {
+	*x = MaintenanceOptions{}
+	mi := &file_rla_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceOptions) ProtoMessage() {}
+
+func (x *MaintenanceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceOptions.ProtoReflect.Descriptor instead.
+func (*MaintenanceOptions) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MaintenanceOptions) GetPolicy() MaintenancePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return MaintenancePolicy_MAINTENANCE_POLICY_UNSPECIFIED
+}
+
+func (x *MaintenanceOptions) GetOverride() *MaintenanceOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type MaintenanceOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`                              // required
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // who asked for the override
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceOverride) Reset() {
+	*x = MaintenanceOverride{}
+	mi := &file_rla_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceOverride) ProtoMessage() {}
+
+func (x *MaintenanceOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceOverride.ProtoReflect.Descriptor instead.
+func (*MaintenanceOverride) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MaintenanceOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceOverride) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
 type PowerOnRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
@@ -4475,7 +5779,7 @@ type PowerOnRackRequest struct {
 
 func (x *PowerOnRackRequest) Reset() {
 	*x = PowerOnRackRequest{}
-	mi := &file_rla_proto_msgTypes[63]
+	mi := &file_rla_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4487,7 +5791,7 @@ func (x *PowerOnRackRequest) String() string {
 func (*PowerOnRackRequest) ProtoMessage() {}
 
 func (x *PowerOnRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[63]
+	mi := &file_rla_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4500,7 +5804,7 @@ func (x *PowerOnRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerOnRackRequest.ProtoReflect.Descriptor instead.
 func (*PowerOnRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{63}
+	return file_rla_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *PowerOnRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -4538,13 +5842,14 @@ type PowerOffRackRequest struct {
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // optional task description
 	QueueOptions  *QueueOptions          `protobuf:"bytes,4,opt,name=queue_options,json=queueOptions,proto3,oneof" json:"queue_options,omitempty"`
 	RuleId        *UUID                  `protobuf:"bytes,5,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"` // optional: override rule resolution with a specific rule
+	Maintenance   *MaintenanceOptions    `protobuf:"bytes,6,opt,name=maintenance,proto3,oneof" json:"maintenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PowerOffRackRequest) Reset() {
 	*x = PowerOffRackRequest{}
-	mi := &file_rla_proto_msgTypes[64]
+	mi := &file_rla_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4556,7 +5861,7 @@ func (x *PowerOffRackRequest) String() string {
 func (*PowerOffRackRequest) ProtoMessage() {}
 
 func (x *PowerOffRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[64]
+	mi := &file_rla_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4569,7 +5874,7 @@ func (x *PowerOffRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerOffRackRequest.ProtoReflect.Descriptor instead.
 func (*PowerOffRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{64}
+	return file_rla_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *PowerOffRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -4607,6 +5912,13 @@ func (x *PowerOffRackRequest) GetRuleId() *UUID {
 	return nil
 }
 
+func (x *PowerOffRackRequest) GetMaintenance() *MaintenanceOptions {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
 type PowerResetRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
@@ -4614,13 +5926,14 @@ type PowerResetRackRequest struct {
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // optional task description
 	QueueOptions  *QueueOptions          `protobuf:"bytes,4,opt,name=queue_options,json=queueOptions,proto3,oneof" json:"queue_options,omitempty"`
 	RuleId        *UUID                  `protobuf:"bytes,5,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"` // optional: override rule resolution with a specific rule
+	Maintenance   *MaintenanceOptions    `protobuf:"bytes,6,opt,name=maintenance,proto3,oneof" json:"maintenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PowerResetRackRequest) Reset() {
 	*x = PowerResetRackRequest{}
-	mi := &file_rla_proto_msgTypes[65]
+	mi := &file_rla_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4632,7 +5945,7 @@ func (x *PowerResetRackRequest) String() string {
 func (*PowerResetRackRequest) ProtoMessage() {}
 
 func (x *PowerResetRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[65]
+	mi := &file_rla_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4645,7 +5958,7 @@ func (x *PowerResetRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerResetRackRequest.ProtoReflect.Descriptor instead.
 func (*PowerResetRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{65}
+	return file_rla_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *PowerResetRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -4683,18 +5996,26 @@ func (x *PowerResetRackRequest) GetRuleId() *UUID {
 	return nil
 }
 
+func (x *PowerResetRackRequest) GetMaintenance() *MaintenanceOptions {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
 type BringUpRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Target racks for bring-up
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                 // optional task description
 	RuleId        *UUID                  `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`       // optional: override rule resolution with a specific rule
+	Maintenance   *MaintenanceOptions    `protobuf:"bytes,4,opt,name=maintenance,proto3,oneof" json:"maintenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BringUpRackRequest) Reset() {
 	*x = BringUpRackRequest{}
-	mi := &file_rla_proto_msgTypes[66]
+	mi := &file_rla_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4706,7 +6027,7 @@ func (x *BringUpRackRequest) String() string {
 func (*BringUpRackRequest) ProtoMessage() {}
 
 func (x *BringUpRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[66]
+	mi := &file_rla_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4719,7 +6040,7 @@ func (x *BringUpRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BringUpRackRequest.ProtoReflect.Descriptor instead.
 func (*BringUpRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{66}
+	return file_rla_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *BringUpRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -4743,6 +6064,13 @@ func (x *BringUpRackRequest) GetRuleId() *UUID {
 	return nil
 }
 
+func (x *BringUpRackRequest) GetMaintenance() *MaintenanceOptions {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
 type IngestRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Target racks for ingestion
@@ -4755,7 +6083,7 @@ type IngestRackRequest struct {
 
 func (x *IngestRackRequest) Reset() {
 	*x = IngestRackRequest{}
-	mi := &file_rla_proto_msgTypes[67]
+	mi := &file_rla_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4767,7 +6095,7 @@ func (x *IngestRackRequest) String() string {
 func (*IngestRackRequest) ProtoMessage() {}
 
 func (x *IngestRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[67]
+	mi := &file_rla_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,7 +6108,7 @@ func (x *IngestRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestRackRequest.ProtoReflect.Descriptor instead.
 func (*IngestRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{67}
+	return file_rla_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *IngestRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -4811,30 +6139,39 @@ func (x *IngestRackRequest) GetRuleId() *UUID {
 	return nil
 }
 
-type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"`
-	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+// PlanOperationRequest wraps the request of the operation to plan. The plan
+// resolves targets, rule, conflicts and admission exactly as the operation's
+// own RPC would, but no task is created.
+type PlanOperationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*PlanOperationRequest_PowerOn
+	//	*PlanOperationRequest_PowerOff
+	//	*PlanOperationRequest_PowerReset
+	//	*PlanOperationRequest_UpgradeFirmware
+	//	*PlanOperationRequest_BringUp
+	//	*PlanOperationRequest_Ingest
+	Operation     isPlanOperationRequest_Operation `protobuf_oneof:"operation"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_rla_proto_msgTypes[68]
+func (x *PlanOperationRequest) Reset() {
+	*x = PlanOperationRequest{}
+	mi := &file_rla_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *PlanOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*PlanOperationRequest) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[68]
+func (x *PlanOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4845,106 +6182,134 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use PlanOperationRequest.ProtoReflect.Descriptor instead.
+func (*PlanOperationRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListTasksRequest) GetRackId() *UUID {
+func (x *PlanOperationRequest) GetOperation() isPlanOperationRequest_Operation {
 	if x != nil {
-		return x.RackId
+		return x.Operation
 	}
 	return nil
 }
 
-func (x *ListTasksRequest) GetActiveOnly() bool {
+func (x *PlanOperationRequest) GetPowerOn() *PowerOnRackRequest {
 	if x != nil {
-		return x.ActiveOnly
+		if x, ok := x.Operation.(*PlanOperationRequest_PowerOn); ok {
+			return x.PowerOn
+		}
 	}
-	return false
+	return nil
 }
 
-func (x *ListTasksRequest) GetPagination() *Pagination {
+func (x *PlanOperationRequest) GetPowerOff() *PowerOffRackRequest {
 	if x != nil {
-		return x.Pagination
+		if x, ok := x.Operation.(*PlanOperationRequest_PowerOff); ok {
+			return x.PowerOff
+		}
 	}
 	return nil
 }
 
-type ListTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PlanOperationRequest) GetPowerReset() *PowerResetRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*PlanOperationRequest_PowerReset); ok {
+			return x.PowerReset
+		}
+	}
+	return nil
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_rla_proto_msgTypes[69]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PlanOperationRequest) GetUpgradeFirmware() *UpgradeFirmwareRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*PlanOperationRequest_UpgradeFirmware); ok {
+			return x.UpgradeFirmware
+		}
+	}
+	return nil
 }
 
-func (x *ListTasksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PlanOperationRequest) GetBringUp() *BringUpRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*PlanOperationRequest_BringUp); ok {
+			return x.BringUp
+		}
+	}
+	return nil
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
-
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[69]
+func (x *PlanOperationRequest) GetIngest() *IngestRackRequest {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Operation.(*PlanOperationRequest_Ingest); ok {
+			return x.Ingest
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{69}
+type isPlanOperationRequest_Operation interface {
+	isPlanOperationRequest_Operation()
 }
 
-func (x *ListTasksResponse) GetTasks() []*Task {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
+type PlanOperationRequest_PowerOn struct {
+	PowerOn *PowerOnRackRequest `protobuf:"bytes,1,opt,name=power_on,json=powerOn,proto3,oneof"`
 }
 
-func (x *ListTasksResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+type PlanOperationRequest_PowerOff struct {
+	PowerOff *PowerOffRackRequest `protobuf:"bytes,2,opt,name=power_off,json=powerOff,proto3,oneof"`
 }
 
-type GetTasksByIDsRequest struct {
+type PlanOperationRequest_PowerReset struct {
+	PowerReset *PowerResetRackRequest `protobuf:"bytes,3,opt,name=power_reset,json=powerReset,proto3,oneof"`
+}
+
+type PlanOperationRequest_UpgradeFirmware struct {
+	UpgradeFirmware *UpgradeFirmwareRequest `protobuf:"bytes,4,opt,name=upgrade_firmware,json=upgradeFirmware,proto3,oneof"`
+}
+
+type PlanOperationRequest_BringUp struct {
+	BringUp *BringUpRackRequest `protobuf:"bytes,5,opt,name=bring_up,json=bringUp,proto3,oneof"`
+}
+
+type PlanOperationRequest_Ingest struct {
+	Ingest *IngestRackRequest `protobuf:"bytes,6,opt,name=ingest,proto3,oneof"`
+}
+
+func (*PlanOperationRequest_PowerOn) isPlanOperationRequest_Operation() {}
+
+func (*PlanOperationRequest_PowerOff) isPlanOperationRequest_Operation() {}
+
+func (*PlanOperationRequest_PowerReset) isPlanOperationRequest_Operation() {}
+
+func (*PlanOperationRequest_UpgradeFirmware) isPlanOperationRequest_Operation() {}
+
+func (*PlanOperationRequest_BringUp) isPlanOperationRequest_Operation() {}
+
+func (*PlanOperationRequest_Ingest) isPlanOperationRequest_Operation() {}
+
+type PlanOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskIds       []*UUID                `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	Plans         []*RackOperationPlan   `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"` // one per target rack, ordered by rack name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksByIDsRequest) Reset() {
-	*x = GetTasksByIDsRequest{}
-	mi := &file_rla_proto_msgTypes[70]
+func (x *PlanOperationResponse) Reset() {
+	*x = PlanOperationResponse{}
+	mi := &file_rla_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksByIDsRequest) String() string {
+func (x *PlanOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksByIDsRequest) ProtoMessage() {}
+func (*PlanOperationResponse) ProtoMessage() {}
 
-func (x *GetTasksByIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[70]
+func (x *PlanOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4955,40 +6320,50 @@ func (x *GetTasksByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksByIDsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use PlanOperationResponse.ProtoReflect.Descriptor instead.
+func (*PlanOperationResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetTasksByIDsRequest) GetTaskIds() []*UUID {
+func (x *PlanOperationResponse) GetPlans() []*RackOperationPlan {
 	if x != nil {
-		return x.TaskIds
+		return x.Plans
 	}
 	return nil
 }
 
-type GetTasksByIDsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RackOperationPlan struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	RackId                   *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RackName                 string                 `protobuf:"bytes,2,opt,name=rack_name,json=rackName,proto3" json:"rack_name,omitempty"`
+	Operation                string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Rule                     *OperationRule         `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	RuleSource               RuleSource             `protobuf:"varint,5,opt,name=rule_source,json=ruleSource,proto3,enum=v1.RuleSource" json:"rule_source,omitempty"`
+	Stages                   []*PlannedStage        `protobuf:"bytes,6,rep,name=stages,proto3" json:"stages,omitempty"`                                                                        // in execution order
+	EstimatedDurationSeconds int64                  `protobuf:"varint,7,opt,name=estimated_duration_seconds,json=estimatedDurationSeconds,proto3" json:"estimated_duration_seconds,omitempty"` // sum of stages; every action at its timeout, no retries
+	ConflictingTasks         []*Task                `protobuf:"bytes,8,rep,name=conflicting_tasks,json=conflictingTasks,proto3" json:"conflicting_tasks,omitempty"`
+	Outcome                  PlanOutcome            `protobuf:"varint,9,opt,name=outcome,proto3,enum=v1.PlanOutcome" json:"outcome,omitempty"`
+	Reason                   string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	Warnings                 []string               `protobuf:"bytes,11,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetTasksByIDsResponse) Reset() {
-	*x = GetTasksByIDsResponse{}
-	mi := &file_rla_proto_msgTypes[71]
+func (x *RackOperationPlan) Reset() {
+	*x = RackOperationPlan{}
+	mi := &file_rla_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksByIDsResponse) String() string {
+func (x *RackOperationPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksByIDsResponse) ProtoMessage() {}
+func (*RackOperationPlan) ProtoMessage() {}
 
-func (x *GetTasksByIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[71]
+func (x *RackOperationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4999,84 +6374,112 @@ func (x *GetTasksByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetTasksByIDsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use RackOperationPlan.ProtoReflect.Descriptor instead.
+func (*RackOperationPlan) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetTasksByIDsResponse) GetTasks() []*Task {
+func (x *RackOperationPlan) GetRackId() *UUID {
 	if x != nil {
-		return x.Tasks
+		return x.RackId
 	}
 	return nil
 }
 
-type CancelTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        *UUID                  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RackOperationPlan) GetRackName() string {
+	if x != nil {
+		return x.RackName
+	}
+	return ""
 }
 
-func (x *CancelTaskRequest) Reset() {
-	*x = CancelTaskRequest{}
-	mi := &file_rla_proto_msgTypes[72]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RackOperationPlan) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
 }
 
-func (x *CancelTaskRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RackOperationPlan) GetRule() *OperationRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
 }
 
-func (*CancelTaskRequest) ProtoMessage() {}
+func (x *RackOperationPlan) GetRuleSource() RuleSource {
+	if x != nil {
+		return x.RuleSource
+	}
+	return RuleSource_RULE_SOURCE_UNKNOWN
+}
 
-func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[72]
+func (x *RackOperationPlan) GetStages() []*PlannedStage {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Stages
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
-func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{72}
+func (x *RackOperationPlan) GetEstimatedDurationSeconds() int64 {
+	if x != nil {
+		return x.EstimatedDurationSeconds
+	}
+	return 0
 }
 
-func (x *CancelTaskRequest) GetTaskId() *UUID {
+func (x *RackOperationPlan) GetConflictingTasks() []*Task {
 	if x != nil {
-		return x.TaskId
+		return x.ConflictingTasks
 	}
 	return nil
 }
 
-type CancelTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RackOperationPlan) GetOutcome() PlanOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PlanOutcome_PLAN_OUTCOME_UNKNOWN
 }
 
-func (x *CancelTaskResponse) Reset() {
-	*x = CancelTaskResponse{}
-	mi := &file_rla_proto_msgTypes[73]
+func (x *RackOperationPlan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RackOperationPlan) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type PlannedStage struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Number                   int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Steps                    []*PlannedStep         `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`                                                                          // run in parallel
+	EstimatedDurationSeconds int64                  `protobuf:"varint,3,opt,name=estimated_duration_seconds,json=estimatedDurationSeconds,proto3" json:"estimated_duration_seconds,omitempty"` // slowest step
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PlannedStage) Reset() {
+	*x = PlannedStage{}
+	mi := &file_rla_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelTaskResponse) String() string {
+func (x *PlannedStage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelTaskResponse) ProtoMessage() {}
+func (*PlannedStage) ProtoMessage() {}
 
-func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[73]
+func (x *PlannedStage) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5087,40 +6490,4490 @@ func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
-func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use PlannedStage.ProtoReflect.Descriptor instead.
+func (*PlannedStage) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CancelTaskResponse) GetTask() *Task {
+func (x *PlannedStage) GetNumber() int32 {
 	if x != nil {
-		return x.Task
+		return x.Number
 	}
-	return nil
+	return 0
 }
 
-// Version API messages
-type VersionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PlannedStage) GetSteps() []*PlannedStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PlannedStage) GetEstimatedDurationSeconds() int64 {
+	if x != nil {
+		return x.EstimatedDurationSeconds
+	}
+	return 0
+}
+
+type PlannedStep struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	ComponentType            ComponentType          `protobuf:"varint,1,opt,name=component_type,json=componentType,proto3,enum=v1.ComponentType" json:"component_type,omitempty"`
+	MaxParallel              int32                  `protobuf:"varint,2,opt,name=max_parallel,json=maxParallel,proto3" json:"max_parallel,omitempty"`
+	Batches                  []*ComponentBatch      `protobuf:"bytes,3,rep,name=batches,proto3" json:"batches,omitempty"` // run one after another
+	Actions                  []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"` // pre, main and post actions in order
+	EstimatedDurationSeconds int64                  `protobuf:"varint,5,opt,name=estimated_duration_seconds,json=estimatedDurationSeconds,proto3" json:"estimated_duration_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PlannedStep) Reset() {
+	*x = PlannedStep{}
+	mi := &file_rla_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedStep) ProtoMessage() {}
+
+func (x *PlannedStep) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedStep.ProtoReflect.Descriptor instead.
+func (*PlannedStep) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PlannedStep) GetComponentType() ComponentType {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *PlannedStep) GetMaxParallel() int32 {
+	if x != nil {
+		return x.MaxParallel
+	}
+	return 0
+}
+
+func (x *PlannedStep) GetBatches() []*ComponentBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+func (x *PlannedStep) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlannedStep) GetEstimatedDurationSeconds() int64 {
+	if x != nil {
+		return x.EstimatedDurationSeconds
+	}
+	return 0
+}
+
+type ComponentBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentIds  []*UUID                `protobuf:"bytes,1,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentBatch) Reset() {
+	*x = ComponentBatch{}
+	mi := &file_rla_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentBatch) ProtoMessage() {}
+
+func (x *ComponentBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentBatch.ProtoReflect.Descriptor instead.
+func (*ComponentBatch) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ComponentBatch) GetComponentIds() []*UUID {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_rla_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListTasksRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_rla_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTasksByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []*UUID                `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksByIDsRequest) Reset() {
+	*x = GetTasksByIDsRequest{}
+	mi := &file_rla_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksByIDsRequest) ProtoMessage() {}
+
+func (x *GetTasksByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetTasksByIDsRequest) GetTaskIds() []*UUID {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+type GetTasksByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksByIDsResponse) Reset() {
+	*x = GetTasksByIDsResponse{}
+	mi := &file_rla_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksByIDsResponse) ProtoMessage() {}
+
+func (x *GetTasksByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetTasksByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetTasksByIDsResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type CancelTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        *UUID                  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskRequest) Reset() {
+	*x = CancelTaskRequest{}
+	mi := &file_rla_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskRequest) ProtoMessage() {}
+
+func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CancelTaskRequest) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
+
+type CancelTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskResponse) Reset() {
+	*x = CancelTaskResponse{}
+	mi := &file_rla_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskResponse) ProtoMessage() {}
+
+func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
+func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CancelTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ApproveTaskStepRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId *UUID                  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// approval_id may be left empty when the task has exactly one pending gate.
+	ApprovalId    string `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	Approver      string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTaskStepRequest) Reset() {
+	*x = ApproveTaskStepRequest{}
+	mi := &file_rla_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTaskStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTaskStepRequest) ProtoMessage() {}
+
+func (x *ApproveTaskStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTaskStepRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTaskStepRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ApproveTaskStepRequest) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
+
+func (x *ApproveTaskStepRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *ApproveTaskStepRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApproveTaskStepRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ApproveTaskStepResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTaskStepResponse) Reset() {
+	*x = ApproveTaskStepResponse{}
+	mi := &file_rla_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTaskStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTaskStepResponse) ProtoMessage() {}
+
+func (x *ApproveTaskStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTaskStepResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTaskStepResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ApproveTaskStepResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type RejectTaskStepRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId *UUID                  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// approval_id may be left empty when the task has exactly one pending gate.
+	ApprovalId string `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	Approver   string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	Comment    string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	// abort ends the task as terminated instead of failed.
+	Abort         bool `protobuf:"varint,5,opt,name=abort,proto3" json:"abort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTaskStepRequest) Reset() {
+	*x = RejectTaskStepRequest{}
+	mi := &file_rla_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTaskStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTaskStepRequest) ProtoMessage() {}
+
+func (x *RejectTaskStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTaskStepRequest.ProtoReflect.Descriptor instead.
+func (*RejectTaskStepRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RejectTaskStepRequest) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
+
+func (x *RejectTaskStepRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *RejectTaskStepRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *RejectTaskStepRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RejectTaskStepRequest) GetAbort() bool {
+	if x != nil {
+		return x.Abort
+	}
+	return false
+}
+
+type RejectTaskStepResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTaskStepResponse) Reset() {
+	*x = RejectTaskStepResponse{}
+	mi := &file_rla_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTaskStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTaskStepResponse) ProtoMessage() {}
+
+func (x *RejectTaskStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTaskStepResponse.ProtoReflect.Descriptor instead.
+func (*RejectTaskStepResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RejectTaskStepResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// TaskReportEntry is one line of a task report. Entries about the task as a
+// whole have no component.
+type TaskReportEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	ComponentType ComponentType          `protobuf:"varint,2,opt,name=component_type,json=componentType,proto3,enum=v1.ComponentType" json:"component_type,omitempty"`
+	ComponentId   string                 `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Stage         int32                  `protobuf:"varint,4,opt,name=stage,proto3" json:"stage,omitempty"`  // operation rule stage, 0 for task entries
+	Step          string                 `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`     // pre_operation | main_operation | post_operation | compensation
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"` // e.g. PowerControl, or Task for the task outcome
+	Outcome       TaskReportOutcome      `protobuf:"varint,7,opt,name=outcome,proto3,enum=v1.TaskReportOutcome" json:"outcome,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskReportEntry) Reset() {
+	*x = TaskReportEntry{}
+	mi := &file_rla_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskReportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskReportEntry) ProtoMessage() {}
+
+func (x *TaskReportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskReportEntry.ProtoReflect.Descriptor instead.
+func (*TaskReportEntry) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TaskReportEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TaskReportEntry) GetComponentType() ComponentType {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *TaskReportEntry) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *TaskReportEntry) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *TaskReportEntry) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *TaskReportEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TaskReportEntry) GetOutcome() TaskReportOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TaskReportOutcome_TASK_REPORT_OUTCOME_UNKNOWN
+}
+
+func (x *TaskReportEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTaskReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        *UUID                  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Format        TaskReportFormat       `protobuf:"varint,2,opt,name=format,proto3,enum=v1.TaskReportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskReportRequest) Reset() {
+	*x = GetTaskReportRequest{}
+	mi := &file_rla_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskReportRequest) ProtoMessage() {}
+
+func (x *GetTaskReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskReportRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetTaskReportRequest) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
+
+func (x *GetTaskReportRequest) GetFormat() TaskReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED
+}
+
+type GetTaskReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Entries       []*TaskReportEntry     `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // in the order they were recorded
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // rendered report, set when a format was requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskReportResponse) Reset() {
+	*x = GetTaskReportResponse{}
+	mi := &file_rla_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskReportResponse) ProtoMessage() {}
+
+func (x *GetTaskReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskReportResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetTaskReportResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *GetTaskReportResponse) GetEntries() []*TaskReportEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTaskReportResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Version API messages
+type VersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VersionRequest) Reset() {
 	*x = VersionRequest{}
-	mi := &file_rla_proto_msgTypes[74]
+	mi := &file_rla_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{91}
+}
+
+type BuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // e.g., v2025.11.19
+	BuildTime     string                 `protobuf:"bytes,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // e.g., 2025-01-27T10:30:00Z
+	GitCommit     string                 `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"` // e.g., abc1234
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_rla_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuildTime() string {
+	if x != nil {
+		return x.BuildTime
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+type OperationRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OperationType      OperationType          `protobuf:"varint,4,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode      string                 `protobuf:"bytes,5,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
+	RuleDefinitionJson string                 `protobuf:"bytes,6,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
+	IsDefault          bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OperationRule) Reset() {
+	*x = OperationRule{}
+	mi := &file_rla_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRule) ProtoMessage() {}
+
+func (x *OperationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRule.ProtoReflect.Descriptor instead.
+func (*OperationRule) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *OperationRule) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *OperationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OperationRule) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *OperationRule) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+func (x *OperationRule) GetRuleDefinitionJson() string {
+	if x != nil {
+		return x.RuleDefinitionJson
+	}
+	return ""
+}
+
+func (x *OperationRule) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *OperationRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OperationRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateOperationRuleRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OperationType      OperationType          `protobuf:"varint,3,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode      string                 `protobuf:"bytes,4,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
+	RuleDefinitionJson string                 `protobuf:"bytes,5,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
+	IsDefault          bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateOperationRuleRequest) Reset() {
+	*x = CreateOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOperationRuleRequest) ProtoMessage() {}
+
+func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateOperationRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *CreateOperationRuleRequest) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetRuleDefinitionJson() string {
+	if x != nil {
+		return x.RuleDefinitionJson
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type CreateOperationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOperationRuleResponse) Reset() {
+	*x = CreateOperationRuleResponse{}
+	mi := &file_rla_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOperationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOperationRuleResponse) ProtoMessage() {}
+
+func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOperationRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateOperationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateOperationRuleResponse) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type UpdateOperationRuleRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RuleId             *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name               *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description        *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RuleDefinitionJson *string                `protobuf:"bytes,4,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3,oneof" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateOperationRuleRequest) Reset() {
+	*x = UpdateOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperationRuleRequest) ProtoMessage() {}
+
+func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateOperationRuleRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *UpdateOperationRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOperationRuleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateOperationRuleRequest) GetRuleDefinitionJson() string {
+	if x != nil && x.RuleDefinitionJson != nil {
+		return *x.RuleDefinitionJson
+	}
+	return ""
+}
+
+type DeleteOperationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOperationRuleRequest) Reset() {
+	*x = DeleteOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOperationRuleRequest) ProtoMessage() {}
+
+func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteOperationRuleRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type SetRuleAsDefaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRuleAsDefaultRequest) Reset() {
+	*x = SetRuleAsDefaultRequest{}
+	mi := &file_rla_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRuleAsDefaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRuleAsDefaultRequest) ProtoMessage() {}
+
+func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRuleAsDefaultRequest.ProtoReflect.Descriptor instead.
+func (*SetRuleAsDefaultRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetRuleAsDefaultRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type GetOperationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationRuleRequest) Reset() {
+	*x = GetOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRuleRequest) ProtoMessage() {}
+
+func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetOperationRuleRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type ListOperationRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationType *OperationType         `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType,oneof" json:"operation_type,omitempty"`
+	IsDefault     *bool                  `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty"`
+	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationRulesRequest) Reset() {
+	*x = ListOperationRulesRequest{}
+	mi := &file_rla_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationRulesRequest) ProtoMessage() {}
+
+func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListOperationRulesRequest) GetOperationType() OperationType {
+	if x != nil && x.OperationType != nil {
+		return *x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *ListOperationRulesRequest) GetIsDefault() bool {
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
+	}
+	return false
+}
+
+func (x *ListOperationRulesRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListOperationRulesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListOperationRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*OperationRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationRulesResponse) Reset() {
+	*x = ListOperationRulesResponse{}
+	mi := &file_rla_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationRulesResponse) ProtoMessage() {}
+
+func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListOperationRulesResponse) GetRules() []*OperationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListOperationRulesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type AssociateRuleWithRackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RuleId        *UUID                  `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssociateRuleWithRackRequest) Reset() {
+	*x = AssociateRuleWithRackRequest{}
+	mi := &file_rla_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssociateRuleWithRackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociateRuleWithRackRequest) ProtoMessage() {}
+
+func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociateRuleWithRackRequest.ProtoReflect.Descriptor instead.
+func (*AssociateRuleWithRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *AssociateRuleWithRackRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *AssociateRuleWithRackRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type DisassociateRuleFromRackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisassociateRuleFromRackRequest) Reset() {
+	*x = DisassociateRuleFromRackRequest{}
+	mi := &file_rla_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisassociateRuleFromRackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisassociateRuleFromRackRequest) ProtoMessage() {}
+
+func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisassociateRuleFromRackRequest.ProtoReflect.Descriptor instead.
+func (*DisassociateRuleFromRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DisassociateRuleFromRackRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *DisassociateRuleFromRackRequest) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *DisassociateRuleFromRackRequest) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+type GetRackRuleAssociationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRackRuleAssociationRequest) Reset() {
+	*x = GetRackRuleAssociationRequest{}
+	mi := &file_rla_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRackRuleAssociationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRackRuleAssociationRequest) ProtoMessage() {}
+
+func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRackRuleAssociationRequest.ProtoReflect.Descriptor instead.
+func (*GetRackRuleAssociationRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetRackRuleAssociationRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *GetRackRuleAssociationRequest) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *GetRackRuleAssociationRequest) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+type GetRackRuleAssociationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Empty if no association exists
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRackRuleAssociationResponse) Reset() {
+	*x = GetRackRuleAssociationResponse{}
+	mi := &file_rla_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRackRuleAssociationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRackRuleAssociationResponse) ProtoMessage() {}
+
+func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRackRuleAssociationResponse.ProtoReflect.Descriptor instead.
+func (*GetRackRuleAssociationResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetRackRuleAssociationResponse) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type ListRackRuleAssociationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRackRuleAssociationsRequest) Reset() {
+	*x = ListRackRuleAssociationsRequest{}
+	mi := &file_rla_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRackRuleAssociationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRackRuleAssociationsRequest) ProtoMessage() {}
+
+func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRackRuleAssociationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRackRuleAssociationsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListRackRuleAssociationsRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+type RackRuleAssociation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code (e.g., "power_on", "upgrade")
+	RuleId        *UUID                  `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RackRuleAssociation) Reset() {
+	*x = RackRuleAssociation{}
+	mi := &file_rla_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RackRuleAssociation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RackRuleAssociation) ProtoMessage() {}
+
+func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RackRuleAssociation.ProtoReflect.Descriptor instead.
+func (*RackRuleAssociation) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RackRuleAssociation) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *RackRuleAssociation) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *RackRuleAssociation) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+func (x *RackRuleAssociation) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *RackRuleAssociation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RackRuleAssociation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListRackRuleAssociationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Associations  []*RackRuleAssociation `protobuf:"bytes,1,rep,name=associations,proto3" json:"associations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRackRuleAssociationsResponse) Reset() {
+	*x = ListRackRuleAssociationsResponse{}
+	mi := &file_rla_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRackRuleAssociationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRackRuleAssociationsResponse) ProtoMessage() {}
+
+func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRackRuleAssociationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRackRuleAssociationsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListRackRuleAssociationsResponse) GetAssociations() []*RackRuleAssociation {
+	if x != nil {
+		return x.Associations
+	}
+	return nil
+}
+
+type ScheduleSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ScheduleSpecType       `protobuf:"varint,1,opt,name=type,proto3,enum=v1.ScheduleSpecType" json:"type,omitempty"`
+	Spec  string                 `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// IANA timezone for interpreting cron specs (e.g. "America/New_York").
+	// Defaults to "UTC". Ignored for interval and one-time specs.
+	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSpec) Reset() {
+	*x = ScheduleSpec{}
+	mi := &file_rla_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSpec) ProtoMessage() {}
+
+func (x *ScheduleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSpec.ProtoReflect.Descriptor instead.
+func (*ScheduleSpec) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ScheduleSpec) GetType() ScheduleSpecType {
+	if x != nil {
+		return x.Type
+	}
+	return ScheduleSpecType_SCHEDULE_SPEC_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduleSpec) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// ScheduleConfig groups the scheduling fields shared by multiple request types.
+type ScheduleConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *ScheduleSpec          `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	OverlapPolicy OverlapPolicy          `protobuf:"varint,3,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=v1.OverlapPolicy" json:"overlap_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConfig) Reset() {
+	*x = ScheduleConfig{}
+	mi := &file_rla_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConfig) ProtoMessage() {}
+
+func (x *ScheduleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConfig.ProtoReflect.Descriptor instead.
+func (*ScheduleConfig) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ScheduleConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleConfig) GetSpec() *ScheduleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ScheduleConfig) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+// TaskSchedule defines when (spec) and what (operation) should run automatically.
+// Which racks to target is tracked separately in TaskScheduleScope rows and
+// managed via AddTaskScheduleScope / RemoveTaskScheduleScope / ListTaskScheduleScopes.
+type TaskSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // unique, human-readable identifier
+	Spec          *ScheduleSpec          `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"` // when to fire (interval, cron, or one-time)
+	OverlapPolicy OverlapPolicy          `protobuf:"varint,4,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=v1.OverlapPolicy" json:"overlap_policy,omitempty"`
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`                             // false = paused (will not fire)
+	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_at,json=nextRunAt,proto3,oneof" json:"next_run_at,omitempty"` // absent for disabled or fully-fired one-time schedules
+	LastRunAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_run_at,json=lastRunAt,proto3,oneof" json:"last_run_at,omitempty"` // absent if the schedule has never fired
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// operation_type identifies the kind of operation this schedule runs.
+	// Values: "POWER_ON", "POWER_OFF", "POWER_RESET", "BRING_UP", "INGEST",
+	//         "UPGRADE_FIRMWARE", "DOWNGRADE_FIRMWARE", "ROLLBACK_FIRMWARE".
+	OperationType string `protobuf:"bytes,10,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	// description is a human-readable summary of the operation and its key parameters,
+	// e.g. "Power Reset (forced)" or "Upgrade Firmware to v2.3.1".
+	Description   string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSchedule) Reset() {
+	*x = TaskSchedule{}
+	mi := &file_rla_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSchedule) ProtoMessage() {}
+
+func (x *TaskSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSchedule.ProtoReflect.Descriptor instead.
+func (*TaskSchedule) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *TaskSchedule) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskSchedule) GetSpec() *ScheduleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (x *TaskSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TaskSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *TaskSchedule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ScheduledOperation is the shared operation oneof used by
+// CreateTaskScheduleRequest and CheckScheduleConflictsRequest.
+// Centralising it here means a single proto change adds support for a new
+// operation type in both RPCs, and the Go conversion logic lives in one place.
+//
+// Note: the embedded request messages (e.g. PowerOnRackRequest) may carry a
+// description field, but it is ignored when used inside a ScheduledOperation.
+// The dispatcher generates task descriptions automatically at fire time in the
+// form "<schedule name> — <RFC3339 timestamp>".
+type ScheduledOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*ScheduledOperation_PowerOn
+	//	*ScheduledOperation_PowerOff
+	//	*ScheduledOperation_PowerReset
+	//	*ScheduledOperation_BringUp
+	//	*ScheduledOperation_UpgradeFirmware
+	//	*ScheduledOperation_Ingest
+	Operation     isScheduledOperation_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledOperation) Reset() {
+	*x = ScheduledOperation{}
+	mi := &file_rla_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledOperation) ProtoMessage() {}
+
+func (x *ScheduledOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledOperation.ProtoReflect.Descriptor instead.
+func (*ScheduledOperation) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ScheduledOperation) GetOperation() isScheduledOperation_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ScheduledOperation) GetPowerOn() *PowerOnRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_PowerOn); ok {
+			return x.PowerOn
+		}
+	}
+	return nil
+}
+
+func (x *ScheduledOperation) GetPowerOff() *PowerOffRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_PowerOff); ok {
+			return x.PowerOff
+		}
+	}
+	return nil
+}
+
+func (x *ScheduledOperation) GetPowerReset() *PowerResetRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_PowerReset); ok {
+			return x.PowerReset
+		}
+	}
+	return nil
+}
+
+func (x *ScheduledOperation) GetBringUp() *BringUpRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_BringUp); ok {
+			return x.BringUp
+		}
+	}
+	return nil
+}
+
+func (x *ScheduledOperation) GetUpgradeFirmware() *UpgradeFirmwareRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_UpgradeFirmware); ok {
+			return x.UpgradeFirmware
+		}
+	}
+	return nil
+}
+
+func (x *ScheduledOperation) GetIngest() *IngestRackRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*ScheduledOperation_Ingest); ok {
+			return x.Ingest
+		}
+	}
+	return nil
+}
+
+type isScheduledOperation_Operation interface {
+	isScheduledOperation_Operation()
+}
+
+type ScheduledOperation_PowerOn struct {
+	PowerOn *PowerOnRackRequest `protobuf:"bytes,1,opt,name=power_on,json=powerOn,proto3,oneof"`
+}
+
+type ScheduledOperation_PowerOff struct {
+	PowerOff *PowerOffRackRequest `protobuf:"bytes,2,opt,name=power_off,json=powerOff,proto3,oneof"`
+}
+
+type ScheduledOperation_PowerReset struct {
+	PowerReset *PowerResetRackRequest `protobuf:"bytes,3,opt,name=power_reset,json=powerReset,proto3,oneof"`
+}
+
+type ScheduledOperation_BringUp struct {
+	BringUp *BringUpRackRequest `protobuf:"bytes,4,opt,name=bring_up,json=bringUp,proto3,oneof"`
+}
+
+type ScheduledOperation_UpgradeFirmware struct {
+	UpgradeFirmware *UpgradeFirmwareRequest `protobuf:"bytes,5,opt,name=upgrade_firmware,json=upgradeFirmware,proto3,oneof"`
+}
+
+type ScheduledOperation_Ingest struct {
+	Ingest *IngestRackRequest `protobuf:"bytes,6,opt,name=ingest,proto3,oneof"`
+}
+
+func (*ScheduledOperation_PowerOn) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_PowerOff) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_PowerReset) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_BringUp) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_UpgradeFirmware) isScheduledOperation_Operation() {}
+
+func (*ScheduledOperation_Ingest) isScheduledOperation_Operation() {}
+
+// CreateTaskScheduleRequest creates a new TaskSchedule.
+// The target_spec on the operation message defines the initial scope; it follows
+// the same targeting rules as AddTaskScheduleScope (rack-level or component-level).
+// Use AddTaskScheduleScope / RemoveTaskScheduleScope to modify the scope after creation.
+type CreateTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ScheduleConfig        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Operation     *ScheduledOperation    `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskScheduleRequest) Reset() {
+	*x = CreateTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskScheduleRequest) ProtoMessage() {}
+
+func (x *CreateTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreateTaskScheduleRequest) GetSchedule() *ScheduleConfig {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *CreateTaskScheduleRequest) GetOperation() *ScheduledOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type GetTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskScheduleRequest) Reset() {
+	*x = GetTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskScheduleRequest) ProtoMessage() {}
+
+func (x *GetTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetTaskScheduleRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// ListTaskSchedulesRequest lists TaskSchedules with optional filters.
+// Results are ordered by creation time ascending.
+type ListTaskSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"` // if set, return only schedules with a scope on this rack
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	EnabledOnly   *bool                  `protobuf:"varint,3,opt,name=enabled_only,json=enabledOnly,proto3,oneof" json:"enabled_only,omitempty"` // if true, return only enabled (non-paused) schedules
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskSchedulesRequest) Reset() {
+	*x = ListTaskSchedulesRequest{}
+	mi := &file_rla_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskSchedulesRequest) ProtoMessage() {}
+
+func (x *ListTaskSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListTaskSchedulesRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *ListTaskSchedulesRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListTaskSchedulesRequest) GetEnabledOnly() bool {
+	if x != nil && x.EnabledOnly != nil {
+		return *x.EnabledOnly
+	}
+	return false
+}
+
+type ListTaskSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskSchedules []*TaskSchedule        `protobuf:"bytes,1,rep,name=task_schedules,json=taskSchedules,proto3" json:"task_schedules,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // total matching count before pagination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskSchedulesResponse) Reset() {
+	*x = ListTaskSchedulesResponse{}
+	mi := &file_rla_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskSchedulesResponse) ProtoMessage() {}
+
+func (x *ListTaskSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListTaskSchedulesResponse) GetTaskSchedules() []*TaskSchedule {
+	if x != nil {
+		return x.TaskSchedules
+	}
+	return nil
+}
+
+func (x *ListTaskSchedulesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// UpdateTaskScheduleRequest updates the scheduling config of an existing
+// TaskSchedule. To modify which racks are targeted, use
+// AddTaskScheduleScope / RemoveTaskScheduleScope instead.
+//
+// update_mask is required and controls which fields are written. Supported paths:
+//
+//	"schedule.name"           – display name
+//	"schedule.overlap_policy" – overlap behaviour
+//	"schedule.spec"           – full spec block (type + spec string + next_run_at recomputed)
+//	"schedule.spec.timezone"  – timezone only (spec type/string unchanged)
+type UpdateTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule      *ScheduleConfig        `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskScheduleRequest) Reset() {
+	*x = UpdateTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UpdateTaskScheduleRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateTaskScheduleRequest) GetSchedule() *ScheduleConfig {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *UpdateTaskScheduleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// PauseTaskScheduleRequest disables a TaskSchedule without deleting it.
+// The schedule will not fire until resumed. Has no effect if already paused.
+// Returns an error for a one-time schedule that has already fired.
+type PauseTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTaskScheduleRequest) Reset() {
+	*x = PauseTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTaskScheduleRequest) ProtoMessage() {}
+
+func (x *PauseTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PauseTaskScheduleRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// ResumeTaskScheduleRequest re-enables a paused TaskSchedule. For interval
+// and cron schedules, next_run_at is recomputed from the current time so the
+// schedule does not fire immediately. Has no effect if already enabled.
+type ResumeTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTaskScheduleRequest) Reset() {
+	*x = ResumeTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTaskScheduleRequest) ProtoMessage() {}
+
+func (x *ResumeTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ResumeTaskScheduleRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// DeleteTaskScheduleRequest permanently deletes a TaskSchedule and all its
+// scope entries. In-flight tasks are not cancelled.
+type DeleteTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskScheduleRequest) Reset() {
+	*x = DeleteTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DeleteTaskScheduleRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// TriggerTaskScheduleRequest fires a TaskSchedule immediately, regardless of
+// next_run_at or enabled state. The overlap policy is not consulted — all
+// scopes are submitted unconditionally. Returns an error for a one-time
+// schedule that has already fired.
+type TriggerTaskScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerTaskScheduleRequest) Reset() {
+	*x = TriggerTaskScheduleRequest{}
+	mi := &file_rla_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerTaskScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerTaskScheduleRequest) ProtoMessage() {}
+
+func (x *TriggerTaskScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerTaskScheduleRequest.ProtoReflect.Descriptor instead.
+func (*TriggerTaskScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *TriggerTaskScheduleRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// TaskScheduleScope represents one rack target in a schedule's scope.
+// Each scope entry causes one task to be submitted per schedule firing.
+// last_task_id tracks the task produced for this rack by the most recent firing;
+// the dispatcher uses it for the overlap check. Absent if no task has fired yet
+// for this scope (e.g. a newly added rack).
+type TaskScheduleScope struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId *UUID                  `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	RackId     *UUID                  `protobuf:"bytes,3,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	// component_filter restricts which components in the rack are targeted.
+	// Omit to target all components in the rack.
+	//
+	// Types that are valid to be assigned to ComponentFilter:
+	//
+	//	*TaskScheduleScope_Types
+	//	*TaskScheduleScope_Components
+	ComponentFilter isTaskScheduleScope_ComponentFilter `protobuf_oneof:"component_filter"`
+	LastTaskId      *UUID                               `protobuf:"bytes,6,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"` // absent until the first firing for this scope
+	CreatedAt       *timestamppb.Timestamp              `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskScheduleScope) Reset() {
+	*x = TaskScheduleScope{}
+	mi := &file_rla_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskScheduleScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskScheduleScope) ProtoMessage() {}
+
+func (x *TaskScheduleScope) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskScheduleScope.ProtoReflect.Descriptor instead.
+func (*TaskScheduleScope) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *TaskScheduleScope) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetScheduleId() *UUID {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetComponentFilter() isTaskScheduleScope_ComponentFilter {
+	if x != nil {
+		return x.ComponentFilter
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetTypes() *ComponentTypes {
+	if x != nil {
+		if x, ok := x.ComponentFilter.(*TaskScheduleScope_Types); ok {
+			return x.Types
+		}
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetComponents() *ComponentTargets {
+	if x != nil {
+		if x, ok := x.ComponentFilter.(*TaskScheduleScope_Components); ok {
+			return x.Components
+		}
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetLastTaskId() *UUID {
+	if x != nil {
+		return x.LastTaskId
+	}
+	return nil
+}
+
+func (x *TaskScheduleScope) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type isTaskScheduleScope_ComponentFilter interface {
+	isTaskScheduleScope_ComponentFilter()
+}
+
+type TaskScheduleScope_Types struct {
+	// types filters by component type (e.g. COMPUTE, POWERSHELF).
+	Types *ComponentTypes `protobuf:"bytes,4,opt,name=types,proto3,oneof"`
+}
+
+type TaskScheduleScope_Components struct {
+	// components targets specific components by UUID or external reference.
+	Components *ComponentTargets `protobuf:"bytes,5,opt,name=components,proto3,oneof"`
+}
+
+func (*TaskScheduleScope_Types) isTaskScheduleScope_ComponentFilter() {}
+
+func (*TaskScheduleScope_Components) isTaskScheduleScope_ComponentFilter() {}
+
+// AddTaskScheduleScopeRequest adds one or more scope entries to a schedule.
+// Supports rack-level targeting (with optional component-type filter) and
+// component-level targeting (specific components by UUID or external reference).
+// For component-level targets the server resolves which rack each component
+// belongs to and groups them into per-rack scope entries automatically.
+// Racks already present in the scope have their component filter merged with the
+// incoming filter rather than erroring; racks not yet present are added.
+// Existing racks are never removed — use UpdateTaskScheduleScope for replace semantics.
+type AddTaskScheduleScopeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    *UUID                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,2,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskScheduleScopeRequest) Reset() {
+	*x = AddTaskScheduleScopeRequest{}
+	mi := &file_rla_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskScheduleScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskScheduleScopeRequest) ProtoMessage() {}
+
+func (x *AddTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskScheduleScopeRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskScheduleScopeRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AddTaskScheduleScopeRequest) GetScheduleId() *UUID {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return nil
+}
+
+func (x *AddTaskScheduleScopeRequest) GetTargetSpec() *OperationTargetSpec {
+	if x != nil {
+		return x.TargetSpec
+	}
+	return nil
+}
+
+// AddTaskScheduleScopeResponse returns the newly created scope entries.
+type AddTaskScheduleScopeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scopes        []*TaskScheduleScope   `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskScheduleScopeResponse) Reset() {
+	*x = AddTaskScheduleScopeResponse{}
+	mi := &file_rla_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskScheduleScopeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskScheduleScopeResponse) ProtoMessage() {}
+
+func (x *AddTaskScheduleScopeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskScheduleScopeResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskScheduleScopeResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *AddTaskScheduleScopeResponse) GetScopes() []*TaskScheduleScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// RemoveTaskScheduleScopeRequest removes a single rack scope entry by its scope ID.
+// In-flight tasks for that rack are not cancelled.
+type RemoveTaskScheduleScopeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScopeId       *UUID                  `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskScheduleScopeRequest) Reset() {
+	*x = RemoveTaskScheduleScopeRequest{}
+	mi := &file_rla_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskScheduleScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskScheduleScopeRequest) ProtoMessage() {}
+
+func (x *RemoveTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskScheduleScopeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskScheduleScopeRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RemoveTaskScheduleScopeRequest) GetScopeId() *UUID {
+	if x != nil {
+		return x.ScopeId
+	}
+	return nil
+}
+
+// UpdateTaskScheduleScopeRequest reconciles the schedule's scope against the
+// desired target_spec: racks present in desired_scope but not in the current scope
+// are added; racks present in the current scope but absent from desired_scope are
+// removed; racks present in both have their component_filter updated if changed.
+// For component-level targets the server resolves rack membership automatically.
+type UpdateTaskScheduleScopeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    *UUID                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	DesiredScope  *OperationTargetSpec   `protobuf:"bytes,2,opt,name=desired_scope,json=desiredScope,proto3" json:"desired_scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskScheduleScopeRequest) Reset() {
+	*x = UpdateTaskScheduleScopeRequest{}
+	mi := &file_rla_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskScheduleScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskScheduleScopeRequest) ProtoMessage() {}
+
+func (x *UpdateTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskScheduleScopeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskScheduleScopeRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UpdateTaskScheduleScopeRequest) GetScheduleId() *UUID {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return nil
+}
+
+func (x *UpdateTaskScheduleScopeRequest) GetDesiredScope() *OperationTargetSpec {
+	if x != nil {
+		return x.DesiredScope
+	}
+	return nil
+}
+
+// UpdateTaskScheduleScopeResponse returns the complete scope after reconciliation.
+type UpdateTaskScheduleScopeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scopes        []*TaskScheduleScope   `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`     // number of scope entries added
+	Removed       int32                  `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"` // number of scope entries removed
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"` // number of scope entries with updated component_filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskScheduleScopeResponse) Reset() {
+	*x = UpdateTaskScheduleScopeResponse{}
+	mi := &file_rla_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskScheduleScopeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskScheduleScopeResponse) ProtoMessage() {}
+
+func (x *UpdateTaskScheduleScopeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskScheduleScopeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskScheduleScopeResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UpdateTaskScheduleScopeResponse) GetScopes() []*TaskScheduleScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UpdateTaskScheduleScopeResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *UpdateTaskScheduleScopeResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *UpdateTaskScheduleScopeResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// ListTaskScheduleScopesRequest returns all scope entries for a given schedule.
+type ListTaskScheduleScopesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    *UUID                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskScheduleScopesRequest) Reset() {
+	*x = ListTaskScheduleScopesRequest{}
+	mi := &file_rla_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskScheduleScopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskScheduleScopesRequest) ProtoMessage() {}
+
+func (x *ListTaskScheduleScopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskScheduleScopesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskScheduleScopesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListTaskScheduleScopesRequest) GetScheduleId() *UUID {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return nil
+}
+
+type ListTaskScheduleScopesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scopes        []*TaskScheduleScope   `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskScheduleScopesResponse) Reset() {
+	*x = ListTaskScheduleScopesResponse{}
+	mi := &file_rla_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskScheduleScopesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskScheduleScopesResponse) ProtoMessage() {}
+
+func (x *ListTaskScheduleScopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskScheduleScopesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskScheduleScopesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListTaskScheduleScopesResponse) GetScopes() []*TaskScheduleScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// CheckScheduleConflictsRequest checks whether a proposed scheduled operation
+// would conflict with any existing enabled schedules.
+// The operation oneof mirrors CreateTaskScheduleRequest: the target_spec
+// embedded in the operation message defines which racks are checked.
+//
+// This call is advisory and intentionally coarse: it matches on operation
+// type and code only, without intersecting component-type filters or explicit
+// component UUID lists. As a result it may return false positives — two
+// schedules that target disjoint component sets on the same rack will appear
+// to conflict here even if their tasks would never collide at runtime.
+// Execution-time conflict detection (the task manager's conflict rules) remains
+// the authoritative backstop. The caller may proceed even when conflicts are
+// returned.
+type CheckScheduleConflictsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Operation *ScheduledOperation    `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// exclude_schedule_id omits a schedule from the conflict check results.
+	// Pass the ID of the schedule being updated so its current definition is
+	// not returned as a conflict against the proposed replacement operation.
+	ExcludeScheduleId *UUID `protobuf:"bytes,2,opt,name=exclude_schedule_id,json=excludeScheduleId,proto3,oneof" json:"exclude_schedule_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckScheduleConflictsRequest) Reset() {
+	*x = CheckScheduleConflictsRequest{}
+	mi := &file_rla_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckScheduleConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckScheduleConflictsRequest) ProtoMessage() {}
+
+func (x *CheckScheduleConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckScheduleConflictsRequest.ProtoReflect.Descriptor instead.
+func (*CheckScheduleConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CheckScheduleConflictsRequest) GetOperation() *ScheduledOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *CheckScheduleConflictsRequest) GetExcludeScheduleId() *UUID {
+	if x != nil {
+		return x.ExcludeScheduleId
+	}
+	return nil
+}
+
+// CheckScheduleConflictsResponse lists the existing enabled schedules whose
+// operations may conflict with the proposed operation at execution time.
+// An empty list means no conflicts were detected.
+type CheckScheduleConflictsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*TaskSchedule        `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckScheduleConflictsResponse) Reset() {
+	*x = CheckScheduleConflictsResponse{}
+	mi := &file_rla_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckScheduleConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckScheduleConflictsResponse) ProtoMessage() {}
+
+func (x *CheckScheduleConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckScheduleConflictsResponse.ProtoReflect.Descriptor instead.
+func (*CheckScheduleConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CheckScheduleConflictsResponse) GetConflicts() []*TaskSchedule {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// PowerBudget caps the power draw of a rack or of a single power shelf.
+// A rack budget is split evenly across the rack's power shelves and applied as
+// Redfish PowerLimit where the vendor supports it; a power shelf cap bounds
+// that shelf's share. Budgets are also enforced by monitoring (over-budget
+// alerts) and by refusing power-on and bring-up operations that would exceed
+// the rack budget.
+type PowerBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,2,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	ComponentId   *UUID                  `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3,oneof" json:"component_id,omitempty"` // set for a power shelf cap, absent for a rack budget
+	LimitWatts    float64                `protobuf:"fixed64,4,opt,name=limit_watts,json=limitWatts,proto3" json:"limit_watts,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerBudget) Reset() {
+	*x = PowerBudget{}
+	mi := &file_rla_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerBudget) ProtoMessage() {}
+
+func (x *PowerBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerBudget.ProtoReflect.Descriptor instead.
+func (*PowerBudget) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *PowerBudget) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PowerBudget) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *PowerBudget) GetComponentId() *UUID {
+	if x != nil {
+		return x.ComponentId
+	}
+	return nil
+}
+
+func (x *PowerBudget) GetLimitWatts() float64 {
+	if x != nil {
+		return x.LimitWatts
+	}
+	return 0
+}
+
+func (x *PowerBudget) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PowerBudget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ShelfPowerLimit is the limit pushed to one power shelf. A limit_watts of
+// zero clears the shelf's limit.
+type ShelfPowerLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   *UUID                  `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	PmcMacAddress string                 `protobuf:"bytes,2,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	LimitWatts    float64                `protobuf:"fixed64,3,opt,name=limit_watts,json=limitWatts,proto3" json:"limit_watts,omitempty"`
+	Status        PowerLimitApplyStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=v1.PowerLimitApplyStatus" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShelfPowerLimit) Reset() {
+	*x = ShelfPowerLimit{}
+	mi := &file_rla_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShelfPowerLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShelfPowerLimit) ProtoMessage() {}
+
+func (x *ShelfPowerLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShelfPowerLimit.ProtoReflect.Descriptor instead.
+func (*ShelfPowerLimit) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ShelfPowerLimit) GetComponentId() *UUID {
+	if x != nil {
+		return x.ComponentId
+	}
+	return nil
+}
+
+func (x *ShelfPowerLimit) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *ShelfPowerLimit) GetLimitWatts() float64 {
+	if x != nil {
+		return x.LimitWatts
+	}
+	return 0
+}
+
+func (x *ShelfPowerLimit) GetStatus() PowerLimitApplyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PowerLimitApplyStatus_POWER_LIMIT_APPLY_STATUS_UNKNOWN
+}
+
+func (x *ShelfPowerLimit) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SetPowerBudgetRequest sets the budget of a rack or the cap of a power shelf,
+// replacing any existing one, and re-applies the shelf limits of the rack.
+type SetPowerBudgetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*SetPowerBudgetRequest_RackId
+	//	*SetPowerBudgetRequest_ComponentId
+	Target        isSetPowerBudgetRequest_Target `protobuf_oneof:"target"`
+	LimitWatts    float64                        `protobuf:"fixed64,3,opt,name=limit_watts,json=limitWatts,proto3" json:"limit_watts,omitempty"` // must be positive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerBudgetRequest) Reset() {
+	*x = SetPowerBudgetRequest{}
+	mi := &file_rla_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerBudgetRequest) ProtoMessage() {}
+
+func (x *SetPowerBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *SetPowerBudgetRequest) GetTarget() isSetPowerBudgetRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SetPowerBudgetRequest) GetRackId() *UUID {
+	if x != nil {
+		if x, ok := x.Target.(*SetPowerBudgetRequest_RackId); ok {
+			return x.RackId
+		}
+	}
+	return nil
+}
+
+func (x *SetPowerBudgetRequest) GetComponentId() *UUID {
+	if x != nil {
+		if x, ok := x.Target.(*SetPowerBudgetRequest_ComponentId); ok {
+			return x.ComponentId
+		}
+	}
+	return nil
+}
+
+func (x *SetPowerBudgetRequest) GetLimitWatts() float64 {
+	if x != nil {
+		return x.LimitWatts
+	}
+	return 0
+}
+
+type isSetPowerBudgetRequest_Target interface {
+	isSetPowerBudgetRequest_Target()
+}
+
+type SetPowerBudgetRequest_RackId struct {
+	RackId *UUID `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof"`
+}
+
+type SetPowerBudgetRequest_ComponentId struct {
+	ComponentId *UUID `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3,oneof"` // must be a power shelf
+}
+
+func (*SetPowerBudgetRequest_RackId) isSetPowerBudgetRequest_Target() {}
+
+func (*SetPowerBudgetRequest_ComponentId) isSetPowerBudgetRequest_Target() {}
+
+type SetPowerBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *PowerBudget           `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	Limits        []*ShelfPowerLimit     `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerBudgetResponse) Reset() {
+	*x = SetPowerBudgetResponse{}
+	mi := &file_rla_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerBudgetResponse) ProtoMessage() {}
+
+func (x *SetPowerBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetPowerBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SetPowerBudgetResponse) GetBudget() *PowerBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *SetPowerBudgetResponse) GetLimits() []*ShelfPowerLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type DeletePowerBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePowerBudgetRequest) Reset() {
+	*x = DeletePowerBudgetRequest{}
+	mi := &file_rla_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePowerBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePowerBudgetRequest) ProtoMessage() {}
+
+func (x *DeletePowerBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePowerBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeletePowerBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *DeletePowerBudgetRequest) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type DeletePowerBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        []*ShelfPowerLimit     `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePowerBudgetResponse) Reset() {
+	*x = DeletePowerBudgetResponse{}
+	mi := &file_rla_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePowerBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePowerBudgetResponse) ProtoMessage() {}
+
+func (x *DeletePowerBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePowerBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeletePowerBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeletePowerBudgetResponse) GetLimits() []*ShelfPowerLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type ListPowerBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackIds       []*UUID                `protobuf:"bytes,1,rep,name=rack_ids,json=rackIds,proto3" json:"rack_ids,omitempty"` // empty = all racks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPowerBudgetsRequest) Reset() {
+	*x = ListPowerBudgetsRequest{}
+	mi := &file_rla_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPowerBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPowerBudgetsRequest) ProtoMessage() {}
+
+func (x *ListPowerBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPowerBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListPowerBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListPowerBudgetsRequest) GetRackIds() []*UUID {
+	if x != nil {
+		return x.RackIds
+	}
+	return nil
+}
+
+type ListPowerBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*PowerBudget         `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPowerBudgetsResponse) Reset() {
+	*x = ListPowerBudgetsResponse{}
+	mi := &file_rla_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPowerBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPowerBudgetsResponse) ProtoMessage() {}
+
+func (x *ListPowerBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPowerBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListPowerBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListPowerBudgetsResponse) GetBudgets() []*PowerBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetRackPowerStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRackPowerStatusRequest) Reset() {
+	*x = GetRackPowerStatusRequest{}
+	mi := &file_rla_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRackPowerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRackPowerStatusRequest) ProtoMessage() {}
+
+func (x *GetRackPowerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRackPowerStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRackPowerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetRackPowerStatusRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+type ShelfPowerStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId       *UUID                  `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	PmcMacAddress     string                 `protobuf:"bytes,2,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
+	CapWatts          float64                `protobuf:"fixed64,3,opt,name=cap_watts,json=capWatts,proto3" json:"cap_watts,omitempty"`                                    // zero if the shelf has no cap
+	AppliedLimitWatts *float64               `protobuf:"fixed64,4,opt,name=applied_limit_watts,json=appliedLimitWatts,proto3,oneof" json:"applied_limit_watts,omitempty"` // limit reported by the shelf, absent if none
+	DrawWatts         float64                `protobuf:"fixed64,5,opt,name=draw_watts,json=drawWatts,proto3" json:"draw_watts,omitempty"`
+	Measured          bool                   `protobuf:"varint,6,opt,name=measured,proto3" json:"measured,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ShelfPowerStatus) Reset() {
+	*x = ShelfPowerStatus{}
+	mi := &file_rla_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShelfPowerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShelfPowerStatus) ProtoMessage() {}
+
+func (x *ShelfPowerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShelfPowerStatus.ProtoReflect.Descriptor instead.
+func (*ShelfPowerStatus) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ShelfPowerStatus) GetComponentId() *UUID {
+	if x != nil {
+		return x.ComponentId
+	}
+	return nil
+}
+
+func (x *ShelfPowerStatus) GetPmcMacAddress() string {
+	if x != nil {
+		return x.PmcMacAddress
+	}
+	return ""
+}
+
+func (x *ShelfPowerStatus) GetCapWatts() float64 {
+	if x != nil {
+		return x.CapWatts
+	}
+	return 0
+}
+
+func (x *ShelfPowerStatus) GetAppliedLimitWatts() float64 {
+	if x != nil && x.AppliedLimitWatts != nil {
+		return *x.AppliedLimitWatts
+	}
+	return 0
+}
+
+func (x *ShelfPowerStatus) GetDrawWatts() float64 {
+	if x != nil {
+		return x.DrawWatts
+	}
+	return 0
+}
+
+func (x *ShelfPowerStatus) GetMeasured() bool {
+	if x != nil {
+		return x.Measured
+	}
+	return false
+}
+
+type RackPowerStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	BudgetWatts   float64                `protobuf:"fixed64,2,opt,name=budget_watts,json=budgetWatts,proto3" json:"budget_watts,omitempty"` // zero if the rack has no budget
+	DrawWatts     float64                `protobuf:"fixed64,3,opt,name=draw_watts,json=drawWatts,proto3" json:"draw_watts,omitempty"`
+	Measured      bool                   `protobuf:"varint,4,opt,name=measured,proto3" json:"measured,omitempty"` // false if a shelf did not report; draw_watts is then a lower bound
+	OverBudget    bool                   `protobuf:"varint,5,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+	Shelves       []*ShelfPowerStatus    `protobuf:"bytes,6,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RackPowerStatus) Reset() {
+	*x = RackPowerStatus{}
+	mi := &file_rla_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RackPowerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RackPowerStatus) ProtoMessage() {}
+
+func (x *RackPowerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RackPowerStatus.ProtoReflect.Descriptor instead.
+func (*RackPowerStatus) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RackPowerStatus) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *RackPowerStatus) GetBudgetWatts() float64 {
+	if x != nil {
+		return x.BudgetWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStatus) GetDrawWatts() float64 {
+	if x != nil {
+		return x.DrawWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStatus) GetMeasured() bool {
+	if x != nil {
+		return x.Measured
+	}
+	return false
+}
+
+func (x *RackPowerStatus) GetOverBudget() bool {
+	if x != nil {
+		return x.OverBudget
+	}
+	return false
+}
+
+func (x *RackPowerStatus) GetShelves() []*ShelfPowerStatus {
+	if x != nil {
+		return x.Shelves
+	}
+	return nil
+}
+
+// RotateRackCredentialsRequest starts rotating the passwords of the power
+// shelves and/or NVLink switches of a rack. The rotation itself is done by
+// the Powershelf Manager and the NV-Switch Manager in the background.
+type RotateRackCredentialsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RackId         *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	ComponentTypes []ComponentType        `protobuf:"varint,2,rep,packed,name=component_types,json=componentTypes,proto3,enum=v1.ComponentType" json:"component_types,omitempty"` // POWERSHELF and/or NVLSWITCH; empty = both
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateRackCredentialsRequest) Reset() {
+	*x = RotateRackCredentialsRequest{}
+	mi := &file_rla_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateRackCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateRackCredentialsRequest) ProtoMessage() {}
+
+func (x *RotateRackCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateRackCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RotateRackCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RotateRackCredentialsRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *RotateRackCredentialsRequest) GetComponentTypes() []ComponentType {
+	if x != nil {
+		return x.ComponentTypes
+	}
+	return nil
+}
+
+type CredentialRotationTrigger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   *UUID                  `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	ComponentType ComponentType          `protobuf:"varint,2,opt,name=component_type,json=componentType,proto3,enum=v1.ComponentType" json:"component_type,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // PMC MAC of a power shelf, NV-Switch Manager UUID of a switch
+	Account       CredentialAccount      `protobuf:"varint,4,opt,name=account,proto3,enum=v1.CredentialAccount" json:"account,omitempty"`
+	Started       bool                   `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialRotationTrigger) Reset() {
+	*x = CredentialRotationTrigger{}
+	mi := &file_rla_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialRotationTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialRotationTrigger) ProtoMessage() {}
+
+func (x *CredentialRotationTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialRotationTrigger.ProtoReflect.Descriptor instead.
+func (*CredentialRotationTrigger) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *CredentialRotationTrigger) GetComponentId() *UUID {
+	if x != nil {
+		return x.ComponentId
+	}
+	return nil
+}
+
+func (x *CredentialRotationTrigger) GetComponentType() ComponentType {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *CredentialRotationTrigger) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CredentialRotationTrigger) GetAccount() CredentialAccount {
+	if x != nil {
+		return x.Account
+	}
+	return CredentialAccount_CREDENTIAL_ACCOUNT_UNKNOWN
+}
+
+func (x *CredentialRotationTrigger) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *CredentialRotationTrigger) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RotateRackCredentialsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Results       []*CredentialRotationTrigger `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateRackCredentialsResponse) Reset() {
+	*x = RotateRackCredentialsResponse{}
+	mi := &file_rla_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateRackCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateRackCredentialsResponse) ProtoMessage() {}
+
+func (x *RotateRackCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateRackCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RotateRackCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *RotateRackCredentialsResponse) GetResults() []*CredentialRotationTrigger {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetRackCredentialRotationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRackCredentialRotationStatusRequest) Reset() {
+	*x = GetRackCredentialRotationStatusRequest{}
+	mi := &file_rla_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRackCredentialRotationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRackCredentialRotationStatusRequest) ProtoMessage() {}
+
+func (x *GetRackCredentialRotationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRackCredentialRotationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRackCredentialRotationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetRackCredentialRotationStatusRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+type DeviceCredentialRotationStatus struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	ComponentId   *UUID                         `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	ComponentType ComponentType                 `protobuf:"varint,2,opt,name=component_type,json=componentType,proto3,enum=v1.ComponentType" json:"component_type,omitempty"`
+	DeviceId      string                        `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Account       CredentialAccount             `protobuf:"varint,4,opt,name=account,proto3,enum=v1.CredentialAccount" json:"account,omitempty"`
+	State         DeviceCredentialRotationState `protobuf:"varint,5,opt,name=state,proto3,enum=v1.DeviceCredentialRotationState" json:"state,omitempty"`
+	LastAttempt   *timestamppb.Timestamp        `protobuf:"bytes,6,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastRotated   *timestamppb.Timestamp        `protobuf:"bytes,7,opt,name=last_rotated,json=lastRotated,proto3" json:"last_rotated,omitempty"`
+	NextRotation  *timestamppb.Timestamp        `protobuf:"bytes,8,opt,name=next_rotation,json=nextRotation,proto3" json:"next_rotation,omitempty"`    // absent if scheduled rotation is disabled
+	RotationError string                        `protobuf:"bytes,9,opt,name=rotation_error,json=rotationError,proto3" json:"rotation_error,omitempty"` // error of the latest rotation, if it failed
+	Error         string                        `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                                     // set if the status could not be read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceCredentialRotationStatus) Reset() {
+	*x = DeviceCredentialRotationStatus{}
+	mi := &file_rla_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCredentialRotationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCredentialRotationStatus) ProtoMessage() {}
+
+func (x *DeviceCredentialRotationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCredentialRotationStatus.ProtoReflect.Descriptor instead.
+func (*DeviceCredentialRotationStatus) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DeviceCredentialRotationStatus) GetComponentId() *UUID {
+	if x != nil {
+		return x.ComponentId
+	}
+	return nil
+}
+
+func (x *DeviceCredentialRotationStatus) GetComponentType() ComponentType {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *DeviceCredentialRotationStatus) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceCredentialRotationStatus) GetAccount() CredentialAccount {
+	if x != nil {
+		return x.Account
+	}
+	return CredentialAccount_CREDENTIAL_ACCOUNT_UNKNOWN
+}
+
+func (x *DeviceCredentialRotationStatus) GetState() DeviceCredentialRotationState {
+	if x != nil {
+		return x.State
+	}
+	return DeviceCredentialRotationState_DEVICE_CREDENTIAL_ROTATION_STATE_UNKNOWN
+}
+
+func (x *DeviceCredentialRotationStatus) GetLastAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return nil
+}
+
+func (x *DeviceCredentialRotationStatus) GetLastRotated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotated
+	}
+	return nil
+}
+
+func (x *DeviceCredentialRotationStatus) GetNextRotation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRotation
+	}
+	return nil
+}
+
+func (x *DeviceCredentialRotationStatus) GetRotationError() string {
+	if x != nil {
+		return x.RotationError
+	}
+	return ""
+}
+
+func (x *DeviceCredentialRotationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetRackCredentialRotationStatusResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Statuses      []*DeviceCredentialRotationStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRackCredentialRotationStatusResponse) Reset() {
+	*x = GetRackCredentialRotationStatusResponse{}
+	mi := &file_rla_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionRequest) String() string {
+func (x *GetRackCredentialRotationStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionRequest) ProtoMessage() {}
+func (*GetRackCredentialRotationStatusResponse) ProtoMessage() {}
 
-func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[74]
+func (x *GetRackCredentialRotationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5131,35 +10984,57 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
-func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetRackCredentialRotationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRackCredentialRotationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{148}
 }
 
-type BuildInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // e.g., v2025.11.19
-	BuildTime     string                 `protobuf:"bytes,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // e.g., 2025-01-27T10:30:00Z
-	GitCommit     string                 `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"` // e.g., abc1234
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetRackCredentialRotationStatusResponse) GetStatuses() []*DeviceCredentialRotationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
 }
 
-func (x *BuildInfo) Reset() {
-	*x = BuildInfo{}
-	mi := &file_rla_proto_msgTypes[75]
+// DiscoveredDevice is a power shelf PMC or NVLink switch BMC found by
+// sweeping the management subnets, fingerprinted from its Redfish Chassis and
+// Manager data.
+type DiscoveredDevice struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MacAddress      string                 `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"` // BMC/PMC MAC the device is registered under
+	IpAddress       string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Type            ComponentType          `protobuf:"varint,4,opt,name=type,proto3,enum=v1.ComponentType" json:"type,omitempty"`
+	Manufacturer    string                 `protobuf:"bytes,5,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Model           string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	SerialNumber    string                 `protobuf:"bytes,7,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	FirmwareVersion string                 `protobuf:"bytes,8,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+	NvosMacAddress  *string                `protobuf:"bytes,9,opt,name=nvos_mac_address,json=nvosMacAddress,proto3,oneof" json:"nvos_mac_address,omitempty"` // NVLink switches only, if known
+	NvosIpAddress   *string                `protobuf:"bytes,10,opt,name=nvos_ip_address,json=nvosIpAddress,proto3,oneof" json:"nvos_ip_address,omitempty"`
+	ComponentId     *UUID                  `protobuf:"bytes,11,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"` // matched expected component, absent if unmatched
+	State           DiscoveredDeviceState  `protobuf:"varint,12,opt,name=state,proto3,enum=v1.DiscoveredDeviceState" json:"state,omitempty"`
+	Message         string                 `protobuf:"bytes,13,opt,name=message,proto3" json:"message,omitempty"` // reason for the state, e.g. the registration error
+	FirstSeenAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiscoveredDevice) Reset() {
+	*x = DiscoveredDevice{}
+	mi := &file_rla_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildInfo) String() string {
+func (x *DiscoveredDevice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildInfo) ProtoMessage() {}
+func (*DiscoveredDevice) ProtoMessage() {}
 
-func (x *BuildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[75]
+func (x *DiscoveredDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5170,62 +11045,273 @@ func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
-func (*BuildInfo) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use DiscoveredDevice.ProtoReflect.Descriptor instead.
+func (*DiscoveredDevice) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *BuildInfo) GetVersion() string {
+func (x *DiscoveredDevice) GetId() *UUID {
 	if x != nil {
-		return x.Version
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DiscoveredDevice) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
 	}
 	return ""
 }
 
-func (x *BuildInfo) GetBuildTime() string {
+func (x *DiscoveredDevice) GetIpAddress() string {
 	if x != nil {
-		return x.BuildTime
+		return x.IpAddress
 	}
 	return ""
 }
 
-func (x *BuildInfo) GetGitCommit() string {
+func (x *DiscoveredDevice) GetType() ComponentType {
 	if x != nil {
-		return x.GitCommit
+		return x.Type
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *DiscoveredDevice) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
 	}
 	return ""
 }
 
-type OperationRule struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	OperationType      OperationType          `protobuf:"varint,4,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode      string                 `protobuf:"bytes,5,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
-	RuleDefinitionJson string                 `protobuf:"bytes,6,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
-	IsDefault          bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *DiscoveredDevice) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DiscoveredDevice) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DiscoveredDevice) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *DiscoveredDevice) GetNvosMacAddress() string {
+	if x != nil && x.NvosMacAddress != nil {
+		return *x.NvosMacAddress
+	}
+	return ""
+}
+
+func (x *DiscoveredDevice) GetNvosIpAddress() string {
+	if x != nil && x.NvosIpAddress != nil {
+		return *x.NvosIpAddress
+	}
+	return ""
+}
+
+func (x *DiscoveredDevice) GetComponentId() *UUID {
+	if x != nil {
+		return x.ComponentId
+	}
+	return nil
+}
+
+func (x *DiscoveredDevice) GetState() DiscoveredDeviceState {
+	if x != nil {
+		return x.State
+	}
+	return DiscoveredDeviceState_DISCOVERED_DEVICE_STATE_UNKNOWN
+}
+
+func (x *DiscoveredDevice) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DiscoveredDevice) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
+func (x *DiscoveredDevice) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+// DiscoverDevicesRequest sweeps the given subnets, or the configured ones if
+// empty, and returns the devices found. Matched devices are registered or
+// held for approval depending on the auto-registration setting.
+type DiscoverDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subnets       []string               `protobuf:"bytes,1,rep,name=subnets,proto3" json:"subnets,omitempty"` // CIDR notation, e.g. 10.10.4.0/24
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverDevicesRequest) Reset() {
+	*x = DiscoverDevicesRequest{}
+	mi := &file_rla_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverDevicesRequest) ProtoMessage() {}
+
+func (x *DiscoverDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverDevicesRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *DiscoverDevicesRequest) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+type DiscoverDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*DiscoveredDevice    `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverDevicesResponse) Reset() {
+	*x = DiscoverDevicesResponse{}
+	mi := &file_rla_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverDevicesResponse) ProtoMessage() {}
+
+func (x *DiscoverDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverDevicesResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *DiscoverDevicesResponse) GetDevices() []*DiscoveredDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type ListDiscoveredDevicesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	States        []DiscoveredDeviceState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=v1.DiscoveredDeviceState" json:"states,omitempty"` // empty = all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscoveredDevicesRequest) Reset() {
+	*x = ListDiscoveredDevicesRequest{}
+	mi := &file_rla_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscoveredDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscoveredDevicesRequest) ProtoMessage() {}
+
+func (x *ListDiscoveredDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscoveredDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDiscoveredDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListDiscoveredDevicesRequest) GetStates() []DiscoveredDeviceState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListDiscoveredDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*DiscoveredDevice    `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationRule) Reset() {
-	*x = OperationRule{}
-	mi := &file_rla_proto_msgTypes[76]
+func (x *ListDiscoveredDevicesResponse) Reset() {
+	*x = ListDiscoveredDevicesResponse{}
+	mi := &file_rla_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationRule) String() string {
+func (x *ListDiscoveredDevicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRule) ProtoMessage() {}
+func (*ListDiscoveredDevicesResponse) ProtoMessage() {}
 
-func (x *OperationRule) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[76]
+func (x *ListDiscoveredDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5236,101 +11322,111 @@ func (x *OperationRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRule.ProtoReflect.Descriptor instead.
-func (*OperationRule) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ListDiscoveredDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDiscoveredDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *OperationRule) GetId() *UUID {
+func (x *ListDiscoveredDevicesResponse) GetDevices() []*DiscoveredDevice {
 	if x != nil {
-		return x.Id
+		return x.Devices
 	}
 	return nil
 }
 
-func (x *OperationRule) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// ApproveDiscoveredDeviceRequest registers a discovered device with the
+// Powershelf Manager or the NV-Switch Manager and records its BMC on the
+// expected component.
+type ApproveDiscoveredDeviceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ComponentId    *UUID                  `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`                  // bind to this expected component instead of the serial number match
+	NvosMacAddress *string                `protobuf:"bytes,3,opt,name=nvos_mac_address,json=nvosMacAddress,proto3,oneof" json:"nvos_mac_address,omitempty"` // NVLink switches whose BMC does not report the NVOS port
+	NvosIpAddress  *string                `protobuf:"bytes,4,opt,name=nvos_ip_address,json=nvosIpAddress,proto3,oneof" json:"nvos_ip_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *OperationRule) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ApproveDiscoveredDeviceRequest) Reset() {
+	*x = ApproveDiscoveredDeviceRequest{}
+	mi := &file_rla_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationRule) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+func (x *ApproveDiscoveredDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OperationRule) GetOperationCode() string {
+func (*ApproveDiscoveredDeviceRequest) ProtoMessage() {}
+
+func (x *ApproveDiscoveredDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[154]
 	if x != nil {
-		return x.OperationCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OperationRule) GetRuleDefinitionJson() string {
-	if x != nil {
-		return x.RuleDefinitionJson
-	}
-	return ""
+// Deprecated: Use ApproveDiscoveredDeviceRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDiscoveredDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *OperationRule) GetIsDefault() bool {
+func (x *ApproveDiscoveredDeviceRequest) GetId() *UUID {
 	if x != nil {
-		return x.IsDefault
+		return x.Id
 	}
-	return false
+	return nil
 }
 
-func (x *OperationRule) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ApproveDiscoveredDeviceRequest) GetComponentId() *UUID {
 	if x != nil {
-		return x.CreatedAt
+		return x.ComponentId
 	}
 	return nil
 }
 
-func (x *OperationRule) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
+func (x *ApproveDiscoveredDeviceRequest) GetNvosMacAddress() string {
+	if x != nil && x.NvosMacAddress != nil {
+		return *x.NvosMacAddress
 	}
-	return nil
+	return ""
 }
 
-type CreateOperationRuleRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	OperationType      OperationType          `protobuf:"varint,3,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode      string                 `protobuf:"bytes,4,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
-	RuleDefinitionJson string                 `protobuf:"bytes,5,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
-	IsDefault          bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ApproveDiscoveredDeviceRequest) GetNvosIpAddress() string {
+	if x != nil && x.NvosIpAddress != nil {
+		return *x.NvosIpAddress
+	}
+	return ""
 }
 
-func (x *CreateOperationRuleRequest) Reset() {
-	*x = CreateOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[77]
+type RejectDiscoveredDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectDiscoveredDeviceRequest) Reset() {
+	*x = RejectDiscoveredDeviceRequest{}
+	mi := &file_rla_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOperationRuleRequest) String() string {
+func (x *RejectDiscoveredDeviceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOperationRuleRequest) ProtoMessage() {}
+func (*RejectDiscoveredDeviceRequest) ProtoMessage() {}
 
-func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[77]
+func (x *RejectDiscoveredDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5341,75 +11437,90 @@ func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*CreateOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use RejectDiscoveredDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RejectDiscoveredDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *CreateOperationRuleRequest) GetName() string {
+func (x *RejectDiscoveredDeviceRequest) GetId() *UUID {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOperationRuleRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type IdentifierList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*Identifier          `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOperationRuleRequest) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+func (x *IdentifierList) Reset() {
+	*x = IdentifierList{}
+	mi := &file_rla_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOperationRuleRequest) GetOperationCode() string {
-	if x != nil {
-		return x.OperationCode
-	}
-	return ""
+func (x *IdentifierList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateOperationRuleRequest) GetRuleDefinitionJson() string {
+func (*IdentifierList) ProtoMessage() {}
+
+func (x *IdentifierList) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[156]
 	if x != nil {
-		return x.RuleDefinitionJson
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOperationRuleRequest) GetIsDefault() bool {
+// Deprecated: Use IdentifierList.ProtoReflect.Descriptor instead.
+func (*IdentifierList) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *IdentifierList) GetIdentifiers() []*Identifier {
 	if x != nil {
-		return x.IsDefault
+		return x.Identifiers
 	}
-	return false
+	return nil
 }
 
-type CreateOperationRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// CampaignRackSelector chooses the racks of a campaign.
+type CampaignRackSelector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Selector:
+	//
+	//	*CampaignRackSelector_Racks
+	//	*CampaignRackSelector_NvlDomains
+	//	*CampaignRackSelector_Location
+	Selector      isCampaignRackSelector_Selector `protobuf_oneof:"selector"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOperationRuleResponse) Reset() {
-	*x = CreateOperationRuleResponse{}
-	mi := &file_rla_proto_msgTypes[78]
+func (x *CampaignRackSelector) Reset() {
+	*x = CampaignRackSelector{}
+	mi := &file_rla_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOperationRuleResponse) String() string {
+func (x *CampaignRackSelector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOperationRuleResponse) ProtoMessage() {}
+func (*CampaignRackSelector) ProtoMessage() {}
 
-func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[78]
+func (x *CampaignRackSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5420,43 +11531,93 @@ func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOperationRuleResponse.ProtoReflect.Descriptor instead.
-func (*CreateOperationRuleResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use CampaignRackSelector.ProtoReflect.Descriptor instead.
+func (*CampaignRackSelector) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *CreateOperationRuleResponse) GetId() *UUID {
+func (x *CampaignRackSelector) GetSelector() isCampaignRackSelector_Selector {
 	if x != nil {
-		return x.Id
+		return x.Selector
 	}
 	return nil
 }
 
-type UpdateOperationRuleRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	RuleId             *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	Name               *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description        *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	RuleDefinitionJson *string                `protobuf:"bytes,4,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3,oneof" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *CampaignRackSelector) GetRacks() *IdentifierList {
+	if x != nil {
+		if x, ok := x.Selector.(*CampaignRackSelector_Racks); ok {
+			return x.Racks
+		}
+	}
+	return nil
 }
 
-func (x *UpdateOperationRuleRequest) Reset() {
-	*x = UpdateOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[79]
+func (x *CampaignRackSelector) GetNvlDomains() *IdentifierList {
+	if x != nil {
+		if x, ok := x.Selector.(*CampaignRackSelector_NvlDomains); ok {
+			return x.NvlDomains
+		}
+	}
+	return nil
+}
+
+func (x *CampaignRackSelector) GetLocation() *Location {
+	if x != nil {
+		if x, ok := x.Selector.(*CampaignRackSelector_Location); ok {
+			return x.Location
+		}
+	}
+	return nil
+}
+
+type isCampaignRackSelector_Selector interface {
+	isCampaignRackSelector_Selector()
+}
+
+type CampaignRackSelector_Racks struct {
+	Racks *IdentifierList `protobuf:"bytes,1,opt,name=racks,proto3,oneof"` // racks by ID or name, rolled out in this order
+}
+
+type CampaignRackSelector_NvlDomains struct {
+	NvlDomains *IdentifierList `protobuf:"bytes,2,opt,name=nvl_domains,json=nvlDomains,proto3,oneof"` // every rack of these NVL domains
+}
+
+type CampaignRackSelector_Location struct {
+	Location *Location `protobuf:"bytes,3,opt,name=location,proto3,oneof"` // racks matching every non-empty field; position is ignored
+}
+
+func (*CampaignRackSelector_Racks) isCampaignRackSelector_Selector() {}
+
+func (*CampaignRackSelector_NvlDomains) isCampaignRackSelector_Selector() {}
+
+func (*CampaignRackSelector_Location) isCampaignRackSelector_Selector() {}
+
+// CampaignPolicy controls how a campaign moves through its racks. A batch is
+// started, and the next one starts only once every rack of it has finished.
+type CampaignPolicy struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxConcurrentRacks   int32                  `protobuf:"varint,1,opt,name=max_concurrent_racks,json=maxConcurrentRacks,proto3" json:"max_concurrent_racks,omitempty"`           // racks per batch, at least 1
+	MaxRacksPerNvlDomain int32                  `protobuf:"varint,2,opt,name=max_racks_per_nvl_domain,json=maxRacksPerNvlDomain,proto3" json:"max_racks_per_nvl_domain,omitempty"` // racks of one NVL domain per batch; 0 = no limit
+	MinSuccessRate       float64                `protobuf:"fixed64,3,opt,name=min_success_rate,json=minSuccessRate,proto3" json:"min_success_rate,omitempty"`                      // share of a batch that must succeed, 0-1; 0 = no gate
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CampaignPolicy) Reset() {
+	*x = CampaignPolicy{}
+	mi := &file_rla_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOperationRuleRequest) String() string {
+func (x *CampaignPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOperationRuleRequest) ProtoMessage() {}
+func (*CampaignPolicy) ProtoMessage() {}
 
-func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[79]
+func (x *CampaignPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5467,61 +11628,60 @@ func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use CampaignPolicy.ProtoReflect.Descriptor instead.
+func (*CampaignPolicy) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *UpdateOperationRuleRequest) GetRuleId() *UUID {
+func (x *CampaignPolicy) GetMaxConcurrentRacks() int32 {
 	if x != nil {
-		return x.RuleId
-	}
-	return nil
-}
-
-func (x *UpdateOperationRuleRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.MaxConcurrentRacks
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateOperationRuleRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *CampaignPolicy) GetMaxRacksPerNvlDomain() int32 {
+	if x != nil {
+		return x.MaxRacksPerNvlDomain
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateOperationRuleRequest) GetRuleDefinitionJson() string {
-	if x != nil && x.RuleDefinitionJson != nil {
-		return *x.RuleDefinitionJson
+func (x *CampaignPolicy) GetMinSuccessRate() float64 {
+	if x != nil {
+		return x.MinSuccessRate
 	}
-	return ""
+	return 0
 }
 
-type DeleteOperationRuleRequest struct {
+type CampaignProgress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Pending       int32                  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Running       int32                  `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       int32                  `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Cancelled     int32                  `protobuf:"varint,7,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOperationRuleRequest) Reset() {
-	*x = DeleteOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[80]
+func (x *CampaignProgress) Reset() {
+	*x = CampaignProgress{}
+	mi := &file_rla_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOperationRuleRequest) String() string {
+func (x *CampaignProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOperationRuleRequest) ProtoMessage() {}
+func (*CampaignProgress) ProtoMessage() {}
 
-func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[80]
+func (x *CampaignProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5529,43 +11689,94 @@ func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignProgress.ProtoReflect.Descriptor instead.
+func (*CampaignProgress) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CampaignProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CampaignProgress) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *CampaignProgress) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *CampaignProgress) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *CampaignProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
 }
 
-// Deprecated: Use DeleteOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{80}
+func (x *CampaignProgress) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
 }
 
-func (x *DeleteOperationRuleRequest) GetRuleId() *UUID {
+func (x *CampaignProgress) GetCancelled() int32 {
 	if x != nil {
-		return x.RuleId
+		return x.Cancelled
 	}
-	return nil
+	return 0
 }
 
-type SetRuleAsDefaultRequest struct {
+// CampaignRack is one rack of a campaign and the task that ran on it.
+type CampaignRack struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RackName      string                 `protobuf:"bytes,2,opt,name=rack_name,json=rackName,proto3" json:"rack_name,omitempty"`
+	NvlDomainId   *UUID                  `protobuf:"bytes,3,opt,name=nvl_domain_id,json=nvlDomainId,proto3" json:"nvl_domain_id,omitempty"` // absent if the rack is in no NVL domain
+	Batch         int32                  `protobuf:"varint,4,opt,name=batch,proto3" json:"batch,omitempty"`                                 // 0 until started
+	TaskId        *UUID                  `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                  // absent until started
+	State         CampaignRackState      `protobuf:"varint,6,opt,name=state,proto3,enum=v1.CampaignRackState" json:"state,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetRuleAsDefaultRequest) Reset() {
-	*x = SetRuleAsDefaultRequest{}
-	mi := &file_rla_proto_msgTypes[81]
+func (x *CampaignRack) Reset() {
+	*x = CampaignRack{}
+	mi := &file_rla_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetRuleAsDefaultRequest) String() string {
+func (x *CampaignRack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRuleAsDefaultRequest) ProtoMessage() {}
+func (*CampaignRack) ProtoMessage() {}
 
-func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[81]
+func (x *CampaignRack) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5576,87 +11787,112 @@ func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRuleAsDefaultRequest.ProtoReflect.Descriptor instead.
-func (*SetRuleAsDefaultRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use CampaignRack.ProtoReflect.Descriptor instead.
+func (*CampaignRack) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *SetRuleAsDefaultRequest) GetRuleId() *UUID {
+func (x *CampaignRack) GetRackId() *UUID {
 	if x != nil {
-		return x.RuleId
+		return x.RackId
 	}
 	return nil
 }
 
-type GetOperationRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CampaignRack) GetRackName() string {
+	if x != nil {
+		return x.RackName
+	}
+	return ""
 }
 
-func (x *GetOperationRuleRequest) Reset() {
-	*x = GetOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[82]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CampaignRack) GetNvlDomainId() *UUID {
+	if x != nil {
+		return x.NvlDomainId
+	}
+	return nil
 }
 
-func (x *GetOperationRuleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CampaignRack) GetBatch() int32 {
+	if x != nil {
+		return x.Batch
+	}
+	return 0
 }
 
-func (*GetOperationRuleRequest) ProtoMessage() {}
+func (x *CampaignRack) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
 
-func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[82]
+func (x *CampaignRack) GetState() CampaignRackState {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return CampaignRackState_CAMPAIGN_RACK_STATE_UNKNOWN
 }
 
-// Deprecated: Use GetOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*GetOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{82}
+func (x *CampaignRack) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *GetOperationRuleRequest) GetRuleId() *UUID {
+func (x *CampaignRack) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RuleId
+		return x.StartedAt
 	}
 	return nil
 }
 
-type ListOperationRulesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperationType *OperationType         `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType,oneof" json:"operation_type,omitempty"`
-	IsDefault     *bool                  `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty"`
-	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CampaignRack) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
 }
 
-func (x *ListOperationRulesRequest) Reset() {
-	*x = ListOperationRulesRequest{}
-	mi := &file_rla_proto_msgTypes[83]
+// Campaign rolls one operation out across many racks. Its progress is
+// aggregated from the per-rack tasks.
+type Campaign struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OperationType        string                 `protobuf:"bytes,4,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`                      // e.g. "UPGRADE_FIRMWARE"
+	OperationDescription string                 `protobuf:"bytes,5,opt,name=operation_description,json=operationDescription,proto3" json:"operation_description,omitempty"` // e.g. "Upgrade Firmware to v2.1.0"
+	ComponentTypes       []ComponentType        `protobuf:"varint,6,rep,packed,name=component_types,json=componentTypes,proto3,enum=v1.ComponentType" json:"component_types,omitempty"`
+	Policy               *CampaignPolicy        `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
+	State                CampaignState          `protobuf:"varint,8,opt,name=state,proto3,enum=v1.CampaignState" json:"state,omitempty"`
+	Message              string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"` // reason for the state, e.g. the failed gate
+	CurrentBatch         int32                  `protobuf:"varint,10,opt,name=current_batch,json=currentBatch,proto3" json:"current_batch,omitempty"`
+	Progress             *CampaignProgress      `protobuf:"bytes,11,opt,name=progress,proto3" json:"progress,omitempty"`
+	Racks                []*CampaignRack        `protobuf:"bytes,12,rep,name=racks,proto3" json:"racks,omitempty"` // rollout order; not set by ListCampaigns
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	mi := &file_rla_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOperationRulesRequest) String() string {
+func (x *Campaign) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOperationRulesRequest) ProtoMessage() {}
+func (*Campaign) ProtoMessage() {}
 
-func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[83]
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5667,167 +11903,146 @@ func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOperationRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListOperationRulesRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ListOperationRulesRequest) GetOperationType() OperationType {
-	if x != nil && x.OperationType != nil {
-		return *x.OperationType
+func (x *Campaign) GetId() *UUID {
+	if x != nil {
+		return x.Id
 	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *ListOperationRulesRequest) GetIsDefault() bool {
-	if x != nil && x.IsDefault != nil {
-		return *x.IsDefault
+func (x *Campaign) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *ListOperationRulesRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+func (x *Campaign) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOperationRulesRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *Campaign) GetOperationType() string {
+	if x != nil {
+		return x.OperationType
 	}
-	return 0
-}
-
-type ListOperationRulesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*OperationRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListOperationRulesResponse) Reset() {
-	*x = ListOperationRulesResponse{}
-	mi := &file_rla_proto_msgTypes[84]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListOperationRulesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListOperationRulesResponse) ProtoMessage() {}
-
-func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[84]
+func (x *Campaign) GetOperationDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OperationDescription
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListOperationRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListOperationRulesResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{84}
+func (x *Campaign) GetComponentTypes() []ComponentType {
+	if x != nil {
+		return x.ComponentTypes
+	}
+	return nil
 }
 
-func (x *ListOperationRulesResponse) GetRules() []*OperationRule {
+func (x *Campaign) GetPolicy() *CampaignPolicy {
 	if x != nil {
-		return x.Rules
+		return x.Policy
 	}
 	return nil
 }
 
-func (x *ListOperationRulesResponse) GetTotalCount() int32 {
+func (x *Campaign) GetState() CampaignState {
 	if x != nil {
-		return x.TotalCount
+		return x.State
 	}
-	return 0
+	return CampaignState_CAMPAIGN_STATE_UNKNOWN
 }
 
-type AssociateRuleWithRackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	RuleId        *UUID                  `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Campaign) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *AssociateRuleWithRackRequest) Reset() {
-	*x = AssociateRuleWithRackRequest{}
-	mi := &file_rla_proto_msgTypes[85]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Campaign) GetCurrentBatch() int32 {
+	if x != nil {
+		return x.CurrentBatch
+	}
+	return 0
 }
 
-func (x *AssociateRuleWithRackRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Campaign) GetProgress() *CampaignProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
 }
 
-func (*AssociateRuleWithRackRequest) ProtoMessage() {}
-
-func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[85]
+func (x *Campaign) GetRacks() []*CampaignRack {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Racks
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AssociateRuleWithRackRequest.ProtoReflect.Descriptor instead.
-func (*AssociateRuleWithRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{85}
+func (x *Campaign) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *AssociateRuleWithRackRequest) GetRackId() *UUID {
+func (x *Campaign) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RackId
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *AssociateRuleWithRackRequest) GetRuleId() *UUID {
+func (x *Campaign) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RuleId
+		return x.FinishedAt
 	}
 	return nil
 }
 
-type DisassociateRuleFromRackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CreateCampaignRequest creates a campaign and starts its first batch. The
+// operation's target_spec must be unset: the selector chooses the racks, and
+// component_types restricts the operation in every rack (empty = all).
+type CreateCampaignRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Operation      *ScheduledOperation    `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Racks          *CampaignRackSelector  `protobuf:"bytes,4,opt,name=racks,proto3" json:"racks,omitempty"`
+	ComponentTypes []ComponentType        `protobuf:"varint,5,rep,packed,name=component_types,json=componentTypes,proto3,enum=v1.ComponentType" json:"component_types,omitempty"`
+	Policy         *CampaignPolicy        `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DisassociateRuleFromRackRequest) Reset() {
-	*x = DisassociateRuleFromRackRequest{}
-	mi := &file_rla_proto_msgTypes[86]
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_rla_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisassociateRuleFromRackRequest) String() string {
+func (x *CreateCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateRuleFromRackRequest) ProtoMessage() {}
+func (*CreateCampaignRequest) ProtoMessage() {}
 
-func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[86]
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5838,56 +12053,75 @@ func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateRuleFromRackRequest.ProtoReflect.Descriptor instead.
-func (*DisassociateRuleFromRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *DisassociateRuleFromRackRequest) GetRackId() *UUID {
+func (x *CreateCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetOperation() *ScheduledOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetRacks() *CampaignRackSelector {
 	if x != nil {
-		return x.RackId
+		return x.Racks
 	}
 	return nil
 }
 
-func (x *DisassociateRuleFromRackRequest) GetOperationType() OperationType {
+func (x *CreateCampaignRequest) GetComponentTypes() []ComponentType {
 	if x != nil {
-		return x.OperationType
+		return x.ComponentTypes
 	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *DisassociateRuleFromRackRequest) GetOperationCode() string {
+func (x *CreateCampaignRequest) GetPolicy() *CampaignPolicy {
 	if x != nil {
-		return x.OperationCode
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-type GetRackRuleAssociationRequest struct {
+type GetCampaignRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRackRuleAssociationRequest) Reset() {
-	*x = GetRackRuleAssociationRequest{}
-	mi := &file_rla_proto_msgTypes[87]
+func (x *GetCampaignRequest) Reset() {
+	*x = GetCampaignRequest{}
+	mi := &file_rla_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRackRuleAssociationRequest) String() string {
+func (x *GetCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRackRuleAssociationRequest) ProtoMessage() {}
+func (*GetCampaignRequest) ProtoMessage() {}
 
-func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[87]
+func (x *GetCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5898,54 +12132,40 @@ func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRackRuleAssociationRequest.ProtoReflect.Descriptor instead.
-func (*GetRackRuleAssociationRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use GetCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *GetRackRuleAssociationRequest) GetRackId() *UUID {
+func (x *GetCampaignRequest) GetId() *UUID {
 	if x != nil {
-		return x.RackId
+		return x.Id
 	}
 	return nil
 }
 
-func (x *GetRackRuleAssociationRequest) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
-}
-
-func (x *GetRackRuleAssociationRequest) GetOperationCode() string {
-	if x != nil {
-		return x.OperationCode
-	}
-	return ""
-}
-
-type GetRackRuleAssociationResponse struct {
+type ListCampaignsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Empty if no association exists
+	States        []CampaignState        `protobuf:"varint,1,rep,packed,name=states,proto3,enum=v1.CampaignState" json:"states,omitempty"` // empty = all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRackRuleAssociationResponse) Reset() {
-	*x = GetRackRuleAssociationResponse{}
-	mi := &file_rla_proto_msgTypes[88]
+func (x *ListCampaignsRequest) Reset() {
+	*x = ListCampaignsRequest{}
+	mi := &file_rla_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRackRuleAssociationResponse) String() string {
+func (x *ListCampaignsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRackRuleAssociationResponse) ProtoMessage() {}
+func (*ListCampaignsRequest) ProtoMessage() {}
 
-func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[88]
+func (x *ListCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5956,40 +12176,40 @@ func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRackRuleAssociationResponse.ProtoReflect.Descriptor instead.
-func (*GetRackRuleAssociationResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ListCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *GetRackRuleAssociationResponse) GetRuleId() *UUID {
+func (x *ListCampaignsRequest) GetStates() []CampaignState {
 	if x != nil {
-		return x.RuleId
+		return x.States
 	}
 	return nil
 }
 
-type ListRackRuleAssociationsRequest struct {
+type ListCampaignsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	Campaigns     []*Campaign            `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRackRuleAssociationsRequest) Reset() {
-	*x = ListRackRuleAssociationsRequest{}
-	mi := &file_rla_proto_msgTypes[89]
+func (x *ListCampaignsResponse) Reset() {
+	*x = ListCampaignsResponse{}
+	mi := &file_rla_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRackRuleAssociationsRequest) String() string {
+func (x *ListCampaignsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRackRuleAssociationsRequest) ProtoMessage() {}
+func (*ListCampaignsResponse) ProtoMessage() {}
 
-func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[89]
+func (x *ListCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6000,45 +12220,40 @@ func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRackRuleAssociationsRequest.ProtoReflect.Descriptor instead.
-func (*ListRackRuleAssociationsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ListCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ListRackRuleAssociationsRequest) GetRackId() *UUID {
+func (x *ListCampaignsResponse) GetCampaigns() []*Campaign {
 	if x != nil {
-		return x.RackId
+		return x.Campaigns
 	}
 	return nil
 }
 
-type RackRuleAssociation struct {
+type PauseCampaignRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code (e.g., "power_on", "upgrade")
-	RuleId        *UUID                  `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RackRuleAssociation) Reset() {
-	*x = RackRuleAssociation{}
-	mi := &file_rla_proto_msgTypes[90]
+func (x *PauseCampaignRequest) Reset() {
+	*x = PauseCampaignRequest{}
+	mi := &file_rla_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RackRuleAssociation) String() string {
+func (x *PauseCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RackRuleAssociation) ProtoMessage() {}
+func (*PauseCampaignRequest) ProtoMessage() {}
 
-func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[90]
+func (x *PauseCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6049,75 +12264,84 @@ func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RackRuleAssociation.ProtoReflect.Descriptor instead.
-func (*RackRuleAssociation) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use PauseCampaignRequest.ProtoReflect.Descriptor instead.
+func (*PauseCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *RackRuleAssociation) GetRackId() *UUID {
+func (x *PauseCampaignRequest) GetId() *UUID {
 	if x != nil {
-		return x.RackId
+		return x.Id
 	}
 	return nil
 }
 
-func (x *RackRuleAssociation) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+type ResumeCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RackRuleAssociation) GetOperationCode() string {
-	if x != nil {
-		return x.OperationCode
-	}
-	return ""
+func (x *ResumeCampaignRequest) Reset() {
+	*x = ResumeCampaignRequest{}
+	mi := &file_rla_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RackRuleAssociation) GetRuleId() *UUID {
-	if x != nil {
-		return x.RuleId
-	}
-	return nil
+func (x *ResumeCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RackRuleAssociation) GetCreatedAt() *timestamppb.Timestamp {
+func (*ResumeCampaignRequest) ProtoMessage() {}
+
+func (x *ResumeCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[167]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RackRuleAssociation) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ResumeCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ResumeCampaignRequest) GetId() *UUID {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Id
 	}
 	return nil
 }
 
-type ListRackRuleAssociationsResponse struct {
+type AbortCampaignRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Associations  []*RackRuleAssociation `protobuf:"bytes,1,rep,name=associations,proto3" json:"associations,omitempty"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRackRuleAssociationsResponse) Reset() {
-	*x = ListRackRuleAssociationsResponse{}
-	mi := &file_rla_proto_msgTypes[91]
+func (x *AbortCampaignRequest) Reset() {
+	*x = AbortCampaignRequest{}
+	mi := &file_rla_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRackRuleAssociationsResponse) String() string {
+func (x *AbortCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRackRuleAssociationsResponse) ProtoMessage() {}
+func (*AbortCampaignRequest) ProtoMessage() {}
 
-func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[91]
+func (x *AbortCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6128,44 +12352,42 @@ func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRackRuleAssociationsResponse.ProtoReflect.Descriptor instead.
-func (*ListRackRuleAssociationsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use AbortCampaignRequest.ProtoReflect.Descriptor instead.
+func (*AbortCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *ListRackRuleAssociationsResponse) GetAssociations() []*RackRuleAssociation {
+func (x *AbortCampaignRequest) GetId() *UUID {
 	if x != nil {
-		return x.Associations
+		return x.Id
 	}
 	return nil
 }
 
-type ScheduleSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Type  ScheduleSpecType       `protobuf:"varint,1,opt,name=type,proto3,enum=v1.ScheduleSpecType" json:"type,omitempty"`
-	Spec  string                 `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	// IANA timezone for interpreting cron specs (e.g. "America/New_York").
-	// Defaults to "UTC". Ignored for interval and one-time specs.
-	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+// MaintenanceOnce is a single occurrence.
+type MaintenanceOnce struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduleSpec) Reset() {
-	*x = ScheduleSpec{}
-	mi := &file_rla_proto_msgTypes[92]
+func (x *MaintenanceOnce) Reset() {
+	*x = MaintenanceOnce{}
+	mi := &file_rla_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduleSpec) String() string {
+func (x *MaintenanceOnce) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduleSpec) ProtoMessage() {}
+func (*MaintenanceOnce) ProtoMessage() {}
 
-func (x *ScheduleSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[92]
+func (x *MaintenanceOnce) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6176,57 +12398,50 @@ func (x *ScheduleSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduleSpec.ProtoReflect.Descriptor instead.
-func (*ScheduleSpec) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{92}
-}
-
-func (x *ScheduleSpec) GetType() ScheduleSpecType {
-	if x != nil {
-		return x.Type
-	}
-	return ScheduleSpecType_SCHEDULE_SPEC_TYPE_UNSPECIFIED
+// Deprecated: Use MaintenanceOnce.ProtoReflect.Descriptor instead.
+func (*MaintenanceOnce) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *ScheduleSpec) GetSpec() string {
+func (x *MaintenanceOnce) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Spec
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ScheduleSpec) GetTimezone() string {
+func (x *MaintenanceOnce) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timezone
+		return x.EndTime
 	}
-	return ""
+	return nil
 }
 
-// ScheduleConfig groups the scheduling fields shared by multiple request types.
-type ScheduleConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Spec          *ScheduleSpec          `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	OverlapPolicy OverlapPolicy          `protobuf:"varint,3,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=v1.OverlapPolicy" json:"overlap_policy,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// MaintenanceRecurrence repeats an occurrence on a cron schedule.
+type MaintenanceRecurrence struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Cron            string                 `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`                                               // 5-field cron spec of every start
+	DurationSeconds int32                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // length of every occurrence, at least 60
+	Timezone        string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                                       // IANA timezone of the cron spec; empty = UTC
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ScheduleConfig) Reset() {
-	*x = ScheduleConfig{}
-	mi := &file_rla_proto_msgTypes[93]
+func (x *MaintenanceRecurrence) Reset() {
+	*x = MaintenanceRecurrence{}
+	mi := &file_rla_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduleConfig) String() string {
+func (x *MaintenanceRecurrence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduleConfig) ProtoMessage() {}
+func (*MaintenanceRecurrence) ProtoMessage() {}
 
-func (x *ScheduleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[93]
+func (x *MaintenanceRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6237,73 +12452,69 @@ func (x *ScheduleConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduleConfig.ProtoReflect.Descriptor instead.
-func (*ScheduleConfig) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use MaintenanceRecurrence.ProtoReflect.Descriptor instead.
+func (*MaintenanceRecurrence) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *ScheduleConfig) GetName() string {
+func (x *MaintenanceRecurrence) GetCron() string {
 	if x != nil {
-		return x.Name
+		return x.Cron
 	}
 	return ""
 }
 
-func (x *ScheduleConfig) GetSpec() *ScheduleSpec {
+func (x *MaintenanceRecurrence) GetDurationSeconds() int32 {
 	if x != nil {
-		return x.Spec
+		return x.DurationSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *ScheduleConfig) GetOverlapPolicy() OverlapPolicy {
+func (x *MaintenanceRecurrence) GetTimezone() string {
 	if x != nil {
-		return x.OverlapPolicy
+		return x.Timezone
 	}
-	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+	return ""
 }
 
-// TaskSchedule defines when (spec) and what (operation) should run automatically.
-// Which racks to target is tracked separately in TaskScheduleScope rows and
-// managed via AddTaskScheduleScope / RemoveTaskScheduleScope / ListTaskScheduleScopes.
-type TaskSchedule struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // unique, human-readable identifier
-	Spec          *ScheduleSpec          `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"` // when to fire (interval, cron, or one-time)
-	OverlapPolicy OverlapPolicy          `protobuf:"varint,4,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=v1.OverlapPolicy" json:"overlap_policy,omitempty"`
-	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`                             // false = paused (will not fire)
-	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_at,json=nextRunAt,proto3,oneof" json:"next_run_at,omitempty"` // absent for disabled or fully-fired one-time schedules
-	LastRunAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_run_at,json=lastRunAt,proto3,oneof" json:"last_run_at,omitempty"` // absent if the schedule has never fired
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// operation_type identifies the kind of operation this schedule runs.
-	// Values: "POWER_ON", "POWER_OFF", "POWER_RESET", "BRING_UP", "INGEST",
+type MaintenanceWindow struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Kind        MaintenanceWindowKind  `protobuf:"varint,4,opt,name=kind,proto3,enum=v1.MaintenanceWindowKind" json:"kind,omitempty"`
+	// Types that are valid to be assigned to Scope:
 	//
-	//	"UPGRADE_FIRMWARE", "DOWNGRADE_FIRMWARE", "ROLLBACK_FIRMWARE".
-	OperationType string `protobuf:"bytes,10,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
-	// description is a human-readable summary of the operation and its key parameters,
-	// e.g. "Power Reset (forced)" or "Upgrade Firmware to v2.3.1".
-	Description   string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	//	*MaintenanceWindow_RackId
+	//	*MaintenanceWindow_NvlDomainId
+	//	*MaintenanceWindow_Site
+	Scope isMaintenanceWindow_Scope `protobuf_oneof:"scope"`
+	// Types that are valid to be assigned to Occurrence:
+	//
+	//	*MaintenanceWindow_Once
+	//	*MaintenanceWindow_Recurrence
+	Occurrence    isMaintenanceWindow_Occurrence `protobuf_oneof:"occurrence"`
+	CreatedAt     *timestamppb.Timestamp         `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskSchedule) Reset() {
-	*x = TaskSchedule{}
-	mi := &file_rla_proto_msgTypes[94]
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_rla_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskSchedule) String() string {
+func (x *MaintenanceWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskSchedule) ProtoMessage() {}
+func (*MaintenanceWindow) ProtoMessage() {}
 
-func (x *TaskSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[94]
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6314,127 +12525,178 @@ func (x *TaskSchedule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskSchedule.ProtoReflect.Descriptor instead.
-func (*TaskSchedule) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *TaskSchedule) GetId() *UUID {
+func (x *MaintenanceWindow) GetId() *UUID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *TaskSchedule) GetName() string {
+func (x *MaintenanceWindow) GetName() string {
 	if x != nil {
 		return x.Name
 	}
-	return ""
+	return ""
+}
+
+func (x *MaintenanceWindow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetKind() MaintenanceWindowKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_UNSPECIFIED
 }
 
-func (x *TaskSchedule) GetSpec() *ScheduleSpec {
+func (x *MaintenanceWindow) GetScope() isMaintenanceWindow_Scope {
 	if x != nil {
-		return x.Spec
+		return x.Scope
 	}
 	return nil
 }
 
-func (x *TaskSchedule) GetOverlapPolicy() OverlapPolicy {
+func (x *MaintenanceWindow) GetRackId() *UUID {
 	if x != nil {
-		return x.OverlapPolicy
+		if x, ok := x.Scope.(*MaintenanceWindow_RackId); ok {
+			return x.RackId
+		}
 	}
-	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+	return nil
 }
 
-func (x *TaskSchedule) GetEnabled() bool {
+func (x *MaintenanceWindow) GetNvlDomainId() *UUID {
 	if x != nil {
-		return x.Enabled
+		if x, ok := x.Scope.(*MaintenanceWindow_NvlDomainId); ok {
+			return x.NvlDomainId
+		}
 	}
-	return false
+	return nil
 }
 
-func (x *TaskSchedule) GetNextRunAt() *timestamppb.Timestamp {
+func (x *MaintenanceWindow) GetSite() *Location {
 	if x != nil {
-		return x.NextRunAt
+		if x, ok := x.Scope.(*MaintenanceWindow_Site); ok {
+			return x.Site
+		}
 	}
 	return nil
 }
 
-func (x *TaskSchedule) GetLastRunAt() *timestamppb.Timestamp {
+func (x *MaintenanceWindow) GetOccurrence() isMaintenanceWindow_Occurrence {
 	if x != nil {
-		return x.LastRunAt
+		return x.Occurrence
 	}
 	return nil
 }
 
-func (x *TaskSchedule) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MaintenanceWindow) GetOnce() *MaintenanceOnce {
 	if x != nil {
-		return x.CreatedAt
+		if x, ok := x.Occurrence.(*MaintenanceWindow_Once); ok {
+			return x.Once
+		}
 	}
 	return nil
 }
 
-func (x *TaskSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *MaintenanceWindow) GetRecurrence() *MaintenanceRecurrence {
 	if x != nil {
-		return x.UpdatedAt
+		if x, ok := x.Occurrence.(*MaintenanceWindow_Recurrence); ok {
+			return x.Recurrence
+		}
 	}
 	return nil
 }
 
-func (x *TaskSchedule) GetOperationType() string {
+func (x *MaintenanceWindow) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OperationType
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskSchedule) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type isMaintenanceWindow_Scope interface {
+	isMaintenanceWindow_Scope()
 }
 
-// ScheduledOperation is the shared operation oneof used by
-// CreateTaskScheduleRequest and CheckScheduleConflictsRequest.
-// Centralising it here means a single proto change adds support for a new
-// operation type in both RPCs, and the Go conversion logic lives in one place.
-//
-// Note: the embedded request messages (e.g. PowerOnRackRequest) may carry a
-// description field, but it is ignored when used inside a ScheduledOperation.
-// The dispatcher generates task descriptions automatically at fire time in the
-// form "<schedule name> — <RFC3339 timestamp>".
-type ScheduledOperation struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Operation:
+type MaintenanceWindow_RackId struct {
+	RackId *UUID `protobuf:"bytes,5,opt,name=rack_id,json=rackId,proto3,oneof"`
+}
+
+type MaintenanceWindow_NvlDomainId struct {
+	NvlDomainId *UUID `protobuf:"bytes,6,opt,name=nvl_domain_id,json=nvlDomainId,proto3,oneof"`
+}
+
+type MaintenanceWindow_Site struct {
+	Site *Location `protobuf:"bytes,7,opt,name=site,proto3,oneof"` // racks matching every non-empty field; position is ignored
+}
+
+func (*MaintenanceWindow_RackId) isMaintenanceWindow_Scope() {}
+
+func (*MaintenanceWindow_NvlDomainId) isMaintenanceWindow_Scope() {}
+
+func (*MaintenanceWindow_Site) isMaintenanceWindow_Scope() {}
+
+type isMaintenanceWindow_Occurrence interface {
+	isMaintenanceWindow_Occurrence()
+}
+
+type MaintenanceWindow_Once struct {
+	Once *MaintenanceOnce `protobuf:"bytes,8,opt,name=once,proto3,oneof"`
+}
+
+type MaintenanceWindow_Recurrence struct {
+	Recurrence *MaintenanceRecurrence `protobuf:"bytes,9,opt,name=recurrence,proto3,oneof"`
+}
+
+func (*MaintenanceWindow_Once) isMaintenanceWindow_Occurrence() {}
+
+func (*MaintenanceWindow_Recurrence) isMaintenanceWindow_Occurrence() {}
+
+type CreateMaintenanceWindowRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Kind        MaintenanceWindowKind  `protobuf:"varint,3,opt,name=kind,proto3,enum=v1.MaintenanceWindowKind" json:"kind,omitempty"`
+	// Types that are valid to be assigned to Scope:
 	//
-	//	*ScheduledOperation_PowerOn
-	//	*ScheduledOperation_PowerOff
-	//	*ScheduledOperation_PowerReset
-	//	*ScheduledOperation_BringUp
-	//	*ScheduledOperation_UpgradeFirmware
-	//	*ScheduledOperation_Ingest
-	Operation     isScheduledOperation_Operation `protobuf_oneof:"operation"`
+	//	*CreateMaintenanceWindowRequest_RackId
+	//	*CreateMaintenanceWindowRequest_NvlDomainId
+	//	*CreateMaintenanceWindowRequest_Site
+	Scope isCreateMaintenanceWindowRequest_Scope `protobuf_oneof:"scope"`
+	// Types that are valid to be assigned to Occurrence:
+	//
+	//	*CreateMaintenanceWindowRequest_Once
+	//	*CreateMaintenanceWindowRequest_Recurrence
+	Occurrence    isCreateMaintenanceWindowRequest_Occurrence `protobuf_oneof:"occurrence"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledOperation) Reset() {
-	*x = ScheduledOperation{}
-	mi := &file_rla_proto_msgTypes[95]
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_rla_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledOperation) String() string {
+func (x *CreateMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduledOperation) ProtoMessage() {}
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *ScheduledOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[95]
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6445,139 +12707,151 @@ func (x *ScheduledOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduledOperation.ProtoReflect.Descriptor instead.
-func (*ScheduledOperation) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *ScheduledOperation) GetOperation() isScheduledOperation_Operation {
+func (x *CreateMaintenanceWindowRequest) GetName() string {
 	if x != nil {
-		return x.Operation
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduledOperation) GetPowerOn() *PowerOnRackRequest {
+func (x *CreateMaintenanceWindowRequest) GetDescription() string {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_PowerOn); ok {
-			return x.PowerOn
-		}
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMaintenanceWindowRequest) GetKind() MaintenanceWindowKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MaintenanceWindowKind_MAINTENANCE_WINDOW_KIND_UNSPECIFIED
+}
+
+func (x *CreateMaintenanceWindowRequest) GetScope() isCreateMaintenanceWindowRequest_Scope {
+	if x != nil {
+		return x.Scope
 	}
 	return nil
 }
 
-func (x *ScheduledOperation) GetPowerOff() *PowerOffRackRequest {
+func (x *CreateMaintenanceWindowRequest) GetRackId() *UUID {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_PowerOff); ok {
-			return x.PowerOff
+		if x, ok := x.Scope.(*CreateMaintenanceWindowRequest_RackId); ok {
+			return x.RackId
 		}
 	}
 	return nil
 }
 
-func (x *ScheduledOperation) GetPowerReset() *PowerResetRackRequest {
+func (x *CreateMaintenanceWindowRequest) GetNvlDomainId() *UUID {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_PowerReset); ok {
-			return x.PowerReset
+		if x, ok := x.Scope.(*CreateMaintenanceWindowRequest_NvlDomainId); ok {
+			return x.NvlDomainId
 		}
 	}
 	return nil
 }
 
-func (x *ScheduledOperation) GetBringUp() *BringUpRackRequest {
+func (x *CreateMaintenanceWindowRequest) GetSite() *Location {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_BringUp); ok {
-			return x.BringUp
+		if x, ok := x.Scope.(*CreateMaintenanceWindowRequest_Site); ok {
+			return x.Site
 		}
 	}
 	return nil
 }
 
-func (x *ScheduledOperation) GetUpgradeFirmware() *UpgradeFirmwareRequest {
+func (x *CreateMaintenanceWindowRequest) GetOccurrence() isCreateMaintenanceWindowRequest_Occurrence {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_UpgradeFirmware); ok {
-			return x.UpgradeFirmware
-		}
+		return x.Occurrence
 	}
 	return nil
 }
 
-func (x *ScheduledOperation) GetIngest() *IngestRackRequest {
+func (x *CreateMaintenanceWindowRequest) GetOnce() *MaintenanceOnce {
 	if x != nil {
-		if x, ok := x.Operation.(*ScheduledOperation_Ingest); ok {
-			return x.Ingest
+		if x, ok := x.Occurrence.(*CreateMaintenanceWindowRequest_Once); ok {
+			return x.Once
 		}
 	}
 	return nil
 }
 
-type isScheduledOperation_Operation interface {
-	isScheduledOperation_Operation()
+func (x *CreateMaintenanceWindowRequest) GetRecurrence() *MaintenanceRecurrence {
+	if x != nil {
+		if x, ok := x.Occurrence.(*CreateMaintenanceWindowRequest_Recurrence); ok {
+			return x.Recurrence
+		}
+	}
+	return nil
 }
 
-type ScheduledOperation_PowerOn struct {
-	PowerOn *PowerOnRackRequest `protobuf:"bytes,1,opt,name=power_on,json=powerOn,proto3,oneof"`
+type isCreateMaintenanceWindowRequest_Scope interface {
+	isCreateMaintenanceWindowRequest_Scope()
 }
 
-type ScheduledOperation_PowerOff struct {
-	PowerOff *PowerOffRackRequest `protobuf:"bytes,2,opt,name=power_off,json=powerOff,proto3,oneof"`
+type CreateMaintenanceWindowRequest_RackId struct {
+	RackId *UUID `protobuf:"bytes,4,opt,name=rack_id,json=rackId,proto3,oneof"`
 }
 
-type ScheduledOperation_PowerReset struct {
-	PowerReset *PowerResetRackRequest `protobuf:"bytes,3,opt,name=power_reset,json=powerReset,proto3,oneof"`
+type CreateMaintenanceWindowRequest_NvlDomainId struct {
+	NvlDomainId *UUID `protobuf:"bytes,5,opt,name=nvl_domain_id,json=nvlDomainId,proto3,oneof"`
 }
 
-type ScheduledOperation_BringUp struct {
-	BringUp *BringUpRackRequest `protobuf:"bytes,4,opt,name=bring_up,json=bringUp,proto3,oneof"`
+type CreateMaintenanceWindowRequest_Site struct {
+	Site *Location `protobuf:"bytes,6,opt,name=site,proto3,oneof"`
 }
 
-type ScheduledOperation_UpgradeFirmware struct {
-	UpgradeFirmware *UpgradeFirmwareRequest `protobuf:"bytes,5,opt,name=upgrade_firmware,json=upgradeFirmware,proto3,oneof"`
-}
+func (*CreateMaintenanceWindowRequest_RackId) isCreateMaintenanceWindowRequest_Scope() {}
 
-type ScheduledOperation_Ingest struct {
-	Ingest *IngestRackRequest `protobuf:"bytes,6,opt,name=ingest,proto3,oneof"`
-}
+func (*CreateMaintenanceWindowRequest_NvlDomainId) isCreateMaintenanceWindowRequest_Scope() {}
 
-func (*ScheduledOperation_PowerOn) isScheduledOperation_Operation() {}
+func (*CreateMaintenanceWindowRequest_Site) isCreateMaintenanceWindowRequest_Scope() {}
 
-func (*ScheduledOperation_PowerOff) isScheduledOperation_Operation() {}
+type isCreateMaintenanceWindowRequest_Occurrence interface {
+	isCreateMaintenanceWindowRequest_Occurrence()
+}
 
-func (*ScheduledOperation_PowerReset) isScheduledOperation_Operation() {}
+type CreateMaintenanceWindowRequest_Once struct {
+	Once *MaintenanceOnce `protobuf:"bytes,7,opt,name=once,proto3,oneof"`
+}
 
-func (*ScheduledOperation_BringUp) isScheduledOperation_Operation() {}
+type CreateMaintenanceWindowRequest_Recurrence struct {
+	Recurrence *MaintenanceRecurrence `protobuf:"bytes,8,opt,name=recurrence,proto3,oneof"`
+}
 
-func (*ScheduledOperation_UpgradeFirmware) isScheduledOperation_Operation() {}
+func (*CreateMaintenanceWindowRequest_Once) isCreateMaintenanceWindowRequest_Occurrence() {}
 
-func (*ScheduledOperation_Ingest) isScheduledOperation_Operation() {}
+func (*CreateMaintenanceWindowRequest_Recurrence) isCreateMaintenanceWindowRequest_Occurrence() {}
 
-// CreateTaskScheduleRequest creates a new TaskSchedule.
-// The target_spec on the operation message defines the initial scope; it follows
-// the same targeting rules as AddTaskScheduleScope (rack-level or component-level).
-// Use AddTaskScheduleScope / RemoveTaskScheduleScope to modify the scope after creation.
-type CreateTaskScheduleRequest struct {
+type DeleteMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schedule      *ScheduleConfig        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Operation     *ScheduledOperation    `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskScheduleRequest) Reset() {
-	*x = CreateTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[96]
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_rla_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskScheduleRequest) String() string {
+func (x *DeleteMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskScheduleRequest) ProtoMessage() {}
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *CreateTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[96]
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6588,47 +12862,40 @@ func (x *CreateTaskScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *CreateTaskScheduleRequest) GetSchedule() *ScheduleConfig {
-	if x != nil {
-		return x.Schedule
-	}
-	return nil
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *CreateTaskScheduleRequest) GetOperation() *ScheduledOperation {
+func (x *DeleteMaintenanceWindowRequest) GetId() *UUID {
 	if x != nil {
-		return x.Operation
+		return x.Id
 	}
 	return nil
 }
 
-type GetTaskScheduleRequest struct {
+type ListMaintenanceWindowsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"` // only the windows and blackouts that apply to this rack
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskScheduleRequest) Reset() {
-	*x = GetTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[97]
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_rla_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskScheduleRequest) String() string {
+func (x *ListMaintenanceWindowsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskScheduleRequest) ProtoMessage() {}
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
 
-func (x *GetTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[97]
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6639,44 +12906,40 @@ func (x *GetTaskScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *GetTaskScheduleRequest) GetId() *UUID {
+func (x *ListMaintenanceWindowsRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.Id
+		return x.RackId
 	}
 	return nil
 }
 
-// ListTaskSchedulesRequest lists TaskSchedules with optional filters.
-// Results are ordered by creation time ascending.
-type ListTaskSchedulesRequest struct {
+type ListMaintenanceWindowsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"` // if set, return only schedules with a scope on this rack
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	EnabledOnly   *bool                  `protobuf:"varint,3,opt,name=enabled_only,json=enabledOnly,proto3,oneof" json:"enabled_only,omitempty"` // if true, return only enabled (non-paused) schedules
+	Windows       []*MaintenanceWindow   `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTaskSchedulesRequest) Reset() {
-	*x = ListTaskSchedulesRequest{}
-	mi := &file_rla_proto_msgTypes[98]
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_rla_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTaskSchedulesRequest) String() string {
+func (x *ListMaintenanceWindowsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTaskSchedulesRequest) ProtoMessage() {}
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
 
-func (x *ListTaskSchedulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[98]
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6687,55 +12950,41 @@ func (x *ListTaskSchedulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTaskSchedulesRequest.ProtoReflect.Descriptor instead.
-func (*ListTaskSchedulesRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{98}
-}
-
-func (x *ListTaskSchedulesRequest) GetRackId() *UUID {
-	if x != nil {
-		return x.RackId
-	}
-	return nil
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListTaskSchedulesRequest) GetPagination() *Pagination {
+func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
 	if x != nil {
-		return x.Pagination
+		return x.Windows
 	}
 	return nil
 }
 
-func (x *ListTaskSchedulesRequest) GetEnabledOnly() bool {
-	if x != nil && x.EnabledOnly != nil {
-		return *x.EnabledOnly
-	}
-	return false
-}
-
-type ListTaskSchedulesResponse struct {
+type GetRackMaintenanceStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskSchedules []*TaskSchedule        `protobuf:"bytes,1,rep,name=task_schedules,json=taskSchedules,proto3" json:"task_schedules,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // total matching count before pagination
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3,oneof" json:"at,omitempty"` // default: now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTaskSchedulesResponse) Reset() {
-	*x = ListTaskSchedulesResponse{}
-	mi := &file_rla_proto_msgTypes[99]
+func (x *GetRackMaintenanceStatusRequest) Reset() {
+	*x = GetRackMaintenanceStatusRequest{}
+	mi := &file_rla_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTaskSchedulesResponse) String() string {
+func (x *GetRackMaintenanceStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTaskSchedulesResponse) ProtoMessage() {}
+func (*GetRackMaintenanceStatusRequest) ProtoMessage() {}
 
-func (x *ListTaskSchedulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[99]
+func (x *GetRackMaintenanceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6746,59 +12995,54 @@ func (x *ListTaskSchedulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTaskSchedulesResponse.ProtoReflect.Descriptor instead.
-func (*ListTaskSchedulesResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GetRackMaintenanceStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRackMaintenanceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *ListTaskSchedulesResponse) GetTaskSchedules() []*TaskSchedule {
+func (x *GetRackMaintenanceStatusRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.TaskSchedules
+		return x.RackId
 	}
 	return nil
 }
 
-func (x *ListTaskSchedulesResponse) GetTotal() int32 {
+func (x *GetRackMaintenanceStatusRequest) GetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Total
+		return x.At
 	}
-	return 0
+	return nil
 }
 
-// UpdateTaskScheduleRequest updates the scheduling config of an existing
-// TaskSchedule. To modify which racks are targeted, use
-// AddTaskScheduleScope / RemoveTaskScheduleScope instead.
-//
-// update_mask is required and controls which fields are written. Supported paths:
-//
-//	"schedule.name"           – display name
-//	"schedule.overlap_policy" – overlap behaviour
-//	"schedule.spec"           – full spec block (type + spec string + next_run_at recomputed)
-//	"schedule.spec.timezone"  – timezone only (spec type/string unchanged)
-type UpdateTaskScheduleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Schedule      *ScheduleConfig        `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+type RackMaintenanceStatus struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RackId     *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	Open       bool                   `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`             // disruptive operations may run at the requested time
+	Reason     string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`          // why the rack is closed
+	Restricted bool                   `protobuf:"varint,4,opt,name=restricted,proto3" json:"restricted,omitempty"` // any window or blackout applies to the rack
+	// The current opening when open, otherwise the next one; absent when the
+	// rack does not open within a year.
+	OpeningStart  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=opening_start,json=openingStart,proto3,oneof" json:"opening_start,omitempty"`
+	OpeningEnd    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=opening_end,json=openingEnd,proto3,oneof" json:"opening_end,omitempty"` // absent when the opening does not close
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskScheduleRequest) Reset() {
-	*x = UpdateTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[100]
+func (x *RackMaintenanceStatus) Reset() {
+	*x = RackMaintenanceStatus{}
+	mi := &file_rla_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskScheduleRequest) String() string {
+func (x *RackMaintenanceStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskScheduleRequest) ProtoMessage() {}
+func (*RackMaintenanceStatus) ProtoMessage() {}
 
-func (x *UpdateTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[100]
+func (x *RackMaintenanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6809,57 +13053,76 @@ func (x *UpdateTaskScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use RackMaintenanceStatus.ProtoReflect.Descriptor instead.
+func (*RackMaintenanceStatus) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *UpdateTaskScheduleRequest) GetId() *UUID {
+func (x *RackMaintenanceStatus) GetRackId() *UUID {
 	if x != nil {
-		return x.Id
+		return x.RackId
 	}
 	return nil
 }
 
-func (x *UpdateTaskScheduleRequest) GetSchedule() *ScheduleConfig {
+func (x *RackMaintenanceStatus) GetOpen() bool {
 	if x != nil {
-		return x.Schedule
+		return x.Open
+	}
+	return false
+}
+
+func (x *RackMaintenanceStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RackMaintenanceStatus) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+func (x *RackMaintenanceStatus) GetOpeningStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpeningStart
 	}
 	return nil
 }
 
-func (x *UpdateTaskScheduleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *RackMaintenanceStatus) GetOpeningEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateMask
+		return x.OpeningEnd
 	}
 	return nil
 }
 
-// PauseTaskScheduleRequest disables a TaskSchedule without deleting it.
-// The schedule will not fire until resumed. Has no effect if already paused.
-// Returns an error for a one-time schedule that has already fired.
-type PauseTaskScheduleRequest struct {
+type ListMaintenanceOverridesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 = no limit
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PauseTaskScheduleRequest) Reset() {
-	*x = PauseTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[101]
+func (x *ListMaintenanceOverridesRequest) Reset() {
+	*x = ListMaintenanceOverridesRequest{}
+	mi := &file_rla_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PauseTaskScheduleRequest) String() string {
+func (x *ListMaintenanceOverridesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseTaskScheduleRequest) ProtoMessage() {}
+func (*ListMaintenanceOverridesRequest) ProtoMessage() {}
 
-func (x *PauseTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[101]
+func (x *ListMaintenanceOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6870,43 +13133,56 @@ func (x *PauseTaskScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*PauseTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use ListMaintenanceOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *PauseTaskScheduleRequest) GetId() *UUID {
+func (x *ListMaintenanceOverridesRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.Id
+		return x.RackId
 	}
 	return nil
 }
 
-// ResumeTaskScheduleRequest re-enables a paused TaskSchedule. For interval
-// and cron schedules, next_run_at is recomputed from the current time so the
-// schedule does not fire immediately. Has no effect if already enabled.
-type ResumeTaskScheduleRequest struct {
+func (x *ListMaintenanceOverridesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// MaintenanceOverrideRecord is one operation that ran on a closed rack
+// because of a maintenance override.
+type MaintenanceOverrideRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        *UUID                  `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,3,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"` // e.g. "firmware_control/upgrade"
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Bypassed      string                 `protobuf:"bytes,7,opt,name=bypassed,proto3" json:"bypassed,omitempty"` // what the calendar would have done, e.g. blackout "q4-training" is active
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResumeTaskScheduleRequest) Reset() {
-	*x = ResumeTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[102]
+func (x *MaintenanceOverrideRecord) Reset() {
+	*x = MaintenanceOverrideRecord{}
+	mi := &file_rla_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResumeTaskScheduleRequest) String() string {
+func (x *MaintenanceOverrideRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResumeTaskScheduleRequest) ProtoMessage() {}
+func (*MaintenanceOverrideRecord) ProtoMessage() {}
 
-func (x *ResumeTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[102]
+func (x *MaintenanceOverrideRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6917,90 +13193,89 @@ func (x *ResumeTaskScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResumeTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*ResumeTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use MaintenanceOverrideRecord.ProtoReflect.Descriptor instead.
+func (*MaintenanceOverrideRecord) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *ResumeTaskScheduleRequest) GetId() *UUID {
+func (x *MaintenanceOverrideRecord) GetId() *UUID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-// DeleteTaskScheduleRequest permanently deletes a TaskSchedule and all its
-// scope entries. In-flight tasks are not cancelled.
-type DeleteTaskScheduleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MaintenanceOverrideRecord) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
 }
 
-func (x *DeleteTaskScheduleRequest) Reset() {
-	*x = DeleteTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[103]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MaintenanceOverrideRecord) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
 }
 
-func (x *DeleteTaskScheduleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MaintenanceOverrideRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
 }
 
-func (*DeleteTaskScheduleRequest) ProtoMessage() {}
+func (x *MaintenanceOverrideRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
 
-func (x *DeleteTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[103]
+func (x *MaintenanceOverrideRecord) GetRequestedBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RequestedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{103}
+func (x *MaintenanceOverrideRecord) GetBypassed() string {
+	if x != nil {
+		return x.Bypassed
+	}
+	return ""
 }
 
-func (x *DeleteTaskScheduleRequest) GetId() *UUID {
+func (x *MaintenanceOverrideRecord) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// TriggerTaskScheduleRequest fires a TaskSchedule immediately, regardless of
-// next_run_at or enabled state. The overlap policy is not consulted — all
-// scopes are submitted unconditionally. Returns an error for a one-time
-// schedule that has already fired.
-type TriggerTaskScheduleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ListMaintenanceOverridesResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Overrides     []*MaintenanceOverrideRecord `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TriggerTaskScheduleRequest) Reset() {
-	*x = TriggerTaskScheduleRequest{}
-	mi := &file_rla_proto_msgTypes[104]
+func (x *ListMaintenanceOverridesResponse) Reset() {
+	*x = ListMaintenanceOverridesResponse{}
+	mi := &file_rla_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TriggerTaskScheduleRequest) String() string {
+func (x *ListMaintenanceOverridesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerTaskScheduleRequest) ProtoMessage() {}
+func (*ListMaintenanceOverridesResponse) ProtoMessage() {}
 
-func (x *TriggerTaskScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[104]
+func (x *ListMaintenanceOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7011,57 +13286,54 @@ func (x *TriggerTaskScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerTaskScheduleRequest.ProtoReflect.Descriptor instead.
-func (*TriggerTaskScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use ListMaintenanceOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *TriggerTaskScheduleRequest) GetId() *UUID {
+func (x *ListMaintenanceOverridesResponse) GetOverrides() []*MaintenanceOverrideRecord {
 	if x != nil {
-		return x.Id
+		return x.Overrides
 	}
 	return nil
 }
 
-// TaskScheduleScope represents one rack target in a schedule's scope.
-// Each scope entry causes one task to be submitted per schedule firing.
-// last_task_id tracks the task produced for this rack by the most recent firing;
-// the dispatcher uses it for the overlap check. Absent if no task has fired yet
-// for this scope (e.g. a newly added rack).
-type TaskScheduleScope struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	Id         *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ScheduleId *UUID                  `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	RackId     *UUID                  `protobuf:"bytes,3,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	// component_filter restricts which components in the rack are targeted.
-	// Omit to target all components in the rack.
-	//
-	// Types that are valid to be assigned to ComponentFilter:
-	//
-	//	*TaskScheduleScope_Types
-	//	*TaskScheduleScope_Components
-	ComponentFilter isTaskScheduleScope_ComponentFilter `protobuf_oneof:"component_filter"`
-	LastTaskId      *UUID                               `protobuf:"bytes,6,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"` // absent until the first firing for this scope
-	CreatedAt       *timestamppb.Timestamp              `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// ComponentDrift is a difference found by the inventory sync between a
+// component in the inventory and what its component manager service reports.
+// A drift keeps its ID for as long as the same difference is observed.
+type ComponentDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ComponentId   *UUID                  `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"` // absent for DRIFT_TYPE_MISSING_IN_EXPECTED
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`    // ID in the component manager service, if known
+	RackId        *UUID                  `protobuf:"bytes,4,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`                // absent for DRIFT_TYPE_MISSING_IN_EXPECTED
+	ComponentType ComponentType          `protobuf:"varint,5,opt,name=component_type,json=componentType,proto3,enum=v1.ComponentType" json:"component_type,omitempty"`
+	Type          DriftType              `protobuf:"varint,6,opt,name=type,proto3,enum=v1.DriftType" json:"type,omitempty"`
+	Diffs         []*FieldDiff           `protobuf:"bytes,7,rep,name=diffs,proto3" json:"diffs,omitempty"` // DRIFT_TYPE_MISMATCH only
+	Status        DriftStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=v1.DriftStatus" json:"status,omitempty"`
+	DismissReason string                 `protobuf:"bytes,9,opt,name=dismiss_reason,json=dismissReason,proto3" json:"dismiss_reason,omitempty"`
+	DismissedBy   string                 `protobuf:"bytes,10,opt,name=dismissed_by,json=dismissedBy,proto3" json:"dismissed_by,omitempty"`
+	DismissedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=dismissed_at,json=dismissedAt,proto3,oneof" json:"dismissed_at,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // when the inventory sync last found the drift
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskScheduleScope) Reset() {
-	*x = TaskScheduleScope{}
-	mi := &file_rla_proto_msgTypes[105]
+func (x *ComponentDrift) Reset() {
+	*x = ComponentDrift{}
+	mi := &file_rla_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskScheduleScope) String() string {
+func (x *ComponentDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskScheduleScope) ProtoMessage() {}
+func (*ComponentDrift) ProtoMessage() {}
 
-func (x *TaskScheduleScope) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[105]
+func (x *ComponentDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7072,172 +13344,120 @@ func (x *TaskScheduleScope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskScheduleScope.ProtoReflect.Descriptor instead.
-func (*TaskScheduleScope) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use ComponentDrift.ProtoReflect.Descriptor instead.
+func (*ComponentDrift) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *TaskScheduleScope) GetId() *UUID {
+func (x *ComponentDrift) GetId() *UUID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *TaskScheduleScope) GetScheduleId() *UUID {
+func (x *ComponentDrift) GetComponentId() *UUID {
 	if x != nil {
-		return x.ScheduleId
+		return x.ComponentId
 	}
 	return nil
 }
 
-func (x *TaskScheduleScope) GetRackId() *UUID {
+func (x *ComponentDrift) GetExternalId() string {
 	if x != nil {
-		return x.RackId
+		return x.ExternalId
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskScheduleScope) GetComponentFilter() isTaskScheduleScope_ComponentFilter {
+func (x *ComponentDrift) GetRackId() *UUID {
 	if x != nil {
-		return x.ComponentFilter
+		return x.RackId
 	}
 	return nil
 }
 
-func (x *TaskScheduleScope) GetTypes() *ComponentTypes {
+func (x *ComponentDrift) GetComponentType() ComponentType {
 	if x != nil {
-		if x, ok := x.ComponentFilter.(*TaskScheduleScope_Types); ok {
-			return x.Types
-		}
+		return x.ComponentType
 	}
-	return nil
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
 }
 
-func (x *TaskScheduleScope) GetComponents() *ComponentTargets {
+func (x *ComponentDrift) GetType() DriftType {
 	if x != nil {
-		if x, ok := x.ComponentFilter.(*TaskScheduleScope_Components); ok {
-			return x.Components
-		}
+		return x.Type
 	}
-	return nil
+	return DriftType_DRIFT_TYPE_UNKNOWN
 }
 
-func (x *TaskScheduleScope) GetLastTaskId() *UUID {
+func (x *ComponentDrift) GetDiffs() []*FieldDiff {
 	if x != nil {
-		return x.LastTaskId
+		return x.Diffs
 	}
 	return nil
 }
 
-func (x *TaskScheduleScope) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ComponentDrift) GetStatus() DriftStatus {
 	if x != nil {
-		return x.CreatedAt
+		return x.Status
 	}
-	return nil
-}
-
-type isTaskScheduleScope_ComponentFilter interface {
-	isTaskScheduleScope_ComponentFilter()
-}
-
-type TaskScheduleScope_Types struct {
-	// types filters by component type (e.g. COMPUTE, POWERSHELF).
-	Types *ComponentTypes `protobuf:"bytes,4,opt,name=types,proto3,oneof"`
-}
-
-type TaskScheduleScope_Components struct {
-	// components targets specific components by UUID or external reference.
-	Components *ComponentTargets `protobuf:"bytes,5,opt,name=components,proto3,oneof"`
-}
-
-func (*TaskScheduleScope_Types) isTaskScheduleScope_ComponentFilter() {}
-
-func (*TaskScheduleScope_Components) isTaskScheduleScope_ComponentFilter() {}
-
-// AddTaskScheduleScopeRequest adds one or more scope entries to a schedule.
-// Supports rack-level targeting (with optional component-type filter) and
-// component-level targeting (specific components by UUID or external reference).
-// For component-level targets the server resolves which rack each component
-// belongs to and groups them into per-rack scope entries automatically.
-// Racks already present in the scope have their component filter merged with the
-// incoming filter rather than erroring; racks not yet present are added.
-// Existing racks are never removed — use UpdateTaskScheduleScope for replace semantics.
-type AddTaskScheduleScopeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    *UUID                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,2,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AddTaskScheduleScopeRequest) Reset() {
-	*x = AddTaskScheduleScopeRequest{}
-	mi := &file_rla_proto_msgTypes[106]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AddTaskScheduleScopeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AddTaskScheduleScopeRequest) ProtoMessage() {}
+	return DriftStatus_DRIFT_STATUS_UNKNOWN
+}
 
-func (x *AddTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[106]
+func (x *ComponentDrift) GetDismissReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DismissReason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddTaskScheduleScopeRequest.ProtoReflect.Descriptor instead.
-func (*AddTaskScheduleScopeRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{106}
+func (x *ComponentDrift) GetDismissedBy() string {
+	if x != nil {
+		return x.DismissedBy
+	}
+	return ""
 }
 
-func (x *AddTaskScheduleScopeRequest) GetScheduleId() *UUID {
+func (x *ComponentDrift) GetDismissedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ScheduleId
+		return x.DismissedAt
 	}
 	return nil
 }
 
-func (x *AddTaskScheduleScopeRequest) GetTargetSpec() *OperationTargetSpec {
+func (x *ComponentDrift) GetCheckedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TargetSpec
+		return x.CheckedAt
 	}
 	return nil
 }
 
-// AddTaskScheduleScopeResponse returns the newly created scope entries.
-type AddTaskScheduleScopeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scopes        []*TaskScheduleScope   `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListDriftsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RackId           *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"`
+	Types            []DriftType            `protobuf:"varint,2,rep,packed,name=types,proto3,enum=v1.DriftType" json:"types,omitempty"`                                             // empty = all
+	ComponentTypes   []ComponentType        `protobuf:"varint,3,rep,packed,name=component_types,json=componentTypes,proto3,enum=v1.ComponentType" json:"component_types,omitempty"` // empty = all
+	IncludeDismissed bool                   `protobuf:"varint,4,opt,name=include_dismissed,json=includeDismissed,proto3" json:"include_dismissed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *AddTaskScheduleScopeResponse) Reset() {
-	*x = AddTaskScheduleScopeResponse{}
-	mi := &file_rla_proto_msgTypes[107]
+func (x *ListDriftsRequest) Reset() {
+	*x = ListDriftsRequest{}
+	mi := &file_rla_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTaskScheduleScopeResponse) String() string {
+func (x *ListDriftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTaskScheduleScopeResponse) ProtoMessage() {}
+func (*ListDriftsRequest) ProtoMessage() {}
 
-func (x *AddTaskScheduleScopeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[107]
+func (x *ListDriftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7248,92 +13468,61 @@ func (x *AddTaskScheduleScopeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTaskScheduleScopeResponse.ProtoReflect.Descriptor instead.
-func (*AddTaskScheduleScopeResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use ListDriftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDriftsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *AddTaskScheduleScopeResponse) GetScopes() []*TaskScheduleScope {
+func (x *ListDriftsRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.Scopes
+		return x.RackId
 	}
 	return nil
 }
 
-// RemoveTaskScheduleScopeRequest removes a single rack scope entry by its scope ID.
-// In-flight tasks for that rack are not cancelled.
-type RemoveTaskScheduleScopeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScopeId       *UUID                  `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RemoveTaskScheduleScopeRequest) Reset() {
-	*x = RemoveTaskScheduleScopeRequest{}
-	mi := &file_rla_proto_msgTypes[108]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RemoveTaskScheduleScopeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RemoveTaskScheduleScopeRequest) ProtoMessage() {}
-
-func (x *RemoveTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[108]
+func (x *ListDriftsRequest) GetTypes() []DriftType {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Types
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RemoveTaskScheduleScopeRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTaskScheduleScopeRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{108}
+func (x *ListDriftsRequest) GetComponentTypes() []ComponentType {
+	if x != nil {
+		return x.ComponentTypes
+	}
+	return nil
 }
 
-func (x *RemoveTaskScheduleScopeRequest) GetScopeId() *UUID {
+func (x *ListDriftsRequest) GetIncludeDismissed() bool {
 	if x != nil {
-		return x.ScopeId
+		return x.IncludeDismissed
 	}
-	return nil
+	return false
 }
 
-// UpdateTaskScheduleScopeRequest reconciles the schedule's scope against the
-// desired target_spec: racks present in desired_scope but not in the current scope
-// are added; racks present in the current scope but absent from desired_scope are
-// removed; racks present in both have their component_filter updated if changed.
-// For component-level targets the server resolves rack membership automatically.
-type UpdateTaskScheduleScopeRequest struct {
+type ListDriftsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    *UUID                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	DesiredScope  *OperationTargetSpec   `protobuf:"bytes,2,opt,name=desired_scope,json=desiredScope,proto3" json:"desired_scope,omitempty"`
+	Drifts        []*ComponentDrift      `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskScheduleScopeRequest) Reset() {
-	*x = UpdateTaskScheduleScopeRequest{}
-	mi := &file_rla_proto_msgTypes[109]
+func (x *ListDriftsResponse) Reset() {
+	*x = ListDriftsResponse{}
+	mi := &file_rla_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskScheduleScopeRequest) String() string {
+func (x *ListDriftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskScheduleScopeRequest) ProtoMessage() {}
+func (*ListDriftsResponse) ProtoMessage() {}
 
-func (x *UpdateTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[109]
+func (x *ListDriftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7344,51 +13533,43 @@ func (x *UpdateTaskScheduleScopeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskScheduleScopeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskScheduleScopeRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{109}
-}
-
-func (x *UpdateTaskScheduleScopeRequest) GetScheduleId() *UUID {
-	if x != nil {
-		return x.ScheduleId
-	}
-	return nil
+// Deprecated: Use ListDriftsResponse.ProtoReflect.Descriptor instead.
+func (*ListDriftsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *UpdateTaskScheduleScopeRequest) GetDesiredScope() *OperationTargetSpec {
+func (x *ListDriftsResponse) GetDrifts() []*ComponentDrift {
 	if x != nil {
-		return x.DesiredScope
+		return x.Drifts
 	}
 	return nil
 }
 
-// UpdateTaskScheduleScopeResponse returns the complete scope after reconciliation.
-type UpdateTaskScheduleScopeResponse struct {
+// AcceptDriftRequest writes the values reported by the component manager
+// service into the expected component and removes the drift. Only mismatch
+// drifts whose values are all reported can be accepted.
+type AcceptDriftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scopes        []*TaskScheduleScope   `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`     // number of scope entries added
-	Removed       int32                  `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"` // number of scope entries removed
-	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"` // number of scope entries with updated component_filter
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskScheduleScopeResponse) Reset() {
-	*x = UpdateTaskScheduleScopeResponse{}
-	mi := &file_rla_proto_msgTypes[110]
+func (x *AcceptDriftRequest) Reset() {
+	*x = AcceptDriftRequest{}
+	mi := &file_rla_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskScheduleScopeResponse) String() string {
+func (x *AcceptDriftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskScheduleScopeResponse) ProtoMessage() {}
+func (*AcceptDriftRequest) ProtoMessage() {}
 
-func (x *UpdateTaskScheduleScopeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[110]
+func (x *AcceptDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7399,62 +13580,40 @@ func (x *UpdateTaskScheduleScopeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskScheduleScopeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskScheduleScopeResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use AcceptDriftRequest.ProtoReflect.Descriptor instead.
+func (*AcceptDriftRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *UpdateTaskScheduleScopeResponse) GetScopes() []*TaskScheduleScope {
+func (x *AcceptDriftRequest) GetId() *UUID {
 	if x != nil {
-		return x.Scopes
+		return x.Id
 	}
 	return nil
 }
 
-func (x *UpdateTaskScheduleScopeResponse) GetAdded() int32 {
-	if x != nil {
-		return x.Added
-	}
-	return 0
-}
-
-func (x *UpdateTaskScheduleScopeResponse) GetRemoved() int32 {
-	if x != nil {
-		return x.Removed
-	}
-	return 0
-}
-
-func (x *UpdateTaskScheduleScopeResponse) GetUpdated() int32 {
-	if x != nil {
-		return x.Updated
-	}
-	return 0
-}
-
-// ListTaskScheduleScopesRequest returns all scope entries for a given schedule.
-type ListTaskScheduleScopesRequest struct {
+type AcceptDriftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    *UUID                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Component     *Component             `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // the updated component
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTaskScheduleScopesRequest) Reset() {
-	*x = ListTaskScheduleScopesRequest{}
-	mi := &file_rla_proto_msgTypes[111]
+func (x *AcceptDriftResponse) Reset() {
+	*x = AcceptDriftResponse{}
+	mi := &file_rla_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTaskScheduleScopesRequest) String() string {
+func (x *AcceptDriftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTaskScheduleScopesRequest) ProtoMessage() {}
+func (*AcceptDriftResponse) ProtoMessage() {}
 
-func (x *ListTaskScheduleScopesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[111]
+func (x *AcceptDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7465,40 +13624,42 @@ func (x *ListTaskScheduleScopesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTaskScheduleScopesRequest.ProtoReflect.Descriptor instead.
-func (*ListTaskScheduleScopesRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use AcceptDriftResponse.ProtoReflect.Descriptor instead.
+func (*AcceptDriftResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *ListTaskScheduleScopesRequest) GetScheduleId() *UUID {
+func (x *AcceptDriftResponse) GetComponent() *Component {
 	if x != nil {
-		return x.ScheduleId
+		return x.Component
 	}
 	return nil
 }
 
-type ListTaskScheduleScopesResponse struct {
+type DismissDriftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scopes        []*TaskScheduleScope   `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // required
+	DismissedBy   string                 `protobuf:"bytes,3,opt,name=dismissed_by,json=dismissedBy,proto3" json:"dismissed_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTaskScheduleScopesResponse) Reset() {
-	*x = ListTaskScheduleScopesResponse{}
-	mi := &file_rla_proto_msgTypes[112]
+func (x *DismissDriftRequest) Reset() {
+	*x = DismissDriftRequest{}
+	mi := &file_rla_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTaskScheduleScopesResponse) String() string {
+func (x *DismissDriftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTaskScheduleScopesResponse) ProtoMessage() {}
+func (*DismissDriftRequest) ProtoMessage() {}
 
-func (x *ListTaskScheduleScopesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[112]
+func (x *DismissDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7509,57 +13670,56 @@ func (x *ListTaskScheduleScopesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTaskScheduleScopesResponse.ProtoReflect.Descriptor instead.
-func (*ListTaskScheduleScopesResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use DismissDriftRequest.ProtoReflect.Descriptor instead.
+func (*DismissDriftRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *ListTaskScheduleScopesResponse) GetScopes() []*TaskScheduleScope {
+func (x *DismissDriftRequest) GetId() *UUID {
 	if x != nil {
-		return x.Scopes
+		return x.Id
 	}
 	return nil
 }
 
-// CheckScheduleConflictsRequest checks whether a proposed scheduled operation
-// would conflict with any existing enabled schedules.
-// The operation oneof mirrors CreateTaskScheduleRequest: the target_spec
-// embedded in the operation message defines which racks are checked.
-//
-// This call is advisory and intentionally coarse: it matches on operation
-// type and code only, without intersecting component-type filters or explicit
-// component UUID lists. As a result it may return false positives — two
-// schedules that target disjoint component sets on the same rack will appear
-// to conflict here even if their tasks would never collide at runtime.
-// Execution-time conflict detection (the task manager's conflict rules) remains
-// the authoritative backstop. The caller may proceed even when conflicts are
-// returned.
-type CheckScheduleConflictsRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Operation *ScheduledOperation    `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
-	// exclude_schedule_id omits a schedule from the conflict check results.
-	// Pass the ID of the schedule being updated so its current definition is
-	// not returned as a conflict against the proposed replacement operation.
-	ExcludeScheduleId *UUID `protobuf:"bytes,2,opt,name=exclude_schedule_id,json=excludeScheduleId,proto3,oneof" json:"exclude_schedule_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *DismissDriftRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *CheckScheduleConflictsRequest) Reset() {
-	*x = CheckScheduleConflictsRequest{}
-	mi := &file_rla_proto_msgTypes[113]
+func (x *DismissDriftRequest) GetDismissedBy() string {
+	if x != nil {
+		return x.DismissedBy
+	}
+	return ""
+}
+
+// ResyncRackRequest runs the inventory sync now and replaces the drifts of
+// the components of a rack.
+type ResyncRackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncRackRequest) Reset() {
+	*x = ResyncRackRequest{}
+	mi := &file_rla_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckScheduleConflictsRequest) String() string {
+func (x *ResyncRackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckScheduleConflictsRequest) ProtoMessage() {}
+func (*ResyncRackRequest) ProtoMessage() {}
 
-func (x *CheckScheduleConflictsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[113]
+func (x *ResyncRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7570,50 +13730,40 @@ func (x *CheckScheduleConflictsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckScheduleConflictsRequest.ProtoReflect.Descriptor instead.
-func (*CheckScheduleConflictsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{113}
-}
-
-func (x *CheckScheduleConflictsRequest) GetOperation() *ScheduledOperation {
-	if x != nil {
-		return x.Operation
-	}
-	return nil
+// Deprecated: Use ResyncRackRequest.ProtoReflect.Descriptor instead.
+func (*ResyncRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *CheckScheduleConflictsRequest) GetExcludeScheduleId() *UUID {
+func (x *ResyncRackRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.ExcludeScheduleId
+		return x.RackId
 	}
 	return nil
 }
 
-// CheckScheduleConflictsResponse lists the existing enabled schedules whose
-// operations may conflict with the proposed operation at execution time.
-// An empty list means no conflicts were detected.
-type CheckScheduleConflictsResponse struct {
+type ResyncRackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conflicts     []*TaskSchedule        `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Drifts        []*ComponentDrift      `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"` // open drifts of the rack after the sync
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckScheduleConflictsResponse) Reset() {
-	*x = CheckScheduleConflictsResponse{}
-	mi := &file_rla_proto_msgTypes[114]
+func (x *ResyncRackResponse) Reset() {
+	*x = ResyncRackResponse{}
+	mi := &file_rla_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckScheduleConflictsResponse) String() string {
+func (x *ResyncRackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckScheduleConflictsResponse) ProtoMessage() {}
+func (*ResyncRackResponse) ProtoMessage() {}
 
-func (x *CheckScheduleConflictsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[114]
+func (x *ResyncRackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7624,1840 +13774,2040 @@ func (x *CheckScheduleConflictsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckScheduleConflictsResponse.ProtoReflect.Descriptor instead.
-func (*CheckScheduleConflictsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use ResyncRackResponse.ProtoReflect.Descriptor instead.
+func (*ResyncRackResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *CheckScheduleConflictsResponse) GetConflicts() []*TaskSchedule {
+func (x *ResyncRackResponse) GetDrifts() []*ComponentDrift {
 	if x != nil {
-		return x.Conflicts
+		return x.Drifts
 	}
 	return nil
 }
 
 var File_rla_proto protoreflect.FileDescriptor
 
-var file_rla_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x72, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x16, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75,
-	0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x08, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
-	0x0a, 0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f,
-	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a,
-	0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7e, 0x0a, 0x07, 0x42, 0x4d,
-	0x43, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4d, 0x43, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x63,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x69,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5b, 0x0a, 0x0c, 0x52, 0x61,
-	0x63, 0x6b, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c,
-	0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6c, 0x6f,
-	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x79, 0x49, 0x64, 0x78, 0x12, 0x17,
-	0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x6d,
-	0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x6d, 0x63,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4d, 0x43,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x6d, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x22, 0x83, 0x01, 0x0a, 0x04, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x28,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x05, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x48, 0x00,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x0b, 0x52, 0x61, 0x63, 0x6b, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63,
-	0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
-	0x22, 0x41, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x88,
-	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x55, 0x49, 0x44, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x3a, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x08, 0x65,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x09, 0x4e,
-	0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x65, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x77, 0x69, 0x6c, 0x64, 0x63, 0x61,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x57, 0x69, 0x6c, 0x64,
-	0x63, 0x61, 0x72, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x5f, 0x6f, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75, 0x73, 0x65, 0x4f, 0x72, 0x22, 0xc0, 0x01, 0x0a, 0x06,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
-	0x00, 0x52, 0x09, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x43, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
-	0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x32, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xad,
-	0x01, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x72, 0x61,
-	0x63, 0x6b, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x09, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x44, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x98,
-	0x06, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x21, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x12, 0x31, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x55, 0x75, 0x69, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
-	0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a,
-	0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
-	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x35, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x48, 0x02, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x48, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x39, 0x0a, 0x19, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x04,
-	0x72, 0x61, 0x63, 0x6b, 0x22, 0x36, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27,
-	0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x33, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x30, 0x0a, 0x10,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x2b,
-	0x0a, 0x11, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x54, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x63,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x52, 0x61, 0x63,
-	0x6b, 0x22, 0x75, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x77,
-	0x69, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x77, 0x69, 0x74, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22,
-	0xe4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x48, 0x01, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x66, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1e, 0x0a, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x46, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2c, 0x0a, 0x0a, 0x6e, 0x76, 0x6c, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x52, 0x09, 0x6e, 0x76, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x33,
-	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x1d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x61,
-	0x63, 0x6b, 0x73, 0x54, 0x6f, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x15, 0x6e, 0x76, 0x6c, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x13, 0x6e, 0x76, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x10, 0x72, 0x61, 0x63,
-	0x6b, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x52, 0x0f, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x22, 0x5c, 0x0a, 0x1f, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x61,
-	0x63, 0x6b, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x10, 0x72, 0x61, 0x63, 0x6b, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x52, 0x0f, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x56, 0x4c, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x0b, 0x6e, 0x76, 0x6c, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x52, 0x0a, 0x6e, 0x76, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0x61, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x46,
-	0x6f, 0x72, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x42, 0x0a, 0x15, 0x6e, 0x76, 0x6c, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x52, 0x13, 0x6e, 0x76, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63,
-	0x6b, 0x73, 0x46, 0x6f, 0x72, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52,
-	0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xcd, 0x03, 0x0a, 0x16, 0x55, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
-	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2a, 0x0a, 0x0e, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x03,
-	0x52, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x04, 0x52, 0x06,
-	0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3d, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52,
-	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x88, 0x01, 0x01, 0x12, 0x24,
-	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x48, 0x02, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x62, 0x79, 0x22, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x22, 0x8e, 0x02, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x0a,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
-	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x48, 0x02, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x73, 0x70, 0x65, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
-	0x79, 0x22, 0xf8, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x27, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x44, 0x69,
-	0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x66, 0x66, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x29, 0x0a, 0x10, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x75, 0x6e, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72,
-	0x69, 0x66, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x64, 0x72, 0x69, 0x66, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x01, 0x0a,
-	0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x20,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x25,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64,
-	0x69, 0x66, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x44, 0x69, 0x66, 0x66, 0x73, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x74, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x42, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x14, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x32,
-	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x75, 0x72, 0x67,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xc2, 0x02, 0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61,
-	0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x63, 0x6b, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x26, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x03, 0x52, 0x06, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x6d, 0x63, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4d, 0x43, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x6d, 0x63, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x66, 0x69,
-	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x16, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x39,
-	0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x63, 0x6f,
-	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
-	0x69, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6e,
-	0x66, 0x6c, 0x69, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x32, 0x0a,
-	0x15, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
-	0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x26, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x01, 0x52, 0x06, 0x72,
-	0x75, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x13, 0x50, 0x6f, 0x77, 0x65, 0x72,
-	0x4f, 0x66, 0x66, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26,
-	0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x01, 0x52, 0x06, 0x72, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x75, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x22, 0x8d, 0x02, 0x0a, 0x15, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
-	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26,
-	0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x01, 0x52, 0x06, 0x72, 0x75, 0x6c,
-	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x75, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x42, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x48, 0x00, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x11,
-	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
-	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x00,
-	0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07,
-	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f,
-	0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
-	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x22, 0x3b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x49, 0x44,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x55, 0x49, 0x44, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x37, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x36, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x32,
-	0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61,
-	0x73, 0x6b, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69,
-	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x87, 0x03, 0x0a, 0x0d, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0e, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14,
-	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x75, 0x6c, 0x65,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x75, 0x6c,
-	0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
-	0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x1b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72,
-	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x12, 0x72, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x3f,
-	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07,
-	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22,
-	0x3c, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x75,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x19,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0e, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09,
-	0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x66, 0x0a, 0x1a, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x1c, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65,
-	0x52, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x1f, 0x44, 0x69,
-	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64,
-	0x12, 0x38, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c,
-	0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x43, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x75, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x1f,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x22, 0xb2, 0x02, 0x0a, 0x13, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x61,
-	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x38, 0x0a,
-	0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21,
-	0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5f, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x68, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
-	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
-	0x6e, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12,
-	0x38, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65,
-	0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x97, 0x04, 0x0a, 0x0c, 0x54, 0x61,
-	0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x38,
-	0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
-	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e,
-	0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e,
-	0x5f, 0x61, 0x74, 0x22, 0xfb, 0x02, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x12,
-	0x36, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x70, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x07, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x12, 0x47, 0x0a, 0x10, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77,
-	0x61, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e,
-	0x67, 0x65, 0x73, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2e, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
-	0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x18, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x33,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x6f,
-	0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x22, 0x6a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0d,
-	0x74, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0xa2, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x34, 0x0a, 0x18, 0x50, 0x61, 0x75, 0x73,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35,
-	0x0a, 0x19, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xda, 0x02, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x55, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x36,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x12, 0x0a,
-	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x22, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x29, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0x4d, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x55, 0x49, 0x44, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a,
-	0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0a,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x64, 0x65,
-	0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x69,
-	0x72, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x64, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
-	0x64, 0x22, 0x4f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x13, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x48, 0x00, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x22, 0x50, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
-	0x63, 0x74, 0x73, 0x2a, 0x44, 0x0a, 0x07, 0x42, 0x4d, 0x43, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
-	0x0a, 0x10, 0x42, 0x4d, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4d, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4d, 0x43, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x44, 0x50, 0x55, 0x10, 0x02, 0x2a, 0xd2, 0x01, 0x0a, 0x0d, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x43,
-	0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x50, 0x4f,
-	0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54,
-	0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x56, 0x4c, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x10,
-	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x10, 0x03,
-	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x54, 0x4f, 0x52, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12, 0x16,
-	0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4d, 0x53, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e,
-	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x44, 0x55, 0x10, 0x06, 0x2a, 0x91,
-	0x01, 0x0a, 0x0f, 0x52, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45,
-	0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x49,
-	0x4c, 0x54, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
-	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x46, 0x41, 0x43, 0x54, 0x55,
-	0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x49,
-	0x4c, 0x54, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
-	0x10, 0x03, 0x2a, 0xcb, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x26, 0x0a, 0x22, 0x43,
-	0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
-	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54,
-	0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e,
-	0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d,
-	0x41, 0x4e, 0x55, 0x46, 0x41, 0x43, 0x54, 0x55, 0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x20, 0x0a,
-	0x1c, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45,
-	0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x03, 0x12,
-	0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49, 0x4c,
-	0x54, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04,
-	0x2a, 0xd6, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f,
-	0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59,
-	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e,
-	0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
-	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x43, 0x4f, 0x4d, 0x50, 0x4f,
-	0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x46, 0x49,
-	0x45, 0x4c, 0x44, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x46, 0x41, 0x43, 0x54, 0x55, 0x52, 0x45, 0x52,
-	0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f,
-	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e,
-	0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x46, 0x49, 0x45,
-	0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x2a, 0x9a, 0x01, 0x0a, 0x10, 0x52, 0x61,
-	0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23,
-	0x0a, 0x1f, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f,
-	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x42, 0x59, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x01, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x42, 0x59, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x46, 0x41, 0x43,
-	0x54, 0x55, 0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x43, 0x4b, 0x5f,
-	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x4c, 0x10, 0x03, 0x2a, 0xa4, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x77, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4f, 0x70, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x57, 0x45, 0x52,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x5f, 0x4f, 0x4e, 0x10, 0x01,
-	0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
-	0x4c, 0x5f, 0x4f, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x4e, 0x10, 0x02, 0x12,
-	0x18, 0x0a, 0x14, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
-	0x5f, 0x4f, 0x50, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x5f, 0x46, 0x4f,
-	0x52, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x5f, 0x52, 0x45,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x4f, 0x57, 0x45, 0x52,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x43,
-	0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x50,
-	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x5f,
-	0x57, 0x41, 0x52, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b,
-	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4f, 0x50,
-	0x5f, 0x43, 0x4f, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x08, 0x2a, 0xbf, 0x01,
-	0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13,
-	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17,
-	0x0a, 0x13, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55,
-	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41,
-	0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x2a,
-	0x53, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x58, 0x45, 0x43,
-	0x55, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x58, 0x45, 0x43,
-	0x55, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52,
-	0x41, 0x4c, 0x10, 0x01, 0x2a, 0x67, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x46, 0x46, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x18,
-	0x0a, 0x14, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x45, 0x58,
-	0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x10, 0x03, 0x2a, 0x70, 0x0a,
-	0x10, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x53, 0x54,
-	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
-	0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10,
-	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x53, 0x54,
-	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x2a,
-	0x72, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
-	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
-	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x23,
-	0x0a, 0x1f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
-	0x4c, 0x10, 0x02, 0x2a, 0x95, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x43, 0x48, 0x45,
-	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b,
-	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a,
-	0x17, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43,
-	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0x62, 0x0a, 0x0d, 0x4f,
-	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x1a,
-	0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
-	0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53,
-	0x4b, 0x49, 0x50, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50,
-	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x02, 0x32,
-	0xeb, 0x1f, 0x0a, 0x03, 0x52, 0x4c, 0x41, 0x12, 0x2c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
-	0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x50, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x52,
-	0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x4d, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
-	0x0a, 0x14, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x17, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x62, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x21,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12,
-	0x21, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1a,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x52, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x52,
-	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x61, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x61, 0x63, 0x6b,
-	0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67,
-	0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
-	0x0f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
-	0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x46, 0x69, 0x72,
-	0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x42, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x52,
-	0x61, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x63,
-	0x6b, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3d, 0x0a, 0x0b, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52, 0x61, 0x63, 0x6b, 0x12,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x52, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3f, 0x0a, 0x0c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x52, 0x61, 0x63, 0x6b, 0x12,
-	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x52, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x0e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61,
-	0x63, 0x6b, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79,
-	0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x53, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
-	0x0a, 0x0e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x61, 0x63,
-	0x6b, 0x73, 0x54, 0x6f, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x54, 0x6f,
-	0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x18, 0x44, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x56, 0x4c, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x52, 0x61, 0x63, 0x6b, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x56,
-	0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x63, 0x6b, 0x73, 0x46, 0x6f, 0x72, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x46,
-	0x6f, 0x72, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73,
-	0x46, 0x6f, 0x72, 0x4e, 0x56, 0x4c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12,
-	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x49,
-	0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x56, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x41, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x15, 0x41, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61,
-	0x63, 0x6b, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x65, 0x52, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x18,
-	0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
-	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b,
-	0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x21, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52,
-	0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61,
-	0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b,
-	0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a,
-	0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x56, 0x49, 0x44,
-	0x49, 0x41, 0x2f, 0x6e, 0x63, 0x78, 0x2d, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2d, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x72, 0x6c, 0x61,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_rla_proto_rawDesc = "" +
+	"\n" +
+	"\trla.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x16\n" +
+	"\x04UUID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xdf\x01\n" +
+	"\n" +
+	"DeviceInfo\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\fmanufacturer\x18\x03 \x01(\tR\fmanufacturer\x12\x19\n" +
+	"\x05model\x18\x04 \x01(\tH\x00R\x05model\x88\x01\x01\x12#\n" +
+	"\rserial_number\x18\x05 \x01(\tR\fserialNumber\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x01R\vdescription\x88\x01\x01B\b\n" +
+	"\x06_modelB\x0e\n" +
+	"\f_description\"r\n" +
+	"\bLocation\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"datacenter\x18\x02 \x01(\tR\n" +
+	"datacenter\x12\x12\n" +
+	"\x04room\x18\x03 \x01(\tR\x04room\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\tR\bposition\"[\n" +
+	"\x10DeviceSerialInfo\x12\"\n" +
+	"\fmanufacturer\x18\x01 \x01(\tR\fmanufacturer\x12#\n" +
+	"\rserial_number\x18\x02 \x01(\tR\fserialNumber\"~\n" +
+	"\aBMCInfo\x12\x1f\n" +
+	"\x04type\x18\x01 \x01(\x0e2\v.v1.BMCTypeR\x04type\x12\x1f\n" +
+	"\vmac_address\x18\x02 \x01(\tR\n" +
+	"macAddress\x12\"\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tH\x00R\tipAddress\x88\x01\x01B\r\n" +
+	"\v_ip_address\"[\n" +
+	"\fRackPosition\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\x05R\x06slotId\x12\x19\n" +
+	"\btray_idx\x18\x02 \x01(\x05R\atrayIdx\x12\x17\n" +
+	"\ahost_id\x18\x03 \x01(\x05R\x06hostId\"\xb7\x02\n" +
+	"\tComponent\x12%\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x11.v1.ComponentTypeR\x04type\x12\"\n" +
+	"\x04info\x18\x02 \x01(\v2\x0e.v1.DeviceInfoR\x04info\x12)\n" +
+	"\x10firmware_version\x18\x03 \x01(\tR\x0ffirmwareVersion\x12,\n" +
+	"\bposition\x18\x04 \x01(\v2\x10.v1.RackPositionR\bposition\x12\x1f\n" +
+	"\x04bmcs\x18\x05 \x03(\v2\v.v1.BMCInfoR\x04bmcs\x12!\n" +
+	"\fcomponent_id\x18\x06 \x01(\tR\vcomponentId\x12!\n" +
+	"\arack_id\x18\a \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1f\n" +
+	"\vpower_state\x18\b \x01(\tR\n" +
+	"powerState\"\x83\x01\n" +
+	"\x04Rack\x12\"\n" +
+	"\x04info\x18\x01 \x01(\v2\x0e.v1.DeviceInfoR\x04info\x12(\n" +
+	"\blocation\x18\x02 \x01(\v2\f.v1.LocationR\blocation\x12-\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2\r.v1.ComponentR\n" +
+	"components\":\n" +
+	"\n" +
+	"Identifier\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x81\x01\n" +
+	"\x13OperationTargetSpec\x12'\n" +
+	"\x05racks\x18\x01 \x01(\v2\x0f.v1.RackTargetsH\x00R\x05racks\x126\n" +
+	"\n" +
+	"components\x18\x02 \x01(\v2\x14.v1.ComponentTargetsH\x00R\n" +
+	"componentsB\t\n" +
+	"\atargets\"7\n" +
+	"\vRackTargets\x12(\n" +
+	"\atargets\x18\x01 \x03(\v2\x0e.v1.RackTargetR\atargets\"A\n" +
+	"\x10ComponentTargets\x12-\n" +
+	"\atargets\x18\x01 \x03(\v2\x13.v1.ComponentTargetR\atargets\"9\n" +
+	"\x0eComponentTypes\x12'\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x11.v1.ComponentTypeR\x05types\"\x88\x01\n" +
+	"\n" +
+	"RackTarget\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x02id\x12\x14\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x12:\n" +
+	"\x0fcomponent_types\x18\x03 \x03(\x0e2\x11.v1.ComponentTypeR\x0ecomponentTypesB\f\n" +
+	"\n" +
+	"identifier\"j\n" +
+	"\x0fComponentTarget\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x02id\x12-\n" +
+	"\bexternal\x18\x02 \x01(\v2\x0f.v1.ExternalRefH\x00R\bexternalB\f\n" +
+	"\n" +
+	"identifier\"D\n" +
+	"\vExternalRef\x12%\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x11.v1.ComponentTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\";\n" +
+	"\tNVLDomain\x12.\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\v2\x0e.v1.IdentifierR\n" +
+	"identifier\":\n" +
+	"\n" +
+	"Pagination\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"e\n" +
+	"\x0fStringQueryInfo\x12\x1a\n" +
+	"\bpatterns\x18\x01 \x03(\tR\bpatterns\x12\x1f\n" +
+	"\vis_wildcard\x18\x02 \x01(\bR\n" +
+	"isWildcard\x12\x15\n" +
+	"\x06use_or\x18\x03 \x01(\bR\x05useOr\"\xc0\x01\n" +
+	"\x06Filter\x124\n" +
+	"\n" +
+	"rack_field\x18\x01 \x01(\x0e2\x13.v1.RackFilterFieldH\x00R\trackField\x12C\n" +
+	"\x0fcomponent_field\x18\x02 \x01(\x0e2\x18.v1.ComponentFilterFieldH\x00R\x0ecomponentField\x122\n" +
+	"\n" +
+	"query_info\x18\x03 \x01(\v2\x13.v1.StringQueryInfoR\tqueryInfoB\a\n" +
+	"\x05field\"\xad\x01\n" +
+	"\aOrderBy\x125\n" +
+	"\n" +
+	"rack_field\x18\x01 \x01(\x0e2\x14.v1.RackOrderByFieldH\x00R\trackField\x12D\n" +
+	"\x0fcomponent_field\x18\x02 \x01(\x0e2\x19.v1.ComponentOrderByFieldH\x00R\x0ecomponentField\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirectionB\a\n" +
+	"\x05field\"\xfb\a\n" +
+	"\x04Task\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12!\n" +
+	"\arack_id\x18\x03 \x01(\v2\b.v1.UUIDR\x06rackId\x121\n" +
+	"\x0fcomponent_uuids\x18\x04 \x03(\v2\b.v1.UUIDR\x0ecomponentUuids\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x129\n" +
+	"\rexecutor_type\x18\x06 \x01(\x0e2\x14.v1.TaskExecutorTypeR\fexecutorType\x12!\n" +
+	"\fexecution_id\x18\a \x01(\tR\vexecutionId\x12&\n" +
+	"\x06status\x18\b \x01(\x0e2\x0e.v1.TaskStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12I\n" +
+	"\x10queue_expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0equeueExpiresAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12@\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"finishedAt\x88\x01\x01\x125\n" +
+	"\x0fapplied_rule_id\x18\r \x01(\v2\b.v1.UUIDH\x02R\rappliedRuleId\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\n" +
+	"started_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tstartedAt\x88\x01\x01\x12.\n" +
+	"\tapprovals\x18\x10 \x03(\v2\x10.v1.TaskApprovalR\tapprovals\x12>\n" +
+	"\n" +
+	"not_before\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tnotBefore\x88\x01\x01\x12,\n" +
+	"\bpriority\x18\x12 \x01(\x0e2\x10.v1.TaskPriorityR\bpriority\x124\n" +
+	"\vpreemptions\x18\x13 \x03(\v2\x12.v1.TaskPreemptionR\vpreemptionsB\x13\n" +
+	"\x11_queue_expires_atB\x0e\n" +
+	"\f_finished_atB\x12\n" +
+	"\x10_applied_rule_idB\r\n" +
+	"\v_started_atB\r\n" +
+	"\v_not_before\"\xde\x01\n" +
+	"\x0eTaskPreemption\x12+\n" +
+	"\fpreempted_by\x18\x01 \x01(\v2\b.v1.UUIDR\vpreemptedBy\x12,\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x10.v1.TaskPriorityR\bpriority\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\brequeued\x18\x04 \x01(\bR\brequeued\x12=\n" +
+	"\fpreempted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vpreemptedAt\"\xd6\x03\n" +
+	"\fTaskApproval\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\tR\x04step\x12\x16\n" +
+	"\x06prompt\x18\x03 \x01(\tR\x06prompt\x12=\n" +
+	"\frequested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12?\n" +
+	"\x10default_decision\x18\x06 \x01(\x0e2\x14.v1.ApprovalDecisionR\x0fdefaultDecision\x120\n" +
+	"\bdecision\x18\a \x01(\x0e2\x14.v1.ApprovalDecisionR\bdecision\x12\x1a\n" +
+	"\bapprover\x18\b \x01(\tR\bapprover\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\x12>\n" +
+	"\n" +
+	"decided_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdecidedAt\x88\x01\x01\x12\x1b\n" +
+	"\ttimed_out\x18\v \x01(\bR\btimedOutB\r\n" +
+	"\v_decided_at\"9\n" +
+	"\x19CreateExpectedRackRequest\x12\x1c\n" +
+	"\x04rack\x18\x01 \x01(\v2\b.v1.RackR\x04rack\"6\n" +
+	"\x1aCreateExpectedRackResponse\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"[\n" +
+	"\x16GetRackInfoByIDRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12'\n" +
+	"\x0fwith_components\x18\x02 \x01(\bR\x0ewithComponents\"|\n" +
+	"\x1aGetRackInfoBySerialRequest\x125\n" +
+	"\vserial_info\x18\x01 \x01(\v2\x14.v1.DeviceSerialInfoR\n" +
+	"serialInfo\x12'\n" +
+	"\x0fwith_components\x18\x02 \x01(\bR\x0ewithComponents\"3\n" +
+	"\x13GetRackInfoResponse\x12\x1c\n" +
+	"\x04rack\x18\x01 \x01(\v2\b.v1.RackR\x04rack\"0\n" +
+	"\x10PatchRackRequest\x12\x1c\n" +
+	"\x04rack\x18\x01 \x01(\v2\b.v1.RackR\x04rack\"+\n" +
+	"\x11PatchRackResponse\x12\x16\n" +
+	"\x06report\x18\x01 \x01(\tR\x06report\"T\n" +
+	"\x1bGetComponentInfoByIDRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x1b\n" +
+	"\twith_rack\x18\x02 \x01(\bR\bwithRack\"u\n" +
+	"\x1fGetComponentInfoBySerialRequest\x125\n" +
+	"\vserial_info\x18\x01 \x01(\v2\x14.v1.DeviceSerialInfoR\n" +
+	"serialInfo\x12\x1b\n" +
+	"\twith_rack\x18\x02 \x01(\bR\bwithRack\"e\n" +
+	"\x18GetComponentInfoResponse\x12+\n" +
+	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\x12\x1c\n" +
+	"\x04rack\x18\x02 \x01(\v2\b.v1.RackR\x04rack\"\xe4\x01\n" +
+	"\x15GetListOfRacksRequest\x12$\n" +
+	"\afilters\x18\x01 \x03(\v2\n" +
+	".v1.FilterR\afilters\x12'\n" +
+	"\x0fwith_components\x18\x02 \x01(\bR\x0ewithComponents\x123\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x0e.v1.PaginationH\x00R\n" +
+	"pagination\x88\x01\x01\x12+\n" +
+	"\border_by\x18\x04 \x01(\v2\v.v1.OrderByH\x01R\aorderBy\x88\x01\x01B\r\n" +
+	"\v_paginationB\v\n" +
+	"\t_order_by\"N\n" +
+	"\x16GetListOfRacksResponse\x12\x1e\n" +
+	"\x05racks\x18\x01 \x03(\v2\b.v1.RackR\x05racks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"F\n" +
+	"\x16CreateNVLDomainRequest\x12,\n" +
+	"\n" +
+	"nvl_domain\x18\x01 \x01(\v2\r.v1.NVLDomainR\tnvlDomain\"3\n" +
+	"\x17CreateNVLDomainResponse\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\x9e\x01\n" +
+	"\x1dAttachRacksToNVLDomainRequest\x12B\n" +
+	"\x15nvl_domain_identifier\x18\x01 \x01(\v2\x0e.v1.IdentifierR\x13nvlDomainIdentifier\x129\n" +
+	"\x10rack_identifiers\x18\x02 \x03(\v2\x0e.v1.IdentifierR\x0frackIdentifiers\"\\\n" +
+	"\x1fDetachRacksFromNVLDomainRequest\x129\n" +
+	"\x10rack_identifiers\x18\x01 \x03(\v2\x0e.v1.IdentifierR\x0frackIdentifiers\"\x89\x01\n" +
+	"\x1aGetListOfNVLDomainsRequest\x12'\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.v1.StringQueryInfoR\x04info\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x0e.v1.PaginationH\x00R\n" +
+	"pagination\x88\x01\x01B\r\n" +
+	"\v_pagination\"c\n" +
+	"\x1bGetListOfNVLDomainsResponse\x12.\n" +
+	"\vnvl_domains\x18\x01 \x03(\v2\r.v1.NVLDomainR\n" +
+	"nvlDomains\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"a\n" +
+	"\x1bGetRacksForNVLDomainRequest\x12B\n" +
+	"\x15nvl_domain_identifier\x18\x01 \x01(\v2\x0e.v1.IdentifierR\x13nvlDomainIdentifier\">\n" +
+	"\x1cGetRacksForNVLDomainResponse\x12\x1e\n" +
+	"\x05racks\x18\x01 \x03(\v2\b.v1.RackR\x05racks\"\x9c\x04\n" +
+	"\x16UpgradeFirmwareRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\x12*\n" +
+	"\x0etarget_version\x18\x02 \x01(\tH\x00R\rtargetVersion\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendTime\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12:\n" +
+	"\rqueue_options\x18\x06 \x01(\v2\x10.v1.QueueOptionsH\x03R\fqueueOptions\x88\x01\x01\x12&\n" +
+	"\arule_id\x18\a \x01(\v2\b.v1.UUIDH\x04R\x06ruleId\x88\x01\x01\x12=\n" +
+	"\vmaintenance\x18\b \x01(\v2\x16.v1.MaintenanceOptionsH\x05R\vmaintenance\x88\x01\x01B\x11\n" +
+	"\x0f_target_versionB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\x10\n" +
+	"\x0e_queue_optionsB\n" +
+	"\n" +
+	"\b_rule_idB\x0e\n" +
+	"\f_maintenance\"\x89\x02\n" +
+	"\x14GetComponentsRequest\x12=\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecH\x00R\n" +
+	"targetSpec\x88\x01\x01\x12$\n" +
+	"\afilters\x18\x02 \x03(\v2\n" +
+	".v1.FilterR\afilters\x123\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x0e.v1.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01\x12+\n" +
+	"\border_by\x18\x04 \x01(\v2\v.v1.OrderByH\x02R\aorderBy\x88\x01\x01B\x0e\n" +
+	"\f_target_specB\r\n" +
+	"\v_paginationB\v\n" +
+	"\t_order_by\"\\\n" +
+	"\x15GetComponentsResponse\x12-\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\r.v1.ComponentR\n" +
+	"components\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x8e\x02\n" +
+	"\x19ValidateComponentsRequest\x12=\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecH\x00R\n" +
+	"targetSpec\x88\x01\x01\x12$\n" +
+	"\afilters\x18\x02 \x03(\v2\n" +
+	".v1.FilterR\afilters\x123\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x0e.v1.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01\x12+\n" +
+	"\border_by\x18\x04 \x01(\v2\v.v1.OrderByH\x02R\aorderBy\x88\x01\x01B\x0e\n" +
+	"\f_target_specB\r\n" +
+	"\v_paginationB\v\n" +
+	"\t_order_by\"\xf8\x01\n" +
+	"\x1aValidateComponentsResponse\x12'\n" +
+	"\x05diffs\x18\x01 \x03(\v2\x11.v1.ComponentDiffR\x05diffs\x12\x1f\n" +
+	"\vtotal_diffs\x18\x02 \x01(\x05R\n" +
+	"totalDiffs\x12#\n" +
+	"\rmissing_count\x18\x03 \x01(\x05R\fmissingCount\x12)\n" +
+	"\x10unexpected_count\x18\x04 \x01(\x05R\x0funexpectedCount\x12\x1f\n" +
+	"\vdrift_count\x18\x05 \x01(\x05R\n" +
+	"driftCount\x12\x1f\n" +
+	"\vmatch_count\x18\x06 \x01(\x05R\n" +
+	"matchCount\"\xf0\x01\n" +
+	"\rComponentDiff\x12 \n" +
+	"\x04type\x18\x01 \x01(\x0e2\f.v1.DiffTypeR\x04type\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\tR\vcomponentId\x12)\n" +
+	"\bexpected\x18\x03 \x01(\v2\r.v1.ComponentR\bexpected\x12%\n" +
+	"\x06actual\x18\x04 \x01(\v2\r.v1.ComponentR\x06actual\x12.\n" +
+	"\vfield_diffs\x18\x05 \x03(\v2\r.v1.FieldDiffR\n" +
+	"fieldDiffs\x12\x18\n" +
+	"\x02id\x18\x06 \x01(\v2\b.v1.UUIDR\x02id\"t\n" +
+	"\tFieldDiff\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x01 \x01(\tR\tfieldName\x12%\n" +
+	"\x0eexpected_value\x18\x02 \x01(\tR\rexpectedValue\x12!\n" +
+	"\factual_value\x18\x03 \x01(\tR\vactualValue\"B\n" +
+	"\x13AddComponentRequest\x12+\n" +
+	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\"C\n" +
+	"\x14AddComponentResponse\x12+\n" +
+	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\"2\n" +
+	"\x16DeleteComponentRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\x19\n" +
+	"\x17DeleteComponentResponse\"-\n" +
+	"\x11DeleteRackRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\x14\n" +
+	"\x12DeleteRackResponse\",\n" +
+	"\x10PurgeRackRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\x13\n" +
+	"\x11PurgeRackResponse\"1\n" +
+	"\x15PurgeComponentRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\x18\n" +
+	"\x16PurgeComponentResponse\"\xc2\x02\n" +
+	"\x15PatchComponentRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12.\n" +
+	"\x10firmware_version\x18\x02 \x01(\tH\x00R\x0ffirmwareVersion\x88\x01\x01\x121\n" +
+	"\bposition\x18\x03 \x01(\v2\x10.v1.RackPositionH\x01R\bposition\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12&\n" +
+	"\arack_id\x18\x05 \x01(\v2\b.v1.UUIDH\x03R\x06rackId\x88\x01\x01\x12\x1f\n" +
+	"\x04bmcs\x18\x06 \x03(\v2\v.v1.BMCInfoR\x04bmcsB\x13\n" +
+	"\x11_firmware_versionB\v\n" +
+	"\t_positionB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_rack_id\"E\n" +
+	"\x16PatchComponentResponse\x12+\n" +
+	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\"9\n" +
+	"\x12SubmitTaskResponse\x12#\n" +
+	"\btask_ids\x18\x01 \x03(\v2\b.v1.UUIDR\ataskIds\"\xe3\x01\n" +
+	"\fQueueOptions\x12A\n" +
+	"\x11conflict_strategy\x18\x01 \x01(\x0e2\x14.v1.ConflictStrategyR\x10conflictStrategy\x122\n" +
+	"\x15queue_timeout_seconds\x18\x02 \x01(\x05R\x13queueTimeoutSeconds\x12,\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x10.v1.TaskPriorityR\bpriority\x12.\n" +
+	"\n" +
+	"preemption\x18\x04 \x01(\x0e2\x0e.v1.PreemptionR\n" +
+	"preemption\"\x8a\x01\n" +
+	"\x12MaintenanceOptions\x12-\n" +
+	"\x06policy\x18\x01 \x01(\x0e2\x15.v1.MaintenancePolicyR\x06policy\x128\n" +
+	"\boverride\x18\x02 \x01(\v2\x17.v1.MaintenanceOverrideH\x00R\boverride\x88\x01\x01B\v\n" +
+	"\t_override\"P\n" +
+	"\x13MaintenanceOverride\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"\xf2\x01\n" +
+	"\x12PowerOnRackRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12:\n" +
+	"\rqueue_options\x18\x03 \x01(\v2\x10.v1.QueueOptionsH\x00R\fqueueOptions\x88\x01\x01\x12&\n" +
+	"\arule_id\x18\x04 \x01(\v2\b.v1.UUIDH\x01R\x06ruleId\x88\x01\x01B\x10\n" +
+	"\x0e_queue_optionsB\n" +
+	"\n" +
+	"\b_rule_id\"\xda\x02\n" +
+	"\x13PowerOffRackRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\x12\x16\n" +
+	"\x06forced\x18\x02 \x01(\bR\x06forced\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\rqueue_options\x18\x04 \x01(\v2\x10.v1.QueueOptionsH\x00R\fqueueOptions\x88\x01\x01\x12&\n" +
+	"\arule_id\x18\x05 \x01(\v2\b.v1.UUIDH\x01R\x06ruleId\x88\x01\x01\x12=\n" +
+	"\vmaintenance\x18\x06 \x01(\v2\x16.v1.MaintenanceOptionsH\x02R\vmaintenance\x88\x01\x01B\x10\n" +
+	"\x0e_queue_optionsB\n" +
+	"\n" +
+	"\b_rule_idB\x0e\n" +
+	"\f_maintenance\"\xdc\x02\n" +
+	"\x15PowerResetRackRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\x12\x16\n" +
+	"\x06forced\x18\x02 \x01(\bR\x06forced\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\rqueue_options\x18\x04 \x01(\v2\x10.v1.QueueOptionsH\x00R\fqueueOptions\x88\x01\x01\x12&\n" +
+	"\arule_id\x18\x05 \x01(\v2\b.v1.UUIDH\x01R\x06ruleId\x88\x01\x01\x12=\n" +
+	"\vmaintenance\x18\x06 \x01(\v2\x16.v1.MaintenanceOptionsH\x02R\vmaintenance\x88\x01\x01B\x10\n" +
+	"\x0e_queue_optionsB\n" +
+	"\n" +
+	"\b_rule_idB\x0e\n" +
+	"\f_maintenance\"\xf3\x01\n" +
+	"\x12BringUpRackRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12&\n" +
+	"\arule_id\x18\x03 \x01(\v2\b.v1.UUIDH\x00R\x06ruleId\x88\x01\x01\x12=\n" +
+	"\vmaintenance\x18\x04 \x01(\v2\x16.v1.MaintenanceOptionsH\x01R\vmaintenance\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rule_idB\x0e\n" +
+	"\f_maintenance\"\xc9\x01\n" +
+	"\x11IngestRackRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\x12$\n" +
+	"\afilters\x18\x02 \x03(\v2\n" +
+	".v1.FilterR\afilters\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12&\n" +
+	"\arule_id\x18\x04 \x01(\v2\b.v1.UUIDH\x00R\x06ruleId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rule_id\"\xfd\x02\n" +
+	"\x14PlanOperationRequest\x123\n" +
+	"\bpower_on\x18\x01 \x01(\v2\x16.v1.PowerOnRackRequestH\x00R\apowerOn\x126\n" +
+	"\tpower_off\x18\x02 \x01(\v2\x17.v1.PowerOffRackRequestH\x00R\bpowerOff\x12<\n" +
+	"\vpower_reset\x18\x03 \x01(\v2\x19.v1.PowerResetRackRequestH\x00R\n" +
+	"powerReset\x12G\n" +
+	"\x10upgrade_firmware\x18\x04 \x01(\v2\x1a.v1.UpgradeFirmwareRequestH\x00R\x0fupgradeFirmware\x123\n" +
+	"\bbring_up\x18\x05 \x01(\v2\x16.v1.BringUpRackRequestH\x00R\abringUp\x12/\n" +
+	"\x06ingest\x18\x06 \x01(\v2\x15.v1.IngestRackRequestH\x00R\x06ingestB\v\n" +
+	"\toperation\"D\n" +
+	"\x15PlanOperationResponse\x12+\n" +
+	"\x05plans\x18\x01 \x03(\v2\x15.v1.RackOperationPlanR\x05plans\"\xc7\x03\n" +
+	"\x11RackOperationPlan\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1b\n" +
+	"\track_name\x18\x02 \x01(\tR\brackName\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12%\n" +
+	"\x04rule\x18\x04 \x01(\v2\x11.v1.OperationRuleR\x04rule\x12/\n" +
+	"\vrule_source\x18\x05 \x01(\x0e2\x0e.v1.RuleSourceR\n" +
+	"ruleSource\x12(\n" +
+	"\x06stages\x18\x06 \x03(\v2\x10.v1.PlannedStageR\x06stages\x12<\n" +
+	"\x1aestimated_duration_seconds\x18\a \x01(\x03R\x18estimatedDurationSeconds\x125\n" +
+	"\x11conflicting_tasks\x18\b \x03(\v2\b.v1.TaskR\x10conflictingTasks\x12)\n" +
+	"\aoutcome\x18\t \x01(\x0e2\x0f.v1.PlanOutcomeR\aoutcome\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1a\n" +
+	"\bwarnings\x18\v \x03(\tR\bwarnings\"\x8b\x01\n" +
+	"\fPlannedStage\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12%\n" +
+	"\x05steps\x18\x02 \x03(\v2\x0f.v1.PlannedStepR\x05steps\x12<\n" +
+	"\x1aestimated_duration_seconds\x18\x03 \x01(\x03R\x18estimatedDurationSeconds\"\xf0\x01\n" +
+	"\vPlannedStep\x128\n" +
+	"\x0ecomponent_type\x18\x01 \x01(\x0e2\x11.v1.ComponentTypeR\rcomponentType\x12!\n" +
+	"\fmax_parallel\x18\x02 \x01(\x05R\vmaxParallel\x12,\n" +
+	"\abatches\x18\x03 \x03(\v2\x12.v1.ComponentBatchR\abatches\x12\x18\n" +
+	"\aactions\x18\x04 \x03(\tR\aactions\x12<\n" +
+	"\x1aestimated_duration_seconds\x18\x05 \x01(\x03R\x18estimatedDurationSeconds\"?\n" +
+	"\x0eComponentBatch\x12-\n" +
+	"\rcomponent_ids\x18\x01 \x03(\v2\b.v1.UUIDR\fcomponentIds\"\xab\x01\n" +
+	"\x10ListTasksRequest\x12&\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x88\x01\x01\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\x123\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x0e.v1.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rack_idB\r\n" +
+	"\v_pagination\"I\n" +
+	"\x11ListTasksResponse\x12\x1e\n" +
+	"\x05tasks\x18\x01 \x03(\v2\b.v1.TaskR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\";\n" +
+	"\x14GetTasksByIDsRequest\x12#\n" +
+	"\btask_ids\x18\x01 \x03(\v2\b.v1.UUIDR\ataskIds\"7\n" +
+	"\x15GetTasksByIDsResponse\x12\x1e\n" +
+	"\x05tasks\x18\x01 \x03(\v2\b.v1.TaskR\x05tasks\"6\n" +
+	"\x11CancelTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06taskId\"2\n" +
+	"\x12CancelTaskResponse\x12\x1c\n" +
+	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\"\x92\x01\n" +
+	"\x16ApproveTaskStepRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06taskId\x12\x1f\n" +
+	"\vapproval_id\x18\x02 \x01(\tR\n" +
+	"approvalId\x12\x1a\n" +
+	"\bapprover\x18\x03 \x01(\tR\bapprover\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"7\n" +
+	"\x17ApproveTaskStepResponse\x12\x1c\n" +
+	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\"\xa7\x01\n" +
+	"\x15RejectTaskStepRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06taskId\x12\x1f\n" +
+	"\vapproval_id\x18\x02 \x01(\tR\n" +
+	"approvalId\x12\x1a\n" +
+	"\bapprover\x18\x03 \x01(\tR\bapprover\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x14\n" +
+	"\x05abort\x18\x05 \x01(\bR\x05abort\"6\n" +
+	"\x16RejectTaskStepResponse\x12\x1c\n" +
+	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\"\xab\x02\n" +
+	"\x0fTaskReportEntry\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x128\n" +
+	"\x0ecomponent_type\x18\x02 \x01(\x0e2\x11.v1.ComponentTypeR\rcomponentType\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\tR\vcomponentId\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\x05R\x05stage\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\tR\x04step\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12/\n" +
+	"\aoutcome\x18\a \x01(\x0e2\x15.v1.TaskReportOutcomeR\aoutcome\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"g\n" +
+	"\x14GetTaskReportRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06taskId\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.v1.TaskReportFormatR\x06format\"~\n" +
+	"\x15GetTaskReportResponse\x12\x1c\n" +
+	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\x12-\n" +
+	"\aentries\x18\x02 \x03(\v2\x13.v1.TaskReportEntryR\aentries\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"\x10\n" +
+	"\x0eVersionRequest\"c\n" +
+	"\tBuildInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1d\n" +
+	"\n" +
+	"build_time\x18\x02 \x01(\tR\tbuildTime\x12\x1d\n" +
+	"\n" +
+	"git_commit\x18\x03 \x01(\tR\tgitCommit\"\x87\x03\n" +
+	"\rOperationRule\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x128\n" +
+	"\x0eoperation_type\x18\x04 \x01(\x0e2\x11.v1.OperationTypeR\roperationType\x12%\n" +
+	"\x0eoperation_code\x18\x05 \x01(\tR\roperationCode\x120\n" +
+	"\x14rule_definition_json\x18\x06 \x01(\tR\x12ruleDefinitionJson\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\a \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x84\x02\n" +
+	"\x1aCreateOperationRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x128\n" +
+	"\x0eoperation_type\x18\x03 \x01(\x0e2\x11.v1.OperationTypeR\roperationType\x12%\n" +
+	"\x0eoperation_code\x18\x04 \x01(\tR\roperationCode\x120\n" +
+	"\x14rule_definition_json\x18\x05 \x01(\tR\x12ruleDefinitionJson\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\"7\n" +
+	"\x1bCreateOperationRuleResponse\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\xe8\x01\n" +
+	"\x1aUpdateOperationRuleRequest\x12!\n" +
+	"\arule_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06ruleId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x125\n" +
+	"\x14rule_definition_json\x18\x04 \x01(\tH\x02R\x12ruleDefinitionJson\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x17\n" +
+	"\x15_rule_definition_json\"?\n" +
+	"\x1aDeleteOperationRuleRequest\x12!\n" +
+	"\arule_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06ruleId\"<\n" +
+	"\x17SetRuleAsDefaultRequest\x12!\n" +
+	"\arule_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06ruleId\"<\n" +
+	"\x17GetOperationRuleRequest\x12!\n" +
+	"\arule_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06ruleId\"\xed\x01\n" +
+	"\x19ListOperationRulesRequest\x12=\n" +
+	"\x0eoperation_type\x18\x01 \x01(\x0e2\x11.v1.OperationTypeH\x00R\roperationType\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x02 \x01(\bH\x01R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x03 \x01(\x05H\x02R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01B\x11\n" +
+	"\x0f_operation_typeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"f\n" +
+	"\x1aListOperationRulesResponse\x12'\n" +
+	"\x05rules\x18\x01 \x03(\v2\x11.v1.OperationRuleR\x05rules\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"d\n" +
+	"\x1cAssociateRuleWithRackRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12!\n" +
+	"\arule_id\x18\x02 \x01(\v2\b.v1.UUIDR\x06ruleId\"\xa5\x01\n" +
+	"\x1fDisassociateRuleFromRackRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x128\n" +
+	"\x0eoperation_type\x18\x02 \x01(\x0e2\x11.v1.OperationTypeR\roperationType\x12%\n" +
+	"\x0eoperation_code\x18\x03 \x01(\tR\roperationCode\"\xa3\x01\n" +
+	"\x1dGetRackRuleAssociationRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x128\n" +
+	"\x0eoperation_type\x18\x02 \x01(\x0e2\x11.v1.OperationTypeR\roperationType\x12%\n" +
+	"\x0eoperation_code\x18\x03 \x01(\tR\roperationCode\"C\n" +
+	"\x1eGetRackRuleAssociationResponse\x12!\n" +
+	"\arule_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06ruleId\"D\n" +
+	"\x1fListRackRuleAssociationsRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\"\xb2\x02\n" +
+	"\x13RackRuleAssociation\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x128\n" +
+	"\x0eoperation_type\x18\x02 \x01(\x0e2\x11.v1.OperationTypeR\roperationType\x12%\n" +
+	"\x0eoperation_code\x18\x03 \x01(\tR\roperationCode\x12!\n" +
+	"\arule_id\x18\x04 \x01(\v2\b.v1.UUIDR\x06ruleId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"_\n" +
+	" ListRackRuleAssociationsResponse\x12;\n" +
+	"\fassociations\x18\x01 \x03(\v2\x17.v1.RackRuleAssociationR\fassociations\"h\n" +
+	"\fScheduleSpec\x12(\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x14.v1.ScheduleSpecTypeR\x04type\x12\x12\n" +
+	"\x04spec\x18\x02 \x01(\tR\x04spec\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\x84\x01\n" +
+	"\x0eScheduleConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
+	"\x04spec\x18\x02 \x01(\v2\x10.v1.ScheduleSpecR\x04spec\x128\n" +
+	"\x0eoverlap_policy\x18\x03 \x01(\x0e2\x11.v1.OverlapPolicyR\roverlapPolicy\"\x97\x04\n" +
+	"\fTaskSchedule\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\x04spec\x18\x03 \x01(\v2\x10.v1.ScheduleSpecR\x04spec\x128\n" +
+	"\x0eoverlap_policy\x18\x04 \x01(\x0e2\x11.v1.OverlapPolicyR\roverlapPolicy\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12?\n" +
+	"\vnext_run_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tnextRunAt\x88\x01\x01\x12?\n" +
+	"\vlast_run_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tlastRunAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0eoperation_type\x18\n" +
+	" \x01(\tR\roperationType\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescriptionB\x0e\n" +
+	"\f_next_run_atB\x0e\n" +
+	"\f_last_run_at\"\xfb\x02\n" +
+	"\x12ScheduledOperation\x123\n" +
+	"\bpower_on\x18\x01 \x01(\v2\x16.v1.PowerOnRackRequestH\x00R\apowerOn\x126\n" +
+	"\tpower_off\x18\x02 \x01(\v2\x17.v1.PowerOffRackRequestH\x00R\bpowerOff\x12<\n" +
+	"\vpower_reset\x18\x03 \x01(\v2\x19.v1.PowerResetRackRequestH\x00R\n" +
+	"powerReset\x123\n" +
+	"\bbring_up\x18\x04 \x01(\v2\x16.v1.BringUpRackRequestH\x00R\abringUp\x12G\n" +
+	"\x10upgrade_firmware\x18\x05 \x01(\v2\x1a.v1.UpgradeFirmwareRequestH\x00R\x0fupgradeFirmware\x12/\n" +
+	"\x06ingest\x18\x06 \x01(\v2\x15.v1.IngestRackRequestH\x00R\x06ingestB\v\n" +
+	"\toperation\"\x81\x01\n" +
+	"\x19CreateTaskScheduleRequest\x12.\n" +
+	"\bschedule\x18\x01 \x01(\v2\x12.v1.ScheduleConfigR\bschedule\x124\n" +
+	"\toperation\x18\x02 \x01(\v2\x16.v1.ScheduledOperationR\toperation\"2\n" +
+	"\x16GetTaskScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\xcb\x01\n" +
+	"\x18ListTaskSchedulesRequest\x12&\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x88\x01\x01\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x0e.v1.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01\x12&\n" +
+	"\fenabled_only\x18\x03 \x01(\bH\x02R\venabledOnly\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rack_idB\r\n" +
+	"\v_paginationB\x0f\n" +
+	"\r_enabled_only\"j\n" +
+	"\x19ListTaskSchedulesResponse\x127\n" +
+	"\x0etask_schedules\x18\x01 \x03(\v2\x10.v1.TaskScheduleR\rtaskSchedules\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa2\x01\n" +
+	"\x19UpdateTaskScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12.\n" +
+	"\bschedule\x18\x02 \x01(\v2\x12.v1.ScheduleConfigR\bschedule\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"4\n" +
+	"\x18PauseTaskScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"5\n" +
+	"\x19ResumeTaskScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"5\n" +
+	"\x19DeleteTaskScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"6\n" +
+	"\x1aTriggerTaskScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\xda\x02\n" +
+	"\x11TaskScheduleScope\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12)\n" +
+	"\vschedule_id\x18\x02 \x01(\v2\b.v1.UUIDR\n" +
+	"scheduleId\x12!\n" +
+	"\arack_id\x18\x03 \x01(\v2\b.v1.UUIDR\x06rackId\x12*\n" +
+	"\x05types\x18\x04 \x01(\v2\x12.v1.ComponentTypesH\x00R\x05types\x126\n" +
+	"\n" +
+	"components\x18\x05 \x01(\v2\x14.v1.ComponentTargetsH\x00R\n" +
+	"components\x12*\n" +
+	"\flast_task_id\x18\x06 \x01(\v2\b.v1.UUIDR\n" +
+	"lastTaskId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10component_filter\"\x82\x01\n" +
+	"\x1bAddTaskScheduleScopeRequest\x12)\n" +
+	"\vschedule_id\x18\x01 \x01(\v2\b.v1.UUIDR\n" +
+	"scheduleId\x128\n" +
+	"\vtarget_spec\x18\x02 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\"M\n" +
+	"\x1cAddTaskScheduleScopeResponse\x12-\n" +
+	"\x06scopes\x18\x01 \x03(\v2\x15.v1.TaskScheduleScopeR\x06scopes\"E\n" +
+	"\x1eRemoveTaskScheduleScopeRequest\x12#\n" +
+	"\bscope_id\x18\x01 \x01(\v2\b.v1.UUIDR\ascopeId\"\x89\x01\n" +
+	"\x1eUpdateTaskScheduleScopeRequest\x12)\n" +
+	"\vschedule_id\x18\x01 \x01(\v2\b.v1.UUIDR\n" +
+	"scheduleId\x12<\n" +
+	"\rdesired_scope\x18\x02 \x01(\v2\x17.v1.OperationTargetSpecR\fdesiredScope\"\x9a\x01\n" +
+	"\x1fUpdateTaskScheduleScopeResponse\x12-\n" +
+	"\x06scopes\x18\x01 \x03(\v2\x15.v1.TaskScheduleScopeR\x06scopes\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x18\n" +
+	"\aremoved\x18\x03 \x01(\x05R\aremoved\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\"J\n" +
+	"\x1dListTaskScheduleScopesRequest\x12)\n" +
+	"\vschedule_id\x18\x01 \x01(\v2\b.v1.UUIDR\n" +
+	"scheduleId\"O\n" +
+	"\x1eListTaskScheduleScopesResponse\x12-\n" +
+	"\x06scopes\x18\x01 \x03(\v2\x15.v1.TaskScheduleScopeR\x06scopes\"\xac\x01\n" +
+	"\x1dCheckScheduleConflictsRequest\x124\n" +
+	"\toperation\x18\x01 \x01(\v2\x16.v1.ScheduledOperationR\toperation\x12=\n" +
+	"\x13exclude_schedule_id\x18\x02 \x01(\v2\b.v1.UUIDH\x00R\x11excludeScheduleId\x88\x01\x01B\x16\n" +
+	"\x14_exclude_schedule_id\"P\n" +
+	"\x1eCheckScheduleConflictsResponse\x12.\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x10.v1.TaskScheduleR\tconflicts\"\xa4\x02\n" +
+	"\vPowerBudget\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12!\n" +
+	"\arack_id\x18\x02 \x01(\v2\b.v1.UUIDR\x06rackId\x120\n" +
+	"\fcomponent_id\x18\x03 \x01(\v2\b.v1.UUIDH\x00R\vcomponentId\x88\x01\x01\x12\x1f\n" +
+	"\vlimit_watts\x18\x04 \x01(\x01R\n" +
+	"limitWatts\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"\r_component_id\"\xd0\x01\n" +
+	"\x0fShelfPowerLimit\x12+\n" +
+	"\fcomponent_id\x18\x01 \x01(\v2\b.v1.UUIDR\vcomponentId\x12&\n" +
+	"\x0fpmc_mac_address\x18\x02 \x01(\tR\rpmcMacAddress\x12\x1f\n" +
+	"\vlimit_watts\x18\x03 \x01(\x01R\n" +
+	"limitWatts\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.v1.PowerLimitApplyStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x96\x01\n" +
+	"\x15SetPowerBudgetRequest\x12#\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x12-\n" +
+	"\fcomponent_id\x18\x02 \x01(\v2\b.v1.UUIDH\x00R\vcomponentId\x12\x1f\n" +
+	"\vlimit_watts\x18\x03 \x01(\x01R\n" +
+	"limitWattsB\b\n" +
+	"\x06target\"n\n" +
+	"\x16SetPowerBudgetResponse\x12'\n" +
+	"\x06budget\x18\x01 \x01(\v2\x0f.v1.PowerBudgetR\x06budget\x12+\n" +
+	"\x06limits\x18\x02 \x03(\v2\x13.v1.ShelfPowerLimitR\x06limits\"4\n" +
+	"\x18DeletePowerBudgetRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"H\n" +
+	"\x19DeletePowerBudgetResponse\x12+\n" +
+	"\x06limits\x18\x01 \x03(\v2\x13.v1.ShelfPowerLimitR\x06limits\">\n" +
+	"\x17ListPowerBudgetsRequest\x12#\n" +
+	"\brack_ids\x18\x01 \x03(\v2\b.v1.UUIDR\arackIds\"E\n" +
+	"\x18ListPowerBudgetsResponse\x12)\n" +
+	"\abudgets\x18\x01 \x03(\v2\x0f.v1.PowerBudgetR\abudgets\">\n" +
+	"\x19GetRackPowerStatusRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\"\x8c\x02\n" +
+	"\x10ShelfPowerStatus\x12+\n" +
+	"\fcomponent_id\x18\x01 \x01(\v2\b.v1.UUIDR\vcomponentId\x12&\n" +
+	"\x0fpmc_mac_address\x18\x02 \x01(\tR\rpmcMacAddress\x12\x1b\n" +
+	"\tcap_watts\x18\x03 \x01(\x01R\bcapWatts\x123\n" +
+	"\x13applied_limit_watts\x18\x04 \x01(\x01H\x00R\x11appliedLimitWatts\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"draw_watts\x18\x05 \x01(\x01R\tdrawWatts\x12\x1a\n" +
+	"\bmeasured\x18\x06 \x01(\bR\bmeasuredB\x16\n" +
+	"\x14_applied_limit_watts\"\xe3\x01\n" +
+	"\x0fRackPowerStatus\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12!\n" +
+	"\fbudget_watts\x18\x02 \x01(\x01R\vbudgetWatts\x12\x1d\n" +
+	"\n" +
+	"draw_watts\x18\x03 \x01(\x01R\tdrawWatts\x12\x1a\n" +
+	"\bmeasured\x18\x04 \x01(\bR\bmeasured\x12\x1f\n" +
+	"\vover_budget\x18\x05 \x01(\bR\n" +
+	"overBudget\x12.\n" +
+	"\ashelves\x18\x06 \x03(\v2\x14.v1.ShelfPowerStatusR\ashelves\"}\n" +
+	"\x1cRotateRackCredentialsRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12:\n" +
+	"\x0fcomponent_types\x18\x02 \x03(\x0e2\x11.v1.ComponentTypeR\x0ecomponentTypes\"\x80\x02\n" +
+	"\x19CredentialRotationTrigger\x12+\n" +
+	"\fcomponent_id\x18\x01 \x01(\v2\b.v1.UUIDR\vcomponentId\x128\n" +
+	"\x0ecomponent_type\x18\x02 \x01(\x0e2\x11.v1.ComponentTypeR\rcomponentType\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12/\n" +
+	"\aaccount\x18\x04 \x01(\x0e2\x15.v1.CredentialAccountR\aaccount\x12\x18\n" +
+	"\astarted\x18\x05 \x01(\bR\astarted\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"X\n" +
+	"\x1dRotateRackCredentialsResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.v1.CredentialRotationTriggerR\aresults\"K\n" +
+	"&GetRackCredentialRotationStatusRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\"\x8a\x04\n" +
+	"\x1eDeviceCredentialRotationStatus\x12+\n" +
+	"\fcomponent_id\x18\x01 \x01(\v2\b.v1.UUIDR\vcomponentId\x128\n" +
+	"\x0ecomponent_type\x18\x02 \x01(\x0e2\x11.v1.ComponentTypeR\rcomponentType\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12/\n" +
+	"\aaccount\x18\x04 \x01(\x0e2\x15.v1.CredentialAccountR\aaccount\x127\n" +
+	"\x05state\x18\x05 \x01(\x0e2!.v1.DeviceCredentialRotationStateR\x05state\x12=\n" +
+	"\flast_attempt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vlastAttempt\x12=\n" +
+	"\flast_rotated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastRotated\x12?\n" +
+	"\rnext_rotation\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fnextRotation\x12%\n" +
+	"\x0erotation_error\x18\t \x01(\tR\rrotationError\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\"i\n" +
+	"'GetRackCredentialRotationStatusResponse\x12>\n" +
+	"\bstatuses\x18\x01 \x03(\v2\".v1.DeviceCredentialRotationStatusR\bstatuses\"\x98\x05\n" +
+	"\x10DiscoveredDevice\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x1f\n" +
+	"\vmac_address\x18\x02 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12%\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x11.v1.ComponentTypeR\x04type\x12\"\n" +
+	"\fmanufacturer\x18\x05 \x01(\tR\fmanufacturer\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12#\n" +
+	"\rserial_number\x18\a \x01(\tR\fserialNumber\x12)\n" +
+	"\x10firmware_version\x18\b \x01(\tR\x0ffirmwareVersion\x12-\n" +
+	"\x10nvos_mac_address\x18\t \x01(\tH\x00R\x0envosMacAddress\x88\x01\x01\x12+\n" +
+	"\x0fnvos_ip_address\x18\n" +
+	" \x01(\tH\x01R\rnvosIpAddress\x88\x01\x01\x12+\n" +
+	"\fcomponent_id\x18\v \x01(\v2\b.v1.UUIDR\vcomponentId\x12/\n" +
+	"\x05state\x18\f \x01(\x0e2\x19.v1.DiscoveredDeviceStateR\x05state\x12\x18\n" +
+	"\amessage\x18\r \x01(\tR\amessage\x12>\n" +
+	"\rfirst_seen_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vfirstSeenAt\x12<\n" +
+	"\flast_seen_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAtB\x13\n" +
+	"\x11_nvos_mac_addressB\x12\n" +
+	"\x10_nvos_ip_address\"2\n" +
+	"\x16DiscoverDevicesRequest\x12\x18\n" +
+	"\asubnets\x18\x01 \x03(\tR\asubnets\"I\n" +
+	"\x17DiscoverDevicesResponse\x12.\n" +
+	"\adevices\x18\x01 \x03(\v2\x14.v1.DiscoveredDeviceR\adevices\"Q\n" +
+	"\x1cListDiscoveredDevicesRequest\x121\n" +
+	"\x06states\x18\x01 \x03(\x0e2\x19.v1.DiscoveredDeviceStateR\x06states\"O\n" +
+	"\x1dListDiscoveredDevicesResponse\x12.\n" +
+	"\adevices\x18\x01 \x03(\v2\x14.v1.DiscoveredDeviceR\adevices\"\xec\x01\n" +
+	"\x1eApproveDiscoveredDeviceRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12+\n" +
+	"\fcomponent_id\x18\x02 \x01(\v2\b.v1.UUIDR\vcomponentId\x12-\n" +
+	"\x10nvos_mac_address\x18\x03 \x01(\tH\x00R\x0envosMacAddress\x88\x01\x01\x12+\n" +
+	"\x0fnvos_ip_address\x18\x04 \x01(\tH\x01R\rnvosIpAddress\x88\x01\x01B\x13\n" +
+	"\x11_nvos_mac_addressB\x12\n" +
+	"\x10_nvos_ip_address\"9\n" +
+	"\x1dRejectDiscoveredDeviceRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"B\n" +
+	"\x0eIdentifierList\x120\n" +
+	"\videntifiers\x18\x01 \x03(\v2\x0e.v1.IdentifierR\videntifiers\"\xb1\x01\n" +
+	"\x14CampaignRackSelector\x12*\n" +
+	"\x05racks\x18\x01 \x01(\v2\x12.v1.IdentifierListH\x00R\x05racks\x125\n" +
+	"\vnvl_domains\x18\x02 \x01(\v2\x12.v1.IdentifierListH\x00R\n" +
+	"nvlDomains\x12*\n" +
+	"\blocation\x18\x03 \x01(\v2\f.v1.LocationH\x00R\blocationB\n" +
+	"\n" +
+	"\bselector\"\xa4\x01\n" +
+	"\x0eCampaignPolicy\x120\n" +
+	"\x14max_concurrent_racks\x18\x01 \x01(\x05R\x12maxConcurrentRacks\x126\n" +
+	"\x18max_racks_per_nvl_domain\x18\x02 \x01(\x05R\x14maxRacksPerNvlDomain\x12(\n" +
+	"\x10min_success_rate\x18\x03 \x01(\x01R\x0eminSuccessRate\"\xca\x01\n" +
+	"\x10CampaignProgress\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x05R\apending\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\x05R\arunning\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\x05R\askipped\x12\x1c\n" +
+	"\tcancelled\x18\a \x01(\x05R\tcancelled\"\xf4\x02\n" +
+	"\fCampaignRack\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1b\n" +
+	"\track_name\x18\x02 \x01(\tR\brackName\x12,\n" +
+	"\rnvl_domain_id\x18\x03 \x01(\v2\b.v1.UUIDR\vnvlDomainId\x12\x14\n" +
+	"\x05batch\x18\x04 \x01(\x05R\x05batch\x12!\n" +
+	"\atask_id\x18\x05 \x01(\v2\b.v1.UUIDR\x06taskId\x12+\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x15.v1.CampaignRackStateR\x05state\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\x93\x05\n" +
+	"\bCampaign\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
+	"\x0eoperation_type\x18\x04 \x01(\tR\roperationType\x123\n" +
+	"\x15operation_description\x18\x05 \x01(\tR\x14operationDescription\x12:\n" +
+	"\x0fcomponent_types\x18\x06 \x03(\x0e2\x11.v1.ComponentTypeR\x0ecomponentTypes\x12*\n" +
+	"\x06policy\x18\a \x01(\v2\x12.v1.CampaignPolicyR\x06policy\x12'\n" +
+	"\x05state\x18\b \x01(\x0e2\x11.v1.CampaignStateR\x05state\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12#\n" +
+	"\rcurrent_batch\x18\n" +
+	" \x01(\x05R\fcurrentBatch\x120\n" +
+	"\bprogress\x18\v \x01(\v2\x14.v1.CampaignProgressR\bprogress\x12&\n" +
+	"\x05racks\x18\f \x03(\v2\x10.v1.CampaignRackR\x05racks\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\x9b\x02\n" +
+	"\x15CreateCampaignRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x124\n" +
+	"\toperation\x18\x03 \x01(\v2\x16.v1.ScheduledOperationR\toperation\x12.\n" +
+	"\x05racks\x18\x04 \x01(\v2\x18.v1.CampaignRackSelectorR\x05racks\x12:\n" +
+	"\x0fcomponent_types\x18\x05 \x03(\x0e2\x11.v1.ComponentTypeR\x0ecomponentTypes\x12*\n" +
+	"\x06policy\x18\x06 \x01(\v2\x12.v1.CampaignPolicyR\x06policy\".\n" +
+	"\x12GetCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"A\n" +
+	"\x14ListCampaignsRequest\x12)\n" +
+	"\x06states\x18\x01 \x03(\x0e2\x11.v1.CampaignStateR\x06states\"C\n" +
+	"\x15ListCampaignsResponse\x12*\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\f.v1.CampaignR\tcampaigns\"0\n" +
+	"\x14PauseCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"1\n" +
+	"\x15ResumeCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"0\n" +
+	"\x14AbortCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"\x83\x01\n" +
+	"\x0fMaintenanceOnce\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"r\n" +
+	"\x15MaintenanceRecurrence\x12\x12\n" +
+	"\x04cron\x18\x01 \x01(\tR\x04cron\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\xc5\x03\n" +
+	"\x11MaintenanceWindow\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x19.v1.MaintenanceWindowKindR\x04kind\x12#\n" +
+	"\arack_id\x18\x05 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x12.\n" +
+	"\rnvl_domain_id\x18\x06 \x01(\v2\b.v1.UUIDH\x00R\vnvlDomainId\x12\"\n" +
+	"\x04site\x18\a \x01(\v2\f.v1.LocationH\x00R\x04site\x12)\n" +
+	"\x04once\x18\b \x01(\v2\x13.v1.MaintenanceOnceH\x01R\x04once\x12;\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2\x19.v1.MaintenanceRecurrenceH\x01R\n" +
+	"recurrence\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05scopeB\f\n" +
+	"\n" +
+	"occurrence\"\xfd\x02\n" +
+	"\x1eCreateMaintenanceWindowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12-\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x19.v1.MaintenanceWindowKindR\x04kind\x12#\n" +
+	"\arack_id\x18\x04 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x12.\n" +
+	"\rnvl_domain_id\x18\x05 \x01(\v2\b.v1.UUIDH\x00R\vnvlDomainId\x12\"\n" +
+	"\x04site\x18\x06 \x01(\v2\f.v1.LocationH\x00R\x04site\x12)\n" +
+	"\x04once\x18\a \x01(\v2\x13.v1.MaintenanceOnceH\x01R\x04once\x12;\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x19.v1.MaintenanceRecurrenceH\x01R\n" +
+	"recurrenceB\a\n" +
+	"\x05scopeB\f\n" +
+	"\n" +
+	"occurrence\":\n" +
+	"\x1eDeleteMaintenanceWindowRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"S\n" +
+	"\x1dListMaintenanceWindowsRequest\x12&\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rack_id\"Q\n" +
+	"\x1eListMaintenanceWindowsResponse\x12/\n" +
+	"\awindows\x18\x01 \x03(\v2\x15.v1.MaintenanceWindowR\awindows\"|\n" +
+	"\x1fGetRackMaintenanceStatusRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12/\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x02at\x88\x01\x01B\x05\n" +
+	"\x03_at\"\xb0\x02\n" +
+	"\x15RackMaintenanceStatus\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\bR\x04open\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x04 \x01(\bR\n" +
+	"restricted\x12D\n" +
+	"\ropening_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fopeningStart\x88\x01\x01\x12@\n" +
+	"\vopening_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"openingEnd\x88\x01\x01B\x10\n" +
+	"\x0e_opening_startB\x0e\n" +
+	"\f_opening_end\"k\n" +
+	"\x1fListMaintenanceOverridesRequest\x12&\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limitB\n" +
+	"\n" +
+	"\b_rack_id\"\xab\x02\n" +
+	"\x19MaintenanceOverrideRecord\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12!\n" +
+	"\atask_id\x18\x02 \x01(\v2\b.v1.UUIDR\x06taskId\x12!\n" +
+	"\arack_id\x18\x03 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12\x1a\n" +
+	"\bbypassed\x18\a \x01(\tR\bbypassed\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"_\n" +
+	" ListMaintenanceOverridesResponse\x12;\n" +
+	"\toverrides\x18\x01 \x03(\v2\x1d.v1.MaintenanceOverrideRecordR\toverrides\"\xa0\x04\n" +
+	"\x0eComponentDrift\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12+\n" +
+	"\fcomponent_id\x18\x02 \x01(\v2\b.v1.UUIDR\vcomponentId\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12!\n" +
+	"\arack_id\x18\x04 \x01(\v2\b.v1.UUIDR\x06rackId\x128\n" +
+	"\x0ecomponent_type\x18\x05 \x01(\x0e2\x11.v1.ComponentTypeR\rcomponentType\x12!\n" +
+	"\x04type\x18\x06 \x01(\x0e2\r.v1.DriftTypeR\x04type\x12#\n" +
+	"\x05diffs\x18\a \x03(\v2\r.v1.FieldDiffR\x05diffs\x12'\n" +
+	"\x06status\x18\b \x01(\x0e2\x0f.v1.DriftStatusR\x06status\x12%\n" +
+	"\x0edismiss_reason\x18\t \x01(\tR\rdismissReason\x12!\n" +
+	"\fdismissed_by\x18\n" +
+	" \x01(\tR\vdismissedBy\x12B\n" +
+	"\fdismissed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vdismissedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"checked_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAtB\x0f\n" +
+	"\r_dismissed_at\"\xd5\x01\n" +
+	"\x11ListDriftsRequest\x12&\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x88\x01\x01\x12#\n" +
+	"\x05types\x18\x02 \x03(\x0e2\r.v1.DriftTypeR\x05types\x12:\n" +
+	"\x0fcomponent_types\x18\x03 \x03(\x0e2\x11.v1.ComponentTypeR\x0ecomponentTypes\x12+\n" +
+	"\x11include_dismissed\x18\x04 \x01(\bR\x10includeDismissedB\n" +
+	"\n" +
+	"\b_rack_id\"@\n" +
+	"\x12ListDriftsResponse\x12*\n" +
+	"\x06drifts\x18\x01 \x03(\v2\x12.v1.ComponentDriftR\x06drifts\".\n" +
+	"\x12AcceptDriftRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"B\n" +
+	"\x13AcceptDriftResponse\x12+\n" +
+	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\"j\n" +
+	"\x13DismissDriftRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12!\n" +
+	"\fdismissed_by\x18\x03 \x01(\tR\vdismissedBy\"6\n" +
+	"\x11ResyncRackRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\"@\n" +
+	"\x12ResyncRackResponse\x12*\n" +
+	"\x06drifts\x18\x01 \x03(\v2\x12.v1.ComponentDriftR\x06drifts*D\n" +
+	"\aBMCType\x12\x14\n" +
+	"\x10BMC_TYPE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rBMC_TYPE_HOST\x10\x01\x12\x10\n" +
+	"\fBMC_TYPE_DPU\x10\x02*\xd2\x01\n" +
+	"\rComponentType\x12\x1a\n" +
+	"\x16COMPONENT_TYPE_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16COMPONENT_TYPE_COMPUTE\x10\x01\x12\x1c\n" +
+	"\x18COMPONENT_TYPE_NVLSWITCH\x10\x02\x12\x1d\n" +
+	"\x19COMPONENT_TYPE_POWERSHELF\x10\x03\x12\x1c\n" +
+	"\x18COMPONENT_TYPE_TORSWITCH\x10\x04\x12\x16\n" +
+	"\x12COMPONENT_TYPE_UMS\x10\x05\x12\x16\n" +
+	"\x12COMPONENT_TYPE_CDU\x10\x06*\x91\x01\n" +
+	"\x0fRackFilterField\x12!\n" +
+	"\x1dRACK_FILTER_FIELD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RACK_FILTER_FIELD_NAME\x10\x01\x12\"\n" +
+	"\x1eRACK_FILTER_FIELD_MANUFACTURER\x10\x02\x12\x1b\n" +
+	"\x17RACK_FILTER_FIELD_MODEL\x10\x03*\xcb\x01\n" +
+	"\x14ComponentFilterField\x12&\n" +
+	"\"COMPONENT_FILTER_FIELD_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bCOMPONENT_FILTER_FIELD_NAME\x10\x01\x12'\n" +
+	"#COMPONENT_FILTER_FIELD_MANUFACTURER\x10\x02\x12 \n" +
+	"\x1cCOMPONENT_FILTER_FIELD_MODEL\x10\x03\x12\x1f\n" +
+	"\x1bCOMPONENT_FILTER_FIELD_TYPE\x10\x04*\xd6\x01\n" +
+	"\x15ComponentOrderByField\x12(\n" +
+	"$COMPONENT_ORDER_BY_FIELD_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCOMPONENT_ORDER_BY_FIELD_NAME\x10\x01\x12)\n" +
+	"%COMPONENT_ORDER_BY_FIELD_MANUFACTURER\x10\x02\x12\"\n" +
+	"\x1eCOMPONENT_ORDER_BY_FIELD_MODEL\x10\x03\x12!\n" +
+	"\x1dCOMPONENT_ORDER_BY_FIELD_TYPE\x10\x04*\x9a\x01\n" +
+	"\x10RackOrderByField\x12#\n" +
+	"\x1fRACK_ORDER_BY_FIELD_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RACK_ORDER_BY_FIELD_NAME\x10\x01\x12$\n" +
+	" RACK_ORDER_BY_FIELD_MANUFACTURER\x10\x02\x12\x1d\n" +
+	"\x19RACK_ORDER_BY_FIELD_MODEL\x10\x03*\xa4\x02\n" +
+	"\x0ePowerControlOp\x12\x1c\n" +
+	"\x18POWER_CONTROL_OP_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13POWER_CONTROL_OP_ON\x10\x01\x12\x1d\n" +
+	"\x19POWER_CONTROL_OP_FORCE_ON\x10\x02\x12\x18\n" +
+	"\x14POWER_CONTROL_OP_OFF\x10\x03\x12\x1e\n" +
+	"\x1aPOWER_CONTROL_OP_FORCE_OFF\x10\x04\x12\x1c\n" +
+	"\x18POWER_CONTROL_OP_RESTART\x10\x05\x12\"\n" +
+	"\x1ePOWER_CONTROL_OP_FORCE_RESTART\x10\x06\x12\x1f\n" +
+	"\x1bPOWER_CONTROL_OP_WARM_RESET\x10\a\x12\x1f\n" +
+	"\x1bPOWER_CONTROL_OP_COLD_RESET\x10\b*\xe1\x01\n" +
+	"\n" +
+	"TaskStatus\x12\x17\n" +
+	"\x13TASK_STATUS_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13TASK_STATUS_PENDING\x10\x01\x12\x17\n" +
+	"\x13TASK_STATUS_RUNNING\x10\x02\x12\x19\n" +
+	"\x15TASK_STATUS_COMPLETED\x10\x03\x12\x16\n" +
+	"\x12TASK_STATUS_FAILED\x10\x04\x12\x1a\n" +
+	"\x16TASK_STATUS_TERMINATED\x10\x05\x12\x17\n" +
+	"\x13TASK_STATUS_WAITING\x10\x06\x12 \n" +
+	"\x1cTASK_STATUS_WAITING_APPROVAL\x10\a*\x88\x01\n" +
+	"\x10ApprovalDecision\x12\x1a\n" +
+	"\x16APPROVAL_DECISION_NONE\x10\x00\x12\x1d\n" +
+	"\x19APPROVAL_DECISION_APPROVE\x10\x01\x12\x1c\n" +
+	"\x18APPROVAL_DECISION_REJECT\x10\x02\x12\x1b\n" +
+	"\x17APPROVAL_DECISION_ABORT\x10\x03*S\n" +
+	"\x10TaskExecutorType\x12\x1e\n" +
+	"\x1aTASK_EXECUTOR_TYPE_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bTASK_EXECUTOR_TYPE_TEMPORAL\x10\x01*g\n" +
+	"\bDiffType\x12\x15\n" +
+	"\x11DIFF_TYPE_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11DIFF_TYPE_MISSING\x10\x01\x12\x18\n" +
+	"\x14DIFF_TYPE_UNEXPECTED\x10\x02\x12\x13\n" +
+	"\x0fDIFF_TYPE_DRIFT\x10\x03*p\n" +
+	"\x10ConflictStrategy\x12!\n" +
+	"\x1dCONFLICT_STRATEGY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CONFLICT_STRATEGY_QUEUE\x10\x01\x12\x1c\n" +
+	"\x18CONFLICT_STRATEGY_REJECT\x10\x02*\x82\x01\n" +
+	"\fTaskPriority\x12\x1d\n" +
+	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_PRIORITY_EMERGENCY\x10\x01\x12\x18\n" +
+	"\x14TASK_PRIORITY_NORMAL\x10\x02\x12\x1c\n" +
+	"\x18TASK_PRIORITY_BACKGROUND\x10\x03*P\n" +
+	"\n" +
+	"Preemption\x12\x13\n" +
+	"\x0fPREEMPTION_NONE\x10\x00\x12\x15\n" +
+	"\x11PREEMPTION_CANCEL\x10\x01\x12\x16\n" +
+	"\x12PREEMPTION_REQUEUE\x10\x02*t\n" +
+	"\x11MaintenancePolicy\x12\"\n" +
+	"\x1eMAINTENANCE_POLICY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MAINTENANCE_POLICY_REJECT\x10\x01\x12\x1c\n" +
+	"\x18MAINTENANCE_POLICY_DEFER\x10\x02*\x95\x01\n" +
+	"\n" +
+	"RuleSource\x12\x17\n" +
+	"\x13RULE_SOURCE_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14RULE_SOURCE_EXPLICIT\x10\x01\x12 \n" +
+	"\x1cRULE_SOURCE_RACK_ASSOCIATION\x10\x02\x12\x17\n" +
+	"\x13RULE_SOURCE_DEFAULT\x10\x03\x12\x19\n" +
+	"\x15RULE_SOURCE_HARDCODED\x10\x04*n\n" +
+	"\vPlanOutcome\x12\x18\n" +
+	"\x14PLAN_OUTCOME_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10PLAN_OUTCOME_RUN\x10\x01\x12\x16\n" +
+	"\x12PLAN_OUTCOME_QUEUE\x10\x02\x12\x17\n" +
+	"\x13PLAN_OUTCOME_REJECT\x10\x03*\x9b\x01\n" +
+	"\x11TaskReportOutcome\x12\x1f\n" +
+	"\x1bTASK_REPORT_OUTCOME_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dTASK_REPORT_OUTCOME_SUCCEEDED\x10\x01\x12\x1e\n" +
+	"\x1aTASK_REPORT_OUTCOME_FAILED\x10\x02\x12\"\n" +
+	"\x1eTASK_REPORT_OUTCOME_TERMINATED\x10\x03*t\n" +
+	"\x10TaskReportFormat\x12\"\n" +
+	"\x1eTASK_REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_REPORT_FORMAT_JSON\x10\x01\x12\x1f\n" +
+	"\x1bTASK_REPORT_FORMAT_MARKDOWN\x10\x02*r\n" +
+	"\rOperationType\x12\x1a\n" +
+	"\x16OPERATION_TYPE_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cOPERATION_TYPE_POWER_CONTROL\x10\x01\x12#\n" +
+	"\x1fOPERATION_TYPE_FIRMWARE_CONTROL\x10\x02*\x95\x01\n" +
+	"\x10ScheduleSpecType\x12\"\n" +
+	"\x1eSCHEDULE_SPEC_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSCHEDULE_SPEC_TYPE_INTERVAL\x10\x01\x12\x1b\n" +
+	"\x17SCHEDULE_SPEC_TYPE_CRON\x10\x02\x12\x1f\n" +
+	"\x1bSCHEDULE_SPEC_TYPE_ONE_TIME\x10\x03*b\n" +
+	"\rOverlapPolicy\x12\x1e\n" +
+	"\x1aOVERLAP_POLICY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13OVERLAP_POLICY_SKIP\x10\x01\x12\x18\n" +
+	"\x14OVERLAP_POLICY_QUEUE\x10\x02*\xda\x01\n" +
+	"\x15PowerLimitApplyStatus\x12$\n" +
+	" POWER_LIMIT_APPLY_STATUS_UNKNOWN\x10\x00\x12$\n" +
+	" POWER_LIMIT_APPLY_STATUS_APPLIED\x10\x01\x12*\n" +
+	"&POWER_LIMIT_APPLY_STATUS_NOT_SUPPORTED\x10\x02\x12#\n" +
+	"\x1fPOWER_LIMIT_APPLY_STATUS_FAILED\x10\x03\x12$\n" +
+	" POWER_LIMIT_APPLY_STATUS_SKIPPED\x10\x04*\x88\x01\n" +
+	"\x11CredentialAccount\x12\x1e\n" +
+	"\x1aCREDENTIAL_ACCOUNT_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16CREDENTIAL_ACCOUNT_PMC\x10\x01\x12\x1a\n" +
+	"\x16CREDENTIAL_ACCOUNT_BMC\x10\x02\x12\x1b\n" +
+	"\x17CREDENTIAL_ACCOUNT_NVOS\x10\x03*\xc6\x02\n" +
+	"\x1dDeviceCredentialRotationState\x12,\n" +
+	"(DEVICE_CREDENTIAL_ROTATION_STATE_UNKNOWN\x10\x00\x122\n" +
+	".DEVICE_CREDENTIAL_ROTATION_STATE_NEVER_ROTATED\x10\x01\x120\n" +
+	",DEVICE_CREDENTIAL_ROTATION_STATE_IN_PROGRESS\x10\x02\x12.\n" +
+	"*DEVICE_CREDENTIAL_ROTATION_STATE_SUCCEEDED\x10\x03\x12+\n" +
+	"'DEVICE_CREDENTIAL_ROTATION_STATE_FAILED\x10\x04\x124\n" +
+	"0DEVICE_CREDENTIAL_ROTATION_STATE_ROLLBACK_FAILED\x10\x05*\xc3\x02\n" +
+	"\x15DiscoveredDeviceState\x12#\n" +
+	"\x1fDISCOVERED_DEVICE_STATE_UNKNOWN\x10\x00\x12#\n" +
+	"\x1fDISCOVERED_DEVICE_STATE_PENDING\x10\x01\x12&\n" +
+	"\"DISCOVERED_DEVICE_STATE_REGISTERED\x10\x02\x12!\n" +
+	"\x1dDISCOVERED_DEVICE_STATE_KNOWN\x10\x03\x12%\n" +
+	"!DISCOVERED_DEVICE_STATE_UNMATCHED\x10\x04\x12$\n" +
+	" DISCOVERED_DEVICE_STATE_CONFLICT\x10\x05\x12\"\n" +
+	"\x1eDISCOVERED_DEVICE_STATE_FAILED\x10\x06\x12$\n" +
+	" DISCOVERED_DEVICE_STATE_REJECTED\x10\a*\x9c\x01\n" +
+	"\rCampaignState\x12\x1a\n" +
+	"\x16CAMPAIGN_STATE_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16CAMPAIGN_STATE_RUNNING\x10\x01\x12\x19\n" +
+	"\x15CAMPAIGN_STATE_PAUSED\x10\x02\x12\x1c\n" +
+	"\x18CAMPAIGN_STATE_COMPLETED\x10\x03\x12\x1a\n" +
+	"\x16CAMPAIGN_STATE_ABORTED\x10\x04*\xfd\x01\n" +
+	"\x11CampaignRackState\x12\x1f\n" +
+	"\x1bCAMPAIGN_RACK_STATE_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bCAMPAIGN_RACK_STATE_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bCAMPAIGN_RACK_STATE_RUNNING\x10\x02\x12!\n" +
+	"\x1dCAMPAIGN_RACK_STATE_SUCCEEDED\x10\x03\x12\x1e\n" +
+	"\x1aCAMPAIGN_RACK_STATE_FAILED\x10\x04\x12\x1f\n" +
+	"\x1bCAMPAIGN_RACK_STATE_SKIPPED\x10\x05\x12!\n" +
+	"\x1dCAMPAIGN_RACK_STATE_CANCELLED\x10\x06*\x8a\x01\n" +
+	"\x15MaintenanceWindowKind\x12'\n" +
+	"#MAINTENANCE_WINDOW_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eMAINTENANCE_WINDOW_KIND_WINDOW\x10\x01\x12$\n" +
+	" MAINTENANCE_WINDOW_KIND_BLACKOUT\x10\x02*\x82\x01\n" +
+	"\tDriftType\x12\x16\n" +
+	"\x12DRIFT_TYPE_UNKNOWN\x10\x00\x12\"\n" +
+	"\x1eDRIFT_TYPE_MISSING_IN_EXPECTED\x10\x01\x12 \n" +
+	"\x1cDRIFT_TYPE_MISSING_IN_ACTUAL\x10\x02\x12\x17\n" +
+	"\x13DRIFT_TYPE_MISMATCH\x10\x03*Z\n" +
+	"\vDriftStatus\x12\x18\n" +
+	"\x14DRIFT_STATUS_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11DRIFT_STATUS_OPEN\x10\x01\x12\x1a\n" +
+	"\x16DRIFT_STATUS_DISMISSED\x10\x022\x971\n" +
+	"\x03RLA\x12,\n" +
+	"\aVersion\x12\x12.v1.VersionRequest\x1a\r.v1.BuildInfo\x12E\n" +
+	"\x12CreateTaskSchedule\x12\x1d.v1.CreateTaskScheduleRequest\x1a\x10.v1.TaskSchedule\x12?\n" +
+	"\x0fGetTaskSchedule\x12\x1a.v1.GetTaskScheduleRequest\x1a\x10.v1.TaskSchedule\x12P\n" +
+	"\x11ListTaskSchedules\x12\x1c.v1.ListTaskSchedulesRequest\x1a\x1d.v1.ListTaskSchedulesResponse\x12E\n" +
+	"\x12UpdateTaskSchedule\x12\x1d.v1.UpdateTaskScheduleRequest\x1a\x10.v1.TaskSchedule\x12C\n" +
+	"\x11PauseTaskSchedule\x12\x1c.v1.PauseTaskScheduleRequest\x1a\x10.v1.TaskSchedule\x12E\n" +
+	"\x12ResumeTaskSchedule\x12\x1d.v1.ResumeTaskScheduleRequest\x1a\x10.v1.TaskSchedule\x12K\n" +
+	"\x12DeleteTaskSchedule\x12\x1d.v1.DeleteTaskScheduleRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x13TriggerTaskSchedule\x12\x1e.v1.TriggerTaskScheduleRequest\x1a\x16.v1.SubmitTaskResponse\x12Y\n" +
+	"\x14AddTaskScheduleScope\x12\x1f.v1.AddTaskScheduleScopeRequest\x1a .v1.AddTaskScheduleScopeResponse\x12U\n" +
+	"\x17RemoveTaskScheduleScope\x12\".v1.RemoveTaskScheduleScopeRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x17UpdateTaskScheduleScope\x12\".v1.UpdateTaskScheduleScopeRequest\x1a#.v1.UpdateTaskScheduleScopeResponse\x12_\n" +
+	"\x16ListTaskScheduleScopes\x12!.v1.ListTaskScheduleScopesRequest\x1a\".v1.ListTaskScheduleScopesResponse\x12_\n" +
+	"\x16CheckScheduleConflicts\x12!.v1.CheckScheduleConflictsRequest\x1a\".v1.CheckScheduleConflictsResponse\x12S\n" +
+	"\x12CreateExpectedRack\x12\x1d.v1.CreateExpectedRackRequest\x1a\x1e.v1.CreateExpectedRackResponse\x12F\n" +
+	"\x0fGetRackInfoByID\x12\x1a.v1.GetRackInfoByIDRequest\x1a\x17.v1.GetRackInfoResponse\x12N\n" +
+	"\x13GetRackInfoBySerial\x12\x1e.v1.GetRackInfoBySerialRequest\x1a\x17.v1.GetRackInfoResponse\x12G\n" +
+	"\x0eGetListOfRacks\x12\x19.v1.GetListOfRacksRequest\x1a\x1a.v1.GetListOfRacksResponse\x128\n" +
+	"\tPatchRack\x12\x14.v1.PatchRackRequest\x1a\x15.v1.PatchRackResponse\x12;\n" +
+	"\n" +
+	"DeleteRack\x12\x15.v1.DeleteRackRequest\x1a\x16.v1.DeleteRackResponse\x128\n" +
+	"\tPurgeRack\x12\x14.v1.PurgeRackRequest\x1a\x15.v1.PurgeRackResponse\x12E\n" +
+	"\x0fUpgradeFirmware\x12\x1a.v1.UpgradeFirmwareRequest\x1a\x16.v1.SubmitTaskResponse\x12=\n" +
+	"\vBringUpRack\x12\x16.v1.BringUpRackRequest\x1a\x16.v1.SubmitTaskResponse\x12;\n" +
+	"\n" +
+	"IngestRack\x12\x15.v1.IngestRackRequest\x1a\x16.v1.SubmitTaskResponse\x12=\n" +
+	"\vPowerOnRack\x12\x16.v1.PowerOnRackRequest\x1a\x16.v1.SubmitTaskResponse\x12?\n" +
+	"\fPowerOffRack\x12\x17.v1.PowerOffRackRequest\x1a\x16.v1.SubmitTaskResponse\x12C\n" +
+	"\x0ePowerResetRack\x12\x19.v1.PowerResetRackRequest\x1a\x16.v1.SubmitTaskResponse\x12D\n" +
+	"\rPlanOperation\x12\x18.v1.PlanOperationRequest\x1a\x19.v1.PlanOperationResponse\x12U\n" +
+	"\x14GetComponentInfoByID\x12\x1f.v1.GetComponentInfoByIDRequest\x1a\x1c.v1.GetComponentInfoResponse\x12]\n" +
+	"\x18GetComponentInfoBySerial\x12#.v1.GetComponentInfoBySerialRequest\x1a\x1c.v1.GetComponentInfoResponse\x12D\n" +
+	"\rGetComponents\x12\x18.v1.GetComponentsRequest\x1a\x19.v1.GetComponentsResponse\x12S\n" +
+	"\x12ValidateComponents\x12\x1d.v1.ValidateComponentsRequest\x1a\x1e.v1.ValidateComponentsResponse\x12A\n" +
+	"\fAddComponent\x12\x17.v1.AddComponentRequest\x1a\x18.v1.AddComponentResponse\x12G\n" +
+	"\x0ePatchComponent\x12\x19.v1.PatchComponentRequest\x1a\x1a.v1.PatchComponentResponse\x12J\n" +
+	"\x0fDeleteComponent\x12\x1a.v1.DeleteComponentRequest\x1a\x1b.v1.DeleteComponentResponse\x12G\n" +
+	"\x0ePurgeComponent\x12\x19.v1.PurgeComponentRequest\x1a\x1a.v1.PurgeComponentResponse\x12J\n" +
+	"\x0fCreateNVLDomain\x12\x1a.v1.CreateNVLDomainRequest\x1a\x1b.v1.CreateNVLDomainResponse\x12S\n" +
+	"\x16AttachRacksToNVLDomain\x12!.v1.AttachRacksToNVLDomainRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x18DetachRacksFromNVLDomain\x12#.v1.DetachRacksFromNVLDomainRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x13GetListOfNVLDomains\x12\x1e.v1.GetListOfNVLDomainsRequest\x1a\x1f.v1.GetListOfNVLDomainsResponse\x12Y\n" +
+	"\x14GetRacksForNVLDomain\x12\x1f.v1.GetRacksForNVLDomainRequest\x1a .v1.GetRacksForNVLDomainResponse\x128\n" +
+	"\tListTasks\x12\x14.v1.ListTasksRequest\x1a\x15.v1.ListTasksResponse\x12D\n" +
+	"\rGetTasksByIDs\x12\x18.v1.GetTasksByIDsRequest\x1a\x19.v1.GetTasksByIDsResponse\x12;\n" +
+	"\n" +
+	"CancelTask\x12\x15.v1.CancelTaskRequest\x1a\x16.v1.CancelTaskResponse\x12J\n" +
+	"\x0fApproveTaskStep\x12\x1a.v1.ApproveTaskStepRequest\x1a\x1b.v1.ApproveTaskStepResponse\x12G\n" +
+	"\x0eRejectTaskStep\x12\x19.v1.RejectTaskStepRequest\x1a\x1a.v1.RejectTaskStepResponse\x12D\n" +
+	"\rGetTaskReport\x12\x18.v1.GetTaskReportRequest\x1a\x19.v1.GetTaskReportResponse\x12V\n" +
+	"\x13CreateOperationRule\x12\x1e.v1.CreateOperationRuleRequest\x1a\x1f.v1.CreateOperationRuleResponse\x12M\n" +
+	"\x13UpdateOperationRule\x12\x1e.v1.UpdateOperationRuleRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x13DeleteOperationRule\x12\x1e.v1.DeleteOperationRuleRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x10GetOperationRule\x12\x1b.v1.GetOperationRuleRequest\x1a\x11.v1.OperationRule\x12S\n" +
+	"\x12ListOperationRules\x12\x1d.v1.ListOperationRulesRequest\x1a\x1e.v1.ListOperationRulesResponse\x12G\n" +
+	"\x10SetRuleAsDefault\x12\x1b.v1.SetRuleAsDefaultRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x15AssociateRuleWithRack\x12 .v1.AssociateRuleWithRackRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x18DisassociateRuleFromRack\x12#.v1.DisassociateRuleFromRackRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x16GetRackRuleAssociation\x12!.v1.GetRackRuleAssociationRequest\x1a\".v1.GetRackRuleAssociationResponse\x12e\n" +
+	"\x18ListRackRuleAssociations\x12#.v1.ListRackRuleAssociationsRequest\x1a$.v1.ListRackRuleAssociationsResponse\x12G\n" +
+	"\x0eSetPowerBudget\x12\x19.v1.SetPowerBudgetRequest\x1a\x1a.v1.SetPowerBudgetResponse\x12P\n" +
+	"\x11DeletePowerBudget\x12\x1c.v1.DeletePowerBudgetRequest\x1a\x1d.v1.DeletePowerBudgetResponse\x12M\n" +
+	"\x10ListPowerBudgets\x12\x1b.v1.ListPowerBudgetsRequest\x1a\x1c.v1.ListPowerBudgetsResponse\x12H\n" +
+	"\x12GetRackPowerStatus\x12\x1d.v1.GetRackPowerStatusRequest\x1a\x13.v1.RackPowerStatus\x12\\\n" +
+	"\x15RotateRackCredentials\x12 .v1.RotateRackCredentialsRequest\x1a!.v1.RotateRackCredentialsResponse\x12z\n" +
+	"\x1fGetRackCredentialRotationStatus\x12*.v1.GetRackCredentialRotationStatusRequest\x1a+.v1.GetRackCredentialRotationStatusResponse\x12J\n" +
+	"\x0fDiscoverDevices\x12\x1a.v1.DiscoverDevicesRequest\x1a\x1b.v1.DiscoverDevicesResponse\x12\\\n" +
+	"\x15ListDiscoveredDevices\x12 .v1.ListDiscoveredDevicesRequest\x1a!.v1.ListDiscoveredDevicesResponse\x12S\n" +
+	"\x17ApproveDiscoveredDevice\x12\".v1.ApproveDiscoveredDeviceRequest\x1a\x14.v1.DiscoveredDevice\x12Q\n" +
+	"\x16RejectDiscoveredDevice\x12!.v1.RejectDiscoveredDeviceRequest\x1a\x14.v1.DiscoveredDevice\x129\n" +
+	"\x0eCreateCampaign\x12\x19.v1.CreateCampaignRequest\x1a\f.v1.Campaign\x123\n" +
+	"\vGetCampaign\x12\x16.v1.GetCampaignRequest\x1a\f.v1.Campaign\x12D\n" +
+	"\rListCampaigns\x12\x18.v1.ListCampaignsRequest\x1a\x19.v1.ListCampaignsResponse\x127\n" +
+	"\rPauseCampaign\x12\x18.v1.PauseCampaignRequest\x1a\f.v1.Campaign\x129\n" +
+	"\x0eResumeCampaign\x12\x19.v1.ResumeCampaignRequest\x1a\f.v1.Campaign\x127\n" +
+	"\rAbortCampaign\x12\x18.v1.AbortCampaignRequest\x1a\f.v1.Campaign\x12T\n" +
+	"\x17CreateMaintenanceWindow\x12\".v1.CreateMaintenanceWindowRequest\x1a\x15.v1.MaintenanceWindow\x12U\n" +
+	"\x17DeleteMaintenanceWindow\x12\".v1.DeleteMaintenanceWindowRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x16ListMaintenanceWindows\x12!.v1.ListMaintenanceWindowsRequest\x1a\".v1.ListMaintenanceWindowsResponse\x12Z\n" +
+	"\x18GetRackMaintenanceStatus\x12#.v1.GetRackMaintenanceStatusRequest\x1a\x19.v1.RackMaintenanceStatus\x12e\n" +
+	"\x18ListMaintenanceOverrides\x12#.v1.ListMaintenanceOverridesRequest\x1a$.v1.ListMaintenanceOverridesResponse\x12;\n" +
+	"\n" +
+	"ListDrifts\x12\x15.v1.ListDriftsRequest\x1a\x16.v1.ListDriftsResponse\x12>\n" +
+	"\vAcceptDrift\x12\x16.v1.AcceptDriftRequest\x1a\x17.v1.AcceptDriftResponse\x12;\n" +
+	"\fDismissDrift\x12\x17.v1.DismissDriftRequest\x1a\x12.v1.ComponentDrift\x12;\n" +
+	"\n" +
+	"ResyncRack\x12\x15.v1.ResyncRackRequest\x1a\x16.v1.ResyncRackResponseB>Z<github.com/NVIDIA/ncx-infra-controller-rest/rla/pkg/proto/v1b\x06proto3"
 
 var (
 	file_rla_proto_rawDescOnce sync.Once
-	file_rla_proto_rawDescData = file_rla_proto_rawDesc
+	file_rla_proto_rawDescData []byte
 )
 
 func file_rla_proto_rawDescGZIP() []byte {
 	file_rla_proto_rawDescOnce.Do(func() {
-		file_rla_proto_rawDescData = protoimpl.X.CompressGZIP(file_rla_proto_rawDescData)
+		file_rla_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rla_proto_rawDesc), len(file_rla_proto_rawDesc)))
 	})
 	return file_rla_proto_rawDescData
 }
 
-var file_rla_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_rla_proto_msgTypes = make([]protoimpl.MessageInfo, 115)
+var file_rla_proto_enumTypes = make([]protoimpl.EnumInfo, 31)
+var file_rla_proto_msgTypes = make([]protoimpl.MessageInfo, 189)
 var file_rla_proto_goTypes = []any{
-	(BMCType)(0),                             // 0: v1.BMCType
-	(ComponentType)(0),                       // 1: v1.ComponentType
-	(RackFilterField)(0),                     // 2: v1.RackFilterField
-	(ComponentFilterField)(0),                // 3: v1.ComponentFilterField
-	(ComponentOrderByField)(0),               // 4: v1.ComponentOrderByField
-	(RackOrderByField)(0),                    // 5: v1.RackOrderByField
-	(PowerControlOp)(0),                      // 6: v1.PowerControlOp
-	(TaskStatus)(0),                          // 7: v1.TaskStatus
-	(TaskExecutorType)(0),                    // 8: v1.TaskExecutorType
-	(DiffType)(0),                            // 9: v1.DiffType
-	(ConflictStrategy)(0),                    // 10: v1.ConflictStrategy
-	(OperationType)(0),                       // 11: v1.OperationType
-	(ScheduleSpecType)(0),                    // 12: v1.ScheduleSpecType
-	(OverlapPolicy)(0),                       // 13: v1.OverlapPolicy
-	(*UUID)(nil),                             // 14: v1.UUID
-	(*DeviceInfo)(nil),                       // 15: v1.DeviceInfo
-	(*Location)(nil),                         // 16: v1.Location
-	(*DeviceSerialInfo)(nil),                 // 17: v1.DeviceSerialInfo
-	(*BMCInfo)(nil),                          // 18: v1.BMCInfo
-	(*RackPosition)(nil),                     // 19: v1.RackPosition
-	(*Component)(nil),                        // 20: v1.Component
-	(*Rack)(nil),                             // 21: v1.Rack
-	(*Identifier)(nil),                       // 22: v1.Identifier
-	(*OperationTargetSpec)(nil),              // 23: v1.OperationTargetSpec
-	(*RackTargets)(nil),                      // 24: v1.RackTargets
-	(*ComponentTargets)(nil),                 // 25: v1.ComponentTargets
-	(*ComponentTypes)(nil),                   // 26: v1.ComponentTypes
-	(*RackTarget)(nil),                       // 27: v1.RackTarget
-	(*ComponentTarget)(nil),                  // 28: v1.ComponentTarget
-	(*ExternalRef)(nil),                      // 29: v1.ExternalRef
-	(*NVLDomain)(nil),                        // 30: v1.NVLDomain
-	(*Pagination)(nil),                       // 31: v1.Pagination
-	(*StringQueryInfo)(nil),                  // 32: v1.StringQueryInfo
-	(*Filter)(nil),                           // 33: v1.Filter
-	(*OrderBy)(nil),                          // 34: v1.OrderBy
-	(*Task)(nil),                             // 35: v1.Task
-	(*CreateExpectedRackRequest)(nil),        // 36: v1.CreateExpectedRackRequest
-	(*CreateExpectedRackResponse)(nil),       // 37: v1.CreateExpectedRackResponse
-	(*GetRackInfoByIDRequest)(nil),           // 38: v1.GetRackInfoByIDRequest
-	(*GetRackInfoBySerialRequest)(nil),       // 39: v1.GetRackInfoBySerialRequest
-	(*GetRackInfoResponse)(nil),              // 40: v1.GetRackInfoResponse
-	(*PatchRackRequest)(nil),                 // 41: v1.PatchRackRequest
-	(*PatchRackResponse)(nil),                // 42: v1.PatchRackResponse
-	(*GetComponentInfoByIDRequest)(nil),      // 43: v1.GetComponentInfoByIDRequest
-	(*GetComponentInfoBySerialRequest)(nil),  // 44: v1.GetComponentInfoBySerialRequest
-	(*GetComponentInfoResponse)(nil),         // 45: v1.GetComponentInfoResponse
-	(*GetListOfRacksRequest)(nil),            // 46: v1.GetListOfRacksRequest
-	(*GetListOfRacksResponse)(nil),           // 47: v1.GetListOfRacksResponse
-	(*CreateNVLDomainRequest)(nil),           // 48: v1.CreateNVLDomainRequest
-	(*CreateNVLDomainResponse)(nil),          // 49: v1.CreateNVLDomainResponse
-	(*AttachRacksToNVLDomainRequest)(nil),    // 50: v1.AttachRacksToNVLDomainRequest
-	(*DetachRacksFromNVLDomainRequest)(nil),  // 51: v1.DetachRacksFromNVLDomainRequest
-	(*GetListOfNVLDomainsRequest)(nil),       // 52: v1.GetListOfNVLDomainsRequest
-	(*GetListOfNVLDomainsResponse)(nil),      // 53: v1.GetListOfNVLDomainsResponse
-	(*GetRacksForNVLDomainRequest)(nil),      // 54: v1.GetRacksForNVLDomainRequest
-	(*GetRacksForNVLDomainResponse)(nil),     // 55: v1.GetRacksForNVLDomainResponse
-	(*UpgradeFirmwareRequest)(nil),           // 56: v1.UpgradeFirmwareRequest
-	(*GetComponentsRequest)(nil),             // 57: v1.GetComponentsRequest
-	(*GetComponentsResponse)(nil),            // 58: v1.GetComponentsResponse
-	(*ValidateComponentsRequest)(nil),        // 59: v1.ValidateComponentsRequest
-	(*ValidateComponentsResponse)(nil),       // 60: v1.ValidateComponentsResponse
-	(*ComponentDiff)(nil),                    // 61: v1.ComponentDiff
-	(*FieldDiff)(nil),                        // 62: v1.FieldDiff
-	(*AddComponentRequest)(nil),              // 63: v1.AddComponentRequest
-	(*AddComponentResponse)(nil),             // 64: v1.AddComponentResponse
-	(*DeleteComponentRequest)(nil),           // 65: v1.DeleteComponentRequest
-	(*DeleteComponentResponse)(nil),          // 66: v1.DeleteComponentResponse
-	(*DeleteRackRequest)(nil),                // 67: v1.DeleteRackRequest
-	(*DeleteRackResponse)(nil),               // 68: v1.DeleteRackResponse
-	(*PurgeRackRequest)(nil),                 // 69: v1.PurgeRackRequest
-	(*PurgeRackResponse)(nil),                // 70: v1.PurgeRackResponse
-	(*PurgeComponentRequest)(nil),            // 71: v1.PurgeComponentRequest
-	(*PurgeComponentResponse)(nil),           // 72: v1.PurgeComponentResponse
-	(*PatchComponentRequest)(nil),            // 73: v1.PatchComponentRequest
-	(*PatchComponentResponse)(nil),           // 74: v1.PatchComponentResponse
-	(*SubmitTaskResponse)(nil),               // 75: v1.SubmitTaskResponse
-	(*QueueOptions)(nil),                     // 76: v1.QueueOptions
-	(*PowerOnRackRequest)(nil),               // 77: v1.PowerOnRackRequest
-	(*PowerOffRackRequest)(nil),              // 78: v1.PowerOffRackRequest
-	(*PowerResetRackRequest)(nil),            // 79: v1.PowerResetRackRequest
-	(*BringUpRackRequest)(nil),               // 80: v1.BringUpRackRequest
-	(*IngestRackRequest)(nil),                // 81: v1.IngestRackRequest
-	(*ListTasksRequest)(nil),                 // 82: v1.ListTasksRequest
-	(*ListTasksResponse)(nil),                // 83: v1.ListTasksResponse
-	(*GetTasksByIDsRequest)(nil),             // 84: v1.GetTasksByIDsRequest
-	(*GetTasksByIDsResponse)(nil),            // 85: v1.GetTasksByIDsResponse
-	(*CancelTaskRequest)(nil),                // 86: v1.CancelTaskRequest
-	(*CancelTaskResponse)(nil),               // 87: v1.CancelTaskResponse
-	(*VersionRequest)(nil),                   // 88: v1.VersionRequest
-	(*BuildInfo)(nil),                        // 89: v1.BuildInfo
-	(*OperationRule)(nil),                    // 90: v1.OperationRule
-	(*CreateOperationRuleRequest)(nil),       // 91: v1.CreateOperationRuleRequest
-	(*CreateOperationRuleResponse)(nil),      // 92: v1.CreateOperationRuleResponse
-	(*UpdateOperationRuleRequest)(nil),       // 93: v1.UpdateOperationRuleRequest
-	(*DeleteOperationRuleRequest)(nil),       // 94: v1.DeleteOperationRuleRequest
-	(*SetRuleAsDefaultRequest)(nil),          // 95: v1.SetRuleAsDefaultRequest
-	(*GetOperationRuleRequest)(nil),          // 96: v1.GetOperationRuleRequest
-	(*ListOperationRulesRequest)(nil),        // 97: v1.ListOperationRulesRequest
-	(*ListOperationRulesResponse)(nil),       // 98: v1.ListOperationRulesResponse
-	(*AssociateRuleWithRackRequest)(nil),     // 99: v1.AssociateRuleWithRackRequest
-	(*DisassociateRuleFromRackRequest)(nil),  // 100: v1.DisassociateRuleFromRackRequest
-	(*GetRackRuleAssociationRequest)(nil),    // 101: v1.GetRackRuleAssociationRequest
-	(*GetRackRuleAssociationResponse)(nil),   // 102: v1.GetRackRuleAssociationResponse
-	(*ListRackRuleAssociationsRequest)(nil),  // 103: v1.ListRackRuleAssociationsRequest
-	(*RackRuleAssociation)(nil),              // 104: v1.RackRuleAssociation
-	(*ListRackRuleAssociationsResponse)(nil), // 105: v1.ListRackRuleAssociationsResponse
-	(*ScheduleSpec)(nil),                     // 106: v1.ScheduleSpec
-	(*ScheduleConfig)(nil),                   // 107: v1.ScheduleConfig
-	(*TaskSchedule)(nil),                     // 108: v1.TaskSchedule
-	(*ScheduledOperation)(nil),               // 109: v1.ScheduledOperation
-	(*CreateTaskScheduleRequest)(nil),        // 110: v1.CreateTaskScheduleRequest
-	(*GetTaskScheduleRequest)(nil),           // 111: v1.GetTaskScheduleRequest
-	(*ListTaskSchedulesRequest)(nil),         // 112: v1.ListTaskSchedulesRequest
-	(*ListTaskSchedulesResponse)(nil),        // 113: v1.ListTaskSchedulesResponse
-	(*UpdateTaskScheduleRequest)(nil),        // 114: v1.UpdateTaskScheduleRequest
-	(*PauseTaskScheduleRequest)(nil),         // 115: v1.PauseTaskScheduleRequest
-	(*ResumeTaskScheduleRequest)(nil),        // 116: v1.ResumeTaskScheduleRequest
-	(*DeleteTaskScheduleRequest)(nil),        // 117: v1.DeleteTaskScheduleRequest
-	(*TriggerTaskScheduleRequest)(nil),       // 118: v1.TriggerTaskScheduleRequest
-	(*TaskScheduleScope)(nil),                // 119: v1.TaskScheduleScope
-	(*AddTaskScheduleScopeRequest)(nil),      // 120: v1.AddTaskScheduleScopeRequest
-	(*AddTaskScheduleScopeResponse)(nil),     // 121: v1.AddTaskScheduleScopeResponse
-	(*RemoveTaskScheduleScopeRequest)(nil),   // 122: v1.RemoveTaskScheduleScopeRequest
-	(*UpdateTaskScheduleScopeRequest)(nil),   // 123: v1.UpdateTaskScheduleScopeRequest
-	(*UpdateTaskScheduleScopeResponse)(nil),  // 124: v1.UpdateTaskScheduleScopeResponse
-	(*ListTaskScheduleScopesRequest)(nil),    // 125: v1.ListTaskScheduleScopesRequest
-	(*ListTaskScheduleScopesResponse)(nil),   // 126: v1.ListTaskScheduleScopesResponse
-	(*CheckScheduleConflictsRequest)(nil),    // 127: v1.CheckScheduleConflictsRequest
-	(*CheckScheduleConflictsResponse)(nil),   // 128: v1.CheckScheduleConflictsResponse
-	(*timestamppb.Timestamp)(nil),            // 129: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),            // 130: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                    // 131: google.protobuf.Empty
+	(BMCType)(0),                                    // 0: v1.BMCType
+	(ComponentType)(0),                              // 1: v1.ComponentType
+	(RackFilterField)(0),                            // 2: v1.RackFilterField
+	(ComponentFilterField)(0),                       // 3: v1.ComponentFilterField
+	(ComponentOrderByField)(0),                      // 4: v1.ComponentOrderByField
+	(RackOrderByField)(0),                           // 5: v1.RackOrderByField
+	(PowerControlOp)(0),                             // 6: v1.PowerControlOp
+	(TaskStatus)(0),                                 // 7: v1.TaskStatus
+	(ApprovalDecision)(0),                           // 8: v1.ApprovalDecision
+	(TaskExecutorType)(0),                           // 9: v1.TaskExecutorType
+	(DiffType)(0),                                   // 10: v1.DiffType
+	(ConflictStrategy)(0),                           // 11: v1.ConflictStrategy
+	(TaskPriority)(0),                               // 12: v1.TaskPriority
+	(Preemption)(0),                                 // 13: v1.Preemption
+	(MaintenancePolicy)(0),                          // 14: v1.MaintenancePolicy
+	(RuleSource)(0),                                 // 15: v1.RuleSource
+	(PlanOutcome)(0),                                // 16: v1.PlanOutcome
+	(TaskReportOutcome)(0),                          // 17: v1.TaskReportOutcome
+	(TaskReportFormat)(0),                           // 18: v1.TaskReportFormat
+	(OperationType)(0),                              // 19: v1.OperationType
+	(ScheduleSpecType)(0),                           // 20: v1.ScheduleSpecType
+	(OverlapPolicy)(0),                              // 21: v1.OverlapPolicy
+	(PowerLimitApplyStatus)(0),                      // 22: v1.PowerLimitApplyStatus
+	(CredentialAccount)(0),                          // 23: v1.CredentialAccount
+	(DeviceCredentialRotationState)(0),              // 24: v1.DeviceCredentialRotationState
+	(DiscoveredDeviceState)(0),                      // 25: v1.DiscoveredDeviceState
+	(CampaignState)(0),                              // 26: v1.CampaignState
+	(CampaignRackState)(0),                          // 27: v1.CampaignRackState
+	(MaintenanceWindowKind)(0),                      // 28: v1.MaintenanceWindowKind
+	(DriftType)(0),                                  // 29: v1.DriftType
+	(DriftStatus)(0),                                // 30: v1.DriftStatus
+	(*UUID)(nil),                                    // 31: v1.UUID
+	(*DeviceInfo)(nil),                              // 32: v1.DeviceInfo
+	(*Location)(nil),                                // 33: v1.Location
+	(*DeviceSerialInfo)(nil),                        // 34: v1.DeviceSerialInfo
+	(*BMCInfo)(nil),                                 // 35: v1.BMCInfo
+	(*RackPosition)(nil),                            // 36: v1.RackPosition
+	(*Component)(nil),                               // 37: v1.Component
+	(*Rack)(nil),                                    // 38: v1.Rack
+	(*Identifier)(nil),                              // 39: v1.Identifier
+	(*OperationTargetSpec)(nil),                     // 40: v1.OperationTargetSpec
+	(*RackTargets)(nil),                             // 41: v1.RackTargets
+	(*ComponentTargets)(nil),                        // 42: v1.ComponentTargets
+	(*ComponentTypes)(nil),                          // 43: v1.ComponentTypes
+	(*RackTarget)(nil),                              // 44: v1.RackTarget
+	(*ComponentTarget)(nil),                         // 45: v1.ComponentTarget
+	(*ExternalRef)(nil),                             // 46: v1.ExternalRef
+	(*NVLDomain)(nil),                               // 47: v1.NVLDomain
+	(*Pagination)(nil),                              // 48: v1.Pagination
+	(*StringQueryInfo)(nil),                         // 49: v1.StringQueryInfo
+	(*Filter)(nil),                                  // 50: v1.Filter
+	(*OrderBy)(nil),                                 // 51: v1.OrderBy
+	(*Task)(nil),                                    // 52: v1.Task
+	(*TaskPreemption)(nil),                          // 53: v1.TaskPreemption
+	(*TaskApproval)(nil),                            // 54: v1.TaskApproval
+	(*CreateExpectedRackRequest)(nil),               // 55: v1.CreateExpectedRackRequest
+	(*CreateExpectedRackResponse)(nil),              // 56: v1.CreateExpectedRackResponse
+	(*GetRackInfoByIDRequest)(nil),                  // 57: v1.GetRackInfoByIDRequest
+	(*GetRackInfoBySerialRequest)(nil),              // 58: v1.GetRackInfoBySerialRequest
+	(*GetRackInfoResponse)(nil),                     // 59: v1.GetRackInfoResponse
+	(*PatchRackRequest)(nil),                        // 60: v1.PatchRackRequest
+	(*PatchRackResponse)(nil),                       // 61: v1.PatchRackResponse
+	(*GetComponentInfoByIDRequest)(nil),             // 62: v1.GetComponentInfoByIDRequest
+	(*GetComponentInfoBySerialRequest)(nil),         // 63: v1.GetComponentInfoBySerialRequest
+	(*GetComponentInfoResponse)(nil),                // 64: v1.GetComponentInfoResponse
+	(*GetListOfRacksRequest)(nil),                   // 65: v1.GetListOfRacksRequest
+	(*GetListOfRacksResponse)(nil),                  // 66: v1.GetListOfRacksResponse
+	(*CreateNVLDomainRequest)(nil),                  // 67: v1.CreateNVLDomainRequest
+	(*CreateNVLDomainResponse)(nil),                 // 68: v1.CreateNVLDomainResponse
+	(*AttachRacksToNVLDomainRequest)(nil),           // 69: v1.AttachRacksToNVLDomainRequest
+	(*DetachRacksFromNVLDomainRequest)(nil),         // 70: v1.DetachRacksFromNVLDomainRequest
+	(*GetListOfNVLDomainsRequest)(nil),              // 71: v1.GetListOfNVLDomainsRequest
+	(*GetListOfNVLDomainsResponse)(nil),             // 72: v1.GetListOfNVLDomainsResponse
+	(*GetRacksForNVLDomainRequest)(nil),             // 73: v1.GetRacksForNVLDomainRequest
+	(*GetRacksForNVLDomainResponse)(nil),            // 74: v1.GetRacksForNVLDomainResponse
+	(*UpgradeFirmwareRequest)(nil),                  // 75: v1.UpgradeFirmwareRequest
+	(*GetComponentsRequest)(nil),                    // 76: v1.GetComponentsRequest
+	(*GetComponentsResponse)(nil),                   // 77: v1.GetComponentsResponse
+	(*ValidateComponentsRequest)(nil),               // 78: v1.ValidateComponentsRequest
+	(*ValidateComponentsResponse)(nil),              // 79: v1.ValidateComponentsResponse
+	(*ComponentDiff)(nil),                           // 80: v1.ComponentDiff
+	(*FieldDiff)(nil),                               // 81: v1.FieldDiff
+	(*AddComponentRequest)(nil),                     // 82: v1.AddComponentRequest
+	(*AddComponentResponse)(nil),                    // 83: v1.AddComponentResponse
+	(*DeleteComponentRequest)(nil),                  // 84: v1.DeleteComponentRequest
+	(*DeleteComponentResponse)(nil),                 // 85: v1.DeleteComponentResponse
+	(*DeleteRackRequest)(nil),                       // 86: v1.DeleteRackRequest
+	(*DeleteRackResponse)(nil),                      // 87: v1.DeleteRackResponse
+	(*PurgeRackRequest)(nil),                        // 88: v1.PurgeRackRequest
+	(*PurgeRackResponse)(nil),                       // 89: v1.PurgeRackResponse
+	(*PurgeComponentRequest)(nil),                   // 90: v1.PurgeComponentRequest
+	(*PurgeComponentResponse)(nil),                  // 91: v1.PurgeComponentResponse
+	(*PatchComponentRequest)(nil),                   // 92: v1.PatchComponentRequest
+	(*PatchComponentResponse)(nil),                  // 93: v1.PatchComponentResponse
+	(*SubmitTaskResponse)(nil),                      // 94: v1.SubmitTaskResponse
+	(*QueueOptions)(nil),                            // 95: v1.QueueOptions
+	(*MaintenanceOptions)(nil),                      // 96: v1.MaintenanceOptions
+	(*MaintenanceOverride)(nil),                     // 97: v1.MaintenanceOverride
+	(*PowerOnRackRequest)(nil),                      // 98: v1.PowerOnRackRequest
+	(*PowerOffRackRequest)(nil),                     // 99: v1.PowerOffRackRequest
+	(*PowerResetRackRequest)(nil),                   // 100: v1.PowerResetRackRequest
+	(*BringUpRackRequest)(nil),                      // 101: v1.BringUpRackRequest
+	(*IngestRackRequest)(nil),                       // 102: v1.IngestRackRequest
+	(*PlanOperationRequest)(nil),                    // 103: v1.PlanOperationRequest
+	(*PlanOperationResponse)(nil),                   // 104: v1.PlanOperationResponse
+	(*RackOperationPlan)(nil),                       // 105: v1.RackOperationPlan
+	(*PlannedStage)(nil),                            // 106: v1.PlannedStage
+	(*PlannedStep)(nil),                             // 107: v1.PlannedStep
+	(*ComponentBatch)(nil),                          // 108: v1.ComponentBatch
+	(*ListTasksRequest)(nil),                        // 109: v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                       // 110: v1.ListTasksResponse
+	(*GetTasksByIDsRequest)(nil),                    // 111: v1.GetTasksByIDsRequest
+	(*GetTasksByIDsResponse)(nil),                   // 112: v1.GetTasksByIDsResponse
+	(*CancelTaskRequest)(nil),                       // 113: v1.CancelTaskRequest
+	(*CancelTaskResponse)(nil),                      // 114: v1.CancelTaskResponse
+	(*ApproveTaskStepRequest)(nil),                  // 115: v1.ApproveTaskStepRequest
+	(*ApproveTaskStepResponse)(nil),                 // 116: v1.ApproveTaskStepResponse
+	(*RejectTaskStepRequest)(nil),                   // 117: v1.RejectTaskStepRequest
+	(*RejectTaskStepResponse)(nil),                  // 118: v1.RejectTaskStepResponse
+	(*TaskReportEntry)(nil),                         // 119: v1.TaskReportEntry
+	(*GetTaskReportRequest)(nil),                    // 120: v1.GetTaskReportRequest
+	(*GetTaskReportResponse)(nil),                   // 121: v1.GetTaskReportResponse
+	(*VersionRequest)(nil),                          // 122: v1.VersionRequest
+	(*BuildInfo)(nil),                               // 123: v1.BuildInfo
+	(*OperationRule)(nil),                           // 124: v1.OperationRule
+	(*CreateOperationRuleRequest)(nil),              // 125: v1.CreateOperationRuleRequest
+	(*CreateOperationRuleResponse)(nil),             // 126: v1.CreateOperationRuleResponse
+	(*UpdateOperationRuleRequest)(nil),              // 127: v1.UpdateOperationRuleRequest
+	(*DeleteOperationRuleRequest)(nil),              // 128: v1.DeleteOperationRuleRequest
+	(*SetRuleAsDefaultRequest)(nil),                 // 129: v1.SetRuleAsDefaultRequest
+	(*GetOperationRuleRequest)(nil),                 // 130: v1.GetOperationRuleRequest
+	(*ListOperationRulesRequest)(nil),               // 131: v1.ListOperationRulesRequest
+	(*ListOperationRulesResponse)(nil),              // 132: v1.ListOperationRulesResponse
+	(*AssociateRuleWithRackRequest)(nil),            // 133: v1.AssociateRuleWithRackRequest
+	(*DisassociateRuleFromRackRequest)(nil),         // 134: v1.DisassociateRuleFromRackRequest
+	(*GetRackRuleAssociationRequest)(nil),           // 135: v1.GetRackRuleAssociationRequest
+	(*GetRackRuleAssociationResponse)(nil),          // 136: v1.GetRackRuleAssociationResponse
+	(*ListRackRuleAssociationsRequest)(nil),         // 137: v1.ListRackRuleAssociationsRequest
+	(*RackRuleAssociation)(nil),                     // 138: v1.RackRuleAssociation
+	(*ListRackRuleAssociationsResponse)(nil),        // 139: v1.ListRackRuleAssociationsResponse
+	(*ScheduleSpec)(nil),                            // 140: v1.ScheduleSpec
+	(*ScheduleConfig)(nil),                          // 141: v1.ScheduleConfig
+	(*TaskSchedule)(nil),                            // 142: v1.TaskSchedule
+	(*ScheduledOperation)(nil),                      // 143: v1.ScheduledOperation
+	(*CreateTaskScheduleRequest)(nil),               // 144: v1.CreateTaskScheduleRequest
+	(*GetTaskScheduleRequest)(nil),                  // 145: v1.GetTaskScheduleRequest
+	(*ListTaskSchedulesRequest)(nil),                // 146: v1.ListTaskSchedulesRequest
+	(*ListTaskSchedulesResponse)(nil),               // 147: v1.ListTaskSchedulesResponse
+	(*UpdateTaskScheduleRequest)(nil),               // 148: v1.UpdateTaskScheduleRequest
+	(*PauseTaskScheduleRequest)(nil),                // 149: v1.PauseTaskScheduleRequest
+	(*ResumeTaskScheduleRequest)(nil),               // 150: v1.ResumeTaskScheduleRequest
+	(*DeleteTaskScheduleRequest)(nil),               // 151: v1.DeleteTaskScheduleRequest
+	(*TriggerTaskScheduleRequest)(nil),              // 152: v1.TriggerTaskScheduleRequest
+	(*TaskScheduleScope)(nil),                       // 153: v1.TaskScheduleScope
+	(*AddTaskScheduleScopeRequest)(nil),             // 154: v1.AddTaskScheduleScopeRequest
+	(*AddTaskScheduleScopeResponse)(nil),            // 155: v1.AddTaskScheduleScopeResponse
+	(*RemoveTaskScheduleScopeRequest)(nil),          // 156: v1.RemoveTaskScheduleScopeRequest
+	(*UpdateTaskScheduleScopeRequest)(nil),          // 157: v1.UpdateTaskScheduleScopeRequest
+	(*UpdateTaskScheduleScopeResponse)(nil),         // 158: v1.UpdateTaskScheduleScopeResponse
+	(*ListTaskScheduleScopesRequest)(nil),           // 159: v1.ListTaskScheduleScopesRequest
+	(*ListTaskScheduleScopesResponse)(nil),          // 160: v1.ListTaskScheduleScopesResponse
+	(*CheckScheduleConflictsRequest)(nil),           // 161: v1.CheckScheduleConflictsRequest
+	(*CheckScheduleConflictsResponse)(nil),          // 162: v1.CheckScheduleConflictsResponse
+	(*PowerBudget)(nil),                             // 163: v1.PowerBudget
+	(*ShelfPowerLimit)(nil),                         // 164: v1.ShelfPowerLimit
+	(*SetPowerBudgetRequest)(nil),                   // 165: v1.SetPowerBudgetRequest
+	(*SetPowerBudgetResponse)(nil),                  // 166: v1.SetPowerBudgetResponse
+	(*DeletePowerBudgetRequest)(nil),                // 167: v1.DeletePowerBudgetRequest
+	(*DeletePowerBudgetResponse)(nil),               // 168: v1.DeletePowerBudgetResponse
+	(*ListPowerBudgetsRequest)(nil),                 // 169: v1.ListPowerBudgetsRequest
+	(*ListPowerBudgetsResponse)(nil),                // 170: v1.ListPowerBudgetsResponse
+	(*GetRackPowerStatusRequest)(nil),               // 171: v1.GetRackPowerStatusRequest
+	(*ShelfPowerStatus)(nil),                        // 172: v1.ShelfPowerStatus
+	(*RackPowerStatus)(nil),                         // 173: v1.RackPowerStatus
+	(*RotateRackCredentialsRequest)(nil),            // 174: v1.RotateRackCredentialsRequest
+	(*CredentialRotationTrigger)(nil),               // 175: v1.CredentialRotationTrigger
+	(*RotateRackCredentialsResponse)(nil),           // 176: v1.RotateRackCredentialsResponse
+	(*GetRackCredentialRotationStatusRequest)(nil),  // 177: v1.GetRackCredentialRotationStatusRequest
+	(*DeviceCredentialRotationStatus)(nil),          // 178: v1.DeviceCredentialRotationStatus
+	(*GetRackCredentialRotationStatusResponse)(nil), // 179: v1.GetRackCredentialRotationStatusResponse
+	(*DiscoveredDevice)(nil),                        // 180: v1.DiscoveredDevice
+	(*DiscoverDevicesRequest)(nil),                  // 181: v1.DiscoverDevicesRequest
+	(*DiscoverDevicesResponse)(nil),                 // 182: v1.DiscoverDevicesResponse
+	(*ListDiscoveredDevicesRequest)(nil),            // 183: v1.ListDiscoveredDevicesRequest
+	(*ListDiscoveredDevicesResponse)(nil),           // 184: v1.ListDiscoveredDevicesResponse
+	(*ApproveDiscoveredDeviceRequest)(nil),          // 185: v1.ApproveDiscoveredDeviceRequest
+	(*RejectDiscoveredDeviceRequest)(nil),           // 186: v1.RejectDiscoveredDeviceRequest
+	(*IdentifierList)(nil),                          // 187: v1.IdentifierList
+	(*CampaignRackSelector)(nil),                    // 188: v1.CampaignRackSelector
+	(*CampaignPolicy)(nil),                          // 189: v1.CampaignPolicy
+	(*CampaignProgress)(nil),                        // 190: v1.CampaignProgress
+	(*CampaignRack)(nil),                            // 191: v1.CampaignRack
+	(*Campaign)(nil),                                // 192: v1.Campaign
+	(*CreateCampaignRequest)(nil),                   // 193: v1.CreateCampaignRequest
+	(*GetCampaignRequest)(nil),                      // 194: v1.GetCampaignRequest
+	(*ListCampaignsRequest)(nil),                    // 195: v1.ListCampaignsRequest
+	(*ListCampaignsResponse)(nil),                   // 196: v1.ListCampaignsResponse
+	(*PauseCampaignRequest)(nil),                    // 197: v1.PauseCampaignRequest
+	(*ResumeCampaignRequest)(nil),                   // 198: v1.ResumeCampaignRequest
+	(*AbortCampaignRequest)(nil),                    // 199: v1.AbortCampaignRequest
+	(*MaintenanceOnce)(nil),                         // 200: v1.MaintenanceOnce
+	(*MaintenanceRecurrence)(nil),                   // 201: v1.MaintenanceRecurrence
+	(*MaintenanceWindow)(nil),                       // 202: v1.MaintenanceWindow
+	(*CreateMaintenanceWindowRequest)(nil),          // 203: v1.CreateMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowRequest)(nil),          // 204: v1.DeleteMaintenanceWindowRequest
+	(*ListMaintenanceWindowsRequest)(nil),           // 205: v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),          // 206: v1.ListMaintenanceWindowsResponse
+	(*GetRackMaintenanceStatusRequest)(nil),         // 207: v1.GetRackMaintenanceStatusRequest
+	(*RackMaintenanceStatus)(nil),                   // 208: v1.RackMaintenanceStatus
+	(*ListMaintenanceOverridesRequest)(nil),         // 209: v1.ListMaintenanceOverridesRequest
+	(*MaintenanceOverrideRecord)(nil),               // 210: v1.MaintenanceOverrideRecord
+	(*ListMaintenanceOverridesResponse)(nil),        // 211: v1.ListMaintenanceOverridesResponse
+	(*ComponentDrift)(nil),                          // 212: v1.ComponentDrift
+	(*ListDriftsRequest)(nil),                       // 213: v1.ListDriftsRequest
+	(*ListDriftsResponse)(nil),                      // 214: v1.ListDriftsResponse
+	(*AcceptDriftRequest)(nil),                      // 215: v1.AcceptDriftRequest
+	(*AcceptDriftResponse)(nil),                     // 216: v1.AcceptDriftResponse
+	(*DismissDriftRequest)(nil),                     // 217: v1.DismissDriftRequest
+	(*ResyncRackRequest)(nil),                       // 218: v1.ResyncRackRequest
+	(*ResyncRackResponse)(nil),                      // 219: v1.ResyncRackResponse
+	(*timestamppb.Timestamp)(nil),                   // 220: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                   // 221: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                           // 222: google.protobuf.Empty
 }
 var file_rla_proto_depIdxs = []int32{
-	14,  // 0: v1.DeviceInfo.id:type_name -> v1.UUID
+	31,  // 0: v1.DeviceInfo.id:type_name -> v1.UUID
 	0,   // 1: v1.BMCInfo.type:type_name -> v1.BMCType
 	1,   // 2: v1.Component.type:type_name -> v1.ComponentType
-	15,  // 3: v1.Component.info:type_name -> v1.DeviceInfo
-	19,  // 4: v1.Component.position:type_name -> v1.RackPosition
-	18,  // 5: v1.Component.bmcs:type_name -> v1.BMCInfo
-	14,  // 6: v1.Component.rack_id:type_name -> v1.UUID
-	15,  // 7: v1.Rack.info:type_name -> v1.DeviceInfo
-	16,  // 8: v1.Rack.location:type_name -> v1.Location
-	20,  // 9: v1.Rack.components:type_name -> v1.Component
-	14,  // 10: v1.Identifier.id:type_name -> v1.UUID
-	24,  // 11: v1.OperationTargetSpec.racks:type_name -> v1.RackTargets
-	25,  // 12: v1.OperationTargetSpec.components:type_name -> v1.ComponentTargets
-	27,  // 13: v1.RackTargets.targets:type_name -> v1.RackTarget
-	28,  // 14: v1.ComponentTargets.targets:type_name -> v1.ComponentTarget
+	32,  // 3: v1.Component.info:type_name -> v1.DeviceInfo
+	36,  // 4: v1.Component.position:type_name -> v1.RackPosition
+	35,  // 5: v1.Component.bmcs:type_name -> v1.BMCInfo
+	31,  // 6: v1.Component.rack_id:type_name -> v1.UUID
+	32,  // 7: v1.Rack.info:type_name -> v1.DeviceInfo
+	33,  // 8: v1.Rack.location:type_name -> v1.Location
+	37,  // 9: v1.Rack.components:type_name -> v1.Component
+	31,  // 10: v1.Identifier.id:type_name -> v1.UUID
+	41,  // 11: v1.OperationTargetSpec.racks:type_name -> v1.RackTargets
+	42,  // 12: v1.OperationTargetSpec.components:type_name -> v1.ComponentTargets
+	44,  // 13: v1.RackTargets.targets:type_name -> v1.RackTarget
+	45,  // 14: v1.ComponentTargets.targets:type_name -> v1.ComponentTarget
 	1,   // 15: v1.ComponentTypes.types:type_name -> v1.ComponentType
-	14,  // 16: v1.RackTarget.id:type_name -> v1.UUID
+	31,  // 16: v1.RackTarget.id:type_name -> v1.UUID
 	1,   // 17: v1.RackTarget.component_types:type_name -> v1.ComponentType
-	14,  // 18: v1.ComponentTarget.id:type_name -> v1.UUID
-	29,  // 19: v1.ComponentTarget.external:type_name -> v1.ExternalRef
+	31,  // 18: v1.ComponentTarget.id:type_name -> v1.UUID
+	46,  // 19: v1.ComponentTarget.external:type_name -> v1.ExternalRef
 	1,   // 20: v1.ExternalRef.type:type_name -> v1.ComponentType
-	22,  // 21: v1.NVLDomain.identifier:type_name -> v1.Identifier
+	39,  // 21: v1.NVLDomain.identifier:type_name -> v1.Identifier
 	2,   // 22: v1.Filter.rack_field:type_name -> v1.RackFilterField
 	3,   // 23: v1.Filter.component_field:type_name -> v1.ComponentFilterField
-	32,  // 24: v1.Filter.query_info:type_name -> v1.StringQueryInfo
+	49,  // 24: v1.Filter.query_info:type_name -> v1.StringQueryInfo
 	5,   // 25: v1.OrderBy.rack_field:type_name -> v1.RackOrderByField
 	4,   // 26: v1.OrderBy.component_field:type_name -> v1.ComponentOrderByField
-	14,  // 27: v1.Task.id:type_name -> v1.UUID
-	14,  // 28: v1.Task.rack_id:type_name -> v1.UUID
-	14,  // 29: v1.Task.component_uuids:type_name -> v1.UUID
-	8,   // 30: v1.Task.executor_type:type_name -> v1.TaskExecutorType
+	31,  // 27: v1.Task.id:type_name -> v1.UUID
+	31,  // 28: v1.Task.rack_id:type_name -> v1.UUID
+	31,  // 29: v1.Task.component_uuids:type_name -> v1.UUID
+	9,   // 30: v1.Task.executor_type:type_name -> v1.TaskExecutorType
 	7,   // 31: v1.Task.status:type_name -> v1.TaskStatus
-	129, // 32: v1.Task.queue_expires_at:type_name -> google.protobuf.Timestamp
-	129, // 33: v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	129, // 34: v1.Task.finished_at:type_name -> google.protobuf.Timestamp
-	14,  // 35: v1.Task.applied_rule_id:type_name -> v1.UUID
-	129, // 36: v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	129, // 37: v1.Task.started_at:type_name -> google.protobuf.Timestamp
-	21,  // 38: v1.CreateExpectedRackRequest.rack:type_name -> v1.Rack
-	14,  // 39: v1.CreateExpectedRackResponse.id:type_name -> v1.UUID
-	14,  // 40: v1.GetRackInfoByIDRequest.id:type_name -> v1.UUID
-	17,  // 41: v1.GetRackInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
-	21,  // 42: v1.GetRackInfoResponse.rack:type_name -> v1.Rack
-	21,  // 43: v1.PatchRackRequest.rack:type_name -> v1.Rack
-	14,  // 44: v1.GetComponentInfoByIDRequest.id:type_name -> v1.UUID
-	17,  // 45: v1.GetComponentInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
-	20,  // 46: v1.GetComponentInfoResponse.component:type_name -> v1.Component
-	21,  // 47: v1.GetComponentInfoResponse.rack:type_name -> v1.Rack
-	33,  // 48: v1.GetListOfRacksRequest.filters:type_name -> v1.Filter
-	31,  // 49: v1.GetListOfRacksRequest.pagination:type_name -> v1.Pagination
-	34,  // 50: v1.GetListOfRacksRequest.order_by:type_name -> v1.OrderBy
-	21,  // 51: v1.GetListOfRacksResponse.racks:type_name -> v1.Rack
-	30,  // 52: v1.CreateNVLDomainRequest.nvl_domain:type_name -> v1.NVLDomain
-	14,  // 53: v1.CreateNVLDomainResponse.id:type_name -> v1.UUID
-	22,  // 54: v1.AttachRacksToNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
-	22,  // 55: v1.AttachRacksToNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
-	22,  // 56: v1.DetachRacksFromNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
-	32,  // 57: v1.GetListOfNVLDomainsRequest.info:type_name -> v1.StringQueryInfo
-	31,  // 58: v1.GetListOfNVLDomainsRequest.pagination:type_name -> v1.Pagination
-	30,  // 59: v1.GetListOfNVLDomainsResponse.nvl_domains:type_name -> v1.NVLDomain
-	22,  // 60: v1.GetRacksForNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
-	21,  // 61: v1.GetRacksForNVLDomainResponse.racks:type_name -> v1.Rack
-	23,  // 62: v1.UpgradeFirmwareRequest.target_spec:type_name -> v1.OperationTargetSpec
-	129, // 63: v1.UpgradeFirmwareRequest.start_time:type_name -> google.protobuf.Timestamp
-	129, // 64: v1.UpgradeFirmwareRequest.end_time:type_name -> google.protobuf.Timestamp
-	76,  // 65: v1.UpgradeFirmwareRequest.queue_options:type_name -> v1.QueueOptions
-	14,  // 66: v1.UpgradeFirmwareRequest.rule_id:type_name -> v1.UUID
-	23,  // 67: v1.GetComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
-	33,  // 68: v1.GetComponentsRequest.filters:type_name -> v1.Filter
-	31,  // 69: v1.GetComponentsRequest.pagination:type_name -> v1.Pagination
-	34,  // 70: v1.GetComponentsRequest.order_by:type_name -> v1.OrderBy
-	20,  // 71: v1.GetComponentsResponse.components:type_name -> v1.Component
-	23,  // 72: v1.ValidateComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
-	33,  // 73: v1.ValidateComponentsRequest.filters:type_name -> v1.Filter
-	31,  // 74: v1.ValidateComponentsRequest.pagination:type_name -> v1.Pagination
-	34,  // 75: v1.ValidateComponentsRequest.order_by:type_name -> v1.OrderBy
-	61,  // 76: v1.ValidateComponentsResponse.diffs:type_name -> v1.ComponentDiff
-	9,   // 77: v1.ComponentDiff.type:type_name -> v1.DiffType
-	20,  // 78: v1.ComponentDiff.expected:type_name -> v1.Component
-	20,  // 79: v1.ComponentDiff.actual:type_name -> v1.Component
-	62,  // 80: v1.ComponentDiff.field_diffs:type_name -> v1.FieldDiff
-	14,  // 81: v1.ComponentDiff.id:type_name -> v1.UUID
-	20,  // 82: v1.AddComponentRequest.component:type_name -> v1.Component
-	20,  // 83: v1.AddComponentResponse.component:type_name -> v1.Component
-	14,  // 84: v1.DeleteComponentRequest.id:type_name -> v1.UUID
-	14,  // 85: v1.DeleteRackRequest.id:type_name -> v1.UUID
-	14,  // 86: v1.PurgeRackRequest.id:type_name -> v1.UUID
-	14,  // 87: v1.PurgeComponentRequest.id:type_name -> v1.UUID
-	14,  // 88: v1.PatchComponentRequest.id:type_name -> v1.UUID
-	19,  // 89: v1.PatchComponentRequest.position:type_name -> v1.RackPosition
-	14,  // 90: v1.PatchComponentRequest.rack_id:type_name -> v1.UUID
-	18,  // 91: v1.PatchComponentRequest.bmcs:type_name -> v1.BMCInfo
-	20,  // 92: v1.PatchComponentResponse.component:type_name -> v1.Component
-	14,  // 93: v1.SubmitTaskResponse.task_ids:type_name -> v1.UUID
-	10,  // 94: v1.QueueOptions.conflict_strategy:type_name -> v1.ConflictStrategy
-	23,  // 95: v1.PowerOnRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	76,  // 96: v1.PowerOnRackRequest.queue_options:type_name -> v1.QueueOptions
-	14,  // 97: v1.PowerOnRackRequest.rule_id:type_name -> v1.UUID
-	23,  // 98: v1.PowerOffRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	76,  // 99: v1.PowerOffRackRequest.queue_options:type_name -> v1.QueueOptions
-	14,  // 100: v1.PowerOffRackRequest.rule_id:type_name -> v1.UUID
-	23,  // 101: v1.PowerResetRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	76,  // 102: v1.PowerResetRackRequest.queue_options:type_name -> v1.QueueOptions
-	14,  // 103: v1.PowerResetRackRequest.rule_id:type_name -> v1.UUID
-	23,  // 104: v1.BringUpRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	14,  // 105: v1.BringUpRackRequest.rule_id:type_name -> v1.UUID
-	23,  // 106: v1.IngestRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	33,  // 107: v1.IngestRackRequest.filters:type_name -> v1.Filter
-	14,  // 108: v1.IngestRackRequest.rule_id:type_name -> v1.UUID
-	14,  // 109: v1.ListTasksRequest.rack_id:type_name -> v1.UUID
-	31,  // 110: v1.ListTasksRequest.pagination:type_name -> v1.Pagination
-	35,  // 111: v1.ListTasksResponse.tasks:type_name -> v1.Task
-	14,  // 112: v1.GetTasksByIDsRequest.task_ids:type_name -> v1.UUID
-	35,  // 113: v1.GetTasksByIDsResponse.tasks:type_name -> v1.Task
-	14,  // 114: v1.CancelTaskRequest.task_id:type_name -> v1.UUID
-	35,  // 115: v1.CancelTaskResponse.task:type_name -> v1.Task
-	14,  // 116: v1.OperationRule.id:type_name -> v1.UUID
-	11,  // 117: v1.OperationRule.operation_type:type_name -> v1.OperationType
-	129, // 118: v1.OperationRule.created_at:type_name -> google.protobuf.Timestamp
-	129, // 119: v1.OperationRule.updated_at:type_name -> google.protobuf.Timestamp
-	11,  // 120: v1.CreateOperationRuleRequest.operation_type:type_name -> v1.OperationType
-	14,  // 121: v1.CreateOperationRuleResponse.id:type_name -> v1.UUID
-	14,  // 122: v1.UpdateOperationRuleRequest.rule_id:type_name -> v1.UUID
-	14,  // 123: v1.DeleteOperationRuleRequest.rule_id:type_name -> v1.UUID
-	14,  // 124: v1.SetRuleAsDefaultRequest.rule_id:type_name -> v1.UUID
-	14,  // 125: v1.GetOperationRuleRequest.rule_id:type_name -> v1.UUID
-	11,  // 126: v1.ListOperationRulesRequest.operation_type:type_name -> v1.OperationType
-	90,  // 127: v1.ListOperationRulesResponse.rules:type_name -> v1.OperationRule
-	14,  // 128: v1.AssociateRuleWithRackRequest.rack_id:type_name -> v1.UUID
-	14,  // 129: v1.AssociateRuleWithRackRequest.rule_id:type_name -> v1.UUID
-	14,  // 130: v1.DisassociateRuleFromRackRequest.rack_id:type_name -> v1.UUID
-	11,  // 131: v1.DisassociateRuleFromRackRequest.operation_type:type_name -> v1.OperationType
-	14,  // 132: v1.GetRackRuleAssociationRequest.rack_id:type_name -> v1.UUID
-	11,  // 133: v1.GetRackRuleAssociationRequest.operation_type:type_name -> v1.OperationType
-	14,  // 134: v1.GetRackRuleAssociationResponse.rule_id:type_name -> v1.UUID
-	14,  // 135: v1.ListRackRuleAssociationsRequest.rack_id:type_name -> v1.UUID
-	14,  // 136: v1.RackRuleAssociation.rack_id:type_name -> v1.UUID
-	11,  // 137: v1.RackRuleAssociation.operation_type:type_name -> v1.OperationType
-	14,  // 138: v1.RackRuleAssociation.rule_id:type_name -> v1.UUID
-	129, // 139: v1.RackRuleAssociation.created_at:type_name -> google.protobuf.Timestamp
-	129, // 140: v1.RackRuleAssociation.updated_at:type_name -> google.protobuf.Timestamp
-	104, // 141: v1.ListRackRuleAssociationsResponse.associations:type_name -> v1.RackRuleAssociation
-	12,  // 142: v1.ScheduleSpec.type:type_name -> v1.ScheduleSpecType
-	106, // 143: v1.ScheduleConfig.spec:type_name -> v1.ScheduleSpec
-	13,  // 144: v1.ScheduleConfig.overlap_policy:type_name -> v1.OverlapPolicy
-	14,  // 145: v1.TaskSchedule.id:type_name -> v1.UUID
-	106, // 146: v1.TaskSchedule.spec:type_name -> v1.ScheduleSpec
-	13,  // 147: v1.TaskSchedule.overlap_policy:type_name -> v1.OverlapPolicy
-	129, // 148: v1.TaskSchedule.next_run_at:type_name -> google.protobuf.Timestamp
-	129, // 149: v1.TaskSchedule.last_run_at:type_name -> google.protobuf.Timestamp
-	129, // 150: v1.TaskSchedule.created_at:type_name -> google.protobuf.Timestamp
-	129, // 151: v1.TaskSchedule.updated_at:type_name -> google.protobuf.Timestamp
-	77,  // 152: v1.ScheduledOperation.power_on:type_name -> v1.PowerOnRackRequest
-	78,  // 153: v1.ScheduledOperation.power_off:type_name -> v1.PowerOffRackRequest
-	79,  // 154: v1.ScheduledOperation.power_reset:type_name -> v1.PowerResetRackRequest
-	80,  // 155: v1.ScheduledOperation.bring_up:type_name -> v1.BringUpRackRequest
-	56,  // 156: v1.ScheduledOperation.upgrade_firmware:type_name -> v1.UpgradeFirmwareRequest
-	81,  // 157: v1.ScheduledOperation.ingest:type_name -> v1.IngestRackRequest
-	107, // 158: v1.CreateTaskScheduleRequest.schedule:type_name -> v1.ScheduleConfig
-	109, // 159: v1.CreateTaskScheduleRequest.operation:type_name -> v1.ScheduledOperation
-	14,  // 160: v1.GetTaskScheduleRequest.id:type_name -> v1.UUID
-	14,  // 161: v1.ListTaskSchedulesRequest.rack_id:type_name -> v1.UUID
-	31,  // 162: v1.ListTaskSchedulesRequest.pagination:type_name -> v1.Pagination
-	108, // 163: v1.ListTaskSchedulesResponse.task_schedules:type_name -> v1.TaskSchedule
-	14,  // 164: v1.UpdateTaskScheduleRequest.id:type_name -> v1.UUID
-	107, // 165: v1.UpdateTaskScheduleRequest.schedule:type_name -> v1.ScheduleConfig
-	130, // 166: v1.UpdateTaskScheduleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	14,  // 167: v1.PauseTaskScheduleRequest.id:type_name -> v1.UUID
-	14,  // 168: v1.ResumeTaskScheduleRequest.id:type_name -> v1.UUID
-	14,  // 169: v1.DeleteTaskScheduleRequest.id:type_name -> v1.UUID
-	14,  // 170: v1.TriggerTaskScheduleRequest.id:type_name -> v1.UUID
-	14,  // 171: v1.TaskScheduleScope.id:type_name -> v1.UUID
-	14,  // 172: v1.TaskScheduleScope.schedule_id:type_name -> v1.UUID
-	14,  // 173: v1.TaskScheduleScope.rack_id:type_name -> v1.UUID
-	26,  // 174: v1.TaskScheduleScope.types:type_name -> v1.ComponentTypes
-	25,  // 175: v1.TaskScheduleScope.components:type_name -> v1.ComponentTargets
-	14,  // 176: v1.TaskScheduleScope.last_task_id:type_name -> v1.UUID
-	129, // 177: v1.TaskScheduleScope.created_at:type_name -> google.protobuf.Timestamp
-	14,  // 178: v1.AddTaskScheduleScopeRequest.schedule_id:type_name -> v1.UUID
-	23,  // 179: v1.AddTaskScheduleScopeRequest.target_spec:type_name -> v1.OperationTargetSpec
-	119, // 180: v1.AddTaskScheduleScopeResponse.scopes:type_name -> v1.TaskScheduleScope
-	14,  // 181: v1.RemoveTaskScheduleScopeRequest.scope_id:type_name -> v1.UUID
-	14,  // 182: v1.UpdateTaskScheduleScopeRequest.schedule_id:type_name -> v1.UUID
-	23,  // 183: v1.UpdateTaskScheduleScopeRequest.desired_scope:type_name -> v1.OperationTargetSpec
-	119, // 184: v1.UpdateTaskScheduleScopeResponse.scopes:type_name -> v1.TaskScheduleScope
-	14,  // 185: v1.ListTaskScheduleScopesRequest.schedule_id:type_name -> v1.UUID
-	119, // 186: v1.ListTaskScheduleScopesResponse.scopes:type_name -> v1.TaskScheduleScope
-	109, // 187: v1.CheckScheduleConflictsRequest.operation:type_name -> v1.ScheduledOperation
-	14,  // 188: v1.CheckScheduleConflictsRequest.exclude_schedule_id:type_name -> v1.UUID
-	108, // 189: v1.CheckScheduleConflictsResponse.conflicts:type_name -> v1.TaskSchedule
-	88,  // 190: v1.RLA.Version:input_type -> v1.VersionRequest
-	110, // 191: v1.RLA.CreateTaskSchedule:input_type -> v1.CreateTaskScheduleRequest
-	111, // 192: v1.RLA.GetTaskSchedule:input_type -> v1.GetTaskScheduleRequest
-	112, // 193: v1.RLA.ListTaskSchedules:input_type -> v1.ListTaskSchedulesRequest
-	114, // 194: v1.RLA.UpdateTaskSchedule:input_type -> v1.UpdateTaskScheduleRequest
-	115, // 195: v1.RLA.PauseTaskSchedule:input_type -> v1.PauseTaskScheduleRequest
-	116, // 196: v1.RLA.ResumeTaskSchedule:input_type -> v1.ResumeTaskScheduleRequest
-	117, // 197: v1.RLA.DeleteTaskSchedule:input_type -> v1.DeleteTaskScheduleRequest
-	118, // 198: v1.RLA.TriggerTaskSchedule:input_type -> v1.TriggerTaskScheduleRequest
-	120, // 199: v1.RLA.AddTaskScheduleScope:input_type -> v1.AddTaskScheduleScopeRequest
-	122, // 200: v1.RLA.RemoveTaskScheduleScope:input_type -> v1.RemoveTaskScheduleScopeRequest
-	123, // 201: v1.RLA.UpdateTaskScheduleScope:input_type -> v1.UpdateTaskScheduleScopeRequest
-	125, // 202: v1.RLA.ListTaskScheduleScopes:input_type -> v1.ListTaskScheduleScopesRequest
-	127, // 203: v1.RLA.CheckScheduleConflicts:input_type -> v1.CheckScheduleConflictsRequest
-	36,  // 204: v1.RLA.CreateExpectedRack:input_type -> v1.CreateExpectedRackRequest
-	38,  // 205: v1.RLA.GetRackInfoByID:input_type -> v1.GetRackInfoByIDRequest
-	39,  // 206: v1.RLA.GetRackInfoBySerial:input_type -> v1.GetRackInfoBySerialRequest
-	46,  // 207: v1.RLA.GetListOfRacks:input_type -> v1.GetListOfRacksRequest
-	41,  // 208: v1.RLA.PatchRack:input_type -> v1.PatchRackRequest
-	67,  // 209: v1.RLA.DeleteRack:input_type -> v1.DeleteRackRequest
-	69,  // 210: v1.RLA.PurgeRack:input_type -> v1.PurgeRackRequest
-	56,  // 211: v1.RLA.UpgradeFirmware:input_type -> v1.UpgradeFirmwareRequest
-	80,  // 212: v1.RLA.BringUpRack:input_type -> v1.BringUpRackRequest
-	81,  // 213: v1.RLA.IngestRack:input_type -> v1.IngestRackRequest
-	77,  // 214: v1.RLA.PowerOnRack:input_type -> v1.PowerOnRackRequest
-	78,  // 215: v1.RLA.PowerOffRack:input_type -> v1.PowerOffRackRequest
-	79,  // 216: v1.RLA.PowerResetRack:input_type -> v1.PowerResetRackRequest
-	43,  // 217: v1.RLA.GetComponentInfoByID:input_type -> v1.GetComponentInfoByIDRequest
-	44,  // 218: v1.RLA.GetComponentInfoBySerial:input_type -> v1.GetComponentInfoBySerialRequest
-	57,  // 219: v1.RLA.GetComponents:input_type -> v1.GetComponentsRequest
-	59,  // 220: v1.RLA.ValidateComponents:input_type -> v1.ValidateComponentsRequest
-	63,  // 221: v1.RLA.AddComponent:input_type -> v1.AddComponentRequest
-	73,  // 222: v1.RLA.PatchComponent:input_type -> v1.PatchComponentRequest
-	65,  // 223: v1.RLA.DeleteComponent:input_type -> v1.DeleteComponentRequest
-	71,  // 224: v1.RLA.PurgeComponent:input_type -> v1.PurgeComponentRequest
-	48,  // 225: v1.RLA.CreateNVLDomain:input_type -> v1.CreateNVLDomainRequest
-	50,  // 226: v1.RLA.AttachRacksToNVLDomain:input_type -> v1.AttachRacksToNVLDomainRequest
-	51,  // 227: v1.RLA.DetachRacksFromNVLDomain:input_type -> v1.DetachRacksFromNVLDomainRequest
-	52,  // 228: v1.RLA.GetListOfNVLDomains:input_type -> v1.GetListOfNVLDomainsRequest
-	54,  // 229: v1.RLA.GetRacksForNVLDomain:input_type -> v1.GetRacksForNVLDomainRequest
-	82,  // 230: v1.RLA.ListTasks:input_type -> v1.ListTasksRequest
-	84,  // 231: v1.RLA.GetTasksByIDs:input_type -> v1.GetTasksByIDsRequest
-	86,  // 232: v1.RLA.CancelTask:input_type -> v1.CancelTaskRequest
-	91,  // 233: v1.RLA.CreateOperationRule:input_type -> v1.CreateOperationRuleRequest
-	93,  // 234: v1.RLA.UpdateOperationRule:input_type -> v1.UpdateOperationRuleRequest
-	94,  // 235: v1.RLA.DeleteOperationRule:input_type -> v1.DeleteOperationRuleRequest
-	96,  // 236: v1.RLA.GetOperationRule:input_type -> v1.GetOperationRuleRequest
-	97,  // 237: v1.RLA.ListOperationRules:input_type -> v1.ListOperationRulesRequest
-	95,  // 238: v1.RLA.SetRuleAsDefault:input_type -> v1.SetRuleAsDefaultRequest
-	99,  // 239: v1.RLA.AssociateRuleWithRack:input_type -> v1.AssociateRuleWithRackRequest
-	100, // 240: v1.RLA.DisassociateRuleFromRack:input_type -> v1.DisassociateRuleFromRackRequest
-	101, // 241: v1.RLA.GetRackRuleAssociation:input_type -> v1.GetRackRuleAssociationRequest
-	103, // 242: v1.RLA.ListRackRuleAssociations:input_type -> v1.ListRackRuleAssociationsRequest
-	89,  // 243: v1.RLA.Version:output_type -> v1.BuildInfo
-	108, // 244: v1.RLA.CreateTaskSchedule:output_type -> v1.TaskSchedule
-	108, // 245: v1.RLA.GetTaskSchedule:output_type -> v1.TaskSchedule
-	113, // 246: v1.RLA.ListTaskSchedules:output_type -> v1.ListTaskSchedulesResponse
-	108, // 247: v1.RLA.UpdateTaskSchedule:output_type -> v1.TaskSchedule
-	108, // 248: v1.RLA.PauseTaskSchedule:output_type -> v1.TaskSchedule
-	108, // 249: v1.RLA.ResumeTaskSchedule:output_type -> v1.TaskSchedule
-	131, // 250: v1.RLA.DeleteTaskSchedule:output_type -> google.protobuf.Empty
-	75,  // 251: v1.RLA.TriggerTaskSchedule:output_type -> v1.SubmitTaskResponse
-	121, // 252: v1.RLA.AddTaskScheduleScope:output_type -> v1.AddTaskScheduleScopeResponse
-	131, // 253: v1.RLA.RemoveTaskScheduleScope:output_type -> google.protobuf.Empty
-	124, // 254: v1.RLA.UpdateTaskScheduleScope:output_type -> v1.UpdateTaskScheduleScopeResponse
-	126, // 255: v1.RLA.ListTaskScheduleScopes:output_type -> v1.ListTaskScheduleScopesResponse
-	128, // 256: v1.RLA.CheckScheduleConflicts:output_type -> v1.CheckScheduleConflictsResponse
-	37,  // 257: v1.RLA.CreateExpectedRack:output_type -> v1.CreateExpectedRackResponse
-	40,  // 258: v1.RLA.GetRackInfoByID:output_type -> v1.GetRackInfoResponse
-	40,  // 259: v1.RLA.GetRackInfoBySerial:output_type -> v1.GetRackInfoResponse
-	47,  // 260: v1.RLA.GetListOfRacks:output_type -> v1.GetListOfRacksResponse
-	42,  // 261: v1.RLA.PatchRack:output_type -> v1.PatchRackResponse
-	68,  // 262: v1.RLA.DeleteRack:output_type -> v1.DeleteRackResponse
-	70,  // 263: v1.RLA.PurgeRack:output_type -> v1.PurgeRackResponse
-	75,  // 264: v1.RLA.UpgradeFirmware:output_type -> v1.SubmitTaskResponse
-	75,  // 265: v1.RLA.BringUpRack:output_type -> v1.SubmitTaskResponse
-	75,  // 266: v1.RLA.IngestRack:output_type -> v1.SubmitTaskResponse
-	75,  // 267: v1.RLA.PowerOnRack:output_type -> v1.SubmitTaskResponse
-	75,  // 268: v1.RLA.PowerOffRack:output_type -> v1.SubmitTaskResponse
-	75,  // 269: v1.RLA.PowerResetRack:output_type -> v1.SubmitTaskResponse
-	45,  // 270: v1.RLA.GetComponentInfoByID:output_type -> v1.GetComponentInfoResponse
-	45,  // 271: v1.RLA.GetComponentInfoBySerial:output_type -> v1.GetComponentInfoResponse
-	58,  // 272: v1.RLA.GetComponents:output_type -> v1.GetComponentsResponse
-	60,  // 273: v1.RLA.ValidateComponents:output_type -> v1.ValidateComponentsResponse
-	64,  // 274: v1.RLA.AddComponent:output_type -> v1.AddComponentResponse
-	74,  // 275: v1.RLA.PatchComponent:output_type -> v1.PatchComponentResponse
-	66,  // 276: v1.RLA.DeleteComponent:output_type -> v1.DeleteComponentResponse
-	72,  // 277: v1.RLA.PurgeComponent:output_type -> v1.PurgeComponentResponse
-	49,  // 278: v1.RLA.CreateNVLDomain:output_type -> v1.CreateNVLDomainResponse
-	131, // 279: v1.RLA.AttachRacksToNVLDomain:output_type -> google.protobuf.Empty
-	131, // 280: v1.RLA.DetachRacksFromNVLDomain:output_type -> google.protobuf.Empty
-	53,  // 281: v1.RLA.GetListOfNVLDomains:output_type -> v1.GetListOfNVLDomainsResponse
-	55,  // 282: v1.RLA.GetRacksForNVLDomain:output_type -> v1.GetRacksForNVLDomainResponse
-	83,  // 283: v1.RLA.ListTasks:output_type -> v1.ListTasksResponse
-	85,  // 284: v1.RLA.GetTasksByIDs:output_type -> v1.GetTasksByIDsResponse
-	87,  // 285: v1.RLA.CancelTask:output_type -> v1.CancelTaskResponse
-	92,  // 286: v1.RLA.CreateOperationRule:output_type -> v1.CreateOperationRuleResponse
-	131, // 287: v1.RLA.UpdateOperationRule:output_type -> google.protobuf.Empty
-	131, // 288: v1.RLA.DeleteOperationRule:output_type -> google.protobuf.Empty
-	90,  // 289: v1.RLA.GetOperationRule:output_type -> v1.OperationRule
-	98,  // 290: v1.RLA.ListOperationRules:output_type -> v1.ListOperationRulesResponse
-	131, // 291: v1.RLA.SetRuleAsDefault:output_type -> google.protobuf.Empty
-	131, // 292: v1.RLA.AssociateRuleWithRack:output_type -> google.protobuf.Empty
-	131, // 293: v1.RLA.DisassociateRuleFromRack:output_type -> google.protobuf.Empty
-	102, // 294: v1.RLA.GetRackRuleAssociation:output_type -> v1.GetRackRuleAssociationResponse
-	105, // 295: v1.RLA.ListRackRuleAssociations:output_type -> v1.ListRackRuleAssociationsResponse
-	243, // [243:296] is the sub-list for method output_type
-	190, // [190:243] is the sub-list for method input_type
-	190, // [190:190] is the sub-list for extension type_name
-	190, // [190:190] is the sub-list for extension extendee
-	0,   // [0:190] is the sub-list for field type_name
+	220, // 32: v1.Task.queue_expires_at:type_name -> google.protobuf.Timestamp
+	220, // 33: v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	220, // 34: v1.Task.finished_at:type_name -> google.protobuf.Timestamp
+	31,  // 35: v1.Task.applied_rule_id:type_name -> v1.UUID
+	220, // 36: v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	220, // 37: v1.Task.started_at:type_name -> google.protobuf.Timestamp
+	54,  // 38: v1.Task.approvals:type_name -> v1.TaskApproval
+	220, // 39: v1.Task.not_before:type_name -> google.protobuf.Timestamp
+	12,  // 40: v1.Task.priority:type_name -> v1.TaskPriority
+	53,  // 41: v1.Task.preemptions:type_name -> v1.TaskPreemption
+	31,  // 42: v1.TaskPreemption.preempted_by:type_name -> v1.UUID
+	12,  // 43: v1.TaskPreemption.priority:type_name -> v1.TaskPriority
+	220, // 44: v1.TaskPreemption.preempted_at:type_name -> google.protobuf.Timestamp
+	220, // 45: v1.TaskApproval.requested_at:type_name -> google.protobuf.Timestamp
+	220, // 46: v1.TaskApproval.deadline:type_name -> google.protobuf.Timestamp
+	8,   // 47: v1.TaskApproval.default_decision:type_name -> v1.ApprovalDecision
+	8,   // 48: v1.TaskApproval.decision:type_name -> v1.ApprovalDecision
+	220, // 49: v1.TaskApproval.decided_at:type_name -> google.protobuf.Timestamp
+	38,  // 50: v1.CreateExpectedRackRequest.rack:type_name -> v1.Rack
+	31,  // 51: v1.CreateExpectedRackResponse.id:type_name -> v1.UUID
+	31,  // 52: v1.GetRackInfoByIDRequest.id:type_name -> v1.UUID
+	34,  // 53: v1.GetRackInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
+	38,  // 54: v1.GetRackInfoResponse.rack:type_name -> v1.Rack
+	38,  // 55: v1.PatchRackRequest.rack:type_name -> v1.Rack
+	31,  // 56: v1.GetComponentInfoByIDRequest.id:type_name -> v1.UUID
+	34,  // 57: v1.GetComponentInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
+	37,  // 58: v1.GetComponentInfoResponse.component:type_name -> v1.Component
+	38,  // 59: v1.GetComponentInfoResponse.rack:type_name -> v1.Rack
+	50,  // 60: v1.GetListOfRacksRequest.filters:type_name -> v1.Filter
+	48,  // 61: v1.GetListOfRacksRequest.pagination:type_name -> v1.Pagination
+	51,  // 62: v1.GetListOfRacksRequest.order_by:type_name -> v1.OrderBy
+	38,  // 63: v1.GetListOfRacksResponse.racks:type_name -> v1.Rack
+	47,  // 64: v1.CreateNVLDomainRequest.nvl_domain:type_name -> v1.NVLDomain
+	31,  // 65: v1.CreateNVLDomainResponse.id:type_name -> v1.UUID
+	39,  // 66: v1.AttachRacksToNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
+	39,  // 67: v1.AttachRacksToNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
+	39,  // 68: v1.DetachRacksFromNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
+	49,  // 69: v1.GetListOfNVLDomainsRequest.info:type_name -> v1.StringQueryInfo
+	48,  // 70: v1.GetListOfNVLDomainsRequest.pagination:type_name -> v1.Pagination
+	47,  // 71: v1.GetListOfNVLDomainsResponse.nvl_domains:type_name -> v1.NVLDomain
+	39,  // 72: v1.GetRacksForNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
+	38,  // 73: v1.GetRacksForNVLDomainResponse.racks:type_name -> v1.Rack
+	40,  // 74: v1.UpgradeFirmwareRequest.target_spec:type_name -> v1.OperationTargetSpec
+	220, // 75: v1.UpgradeFirmwareRequest.start_time:type_name -> google.protobuf.Timestamp
+	220, // 76: v1.UpgradeFirmwareRequest.end_time:type_name -> google.protobuf.Timestamp
+	95,  // 77: v1.UpgradeFirmwareRequest.queue_options:type_name -> v1.QueueOptions
+	31,  // 78: v1.UpgradeFirmwareRequest.rule_id:type_name -> v1.UUID
+	96,  // 79: v1.UpgradeFirmwareRequest.maintenance:type_name -> v1.MaintenanceOptions
+	40,  // 80: v1.GetComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	50,  // 81: v1.GetComponentsRequest.filters:type_name -> v1.Filter
+	48,  // 82: v1.GetComponentsRequest.pagination:type_name -> v1.Pagination
+	51,  // 83: v1.GetComponentsRequest.order_by:type_name -> v1.OrderBy
+	37,  // 84: v1.GetComponentsResponse.components:type_name -> v1.Component
+	40,  // 85: v1.ValidateComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	50,  // 86: v1.ValidateComponentsRequest.filters:type_name -> v1.Filter
+	48,  // 87: v1.ValidateComponentsRequest.pagination:type_name -> v1.Pagination
+	51,  // 88: v1.ValidateComponentsRequest.order_by:type_name -> v1.OrderBy
+	80,  // 89: v1.ValidateComponentsResponse.diffs:type_name -> v1.ComponentDiff
+	10,  // 90: v1.ComponentDiff.type:type_name -> v1.DiffType
+	37,  // 91: v1.ComponentDiff.expected:type_name -> v1.Component
+	37,  // 92: v1.ComponentDiff.actual:type_name -> v1.Component
+	81,  // 93: v1.ComponentDiff.field_diffs:type_name -> v1.FieldDiff
+	31,  // 94: v1.ComponentDiff.id:type_name -> v1.UUID
+	37,  // 95: v1.AddComponentRequest.component:type_name -> v1.Component
+	37,  // 96: v1.AddComponentResponse.component:type_name -> v1.Component
+	31,  // 97: v1.DeleteComponentRequest.id:type_name -> v1.UUID
+	31,  // 98: v1.DeleteRackRequest.id:type_name -> v1.UUID
+	31,  // 99: v1.PurgeRackRequest.id:type_name -> v1.UUID
+	31,  // 100: v1.PurgeComponentRequest.id:type_name -> v1.UUID
+	31,  // 101: v1.PatchComponentRequest.id:type_name -> v1.UUID
+	36,  // 102: v1.PatchComponentRequest.position:type_name -> v1.RackPosition
+	31,  // 103: v1.PatchComponentRequest.rack_id:type_name -> v1.UUID
+	35,  // 104: v1.PatchComponentRequest.bmcs:type_name -> v1.BMCInfo
+	37,  // 105: v1.PatchComponentResponse.component:type_name -> v1.Component
+	31,  // 106: v1.SubmitTaskResponse.task_ids:type_name -> v1.UUID
+	11,  // 107: v1.QueueOptions.conflict_strategy:type_name -> v1.ConflictStrategy
+	12,  // 108: v1.QueueOptions.priority:type_name -> v1.TaskPriority
+	13,  // 109: v1.QueueOptions.preemption:type_name -> v1.Preemption
+	14,  // 110: v1.MaintenanceOptions.policy:type_name -> v1.MaintenancePolicy
+	97,  // 111: v1.MaintenanceOptions.override:type_name -> v1.MaintenanceOverride
+	40,  // 112: v1.PowerOnRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	95,  // 113: v1.PowerOnRackRequest.queue_options:type_name -> v1.QueueOptions
+	31,  // 114: v1.PowerOnRackRequest.rule_id:type_name -> v1.UUID
+	40,  // 115: v1.PowerOffRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	95,  // 116: v1.PowerOffRackRequest.queue_options:type_name -> v1.QueueOptions
+	31,  // 117: v1.PowerOffRackRequest.rule_id:type_name -> v1.UUID
+	96,  // 118: v1.PowerOffRackRequest.maintenance:type_name -> v1.MaintenanceOptions
+	40,  // 119: v1.PowerResetRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	95,  // 120: v1.PowerResetRackRequest.queue_options:type_name -> v1.QueueOptions
+	31,  // 121: v1.PowerResetRackRequest.rule_id:type_name -> v1.UUID
+	96,  // 122: v1.PowerResetRackRequest.maintenance:type_name -> v1.MaintenanceOptions
+	40,  // 123: v1.BringUpRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	31,  // 124: v1.BringUpRackRequest.rule_id:type_name -> v1.UUID
+	96,  // 125: v1.BringUpRackRequest.maintenance:type_name -> v1.MaintenanceOptions
+	40,  // 126: v1.IngestRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	50,  // 127: v1.IngestRackRequest.filters:type_name -> v1.Filter
+	31,  // 128: v1.IngestRackRequest.rule_id:type_name -> v1.UUID
+	98,  // 129: v1.PlanOperationRequest.power_on:type_name -> v1.PowerOnRackRequest
+	99,  // 130: v1.PlanOperationRequest.power_off:type_name -> v1.PowerOffRackRequest
+	100, // 131: v1.PlanOperationRequest.power_reset:type_name -> v1.PowerResetRackRequest
+	75,  // 132: v1.PlanOperationRequest.upgrade_firmware:type_name -> v1.UpgradeFirmwareRequest
+	101, // 133: v1.PlanOperationRequest.bring_up:type_name -> v1.BringUpRackRequest
+	102, // 134: v1.PlanOperationRequest.ingest:type_name -> v1.IngestRackRequest
+	105, // 135: v1.PlanOperationResponse.plans:type_name -> v1.RackOperationPlan
+	31,  // 136: v1.RackOperationPlan.rack_id:type_name -> v1.UUID
+	124, // 137: v1.RackOperationPlan.rule:type_name -> v1.OperationRule
+	15,  // 138: v1.RackOperationPlan.rule_source:type_name -> v1.RuleSource
+	106, // 139: v1.RackOperationPlan.stages:type_name -> v1.PlannedStage
+	52,  // 140: v1.RackOperationPlan.conflicting_tasks:type_name -> v1.Task
+	16,  // 141: v1.RackOperationPlan.outcome:type_name -> v1.PlanOutcome
+	107, // 142: v1.PlannedStage.steps:type_name -> v1.PlannedStep
+	1,   // 143: v1.PlannedStep.component_type:type_name -> v1.ComponentType
+	108, // 144: v1.PlannedStep.batches:type_name -> v1.ComponentBatch
+	31,  // 145: v1.ComponentBatch.component_ids:type_name -> v1.UUID
+	31,  // 146: v1.ListTasksRequest.rack_id:type_name -> v1.UUID
+	48,  // 147: v1.ListTasksRequest.pagination:type_name -> v1.Pagination
+	52,  // 148: v1.ListTasksResponse.tasks:type_name -> v1.Task
+	31,  // 149: v1.GetTasksByIDsRequest.task_ids:type_name -> v1.UUID
+	52,  // 150: v1.GetTasksByIDsResponse.tasks:type_name -> v1.Task
+	31,  // 151: v1.CancelTaskRequest.task_id:type_name -> v1.UUID
+	52,  // 152: v1.CancelTaskResponse.task:type_name -> v1.Task
+	31,  // 153: v1.ApproveTaskStepRequest.task_id:type_name -> v1.UUID
+	52,  // 154: v1.ApproveTaskStepResponse.task:type_name -> v1.Task
+	31,  // 155: v1.RejectTaskStepRequest.task_id:type_name -> v1.UUID
+	52,  // 156: v1.RejectTaskStepResponse.task:type_name -> v1.Task
+	220, // 157: v1.TaskReportEntry.time:type_name -> google.protobuf.Timestamp
+	1,   // 158: v1.TaskReportEntry.component_type:type_name -> v1.ComponentType
+	17,  // 159: v1.TaskReportEntry.outcome:type_name -> v1.TaskReportOutcome
+	31,  // 160: v1.GetTaskReportRequest.task_id:type_name -> v1.UUID
+	18,  // 161: v1.GetTaskReportRequest.format:type_name -> v1.TaskReportFormat
+	52,  // 162: v1.GetTaskReportResponse.task:type_name -> v1.Task
+	119, // 163: v1.GetTaskReportResponse.entries:type_name -> v1.TaskReportEntry
+	31,  // 164: v1.OperationRule.id:type_name -> v1.UUID
+	19,  // 165: v1.OperationRule.operation_type:type_name -> v1.OperationType
+	220, // 166: v1.OperationRule.created_at:type_name -> google.protobuf.Timestamp
+	220, // 167: v1.OperationRule.updated_at:type_name -> google.protobuf.Timestamp
+	19,  // 168: v1.CreateOperationRuleRequest.operation_type:type_name -> v1.OperationType
+	31,  // 169: v1.CreateOperationRuleResponse.id:type_name -> v1.UUID
+	31,  // 170: v1.UpdateOperationRuleRequest.rule_id:type_name -> v1.UUID
+	31,  // 171: v1.DeleteOperationRuleRequest.rule_id:type_name -> v1.UUID
+	31,  // 172: v1.SetRuleAsDefaultRequest.rule_id:type_name -> v1.UUID
+	31,  // 173: v1.GetOperationRuleRequest.rule_id:type_name -> v1.UUID
+	19,  // 174: v1.ListOperationRulesRequest.operation_type:type_name -> v1.OperationType
+	124, // 175: v1.ListOperationRulesResponse.rules:type_name -> v1.OperationRule
+	31,  // 176: v1.AssociateRuleWithRackRequest.rack_id:type_name -> v1.UUID
+	31,  // 177: v1.AssociateRuleWithRackRequest.rule_id:type_name -> v1.UUID
+	31,  // 178: v1.DisassociateRuleFromRackRequest.rack_id:type_name -> v1.UUID
+	19,  // 179: v1.DisassociateRuleFromRackRequest.operation_type:type_name -> v1.OperationType
+	31,  // 180: v1.GetRackRuleAssociationRequest.rack_id:type_name -> v1.UUID
+	19,  // 181: v1.GetRackRuleAssociationRequest.operation_type:type_name -> v1.OperationType
+	31,  // 182: v1.GetRackRuleAssociationResponse.rule_id:type_name -> v1.UUID
+	31,  // 183: v1.ListRackRuleAssociationsRequest.rack_id:type_name -> v1.UUID
+	31,  // 184: v1.RackRuleAssociation.rack_id:type_name -> v1.UUID
+	19,  // 185: v1.RackRuleAssociation.operation_type:type_name -> v1.OperationType
+	31,  // 186: v1.RackRuleAssociation.rule_id:type_name -> v1.UUID
+	220, // 187: v1.RackRuleAssociation.created_at:type_name -> google.protobuf.Timestamp
+	220, // 188: v1.RackRuleAssociation.updated_at:type_name -> google.protobuf.Timestamp
+	138, // 189: v1.ListRackRuleAssociationsResponse.associations:type_name -> v1.RackRuleAssociation
+	20,  // 190: v1.ScheduleSpec.type:type_name -> v1.ScheduleSpecType
+	140, // 191: v1.ScheduleConfig.spec:type_name -> v1.ScheduleSpec
+	21,  // 192: v1.ScheduleConfig.overlap_policy:type_name -> v1.OverlapPolicy
+	31,  // 193: v1.TaskSchedule.id:type_name -> v1.UUID
+	140, // 194: v1.TaskSchedule.spec:type_name -> v1.ScheduleSpec
+	21,  // 195: v1.TaskSchedule.overlap_policy:type_name -> v1.OverlapPolicy
+	220, // 196: v1.TaskSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	220, // 197: v1.TaskSchedule.last_run_at:type_name -> google.protobuf.Timestamp
+	220, // 198: v1.TaskSchedule.created_at:type_name -> google.protobuf.Timestamp
+	220, // 199: v1.TaskSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	98,  // 200: v1.ScheduledOperation.power_on:type_name -> v1.PowerOnRackRequest
+	99,  // 201: v1.ScheduledOperation.power_off:type_name -> v1.PowerOffRackRequest
+	100, // 202: v1.ScheduledOperation.power_reset:type_name -> v1.PowerResetRackRequest
+	101, // 203: v1.ScheduledOperation.bring_up:type_name -> v1.BringUpRackRequest
+	75,  // 204: v1.ScheduledOperation.upgrade_firmware:type_name -> v1.UpgradeFirmwareRequest
+	102, // 205: v1.ScheduledOperation.ingest:type_name -> v1.IngestRackRequest
+	141, // 206: v1.CreateTaskScheduleRequest.schedule:type_name -> v1.ScheduleConfig
+	143, // 207: v1.CreateTaskScheduleRequest.operation:type_name -> v1.ScheduledOperation
+	31,  // 208: v1.GetTaskScheduleRequest.id:type_name -> v1.UUID
+	31,  // 209: v1.ListTaskSchedulesRequest.rack_id:type_name -> v1.UUID
+	48,  // 210: v1.ListTaskSchedulesRequest.pagination:type_name -> v1.Pagination
+	142, // 211: v1.ListTaskSchedulesResponse.task_schedules:type_name -> v1.TaskSchedule
+	31,  // 212: v1.UpdateTaskScheduleRequest.id:type_name -> v1.UUID
+	141, // 213: v1.UpdateTaskScheduleRequest.schedule:type_name -> v1.ScheduleConfig
+	221, // 214: v1.UpdateTaskScheduleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	31,  // 215: v1.PauseTaskScheduleRequest.id:type_name -> v1.UUID
+	31,  // 216: v1.ResumeTaskScheduleRequest.id:type_name -> v1.UUID
+	31,  // 217: v1.DeleteTaskScheduleRequest.id:type_name -> v1.UUID
+	31,  // 218: v1.TriggerTaskScheduleRequest.id:type_name -> v1.UUID
+	31,  // 219: v1.TaskScheduleScope.id:type_name -> v1.UUID
+	31,  // 220: v1.TaskScheduleScope.schedule_id:type_name -> v1.UUID
+	31,  // 221: v1.TaskScheduleScope.rack_id:type_name -> v1.UUID
+	43,  // 222: v1.TaskScheduleScope.types:type_name -> v1.ComponentTypes
+	42,  // 223: v1.TaskScheduleScope.components:type_name -> v1.ComponentTargets
+	31,  // 224: v1.TaskScheduleScope.last_task_id:type_name -> v1.UUID
+	220, // 225: v1.TaskScheduleScope.created_at:type_name -> google.protobuf.Timestamp
+	31,  // 226: v1.AddTaskScheduleScopeRequest.schedule_id:type_name -> v1.UUID
+	40,  // 227: v1.AddTaskScheduleScopeRequest.target_spec:type_name -> v1.OperationTargetSpec
+	153, // 228: v1.AddTaskScheduleScopeResponse.scopes:type_name -> v1.TaskScheduleScope
+	31,  // 229: v1.RemoveTaskScheduleScopeRequest.scope_id:type_name -> v1.UUID
+	31,  // 230: v1.UpdateTaskScheduleScopeRequest.schedule_id:type_name -> v1.UUID
+	40,  // 231: v1.UpdateTaskScheduleScopeRequest.desired_scope:type_name -> v1.OperationTargetSpec
+	153, // 232: v1.UpdateTaskScheduleScopeResponse.scopes:type_name -> v1.TaskScheduleScope
+	31,  // 233: v1.ListTaskScheduleScopesRequest.schedule_id:type_name -> v1.UUID
+	153, // 234: v1.ListTaskScheduleScopesResponse.scopes:type_name -> v1.TaskScheduleScope
+	143, // 235: v1.CheckScheduleConflictsRequest.operation:type_name -> v1.ScheduledOperation
+	31,  // 236: v1.CheckScheduleConflictsRequest.exclude_schedule_id:type_name -> v1.UUID
+	142, // 237: v1.CheckScheduleConflictsResponse.conflicts:type_name -> v1.TaskSchedule
+	31,  // 238: v1.PowerBudget.id:type_name -> v1.UUID
+	31,  // 239: v1.PowerBudget.rack_id:type_name -> v1.UUID
+	31,  // 240: v1.PowerBudget.component_id:type_name -> v1.UUID
+	220, // 241: v1.PowerBudget.created_at:type_name -> google.protobuf.Timestamp
+	220, // 242: v1.PowerBudget.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 243: v1.ShelfPowerLimit.component_id:type_name -> v1.UUID
+	22,  // 244: v1.ShelfPowerLimit.status:type_name -> v1.PowerLimitApplyStatus
+	31,  // 245: v1.SetPowerBudgetRequest.rack_id:type_name -> v1.UUID
+	31,  // 246: v1.SetPowerBudgetRequest.component_id:type_name -> v1.UUID
+	163, // 247: v1.SetPowerBudgetResponse.budget:type_name -> v1.PowerBudget
+	164, // 248: v1.SetPowerBudgetResponse.limits:type_name -> v1.ShelfPowerLimit
+	31,  // 249: v1.DeletePowerBudgetRequest.id:type_name -> v1.UUID
+	164, // 250: v1.DeletePowerBudgetResponse.limits:type_name -> v1.ShelfPowerLimit
+	31,  // 251: v1.ListPowerBudgetsRequest.rack_ids:type_name -> v1.UUID
+	163, // 252: v1.ListPowerBudgetsResponse.budgets:type_name -> v1.PowerBudget
+	31,  // 253: v1.GetRackPowerStatusRequest.rack_id:type_name -> v1.UUID
+	31,  // 254: v1.ShelfPowerStatus.component_id:type_name -> v1.UUID
+	31,  // 255: v1.RackPowerStatus.rack_id:type_name -> v1.UUID
+	172, // 256: v1.RackPowerStatus.shelves:type_name -> v1.ShelfPowerStatus
+	31,  // 257: v1.RotateRackCredentialsRequest.rack_id:type_name -> v1.UUID
+	1,   // 258: v1.RotateRackCredentialsRequest.component_types:type_name -> v1.ComponentType
+	31,  // 259: v1.CredentialRotationTrigger.component_id:type_name -> v1.UUID
+	1,   // 260: v1.CredentialRotationTrigger.component_type:type_name -> v1.ComponentType
+	23,  // 261: v1.CredentialRotationTrigger.account:type_name -> v1.CredentialAccount
+	175, // 262: v1.RotateRackCredentialsResponse.results:type_name -> v1.CredentialRotationTrigger
+	31,  // 263: v1.GetRackCredentialRotationStatusRequest.rack_id:type_name -> v1.UUID
+	31,  // 264: v1.DeviceCredentialRotationStatus.component_id:type_name -> v1.UUID
+	1,   // 265: v1.DeviceCredentialRotationStatus.component_type:type_name -> v1.ComponentType
+	23,  // 266: v1.DeviceCredentialRotationStatus.account:type_name -> v1.CredentialAccount
+	24,  // 267: v1.DeviceCredentialRotationStatus.state:type_name -> v1.DeviceCredentialRotationState
+	220, // 268: v1.DeviceCredentialRotationStatus.last_attempt:type_name -> google.protobuf.Timestamp
+	220, // 269: v1.DeviceCredentialRotationStatus.last_rotated:type_name -> google.protobuf.Timestamp
+	220, // 270: v1.DeviceCredentialRotationStatus.next_rotation:type_name -> google.protobuf.Timestamp
+	178, // 271: v1.GetRackCredentialRotationStatusResponse.statuses:type_name -> v1.DeviceCredentialRotationStatus
+	31,  // 272: v1.DiscoveredDevice.id:type_name -> v1.UUID
+	1,   // 273: v1.DiscoveredDevice.type:type_name -> v1.ComponentType
+	31,  // 274: v1.DiscoveredDevice.component_id:type_name -> v1.UUID
+	25,  // 275: v1.DiscoveredDevice.state:type_name -> v1.DiscoveredDeviceState
+	220, // 276: v1.DiscoveredDevice.first_seen_at:type_name -> google.protobuf.Timestamp
+	220, // 277: v1.DiscoveredDevice.last_seen_at:type_name -> google.protobuf.Timestamp
+	180, // 278: v1.DiscoverDevicesResponse.devices:type_name -> v1.DiscoveredDevice
+	25,  // 279: v1.ListDiscoveredDevicesRequest.states:type_name -> v1.DiscoveredDeviceState
+	180, // 280: v1.ListDiscoveredDevicesResponse.devices:type_name -> v1.DiscoveredDevice
+	31,  // 281: v1.ApproveDiscoveredDeviceRequest.id:type_name -> v1.UUID
+	31,  // 282: v1.ApproveDiscoveredDeviceRequest.component_id:type_name -> v1.UUID
+	31,  // 283: v1.RejectDiscoveredDeviceRequest.id:type_name -> v1.UUID
+	39,  // 284: v1.IdentifierList.identifiers:type_name -> v1.Identifier
+	187, // 285: v1.CampaignRackSelector.racks:type_name -> v1.IdentifierList
+	187, // 286: v1.CampaignRackSelector.nvl_domains:type_name -> v1.IdentifierList
+	33,  // 287: v1.CampaignRackSelector.location:type_name -> v1.Location
+	31,  // 288: v1.CampaignRack.rack_id:type_name -> v1.UUID
+	31,  // 289: v1.CampaignRack.nvl_domain_id:type_name -> v1.UUID
+	31,  // 290: v1.CampaignRack.task_id:type_name -> v1.UUID
+	27,  // 291: v1.CampaignRack.state:type_name -> v1.CampaignRackState
+	220, // 292: v1.CampaignRack.started_at:type_name -> google.protobuf.Timestamp
+	220, // 293: v1.CampaignRack.finished_at:type_name -> google.protobuf.Timestamp
+	31,  // 294: v1.Campaign.id:type_name -> v1.UUID
+	1,   // 295: v1.Campaign.component_types:type_name -> v1.ComponentType
+	189, // 296: v1.Campaign.policy:type_name -> v1.CampaignPolicy
+	26,  // 297: v1.Campaign.state:type_name -> v1.CampaignState
+	190, // 298: v1.Campaign.progress:type_name -> v1.CampaignProgress
+	191, // 299: v1.Campaign.racks:type_name -> v1.CampaignRack
+	220, // 300: v1.Campaign.created_at:type_name -> google.protobuf.Timestamp
+	220, // 301: v1.Campaign.updated_at:type_name -> google.protobuf.Timestamp
+	220, // 302: v1.Campaign.finished_at:type_name -> google.protobuf.Timestamp
+	143, // 303: v1.CreateCampaignRequest.operation:type_name -> v1.ScheduledOperation
+	188, // 304: v1.CreateCampaignRequest.racks:type_name -> v1.CampaignRackSelector
+	1,   // 305: v1.CreateCampaignRequest.component_types:type_name -> v1.ComponentType
+	189, // 306: v1.CreateCampaignRequest.policy:type_name -> v1.CampaignPolicy
+	31,  // 307: v1.GetCampaignRequest.id:type_name -> v1.UUID
+	26,  // 308: v1.ListCampaignsRequest.states:type_name -> v1.CampaignState
+	192, // 309: v1.ListCampaignsResponse.campaigns:type_name -> v1.Campaign
+	31,  // 310: v1.PauseCampaignRequest.id:type_name -> v1.UUID
+	31,  // 311: v1.ResumeCampaignRequest.id:type_name -> v1.UUID
+	31,  // 312: v1.AbortCampaignRequest.id:type_name -> v1.UUID
+	220, // 313: v1.MaintenanceOnce.start_time:type_name -> google.protobuf.Timestamp
+	220, // 314: v1.MaintenanceOnce.end_time:type_name -> google.protobuf.Timestamp
+	31,  // 315: v1.MaintenanceWindow.id:type_name -> v1.UUID
+	28,  // 316: v1.MaintenanceWindow.kind:type_name -> v1.MaintenanceWindowKind
+	31,  // 317: v1.MaintenanceWindow.rack_id:type_name -> v1.UUID
+	31,  // 318: v1.MaintenanceWindow.nvl_domain_id:type_name -> v1.UUID
+	33,  // 319: v1.MaintenanceWindow.site:type_name -> v1.Location
+	200, // 320: v1.MaintenanceWindow.once:type_name -> v1.MaintenanceOnce
+	201, // 321: v1.MaintenanceWindow.recurrence:type_name -> v1.MaintenanceRecurrence
+	220, // 322: v1.MaintenanceWindow.created_at:type_name -> google.protobuf.Timestamp
+	28,  // 323: v1.CreateMaintenanceWindowRequest.kind:type_name -> v1.MaintenanceWindowKind
+	31,  // 324: v1.CreateMaintenanceWindowRequest.rack_id:type_name -> v1.UUID
+	31,  // 325: v1.CreateMaintenanceWindowRequest.nvl_domain_id:type_name -> v1.UUID
+	33,  // 326: v1.CreateMaintenanceWindowRequest.site:type_name -> v1.Location
+	200, // 327: v1.CreateMaintenanceWindowRequest.once:type_name -> v1.MaintenanceOnce
+	201, // 328: v1.CreateMaintenanceWindowRequest.recurrence:type_name -> v1.MaintenanceRecurrence
+	31,  // 329: v1.DeleteMaintenanceWindowRequest.id:type_name -> v1.UUID
+	31,  // 330: v1.ListMaintenanceWindowsRequest.rack_id:type_name -> v1.UUID
+	202, // 331: v1.ListMaintenanceWindowsResponse.windows:type_name -> v1.MaintenanceWindow
+	31,  // 332: v1.GetRackMaintenanceStatusRequest.rack_id:type_name -> v1.UUID
+	220, // 333: v1.GetRackMaintenanceStatusRequest.at:type_name -> google.protobuf.Timestamp
+	31,  // 334: v1.RackMaintenanceStatus.rack_id:type_name -> v1.UUID
+	220, // 335: v1.RackMaintenanceStatus.opening_start:type_name -> google.protobuf.Timestamp
+	220, // 336: v1.RackMaintenanceStatus.opening_end:type_name -> google.protobuf.Timestamp
+	31,  // 337: v1.ListMaintenanceOverridesRequest.rack_id:type_name -> v1.UUID
+	31,  // 338: v1.MaintenanceOverrideRecord.id:type_name -> v1.UUID
+	31,  // 339: v1.MaintenanceOverrideRecord.task_id:type_name -> v1.UUID
+	31,  // 340: v1.MaintenanceOverrideRecord.rack_id:type_name -> v1.UUID
+	220, // 341: v1.MaintenanceOverrideRecord.created_at:type_name -> google.protobuf.Timestamp
+	210, // 342: v1.ListMaintenanceOverridesResponse.overrides:type_name -> v1.MaintenanceOverrideRecord
+	31,  // 343: v1.ComponentDrift.id:type_name -> v1.UUID
+	31,  // 344: v1.ComponentDrift.component_id:type_name -> v1.UUID
+	31,  // 345: v1.ComponentDrift.rack_id:type_name -> v1.UUID
+	1,   // 346: v1.ComponentDrift.component_type:type_name -> v1.ComponentType
+	29,  // 347: v1.ComponentDrift.type:type_name -> v1.DriftType
+	81,  // 348: v1.ComponentDrift.diffs:type_name -> v1.FieldDiff
+	30,  // 349: v1.ComponentDrift.status:type_name -> v1.DriftStatus
+	220, // 350: v1.ComponentDrift.dismissed_at:type_name -> google.protobuf.Timestamp
+	220, // 351: v1.ComponentDrift.checked_at:type_name -> google.protobuf.Timestamp
+	31,  // 352: v1.ListDriftsRequest.rack_id:type_name -> v1.UUID
+	29,  // 353: v1.ListDriftsRequest.types:type_name -> v1.DriftType
+	1,   // 354: v1.ListDriftsRequest.component_types:type_name -> v1.ComponentType
+	212, // 355: v1.ListDriftsResponse.drifts:type_name -> v1.ComponentDrift
+	31,  // 356: v1.AcceptDriftRequest.id:type_name -> v1.UUID
+	37,  // 357: v1.AcceptDriftResponse.component:type_name -> v1.Component
+	31,  // 358: v1.DismissDriftRequest.id:type_name -> v1.UUID
+	31,  // 359: v1.ResyncRackRequest.rack_id:type_name -> v1.UUID
+	212, // 360: v1.ResyncRackResponse.drifts:type_name -> v1.ComponentDrift
+	122, // 361: v1.RLA.Version:input_type -> v1.VersionRequest
+	144, // 362: v1.RLA.CreateTaskSchedule:input_type -> v1.CreateTaskScheduleRequest
+	145, // 363: v1.RLA.GetTaskSchedule:input_type -> v1.GetTaskScheduleRequest
+	146, // 364: v1.RLA.ListTaskSchedules:input_type -> v1.ListTaskSchedulesRequest
+	148, // 365: v1.RLA.UpdateTaskSchedule:input_type -> v1.UpdateTaskScheduleRequest
+	149, // 366: v1.RLA.PauseTaskSchedule:input_type -> v1.PauseTaskScheduleRequest
+	150, // 367: v1.RLA.ResumeTaskSchedule:input_type -> v1.ResumeTaskScheduleRequest
+	151, // 368: v1.RLA.DeleteTaskSchedule:input_type -> v1.DeleteTaskScheduleRequest
+	152, // 369: v1.RLA.TriggerTaskSchedule:input_type -> v1.TriggerTaskScheduleRequest
+	154, // 370: v1.RLA.AddTaskScheduleScope:input_type -> v1.AddTaskScheduleScopeRequest
+	156, // 371: v1.RLA.RemoveTaskScheduleScope:input_type -> v1.RemoveTaskScheduleScopeRequest
+	157, // 372: v1.RLA.UpdateTaskScheduleScope:input_type -> v1.UpdateTaskScheduleScopeRequest
+	159, // 373: v1.RLA.ListTaskScheduleScopes:input_type -> v1.ListTaskScheduleScopesRequest
+	161, // 374: v1.RLA.CheckScheduleConflicts:input_type -> v1.CheckScheduleConflictsRequest
+	55,  // 375: v1.RLA.CreateExpectedRack:input_type -> v1.CreateExpectedRackRequest
+	57,  // 376: v1.RLA.GetRackInfoByID:input_type -> v1.GetRackInfoByIDRequest
+	58,  // 377: v1.RLA.GetRackInfoBySerial:input_type -> v1.GetRackInfoBySerialRequest
+	65,  // 378: v1.RLA.GetListOfRacks:input_type -> v1.GetListOfRacksRequest
+	60,  // 379: v1.RLA.PatchRack:input_type -> v1.PatchRackRequest
+	86,  // 380: v1.RLA.DeleteRack:input_type -> v1.DeleteRackRequest
+	88,  // 381: v1.RLA.PurgeRack:input_type -> v1.PurgeRackRequest
+	75,  // 382: v1.RLA.UpgradeFirmware:input_type -> v1.UpgradeFirmwareRequest
+	101, // 383: v1.RLA.BringUpRack:input_type -> v1.BringUpRackRequest
+	102, // 384: v1.RLA.IngestRack:input_type -> v1.IngestRackRequest
+	98,  // 385: v1.RLA.PowerOnRack:input_type -> v1.PowerOnRackRequest
+	99,  // 386: v1.RLA.PowerOffRack:input_type -> v1.PowerOffRackRequest
+	100, // 387: v1.RLA.PowerResetRack:input_type -> v1.PowerResetRackRequest
+	103, // 388: v1.RLA.PlanOperation:input_type -> v1.PlanOperationRequest
+	62,  // 389: v1.RLA.GetComponentInfoByID:input_type -> v1.GetComponentInfoByIDRequest
+	63,  // 390: v1.RLA.GetComponentInfoBySerial:input_type -> v1.GetComponentInfoBySerialRequest
+	76,  // 391: v1.RLA.GetComponents:input_type -> v1.GetComponentsRequest
+	78,  // 392: v1.RLA.ValidateComponents:input_type -> v1.ValidateComponentsRequest
+	82,  // 393: v1.RLA.AddComponent:input_type -> v1.AddComponentRequest
+	92,  // 394: v1.RLA.PatchComponent:input_type -> v1.PatchComponentRequest
+	84,  // 395: v1.RLA.DeleteComponent:input_type -> v1.DeleteComponentRequest
+	90,  // 396: v1.RLA.PurgeComponent:input_type -> v1.PurgeComponentRequest
+	67,  // 397: v1.RLA.CreateNVLDomain:input_type -> v1.CreateNVLDomainRequest
+	69,  // 398: v1.RLA.AttachRacksToNVLDomain:input_type -> v1.AttachRacksToNVLDomainRequest
+	70,  // 399: v1.RLA.DetachRacksFromNVLDomain:input_type -> v1.DetachRacksFromNVLDomainRequest
+	71,  // 400: v1.RLA.GetListOfNVLDomains:input_type -> v1.GetListOfNVLDomainsRequest
+	73,  // 401: v1.RLA.GetRacksForNVLDomain:input_type -> v1.GetRacksForNVLDomainRequest
+	109, // 402: v1.RLA.ListTasks:input_type -> v1.ListTasksRequest
+	111, // 403: v1.RLA.GetTasksByIDs:input_type -> v1.GetTasksByIDsRequest
+	113, // 404: v1.RLA.CancelTask:input_type -> v1.CancelTaskRequest
+	115, // 405: v1.RLA.ApproveTaskStep:input_type -> v1.ApproveTaskStepRequest
+	117, // 406: v1.RLA.RejectTaskStep:input_type -> v1.RejectTaskStepRequest
+	120, // 407: v1.RLA.GetTaskReport:input_type -> v1.GetTaskReportRequest
+	125, // 408: v1.RLA.CreateOperationRule:input_type -> v1.CreateOperationRuleRequest
+	127, // 409: v1.RLA.UpdateOperationRule:input_type -> v1.UpdateOperationRuleRequest
+	128, // 410: v1.RLA.DeleteOperationRule:input_type -> v1.DeleteOperationRuleRequest
+	130, // 411: v1.RLA.GetOperationRule:input_type -> v1.GetOperationRuleRequest
+	131, // 412: v1.RLA.ListOperationRules:input_type -> v1.ListOperationRulesRequest
+	129, // 413: v1.RLA.SetRuleAsDefault:input_type -> v1.SetRuleAsDefaultRequest
+	133, // 414: v1.RLA.AssociateRuleWithRack:input_type -> v1.AssociateRuleWithRackRequest
+	134, // 415: v1.RLA.DisassociateRuleFromRack:input_type -> v1.DisassociateRuleFromRackRequest
+	135, // 416: v1.RLA.GetRackRuleAssociation:input_type -> v1.GetRackRuleAssociationRequest
+	137, // 417: v1.RLA.ListRackRuleAssociations:input_type -> v1.ListRackRuleAssociationsRequest
+	165, // 418: v1.RLA.SetPowerBudget:input_type -> v1.SetPowerBudgetRequest
+	167, // 419: v1.RLA.DeletePowerBudget:input_type -> v1.DeletePowerBudgetRequest
+	169, // 420: v1.RLA.ListPowerBudgets:input_type -> v1.ListPowerBudgetsRequest
+	171, // 421: v1.RLA.GetRackPowerStatus:input_type -> v1.GetRackPowerStatusRequest
+	174, // 422: v1.RLA.RotateRackCredentials:input_type -> v1.RotateRackCredentialsRequest
+	177, // 423: v1.RLA.GetRackCredentialRotationStatus:input_type -> v1.GetRackCredentialRotationStatusRequest
+	181, // 424: v1.RLA.DiscoverDevices:input_type -> v1.DiscoverDevicesRequest
+	183, // 425: v1.RLA.ListDiscoveredDevices:input_type -> v1.ListDiscoveredDevicesRequest
+	185, // 426: v1.RLA.ApproveDiscoveredDevice:input_type -> v1.ApproveDiscoveredDeviceRequest
+	186, // 427: v1.RLA.RejectDiscoveredDevice:input_type -> v1.RejectDiscoveredDeviceRequest
+	193, // 428: v1.RLA.CreateCampaign:input_type -> v1.CreateCampaignRequest
+	194, // 429: v1.RLA.GetCampaign:input_type -> v1.GetCampaignRequest
+	195, // 430: v1.RLA.ListCampaigns:input_type -> v1.ListCampaignsRequest
+	197, // 431: v1.RLA.PauseCampaign:input_type -> v1.PauseCampaignRequest
+	198, // 432: v1.RLA.ResumeCampaign:input_type -> v1.ResumeCampaignRequest
+	199, // 433: v1.RLA.AbortCampaign:input_type -> v1.AbortCampaignRequest
+	203, // 434: v1.RLA.CreateMaintenanceWindow:input_type -> v1.CreateMaintenanceWindowRequest
+	204, // 435: v1.RLA.DeleteMaintenanceWindow:input_type -> v1.DeleteMaintenanceWindowRequest
+	205, // 436: v1.RLA.ListMaintenanceWindows:input_type -> v1.ListMaintenanceWindowsRequest
+	207, // 437: v1.RLA.GetRackMaintenanceStatus:input_type -> v1.GetRackMaintenanceStatusRequest
+	209, // 438: v1.RLA.ListMaintenanceOverrides:input_type -> v1.ListMaintenanceOverridesRequest
+	213, // 439: v1.RLA.ListDrifts:input_type -> v1.ListDriftsRequest
+	215, // 440: v1.RLA.AcceptDrift:input_type -> v1.AcceptDriftRequest
+	217, // 441: v1.RLA.DismissDrift:input_type -> v1.DismissDriftRequest
+	218, // 442: v1.RLA.ResyncRack:input_type -> v1.ResyncRackRequest
+	123, // 443: v1.RLA.Version:output_type -> v1.BuildInfo
+	142, // 444: v1.RLA.CreateTaskSchedule:output_type -> v1.TaskSchedule
+	142, // 445: v1.RLA.GetTaskSchedule:output_type -> v1.TaskSchedule
+	147, // 446: v1.RLA.ListTaskSchedules:output_type -> v1.ListTaskSchedulesResponse
+	142, // 447: v1.RLA.UpdateTaskSchedule:output_type -> v1.TaskSchedule
+	142, // 448: v1.RLA.PauseTaskSchedule:output_type -> v1.TaskSchedule
+	142, // 449: v1.RLA.ResumeTaskSchedule:output_type -> v1.TaskSchedule
+	222, // 450: v1.RLA.DeleteTaskSchedule:output_type -> google.protobuf.Empty
+	94,  // 451: v1.RLA.TriggerTaskSchedule:output_type -> v1.SubmitTaskResponse
+	155, // 452: v1.RLA.AddTaskScheduleScope:output_type -> v1.AddTaskScheduleScopeResponse
+	222, // 453: v1.RLA.RemoveTaskScheduleScope:output_type -> google.protobuf.Empty
+	158, // 454: v1.RLA.UpdateTaskScheduleScope:output_type -> v1.UpdateTaskScheduleScopeResponse
+	160, // 455: v1.RLA.ListTaskScheduleScopes:output_type -> v1.ListTaskScheduleScopesResponse
+	162, // 456: v1.RLA.CheckScheduleConflicts:output_type -> v1.CheckScheduleConflictsResponse
+	56,  // 457: v1.RLA.CreateExpectedRack:output_type -> v1.CreateExpectedRackResponse
+	59,  // 458: v1.RLA.GetRackInfoByID:output_type -> v1.GetRackInfoResponse
+	59,  // 459: v1.RLA.GetRackInfoBySerial:output_type -> v1.GetRackInfoResponse
+	66,  // 460: v1.RLA.GetListOfRacks:output_type -> v1.GetListOfRacksResponse
+	61,  // 461: v1.RLA.PatchRack:output_type -> v1.PatchRackResponse
+	87,  // 462: v1.RLA.DeleteRack:output_type -> v1.DeleteRackResponse
+	89,  // 463: v1.RLA.PurgeRack:output_type -> v1.PurgeRackResponse
+	94,  // 464: v1.RLA.UpgradeFirmware:output_type -> v1.SubmitTaskResponse
+	94,  // 465: v1.RLA.BringUpRack:output_type -> v1.SubmitTaskResponse
+	94,  // 466: v1.RLA.IngestRack:output_type -> v1.SubmitTaskResponse
+	94,  // 467: v1.RLA.PowerOnRack:output_type -> v1.SubmitTaskResponse
+	94,  // 468: v1.RLA.PowerOffRack:output_type -> v1.SubmitTaskResponse
+	94,  // 469: v1.RLA.PowerResetRack:output_type -> v1.SubmitTaskResponse
+	104, // 470: v1.RLA.PlanOperation:output_type -> v1.PlanOperationResponse
+	64,  // 471: v1.RLA.GetComponentInfoByID:output_type -> v1.GetComponentInfoResponse
+	64,  // 472: v1.RLA.GetComponentInfoBySerial:output_type -> v1.GetComponentInfoResponse
+	77,  // 473: v1.RLA.GetComponents:output_type -> v1.GetComponentsResponse
+	79,  // 474: v1.RLA.ValidateComponents:output_type -> v1.ValidateComponentsResponse
+	83,  // 475: v1.RLA.AddComponent:output_type -> v1.AddComponentResponse
+	93,  // 476: v1.RLA.PatchComponent:output_type -> v1.PatchComponentResponse
+	85,  // 477: v1.RLA.DeleteComponent:output_type -> v1.DeleteComponentResponse
+	91,  // 478: v1.RLA.PurgeComponent:output_type -> v1.PurgeComponentResponse
+	68,  // 479: v1.RLA.CreateNVLDomain:output_type -> v1.CreateNVLDomainResponse
+	222, // 480: v1.RLA.AttachRacksToNVLDomain:output_type -> google.protobuf.Empty
+	222, // 481: v1.RLA.DetachRacksFromNVLDomain:output_type -> google.protobuf.Empty
+	72,  // 482: v1.RLA.GetListOfNVLDomains:output_type -> v1.GetListOfNVLDomainsResponse
+	74,  // 483: v1.RLA.GetRacksForNVLDomain:output_type -> v1.GetRacksForNVLDomainResponse
+	110, // 484: v1.RLA.ListTasks:output_type -> v1.ListTasksResponse
+	112, // 485: v1.RLA.GetTasksByIDs:output_type -> v1.GetTasksByIDsResponse
+	114, // 486: v1.RLA.CancelTask:output_type -> v1.CancelTaskResponse
+	116, // 487: v1.RLA.ApproveTaskStep:output_type -> v1.ApproveTaskStepResponse
+	118, // 488: v1.RLA.RejectTaskStep:output_type -> v1.RejectTaskStepResponse
+	121, // 489: v1.RLA.GetTaskReport:output_type -> v1.GetTaskReportResponse
+	126, // 490: v1.RLA.CreateOperationRule:output_type -> v1.CreateOperationRuleResponse
+	222, // 491: v1.RLA.UpdateOperationRule:output_type -> google.protobuf.Empty
+	222, // 492: v1.RLA.DeleteOperationRule:output_type -> google.protobuf.Empty
+	124, // 493: v1.RLA.GetOperationRule:output_type -> v1.OperationRule
+	132, // 494: v1.RLA.ListOperationRules:output_type -> v1.ListOperationRulesResponse
+	222, // 495: v1.RLA.SetRuleAsDefault:output_type -> google.protobuf.Empty
+	222, // 496: v1.RLA.AssociateRuleWithRack:output_type -> google.protobuf.Empty
+	222, // 497: v1.RLA.DisassociateRuleFromRack:output_type -> google.protobuf.Empty
+	136, // 498: v1.RLA.GetRackRuleAssociation:output_type -> v1.GetRackRuleAssociationResponse
+	139, // 499: v1.RLA.ListRackRuleAssociations:output_type -> v1.ListRackRuleAssociationsResponse
+	166, // 500: v1.RLA.SetPowerBudget:output_type -> v1.SetPowerBudgetResponse
+	168, // 501: v1.RLA.DeletePowerBudget:output_type -> v1.DeletePowerBudgetResponse
+	170, // 502: v1.RLA.ListPowerBudgets:output_type -> v1.ListPowerBudgetsResponse
+	173, // 503: v1.RLA.GetRackPowerStatus:output_type -> v1.RackPowerStatus
+	176, // 504: v1.RLA.RotateRackCredentials:output_type -> v1.RotateRackCredentialsResponse
+	179, // 505: v1.RLA.GetRackCredentialRotationStatus:output_type -> v1.GetRackCredentialRotationStatusResponse
+	182, // 506: v1.RLA.DiscoverDevices:output_type -> v1.DiscoverDevicesResponse
+	184, // 507: v1.RLA.ListDiscoveredDevices:output_type -> v1.ListDiscoveredDevicesResponse
+	180, // 508: v1.RLA.ApproveDiscoveredDevice:output_type -> v1.DiscoveredDevice
+	180, // 509: v1.RLA.RejectDiscoveredDevice:output_type -> v1.DiscoveredDevice
+	192, // 510: v1.RLA.CreateCampaign:output_type -> v1.Campaign
+	192, // 511: v1.RLA.GetCampaign:output_type -> v1.Campaign
+	196, // 512: v1.RLA.ListCampaigns:output_type -> v1.ListCampaignsResponse
+	192, // 513: v1.RLA.PauseCampaign:output_type -> v1.Campaign
+	192, // 514: v1.RLA.ResumeCampaign:output_type -> v1.Campaign
+	192, // 515: v1.RLA.AbortCampaign:output_type -> v1.Campaign
+	202, // 516: v1.RLA.CreateMaintenanceWindow:output_type -> v1.MaintenanceWindow
+	222, // 517: v1.RLA.DeleteMaintenanceWindow:output_type -> google.protobuf.Empty
+	206, // 518: v1.RLA.ListMaintenanceWindows:output_type -> v1.ListMaintenanceWindowsResponse
+	208, // 519: v1.RLA.GetRackMaintenanceStatus:output_type -> v1.RackMaintenanceStatus
+	211, // 520: v1.RLA.ListMaintenanceOverrides:output_type -> v1.ListMaintenanceOverridesResponse
+	214, // 521: v1.RLA.ListDrifts:output_type -> v1.ListDriftsResponse
+	216, // 522: v1.RLA.AcceptDrift:output_type -> v1.AcceptDriftResponse
+	212, // 523: v1.RLA.DismissDrift:output_type -> v1.ComponentDrift
+	219, // 524: v1.RLA.ResyncRack:output_type -> v1.ResyncRackResponse
+	443, // [443:525] is the sub-list for method output_type
+	361, // [361:443] is the sub-list for method input_type
+	361, // [361:361] is the sub-list for extension type_name
+	361, // [361:361] is the sub-list for extension extendee
+	0,   // [0:361] is the sub-list for field type_name
 }
 
 func init() { file_rla_proto_init() }
@@ -9488,22 +15838,32 @@ func file_rla_proto_init() {
 		(*OrderBy_ComponentField)(nil),
 	}
 	file_rla_proto_msgTypes[21].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[32].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[38].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[42].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[43].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[23].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[34].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[40].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[44].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[45].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[59].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[63].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[64].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[47].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[61].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[65].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[66].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[67].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[68].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[79].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[83].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[94].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[95].OneofWrappers = []any{
+	file_rla_proto_msgTypes[69].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[70].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[71].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[72].OneofWrappers = []any{
+		(*PlanOperationRequest_PowerOn)(nil),
+		(*PlanOperationRequest_PowerOff)(nil),
+		(*PlanOperationRequest_PowerReset)(nil),
+		(*PlanOperationRequest_UpgradeFirmware)(nil),
+		(*PlanOperationRequest_BringUp)(nil),
+		(*PlanOperationRequest_Ingest)(nil),
+	}
+	file_rla_proto_msgTypes[78].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[96].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[100].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[111].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[112].OneofWrappers = []any{
 		(*ScheduledOperation_PowerOn)(nil),
 		(*ScheduledOperation_PowerOff)(nil),
 		(*ScheduledOperation_PowerReset)(nil),
@@ -9511,19 +15871,52 @@ func file_rla_proto_init() {
 		(*ScheduledOperation_UpgradeFirmware)(nil),
 		(*ScheduledOperation_Ingest)(nil),
 	}
-	file_rla_proto_msgTypes[98].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[105].OneofWrappers = []any{
+	file_rla_proto_msgTypes[115].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[122].OneofWrappers = []any{
 		(*TaskScheduleScope_Types)(nil),
 		(*TaskScheduleScope_Components)(nil),
 	}
-	file_rla_proto_msgTypes[113].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[130].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[132].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[134].OneofWrappers = []any{
+		(*SetPowerBudgetRequest_RackId)(nil),
+		(*SetPowerBudgetRequest_ComponentId)(nil),
+	}
+	file_rla_proto_msgTypes[141].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[149].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[154].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[157].OneofWrappers = []any{
+		(*CampaignRackSelector_Racks)(nil),
+		(*CampaignRackSelector_NvlDomains)(nil),
+		(*CampaignRackSelector_Location)(nil),
+	}
+	file_rla_proto_msgTypes[171].OneofWrappers = []any{
+		(*MaintenanceWindow_RackId)(nil),
+		(*MaintenanceWindow_NvlDomainId)(nil),
+		(*MaintenanceWindow_Site)(nil),
+		(*MaintenanceWindow_Once)(nil),
+		(*MaintenanceWindow_Recurrence)(nil),
+	}
+	file_rla_proto_msgTypes[172].OneofWrappers = []any{
+		(*CreateMaintenanceWindowRequest_RackId)(nil),
+		(*CreateMaintenanceWindowRequest_NvlDomainId)(nil),
+		(*CreateMaintenanceWindowRequest_Site)(nil),
+		(*CreateMaintenanceWindowRequest_Once)(nil),
+		(*CreateMaintenanceWindowRequest_Recurrence)(nil),
+	}
+	file_rla_proto_msgTypes[174].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[176].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[177].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[178].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[181].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[182].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_rla_proto_rawDesc,
-			NumEnums:      14,
-			NumMessages:   115,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rla_proto_rawDesc), len(file_rla_proto_rawDesc)),
+			NumEnums:      31,
+			NumMessages:   189,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9533,7 +15926,6 @@ func file_rla_proto_init() {
 		MessageInfos:      file_rla_proto_msgTypes,
 	}.Build()
 	File_rla_proto = out.File
-	file_rla_proto_rawDesc = nil
 	file_rla_proto_goTypes = nil
 	file_rla_proto_depIdxs = nil
 }
@@ -16,7 +16,7 @@
 
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.5.1
+// - protoc-gen-go-grpc v1.6.1
 // - protoc             v5.29.2
 // source: rla.proto
 
@@ -36,59 +36,88 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RLA_Version_FullMethodName                  = "/v1.RLA/Version"
-	RLA_CreateTaskSchedule_FullMethodName       = "/v1.RLA/CreateTaskSchedule"
-	RLA_GetTaskSchedule_FullMethodName          = "/v1.RLA/GetTaskSchedule"
-	RLA_ListTaskSchedules_FullMethodName        = "/v1.RLA/ListTaskSchedules"
-	RLA_UpdateTaskSchedule_FullMethodName       = "/v1.RLA/UpdateTaskSchedule"
-	RLA_PauseTaskSchedule_FullMethodName        = "/v1.RLA/PauseTaskSchedule"
-	RLA_ResumeTaskSchedule_FullMethodName       = "/v1.RLA/ResumeTaskSchedule"
-	RLA_DeleteTaskSchedule_FullMethodName       = "/v1.RLA/DeleteTaskSchedule"
-	RLA_TriggerTaskSchedule_FullMethodName      = "/v1.RLA/TriggerTaskSchedule"
-	RLA_AddTaskScheduleScope_FullMethodName     = "/v1.RLA/AddTaskScheduleScope"
-	RLA_RemoveTaskScheduleScope_FullMethodName  = "/v1.RLA/RemoveTaskScheduleScope"
-	RLA_UpdateTaskScheduleScope_FullMethodName  = "/v1.RLA/UpdateTaskScheduleScope"
-	RLA_ListTaskScheduleScopes_FullMethodName   = "/v1.RLA/ListTaskScheduleScopes"
-	RLA_CheckScheduleConflicts_FullMethodName   = "/v1.RLA/CheckScheduleConflicts"
-	RLA_CreateExpectedRack_FullMethodName       = "/v1.RLA/CreateExpectedRack"
-	RLA_GetRackInfoByID_FullMethodName          = "/v1.RLA/GetRackInfoByID"
-	RLA_GetRackInfoBySerial_FullMethodName      = "/v1.RLA/GetRackInfoBySerial"
-	RLA_GetListOfRacks_FullMethodName           = "/v1.RLA/GetListOfRacks"
-	RLA_PatchRack_FullMethodName                = "/v1.RLA/PatchRack"
-	RLA_DeleteRack_FullMethodName               = "/v1.RLA/DeleteRack"
-	RLA_PurgeRack_FullMethodName                = "/v1.RLA/PurgeRack"
-	RLA_UpgradeFirmware_FullMethodName          = "/v1.RLA/UpgradeFirmware"
-	RLA_BringUpRack_FullMethodName              = "/v1.RLA/BringUpRack"
-	RLA_IngestRack_FullMethodName               = "/v1.RLA/IngestRack"
-	RLA_PowerOnRack_FullMethodName              = "/v1.RLA/PowerOnRack"
-	RLA_PowerOffRack_FullMethodName             = "/v1.RLA/PowerOffRack"
-	RLA_PowerResetRack_FullMethodName           = "/v1.RLA/PowerResetRack"
-	RLA_GetComponentInfoByID_FullMethodName     = "/v1.RLA/GetComponentInfoByID"
-	RLA_GetComponentInfoBySerial_FullMethodName = "/v1.RLA/GetComponentInfoBySerial"
-	RLA_GetComponents_FullMethodName            = "/v1.RLA/GetComponents"
-	RLA_ValidateComponents_FullMethodName       = "/v1.RLA/ValidateComponents"
-	RLA_AddComponent_FullMethodName             = "/v1.RLA/AddComponent"
-	RLA_PatchComponent_FullMethodName           = "/v1.RLA/PatchComponent"
-	RLA_DeleteComponent_FullMethodName          = "/v1.RLA/DeleteComponent"
-	RLA_PurgeComponent_FullMethodName           = "/v1.RLA/PurgeComponent"
-	RLA_CreateNVLDomain_FullMethodName          = "/v1.RLA/CreateNVLDomain"
-	RLA_AttachRacksToNVLDomain_FullMethodName   = "/v1.RLA/AttachRacksToNVLDomain"
-	RLA_DetachRacksFromNVLDomain_FullMethodName = "/v1.RLA/DetachRacksFromNVLDomain"
-	RLA_GetListOfNVLDomains_FullMethodName      = "/v1.RLA/GetListOfNVLDomains"
-	RLA_GetRacksForNVLDomain_FullMethodName     = "/v1.RLA/GetRacksForNVLDomain"
-	RLA_ListTasks_FullMethodName                = "/v1.RLA/ListTasks"
-	RLA_GetTasksByIDs_FullMethodName            = "/v1.RLA/GetTasksByIDs"
-	RLA_CancelTask_FullMethodName               = "/v1.RLA/CancelTask"
-	RLA_CreateOperationRule_FullMethodName      = "/v1.RLA/CreateOperationRule"
-	RLA_UpdateOperationRule_FullMethodName      = "/v1.RLA/UpdateOperationRule"
-	RLA_DeleteOperationRule_FullMethodName      = "/v1.RLA/DeleteOperationRule"
-	RLA_GetOperationRule_FullMethodName         = "/v1.RLA/GetOperationRule"
-	RLA_ListOperationRules_FullMethodName       = "/v1.RLA/ListOperationRules"
-	RLA_SetRuleAsDefault_FullMethodName         = "/v1.RLA/SetRuleAsDefault"
-	RLA_AssociateRuleWithRack_FullMethodName    = "/v1.RLA/AssociateRuleWithRack"
-	RLA_DisassociateRuleFromRack_FullMethodName = "/v1.RLA/DisassociateRuleFromRack"
-	RLA_GetRackRuleAssociation_FullMethodName   = "/v1.RLA/GetRackRuleAssociation"
-	RLA_ListRackRuleAssociations_FullMethodName = "/v1.RLA/ListRackRuleAssociations"
+	RLA_Version_FullMethodName                         = "/v1.RLA/Version"
+	RLA_CreateTaskSchedule_FullMethodName              = "/v1.RLA/CreateTaskSchedule"
+	RLA_GetTaskSchedule_FullMethodName                 = "/v1.RLA/GetTaskSchedule"
+	RLA_ListTaskSchedules_FullMethodName               = "/v1.RLA/ListTaskSchedules"
+	RLA_UpdateTaskSchedule_FullMethodName              = "/v1.RLA/UpdateTaskSchedule"
+	RLA_PauseTaskSchedule_FullMethodName               = "/v1.RLA/PauseTaskSchedule"
+	RLA_ResumeTaskSchedule_FullMethodName              = "/v1.RLA/ResumeTaskSchedule"
+	RLA_DeleteTaskSchedule_FullMethodName              = "/v1.RLA/DeleteTaskSchedule"
+	RLA_TriggerTaskSchedule_FullMethodName             = "/v1.RLA/TriggerTaskSchedule"
+	RLA_AddTaskScheduleScope_FullMethodName            = "/v1.RLA/AddTaskScheduleScope"
+	RLA_RemoveTaskScheduleScope_FullMethodName         = "/v1.RLA/RemoveTaskScheduleScope"
+	RLA_UpdateTaskScheduleScope_FullMethodName         = "/v1.RLA/UpdateTaskScheduleScope"
+	RLA_ListTaskScheduleScopes_FullMethodName          = "/v1.RLA/ListTaskScheduleScopes"
+	RLA_CheckScheduleConflicts_FullMethodName          = "/v1.RLA/CheckScheduleConflicts"
+	RLA_CreateExpectedRack_FullMethodName              = "/v1.RLA/CreateExpectedRack"
+	RLA_GetRackInfoByID_FullMethodName                 = "/v1.RLA/GetRackInfoByID"
+	RLA_GetRackInfoBySerial_FullMethodName             = "/v1.RLA/GetRackInfoBySerial"
+	RLA_GetListOfRacks_FullMethodName                  = "/v1.RLA/GetListOfRacks"
+	RLA_PatchRack_FullMethodName                       = "/v1.RLA/PatchRack"
+	RLA_DeleteRack_FullMethodName                      = "/v1.RLA/DeleteRack"
+	RLA_PurgeRack_FullMethodName                       = "/v1.RLA/PurgeRack"
+	RLA_UpgradeFirmware_FullMethodName                 = "/v1.RLA/UpgradeFirmware"
+	RLA_BringUpRack_FullMethodName                     = "/v1.RLA/BringUpRack"
+	RLA_IngestRack_FullMethodName                      = "/v1.RLA/IngestRack"
+	RLA_PowerOnRack_FullMethodName                     = "/v1.RLA/PowerOnRack"
+	RLA_PowerOffRack_FullMethodName                    = "/v1.RLA/PowerOffRack"
+	RLA_PowerResetRack_FullMethodName                  = "/v1.RLA/PowerResetRack"
+	RLA_PlanOperation_FullMethodName                   = "/v1.RLA/PlanOperation"
+	RLA_GetComponentInfoByID_FullMethodName            = "/v1.RLA/GetComponentInfoByID"
+	RLA_GetComponentInfoBySerial_FullMethodName        = "/v1.RLA/GetComponentInfoBySerial"
+	RLA_GetComponents_FullMethodName                   = "/v1.RLA/GetComponents"
+	RLA_ValidateComponents_FullMethodName              = "/v1.RLA/ValidateComponents"
+	RLA_AddComponent_FullMethodName                    = "/v1.RLA/AddComponent"
+	RLA_PatchComponent_FullMethodName                  = "/v1.RLA/PatchComponent"
+	RLA_DeleteComponent_FullMethodName                 = "/v1.RLA/DeleteComponent"
+	RLA_PurgeComponent_FullMethodName                  = "/v1.RLA/PurgeComponent"
+	RLA_CreateNVLDomain_FullMethodName                 = "/v1.RLA/CreateNVLDomain"
+	RLA_AttachRacksToNVLDomain_FullMethodName          = "/v1.RLA/AttachRacksToNVLDomain"
+	RLA_DetachRacksFromNVLDomain_FullMethodName        = "/v1.RLA/DetachRacksFromNVLDomain"
+	RLA_GetListOfNVLDomains_FullMethodName             = "/v1.RLA/GetListOfNVLDomains"
+	RLA_GetRacksForNVLDomain_FullMethodName            = "/v1.RLA/GetRacksForNVLDomain"
+	RLA_ListTasks_FullMethodName                       = "/v1.RLA/ListTasks"
+	RLA_GetTasksByIDs_FullMethodName                   = "/v1.RLA/GetTasksByIDs"
+	RLA_CancelTask_FullMethodName                      = "/v1.RLA/CancelTask"
+	RLA_ApproveTaskStep_FullMethodName                 = "/v1.RLA/ApproveTaskStep"
+	RLA_RejectTaskStep_FullMethodName                  = "/v1.RLA/RejectTaskStep"
+	RLA_GetTaskReport_FullMethodName                   = "/v1.RLA/GetTaskReport"
+	RLA_CreateOperationRule_FullMethodName             = "/v1.RLA/CreateOperationRule"
+	RLA_UpdateOperationRule_FullMethodName             = "/v1.RLA/UpdateOperationRule"
+	RLA_DeleteOperationRule_FullMethodName             = "/v1.RLA/DeleteOperationRule"
+	RLA_GetOperationRule_FullMethodName                = "/v1.RLA/GetOperationRule"
+	RLA_ListOperationRules_FullMethodName              = "/v1.RLA/ListOperationRules"
+	RLA_SetRuleAsDefault_FullMethodName                = "/v1.RLA/SetRuleAsDefault"
+	RLA_AssociateRuleWithRack_FullMethodName           = "/v1.RLA/AssociateRuleWithRack"
+	RLA_DisassociateRuleFromRack_FullMethodName        = "/v1.RLA/DisassociateRuleFromRack"
+	RLA_GetRackRuleAssociation_FullMethodName          = "/v1.RLA/GetRackRuleAssociation"
+	RLA_ListRackRuleAssociations_FullMethodName        = "/v1.RLA/ListRackRuleAssociations"
+	RLA_SetPowerBudget_FullMethodName                  = "/v1.RLA/SetPowerBudget"
+	RLA_DeletePowerBudget_FullMethodName               = "/v1.RLA/DeletePowerBudget"
+	RLA_ListPowerBudgets_FullMethodName                = "/v1.RLA/ListPowerBudgets"
+	RLA_GetRackPowerStatus_FullMethodName              = "/v1.RLA/GetRackPowerStatus"
+	RLA_RotateRackCredentials_FullMethodName           = "/v1.RLA/RotateRackCredentials"
+	RLA_GetRackCredentialRotationStatus_FullMethodName = "/v1.RLA/GetRackCredentialRotationStatus"
+	RLA_DiscoverDevices_FullMethodName                 = "/v1.RLA/DiscoverDevices"
+	RLA_ListDiscoveredDevices_FullMethodName           = "/v1.RLA/ListDiscoveredDevices"
+	RLA_ApproveDiscoveredDevice_FullMethodName         = "/v1.RLA/ApproveDiscoveredDevice"
+	RLA_RejectDiscoveredDevice_FullMethodName          = "/v1.RLA/RejectDiscoveredDevice"
+	RLA_CreateCampaign_FullMethodName                  = "/v1.RLA/CreateCampaign"
+	RLA_GetCampaign_FullMethodName                     = "/v1.RLA/GetCampaign"
+	RLA_ListCampaigns_FullMethodName                   = "/v1.RLA/ListCampaigns"
+	RLA_PauseCampaign_FullMethodName                   = "/v1.RLA/PauseCampaign"
+	RLA_ResumeCampaign_FullMethodName                  = "/v1.RLA/ResumeCampaign"
+	RLA_AbortCampaign_FullMethodName                   = "/v1.RLA/AbortCampaign"
+	RLA_CreateMaintenanceWindow_FullMethodName         = "/v1.RLA/CreateMaintenanceWindow"
+	RLA_DeleteMaintenanceWindow_FullMethodName         = "/v1.RLA/DeleteMaintenanceWindow"
+	RLA_ListMaintenanceWindows_FullMethodName          = "/v1.RLA/ListMaintenanceWindows"
+	RLA_GetRackMaintenanceStatus_FullMethodName        = "/v1.RLA/GetRackMaintenanceStatus"
+	RLA_ListMaintenanceOverrides_FullMethodName        = "/v1.RLA/ListMaintenanceOverrides"
+	RLA_ListDrifts_FullMethodName                      = "/v1.RLA/ListDrifts"
+	RLA_AcceptDrift_FullMethodName                     = "/v1.RLA/AcceptDrift"
+	RLA_DismissDrift_FullMethodName                    = "/v1.RLA/DismissDrift"
+	RLA_ResyncRack_FullMethodName                      = "/v1.RLA/ResyncRack"
 )
 
 // RLAClient is the client API for RLA service.
@@ -126,6 +155,7 @@ type RLAClient interface {
 	PowerOnRack(ctx context.Context, in *PowerOnRackRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
 	PowerOffRack(ctx context.Context, in *PowerOffRackRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
 	PowerResetRack(ctx context.Context, in *PowerResetRackRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
+	PlanOperation(ctx context.Context, in *PlanOperationRequest, opts ...grpc.CallOption) (*PlanOperationResponse, error)
 	// Component CRUD
 	GetComponentInfoByID(ctx context.Context, in *GetComponentInfoByIDRequest, opts ...grpc.CallOption) (*GetComponentInfoResponse, error)
 	GetComponentInfoBySerial(ctx context.Context, in *GetComponentInfoBySerialRequest, opts ...grpc.CallOption) (*GetComponentInfoResponse, error)
@@ -145,6 +175,9 @@ type RLAClient interface {
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	GetTasksByIDs(ctx context.Context, in *GetTasksByIDsRequest, opts ...grpc.CallOption) (*GetTasksByIDsResponse, error)
 	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*CancelTaskResponse, error)
+	ApproveTaskStep(ctx context.Context, in *ApproveTaskStepRequest, opts ...grpc.CallOption) (*ApproveTaskStepResponse, error)
+	RejectTaskStep(ctx context.Context, in *RejectTaskStepRequest, opts ...grpc.CallOption) (*RejectTaskStepResponse, error)
+	GetTaskReport(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*GetTaskReportResponse, error)
 	// Operation rules
 	CreateOperationRule(ctx context.Context, in *CreateOperationRuleRequest, opts ...grpc.CallOption) (*CreateOperationRuleResponse, error)
 	UpdateOperationRule(ctx context.Context, in *UpdateOperationRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -157,6 +190,37 @@ type RLAClient interface {
 	DisassociateRuleFromRack(ctx context.Context, in *DisassociateRuleFromRackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetRackRuleAssociation(ctx context.Context, in *GetRackRuleAssociationRequest, opts ...grpc.CallOption) (*GetRackRuleAssociationResponse, error)
 	ListRackRuleAssociations(ctx context.Context, in *ListRackRuleAssociationsRequest, opts ...grpc.CallOption) (*ListRackRuleAssociationsResponse, error)
+	// Power budgets
+	SetPowerBudget(ctx context.Context, in *SetPowerBudgetRequest, opts ...grpc.CallOption) (*SetPowerBudgetResponse, error)
+	DeletePowerBudget(ctx context.Context, in *DeletePowerBudgetRequest, opts ...grpc.CallOption) (*DeletePowerBudgetResponse, error)
+	ListPowerBudgets(ctx context.Context, in *ListPowerBudgetsRequest, opts ...grpc.CallOption) (*ListPowerBudgetsResponse, error)
+	GetRackPowerStatus(ctx context.Context, in *GetRackPowerStatusRequest, opts ...grpc.CallOption) (*RackPowerStatus, error)
+	// Credential rotation
+	RotateRackCredentials(ctx context.Context, in *RotateRackCredentialsRequest, opts ...grpc.CallOption) (*RotateRackCredentialsResponse, error)
+	GetRackCredentialRotationStatus(ctx context.Context, in *GetRackCredentialRotationStatusRequest, opts ...grpc.CallOption) (*GetRackCredentialRotationStatusResponse, error)
+	// Device discovery
+	DiscoverDevices(ctx context.Context, in *DiscoverDevicesRequest, opts ...grpc.CallOption) (*DiscoverDevicesResponse, error)
+	ListDiscoveredDevices(ctx context.Context, in *ListDiscoveredDevicesRequest, opts ...grpc.CallOption) (*ListDiscoveredDevicesResponse, error)
+	ApproveDiscoveredDevice(ctx context.Context, in *ApproveDiscoveredDeviceRequest, opts ...grpc.CallOption) (*DiscoveredDevice, error)
+	RejectDiscoveredDevice(ctx context.Context, in *RejectDiscoveredDeviceRequest, opts ...grpc.CallOption) (*DiscoveredDevice, error)
+	// Campaigns
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error)
+	PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	ResumeCampaign(ctx context.Context, in *ResumeCampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	AbortCampaign(ctx context.Context, in *AbortCampaignRequest, opts ...grpc.CallOption) (*Campaign, error)
+	// Maintenance calendar
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	GetRackMaintenanceStatus(ctx context.Context, in *GetRackMaintenanceStatusRequest, opts ...grpc.CallOption) (*RackMaintenanceStatus, error)
+	ListMaintenanceOverrides(ctx context.Context, in *ListMaintenanceOverridesRequest, opts ...grpc.CallOption) (*ListMaintenanceOverridesResponse, error)
+	// Inventory drift review
+	ListDrifts(ctx context.Context, in *ListDriftsRequest, opts ...grpc.CallOption) (*ListDriftsResponse, error)
+	AcceptDrift(ctx context.Context, in *AcceptDriftRequest, opts ...grpc.CallOption) (*AcceptDriftResponse, error)
+	DismissDrift(ctx context.Context, in *DismissDriftRequest, opts ...grpc.CallOption) (*ComponentDrift, error)
+	ResyncRack(ctx context.Context, in *ResyncRackRequest, opts ...grpc.CallOption) (*ResyncRackResponse, error)
 }
 
 type rLAClient struct {
@@ -437,6 +501,16 @@ func (c *rLAClient) PowerResetRack(ctx context.Context, in *PowerResetRackReques
 	return out, nil
 }
 
+func (c *rLAClient) PlanOperation(ctx context.Context, in *PlanOperationRequest, opts ...grpc.CallOption) (*PlanOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanOperationResponse)
+	err := c.cc.Invoke(ctx, RLA_PlanOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rLAClient) GetComponentInfoByID(ctx context.Context, in *GetComponentInfoByIDRequest, opts ...grpc.CallOption) (*GetComponentInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetComponentInfoResponse)
@@ -597,6 +671,36 @@ func (c *rLAClient) CancelTask(ctx context.Context, in *CancelTaskRequest, opts
 	return out, nil
 }
 
+func (c *rLAClient) ApproveTaskStep(ctx context.Context, in *ApproveTaskStepRequest, opts ...grpc.CallOption) (*ApproveTaskStepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveTaskStepResponse)
+	err := c.cc.Invoke(ctx, RLA_ApproveTaskStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) RejectTaskStep(ctx context.Context, in *RejectTaskStepRequest, opts ...grpc.CallOption) (*RejectTaskStepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectTaskStepResponse)
+	err := c.cc.Invoke(ctx, RLA_RejectTaskStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetTaskReport(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*GetTaskReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskReportResponse)
+	err := c.cc.Invoke(ctx, RLA_GetTaskReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rLAClient) CreateOperationRule(ctx context.Context, in *CreateOperationRuleRequest, opts ...grpc.CallOption) (*CreateOperationRuleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateOperationRuleResponse)
@@ -697,6 +801,256 @@ func (c *rLAClient) ListRackRuleAssociations(ctx context.Context, in *ListRackRu
 	return out, nil
 }
 
+func (c *rLAClient) SetPowerBudget(ctx context.Context, in *SetPowerBudgetRequest, opts ...grpc.CallOption) (*SetPowerBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPowerBudgetResponse)
+	err := c.cc.Invoke(ctx, RLA_SetPowerBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) DeletePowerBudget(ctx context.Context, in *DeletePowerBudgetRequest, opts ...grpc.CallOption) (*DeletePowerBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePowerBudgetResponse)
+	err := c.cc.Invoke(ctx, RLA_DeletePowerBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListPowerBudgets(ctx context.Context, in *ListPowerBudgetsRequest, opts ...grpc.CallOption) (*ListPowerBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPowerBudgetsResponse)
+	err := c.cc.Invoke(ctx, RLA_ListPowerBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetRackPowerStatus(ctx context.Context, in *GetRackPowerStatusRequest, opts ...grpc.CallOption) (*RackPowerStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RackPowerStatus)
+	err := c.cc.Invoke(ctx, RLA_GetRackPowerStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) RotateRackCredentials(ctx context.Context, in *RotateRackCredentialsRequest, opts ...grpc.CallOption) (*RotateRackCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateRackCredentialsResponse)
+	err := c.cc.Invoke(ctx, RLA_RotateRackCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetRackCredentialRotationStatus(ctx context.Context, in *GetRackCredentialRotationStatusRequest, opts ...grpc.CallOption) (*GetRackCredentialRotationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRackCredentialRotationStatusResponse)
+	err := c.cc.Invoke(ctx, RLA_GetRackCredentialRotationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) DiscoverDevices(ctx context.Context, in *DiscoverDevicesRequest, opts ...grpc.CallOption) (*DiscoverDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverDevicesResponse)
+	err := c.cc.Invoke(ctx, RLA_DiscoverDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListDiscoveredDevices(ctx context.Context, in *ListDiscoveredDevicesRequest, opts ...grpc.CallOption) (*ListDiscoveredDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiscoveredDevicesResponse)
+	err := c.cc.Invoke(ctx, RLA_ListDiscoveredDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ApproveDiscoveredDevice(ctx context.Context, in *ApproveDiscoveredDeviceRequest, opts ...grpc.CallOption) (*DiscoveredDevice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoveredDevice)
+	err := c.cc.Invoke(ctx, RLA_ApproveDiscoveredDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) RejectDiscoveredDevice(ctx context.Context, in *RejectDiscoveredDeviceRequest, opts ...grpc.CallOption) (*DiscoveredDevice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoveredDevice)
+	err := c.cc.Invoke(ctx, RLA_RejectDiscoveredDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, RLA_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, RLA_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsResponse)
+	err := c.cc.Invoke(ctx, RLA_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, RLA_PauseCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ResumeCampaign(ctx context.Context, in *ResumeCampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, RLA_ResumeCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) AbortCampaign(ctx context.Context, in *AbortCampaignRequest, opts ...grpc.CallOption) (*Campaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Campaign)
+	err := c.cc.Invoke(ctx, RLA_AbortCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, RLA_CreateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RLA_DeleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, RLA_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetRackMaintenanceStatus(ctx context.Context, in *GetRackMaintenanceStatusRequest, opts ...grpc.CallOption) (*RackMaintenanceStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RackMaintenanceStatus)
+	err := c.cc.Invoke(ctx, RLA_GetRackMaintenanceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListMaintenanceOverrides(ctx context.Context, in *ListMaintenanceOverridesRequest, opts ...grpc.CallOption) (*ListMaintenanceOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceOverridesResponse)
+	err := c.cc.Invoke(ctx, RLA_ListMaintenanceOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListDrifts(ctx context.Context, in *ListDriftsRequest, opts ...grpc.CallOption) (*ListDriftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriftsResponse)
+	err := c.cc.Invoke(ctx, RLA_ListDrifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) AcceptDrift(ctx context.Context, in *AcceptDriftRequest, opts ...grpc.CallOption) (*AcceptDriftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptDriftResponse)
+	err := c.cc.Invoke(ctx, RLA_AcceptDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) DismissDrift(ctx context.Context, in *DismissDriftRequest, opts ...grpc.CallOption) (*ComponentDrift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComponentDrift)
+	err := c.cc.Invoke(ctx, RLA_DismissDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ResyncRack(ctx context.Context, in *ResyncRackRequest, opts ...grpc.CallOption) (*ResyncRackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResyncRackResponse)
+	err := c.cc.Invoke(ctx, RLA_ResyncRack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RLAServer is the server API for RLA service.
 // All implementations must embed UnimplementedRLAServer
 // for forward compatibility.
@@ -732,6 +1086,7 @@ type RLAServer interface {
 	PowerOnRack(context.Context, *PowerOnRackRequest) (*SubmitTaskResponse, error)
 	PowerOffRack(context.Context, *PowerOffRackRequest) (*SubmitTaskResponse, error)
 	PowerResetRack(context.Context, *PowerResetRackRequest) (*SubmitTaskResponse, error)
+	PlanOperation(context.Context, *PlanOperationRequest) (*PlanOperationResponse, error)
 	// Component CRUD
 	GetComponentInfoByID(context.Context, *GetComponentInfoByIDRequest) (*GetComponentInfoResponse, error)
 	GetComponentInfoBySerial(context.Context, *GetComponentInfoBySerialRequest) (*GetComponentInfoResponse, error)
@@ -751,6 +1106,9 @@ type RLAServer interface {
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	GetTasksByIDs(context.Context, *GetTasksByIDsRequest) (*GetTasksByIDsResponse, error)
 	CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error)
+	ApproveTaskStep(context.Context, *ApproveTaskStepRequest) (*ApproveTaskStepResponse, error)
+	RejectTaskStep(context.Context, *RejectTaskStepRequest) (*RejectTaskStepResponse, error)
+	GetTaskReport(context.Context, *GetTaskReportRequest) (*GetTaskReportResponse, error)
 	// Operation rules
 	CreateOperationRule(context.Context, *CreateOperationRuleRequest) (*CreateOperationRuleResponse, error)
 	UpdateOperationRule(context.Context, *UpdateOperationRuleRequest) (*emptypb.Empty, error)
@@ -763,6 +1121,37 @@ type RLAServer interface {
 	DisassociateRuleFromRack(context.Context, *DisassociateRuleFromRackRequest) (*emptypb.Empty, error)
 	GetRackRuleAssociation(context.Context, *GetRackRuleAssociationRequest) (*GetRackRuleAssociationResponse, error)
 	ListRackRuleAssociations(context.Context, *ListRackRuleAssociationsRequest) (*ListRackRuleAssociationsResponse, error)
+	// Power budgets
+	SetPowerBudget(context.Context, *SetPowerBudgetRequest) (*SetPowerBudgetResponse, error)
+	DeletePowerBudget(context.Context, *DeletePowerBudgetRequest) (*DeletePowerBudgetResponse, error)
+	ListPowerBudgets(context.Context, *ListPowerBudgetsRequest) (*ListPowerBudgetsResponse, error)
+	GetRackPowerStatus(context.Context, *GetRackPowerStatusRequest) (*RackPowerStatus, error)
+	// Credential rotation
+	RotateRackCredentials(context.Context, *RotateRackCredentialsRequest) (*RotateRackCredentialsResponse, error)
+	GetRackCredentialRotationStatus(context.Context, *GetRackCredentialRotationStatusRequest) (*GetRackCredentialRotationStatusResponse, error)
+	// Device discovery
+	DiscoverDevices(context.Context, *DiscoverDevicesRequest) (*DiscoverDevicesResponse, error)
+	ListDiscoveredDevices(context.Context, *ListDiscoveredDevicesRequest) (*ListDiscoveredDevicesResponse, error)
+	ApproveDiscoveredDevice(context.Context, *ApproveDiscoveredDeviceRequest) (*DiscoveredDevice, error)
+	RejectDiscoveredDevice(context.Context, *RejectDiscoveredDeviceRequest) (*DiscoveredDevice, error)
+	// Campaigns
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*Campaign, error)
+	GetCampaign(context.Context, *GetCampaignRequest) (*Campaign, error)
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error)
+	PauseCampaign(context.Context, *PauseCampaignRequest) (*Campaign, error)
+	ResumeCampaign(context.Context, *ResumeCampaignRequest) (*Campaign, error)
+	AbortCampaign(context.Context, *AbortCampaignRequest) (*Campaign, error)
+	// Maintenance calendar
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*emptypb.Empty, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	GetRackMaintenanceStatus(context.Context, *GetRackMaintenanceStatusRequest) (*RackMaintenanceStatus, error)
+	ListMaintenanceOverrides(context.Context, *ListMaintenanceOverridesRequest) (*ListMaintenanceOverridesResponse, error)
+	// Inventory drift review
+	ListDrifts(context.Context, *ListDriftsRequest) (*ListDriftsResponse, error)
+	AcceptDrift(context.Context, *AcceptDriftRequest) (*AcceptDriftResponse, error)
+	DismissDrift(context.Context, *DismissDriftRequest) (*ComponentDrift, error)
+	ResyncRack(context.Context, *ResyncRackRequest) (*ResyncRackResponse, error)
 	mustEmbedUnimplementedRLAServer()
 }
 
@@ -774,163 +1163,250 @@ type RLAServer interface {
 type UnimplementedRLAServer struct{}
 
 func (UnimplementedRLAServer) Version(context.Context, *VersionRequest) (*BuildInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+	return nil, status.Error(codes.Unimplemented, "method Version not implemented")
 }
 func (UnimplementedRLAServer) CreateTaskSchedule(context.Context, *CreateTaskScheduleRequest) (*TaskSchedule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CreateTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) GetTaskSchedule(context.Context, *GetTaskScheduleRequest) (*TaskSchedule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) ListTaskSchedules(context.Context, *ListTaskSchedulesRequest) (*ListTaskSchedulesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTaskSchedules not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ListTaskSchedules not implemented")
 }
 func (UnimplementedRLAServer) UpdateTaskSchedule(context.Context, *UpdateTaskScheduleRequest) (*TaskSchedule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method UpdateTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) PauseTaskSchedule(context.Context, *PauseTaskScheduleRequest) (*TaskSchedule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PauseTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PauseTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) ResumeTaskSchedule(context.Context, *ResumeTaskScheduleRequest) (*TaskSchedule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResumeTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ResumeTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) DeleteTaskSchedule(context.Context, *DeleteTaskScheduleRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DeleteTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) TriggerTaskSchedule(context.Context, *TriggerTaskScheduleRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerTaskSchedule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method TriggerTaskSchedule not implemented")
 }
 func (UnimplementedRLAServer) AddTaskScheduleScope(context.Context, *AddTaskScheduleScopeRequest) (*AddTaskScheduleScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTaskScheduleScope not implemented")
+	return nil, status.Error(codes.Unimplemented, "method AddTaskScheduleScope not implemented")
 }
 func (UnimplementedRLAServer) RemoveTaskScheduleScope(context.Context, *RemoveTaskScheduleScopeRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveTaskScheduleScope not implemented")
+	return nil, status.Error(codes.Unimplemented, "method RemoveTaskScheduleScope not implemented")
 }
 func (UnimplementedRLAServer) UpdateTaskScheduleScope(context.Context, *UpdateTaskScheduleScopeRequest) (*UpdateTaskScheduleScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskScheduleScope not implemented")
+	return nil, status.Error(codes.Unimplemented, "method UpdateTaskScheduleScope not implemented")
 }
 func (UnimplementedRLAServer) ListTaskScheduleScopes(context.Context, *ListTaskScheduleScopesRequest) (*ListTaskScheduleScopesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTaskScheduleScopes not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ListTaskScheduleScopes not implemented")
 }
 func (UnimplementedRLAServer) CheckScheduleConflicts(context.Context, *CheckScheduleConflictsRequest) (*CheckScheduleConflictsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckScheduleConflicts not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CheckScheduleConflicts not implemented")
 }
 func (UnimplementedRLAServer) CreateExpectedRack(context.Context, *CreateExpectedRackRequest) (*CreateExpectedRackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateExpectedRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CreateExpectedRack not implemented")
 }
 func (UnimplementedRLAServer) GetRackInfoByID(context.Context, *GetRackInfoByIDRequest) (*GetRackInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRackInfoByID not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetRackInfoByID not implemented")
 }
 func (UnimplementedRLAServer) GetRackInfoBySerial(context.Context, *GetRackInfoBySerialRequest) (*GetRackInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRackInfoBySerial not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetRackInfoBySerial not implemented")
 }
 func (UnimplementedRLAServer) GetListOfRacks(context.Context, *GetListOfRacksRequest) (*GetListOfRacksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListOfRacks not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetListOfRacks not implemented")
 }
 func (UnimplementedRLAServer) PatchRack(context.Context, *PatchRackRequest) (*PatchRackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PatchRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PatchRack not implemented")
 }
 func (UnimplementedRLAServer) DeleteRack(context.Context, *DeleteRackRequest) (*DeleteRackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DeleteRack not implemented")
 }
 func (UnimplementedRLAServer) PurgeRack(context.Context, *PurgeRackRequest) (*PurgeRackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PurgeRack not implemented")
 }
 func (UnimplementedRLAServer) UpgradeFirmware(context.Context, *UpgradeFirmwareRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpgradeFirmware not implemented")
+	return nil, status.Error(codes.Unimplemented, "method UpgradeFirmware not implemented")
 }
 func (UnimplementedRLAServer) BringUpRack(context.Context, *BringUpRackRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BringUpRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method BringUpRack not implemented")
 }
 func (UnimplementedRLAServer) IngestRack(context.Context, *IngestRackRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IngestRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method IngestRack not implemented")
 }
 func (UnimplementedRLAServer) PowerOnRack(context.Context, *PowerOnRackRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PowerOnRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PowerOnRack not implemented")
 }
 func (UnimplementedRLAServer) PowerOffRack(context.Context, *PowerOffRackRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PowerOffRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PowerOffRack not implemented")
 }
 func (UnimplementedRLAServer) PowerResetRack(context.Context, *PowerResetRackRequest) (*SubmitTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PowerResetRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PowerResetRack not implemented")
+}
+func (UnimplementedRLAServer) PlanOperation(context.Context, *PlanOperationRequest) (*PlanOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanOperation not implemented")
 }
 func (UnimplementedRLAServer) GetComponentInfoByID(context.Context, *GetComponentInfoByIDRequest) (*GetComponentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentInfoByID not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetComponentInfoByID not implemented")
 }
 func (UnimplementedRLAServer) GetComponentInfoBySerial(context.Context, *GetComponentInfoBySerialRequest) (*GetComponentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentInfoBySerial not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetComponentInfoBySerial not implemented")
 }
 func (UnimplementedRLAServer) GetComponents(context.Context, *GetComponentsRequest) (*GetComponentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponents not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetComponents not implemented")
 }
 func (UnimplementedRLAServer) ValidateComponents(context.Context, *ValidateComponentsRequest) (*ValidateComponentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateComponents not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ValidateComponents not implemented")
 }
 func (UnimplementedRLAServer) AddComponent(context.Context, *AddComponentRequest) (*AddComponentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddComponent not implemented")
+	return nil, status.Error(codes.Unimplemented, "method AddComponent not implemented")
 }
 func (UnimplementedRLAServer) PatchComponent(context.Context, *PatchComponentRequest) (*PatchComponentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PatchComponent not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PatchComponent not implemented")
 }
 func (UnimplementedRLAServer) DeleteComponent(context.Context, *DeleteComponentRequest) (*DeleteComponentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteComponent not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DeleteComponent not implemented")
 }
 func (UnimplementedRLAServer) PurgeComponent(context.Context, *PurgeComponentRequest) (*PurgeComponentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeComponent not implemented")
+	return nil, status.Error(codes.Unimplemented, "method PurgeComponent not implemented")
 }
 func (UnimplementedRLAServer) CreateNVLDomain(context.Context, *CreateNVLDomainRequest) (*CreateNVLDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNVLDomain not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CreateNVLDomain not implemented")
 }
 func (UnimplementedRLAServer) AttachRacksToNVLDomain(context.Context, *AttachRacksToNVLDomainRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttachRacksToNVLDomain not implemented")
+	return nil, status.Error(codes.Unimplemented, "method AttachRacksToNVLDomain not implemented")
 }
 func (UnimplementedRLAServer) DetachRacksFromNVLDomain(context.Context, *DetachRacksFromNVLDomainRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DetachRacksFromNVLDomain not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DetachRacksFromNVLDomain not implemented")
 }
 func (UnimplementedRLAServer) GetListOfNVLDomains(context.Context, *GetListOfNVLDomainsRequest) (*GetListOfNVLDomainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListOfNVLDomains not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetListOfNVLDomains not implemented")
 }
 func (UnimplementedRLAServer) GetRacksForNVLDomain(context.Context, *GetRacksForNVLDomainRequest) (*GetRacksForNVLDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRacksForNVLDomain not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetRacksForNVLDomain not implemented")
 }
 func (UnimplementedRLAServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
 }
 func (UnimplementedRLAServer) GetTasksByIDs(context.Context, *GetTasksByIDsRequest) (*GetTasksByIDsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTasksByIDs not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetTasksByIDs not implemented")
 }
 func (UnimplementedRLAServer) CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (UnimplementedRLAServer) ApproveTaskStep(context.Context, *ApproveTaskStepRequest) (*ApproveTaskStepResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveTaskStep not implemented")
+}
+func (UnimplementedRLAServer) RejectTaskStep(context.Context, *RejectTaskStepRequest) (*RejectTaskStepResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectTaskStep not implemented")
+}
+func (UnimplementedRLAServer) GetTaskReport(context.Context, *GetTaskReportRequest) (*GetTaskReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaskReport not implemented")
 }
 func (UnimplementedRLAServer) CreateOperationRule(context.Context, *CreateOperationRuleRequest) (*CreateOperationRuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOperationRule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CreateOperationRule not implemented")
 }
 func (UnimplementedRLAServer) UpdateOperationRule(context.Context, *UpdateOperationRuleRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperationRule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method UpdateOperationRule not implemented")
 }
 func (UnimplementedRLAServer) DeleteOperationRule(context.Context, *DeleteOperationRuleRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOperationRule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DeleteOperationRule not implemented")
 }
 func (UnimplementedRLAServer) GetOperationRule(context.Context, *GetOperationRuleRequest) (*OperationRule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOperationRule not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetOperationRule not implemented")
 }
 func (UnimplementedRLAServer) ListOperationRules(context.Context, *ListOperationRulesRequest) (*ListOperationRulesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOperationRules not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ListOperationRules not implemented")
 }
 func (UnimplementedRLAServer) SetRuleAsDefault(context.Context, *SetRuleAsDefaultRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRuleAsDefault not implemented")
+	return nil, status.Error(codes.Unimplemented, "method SetRuleAsDefault not implemented")
 }
 func (UnimplementedRLAServer) AssociateRuleWithRack(context.Context, *AssociateRuleWithRackRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssociateRuleWithRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method AssociateRuleWithRack not implemented")
 }
 func (UnimplementedRLAServer) DisassociateRuleFromRack(context.Context, *DisassociateRuleFromRackRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisassociateRuleFromRack not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DisassociateRuleFromRack not implemented")
 }
 func (UnimplementedRLAServer) GetRackRuleAssociation(context.Context, *GetRackRuleAssociationRequest) (*GetRackRuleAssociationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRackRuleAssociation not implemented")
+	return nil, status.Error(codes.Unimplemented, "method GetRackRuleAssociation not implemented")
 }
 func (UnimplementedRLAServer) ListRackRuleAssociations(context.Context, *ListRackRuleAssociationsRequest) (*ListRackRuleAssociationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRackRuleAssociations not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ListRackRuleAssociations not implemented")
+}
+func (UnimplementedRLAServer) SetPowerBudget(context.Context, *SetPowerBudgetRequest) (*SetPowerBudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPowerBudget not implemented")
+}
+func (UnimplementedRLAServer) DeletePowerBudget(context.Context, *DeletePowerBudgetRequest) (*DeletePowerBudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePowerBudget not implemented")
+}
+func (UnimplementedRLAServer) ListPowerBudgets(context.Context, *ListPowerBudgetsRequest) (*ListPowerBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPowerBudgets not implemented")
+}
+func (UnimplementedRLAServer) GetRackPowerStatus(context.Context, *GetRackPowerStatusRequest) (*RackPowerStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRackPowerStatus not implemented")
+}
+func (UnimplementedRLAServer) RotateRackCredentials(context.Context, *RotateRackCredentialsRequest) (*RotateRackCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateRackCredentials not implemented")
+}
+func (UnimplementedRLAServer) GetRackCredentialRotationStatus(context.Context, *GetRackCredentialRotationStatusRequest) (*GetRackCredentialRotationStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRackCredentialRotationStatus not implemented")
+}
+func (UnimplementedRLAServer) DiscoverDevices(context.Context, *DiscoverDevicesRequest) (*DiscoverDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscoverDevices not implemented")
+}
+func (UnimplementedRLAServer) ListDiscoveredDevices(context.Context, *ListDiscoveredDevicesRequest) (*ListDiscoveredDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDiscoveredDevices not implemented")
+}
+func (UnimplementedRLAServer) ApproveDiscoveredDevice(context.Context, *ApproveDiscoveredDeviceRequest) (*DiscoveredDevice, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveDiscoveredDevice not implemented")
+}
+func (UnimplementedRLAServer) RejectDiscoveredDevice(context.Context, *RejectDiscoveredDeviceRequest) (*DiscoveredDevice, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectDiscoveredDevice not implemented")
+}
+func (UnimplementedRLAServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*Campaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedRLAServer) GetCampaign(context.Context, *GetCampaignRequest) (*Campaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedRLAServer) ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedRLAServer) PauseCampaign(context.Context, *PauseCampaignRequest) (*Campaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseCampaign not implemented")
+}
+func (UnimplementedRLAServer) ResumeCampaign(context.Context, *ResumeCampaignRequest) (*Campaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeCampaign not implemented")
+}
+func (UnimplementedRLAServer) AbortCampaign(context.Context, *AbortCampaignRequest) (*Campaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortCampaign not implemented")
+}
+func (UnimplementedRLAServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedRLAServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedRLAServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedRLAServer) GetRackMaintenanceStatus(context.Context, *GetRackMaintenanceStatusRequest) (*RackMaintenanceStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRackMaintenanceStatus not implemented")
+}
+func (UnimplementedRLAServer) ListMaintenanceOverrides(context.Context, *ListMaintenanceOverridesRequest) (*ListMaintenanceOverridesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMaintenanceOverrides not implemented")
+}
+func (UnimplementedRLAServer) ListDrifts(context.Context, *ListDriftsRequest) (*ListDriftsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDrifts not implemented")
+}
+func (UnimplementedRLAServer) AcceptDrift(context.Context, *AcceptDriftRequest) (*AcceptDriftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptDrift not implemented")
+}
+func (UnimplementedRLAServer) DismissDrift(context.Context, *DismissDriftRequest) (*ComponentDrift, error) {
+	return nil, status.Error(codes.Unimplemented, "method DismissDrift not implemented")
+}
+func (UnimplementedRLAServer) ResyncRack(context.Context, *ResyncRackRequest) (*ResyncRackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResyncRack not implemented")
 }
 func (UnimplementedRLAServer) mustEmbedUnimplementedRLAServer() {}
 func (UnimplementedRLAServer) testEmbeddedByValue()             {}
@@ -943,7 +1419,7 @@ type UnsafeRLAServer interface {
 }
 
 func RegisterRLAServer(s grpc.ServiceRegistrar, srv RLAServer) {
-	// If the following call pancis, it indicates UnimplementedRLAServer was
+	// If the following call panics, it indicates UnimplementedRLAServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -1439,6 +1915,24 @@ func _RLA_PowerResetRack_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RLA_PlanOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).PlanOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_PlanOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).PlanOperation(ctx, req.(*PlanOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RLA_GetComponentInfoByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetComponentInfoByIDRequest)
 	if err := dec(in); err != nil {
@@ -1727,38 +2221,92 @@ func _RLA_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RLA_CreateOperationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOperationRuleRequest)
+func _RLA_ApproveTaskStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTaskStepRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RLAServer).CreateOperationRule(ctx, in)
+		return srv.(RLAServer).ApproveTaskStep(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RLA_CreateOperationRule_FullMethodName,
+		FullMethod: RLA_ApproveTaskStep_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RLAServer).CreateOperationRule(ctx, req.(*CreateOperationRuleRequest))
+		return srv.(RLAServer).ApproveTaskStep(ctx, req.(*ApproveTaskStepRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RLA_UpdateOperationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOperationRuleRequest)
+func _RLA_RejectTaskStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTaskStepRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RLAServer).UpdateOperationRule(ctx, in)
+		return srv.(RLAServer).RejectTaskStep(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RLA_UpdateOperationRule_FullMethodName,
+		FullMethod: RLA_RejectTaskStep_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RLAServer).UpdateOperationRule(ctx, req.(*UpdateOperationRuleRequest))
+		return srv.(RLAServer).RejectTaskStep(ctx, req.(*RejectTaskStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetTaskReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetTaskReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetTaskReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetTaskReport(ctx, req.(*GetTaskReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_CreateOperationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOperationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).CreateOperationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_CreateOperationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).CreateOperationRule(ctx, req.(*CreateOperationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_UpdateOperationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOperationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).UpdateOperationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_UpdateOperationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).UpdateOperationRule(ctx, req.(*UpdateOperationRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1907,6 +2455,456 @@ func _RLA_ListRackRuleAssociations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RLA_SetPowerBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPowerBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).SetPowerBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_SetPowerBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).SetPowerBudget(ctx, req.(*SetPowerBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_DeletePowerBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePowerBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).DeletePowerBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_DeletePowerBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).DeletePowerBudget(ctx, req.(*DeletePowerBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListPowerBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPowerBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListPowerBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListPowerBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListPowerBudgets(ctx, req.(*ListPowerBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetRackPowerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRackPowerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetRackPowerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetRackPowerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetRackPowerStatus(ctx, req.(*GetRackPowerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_RotateRackCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRackCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).RotateRackCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_RotateRackCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).RotateRackCredentials(ctx, req.(*RotateRackCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetRackCredentialRotationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRackCredentialRotationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetRackCredentialRotationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetRackCredentialRotationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetRackCredentialRotationStatus(ctx, req.(*GetRackCredentialRotationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_DiscoverDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).DiscoverDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_DiscoverDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).DiscoverDevices(ctx, req.(*DiscoverDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListDiscoveredDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiscoveredDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListDiscoveredDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListDiscoveredDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListDiscoveredDevices(ctx, req.(*ListDiscoveredDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ApproveDiscoveredDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDiscoveredDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ApproveDiscoveredDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ApproveDiscoveredDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ApproveDiscoveredDevice(ctx, req.(*ApproveDiscoveredDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_RejectDiscoveredDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectDiscoveredDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).RejectDiscoveredDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_RejectDiscoveredDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).RejectDiscoveredDevice(ctx, req.(*RejectDiscoveredDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetCampaign(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListCampaigns(ctx, req.(*ListCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_PauseCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).PauseCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_PauseCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).PauseCampaign(ctx, req.(*PauseCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ResumeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ResumeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ResumeCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ResumeCampaign(ctx, req.(*ResumeCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_AbortCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).AbortCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_AbortCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).AbortCampaign(ctx, req.(*AbortCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetRackMaintenanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRackMaintenanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetRackMaintenanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetRackMaintenanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetRackMaintenanceStatus(ctx, req.(*GetRackMaintenanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListMaintenanceOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListMaintenanceOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListMaintenanceOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListMaintenanceOverrides(ctx, req.(*ListMaintenanceOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListDrifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListDrifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListDrifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListDrifts(ctx, req.(*ListDriftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_AcceptDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).AcceptDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_AcceptDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).AcceptDrift(ctx, req.(*AcceptDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_DismissDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).DismissDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_DismissDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).DismissDrift(ctx, req.(*DismissDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ResyncRack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncRackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ResyncRack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ResyncRack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ResyncRack(ctx, req.(*ResyncRackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RLA_ServiceDesc is the grpc.ServiceDesc for RLA service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2022,6 +3020,10 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PowerResetRack",
 			Handler:    _RLA_PowerResetRack_Handler,
 		},
+		{
+			MethodName: "PlanOperation",
+			Handler:    _RLA_PlanOperation_Handler,
+		},
 		{
 			MethodName: "GetComponentInfoByID",
 			Handler:    _RLA_GetComponentInfoByID_Handler,
@@ -2086,6 +3088,18 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTask",
 			Handler:    _RLA_CancelTask_Handler,
 		},
+		{
+			MethodName: "ApproveTaskStep",
+			Handler:    _RLA_ApproveTaskStep_Handler,
+		},
+		{
+			MethodName: "RejectTaskStep",
+			Handler:    _RLA_RejectTaskStep_Handler,
+		},
+		{
+			MethodName: "GetTaskReport",
+			Handler:    _RLA_GetTaskReport_Handler,
+		},
 		{
 			MethodName: "CreateOperationRule",
 			Handler:    _RLA_CreateOperationRule_Handler,
@@ -2126,6 +3140,106 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRackRuleAssociations",
 			Handler:    _RLA_ListRackRuleAssociations_Handler,
 		},
+		{
+			MethodName: "SetPowerBudget",
+			Handler:    _RLA_SetPowerBudget_Handler,
+		},
+		{
+			MethodName: "DeletePowerBudget",
+			Handler:    _RLA_DeletePowerBudget_Handler,
+		},
+		{
+			MethodName: "ListPowerBudgets",
+			Handler:    _RLA_ListPowerBudgets_Handler,
+		},
+		{
+			MethodName: "GetRackPowerStatus",
+			Handler:    _RLA_GetRackPowerStatus_Handler,
+		},
+		{
+			MethodName: "RotateRackCredentials",
+			Handler:    _RLA_RotateRackCredentials_Handler,
+		},
+		{
+			MethodName: "GetRackCredentialRotationStatus",
+			Handler:    _RLA_GetRackCredentialRotationStatus_Handler,
+		},
+		{
+			MethodName: "DiscoverDevices",
+			Handler:    _RLA_DiscoverDevices_Handler,
+		},
+		{
+			MethodName: "ListDiscoveredDevices",
+			Handler:    _RLA_ListDiscoveredDevices_Handler,
+		},
+		{
+			MethodName: "ApproveDiscoveredDevice",
+			Handler:    _RLA_ApproveDiscoveredDevice_Handler,
+		},
+		{
+			MethodName: "RejectDiscoveredDevice",
+			Handler:    _RLA_RejectDiscoveredDevice_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _RLA_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _RLA_GetCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _RLA_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "PauseCampaign",
+			Handler:    _RLA_PauseCampaign_Handler,
+		},
+		{
+			MethodName: "ResumeCampaign",
+			Handler:    _RLA_ResumeCampaign_Handler,
+		},
+		{
+			MethodName: "AbortCampaign",
+			Handler:    _RLA_AbortCampaign_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _RLA_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _RLA_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _RLA_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "GetRackMaintenanceStatus",
+			Handler:    _RLA_GetRackMaintenanceStatus_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceOverrides",
+			Handler:    _RLA_ListMaintenanceOverrides_Handler,
+		},
+		{
+			MethodName: "ListDrifts",
+			Handler:    _RLA_ListDrifts_Handler,
+		},
+		{
+			MethodName: "AcceptDrift",
+			Handler:    _RLA_AcceptDrift_Handler,
+		},
+		{
+			MethodName: "DismissDrift",
+			Handler:    _RLA_DismissDrift_Handler,
+		},
+		{
+			MethodName: "ResyncRack",
+			Handler:    _RLA_ResyncRack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rla.proto",
@@ -86,6 +86,25 @@ const (
 	DiffTypeDrift      DiffType = "Drift"
 )
 
+// DriftType represents the type of drift found by the inventory sync.
+type DriftType string
+
+const (
+	DriftTypeUnknown           DriftType = "Unknown"
+	DriftTypeMissingInExpected DriftType = "MissingInExpected"
+	DriftTypeMissingInActual   DriftType = "MissingInActual"
+	DriftTypeMismatch          DriftType = "Mismatch"
+)
+
+// DriftStatus represents the review state of a drift.
+type DriftStatus string
+
+const (
+	DriftStatusUnknown   DriftStatus = "Unknown"
+	DriftStatusOpen      DriftStatus = "Open"
+	DriftStatusDismissed DriftStatus = "Dismissed"
+)
+
 // OperationType represents the type of operation (power control, firmware, etc.).
 type OperationType string
 
@@ -94,3 +113,43 @@ const (
 	OperationTypePowerControl    OperationType = "POWER_CONTROL"
 	OperationTypeFirmwareControl OperationType = "FIRMWARE_CONTROL"
 )
+
+// RuleSource represents where the rule resolver found an operation rule.
+type RuleSource string
+
+const (
+	RuleSourceUnknown         RuleSource = "UNKNOWN"
+	RuleSourceExplicit        RuleSource = "EXPLICIT"
+	RuleSourceRackAssociation RuleSource = "RACK_ASSOCIATION"
+	RuleSourceDefault         RuleSource = "DEFAULT"
+	RuleSourceHardcoded       RuleSource = "HARDCODED"
+)
+
+// PlanOutcome represents what submitting a planned operation would do.
+type PlanOutcome string
+
+const (
+	PlanOutcomeUnknown PlanOutcome = "UNKNOWN"
+	PlanOutcomeRun     PlanOutcome = "RUN"
+	PlanOutcomeQueue   PlanOutcome = "QUEUE"
+	PlanOutcomeReject  PlanOutcome = "REJECT"
+)
+
+// TaskReportOutcome represents the outcome of a task report entry.
+type TaskReportOutcome string
+
+const (
+	TaskReportOutcomeUnknown    TaskReportOutcome = "UNKNOWN"
+	TaskReportOutcomeSucceeded  TaskReportOutcome = "SUCCEEDED"
+	TaskReportOutcomeFailed     TaskReportOutcome = "FAILED"
+	TaskReportOutcomeTerminated TaskReportOutcome = "TERMINATED"
+)
+
+// TaskReportFormat represents a format the server renders a task report in.
+type TaskReportFormat string
+
+const (
+	TaskReportFormatNone     TaskReportFormat = ""
+	TaskReportFormatJSON     TaskReportFormat = "JSON"
+	TaskReportFormatMarkdown TaskReportFormat = "MARKDOWN"
+)
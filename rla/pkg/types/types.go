@@ -130,6 +130,32 @@ type FieldDiff struct {
 	ActualValue   string
 }
 
+// ComponentDrift represents a difference found by the inventory sync between
+// a component in the inventory and the component manager service.
+type ComponentDrift struct {
+	ID            uuid.UUID
+	ComponentID   uuid.UUID // uuid.Nil for DriftTypeMissingInExpected
+	ExternalID    string    // ID in the component manager service, if known
+	RackID        uuid.UUID // uuid.Nil for DriftTypeMissingInExpected
+	ComponentType ComponentType
+	Type          DriftType
+	FieldDiffs    []FieldDiff
+	Status        DriftStatus
+	DismissReason string
+	DismissedBy   string
+	DismissedAt   *time.Time
+	CheckedAt     time.Time
+}
+
+// DriftFilter selects the drifts returned by ListDrifts. Empty fields match
+// everything.
+type DriftFilter struct {
+	RackID           uuid.UUID
+	Types            []DriftType
+	ComponentTypes   []ComponentType
+	IncludeDismissed bool
+}
+
 // OperationRule represents a configurable rule for executing operations.
 type OperationRule struct {
 	ID                 uuid.UUID
@@ -152,3 +178,54 @@ type RackRuleAssociation struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// OperationPlan describes how an operation would execute on one rack.
+type OperationPlan struct {
+	RackID            uuid.UUID
+	RackName          string
+	Operation         string
+	Rule              *OperationRule
+	RuleSource        RuleSource
+	Stages            []PlannedStage // in execution order
+	EstimatedDuration time.Duration
+	ConflictingTasks  []*Task
+	Outcome           PlanOutcome
+	Reason            string
+	Warnings          []string
+}
+
+// PlannedStage is one stage of an OperationPlan; its steps run in parallel.
+type PlannedStage struct {
+	Number            int
+	Steps             []PlannedStep
+	EstimatedDuration time.Duration
+}
+
+// PlannedStep is one rule step; its batches of components run one after another.
+type PlannedStep struct {
+	ComponentType     ComponentType
+	MaxParallel       int
+	Batches           [][]uuid.UUID
+	Actions           []string
+	EstimatedDuration time.Duration
+}
+
+// TaskReport is the report recorded while a task ran.
+type TaskReport struct {
+	Task    *Task
+	Entries []TaskReportEntry // in the order they were recorded
+	Content string            // rendered report, set when a format was requested
+}
+
+// TaskReportEntry is one line of a task report. Entries about the task as a
+// whole have no component.
+type TaskReportEntry struct {
+	Time          time.Time
+	ComponentType ComponentType
+	ComponentID   string
+	Stage         int
+	Step          string
+	Action        string
+	Outcome       TaskReportOutcome
+	Message       string
+}
@@ -0,0 +1,41 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package floatingip
+
+import (
+	Manager "github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/managerapi"
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/datatypes/elektratypes"
+)
+
+// ManagerAccess - access to all managers
+var ManagerAccess *Manager.ManagerAccess
+
+// API - all API interface
+type API struct{}
+
+// NewFloatingIPManager - returns a new instance of Floating IP manager
+func NewFloatingIPManager(superForge *elektratypes.Elektra, superAPI *Manager.ManagerAPI, superConf *Manager.ManagerConf) *API {
+	ManagerAccess = &Manager.ManagerAccess{
+		Data: &Manager.ManagerData{
+			EB: superForge,
+		},
+		API:  superAPI,
+		Conf: superConf,
+	}
+	return &API{}
+}
@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package floatingip
+
+import (
+	swa "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/activity"
+	sww "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/workflow"
+)
+
+// RegisterSubscriber registers FloatingIP workflows and activities with Temporal
+func (api *API) RegisterSubscriber() error {
+	ManagerAccess.Data.EB.Log.Info().Msg("FloatingIP: Registering workflows and activities")
+
+	// Register workflows
+
+	// Register AssociateFloatingIP workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.AssociateFloatingIP)
+	ManagerAccess.Data.EB.Log.Info().Msg("FloatingIP: Successfully registered AssociateFloatingIP workflow")
+
+	// Register DisassociateFloatingIP workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.DisassociateFloatingIP)
+	ManagerAccess.Data.EB.Log.Info().Msg("FloatingIP: Successfully registered DisassociateFloatingIP workflow")
+
+	// Register activities
+	floatingIPManager := swa.NewManageFloatingIP(ManagerAccess.Data.EB.Managers.Carbide.Client)
+
+	// Register AssignFloatingIPOnSite
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(floatingIPManager.AssignFloatingIPOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("FloatingIP: Successfully registered AssignFloatingIPOnSite activity")
+
+	// Register RemoveFloatingIPOnSite
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(floatingIPManager.RemoveFloatingIPOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("FloatingIP: Successfully registered RemoveFloatingIPOnSite activity")
+
+	return nil
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/expectedmachine"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/expectedpowershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/expectedswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/floatingip"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/infinibandpartition"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/instance"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/instancetype"
@@ -43,6 +44,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/nvlinklogicalpartition"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/operatingsystem"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/rla"
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/routeserver"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/sku"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/sshkeygroup"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/subnet"
@@ -84,6 +86,8 @@ func NewAPIHandlers() {
 		DpuExtensionService:    &dpuextensionservice.API{},
 		NVLinkLogicalPartition: &nvlinklogicalpartition.API{},
 		RLA:                    &rla.API{},
+		RouteServer:            &routeserver.API{},
+		FloatingIP:             &floatingip.API{},
 	}
 }
 
@@ -129,6 +133,8 @@ func (Managers *Manager) NewInstance() {
 	Managers.NVLinkLogicalPartition()
 	Managers.RLA()
 	Managers.VpcPeering()
+	Managers.RouteServer()
+	Managers.FloatingIP()
 }
 
 // Init - initialize all the mgrs
@@ -177,6 +183,8 @@ func (Managers *Manager) Init() {
 	Managers.NVLinkLogicalPartition().Init()
 	Managers.RLA().Init()
 	Managers.VpcPeering().Init()
+	Managers.RouteServer().Init()
+	Managers.FloatingIP().Init()
 }
 
 // Start - start the mgrs
@@ -24,6 +24,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/expectedmachine"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/expectedpowershelf"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/expectedswitch"
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/floatingip"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/infinibandpartition"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/instance"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/instancetype"
@@ -34,6 +35,7 @@ import (
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/nvlinklogicalpartition"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/operatingsystem"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/rla"
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/routeserver"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/sku"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/sshkeygroup"
 	"github.com/NVIDIA/ncx-infra-controller-rest/site-agent/pkg/components/managers/subnet"
@@ -172,3 +174,13 @@ func (m *Manager) NVLinkLogicalPartition() *nvlinklogicalpartition.API {
 func (m *Manager) RLA() *rla.API {
 	return rla.NewRLAManager(m.Data.EB, m.API, m.Conf)
 }
+
+// RouteServer - Add RouteServer Manager instance here
+func (m *Manager) RouteServer() *routeserver.API {
+	return routeserver.NewRouteServerManager(m.Data.EB, m.API, m.Conf)
+}
+
+// FloatingIP - Add FloatingIP Manager instance here
+func (m *Manager) FloatingIP() *floatingip.API {
+	return floatingip.NewFloatingIPManager(m.Data.EB, m.API, m.Conf)
+}
@@ -0,0 +1,30 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package managerapi
+
+// FloatingIPExpansion - FloatingIP Expansion
+type FloatingIPExpansion interface{}
+
+// FloatingIPInterface - Interface for FloatingIP
+type FloatingIPInterface interface {
+	// List all the APIs for FloatingIP here
+	Init()
+	RegisterSubscriber() error
+	GetState() []string
+	FloatingIPExpansion
+}
@@ -64,6 +64,8 @@ type ManagerAPI struct {
 	DpuExtensionService    DpuExtensionServiceInterface
 	NVLinkLogicalPartition NVLinkLogicalPartitionInterface
 	RLA                    RLAInterface
+	RouteServer            RouteServerInterface
+	FloatingIP             FloatingIPInterface
 }
 
 // ManagerConf - Conf struct
@@ -69,6 +69,10 @@ func (api *API) RegisterSubscriber() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.GetRackTask)
 	ManagerAccess.Data.EB.Log.Info().Msg("RLA: Successfully registered GetRackTask workflow")
 
+	// Register GetRackTaskReport workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.GetRackTaskReport)
+	ManagerAccess.Data.EB.Log.Info().Msg("RLA: Successfully registered GetRackTaskReport workflow")
+
 	// Register activities
 	rackManager := swa.NewManageRack(ManagerAccess.Data.EB.Managers.RLA.Client)
 
@@ -108,6 +112,10 @@ func (api *API) RegisterSubscriber() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(rackManager.GetTaskByID)
 	ManagerAccess.Data.EB.Log.Info().Msg("RLA: Successfully registered GetTaskByID activity")
 
+	// Register GetTaskReport activity
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(rackManager.GetTaskReport)
+	ManagerAccess.Data.EB.Log.Info().Msg("RLA: Successfully registered GetTaskReport activity")
+
 	// Register the tray subscribers here
 	ManagerAccess.Data.EB.Log.Info().Msg("RLA: Registering tray workflows")
 
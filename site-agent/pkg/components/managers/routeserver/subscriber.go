@@ -0,0 +1,67 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routeserver
+
+import (
+	swa "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/activity"
+	sww "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/workflow"
+)
+
+// RegisterSubscriber registers RouteServer workflows and activities with Temporal
+func (api *API) RegisterSubscriber() error {
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Registering workflows and activities")
+
+	// Register workflows
+
+	// Register GetRouteServers workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.GetRouteServers)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered GetRouteServers workflow")
+
+	// Register AddRouteServers workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.AddRouteServers)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered AddRouteServers workflow")
+
+	// Register RemoveRouteServers workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.RemoveRouteServers)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered RemoveRouteServers workflow")
+
+	// Register ReplaceRouteServers workflow
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.ReplaceRouteServers)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered ReplaceRouteServers workflow")
+
+	// Register activities
+	routeServerManager := swa.NewManageRouteServer(ManagerAccess.Data.EB.Managers.Carbide.Client)
+
+	// Register GetRouteServersOnSite
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(routeServerManager.GetRouteServersOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered GetRouteServersOnSite activity")
+
+	// Register AddRouteServersOnSite
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(routeServerManager.AddRouteServersOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered AddRouteServersOnSite activity")
+
+	// Register RemoveRouteServersOnSite
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(routeServerManager.RemoveRouteServersOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered RemoveRouteServersOnSite activity")
+
+	// Register ReplaceRouteServersOnSite
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(routeServerManager.ReplaceRouteServersOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("RouteServer: Successfully registered ReplaceRouteServersOnSite activity")
+
+	return nil
+}
@@ -265,6 +265,10 @@ func workflowOrchestrator() error {
 
 	ManagerAccess.API.MachineValidation.RegisterSubscriber()
 
+	ManagerAccess.API.RouteServer.RegisterSubscriber()
+
+	ManagerAccess.API.FloatingIP.RegisterSubscriber()
+
 	ManagerAccess.API.InstanceType.RegisterSubscriber()
 	ManagerAccess.API.InstanceType.RegisterPublisher()
 
@@ -78,6 +78,8 @@ type Workflow struct {
 	DpuExtensionServiceState    *MgrState
 	NVLinkLogicalPartitionState *MgrState
 	VpcPeeringState             *MgrState
+	RouteServerState            *MgrState
+	FloatingIPState             *MgrState
 }
 
 // Temporal datastructure
@@ -111,5 +113,7 @@ func NewWorkflowInstance() *Workflow {
 		DpuExtensionServiceState:    &MgrState{},
 		NVLinkLogicalPartitionState: &MgrState{},
 		VpcPeeringState:             &MgrState{},
+		RouteServerState:            &MgrState{},
+		FloatingIPState:             &MgrState{},
 	}
 }
@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package activity
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	swe "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/error"
+	cClient "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/grpc/client"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/temporal"
+)
+
+// ManageFloatingIP is an activity wrapper for Floating IP management
+type ManageFloatingIP struct {
+	CarbideAtomicClient *cClient.CarbideAtomicClient
+}
+
+// NewManageFloatingIP returns a new ManageFloatingIP client
+func NewManageFloatingIP(carbideClient *cClient.CarbideAtomicClient) ManageFloatingIP {
+	return ManageFloatingIP{
+		CarbideAtomicClient: carbideClient,
+	}
+}
+
+// validateStaticAddress ensures the machine interface ID and IP address of a static address request are valid
+func validateStaticAddress(interfaceID *cwssaws.MachineInterfaceId, ipAddress string) error {
+	if interfaceID == nil || interfaceID.Value == "" {
+		return errors.New("received static address request without machine interface ID")
+	}
+
+	if net.ParseIP(ipAddress) == nil {
+		return errors.New("received static address request with invalid IP address: " + ipAddress)
+	}
+
+	return nil
+}
+
+// AssignFloatingIPOnSite assigns a Floating IP as a static address to a machine interface on Site
+func (mfi *ManageFloatingIP) AssignFloatingIPOnSite(ctx context.Context, request *cwssaws.AssignStaticAddressRequest) (*cwssaws.AssignStaticAddressResponse, error) {
+	logger := log.With().Str("Activity", "AssignFloatingIPOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	// Validate request
+	var err error
+	if request == nil {
+		err = errors.New("received empty assign static address request")
+	} else {
+		err = validateStaticAddress(request.InterfaceId, request.IpAddress)
+	}
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller API
+	carbideClient := mfi.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return nil, cClient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	response, err := forgeClient.AssignStaticAddress(ctx, request)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to assign Floating IP using Site Controller API")
+		return nil, swe.WrapErr(err)
+	}
+
+	logger.Info().Str("Status", response.GetStatus().String()).Msg("Completed activity")
+
+	return response, nil
+}
+
+// RemoveFloatingIPOnSite removes a Floating IP static address from a machine interface on Site.
+// Removing an address that is no longer present on the interface is not considered an error.
+func (mfi *ManageFloatingIP) RemoveFloatingIPOnSite(ctx context.Context, request *cwssaws.RemoveStaticAddressRequest) (*cwssaws.RemoveStaticAddressResponse, error) {
+	logger := log.With().Str("Activity", "RemoveFloatingIPOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	// Validate request
+	var err error
+	if request == nil {
+		err = errors.New("received empty remove static address request")
+	} else {
+		err = validateStaticAddress(request.InterfaceId, request.IpAddress)
+	}
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller API
+	carbideClient := mfi.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return nil, cClient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	response, err := forgeClient.RemoveStaticAddress(ctx, request)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to remove Floating IP using Site Controller API")
+		return nil, swe.WrapErr(err)
+	}
+
+	logger.Info().Str("Status", response.GetStatus().String()).Msg("Completed activity")
+
+	return response, nil
+}
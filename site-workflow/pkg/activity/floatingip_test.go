@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package activity
+
+import (
+	"context"
+	"testing"
+
+	cClient "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/grpc/client"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManageFloatingIP_AssignRemoveFloatingIPOnSite(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	tests := []struct {
+		name        string
+		interfaceID *cwssaws.MachineInterfaceId
+		ipAddress   string
+		wantErr     bool
+	}{
+		{
+			name:        "test assign/remove floating ip success",
+			interfaceID: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+			ipAddress:   "192.168.10.5",
+			wantErr:     false,
+		},
+		{
+			name:      "test assign/remove floating ip fail on missing interface ID",
+			ipAddress: "192.168.10.5",
+			wantErr:   true,
+		},
+		{
+			name:        "test assign/remove floating ip fail on invalid address",
+			interfaceID: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+			ipAddress:   "192.168.10.500",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mfi := NewManageFloatingIP(carbideAtomicClient)
+
+			assignResponse, err := mfi.AssignFloatingIPOnSite(context.Background(), &cwssaws.AssignStaticAddressRequest{
+				InterfaceId: tt.interfaceID,
+				IpAddress:   tt.ipAddress,
+			})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.ipAddress, assignResponse.IpAddress)
+			}
+
+			removeResponse, err := mfi.RemoveFloatingIPOnSite(context.Background(), &cwssaws.RemoveStaticAddressRequest{
+				InterfaceId: tt.interfaceID,
+				IpAddress:   tt.ipAddress,
+			})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, cwssaws.RemoveStaticAddressStatus_REMOVE_STATIC_ADDRESS_STATUS_REMOVED, removeResponse.Status)
+			}
+		})
+	}
+
+	// Missing request
+	mfi := NewManageFloatingIP(carbideAtomicClient)
+	_, err := mfi.AssignFloatingIPOnSite(context.Background(), nil)
+	assert.Error(t, err)
+	_, err = mfi.RemoveFloatingIPOnSite(context.Background(), nil)
+	assert.Error(t, err)
+
+	// Disconnected client
+	mfi = NewManageFloatingIP(cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{}))
+	_, err = mfi.AssignFloatingIPOnSite(context.Background(), &cwssaws.AssignStaticAddressRequest{
+		InterfaceId: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+		IpAddress:   "192.168.10.5",
+	})
+	assert.ErrorIs(t, err, cClient.ErrClientNotConnected)
+}
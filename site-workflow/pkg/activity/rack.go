@@ -312,6 +312,41 @@ func (mr *ManageRack) GetTaskByID(ctx context.Context, request *rlav1.GetTasksBy
 	return response, nil
 }
 
+// GetTaskReport retrieves the report recorded while a task ran from RLA
+func (mr *ManageRack) GetTaskReport(ctx context.Context, request *rlav1.GetTaskReportRequest) (*rlav1.GetTaskReportResponse, error) {
+	logger := log.With().Str("Activity", "GetTaskReport").Logger()
+	logger.Info().Msg("Starting activity")
+
+	var err error
+
+	switch {
+	case request == nil:
+		err = errors.New("received empty get task report request")
+	case request.GetTaskId().GetId() == "":
+		err = errors.New("received get task report request without task ID")
+	}
+
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	rlaClient := mr.RlaAtomicClient.GetClient()
+	if rlaClient == nil {
+		return nil, cClient.ErrClientNotConnected
+	}
+	rla := rlaClient.Rla()
+
+	response, err := rla.GetTaskReport(ctx, request)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to get task report using RLA API")
+		return nil, swe.WrapErr(err)
+	}
+
+	logger.Info().Int("EntryCount", len(response.GetEntries())).Msg("Completed activity")
+
+	return response, nil
+}
+
 // UpgradeFirmware upgrades firmware on racks or components via RLA
 func (mr *ManageRack) UpgradeFirmware(ctx context.Context, request *rlav1.UpgradeFirmwareRequest) (*rlav1.SubmitTaskResponse, error) {
 	logger := log.With().Str("Activity", "UpgradeFirmware").Logger()
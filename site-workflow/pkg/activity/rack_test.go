@@ -720,6 +720,71 @@ func TestManageRack_GetTaskByID(t *testing.T) {
 	}
 }
 
+func TestManageRack_GetTaskReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *rlav1.GetTaskReportRequest
+		wantErr     bool
+		errContains string
+		wantContent bool
+	}{
+		{
+			name:        "nil request returns error",
+			request:     nil,
+			wantErr:     true,
+			errContains: "empty get task report request",
+		},
+		{
+			name:        "request without task ID returns error",
+			request:     &rlav1.GetTaskReportRequest{},
+			wantErr:     true,
+			errContains: "without task ID",
+		},
+		{
+			name: "successful request - entries only",
+			request: &rlav1.GetTaskReportRequest{
+				TaskId: &rlav1.UUID{Id: "test-task-id"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "successful request - rendered as markdown",
+			request: &rlav1.GetTaskReportRequest{
+				TaskId: &rlav1.UUID{Id: "test-task-id"},
+				Format: rlav1.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN,
+			},
+			wantErr:     false,
+			wantContent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRlaClient := cClient.NewMockRlaClient()
+			rlaAtomicClient := cClient.NewRlaAtomicClient(&cClient.RlaClientConfig{})
+			rlaAtomicClient.SwapClient(mockRlaClient)
+			manageRack := NewManageRack(rlaAtomicClient)
+
+			ctx := context.Background()
+			result, err := manageRack.GetTaskReport(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, tt.request.GetTaskId().GetId(), result.GetTask().GetId().GetId())
+			assert.NotEmpty(t, result.GetEntries())
+			assert.Equal(t, tt.wantContent, result.GetContent() != "")
+		})
+	}
+}
+
 func TestManageRack_UpgradeFirmware(t *testing.T) {
 	tests := []struct {
 		name        string
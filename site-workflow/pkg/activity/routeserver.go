@@ -0,0 +1,177 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package activity
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	swe "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/error"
+	cClient "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/grpc/client"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ManageRouteServer is an activity wrapper for Route Server management
+type ManageRouteServer struct {
+	CarbideAtomicClient *cClient.CarbideAtomicClient
+}
+
+// NewManageRouteServer returns a new ManageRouteServer client
+func NewManageRouteServer(carbideClient *cClient.CarbideAtomicClient) ManageRouteServer {
+	return ManageRouteServer{
+		CarbideAtomicClient: carbideClient,
+	}
+}
+
+// validateRouteServersRequest ensures every route server address in the request is a valid IP address
+func validateRouteServersRequest(request *cwssaws.RouteServers, allowEmpty bool) error {
+	if request == nil {
+		return errors.New("received empty route servers request")
+	}
+
+	if !allowEmpty && len(request.RouteServers) == 0 {
+		return errors.New("received route servers request without any addresses")
+	}
+
+	for _, address := range request.RouteServers {
+		if net.ParseIP(address) == nil {
+			return errors.New("received route servers request with invalid address: " + address)
+		}
+	}
+
+	return nil
+}
+
+// GetRouteServersOnSite retrieves the Route Servers configured on Site
+func (mrs *ManageRouteServer) GetRouteServersOnSite(ctx context.Context) (*cwssaws.RouteServerEntries, error) {
+	logger := log.With().Str("Activity", "GetRouteServersOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	// Call Site Controller API
+	carbideClient := mrs.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return nil, cClient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	entries, err := forgeClient.GetRouteServers(ctx, &emptypb.Empty{})
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to retrieve Route Servers using Site Controller API")
+		return nil, swe.WrapErr(err)
+	}
+
+	logger.Info().Msg("Completed activity")
+
+	return entries, nil
+}
+
+// AddRouteServersOnSite adds Route Servers on Site
+func (mrs *ManageRouteServer) AddRouteServersOnSite(ctx context.Context, request *cwssaws.RouteServers) error {
+	logger := log.With().Str("Activity", "AddRouteServersOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	// Validate request
+	err := validateRouteServersRequest(request, false)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller API
+	carbideClient := mrs.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return cClient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	_, err = forgeClient.AddRouteServers(ctx, request)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to add Route Servers using Site Controller API")
+		return swe.WrapErr(err)
+	}
+
+	logger.Info().Msg("Completed activity")
+
+	return nil
+}
+
+// RemoveRouteServersOnSite removes Route Servers from Site
+func (mrs *ManageRouteServer) RemoveRouteServersOnSite(ctx context.Context, request *cwssaws.RouteServers) error {
+	logger := log.With().Str("Activity", "RemoveRouteServersOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	// Validate request
+	err := validateRouteServersRequest(request, false)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller API
+	carbideClient := mrs.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return cClient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	_, err = forgeClient.RemoveRouteServers(ctx, request)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to remove Route Servers using Site Controller API")
+		return swe.WrapErr(err)
+	}
+
+	logger.Info().Msg("Completed activity")
+
+	return nil
+}
+
+// ReplaceRouteServersOnSite replaces all Route Servers of the request source type on Site.
+// An empty list of addresses clears the Route Servers of that source type.
+func (mrs *ManageRouteServer) ReplaceRouteServersOnSite(ctx context.Context, request *cwssaws.RouteServers) error {
+	logger := log.With().Str("Activity", "ReplaceRouteServersOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	// Validate request
+	err := validateRouteServersRequest(request, true)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller API
+	carbideClient := mrs.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return cClient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	_, err = forgeClient.ReplaceRouteServers(ctx, request)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to replace Route Servers using Site Controller API")
+		return swe.WrapErr(err)
+	}
+
+	logger.Info().Msg("Completed activity")
+
+	return nil
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package activity
+
+import (
+	"context"
+	"testing"
+
+	cClient "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/grpc/client"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManageRouteServer_GetRouteServersOnSite(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	mrs := NewManageRouteServer(carbideAtomicClient)
+	entries, err := mrs.GetRouteServersOnSite(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, entries)
+
+	// Disconnected client
+	mrs = NewManageRouteServer(cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{}))
+	_, err = mrs.GetRouteServersOnSite(context.Background())
+	assert.ErrorIs(t, err, cClient.ErrClientNotConnected)
+}
+
+func TestManageRouteServer_AddRemoveRouteServersOnSite(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	tests := []struct {
+		name    string
+		request *cwssaws.RouteServers
+		wantErr bool
+	}{
+		{
+			name: "test add/remove route servers success",
+			request: &cwssaws.RouteServers{
+				RouteServers: []string{"10.0.0.1", "10.0.0.2"},
+				SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+			},
+			wantErr: false,
+		},
+		{
+			name: "test add/remove route servers fail on empty address list",
+			request: &cwssaws.RouteServers{
+				SourceType: cwssaws.RouteServerSourceType_AdminApi,
+			},
+			wantErr: true,
+		},
+		{
+			name: "test add/remove route servers fail on invalid address",
+			request: &cwssaws.RouteServers{
+				RouteServers: []string{"10.0.0.300"},
+				SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "test add/remove route servers fail on missing request",
+			request: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mrs := NewManageRouteServer(carbideAtomicClient)
+
+			err := mrs.AddRouteServersOnSite(context.Background(), tt.request)
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			err = mrs.RemoveRouteServersOnSite(context.Background(), tt.request)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestManageRouteServer_ReplaceRouteServersOnSite(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	tests := []struct {
+		name    string
+		request *cwssaws.RouteServers
+		wantErr bool
+	}{
+		{
+			name: "test replace route servers success",
+			request: &cwssaws.RouteServers{
+				RouteServers: []string{"10.0.0.1"},
+				SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+			},
+			wantErr: false,
+		},
+		{
+			name: "test replace route servers success with empty address list",
+			request: &cwssaws.RouteServers{
+				SourceType: cwssaws.RouteServerSourceType_AdminApi,
+			},
+			wantErr: false,
+		},
+		{
+			name: "test replace route servers fail on invalid address",
+			request: &cwssaws.RouteServers{
+				RouteServers: []string{"not-an-ip"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "test replace route servers fail on missing request",
+			request: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mrs := NewManageRouteServer(carbideAtomicClient)
+			err := mrs.ReplaceRouteServersOnSite(context.Background(), tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1435,6 +1435,46 @@ func (c *MockForgeClient) NVLinkLogicalPartitionsForTenant(ctx context.Context,
 	return out, nil
 }
 
+/* Route Server mock methods */
+func (c *MockForgeClient) GetRouteServers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wflows.RouteServerEntries, error) {
+	out := &wflows.RouteServerEntries{}
+	return out, nil
+}
+
+func (c *MockForgeClient) AddRouteServers(ctx context.Context, in *wflows.RouteServers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	return out, nil
+}
+
+func (c *MockForgeClient) RemoveRouteServers(ctx context.Context, in *wflows.RouteServers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	return out, nil
+}
+
+func (c *MockForgeClient) ReplaceRouteServers(ctx context.Context, in *wflows.RouteServers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	return out, nil
+}
+
+/* Static Address mock methods */
+func (c *MockForgeClient) AssignStaticAddress(ctx context.Context, in *wflows.AssignStaticAddressRequest, opts ...grpc.CallOption) (*wflows.AssignStaticAddressResponse, error) {
+	out := &wflows.AssignStaticAddressResponse{
+		InterfaceId: in.GetInterfaceId(),
+		IpAddress:   in.GetIpAddress(),
+		Status:      wflows.AssignStaticAddressStatus_ASSIGN_STATIC_ADDRESS_STATUS_ASSIGNED,
+	}
+	return out, nil
+}
+
+func (c *MockForgeClient) RemoveStaticAddress(ctx context.Context, in *wflows.RemoveStaticAddressRequest, opts ...grpc.CallOption) (*wflows.RemoveStaticAddressResponse, error) {
+	out := &wflows.RemoveStaticAddressResponse{
+		InterfaceId: in.GetInterfaceId(),
+		IpAddress:   in.GetIpAddress(),
+		Status:      wflows.RemoveStaticAddressStatus_REMOVE_STATIC_ADDRESS_STATUS_REMOVED,
+	}
+	return out, nil
+}
+
 // NewMockCarbideClient creates a new mock CarbideClient
 func NewMockCarbideClient() *CarbideClient {
 	return &CarbideClient{
@@ -1743,6 +1783,31 @@ func (c *MockRLAClient) CancelTask(ctx context.Context, in *rlav1.CancelTaskRequ
 	return out, nil
 }
 
+func (c *MockRLAClient) GetTaskReport(ctx context.Context, in *rlav1.GetTaskReportRequest, opts ...grpc.CallOption) (*rlav1.GetTaskReportResponse, error) {
+	out := &rlav1.GetTaskReportResponse{}
+	if in != nil && in.GetTaskId() != nil {
+		out.Task = &rlav1.Task{
+			Id:     in.GetTaskId(),
+			Status: rlav1.TaskStatus_TASK_STATUS_FAILED,
+		}
+		out.Entries = []*rlav1.TaskReportEntry{
+			{
+				ComponentType: rlav1.ComponentType_COMPONENT_TYPE_COMPUTE,
+				ComponentId:   "compute-1",
+				Stage:         1,
+				Step:          "main_operation",
+				Action:        "PowerControl",
+				Outcome:       rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED,
+				Message:       "BMC unreachable",
+			},
+		}
+		if in.GetFormat() != rlav1.TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED {
+			out.Content = "report of task " + in.GetTaskId().GetId()
+		}
+	}
+	return out, nil
+}
+
 /* Operation rule mock methods */
 func (c *MockRLAClient) CreateOperationRule(ctx context.Context, in *rlav1.CreateOperationRuleRequest, opts ...grpc.CallOption) (*rlav1.CreateOperationRuleResponse, error) {
 	out := &rlav1.CreateOperationRuleResponse{
@@ -0,0 +1,103 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package workflow
+
+import (
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/activity"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AssociateFloatingIP is a workflow to assign a Floating IP to a machine interface on Site using AssignFloatingIPOnSite activity
+func AssociateFloatingIP(ctx workflow.Context, request *cwssaws.AssignStaticAddressRequest) (*cwssaws.AssignStaticAddressResponse, error) {
+	logger := log.With().Str("Workflow", "AssociateFloatingIP").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke AssignFloatingIPOnSite activity
+	var floatingIPManager activity.ManageFloatingIP
+	var response cwssaws.AssignStaticAddressResponse
+
+	err := workflow.ExecuteActivity(ctx, floatingIPManager.AssignFloatingIPOnSite, request).Get(ctx, &response)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "AssignFloatingIPOnSite").Msg("Failed to execute activity from workflow")
+		return nil, err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return &response, nil
+}
+
+// DisassociateFloatingIP is a workflow to remove a Floating IP from a machine interface on Site using RemoveFloatingIPOnSite activity
+func DisassociateFloatingIP(ctx workflow.Context, request *cwssaws.RemoveStaticAddressRequest) (*cwssaws.RemoveStaticAddressResponse, error) {
+	logger := log.With().Str("Workflow", "DisassociateFloatingIP").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke RemoveFloatingIPOnSite activity
+	var floatingIPManager activity.ManageFloatingIP
+	var response cwssaws.RemoveStaticAddressResponse
+
+	err := workflow.ExecuteActivity(ctx, floatingIPManager.RemoveFloatingIPOnSite, request).Get(ctx, &response)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "RemoveFloatingIPOnSite").Msg("Failed to execute activity from workflow")
+		return nil, err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return &response, nil
+}
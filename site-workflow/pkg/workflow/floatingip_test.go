@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package workflow
+
+import (
+	"errors"
+	"testing"
+
+	iActivity "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/activity"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type AssociateFloatingIPTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *AssociateFloatingIPTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *AssociateFloatingIPTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *AssociateFloatingIPTestSuite) Test_AssociateFloatingIP_Success() {
+	var manager iActivity.ManageFloatingIP
+	request := &cwssaws.AssignStaticAddressRequest{
+		InterfaceId: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+		IpAddress:   "192.168.10.5",
+	}
+	response := &cwssaws.AssignStaticAddressResponse{
+		InterfaceId: request.InterfaceId,
+		IpAddress:   request.IpAddress,
+		Status:      cwssaws.AssignStaticAddressStatus_ASSIGN_STATIC_ADDRESS_STATUS_ASSIGNED,
+	}
+
+	// Mock AssignFloatingIPOnSite activity
+	s.env.RegisterActivity(manager.AssignFloatingIPOnSite)
+	s.env.OnActivity(manager.AssignFloatingIPOnSite, mock.Anything, mock.Anything).Return(response, nil)
+
+	// Execute AssociateFloatingIP workflow
+	s.env.ExecuteWorkflow(AssociateFloatingIP, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var result cwssaws.AssignStaticAddressResponse
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(request.IpAddress, result.IpAddress)
+}
+
+func (s *AssociateFloatingIPTestSuite) Test_AssociateFloatingIP_Failure() {
+	var manager iActivity.ManageFloatingIP
+	request := &cwssaws.AssignStaticAddressRequest{
+		InterfaceId: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+		IpAddress:   "192.168.10.5",
+	}
+
+	errMsg := "Site Controller communication error"
+
+	// Mock AssignFloatingIPOnSite activity
+	s.env.RegisterActivity(manager.AssignFloatingIPOnSite)
+	s.env.OnActivity(manager.AssignFloatingIPOnSite, mock.Anything, mock.Anything).Return(nil, errors.New(errMsg))
+
+	// Execute AssociateFloatingIP workflow
+	s.env.ExecuteWorkflow(AssociateFloatingIP, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func TestAssociateFloatingIPTestSuite(t *testing.T) {
+	suite.Run(t, new(AssociateFloatingIPTestSuite))
+}
+
+type DisassociateFloatingIPTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *DisassociateFloatingIPTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *DisassociateFloatingIPTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *DisassociateFloatingIPTestSuite) Test_DisassociateFloatingIP_Success() {
+	var manager iActivity.ManageFloatingIP
+	request := &cwssaws.RemoveStaticAddressRequest{
+		InterfaceId: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+		IpAddress:   "192.168.10.5",
+	}
+	response := &cwssaws.RemoveStaticAddressResponse{
+		InterfaceId: request.InterfaceId,
+		IpAddress:   request.IpAddress,
+		Status:      cwssaws.RemoveStaticAddressStatus_REMOVE_STATIC_ADDRESS_STATUS_NOT_FOUND,
+	}
+
+	// Mock RemoveFloatingIPOnSite activity
+	s.env.RegisterActivity(manager.RemoveFloatingIPOnSite)
+	s.env.OnActivity(manager.RemoveFloatingIPOnSite, mock.Anything, mock.Anything).Return(response, nil)
+
+	// Execute DisassociateFloatingIP workflow
+	s.env.ExecuteWorkflow(DisassociateFloatingIP, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var result cwssaws.RemoveStaticAddressResponse
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(cwssaws.RemoveStaticAddressStatus_REMOVE_STATIC_ADDRESS_STATUS_NOT_FOUND, result.Status)
+}
+
+func (s *DisassociateFloatingIPTestSuite) Test_DisassociateFloatingIP_Failure() {
+	var manager iActivity.ManageFloatingIP
+	request := &cwssaws.RemoveStaticAddressRequest{
+		InterfaceId: &cwssaws.MachineInterfaceId{Value: "b410867c-655a-11ef-bc4a-0393098e5d09"},
+		IpAddress:   "192.168.10.5",
+	}
+
+	errMsg := "Site Controller communication error"
+
+	// Mock RemoveFloatingIPOnSite activity
+	s.env.RegisterActivity(manager.RemoveFloatingIPOnSite)
+	s.env.OnActivity(manager.RemoveFloatingIPOnSite, mock.Anything, mock.Anything).Return(nil, errors.New(errMsg))
+
+	// Execute DisassociateFloatingIP workflow
+	s.env.ExecuteWorkflow(DisassociateFloatingIP, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func TestDisassociateFloatingIPTestSuite(t *testing.T) {
+	suite.Run(t, new(DisassociateFloatingIPTestSuite))
+}
@@ -305,6 +305,39 @@ func GetRackTask(ctx workflow.Context, request *rlav1.GetTasksByIDsRequest) (*rl
 	return &response, nil
 }
 
+// GetRackTaskReport is a workflow to get the report of a rack task by its UUID
+func GetRackTaskReport(ctx workflow.Context, request *rlav1.GetTaskReportRequest) (*rlav1.GetTaskReportResponse, error) {
+	logger := log.With().Str("Workflow", "Rack").Str("Action", "GetRackTaskReport").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy:         retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var rackManager activity.ManageRack
+	var response rlav1.GetTaskReportResponse
+
+	err := workflow.ExecuteActivity(ctx, rackManager.GetTaskReport, request).Get(ctx, &response)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "GetTaskReport").Msg("Failed to execute activity from workflow")
+		return nil, err
+	}
+
+	logger.Info().Int("EntryCount", len(response.GetEntries())).Msg("Completing workflow")
+
+	return &response, nil
+}
+
 // UpgradeFirmware is a workflow to upgrade firmware on racks or components via RLA
 func UpgradeFirmware(ctx workflow.Context, request *rlav1.UpgradeFirmwareRequest) (*rlav1.SubmitTaskResponse, error) {
 	logger := log.With().Str("Workflow", "Rack").Str("Action", "UpgradeFirmware").Logger()
@@ -888,6 +888,87 @@ func TestGetRackTaskTestSuite(t *testing.T) {
 	suite.Run(t, new(GetRackTaskTestSuite))
 }
 
+// GetRackTaskReportTestSuite tests the GetRackTaskReport workflow
+type GetRackTaskReportTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *GetRackTaskReportTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *GetRackTaskReportTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *GetRackTaskReportTestSuite) Test_GetRackTaskReport_Success() {
+	var rackManager rActivity.ManageRack
+
+	taskID := "test-task-id"
+	request := &rlav1.GetTaskReportRequest{
+		TaskId: &rlav1.UUID{Id: taskID},
+		Format: rlav1.TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN,
+	}
+
+	expectedResponse := &rlav1.GetTaskReportResponse{
+		Task: &rlav1.Task{
+			Id:     &rlav1.UUID{Id: taskID},
+			Status: rlav1.TaskStatus_TASK_STATUS_FAILED,
+		},
+		Entries: []*rlav1.TaskReportEntry{
+			{
+				ComponentType: rlav1.ComponentType_COMPONENT_TYPE_COMPUTE,
+				ComponentId:   "compute-1",
+				Action:        "PowerControl",
+				Outcome:       rlav1.TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED,
+			},
+		},
+		Content: "# Task test-task-id",
+	}
+
+	s.env.RegisterActivity(rackManager.GetTaskReport)
+	s.env.OnActivity(rackManager.GetTaskReport, mock.Anything, mock.Anything).Return(expectedResponse, nil)
+
+	s.env.ExecuteWorkflow(GetRackTaskReport, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var response rlav1.GetTaskReportResponse
+	s.NoError(s.env.GetWorkflowResult(&response))
+	s.Equal(taskID, response.GetTask().GetId().GetId())
+	s.Equal(1, len(response.GetEntries()))
+	s.Equal("# Task test-task-id", response.GetContent())
+}
+
+func (s *GetRackTaskReportTestSuite) Test_GetRackTaskReport_ActivityFails() {
+	var rackManager rActivity.ManageRack
+
+	request := &rlav1.GetTaskReportRequest{
+		TaskId: &rlav1.UUID{Id: "test-task-id"},
+	}
+
+	errMsg := "RLA connection failed"
+
+	s.env.RegisterActivity(rackManager.GetTaskReport)
+	s.env.OnActivity(rackManager.GetTaskReport, mock.Anything, mock.Anything).Return(nil, errors.New(errMsg))
+
+	s.env.ExecuteWorkflow(GetRackTaskReport, request)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal(errMsg, applicationErr.Error())
+}
+
+func TestGetRackTaskReportTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRackTaskReportTestSuite))
+}
+
 // UpgradeFirmwareTestSuite tests the UpgradeFirmware workflow
 type UpgradeFirmwareTestSuite struct {
 	suite.Suite
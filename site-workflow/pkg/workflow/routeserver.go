@@ -0,0 +1,177 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"time"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/activity"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// GetRouteServers is a workflow to retrieve the Route Servers configured on Site using GetRouteServersOnSite activity
+func GetRouteServers(ctx workflow.Context) (*cwssaws.RouteServerEntries, error) {
+	logger := log.With().Str("Workflow", "GetRouteServers").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke GetRouteServersOnSite activity
+	var routeServerManager activity.ManageRouteServer
+	var response cwssaws.RouteServerEntries
+
+	err := workflow.ExecuteActivity(ctx, routeServerManager.GetRouteServersOnSite).Get(ctx, &response)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "GetRouteServersOnSite").Msg("Failed to execute activity from workflow")
+		return nil, err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return &response, nil
+}
+
+// AddRouteServers is a workflow to add Route Servers on Site using AddRouteServersOnSite activity
+func AddRouteServers(ctx workflow.Context, request *cwssaws.RouteServers) error {
+	logger := log.With().Str("Workflow", "AddRouteServers").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke AddRouteServersOnSite activity
+	var routeServerManager activity.ManageRouteServer
+
+	err := workflow.ExecuteActivity(ctx, routeServerManager.AddRouteServersOnSite, request).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "AddRouteServersOnSite").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return nil
+}
+
+// RemoveRouteServers is a workflow to remove Route Servers from Site using RemoveRouteServersOnSite activity
+func RemoveRouteServers(ctx workflow.Context, request *cwssaws.RouteServers) error {
+	logger := log.With().Str("Workflow", "RemoveRouteServers").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke RemoveRouteServersOnSite activity
+	var routeServerManager activity.ManageRouteServer
+
+	err := workflow.ExecuteActivity(ctx, routeServerManager.RemoveRouteServersOnSite, request).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "RemoveRouteServersOnSite").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return nil
+}
+
+// ReplaceRouteServers is a workflow to replace the Route Servers of a source type on Site using ReplaceRouteServersOnSite activity
+func ReplaceRouteServers(ctx workflow.Context, request *cwssaws.RouteServers) error {
+	logger := log.With().Str("Workflow", "ReplaceRouteServers").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke ReplaceRouteServersOnSite activity
+	var routeServerManager activity.ManageRouteServer
+
+	err := workflow.ExecuteActivity(ctx, routeServerManager.ReplaceRouteServersOnSite, request).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "ReplaceRouteServersOnSite").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return nil
+}
@@ -0,0 +1,250 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"errors"
+	"testing"
+
+	iActivity "github.com/NVIDIA/ncx-infra-controller-rest/site-workflow/pkg/activity"
+	cwssaws "github.com/NVIDIA/ncx-infra-controller-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type GetRouteServersTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *GetRouteServersTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *GetRouteServersTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *GetRouteServersTestSuite) Test_GetRouteServers_Success() {
+	var manager iActivity.ManageRouteServer
+	entries := &cwssaws.RouteServerEntries{
+		RouteServers: []*cwssaws.RouteServer{
+			{Address: "10.0.0.1", SourceType: cwssaws.RouteServerSourceType_AdminApi},
+		},
+	}
+
+	// Mock GetRouteServersOnSite activity
+	s.env.RegisterActivity(manager.GetRouteServersOnSite)
+	s.env.OnActivity(manager.GetRouteServersOnSite, mock.Anything).Return(entries, nil)
+
+	// Execute GetRouteServers workflow
+	s.env.ExecuteWorkflow(GetRouteServers)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var response cwssaws.RouteServerEntries
+	s.NoError(s.env.GetWorkflowResult(&response))
+	s.Equal(1, len(response.RouteServers))
+}
+
+func (s *GetRouteServersTestSuite) Test_GetRouteServers_Failure() {
+	var manager iActivity.ManageRouteServer
+
+	errMsg := "Site Controller communication error"
+
+	// Mock GetRouteServersOnSite activity
+	s.env.RegisterActivity(manager.GetRouteServersOnSite)
+	s.env.OnActivity(manager.GetRouteServersOnSite, mock.Anything).Return(nil, errors.New(errMsg))
+
+	// Execute GetRouteServers workflow
+	s.env.ExecuteWorkflow(GetRouteServers)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func TestGetRouteServersTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRouteServersTestSuite))
+}
+
+type AddRouteServersTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *AddRouteServersTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *AddRouteServersTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *AddRouteServersTestSuite) Test_AddRouteServers_Success() {
+	var manager iActivity.ManageRouteServer
+	request := &cwssaws.RouteServers{
+		RouteServers: []string{"10.0.0.1", "10.0.0.2"},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+
+	// Mock AddRouteServersOnSite activity
+	s.env.RegisterActivity(manager.AddRouteServersOnSite)
+	s.env.OnActivity(manager.AddRouteServersOnSite, mock.Anything, mock.Anything).Return(nil)
+
+	// Execute AddRouteServers workflow
+	s.env.ExecuteWorkflow(AddRouteServers, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *AddRouteServersTestSuite) Test_AddRouteServers_Failure() {
+	var manager iActivity.ManageRouteServer
+	request := &cwssaws.RouteServers{
+		RouteServers: []string{"10.0.0.1"},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+
+	errMsg := "Site Controller communication error"
+
+	// Mock AddRouteServersOnSite activity
+	s.env.RegisterActivity(manager.AddRouteServersOnSite)
+	s.env.OnActivity(manager.AddRouteServersOnSite, mock.Anything, mock.Anything).Return(errors.New(errMsg))
+
+	// Execute AddRouteServers workflow
+	s.env.ExecuteWorkflow(AddRouteServers, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func TestAddRouteServersTestSuite(t *testing.T) {
+	suite.Run(t, new(AddRouteServersTestSuite))
+}
+
+type RemoveRouteServersTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *RemoveRouteServersTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *RemoveRouteServersTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *RemoveRouteServersTestSuite) Test_RemoveRouteServers_Success() {
+	var manager iActivity.ManageRouteServer
+	request := &cwssaws.RouteServers{
+		RouteServers: []string{"10.0.0.1", "10.0.0.2"},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+
+	// Mock RemoveRouteServersOnSite activity
+	s.env.RegisterActivity(manager.RemoveRouteServersOnSite)
+	s.env.OnActivity(manager.RemoveRouteServersOnSite, mock.Anything, mock.Anything).Return(nil)
+
+	// Execute RemoveRouteServers workflow
+	s.env.ExecuteWorkflow(RemoveRouteServers, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *RemoveRouteServersTestSuite) Test_RemoveRouteServers_Failure() {
+	var manager iActivity.ManageRouteServer
+	request := &cwssaws.RouteServers{
+		RouteServers: []string{"10.0.0.1"},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+
+	errMsg := "Site Controller communication error"
+
+	// Mock RemoveRouteServersOnSite activity
+	s.env.RegisterActivity(manager.RemoveRouteServersOnSite)
+	s.env.OnActivity(manager.RemoveRouteServersOnSite, mock.Anything, mock.Anything).Return(errors.New(errMsg))
+
+	// Execute RemoveRouteServers workflow
+	s.env.ExecuteWorkflow(RemoveRouteServers, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func TestRemoveRouteServersTestSuite(t *testing.T) {
+	suite.Run(t, new(RemoveRouteServersTestSuite))
+}
+
+type ReplaceRouteServersTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *ReplaceRouteServersTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *ReplaceRouteServersTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *ReplaceRouteServersTestSuite) Test_ReplaceRouteServers_Success() {
+	var manager iActivity.ManageRouteServer
+	request := &cwssaws.RouteServers{
+		RouteServers: []string{"10.0.0.1", "10.0.0.2"},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+
+	// Mock ReplaceRouteServersOnSite activity
+	s.env.RegisterActivity(manager.ReplaceRouteServersOnSite)
+	s.env.OnActivity(manager.ReplaceRouteServersOnSite, mock.Anything, mock.Anything).Return(nil)
+
+	// Execute ReplaceRouteServers workflow
+	s.env.ExecuteWorkflow(ReplaceRouteServers, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *ReplaceRouteServersTestSuite) Test_ReplaceRouteServers_Failure() {
+	var manager iActivity.ManageRouteServer
+	request := &cwssaws.RouteServers{
+		RouteServers: []string{"10.0.0.1"},
+		SourceType:   cwssaws.RouteServerSourceType_AdminApi,
+	}
+
+	errMsg := "Site Controller communication error"
+
+	// Mock ReplaceRouteServersOnSite activity
+	s.env.RegisterActivity(manager.ReplaceRouteServersOnSite)
+	s.env.OnActivity(manager.ReplaceRouteServersOnSite, mock.Anything, mock.Anything).Return(errors.New(errMsg))
+
+	// Execute ReplaceRouteServers workflow
+	s.env.ExecuteWorkflow(ReplaceRouteServers, request)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func TestReplaceRouteServersTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplaceRouteServersTestSuite))
+}
@@ -655,6 +655,109 @@ func (ConflictStrategy) EnumDescriptor() ([]byte, []int) {
 	return file_rla_proto_rawDescGZIP(), []int{10}
 }
 
+// TaskReportOutcome is the outcome of a task report entry.
+type TaskReportOutcome int32
+
+const (
+	TaskReportOutcome_TASK_REPORT_OUTCOME_UNKNOWN    TaskReportOutcome = 0
+	TaskReportOutcome_TASK_REPORT_OUTCOME_SUCCEEDED  TaskReportOutcome = 1
+	TaskReportOutcome_TASK_REPORT_OUTCOME_FAILED     TaskReportOutcome = 2
+	TaskReportOutcome_TASK_REPORT_OUTCOME_TERMINATED TaskReportOutcome = 3 // the task was stopped before it finished
+)
+
+// Enum value maps for TaskReportOutcome.
+var (
+	TaskReportOutcome_name = map[int32]string{
+		0: "TASK_REPORT_OUTCOME_UNKNOWN",
+		1: "TASK_REPORT_OUTCOME_SUCCEEDED",
+		2: "TASK_REPORT_OUTCOME_FAILED",
+		3: "TASK_REPORT_OUTCOME_TERMINATED",
+	}
+	TaskReportOutcome_value = map[string]int32{
+		"TASK_REPORT_OUTCOME_UNKNOWN":    0,
+		"TASK_REPORT_OUTCOME_SUCCEEDED":  1,
+		"TASK_REPORT_OUTCOME_FAILED":     2,
+		"TASK_REPORT_OUTCOME_TERMINATED": 3,
+	}
+)
+
+func (x TaskReportOutcome) Enum() *TaskReportOutcome {
+	p := new(TaskReportOutcome)
+	*p = x
+	return p
+}
+
+func (x TaskReportOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskReportOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[11].Descriptor()
+}
+
+func (TaskReportOutcome) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[11]
+}
+
+func (x TaskReportOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskReportOutcome.Descriptor instead.
+func (TaskReportOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{11}
+}
+
+// TaskReportFormat selects how GetTaskReport renders the report.
+type TaskReportFormat int32
+
+const (
+	TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED TaskReportFormat = 0 // structured entries only
+	TaskReportFormat_TASK_REPORT_FORMAT_JSON        TaskReportFormat = 1
+	TaskReportFormat_TASK_REPORT_FORMAT_MARKDOWN    TaskReportFormat = 2
+)
+
+// Enum value maps for TaskReportFormat.
+var (
+	TaskReportFormat_name = map[int32]string{
+		0: "TASK_REPORT_FORMAT_UNSPECIFIED",
+		1: "TASK_REPORT_FORMAT_JSON",
+		2: "TASK_REPORT_FORMAT_MARKDOWN",
+	}
+	TaskReportFormat_value = map[string]int32{
+		"TASK_REPORT_FORMAT_UNSPECIFIED": 0,
+		"TASK_REPORT_FORMAT_JSON":        1,
+		"TASK_REPORT_FORMAT_MARKDOWN":    2,
+	}
+)
+
+func (x TaskReportFormat) Enum() *TaskReportFormat {
+	p := new(TaskReportFormat)
+	*p = x
+	return p
+}
+
+func (x TaskReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rla_proto_enumTypes[12].Descriptor()
+}
+
+func (TaskReportFormat) Type() protoreflect.EnumType {
+	return &file_rla_proto_enumTypes[12]
+}
+
+func (x TaskReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskReportFormat.Descriptor instead.
+func (TaskReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{12}
+}
+
 type OperationType int32
 
 const (
@@ -688,11 +791,11 @@ func (x OperationType) String() string {
 }
 
 func (OperationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rla_proto_enumTypes[11].Descriptor()
+	return file_rla_proto_enumTypes[13].Descriptor()
 }
 
 func (OperationType) Type() protoreflect.EnumType {
-	return &file_rla_proto_enumTypes[11]
+	return &file_rla_proto_enumTypes[13]
 }
 
 func (x OperationType) Number() protoreflect.EnumNumber {
@@ -701,7 +804,7 @@ func (x OperationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperationType.Descriptor instead.
 func (OperationType) EnumDescriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{11}
+	return file_rla_proto_rawDescGZIP(), []int{13}
 }
 
 type UUID struct {
@@ -4902,6 +5005,220 @@ func (x *CancelTaskResponse) GetTask() *Task {
 	return nil
 }
 
+// TaskReportEntry is one line of a task report. Entries about the task as a
+// whole have no component.
+type TaskReportEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	ComponentType ComponentType          `protobuf:"varint,2,opt,name=component_type,json=componentType,proto3,enum=v1.ComponentType" json:"component_type,omitempty"`
+	ComponentId   string                 `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Stage         int32                  `protobuf:"varint,4,opt,name=stage,proto3" json:"stage,omitempty"`  // operation rule stage, 0 for task entries
+	Step          string                 `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`     // pre_operation | main_operation | post_operation | compensation
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"` // e.g. PowerControl, or Task for the task outcome
+	Outcome       TaskReportOutcome      `protobuf:"varint,7,opt,name=outcome,proto3,enum=v1.TaskReportOutcome" json:"outcome,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskReportEntry) Reset() {
+	*x = TaskReportEntry{}
+	mi := &file_rla_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskReportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskReportEntry) ProtoMessage() {}
+
+func (x *TaskReportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskReportEntry.ProtoReflect.Descriptor instead.
+func (*TaskReportEntry) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TaskReportEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TaskReportEntry) GetComponentType() ComponentType {
+	if x != nil {
+		return x.ComponentType
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *TaskReportEntry) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *TaskReportEntry) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *TaskReportEntry) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *TaskReportEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TaskReportEntry) GetOutcome() TaskReportOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TaskReportOutcome_TASK_REPORT_OUTCOME_UNKNOWN
+}
+
+func (x *TaskReportEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTaskReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        *UUID                  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Format        TaskReportFormat       `protobuf:"varint,2,opt,name=format,proto3,enum=v1.TaskReportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskReportRequest) Reset() {
+	*x = GetTaskReportRequest{}
+	mi := &file_rla_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskReportRequest) ProtoMessage() {}
+
+func (x *GetTaskReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskReportRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetTaskReportRequest) GetTaskId() *UUID {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
+
+func (x *GetTaskReportRequest) GetFormat() TaskReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TaskReportFormat_TASK_REPORT_FORMAT_UNSPECIFIED
+}
+
+type GetTaskReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Entries       []*TaskReportEntry     `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // in the order they were recorded
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // rendered report, set when a format was requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskReportResponse) Reset() {
+	*x = GetTaskReportResponse{}
+	mi := &file_rla_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskReportResponse) ProtoMessage() {}
+
+func (x *GetTaskReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskReportResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetTaskReportResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *GetTaskReportResponse) GetEntries() []*TaskReportEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTaskReportResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 // Version API messages
 type VersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -4911,7 +5228,7 @@ type VersionRequest struct {
 
 func (x *VersionRequest) Reset() {
 	*x = VersionRequest{}
-	mi := &file_rla_proto_msgTypes[73]
+	mi := &file_rla_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4923,7 +5240,7 @@ func (x *VersionRequest) String() string {
 func (*VersionRequest) ProtoMessage() {}
 
 func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[73]
+	mi := &file_rla_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4936,7 +5253,7 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{73}
+	return file_rla_proto_rawDescGZIP(), []int{76}
 }
 
 type BuildInfo struct {
@@ -4950,7 +5267,7 @@ type BuildInfo struct {
 
 func (x *BuildInfo) Reset() {
 	*x = BuildInfo{}
-	mi := &file_rla_proto_msgTypes[74]
+	mi := &file_rla_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4962,7 +5279,7 @@ func (x *BuildInfo) String() string {
 func (*BuildInfo) ProtoMessage() {}
 
 func (x *BuildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[74]
+	mi := &file_rla_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4975,7 +5292,7 @@ func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
 func (*BuildInfo) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{74}
+	return file_rla_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *BuildInfo) GetVersion() string {
@@ -5016,7 +5333,7 @@ type OperationRule struct {
 
 func (x *OperationRule) Reset() {
 	*x = OperationRule{}
-	mi := &file_rla_proto_msgTypes[75]
+	mi := &file_rla_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5028,7 +5345,7 @@ func (x *OperationRule) String() string {
 func (*OperationRule) ProtoMessage() {}
 
 func (x *OperationRule) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[75]
+	mi := &file_rla_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5041,7 +5358,7 @@ func (x *OperationRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationRule.ProtoReflect.Descriptor instead.
 func (*OperationRule) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{75}
+	return file_rla_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *OperationRule) GetId() *UUID {
@@ -5121,7 +5438,7 @@ type CreateOperationRuleRequest struct {
 
 func (x *CreateOperationRuleRequest) Reset() {
 	*x = CreateOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[76]
+	mi := &file_rla_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5133,7 +5450,7 @@ func (x *CreateOperationRuleRequest) String() string {
 func (*CreateOperationRuleRequest) ProtoMessage() {}
 
 func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[76]
+	mi := &file_rla_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5146,7 +5463,7 @@ func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOperationRuleRequest.ProtoReflect.Descriptor instead.
 func (*CreateOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{76}
+	return file_rla_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *CreateOperationRuleRequest) GetName() string {
@@ -5200,7 +5517,7 @@ type CreateOperationRuleResponse struct {
 
 func (x *CreateOperationRuleResponse) Reset() {
 	*x = CreateOperationRuleResponse{}
-	mi := &file_rla_proto_msgTypes[77]
+	mi := &file_rla_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5212,7 +5529,7 @@ func (x *CreateOperationRuleResponse) String() string {
 func (*CreateOperationRuleResponse) ProtoMessage() {}
 
 func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[77]
+	mi := &file_rla_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5225,7 +5542,7 @@ func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOperationRuleResponse.ProtoReflect.Descriptor instead.
 func (*CreateOperationRuleResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{77}
+	return file_rla_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *CreateOperationRuleResponse) GetId() *UUID {
@@ -5247,7 +5564,7 @@ type UpdateOperationRuleRequest struct {
 
 func (x *UpdateOperationRuleRequest) Reset() {
 	*x = UpdateOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[78]
+	mi := &file_rla_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5259,7 +5576,7 @@ func (x *UpdateOperationRuleRequest) String() string {
 func (*UpdateOperationRuleRequest) ProtoMessage() {}
 
 func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[78]
+	mi := &file_rla_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5272,7 +5589,7 @@ func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOperationRuleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{78}
+	return file_rla_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *UpdateOperationRuleRequest) GetRuleId() *UUID {
@@ -5312,7 +5629,7 @@ type DeleteOperationRuleRequest struct {
 
 func (x *DeleteOperationRuleRequest) Reset() {
 	*x = DeleteOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[79]
+	mi := &file_rla_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5324,7 +5641,7 @@ func (x *DeleteOperationRuleRequest) String() string {
 func (*DeleteOperationRuleRequest) ProtoMessage() {}
 
 func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[79]
+	mi := &file_rla_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5337,7 +5654,7 @@ func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOperationRuleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{79}
+	return file_rla_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DeleteOperationRuleRequest) GetRuleId() *UUID {
@@ -5356,7 +5673,7 @@ type SetRuleAsDefaultRequest struct {
 
 func (x *SetRuleAsDefaultRequest) Reset() {
 	*x = SetRuleAsDefaultRequest{}
-	mi := &file_rla_proto_msgTypes[80]
+	mi := &file_rla_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5368,7 +5685,7 @@ func (x *SetRuleAsDefaultRequest) String() string {
 func (*SetRuleAsDefaultRequest) ProtoMessage() {}
 
 func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[80]
+	mi := &file_rla_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5381,7 +5698,7 @@ func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetRuleAsDefaultRequest.ProtoReflect.Descriptor instead.
 func (*SetRuleAsDefaultRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{80}
+	return file_rla_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *SetRuleAsDefaultRequest) GetRuleId() *UUID {
@@ -5400,7 +5717,7 @@ type GetOperationRuleRequest struct {
 
 func (x *GetOperationRuleRequest) Reset() {
 	*x = GetOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[81]
+	mi := &file_rla_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5412,7 +5729,7 @@ func (x *GetOperationRuleRequest) String() string {
 func (*GetOperationRuleRequest) ProtoMessage() {}
 
 func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[81]
+	mi := &file_rla_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5425,7 +5742,7 @@ func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOperationRuleRequest.ProtoReflect.Descriptor instead.
 func (*GetOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{81}
+	return file_rla_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetOperationRuleRequest) GetRuleId() *UUID {
@@ -5447,7 +5764,7 @@ type ListOperationRulesRequest struct {
 
 func (x *ListOperationRulesRequest) Reset() {
 	*x = ListOperationRulesRequest{}
-	mi := &file_rla_proto_msgTypes[82]
+	mi := &file_rla_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5459,7 +5776,7 @@ func (x *ListOperationRulesRequest) String() string {
 func (*ListOperationRulesRequest) ProtoMessage() {}
 
 func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[82]
+	mi := &file_rla_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5472,7 +5789,7 @@ func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOperationRulesRequest.ProtoReflect.Descriptor instead.
 func (*ListOperationRulesRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{82}
+	return file_rla_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ListOperationRulesRequest) GetOperationType() OperationType {
@@ -5513,7 +5830,7 @@ type ListOperationRulesResponse struct {
 
 func (x *ListOperationRulesResponse) Reset() {
 	*x = ListOperationRulesResponse{}
-	mi := &file_rla_proto_msgTypes[83]
+	mi := &file_rla_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5525,7 +5842,7 @@ func (x *ListOperationRulesResponse) String() string {
 func (*ListOperationRulesResponse) ProtoMessage() {}
 
 func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[83]
+	mi := &file_rla_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5538,7 +5855,7 @@ func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOperationRulesResponse.ProtoReflect.Descriptor instead.
 func (*ListOperationRulesResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{83}
+	return file_rla_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ListOperationRulesResponse) GetRules() []*OperationRule {
@@ -5565,7 +5882,7 @@ type AssociateRuleWithRackRequest struct {
 
 func (x *AssociateRuleWithRackRequest) Reset() {
 	*x = AssociateRuleWithRackRequest{}
-	mi := &file_rla_proto_msgTypes[84]
+	mi := &file_rla_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5577,7 +5894,7 @@ func (x *AssociateRuleWithRackRequest) String() string {
 func (*AssociateRuleWithRackRequest) ProtoMessage() {}
 
 func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[84]
+	mi := &file_rla_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5590,7 +5907,7 @@ func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssociateRuleWithRackRequest.ProtoReflect.Descriptor instead.
 func (*AssociateRuleWithRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{84}
+	return file_rla_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *AssociateRuleWithRackRequest) GetRackId() *UUID {
@@ -5618,7 +5935,7 @@ type DisassociateRuleFromRackRequest struct {
 
 func (x *DisassociateRuleFromRackRequest) Reset() {
 	*x = DisassociateRuleFromRackRequest{}
-	mi := &file_rla_proto_msgTypes[85]
+	mi := &file_rla_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5630,7 +5947,7 @@ func (x *DisassociateRuleFromRackRequest) String() string {
 func (*DisassociateRuleFromRackRequest) ProtoMessage() {}
 
 func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[85]
+	mi := &file_rla_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5643,7 +5960,7 @@ func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisassociateRuleFromRackRequest.ProtoReflect.Descriptor instead.
 func (*DisassociateRuleFromRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{85}
+	return file_rla_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *DisassociateRuleFromRackRequest) GetRackId() *UUID {
@@ -5678,7 +5995,7 @@ type GetRackRuleAssociationRequest struct {
 
 func (x *GetRackRuleAssociationRequest) Reset() {
 	*x = GetRackRuleAssociationRequest{}
-	mi := &file_rla_proto_msgTypes[86]
+	mi := &file_rla_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5690,7 +6007,7 @@ func (x *GetRackRuleAssociationRequest) String() string {
 func (*GetRackRuleAssociationRequest) ProtoMessage() {}
 
 func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[86]
+	mi := &file_rla_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5703,7 +6020,7 @@ func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackRuleAssociationRequest.ProtoReflect.Descriptor instead.
 func (*GetRackRuleAssociationRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{86}
+	return file_rla_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GetRackRuleAssociationRequest) GetRackId() *UUID {
@@ -5736,7 +6053,7 @@ type GetRackRuleAssociationResponse struct {
 
 func (x *GetRackRuleAssociationResponse) Reset() {
 	*x = GetRackRuleAssociationResponse{}
-	mi := &file_rla_proto_msgTypes[87]
+	mi := &file_rla_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5748,7 +6065,7 @@ func (x *GetRackRuleAssociationResponse) String() string {
 func (*GetRackRuleAssociationResponse) ProtoMessage() {}
 
 func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[87]
+	mi := &file_rla_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5761,7 +6078,7 @@ func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackRuleAssociationResponse.ProtoReflect.Descriptor instead.
 func (*GetRackRuleAssociationResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{87}
+	return file_rla_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *GetRackRuleAssociationResponse) GetRuleId() *UUID {
@@ -5780,7 +6097,7 @@ type ListRackRuleAssociationsRequest struct {
 
 func (x *ListRackRuleAssociationsRequest) Reset() {
 	*x = ListRackRuleAssociationsRequest{}
-	mi := &file_rla_proto_msgTypes[88]
+	mi := &file_rla_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5792,7 +6109,7 @@ func (x *ListRackRuleAssociationsRequest) String() string {
 func (*ListRackRuleAssociationsRequest) ProtoMessage() {}
 
 func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[88]
+	mi := &file_rla_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5805,7 +6122,7 @@ func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRackRuleAssociationsRequest.ProtoReflect.Descriptor instead.
 func (*ListRackRuleAssociationsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{88}
+	return file_rla_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ListRackRuleAssociationsRequest) GetRackId() *UUID {
@@ -5829,7 +6146,7 @@ type RackRuleAssociation struct {
 
 func (x *RackRuleAssociation) Reset() {
 	*x = RackRuleAssociation{}
-	mi := &file_rla_proto_msgTypes[89]
+	mi := &file_rla_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5841,7 +6158,7 @@ func (x *RackRuleAssociation) String() string {
 func (*RackRuleAssociation) ProtoMessage() {}
 
 func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[89]
+	mi := &file_rla_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5854,7 +6171,7 @@ func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RackRuleAssociation.ProtoReflect.Descriptor instead.
 func (*RackRuleAssociation) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{89}
+	return file_rla_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RackRuleAssociation) GetRackId() *UUID {
@@ -5908,7 +6225,7 @@ type ListRackRuleAssociationsResponse struct {
 
 func (x *ListRackRuleAssociationsResponse) Reset() {
 	*x = ListRackRuleAssociationsResponse{}
-	mi := &file_rla_proto_msgTypes[90]
+	mi := &file_rla_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5920,7 +6237,7 @@ func (x *ListRackRuleAssociationsResponse) String() string {
 func (*ListRackRuleAssociationsResponse) ProtoMessage() {}
 
 func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[90]
+	mi := &file_rla_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5933,7 +6250,7 @@ func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRackRuleAssociationsResponse.ProtoReflect.Descriptor instead.
 func (*ListRackRuleAssociationsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{90}
+	return file_rla_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *ListRackRuleAssociationsResponse) GetAssociations() []*RackRuleAssociation {
@@ -6291,7 +6608,23 @@ const file_rla_proto_rawDesc = "" +
 	"\x11CancelTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06taskId\"2\n" +
 	"\x12CancelTaskResponse\x12\x1c\n" +
-	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\"\x10\n" +
+	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\"\xab\x02\n" +
+	"\x0fTaskReportEntry\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x128\n" +
+	"\x0ecomponent_type\x18\x02 \x01(\x0e2\x11.v1.ComponentTypeR\rcomponentType\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\tR\vcomponentId\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\x05R\x05stage\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\tR\x04step\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12/\n" +
+	"\aoutcome\x18\a \x01(\x0e2\x15.v1.TaskReportOutcomeR\aoutcome\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"g\n" +
+	"\x14GetTaskReportRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06taskId\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.v1.TaskReportFormatR\x06format\"~\n" +
+	"\x15GetTaskReportResponse\x12\x1c\n" +
+	"\x04task\x18\x01 \x01(\v2\b.v1.TaskR\x04task\x12-\n" +
+	"\aentries\x18\x02 \x03(\v2\x13.v1.TaskReportEntryR\aentries\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"\x10\n" +
 	"\x0eVersionRequest\"c\n" +
 	"\tBuildInfo\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1d\n" +
@@ -6440,11 +6773,20 @@ const file_rla_proto_rawDesc = "" +
 	"\x10ConflictStrategy\x12!\n" +
 	"\x1dCONFLICT_STRATEGY_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17CONFLICT_STRATEGY_QUEUE\x10\x01\x12\x1c\n" +
-	"\x18CONFLICT_STRATEGY_REJECT\x10\x02*r\n" +
+	"\x18CONFLICT_STRATEGY_REJECT\x10\x02*\x9b\x01\n" +
+	"\x11TaskReportOutcome\x12\x1f\n" +
+	"\x1bTASK_REPORT_OUTCOME_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dTASK_REPORT_OUTCOME_SUCCEEDED\x10\x01\x12\x1e\n" +
+	"\x1aTASK_REPORT_OUTCOME_FAILED\x10\x02\x12\"\n" +
+	"\x1eTASK_REPORT_OUTCOME_TERMINATED\x10\x03*t\n" +
+	"\x10TaskReportFormat\x12\"\n" +
+	"\x1eTASK_REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_REPORT_FORMAT_JSON\x10\x01\x12\x1f\n" +
+	"\x1bTASK_REPORT_FORMAT_MARKDOWN\x10\x02*r\n" +
 	"\rOperationType\x12\x1a\n" +
 	"\x16OPERATION_TYPE_UNKNOWN\x10\x00\x12 \n" +
 	"\x1cOPERATION_TYPE_POWER_CONTROL\x10\x01\x12#\n" +
-	"\x1fOPERATION_TYPE_FIRMWARE_CONTROL\x10\x022\xca\x17\n" +
+	"\x1fOPERATION_TYPE_FIRMWARE_CONTROL\x10\x022\x90\x18\n" +
 	"\x03RLA\x12,\n" +
 	"\aVersion\x12\x12.v1.VersionRequest\x1a\r.v1.BuildInfo\x12S\n" +
 	"\x12CreateExpectedRack\x12\x1d.v1.CreateExpectedRackRequest\x1a\x1e.v1.CreateExpectedRackResponse\x12F\n" +
@@ -6478,7 +6820,8 @@ const file_rla_proto_rawDesc = "" +
 	"\tListTasks\x12\x14.v1.ListTasksRequest\x1a\x15.v1.ListTasksResponse\x12D\n" +
 	"\rGetTasksByIDs\x12\x18.v1.GetTasksByIDsRequest\x1a\x19.v1.GetTasksByIDsResponse\x12;\n" +
 	"\n" +
-	"CancelTask\x12\x15.v1.CancelTaskRequest\x1a\x16.v1.CancelTaskResponse\x12V\n" +
+	"CancelTask\x12\x15.v1.CancelTaskRequest\x1a\x16.v1.CancelTaskResponse\x12D\n" +
+	"\rGetTaskReport\x12\x18.v1.GetTaskReportRequest\x1a\x19.v1.GetTaskReportResponse\x12V\n" +
 	"\x13CreateOperationRule\x12\x1e.v1.CreateOperationRuleRequest\x1a\x1f.v1.CreateOperationRuleResponse\x12M\n" +
 	"\x13UpdateOperationRule\x12\x1e.v1.UpdateOperationRuleRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
 	"\x13DeleteOperationRule\x12\x1e.v1.DeleteOperationRuleRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
@@ -6502,8 +6845,8 @@ func file_rla_proto_rawDescGZIP() []byte {
 	return file_rla_proto_rawDescData
 }
 
-var file_rla_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_rla_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
+var file_rla_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_rla_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_rla_proto_goTypes = []any{
 	(BMCType)(0),                             // 0: v1.BMCType
 	(ComponentType)(0),                       // 1: v1.ComponentType
@@ -6516,322 +6859,336 @@ var file_rla_proto_goTypes = []any{
 	(TaskExecutorType)(0),                    // 8: v1.TaskExecutorType
 	(DiffType)(0),                            // 9: v1.DiffType
 	(ConflictStrategy)(0),                    // 10: v1.ConflictStrategy
-	(OperationType)(0),                       // 11: v1.OperationType
-	(*UUID)(nil),                             // 12: v1.UUID
-	(*DeviceInfo)(nil),                       // 13: v1.DeviceInfo
-	(*Location)(nil),                         // 14: v1.Location
-	(*DeviceSerialInfo)(nil),                 // 15: v1.DeviceSerialInfo
-	(*BMCInfo)(nil),                          // 16: v1.BMCInfo
-	(*RackPosition)(nil),                     // 17: v1.RackPosition
-	(*Component)(nil),                        // 18: v1.Component
-	(*Rack)(nil),                             // 19: v1.Rack
-	(*Identifier)(nil),                       // 20: v1.Identifier
-	(*OperationTargetSpec)(nil),              // 21: v1.OperationTargetSpec
-	(*RackTargets)(nil),                      // 22: v1.RackTargets
-	(*ComponentTargets)(nil),                 // 23: v1.ComponentTargets
-	(*RackTarget)(nil),                       // 24: v1.RackTarget
-	(*ComponentTarget)(nil),                  // 25: v1.ComponentTarget
-	(*ExternalRef)(nil),                      // 26: v1.ExternalRef
-	(*NVLDomain)(nil),                        // 27: v1.NVLDomain
-	(*Pagination)(nil),                       // 28: v1.Pagination
-	(*StringQueryInfo)(nil),                  // 29: v1.StringQueryInfo
-	(*Filter)(nil),                           // 30: v1.Filter
-	(*OrderBy)(nil),                          // 31: v1.OrderBy
-	(*Task)(nil),                             // 32: v1.Task
-	(*CreateExpectedRackRequest)(nil),        // 33: v1.CreateExpectedRackRequest
-	(*CreateExpectedRackResponse)(nil),       // 34: v1.CreateExpectedRackResponse
-	(*GetRackInfoByIDRequest)(nil),           // 35: v1.GetRackInfoByIDRequest
-	(*GetRackInfoBySerialRequest)(nil),       // 36: v1.GetRackInfoBySerialRequest
-	(*GetRackInfoResponse)(nil),              // 37: v1.GetRackInfoResponse
-	(*PatchRackRequest)(nil),                 // 38: v1.PatchRackRequest
-	(*PatchRackResponse)(nil),                // 39: v1.PatchRackResponse
-	(*GetComponentInfoByIDRequest)(nil),      // 40: v1.GetComponentInfoByIDRequest
-	(*GetComponentInfoBySerialRequest)(nil),  // 41: v1.GetComponentInfoBySerialRequest
-	(*GetComponentInfoResponse)(nil),         // 42: v1.GetComponentInfoResponse
-	(*GetListOfRacksRequest)(nil),            // 43: v1.GetListOfRacksRequest
-	(*GetListOfRacksResponse)(nil),           // 44: v1.GetListOfRacksResponse
-	(*CreateNVLDomainRequest)(nil),           // 45: v1.CreateNVLDomainRequest
-	(*CreateNVLDomainResponse)(nil),          // 46: v1.CreateNVLDomainResponse
-	(*AttachRacksToNVLDomainRequest)(nil),    // 47: v1.AttachRacksToNVLDomainRequest
-	(*DetachRacksFromNVLDomainRequest)(nil),  // 48: v1.DetachRacksFromNVLDomainRequest
-	(*GetListOfNVLDomainsRequest)(nil),       // 49: v1.GetListOfNVLDomainsRequest
-	(*GetListOfNVLDomainsResponse)(nil),      // 50: v1.GetListOfNVLDomainsResponse
-	(*GetRacksForNVLDomainRequest)(nil),      // 51: v1.GetRacksForNVLDomainRequest
-	(*GetRacksForNVLDomainResponse)(nil),     // 52: v1.GetRacksForNVLDomainResponse
-	(*UpgradeFirmwareRequest)(nil),           // 53: v1.UpgradeFirmwareRequest
-	(*GetComponentsRequest)(nil),             // 54: v1.GetComponentsRequest
-	(*GetComponentsResponse)(nil),            // 55: v1.GetComponentsResponse
-	(*ValidateComponentsRequest)(nil),        // 56: v1.ValidateComponentsRequest
-	(*ValidateComponentsResponse)(nil),       // 57: v1.ValidateComponentsResponse
-	(*ComponentDiff)(nil),                    // 58: v1.ComponentDiff
-	(*FieldDiff)(nil),                        // 59: v1.FieldDiff
-	(*AddComponentRequest)(nil),              // 60: v1.AddComponentRequest
-	(*AddComponentResponse)(nil),             // 61: v1.AddComponentResponse
-	(*DeleteComponentRequest)(nil),           // 62: v1.DeleteComponentRequest
-	(*DeleteComponentResponse)(nil),          // 63: v1.DeleteComponentResponse
-	(*DeleteRackRequest)(nil),                // 64: v1.DeleteRackRequest
-	(*DeleteRackResponse)(nil),               // 65: v1.DeleteRackResponse
-	(*PurgeRackRequest)(nil),                 // 66: v1.PurgeRackRequest
-	(*PurgeRackResponse)(nil),                // 67: v1.PurgeRackResponse
-	(*PurgeComponentRequest)(nil),            // 68: v1.PurgeComponentRequest
-	(*PurgeComponentResponse)(nil),           // 69: v1.PurgeComponentResponse
-	(*PatchComponentRequest)(nil),            // 70: v1.PatchComponentRequest
-	(*PatchComponentResponse)(nil),           // 71: v1.PatchComponentResponse
-	(*SubmitTaskResponse)(nil),               // 72: v1.SubmitTaskResponse
-	(*QueueOptions)(nil),                     // 73: v1.QueueOptions
-	(*PowerOnRackRequest)(nil),               // 74: v1.PowerOnRackRequest
-	(*PowerOffRackRequest)(nil),              // 75: v1.PowerOffRackRequest
-	(*PowerResetRackRequest)(nil),            // 76: v1.PowerResetRackRequest
-	(*BringUpRackRequest)(nil),               // 77: v1.BringUpRackRequest
-	(*IngestRackRequest)(nil),                // 78: v1.IngestRackRequest
-	(*ListTasksRequest)(nil),                 // 79: v1.ListTasksRequest
-	(*ListTasksResponse)(nil),                // 80: v1.ListTasksResponse
-	(*GetTasksByIDsRequest)(nil),             // 81: v1.GetTasksByIDsRequest
-	(*GetTasksByIDsResponse)(nil),            // 82: v1.GetTasksByIDsResponse
-	(*CancelTaskRequest)(nil),                // 83: v1.CancelTaskRequest
-	(*CancelTaskResponse)(nil),               // 84: v1.CancelTaskResponse
-	(*VersionRequest)(nil),                   // 85: v1.VersionRequest
-	(*BuildInfo)(nil),                        // 86: v1.BuildInfo
-	(*OperationRule)(nil),                    // 87: v1.OperationRule
-	(*CreateOperationRuleRequest)(nil),       // 88: v1.CreateOperationRuleRequest
-	(*CreateOperationRuleResponse)(nil),      // 89: v1.CreateOperationRuleResponse
-	(*UpdateOperationRuleRequest)(nil),       // 90: v1.UpdateOperationRuleRequest
-	(*DeleteOperationRuleRequest)(nil),       // 91: v1.DeleteOperationRuleRequest
-	(*SetRuleAsDefaultRequest)(nil),          // 92: v1.SetRuleAsDefaultRequest
-	(*GetOperationRuleRequest)(nil),          // 93: v1.GetOperationRuleRequest
-	(*ListOperationRulesRequest)(nil),        // 94: v1.ListOperationRulesRequest
-	(*ListOperationRulesResponse)(nil),       // 95: v1.ListOperationRulesResponse
-	(*AssociateRuleWithRackRequest)(nil),     // 96: v1.AssociateRuleWithRackRequest
-	(*DisassociateRuleFromRackRequest)(nil),  // 97: v1.DisassociateRuleFromRackRequest
-	(*GetRackRuleAssociationRequest)(nil),    // 98: v1.GetRackRuleAssociationRequest
-	(*GetRackRuleAssociationResponse)(nil),   // 99: v1.GetRackRuleAssociationResponse
-	(*ListRackRuleAssociationsRequest)(nil),  // 100: v1.ListRackRuleAssociationsRequest
-	(*RackRuleAssociation)(nil),              // 101: v1.RackRuleAssociation
-	(*ListRackRuleAssociationsResponse)(nil), // 102: v1.ListRackRuleAssociationsResponse
-	(*timestamppb.Timestamp)(nil),            // 103: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 104: google.protobuf.Empty
+	(TaskReportOutcome)(0),                   // 11: v1.TaskReportOutcome
+	(TaskReportFormat)(0),                    // 12: v1.TaskReportFormat
+	(OperationType)(0),                       // 13: v1.OperationType
+	(*UUID)(nil),                             // 14: v1.UUID
+	(*DeviceInfo)(nil),                       // 15: v1.DeviceInfo
+	(*Location)(nil),                         // 16: v1.Location
+	(*DeviceSerialInfo)(nil),                 // 17: v1.DeviceSerialInfo
+	(*BMCInfo)(nil),                          // 18: v1.BMCInfo
+	(*RackPosition)(nil),                     // 19: v1.RackPosition
+	(*Component)(nil),                        // 20: v1.Component
+	(*Rack)(nil),                             // 21: v1.Rack
+	(*Identifier)(nil),                       // 22: v1.Identifier
+	(*OperationTargetSpec)(nil),              // 23: v1.OperationTargetSpec
+	(*RackTargets)(nil),                      // 24: v1.RackTargets
+	(*ComponentTargets)(nil),                 // 25: v1.ComponentTargets
+	(*RackTarget)(nil),                       // 26: v1.RackTarget
+	(*ComponentTarget)(nil),                  // 27: v1.ComponentTarget
+	(*ExternalRef)(nil),                      // 28: v1.ExternalRef
+	(*NVLDomain)(nil),                        // 29: v1.NVLDomain
+	(*Pagination)(nil),                       // 30: v1.Pagination
+	(*StringQueryInfo)(nil),                  // 31: v1.StringQueryInfo
+	(*Filter)(nil),                           // 32: v1.Filter
+	(*OrderBy)(nil),                          // 33: v1.OrderBy
+	(*Task)(nil),                             // 34: v1.Task
+	(*CreateExpectedRackRequest)(nil),        // 35: v1.CreateExpectedRackRequest
+	(*CreateExpectedRackResponse)(nil),       // 36: v1.CreateExpectedRackResponse
+	(*GetRackInfoByIDRequest)(nil),           // 37: v1.GetRackInfoByIDRequest
+	(*GetRackInfoBySerialRequest)(nil),       // 38: v1.GetRackInfoBySerialRequest
+	(*GetRackInfoResponse)(nil),              // 39: v1.GetRackInfoResponse
+	(*PatchRackRequest)(nil),                 // 40: v1.PatchRackRequest
+	(*PatchRackResponse)(nil),                // 41: v1.PatchRackResponse
+	(*GetComponentInfoByIDRequest)(nil),      // 42: v1.GetComponentInfoByIDRequest
+	(*GetComponentInfoBySerialRequest)(nil),  // 43: v1.GetComponentInfoBySerialRequest
+	(*GetComponentInfoResponse)(nil),         // 44: v1.GetComponentInfoResponse
+	(*GetListOfRacksRequest)(nil),            // 45: v1.GetListOfRacksRequest
+	(*GetListOfRacksResponse)(nil),           // 46: v1.GetListOfRacksResponse
+	(*CreateNVLDomainRequest)(nil),           // 47: v1.CreateNVLDomainRequest
+	(*CreateNVLDomainResponse)(nil),          // 48: v1.CreateNVLDomainResponse
+	(*AttachRacksToNVLDomainRequest)(nil),    // 49: v1.AttachRacksToNVLDomainRequest
+	(*DetachRacksFromNVLDomainRequest)(nil),  // 50: v1.DetachRacksFromNVLDomainRequest
+	(*GetListOfNVLDomainsRequest)(nil),       // 51: v1.GetListOfNVLDomainsRequest
+	(*GetListOfNVLDomainsResponse)(nil),      // 52: v1.GetListOfNVLDomainsResponse
+	(*GetRacksForNVLDomainRequest)(nil),      // 53: v1.GetRacksForNVLDomainRequest
+	(*GetRacksForNVLDomainResponse)(nil),     // 54: v1.GetRacksForNVLDomainResponse
+	(*UpgradeFirmwareRequest)(nil),           // 55: v1.UpgradeFirmwareRequest
+	(*GetComponentsRequest)(nil),             // 56: v1.GetComponentsRequest
+	(*GetComponentsResponse)(nil),            // 57: v1.GetComponentsResponse
+	(*ValidateComponentsRequest)(nil),        // 58: v1.ValidateComponentsRequest
+	(*ValidateComponentsResponse)(nil),       // 59: v1.ValidateComponentsResponse
+	(*ComponentDiff)(nil),                    // 60: v1.ComponentDiff
+	(*FieldDiff)(nil),                        // 61: v1.FieldDiff
+	(*AddComponentRequest)(nil),              // 62: v1.AddComponentRequest
+	(*AddComponentResponse)(nil),             // 63: v1.AddComponentResponse
+	(*DeleteComponentRequest)(nil),           // 64: v1.DeleteComponentRequest
+	(*DeleteComponentResponse)(nil),          // 65: v1.DeleteComponentResponse
+	(*DeleteRackRequest)(nil),                // 66: v1.DeleteRackRequest
+	(*DeleteRackResponse)(nil),               // 67: v1.DeleteRackResponse
+	(*PurgeRackRequest)(nil),                 // 68: v1.PurgeRackRequest
+	(*PurgeRackResponse)(nil),                // 69: v1.PurgeRackResponse
+	(*PurgeComponentRequest)(nil),            // 70: v1.PurgeComponentRequest
+	(*PurgeComponentResponse)(nil),           // 71: v1.PurgeComponentResponse
+	(*PatchComponentRequest)(nil),            // 72: v1.PatchComponentRequest
+	(*PatchComponentResponse)(nil),           // 73: v1.PatchComponentResponse
+	(*SubmitTaskResponse)(nil),               // 74: v1.SubmitTaskResponse
+	(*QueueOptions)(nil),                     // 75: v1.QueueOptions
+	(*PowerOnRackRequest)(nil),               // 76: v1.PowerOnRackRequest
+	(*PowerOffRackRequest)(nil),              // 77: v1.PowerOffRackRequest
+	(*PowerResetRackRequest)(nil),            // 78: v1.PowerResetRackRequest
+	(*BringUpRackRequest)(nil),               // 79: v1.BringUpRackRequest
+	(*IngestRackRequest)(nil),                // 80: v1.IngestRackRequest
+	(*ListTasksRequest)(nil),                 // 81: v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                // 82: v1.ListTasksResponse
+	(*GetTasksByIDsRequest)(nil),             // 83: v1.GetTasksByIDsRequest
+	(*GetTasksByIDsResponse)(nil),            // 84: v1.GetTasksByIDsResponse
+	(*CancelTaskRequest)(nil),                // 85: v1.CancelTaskRequest
+	(*CancelTaskResponse)(nil),               // 86: v1.CancelTaskResponse
+	(*TaskReportEntry)(nil),                  // 87: v1.TaskReportEntry
+	(*GetTaskReportRequest)(nil),             // 88: v1.GetTaskReportRequest
+	(*GetTaskReportResponse)(nil),            // 89: v1.GetTaskReportResponse
+	(*VersionRequest)(nil),                   // 90: v1.VersionRequest
+	(*BuildInfo)(nil),                        // 91: v1.BuildInfo
+	(*OperationRule)(nil),                    // 92: v1.OperationRule
+	(*CreateOperationRuleRequest)(nil),       // 93: v1.CreateOperationRuleRequest
+	(*CreateOperationRuleResponse)(nil),      // 94: v1.CreateOperationRuleResponse
+	(*UpdateOperationRuleRequest)(nil),       // 95: v1.UpdateOperationRuleRequest
+	(*DeleteOperationRuleRequest)(nil),       // 96: v1.DeleteOperationRuleRequest
+	(*SetRuleAsDefaultRequest)(nil),          // 97: v1.SetRuleAsDefaultRequest
+	(*GetOperationRuleRequest)(nil),          // 98: v1.GetOperationRuleRequest
+	(*ListOperationRulesRequest)(nil),        // 99: v1.ListOperationRulesRequest
+	(*ListOperationRulesResponse)(nil),       // 100: v1.ListOperationRulesResponse
+	(*AssociateRuleWithRackRequest)(nil),     // 101: v1.AssociateRuleWithRackRequest
+	(*DisassociateRuleFromRackRequest)(nil),  // 102: v1.DisassociateRuleFromRackRequest
+	(*GetRackRuleAssociationRequest)(nil),    // 103: v1.GetRackRuleAssociationRequest
+	(*GetRackRuleAssociationResponse)(nil),   // 104: v1.GetRackRuleAssociationResponse
+	(*ListRackRuleAssociationsRequest)(nil),  // 105: v1.ListRackRuleAssociationsRequest
+	(*RackRuleAssociation)(nil),              // 106: v1.RackRuleAssociation
+	(*ListRackRuleAssociationsResponse)(nil), // 107: v1.ListRackRuleAssociationsResponse
+	(*timestamppb.Timestamp)(nil),            // 108: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 109: google.protobuf.Empty
 }
 var file_rla_proto_depIdxs = []int32{
-	12,  // 0: v1.DeviceInfo.id:type_name -> v1.UUID
+	14,  // 0: v1.DeviceInfo.id:type_name -> v1.UUID
 	0,   // 1: v1.BMCInfo.type:type_name -> v1.BMCType
 	1,   // 2: v1.Component.type:type_name -> v1.ComponentType
-	13,  // 3: v1.Component.info:type_name -> v1.DeviceInfo
-	17,  // 4: v1.Component.position:type_name -> v1.RackPosition
-	16,  // 5: v1.Component.bmcs:type_name -> v1.BMCInfo
-	12,  // 6: v1.Component.rack_id:type_name -> v1.UUID
-	13,  // 7: v1.Rack.info:type_name -> v1.DeviceInfo
-	14,  // 8: v1.Rack.location:type_name -> v1.Location
-	18,  // 9: v1.Rack.components:type_name -> v1.Component
-	12,  // 10: v1.Identifier.id:type_name -> v1.UUID
-	22,  // 11: v1.OperationTargetSpec.racks:type_name -> v1.RackTargets
-	23,  // 12: v1.OperationTargetSpec.components:type_name -> v1.ComponentTargets
-	24,  // 13: v1.RackTargets.targets:type_name -> v1.RackTarget
-	25,  // 14: v1.ComponentTargets.targets:type_name -> v1.ComponentTarget
-	12,  // 15: v1.RackTarget.id:type_name -> v1.UUID
+	15,  // 3: v1.Component.info:type_name -> v1.DeviceInfo
+	19,  // 4: v1.Component.position:type_name -> v1.RackPosition
+	18,  // 5: v1.Component.bmcs:type_name -> v1.BMCInfo
+	14,  // 6: v1.Component.rack_id:type_name -> v1.UUID
+	15,  // 7: v1.Rack.info:type_name -> v1.DeviceInfo
+	16,  // 8: v1.Rack.location:type_name -> v1.Location
+	20,  // 9: v1.Rack.components:type_name -> v1.Component
+	14,  // 10: v1.Identifier.id:type_name -> v1.UUID
+	24,  // 11: v1.OperationTargetSpec.racks:type_name -> v1.RackTargets
+	25,  // 12: v1.OperationTargetSpec.components:type_name -> v1.ComponentTargets
+	26,  // 13: v1.RackTargets.targets:type_name -> v1.RackTarget
+	27,  // 14: v1.ComponentTargets.targets:type_name -> v1.ComponentTarget
+	14,  // 15: v1.RackTarget.id:type_name -> v1.UUID
 	1,   // 16: v1.RackTarget.component_types:type_name -> v1.ComponentType
-	12,  // 17: v1.ComponentTarget.id:type_name -> v1.UUID
-	26,  // 18: v1.ComponentTarget.external:type_name -> v1.ExternalRef
+	14,  // 17: v1.ComponentTarget.id:type_name -> v1.UUID
+	28,  // 18: v1.ComponentTarget.external:type_name -> v1.ExternalRef
 	1,   // 19: v1.ExternalRef.type:type_name -> v1.ComponentType
-	20,  // 20: v1.NVLDomain.identifier:type_name -> v1.Identifier
+	22,  // 20: v1.NVLDomain.identifier:type_name -> v1.Identifier
 	2,   // 21: v1.Filter.rack_field:type_name -> v1.RackFilterField
 	3,   // 22: v1.Filter.component_field:type_name -> v1.ComponentFilterField
-	29,  // 23: v1.Filter.query_info:type_name -> v1.StringQueryInfo
+	31,  // 23: v1.Filter.query_info:type_name -> v1.StringQueryInfo
 	5,   // 24: v1.OrderBy.rack_field:type_name -> v1.RackOrderByField
 	4,   // 25: v1.OrderBy.component_field:type_name -> v1.ComponentOrderByField
-	12,  // 26: v1.Task.id:type_name -> v1.UUID
-	12,  // 27: v1.Task.rack_id:type_name -> v1.UUID
-	12,  // 28: v1.Task.component_uuids:type_name -> v1.UUID
+	14,  // 26: v1.Task.id:type_name -> v1.UUID
+	14,  // 27: v1.Task.rack_id:type_name -> v1.UUID
+	14,  // 28: v1.Task.component_uuids:type_name -> v1.UUID
 	8,   // 29: v1.Task.executor_type:type_name -> v1.TaskExecutorType
 	7,   // 30: v1.Task.status:type_name -> v1.TaskStatus
-	103, // 31: v1.Task.queue_expires_at:type_name -> google.protobuf.Timestamp
-	103, // 32: v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	103, // 33: v1.Task.finished_at:type_name -> google.protobuf.Timestamp
-	12,  // 34: v1.Task.applied_rule_id:type_name -> v1.UUID
-	103, // 35: v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	103, // 36: v1.Task.started_at:type_name -> google.protobuf.Timestamp
-	19,  // 37: v1.CreateExpectedRackRequest.rack:type_name -> v1.Rack
-	12,  // 38: v1.CreateExpectedRackResponse.id:type_name -> v1.UUID
-	12,  // 39: v1.GetRackInfoByIDRequest.id:type_name -> v1.UUID
-	15,  // 40: v1.GetRackInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
-	19,  // 41: v1.GetRackInfoResponse.rack:type_name -> v1.Rack
-	19,  // 42: v1.PatchRackRequest.rack:type_name -> v1.Rack
-	12,  // 43: v1.GetComponentInfoByIDRequest.id:type_name -> v1.UUID
-	15,  // 44: v1.GetComponentInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
-	18,  // 45: v1.GetComponentInfoResponse.component:type_name -> v1.Component
-	19,  // 46: v1.GetComponentInfoResponse.rack:type_name -> v1.Rack
-	30,  // 47: v1.GetListOfRacksRequest.filters:type_name -> v1.Filter
-	28,  // 48: v1.GetListOfRacksRequest.pagination:type_name -> v1.Pagination
-	31,  // 49: v1.GetListOfRacksRequest.order_by:type_name -> v1.OrderBy
-	19,  // 50: v1.GetListOfRacksResponse.racks:type_name -> v1.Rack
-	27,  // 51: v1.CreateNVLDomainRequest.nvl_domain:type_name -> v1.NVLDomain
-	12,  // 52: v1.CreateNVLDomainResponse.id:type_name -> v1.UUID
-	20,  // 53: v1.AttachRacksToNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
-	20,  // 54: v1.AttachRacksToNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
-	20,  // 55: v1.DetachRacksFromNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
-	29,  // 56: v1.GetListOfNVLDomainsRequest.info:type_name -> v1.StringQueryInfo
-	28,  // 57: v1.GetListOfNVLDomainsRequest.pagination:type_name -> v1.Pagination
-	27,  // 58: v1.GetListOfNVLDomainsResponse.nvl_domains:type_name -> v1.NVLDomain
-	20,  // 59: v1.GetRacksForNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
-	19,  // 60: v1.GetRacksForNVLDomainResponse.racks:type_name -> v1.Rack
-	21,  // 61: v1.UpgradeFirmwareRequest.target_spec:type_name -> v1.OperationTargetSpec
-	103, // 62: v1.UpgradeFirmwareRequest.start_time:type_name -> google.protobuf.Timestamp
-	103, // 63: v1.UpgradeFirmwareRequest.end_time:type_name -> google.protobuf.Timestamp
-	73,  // 64: v1.UpgradeFirmwareRequest.queue_options:type_name -> v1.QueueOptions
-	21,  // 65: v1.GetComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
-	30,  // 66: v1.GetComponentsRequest.filters:type_name -> v1.Filter
-	28,  // 67: v1.GetComponentsRequest.pagination:type_name -> v1.Pagination
-	31,  // 68: v1.GetComponentsRequest.order_by:type_name -> v1.OrderBy
-	18,  // 69: v1.GetComponentsResponse.components:type_name -> v1.Component
-	21,  // 70: v1.ValidateComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
-	30,  // 71: v1.ValidateComponentsRequest.filters:type_name -> v1.Filter
-	28,  // 72: v1.ValidateComponentsRequest.pagination:type_name -> v1.Pagination
-	31,  // 73: v1.ValidateComponentsRequest.order_by:type_name -> v1.OrderBy
-	58,  // 74: v1.ValidateComponentsResponse.diffs:type_name -> v1.ComponentDiff
+	108, // 31: v1.Task.queue_expires_at:type_name -> google.protobuf.Timestamp
+	108, // 32: v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	108, // 33: v1.Task.finished_at:type_name -> google.protobuf.Timestamp
+	14,  // 34: v1.Task.applied_rule_id:type_name -> v1.UUID
+	108, // 35: v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 36: v1.Task.started_at:type_name -> google.protobuf.Timestamp
+	21,  // 37: v1.CreateExpectedRackRequest.rack:type_name -> v1.Rack
+	14,  // 38: v1.CreateExpectedRackResponse.id:type_name -> v1.UUID
+	14,  // 39: v1.GetRackInfoByIDRequest.id:type_name -> v1.UUID
+	17,  // 40: v1.GetRackInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
+	21,  // 41: v1.GetRackInfoResponse.rack:type_name -> v1.Rack
+	21,  // 42: v1.PatchRackRequest.rack:type_name -> v1.Rack
+	14,  // 43: v1.GetComponentInfoByIDRequest.id:type_name -> v1.UUID
+	17,  // 44: v1.GetComponentInfoBySerialRequest.serial_info:type_name -> v1.DeviceSerialInfo
+	20,  // 45: v1.GetComponentInfoResponse.component:type_name -> v1.Component
+	21,  // 46: v1.GetComponentInfoResponse.rack:type_name -> v1.Rack
+	32,  // 47: v1.GetListOfRacksRequest.filters:type_name -> v1.Filter
+	30,  // 48: v1.GetListOfRacksRequest.pagination:type_name -> v1.Pagination
+	33,  // 49: v1.GetListOfRacksRequest.order_by:type_name -> v1.OrderBy
+	21,  // 50: v1.GetListOfRacksResponse.racks:type_name -> v1.Rack
+	29,  // 51: v1.CreateNVLDomainRequest.nvl_domain:type_name -> v1.NVLDomain
+	14,  // 52: v1.CreateNVLDomainResponse.id:type_name -> v1.UUID
+	22,  // 53: v1.AttachRacksToNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
+	22,  // 54: v1.AttachRacksToNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
+	22,  // 55: v1.DetachRacksFromNVLDomainRequest.rack_identifiers:type_name -> v1.Identifier
+	31,  // 56: v1.GetListOfNVLDomainsRequest.info:type_name -> v1.StringQueryInfo
+	30,  // 57: v1.GetListOfNVLDomainsRequest.pagination:type_name -> v1.Pagination
+	29,  // 58: v1.GetListOfNVLDomainsResponse.nvl_domains:type_name -> v1.NVLDomain
+	22,  // 59: v1.GetRacksForNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
+	21,  // 60: v1.GetRacksForNVLDomainResponse.racks:type_name -> v1.Rack
+	23,  // 61: v1.UpgradeFirmwareRequest.target_spec:type_name -> v1.OperationTargetSpec
+	108, // 62: v1.UpgradeFirmwareRequest.start_time:type_name -> google.protobuf.Timestamp
+	108, // 63: v1.UpgradeFirmwareRequest.end_time:type_name -> google.protobuf.Timestamp
+	75,  // 64: v1.UpgradeFirmwareRequest.queue_options:type_name -> v1.QueueOptions
+	23,  // 65: v1.GetComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	32,  // 66: v1.GetComponentsRequest.filters:type_name -> v1.Filter
+	30,  // 67: v1.GetComponentsRequest.pagination:type_name -> v1.Pagination
+	33,  // 68: v1.GetComponentsRequest.order_by:type_name -> v1.OrderBy
+	20,  // 69: v1.GetComponentsResponse.components:type_name -> v1.Component
+	23,  // 70: v1.ValidateComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	32,  // 71: v1.ValidateComponentsRequest.filters:type_name -> v1.Filter
+	30,  // 72: v1.ValidateComponentsRequest.pagination:type_name -> v1.Pagination
+	33,  // 73: v1.ValidateComponentsRequest.order_by:type_name -> v1.OrderBy
+	60,  // 74: v1.ValidateComponentsResponse.diffs:type_name -> v1.ComponentDiff
 	9,   // 75: v1.ComponentDiff.type:type_name -> v1.DiffType
-	18,  // 76: v1.ComponentDiff.expected:type_name -> v1.Component
-	18,  // 77: v1.ComponentDiff.actual:type_name -> v1.Component
-	59,  // 78: v1.ComponentDiff.field_diffs:type_name -> v1.FieldDiff
-	12,  // 79: v1.ComponentDiff.id:type_name -> v1.UUID
-	18,  // 80: v1.AddComponentRequest.component:type_name -> v1.Component
-	18,  // 81: v1.AddComponentResponse.component:type_name -> v1.Component
-	12,  // 82: v1.DeleteComponentRequest.id:type_name -> v1.UUID
-	12,  // 83: v1.DeleteRackRequest.id:type_name -> v1.UUID
-	12,  // 84: v1.PurgeRackRequest.id:type_name -> v1.UUID
-	12,  // 85: v1.PurgeComponentRequest.id:type_name -> v1.UUID
-	12,  // 86: v1.PatchComponentRequest.id:type_name -> v1.UUID
-	17,  // 87: v1.PatchComponentRequest.position:type_name -> v1.RackPosition
-	12,  // 88: v1.PatchComponentRequest.rack_id:type_name -> v1.UUID
-	16,  // 89: v1.PatchComponentRequest.bmcs:type_name -> v1.BMCInfo
-	18,  // 90: v1.PatchComponentResponse.component:type_name -> v1.Component
-	12,  // 91: v1.SubmitTaskResponse.task_ids:type_name -> v1.UUID
+	20,  // 76: v1.ComponentDiff.expected:type_name -> v1.Component
+	20,  // 77: v1.ComponentDiff.actual:type_name -> v1.Component
+	61,  // 78: v1.ComponentDiff.field_diffs:type_name -> v1.FieldDiff
+	14,  // 79: v1.ComponentDiff.id:type_name -> v1.UUID
+	20,  // 80: v1.AddComponentRequest.component:type_name -> v1.Component
+	20,  // 81: v1.AddComponentResponse.component:type_name -> v1.Component
+	14,  // 82: v1.DeleteComponentRequest.id:type_name -> v1.UUID
+	14,  // 83: v1.DeleteRackRequest.id:type_name -> v1.UUID
+	14,  // 84: v1.PurgeRackRequest.id:type_name -> v1.UUID
+	14,  // 85: v1.PurgeComponentRequest.id:type_name -> v1.UUID
+	14,  // 86: v1.PatchComponentRequest.id:type_name -> v1.UUID
+	19,  // 87: v1.PatchComponentRequest.position:type_name -> v1.RackPosition
+	14,  // 88: v1.PatchComponentRequest.rack_id:type_name -> v1.UUID
+	18,  // 89: v1.PatchComponentRequest.bmcs:type_name -> v1.BMCInfo
+	20,  // 90: v1.PatchComponentResponse.component:type_name -> v1.Component
+	14,  // 91: v1.SubmitTaskResponse.task_ids:type_name -> v1.UUID
 	10,  // 92: v1.QueueOptions.conflict_strategy:type_name -> v1.ConflictStrategy
-	21,  // 93: v1.PowerOnRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	73,  // 94: v1.PowerOnRackRequest.queue_options:type_name -> v1.QueueOptions
-	21,  // 95: v1.PowerOffRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	73,  // 96: v1.PowerOffRackRequest.queue_options:type_name -> v1.QueueOptions
-	21,  // 97: v1.PowerResetRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	73,  // 98: v1.PowerResetRackRequest.queue_options:type_name -> v1.QueueOptions
-	21,  // 99: v1.BringUpRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	21,  // 100: v1.IngestRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	30,  // 101: v1.IngestRackRequest.filters:type_name -> v1.Filter
-	12,  // 102: v1.ListTasksRequest.rack_id:type_name -> v1.UUID
-	28,  // 103: v1.ListTasksRequest.pagination:type_name -> v1.Pagination
-	32,  // 104: v1.ListTasksResponse.tasks:type_name -> v1.Task
-	12,  // 105: v1.GetTasksByIDsRequest.task_ids:type_name -> v1.UUID
-	32,  // 106: v1.GetTasksByIDsResponse.tasks:type_name -> v1.Task
-	12,  // 107: v1.CancelTaskRequest.task_id:type_name -> v1.UUID
-	32,  // 108: v1.CancelTaskResponse.task:type_name -> v1.Task
-	12,  // 109: v1.OperationRule.id:type_name -> v1.UUID
-	11,  // 110: v1.OperationRule.operation_type:type_name -> v1.OperationType
-	103, // 111: v1.OperationRule.created_at:type_name -> google.protobuf.Timestamp
-	103, // 112: v1.OperationRule.updated_at:type_name -> google.protobuf.Timestamp
-	11,  // 113: v1.CreateOperationRuleRequest.operation_type:type_name -> v1.OperationType
-	12,  // 114: v1.CreateOperationRuleResponse.id:type_name -> v1.UUID
-	12,  // 115: v1.UpdateOperationRuleRequest.rule_id:type_name -> v1.UUID
-	12,  // 116: v1.DeleteOperationRuleRequest.rule_id:type_name -> v1.UUID
-	12,  // 117: v1.SetRuleAsDefaultRequest.rule_id:type_name -> v1.UUID
-	12,  // 118: v1.GetOperationRuleRequest.rule_id:type_name -> v1.UUID
-	11,  // 119: v1.ListOperationRulesRequest.operation_type:type_name -> v1.OperationType
-	87,  // 120: v1.ListOperationRulesResponse.rules:type_name -> v1.OperationRule
-	12,  // 121: v1.AssociateRuleWithRackRequest.rack_id:type_name -> v1.UUID
-	12,  // 122: v1.AssociateRuleWithRackRequest.rule_id:type_name -> v1.UUID
-	12,  // 123: v1.DisassociateRuleFromRackRequest.rack_id:type_name -> v1.UUID
-	11,  // 124: v1.DisassociateRuleFromRackRequest.operation_type:type_name -> v1.OperationType
-	12,  // 125: v1.GetRackRuleAssociationRequest.rack_id:type_name -> v1.UUID
-	11,  // 126: v1.GetRackRuleAssociationRequest.operation_type:type_name -> v1.OperationType
-	12,  // 127: v1.GetRackRuleAssociationResponse.rule_id:type_name -> v1.UUID
-	12,  // 128: v1.ListRackRuleAssociationsRequest.rack_id:type_name -> v1.UUID
-	12,  // 129: v1.RackRuleAssociation.rack_id:type_name -> v1.UUID
-	11,  // 130: v1.RackRuleAssociation.operation_type:type_name -> v1.OperationType
-	12,  // 131: v1.RackRuleAssociation.rule_id:type_name -> v1.UUID
-	103, // 132: v1.RackRuleAssociation.created_at:type_name -> google.protobuf.Timestamp
-	103, // 133: v1.RackRuleAssociation.updated_at:type_name -> google.protobuf.Timestamp
-	101, // 134: v1.ListRackRuleAssociationsResponse.associations:type_name -> v1.RackRuleAssociation
-	85,  // 135: v1.RLA.Version:input_type -> v1.VersionRequest
-	33,  // 136: v1.RLA.CreateExpectedRack:input_type -> v1.CreateExpectedRackRequest
-	35,  // 137: v1.RLA.GetRackInfoByID:input_type -> v1.GetRackInfoByIDRequest
-	36,  // 138: v1.RLA.GetRackInfoBySerial:input_type -> v1.GetRackInfoBySerialRequest
-	43,  // 139: v1.RLA.GetListOfRacks:input_type -> v1.GetListOfRacksRequest
-	38,  // 140: v1.RLA.PatchRack:input_type -> v1.PatchRackRequest
-	64,  // 141: v1.RLA.DeleteRack:input_type -> v1.DeleteRackRequest
-	66,  // 142: v1.RLA.PurgeRack:input_type -> v1.PurgeRackRequest
-	53,  // 143: v1.RLA.UpgradeFirmware:input_type -> v1.UpgradeFirmwareRequest
-	77,  // 144: v1.RLA.BringUpRack:input_type -> v1.BringUpRackRequest
-	78,  // 145: v1.RLA.IngestRack:input_type -> v1.IngestRackRequest
-	74,  // 146: v1.RLA.PowerOnRack:input_type -> v1.PowerOnRackRequest
-	75,  // 147: v1.RLA.PowerOffRack:input_type -> v1.PowerOffRackRequest
-	76,  // 148: v1.RLA.PowerResetRack:input_type -> v1.PowerResetRackRequest
-	40,  // 149: v1.RLA.GetComponentInfoByID:input_type -> v1.GetComponentInfoByIDRequest
-	41,  // 150: v1.RLA.GetComponentInfoBySerial:input_type -> v1.GetComponentInfoBySerialRequest
-	54,  // 151: v1.RLA.GetComponents:input_type -> v1.GetComponentsRequest
-	56,  // 152: v1.RLA.ValidateComponents:input_type -> v1.ValidateComponentsRequest
-	60,  // 153: v1.RLA.AddComponent:input_type -> v1.AddComponentRequest
-	70,  // 154: v1.RLA.PatchComponent:input_type -> v1.PatchComponentRequest
-	62,  // 155: v1.RLA.DeleteComponent:input_type -> v1.DeleteComponentRequest
-	68,  // 156: v1.RLA.PurgeComponent:input_type -> v1.PurgeComponentRequest
-	45,  // 157: v1.RLA.CreateNVLDomain:input_type -> v1.CreateNVLDomainRequest
-	47,  // 158: v1.RLA.AttachRacksToNVLDomain:input_type -> v1.AttachRacksToNVLDomainRequest
-	48,  // 159: v1.RLA.DetachRacksFromNVLDomain:input_type -> v1.DetachRacksFromNVLDomainRequest
-	49,  // 160: v1.RLA.GetListOfNVLDomains:input_type -> v1.GetListOfNVLDomainsRequest
-	51,  // 161: v1.RLA.GetRacksForNVLDomain:input_type -> v1.GetRacksForNVLDomainRequest
-	79,  // 162: v1.RLA.ListTasks:input_type -> v1.ListTasksRequest
-	81,  // 163: v1.RLA.GetTasksByIDs:input_type -> v1.GetTasksByIDsRequest
-	83,  // 164: v1.RLA.CancelTask:input_type -> v1.CancelTaskRequest
-	88,  // 165: v1.RLA.CreateOperationRule:input_type -> v1.CreateOperationRuleRequest
-	90,  // 166: v1.RLA.UpdateOperationRule:input_type -> v1.UpdateOperationRuleRequest
-	91,  // 167: v1.RLA.DeleteOperationRule:input_type -> v1.DeleteOperationRuleRequest
-	93,  // 168: v1.RLA.GetOperationRule:input_type -> v1.GetOperationRuleRequest
-	94,  // 169: v1.RLA.ListOperationRules:input_type -> v1.ListOperationRulesRequest
-	92,  // 170: v1.RLA.SetRuleAsDefault:input_type -> v1.SetRuleAsDefaultRequest
-	96,  // 171: v1.RLA.AssociateRuleWithRack:input_type -> v1.AssociateRuleWithRackRequest
-	97,  // 172: v1.RLA.DisassociateRuleFromRack:input_type -> v1.DisassociateRuleFromRackRequest
-	98,  // 173: v1.RLA.GetRackRuleAssociation:input_type -> v1.GetRackRuleAssociationRequest
-	100, // 174: v1.RLA.ListRackRuleAssociations:input_type -> v1.ListRackRuleAssociationsRequest
-	86,  // 175: v1.RLA.Version:output_type -> v1.BuildInfo
-	34,  // 176: v1.RLA.CreateExpectedRack:output_type -> v1.CreateExpectedRackResponse
-	37,  // 177: v1.RLA.GetRackInfoByID:output_type -> v1.GetRackInfoResponse
-	37,  // 178: v1.RLA.GetRackInfoBySerial:output_type -> v1.GetRackInfoResponse
-	44,  // 179: v1.RLA.GetListOfRacks:output_type -> v1.GetListOfRacksResponse
-	39,  // 180: v1.RLA.PatchRack:output_type -> v1.PatchRackResponse
-	65,  // 181: v1.RLA.DeleteRack:output_type -> v1.DeleteRackResponse
-	67,  // 182: v1.RLA.PurgeRack:output_type -> v1.PurgeRackResponse
-	72,  // 183: v1.RLA.UpgradeFirmware:output_type -> v1.SubmitTaskResponse
-	72,  // 184: v1.RLA.BringUpRack:output_type -> v1.SubmitTaskResponse
-	72,  // 185: v1.RLA.IngestRack:output_type -> v1.SubmitTaskResponse
-	72,  // 186: v1.RLA.PowerOnRack:output_type -> v1.SubmitTaskResponse
-	72,  // 187: v1.RLA.PowerOffRack:output_type -> v1.SubmitTaskResponse
-	72,  // 188: v1.RLA.PowerResetRack:output_type -> v1.SubmitTaskResponse
-	42,  // 189: v1.RLA.GetComponentInfoByID:output_type -> v1.GetComponentInfoResponse
-	42,  // 190: v1.RLA.GetComponentInfoBySerial:output_type -> v1.GetComponentInfoResponse
-	55,  // 191: v1.RLA.GetComponents:output_type -> v1.GetComponentsResponse
-	57,  // 192: v1.RLA.ValidateComponents:output_type -> v1.ValidateComponentsResponse
-	61,  // 193: v1.RLA.AddComponent:output_type -> v1.AddComponentResponse
-	71,  // 194: v1.RLA.PatchComponent:output_type -> v1.PatchComponentResponse
-	63,  // 195: v1.RLA.DeleteComponent:output_type -> v1.DeleteComponentResponse
-	69,  // 196: v1.RLA.PurgeComponent:output_type -> v1.PurgeComponentResponse
-	46,  // 197: v1.RLA.CreateNVLDomain:output_type -> v1.CreateNVLDomainResponse
-	104, // 198: v1.RLA.AttachRacksToNVLDomain:output_type -> google.protobuf.Empty
-	104, // 199: v1.RLA.DetachRacksFromNVLDomain:output_type -> google.protobuf.Empty
-	50,  // 200: v1.RLA.GetListOfNVLDomains:output_type -> v1.GetListOfNVLDomainsResponse
-	52,  // 201: v1.RLA.GetRacksForNVLDomain:output_type -> v1.GetRacksForNVLDomainResponse
-	80,  // 202: v1.RLA.ListTasks:output_type -> v1.ListTasksResponse
-	82,  // 203: v1.RLA.GetTasksByIDs:output_type -> v1.GetTasksByIDsResponse
-	84,  // 204: v1.RLA.CancelTask:output_type -> v1.CancelTaskResponse
-	89,  // 205: v1.RLA.CreateOperationRule:output_type -> v1.CreateOperationRuleResponse
-	104, // 206: v1.RLA.UpdateOperationRule:output_type -> google.protobuf.Empty
-	104, // 207: v1.RLA.DeleteOperationRule:output_type -> google.protobuf.Empty
-	87,  // 208: v1.RLA.GetOperationRule:output_type -> v1.OperationRule
-	95,  // 209: v1.RLA.ListOperationRules:output_type -> v1.ListOperationRulesResponse
-	104, // 210: v1.RLA.SetRuleAsDefault:output_type -> google.protobuf.Empty
-	104, // 211: v1.RLA.AssociateRuleWithRack:output_type -> google.protobuf.Empty
-	104, // 212: v1.RLA.DisassociateRuleFromRack:output_type -> google.protobuf.Empty
-	99,  // 213: v1.RLA.GetRackRuleAssociation:output_type -> v1.GetRackRuleAssociationResponse
-	102, // 214: v1.RLA.ListRackRuleAssociations:output_type -> v1.ListRackRuleAssociationsResponse
-	175, // [175:215] is the sub-list for method output_type
-	135, // [135:175] is the sub-list for method input_type
-	135, // [135:135] is the sub-list for extension type_name
-	135, // [135:135] is the sub-list for extension extendee
-	0,   // [0:135] is the sub-list for field type_name
+	23,  // 93: v1.PowerOnRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	75,  // 94: v1.PowerOnRackRequest.queue_options:type_name -> v1.QueueOptions
+	23,  // 95: v1.PowerOffRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	75,  // 96: v1.PowerOffRackRequest.queue_options:type_name -> v1.QueueOptions
+	23,  // 97: v1.PowerResetRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	75,  // 98: v1.PowerResetRackRequest.queue_options:type_name -> v1.QueueOptions
+	23,  // 99: v1.BringUpRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	23,  // 100: v1.IngestRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	32,  // 101: v1.IngestRackRequest.filters:type_name -> v1.Filter
+	14,  // 102: v1.ListTasksRequest.rack_id:type_name -> v1.UUID
+	30,  // 103: v1.ListTasksRequest.pagination:type_name -> v1.Pagination
+	34,  // 104: v1.ListTasksResponse.tasks:type_name -> v1.Task
+	14,  // 105: v1.GetTasksByIDsRequest.task_ids:type_name -> v1.UUID
+	34,  // 106: v1.GetTasksByIDsResponse.tasks:type_name -> v1.Task
+	14,  // 107: v1.CancelTaskRequest.task_id:type_name -> v1.UUID
+	34,  // 108: v1.CancelTaskResponse.task:type_name -> v1.Task
+	108, // 109: v1.TaskReportEntry.time:type_name -> google.protobuf.Timestamp
+	1,   // 110: v1.TaskReportEntry.component_type:type_name -> v1.ComponentType
+	11,  // 111: v1.TaskReportEntry.outcome:type_name -> v1.TaskReportOutcome
+	14,  // 112: v1.GetTaskReportRequest.task_id:type_name -> v1.UUID
+	12,  // 113: v1.GetTaskReportRequest.format:type_name -> v1.TaskReportFormat
+	34,  // 114: v1.GetTaskReportResponse.task:type_name -> v1.Task
+	87,  // 115: v1.GetTaskReportResponse.entries:type_name -> v1.TaskReportEntry
+	14,  // 116: v1.OperationRule.id:type_name -> v1.UUID
+	13,  // 117: v1.OperationRule.operation_type:type_name -> v1.OperationType
+	108, // 118: v1.OperationRule.created_at:type_name -> google.protobuf.Timestamp
+	108, // 119: v1.OperationRule.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 120: v1.CreateOperationRuleRequest.operation_type:type_name -> v1.OperationType
+	14,  // 121: v1.CreateOperationRuleResponse.id:type_name -> v1.UUID
+	14,  // 122: v1.UpdateOperationRuleRequest.rule_id:type_name -> v1.UUID
+	14,  // 123: v1.DeleteOperationRuleRequest.rule_id:type_name -> v1.UUID
+	14,  // 124: v1.SetRuleAsDefaultRequest.rule_id:type_name -> v1.UUID
+	14,  // 125: v1.GetOperationRuleRequest.rule_id:type_name -> v1.UUID
+	13,  // 126: v1.ListOperationRulesRequest.operation_type:type_name -> v1.OperationType
+	92,  // 127: v1.ListOperationRulesResponse.rules:type_name -> v1.OperationRule
+	14,  // 128: v1.AssociateRuleWithRackRequest.rack_id:type_name -> v1.UUID
+	14,  // 129: v1.AssociateRuleWithRackRequest.rule_id:type_name -> v1.UUID
+	14,  // 130: v1.DisassociateRuleFromRackRequest.rack_id:type_name -> v1.UUID
+	13,  // 131: v1.DisassociateRuleFromRackRequest.operation_type:type_name -> v1.OperationType
+	14,  // 132: v1.GetRackRuleAssociationRequest.rack_id:type_name -> v1.UUID
+	13,  // 133: v1.GetRackRuleAssociationRequest.operation_type:type_name -> v1.OperationType
+	14,  // 134: v1.GetRackRuleAssociationResponse.rule_id:type_name -> v1.UUID
+	14,  // 135: v1.ListRackRuleAssociationsRequest.rack_id:type_name -> v1.UUID
+	14,  // 136: v1.RackRuleAssociation.rack_id:type_name -> v1.UUID
+	13,  // 137: v1.RackRuleAssociation.operation_type:type_name -> v1.OperationType
+	14,  // 138: v1.RackRuleAssociation.rule_id:type_name -> v1.UUID
+	108, // 139: v1.RackRuleAssociation.created_at:type_name -> google.protobuf.Timestamp
+	108, // 140: v1.RackRuleAssociation.updated_at:type_name -> google.protobuf.Timestamp
+	106, // 141: v1.ListRackRuleAssociationsResponse.associations:type_name -> v1.RackRuleAssociation
+	90,  // 142: v1.RLA.Version:input_type -> v1.VersionRequest
+	35,  // 143: v1.RLA.CreateExpectedRack:input_type -> v1.CreateExpectedRackRequest
+	37,  // 144: v1.RLA.GetRackInfoByID:input_type -> v1.GetRackInfoByIDRequest
+	38,  // 145: v1.RLA.GetRackInfoBySerial:input_type -> v1.GetRackInfoBySerialRequest
+	45,  // 146: v1.RLA.GetListOfRacks:input_type -> v1.GetListOfRacksRequest
+	40,  // 147: v1.RLA.PatchRack:input_type -> v1.PatchRackRequest
+	66,  // 148: v1.RLA.DeleteRack:input_type -> v1.DeleteRackRequest
+	68,  // 149: v1.RLA.PurgeRack:input_type -> v1.PurgeRackRequest
+	55,  // 150: v1.RLA.UpgradeFirmware:input_type -> v1.UpgradeFirmwareRequest
+	79,  // 151: v1.RLA.BringUpRack:input_type -> v1.BringUpRackRequest
+	80,  // 152: v1.RLA.IngestRack:input_type -> v1.IngestRackRequest
+	76,  // 153: v1.RLA.PowerOnRack:input_type -> v1.PowerOnRackRequest
+	77,  // 154: v1.RLA.PowerOffRack:input_type -> v1.PowerOffRackRequest
+	78,  // 155: v1.RLA.PowerResetRack:input_type -> v1.PowerResetRackRequest
+	42,  // 156: v1.RLA.GetComponentInfoByID:input_type -> v1.GetComponentInfoByIDRequest
+	43,  // 157: v1.RLA.GetComponentInfoBySerial:input_type -> v1.GetComponentInfoBySerialRequest
+	56,  // 158: v1.RLA.GetComponents:input_type -> v1.GetComponentsRequest
+	58,  // 159: v1.RLA.ValidateComponents:input_type -> v1.ValidateComponentsRequest
+	62,  // 160: v1.RLA.AddComponent:input_type -> v1.AddComponentRequest
+	72,  // 161: v1.RLA.PatchComponent:input_type -> v1.PatchComponentRequest
+	64,  // 162: v1.RLA.DeleteComponent:input_type -> v1.DeleteComponentRequest
+	70,  // 163: v1.RLA.PurgeComponent:input_type -> v1.PurgeComponentRequest
+	47,  // 164: v1.RLA.CreateNVLDomain:input_type -> v1.CreateNVLDomainRequest
+	49,  // 165: v1.RLA.AttachRacksToNVLDomain:input_type -> v1.AttachRacksToNVLDomainRequest
+	50,  // 166: v1.RLA.DetachRacksFromNVLDomain:input_type -> v1.DetachRacksFromNVLDomainRequest
+	51,  // 167: v1.RLA.GetListOfNVLDomains:input_type -> v1.GetListOfNVLDomainsRequest
+	53,  // 168: v1.RLA.GetRacksForNVLDomain:input_type -> v1.GetRacksForNVLDomainRequest
+	81,  // 169: v1.RLA.ListTasks:input_type -> v1.ListTasksRequest
+	83,  // 170: v1.RLA.GetTasksByIDs:input_type -> v1.GetTasksByIDsRequest
+	85,  // 171: v1.RLA.CancelTask:input_type -> v1.CancelTaskRequest
+	88,  // 172: v1.RLA.GetTaskReport:input_type -> v1.GetTaskReportRequest
+	93,  // 173: v1.RLA.CreateOperationRule:input_type -> v1.CreateOperationRuleRequest
+	95,  // 174: v1.RLA.UpdateOperationRule:input_type -> v1.UpdateOperationRuleRequest
+	96,  // 175: v1.RLA.DeleteOperationRule:input_type -> v1.DeleteOperationRuleRequest
+	98,  // 176: v1.RLA.GetOperationRule:input_type -> v1.GetOperationRuleRequest
+	99,  // 177: v1.RLA.ListOperationRules:input_type -> v1.ListOperationRulesRequest
+	97,  // 178: v1.RLA.SetRuleAsDefault:input_type -> v1.SetRuleAsDefaultRequest
+	101, // 179: v1.RLA.AssociateRuleWithRack:input_type -> v1.AssociateRuleWithRackRequest
+	102, // 180: v1.RLA.DisassociateRuleFromRack:input_type -> v1.DisassociateRuleFromRackRequest
+	103, // 181: v1.RLA.GetRackRuleAssociation:input_type -> v1.GetRackRuleAssociationRequest
+	105, // 182: v1.RLA.ListRackRuleAssociations:input_type -> v1.ListRackRuleAssociationsRequest
+	91,  // 183: v1.RLA.Version:output_type -> v1.BuildInfo
+	36,  // 184: v1.RLA.CreateExpectedRack:output_type -> v1.CreateExpectedRackResponse
+	39,  // 185: v1.RLA.GetRackInfoByID:output_type -> v1.GetRackInfoResponse
+	39,  // 186: v1.RLA.GetRackInfoBySerial:output_type -> v1.GetRackInfoResponse
+	46,  // 187: v1.RLA.GetListOfRacks:output_type -> v1.GetListOfRacksResponse
+	41,  // 188: v1.RLA.PatchRack:output_type -> v1.PatchRackResponse
+	67,  // 189: v1.RLA.DeleteRack:output_type -> v1.DeleteRackResponse
+	69,  // 190: v1.RLA.PurgeRack:output_type -> v1.PurgeRackResponse
+	74,  // 191: v1.RLA.UpgradeFirmware:output_type -> v1.SubmitTaskResponse
+	74,  // 192: v1.RLA.BringUpRack:output_type -> v1.SubmitTaskResponse
+	74,  // 193: v1.RLA.IngestRack:output_type -> v1.SubmitTaskResponse
+	74,  // 194: v1.RLA.PowerOnRack:output_type -> v1.SubmitTaskResponse
+	74,  // 195: v1.RLA.PowerOffRack:output_type -> v1.SubmitTaskResponse
+	74,  // 196: v1.RLA.PowerResetRack:output_type -> v1.SubmitTaskResponse
+	44,  // 197: v1.RLA.GetComponentInfoByID:output_type -> v1.GetComponentInfoResponse
+	44,  // 198: v1.RLA.GetComponentInfoBySerial:output_type -> v1.GetComponentInfoResponse
+	57,  // 199: v1.RLA.GetComponents:output_type -> v1.GetComponentsResponse
+	59,  // 200: v1.RLA.ValidateComponents:output_type -> v1.ValidateComponentsResponse
+	63,  // 201: v1.RLA.AddComponent:output_type -> v1.AddComponentResponse
+	73,  // 202: v1.RLA.PatchComponent:output_type -> v1.PatchComponentResponse
+	65,  // 203: v1.RLA.DeleteComponent:output_type -> v1.DeleteComponentResponse
+	71,  // 204: v1.RLA.PurgeComponent:output_type -> v1.PurgeComponentResponse
+	48,  // 205: v1.RLA.CreateNVLDomain:output_type -> v1.CreateNVLDomainResponse
+	109, // 206: v1.RLA.AttachRacksToNVLDomain:output_type -> google.protobuf.Empty
+	109, // 207: v1.RLA.DetachRacksFromNVLDomain:output_type -> google.protobuf.Empty
+	52,  // 208: v1.RLA.GetListOfNVLDomains:output_type -> v1.GetListOfNVLDomainsResponse
+	54,  // 209: v1.RLA.GetRacksForNVLDomain:output_type -> v1.GetRacksForNVLDomainResponse
+	82,  // 210: v1.RLA.ListTasks:output_type -> v1.ListTasksResponse
+	84,  // 211: v1.RLA.GetTasksByIDs:output_type -> v1.GetTasksByIDsResponse
+	86,  // 212: v1.RLA.CancelTask:output_type -> v1.CancelTaskResponse
+	89,  // 213: v1.RLA.GetTaskReport:output_type -> v1.GetTaskReportResponse
+	94,  // 214: v1.RLA.CreateOperationRule:output_type -> v1.CreateOperationRuleResponse
+	109, // 215: v1.RLA.UpdateOperationRule:output_type -> google.protobuf.Empty
+	109, // 216: v1.RLA.DeleteOperationRule:output_type -> google.protobuf.Empty
+	92,  // 217: v1.RLA.GetOperationRule:output_type -> v1.OperationRule
+	100, // 218: v1.RLA.ListOperationRules:output_type -> v1.ListOperationRulesResponse
+	109, // 219: v1.RLA.SetRuleAsDefault:output_type -> google.protobuf.Empty
+	109, // 220: v1.RLA.AssociateRuleWithRack:output_type -> google.protobuf.Empty
+	109, // 221: v1.RLA.DisassociateRuleFromRack:output_type -> google.protobuf.Empty
+	104, // 222: v1.RLA.GetRackRuleAssociation:output_type -> v1.GetRackRuleAssociationResponse
+	107, // 223: v1.RLA.ListRackRuleAssociations:output_type -> v1.ListRackRuleAssociationsResponse
+	183, // [183:224] is the sub-list for method output_type
+	142, // [142:183] is the sub-list for method input_type
+	142, // [142:142] is the sub-list for extension type_name
+	142, // [142:142] is the sub-list for extension extendee
+	0,   // [0:142] is the sub-list for field type_name
 }
 
 func init() { file_rla_proto_init() }
@@ -6872,15 +7229,15 @@ func file_rla_proto_init() {
 	file_rla_proto_msgTypes[63].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[64].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[67].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[78].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[82].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[81].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[85].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rla_proto_rawDesc), len(file_rla_proto_rawDesc)),
-			NumEnums:      12,
-			NumMessages:   91,
+			NumEnums:      14,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -66,6 +66,7 @@ const (
 	RLA_ListTasks_FullMethodName                = "/v1.RLA/ListTasks"
 	RLA_GetTasksByIDs_FullMethodName            = "/v1.RLA/GetTasksByIDs"
 	RLA_CancelTask_FullMethodName               = "/v1.RLA/CancelTask"
+	RLA_GetTaskReport_FullMethodName            = "/v1.RLA/GetTaskReport"
 	RLA_CreateOperationRule_FullMethodName      = "/v1.RLA/CreateOperationRule"
 	RLA_UpdateOperationRule_FullMethodName      = "/v1.RLA/UpdateOperationRule"
 	RLA_DeleteOperationRule_FullMethodName      = "/v1.RLA/DeleteOperationRule"
@@ -118,6 +119,7 @@ type RLAClient interface {
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	GetTasksByIDs(ctx context.Context, in *GetTasksByIDsRequest, opts ...grpc.CallOption) (*GetTasksByIDsResponse, error)
 	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*CancelTaskResponse, error)
+	GetTaskReport(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*GetTaskReportResponse, error)
 	// Operation rules
 	CreateOperationRule(ctx context.Context, in *CreateOperationRuleRequest, opts ...grpc.CallOption) (*CreateOperationRuleResponse, error)
 	UpdateOperationRule(ctx context.Context, in *UpdateOperationRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -440,6 +442,16 @@ func (c *rLAClient) CancelTask(ctx context.Context, in *CancelTaskRequest, opts
 	return out, nil
 }
 
+func (c *rLAClient) GetTaskReport(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*GetTaskReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskReportResponse)
+	err := c.cc.Invoke(ctx, RLA_GetTaskReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rLAClient) CreateOperationRule(ctx context.Context, in *CreateOperationRuleRequest, opts ...grpc.CallOption) (*CreateOperationRuleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateOperationRuleResponse)
@@ -580,6 +592,7 @@ type RLAServer interface {
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	GetTasksByIDs(context.Context, *GetTasksByIDsRequest) (*GetTasksByIDsResponse, error)
 	CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error)
+	GetTaskReport(context.Context, *GetTaskReportRequest) (*GetTaskReportResponse, error)
 	// Operation rules
 	CreateOperationRule(context.Context, *CreateOperationRuleRequest) (*CreateOperationRuleResponse, error)
 	UpdateOperationRule(context.Context, *UpdateOperationRuleRequest) (*emptypb.Empty, error)
@@ -691,6 +704,9 @@ func (UnimplementedRLAServer) GetTasksByIDs(context.Context, *GetTasksByIDsReque
 func (UnimplementedRLAServer) CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelTask not implemented")
 }
+func (UnimplementedRLAServer) GetTaskReport(context.Context, *GetTaskReportRequest) (*GetTaskReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaskReport not implemented")
+}
 func (UnimplementedRLAServer) CreateOperationRule(context.Context, *CreateOperationRuleRequest) (*CreateOperationRuleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateOperationRule not implemented")
 }
@@ -1281,6 +1297,24 @@ func _RLA_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RLA_GetTaskReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetTaskReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetTaskReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetTaskReport(ctx, req.(*GetTaskReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RLA_CreateOperationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOperationRuleRequest)
 	if err := dec(in); err != nil {
@@ -1588,6 +1622,10 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTask",
 			Handler:    _RLA_CancelTask_Handler,
 		},
+		{
+			MethodName: "GetTaskReport",
+			Handler:    _RLA_GetTaskReport_Handler,
+		},
 		{
 			MethodName: "CreateOperationRule",
 			Handler:    _RLA_CreateOperationRule_Handler,
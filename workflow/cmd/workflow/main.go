@@ -71,6 +71,9 @@ import (
 	siteActivity "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/activity/site"
 	siteWorkflow "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/workflow/site"
 
+	ipUsageActivity "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/activity/ipusage"
+	ipUsageWorkflow "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/workflow/ipusage"
+
 	sshKeyGroupActivity "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/activity/sshkeygroup"
 	sshKeyGroupWorkflow "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/workflow/sshkeygroup"
 
@@ -262,6 +265,9 @@ func main() {
 		w.RegisterWorkflow(siteWorkflow.MonitorTemporalCertExpirationForAllSites)
 		w.RegisterWorkflow(siteWorkflow.MonitorSiteTemporalNamespaces)
 
+		// IP usage workflows
+		w.RegisterWorkflow(ipUsageWorkflow.RecordIPUsage)
+
 		// SSHKeyGroup workflows
 		w.RegisterWorkflow(sshKeyGroupWorkflow.SyncSSHKeyGroup)
 		w.RegisterWorkflow(sshKeyGroupWorkflow.DeleteSSHKeyGroup)
@@ -345,6 +351,9 @@ func main() {
 	siteManager := siteActivity.NewManageSite(dbSession, siteClientPool, tc, cfg)
 	w.RegisterActivity(&siteManager)
 
+	ipUsageManager := ipUsageActivity.NewManageIPUsage(dbSession, cfg)
+	w.RegisterActivity(&ipUsageManager)
+
 	sshKeyGroupManager := sshKeyGroupActivity.NewManageSSHKeyGroup(dbSession, siteClientPool)
 	w.RegisterActivity(&sshKeyGroupManager)
 
@@ -479,6 +488,12 @@ func main() {
 		if err != nil {
 			log.Error().Err(err).Msg("failed to trigger Monitor Site Temporal Namespaces workflow")
 		}
+
+		// Trigger RecordIPUsage
+		_, err = ipUsageWorkflow.ExecuteRecordIPUsageWorkflow(ctx, tc)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to trigger Record IP Usage workflow")
+		}
 	}
 
 	// NOTE: Log messages past this point do not show up in the log output
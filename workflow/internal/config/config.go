@@ -100,6 +100,13 @@ const (
 	// ConfigNotificationsPagerDutyIntegrationKeyPath specifies file path to read PagerDuty integration key
 	ConfigNotificationsPagerDutyIntegrationKeyPath = "notifications.pagerduty.integrationKeyPath"
 
+	// ConfigNotificationsIPUsageWarningThreshold specifies the utilization percentage at which IP usage warnings are sent
+	ConfigNotificationsIPUsageWarningThreshold = "notifications.ipUsage.warningThreshold"
+	// ConfigNotificationsIPUsageCriticalThreshold specifies the utilization percentage at which critical IP usage warnings are sent
+	ConfigNotificationsIPUsageCriticalThreshold = "notifications.ipUsage.criticalThreshold"
+	// ConfigNotificationsIPUsageExhaustionWarningDays specifies how many days ahead of projected exhaustion IP usage warnings are sent
+	ConfigNotificationsIPUsageExhaustionWarningDays = "notifications.ipUsage.exhaustionWarningDays"
+
 	// ConfigSiteManagerEndpoint is the service endpoint for site manager
 	ConfigSiteManagerEndpoint = "siteManager.svcEndpoint"
 
@@ -167,6 +174,10 @@ func NewConfig() *Config {
 
 	c.v.SetDefault(ConfigTracingEnabled, false)
 
+	c.v.SetDefault(ConfigNotificationsIPUsageWarningThreshold, 80)
+	c.v.SetDefault(ConfigNotificationsIPUsageCriticalThreshold, 95)
+	c.v.SetDefault(ConfigNotificationsIPUsageExhaustionWarningDays, 14)
+
 	c.v.AutomaticEnv()
 	c.v.SetConfigFile(c.GetPathToConfig())
 
@@ -549,6 +560,21 @@ func (c *Config) GetNotificationsPagerDutyIntegrationKeyPath() string {
 	return c.v.GetString(ConfigNotificationsPagerDutyIntegrationKeyPath)
 }
 
+// GetNotificationsIPUsageWarningThreshold gets the utilization percentage at which IP usage warnings are sent
+func (c *Config) GetNotificationsIPUsageWarningThreshold() float64 {
+	return c.v.GetFloat64(ConfigNotificationsIPUsageWarningThreshold)
+}
+
+// GetNotificationsIPUsageCriticalThreshold gets the utilization percentage at which critical IP usage warnings are sent
+func (c *Config) GetNotificationsIPUsageCriticalThreshold() float64 {
+	return c.v.GetFloat64(ConfigNotificationsIPUsageCriticalThreshold)
+}
+
+// GetNotificationsIPUsageExhaustionWarningDays gets how many days ahead of projected exhaustion IP usage warnings are sent
+func (c *Config) GetNotificationsIPUsageExhaustionWarningDays() int {
+	return c.v.GetInt(ConfigNotificationsIPUsageExhaustionWarningDays)
+}
+
 // SetSiteManagerEndpoint sets the endpoint
 func (c *Config) SetSiteManagerEndpoint(value string) {
 	c.v.Set(ConfigSiteManagerEndpoint, value)
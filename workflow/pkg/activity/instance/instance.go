@@ -20,6 +20,7 @@ package instance
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -27,6 +28,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
+	temporalEnums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 
 	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
@@ -47,6 +50,10 @@ import (
 	cwutil "github.com/NVIDIA/ncx-infra-controller-rest/common/pkg/util"
 )
 
+// FloatingIPDetachedTimeout is how long a Floating IP stays Detached waiting for the replacement of its deleted Instance,
+// after which it is released and becomes Available
+const FloatingIPDetachedTimeout = 24 * time.Hour
+
 // ManageInstance is an activity wrapper for managing Instance lifecycle that allows
 // injecting DB access
 type ManageInstance struct {
@@ -142,6 +149,16 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 
 	sdDAO := cdbm.NewStatusDetailDAO(mi.dbSession)
 
+	// Floating IPs of deleted Instances waiting for their replacement Instance to become Ready, and Floating IPs being moved
+	// to a replacement Instance
+	fipDAO := cdbm.NewFloatingIPDAO(mi.dbSession)
+	movingFloatingIPs, _, err := fipDAO.GetAll(ctx, nil, cdbm.FloatingIPFilterInput{SiteIDs: []uuid.UUID{site.ID}, Statuses: []string{cdbm.FloatingIPStatusDetached, cdbm.FloatingIPStatusAssociating}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get Floating IPs being moved for Site from DB")
+		return nil, err
+	}
+	movingFloatingIPs = mi.releaseExpiredDetachedFloatingIPs(ctx, movingFloatingIPs, logger)
+
 	ethernetInterfacesToDelete := []*cdbm.Interface{}
 	infiniBandInterfacesToDelete := []*cdbm.InfiniBandInterface{}
 	nvLinkInterfacesToDelete := []*cdbm.NVLinkInterface{}
@@ -469,6 +486,16 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 			slogger.Error().Err(err).Msg("Site Controller Instance is missing Network Config and/or Status")
 		}
 
+		// Move Floating IPs of the Instance this Instance replaces once it is Ready
+		if len(movingFloatingIPs) > 0 && updatedInstanceStatus != nil && *updatedInstanceStatus == cdbm.InstanceStatusReady {
+			mi.completeFloatingIPMoves(ctx, tc, instance, movingFloatingIPs, slogger)
+
+			serr := mi.moveFloatingIPsToReplacementInstance(ctx, tc, instance, movingFloatingIPs, slogger)
+			if serr != nil {
+				slogger.Error().Err(serr).Msg("failed to move Floating IPs to replacement Instance")
+			}
+		}
+
 		// Populate a map of existing InfiniBand Interfaces by key
 		ibiDAO := cdbm.NewInfiniBandInterfaceDAO(mi.dbSession)
 		infiniBandInterfaces, _, serr := ibiDAO.GetAll(
@@ -1051,6 +1078,33 @@ func (mi ManageInstance) deleteInstanceFromDB(ctx context.Context, tx *cdb.Tx, i
 		}
 	}
 
+	// Detach Floating IPs associated with the instance. They keep their association so they can move to the replacement instance,
+	// only the Site interface they were programmed on is gone
+	fipDAO := cdbm.NewFloatingIPDAO(mi.dbSession)
+	fips, _, err := fipDAO.GetAll(ctx, tx, cdbm.FloatingIPFilterInput{InstanceIDs: []uuid.UUID{instance.ID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Floating IPs associated with instance from DB")
+		terr := tx.Rollback()
+		if terr != nil {
+			logger.Error().Err(terr).Msg("failed to rollback transaction")
+		}
+		return err
+	}
+	for _, fip := range fips {
+		_, serr := fipDAO.Clear(ctx, tx, cdbm.FloatingIPClearInput{FloatingIPID: fip.ID, ControllerInterfaceID: true, UpdatedByID: fip.UpdatedBy})
+		if serr == nil {
+			_, serr = fipDAO.Update(ctx, tx, cdbm.FloatingIPUpdateInput{FloatingIPID: fip.ID, Status: cdb.GetStrPtr(cdbm.FloatingIPStatusDetached), UpdatedByID: fip.UpdatedBy})
+		}
+		if serr != nil {
+			logger.Error().Err(serr).Msg("failed to detach Floating IP from instance in DB")
+			terr := tx.Rollback()
+			if terr != nil {
+				logger.Error().Err(terr).Msg("failed to rollback transaction")
+			}
+			return serr
+		}
+	}
+
 	// clear isAssigned on the machine
 	if instance.MachineID != nil {
 		serr := mi.clearMachineIsAssigned(ctx, tx, logger, *instance.MachineID)
@@ -1067,6 +1121,223 @@ func (mi ManageInstance) deleteInstanceFromDB(ctx context.Context, tx *cdb.Tx, i
 	return nil
 }
 
+// releaseExpiredDetachedFloatingIPs releases Floating IPs that have been Detached for longer than FloatingIPDetachedTimeout,
+// their deleted Instance was not replaced in time. The Floating IPs stay allocated to the Tenant and become Available.
+// Returns the Floating IPs that are still being moved.
+func (mi ManageInstance) releaseExpiredDetachedFloatingIPs(ctx context.Context, floatingIPs []cdbm.FloatingIP, logger zerolog.Logger) []cdbm.FloatingIP {
+	fipDAO := cdbm.NewFloatingIPDAO(mi.dbSession)
+
+	moving := []cdbm.FloatingIP{}
+	for _, fip := range floatingIPs {
+		if fip.Status != cdbm.FloatingIPStatusDetached || time.Since(fip.Updated) < FloatingIPDetachedTimeout {
+			moving = append(moving, fip)
+			continue
+		}
+
+		flogger := logger.With().Str("Floating IP ID", fip.ID.String()).Logger()
+
+		_, err := fipDAO.Clear(ctx, nil, cdbm.FloatingIPClearInput{FloatingIPID: fip.ID, InstanceID: true, InterfaceID: true, ControllerInterfaceID: true, UpdatedByID: fip.UpdatedBy})
+		if err == nil {
+			_, err = fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{FloatingIPID: fip.ID, Status: cdb.GetStrPtr(cdbm.FloatingIPStatusAvailable), UpdatedByID: fip.UpdatedBy})
+		}
+		if err != nil {
+			flogger.Error().Err(err).Msg("failed to release detached Floating IP in DB")
+			moving = append(moving, fip)
+			continue
+		}
+
+		flogger.Info().Msg("released Floating IP, its deleted Instance was not replaced in time")
+	}
+
+	return moving
+}
+
+// floatingIPMoveWorkflowID returns the ID of the Site workflow that associates a Floating IP with its replacement Instance
+func floatingIPMoveWorkflowID(floatingIPID uuid.UUID, instanceID uuid.UUID) string {
+	return "floating-ip-move-" + floatingIPID.String() + "-" + instanceID.String()
+}
+
+// moveFloatingIPsToReplacementInstance moves detached Floating IPs to the Instance that replaces their deleted Instance, i.e. the
+// Instance created with `replacesInstanceId` set to the deleted Instance. Each Floating IP moves to the Interface attached to the
+// same Subnet or VPC Prefix. The Site workflow that programs the Floating IP is only started here, the Floating IP is Associating
+// until completeFloatingIPMoves finds the workflow completed. Floating IPs that can't be moved yet stay detached and are retried
+// with the next inventory.
+func (mi ManageInstance) moveFloatingIPsToReplacementInstance(ctx context.Context, tc client.Client, instance *cdbm.Instance, floatingIPs []cdbm.FloatingIP, logger zerolog.Logger) error {
+	if instance.ReplacesInstanceID == nil {
+		return nil
+	}
+	replacedInstanceID := *instance.ReplacesInstanceID
+
+	detachedFloatingIPs := []*cdbm.FloatingIP{}
+	for i := range floatingIPs {
+		fip := &floatingIPs[i]
+		if fip.Status == cdbm.FloatingIPStatusDetached && fip.TenantID == instance.TenantID && fip.InstanceID != nil && *fip.InstanceID == replacedInstanceID && fip.InterfaceID != nil {
+			detachedFloatingIPs = append(detachedFloatingIPs, fip)
+		}
+	}
+	if len(detachedFloatingIPs) == 0 {
+		return nil
+	}
+
+	interfaceDAO := cdbm.NewInterfaceDAO(mi.dbSession)
+	replacedInterfaces, _, err := interfaceDAO.GetAll(ctx, nil, cdbm.InterfaceFilterInput{InstanceIDs: []uuid.UUID{replacedInstanceID}, IncludeDeleted: true}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Interfaces of replaced Instance from DB")
+		return err
+	}
+	replacedInterfaceMap := map[uuid.UUID]*cdbm.Interface{}
+	for i := range replacedInterfaces {
+		replacedInterfaceMap[replacedInterfaces[i].ID] = &replacedInterfaces[i]
+	}
+
+	interfaces, _, err := interfaceDAO.GetAll(ctx, nil, cdbm.InterfaceFilterInput{InstanceIDs: []uuid.UUID{instance.ID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Interfaces of Instance from DB")
+		return err
+	}
+
+	fipDAO := cdbm.NewFloatingIPDAO(mi.dbSession)
+	miDAO := cdbm.NewMachineInterfaceDAO(mi.dbSession)
+
+	for _, fip := range detachedFloatingIPs {
+		flogger := logger.With().Str("Floating IP ID", fip.ID.String()).Logger()
+
+		replacedIfc, ok := replacedInterfaceMap[*fip.InterfaceID]
+		if !ok {
+			flogger.Warn().Msg("could not find Interface of Floating IP on replaced Instance, Floating IP stays detached")
+			continue
+		}
+
+		var ifc *cdbm.Interface
+		for j := range interfaces {
+			if isReplacementInterface(replacedIfc, &interfaces[j]) {
+				ifc = &interfaces[j]
+				break
+			}
+		}
+		if ifc == nil {
+			flogger.Warn().Msg("replacement Instance has no Interface matching the Interface of the Floating IP, Floating IP stays detached")
+			continue
+		}
+
+		if instance.MachineID == nil || ifc.MacAddress == nil {
+			flogger.Info().Msg("Interface of replacement Instance has not been provisioned on Site yet")
+			continue
+		}
+
+		mis, _, serr := miDAO.GetAll(ctx, nil, cdbm.MachineInterfaceFilterInput{
+			MachineIDs:   []string{*instance.MachineID},
+			MacAddresses: []string{*ifc.MacAddress},
+		}, cdbp.PageInput{}, nil)
+		if serr != nil {
+			flogger.Error().Err(serr).Msg("failed to retrieve Machine Interface for Interface of replacement Instance from DB")
+			return serr
+		}
+		if len(mis) == 0 || mis[0].ControllerInterfaceID == nil {
+			flogger.Info().Msg("could not find Machine Interface for Interface of replacement Instance yet")
+			continue
+		}
+
+		workflowOptions := client.StartWorkflowOptions{
+			ID:        floatingIPMoveWorkflowID(fip.ID, instance.ID),
+			TaskQueue: queue.SiteTaskQueue,
+		}
+
+		// Start the workflow without waiting for it, so the inventory update isn't held up by the Site
+		we, serr := tc.ExecuteWorkflow(ctx, workflowOptions, "AssociateFloatingIP", &cwsv1.AssignStaticAddressRequest{
+			InterfaceId: &cwsv1.MachineInterfaceId{Value: mis[0].ControllerInterfaceID.String()},
+			IpAddress:   fip.IPAddress,
+		})
+		if serr != nil {
+			flogger.Error().Err(serr).Msg("failed to trigger workflow to associate Floating IP with replacement Instance, will retry with next inventory")
+			continue
+		}
+
+		_, serr = fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+			FloatingIPID:          fip.ID,
+			InstanceID:            &instance.ID,
+			InterfaceID:           &ifc.ID,
+			ControllerInterfaceID: mis[0].ControllerInterfaceID,
+			Status:                cdb.GetStrPtr(cdbm.FloatingIPStatusAssociating),
+			UpdatedByID:           fip.UpdatedBy,
+		})
+		if serr != nil {
+			flogger.Error().Err(serr).Msg("failed to move Floating IP to replacement Instance in DB")
+			return serr
+		}
+
+		// Floating IP is no longer considered for other Instances of this inventory
+		fip.InstanceID = &instance.ID
+		fip.InterfaceID = &ifc.ID
+		fip.Status = cdbm.FloatingIPStatusAssociating
+
+		flogger.Info().Str("Interface ID", ifc.ID.String()).Str("Workflow ID", we.GetID()).Msg("triggered workflow to move Floating IP to replacement Instance")
+	}
+
+	return nil
+}
+
+// completeFloatingIPMoves completes the moves of Floating IPs to the replacement Instance started by
+// moveFloatingIPsToReplacementInstance. A Floating IP becomes Associated once its Site workflow completed, or Error if the
+// workflow failed; it can then be associated again through the API.
+func (mi ManageInstance) completeFloatingIPMoves(ctx context.Context, tc client.Client, instance *cdbm.Instance, floatingIPs []cdbm.FloatingIP, logger zerolog.Logger) {
+	fipDAO := cdbm.NewFloatingIPDAO(mi.dbSession)
+
+	for i := range floatingIPs {
+		fip := &floatingIPs[i]
+		if fip.Status != cdbm.FloatingIPStatusAssociating || fip.InstanceID == nil || *fip.InstanceID != instance.ID {
+			continue
+		}
+
+		flogger := logger.With().Str("Floating IP ID", fip.ID.String()).Logger()
+
+		resp, err := tc.DescribeWorkflowExecution(ctx, floatingIPMoveWorkflowID(fip.ID, instance.ID), "")
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				flogger.Warn().Msg("could not find workflow moving Floating IP to replacement Instance")
+			} else {
+				flogger.Error().Err(err).Msg("failed to describe workflow moving Floating IP to replacement Instance, will retry with next inventory")
+			}
+			continue
+		}
+
+		var status string
+		switch resp.GetWorkflowExecutionInfo().GetStatus() {
+		case temporalEnums.WORKFLOW_EXECUTION_STATUS_RUNNING:
+			continue
+		case temporalEnums.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+			status = cdbm.FloatingIPStatusAssociated
+		default:
+			status = cdbm.FloatingIPStatusError
+		}
+
+		_, err = fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{FloatingIPID: fip.ID, Status: cdb.GetStrPtr(status), UpdatedByID: fip.UpdatedBy})
+		if err != nil {
+			flogger.Error().Err(err).Msg("failed to update status of Floating IP moved to replacement Instance in DB")
+			continue
+		}
+		fip.Status = status
+
+		flogger.Info().Str("Status", status).Msg("completed move of Floating IP to replacement Instance")
+	}
+}
+
+// isReplacementInterface returns true if the Interface of a replacement Instance takes over the Interface of the replaced Instance
+func isReplacementInterface(replaced *cdbm.Interface, ifc *cdbm.Interface) bool {
+	equalIDs := func(a, b *uuid.UUID) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+	}
+	equalInts := func(a, b *int) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+	}
+	return ifc.Status != cdbm.InterfaceStatusDeleting &&
+		equalIDs(replaced.SubnetID, ifc.SubnetID) &&
+		equalIDs(replaced.VpcPrefixID, ifc.VpcPrefixID) &&
+		replaced.IsPhysical == ifc.IsPhysical &&
+		equalInts(replaced.VirtualFunctionID, ifc.VirtualFunctionID)
+}
+
 // clearMachineIsAssigned is a utility function to set the isAssigned state in the machine to false
 // tx must be non-nil when calling this function
 func (mi ManageInstance) clearMachineIsAssigned(ctx context.Context, tx *cdb.Tx, logger zerolog.Logger, machineID string) error {
@@ -36,6 +36,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	temporalEnums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/google/uuid"
@@ -176,6 +180,350 @@ func TestManageInstance_deleteInstanceFromDB_removesIBAndNVLinkInterfaces(t *tes
 	require.Empty(t, nvlis)
 }
 
+func TestManageInstance_deleteInstanceFromDB_detachesFloatingIPs(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := util.TestInitDB(t)
+	defer dbSession.Close()
+
+	util.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org-1"
+	ipRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	ipu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, ipRoles)
+	ip := util.TestBuildInfrastructureProvider(t, dbSession, "testIP", ipOrg, ipu)
+
+	tnOrg := "test-tenant-org-1"
+	tnRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	tnu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{tnOrg}, tnRoles)
+	tenant := util.TestBuildTenant(t, dbSession, tnOrg, "Test Tenant", &cdbm.TenantConfig{}, tnu)
+
+	site := util.TestBuildSite(t, dbSession, ip, "testSite", cdbm.SiteStatusRegistered, nil, ipu)
+	vpc := util.TestBuildVpc(t, dbSession, ip, site, tenant, "testVpc")
+	machine := util.TestBuildMachine(t, dbSession, ip.ID, site.ID, cdb.GetStrPtr("mcTypeTest"), cdb.GetBoolPtr(true), cdbm.MachineStatusReady)
+	instanceType := util.TestBuildInstanceType(t, dbSession, ip, site, "testInstanceType")
+	operatingSystem := util.TestBuildOperatingSystem(t, dbSession, "testOS")
+	ipb := util.TestBuildBuildIPBlock(t, dbSession, "testIPBlock", site, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "192.168.10.0", 24, cdbm.IPBlockProtocolVersionV4, false, cdbm.IPBlockStatusReady, ipu)
+
+	instance := util.TestBuildInstance(t, dbSession, "test1", tenant.ID, ip.ID, site.ID, instanceType.ID, vpc.ID, &machine.ID, operatingSystem.ID, cdbm.InstanceStatusTerminating)
+	ifc := util.TestBuildInterface(t, dbSession, &instance.ID, nil, nil, true, nil, nil, nil, &tnu.ID, cdbm.InterfaceStatusReady)
+
+	fip := cdbm.TestBuildFloatingIP(t, dbSession, "vip-1", tenant, site, ipb, "192.168.10.1")
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	_, err := fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+		FloatingIPID:          fip.ID,
+		InstanceID:            &instance.ID,
+		InterfaceID:           &ifc.ID,
+		ControllerInterfaceID: cdb.GetUUIDPtr(uuid.New()),
+		Status:                cdb.GetStrPtr(cdbm.FloatingIPStatusAssociated),
+		UpdatedByID:           tnu.ID,
+	})
+	require.NoError(t, err)
+
+	tx, err := cdb.BeginTx(ctx, dbSession, &sql.TxOptions{})
+	require.NoError(t, err)
+
+	tSiteClientPool := testTemporalSiteClientPool(t)
+	wtc := &tmocks.Client{}
+	cfg := config.GetTestConfig()
+	ms := NewManageInstance(dbSession, tSiteClientPool, wtc, cfg)
+
+	err = ms.deleteInstanceFromDB(ctx, tx, instance, zerolog.Nop())
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	// Floating IP keeps its association, only the Site interface it was programmed on is gone
+	ufip, err := fipDAO.GetByID(ctx, nil, fip.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusDetached, ufip.Status)
+	assert.Equal(t, fip.IPAddress, ufip.IPAddress)
+	require.NotNil(t, ufip.InstanceID)
+	assert.Equal(t, instance.ID, *ufip.InstanceID)
+	require.NotNil(t, ufip.InterfaceID)
+	assert.Equal(t, ifc.ID, *ufip.InterfaceID)
+	assert.Nil(t, ufip.ControllerInterfaceID)
+}
+
+func TestManageInstance_moveFloatingIPsToReplacementInstance(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := util.TestInitDB(t)
+	defer dbSession.Close()
+
+	util.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org-1"
+	ipRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	ipu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, ipRoles)
+	ip := util.TestBuildInfrastructureProvider(t, dbSession, "testIP", ipOrg, ipu)
+
+	tnOrg := "test-tenant-org-1"
+	tnRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	tnu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{tnOrg}, tnRoles)
+	tenant := util.TestBuildTenant(t, dbSession, tnOrg, "Test Tenant", &cdbm.TenantConfig{}, tnu)
+
+	site := util.TestBuildSite(t, dbSession, ip, "testSite", cdbm.SiteStatusRegistered, nil, ipu)
+	vpc := util.TestBuildVpc(t, dbSession, ip, site, tenant, "testVpc")
+	subnet := util.TestBuildSubnet(t, dbSession, tenant, vpc, "testSubnet", cdbm.SubnetStatusReady, cdb.GetUUIDPtr(uuid.New()))
+	machine1 := util.TestBuildMachine(t, dbSession, ip.ID, site.ID, cdb.GetStrPtr("mcTypeTest"), cdb.GetBoolPtr(true), cdbm.MachineStatusReady)
+	machine2 := util.TestBuildMachine(t, dbSession, ip.ID, site.ID, cdb.GetStrPtr("mcTypeTest"), cdb.GetBoolPtr(true), cdbm.MachineStatusReady)
+	instanceType := util.TestBuildInstanceType(t, dbSession, ip, site, "testInstanceType")
+	operatingSystem := util.TestBuildOperatingSystem(t, dbSession, "testOS")
+	ipb := util.TestBuildBuildIPBlock(t, dbSession, "testIPBlock", site, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "192.168.10.0", 24, cdbm.IPBlockProtocolVersionV4, false, cdbm.IPBlockStatusReady, ipu)
+
+	// Instance on the failed host, its Floating IP is associated with its Interface
+	instance1 := util.TestBuildInstance(t, dbSession, "test1", tenant.ID, ip.ID, site.ID, instanceType.ID, vpc.ID, &machine1.ID, operatingSystem.ID, cdbm.InstanceStatusTerminating)
+	ifc1 := util.TestBuildInterface(t, dbSession, &instance1.ID, &subnet.ID, nil, true, nil, nil, nil, &tnu.ID, cdbm.InterfaceStatusReady)
+
+	fip := cdbm.TestBuildFloatingIP(t, dbSession, "vip-1", tenant, site, ipb, "192.168.10.1")
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	_, err := fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+		FloatingIPID:          fip.ID,
+		InstanceID:            &instance1.ID,
+		InterfaceID:           &ifc1.ID,
+		ControllerInterfaceID: cdb.GetUUIDPtr(uuid.New()),
+		Status:                cdb.GetStrPtr(cdbm.FloatingIPStatusAssociated),
+		UpdatedByID:           tnu.ID,
+	})
+	require.NoError(t, err)
+
+	tSiteClientPool := testTemporalSiteClientPool(t)
+	wtc := &tmocks.Client{}
+	cfg := config.GetTestConfig()
+	ms := NewManageInstance(dbSession, tSiteClientPool, wtc, cfg)
+
+	tx, err := cdb.BeginTx(ctx, dbSession, &sql.TxOptions{})
+	require.NoError(t, err)
+	require.NoError(t, ms.deleteInstanceFromDB(ctx, tx, instance1, zerolog.Nop()))
+	require.NoError(t, tx.Commit())
+
+	// Replacement Instance created for the deleted Instance, and an Instance with the same name in the same VPC that does not replace it
+	instance2 := util.TestBuildInstance(t, dbSession, "test1", tenant.ID, ip.ID, site.ID, instanceType.ID, vpc.ID, &machine2.ID, operatingSystem.ID, cdbm.InstanceStatusReady)
+	instance2.ReplacesInstanceID = &instance1.ID
+	_, err = dbSession.DB.NewUpdate().Model(instance2).Column("replaces_instance_id").WherePK().Exec(ctx)
+	require.NoError(t, err)
+	ifc2 := util.TestBuildInterface(t, dbSession, &instance2.ID, &subnet.ID, nil, true, nil, nil, nil, &tnu.ID, cdbm.InterfaceStatusReady)
+	instance3 := util.TestBuildInstance(t, dbSession, "test1", tenant.ID, ip.ID, site.ID, instanceType.ID, vpc.ID, &machine1.ID, operatingSystem.ID, cdbm.InstanceStatusReady)
+
+	interfaceDAO := cdbm.NewInterfaceDAO(dbSession)
+	ifc2, err = interfaceDAO.Update(ctx, nil, cdbm.InterfaceUpdateInput{InterfaceID: ifc2.ID, MacAddress: cdb.GetStrPtr("0:0:0:0:0:0")})
+	require.NoError(t, err)
+
+	controllerInterfaceID := uuid.New()
+	util.TestBuildMachineInterface(t, dbSession, machine2.ID, &controllerInterfaceID, nil, &subnet.ID, nil)
+
+	detached, _, err := fipDAO.GetAll(ctx, nil, cdbm.FloatingIPFilterInput{Statuses: []string{cdbm.FloatingIPStatusDetached}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	require.Len(t, detached, 1)
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(floatingIPMoveWorkflowID(fip.ID, instance2.ID))
+
+	tc := &tmocks.Client{}
+	tc.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"), "AssociateFloatingIP", mock.Anything).Return(wrun, nil)
+
+	// An Instance that was not created to replace the deleted Instance does not get its Floating IP
+	err = ms.moveFloatingIPsToReplacementInstance(ctx, tc, instance3, detached, zerolog.Nop())
+	require.NoError(t, err)
+	tc.AssertNotCalled(t, "ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	err = ms.moveFloatingIPsToReplacementInstance(ctx, tc, instance2, detached, zerolog.Nop())
+	require.NoError(t, err)
+	tc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+	wrun.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+
+	options := tc.Calls[0].Arguments.Get(1).(client.StartWorkflowOptions)
+	assert.Equal(t, floatingIPMoveWorkflowID(fip.ID, instance2.ID), options.ID)
+	request := tc.Calls[0].Arguments.Get(3).(*cwsv1.AssignStaticAddressRequest)
+	assert.Equal(t, controllerInterfaceID.String(), request.InterfaceId.Value)
+	assert.Equal(t, fip.IPAddress, request.IpAddress)
+
+	// Floating IP is associating with the replacement Instance until the workflow completes
+	ufip, err := fipDAO.GetByID(ctx, nil, fip.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusAssociating, ufip.Status)
+	require.NotNil(t, ufip.InstanceID)
+	assert.Equal(t, instance2.ID, *ufip.InstanceID)
+	require.NotNil(t, ufip.InterfaceID)
+	assert.Equal(t, ifc2.ID, *ufip.InterfaceID)
+	require.NotNil(t, ufip.ControllerInterfaceID)
+	assert.Equal(t, controllerInterfaceID, *ufip.ControllerInterfaceID)
+
+	// Workflow is still running, Floating IP keeps associating
+	tc.On("DescribeWorkflowExecution", mock.Anything, floatingIPMoveWorkflowID(fip.ID, instance2.ID), "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: temporalEnums.WORKFLOW_EXECUTION_STATUS_RUNNING},
+	}, nil).Once()
+	ms.completeFloatingIPMoves(ctx, tc, instance2, []cdbm.FloatingIP{*ufip}, zerolog.Nop())
+
+	ufip, err = fipDAO.GetByID(ctx, nil, fip.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusAssociating, ufip.Status)
+
+	// Association survives the replacement once the workflow completed
+	tc.On("DescribeWorkflowExecution", mock.Anything, floatingIPMoveWorkflowID(fip.ID, instance2.ID), "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: temporalEnums.WORKFLOW_EXECUTION_STATUS_COMPLETED},
+	}, nil).Once()
+	ms.completeFloatingIPMoves(ctx, tc, instance2, []cdbm.FloatingIP{*ufip}, zerolog.Nop())
+
+	ufip, err = fipDAO.GetByID(ctx, nil, fip.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusAssociated, ufip.Status)
+	require.NotNil(t, ufip.InstanceID)
+	assert.Equal(t, instance2.ID, *ufip.InstanceID)
+}
+
+func TestManageInstance_completeFloatingIPMoves_workflowFailed(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := util.TestInitDB(t)
+	defer dbSession.Close()
+
+	util.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org-1"
+	ipRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	ipu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, ipRoles)
+	ip := util.TestBuildInfrastructureProvider(t, dbSession, "testIP", ipOrg, ipu)
+
+	tnOrg := "test-tenant-org-1"
+	tnRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	tnu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{tnOrg}, tnRoles)
+	tenant := util.TestBuildTenant(t, dbSession, tnOrg, "Test Tenant", &cdbm.TenantConfig{}, tnu)
+
+	site := util.TestBuildSite(t, dbSession, ip, "testSite", cdbm.SiteStatusRegistered, nil, ipu)
+	vpc := util.TestBuildVpc(t, dbSession, ip, site, tenant, "testVpc")
+	machine := util.TestBuildMachine(t, dbSession, ip.ID, site.ID, cdb.GetStrPtr("mcTypeTest"), cdb.GetBoolPtr(true), cdbm.MachineStatusReady)
+	instanceType := util.TestBuildInstanceType(t, dbSession, ip, site, "testInstanceType")
+	operatingSystem := util.TestBuildOperatingSystem(t, dbSession, "testOS")
+	ipb := util.TestBuildBuildIPBlock(t, dbSession, "testIPBlock", site, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "192.168.10.0", 24, cdbm.IPBlockProtocolVersionV4, false, cdbm.IPBlockStatusReady, ipu)
+
+	instance := util.TestBuildInstance(t, dbSession, "test1", tenant.ID, ip.ID, site.ID, instanceType.ID, vpc.ID, &machine.ID, operatingSystem.ID, cdbm.InstanceStatusReady)
+	ifc := util.TestBuildInterface(t, dbSession, &instance.ID, nil, nil, true, nil, nil, nil, &tnu.ID, cdbm.InterfaceStatusReady)
+
+	fip1 := cdbm.TestBuildFloatingIP(t, dbSession, "vip-1", tenant, site, ipb, "192.168.10.1")
+	fip2 := cdbm.TestBuildFloatingIP(t, dbSession, "vip-2", tenant, site, ipb, "192.168.10.2")
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	fips := []cdbm.FloatingIP{}
+	for _, fip := range []*cdbm.FloatingIP{fip1, fip2} {
+		ufip, err := fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+			FloatingIPID:          fip.ID,
+			InstanceID:            &instance.ID,
+			InterfaceID:           &ifc.ID,
+			ControllerInterfaceID: cdb.GetUUIDPtr(uuid.New()),
+			Status:                cdb.GetStrPtr(cdbm.FloatingIPStatusAssociating),
+			UpdatedByID:           tnu.ID,
+		})
+		require.NoError(t, err)
+		fips = append(fips, *ufip)
+	}
+
+	tSiteClientPool := testTemporalSiteClientPool(t)
+	cfg := config.GetTestConfig()
+	ms := NewManageInstance(dbSession, tSiteClientPool, &tmocks.Client{}, cfg)
+
+	tc := &tmocks.Client{}
+	tc.On("DescribeWorkflowExecution", mock.Anything, floatingIPMoveWorkflowID(fip1.ID, instance.ID), "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: temporalEnums.WORKFLOW_EXECUTION_STATUS_FAILED},
+	}, nil)
+	tc.On("DescribeWorkflowExecution", mock.Anything, floatingIPMoveWorkflowID(fip2.ID, instance.ID), "").Return(nil, serviceerror.NewUnavailable("temporal unavailable"))
+
+	ms.completeFloatingIPMoves(ctx, tc, instance, fips, zerolog.Nop())
+
+	// Failed move surfaces as Error, the Floating IP can be associated again through the API
+	ufip, err := fipDAO.GetByID(ctx, nil, fip1.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusError, ufip.Status)
+
+	// Move whose workflow could not be described is retried with the next inventory
+	ufip, err = fipDAO.GetByID(ctx, nil, fip2.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusAssociating, ufip.Status)
+}
+
+func TestManageInstance_releaseExpiredDetachedFloatingIPs(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := util.TestInitDB(t)
+	defer dbSession.Close()
+
+	util.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org-1"
+	ipRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	ipu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, ipRoles)
+	ip := util.TestBuildInfrastructureProvider(t, dbSession, "testIP", ipOrg, ipu)
+
+	tnOrg := "test-tenant-org-1"
+	tnRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	tnu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{tnOrg}, tnRoles)
+	tenant := util.TestBuildTenant(t, dbSession, tnOrg, "Test Tenant", &cdbm.TenantConfig{}, tnu)
+
+	site := util.TestBuildSite(t, dbSession, ip, "testSite", cdbm.SiteStatusRegistered, nil, ipu)
+	vpc := util.TestBuildVpc(t, dbSession, ip, site, tenant, "testVpc")
+	machine := util.TestBuildMachine(t, dbSession, ip.ID, site.ID, cdb.GetStrPtr("mcTypeTest"), cdb.GetBoolPtr(true), cdbm.MachineStatusReady)
+	instanceType := util.TestBuildInstanceType(t, dbSession, ip, site, "testInstanceType")
+	operatingSystem := util.TestBuildOperatingSystem(t, dbSession, "testOS")
+	ipb := util.TestBuildBuildIPBlock(t, dbSession, "testIPBlock", site, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "192.168.10.0", 24, cdbm.IPBlockProtocolVersionV4, false, cdbm.IPBlockStatusReady, ipu)
+
+	instance := util.TestBuildInstance(t, dbSession, "test1", tenant.ID, ip.ID, site.ID, instanceType.ID, vpc.ID, &machine.ID, operatingSystem.ID, cdbm.InstanceStatusTerminated)
+	ifc := util.TestBuildInterface(t, dbSession, &instance.ID, nil, nil, true, nil, nil, nil, &tnu.ID, cdbm.InterfaceStatusDeleting)
+
+	expired := cdbm.TestBuildFloatingIP(t, dbSession, "vip-1", tenant, site, ipb, "192.168.10.1")
+	recent := cdbm.TestBuildFloatingIP(t, dbSession, "vip-2", tenant, site, ipb, "192.168.10.2")
+
+	fipDAO := cdbm.NewFloatingIPDAO(dbSession)
+	for _, fip := range []*cdbm.FloatingIP{expired, recent} {
+		_, err := fipDAO.Update(ctx, nil, cdbm.FloatingIPUpdateInput{
+			FloatingIPID: fip.ID,
+			InstanceID:   &instance.ID,
+			InterfaceID:  &ifc.ID,
+			Status:       cdb.GetStrPtr(cdbm.FloatingIPStatusDetached),
+			UpdatedByID:  tnu.ID,
+		})
+		require.NoError(t, err)
+	}
+	_, err := dbSession.DB.NewUpdate().Model((*cdbm.FloatingIP)(nil)).Set("updated = ?", time.Now().Add(-FloatingIPDetachedTimeout-time.Hour)).Where("id = ?", expired.ID).Exec(ctx)
+	require.NoError(t, err)
+
+	detached, _, err := fipDAO.GetAll(ctx, nil, cdbm.FloatingIPFilterInput{Statuses: []string{cdbm.FloatingIPStatusDetached}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	require.Len(t, detached, 2)
+
+	tSiteClientPool := testTemporalSiteClientPool(t)
+	cfg := config.GetTestConfig()
+	ms := NewManageInstance(dbSession, tSiteClientPool, &tmocks.Client{}, cfg)
+
+	moving := ms.releaseExpiredDetachedFloatingIPs(ctx, detached, zerolog.Nop())
+	require.Len(t, moving, 1)
+	assert.Equal(t, recent.ID, moving[0].ID)
+
+	// Floating IP whose Instance was not replaced in time is released but stays allocated to the Tenant
+	ufip, err := fipDAO.GetByID(ctx, nil, expired.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusAvailable, ufip.Status)
+	assert.Equal(t, tenant.ID, ufip.TenantID)
+	assert.Nil(t, ufip.InstanceID)
+	assert.Nil(t, ufip.InterfaceID)
+	assert.Nil(t, ufip.ControllerInterfaceID)
+
+	ufip, err = fipDAO.GetByID(ctx, nil, recent.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cdbm.FloatingIPStatusDetached, ufip.Status)
+	require.NotNil(t, ufip.InstanceID)
+	assert.Equal(t, instance.ID, *ufip.InstanceID)
+}
+
 func TestManageInstance_UpdateInstancesInDB(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,275 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipusage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	"github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/ipam"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbp "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/util"
+)
+
+const (
+	// IPUsageForecastWindow is the period of usage history used to compute the growth trend for warnings
+	IPUsageForecastWindow = 7 * 24 * time.Hour
+	// IPUsageSampleRetention is the period after which usage samples are deleted
+	IPUsageSampleRetention = 90 * 24 * time.Hour
+)
+
+// ipUsageResource is an IP Block, Subnet or VPC Prefix whose usage is recorded
+type ipUsageResource struct {
+	resourceType string
+	id           uuid.UUID
+	name         string
+	siteID       uuid.UUID
+	usage        *ipam.AddressUsage
+}
+
+// ManageIPUsage is an activity wrapper for recording IP usage that allows
+// injecting DB access
+type ManageIPUsage struct {
+	dbSession *cdb.Session
+	cfg       *config.Config
+}
+
+// Activity functions
+
+// RecordIPUsageForAllSites records a usage sample for every IP Block, Subnet and VPC Prefix of Registered Sites,
+// sends Slack warnings when utilization crosses the configured thresholds or exhaustion is projected to be near,
+// and deletes samples older than IPUsageSampleRetention
+func (miu ManageIPUsage) RecordIPUsageForAllSites(ctx context.Context) error {
+	logger := log.With().Str("Activity", "RecordIPUsageForAllSites").Logger()
+
+	logger.Info().Msg("starting activity")
+
+	siteDAO := cdbm.NewSiteDAO(miu.dbSession)
+	sites, _, err := siteDAO.GetAll(ctx, nil, cdbm.SiteFilterInput{Statuses: []string{cdbm.SiteStatusRegistered}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Sites from DB")
+		return err
+	}
+
+	if len(sites) == 0 {
+		logger.Info().Msg("no Registered Sites found, skipping IP usage recording")
+		return nil
+	}
+
+	siteIDs := []uuid.UUID{}
+	siteNames := map[uuid.UUID]string{}
+	for _, site := range sites {
+		siteIDs = append(siteIDs, site.ID)
+		siteNames[site.ID] = site.Name
+	}
+
+	resources, err := miu.getIPUsageResources(ctx, logger, siteIDs)
+	if err != nil {
+		return err
+	}
+
+	resourceIDs := []uuid.UUID{}
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, resource.id)
+	}
+
+	// Retrieve recent history before recording new samples so warnings are only sent when a threshold is crossed
+	iusDAO := cdbm.NewIPUsageSampleDAO(miu.dbSession)
+	history := map[uuid.UUID][]cdbm.IPUsageSample{}
+	if len(resourceIDs) > 0 {
+		iuss, _, serr := iusDAO.GetAll(ctx, nil, cdbm.IPUsageSampleFilterInput{ResourceIDs: resourceIDs, CreatedAfter: cdb.GetTimePtr(time.Now().Add(-IPUsageForecastWindow))}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)})
+		if serr != nil {
+			logger.Error().Err(serr).Msg("failed to retrieve IP usage samples from DB")
+			return serr
+		}
+		for _, ius := range iuss {
+			history[ius.ResourceID] = append(history[ius.ResourceID], ius)
+		}
+	}
+
+	for _, resource := range resources {
+		ius, serr := iusDAO.Create(ctx, nil, cdbm.IPUsageSampleCreateInput{
+			ResourceType: resource.resourceType,
+			ResourceID:   resource.id,
+			SiteID:       resource.siteID,
+			Prefix:       resource.usage.Prefix,
+			TotalIPs:     resource.usage.TotalIPs,
+			UsedIPs:      resource.usage.UsedIPs,
+		})
+		if serr != nil {
+			logger.Error().Err(serr).Str("Resource ID", resource.id.String()).Msg("failed to create IP usage sample in DB")
+			return serr
+		}
+
+		msg := miu.getIPUsageWarning(resource, siteNames[resource.siteID], history[resource.id], *ius)
+		if msg == nil {
+			continue
+		}
+
+		logger.Warn().Str("Resource ID", resource.id.String()).Str("Resource Type", resource.resourceType).Msg(*msg)
+
+		if miu.cfg.GetNotificationsSlackEnabled() {
+			sc := util.NewSlackClient(miu.cfg.GetNotificationsSlackWebhookURL())
+			nerr := sc.SendSlackNotification(util.SlackMessage{Text: *msg})
+			if nerr != nil {
+				logger.Error().Err(nerr).Msg("failed to send Slack notification for IP usage warning")
+			}
+		}
+	}
+
+	// Expire old samples
+	count, err := iusDAO.DeleteCreatedBefore(ctx, nil, time.Now().Add(-IPUsageSampleRetention))
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to delete expired IP usage samples from DB")
+		return err
+	}
+
+	logger.Info().Int("Recorded", len(resources)).Int("Expired", count).Msg("successfully completed activity")
+
+	return nil
+}
+
+// getIPUsageResources computes the current usage of all Ready IP Blocks, Subnets and VPC Prefixes of the given Sites
+// Resources whose usage cannot be computed are logged and skipped
+func (miu ManageIPUsage) getIPUsageResources(ctx context.Context, logger zerolog.Logger, siteIDs []uuid.UUID) ([]ipUsageResource, error) {
+	resources := []ipUsageResource{}
+
+	ipbDAO := cdbm.NewIPBlockDAO(miu.dbSession)
+	ipbs, _, err := ipbDAO.GetAll(ctx, nil, cdbm.IPBlockFilterInput{SiteIDs: siteIDs, Statuses: []string{cdbm.IPBlockStatusReady}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve IP Blocks from DB")
+		return nil, err
+	}
+
+	ipamStorage := ipam.NewIpamStorage(miu.dbSession.DB, nil)
+	for i := range ipbs {
+		usage, serr := ipam.GetAddressUsageForIPBlock(ctx, ipamStorage, &ipbs[i])
+		if serr != nil {
+			logger.Warn().Err(serr).Str("IP Block ID", ipbs[i].ID.String()).Msg("failed to compute IP usage for IP Block, skipping")
+			continue
+		}
+		resources = append(resources, ipUsageResource{resourceType: cdbm.IPUsageResourceTypeIPBlock, id: ipbs[i].ID, name: ipbs[i].Name, siteID: ipbs[i].SiteID, usage: usage})
+	}
+
+	sDAO := cdbm.NewSubnetDAO(miu.dbSession)
+	subnets, _, err := sDAO.GetAll(ctx, nil, cdbm.SubnetFilterInput{SiteIDs: siteIDs, Statuses: []string{cdbm.SubnetStatusReady}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Subnets from DB")
+		return nil, err
+	}
+
+	for i := range subnets {
+		if subnets[i].IPv4Prefix == nil {
+			continue
+		}
+		usage, serr := ipam.GetAddressUsageForSubnet(ctx, nil, miu.dbSession, &subnets[i])
+		if serr != nil {
+			logger.Warn().Err(serr).Str("Subnet ID", subnets[i].ID.String()).Msg("failed to compute IP usage for Subnet, skipping")
+			continue
+		}
+		resources = append(resources, ipUsageResource{resourceType: cdbm.IPUsageResourceTypeSubnet, id: subnets[i].ID, name: subnets[i].Name, siteID: subnets[i].SiteID, usage: usage})
+	}
+
+	vpDAO := cdbm.NewVpcPrefixDAO(miu.dbSession)
+	vpcPrefixes, _, err := vpDAO.GetAll(ctx, nil, cdbm.VpcPrefixFilterInput{SiteIDs: siteIDs, Statuses: []string{cdbm.VpcPrefixStatusReady}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve VPC Prefixes from DB")
+		return nil, err
+	}
+
+	for i := range vpcPrefixes {
+		usage, serr := ipam.GetAddressUsageForVpcPrefix(ctx, nil, miu.dbSession, &vpcPrefixes[i])
+		if serr != nil {
+			logger.Warn().Err(serr).Str("VPC Prefix ID", vpcPrefixes[i].ID.String()).Msg("failed to compute IP usage for VPC Prefix, skipping")
+			continue
+		}
+		resources = append(resources, ipUsageResource{resourceType: cdbm.IPUsageResourceTypeVpcPrefix, id: vpcPrefixes[i].ID, name: vpcPrefixes[i].Name, siteID: vpcPrefixes[i].SiteID, usage: usage})
+	}
+
+	return resources, nil
+}
+
+// getIPUsageLevel returns 2 if utilization is at or above the critical threshold, 1 if at or above the warning threshold, 0 otherwise
+func (miu ManageIPUsage) getIPUsageLevel(utilization float64) int {
+	if utilization >= miu.cfg.GetNotificationsIPUsageCriticalThreshold() {
+		return 2
+	}
+	if utilization >= miu.cfg.GetNotificationsIPUsageWarningThreshold() {
+		return 1
+	}
+	return 0
+}
+
+// isExhaustionNear returns true if the forecast projects exhaustion within the configured warning period of the given time
+func (miu ManageIPUsage) isExhaustionNear(forecast *ipam.UsageForecast, at time.Time) bool {
+	if forecast.ProjectedExhaustion == nil {
+		return false
+	}
+	return forecast.ProjectedExhaustion.Before(at.AddDate(0, 0, miu.cfg.GetNotificationsIPUsageExhaustionWarningDays()))
+}
+
+// getIPUsageWarning returns the warning to send for the new sample, or nil if neither a utilization threshold
+// was crossed nor projected exhaustion came within the warning period since the previous sample
+func (miu ManageIPUsage) getIPUsageWarning(resource ipUsageResource, siteName string, history []cdbm.IPUsageSample, sample cdbm.IPUsageSample) *string {
+	forecast := ipam.ForecastUsage(append(history, sample))
+
+	utilization := sample.GetUtilization()
+	level := miu.getIPUsageLevel(utilization)
+	exhaustionNear := miu.isExhaustionNear(forecast, sample.Created)
+
+	prevLevel := 0
+	prevExhaustionNear := false
+	if len(history) > 0 {
+		prev := history[len(history)-1]
+		prevLevel = miu.getIPUsageLevel(prev.GetUtilization())
+		prevExhaustionNear = miu.isExhaustionNear(ipam.ForecastUsage(history), prev.Created)
+	}
+
+	if level <= prevLevel && (!exhaustionNear || prevExhaustionNear) {
+		return nil
+	}
+
+	title := ":warning: *IP Usage Warning*"
+	if level == 2 {
+		title = ":rotating_light: *IP Usage Critical*"
+	}
+
+	msg := fmt.Sprintf("%s\n\n%s `%s` (`%s`) at Site `%s` is %.1f%% used (%d of %d addresses)", title, resource.resourceType, resource.name, sample.Prefix, siteName, utilization, sample.UsedIPs, sample.TotalIPs)
+	if forecast.ProjectedExhaustion != nil {
+		msg += fmt.Sprintf(", projected to be exhausted by %s", forecast.ProjectedExhaustion.UTC().Format(time.DateOnly))
+	}
+
+	return &msg
+}
+
+// NewManageIPUsage returns a new ManageIPUsage client
+func NewManageIPUsage(dbSession *cdb.Session, cfg *config.Config) ManageIPUsage {
+	return ManageIPUsage{
+		dbSession: dbSession,
+		cfg:       cfg,
+	}
+}
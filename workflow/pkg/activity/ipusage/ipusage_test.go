@@ -0,0 +1,205 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipusage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun/extra/bundebug"
+
+	cdb "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db"
+	cdbm "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/model"
+	cdbp "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/db/paginator"
+	cdbu "github.com/NVIDIA/ncx-infra-controller-rest/db/pkg/util"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/internal/config"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/util"
+)
+
+func testIPUsageInitDB(t *testing.T) *cdb.Session {
+	dbSession := cdbu.GetTestDBSession(t, false)
+	dbSession.DB.AddQueryHook(bundebug.NewQueryHook(
+		bundebug.WithEnabled(false),
+		bundebug.FromEnv("BUNDEBUG"),
+	))
+	return dbSession
+}
+
+func TestManageIPUsage_RecordIPUsageForAllSites(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testIPUsageInitDB(t)
+	defer dbSession.Close()
+
+	util.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org-1"
+	ipRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	ipu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, ipRoles)
+	ip := util.TestBuildInfrastructureProvider(t, dbSession, "testIP", ipOrg, ipu)
+
+	tnOrg := "test-tenant-org-1"
+	tnRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	tnu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{tnOrg}, tnRoles)
+	tenant := util.TestBuildTenant(t, dbSession, "test-tenant", tnOrg, &cdbm.TenantConfig{}, tnu)
+
+	site1 := util.TestBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, nil, ipu)
+	site2 := util.TestBuildSite(t, dbSession, ip, "test-site-2", cdbm.SiteStatusPending, nil, ipu)
+
+	// Full grant IP Block is fully used
+	ipb1 := util.TestBuildBuildIPBlock(t, dbSession, "test-ipblock-1", site1, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "10.0.0.0", 24, cdbm.IPBlockProtocolVersionV4, true, cdbm.IPBlockStatusReady, ipu)
+	// IP Block at a Site that is not Registered is skipped
+	ipb2 := util.TestBuildBuildIPBlock(t, dbSession, "test-ipblock-2", site2, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "10.1.0.0", 24, cdbm.IPBlockProtocolVersionV4, true, cdbm.IPBlockStatusReady, ipu)
+
+	vpc := util.TestBuildVpc(t, dbSession, ip, site1, tenant, "test-vpc")
+	vp := util.TestBuildVPCPrefix(t, dbSession, "test-vpc-prefix", site1, tenant, vpc.ID, &ipb1.ID, cdb.GetStrPtr("10.0.0.0/28"), cdb.GetIntPtr(28), cdbm.VpcPrefixStatusReady, tnu)
+
+	// Expired sample is deleted
+	expired := cdbm.TestBuildIPUsageSample(t, dbSession, cdbm.IPUsageResourceTypeVpcPrefix, vp.ID, site1, "10.0.0.0/28", 16, 0, time.Now().Add(-IPUsageSampleRetention-time.Hour))
+
+	notifications := atomic.Int32{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notifications.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}))
+	defer testServer.Close()
+
+	cfg := config.NewConfig()
+	cfg.SetNotificationsSlackWebhookURL(testServer.URL)
+
+	miu := NewManageIPUsage(dbSession, cfg)
+
+	err := miu.RecordIPUsageForAllSites(ctx)
+	assert.NoError(t, err)
+
+	iusDAO := cdbm.NewIPUsageSampleDAO(dbSession)
+	samples, total, err := iusDAO.GetAll(ctx, nil, cdbm.IPUsageSampleFilterInput{}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	for _, sample := range samples {
+		assert.NotEqual(t, expired.ID, sample.ID)
+		assert.NotEqual(t, ipb2.ID, sample.ResourceID)
+
+		switch sample.ResourceID {
+		case ipb1.ID:
+			assert.Equal(t, cdbm.IPUsageResourceTypeIPBlock, sample.ResourceType)
+			assert.Equal(t, "10.0.0.0/24", sample.Prefix)
+			assert.Equal(t, int64(256), sample.TotalIPs)
+			assert.Equal(t, int64(256), sample.UsedIPs)
+		case vp.ID:
+			assert.Equal(t, cdbm.IPUsageResourceTypeVpcPrefix, sample.ResourceType)
+			assert.Equal(t, int64(16), sample.TotalIPs)
+			assert.Equal(t, int64(0), sample.UsedIPs)
+		default:
+			t.Errorf("unexpected sample for resource %s", sample.ResourceID)
+		}
+	}
+
+	// Fully used IP Block crossed the critical threshold
+	assert.Equal(t, int32(1), notifications.Load())
+
+	// Utilization level is unchanged, no further notification is sent
+	err = miu.RecordIPUsageForAllSites(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), notifications.Load())
+
+	_, total, err = iusDAO.GetAll(ctx, nil, cdbm.IPUsageSampleFilterInput{}, cdbp.PageInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, total)
+}
+
+func TestManageIPUsage_getIPUsageWarning(t *testing.T) {
+	cfg := config.NewConfig()
+	miu := NewManageIPUsage(nil, cfg)
+
+	now := time.Now()
+	resource := ipUsageResource{resourceType: cdbm.IPUsageResourceTypeSubnet, id: uuid.New(), name: "test-subnet"}
+
+	newSample := func(used int64, created time.Time) cdbm.IPUsageSample {
+		return cdbm.IPUsageSample{ResourceID: resource.id, Prefix: "192.168.0.0/24", TotalIPs: 256, UsedIPs: used, Created: created}
+	}
+
+	tests := []struct {
+		name       string
+		history    []cdbm.IPUsageSample
+		sample     cdbm.IPUsageSample
+		wantWarn   bool
+		wantPrefix string
+	}{
+		{
+			name:     "no warning below thresholds",
+			sample:   newSample(10, now),
+			wantWarn: false,
+		},
+		{
+			name:       "warning threshold crossed",
+			history:    []cdbm.IPUsageSample{newSample(200, now.Add(-time.Hour))},
+			sample:     newSample(210, now),
+			wantWarn:   true,
+			wantPrefix: ":warning:",
+		},
+		{
+			name:     "warning threshold already crossed",
+			history:  []cdbm.IPUsageSample{newSample(210, now.Add(-time.Hour))},
+			sample:   newSample(210, now),
+			wantWarn: false,
+		},
+		{
+			name:       "critical threshold crossed",
+			history:    []cdbm.IPUsageSample{newSample(210, now.Add(-time.Hour))},
+			sample:     newSample(250, now),
+			wantWarn:   true,
+			wantPrefix: ":rotating_light:",
+		},
+		{
+			name:       "exhaustion projected within warning period",
+			history:    []cdbm.IPUsageSample{newSample(10, now.Add(-48*time.Hour)), newSample(20, now.Add(-24*time.Hour))},
+			sample:     newSample(140, now),
+			wantWarn:   true,
+			wantPrefix: ":warning:",
+		},
+		{
+			name:     "exhaustion projected beyond warning period",
+			history:  []cdbm.IPUsageSample{newSample(10, now.Add(-48*time.Hour))},
+			sample:   newSample(11, now),
+			wantWarn: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := miu.getIPUsageWarning(resource, "test-site", tt.history, tt.sample)
+			if !tt.wantWarn {
+				assert.Nil(t, got)
+				return
+			}
+			assert.NotNil(t, got)
+			assert.Contains(t, *got, tt.wantPrefix)
+			assert.Contains(t, *got, "test-subnet")
+		})
+	}
+}
@@ -173,9 +173,14 @@ func (mv ManageNetworkSecurityGroup) UpdateNetworkSecurityGroupsInDB(ctx context
 				// If the record coming in from site is known to cloud but site
 				// reports a different version, time to update cloud.
 
-				rules := make([]*cdbm.NetworkSecurityGroupRule, len(controllerNetworkSecurityGroup.GetAttributes().GetRules()))
-				for i, rule := range controllerNetworkSecurityGroup.GetAttributes().GetRules() {
-					rules[i] = &cdbm.NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: rule}
+				// Rules referencing Address Groups are only known to cloud, site
+				// holds their expanded form, so cloud rules must be kept as they are.
+				var rules []*cdbm.NetworkSecurityGroupRule
+				if !networkSecurityGroup.HasAddressGroupReferences() {
+					rules = make([]*cdbm.NetworkSecurityGroupRule, len(controllerNetworkSecurityGroup.GetAttributes().GetRules()))
+					for i, rule := range controllerNetworkSecurityGroup.GetAttributes().GetRules() {
+						rules[i] = &cdbm.NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: rule}
+					}
 				}
 
 				_, err = networkSecurityGroupDAO.Update(ctx, nil, cdbm.NetworkSecurityGroupUpdateInput{
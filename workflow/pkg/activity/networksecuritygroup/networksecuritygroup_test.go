@@ -243,6 +243,22 @@ func TestManageNetworkSecurityGroup_UpdateNetworkSecurityGroupsInDB(t *testing.T
 	networkSecurityGroup8, err = networkSecurityGroupDAO.Update(ctx, nil, cdbm.NetworkSecurityGroupUpdateInput{NetworkSecurityGroupID: networkSecurityGroup8.ID, Status: cdb.GetStrPtr(cdbm.NetworkSecurityGroupStatusError)})
 	assert.NoError(t, err)
 
+	// Rules referencing an Address Group only exist in cloud, so they must survive a version change reported by Site
+	networkSecurityGroup2, err = networkSecurityGroupDAO.Update(ctx, nil, cdbm.NetworkSecurityGroupUpdateInput{NetworkSecurityGroupID: networkSecurityGroup2.ID, Rules: []*cdbm.NetworkSecurityGroupRule{
+		{
+			NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+				Direction: cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS,
+				Protocol:  cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+				Action:    cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT,
+				DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{
+					DstPrefix: "0.0.0.0/0",
+				},
+			},
+			SourceAddressGroupID: cdb.GetStrPtr(uuid.NewString()),
+		},
+	}})
+	assert.NoError(t, err)
+
 	// Build NetworkSecurityGroup inventory that is paginated
 	// Generate data for 35 NetworkSecurityGroups reported from Site Agent while Cloud has 38 NetworkSecurityGroups
 	// One of the NetworkSecurityGroups on site doesn't exist in cloud.
@@ -326,13 +342,14 @@ func TestManageNetworkSecurityGroup_UpdateNetworkSecurityGroupsInDB(t *testing.T
 	}
 
 	tests := []struct {
-		name                         string
-		fields                       fields
-		args                         args
-		readyNetworkSecurityGroups   []*cdbm.NetworkSecurityGroup
-		deletedNetworkSecurityGroups []*cdbm.NetworkSecurityGroup
-		updatedNetworkSecurityGroups []*cwssaws.NetworkSecurityGroup
-		wantErr                      bool
+		name                             string
+		fields                           fields
+		args                             args
+		readyNetworkSecurityGroups       []*cdbm.NetworkSecurityGroup
+		deletedNetworkSecurityGroups     []*cdbm.NetworkSecurityGroup
+		updatedNetworkSecurityGroups     []*cwssaws.NetworkSecurityGroup
+		referencingNetworkSecurityGroups []*cdbm.NetworkSecurityGroup
+		wantErr                          bool
 	}{
 		{
 			name: "test NetworkSecurityGroup inventory processing error, non-existent Site",
@@ -395,8 +412,9 @@ func TestManageNetworkSecurityGroup_UpdateNetworkSecurityGroupsInDB(t *testing.T
 					},
 				},
 			},
-			deletedNetworkSecurityGroups: []*cdbm.NetworkSecurityGroup{networkSecurityGroup5, networkSecurityGroup6},
-			wantErr:                      false,
+			deletedNetworkSecurityGroups:     []*cdbm.NetworkSecurityGroup{networkSecurityGroup5, networkSecurityGroup6},
+			referencingNetworkSecurityGroups: []*cdbm.NetworkSecurityGroup{networkSecurityGroup2},
+			wantErr:                          false,
 		},
 		{
 			name: "test paged NetworkSecurityGroup inventory processing, empty inventory",
@@ -538,6 +556,12 @@ func TestManageNetworkSecurityGroup_UpdateNetworkSecurityGroupsInDB(t *testing.T
 				assert.Equal(t, networkSecurityGroup.Metadata.Description, *it.Description)
 				assert.Equal(t, networkSecurityGroup.GetAttributes().GetStatefulEgress(), it.StatefulEgress)
 			}
+
+			for _, networkSecurityGroup := range tt.referencingNetworkSecurityGroups {
+				it, err := networkSecurityGroupDAO.GetByID(ctx, nil, networkSecurityGroup.ID, nil)
+				require.Nil(t, err)
+				assert.True(t, it.HasAddressGroupReferences(), fmt.Sprintf("NetworkSecurityGroup %s (%s) should have kept its Address Group references", networkSecurityGroup.Name, networkSecurityGroup.ID))
+			}
 		})
 	}
 
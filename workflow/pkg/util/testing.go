@@ -141,6 +141,12 @@ func TestSetupSchema(t *testing.T, dbSession *cdb.Session) {
 	// create Interface table
 	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.Interface)(nil))
 	assert.Nil(t, err)
+	// create FloatingIP table
+	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.FloatingIP)(nil))
+	assert.Nil(t, err)
+	// create IPUsageSample table
+	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.IPUsageSample)(nil))
+	assert.Nil(t, err)
 	// create SSHKeyGroup table
 	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.SSHKeyGroup)(nil))
 	assert.Nil(t, err)
@@ -0,0 +1,84 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	ipUsageActivity "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/activity/ipusage"
+	"github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/queue"
+)
+
+// RecordIPUsage is a Temporal cron workflow to periodically record IP usage of IP Blocks, Subnets and VPC Prefixes
+func RecordIPUsage(ctx workflow.Context) error {
+	logger := log.With().Str("Workflow", "IPUsage").Str("Action", "Record").Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    2 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    2 * time.Minute,
+		MaximumAttempts:    5,
+	}
+
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 15 * time.Minute,
+		RetryPolicy:         retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var ipUsageManager ipUsageActivity.ManageIPUsage
+
+	err := workflow.ExecuteActivity(ctx, ipUsageManager.RecordIPUsageForAllSites).Get(ctx, nil)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: RecordIPUsageForAllSites")
+		return err
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return nil
+}
+
+// ExecuteRecordIPUsageWorkflow is a helper function to trigger execution of RecordIPUsage workflow
+func ExecuteRecordIPUsageWorkflow(ctx context.Context, tc client.Client) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:           "record-ip-usage",
+		CronSchedule: "@every 1h", // Run hourly
+		TaskQueue:    queue.CloudTaskQueue,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, RecordIPUsage)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute workflow: RecordIPUsage")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
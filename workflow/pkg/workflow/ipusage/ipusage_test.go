@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ipusage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	ipUsageActivity "github.com/NVIDIA/ncx-infra-controller-rest/workflow/pkg/activity/ipusage"
+	tmocks "go.temporal.io/sdk/mocks"
+)
+
+type RecordIPUsageTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *RecordIPUsageTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *RecordIPUsageTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *RecordIPUsageTestSuite) Test_RecordIPUsageWorkflow_Success() {
+	var ipUsageManager ipUsageActivity.ManageIPUsage
+
+	// Mock RecordIPUsageForAllSites activity success
+	s.env.RegisterActivity(ipUsageManager.RecordIPUsageForAllSites)
+	s.env.OnActivity(ipUsageManager.RecordIPUsageForAllSites, mock.Anything).Return(nil)
+
+	// Execute RecordIPUsage workflow
+	s.env.ExecuteWorkflow(RecordIPUsage)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *RecordIPUsageTestSuite) Test_RecordIPUsageWorkflow_ActivityFails() {
+	var ipUsageManager ipUsageActivity.ManageIPUsage
+
+	// Mock RecordIPUsageForAllSites activity failure
+	s.env.RegisterActivity(ipUsageManager.RecordIPUsageForAllSites)
+	s.env.OnActivity(ipUsageManager.RecordIPUsageForAllSites, mock.Anything).Return(errors.New("RecordIPUsageForAllSites Failure"))
+
+	// Execute RecordIPUsage workflow
+	s.env.ExecuteWorkflow(RecordIPUsage)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("RecordIPUsageForAllSites Failure", applicationErr.Error())
+}
+
+func (s *RecordIPUsageTestSuite) Test_ExecuteRecordIPUsageWorkflow_Success() {
+	ctx := context.Background()
+
+	wrid := "test-workflow-run-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wrid)
+
+	tc := &tmocks.Client{}
+
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything).Return(wrun, nil)
+
+	rwrid, err := ExecuteRecordIPUsageWorkflow(ctx, tc)
+	s.NoError(err)
+	s.Equal(wrid, *rwrid)
+}
+
+func TestRecordIPUsageSuite(t *testing.T) {
+	suite.Run(t, new(RecordIPUsageTestSuite))
+}